@@ -19,19 +19,32 @@ import (
 	grpc "github.com/go-park-mail-ru/2025_1_Return_Zero/init/microservices"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/postgres"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/redis"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/s3"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/middleware"
 	albumHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/album/delivery/http"
 	albumUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/album/usecase"
 	artistHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/artist/delivery/http"
 	artistUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/artist/usecase"
-	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	jamHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam/delivery/http"
 	jamRepository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam/repository"
 	jamUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam/usecase"
+	notificationHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification/delivery/http"
+	notificationRepository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification/repository"
+	notificationUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification/usecase"
 	playlistHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/playlist/delivery/http"
 	playlistUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/playlist/usecase"
+	releaseRadarHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar/delivery/http"
+	releaseRadarRepository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar/repository"
+	releaseRadarUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar/usecase"
+	searchHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/delivery/http"
+	searchRepository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/repository"
+	searchUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/usecase"
 	trackHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track/delivery/http"
 	trackUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track/usecase"
+	uploadHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload/delivery/http"
+	uploadRepository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload/repository"
+	uploadUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload/usecase"
 	userHttp "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/user/delivery/http"
 	userUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/user/usecase"
 
@@ -54,7 +67,7 @@ import (
 // @host returnzero.ru
 // @BasePath /api/v1
 func main() {
-	logger, err := logger.NewZapLogger()
+	logger, err := loggerPkg.NewZapLogger()
 	if err != nil {
 		logger.Error("Error creating logger:", zap.Error(err))
 		return
@@ -84,6 +97,12 @@ func main() {
 		}
 	}()
 
+	s3Client, err := s3.InitS3(cfg.S3)
+	if err != nil {
+		logger.Error("Error connecting to S3:", zap.Error(err))
+		return
+	}
+
 	err = postgres.RunMigrations(cfg.Postgres)
 	if err != nil {
 		logger.Error("Error running migrations:", zap.Error(err))
@@ -123,9 +142,25 @@ func main() {
 	authClient := authProto.NewAuthServiceClient(clients.AuthClient)
 	userClient := userProto.NewUserServiceClient(clients.UserClient)
 
+	notificationUsecase := notificationUsecase.NewUsecase(notificationRepository.NewNotificationPostgresRepository(postgresConn), notificationRepository.NewNotificationRedisRepository(redisPool))
+	notificationHandler := notificationHttp.NewNotificationHandler(notificationUsecase, cfg)
+
+	uploadUsecase := uploadUsecase.NewUsecase(uploadRepository.NewUploadRedisRepository(redisPool), uploadRepository.NewUploadS3Repository(s3Client, cfg.S3.S3TracksBucket, cfg.S3.S3ImagesBucket, cfg.Uploads.PartURLExpiration), cfg.Uploads)
+	uploadHandler := uploadHttp.NewUploadHandler(uploadUsecase, cfg)
+	if cfg.Uploads.SweepInterval > 0 {
+		jobCtx, cancelJobs := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+		defer cancelJobs()
+		go uploadUsecase.RunSweeper(jobCtx, cfg.Uploads.SweepInterval)
+	}
+
 	labelRepository := labelRepository.NewLabelPostgresRepository(postgresConn)
-	labelUsecase := labelUsecase.NewLabelUsecase(labelRepository, userClient, artistClient, albumClient, trackClient)
+	labelUsecase := labelUsecase.NewLabelUsecase(labelRepository, userClient, artistClient, albumClient, trackClient, notificationUsecase, uploadUsecase)
 	labelHandler := labelHttp.NewLabelHandler(labelUsecase, cfg)
+	if cfg.Releases.PublishInterval > 0 {
+		jobCtx, cancelJobs := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+		defer cancelJobs()
+		go labelUsecase.RunPublisher(jobCtx, cfg.Releases.PublishInterval)
+	}
 
 	r.Use(middleware.LoggerMiddleware(logger))
 	r.Use(middleware.RequestId)
@@ -135,19 +170,47 @@ func main() {
 	// r.Use(middleware.CSRFMiddleware(cfg.CSRF))
 	r.Use(middleware.MetricsMiddleware(metrics))
 
-	trackHandler := trackHttp.NewTrackHandler(trackUsecase.NewUsecase(trackClient, artistClient, albumClient, playlistClient, userClient), cfg)
-	albumHandler := albumHttp.NewAlbumHandler(albumUsecase.NewUsecase(albumClient, artistClient), cfg)
-	artistHandler := artistHttp.NewArtistHandler(artistUsecase.NewUsecase(artistClient, userClient), cfg)
-	userHandler := userHttp.NewUserHandler(userUsecase.NewUserUsecase(&userClient, &authClient, &artistClient, &trackClient, &playlistClient))
-	playlistHandler := playlistHttp.NewPlaylistHandler(playlistUsecase.NewUsecase(&playlistClient, &userClient), cfg)
-	jamHandler := jamHttp.NewJamHandler(jamUsecase.NewUsecase(jamRepository.NewJamRedisRepository(redisPool), userClient), cfg)
+	trackUsecase := trackUsecase.NewUsecase(trackClient, artistClient, albumClient, playlistClient, userClient)
+	albumUsecase := albumUsecase.NewUsecase(albumClient, artistClient)
+	artistUsecase := artistUsecase.NewUsecase(artistClient, userClient)
+	playlistUsecase := playlistUsecase.NewUsecase(&playlistClient, &userClient, notificationUsecase)
+
+	searchUsecase := searchUsecase.NewUsecase(searchRepository.NewSearchRedisRepository(redisPool), metrics, trackUsecase, albumUsecase, artistUsecase, playlistUsecase)
+
+	trackHandler := trackHttp.NewTrackHandler(trackUsecase, searchUsecase, cfg)
+	albumHandler := albumHttp.NewAlbumHandler(albumUsecase, searchUsecase, cfg)
+	artistHandler := artistHttp.NewArtistHandler(artistUsecase, searchUsecase, cfg)
+	userHandler := userHttp.NewUserHandler(userUsecase.NewUserUsecase(&userClient, &authClient, &artistClient, &trackClient, &playlistClient), cfg)
+	playlistHandler := playlistHttp.NewPlaylistHandler(playlistUsecase, searchUsecase, cfg)
+
+	searchHandler := searchHttp.NewSearchHandler(searchUsecase, cfg)
+	jamUsecase := jamUsecase.NewUsecase(jamRepository.NewJamRedisRepository(redisPool), jamRepository.NewJamHistoryPostgresRepository(postgresConn), userClient, playlistClient, trackUsecase, cfg.Jam)
+	jamHandler := jamHttp.NewJamHandler(jamUsecase, cfg)
+	if cfg.Jam.ReaperInterval > 0 {
+		jobCtx, cancelJobs := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+		defer cancelJobs()
+		go jamUsecase.RunReaper(jobCtx, cfg.Jam.ReaperInterval)
+	}
+
+	releaseRadarUsecase := releaseRadarUsecase.NewUsecase(releaseRadarRepository.NewReleaseRadarPostgresRepository(postgresConn), playlistClient, cfg.ReleaseRadar)
+	releaseRadarHandler := releaseRadarHttp.NewReleaseRadarHandler(releaseRadarUsecase, cfg)
+	if cfg.ReleaseRadar.CheckInterval > 0 {
+		jobCtx, cancelJobs := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+		defer cancelJobs()
+		go releaseRadarUsecase.RunRefresh(jobCtx, cfg.ReleaseRadar.CheckInterval)
+	}
 
 	r.HandleFunc("/api/v1/tracks", trackHandler.GetAllTracks).Methods("GET")
 	r.HandleFunc("/api/v1/tracks/{id:[0-9]+}", trackHandler.GetTrackByID).Methods("GET")
+	r.HandleFunc("/api/v1/tracks/{id:[0-9]+}/waveform", trackHandler.GetTrackWaveform).Methods("GET")
+	r.HandleFunc("/api/v1/tracks/{id:[0-9]+}/lyrics", trackHandler.GetTrackLyrics).Methods("GET")
 	r.HandleFunc("/api/v1/tracks/{id:[0-9]+}/stream", trackHandler.CreateStream).Methods("POST")
 	r.HandleFunc("/api/v1/tracks/{id:[0-9]+}/like", trackHandler.LikeTrack).Methods("POST")
 	r.HandleFunc("/api/v1/tracks/search", trackHandler.SearchTracks).Methods("GET")
 	r.HandleFunc("/api/v1/streams/{id:[0-9]+}", trackHandler.UpdateStreamDuration).Methods("PUT", "PATCH")
+	r.HandleFunc("/api/v1/streams/{id:[0-9]+}/hls/master.m3u8", trackHandler.GetStreamMasterPlaylist).Methods("GET")
+	r.HandleFunc("/api/v1/streams/{id:[0-9]+}/hls/{bitrate:[0-9]+}.m3u8", trackHandler.GetStreamMediaPlaylist).Methods("GET")
+	r.HandleFunc("/api/v1/streams/{id:[0-9]+}/hls/{bitrate:[0-9]+}/{position:[0-9]+}.ts", trackHandler.GetStreamSegment).Methods("GET")
 	r.HandleFunc("/api/v1/selection/{selection}", trackHandler.GetSelectionTracks).Methods("GET")
 
 	r.HandleFunc("/api/v1/albums", albumHandler.GetAllAlbums).Methods("GET")
@@ -175,6 +238,11 @@ func main() {
 	r.HandleFunc("/api/v1/playlists/{id:[0-9]+}/like", playlistHandler.LikePlaylist).Methods("POST")
 	r.HandleFunc("/api/v1/playlists/search", playlistHandler.SearchPlaylists).Methods("GET")
 
+	r.HandleFunc("/api/v1/search", searchHandler.Search).Methods("GET")
+	r.HandleFunc("/api/v1/search/suggest", searchHandler.Suggest).Methods("GET")
+	r.HandleFunc("/api/v1/search/click", searchHandler.Click).Methods("POST")
+	r.HandleFunc("/api/v1/search/analytics", searchHandler.GetAnalytics).Methods("GET")
+
 	r.HandleFunc("/api/v1/auth/signup", userHandler.Signup).Methods("POST")
 	r.HandleFunc("/api/v1/auth/login", userHandler.Login).Methods("POST")
 	r.HandleFunc("/api/v1/auth/logout", userHandler.Logout).Methods("POST")
@@ -185,6 +253,15 @@ func main() {
 	r.HandleFunc("/api/v1/user/me", userHandler.DeleteUser).Methods("DELETE")
 	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}", userHandler.GetUserData).Methods("GET")
 	r.HandleFunc("/api/v1/user/me/history", trackHandler.GetLastListenedTracks).Methods("GET")
+	r.HandleFunc("/api/v1/user/me/feed", userHandler.GetActivityFeed).Methods("GET")
+	r.HandleFunc("/api/v1/user/me/releases", releaseRadarHandler.GetReleases).Methods("GET")
+	r.HandleFunc("/api/v1/user/me/jams", jamHandler.GetSessions).Methods("GET")
+	r.HandleFunc("/api/v1/user/me/jams/{id:[0-9]+}", jamHandler.GetSession).Methods("GET")
+	r.HandleFunc("/api/v1/user/me/jams/{id:[0-9]+}/playlist", jamHandler.CreateSessionPlaylist).Methods("POST")
+	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}/follow", userHandler.FollowUser).Methods("POST")
+	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}/follow", userHandler.UnfollowUser).Methods("DELETE")
+	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}/followers", userHandler.GetFollowers).Methods("GET")
+	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}/following", userHandler.GetFollowing).Methods("GET")
 	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}/artists", artistHandler.GetFavoriteArtists).Methods("GET")
 	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}/tracks", trackHandler.GetFavoriteTracks).Methods("GET")
 	r.HandleFunc("/api/v1/user/{username:[a-zA-Z0-9_]+}/playlists", playlistHandler.GetProfilePlaylists).Methods("GET")
@@ -201,11 +278,38 @@ func main() {
 
 	r.HandleFunc("/api/v1/label/album", labelHandler.CreateAlbum).Methods("POST")
 	r.HandleFunc("/api/v1/label/album", labelHandler.DeleteAlbum).Methods("DELETE")
+	r.HandleFunc("/api/v1/label/album/preview", labelHandler.PreviewAlbum).Methods("POST")
 	r.HandleFunc("/api/v1/label/albums", labelHandler.GetAlbumsByLabelID).Methods("GET")
-
+	r.HandleFunc("/api/v1/label/albums/review", labelHandler.GetAlbumsForReview).Methods("GET")
+	r.HandleFunc("/api/v1/label/duplicates", labelHandler.GetDuplicateFlags).Methods("GET")
+	r.HandleFunc("/api/v1/label/duplicates/{id:[0-9]+}", labelHandler.ResolveDuplicateFlag).Methods("PUT")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}", labelHandler.GetLabelAlbum).Methods("GET")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}", labelHandler.EditAlbum).Methods("PUT")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}/status", labelHandler.ChangeAlbumStatus).Methods("PUT")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}/tracks", labelHandler.EditAlbumTracks).Methods("PUT")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}/tracks/{track_id:[0-9]+}", labelHandler.EditTrack).Methods("PUT")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}/tracks/{track_id:[0-9]+}/audio", labelHandler.ReplaceTrackAudio).Methods("PUT")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}/tracks/{track_id:[0-9]+}/lyrics", labelHandler.SaveTrackLyrics).Methods("PUT")
+	r.HandleFunc("/api/v1/label/album/{id:[0-9]+}/tracks/{track_id:[0-9]+}/lyrics/{language:[a-zA-Z]+}", labelHandler.DeleteTrackLyrics).Methods("DELETE")
+
+	r.HandleFunc("/api/v1/label/uploads", uploadHandler.CreateUpload).Methods("POST")
+	r.HandleFunc("/api/v1/label/uploads/{id}", uploadHandler.GetUpload).Methods("GET")
+	r.HandleFunc("/api/v1/label/uploads/{id}", uploadHandler.AbortUpload).Methods("DELETE")
+	r.HandleFunc("/api/v1/label/uploads/{id}/parts", uploadHandler.GetPartURLs).Methods("POST")
+	r.HandleFunc("/api/v1/label/uploads/{id}/complete", uploadHandler.CompleteUpload).Methods("POST")
+
+	r.HandleFunc("/api/v1/jams", jamHandler.ListRooms).Methods("GET")
 	r.HandleFunc("/api/v1/jams", jamHandler.CreateRoom).Methods("POST")
 	r.HandleFunc("/api/v1/jams/{id}", jamHandler.WSHandler).Methods("GET")
 
+	r.HandleFunc("/api/v1/notifications", notificationHandler.GetNotifications).Methods("GET")
+	r.HandleFunc("/api/v1/notifications/unread-count", notificationHandler.GetUnreadCount).Methods("GET")
+	r.HandleFunc("/api/v1/notifications/read", notificationHandler.MarkAllAsRead).Methods("POST")
+	r.HandleFunc("/api/v1/notifications/{id:[0-9]+}/read", notificationHandler.MarkAsRead).Methods("POST")
+	r.HandleFunc("/api/v1/notifications/preferences", notificationHandler.GetPreferences).Methods("GET")
+	r.HandleFunc("/api/v1/notifications/preferences", notificationHandler.UpdatePreferences).Methods("PUT")
+	r.HandleFunc("/api/v1/notifications/ws", notificationHandler.WSHandler).Methods("GET")
+
 	r.Handle("/api/v1/metrics", promhttp.Handler())
 
 	srv := &http.Server{
@@ -105,16 +105,97 @@ type Prometheus struct {
 	ApiPort        int `mapstructure:"api_port"`
 }
 
+type SearchConfig struct {
+	Backend         string        `mapstructure:"backend"`
+	ShadowBackend   string        `mapstructure:"shadow_backend"`
+	RebuildInterval time.Duration `mapstructure:"rebuild_interval"`
+}
+
+type ReleaseRadarConfig struct {
+	Window          time.Duration `mapstructure:"window"`
+	RefreshInterval time.Duration `mapstructure:"refresh_interval"`
+	CheckInterval   time.Duration `mapstructure:"check_interval"`
+	PlaylistSize    int64         `mapstructure:"playlist_size"`
+}
+
+type JamConfig struct {
+	HostGracePeriod   time.Duration `mapstructure:"host_grace_period"`
+	ChatHistorySize   int64         `mapstructure:"chat_history_size"`
+	ChatMaxLength     int           `mapstructure:"chat_max_length"`
+	MessageRateLimit  int64         `mapstructure:"message_rate_limit"`
+	MessageRateWindow time.Duration `mapstructure:"message_rate_window"`
+	RoomTTL           time.Duration `mapstructure:"room_ttl"`
+	PresenceInterval  time.Duration `mapstructure:"presence_interval"`
+	PresenceTimeout   time.Duration `mapstructure:"presence_timeout"`
+	ReaperInterval    time.Duration `mapstructure:"reaper_interval"`
+	// SuggestionLimit caps the open suggestions of a room and UserSuggestionLimit those of one
+	// listener, zero leaves them unbounded
+	SuggestionLimit     int64 `mapstructure:"suggestion_limit"`
+	UserSuggestionLimit int64 `mapstructure:"user_suggestion_limit"`
+}
+
+type UploadConfig struct {
+	PartSize          int64         `mapstructure:"part_size"`
+	TrackMaxSize      int64         `mapstructure:"track_max_size"`
+	CoverMaxSize      int64         `mapstructure:"cover_max_size"`
+	TTL               time.Duration `mapstructure:"ttl"`
+	PartURLExpiration time.Duration `mapstructure:"part_url_expiration"`
+	SweepInterval     time.Duration `mapstructure:"sweep_interval"`
+}
+
+type ReleaseConfig struct {
+	PublishInterval time.Duration `mapstructure:"publish_interval"`
+}
+
+type TranscodeConfig struct {
+	Interval    time.Duration `mapstructure:"interval"`
+	FFmpegPath  string        `mapstructure:"ffmpeg_path"`
+	WorkDir     string        `mapstructure:"work_dir"`
+	StaleAfter  time.Duration `mapstructure:"stale_after"`
+	MaxAttempts int64         `mapstructure:"max_attempts"`
+}
+
+type FingerprintConfig struct {
+	FpcalcPath       string  `mapstructure:"fpcalc_path"`
+	WorkDir          string  `mapstructure:"work_dir"`
+	Length           int64   `mapstructure:"length"`
+	MaxCandidates    int64   `mapstructure:"max_candidates"`
+	FlagSimilarity   float64 `mapstructure:"flag_similarity"`
+	RejectSimilarity float64 `mapstructure:"reject_similarity"`
+}
+
+type ImageConfig struct {
+	JPEGQuality int    `mapstructure:"jpeg_quality"`
+	WebPQuality int    `mapstructure:"webp_quality"`
+	AVIFQuality int    `mapstructure:"avif_quality"`
+	AVIFSpeed   int    `mapstructure:"avif_speed"`
+	CwebpPath   string `mapstructure:"cwebp_path"`
+	AvifencPath string `mapstructure:"avifenc_path"`
+	WorkDir     string `mapstructure:"work_dir"`
+	// MaxEncoders caps the encoders running at once in a service, the number of CPUs by default
+	MaxEncoders int `mapstructure:"max_encoders"`
+	// Timeout bounds encoding all variants of one upload
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
 type Config struct {
-	Cors       Cors
-	Port       int `mapstructure:"port"`
-	Pagination PaginationConfig
-	Postgres   PostgresConfig
-	S3         S3Config
-	Redis      RedisConfig
-	CSRF       CSRFConfig
-	Services   Services
-	Prometheus Prometheus
+	Cors         Cors
+	Port         int `mapstructure:"port"`
+	Pagination   PaginationConfig
+	Postgres     PostgresConfig
+	S3           S3Config
+	Redis        RedisConfig
+	CSRF         CSRFConfig
+	Services     Services
+	Prometheus   Prometheus
+	Search       SearchConfig
+	ReleaseRadar ReleaseRadarConfig `mapstructure:"release_radar"`
+	Jam          JamConfig
+	Uploads      UploadConfig
+	Releases     ReleaseConfig
+	Transcoding  TranscodeConfig
+	Fingerprints FingerprintConfig
+	Images       ImageConfig
 }
 
 func LoadConfig() (*Config, error) {
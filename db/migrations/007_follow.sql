@@ -0,0 +1,28 @@
+-- Users following each other, the activity feed is built from the follow graph
+
+CREATE TABLE IF NOT EXISTS user_follow (
+    follower_id BIGINT NOT NULL,
+    followee_id BIGINT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (follower_id, followee_id),
+    CONSTRAINT user_follow_self_check CHECK (follower_id <> followee_id),
+    FOREIGN KEY (follower_id)
+        REFERENCES "user" (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (followee_id)
+        REFERENCES "user" (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS user_follow_followee_id_idx ON user_follow (followee_id, created_at DESC);
+
+-- The feed looks up the latest streams of every followed user
+CREATE INDEX IF NOT EXISTS track_stream_user_id_created_at_idx ON track_stream (user_id, created_at DESC);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS track_stream_user_id_created_at_idx;
+DROP INDEX IF EXISTS user_follow_followee_id_idx;
+DROP TABLE IF EXISTS user_follow;
@@ -0,0 +1,42 @@
+-- Notification inbox, rows are written by the album creation and playlist like paths
+
+CREATE TABLE IF NOT EXISTS notification (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    type TEXT NOT NULL,
+    actor_name TEXT NOT NULL DEFAULT '',
+    entity_id BIGINT NOT NULL,
+    entity_title TEXT NOT NULL,
+    entity_thumbnail TEXT NOT NULL DEFAULT '',
+    is_read BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT notification_valid_type_check CHECK (type IN ('album_released', 'playlist_liked')),
+    FOREIGN KEY (user_id)
+        REFERENCES "user" (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS notification_user_id_created_at_idx ON notification (user_id, created_at DESC);
+CREATE INDEX IF NOT EXISTS notification_user_id_unread_idx ON notification (user_id) WHERE NOT is_read;
+
+-- A missing row means the notification type is enabled
+CREATE TABLE IF NOT EXISTS notification_preference (
+    user_id BIGINT NOT NULL,
+    type TEXT NOT NULL,
+    is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (user_id, type),
+    CONSTRAINT notification_preference_valid_type_check CHECK (type IN ('album_released', 'playlist_liked')),
+    FOREIGN KEY (user_id)
+        REFERENCES "user" (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS notification_preference;
+DROP INDEX IF EXISTS notification_user_id_unread_idx;
+DROP INDEX IF EXISTS notification_user_id_created_at_idx;
+DROP TABLE IF EXISTS notification;
@@ -0,0 +1,25 @@
+-- Release radar, the weekly playlist of new tracks from liked artists
+
+CREATE TABLE IF NOT EXISTS release_radar_playlist (
+    user_id BIGINT PRIMARY KEY,
+    playlist_id BIGINT NOT NULL UNIQUE,
+    refreshed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    FOREIGN KEY (user_id)
+        REFERENCES "user" (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    -- Removing the playlist drops the link, the next refresh creates a new one
+    FOREIGN KEY (playlist_id)
+        REFERENCES playlist (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS album_artist_artist_id_idx ON album_artist (artist_id);
+CREATE INDEX IF NOT EXISTS track_artist_artist_id_idx ON track_artist (artist_id);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS track_artist_artist_id_idx;
+DROP INDEX IF EXISTS album_artist_artist_id_idx;
+DROP TABLE IF EXISTS release_radar_playlist;
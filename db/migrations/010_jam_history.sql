@@ -0,0 +1,59 @@
+-- Jam sessions outlive their Redis room: who was there and what was played
+
+CREATE TABLE IF NOT EXISTS jam_session (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    room_id TEXT NOT NULL UNIQUE,
+    host_id BIGINT NOT NULL,
+    started_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- NULL while the room is still open
+    ended_at TIMESTAMP,
+    FOREIGN KEY (host_id)
+        REFERENCES "user" (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS jam_session_participant (
+    session_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    joined_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (session_id, user_id),
+    FOREIGN KEY (session_id)
+        REFERENCES jam_session (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (user_id)
+        REFERENCES "user" (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS jam_session_participant_user_id_idx ON jam_session_participant (user_id);
+
+CREATE TABLE IF NOT EXISTS jam_session_track (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    session_id BIGINT NOT NULL,
+    track_id BIGINT NOT NULL,
+    played_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    -- seconds the room spent on the track
+    duration INTEGER NOT NULL DEFAULT 0,
+    CONSTRAINT jam_session_track_valid_duration_check CHECK (duration >= 0),
+    FOREIGN KEY (session_id)
+        REFERENCES jam_session (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE,
+    FOREIGN KEY (track_id)
+        REFERENCES track (id)
+        ON DELETE CASCADE
+        ON UPDATE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS jam_session_track_session_id_idx ON jam_session_track (session_id, played_at);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS jam_session_track_session_id_idx;
+DROP TABLE IF EXISTS jam_session_track;
+DROP INDEX IF EXISTS jam_session_participant_user_id_idx;
+DROP TABLE IF EXISTS jam_session_participant;
+DROP TABLE IF EXISTS jam_session;
@@ -0,0 +1,28 @@
+-- Releases go draft -> in review -> scheduled -> published and can be taken down,
+-- only published albums and their tracks are visible in the catalog
+
+-- Albums released before the lifecycle existed stay published, new ones start as drafts
+ALTER TABLE album
+ADD COLUMN status TEXT NOT NULL DEFAULT 'published',
+ADD COLUMN published_at TIMESTAMP NULL;
+
+ALTER TABLE album
+ALTER COLUMN status SET DEFAULT 'draft';
+
+ALTER TABLE album
+ADD CONSTRAINT album_valid_status_check CHECK (status IN ('draft', 'in_review', 'scheduled', 'published', 'taken_down'));
+
+UPDATE album
+SET published_at = created_at;
+
+CREATE INDEX IF NOT EXISTS album_status_idx ON album (status);
+-- The publisher only ever looks for scheduled albums whose date has come
+CREATE INDEX IF NOT EXISTS album_scheduled_release_date_idx ON album (release_date) WHERE status = 'scheduled';
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS album_scheduled_release_date_idx;
+DROP INDEX IF EXISTS album_status_idx;
+ALTER TABLE album DROP CONSTRAINT IF EXISTS album_valid_status_check;
+ALTER TABLE album DROP COLUMN IF EXISTS published_at;
+ALTER TABLE album DROP COLUMN IF EXISTS status;
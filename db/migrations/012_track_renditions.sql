@@ -0,0 +1,48 @@
+-- Masters may be uploaded as mp3, flac, wav or ogg, a background worker transcodes
+-- them into the renditions that are actually streamed and measures their loudness
+
+-- Tracks uploaded before transcoding existed keep streaming their original mp3
+ALTER TABLE track
+ADD COLUMN source_format TEXT NOT NULL DEFAULT 'mp3',
+ADD COLUMN transcode_status TEXT NOT NULL DEFAULT 'ready',
+ADD COLUMN transcode_attempts INTEGER NOT NULL DEFAULT 0,
+ADD COLUMN transcode_started_at TIMESTAMP NULL,
+ADD COLUMN loudness_lufs REAL NULL,
+ADD COLUMN true_peak_db REAL NULL,
+ADD COLUMN replay_gain_db REAL NULL;
+
+ALTER TABLE track
+ALTER COLUMN transcode_status SET DEFAULT 'pending';
+
+ALTER TABLE track
+ADD CONSTRAINT track_valid_source_format_check CHECK (source_format IN ('mp3', 'flac', 'wav', 'ogg')),
+ADD CONSTRAINT track_valid_transcode_status_check CHECK (transcode_status IN ('pending', 'processing', 'ready', 'failed'));
+
+-- The worker only ever looks for tracks that still have to be transcoded
+CREATE INDEX IF NOT EXISTS track_transcode_queue_idx ON track (created_at) WHERE transcode_status IN ('pending', 'processing');
+
+CREATE TABLE IF NOT EXISTS track_rendition (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    track_id BIGINT NOT NULL REFERENCES track (id) ON DELETE CASCADE,
+    codec TEXT NOT NULL,
+    bitrate INTEGER NOT NULL,
+    file_key TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT track_rendition_valid_codec_check CHECK (codec IN ('mp3', 'opus')),
+    CONSTRAINT track_rendition_valid_bitrate_check CHECK (bitrate > 0),
+    CONSTRAINT unique_track_rendition_check UNIQUE (track_id, codec, bitrate)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS track_rendition;
+DROP INDEX IF EXISTS track_transcode_queue_idx;
+ALTER TABLE track DROP CONSTRAINT IF EXISTS track_valid_transcode_status_check;
+ALTER TABLE track DROP CONSTRAINT IF EXISTS track_valid_source_format_check;
+ALTER TABLE track DROP COLUMN IF EXISTS replay_gain_db;
+ALTER TABLE track DROP COLUMN IF EXISTS true_peak_db;
+ALTER TABLE track DROP COLUMN IF EXISTS loudness_lufs;
+ALTER TABLE track DROP COLUMN IF EXISTS transcode_started_at;
+ALTER TABLE track DROP COLUMN IF EXISTS transcode_attempts;
+ALTER TABLE track DROP COLUMN IF EXISTS transcode_status;
+ALTER TABLE track DROP COLUMN IF EXISTS source_format;
@@ -0,0 +1,36 @@
+-- The transcoding worker also cuts every track into HLS segments of a few seconds,
+-- segment fetches go through the gateway so that streams count real playback
+
+CREATE TABLE IF NOT EXISTS track_segment (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    track_id BIGINT NOT NULL REFERENCES track (id) ON DELETE CASCADE,
+    codec TEXT NOT NULL,
+    bitrate INTEGER NOT NULL,
+    position INTEGER NOT NULL,
+    duration REAL NOT NULL,
+    file_key TEXT NOT NULL,
+    CONSTRAINT track_segment_valid_codec_check CHECK (codec IN ('aac')),
+    CONSTRAINT track_segment_valid_bitrate_check CHECK (bitrate > 0),
+    CONSTRAINT track_segment_valid_position_check CHECK (position >= 0),
+    CONSTRAINT track_segment_valid_duration_check CHECK (duration > 0),
+    CONSTRAINT unique_track_segment_check UNIQUE (track_id, bitrate, position)
+);
+
+-- A segment is only counted once per stream whatever bitrate the player switched to
+CREATE TABLE IF NOT EXISTS track_stream_segment (
+    stream_id BIGINT NOT NULL REFERENCES track_stream (id) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    duration REAL NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (stream_id, position)
+);
+
+-- Tracks transcoded before HLS existed go through the worker once more
+UPDATE track
+SET transcode_status = 'pending', transcode_attempts = 0, transcode_started_at = NULL
+WHERE transcode_status IN ('ready', 'failed');
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS track_stream_segment;
+DROP TABLE IF EXISTS track_segment;
@@ -0,0 +1,36 @@
+-- Every uploaded track gets a chromaprint fingerprint, near duplicates of tracks
+-- that are already in the catalog are rejected or flagged for an admin to review
+
+CREATE TABLE IF NOT EXISTS track_fingerprint (
+    track_id BIGINT PRIMARY KEY REFERENCES track (id) ON DELETE CASCADE,
+    fingerprint INTEGER[] NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT track_fingerprint_not_empty_check CHECK (cardinality(fingerprint) > 0)
+);
+
+-- Candidates share at least one exact sub-fingerprint with the upload
+CREATE INDEX IF NOT EXISTS track_fingerprint_values_idx ON track_fingerprint USING GIN (fingerprint);
+
+CREATE TABLE IF NOT EXISTS track_duplicate_flag (
+    id BIGINT GENERATED ALWAYS AS IDENTITY PRIMARY KEY,
+    track_id BIGINT NOT NULL REFERENCES track (id) ON DELETE CASCADE,
+    matched_track_id BIGINT NOT NULL REFERENCES track (id) ON DELETE CASCADE,
+    similarity REAL NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    reviewed_at TIMESTAMP NULL,
+    CONSTRAINT track_duplicate_flag_valid_status_check CHECK (status IN ('pending', 'confirmed', 'dismissed')),
+    CONSTRAINT track_duplicate_flag_valid_similarity_check CHECK (similarity >= 0 AND similarity <= 1),
+    CONSTRAINT unique_track_duplicate_flag_check UNIQUE (track_id, matched_track_id)
+);
+
+-- The review queue only ever lists pending flags, oldest first
+CREATE INDEX IF NOT EXISTS track_duplicate_flag_pending_idx ON track_duplicate_flag (created_at, id) WHERE status = 'pending';
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS track_duplicate_flag_pending_idx;
+DROP TABLE IF EXISTS track_duplicate_flag;
+DROP INDEX IF EXISTS track_fingerprint_values_idx;
+DROP TABLE IF EXISTS track_fingerprint;
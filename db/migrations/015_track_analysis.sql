@@ -0,0 +1,29 @@
+-- The transcoding worker also analyses every track for a scrubber waveform, its tempo
+-- and its key, the analysis of a replaced file is hidden until it is transcoded again
+
+ALTER TABLE track
+ADD COLUMN bpm REAL NULL,
+ADD COLUMN musical_key TEXT NULL;
+
+ALTER TABLE track
+ADD CONSTRAINT track_valid_bpm_check CHECK (bpm > 0);
+
+CREATE TABLE IF NOT EXISTS track_waveform (
+    track_id BIGINT PRIMARY KEY REFERENCES track (id) ON DELETE CASCADE,
+    peaks REAL[] NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT track_waveform_not_empty_check CHECK (cardinality(peaks) > 0)
+);
+
+-- Tracks transcoded before the analysis existed go through the worker once more
+UPDATE track
+SET transcode_status = 'pending', transcode_attempts = 0, transcode_started_at = NULL
+WHERE transcode_status IN ('ready', 'failed');
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS track_waveform;
+ALTER TABLE track DROP CONSTRAINT IF EXISTS track_valid_bpm_check;
+ALTER TABLE track DROP COLUMN IF EXISTS musical_key;
+ALTER TABLE track DROP COLUMN IF EXISTS bpm;
@@ -0,0 +1,45 @@
+-- Labels upload plain or LRC lyrics per track in any number of languages,
+-- line_times is NULL for plain lyrics and holds milliseconds for synchronized ones
+
+CREATE TABLE IF NOT EXISTS track_lyrics (
+    track_id BIGINT NOT NULL REFERENCES track (id) ON DELETE CASCADE,
+    language TEXT NOT NULL,
+    is_translation BOOLEAN NOT NULL DEFAULT FALSE,
+    lines TEXT[] NOT NULL,
+    line_times BIGINT[] NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (track_id, language),
+    CONSTRAINT track_lyrics_valid_language_check CHECK (language ~ '^[a-z]{2,3}$'),
+    CONSTRAINT track_lyrics_not_empty_check CHECK (cardinality(lines) > 0),
+    CONSTRAINT track_lyrics_line_times_check CHECK (line_times IS NULL OR cardinality(line_times) = cardinality(lines))
+);
+
+-- A generated column can not read another table, so the text of all lyrics of a track
+-- is copied to it whenever they change
+ALTER TABLE track ADD COLUMN lyrics_text TEXT NULL;
+
+DROP INDEX IF EXISTS track_search_idx;
+ALTER TABLE track DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE track ADD COLUMN search_vector tsvector
+GENERATED ALWAYS AS (
+  setweight(to_tsvector('english', title), 'A') ||
+  setweight(to_tsvector('russian', title), 'A') ||
+  setweight(to_tsvector('english', COALESCE(lyrics_text, '')), 'D') ||
+  setweight(to_tsvector('russian', COALESCE(lyrics_text, '')), 'D')
+) STORED;
+CREATE INDEX track_search_idx ON track USING GIN(search_vector);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS track_search_idx;
+ALTER TABLE track DROP COLUMN IF EXISTS search_vector;
+ALTER TABLE track ADD COLUMN search_vector tsvector
+GENERATED ALWAYS AS (
+  setweight(to_tsvector('english', title), 'A') ||
+  setweight(to_tsvector('russian', title), 'A')
+) STORED;
+CREATE INDEX track_search_idx ON track USING GIN(search_vector);
+
+ALTER TABLE track DROP COLUMN IF EXISTS lyrics_text;
+DROP TABLE IF EXISTS track_lyrics;
@@ -0,0 +1,38 @@
+-- Images processed into variants keep their dominant color next to the url, an empty color
+-- means the image was stored before the variants existed and only has the original file
+
+ALTER TABLE "user" ADD COLUMN thumbnail_color TEXT NOT NULL DEFAULT '';
+ALTER TABLE artist ADD COLUMN thumbnail_color TEXT NOT NULL DEFAULT '';
+ALTER TABLE album ADD COLUMN thumbnail_color TEXT NOT NULL DEFAULT '';
+ALTER TABLE track ADD COLUMN thumbnail_color TEXT NOT NULL DEFAULT '';
+ALTER TABLE playlist ADD COLUMN thumbnail_color TEXT NOT NULL DEFAULT '';
+ALTER TABLE notification ADD COLUMN entity_thumbnail_color TEXT NOT NULL DEFAULT '';
+
+-- Until now the color was only kept in the name of the variant directory
+UPDATE "user" SET thumbnail_color = '#' || substring(thumbnail_url FROM '-([0-9a-f]{6})/1200\.jpg$')
+WHERE thumbnail_url ~ '-[0-9a-f]{6}/1200\.jpg$';
+UPDATE artist SET thumbnail_color = '#' || substring(thumbnail_url FROM '-([0-9a-f]{6})/1200\.jpg$')
+WHERE thumbnail_url ~ '-[0-9a-f]{6}/1200\.jpg$';
+UPDATE album SET thumbnail_color = '#' || substring(thumbnail_url FROM '-([0-9a-f]{6})/1200\.jpg$')
+WHERE thumbnail_url ~ '-[0-9a-f]{6}/1200\.jpg$';
+UPDATE track SET thumbnail_color = '#' || substring(thumbnail_url FROM '-([0-9a-f]{6})/1200\.jpg$')
+WHERE thumbnail_url ~ '-[0-9a-f]{6}/1200\.jpg$';
+UPDATE playlist SET thumbnail_color = '#' || substring(thumbnail_url FROM '-([0-9a-f]{6})/1200\.jpg$')
+WHERE thumbnail_url ~ '-[0-9a-f]{6}/1200\.jpg$';
+UPDATE notification SET entity_thumbnail_color = '#' || substring(entity_thumbnail FROM '-([0-9a-f]{6})/1200\.jpg$')
+WHERE entity_thumbnail ~ '-[0-9a-f]{6}/1200\.jpg$';
+
+ALTER TABLE "user" ADD CONSTRAINT user_valid_thumbnail_color_check CHECK (thumbnail_color ~ '^(#[0-9a-f]{6})?$');
+ALTER TABLE artist ADD CONSTRAINT artist_valid_thumbnail_color_check CHECK (thumbnail_color ~ '^(#[0-9a-f]{6})?$');
+ALTER TABLE album ADD CONSTRAINT album_valid_thumbnail_color_check CHECK (thumbnail_color ~ '^(#[0-9a-f]{6})?$');
+ALTER TABLE track ADD CONSTRAINT track_valid_thumbnail_color_check CHECK (thumbnail_color ~ '^(#[0-9a-f]{6})?$');
+ALTER TABLE playlist ADD CONSTRAINT playlist_valid_thumbnail_color_check CHECK (thumbnail_color ~ '^(#[0-9a-f]{6})?$');
+
+---- create above / drop below ----
+
+ALTER TABLE notification DROP COLUMN IF EXISTS entity_thumbnail_color;
+ALTER TABLE playlist DROP COLUMN IF EXISTS thumbnail_color;
+ALTER TABLE track DROP COLUMN IF EXISTS thumbnail_color;
+ALTER TABLE album DROP COLUMN IF EXISTS thumbnail_color;
+ALTER TABLE artist DROP COLUMN IF EXISTS thumbnail_color;
+ALTER TABLE "user" DROP COLUMN IF EXISTS thumbnail_color;
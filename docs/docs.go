@@ -99,6 +99,18 @@ const docTemplate = `{
                         "name": "query",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -430,19 +442,19 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad request - invalid input",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
                     "401": {
                         "description": "Unauthorized - admin access required",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
                         }
                     }
                 }
@@ -455,9 +467,9 @@ const docTemplate = `{
                         "LabelAuth": []
                     }
                 ],
-                "description": "Creates a new album with tracks. Only accessible by label members.",
+                "description": "Creates a new album with tracks from completed uploads (see /label/uploads). Empty track titles and a missing cover are taken from the tags embedded in the tracks. Only accessible by label members.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -468,50 +480,13 @@ const docTemplate = `{
                 "summary": "Create a new album",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Album title (max 100 characters)",
-                        "name": "title",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Album type (album, single, ep, compilation)",
-                        "name": "type",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Comma-separated list of artist IDs",
-                        "name": "artists_ids",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Album cover image",
-                        "name": "thumbnail",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Array of track files",
-                        "name": "tracks[]",
-                        "in": "formData",
-                        "required": true
-                    },
-                    {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        },
-                        "collectionFormat": "csv",
-                        "description": "Array of track titles corresponding to tracks[]",
-                        "name": "track_titles[]",
-                        "in": "formData",
-                        "required": true
+                        "description": "Album with the upload ids of its cover and tracks",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.CreateAlbumRequest"
+                        }
                     }
                 ],
                 "responses": {
@@ -522,7 +497,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid input",
+                        "description": "Bad request - invalid input, upload of the wrong kind or an upload used for two tracks",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -539,6 +514,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Upload not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Upload is not complete or used by another release, or a track is already in the catalog",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -612,14 +599,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/label/albums": {
-            "get": {
+        "/api/v1/label/album/preview": {
+            "post": {
                 "security": [
                     {
                         "LabelAuth": []
                     }
                 ],
-                "description": "Retrieves a list of albums associated with the user's label with optional pagination.",
+                "description": "Reads the ID3 tags and vorbis comments embedded in completed track uploads to prefill the release before it is created. Only accessible by label members.",
                 "consumes": [
                     "application/json"
                 ],
@@ -629,24 +616,21 @@ const docTemplate = `{
                 "tags": [
                     "label"
                 ],
-                "summary": "Get albums by label ID",
+                "summary": "Preview metadata of uploaded tracks",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Upload ids of the tracks",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.PreviewAlbumRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of albums in the label",
+                        "description": "Metadata of every track in the order of the request",
                         "schema": {
                             "allOf": [
                                 {
@@ -658,7 +642,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/delivery.Album"
+                                                "$ref": "#/definitions/delivery.TrackMetadata"
                                             }
                                         }
                                     }
@@ -667,7 +651,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid pagination parameters",
+                        "description": "Bad request - upload of the wrong kind or not an audio file",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -678,10 +662,16 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - user not in label",
+                    "404": {
+                        "description": "Upload not found or expired",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Upload is not complete",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIErrorResponse"
                         }
                     },
                     "500": {
@@ -693,16 +683,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/label/artist": {
-            "put": {
+        "/api/v1/label/album/{id}": {
+            "get": {
                 "security": [
                     {
                         "LabelAuth": []
+                    },
+                    {
+                        "AdminAuth": []
                     }
                 ],
-                "description": "Updates an artist's information (name and/or image). Only accessible by label members.",
+                "description": "Retrieves an album of the label in any status together with all of its tracks. Accessible by label members for their own releases or administrators for any release.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -710,37 +703,37 @@ const docTemplate = `{
                 "tags": [
                     "label"
                 ],
-                "summary": "Edit an existing artist",
+                "summary": "Get a release of the label",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Artist ID to edit",
-                        "name": "artist_id",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "New artist name (required if thumbnail is not provided)",
-                        "name": "new_title",
-                        "in": "formData"
-                    },
-                    {
-                        "type": "file",
-                        "description": "New artist profile image (required if new_title is not provided, max 6MB)",
-                        "name": "thumbnail",
-                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated artist",
+                        "description": "Album with its tracks",
                         "schema": {
-                            "$ref": "#/definitions/delivery.Artist"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.LabelAlbum"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid input",
+                        "description": "Bad request - invalid album ID",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -751,10 +744,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - user not in label",
+                    "404": {
+                        "description": "Album not found",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
                     },
                     "500": {
@@ -765,15 +758,15 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "LabelAuth": []
                     }
                 ],
-                "description": "Creates a new artist associated with the label. Only accessible by label members.",
+                "description": "Changes the title, the type or the cover of an album of the label. Empty fields keep their current values, a new cover is also applied to every track of the album. Only accessible by label members.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
@@ -781,32 +774,46 @@ const docTemplate = `{
                 "tags": [
                     "label"
                 ],
-                "summary": "Create a new artist",
+                "summary": "Edit an album",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Artist name",
-                        "name": "title",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Artist profile image (max 6MB)",
-                        "name": "thumbnail",
-                        "in": "formData",
-                        "required": true
+                        "description": "New album title, type and cover upload",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.EditAlbumRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created artist",
+                    "200": {
+                        "description": "Edited album",
                         "schema": {
-                            "$ref": "#/definitions/delivery.Artist"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Album"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid input",
+                        "description": "Bad request - invalid title, type or upload",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -817,10 +824,10 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - user not in label",
+                    "404": {
+                        "description": "Album or upload not found",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
                     },
                     "500": {
@@ -830,14 +837,19 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/v1/label/album/{id}/status": {
+            "put": {
                 "security": [
                     {
                         "LabelAuth": []
+                    },
+                    {
+                        "AdminAuth": []
                     }
                 ],
-                "description": "Removes an artist from the label. Only accessible by label members.",
+                "description": "Moves an album through the release lifecycle. Labels send drafts to review with a release date, administrators approve or reject them and take releases down.",
                 "consumes": [
                     "application/json"
                 ],
@@ -847,27 +859,46 @@ const docTemplate = `{
                 "tags": [
                     "label"
                 ],
-                "summary": "Delete an artist",
+                "summary": "Change the status of a release",
                 "parameters": [
                     {
-                        "description": "Artist deletion request containing title",
+                        "type": "integer",
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New status and release date",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/delivery.DeleteArtistRequest"
+                            "$ref": "#/definitions/delivery.ChangeAlbumStatusRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Artist deleted successfully",
+                        "description": "Album with the new status",
                         "schema": {
-                            "$ref": "#/definitions/delivery.DeleteArtistRequest"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Album"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid input",
+                        "description": "Bad request - invalid status or release date",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -879,11 +910,23 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - user not in label",
+                        "description": "Forbidden - transition requires a reviewer",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Album not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - transition not allowed from the current status",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -893,14 +936,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/label/artists": {
-            "get": {
+        "/api/v1/label/album/{id}/tracks": {
+            "put": {
                 "security": [
                     {
                         "LabelAuth": []
                     }
                 ],
-                "description": "Retrieves a list of artists associated with the user's label with optional pagination",
+                "description": "Renames and reorders every track of a draft album and replaces its artist credits. Only accessible by label members.",
                 "consumes": [
                     "application/json"
                 ],
@@ -910,45 +953,34 @@ const docTemplate = `{
                 "tags": [
                     "label"
                 ],
-                "summary": "Get artists in a label",
+                "summary": "Edit the tracks of a draft",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Album artists and every track of the album",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.EditAlbumTracksRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of artists in the label",
+                        "description": "Tracks edited successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Artist"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.Message"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid pagination parameters",
+                        "description": "Bad request - invalid tracks or artists",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -960,11 +992,23 @@ const docTemplate = `{
                         }
                     },
                     "403": {
-                        "description": "Forbidden - user not in label",
+                        "description": "Forbidden - artists are not in the label",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
                         }
                     },
+                    "404": {
+                        "description": "Album not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - album is not a draft",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -974,14 +1018,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v1/label/{id}": {
-            "get": {
+        "/api/v1/label/album/{id}/tracks/{track_id}": {
+            "put": {
                 "security": [
                     {
-                        "AdminAuth": []
+                        "LabelAuth": []
                     }
                 ],
-                "description": "Retrieves a label by its ID. Only accessible by administrators.",
+                "description": "Renames a track, moves it to another position of the album and replaces the roles of its artists when they are given. Only accessible by label members.",
                 "consumes": [
                     "application/json"
                 ],
@@ -991,47 +1035,80 @@ const docTemplate = `{
                 "tags": [
                     "label"
                 ],
-                "summary": "Get label by ID",
+                "summary": "Edit a track of an album",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Label ID",
+                        "description": "Album ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "track_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "New track title, position and artist roles",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.EditAlbumTrackRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Label information",
+                        "description": "Track edited successfully",
                         "schema": {
-                            "$ref": "#/definitions/delivery.Label"
+                            "$ref": "#/definitions/delivery.Message"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid label ID",
+                        "description": "Bad request - invalid title, position or artist roles",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized - admin access required",
+                        "description": "Unauthorized",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - artists are not in the label",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Album or track not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
                     },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/artists": {
-            "get": {
-                "description": "Get a list of artists with optional pagination filters",
+        "/api/v1/label/album/{id}/tracks/{track_id}/audio": {
+            "put": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Swaps the file of a track for a completed upload and recomputes its duration. Only accessible by label members.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1039,51 +1116,65 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artists"
+                    "label"
                 ],
-                "summary": "Get artists",
+                "summary": "Replace the audio of a track",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Track ID",
+                        "name": "track_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Upload with the new audio file",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.ReplaceTrackAudioRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of artists",
+                        "description": "Track audio replaced successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Artist"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.Message"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid filters",
+                        "description": "Bad request - upload is incomplete or not a supported audio file",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Album, track or upload not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The new audio is already in the catalog",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1093,9 +1184,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/search": {
-            "get": {
-                "description": "Search artists by query",
+        "/api/v1/label/album/{id}/tracks/{track_id}/lyrics": {
+            "put": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Stores plain or LRC lyrics of a track in one language, uploading a language again replaces its lyrics. Only accessible by label members.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1103,21 +1199,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artists"
+                    "label"
                 ],
-                "summary": "Search artists",
+                "summary": "Upload lyrics of a track",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Query",
-                        "name": "query",
-                        "in": "query",
+                        "type": "integer",
+                        "description": "Album ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "track_id",
+                        "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Lyrics and their language",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.TrackLyricsRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of artists",
+                        "description": "Lyrics as they were parsed",
                         "schema": {
                             "allOf": [
                                 {
@@ -1127,10 +1239,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Artist"
-                                            }
+                                            "$ref": "#/definitions/delivery.TrackLyrics"
                                         }
                                     }
                                 }
@@ -1138,11 +1247,23 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid query",
+                        "description": "Bad request - invalid lyrics or language",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Album or track not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1152,9 +1273,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{id}": {
-            "get": {
-                "description": "Get detailed information about a specific artist by their ID",
+        "/api/v1/label/album/{id}/tracks/{track_id}/lyrics/{language}": {
+            "delete": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Deletes lyrics of a track in one language. Only accessible by label members.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1162,35 +1288,37 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artists"
+                    "label"
                 ],
-                "summary": "Get artist by ID",
+                "summary": "Delete lyrics of a track",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Artist ID",
+                        "description": "Album ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "track_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ISO 639 code of the language",
+                        "name": "language",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Artist details",
+                        "description": "Lyrics deleted successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/delivery.ArtistDetailed"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.Message"
                         }
                     },
                     "400": {
@@ -1199,6 +1327,18 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Album or lyrics not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1208,9 +1348,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{id}/albums": {
+        "/api/v1/label/albums": {
             "get": {
-                "description": "Get a list of albums for a specific artist",
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Retrieves a list of albums associated with the user's label with optional pagination.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1218,17 +1363,10 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "albums"
+                    "label"
                 ],
-                "summary": "Get albums by artist ID",
+                "summary": "Get albums by label ID",
                 "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "type": "integer",
                         "description": "Offset (default: 0)",
@@ -1244,7 +1382,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of albums",
+                        "description": "List of albums in the label",
                         "schema": {
                             "allOf": [
                                 {
@@ -1265,11 +1403,23 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid artist ID",
+                        "description": "Bad request - invalid pagination parameters",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - user not in label",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1279,9 +1429,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{id}/like": {
-            "post": {
-                "description": "Like an artist for a user",
+        "/api/v1/label/albums/review": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "Retrieves albums of every label that were sent to review, oldest first. Only accessible by administrators.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1289,30 +1444,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "artists"
+                    "label"
                 ],
-                "summary": "Like an artist",
+                "summary": "Get releases waiting for review",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
                     },
                     {
-                        "description": "Like request",
-                        "name": "likeRequest",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/delivery.ArtistLikeRequest"
-                        }
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Artist liked/unliked",
+                        "description": "Albums waiting for review",
                         "schema": {
                             "allOf": [
                                 {
@@ -1322,7 +1473,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.Message"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Album"
+                                            }
                                         }
                                     }
                                 }
@@ -1330,23 +1484,17 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid artist ID",
+                        "description": "Bad request - invalid pagination parameters",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Unauthorized - admin access required",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Artist not found",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1356,72 +1504,68 @@ const docTemplate = `{
                 }
             }
         },
-        "/artists/{id}/tracks": {
-            "get": {
-                "description": "Get a list of tracks by a specific artist with optional pagination filters",
+        "/api/v1/label/artist": {
+            "put": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Updates an artist's information (name and/or image). Only accessible by label members.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "label"
                 ],
-                "summary": "Get tracks by artist ID",
+                "summary": "Edit an existing artist",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Artist ID",
-                        "name": "id",
-                        "in": "path",
+                        "type": "string",
+                        "description": "Artist ID to edit",
+                        "name": "artist_id",
+                        "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
+                        "type": "string",
+                        "description": "New artist name (required if thumbnail is not provided)",
+                        "name": "new_title",
+                        "in": "formData"
                     },
                     {
-                        "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "file",
+                        "description": "New artist profile image (required if new_title is not provided, max 6MB)",
+                        "name": "thumbnail",
+                        "in": "formData"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of tracks by artist",
+                        "description": "Updated artist",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Track"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.Artist"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID or filters",
+                        "description": "Bad request - invalid input",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - user not in label",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
                         }
                     },
                     "500": {
@@ -1431,52 +1575,80 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/check": {
-            "get": {
-                "description": "Verifies user's session and returns user information if authenticated",
+            },
+            "post": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Creates a new artist associated with the label. Only accessible by label members.",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "label"
+                ],
+                "summary": "Create a new artist",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Artist name",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Artist profile image (max 6MB)",
+                        "name": "thumbnail",
+                        "in": "formData",
+                        "required": true
+                    }
                 ],
-                "summary": "Check user authentication",
                 "responses": {
-                    "200": {
-                        "description": "User information",
+                    "201": {
+                        "description": "Created artist",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/delivery.UserToFront"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.Artist"
                         }
                     },
                     "400": {
-                        "description": "Bad request - session not found or invalid",
+                        "description": "Bad request - invalid input",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - user not in label",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
                     }
                 }
-            }
-        },
-        "/auth/login": {
-            "post": {
-                "description": "Authenticates a user with provided login credentials and returns a session",
+            },
+            "delete": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Removes an artist from the label. Only accessible by label members.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1484,51 +1656,62 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "label"
                 ],
-                "summary": "Authenticate user",
+                "summary": "Delete an artist",
                 "parameters": [
                     {
-                        "description": "User login data",
-                        "name": "login",
+                        "description": "Artist deletion request containing title",
+                        "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/delivery.LoginData"
+                            "$ref": "#/definitions/delivery.DeleteArtistRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User successfully authenticated",
+                        "description": "Artist deleted successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/delivery.UserToFront"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.DeleteArtistRequest"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid login data",
+                        "description": "Bad request - invalid input",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - user not in label",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/auth/logout": {
-            "post": {
-                "description": "Terminates user session and invalidates session cookie",
+        "/api/v1/label/artists": {
+            "get": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Retrieves a list of artists associated with the user's label with optional pagination",
                 "consumes": [
                     "application/json"
                 ],
@@ -1536,12 +1719,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "label"
+                ],
+                "summary": "Get artists in a label",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Log out user",
                 "responses": {
                     "200": {
-                        "description": "Successfully logged out",
+                        "description": "List of artists in the label",
                         "schema": {
                             "allOf": [
                                 {
@@ -1551,7 +1748,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.Message"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Artist"
+                                            }
                                         }
                                     }
                                 }
@@ -1559,17 +1759,40 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - session not found",
+                        "description": "Bad request - invalid pagination parameters",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - user not in label",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/auth/signup": {
-            "post": {
-                "description": "Creates a new user account with provided registration data",
+        "/api/v1/label/duplicates": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "Retrieves uploaded tracks of every label that sound like a track already in the catalog, oldest first. Near certain duplicates are rejected at upload and never show up here. Only accessible by administrators.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1577,23 +1800,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "label"
                 ],
-                "summary": "Register a new user",
+                "summary": "Get uploads flagged as possible duplicates",
                 "parameters": [
                     {
-                        "description": "User registration data",
-                        "name": "register",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/delivery.RegisterData"
-                        }
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User successfully registered",
+                        "description": "Flags waiting for review",
                         "schema": {
                             "allOf": [
                                 {
@@ -1603,7 +1829,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.UserToFront"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.DuplicateFlag"
+                                            }
                                         }
                                     }
                                 }
@@ -1611,78 +1840,85 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid registration data",
+                        "description": "Bad request - invalid pagination parameters",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
+                    },
+                    "401": {
+                        "description": "Unauthorized - admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/playlists": {
-            "post": {
-                "description": "Create a new playlist with a title and thumbnail image",
+        "/api/v1/label/duplicates/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "Confirms a flagged upload as a duplicate or dismisses the flag as a false match. Only accessible by administrators.",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "label"
                 ],
-                "summary": "Create a new playlist",
+                "summary": "Resolve a duplicate flag",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Playlist title",
-                        "name": "title",
-                        "in": "formData",
+                        "type": "integer",
+                        "description": "Flag ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
                     },
                     {
-                        "type": "file",
-                        "description": "Playlist thumbnail image",
-                        "name": "thumbnail",
-                        "in": "formData",
-                        "required": true
+                        "description": "Either confirmed or dismissed",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.ResolveDuplicateFlagRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created playlist",
+                    "200": {
+                        "description": "Duplicate flag resolved successfully",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "$ref": "#/definitions/delivery.Playlist"
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.Message"
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid form data",
+                        "description": "Bad request - invalid status",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Unauthorized - admin access required",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
-                    "413": {
-                        "description": "Request entity too large",
+                    "404": {
+                        "description": "Flag not found or already resolved",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIRequestEntityTooLargeErrorResponse"
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
                     },
                     "500": {
@@ -1694,9 +1930,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlists/me": {
+        "/api/v1/label/{id}": {
             "get": {
-                "description": "Retrieves all playlists accessible to the current user",
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "Retrieves a label by its ID. Only accessible by administrators.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1704,33 +1945,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "label"
+                ],
+                "summary": "Get label by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Label ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Get combined playlists for current user",
                 "responses": {
                     "200": {
-                        "description": "List of playlists",
+                        "description": "Label information",
                         "schema": {
-                            "allOf": [
-                                {
-                                    "$ref": "#/definitions/delivery.APIResponse"
-                                },
-                                {
-                                    "type": "object",
-                                    "properties": {
-                                        "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Playlist"
-                                            }
-                                        }
-                                    }
-                                }
-                            ]
+                            "$ref": "#/definitions/delivery.Label"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid label ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
                     "401": {
-                        "description": "Unauthorized",
+                        "description": "Unauthorized - admin access required",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
@@ -1744,9 +1985,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlists/search": {
+        "/artists": {
             "get": {
-                "description": "Search playlists by query",
+                "description": "Get a list of artists with optional pagination filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -1754,21 +1995,26 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "artists"
                 ],
-                "summary": "Search playlists",
+                "summary": "Get artists",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Search query",
-                        "name": "query",
-                        "in": "query",
-                        "required": true
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of playlists",
+                        "description": "List of artists",
                         "schema": {
                             "allOf": [
                                 {
@@ -1780,7 +2026,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/delivery.Playlist"
+                                                "$ref": "#/definitions/delivery.Artist"
                                             }
                                         }
                                     }
@@ -1789,17 +2035,11 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid query",
+                        "description": "Bad request - invalid filters",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1809,9 +2049,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlists/to-add": {
+        "/artists/search": {
             "get": {
-                "description": "Retrieves all playlists owned by the current user with information about whether the track is already included",
+                "description": "Search artists by query",
                 "consumes": [
                     "application/json"
                 ],
@@ -1819,21 +2059,33 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "artists"
                 ],
-                "summary": "Get playlists available for adding a track",
+                "summary": "Search artists",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Track ID to check inclusion status",
-                        "name": "trackId",
+                        "type": "string",
+                        "description": "Query",
+                        "name": "query",
                         "in": "query",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of playlists with track inclusion status",
+                        "description": "List of artists",
                         "schema": {
                             "allOf": [
                                 {
@@ -1845,7 +2097,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/delivery.PlaylistWithIsIncludedTrack"
+                                                "$ref": "#/definitions/delivery.Artist"
                                             }
                                         }
                                     }
@@ -1854,17 +2106,11 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid track ID",
+                        "description": "Bad request - invalid query",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1874,9 +2120,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlists/{id}": {
+        "/artists/{id}": {
             "get": {
-                "description": "Retrieves a specific playlist by its ID with all its details",
+                "description": "Get detailed information about a specific artist by their ID",
                 "consumes": [
                     "application/json"
                 ],
@@ -1884,13 +2130,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "artists"
                 ],
-                "summary": "Get a playlist by ID",
+                "summary": "Get artist by ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist ID",
+                        "description": "Artist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1898,7 +2144,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Playlist details",
+                        "description": "Artist details",
                         "schema": {
                             "allOf": [
                                 {
@@ -1908,7 +2154,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.PlaylistWithIsLiked"
+                                            "$ref": "#/definitions/delivery.ArtistDetailed"
                                         }
                                     }
                                 }
@@ -1921,12 +2167,6 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Playlist not found",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -1934,44 +2174,45 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "Update a playlist's title and/or thumbnail",
+            }
+        },
+        "/artists/{id}/albums": {
+            "get": {
+                "description": "Get a list of albums for a specific artist",
                 "consumes": [
-                    "multipart/form-data"
+                    "application/json"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "albums"
                 ],
-                "summary": "Update a playlist",
+                "summary": "Get albums by artist ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist ID",
+                        "description": "Artist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "New playlist title",
-                        "name": "title",
-                        "in": "formData",
-                        "required": true
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
                     },
                     {
-                        "type": "file",
-                        "description": "New playlist thumbnail image",
-                        "name": "thumbnail",
-                        "in": "formData"
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Updated playlist",
+                        "description": "List of albums",
                         "schema": {
                             "allOf": [
                                 {
@@ -1981,7 +2222,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.Playlist"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Album"
+                                            }
                                         }
                                     }
                                 }
@@ -1989,23 +2233,11 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID or form data",
+                        "description": "Bad request - invalid artist ID",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Playlist not found",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2013,9 +2245,11 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
-                "description": "Deletes a playlist by its ID (only available to the playlist owner)",
+            }
+        },
+        "/artists/{id}/like": {
+            "post": {
+                "description": "Like an artist for a user",
                 "consumes": [
                     "application/json"
                 ],
@@ -2023,21 +2257,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "artists"
                 ],
-                "summary": "Remove a playlist",
+                "summary": "Like an artist",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist ID",
+                        "description": "Artist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Like request",
+                        "name": "likeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.ArtistLikeRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Playlist removed successfully",
+                        "description": "Artist liked/unliked",
                         "schema": {
                             "allOf": [
                                 {
@@ -2055,7 +2298,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID",
+                        "description": "Bad request - invalid artist ID",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -2066,14 +2309,8 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
-                    "403": {
-                        "description": "Forbidden - user is not the playlist owner",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
-                        }
-                    },
                     "404": {
-                        "description": "Playlist not found",
+                        "description": "Artist not found",
                         "schema": {
                             "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
@@ -2087,9 +2324,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlists/{id}/like": {
-            "post": {
-                "description": "Like a playlist",
+        "/artists/{id}/tracks": {
+            "get": {
+                "description": "Get a list of tracks by a specific artist with optional pagination filters",
                 "consumes": [
                     "application/json"
                 ],
@@ -2097,48 +2334,60 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "tracks"
                 ],
-                "summary": "Like a playlist",
+                "summary": "Get tracks by artist ID",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Playlist ID",
+                        "description": "Artist ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Playlist like request",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/delivery.PlaylistLikeRequest"
-                        }
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Playlist liked/unliked successfully",
+                        "description": "List of tracks by artist",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Track"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID or request body",
+                        "description": "Bad request - invalid ID or filters",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
-                        }
-                    },
                     "404": {
-                        "description": "Playlist not found",
+                        "description": "Not found",
                         "schema": {
                             "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
@@ -2152,9 +2401,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/playlists/{id}/tracks": {
+        "/auth/check": {
             "get": {
-                "description": "Get a list of tracks by a specific playlist with optional pagination filters",
+                "description": "Verifies user's session and returns user information if authenticated",
                 "consumes": [
                     "application/json"
                 ],
@@ -2162,21 +2411,12 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
-                ],
-                "summary": "Get playlist tracks",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Playlist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "Check user authentication",
                 "responses": {
                     "200": {
-                        "description": "List of tracks by playlist",
+                        "description": "User information",
                         "schema": {
                             "allOf": [
                                 {
@@ -2186,10 +2426,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Track"
-                                            }
+                                            "$ref": "#/definitions/delivery.UserToFront"
                                         }
                                     }
                                 }
@@ -2197,27 +2434,17 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid playlist ID or filters",
+                        "description": "Bad request - session not found or invalid",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
-                    },
-                    "404": {
-                        "description": "Playlist not found",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
-                        }
                     }
                 }
-            },
-            "post": {
-                "description": "Adds a track to a specific playlist",
+            }
+        },
+        "/auth/login": {
+            "post": {
+                "description": "Authenticates a user with provided login credentials and returns a session",
                 "consumes": [
                     "application/json"
                 ],
@@ -2225,64 +2452,51 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
+                    "auth"
                 ],
-                "summary": "Add a track to a playlist",
+                "summary": "Authenticate user",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Playlist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "Track information",
-                        "name": "request",
+                        "description": "User login data",
+                        "name": "login",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/delivery.AddTrackToPlaylistRequest"
+                            "$ref": "#/definitions/delivery.LoginData"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Track added successfully",
+                        "description": "User successfully authenticated",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.UserToFront"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID or request body",
+                        "description": "Bad request - invalid login data",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Playlist or track not found",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/playlists/{id}/tracks/{trackId}": {
-            "delete": {
-                "description": "Removes a track from a specific playlist",
+        "/auth/logout": {
+            "post": {
+                "description": "Terminates user session and invalidates session cookie",
                 "consumes": [
                     "application/json"
                 ],
@@ -2290,62 +2504,40 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "playlists"
-                ],
-                "summary": "Remove a track from a playlist",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Playlist ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Track ID",
-                        "name": "trackId",
-                        "in": "path",
-                        "required": true
-                    }
+                    "auth"
                 ],
+                "summary": "Log out user",
                 "responses": {
                     "200": {
-                        "description": "Track removed successfully",
+                        "description": "Successfully logged out",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIResponse"
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid IDs",
+                        "description": "Bad request - session not found",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
-                    },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Playlist or track not found",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
-                        }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/selection/{selection}": {
-            "get": {
-                "description": "Get a list of tracks by a specific selection",
+        "/auth/signup": {
+            "post": {
+                "description": "Creates a new user account with provided registration data",
                 "consumes": [
                     "application/json"
                 ],
@@ -2353,21 +2545,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "auth"
                 ],
-                "summary": "Get selection tracks",
+                "summary": "Register a new user",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Selection (most-recent, most-liked, most-liked-last-week, most-listened-last-month, top-chart)",
-                        "name": "selection",
-                        "in": "path",
-                        "required": true
+                        "description": "User registration data",
+                        "name": "register",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.RegisterData"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of tracks",
+                        "description": "User successfully registered",
                         "schema": {
                             "allOf": [
                                 {
@@ -2377,10 +2571,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Track"
-                                            }
+                                            "$ref": "#/definitions/delivery.UserToFront"
                                         }
                                     }
                                 }
@@ -2388,42 +2579,41 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid selection",
+                        "description": "Bad request - invalid registration data",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
-                    },
-                    "500": {
-                        "description": "Internal server error",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
-                        }
                     }
                 }
             }
         },
-        "/streams/{id}": {
-            "put": {
-                "description": "updates listening duration at the end of stream",
+        "/jams": {
+            "get": {
+                "description": "Get public jam rooms and rooms hosted by people the current user follows, most recently active first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "jam"
                 ],
-                "summary": "Update stream duration by id",
+                "summary": "List jam rooms",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "Stream ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Message that stream was updated",
+                        "description": "Jam rooms",
                         "schema": {
                             "allOf": [
                                 {
@@ -2435,7 +2625,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/delivery.Message"
+                                                "$ref": "#/definitions/delivery.JamSummary"
                                             }
                                         }
                                     }
@@ -2444,29 +2634,11 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID or filters",
+                        "description": "Bad request - invalid pagination",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
-                        }
-                    },
-                    "403": {
-                        "description": "Forbidden",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
-                        }
-                    },
-                    "404": {
-                        "description": "Not found",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
-                        }
-                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2476,9 +2648,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/tracks": {
-            "get": {
-                "description": "Get a list of tracks with optional pagination filters",
+        "/label/uploads": {
+            "post": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Starts a resumable multipart upload of a track or a cover. The file is sent straight to the storage in parts of part_size bytes through presigned URLs.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2486,26 +2663,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "label"
                 ],
-                "summary": "Get tracks",
+                "summary": "Start an upload",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "File kind, size and content type",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.CreateUploadRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of tracks",
+                    "201": {
+                        "description": "Upload started",
                         "schema": {
                             "allOf": [
                                 {
@@ -2515,10 +2689,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Track"
-                                            }
+                                            "$ref": "#/definitions/delivery.Upload"
                                         }
                                     }
                                 }
@@ -2526,15 +2697,21 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid filters",
+                        "description": "Bad request - invalid kind, content type or size",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "Not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "413": {
+                        "description": "File is too large",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIRequestEntityTooLargeErrorResponse"
                         }
                     },
                     "500": {
@@ -2546,31 +2723,33 @@ const docTemplate = `{
                 }
             }
         },
-        "/tracks/search": {
+        "/label/uploads/{id}": {
             "get": {
-                "description": "Search tracks by query",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
                 ],
+                "description": "Returns the upload with the parts the storage already has, used to resume an interrupted upload",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "label"
                 ],
-                "summary": "Search tracks",
+                "summary": "Get upload state",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Query",
-                        "name": "query",
+                        "description": "Upload ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of tracks",
+                        "description": "Upload state",
                         "schema": {
                             "allOf": [
                                 {
@@ -2580,20 +2759,85 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Track"
-                                            }
+                                            "$ref": "#/definitions/delivery.Upload"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad request - invalid query",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Upload not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Drops the upload and every part that was already sent",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "label"
+                ],
+                "summary": "Abort an upload",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Upload ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Upload aborted",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Upload not found or expired",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
                     },
                     "500": {
@@ -2605,23 +2849,25 @@ const docTemplate = `{
                 }
             }
         },
-        "/tracks/{id}": {
-            "get": {
-                "description": "Retrieves a specific track by its ID with detailed information",
-                "consumes": [
-                    "application/json"
+        "/label/uploads/{id}/complete": {
+            "post": {
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
                 ],
+                "description": "Assembles the file once every part is uploaded, the upload id can then be used to create a release",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "label"
                 ],
-                "summary": "Get track by ID",
+                "summary": "Complete an upload",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Track ID",
+                        "type": "string",
+                        "description": "Upload ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -2629,7 +2875,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "Track details",
+                        "description": "Completed upload",
                         "schema": {
                             "allOf": [
                                 {
@@ -2639,25 +2885,31 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.TrackDetailed"
+                                            "$ref": "#/definitions/delivery.Upload"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad request - invalid ID",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
                     "404": {
-                        "description": "Not found",
+                        "description": "Upload not found or expired",
                         "schema": {
                             "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Some parts are missing or the size does not match",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2667,9 +2919,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/tracks/{id}/like": {
+        "/label/uploads/{id}/parts": {
             "post": {
-                "description": "Like a track for a user",
+                "security": [
+                    {
+                        "LabelAuth": []
+                    }
+                ],
+                "description": "Returns presigned URLs to PUT the given parts to. Without part numbers every part that is not uploaded yet is signed.",
                 "consumes": [
                     "application/json"
                 ],
@@ -2677,30 +2934,29 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "label"
                 ],
-                "summary": "Like a track",
+                "summary": "Sign upload parts",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Track ID",
+                        "type": "string",
+                        "description": "Upload ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Is like",
-                        "name": "likeRequest",
+                        "description": "Part numbers, starting from 1",
+                        "name": "request",
                         "in": "body",
-                        "required": true,
                         "schema": {
-                            "$ref": "#/definitions/delivery.TrackLikeRequest"
+                            "$ref": "#/definitions/delivery.UploadPartsRequest"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Track liked/unliked",
+                        "description": "Presigned part URLs",
                         "schema": {
                             "allOf": [
                                 {
@@ -2710,7 +2966,10 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.Message"
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.UploadPart"
+                                            }
                                         }
                                     }
                                 }
@@ -2718,7 +2977,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid track ID",
+                        "description": "Bad request - invalid part number",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -2730,11 +2989,17 @@ const docTemplate = `{
                         }
                     },
                     "404": {
-                        "description": "Track not found",
+                        "description": "Upload not found or expired",
                         "schema": {
                             "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
                         }
                     },
+                    "409": {
+                        "description": "Upload is already completed",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2744,28 +3009,39 @@ const docTemplate = `{
                 }
             }
         },
-        "/tracks/{id}/stream": {
-            "post": {
-                "description": "Creates stream for track by id, essentially it means saving track to listening history",
+        "/notifications": {
+            "get": {
+                "description": "Get the current user's notification inbox, newest first",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "notifications"
                 ],
-                "summary": "Create stream for track by id",
+                "summary": "Get notifications",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only unread notifications",
+                        "name": "unread",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "Track ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "ID of created stream",
+                        "description": "List of notifications",
                         "schema": {
                             "allOf": [
                                 {
@@ -2777,7 +3053,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/delivery.StreamID"
+                                                "$ref": "#/definitions/delivery.Notification"
                                             }
                                         }
                                     }
@@ -2786,11 +3062,17 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid ID or filters",
+                        "description": "Bad request - invalid pagination or unread flag",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -2800,33 +3082,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/me": {
-            "put": {
-                "description": "Updates user profile information and privacy settings",
-                "consumes": [
-                    "application/json"
-                ],
+        "/notifications/preferences": {
+            "get": {
+                "description": "Get which notification types the current user receives",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
-                ],
-                "summary": "Change user data",
-                "parameters": [
-                    {
-                        "description": "User data and privacy settings",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/delivery.UserChangeSettings"
-                        }
-                    }
+                    "notifications"
                 ],
+                "summary": "Get notification preferences",
                 "responses": {
                     "200": {
-                        "description": "Updated user data and privacy settings",
+                        "description": "Notification preferences",
                         "schema": {
                             "allOf": [
                                 {
@@ -2836,23 +3104,29 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.UserFullData"
+                                            "$ref": "#/definitions/delivery.NotificationPreferences"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad request - invalid request body, validation failed, or user not found",
+                    "403": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "Deletes the authenticated user's account. Requires valid session cookie and matching user credentials.",
+            "put": {
+                "description": "Switch notification types on or off, types left out of the request keep their setting",
                 "consumes": [
                     "application/json"
                 ],
@@ -2860,30 +3134,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "notifications"
                 ],
-                "summary": "Delete user account",
+                "summary": "Update notification preferences",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Session ID cookie (session_id=...)",
-                        "name": "Authorization",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "description": "User credentials for deletion verification",
-                        "name": "user",
+                        "description": "Preferences to change",
+                        "name": "preferences",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/delivery.UserDelete"
+                            "$ref": "#/definitions/delivery.NotificationPreferences"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User successfully deleted",
+                        "description": "Updated notification preferences",
                         "schema": {
                             "allOf": [
                                 {
@@ -2893,7 +3160,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.Message"
+                                            "$ref": "#/definitions/delivery.NotificationPreferences"
                                         }
                                     }
                                 }
@@ -2901,50 +3168,39 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Possible errors: invalid request body, validation failed, credentials mismatch, session cookie missing",
+                        "description": "Bad request - invalid body or notification type",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
+                    "403": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
                     "500": {
-                        "description": "Internal server error during user deletion",
+                        "description": "Internal server error",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/user/me/albums": {
-            "get": {
-                "description": "Get a list of favorite albums for a user",
-                "consumes": [
-                    "application/json"
-                ],
+        "/notifications/read": {
+            "post": {
+                "description": "Mark every notification of the current user as read",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "albums"
-                ],
-                "summary": "Get favorite albums",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
-                    }
+                    "notifications"
                 ],
+                "summary": "Mark all notifications as read",
                 "responses": {
                     "200": {
-                        "description": "List of favorite albums",
+                        "description": "Notifications marked as read",
                         "schema": {
                             "allOf": [
                                 {
@@ -2954,20 +3210,17 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Album"
-                                            }
+                                            "$ref": "#/definitions/delivery.Message"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "401": {
+                    "403": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
                         }
                     },
                     "500": {
@@ -2979,38 +3232,19 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/me/avatar": {
-            "post": {
-                "description": "Uploads a new avatar image for a specific user",
-                "consumes": [
-                    "multipart/form-data"
-                ],
+        "/notifications/unread-count": {
+            "get": {
+                "description": "Get how many notifications the current user has not read yet",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
-                ],
-                "summary": "Upload user avatar",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Username",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "file",
-                        "description": "Avatar image file (max 5MB, image formats only)",
-                        "name": "avatar",
-                        "in": "formData",
-                        "required": true
-                    }
+                    "notifications"
                 ],
+                "summary": "Get unread notifications count",
                 "responses": {
                     "200": {
-                        "description": "Link to the uploaded avatar image",
+                        "description": "Unread count",
                         "schema": {
                             "allOf": [
                                 {
@@ -3020,47 +3254,50 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.AvatarURL"
+                                            "$ref": "#/definitions/delivery.UnreadNotificationsCount"
                                         }
                                     }
                                 }
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad request - invalid file or username",
+                    "403": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
                         }
                     }
                 }
             }
         },
-        "/user/{username}": {
-            "get": {
-                "description": "Retrieves user's profile information and privacy settings",
-                "consumes": [
-                    "application/json"
-                ],
+        "/notifications/{id}/read": {
+            "post": {
+                "description": "Mark a single notification of the current user as read",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "user"
+                    "notifications"
                 ],
-                "summary": "Get user profile data and privacy settings",
+                "summary": "Mark notification as read",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Username",
-                        "name": "username",
+                        "type": "integer",
+                        "description": "Notification ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "User data, privacy settings and statistics, -1 - if the statistics field is not allowed to display",
+                        "description": "Notification marked as read",
                         "schema": {
                             "allOf": [
                                 {
@@ -3070,7 +3307,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "$ref": "#/definitions/delivery.UserFullData"
+                                            "$ref": "#/definitions/delivery.Message"
                                         }
                                     }
                                 }
@@ -3078,51 +3315,64 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - username not found in URL or user not found",
+                        "description": "Bad request - invalid ID",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
+                    },
+                    "403": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Notification not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
                     }
                 }
             }
         },
-        "/user/{username}/artists": {
-            "get": {
-                "description": "Get a list of favorite artists for a user",
+        "/playlists": {
+            "post": {
+                "description": "Create a new playlist with a title and thumbnail image",
                 "consumes": [
-                    "application/json"
+                    "multipart/form-data"
                 ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "artists"
+                    "playlists"
                 ],
-                "summary": "Get favorite artists",
+                "summary": "Create a new playlist",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
-                        "in": "path",
+                        "description": "Playlist title",
+                        "name": "title",
+                        "in": "formData",
                         "required": true
                     },
                     {
-                        "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "type": "file",
+                        "description": "Playlist thumbnail image",
+                        "name": "thumbnail",
+                        "in": "formData",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "List of favorite artists",
+                    "201": {
+                        "description": "Created playlist",
                         "schema": {
                             "allOf": [
                                 {
@@ -3132,10 +3382,7 @@ const docTemplate = `{
                                     "type": "object",
                                     "properties": {
                                         "body": {
-                                            "type": "array",
-                                            "items": {
-                                                "$ref": "#/definitions/delivery.Artist"
-                                            }
+                                            "$ref": "#/definitions/delivery.Playlist"
                                         }
                                     }
                                 }
@@ -3143,7 +3390,7 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid username",
+                        "description": "Bad request - invalid form data",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
@@ -3154,6 +3401,12 @@ const docTemplate = `{
                             "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
+                    "413": {
+                        "description": "Request entity too large",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIRequestEntityTooLargeErrorResponse"
+                        }
+                    },
                     "500": {
                         "description": "Internal server error",
                         "schema": {
@@ -3163,9 +3416,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/user/{username}/playlists": {
+        "/playlists/me": {
             "get": {
-                "description": "Retrieves all playlists owned by a specific user",
+                "description": "Retrieves all playlists accessible to the current user",
                 "consumes": [
                     "application/json"
                 ],
@@ -3175,16 +3428,7 @@ const docTemplate = `{
                 "tags": [
                     "playlists"
                 ],
-                "summary": "Get profile playlists",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Username of the user",
-                        "name": "username",
-                        "in": "query",
-                        "required": true
-                    }
-                ],
+                "summary": "Get combined playlists for current user",
                 "responses": {
                     "200": {
                         "description": "List of playlists",
@@ -3207,12 +3451,6 @@ const docTemplate = `{
                             ]
                         }
                     },
-                    "400": {
-                        "description": "Bad request - invalid username",
-                        "schema": {
-                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
-                        }
-                    },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
@@ -3228,9 +3466,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/me/history": {
+        "/playlists/search": {
             "get": {
-                "description": "Retrieves a list of tracks last listened by a specific user with pagination",
+                "description": "Search playlists by query",
                 "consumes": [
                     "application/json"
                 ],
@@ -3238,15 +3476,15 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "playlists"
                 ],
-                "summary": "Get last listened tracks for a user",
+                "summary": "Search playlists",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Username",
-                        "name": "username",
-                        "in": "path",
+                        "description": "Search query",
+                        "name": "query",
+                        "in": "query",
                         "required": true
                     },
                     {
@@ -3264,7 +3502,7 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of last listened tracks",
+                        "description": "List of playlists",
                         "schema": {
                             "allOf": [
                                 {
@@ -3276,7 +3514,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/delivery.Track"
+                                                "$ref": "#/definitions/delivery.Playlist"
                                             }
                                         }
                                     }
@@ -3285,15 +3523,15 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid username or filters",
+                        "description": "Bad request - invalid query",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
                     "500": {
@@ -3305,9 +3543,9 @@ const docTemplate = `{
                 }
             }
         },
-        "/users/{username}/tracks": {
+        "/playlists/to-add": {
             "get": {
-                "description": "Get a list of favorite tracks for a user",
+                "description": "Retrieves all playlists owned by the current user with information about whether the track is already included",
                 "consumes": [
                     "application/json"
                 ],
@@ -3315,33 +3553,21 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "tracks"
+                    "playlists"
                 ],
-                "summary": "Get favorite tracks",
+                "summary": "Get playlists available for adding a track",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Username",
-                        "name": "username",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset (default: 0)",
-                        "name": "offset",
-                        "in": "query"
-                    },
                     {
                         "type": "integer",
-                        "description": "Limit (default: 10, max: 100)",
-                        "name": "limit",
-                        "in": "query"
+                        "description": "Track ID to check inclusion status",
+                        "name": "trackId",
+                        "in": "query",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "List of favorite tracks",
+                        "description": "List of playlists with track inclusion status",
                         "schema": {
                             "allOf": [
                                 {
@@ -3353,7 +3579,7 @@ const docTemplate = `{
                                         "body": {
                                             "type": "array",
                                             "items": {
-                                                "$ref": "#/definitions/delivery.Track"
+                                                "$ref": "#/definitions/delivery.PlaylistWithIsIncludedTrack"
                                             }
                                         }
                                     }
@@ -3362,15 +3588,15 @@ const docTemplate = `{
                         }
                     },
                     "400": {
-                        "description": "Bad request - invalid username or filters",
+                        "description": "Bad request - invalid track ID",
                         "schema": {
                             "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
                         }
                     },
-                    "404": {
-                        "description": "User not found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
                         }
                     },
                     "500": {
@@ -3381,318 +3607,3743 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "delivery.APIBadRequestErrorResponse": {
-            "description": "API bad request error response structure",
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Something went wrong"
-                },
-                "status": {
-                    "type": "integer",
-                    "example": 400
-                }
-            }
-        },
-        "delivery.APIForbiddenErrorResponse": {
-            "description": "API forbidden error response structure",
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Forbidden"
-                },
-                "status": {
-                    "type": "integer",
-                    "example": 403
-                }
-            }
-        },
-        "delivery.APIInternalServerErrorResponse": {
-            "description": "API internal server error response structure",
-            "type": "object",
-            "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Something went wrong"
-                },
-                "status": {
-                    "type": "integer",
-                    "example": 500
-                }
-            }
+        },
+        "/playlists/{id}": {
+            "get": {
+                "description": "Retrieves a specific playlist by its ID with all its details",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlists"
+                ],
+                "summary": "Get a playlist by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Playlist details",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.PlaylistWithIsLiked"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Playlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update a playlist's title and/or thumbnail",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlists"
+                ],
+                "summary": "Update a playlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "New playlist title",
+                        "name": "title",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "New playlist thumbnail image",
+                        "name": "thumbnail",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated playlist",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Playlist"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or form data",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Playlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes a playlist by its ID (only available to the playlist owner)",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlists"
+                ],
+                "summary": "Remove a playlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Playlist removed successfully",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - user is not the playlist owner",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Playlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{id}/like": {
+            "post": {
+                "description": "Like a playlist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlists"
+                ],
+                "summary": "Like a playlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Playlist like request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.PlaylistLikeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Playlist liked/unliked successfully",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Playlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{id}/tracks": {
+            "get": {
+                "description": "Get a list of tracks by a specific playlist with optional pagination filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get playlist tracks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of tracks by playlist",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Track"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid playlist ID or filters",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Playlist not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Adds a track to a specific playlist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlists"
+                ],
+                "summary": "Add a track to a playlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Track information",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.AddTrackToPlaylistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Track added successfully",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or request body",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Playlist or track not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/playlists/{id}/tracks/{trackId}": {
+            "delete": {
+                "description": "Removes a track from a specific playlist",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlists"
+                ],
+                "summary": "Remove a track from a playlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Playlist ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "trackId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Track removed successfully",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid IDs",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Playlist or track not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search": {
+            "get": {
+                "description": "Search tracks, albums, artists and playlists in one call. Results are grouped by type and ranked by relevance and popularity. If nothing is found, the query is retried in the other keyboard layout (ЙЦУКЕН↔QWERTY).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Unified search",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search query",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma-separated list of types to search (tracks, albums, artists, playlists). Default: all",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset per type (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit per type (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Grouped search results",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.SearchResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query, type or pagination",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/analytics": {
+            "get": {
+                "security": [
+                    {
+                        "AdminAuth": []
+                    }
+                ],
+                "description": "Top queries, top zero-result queries and click-through rate. Only accessible by administrators. The same data is exported to Prometheus as api_search_queries_total and api_search_clicks_total.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search analytics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Number of queries in each list (default: 20, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Search analytics",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.SearchAnalytics"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid limit",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized - admin access required",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/click": {
+            "post": {
+                "description": "Records that a search result was opened, used for click-through rate. No user data is stored.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Register a search result click",
+                "parameters": [
+                    {
+                        "description": "Clicked result",
+                        "name": "click",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.SearchClick"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Click recorded",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid click",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/search/suggest": {
+            "get": {
+                "description": "Autocomplete for the search box: top prefix matches across artists, albums and tracks. Best effort - types that do not answer within the latency budget are omitted. Popular queries are served from cache.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "search"
+                ],
+                "summary": "Search suggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search prefix",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Suggestions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.SuggestResult"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - empty query",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/selection/{selection}": {
+            "get": {
+                "description": "Get a list of tracks by a specific selection",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get selection tracks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Selection (most-recent, most-liked, most-liked-last-week, most-listened-last-month, top-chart)",
+                        "name": "selection",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of tracks",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Track"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid selection",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/streams/{id}": {
+            "put": {
+                "description": "updates listening duration at the end of stream",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Update stream duration by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Message that stream was updated",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Message"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or filters",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/streams/{id}/hls/master.m3u8": {
+            "get": {
+                "description": "Lists the bitrates the track of the stream can be played at, players switch between them by the bandwidth they measure",
+                "produces": [
+                    "application/vnd.apple.mpegurl"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get HLS master playlist of a stream",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "HLS master playlist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Stream not found or track is not segmented yet",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/streams/{id}/hls/{bitrate}.m3u8": {
+            "get": {
+                "description": "Lists the segments of one bitrate of the track of the stream",
+                "produces": [
+                    "application/vnd.apple.mpegurl"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get HLS media playlist of a stream",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Bitrate in kbit/s",
+                        "name": "bitrate",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "HLS media playlist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or bitrate",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Stream or bitrate not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/streams/{id}/hls/{bitrate}/{position}.ts": {
+            "get": {
+                "description": "Counts the segment towards the stream duration and redirects to its file",
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get HLS segment of a stream",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Stream ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Bitrate in kbit/s",
+                        "name": "bitrate",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Segment position",
+                        "name": "position",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "302": {
+                        "description": "Redirect to the presigned segment url"
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID, bitrate or position",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIForbiddenErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Stream or segment not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tracks": {
+            "get": {
+                "description": "Get a list of tracks with optional pagination filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get tracks",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of tracks",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Track"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid filters",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tracks/search": {
+            "get": {
+                "description": "Search tracks by query",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Search tracks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Query",
+                        "name": "query",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of tracks",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Track"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid query",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tracks/{id}": {
+            "get": {
+                "description": "Retrieves a specific track by its ID with detailed information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get track by ID",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Track details",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.TrackDetailed"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tracks/{id}/like": {
+            "post": {
+                "description": "Like a track for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Like a track",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Is like",
+                        "name": "likeRequest",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.TrackLikeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Track liked/unliked",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid track ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Track not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tracks/{id}/lyrics": {
+            "get": {
+                "description": "Retrieves lyrics of a track in every language they were uploaded in, originals first. Synced lyrics have the time of every line for karaoke-style display.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get track lyrics",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Track lyrics, empty when the track has none",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.TrackLyrics"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tracks/{id}/stream": {
+            "post": {
+                "description": "Creates stream for track by id, essentially it means saving track to listening history",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Create stream for track by id",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ID of created stream",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.StreamID"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID or filters",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/tracks/{id}/waveform": {
+            "get": {
+                "description": "Retrieves peak amplitudes of a track for drawing its waveform, available once the track is analysed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get track waveform",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Track ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Track waveform",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.TrackWaveform"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Track not found or not analysed yet",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me": {
+            "put": {
+                "description": "Updates user profile information and privacy settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Change user data",
+                "parameters": [
+                    {
+                        "description": "User data and privacy settings",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.UserChangeSettings"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Updated user data and privacy settings",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.UserFullData"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid request body, validation failed, or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Deletes the authenticated user's account. Requires valid session cookie and matching user credentials.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Delete user account",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Session ID cookie (session_id=...)",
+                        "name": "Authorization",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "User credentials for deletion verification",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/delivery.UserDelete"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User successfully deleted",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Possible errors: invalid request body, validation failed, credentials mismatch, session cookie missing",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error during user deletion",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me/albums": {
+            "get": {
+                "description": "Get a list of favorite albums for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "albums"
+                ],
+                "summary": "Get favorite albums",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of favorite albums",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Album"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me/avatar": {
+            "post": {
+                "description": "Uploads a new avatar image for a specific user",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Upload user avatar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "file",
+                        "description": "Avatar image file (max 5MB, image formats only)",
+                        "name": "avatar",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Link to the uploaded avatar image",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.AvatarURL"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid file or username",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me/feed": {
+            "get": {
+                "description": "Get recent likes, created playlists and current listening of followed users, respecting their privacy settings",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get activity feed",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Activity feed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Activity"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me/jams": {
+            "get": {
+                "description": "Get the jam sessions the current user hosted or joined, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get jam history",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Jam sessions",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.JamSession"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me/jams/{id}": {
+            "get": {
+                "description": "Get who took part in a jam session and the tracks played in it, only for its participants",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get jam session",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jam session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Jam session",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.JamSessionDetails"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Jam session not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me/jams/{id}/playlist": {
+            "post": {
+                "description": "Save every track played in a jam session as a playlist of the current user, titled after the day of the jam and numbered if that title is taken, unless a title is given",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Save jam as playlist",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Jam session ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Playlist title",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.CreateJamPlaylistRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Playlist created",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.CreateJamPlaylistResponse"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid ID, invalid title or nothing was played",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Jam session not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "409": {
+                        "description": "The given title is used by another playlist of the user",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/me/releases": {
+            "get": {
+                "description": "Get albums and tracks recently released by the artists the current user liked, newest first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get release radar",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "New releases",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Release"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{username}": {
+            "get": {
+                "description": "Retrieves user's profile information and privacy settings",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get user profile data and privacy settings",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User data, privacy settings and statistics, -1 - if the statistics field is not allowed to display",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.UserFullData"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - username not found in URL or user not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{username}/artists": {
+            "get": {
+                "description": "Get a list of favorite artists for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "artists"
+                ],
+                "summary": "Get favorite artists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of favorite artists",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Artist"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid username",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{username}/follow": {
+            "post": {
+                "description": "Subscribes the current user to another user's activity",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Follow user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User followed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - trying to follow yourself",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Unsubscribes the current user from another user's activity",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Unfollow user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "User unfollowed",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "$ref": "#/definitions/delivery.Message"
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{username}/followers": {
+            "get": {
+                "description": "Get users following the given user",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get followers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of followers",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.UserPreview"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{username}/following": {
+            "get": {
+                "description": "Get users the given user follows",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "user"
+                ],
+                "summary": "Get following",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of followed users",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.UserPreview"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid pagination",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/user/{username}/playlists": {
+            "get": {
+                "description": "Retrieves all playlists owned by a specific user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "playlists"
+                ],
+                "summary": "Get profile playlists",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username of the user",
+                        "name": "username",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of playlists",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Playlist"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid username",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIUnauthorizedErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/me/history": {
+            "get": {
+                "description": "Retrieves a list of tracks last listened by a specific user with pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get last listened tracks for a user",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of last listened tracks",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Track"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid username or filters",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/users/{username}/tracks": {
+            "get": {
+                "description": "Get a list of favorite tracks for a user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "tracks"
+                ],
+                "summary": "Get favorite tracks",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Username",
+                        "name": "username",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset (default: 0)",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit (default: 10, max: 100)",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "List of favorite tracks",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/delivery.APIResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "body": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/delivery.Track"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid username or filters",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIBadRequestErrorResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "User not found",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APINotFoundErrorResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal server error",
+                        "schema": {
+                            "$ref": "#/definitions/delivery.APIInternalServerErrorResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "delivery.APIBadRequestErrorResponse": {
+            "description": "API bad request error response structure",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Something went wrong"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                }
+            }
+        },
+        "delivery.APIErrorResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Something went wrong"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 400
+                }
+            }
+        },
+        "delivery.APIForbiddenErrorResponse": {
+            "description": "API forbidden error response structure",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Forbidden"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 403
+                }
+            }
+        },
+        "delivery.APIInternalServerErrorResponse": {
+            "description": "API internal server error response structure",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Something went wrong"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 500
+                }
+            }
         },
         "delivery.APINotFoundErrorResponse": {
             "description": "API not found error response structure",
             "type": "object",
             "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Not found"
+                "error": {
+                    "type": "string",
+                    "example": "Not found"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                }
+            }
+        },
+        "delivery.APIRequestEntityTooLargeErrorResponse": {
+            "description": "API request entity too large error response structure",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Request entity too large"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 413
+                }
+            }
+        },
+        "delivery.APIResponse": {
+            "description": "API response wrapper",
+            "type": "object",
+            "properties": {
+                "body": {},
+                "status": {
+                    "type": "integer",
+                    "example": 200
+                }
+            }
+        },
+        "delivery.APIUnauthorizedErrorResponse": {
+            "description": "API unauthorized error response structure",
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string",
+                    "example": "Unauthorized"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 401
+                }
+            }
+        },
+        "delivery.Activity": {
+            "description": "What a followed user did: liked_track, liked_album, liked_artist, created_playlist or listening",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "entity_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "entity_thumbnail": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                },
+                "entity_thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "entity_title": {
+                    "type": "string",
+                    "example": "Hybrid Theory"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "liked_album"
+                },
+                "user": {
+                    "$ref": "#/definitions/delivery.UserPreview"
+                }
+            }
+        },
+        "delivery.AddTrackToPlaylistRequest": {
+            "description": "Add track to playlist request structure",
+            "type": "object",
+            "properties": {
+                "track_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.Album": {
+            "description": "A music album entity",
+            "type": "object",
+            "properties": {
+                "artists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.AlbumArtist"
+                    }
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_liked": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "release_date": {
+                    "type": "string",
+                    "example": "2021-01-01"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "published"
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "thumbnail_url": {
+                    "type": "string",
+                    "example": "https://example.com/album.jpg"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Anticyclone"
+                },
+                "type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/delivery.AlbumType"
+                        }
+                    ],
+                    "example": "album"
+                }
+            }
+        },
+        "delivery.AlbumArtist": {
+            "description": "An artist associated with an album",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Inabakumori"
+                }
+            }
+        },
+        "delivery.AlbumLikeRequest": {
+            "type": "object",
+            "properties": {
+                "value": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "delivery.AlbumType": {
+            "type": "string",
+            "enum": [
+                "album",
+                "ep",
+                "compilation",
+                "single"
+            ],
+            "x-enum-varnames": [
+                "AlbumTypeAlbum",
+                "AlbumTypeEP",
+                "AlbumTypeCompilation",
+                "AlbumTypeSingle"
+            ]
+        },
+        "delivery.Artist": {
+            "description": "A music artist entity",
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Inabakumori is a Japanese artist"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_liked": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "thumbnail_url": {
+                    "type": "string",
+                    "example": "https://example.com/artist.jpg"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Inabakumori"
+                }
+            }
+        },
+        "delivery.ArtistDetailed": {
+            "description": "A detailed music artist entity",
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Inabakumori is a Japanese artist"
+                },
+                "favorites_count": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "is_liked": {
+                    "type": "boolean",
+                    "example": false
+                },
+                "listeners_count": {
+                    "type": "integer",
+                    "example": 1000
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "thumbnail_url": {
+                    "type": "string",
+                    "example": "https://example.com/artist.jpg"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Inabakumori"
+                }
+            }
+        },
+        "delivery.ArtistLikeRequest": {
+            "description": "A request to like or unlike an artist. Should be authenticated",
+            "type": "object",
+            "properties": {
+                "value": {
+                    "type": "boolean",
+                    "example": true
+                }
+            }
+        },
+        "delivery.AvatarURL": {
+            "type": "object",
+            "properties": {
+                "avatar_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "avatar_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.ChangeAlbumStatusRequest": {
+            "description": "Moves a release between draft, in_review, scheduled, published and taken_down. The release date is required to send a draft to review.",
+            "type": "object",
+            "properties": {
+                "release_date": {
+                    "type": "string",
+                    "example": "2025-06-01"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "in_review"
+                }
+            }
+        },
+        "delivery.CreateAlbumRequest": {
+            "description": "Album release, the cover and every track reference completed uploads",
+            "type": "object",
+            "properties": {
+                "artists_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "cover_upload_id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Album"
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.CreateTrackRequest"
+                    }
+                },
+                "type": {
+                    "type": "string",
+                    "example": "album"
+                }
+            }
+        },
+        "delivery.CreateJamPlaylistRequest": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.CreateJamPlaylistResponse": {
+            "type": "object",
+            "properties": {
+                "playlist_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "delivery.CreateTrackRequest": {
+            "type": "object",
+            "properties": {
+                "title": {
+                    "type": "string",
+                    "example": "Track"
+                },
+                "upload_id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
+                }
+            }
+        },
+        "delivery.CreateUploadRequest": {
+            "description": "Starts a resumable upload of a track (audio/mpeg) or a cover (image/jpeg, image/png, image/gif, image/webp)",
+            "type": "object",
+            "properties": {
+                "content_type": {
+                    "type": "string",
+                    "example": "audio/mpeg"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "track"
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 8388608
+                }
+            }
+        },
+        "delivery.DeleteAlbumRequest": {
+            "type": "object",
+            "properties": {
+                "album_id": {
+                    "type": "integer"
+                },
+                "label_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.DeleteArtistRequest": {
+            "type": "object",
+            "properties": {
+                "artist_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "delivery.DuplicateFlag": {
+            "description": "An uploaded track that sounds like a track already in the catalog, waiting for an admin to decide",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "matched_track": {
+                    "$ref": "#/definitions/delivery.DuplicateFlagTrack"
+                },
+                "similarity": {
+                    "type": "number",
+                    "example": 0.82
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "track": {
+                    "$ref": "#/definitions/delivery.DuplicateFlagTrack"
+                }
+            }
+        },
+        "delivery.DuplicateFlagTrack": {
+            "type": "object",
+            "properties": {
+                "album_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "label_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Track"
+                }
+            }
+        },
+        "delivery.EditAlbumRequest": {
+            "description": "Empty title and type keep the current ones, a completed cover upload swaps the cover of the album and its tracks",
+            "type": "object",
+            "properties": {
+                "cover_upload_id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Album"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "ep"
+                }
+            }
+        },
+        "delivery.EditAlbumTrackRequest": {
+            "description": "Position 0 keeps the track in place, other tracks are shifted when it moves. Artists replace every credit of the track when given.",
+            "type": "object",
+            "properties": {
+                "artists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.TrackArtistRole"
+                    }
                 },
-                "status": {
+                "position": {
                     "type": "integer",
-                    "example": 404
+                    "example": 2
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Track"
                 }
             }
         },
-        "delivery.APIRequestEntityTooLargeErrorResponse": {
-            "description": "API request entity too large error response structure",
+        "delivery.EditAlbumTracksRequest": {
+            "description": "Every track of the draft with its new title and position, tracks without artists are credited to the album artists",
             "type": "object",
             "properties": {
-                "error": {
-                    "type": "string",
-                    "example": "Request entity too large"
+                "artists_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
                 },
-                "status": {
-                    "type": "integer",
-                    "example": 413
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.EditTrackRequest"
+                    }
                 }
             }
         },
-        "delivery.APIResponse": {
-            "description": "API response wrapper",
+        "delivery.EditLabelRequest": {
             "type": "object",
             "properties": {
-                "body": {},
-                "status": {
+                "label_id": {
+                    "type": "integer"
+                },
+                "to_add": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "to_remove": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "delivery.EditTrackRequest": {
+            "type": "object",
+            "properties": {
+                "artists_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "id": {
                     "type": "integer",
-                    "example": 200
+                    "example": 1
+                },
+                "position": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Track"
                 }
             }
         },
-        "delivery.APIUnauthorizedErrorResponse": {
-            "description": "API unauthorized error response structure",
+        "delivery.ImageVariant": {
+            "description": "An image scaled to fit into a square box, smaller images are not upscaled",
             "type": "object",
             "properties": {
-                "error": {
+                "avif": {
                     "type": "string",
-                    "example": "Unauthorized"
+                    "example": "https://example.com/image/300.avif"
                 },
-                "status": {
+                "jpeg": {
+                    "type": "string",
+                    "example": "https://example.com/image/300.jpg"
+                },
+                "size": {
                     "type": "integer",
-                    "example": 401
+                    "example": 300
+                },
+                "webp": {
+                    "type": "string",
+                    "example": "https://example.com/image/300.webp"
                 }
             }
         },
-        "delivery.AddTrackToPlaylistRequest": {
-            "description": "Add track to playlist request structure",
+        "delivery.Images": {
+            "description": "Sizes and encodings of an image, missing for images uploaded before variants were generated",
             "type": "object",
             "properties": {
-                "track_id": {
-                    "type": "integer"
+                "dominant_color": {
+                    "type": "string",
+                    "example": "#1f2e3d"
+                },
+                "variants": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.ImageVariant"
+                    }
                 }
             }
         },
-        "delivery.Album": {
-            "description": "A music album entity",
+        "delivery.JamSession": {
+            "description": "A jam the user took part in, ended_at is empty while the room is still open",
             "type": "object",
             "properties": {
-                "artists": {
+                "duration": {
+                    "type": "integer",
+                    "example": 2700
+                },
+                "ended_at": {
+                    "type": "string",
+                    "example": "2025-01-01T01:00:00Z"
+                },
+                "host_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "host_username": {
+                    "type": "string",
+                    "example": "user"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "participants": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "room_id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "tracks": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "delivery.JamSessionDetails": {
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "example": 2700
+                },
+                "ended_at": {
+                    "type": "string",
+                    "example": "2025-01-01T01:00:00Z"
+                },
+                "host_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "host_username": {
+                    "type": "string",
+                    "example": "user"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "participant_list": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/delivery.AlbumArtist"
+                        "$ref": "#/definitions/delivery.JamSessionParticipant"
+                    }
+                },
+                "participants": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "room_id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
+                },
+                "started_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "track_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.JamSessionTrack"
                     }
                 },
+                "tracks": {
+                    "type": "integer",
+                    "example": 12
+                }
+            }
+        },
+        "delivery.JamSessionParticipant": {
+            "type": "object",
+            "properties": {
+                "avatar_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "avatar_url": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "is_liked": {
-                    "type": "boolean",
-                    "example": true
+                "joined_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
                 },
-                "release_date": {
+                "username": {
                     "type": "string",
-                    "example": "2021-01-01"
+                    "example": "user"
+                }
+            }
+        },
+        "delivery.JamSessionTrack": {
+            "description": "A track played in a jam, duration is how many seconds the room spent on it",
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "example": 185
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "played_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:03:05Z"
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
                 },
                 "thumbnail_url": {
                     "type": "string",
-                    "example": "https://example.com/album.jpg"
+                    "example": "https://example.com/image.jpg"
                 },
                 "title": {
                     "type": "string",
-                    "example": "Anticyclone"
+                    "example": "Numb"
+                }
+            }
+        },
+        "delivery.JamSummary": {
+            "type": "object",
+            "properties": {
+                "friend": {
+                    "type": "boolean"
                 },
-                "type": {
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/delivery.AlbumType"
-                        }
-                    ],
-                    "example": "album"
+                "has_password": {
+                    "type": "boolean"
+                },
+                "host_avatar_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "host_avatar_url": {
+                    "type": "string"
+                },
+                "host_id": {
+                    "type": "string"
+                },
+                "host_username": {
+                    "type": "string"
+                },
+                "listeners": {
+                    "type": "integer"
+                },
+                "paused": {
+                    "type": "boolean"
+                },
+                "private": {
+                    "type": "boolean"
+                },
+                "room_id": {
+                    "type": "string"
+                },
+                "track_id": {
+                    "type": "string"
                 }
             }
         },
-        "delivery.AlbumArtist": {
-            "description": "An artist associated with an album",
+        "delivery.Label": {
             "type": "object",
             "properties": {
                 "id": {
-                    "type": "integer",
-                    "example": 1
+                    "type": "integer"
                 },
-                "title": {
+                "label_name": {
+                    "type": "string"
+                },
+                "usernames": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "delivery.LabelAlbum": {
+            "description": "A release of the label in any status with all of its tracks",
+            "type": "object",
+            "properties": {
+                "album": {
+                    "$ref": "#/definitions/delivery.Album"
+                },
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Track"
+                    }
+                }
+            }
+        },
+        "delivery.LoginData": {
+            "description": "User login data. Either username or email must be provided along with required password (4-25 characters)",
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.LyricsLine": {
+            "description": "A line of lyrics, an empty line of synced lyrics is an instrumental break",
+            "type": "object",
+            "properties": {
+                "text": {
                     "type": "string",
-                    "example": "Inabakumori"
+                    "example": "First line of the song"
+                },
+                "time_ms": {
+                    "type": "integer",
+                    "example": 12500
                 }
             }
         },
-        "delivery.AlbumLikeRequest": {
+        "delivery.Message": {
+            "description": "Message for responses without data",
             "type": "object",
             "properties": {
-                "value": {
-                    "type": "boolean",
-                    "example": true
+                "msg": {
+                    "type": "string",
+                    "example": "object have been successfully created/updated"
                 }
             }
         },
-        "delivery.AlbumType": {
-            "type": "string",
-            "enum": [
-                "album",
-                "ep",
-                "compilation",
-                "single"
-            ],
-            "x-enum-varnames": [
-                "AlbumTypeAlbum",
-                "AlbumTypeEP",
-                "AlbumTypeCompilation",
-                "AlbumTypeSingle"
-            ]
-        },
-        "delivery.Artist": {
-            "description": "A music artist entity",
+        "delivery.Notification": {
+            "description": "Inbox entry. album_released comes from a liked artist, playlist_liked from someone liking your playlist",
             "type": "object",
             "properties": {
-                "description": {
+                "actor_name": {
                     "type": "string",
-                    "example": "Inabakumori is a Japanese artist"
+                    "example": "Linkin Park"
                 },
-                "id": {
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
+                "entity_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "is_liked": {
-                    "type": "boolean",
-                    "example": false
-                },
-                "thumbnail_url": {
+                "entity_thumbnail": {
                     "type": "string",
-                    "example": "https://example.com/artist.jpg"
+                    "example": "https://example.com/image.jpg"
                 },
-                "title": {
-                    "type": "string",
-                    "example": "Inabakumori"
-                }
-            }
-        },
-        "delivery.ArtistDetailed": {
-            "description": "A detailed music artist entity",
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string",
-                    "example": "Inabakumori is a Japanese artist"
+                "entity_thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
                 },
-                "favorites_count": {
-                    "type": "integer",
-                    "example": 1000
+                "entity_title": {
+                    "type": "string",
+                    "example": "Hybrid Theory"
                 },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "is_liked": {
+                "is_read": {
                     "type": "boolean",
                     "example": false
                 },
-                "listeners_count": {
-                    "type": "integer",
-                    "example": 1000
-                },
-                "thumbnail_url": {
-                    "type": "string",
-                    "example": "https://example.com/artist.jpg"
-                },
-                "title": {
+                "type": {
                     "type": "string",
-                    "example": "Inabakumori"
+                    "example": "album_released"
                 }
             }
         },
-        "delivery.ArtistLikeRequest": {
-            "description": "A request to like or unlike an artist. Should be authenticated",
+        "delivery.NotificationPreference": {
+            "description": "Whether notifications of a type are delivered",
             "type": "object",
             "properties": {
-                "value": {
+                "is_enabled": {
                     "type": "boolean",
                     "example": true
+                },
+                "type": {
+                    "type": "string",
+                    "example": "playlist_liked"
                 }
             }
         },
-        "delivery.AvatarURL": {
+        "delivery.NotificationPreferences": {
+            "description": "Notification settings of the current user",
             "type": "object",
             "properties": {
-                "avatar_url": {
-                    "type": "string"
+                "preferences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.NotificationPreference"
+                    }
                 }
             }
         },
-        "delivery.DeleteAlbumRequest": {
+        "delivery.Playlist": {
+            "description": "Playlist structure",
             "type": "object",
             "properties": {
-                "album_id": {
+                "id": {
                     "type": "integer"
                 },
-                "label_id": {
-                    "type": "integer"
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "delivery.DeleteArtistRequest": {
+        "delivery.PlaylistLikeRequest": {
+            "description": "A request to like or unlike an playlist. Should be authenticated",
             "type": "object",
             "properties": {
-                "artist_id": {
-                    "type": "integer"
+                "value": {
+                    "type": "boolean",
+                    "example": true
                 }
             }
         },
-        "delivery.EditLabelRequest": {
+        "delivery.PlaylistWithIsIncludedTrack": {
+            "description": "Playlist with is included track structure",
             "type": "object",
             "properties": {
-                "label_id": {
+                "id": {
                     "type": "integer"
                 },
-                "to_add": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "is_included": {
+                    "type": "boolean"
                 },
-                "to_remove": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "thumbnail_url": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
                 }
             }
         },
-        "delivery.Label": {
+        "delivery.PlaylistWithIsLiked": {
+            "description": "Playlist with is liked structure",
             "type": "object",
             "properties": {
                 "id": {
                     "type": "integer"
                 },
-                "label_name": {
+                "is_liked": {
+                    "type": "boolean"
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "thumbnail_url": {
                     "type": "string"
                 },
-                "usernames": {
+                "title": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "delivery.PreviewAlbumRequest": {
+            "description": "Completed track uploads to read the embedded tags of",
+            "type": "object",
+            "properties": {
+                "upload_ids": {
                     "type": "array",
                     "items": {
                         "type": "string"
@@ -3700,8 +7351,31 @@ const docTemplate = `{
                 }
             }
         },
-        "delivery.LoginData": {
-            "description": "User login data. Either username or email must be provided along with required password (4-25 characters)",
+        "delivery.Privacy": {
+            "type": "object",
+            "properties": {
+                "is_public_artists_listened": {
+                    "type": "boolean"
+                },
+                "is_public_favorite_artists": {
+                    "type": "boolean"
+                },
+                "is_public_favorite_tracks": {
+                    "type": "boolean"
+                },
+                "is_public_minutes_listened": {
+                    "type": "boolean"
+                },
+                "is_public_playlists": {
+                    "type": "boolean"
+                },
+                "is_public_tracks_listened": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "delivery.RegisterData": {
+            "description": "User registration data requiring username (3-20 characters), password (4-25 characters), and valid email (5-30 characters)",
             "type": "object",
             "properties": {
                 "email": {
@@ -3715,121 +7389,186 @@ const docTemplate = `{
                 }
             }
         },
-        "delivery.Message": {
-            "description": "Message for responses without data",
+        "delivery.Release": {
+            "description": "New album or track from an artist the user liked. Tracks of a new album are listed only through the album",
             "type": "object",
             "properties": {
-                "msg": {
+                "album_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "artists": {
                     "type": "string",
-                    "example": "object have been successfully created/updated"
-                }
-            }
-        },
-        "delivery.Playlist": {
-            "description": "Playlist structure",
-            "type": "object",
-            "properties": {
+                    "example": "Linkin Park"
+                },
+                "created_at": {
+                    "type": "string",
+                    "example": "2025-01-01T00:00:00Z"
+                },
                 "id": {
-                    "type": "integer"
+                    "type": "integer",
+                    "example": 1
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
                 },
                 "thumbnail_url": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
                 },
                 "title": {
-                    "type": "string"
+                    "type": "string",
+                    "example": "Hybrid Theory"
                 },
-                "username": {
-                    "type": "string"
+                "type": {
+                    "type": "string",
+                    "example": "album"
                 }
             }
         },
-        "delivery.PlaylistLikeRequest": {
-            "description": "A request to like or unlike an playlist. Should be authenticated",
+        "delivery.ReplaceTrackAudioRequest": {
             "type": "object",
             "properties": {
-                "value": {
-                    "type": "boolean",
-                    "example": true
+                "upload_id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
                 }
             }
         },
-        "delivery.PlaylistWithIsIncludedTrack": {
-            "description": "Playlist with is included track structure",
+        "delivery.ResolveDuplicateFlagRequest": {
+            "description": "Confirmed flags stay on record as duplicates, dismissed ones were a false match",
             "type": "object",
             "properties": {
-                "id": {
+                "status": {
+                    "type": "string",
+                    "example": "dismissed"
+                }
+            }
+        },
+        "delivery.SearchAnalytics": {
+            "description": "Aggregated search analytics",
+            "type": "object",
+            "properties": {
+                "click_through_rate": {
+                    "type": "number"
+                },
+                "top_queries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.SearchQueryStat"
+                    }
+                },
+                "total_clicks": {
                     "type": "integer"
                 },
-                "is_included": {
-                    "type": "boolean"
+                "total_searches": {
+                    "type": "integer"
                 },
-                "thumbnail_url": {
-                    "type": "string"
+                "zero_result_queries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.SearchQueryStat"
+                    }
+                }
+            }
+        },
+        "delivery.SearchClick": {
+            "description": "Search result the user opened",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "title": {
-                    "type": "string"
+                "query": {
+                    "type": "string",
+                    "example": "linkin park"
                 },
-                "username": {
-                    "type": "string"
+                "type": {
+                    "type": "string",
+                    "example": "artists"
                 }
             }
         },
-        "delivery.PlaylistWithIsLiked": {
-            "description": "Playlist with is liked structure",
+        "delivery.SearchQueryStat": {
+            "description": "Aggregated statistics for a normalized query",
             "type": "object",
             "properties": {
-                "id": {
-                    "type": "integer"
+                "click_through_rate": {
+                    "type": "number",
+                    "example": 0.4
                 },
-                "is_liked": {
-                    "type": "boolean"
+                "clicks": {
+                    "type": "integer",
+                    "example": 17
                 },
-                "thumbnail_url": {
-                    "type": "string"
+                "count": {
+                    "type": "integer",
+                    "example": 42
                 },
-                "title": {
-                    "type": "string"
+                "query": {
+                    "type": "string",
+                    "example": "linkin park"
                 },
-                "username": {
-                    "type": "string"
+                "top_results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.SearchResultStat"
+                    }
                 }
             }
         },
-        "delivery.Privacy": {
+        "delivery.SearchResult": {
+            "description": "Unified search result grouped by entity type",
             "type": "object",
             "properties": {
-                "is_public_artists_listened": {
-                    "type": "boolean"
+                "albums": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Album"
+                    }
                 },
-                "is_public_favorite_artists": {
-                    "type": "boolean"
+                "artists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Artist"
+                    }
                 },
-                "is_public_favorite_tracks": {
+                "layout_corrected": {
                     "type": "boolean"
                 },
-                "is_public_minutes_listened": {
-                    "type": "boolean"
+                "playlists": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Playlist"
+                    }
                 },
-                "is_public_playlists": {
-                    "type": "boolean"
+                "query": {
+                    "type": "string"
                 },
-                "is_public_tracks_listened": {
-                    "type": "boolean"
+                "tracks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Track"
+                    }
                 }
             }
         },
-        "delivery.RegisterData": {
-            "description": "User registration data requiring username (3-20 characters), password (4-25 characters), and valid email (5-30 characters)",
+        "delivery.SearchResultStat": {
+            "description": "Search result opened from a query",
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
+                "clicks": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "password": {
-                    "type": "string"
+                "id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "username": {
-                    "type": "string"
+                "type": {
+                    "type": "string",
+                    "example": "artists"
                 }
             }
         },
@@ -3866,6 +7605,49 @@ const docTemplate = `{
                 }
             }
         },
+        "delivery.SuggestResult": {
+            "description": "Autocomplete suggestions for a search prefix",
+            "type": "object",
+            "properties": {
+                "layout_corrected": {
+                    "type": "boolean"
+                },
+                "query": {
+                    "type": "string"
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.Suggestion"
+                    }
+                }
+            }
+        },
+        "delivery.Suggestion": {
+            "description": "Single autocomplete suggestion",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "thumbnail_url": {
+                    "type": "string",
+                    "example": "https://example.com/image.jpg"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Linkin Park"
+                },
+                "type": {
+                    "type": "string",
+                    "example": "artists"
+                }
+            }
+        },
         "delivery.Track": {
             "description": "A music track entity",
             "type": "object",
@@ -3883,6 +7665,10 @@ const docTemplate = `{
                         "$ref": "#/definitions/delivery.TrackArtist"
                     }
                 },
+                "bpm": {
+                    "type": "number",
+                    "example": 124.5
+                },
                 "duration": {
                     "type": "integer",
                     "example": 216
@@ -3895,6 +7681,9 @@ const docTemplate = `{
                     "type": "boolean",
                     "example": false
                 },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
                 "thumbnail_url": {
                     "type": "string",
                     "example": "https://example.com/image.jpg"
@@ -3923,6 +7712,19 @@ const docTemplate = `{
                 }
             }
         },
+        "delivery.TrackArtistRole": {
+            "type": "object",
+            "properties": {
+                "artist_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "role": {
+                    "type": "string",
+                    "example": "featured"
+                }
+            }
+        },
         "delivery.TrackDetailed": {
             "type": "object",
             "properties": {
@@ -3939,6 +7741,10 @@ const docTemplate = `{
                         "$ref": "#/definitions/delivery.TrackArtist"
                     }
                 },
+                "bpm": {
+                    "type": "number",
+                    "example": 124.5
+                },
                 "duration": {
                     "type": "integer",
                     "example": 216
@@ -3947,6 +7753,10 @@ const docTemplate = `{
                     "type": "string",
                     "example": "https://example.com/track.mp3"
                 },
+                "has_lyrics": {
+                    "type": "boolean",
+                    "example": true
+                },
                 "id": {
                     "type": "integer",
                     "example": 1
@@ -3955,6 +7765,27 @@ const docTemplate = `{
                     "type": "boolean",
                     "example": false
                 },
+                "key": {
+                    "type": "string",
+                    "example": "A minor"
+                },
+                "loudness_lufs": {
+                    "type": "number",
+                    "example": -9.2
+                },
+                "renditions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.TrackRendition"
+                    }
+                },
+                "replay_gain_db": {
+                    "type": "number",
+                    "example": -8.8
+                },
+                "thumbnail_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
                 "thumbnail_url": {
                     "type": "string",
                     "example": "https://example.com/image.jpg"
@@ -3975,6 +7806,209 @@ const docTemplate = `{
                 }
             }
         },
+        "delivery.TrackLyrics": {
+            "description": "Lyrics of a track in one language, synced lyrics have the time of every line for karaoke-style display",
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/delivery.LyricsLine"
+                    }
+                },
+                "synced": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "translation": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "delivery.TrackLyricsRequest": {
+            "description": "Lyrics are plain text or LRC with a time for every line, uploading a language again replaces its lyrics",
+            "type": "object",
+            "properties": {
+                "language": {
+                    "type": "string",
+                    "example": "en"
+                },
+                "lyrics": {
+                    "type": "string",
+                    "example": "[00:12.50]First line of the song"
+                },
+                "translation": {
+                    "type": "boolean",
+                    "example": false
+                }
+            }
+        },
+        "delivery.TrackMetadata": {
+            "description": "Embedded tags of an uploaded track to prefill the release with, artist_ids are the label artists whose names match the tagged artists",
+            "type": "object",
+            "properties": {
+                "artist_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                },
+                "artists": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "cover_url": {
+                    "type": "string",
+                    "example": "https://example.com/uploads/cover"
+                },
+                "format": {
+                    "type": "string",
+                    "example": "mp3"
+                },
+                "genre": {
+                    "type": "string",
+                    "example": "Rock"
+                },
+                "isrc": {
+                    "type": "string",
+                    "example": "USRC17607839"
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Track"
+                },
+                "track_number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "upload_id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
+                },
+                "year": {
+                    "type": "integer",
+                    "example": 2025
+                }
+            }
+        },
+        "delivery.TrackRendition": {
+            "description": "A track file encoded with a specific codec and bitrate",
+            "type": "object",
+            "properties": {
+                "bitrate": {
+                    "type": "integer",
+                    "example": 160
+                },
+                "codec": {
+                    "type": "string",
+                    "example": "opus"
+                },
+                "file_url": {
+                    "type": "string",
+                    "example": "https://example.com/track-opus-160.ogg"
+                }
+            }
+        },
+        "delivery.TrackWaveform": {
+            "description": "Peak amplitudes from 0 to 1 spread evenly over the duration of a track",
+            "type": "object",
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "example": 216
+                },
+                "peaks": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    },
+                    "example": [
+                        0.12,
+                        0.5,
+                        0.98
+                    ]
+                }
+            }
+        },
+        "delivery.UnreadNotificationsCount": {
+            "description": "Number of unread notifications",
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "delivery.Upload": {
+            "description": "Upload session state, uploaded_parts lists the parts the storage already has so a client can resume",
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string",
+                    "example": "0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "track"
+                },
+                "part_size": {
+                    "type": "integer",
+                    "example": 8388608
+                },
+                "parts_count": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "size": {
+                    "type": "integer",
+                    "example": 8388608
+                },
+                "status": {
+                    "type": "string",
+                    "example": "pending"
+                },
+                "uploaded_parts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
+        "delivery.UploadPart": {
+            "description": "Presigned URL the client PUTs the part body to",
+            "type": "object",
+            "properties": {
+                "number": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "url": {
+                    "type": "string",
+                    "example": "https://bucket.s3.cloud.ru/key?partNumber=1\u0026uploadId=..."
+                }
+            }
+        },
+        "delivery.UploadPartsRequest": {
+            "description": "Part numbers to sign, an empty list signs every part that is not uploaded yet",
+            "type": "object",
+            "properties": {
+                "parts": {
+                    "type": "array",
+                    "items": {
+                        "type": "integer"
+                    }
+                }
+            }
+        },
         "delivery.UserChangeSettings": {
             "type": "object",
             "properties": {
@@ -4012,6 +8046,9 @@ const docTemplate = `{
         "delivery.UserFullData": {
             "type": "object",
             "properties": {
+                "avatar_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
                 "avatar_url": {
                     "type": "string"
                 },
@@ -4029,10 +8066,30 @@ const docTemplate = `{
                 }
             }
         },
+        "delivery.UserPreview": {
+            "description": "Public user data",
+            "type": "object",
+            "properties": {
+                "avatar_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
+                "avatar_url": {
+                    "type": "string",
+                    "example": "https://example.com/avatar.jpg"
+                },
+                "username": {
+                    "type": "string",
+                    "example": "user123"
+                }
+            }
+        },
         "delivery.UserToFront": {
             "description": "User data",
             "type": "object",
             "properties": {
+                "avatar_images": {
+                    "$ref": "#/definitions/delivery.Images"
+                },
                 "avatar_url": {
                     "type": "string"
                 },
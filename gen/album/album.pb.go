@@ -74,19 +74,296 @@ func (AlbumType) EnumDescriptor() ([]byte, []int) {
 	return file_album_album_proto_rawDescGZIP(), []int{0}
 }
 
+type LabelAlbumRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlbumId *AlbumID `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	LabelId int64    `protobuf:"varint,2,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	IsAdmin bool     `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+}
+
+func (x *LabelAlbumRequest) Reset() {
+	*x = LabelAlbumRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_album_album_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LabelAlbumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LabelAlbumRequest) ProtoMessage() {}
+
+func (x *LabelAlbumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_album_album_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LabelAlbumRequest.ProtoReflect.Descriptor instead.
+func (*LabelAlbumRequest) Descriptor() ([]byte, []int) {
+	return file_album_album_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *LabelAlbumRequest) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *LabelAlbumRequest) GetLabelId() int64 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *LabelAlbumRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+type FiltersWithStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Filters *Filters `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	Status  string   `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *FiltersWithStatus) Reset() {
+	*x = FiltersWithStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_album_album_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FiltersWithStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FiltersWithStatus) ProtoMessage() {}
+
+func (x *FiltersWithStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_album_album_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FiltersWithStatus.ProtoReflect.Descriptor instead.
+func (*FiltersWithStatus) Descriptor() ([]byte, []int) {
+	return file_album_album_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FiltersWithStatus) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+func (x *FiltersWithStatus) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type ChangeAlbumStatusRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlbumId     *AlbumID               `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	LabelId     int64                  `protobuf:"varint,2,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	IsAdmin     bool                   `protobuf:"varint,3,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Status      string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+}
+
+func (x *ChangeAlbumStatusRequest) Reset() {
+	*x = ChangeAlbumStatusRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_album_album_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChangeAlbumStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAlbumStatusRequest) ProtoMessage() {}
+
+func (x *ChangeAlbumStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_album_album_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAlbumStatusRequest.ProtoReflect.Descriptor instead.
+func (*ChangeAlbumStatusRequest) Descriptor() ([]byte, []int) {
+	return file_album_album_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *ChangeAlbumStatusRequest) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *ChangeAlbumStatusRequest) GetLabelId() int64 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *ChangeAlbumStatusRequest) GetIsAdmin() bool {
+	if x != nil {
+		return x.IsAdmin
+	}
+	return false
+}
+
+func (x *ChangeAlbumStatusRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ChangeAlbumStatusRequest) GetReleaseDate() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReleaseDate
+	}
+	return nil
+}
+
+type EditAlbumRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlbumId  *AlbumID  `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	LabelId  int64     `protobuf:"varint,2,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	Title    string    `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Type     AlbumType `protobuf:"varint,4,opt,name=type,proto3,enum=album.AlbumType" json:"type,omitempty"`
+	ImageKey string    `protobuf:"bytes,5,opt,name=image_key,json=imageKey,proto3" json:"image_key,omitempty"`
+}
+
+func (x *EditAlbumRequest) Reset() {
+	*x = EditAlbumRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_album_album_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EditAlbumRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EditAlbumRequest) ProtoMessage() {}
+
+func (x *EditAlbumRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_album_album_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EditAlbumRequest.ProtoReflect.Descriptor instead.
+func (*EditAlbumRequest) Descriptor() ([]byte, []int) {
+	return file_album_album_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *EditAlbumRequest) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *EditAlbumRequest) GetLabelId() int64 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *EditAlbumRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *EditAlbumRequest) GetType() AlbumType {
+	if x != nil {
+		return x.Type
+	}
+	return AlbumType_AlbumTypeAlbum
+}
+
+func (x *EditAlbumRequest) GetImageKey() string {
+	if x != nil {
+		return x.ImageKey
+	}
+	return ""
+}
+
 type AlbumIDAndURL struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id  int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Url            string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	ThumbnailColor string `protobuf:"bytes,3,opt,name=thumbnail_color,json=thumbnailColor,proto3" json:"thumbnail_color,omitempty"`
 }
 
 func (x *AlbumIDAndURL) Reset() {
 	*x = AlbumIDAndURL{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[0]
+		mi := &file_album_album_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -99,7 +376,7 @@ func (x *AlbumIDAndURL) String() string {
 func (*AlbumIDAndURL) ProtoMessage() {}
 
 func (x *AlbumIDAndURL) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[0]
+	mi := &file_album_album_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -112,7 +389,7 @@ func (x *AlbumIDAndURL) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumIDAndURL.ProtoReflect.Descriptor instead.
 func (*AlbumIDAndURL) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{0}
+	return file_album_album_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *AlbumIDAndURL) GetId() int64 {
@@ -129,6 +406,13 @@ func (x *AlbumIDAndURL) GetUrl() string {
 	return ""
 }
 
+func (x *AlbumIDAndURL) GetThumbnailColor() string {
+	if x != nil {
+		return x.ThumbnailColor
+	}
+	return ""
+}
+
 type FiltersWithLabelID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -141,7 +425,7 @@ type FiltersWithLabelID struct {
 func (x *FiltersWithLabelID) Reset() {
 	*x = FiltersWithLabelID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[1]
+		mi := &file_album_album_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -154,7 +438,7 @@ func (x *FiltersWithLabelID) String() string {
 func (*FiltersWithLabelID) ProtoMessage() {}
 
 func (x *FiltersWithLabelID) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[1]
+	mi := &file_album_album_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -167,7 +451,7 @@ func (x *FiltersWithLabelID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiltersWithLabelID.ProtoReflect.Descriptor instead.
 func (*FiltersWithLabelID) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{1}
+	return file_album_album_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *FiltersWithLabelID) GetFilters() *Filters {
@@ -189,16 +473,16 @@ type CreateAlbumRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title   string    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Type    AlbumType `protobuf:"varint,2,opt,name=type,proto3,enum=album.AlbumType" json:"type,omitempty"`
-	Image   []byte    `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
-	LabelId int64     `protobuf:"varint,4,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	Title    string    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Type     AlbumType `protobuf:"varint,2,opt,name=type,proto3,enum=album.AlbumType" json:"type,omitempty"`
+	LabelId  int64     `protobuf:"varint,4,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	ImageKey string    `protobuf:"bytes,5,opt,name=image_key,json=imageKey,proto3" json:"image_key,omitempty"`
 }
 
 func (x *CreateAlbumRequest) Reset() {
 	*x = CreateAlbumRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[2]
+		mi := &file_album_album_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -211,7 +495,7 @@ func (x *CreateAlbumRequest) String() string {
 func (*CreateAlbumRequest) ProtoMessage() {}
 
 func (x *CreateAlbumRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[2]
+	mi := &file_album_album_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -224,7 +508,7 @@ func (x *CreateAlbumRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateAlbumRequest.ProtoReflect.Descriptor instead.
 func (*CreateAlbumRequest) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{2}
+	return file_album_album_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *CreateAlbumRequest) GetTitle() string {
@@ -241,18 +525,18 @@ func (x *CreateAlbumRequest) GetType() AlbumType {
 	return AlbumType_AlbumTypeAlbum
 }
 
-func (x *CreateAlbumRequest) GetImage() []byte {
+func (x *CreateAlbumRequest) GetLabelId() int64 {
 	if x != nil {
-		return x.Image
+		return x.LabelId
 	}
-	return nil
+	return 0
 }
 
-func (x *CreateAlbumRequest) GetLabelId() int64 {
+func (x *CreateAlbumRequest) GetImageKey() string {
 	if x != nil {
-		return x.LabelId
+		return x.ImageKey
 	}
-	return 0
+	return ""
 }
 
 type Query struct {
@@ -260,14 +544,15 @@ type Query struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query  string  `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	UserId *UserID `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query   string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filters *Filters `protobuf:"bytes,3,opt,name=filters,proto3" json:"filters,omitempty"`
 }
 
 func (x *Query) Reset() {
 	*x = Query{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[3]
+		mi := &file_album_album_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -280,7 +565,7 @@ func (x *Query) String() string {
 func (*Query) ProtoMessage() {}
 
 func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[3]
+	mi := &file_album_album_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -293,7 +578,7 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Query.ProtoReflect.Descriptor instead.
 func (*Query) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{3}
+	return file_album_album_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *Query) GetQuery() string {
@@ -310,6 +595,13 @@ func (x *Query) GetUserId() *UserID {
 	return nil
 }
 
+func (x *Query) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type UserID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -321,7 +613,7 @@ type UserID struct {
 func (x *UserID) Reset() {
 	*x = UserID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[4]
+		mi := &file_album_album_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -334,7 +626,7 @@ func (x *UserID) String() string {
 func (*UserID) ProtoMessage() {}
 
 func (x *UserID) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[4]
+	mi := &file_album_album_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -347,7 +639,7 @@ func (x *UserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserID.ProtoReflect.Descriptor instead.
 func (*UserID) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{4}
+	return file_album_album_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *UserID) GetId() int64 {
@@ -368,7 +660,7 @@ type AlbumID struct {
 func (x *AlbumID) Reset() {
 	*x = AlbumID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[5]
+		mi := &file_album_album_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -381,7 +673,7 @@ func (x *AlbumID) String() string {
 func (*AlbumID) ProtoMessage() {}
 
 func (x *AlbumID) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[5]
+	mi := &file_album_album_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -394,7 +686,7 @@ func (x *AlbumID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumID.ProtoReflect.Descriptor instead.
 func (*AlbumID) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{5}
+	return file_album_album_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AlbumID) GetId() int64 {
@@ -416,7 +708,7 @@ type AlbumIDWithUserID struct {
 func (x *AlbumIDWithUserID) Reset() {
 	*x = AlbumIDWithUserID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[6]
+		mi := &file_album_album_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -429,7 +721,7 @@ func (x *AlbumIDWithUserID) String() string {
 func (*AlbumIDWithUserID) ProtoMessage() {}
 
 func (x *AlbumIDWithUserID) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[6]
+	mi := &file_album_album_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -442,7 +734,7 @@ func (x *AlbumIDWithUserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumIDWithUserID.ProtoReflect.Descriptor instead.
 func (*AlbumIDWithUserID) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{6}
+	return file_album_album_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *AlbumIDWithUserID) GetAlbumId() *AlbumID {
@@ -470,7 +762,7 @@ type AlbumIDList struct {
 func (x *AlbumIDList) Reset() {
 	*x = AlbumIDList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[7]
+		mi := &file_album_album_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -483,7 +775,7 @@ func (x *AlbumIDList) String() string {
 func (*AlbumIDList) ProtoMessage() {}
 
 func (x *AlbumIDList) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[7]
+	mi := &file_album_album_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -496,7 +788,7 @@ func (x *AlbumIDList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumIDList.ProtoReflect.Descriptor instead.
 func (*AlbumIDList) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{7}
+	return file_album_album_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *AlbumIDList) GetIds() []*AlbumID {
@@ -518,7 +810,7 @@ type AlbumIDListWithUserID struct {
 func (x *AlbumIDListWithUserID) Reset() {
 	*x = AlbumIDListWithUserID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[8]
+		mi := &file_album_album_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -531,7 +823,7 @@ func (x *AlbumIDListWithUserID) String() string {
 func (*AlbumIDListWithUserID) ProtoMessage() {}
 
 func (x *AlbumIDListWithUserID) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[8]
+	mi := &file_album_album_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -544,7 +836,7 @@ func (x *AlbumIDListWithUserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumIDListWithUserID.ProtoReflect.Descriptor instead.
 func (*AlbumIDListWithUserID) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{8}
+	return file_album_album_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *AlbumIDListWithUserID) GetIds() *AlbumIDList {
@@ -566,18 +858,20 @@ type Album struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Type        AlbumType              `protobuf:"varint,3,opt,name=type,proto3,enum=album.AlbumType" json:"type,omitempty"`
-	Thumbnail   string                 `protobuf:"bytes,4,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	ReleaseDate *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
-	IsFavorite  bool                   `protobuf:"varint,6,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string                 `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Type           AlbumType              `protobuf:"varint,3,opt,name=type,proto3,enum=album.AlbumType" json:"type,omitempty"`
+	Thumbnail      string                 `protobuf:"bytes,4,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	ReleaseDate    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=release_date,json=releaseDate,proto3" json:"release_date,omitempty"`
+	IsFavorite     bool                   `protobuf:"varint,6,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	Status         string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	ThumbnailColor string                 `protobuf:"bytes,8,opt,name=thumbnail_color,json=thumbnailColor,proto3" json:"thumbnail_color,omitempty"`
 }
 
 func (x *Album) Reset() {
 	*x = Album{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[9]
+		mi := &file_album_album_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -590,7 +884,7 @@ func (x *Album) String() string {
 func (*Album) ProtoMessage() {}
 
 func (x *Album) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[9]
+	mi := &file_album_album_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -603,7 +897,7 @@ func (x *Album) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Album.ProtoReflect.Descriptor instead.
 func (*Album) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{9}
+	return file_album_album_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *Album) GetId() int64 {
@@ -648,6 +942,20 @@ func (x *Album) GetIsFavorite() bool {
 	return false
 }
 
+func (x *Album) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Album) GetThumbnailColor() string {
+	if x != nil {
+		return x.ThumbnailColor
+	}
+	return ""
+}
+
 type AlbumList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -659,7 +967,7 @@ type AlbumList struct {
 func (x *AlbumList) Reset() {
 	*x = AlbumList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[10]
+		mi := &file_album_album_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -672,7 +980,7 @@ func (x *AlbumList) String() string {
 func (*AlbumList) ProtoMessage() {}
 
 func (x *AlbumList) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[10]
+	mi := &file_album_album_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -685,7 +993,7 @@ func (x *AlbumList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumList.ProtoReflect.Descriptor instead.
 func (*AlbumList) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{10}
+	return file_album_album_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AlbumList) GetAlbums() []*Album {
@@ -706,7 +1014,7 @@ type AlbumTitle struct {
 func (x *AlbumTitle) Reset() {
 	*x = AlbumTitle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[11]
+		mi := &file_album_album_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -719,7 +1027,7 @@ func (x *AlbumTitle) String() string {
 func (*AlbumTitle) ProtoMessage() {}
 
 func (x *AlbumTitle) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[11]
+	mi := &file_album_album_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,7 +1040,7 @@ func (x *AlbumTitle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumTitle.ProtoReflect.Descriptor instead.
 func (*AlbumTitle) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{11}
+	return file_album_album_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AlbumTitle) GetTitle() string {
@@ -753,7 +1061,7 @@ type AlbumTitleMap struct {
 func (x *AlbumTitleMap) Reset() {
 	*x = AlbumTitleMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[12]
+		mi := &file_album_album_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -766,7 +1074,7 @@ func (x *AlbumTitleMap) String() string {
 func (*AlbumTitleMap) ProtoMessage() {}
 
 func (x *AlbumTitleMap) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[12]
+	mi := &file_album_album_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -779,7 +1087,7 @@ func (x *AlbumTitleMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumTitleMap.ProtoReflect.Descriptor instead.
 func (*AlbumTitleMap) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{12}
+	return file_album_album_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AlbumTitleMap) GetTitles() map[int64]*AlbumTitle {
@@ -801,7 +1109,7 @@ type Pagination struct {
 func (x *Pagination) Reset() {
 	*x = Pagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[13]
+		mi := &file_album_album_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -814,7 +1122,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[13]
+	mi := &file_album_album_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -827,7 +1135,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{13}
+	return file_album_album_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *Pagination) GetOffset() int64 {
@@ -855,7 +1163,7 @@ type Filters struct {
 func (x *Filters) Reset() {
 	*x = Filters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[14]
+		mi := &file_album_album_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -868,7 +1176,7 @@ func (x *Filters) String() string {
 func (*Filters) ProtoMessage() {}
 
 func (x *Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[14]
+	mi := &file_album_album_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -881,7 +1189,7 @@ func (x *Filters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filters.ProtoReflect.Descriptor instead.
 func (*Filters) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{14}
+	return file_album_album_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *Filters) GetPagination() *Pagination {
@@ -903,7 +1211,7 @@ type FiltersWithUserID struct {
 func (x *FiltersWithUserID) Reset() {
 	*x = FiltersWithUserID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[15]
+		mi := &file_album_album_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -916,7 +1224,7 @@ func (x *FiltersWithUserID) String() string {
 func (*FiltersWithUserID) ProtoMessage() {}
 
 func (x *FiltersWithUserID) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[15]
+	mi := &file_album_album_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1237,7 @@ func (x *FiltersWithUserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiltersWithUserID.ProtoReflect.Descriptor instead.
 func (*FiltersWithUserID) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{15}
+	return file_album_album_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *FiltersWithUserID) GetFilters() *Filters {
@@ -958,7 +1266,7 @@ type AlbumStreamCreateData struct {
 func (x *AlbumStreamCreateData) Reset() {
 	*x = AlbumStreamCreateData{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[16]
+		mi := &file_album_album_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -971,7 +1279,7 @@ func (x *AlbumStreamCreateData) String() string {
 func (*AlbumStreamCreateData) ProtoMessage() {}
 
 func (x *AlbumStreamCreateData) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[16]
+	mi := &file_album_album_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -984,7 +1292,7 @@ func (x *AlbumStreamCreateData) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumStreamCreateData.ProtoReflect.Descriptor instead.
 func (*AlbumStreamCreateData) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{16}
+	return file_album_album_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *AlbumStreamCreateData) GetAlbumId() *AlbumID {
@@ -1014,7 +1322,7 @@ type LikeRequest struct {
 func (x *LikeRequest) Reset() {
 	*x = LikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_album_album_proto_msgTypes[17]
+		mi := &file_album_album_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1027,7 +1335,7 @@ func (x *LikeRequest) String() string {
 func (*LikeRequest) ProtoMessage() {}
 
 func (x *LikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_album_album_proto_msgTypes[17]
+	mi := &file_album_album_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1348,7 @@ func (x *LikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeRequest.ProtoReflect.Descriptor instead.
 func (*LikeRequest) Descriptor() ([]byte, []int) {
-	return file_album_album_proto_rawDescGZIP(), []int{17}
+	return file_album_album_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *LikeRequest) GetAlbumId() *AlbumID {
@@ -1072,29 +1380,72 @@ var file_album_album_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70,
-	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x31, 0x0a, 0x0d, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x49, 0x44, 0x41, 0x6e, 0x64, 0x55, 0x52, 0x4c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x59, 0x0a, 0x12, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49,
-	0x44, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61,
-	0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x45, 0x0a, 0x05, 0x51, 0x75,
-	0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65,
-	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62,
-	0x75, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
-	0x64, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x74, 0x0a, 0x11, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a,
+	0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52,
+	0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x55,
+	0x0a, 0x11, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x18, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x61,
+	0x64, 0x6d, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x41, 0x64,
+	0x6d, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x3d, 0x0a, 0x0c, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72,
+	0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x22, 0xb1, 0x01, 0x0a, 0x10, 0x45,
+	0x64, 0x69, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49,
+	0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x22, 0x5a,
+	0x0a, 0x0d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x41, 0x6e, 0x64, 0x55, 0x52, 0x4c, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72,
+	0x6c, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x63,
+	0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x59, 0x0a, 0x12, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44,
+	0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x88, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65,
+	0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79,
+	0x22, 0x6f, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65,
+	0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12,
+	0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
 	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x07, 0x41,
 	0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x11, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49,
@@ -1113,7 +1464,7 @@ var file_album_album_proto_rawDesc = []byte{
 	0x62, 0x75, 0x6d, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x26,
 	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06,
-	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd1, 0x01, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x92, 0x02, 0x0a, 0x05, 0x41, 0x6c, 0x62, 0x75, 0x6d,
 	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
 	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
@@ -1126,104 +1477,128 @@ var file_album_album_proto_rawDesc = []byte{
 	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x72, 0x65,
 	0x6c, 0x65, 0x61, 0x73, 0x65, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f,
 	0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
-	0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x22, 0x31, 0x0a, 0x09, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x22, 0x22, 0x0a,
-	0x0a, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x4d, 0x61, 0x70, 0x12, 0x38, 0x0a, 0x06, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x1a, 0x4c, 0x0a,
-	0x0b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x27,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x0a, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
-	0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c,
-	0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6a, 0x0a, 0x15,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64,
-	0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
-	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75,
-	0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d,
-	0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73,
-	0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4c,
-	0x69, 0x6b, 0x65, 0x2a, 0x5f, 0x0a, 0x09, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65,
-	0x12, 0x12, 0x0a, 0x0e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70,
-	0x65, 0x45, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79,
-	0x70, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x10, 0x03, 0x32, 0xe4, 0x05, 0x0a, 0x0c, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x53, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x41,
-	0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a,
-	0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x42, 0x79, 0x49,
-	0x44, 0x12, 0x18, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49,
-	0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0c, 0x2e, 0x61, 0x6c,
-	0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x36, 0x0a, 0x11, 0x47, 0x65, 0x74,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12, 0x0e,
-	0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a, 0x11,
+	0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f,
+	0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x22, 0x31, 0x0a, 0x09, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x06, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x22, 0x22,
+	0x0a, 0x0a, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x22, 0x97, 0x01, 0x0a, 0x0d, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c,
+	0x65, 0x4d, 0x61, 0x70, 0x12, 0x38, 0x0a, 0x06, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4d, 0x61, 0x70, 0x2e, 0x54, 0x69, 0x74, 0x6c, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x06, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x1a, 0x4c,
+	0x0a, 0x0b, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x27, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
 	0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c,
-	0x65, 0x12, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74,
-	0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x12, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61, 0x6c,
-	0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4d, 0x61,
-	0x70, 0x12, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x42, 0x79,
-	0x49, 0x44, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74,
-	0x61, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x4c, 0x69, 0x6b,
-	0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x12, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x4c,
-	0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x73, 0x12, 0x0c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x51, 0x75, 0x65, 0x72,
-	0x79, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x12, 0x19, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e,
-	0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x41, 0x6e, 0x64,
-	0x55, 0x52, 0x4c, 0x12, 0x35, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c, 0x62,
-	0x75, 0x6d, 0x12, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x12, 0x19,
+	0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x3a, 0x0a, 0x0a,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e,
+	0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x65, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x07, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6a, 0x0a,
+	0x15, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49,
+	0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x79, 0x0a, 0x0b, 0x4c, 0x69, 0x6b,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75,
+	0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
+	0x4c, 0x69, 0x6b, 0x65, 0x2a, 0x5f, 0x0a, 0x09, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x12, 0x0a, 0x0e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79,
+	0x70, 0x65, 0x45, 0x50, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x53, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x69, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x10, 0x03, 0x32, 0xa0, 0x08, 0x0a, 0x0c, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x53,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44,
+	0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x42, 0x79,
+	0x49, 0x44, 0x12, 0x18, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x0c, 0x2e, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x36, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x12,
+	0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a,
+	0x11, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74,
+	0x6c, 0x65, 0x12, 0x3e, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69,
+	0x74, 0x6c, 0x65, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x12, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x4d,
+	0x61, 0x70, 0x12, 0x40, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x42,
+	0x79, 0x49, 0x44, 0x73, 0x12, 0x1c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x44, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x37, 0x0a, 0x09, 0x4c, 0x69,
+	0x6b, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x12, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e,
+	0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69,
+	0x74, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x73, 0x12, 0x0c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x12, 0x19, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14,
+	0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x41, 0x6e,
+	0x64, 0x55, 0x52, 0x4c, 0x12, 0x35, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x12, 0x0e, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3f, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x12,
+	0x19, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57,
+	0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3b, 0x0a, 0x11,
+	0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x42, 0x79, 0x49,
+	0x44, 0x12, 0x18, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x3f, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x42, 0x79, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18,
 	0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75,
-	0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x09, 0x5a, 0x07, 0x2e,
-	0x2f, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x68, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x10, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d,
+	0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x11, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
+	0x1f, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0c, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x44,
+	0x0a, 0x16, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c,
+	0x65, 0x64, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x12, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x32, 0x0a, 0x09, 0x45, 0x64, 0x69, 0x74, 0x41, 0x6c, 0x62, 0x75,
+	0x6d, 0x12, 0x17, 0x2e, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x2e, 0x45, 0x64, 0x69, 0x74, 0x41, 0x6c,
+	0x62, 0x75, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1239,81 +1614,102 @@ func file_album_album_proto_rawDescGZIP() []byte {
 }
 
 var file_album_album_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_album_album_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_album_album_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_album_album_proto_goTypes = []interface{}{
-	(AlbumType)(0),                // 0: album.AlbumType
-	(*AlbumIDAndURL)(nil),         // 1: album.AlbumIDAndURL
-	(*FiltersWithLabelID)(nil),    // 2: album.FiltersWithLabelID
-	(*CreateAlbumRequest)(nil),    // 3: album.CreateAlbumRequest
-	(*Query)(nil),                 // 4: album.Query
-	(*UserID)(nil),                // 5: album.UserID
-	(*AlbumID)(nil),               // 6: album.AlbumID
-	(*AlbumIDWithUserID)(nil),     // 7: album.AlbumIDWithUserID
-	(*AlbumIDList)(nil),           // 8: album.AlbumIDList
-	(*AlbumIDListWithUserID)(nil), // 9: album.AlbumIDListWithUserID
-	(*Album)(nil),                 // 10: album.Album
-	(*AlbumList)(nil),             // 11: album.AlbumList
-	(*AlbumTitle)(nil),            // 12: album.AlbumTitle
-	(*AlbumTitleMap)(nil),         // 13: album.AlbumTitleMap
-	(*Pagination)(nil),            // 14: album.Pagination
-	(*Filters)(nil),               // 15: album.Filters
-	(*FiltersWithUserID)(nil),     // 16: album.FiltersWithUserID
-	(*AlbumStreamCreateData)(nil), // 17: album.AlbumStreamCreateData
-	(*LikeRequest)(nil),           // 18: album.LikeRequest
-	nil,                           // 19: album.AlbumTitleMap.TitlesEntry
-	(*timestamppb.Timestamp)(nil), // 20: google.protobuf.Timestamp
-	(*emptypb.Empty)(nil),         // 21: google.protobuf.Empty
+	(AlbumType)(0),                   // 0: album.AlbumType
+	(*LabelAlbumRequest)(nil),        // 1: album.LabelAlbumRequest
+	(*FiltersWithStatus)(nil),        // 2: album.FiltersWithStatus
+	(*ChangeAlbumStatusRequest)(nil), // 3: album.ChangeAlbumStatusRequest
+	(*EditAlbumRequest)(nil),         // 4: album.EditAlbumRequest
+	(*AlbumIDAndURL)(nil),            // 5: album.AlbumIDAndURL
+	(*FiltersWithLabelID)(nil),       // 6: album.FiltersWithLabelID
+	(*CreateAlbumRequest)(nil),       // 7: album.CreateAlbumRequest
+	(*Query)(nil),                    // 8: album.Query
+	(*UserID)(nil),                   // 9: album.UserID
+	(*AlbumID)(nil),                  // 10: album.AlbumID
+	(*AlbumIDWithUserID)(nil),        // 11: album.AlbumIDWithUserID
+	(*AlbumIDList)(nil),              // 12: album.AlbumIDList
+	(*AlbumIDListWithUserID)(nil),    // 13: album.AlbumIDListWithUserID
+	(*Album)(nil),                    // 14: album.Album
+	(*AlbumList)(nil),                // 15: album.AlbumList
+	(*AlbumTitle)(nil),               // 16: album.AlbumTitle
+	(*AlbumTitleMap)(nil),            // 17: album.AlbumTitleMap
+	(*Pagination)(nil),               // 18: album.Pagination
+	(*Filters)(nil),                  // 19: album.Filters
+	(*FiltersWithUserID)(nil),        // 20: album.FiltersWithUserID
+	(*AlbumStreamCreateData)(nil),    // 21: album.AlbumStreamCreateData
+	(*LikeRequest)(nil),              // 22: album.LikeRequest
+	nil,                              // 23: album.AlbumTitleMap.TitlesEntry
+	(*timestamppb.Timestamp)(nil),    // 24: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),            // 25: google.protobuf.Empty
 }
 var file_album_album_proto_depIdxs = []int32{
-	15, // 0: album.FiltersWithLabelID.filters:type_name -> album.Filters
-	0,  // 1: album.CreateAlbumRequest.type:type_name -> album.AlbumType
-	5,  // 2: album.Query.user_id:type_name -> album.UserID
-	6,  // 3: album.AlbumIDWithUserID.album_id:type_name -> album.AlbumID
-	5,  // 4: album.AlbumIDWithUserID.user_id:type_name -> album.UserID
-	6,  // 5: album.AlbumIDList.ids:type_name -> album.AlbumID
-	8,  // 6: album.AlbumIDListWithUserID.ids:type_name -> album.AlbumIDList
-	5,  // 7: album.AlbumIDListWithUserID.user_id:type_name -> album.UserID
-	0,  // 8: album.Album.type:type_name -> album.AlbumType
-	20, // 9: album.Album.release_date:type_name -> google.protobuf.Timestamp
-	10, // 10: album.AlbumList.albums:type_name -> album.Album
-	19, // 11: album.AlbumTitleMap.titles:type_name -> album.AlbumTitleMap.TitlesEntry
-	14, // 12: album.Filters.pagination:type_name -> album.Pagination
-	15, // 13: album.FiltersWithUserID.filters:type_name -> album.Filters
-	5,  // 14: album.FiltersWithUserID.user_id:type_name -> album.UserID
-	6,  // 15: album.AlbumStreamCreateData.album_id:type_name -> album.AlbumID
-	5,  // 16: album.AlbumStreamCreateData.user_id:type_name -> album.UserID
-	6,  // 17: album.LikeRequest.album_id:type_name -> album.AlbumID
-	5,  // 18: album.LikeRequest.user_id:type_name -> album.UserID
-	12, // 19: album.AlbumTitleMap.TitlesEntry.value:type_name -> album.AlbumTitle
-	16, // 20: album.AlbumService.GetAllAlbums:input_type -> album.FiltersWithUserID
-	7,  // 21: album.AlbumService.GetAlbumByID:input_type -> album.AlbumIDWithUserID
-	6,  // 22: album.AlbumService.GetAlbumTitleByID:input_type -> album.AlbumID
-	8,  // 23: album.AlbumService.GetAlbumTitleByIDs:input_type -> album.AlbumIDList
-	9,  // 24: album.AlbumService.GetAlbumsByIDs:input_type -> album.AlbumIDListWithUserID
-	17, // 25: album.AlbumService.CreateStream:input_type -> album.AlbumStreamCreateData
-	18, // 26: album.AlbumService.LikeAlbum:input_type -> album.LikeRequest
-	16, // 27: album.AlbumService.GetFavoriteAlbums:input_type -> album.FiltersWithUserID
-	4,  // 28: album.AlbumService.SearchAlbums:input_type -> album.Query
-	3,  // 29: album.AlbumService.CreateAlbum:input_type -> album.CreateAlbumRequest
-	6,  // 30: album.AlbumService.DeleteAlbum:input_type -> album.AlbumID
-	2,  // 31: album.AlbumService.GetAlbumsLabelID:input_type -> album.FiltersWithLabelID
-	11, // 32: album.AlbumService.GetAllAlbums:output_type -> album.AlbumList
-	10, // 33: album.AlbumService.GetAlbumByID:output_type -> album.Album
-	12, // 34: album.AlbumService.GetAlbumTitleByID:output_type -> album.AlbumTitle
-	13, // 35: album.AlbumService.GetAlbumTitleByIDs:output_type -> album.AlbumTitleMap
-	11, // 36: album.AlbumService.GetAlbumsByIDs:output_type -> album.AlbumList
-	21, // 37: album.AlbumService.CreateStream:output_type -> google.protobuf.Empty
-	21, // 38: album.AlbumService.LikeAlbum:output_type -> google.protobuf.Empty
-	11, // 39: album.AlbumService.GetFavoriteAlbums:output_type -> album.AlbumList
-	11, // 40: album.AlbumService.SearchAlbums:output_type -> album.AlbumList
-	1,  // 41: album.AlbumService.CreateAlbum:output_type -> album.AlbumIDAndURL
-	21, // 42: album.AlbumService.DeleteAlbum:output_type -> google.protobuf.Empty
-	11, // 43: album.AlbumService.GetAlbumsLabelID:output_type -> album.AlbumList
-	32, // [32:44] is the sub-list for method output_type
-	20, // [20:32] is the sub-list for method input_type
-	20, // [20:20] is the sub-list for extension type_name
-	20, // [20:20] is the sub-list for extension extendee
-	0,  // [0:20] is the sub-list for field type_name
+	10, // 0: album.LabelAlbumRequest.album_id:type_name -> album.AlbumID
+	19, // 1: album.FiltersWithStatus.filters:type_name -> album.Filters
+	10, // 2: album.ChangeAlbumStatusRequest.album_id:type_name -> album.AlbumID
+	24, // 3: album.ChangeAlbumStatusRequest.release_date:type_name -> google.protobuf.Timestamp
+	10, // 4: album.EditAlbumRequest.album_id:type_name -> album.AlbumID
+	0,  // 5: album.EditAlbumRequest.type:type_name -> album.AlbumType
+	19, // 6: album.FiltersWithLabelID.filters:type_name -> album.Filters
+	0,  // 7: album.CreateAlbumRequest.type:type_name -> album.AlbumType
+	9,  // 8: album.Query.user_id:type_name -> album.UserID
+	19, // 9: album.Query.filters:type_name -> album.Filters
+	10, // 10: album.AlbumIDWithUserID.album_id:type_name -> album.AlbumID
+	9,  // 11: album.AlbumIDWithUserID.user_id:type_name -> album.UserID
+	10, // 12: album.AlbumIDList.ids:type_name -> album.AlbumID
+	12, // 13: album.AlbumIDListWithUserID.ids:type_name -> album.AlbumIDList
+	9,  // 14: album.AlbumIDListWithUserID.user_id:type_name -> album.UserID
+	0,  // 15: album.Album.type:type_name -> album.AlbumType
+	24, // 16: album.Album.release_date:type_name -> google.protobuf.Timestamp
+	14, // 17: album.AlbumList.albums:type_name -> album.Album
+	23, // 18: album.AlbumTitleMap.titles:type_name -> album.AlbumTitleMap.TitlesEntry
+	18, // 19: album.Filters.pagination:type_name -> album.Pagination
+	19, // 20: album.FiltersWithUserID.filters:type_name -> album.Filters
+	9,  // 21: album.FiltersWithUserID.user_id:type_name -> album.UserID
+	10, // 22: album.AlbumStreamCreateData.album_id:type_name -> album.AlbumID
+	9,  // 23: album.AlbumStreamCreateData.user_id:type_name -> album.UserID
+	10, // 24: album.LikeRequest.album_id:type_name -> album.AlbumID
+	9,  // 25: album.LikeRequest.user_id:type_name -> album.UserID
+	16, // 26: album.AlbumTitleMap.TitlesEntry.value:type_name -> album.AlbumTitle
+	20, // 27: album.AlbumService.GetAllAlbums:input_type -> album.FiltersWithUserID
+	11, // 28: album.AlbumService.GetAlbumByID:input_type -> album.AlbumIDWithUserID
+	10, // 29: album.AlbumService.GetAlbumTitleByID:input_type -> album.AlbumID
+	12, // 30: album.AlbumService.GetAlbumTitleByIDs:input_type -> album.AlbumIDList
+	13, // 31: album.AlbumService.GetAlbumsByIDs:input_type -> album.AlbumIDListWithUserID
+	21, // 32: album.AlbumService.CreateStream:input_type -> album.AlbumStreamCreateData
+	22, // 33: album.AlbumService.LikeAlbum:input_type -> album.LikeRequest
+	20, // 34: album.AlbumService.GetFavoriteAlbums:input_type -> album.FiltersWithUserID
+	8,  // 35: album.AlbumService.SearchAlbums:input_type -> album.Query
+	7,  // 36: album.AlbumService.CreateAlbum:input_type -> album.CreateAlbumRequest
+	10, // 37: album.AlbumService.DeleteAlbum:input_type -> album.AlbumID
+	6,  // 38: album.AlbumService.GetAlbumsLabelID:input_type -> album.FiltersWithLabelID
+	1,  // 39: album.AlbumService.GetLabelAlbumByID:input_type -> album.LabelAlbumRequest
+	2,  // 40: album.AlbumService.GetAlbumsByStatus:input_type -> album.FiltersWithStatus
+	3,  // 41: album.AlbumService.ChangeAlbumStatus:input_type -> album.ChangeAlbumStatusRequest
+	25, // 42: album.AlbumService.PublishScheduledAlbums:input_type -> google.protobuf.Empty
+	4,  // 43: album.AlbumService.EditAlbum:input_type -> album.EditAlbumRequest
+	15, // 44: album.AlbumService.GetAllAlbums:output_type -> album.AlbumList
+	14, // 45: album.AlbumService.GetAlbumByID:output_type -> album.Album
+	16, // 46: album.AlbumService.GetAlbumTitleByID:output_type -> album.AlbumTitle
+	17, // 47: album.AlbumService.GetAlbumTitleByIDs:output_type -> album.AlbumTitleMap
+	15, // 48: album.AlbumService.GetAlbumsByIDs:output_type -> album.AlbumList
+	25, // 49: album.AlbumService.CreateStream:output_type -> google.protobuf.Empty
+	25, // 50: album.AlbumService.LikeAlbum:output_type -> google.protobuf.Empty
+	15, // 51: album.AlbumService.GetFavoriteAlbums:output_type -> album.AlbumList
+	15, // 52: album.AlbumService.SearchAlbums:output_type -> album.AlbumList
+	5,  // 53: album.AlbumService.CreateAlbum:output_type -> album.AlbumIDAndURL
+	25, // 54: album.AlbumService.DeleteAlbum:output_type -> google.protobuf.Empty
+	15, // 55: album.AlbumService.GetAlbumsLabelID:output_type -> album.AlbumList
+	14, // 56: album.AlbumService.GetLabelAlbumByID:output_type -> album.Album
+	15, // 57: album.AlbumService.GetAlbumsByStatus:output_type -> album.AlbumList
+	14, // 58: album.AlbumService.ChangeAlbumStatus:output_type -> album.Album
+	12, // 59: album.AlbumService.PublishScheduledAlbums:output_type -> album.AlbumIDList
+	14, // 60: album.AlbumService.EditAlbum:output_type -> album.Album
+	44, // [44:61] is the sub-list for method output_type
+	27, // [27:44] is the sub-list for method input_type
+	27, // [27:27] is the sub-list for extension type_name
+	27, // [27:27] is the sub-list for extension extendee
+	0,  // [0:27] is the sub-list for field type_name
 }
 
 func init() { file_album_album_proto_init() }
@@ -1323,7 +1719,7 @@ func file_album_album_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_album_album_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumIDAndURL); i {
+			switch v := v.(*LabelAlbumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1335,7 +1731,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FiltersWithLabelID); i {
+			switch v := v.(*FiltersWithStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1347,7 +1743,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateAlbumRequest); i {
+			switch v := v.(*ChangeAlbumStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1359,7 +1755,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Query); i {
+			switch v := v.(*EditAlbumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1371,7 +1767,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserID); i {
+			switch v := v.(*AlbumIDAndURL); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1383,7 +1779,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumID); i {
+			switch v := v.(*FiltersWithLabelID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1395,7 +1791,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumIDWithUserID); i {
+			switch v := v.(*CreateAlbumRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1407,7 +1803,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumIDList); i {
+			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1419,7 +1815,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumIDListWithUserID); i {
+			switch v := v.(*UserID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1431,7 +1827,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Album); i {
+			switch v := v.(*AlbumID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1443,7 +1839,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumList); i {
+			switch v := v.(*AlbumIDWithUserID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1455,7 +1851,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumTitle); i {
+			switch v := v.(*AlbumIDList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1467,7 +1863,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumTitleMap); i {
+			switch v := v.(*AlbumIDListWithUserID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1479,7 +1875,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
+			switch v := v.(*Album); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1491,7 +1887,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filters); i {
+			switch v := v.(*AlbumList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1503,7 +1899,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FiltersWithUserID); i {
+			switch v := v.(*AlbumTitle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1515,7 +1911,7 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumStreamCreateData); i {
+			switch v := v.(*AlbumTitleMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1527,6 +1923,54 @@ func file_album_album_proto_init() {
 			}
 		}
 		file_album_album_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_album_album_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_album_album_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FiltersWithUserID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_album_album_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumStreamCreateData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_album_album_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LikeRequest); i {
 			case 0:
 				return &v.state
@@ -1545,7 +1989,7 @@ func file_album_album_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_album_album_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -31,6 +31,11 @@ type AlbumServiceClient interface {
 	CreateAlbum(ctx context.Context, in *CreateAlbumRequest, opts ...grpc.CallOption) (*AlbumIDAndURL, error)
 	DeleteAlbum(ctx context.Context, in *AlbumID, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	GetAlbumsLabelID(ctx context.Context, in *FiltersWithLabelID, opts ...grpc.CallOption) (*AlbumList, error)
+	GetLabelAlbumByID(ctx context.Context, in *LabelAlbumRequest, opts ...grpc.CallOption) (*Album, error)
+	GetAlbumsByStatus(ctx context.Context, in *FiltersWithStatus, opts ...grpc.CallOption) (*AlbumList, error)
+	ChangeAlbumStatus(ctx context.Context, in *ChangeAlbumStatusRequest, opts ...grpc.CallOption) (*Album, error)
+	PublishScheduledAlbums(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AlbumIDList, error)
+	EditAlbum(ctx context.Context, in *EditAlbumRequest, opts ...grpc.CallOption) (*Album, error)
 }
 
 type albumServiceClient struct {
@@ -149,6 +154,51 @@ func (c *albumServiceClient) GetAlbumsLabelID(ctx context.Context, in *FiltersWi
 	return out, nil
 }
 
+func (c *albumServiceClient) GetLabelAlbumByID(ctx context.Context, in *LabelAlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, "/album.AlbumService/GetLabelAlbumByID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) GetAlbumsByStatus(ctx context.Context, in *FiltersWithStatus, opts ...grpc.CallOption) (*AlbumList, error) {
+	out := new(AlbumList)
+	err := c.cc.Invoke(ctx, "/album.AlbumService/GetAlbumsByStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) ChangeAlbumStatus(ctx context.Context, in *ChangeAlbumStatusRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, "/album.AlbumService/ChangeAlbumStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) PublishScheduledAlbums(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AlbumIDList, error) {
+	out := new(AlbumIDList)
+	err := c.cc.Invoke(ctx, "/album.AlbumService/PublishScheduledAlbums", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *albumServiceClient) EditAlbum(ctx context.Context, in *EditAlbumRequest, opts ...grpc.CallOption) (*Album, error) {
+	out := new(Album)
+	err := c.cc.Invoke(ctx, "/album.AlbumService/EditAlbum", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AlbumServiceServer is the server API for AlbumService service.
 // All implementations must embed UnimplementedAlbumServiceServer
 // for forward compatibility
@@ -165,6 +215,11 @@ type AlbumServiceServer interface {
 	CreateAlbum(context.Context, *CreateAlbumRequest) (*AlbumIDAndURL, error)
 	DeleteAlbum(context.Context, *AlbumID) (*emptypb.Empty, error)
 	GetAlbumsLabelID(context.Context, *FiltersWithLabelID) (*AlbumList, error)
+	GetLabelAlbumByID(context.Context, *LabelAlbumRequest) (*Album, error)
+	GetAlbumsByStatus(context.Context, *FiltersWithStatus) (*AlbumList, error)
+	ChangeAlbumStatus(context.Context, *ChangeAlbumStatusRequest) (*Album, error)
+	PublishScheduledAlbums(context.Context, *emptypb.Empty) (*AlbumIDList, error)
+	EditAlbum(context.Context, *EditAlbumRequest) (*Album, error)
 	mustEmbedUnimplementedAlbumServiceServer()
 }
 
@@ -208,6 +263,21 @@ func (UnimplementedAlbumServiceServer) DeleteAlbum(context.Context, *AlbumID) (*
 func (UnimplementedAlbumServiceServer) GetAlbumsLabelID(context.Context, *FiltersWithLabelID) (*AlbumList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAlbumsLabelID not implemented")
 }
+func (UnimplementedAlbumServiceServer) GetLabelAlbumByID(context.Context, *LabelAlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabelAlbumByID not implemented")
+}
+func (UnimplementedAlbumServiceServer) GetAlbumsByStatus(context.Context, *FiltersWithStatus) (*AlbumList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAlbumsByStatus not implemented")
+}
+func (UnimplementedAlbumServiceServer) ChangeAlbumStatus(context.Context, *ChangeAlbumStatusRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeAlbumStatus not implemented")
+}
+func (UnimplementedAlbumServiceServer) PublishScheduledAlbums(context.Context, *emptypb.Empty) (*AlbumIDList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishScheduledAlbums not implemented")
+}
+func (UnimplementedAlbumServiceServer) EditAlbum(context.Context, *EditAlbumRequest) (*Album, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditAlbum not implemented")
+}
 func (UnimplementedAlbumServiceServer) mustEmbedUnimplementedAlbumServiceServer() {}
 
 // UnsafeAlbumServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -437,6 +507,96 @@ func _AlbumService_GetAlbumsLabelID_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AlbumService_GetLabelAlbumByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).GetLabelAlbumByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/album.AlbumService/GetLabelAlbumByID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).GetLabelAlbumByID(ctx, req.(*LabelAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_GetAlbumsByStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FiltersWithStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).GetAlbumsByStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/album.AlbumService/GetAlbumsByStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).GetAlbumsByStatus(ctx, req.(*FiltersWithStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_ChangeAlbumStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeAlbumStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).ChangeAlbumStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/album.AlbumService/ChangeAlbumStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).ChangeAlbumStatus(ctx, req.(*ChangeAlbumStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_PublishScheduledAlbums_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).PublishScheduledAlbums(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/album.AlbumService/PublishScheduledAlbums",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).PublishScheduledAlbums(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AlbumService_EditAlbum_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EditAlbumRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AlbumServiceServer).EditAlbum(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/album.AlbumService/EditAlbum",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AlbumServiceServer).EditAlbum(ctx, req.(*EditAlbumRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AlbumService_ServiceDesc is the grpc.ServiceDesc for AlbumService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -492,6 +652,26 @@ var AlbumService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAlbumsLabelID",
 			Handler:    _AlbumService_GetAlbumsLabelID_Handler,
 		},
+		{
+			MethodName: "GetLabelAlbumByID",
+			Handler:    _AlbumService_GetLabelAlbumByID_Handler,
+		},
+		{
+			MethodName: "GetAlbumsByStatus",
+			Handler:    _AlbumService_GetAlbumsByStatus_Handler,
+		},
+		{
+			MethodName: "ChangeAlbumStatus",
+			Handler:    _AlbumService_ChangeAlbumStatus_Handler,
+		},
+		{
+			MethodName: "PublishScheduledAlbums",
+			Handler:    _AlbumService_PublishScheduledAlbums_Handler,
+		},
+		{
+			MethodName: "EditAlbum",
+			Handler:    _AlbumService_EditAlbum_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "album/album.proto",
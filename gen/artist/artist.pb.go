@@ -21,6 +21,250 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type TrackArtistIDs struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId   *TrackID      `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	ArtistIds *ArtistIDList `protobuf:"bytes,2,opt,name=artist_ids,json=artistIds,proto3" json:"artist_ids,omitempty"`
+}
+
+func (x *TrackArtistIDs) Reset() {
+	*x = TrackArtistIDs{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_artist_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackArtistIDs) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackArtistIDs) ProtoMessage() {}
+
+func (x *TrackArtistIDs) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_artist_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackArtistIDs.ProtoReflect.Descriptor instead.
+func (*TrackArtistIDs) Descriptor() ([]byte, []int) {
+	return file_artist_artist_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TrackArtistIDs) GetTrackId() *TrackID {
+	if x != nil {
+		return x.TrackId
+	}
+	return nil
+}
+
+func (x *TrackArtistIDs) GetArtistIds() *ArtistIDList {
+	if x != nil {
+		return x.ArtistIds
+	}
+	return nil
+}
+
+type ArtistRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtistId *ArtistID `protobuf:"bytes,1,opt,name=artist_id,json=artistId,proto3" json:"artist_id,omitempty"`
+	Role     string    `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+}
+
+func (x *ArtistRole) Reset() {
+	*x = ArtistRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_artist_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistRole) ProtoMessage() {}
+
+func (x *ArtistRole) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_artist_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistRole.ProtoReflect.Descriptor instead.
+func (*ArtistRole) Descriptor() ([]byte, []int) {
+	return file_artist_artist_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *ArtistRole) GetArtistId() *ArtistID {
+	if x != nil {
+		return x.ArtistId
+	}
+	return nil
+}
+
+func (x *ArtistRole) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+type TrackArtistRoles struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId *TrackID      `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	LabelId int64         `protobuf:"varint,2,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	Artists []*ArtistRole `protobuf:"bytes,3,rep,name=artists,proto3" json:"artists,omitempty"`
+}
+
+func (x *TrackArtistRoles) Reset() {
+	*x = TrackArtistRoles{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_artist_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackArtistRoles) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackArtistRoles) ProtoMessage() {}
+
+func (x *TrackArtistRoles) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_artist_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackArtistRoles.ProtoReflect.Descriptor instead.
+func (*TrackArtistRoles) Descriptor() ([]byte, []int) {
+	return file_artist_artist_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *TrackArtistRoles) GetTrackId() *TrackID {
+	if x != nil {
+		return x.TrackId
+	}
+	return nil
+}
+
+func (x *TrackArtistRoles) GetLabelId() int64 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *TrackArtistRoles) GetArtists() []*ArtistRole {
+	if x != nil {
+		return x.Artists
+	}
+	return nil
+}
+
+type ArtistCredits struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlbumId   *AlbumID          `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	LabelId   int64             `protobuf:"varint,2,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
+	ArtistIds *ArtistIDList     `protobuf:"bytes,3,opt,name=artist_ids,json=artistIds,proto3" json:"artist_ids,omitempty"`
+	Tracks    []*TrackArtistIDs `protobuf:"bytes,4,rep,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *ArtistCredits) Reset() {
+	*x = ArtistCredits{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_artist_artist_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtistCredits) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtistCredits) ProtoMessage() {}
+
+func (x *ArtistCredits) ProtoReflect() protoreflect.Message {
+	mi := &file_artist_artist_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtistCredits.ProtoReflect.Descriptor instead.
+func (*ArtistCredits) Descriptor() ([]byte, []int) {
+	return file_artist_artist_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ArtistCredits) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *ArtistCredits) GetLabelId() int64 {
+	if x != nil {
+		return x.LabelId
+	}
+	return 0
+}
+
+func (x *ArtistCredits) GetArtistIds() *ArtistIDList {
+	if x != nil {
+		return x.ArtistIds
+	}
+	return nil
+}
+
+func (x *ArtistCredits) GetTracks() []*TrackArtistIDs {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
 type ArtistsIDWithAlbumID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -34,7 +278,7 @@ type ArtistsIDWithAlbumID struct {
 func (x *ArtistsIDWithAlbumID) Reset() {
 	*x = ArtistsIDWithAlbumID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[0]
+		mi := &file_artist_artist_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -47,7 +291,7 @@ func (x *ArtistsIDWithAlbumID) String() string {
 func (*ArtistsIDWithAlbumID) ProtoMessage() {}
 
 func (x *ArtistsIDWithAlbumID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[0]
+	mi := &file_artist_artist_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -60,7 +304,7 @@ func (x *ArtistsIDWithAlbumID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistsIDWithAlbumID.ProtoReflect.Descriptor instead.
 func (*ArtistsIDWithAlbumID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{0}
+	return file_artist_artist_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ArtistsIDWithAlbumID) GetArtistIds() *ArtistIDList {
@@ -96,7 +340,7 @@ type ArtistDelete struct {
 func (x *ArtistDelete) Reset() {
 	*x = ArtistDelete{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[1]
+		mi := &file_artist_artist_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -109,7 +353,7 @@ func (x *ArtistDelete) String() string {
 func (*ArtistDelete) ProtoMessage() {}
 
 func (x *ArtistDelete) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[1]
+	mi := &file_artist_artist_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,7 +366,7 @@ func (x *ArtistDelete) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistDelete.ProtoReflect.Descriptor instead.
 func (*ArtistDelete) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{1}
+	return file_artist_artist_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ArtistDelete) GetArtistId() int64 {
@@ -151,7 +395,7 @@ type FiltersWithLabelID struct {
 func (x *FiltersWithLabelID) Reset() {
 	*x = FiltersWithLabelID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[2]
+		mi := &file_artist_artist_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -164,7 +408,7 @@ func (x *FiltersWithLabelID) String() string {
 func (*FiltersWithLabelID) ProtoMessage() {}
 
 func (x *FiltersWithLabelID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[2]
+	mi := &file_artist_artist_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -177,7 +421,7 @@ func (x *FiltersWithLabelID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiltersWithLabelID.ProtoReflect.Descriptor instead.
 func (*FiltersWithLabelID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{2}
+	return file_artist_artist_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *FiltersWithLabelID) GetFilters() *Filters {
@@ -208,7 +452,7 @@ type ArtistEdit struct {
 func (x *ArtistEdit) Reset() {
 	*x = ArtistEdit{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[3]
+		mi := &file_artist_artist_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -221,7 +465,7 @@ func (x *ArtistEdit) String() string {
 func (*ArtistEdit) ProtoMessage() {}
 
 func (x *ArtistEdit) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[3]
+	mi := &file_artist_artist_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -234,7 +478,7 @@ func (x *ArtistEdit) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistEdit.ProtoReflect.Descriptor instead.
 func (*ArtistEdit) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{3}
+	return file_artist_artist_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ArtistEdit) GetArtistId() int64 {
@@ -270,14 +514,15 @@ type Query struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query  string  `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	UserId *UserID `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Query   string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filters *Filters `protobuf:"bytes,3,opt,name=filters,proto3" json:"filters,omitempty"`
 }
 
 func (x *Query) Reset() {
 	*x = Query{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[4]
+		mi := &file_artist_artist_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -290,7 +535,7 @@ func (x *Query) String() string {
 func (*Query) ProtoMessage() {}
 
 func (x *Query) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[4]
+	mi := &file_artist_artist_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -303,7 +548,7 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Query.ProtoReflect.Descriptor instead.
 func (*Query) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{4}
+	return file_artist_artist_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *Query) GetQuery() string {
@@ -320,6 +565,13 @@ func (x *Query) GetUserId() *UserID {
 	return nil
 }
 
+func (x *Query) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
 type ArtistListened struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -331,7 +583,7 @@ type ArtistListened struct {
 func (x *ArtistListened) Reset() {
 	*x = ArtistListened{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[5]
+		mi := &file_artist_artist_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -344,7 +596,7 @@ func (x *ArtistListened) String() string {
 func (*ArtistListened) ProtoMessage() {}
 
 func (x *ArtistListened) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[5]
+	mi := &file_artist_artist_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -357,7 +609,7 @@ func (x *ArtistListened) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistListened.ProtoReflect.Descriptor instead.
 func (*ArtistListened) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{5}
+	return file_artist_artist_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ArtistListened) GetArtistsListened() int64 {
@@ -378,7 +630,7 @@ type UserID struct {
 func (x *UserID) Reset() {
 	*x = UserID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[6]
+		mi := &file_artist_artist_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -391,7 +643,7 @@ func (x *UserID) String() string {
 func (*UserID) ProtoMessage() {}
 
 func (x *UserID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[6]
+	mi := &file_artist_artist_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -404,7 +656,7 @@ func (x *UserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserID.ProtoReflect.Descriptor instead.
 func (*UserID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{6}
+	return file_artist_artist_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserID) GetId() int64 {
@@ -425,7 +677,7 @@ type ArtistID struct {
 func (x *ArtistID) Reset() {
 	*x = ArtistID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[7]
+		mi := &file_artist_artist_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -438,7 +690,7 @@ func (x *ArtistID) String() string {
 func (*ArtistID) ProtoMessage() {}
 
 func (x *ArtistID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[7]
+	mi := &file_artist_artist_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -451,7 +703,7 @@ func (x *ArtistID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistID.ProtoReflect.Descriptor instead.
 func (*ArtistID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{7}
+	return file_artist_artist_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ArtistID) GetId() int64 {
@@ -473,7 +725,7 @@ type ArtistIDWithUserID struct {
 func (x *ArtistIDWithUserID) Reset() {
 	*x = ArtistIDWithUserID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[8]
+		mi := &file_artist_artist_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -486,7 +738,7 @@ func (x *ArtistIDWithUserID) String() string {
 func (*ArtistIDWithUserID) ProtoMessage() {}
 
 func (x *ArtistIDWithUserID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[8]
+	mi := &file_artist_artist_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -499,7 +751,7 @@ func (x *ArtistIDWithUserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistIDWithUserID.ProtoReflect.Descriptor instead.
 func (*ArtistIDWithUserID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{8}
+	return file_artist_artist_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *ArtistIDWithUserID) GetArtistId() *ArtistID {
@@ -527,7 +779,7 @@ type ArtistIDList struct {
 func (x *ArtistIDList) Reset() {
 	*x = ArtistIDList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[9]
+		mi := &file_artist_artist_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -540,7 +792,7 @@ func (x *ArtistIDList) String() string {
 func (*ArtistIDList) ProtoMessage() {}
 
 func (x *ArtistIDList) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[9]
+	mi := &file_artist_artist_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,7 +805,7 @@ func (x *ArtistIDList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistIDList.ProtoReflect.Descriptor instead.
 func (*ArtistIDList) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{9}
+	return file_artist_artist_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *ArtistIDList) GetIds() []*ArtistID {
@@ -574,7 +826,7 @@ type TrackID struct {
 func (x *TrackID) Reset() {
 	*x = TrackID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[10]
+		mi := &file_artist_artist_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -587,7 +839,7 @@ func (x *TrackID) String() string {
 func (*TrackID) ProtoMessage() {}
 
 func (x *TrackID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[10]
+	mi := &file_artist_artist_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -600,7 +852,7 @@ func (x *TrackID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackID.ProtoReflect.Descriptor instead.
 func (*TrackID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{10}
+	return file_artist_artist_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *TrackID) GetId() int64 {
@@ -621,7 +873,7 @@ type AlbumID struct {
 func (x *AlbumID) Reset() {
 	*x = AlbumID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[11]
+		mi := &file_artist_artist_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -634,7 +886,7 @@ func (x *AlbumID) String() string {
 func (*AlbumID) ProtoMessage() {}
 
 func (x *AlbumID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[11]
+	mi := &file_artist_artist_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -647,7 +899,7 @@ func (x *AlbumID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumID.ProtoReflect.Descriptor instead.
 func (*AlbumID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{11}
+	return file_artist_artist_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AlbumID) GetId() int64 {
@@ -668,7 +920,7 @@ type TrackIDList struct {
 func (x *TrackIDList) Reset() {
 	*x = TrackIDList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[12]
+		mi := &file_artist_artist_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -681,7 +933,7 @@ func (x *TrackIDList) String() string {
 func (*TrackIDList) ProtoMessage() {}
 
 func (x *TrackIDList) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[12]
+	mi := &file_artist_artist_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -694,7 +946,7 @@ func (x *TrackIDList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TrackIDList.ProtoReflect.Descriptor instead.
 func (*TrackIDList) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{12}
+	return file_artist_artist_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *TrackIDList) GetIds() []*TrackID {
@@ -715,7 +967,7 @@ type AlbumIDList struct {
 func (x *AlbumIDList) Reset() {
 	*x = AlbumIDList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[13]
+		mi := &file_artist_artist_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +980,7 @@ func (x *AlbumIDList) String() string {
 func (*AlbumIDList) ProtoMessage() {}
 
 func (x *AlbumIDList) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[13]
+	mi := &file_artist_artist_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -741,7 +993,7 @@ func (x *AlbumIDList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AlbumIDList.ProtoReflect.Descriptor instead.
 func (*AlbumIDList) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{13}
+	return file_artist_artist_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *AlbumIDList) GetIds() []*AlbumID {
@@ -764,7 +1016,7 @@ type ArtistLoad struct {
 func (x *ArtistLoad) Reset() {
 	*x = ArtistLoad{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[14]
+		mi := &file_artist_artist_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -777,7 +1029,7 @@ func (x *ArtistLoad) String() string {
 func (*ArtistLoad) ProtoMessage() {}
 
 func (x *ArtistLoad) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[14]
+	mi := &file_artist_artist_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -790,7 +1042,7 @@ func (x *ArtistLoad) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistLoad.ProtoReflect.Descriptor instead.
 func (*ArtistLoad) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{14}
+	return file_artist_artist_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ArtistLoad) GetTitle() string {
@@ -819,17 +1071,18 @@ type Artist struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id          int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title       string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	Thumbnail   string `protobuf:"bytes,4,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	IsFavorite  bool   `protobuf:"varint,5,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	Id             int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	Thumbnail      string `protobuf:"bytes,4,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	IsFavorite     bool   `protobuf:"varint,5,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	ThumbnailColor string `protobuf:"bytes,6,opt,name=thumbnail_color,json=thumbnailColor,proto3" json:"thumbnail_color,omitempty"`
 }
 
 func (x *Artist) Reset() {
 	*x = Artist{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[15]
+		mi := &file_artist_artist_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -842,7 +1095,7 @@ func (x *Artist) String() string {
 func (*Artist) ProtoMessage() {}
 
 func (x *Artist) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[15]
+	mi := &file_artist_artist_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,7 +1108,7 @@ func (x *Artist) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Artist.ProtoReflect.Descriptor instead.
 func (*Artist) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{15}
+	return file_artist_artist_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *Artist) GetId() int64 {
@@ -893,6 +1146,13 @@ func (x *Artist) GetIsFavorite() bool {
 	return false
 }
 
+func (x *Artist) GetThumbnailColor() string {
+	if x != nil {
+		return x.ThumbnailColor
+	}
+	return ""
+}
+
 type ArtistDetailed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -906,7 +1166,7 @@ type ArtistDetailed struct {
 func (x *ArtistDetailed) Reset() {
 	*x = ArtistDetailed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[16]
+		mi := &file_artist_artist_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -919,7 +1179,7 @@ func (x *ArtistDetailed) String() string {
 func (*ArtistDetailed) ProtoMessage() {}
 
 func (x *ArtistDetailed) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[16]
+	mi := &file_artist_artist_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -932,7 +1192,7 @@ func (x *ArtistDetailed) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistDetailed.ProtoReflect.Descriptor instead.
 func (*ArtistDetailed) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{16}
+	return file_artist_artist_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *ArtistDetailed) GetArtist() *Artist {
@@ -967,7 +1227,7 @@ type ArtistTitle struct {
 func (x *ArtistTitle) Reset() {
 	*x = ArtistTitle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[17]
+		mi := &file_artist_artist_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -980,7 +1240,7 @@ func (x *ArtistTitle) String() string {
 func (*ArtistTitle) ProtoMessage() {}
 
 func (x *ArtistTitle) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[17]
+	mi := &file_artist_artist_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -993,7 +1253,7 @@ func (x *ArtistTitle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistTitle.ProtoReflect.Descriptor instead.
 func (*ArtistTitle) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{17}
+	return file_artist_artist_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *ArtistTitle) GetTitle() string {
@@ -1014,7 +1274,7 @@ type ArtistList struct {
 func (x *ArtistList) Reset() {
 	*x = ArtistList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[18]
+		mi := &file_artist_artist_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1027,7 +1287,7 @@ func (x *ArtistList) String() string {
 func (*ArtistList) ProtoMessage() {}
 
 func (x *ArtistList) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[18]
+	mi := &file_artist_artist_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1300,7 @@ func (x *ArtistList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistList.ProtoReflect.Descriptor instead.
 func (*ArtistList) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{18}
+	return file_artist_artist_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *ArtistList) GetArtists() []*Artist {
@@ -1062,7 +1322,7 @@ type ArtistWithTitle struct {
 func (x *ArtistWithTitle) Reset() {
 	*x = ArtistWithTitle{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[19]
+		mi := &file_artist_artist_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1075,7 +1335,7 @@ func (x *ArtistWithTitle) String() string {
 func (*ArtistWithTitle) ProtoMessage() {}
 
 func (x *ArtistWithTitle) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[19]
+	mi := &file_artist_artist_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,7 +1348,7 @@ func (x *ArtistWithTitle) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistWithTitle.ProtoReflect.Descriptor instead.
 func (*ArtistWithTitle) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{19}
+	return file_artist_artist_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *ArtistWithTitle) GetId() int64 {
@@ -1116,7 +1376,7 @@ type ArtistWithTitleList struct {
 func (x *ArtistWithTitleList) Reset() {
 	*x = ArtistWithTitleList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[20]
+		mi := &file_artist_artist_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1129,7 +1389,7 @@ func (x *ArtistWithTitleList) String() string {
 func (*ArtistWithTitleList) ProtoMessage() {}
 
 func (x *ArtistWithTitleList) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[20]
+	mi := &file_artist_artist_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1142,7 +1402,7 @@ func (x *ArtistWithTitleList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistWithTitleList.ProtoReflect.Descriptor instead.
 func (*ArtistWithTitleList) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{20}
+	return file_artist_artist_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *ArtistWithTitleList) GetArtists() []*ArtistWithTitle {
@@ -1163,7 +1423,7 @@ type ArtistWithTitleMap struct {
 func (x *ArtistWithTitleMap) Reset() {
 	*x = ArtistWithTitleMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[21]
+		mi := &file_artist_artist_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1176,7 +1436,7 @@ func (x *ArtistWithTitleMap) String() string {
 func (*ArtistWithTitleMap) ProtoMessage() {}
 
 func (x *ArtistWithTitleMap) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[21]
+	mi := &file_artist_artist_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1189,7 +1449,7 @@ func (x *ArtistWithTitleMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistWithTitleMap.ProtoReflect.Descriptor instead.
 func (*ArtistWithTitleMap) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{21}
+	return file_artist_artist_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *ArtistWithTitleMap) GetArtists() map[int64]*ArtistWithTitleList {
@@ -1212,7 +1472,7 @@ type ArtistWithRole struct {
 func (x *ArtistWithRole) Reset() {
 	*x = ArtistWithRole{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[22]
+		mi := &file_artist_artist_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1225,7 +1485,7 @@ func (x *ArtistWithRole) String() string {
 func (*ArtistWithRole) ProtoMessage() {}
 
 func (x *ArtistWithRole) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[22]
+	mi := &file_artist_artist_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1238,7 +1498,7 @@ func (x *ArtistWithRole) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistWithRole.ProtoReflect.Descriptor instead.
 func (*ArtistWithRole) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{22}
+	return file_artist_artist_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *ArtistWithRole) GetId() int64 {
@@ -1273,7 +1533,7 @@ type ArtistWithRoleList struct {
 func (x *ArtistWithRoleList) Reset() {
 	*x = ArtistWithRoleList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[23]
+		mi := &file_artist_artist_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1286,7 +1546,7 @@ func (x *ArtistWithRoleList) String() string {
 func (*ArtistWithRoleList) ProtoMessage() {}
 
 func (x *ArtistWithRoleList) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[23]
+	mi := &file_artist_artist_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1299,7 +1559,7 @@ func (x *ArtistWithRoleList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistWithRoleList.ProtoReflect.Descriptor instead.
 func (*ArtistWithRoleList) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{23}
+	return file_artist_artist_proto_rawDescGZIP(), []int{27}
 }
 
 func (x *ArtistWithRoleList) GetArtists() []*ArtistWithRole {
@@ -1320,7 +1580,7 @@ type ArtistWithRoleMap struct {
 func (x *ArtistWithRoleMap) Reset() {
 	*x = ArtistWithRoleMap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[24]
+		mi := &file_artist_artist_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1333,7 +1593,7 @@ func (x *ArtistWithRoleMap) String() string {
 func (*ArtistWithRoleMap) ProtoMessage() {}
 
 func (x *ArtistWithRoleMap) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[24]
+	mi := &file_artist_artist_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1346,7 +1606,7 @@ func (x *ArtistWithRoleMap) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistWithRoleMap.ProtoReflect.Descriptor instead.
 func (*ArtistWithRoleMap) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{24}
+	return file_artist_artist_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *ArtistWithRoleMap) GetArtists() map[int64]*ArtistWithRoleList {
@@ -1368,7 +1628,7 @@ type Pagination struct {
 func (x *Pagination) Reset() {
 	*x = Pagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[25]
+		mi := &file_artist_artist_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1381,7 +1641,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[25]
+	mi := &file_artist_artist_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1394,7 +1654,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{25}
+	return file_artist_artist_proto_rawDescGZIP(), []int{29}
 }
 
 func (x *Pagination) GetOffset() int64 {
@@ -1422,7 +1682,7 @@ type Filters struct {
 func (x *Filters) Reset() {
 	*x = Filters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[26]
+		mi := &file_artist_artist_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1435,7 +1695,7 @@ func (x *Filters) String() string {
 func (*Filters) ProtoMessage() {}
 
 func (x *Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[26]
+	mi := &file_artist_artist_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,7 +1708,7 @@ func (x *Filters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filters.ProtoReflect.Descriptor instead.
 func (*Filters) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{26}
+	return file_artist_artist_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *Filters) GetPagination() *Pagination {
@@ -1470,7 +1730,7 @@ type FiltersWithUserID struct {
 func (x *FiltersWithUserID) Reset() {
 	*x = FiltersWithUserID{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[27]
+		mi := &file_artist_artist_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1483,7 +1743,7 @@ func (x *FiltersWithUserID) String() string {
 func (*FiltersWithUserID) ProtoMessage() {}
 
 func (x *FiltersWithUserID) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[27]
+	mi := &file_artist_artist_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1496,7 +1756,7 @@ func (x *FiltersWithUserID) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FiltersWithUserID.ProtoReflect.Descriptor instead.
 func (*FiltersWithUserID) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{27}
+	return file_artist_artist_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *FiltersWithUserID) GetFilters() *Filters {
@@ -1525,7 +1785,7 @@ type ArtistStreamCreateDataList struct {
 func (x *ArtistStreamCreateDataList) Reset() {
 	*x = ArtistStreamCreateDataList{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[28]
+		mi := &file_artist_artist_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1538,7 +1798,7 @@ func (x *ArtistStreamCreateDataList) String() string {
 func (*ArtistStreamCreateDataList) ProtoMessage() {}
 
 func (x *ArtistStreamCreateDataList) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[28]
+	mi := &file_artist_artist_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1551,7 +1811,7 @@ func (x *ArtistStreamCreateDataList) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArtistStreamCreateDataList.ProtoReflect.Descriptor instead.
 func (*ArtistStreamCreateDataList) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{28}
+	return file_artist_artist_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ArtistStreamCreateDataList) GetArtistIds() *ArtistIDList {
@@ -1581,7 +1841,7 @@ type LikeRequest struct {
 func (x *LikeRequest) Reset() {
 	*x = LikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_artist_artist_proto_msgTypes[29]
+		mi := &file_artist_artist_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1594,7 +1854,7 @@ func (x *LikeRequest) String() string {
 func (*LikeRequest) ProtoMessage() {}
 
 func (x *LikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_artist_artist_proto_msgTypes[29]
+	mi := &file_artist_artist_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1607,7 +1867,7 @@ func (x *LikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeRequest.ProtoReflect.Descriptor instead.
 func (*LikeRequest) Descriptor() ([]byte, []int) {
-	return file_artist_artist_proto_rawDescGZIP(), []int{29}
+	return file_artist_artist_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *LikeRequest) GetArtistId() *ArtistID {
@@ -1637,82 +1897,120 @@ var file_artist_artist_proto_rawDesc = []byte{
 	0x0a, 0x13, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x1a, 0x1b, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65,
-	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x41,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x49, 0x44, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75,
-	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62,
-	0x75, 0x6d, 0x49, 0x64, 0x12, 0x30, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
-	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x49, 0x64, 0x73, 0x22, 0x46, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x5a,
-	0x0a, 0x12, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
+	0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x71, 0x0a, 0x0e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x73, 0x12, 0x2a, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x4c, 0x69,
+	0x73, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x22, 0x4f, 0x0a,
+	0x0a, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x2d, 0x0a, 0x09, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x87,
+	0x01, 0x0a, 0x10, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12,
+	0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x2c, 0x0a, 0x07, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x0d, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x6c,
+	0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49,
+	0x64, 0x12, 0x33, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x73, 0x52, 0x06,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x14, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x73, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12,
+	0x33, 0x0a, 0x0a, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x49, 0x64, 0x73, 0x12, 0x2a, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64,
+	0x12, 0x30, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49,
+	0x64, 0x73, 0x22, 0x46, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12,
 	0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x0a, 0x41, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x45, 0x64, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x72, 0x74,
-	0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
+	0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x12, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x44,
+	0x12, 0x29, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c,
+	0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x77, 0x0a, 0x0a, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x45, 0x64, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x54, 0x69, 0x74, 0x6c, 0x65, 0x22,
+	0x71, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x27,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52,
+	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x3b, 0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f,
+	0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22,
+	0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c, 0x0a, 0x12, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49,
+	0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x09, 0x61,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10,
+	0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44,
+	0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x0c, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x10, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x19, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x22, 0x19, 0x0a, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a,
+	0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x03,
+	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69,
+	0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22,
+	0x30, 0x0a, 0x0b, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21,
+	0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72,
+	0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64,
+	0x73, 0x22, 0x53, 0x0a, 0x0a, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c,
 	0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c,
-	0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x54, 0x69,
-	0x74, 0x6c, 0x65, 0x22, 0x46, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65,
-	0x72, 0x79, 0x12, 0x27, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x3b, 0x0a, 0x0e, 0x41,
-	0x72, 0x74, 0x69, 0x73, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x29, 0x0a,
-	0x10, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x1a, 0x0a, 0x08, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x6c,
-	0x0a, 0x12, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x12, 0x2d, 0x0a, 0x09, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
-	0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x52, 0x08, 0x61, 0x72, 0x74, 0x69, 0x73,
-	0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x32, 0x0a, 0x0c,
-	0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x03,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73,
-	0x22, 0x19, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x19, 0x0a, 0x07, 0x41,
-	0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x30, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49,
-	0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x30, 0x0a, 0x0b, 0x41, 0x6c, 0x62, 0x75,
-	0x6d, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x53, 0x0a, 0x0a, 0x41, 0x72,
-	0x74, 0x69, 0x73, 0x74, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14,
-	0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x69,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22,
-	0x8f, 0x01, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69,
-	0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c,
-	0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74,
-	0x65, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61,
+	0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x41, 0x72, 0x74, 0x69, 0x73,
+	0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x69, 0x73, 0x5f, 0x66, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x69, 0x73,
+	0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x43, 0x6f, 0x6c, 0x6f,
+	0x72, 0x22, 0x8a, 0x01, 0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x44, 0x65, 0x74, 0x61,
 	0x69, 0x6c, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72,
 	0x74, 0x69, 0x73, 0x74, 0x52, 0x06, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f,
@@ -1796,7 +2094,7 @@ var file_artist_artist_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x55, 0x73,
 	0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07,
 	0x69, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
-	0x73, 0x4c, 0x69, 0x6b, 0x65, 0x32, 0xee, 0x09, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x4c, 0x69, 0x6b, 0x65, 0x32, 0xfa, 0x0a, 0x0a, 0x0d, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
 	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3e, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x41, 0x6c,
 	0x6c, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73,
 	0x74, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65,
@@ -1875,8 +2173,17 @@ var file_artist_artist_proto_rawDesc = []byte{
 	0x12, 0x1c, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74,
 	0x73, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a, 0x16,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x61, 0x72, 0x74, 0x69,
-	0x73, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x41, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x41, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74, 0x73, 0x12, 0x15, 0x2e, 0x61, 0x72, 0x74,
+	0x69, 0x73, 0x74, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x43, 0x72, 0x65, 0x64, 0x69, 0x74,
+	0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x47, 0x0a, 0x13, 0x53, 0x65, 0x74,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73,
+	0x12, 0x18, 0x2e, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x41,
+	0x72, 0x74, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
+	0x74, 0x79, 0x42, 0x0a, 0x5a, 0x08, 0x2e, 0x2f, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1891,111 +2198,128 @@ func file_artist_artist_proto_rawDescGZIP() []byte {
 	return file_artist_artist_proto_rawDescData
 }
 
-var file_artist_artist_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
+var file_artist_artist_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_artist_artist_proto_goTypes = []interface{}{
-	(*ArtistsIDWithAlbumID)(nil),       // 0: artist.ArtistsIDWithAlbumID
-	(*ArtistDelete)(nil),               // 1: artist.ArtistDelete
-	(*FiltersWithLabelID)(nil),         // 2: artist.FiltersWithLabelID
-	(*ArtistEdit)(nil),                 // 3: artist.ArtistEdit
-	(*Query)(nil),                      // 4: artist.Query
-	(*ArtistListened)(nil),             // 5: artist.ArtistListened
-	(*UserID)(nil),                     // 6: artist.UserID
-	(*ArtistID)(nil),                   // 7: artist.ArtistID
-	(*ArtistIDWithUserID)(nil),         // 8: artist.ArtistIDWithUserID
-	(*ArtistIDList)(nil),               // 9: artist.ArtistIDList
-	(*TrackID)(nil),                    // 10: artist.TrackID
-	(*AlbumID)(nil),                    // 11: artist.AlbumID
-	(*TrackIDList)(nil),                // 12: artist.TrackIDList
-	(*AlbumIDList)(nil),                // 13: artist.AlbumIDList
-	(*ArtistLoad)(nil),                 // 14: artist.ArtistLoad
-	(*Artist)(nil),                     // 15: artist.Artist
-	(*ArtistDetailed)(nil),             // 16: artist.ArtistDetailed
-	(*ArtistTitle)(nil),                // 17: artist.ArtistTitle
-	(*ArtistList)(nil),                 // 18: artist.ArtistList
-	(*ArtistWithTitle)(nil),            // 19: artist.ArtistWithTitle
-	(*ArtistWithTitleList)(nil),        // 20: artist.ArtistWithTitleList
-	(*ArtistWithTitleMap)(nil),         // 21: artist.ArtistWithTitleMap
-	(*ArtistWithRole)(nil),             // 22: artist.ArtistWithRole
-	(*ArtistWithRoleList)(nil),         // 23: artist.ArtistWithRoleList
-	(*ArtistWithRoleMap)(nil),          // 24: artist.ArtistWithRoleMap
-	(*Pagination)(nil),                 // 25: artist.Pagination
-	(*Filters)(nil),                    // 26: artist.Filters
-	(*FiltersWithUserID)(nil),          // 27: artist.FiltersWithUserID
-	(*ArtistStreamCreateDataList)(nil), // 28: artist.ArtistStreamCreateDataList
-	(*LikeRequest)(nil),                // 29: artist.LikeRequest
-	nil,                                // 30: artist.ArtistWithTitleMap.ArtistsEntry
-	nil,                                // 31: artist.ArtistWithRoleMap.ArtistsEntry
-	(*emptypb.Empty)(nil),              // 32: google.protobuf.Empty
+	(*TrackArtistIDs)(nil),             // 0: artist.TrackArtistIDs
+	(*ArtistRole)(nil),                 // 1: artist.ArtistRole
+	(*TrackArtistRoles)(nil),           // 2: artist.TrackArtistRoles
+	(*ArtistCredits)(nil),              // 3: artist.ArtistCredits
+	(*ArtistsIDWithAlbumID)(nil),       // 4: artist.ArtistsIDWithAlbumID
+	(*ArtistDelete)(nil),               // 5: artist.ArtistDelete
+	(*FiltersWithLabelID)(nil),         // 6: artist.FiltersWithLabelID
+	(*ArtistEdit)(nil),                 // 7: artist.ArtistEdit
+	(*Query)(nil),                      // 8: artist.Query
+	(*ArtistListened)(nil),             // 9: artist.ArtistListened
+	(*UserID)(nil),                     // 10: artist.UserID
+	(*ArtistID)(nil),                   // 11: artist.ArtistID
+	(*ArtistIDWithUserID)(nil),         // 12: artist.ArtistIDWithUserID
+	(*ArtistIDList)(nil),               // 13: artist.ArtistIDList
+	(*TrackID)(nil),                    // 14: artist.TrackID
+	(*AlbumID)(nil),                    // 15: artist.AlbumID
+	(*TrackIDList)(nil),                // 16: artist.TrackIDList
+	(*AlbumIDList)(nil),                // 17: artist.AlbumIDList
+	(*ArtistLoad)(nil),                 // 18: artist.ArtistLoad
+	(*Artist)(nil),                     // 19: artist.Artist
+	(*ArtistDetailed)(nil),             // 20: artist.ArtistDetailed
+	(*ArtistTitle)(nil),                // 21: artist.ArtistTitle
+	(*ArtistList)(nil),                 // 22: artist.ArtistList
+	(*ArtistWithTitle)(nil),            // 23: artist.ArtistWithTitle
+	(*ArtistWithTitleList)(nil),        // 24: artist.ArtistWithTitleList
+	(*ArtistWithTitleMap)(nil),         // 25: artist.ArtistWithTitleMap
+	(*ArtistWithRole)(nil),             // 26: artist.ArtistWithRole
+	(*ArtistWithRoleList)(nil),         // 27: artist.ArtistWithRoleList
+	(*ArtistWithRoleMap)(nil),          // 28: artist.ArtistWithRoleMap
+	(*Pagination)(nil),                 // 29: artist.Pagination
+	(*Filters)(nil),                    // 30: artist.Filters
+	(*FiltersWithUserID)(nil),          // 31: artist.FiltersWithUserID
+	(*ArtistStreamCreateDataList)(nil), // 32: artist.ArtistStreamCreateDataList
+	(*LikeRequest)(nil),                // 33: artist.LikeRequest
+	nil,                                // 34: artist.ArtistWithTitleMap.ArtistsEntry
+	nil,                                // 35: artist.ArtistWithRoleMap.ArtistsEntry
+	(*emptypb.Empty)(nil),              // 36: google.protobuf.Empty
 }
 var file_artist_artist_proto_depIdxs = []int32{
-	9,  // 0: artist.ArtistsIDWithAlbumID.artist_ids:type_name -> artist.ArtistIDList
-	11, // 1: artist.ArtistsIDWithAlbumID.album_id:type_name -> artist.AlbumID
-	12, // 2: artist.ArtistsIDWithAlbumID.track_ids:type_name -> artist.TrackIDList
-	26, // 3: artist.FiltersWithLabelID.filters:type_name -> artist.Filters
-	6,  // 4: artist.Query.user_id:type_name -> artist.UserID
-	7,  // 5: artist.ArtistIDWithUserID.artist_id:type_name -> artist.ArtistID
-	6,  // 6: artist.ArtistIDWithUserID.user_id:type_name -> artist.UserID
-	7,  // 7: artist.ArtistIDList.ids:type_name -> artist.ArtistID
-	10, // 8: artist.TrackIDList.ids:type_name -> artist.TrackID
-	11, // 9: artist.AlbumIDList.ids:type_name -> artist.AlbumID
-	15, // 10: artist.ArtistDetailed.artist:type_name -> artist.Artist
-	15, // 11: artist.ArtistList.artists:type_name -> artist.Artist
-	19, // 12: artist.ArtistWithTitleList.artists:type_name -> artist.ArtistWithTitle
-	30, // 13: artist.ArtistWithTitleMap.artists:type_name -> artist.ArtistWithTitleMap.ArtistsEntry
-	22, // 14: artist.ArtistWithRoleList.artists:type_name -> artist.ArtistWithRole
-	31, // 15: artist.ArtistWithRoleMap.artists:type_name -> artist.ArtistWithRoleMap.ArtistsEntry
-	25, // 16: artist.Filters.pagination:type_name -> artist.Pagination
-	26, // 17: artist.FiltersWithUserID.filters:type_name -> artist.Filters
-	6,  // 18: artist.FiltersWithUserID.user_id:type_name -> artist.UserID
-	9,  // 19: artist.ArtistStreamCreateDataList.artist_ids:type_name -> artist.ArtistIDList
-	6,  // 20: artist.ArtistStreamCreateDataList.user_id:type_name -> artist.UserID
-	7,  // 21: artist.LikeRequest.artist_id:type_name -> artist.ArtistID
-	6,  // 22: artist.LikeRequest.user_id:type_name -> artist.UserID
-	20, // 23: artist.ArtistWithTitleMap.ArtistsEntry.value:type_name -> artist.ArtistWithTitleList
-	23, // 24: artist.ArtistWithRoleMap.ArtistsEntry.value:type_name -> artist.ArtistWithRoleList
-	27, // 25: artist.ArtistService.GetAllArtists:input_type -> artist.FiltersWithUserID
-	8,  // 26: artist.ArtistService.GetArtistByID:input_type -> artist.ArtistIDWithUserID
-	7,  // 27: artist.ArtistService.GetArtistTitleByID:input_type -> artist.ArtistID
-	10, // 28: artist.ArtistService.GetArtistsByTrackID:input_type -> artist.TrackID
-	12, // 29: artist.ArtistService.GetArtistsByTrackIDs:input_type -> artist.TrackIDList
-	11, // 30: artist.ArtistService.GetArtistsByAlbumID:input_type -> artist.AlbumID
-	13, // 31: artist.ArtistService.GetArtistsByAlbumIDs:input_type -> artist.AlbumIDList
-	7,  // 32: artist.ArtistService.GetAlbumIDsByArtistID:input_type -> artist.ArtistID
-	7,  // 33: artist.ArtistService.GetTrackIDsByArtistID:input_type -> artist.ArtistID
-	28, // 34: artist.ArtistService.CreateStreamsByArtistIDs:input_type -> artist.ArtistStreamCreateDataList
-	6,  // 35: artist.ArtistService.GetArtistsListenedByUserID:input_type -> artist.UserID
-	29, // 36: artist.ArtistService.LikeArtist:input_type -> artist.LikeRequest
-	27, // 37: artist.ArtistService.GetFavoriteArtists:input_type -> artist.FiltersWithUserID
-	4,  // 38: artist.ArtistService.SearchArtists:input_type -> artist.Query
-	14, // 39: artist.ArtistService.CreateArtist:input_type -> artist.ArtistLoad
-	3,  // 40: artist.ArtistService.EditArtist:input_type -> artist.ArtistEdit
-	2,  // 41: artist.ArtistService.GetArtistsLabelID:input_type -> artist.FiltersWithLabelID
-	1,  // 42: artist.ArtistService.DeleteArtist:input_type -> artist.ArtistDelete
-	0,  // 43: artist.ArtistService.ConnectArtists:input_type -> artist.ArtistsIDWithAlbumID
-	18, // 44: artist.ArtistService.GetAllArtists:output_type -> artist.ArtistList
-	16, // 45: artist.ArtistService.GetArtistByID:output_type -> artist.ArtistDetailed
-	17, // 46: artist.ArtistService.GetArtistTitleByID:output_type -> artist.ArtistTitle
-	23, // 47: artist.ArtistService.GetArtistsByTrackID:output_type -> artist.ArtistWithRoleList
-	24, // 48: artist.ArtistService.GetArtistsByTrackIDs:output_type -> artist.ArtistWithRoleMap
-	20, // 49: artist.ArtistService.GetArtistsByAlbumID:output_type -> artist.ArtistWithTitleList
-	21, // 50: artist.ArtistService.GetArtistsByAlbumIDs:output_type -> artist.ArtistWithTitleMap
-	13, // 51: artist.ArtistService.GetAlbumIDsByArtistID:output_type -> artist.AlbumIDList
-	12, // 52: artist.ArtistService.GetTrackIDsByArtistID:output_type -> artist.TrackIDList
-	32, // 53: artist.ArtistService.CreateStreamsByArtistIDs:output_type -> google.protobuf.Empty
-	5,  // 54: artist.ArtistService.GetArtistsListenedByUserID:output_type -> artist.ArtistListened
-	32, // 55: artist.ArtistService.LikeArtist:output_type -> google.protobuf.Empty
-	18, // 56: artist.ArtistService.GetFavoriteArtists:output_type -> artist.ArtistList
-	18, // 57: artist.ArtistService.SearchArtists:output_type -> artist.ArtistList
-	15, // 58: artist.ArtistService.CreateArtist:output_type -> artist.Artist
-	15, // 59: artist.ArtistService.EditArtist:output_type -> artist.Artist
-	18, // 60: artist.ArtistService.GetArtistsLabelID:output_type -> artist.ArtistList
-	32, // 61: artist.ArtistService.DeleteArtist:output_type -> google.protobuf.Empty
-	32, // 62: artist.ArtistService.ConnectArtists:output_type -> google.protobuf.Empty
-	44, // [44:63] is the sub-list for method output_type
-	25, // [25:44] is the sub-list for method input_type
-	25, // [25:25] is the sub-list for extension type_name
-	25, // [25:25] is the sub-list for extension extendee
-	0,  // [0:25] is the sub-list for field type_name
+	14, // 0: artist.TrackArtistIDs.track_id:type_name -> artist.TrackID
+	13, // 1: artist.TrackArtistIDs.artist_ids:type_name -> artist.ArtistIDList
+	11, // 2: artist.ArtistRole.artist_id:type_name -> artist.ArtistID
+	14, // 3: artist.TrackArtistRoles.track_id:type_name -> artist.TrackID
+	1,  // 4: artist.TrackArtistRoles.artists:type_name -> artist.ArtistRole
+	15, // 5: artist.ArtistCredits.album_id:type_name -> artist.AlbumID
+	13, // 6: artist.ArtistCredits.artist_ids:type_name -> artist.ArtistIDList
+	0,  // 7: artist.ArtistCredits.tracks:type_name -> artist.TrackArtistIDs
+	13, // 8: artist.ArtistsIDWithAlbumID.artist_ids:type_name -> artist.ArtistIDList
+	15, // 9: artist.ArtistsIDWithAlbumID.album_id:type_name -> artist.AlbumID
+	16, // 10: artist.ArtistsIDWithAlbumID.track_ids:type_name -> artist.TrackIDList
+	30, // 11: artist.FiltersWithLabelID.filters:type_name -> artist.Filters
+	10, // 12: artist.Query.user_id:type_name -> artist.UserID
+	30, // 13: artist.Query.filters:type_name -> artist.Filters
+	11, // 14: artist.ArtistIDWithUserID.artist_id:type_name -> artist.ArtistID
+	10, // 15: artist.ArtistIDWithUserID.user_id:type_name -> artist.UserID
+	11, // 16: artist.ArtistIDList.ids:type_name -> artist.ArtistID
+	14, // 17: artist.TrackIDList.ids:type_name -> artist.TrackID
+	15, // 18: artist.AlbumIDList.ids:type_name -> artist.AlbumID
+	19, // 19: artist.ArtistDetailed.artist:type_name -> artist.Artist
+	19, // 20: artist.ArtistList.artists:type_name -> artist.Artist
+	23, // 21: artist.ArtistWithTitleList.artists:type_name -> artist.ArtistWithTitle
+	34, // 22: artist.ArtistWithTitleMap.artists:type_name -> artist.ArtistWithTitleMap.ArtistsEntry
+	26, // 23: artist.ArtistWithRoleList.artists:type_name -> artist.ArtistWithRole
+	35, // 24: artist.ArtistWithRoleMap.artists:type_name -> artist.ArtistWithRoleMap.ArtistsEntry
+	29, // 25: artist.Filters.pagination:type_name -> artist.Pagination
+	30, // 26: artist.FiltersWithUserID.filters:type_name -> artist.Filters
+	10, // 27: artist.FiltersWithUserID.user_id:type_name -> artist.UserID
+	13, // 28: artist.ArtistStreamCreateDataList.artist_ids:type_name -> artist.ArtistIDList
+	10, // 29: artist.ArtistStreamCreateDataList.user_id:type_name -> artist.UserID
+	11, // 30: artist.LikeRequest.artist_id:type_name -> artist.ArtistID
+	10, // 31: artist.LikeRequest.user_id:type_name -> artist.UserID
+	24, // 32: artist.ArtistWithTitleMap.ArtistsEntry.value:type_name -> artist.ArtistWithTitleList
+	27, // 33: artist.ArtistWithRoleMap.ArtistsEntry.value:type_name -> artist.ArtistWithRoleList
+	31, // 34: artist.ArtistService.GetAllArtists:input_type -> artist.FiltersWithUserID
+	12, // 35: artist.ArtistService.GetArtistByID:input_type -> artist.ArtistIDWithUserID
+	11, // 36: artist.ArtistService.GetArtistTitleByID:input_type -> artist.ArtistID
+	14, // 37: artist.ArtistService.GetArtistsByTrackID:input_type -> artist.TrackID
+	16, // 38: artist.ArtistService.GetArtistsByTrackIDs:input_type -> artist.TrackIDList
+	15, // 39: artist.ArtistService.GetArtistsByAlbumID:input_type -> artist.AlbumID
+	17, // 40: artist.ArtistService.GetArtistsByAlbumIDs:input_type -> artist.AlbumIDList
+	11, // 41: artist.ArtistService.GetAlbumIDsByArtistID:input_type -> artist.ArtistID
+	11, // 42: artist.ArtistService.GetTrackIDsByArtistID:input_type -> artist.ArtistID
+	32, // 43: artist.ArtistService.CreateStreamsByArtistIDs:input_type -> artist.ArtistStreamCreateDataList
+	10, // 44: artist.ArtistService.GetArtistsListenedByUserID:input_type -> artist.UserID
+	33, // 45: artist.ArtistService.LikeArtist:input_type -> artist.LikeRequest
+	31, // 46: artist.ArtistService.GetFavoriteArtists:input_type -> artist.FiltersWithUserID
+	8,  // 47: artist.ArtistService.SearchArtists:input_type -> artist.Query
+	18, // 48: artist.ArtistService.CreateArtist:input_type -> artist.ArtistLoad
+	7,  // 49: artist.ArtistService.EditArtist:input_type -> artist.ArtistEdit
+	6,  // 50: artist.ArtistService.GetArtistsLabelID:input_type -> artist.FiltersWithLabelID
+	5,  // 51: artist.ArtistService.DeleteArtist:input_type -> artist.ArtistDelete
+	4,  // 52: artist.ArtistService.ConnectArtists:input_type -> artist.ArtistsIDWithAlbumID
+	3,  // 53: artist.ArtistService.SetArtistCredits:input_type -> artist.ArtistCredits
+	2,  // 54: artist.ArtistService.SetTrackArtistRoles:input_type -> artist.TrackArtistRoles
+	22, // 55: artist.ArtistService.GetAllArtists:output_type -> artist.ArtistList
+	20, // 56: artist.ArtistService.GetArtistByID:output_type -> artist.ArtistDetailed
+	21, // 57: artist.ArtistService.GetArtistTitleByID:output_type -> artist.ArtistTitle
+	27, // 58: artist.ArtistService.GetArtistsByTrackID:output_type -> artist.ArtistWithRoleList
+	28, // 59: artist.ArtistService.GetArtistsByTrackIDs:output_type -> artist.ArtistWithRoleMap
+	24, // 60: artist.ArtistService.GetArtistsByAlbumID:output_type -> artist.ArtistWithTitleList
+	25, // 61: artist.ArtistService.GetArtistsByAlbumIDs:output_type -> artist.ArtistWithTitleMap
+	17, // 62: artist.ArtistService.GetAlbumIDsByArtistID:output_type -> artist.AlbumIDList
+	16, // 63: artist.ArtistService.GetTrackIDsByArtistID:output_type -> artist.TrackIDList
+	36, // 64: artist.ArtistService.CreateStreamsByArtistIDs:output_type -> google.protobuf.Empty
+	9,  // 65: artist.ArtistService.GetArtistsListenedByUserID:output_type -> artist.ArtistListened
+	36, // 66: artist.ArtistService.LikeArtist:output_type -> google.protobuf.Empty
+	22, // 67: artist.ArtistService.GetFavoriteArtists:output_type -> artist.ArtistList
+	22, // 68: artist.ArtistService.SearchArtists:output_type -> artist.ArtistList
+	19, // 69: artist.ArtistService.CreateArtist:output_type -> artist.Artist
+	19, // 70: artist.ArtistService.EditArtist:output_type -> artist.Artist
+	22, // 71: artist.ArtistService.GetArtistsLabelID:output_type -> artist.ArtistList
+	36, // 72: artist.ArtistService.DeleteArtist:output_type -> google.protobuf.Empty
+	36, // 73: artist.ArtistService.ConnectArtists:output_type -> google.protobuf.Empty
+	36, // 74: artist.ArtistService.SetArtistCredits:output_type -> google.protobuf.Empty
+	36, // 75: artist.ArtistService.SetTrackArtistRoles:output_type -> google.protobuf.Empty
+	55, // [55:76] is the sub-list for method output_type
+	34, // [34:55] is the sub-list for method input_type
+	34, // [34:34] is the sub-list for extension type_name
+	34, // [34:34] is the sub-list for extension extendee
+	0,  // [0:34] is the sub-list for field type_name
 }
 
 func init() { file_artist_artist_proto_init() }
@@ -2005,7 +2329,7 @@ func file_artist_artist_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_artist_artist_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistsIDWithAlbumID); i {
+			switch v := v.(*TrackArtistIDs); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2017,7 +2341,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistDelete); i {
+			switch v := v.(*ArtistRole); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2029,7 +2353,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FiltersWithLabelID); i {
+			switch v := v.(*TrackArtistRoles); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2041,7 +2365,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistEdit); i {
+			switch v := v.(*ArtistCredits); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2053,7 +2377,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Query); i {
+			switch v := v.(*ArtistsIDWithAlbumID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2065,7 +2389,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistListened); i {
+			switch v := v.(*ArtistDelete); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2077,7 +2401,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UserID); i {
+			switch v := v.(*FiltersWithLabelID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2089,7 +2413,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistID); i {
+			switch v := v.(*ArtistEdit); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2101,7 +2425,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistIDWithUserID); i {
+			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2113,7 +2437,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistIDList); i {
+			switch v := v.(*ArtistListened); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2125,7 +2449,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackID); i {
+			switch v := v.(*UserID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2137,7 +2461,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumID); i {
+			switch v := v.(*ArtistID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2149,7 +2473,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TrackIDList); i {
+			switch v := v.(*ArtistIDWithUserID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2161,7 +2485,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AlbumIDList); i {
+			switch v := v.(*ArtistIDList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2173,7 +2497,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistLoad); i {
+			switch v := v.(*TrackID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2185,7 +2509,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Artist); i {
+			switch v := v.(*AlbumID); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2197,7 +2521,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistDetailed); i {
+			switch v := v.(*TrackIDList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2209,7 +2533,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistTitle); i {
+			switch v := v.(*AlbumIDList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2221,7 +2545,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistList); i {
+			switch v := v.(*ArtistLoad); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2233,7 +2557,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistWithTitle); i {
+			switch v := v.(*Artist); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2245,7 +2569,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistWithTitleList); i {
+			switch v := v.(*ArtistDetailed); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2257,7 +2581,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistWithTitleMap); i {
+			switch v := v.(*ArtistTitle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2269,7 +2593,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistWithRole); i {
+			switch v := v.(*ArtistList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2281,7 +2605,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistWithRoleList); i {
+			switch v := v.(*ArtistWithTitle); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2293,7 +2617,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistWithRoleMap); i {
+			switch v := v.(*ArtistWithTitleList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2305,7 +2629,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Pagination); i {
+			switch v := v.(*ArtistWithTitleMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2317,7 +2641,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Filters); i {
+			switch v := v.(*ArtistWithRole); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2329,7 +2653,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FiltersWithUserID); i {
+			switch v := v.(*ArtistWithRoleList); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2341,7 +2665,7 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArtistStreamCreateDataList); i {
+			switch v := v.(*ArtistWithRoleMap); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2353,6 +2677,54 @@ func file_artist_artist_proto_init() {
 			}
 		}
 		file_artist_artist_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Pagination); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_artist_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Filters); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_artist_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FiltersWithUserID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_artist_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtistStreamCreateDataList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_artist_artist_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LikeRequest); i {
 			case 0:
 				return &v.state
@@ -2371,7 +2743,7 @@ func file_artist_artist_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_artist_artist_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   32,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
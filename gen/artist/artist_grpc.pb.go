@@ -38,6 +38,8 @@ type ArtistServiceClient interface {
 	GetArtistsLabelID(ctx context.Context, in *FiltersWithLabelID, opts ...grpc.CallOption) (*ArtistList, error)
 	DeleteArtist(ctx context.Context, in *ArtistDelete, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ConnectArtists(ctx context.Context, in *ArtistsIDWithAlbumID, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetArtistCredits(ctx context.Context, in *ArtistCredits, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetTrackArtistRoles(ctx context.Context, in *TrackArtistRoles, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type artistServiceClient struct {
@@ -219,6 +221,24 @@ func (c *artistServiceClient) ConnectArtists(ctx context.Context, in *ArtistsIDW
 	return out, nil
 }
 
+func (c *artistServiceClient) SetArtistCredits(ctx context.Context, in *ArtistCredits, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/SetArtistCredits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *artistServiceClient) SetTrackArtistRoles(ctx context.Context, in *TrackArtistRoles, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/artist.ArtistService/SetTrackArtistRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ArtistServiceServer is the server API for ArtistService service.
 // All implementations must embed UnimplementedArtistServiceServer
 // for forward compatibility
@@ -242,6 +262,8 @@ type ArtistServiceServer interface {
 	GetArtistsLabelID(context.Context, *FiltersWithLabelID) (*ArtistList, error)
 	DeleteArtist(context.Context, *ArtistDelete) (*emptypb.Empty, error)
 	ConnectArtists(context.Context, *ArtistsIDWithAlbumID) (*emptypb.Empty, error)
+	SetArtistCredits(context.Context, *ArtistCredits) (*emptypb.Empty, error)
+	SetTrackArtistRoles(context.Context, *TrackArtistRoles) (*emptypb.Empty, error)
 	mustEmbedUnimplementedArtistServiceServer()
 }
 
@@ -306,6 +328,12 @@ func (UnimplementedArtistServiceServer) DeleteArtist(context.Context, *ArtistDel
 func (UnimplementedArtistServiceServer) ConnectArtists(context.Context, *ArtistsIDWithAlbumID) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ConnectArtists not implemented")
 }
+func (UnimplementedArtistServiceServer) SetArtistCredits(context.Context, *ArtistCredits) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetArtistCredits not implemented")
+}
+func (UnimplementedArtistServiceServer) SetTrackArtistRoles(context.Context, *TrackArtistRoles) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTrackArtistRoles not implemented")
+}
 func (UnimplementedArtistServiceServer) mustEmbedUnimplementedArtistServiceServer() {}
 
 // UnsafeArtistServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -661,6 +689,42 @@ func _ArtistService_ConnectArtists_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArtistService_SetArtistCredits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArtistCredits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).SetArtistCredits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/SetArtistCredits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).SetArtistCredits(ctx, req.(*ArtistCredits))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ArtistService_SetTrackArtistRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackArtistRoles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArtistServiceServer).SetTrackArtistRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/artist.ArtistService/SetTrackArtistRoles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArtistServiceServer).SetTrackArtistRoles(ctx, req.(*TrackArtistRoles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ArtistService_ServiceDesc is the grpc.ServiceDesc for ArtistService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -744,6 +808,14 @@ var ArtistService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ConnectArtists",
 			Handler:    _ArtistService_ConnectArtists_Handler,
 		},
+		{
+			MethodName: "SetArtistCredits",
+			Handler:    _ArtistService_SetArtistCredits_Handler,
+		},
+		{
+			MethodName: "SetTrackArtistRoles",
+			Handler:    _ArtistService_SetTrackArtistRoles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "artist/artist.proto",
@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -21,16 +22,16 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
-type TrackIdsList struct {
+type TrackFileKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids []*TrackID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	FileKey string `protobuf:"bytes,1,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
 }
 
-func (x *TrackIdsList) Reset() {
-	*x = TrackIdsList{}
+func (x *TrackFileKey) Reset() {
+	*x = TrackFileKey{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[0]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -38,13 +39,13 @@ func (x *TrackIdsList) Reset() {
 	}
 }
 
-func (x *TrackIdsList) String() string {
+func (x *TrackFileKey) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackIdsList) ProtoMessage() {}
+func (*TrackFileKey) ProtoMessage() {}
 
-func (x *TrackIdsList) ProtoReflect() protoreflect.Message {
+func (x *TrackFileKey) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[0]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -56,29 +57,36 @@ func (x *TrackIdsList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackIdsList.ProtoReflect.Descriptor instead.
-func (*TrackIdsList) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackFileKey.ProtoReflect.Descriptor instead.
+func (*TrackFileKey) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{0}
 }
 
-func (x *TrackIdsList) GetIds() []*TrackID {
+func (x *TrackFileKey) GetFileKey() string {
 	if x != nil {
-		return x.Ids
+		return x.FileKey
 	}
-	return nil
+	return ""
 }
 
-type TrackLoad struct {
+type TrackMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	File  []byte `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	Format      string   `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Title       string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	TrackNumber int64    `protobuf:"varint,3,opt,name=track_number,json=trackNumber,proto3" json:"track_number,omitempty"`
+	Artists     []string `protobuf:"bytes,4,rep,name=artists,proto3" json:"artists,omitempty"`
+	Genre       string   `protobuf:"bytes,5,opt,name=genre,proto3" json:"genre,omitempty"`
+	Year        int64    `protobuf:"varint,6,opt,name=year,proto3" json:"year,omitempty"`
+	Isrc        string   `protobuf:"bytes,7,opt,name=isrc,proto3" json:"isrc,omitempty"`
+	CoverKey    string   `protobuf:"bytes,8,opt,name=cover_key,json=coverKey,proto3" json:"cover_key,omitempty"`
+	CoverUrl    string   `protobuf:"bytes,9,opt,name=cover_url,json=coverUrl,proto3" json:"cover_url,omitempty"`
 }
 
-func (x *TrackLoad) Reset() {
-	*x = TrackLoad{}
+func (x *TrackMetadata) Reset() {
+	*x = TrackMetadata{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -86,13 +94,13 @@ func (x *TrackLoad) Reset() {
 	}
 }
 
-func (x *TrackLoad) String() string {
+func (x *TrackMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackLoad) ProtoMessage() {}
+func (*TrackMetadata) ProtoMessage() {}
 
-func (x *TrackLoad) ProtoReflect() protoreflect.Message {
+func (x *TrackMetadata) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -104,37 +112,87 @@ func (x *TrackLoad) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackLoad.ProtoReflect.Descriptor instead.
-func (*TrackLoad) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackMetadata.ProtoReflect.Descriptor instead.
+func (*TrackMetadata) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *TrackLoad) GetTitle() string {
+func (x *TrackMetadata) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *TrackMetadata) GetTitle() string {
 	if x != nil {
 		return x.Title
 	}
 	return ""
 }
 
-func (x *TrackLoad) GetFile() []byte {
+func (x *TrackMetadata) GetTrackNumber() int64 {
+	if x != nil {
+		return x.TrackNumber
+	}
+	return 0
+}
+
+func (x *TrackMetadata) GetArtists() []string {
 	if x != nil {
-		return x.File
+		return x.Artists
 	}
 	return nil
 }
 
-type TracksListWithAlbumID struct {
+func (x *TrackMetadata) GetGenre() string {
+	if x != nil {
+		return x.Genre
+	}
+	return ""
+}
+
+func (x *TrackMetadata) GetYear() int64 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *TrackMetadata) GetIsrc() string {
+	if x != nil {
+		return x.Isrc
+	}
+	return ""
+}
+
+func (x *TrackMetadata) GetCoverKey() string {
+	if x != nil {
+		return x.CoverKey
+	}
+	return ""
+}
+
+func (x *TrackMetadata) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+type DuplicateFlagTrack struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tracks  []*TrackLoad `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
-	AlbumId *AlbumID     `protobuf:"bytes,2,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
-	Cover   []byte       `protobuf:"bytes,3,opt,name=cover,proto3" json:"cover,omitempty"`
+	Id      *TrackID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title   string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	AlbumId *AlbumID `protobuf:"bytes,3,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	LabelId int64    `protobuf:"varint,4,opt,name=label_id,json=labelId,proto3" json:"label_id,omitempty"`
 }
 
-func (x *TracksListWithAlbumID) Reset() {
-	*x = TracksListWithAlbumID{}
+func (x *DuplicateFlagTrack) Reset() {
+	*x = DuplicateFlagTrack{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -142,13 +200,13 @@ func (x *TracksListWithAlbumID) Reset() {
 	}
 }
 
-func (x *TracksListWithAlbumID) String() string {
+func (x *DuplicateFlagTrack) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TracksListWithAlbumID) ProtoMessage() {}
+func (*DuplicateFlagTrack) ProtoMessage() {}
 
-func (x *TracksListWithAlbumID) ProtoReflect() protoreflect.Message {
+func (x *DuplicateFlagTrack) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -160,43 +218,54 @@ func (x *TracksListWithAlbumID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TracksListWithAlbumID.ProtoReflect.Descriptor instead.
-func (*TracksListWithAlbumID) Descriptor() ([]byte, []int) {
+// Deprecated: Use DuplicateFlagTrack.ProtoReflect.Descriptor instead.
+func (*DuplicateFlagTrack) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *TracksListWithAlbumID) GetTracks() []*TrackLoad {
+func (x *DuplicateFlagTrack) GetId() *TrackID {
 	if x != nil {
-		return x.Tracks
+		return x.Id
 	}
 	return nil
 }
 
-func (x *TracksListWithAlbumID) GetAlbumId() *AlbumID {
+func (x *DuplicateFlagTrack) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DuplicateFlagTrack) GetAlbumId() *AlbumID {
 	if x != nil {
 		return x.AlbumId
 	}
 	return nil
 }
 
-func (x *TracksListWithAlbumID) GetCover() []byte {
+func (x *DuplicateFlagTrack) GetLabelId() int64 {
 	if x != nil {
-		return x.Cover
+		return x.LabelId
 	}
-	return nil
+	return 0
 }
 
-type Query struct {
+type DuplicateFlag struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Query  string  `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
-	UserId *UserID `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Track        *DuplicateFlagTrack    `protobuf:"bytes,2,opt,name=track,proto3" json:"track,omitempty"`
+	MatchedTrack *DuplicateFlagTrack    `protobuf:"bytes,3,opt,name=matched_track,json=matchedTrack,proto3" json:"matched_track,omitempty"`
+	Similarity   float64                `protobuf:"fixed64,4,opt,name=similarity,proto3" json:"similarity,omitempty"`
+	Status       string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	CreatedAt    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 }
 
-func (x *Query) Reset() {
-	*x = Query{}
+func (x *DuplicateFlag) Reset() {
+	*x = DuplicateFlag{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -204,13 +273,13 @@ func (x *Query) Reset() {
 	}
 }
 
-func (x *Query) String() string {
+func (x *DuplicateFlag) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Query) ProtoMessage() {}
+func (*DuplicateFlag) ProtoMessage() {}
 
-func (x *Query) ProtoReflect() protoreflect.Message {
+func (x *DuplicateFlag) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -222,35 +291,63 @@ func (x *Query) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Query.ProtoReflect.Descriptor instead.
-func (*Query) Descriptor() ([]byte, []int) {
+// Deprecated: Use DuplicateFlag.ProtoReflect.Descriptor instead.
+func (*DuplicateFlag) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *Query) GetQuery() string {
+func (x *DuplicateFlag) GetId() int64 {
 	if x != nil {
-		return x.Query
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DuplicateFlag) GetTrack() *DuplicateFlagTrack {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *DuplicateFlag) GetMatchedTrack() *DuplicateFlagTrack {
+	if x != nil {
+		return x.MatchedTrack
+	}
+	return nil
+}
+
+func (x *DuplicateFlag) GetSimilarity() float64 {
+	if x != nil {
+		return x.Similarity
+	}
+	return 0
+}
+
+func (x *DuplicateFlag) GetStatus() string {
+	if x != nil {
+		return x.Status
 	}
 	return ""
 }
 
-func (x *Query) GetUserId() *UserID {
+func (x *DuplicateFlag) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type AlbumID struct {
+type DuplicateFlagList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Flags []*DuplicateFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
 }
 
-func (x *AlbumID) Reset() {
-	*x = AlbumID{}
+func (x *DuplicateFlagList) Reset() {
+	*x = DuplicateFlagList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -258,13 +355,13 @@ func (x *AlbumID) Reset() {
 	}
 }
 
-func (x *AlbumID) String() string {
+func (x *DuplicateFlagList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlbumID) ProtoMessage() {}
+func (*DuplicateFlagList) ProtoMessage() {}
 
-func (x *AlbumID) ProtoReflect() protoreflect.Message {
+func (x *DuplicateFlagList) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -276,29 +373,29 @@ func (x *AlbumID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlbumID.ProtoReflect.Descriptor instead.
-func (*AlbumID) Descriptor() ([]byte, []int) {
+// Deprecated: Use DuplicateFlagList.ProtoReflect.Descriptor instead.
+func (*DuplicateFlagList) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *AlbumID) GetId() int64 {
+func (x *DuplicateFlagList) GetFlags() []*DuplicateFlag {
 	if x != nil {
-		return x.Id
+		return x.Flags
 	}
-	return 0
+	return nil
 }
 
-type AlbumIDWithUserID struct {
+type DuplicateFlagResolution struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AlbumId *AlbumID `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
-	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id     int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
 }
 
-func (x *AlbumIDWithUserID) Reset() {
-	*x = AlbumIDWithUserID{}
+func (x *DuplicateFlagResolution) Reset() {
+	*x = DuplicateFlagResolution{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -306,13 +403,13 @@ func (x *AlbumIDWithUserID) Reset() {
 	}
 }
 
-func (x *AlbumIDWithUserID) String() string {
+func (x *DuplicateFlagResolution) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AlbumIDWithUserID) ProtoMessage() {}
+func (*DuplicateFlagResolution) ProtoMessage() {}
 
-func (x *AlbumIDWithUserID) ProtoReflect() protoreflect.Message {
+func (x *DuplicateFlagResolution) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -324,35 +421,37 @@ func (x *AlbumIDWithUserID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AlbumIDWithUserID.ProtoReflect.Descriptor instead.
-func (*AlbumIDWithUserID) Descriptor() ([]byte, []int) {
+// Deprecated: Use DuplicateFlagResolution.ProtoReflect.Descriptor instead.
+func (*DuplicateFlagResolution) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *AlbumIDWithUserID) GetAlbumId() *AlbumID {
+func (x *DuplicateFlagResolution) GetId() int64 {
 	if x != nil {
-		return x.AlbumId
+		return x.Id
 	}
-	return nil
+	return 0
 }
 
-func (x *AlbumIDWithUserID) GetUserId() *UserID {
+func (x *DuplicateFlagResolution) GetStatus() string {
 	if x != nil {
-		return x.UserId
+		return x.Status
 	}
-	return nil
+	return ""
 }
 
-type MinutesListened struct {
+type TrackUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Minutes int64 `protobuf:"varint,1,opt,name=minutes,proto3" json:"minutes,omitempty"`
+	Id       *TrackID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title    string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Position int64    `protobuf:"varint,3,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *MinutesListened) Reset() {
-	*x = MinutesListened{}
+func (x *TrackUpdate) Reset() {
+	*x = TrackUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -360,13 +459,13 @@ func (x *MinutesListened) Reset() {
 	}
 }
 
-func (x *MinutesListened) String() string {
+func (x *TrackUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinutesListened) ProtoMessage() {}
+func (*TrackUpdate) ProtoMessage() {}
 
-func (x *MinutesListened) ProtoReflect() protoreflect.Message {
+func (x *TrackUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -378,28 +477,43 @@ func (x *MinutesListened) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinutesListened.ProtoReflect.Descriptor instead.
-func (*MinutesListened) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackUpdate.ProtoReflect.Descriptor instead.
+func (*TrackUpdate) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *MinutesListened) GetMinutes() int64 {
+func (x *TrackUpdate) GetId() *TrackID {
 	if x != nil {
-		return x.Minutes
+		return x.Id
+	}
+	return nil
+}
+
+func (x *TrackUpdate) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *TrackUpdate) GetPosition() int64 {
+	if x != nil {
+		return x.Position
 	}
 	return 0
 }
 
-type TracksListened struct {
+type AlbumTracksUpdate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tracks int64 `protobuf:"varint,1,opt,name=tracks,proto3" json:"tracks,omitempty"`
+	AlbumId *AlbumID       `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	Tracks  []*TrackUpdate `protobuf:"bytes,2,rep,name=tracks,proto3" json:"tracks,omitempty"`
 }
 
-func (x *TracksListened) Reset() {
-	*x = TracksListened{}
+func (x *AlbumTracksUpdate) Reset() {
+	*x = AlbumTracksUpdate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,13 +521,13 @@ func (x *TracksListened) Reset() {
 	}
 }
 
-func (x *TracksListened) String() string {
+func (x *AlbumTracksUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TracksListened) ProtoMessage() {}
+func (*AlbumTracksUpdate) ProtoMessage() {}
 
-func (x *TracksListened) ProtoReflect() protoreflect.Message {
+func (x *AlbumTracksUpdate) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -425,33 +539,38 @@ func (x *TracksListened) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TracksListened.ProtoReflect.Descriptor instead.
-func (*TracksListened) Descriptor() ([]byte, []int) {
+// Deprecated: Use AlbumTracksUpdate.ProtoReflect.Descriptor instead.
+func (*AlbumTracksUpdate) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TracksListened) GetTracks() int64 {
+func (x *AlbumTracksUpdate) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *AlbumTracksUpdate) GetTracks() []*TrackUpdate {
 	if x != nil {
 		return x.Tracks
 	}
-	return 0
+	return nil
 }
 
-type Track struct {
+type TrackEdit struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title      string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Thumbnail  string `protobuf:"bytes,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
-	Duration   int64  `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
-	AlbumId    int64  `protobuf:"varint,5,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
-	IsFavorite bool   `protobuf:"varint,6,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	Id       *TrackID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlbumId  *AlbumID `protobuf:"bytes,2,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	Title    string   `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Position int64    `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
 }
 
-func (x *Track) Reset() {
-	*x = Track{}
+func (x *TrackEdit) Reset() {
+	*x = TrackEdit{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -459,13 +578,13 @@ func (x *Track) Reset() {
 	}
 }
 
-func (x *Track) String() string {
+func (x *TrackEdit) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Track) ProtoMessage() {}
+func (*TrackEdit) ProtoMessage() {}
 
-func (x *Track) ProtoReflect() protoreflect.Message {
+func (x *TrackEdit) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -477,63 +596,51 @@ func (x *Track) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Track.ProtoReflect.Descriptor instead.
-func (*Track) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackEdit.ProtoReflect.Descriptor instead.
+func (*TrackEdit) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Track) GetId() int64 {
+func (x *TrackEdit) GetId() *TrackID {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return nil
 }
 
-func (x *Track) GetTitle() string {
+func (x *TrackEdit) GetAlbumId() *AlbumID {
 	if x != nil {
-		return x.Title
+		return x.AlbumId
 	}
-	return ""
+	return nil
 }
 
-func (x *Track) GetThumbnail() string {
+func (x *TrackEdit) GetTitle() string {
 	if x != nil {
-		return x.Thumbnail
+		return x.Title
 	}
 	return ""
 }
 
-func (x *Track) GetDuration() int64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
-}
-
-func (x *Track) GetAlbumId() int64 {
+func (x *TrackEdit) GetPosition() int64 {
 	if x != nil {
-		return x.AlbumId
+		return x.Position
 	}
 	return 0
 }
 
-func (x *Track) GetIsFavorite() bool {
-	if x != nil {
-		return x.IsFavorite
-	}
-	return false
-}
-
-type TrackList struct {
+type TrackAudioReplace struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tracks []*Track `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	Id      *TrackID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlbumId *AlbumID `protobuf:"bytes,2,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	FileKey string   `protobuf:"bytes,3,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
 }
 
-func (x *TrackList) Reset() {
-	*x = TrackList{}
+func (x *TrackAudioReplace) Reset() {
+	*x = TrackAudioReplace{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,13 +648,13 @@ func (x *TrackList) Reset() {
 	}
 }
 
-func (x *TrackList) String() string {
+func (x *TrackAudioReplace) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackList) ProtoMessage() {}
+func (*TrackAudioReplace) ProtoMessage() {}
 
-func (x *TrackList) ProtoReflect() protoreflect.Message {
+func (x *TrackAudioReplace) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -559,28 +666,46 @@ func (x *TrackList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackList.ProtoReflect.Descriptor instead.
-func (*TrackList) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackAudioReplace.ProtoReflect.Descriptor instead.
+func (*TrackAudioReplace) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TrackList) GetTracks() []*Track {
+func (x *TrackAudioReplace) GetId() *TrackID {
 	if x != nil {
-		return x.Tracks
+		return x.Id
 	}
 	return nil
 }
 
-type TrackID struct {
+func (x *TrackAudioReplace) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *TrackAudioReplace) GetFileKey() string {
+	if x != nil {
+		return x.FileKey
+	}
+	return ""
+}
+
+type TrackLyricsUpload struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id          *TrackID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlbumId     *AlbumID `protobuf:"bytes,2,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	Language    string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
+	Translation bool     `protobuf:"varint,4,opt,name=translation,proto3" json:"translation,omitempty"`
+	Text        string   `protobuf:"bytes,5,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *TrackID) Reset() {
-	*x = TrackID{}
+func (x *TrackLyricsUpload) Reset() {
+	*x = TrackLyricsUpload{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -588,13 +713,13 @@ func (x *TrackID) Reset() {
 	}
 }
 
-func (x *TrackID) String() string {
+func (x *TrackLyricsUpload) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackID) ProtoMessage() {}
+func (*TrackLyricsUpload) ProtoMessage() {}
 
-func (x *TrackID) ProtoReflect() protoreflect.Message {
+func (x *TrackLyricsUpload) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -606,29 +731,58 @@ func (x *TrackID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackID.ProtoReflect.Descriptor instead.
-func (*TrackID) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackLyricsUpload.ProtoReflect.Descriptor instead.
+func (*TrackLyricsUpload) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TrackID) GetId() int64 {
+func (x *TrackLyricsUpload) GetId() *TrackID {
 	if x != nil {
 		return x.Id
 	}
-	return 0
+	return nil
 }
 
-type TrackIDWithUserID struct {
-	state         protoimpl.MessageState
+func (x *TrackLyricsUpload) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *TrackLyricsUpload) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+func (x *TrackLyricsUpload) GetTranslation() bool {
+	if x != nil {
+		return x.Translation
+	}
+	return false
+}
+
+func (x *TrackLyricsUpload) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type TrackLyricsDelete struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TrackId *TrackID `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
-	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Id       *TrackID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	AlbumId  *AlbumID `protobuf:"bytes,2,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	Language string   `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (x *TrackIDWithUserID) Reset() {
-	*x = TrackIDWithUserID{}
+func (x *TrackLyricsDelete) Reset() {
+	*x = TrackLyricsDelete{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -636,13 +790,13 @@ func (x *TrackIDWithUserID) Reset() {
 	}
 }
 
-func (x *TrackIDWithUserID) String() string {
+func (x *TrackLyricsDelete) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackIDWithUserID) ProtoMessage() {}
+func (*TrackLyricsDelete) ProtoMessage() {}
 
-func (x *TrackIDWithUserID) ProtoReflect() protoreflect.Message {
+func (x *TrackLyricsDelete) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -654,36 +808,43 @@ func (x *TrackIDWithUserID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackIDWithUserID.ProtoReflect.Descriptor instead.
-func (*TrackIDWithUserID) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackLyricsDelete.ProtoReflect.Descriptor instead.
+func (*TrackLyricsDelete) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *TrackIDWithUserID) GetTrackId() *TrackID {
+func (x *TrackLyricsDelete) GetId() *TrackID {
 	if x != nil {
-		return x.TrackId
+		return x.Id
 	}
 	return nil
 }
 
-func (x *TrackIDWithUserID) GetUserId() *UserID {
+func (x *TrackLyricsDelete) GetAlbumId() *AlbumID {
 	if x != nil {
-		return x.UserId
+		return x.AlbumId
 	}
 	return nil
 }
 
-type TrackIDList struct {
+func (x *TrackLyricsDelete) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type LyricsLine struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId *UserID    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Ids    []*TrackID `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+	Time int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Text string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
 }
 
-func (x *TrackIDList) Reset() {
-	*x = TrackIDList{}
+func (x *LyricsLine) Reset() {
+	*x = LyricsLine{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -691,13 +852,13 @@ func (x *TrackIDList) Reset() {
 	}
 }
 
-func (x *TrackIDList) String() string {
+func (x *LyricsLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackIDList) ProtoMessage() {}
+func (*LyricsLine) ProtoMessage() {}
 
-func (x *TrackIDList) ProtoReflect() protoreflect.Message {
+func (x *LyricsLine) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -709,36 +870,38 @@ func (x *TrackIDList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackIDList.ProtoReflect.Descriptor instead.
-func (*TrackIDList) Descriptor() ([]byte, []int) {
+// Deprecated: Use LyricsLine.ProtoReflect.Descriptor instead.
+func (*LyricsLine) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *TrackIDList) GetUserId() *UserID {
+func (x *LyricsLine) GetTime() int64 {
 	if x != nil {
-		return x.UserId
+		return x.Time
 	}
-	return nil
+	return 0
 }
 
-func (x *TrackIDList) GetIds() []*TrackID {
+func (x *LyricsLine) GetText() string {
 	if x != nil {
-		return x.Ids
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type TrackIDListWithFilters struct {
+type TrackLyrics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ids     *TrackIDList `protobuf:"bytes,1,opt,name=ids,proto3" json:"ids,omitempty"`
-	Filters *Filters     `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	Language    string        `protobuf:"bytes,1,opt,name=language,proto3" json:"language,omitempty"`
+	Translation bool          `protobuf:"varint,2,opt,name=translation,proto3" json:"translation,omitempty"`
+	Synced      bool          `protobuf:"varint,3,opt,name=synced,proto3" json:"synced,omitempty"`
+	Lines       []*LyricsLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
 }
 
-func (x *TrackIDListWithFilters) Reset() {
-	*x = TrackIDListWithFilters{}
+func (x *TrackLyrics) Reset() {
+	*x = TrackLyrics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -746,13 +909,13 @@ func (x *TrackIDListWithFilters) Reset() {
 	}
 }
 
-func (x *TrackIDListWithFilters) String() string {
+func (x *TrackLyrics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackIDListWithFilters) ProtoMessage() {}
+func (*TrackLyrics) ProtoMessage() {}
 
-func (x *TrackIDListWithFilters) ProtoReflect() protoreflect.Message {
+func (x *TrackLyrics) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -764,35 +927,49 @@ func (x *TrackIDListWithFilters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackIDListWithFilters.ProtoReflect.Descriptor instead.
-func (*TrackIDListWithFilters) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackLyrics.ProtoReflect.Descriptor instead.
+func (*TrackLyrics) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *TrackIDListWithFilters) GetIds() *TrackIDList {
+func (x *TrackLyrics) GetLanguage() string {
 	if x != nil {
-		return x.Ids
+		return x.Language
 	}
-	return nil
+	return ""
 }
 
-func (x *TrackIDListWithFilters) GetFilters() *Filters {
+func (x *TrackLyrics) GetTranslation() bool {
 	if x != nil {
-		return x.Filters
+		return x.Translation
+	}
+	return false
+}
+
+func (x *TrackLyrics) GetSynced() bool {
+	if x != nil {
+		return x.Synced
+	}
+	return false
+}
+
+func (x *TrackLyrics) GetLines() []*LyricsLine {
+	if x != nil {
+		return x.Lines
 	}
 	return nil
 }
 
-type UserID struct {
+type TrackLyricsList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Lyrics []*TrackLyrics `protobuf:"bytes,1,rep,name=lyrics,proto3" json:"lyrics,omitempty"`
 }
 
-func (x *UserID) Reset() {
-	*x = UserID{}
+func (x *TrackLyricsList) Reset() {
+	*x = TrackLyricsList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -800,13 +977,13 @@ func (x *UserID) Reset() {
 	}
 }
 
-func (x *UserID) String() string {
+func (x *TrackLyricsList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserID) ProtoMessage() {}
+func (*TrackLyricsList) ProtoMessage() {}
 
-func (x *UserID) ProtoReflect() protoreflect.Message {
+func (x *TrackLyricsList) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -818,29 +995,29 @@ func (x *UserID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserID.ProtoReflect.Descriptor instead.
-func (*UserID) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackLyricsList.ProtoReflect.Descriptor instead.
+func (*TrackLyricsList) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *UserID) GetId() int64 {
+func (x *TrackLyricsList) GetLyrics() []*TrackLyrics {
 	if x != nil {
-		return x.Id
+		return x.Lyrics
 	}
-	return 0
+	return nil
 }
 
-type UserIDWithFilters struct {
+type AlbumCover struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	UserId  *UserID  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Filters *Filters `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+	AlbumId  *AlbumID `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	CoverKey string   `protobuf:"bytes,2,opt,name=cover_key,json=coverKey,proto3" json:"cover_key,omitempty"`
 }
 
-func (x *UserIDWithFilters) Reset() {
-	*x = UserIDWithFilters{}
+func (x *AlbumCover) Reset() {
+	*x = AlbumCover{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -848,13 +1025,13 @@ func (x *UserIDWithFilters) Reset() {
 	}
 }
 
-func (x *UserIDWithFilters) String() string {
+func (x *AlbumCover) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserIDWithFilters) ProtoMessage() {}
+func (*AlbumCover) ProtoMessage() {}
 
-func (x *UserIDWithFilters) ProtoReflect() protoreflect.Message {
+func (x *AlbumCover) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -866,35 +1043,35 @@ func (x *UserIDWithFilters) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserIDWithFilters.ProtoReflect.Descriptor instead.
-func (*UserIDWithFilters) Descriptor() ([]byte, []int) {
+// Deprecated: Use AlbumCover.ProtoReflect.Descriptor instead.
+func (*AlbumCover) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *UserIDWithFilters) GetUserId() *UserID {
+func (x *AlbumCover) GetAlbumId() *AlbumID {
 	if x != nil {
-		return x.UserId
+		return x.AlbumId
 	}
 	return nil
 }
 
-func (x *UserIDWithFilters) GetFilters() *Filters {
+func (x *AlbumCover) GetCoverKey() string {
 	if x != nil {
-		return x.Filters
+		return x.CoverKey
 	}
-	return nil
+	return ""
 }
 
-type StreamID struct {
+type TrackIdsList struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Ids []*TrackID `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
 }
 
-func (x *StreamID) Reset() {
-	*x = StreamID{}
+func (x *TrackIdsList) Reset() {
+	*x = TrackIdsList{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,13 +1079,13 @@ func (x *StreamID) Reset() {
 	}
 }
 
-func (x *StreamID) String() string {
+func (x *TrackIdsList) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamID) ProtoMessage() {}
+func (*TrackIdsList) ProtoMessage() {}
 
-func (x *StreamID) ProtoReflect() protoreflect.Message {
+func (x *TrackIdsList) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -920,29 +1097,29 @@ func (x *StreamID) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamID.ProtoReflect.Descriptor instead.
-func (*StreamID) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackIdsList.ProtoReflect.Descriptor instead.
+func (*TrackIdsList) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *StreamID) GetId() int64 {
+func (x *TrackIdsList) GetIds() []*TrackID {
 	if x != nil {
-		return x.Id
+		return x.Ids
 	}
-	return 0
+	return nil
 }
 
-type TrackStreamCreateData struct {
+type TrackLoad struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TrackId *TrackID `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
-	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Title   string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	FileKey string `protobuf:"bytes,3,opt,name=file_key,json=fileKey,proto3" json:"file_key,omitempty"`
 }
 
-func (x *TrackStreamCreateData) Reset() {
-	*x = TrackStreamCreateData{}
+func (x *TrackLoad) Reset() {
+	*x = TrackLoad{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -950,13 +1127,13 @@ func (x *TrackStreamCreateData) Reset() {
 	}
 }
 
-func (x *TrackStreamCreateData) String() string {
+func (x *TrackLoad) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackStreamCreateData) ProtoMessage() {}
+func (*TrackLoad) ProtoMessage() {}
 
-func (x *TrackStreamCreateData) ProtoReflect() protoreflect.Message {
+func (x *TrackLoad) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -968,37 +1145,37 @@ func (x *TrackStreamCreateData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackStreamCreateData.ProtoReflect.Descriptor instead.
-func (*TrackStreamCreateData) Descriptor() ([]byte, []int) {
+// Deprecated: Use TrackLoad.ProtoReflect.Descriptor instead.
+func (*TrackLoad) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TrackStreamCreateData) GetTrackId() *TrackID {
+func (x *TrackLoad) GetTitle() string {
 	if x != nil {
-		return x.TrackId
+		return x.Title
 	}
-	return nil
+	return ""
 }
 
-func (x *TrackStreamCreateData) GetUserId() *UserID {
+func (x *TrackLoad) GetFileKey() string {
 	if x != nil {
-		return x.UserId
+		return x.FileKey
 	}
-	return nil
+	return ""
 }
 
-type TrackStreamUpdateData struct {
+type TracksListWithAlbumID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamId *StreamID `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
-	UserId   *UserID   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Duration int64     `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Tracks   []*TrackLoad `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+	AlbumId  *AlbumID     `protobuf:"bytes,2,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	CoverKey string       `protobuf:"bytes,4,opt,name=cover_key,json=coverKey,proto3" json:"cover_key,omitempty"`
 }
 
-func (x *TrackStreamUpdateData) Reset() {
-	*x = TrackStreamUpdateData{}
+func (x *TracksListWithAlbumID) Reset() {
+	*x = TracksListWithAlbumID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1006,13 +1183,13 @@ func (x *TrackStreamUpdateData) Reset() {
 	}
 }
 
-func (x *TrackStreamUpdateData) String() string {
+func (x *TracksListWithAlbumID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackStreamUpdateData) ProtoMessage() {}
+func (*TracksListWithAlbumID) ProtoMessage() {}
 
-func (x *TrackStreamUpdateData) ProtoReflect() protoreflect.Message {
+func (x *TracksListWithAlbumID) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1024,44 +1201,44 @@ func (x *TrackStreamUpdateData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackStreamUpdateData.ProtoReflect.Descriptor instead.
-func (*TrackStreamUpdateData) Descriptor() ([]byte, []int) {
+// Deprecated: Use TracksListWithAlbumID.ProtoReflect.Descriptor instead.
+func (*TracksListWithAlbumID) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *TrackStreamUpdateData) GetStreamId() *StreamID {
+func (x *TracksListWithAlbumID) GetTracks() []*TrackLoad {
 	if x != nil {
-		return x.StreamId
+		return x.Tracks
 	}
 	return nil
 }
 
-func (x *TrackStreamUpdateData) GetUserId() *UserID {
+func (x *TracksListWithAlbumID) GetAlbumId() *AlbumID {
 	if x != nil {
-		return x.UserId
+		return x.AlbumId
 	}
 	return nil
 }
 
-func (x *TrackStreamUpdateData) GetDuration() int64 {
+func (x *TracksListWithAlbumID) GetCoverKey() string {
 	if x != nil {
-		return x.Duration
+		return x.CoverKey
 	}
-	return 0
+	return ""
 }
 
-type TrackStream struct {
+type Query struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id       int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	TrackId  *TrackID `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
-	Duration int64    `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	Query   string   `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filters *Filters `protobuf:"bytes,3,opt,name=filters,proto3" json:"filters,omitempty"`
 }
 
-func (x *TrackStream) Reset() {
-	*x = TrackStream{}
+func (x *Query) Reset() {
+	*x = Query{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,13 +1246,13 @@ func (x *TrackStream) Reset() {
 	}
 }
 
-func (x *TrackStream) String() string {
+func (x *Query) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackStream) ProtoMessage() {}
+func (*Query) ProtoMessage() {}
 
-func (x *TrackStream) ProtoReflect() protoreflect.Message {
+func (x *Query) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1087,42 +1264,42 @@ func (x *TrackStream) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackStream.ProtoReflect.Descriptor instead.
-func (*TrackStream) Descriptor() ([]byte, []int) {
+// Deprecated: Use Query.ProtoReflect.Descriptor instead.
+func (*Query) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TrackStream) GetId() int64 {
+func (x *Query) GetQuery() string {
 	if x != nil {
-		return x.Id
+		return x.Query
 	}
-	return 0
+	return ""
 }
 
-func (x *TrackStream) GetTrackId() *TrackID {
+func (x *Query) GetUserId() *UserID {
 	if x != nil {
-		return x.TrackId
+		return x.UserId
 	}
 	return nil
 }
 
-func (x *TrackStream) GetDuration() int64 {
+func (x *Query) GetFilters() *Filters {
 	if x != nil {
-		return x.Duration
+		return x.Filters
 	}
-	return 0
+	return nil
 }
 
-type TrackStreamList struct {
+type AlbumID struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Streams []*TrackStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *TrackStreamList) Reset() {
-	*x = TrackStreamList{}
+func (x *AlbumID) Reset() {
+	*x = AlbumID{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_track_track_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1130,13 +1307,13 @@ func (x *TrackStreamList) Reset() {
 	}
 }
 
-func (x *TrackStreamList) String() string {
+func (x *AlbumID) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackStreamList) ProtoMessage() {}
+func (*AlbumID) ProtoMessage() {}
 
-func (x *TrackStreamList) ProtoReflect() protoreflect.Message {
+func (x *AlbumID) ProtoReflect() protoreflect.Message {
 	mi := &file_track_track_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1148,14 +1325,1240 @@ func (x *TrackStreamList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackStreamList.ProtoReflect.Descriptor instead.
-func (*TrackStreamList) Descriptor() ([]byte, []int) {
+// Deprecated: Use AlbumID.ProtoReflect.Descriptor instead.
+func (*AlbumID) Descriptor() ([]byte, []int) {
 	return file_track_track_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *TrackStreamList) GetStreams() []*TrackStream {
+func (x *AlbumID) GetId() int64 {
 	if x != nil {
-		return x.Streams
+		return x.Id
+	}
+	return 0
+}
+
+type AlbumIDWithUserID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AlbumId *AlbumID `protobuf:"bytes,1,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *AlbumIDWithUserID) Reset() {
+	*x = AlbumIDWithUserID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AlbumIDWithUserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AlbumIDWithUserID) ProtoMessage() {}
+
+func (x *AlbumIDWithUserID) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AlbumIDWithUserID.ProtoReflect.Descriptor instead.
+func (*AlbumIDWithUserID) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *AlbumIDWithUserID) GetAlbumId() *AlbumID {
+	if x != nil {
+		return x.AlbumId
+	}
+	return nil
+}
+
+func (x *AlbumIDWithUserID) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type MinutesListened struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Minutes int64 `protobuf:"varint,1,opt,name=minutes,proto3" json:"minutes,omitempty"`
+}
+
+func (x *MinutesListened) Reset() {
+	*x = MinutesListened{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinutesListened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinutesListened) ProtoMessage() {}
+
+func (x *MinutesListened) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinutesListened.ProtoReflect.Descriptor instead.
+func (*MinutesListened) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MinutesListened) GetMinutes() int64 {
+	if x != nil {
+		return x.Minutes
+	}
+	return 0
+}
+
+type TracksListened struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tracks int64 `protobuf:"varint,1,opt,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *TracksListened) Reset() {
+	*x = TracksListened{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TracksListened) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TracksListened) ProtoMessage() {}
+
+func (x *TracksListened) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TracksListened.ProtoReflect.Descriptor instead.
+func (*TracksListened) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *TracksListened) GetTracks() int64 {
+	if x != nil {
+		return x.Tracks
+	}
+	return 0
+}
+
+type Track struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             int64   `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title          string  `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Thumbnail      string  `protobuf:"bytes,3,opt,name=thumbnail,proto3" json:"thumbnail,omitempty"`
+	Duration       int64   `protobuf:"varint,4,opt,name=duration,proto3" json:"duration,omitempty"`
+	AlbumId        int64   `protobuf:"varint,5,opt,name=album_id,json=albumId,proto3" json:"album_id,omitempty"`
+	IsFavorite     bool    `protobuf:"varint,6,opt,name=is_favorite,json=isFavorite,proto3" json:"is_favorite,omitempty"`
+	ThumbnailColor string  `protobuf:"bytes,7,opt,name=thumbnail_color,json=thumbnailColor,proto3" json:"thumbnail_color,omitempty"`
+	Bpm            float64 `protobuf:"fixed64,8,opt,name=bpm,proto3" json:"bpm,omitempty"`
+}
+
+func (x *Track) Reset() {
+	*x = Track{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Track) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Track) ProtoMessage() {}
+
+func (x *Track) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Track.ProtoReflect.Descriptor instead.
+func (*Track) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Track) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Track) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *Track) GetThumbnail() string {
+	if x != nil {
+		return x.Thumbnail
+	}
+	return ""
+}
+
+func (x *Track) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *Track) GetAlbumId() int64 {
+	if x != nil {
+		return x.AlbumId
+	}
+	return 0
+}
+
+func (x *Track) GetIsFavorite() bool {
+	if x != nil {
+		return x.IsFavorite
+	}
+	return false
+}
+
+func (x *Track) GetThumbnailColor() string {
+	if x != nil {
+		return x.ThumbnailColor
+	}
+	return ""
+}
+
+func (x *Track) GetBpm() float64 {
+	if x != nil {
+		return x.Bpm
+	}
+	return 0
+}
+
+type TrackList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tracks []*Track `protobuf:"bytes,1,rep,name=tracks,proto3" json:"tracks,omitempty"`
+}
+
+func (x *TrackList) Reset() {
+	*x = TrackList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackList) ProtoMessage() {}
+
+func (x *TrackList) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackList.ProtoReflect.Descriptor instead.
+func (*TrackList) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TrackList) GetTracks() []*Track {
+	if x != nil {
+		return x.Tracks
+	}
+	return nil
+}
+
+type TrackID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *TrackID) Reset() {
+	*x = TrackID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackID) ProtoMessage() {}
+
+func (x *TrackID) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackID.ProtoReflect.Descriptor instead.
+func (*TrackID) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *TrackID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TrackIDWithUserID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId *TrackID `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *TrackIDWithUserID) Reset() {
+	*x = TrackIDWithUserID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackIDWithUserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackIDWithUserID) ProtoMessage() {}
+
+func (x *TrackIDWithUserID) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackIDWithUserID.ProtoReflect.Descriptor instead.
+func (*TrackIDWithUserID) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TrackIDWithUserID) GetTrackId() *TrackID {
+	if x != nil {
+		return x.TrackId
+	}
+	return nil
+}
+
+func (x *TrackIDWithUserID) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type TrackIDList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId *UserID    `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Ids    []*TrackID `protobuf:"bytes,2,rep,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *TrackIDList) Reset() {
+	*x = TrackIDList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackIDList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackIDList) ProtoMessage() {}
+
+func (x *TrackIDList) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackIDList.ProtoReflect.Descriptor instead.
+func (*TrackIDList) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *TrackIDList) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *TrackIDList) GetIds() []*TrackID {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+type TrackIDListWithFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids     *TrackIDList `protobuf:"bytes,1,opt,name=ids,proto3" json:"ids,omitempty"`
+	Filters *Filters     `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *TrackIDListWithFilters) Reset() {
+	*x = TrackIDListWithFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackIDListWithFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackIDListWithFilters) ProtoMessage() {}
+
+func (x *TrackIDListWithFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackIDListWithFilters.ProtoReflect.Descriptor instead.
+func (*TrackIDListWithFilters) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *TrackIDListWithFilters) GetIds() *TrackIDList {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *TrackIDListWithFilters) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type UserID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *UserID) Reset() {
+	*x = UserID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserID) ProtoMessage() {}
+
+func (x *UserID) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserID.ProtoReflect.Descriptor instead.
+func (*UserID) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UserID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type UserIDWithFilters struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UserId  *UserID  `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Filters *Filters `protobuf:"bytes,2,opt,name=filters,proto3" json:"filters,omitempty"`
+}
+
+func (x *UserIDWithFilters) Reset() {
+	*x = UserIDWithFilters{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UserIDWithFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserIDWithFilters) ProtoMessage() {}
+
+func (x *UserIDWithFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserIDWithFilters.ProtoReflect.Descriptor instead.
+func (*UserIDWithFilters) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UserIDWithFilters) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *UserIDWithFilters) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type StreamID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *StreamID) Reset() {
+	*x = StreamID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamID) ProtoMessage() {}
+
+func (x *StreamID) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamID.ProtoReflect.Descriptor instead.
+func (*StreamID) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *StreamID) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type TrackStreamCreateData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrackId *TrackID `protobuf:"bytes,1,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	UserId  *UserID  `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *TrackStreamCreateData) Reset() {
+	*x = TrackStreamCreateData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackStreamCreateData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackStreamCreateData) ProtoMessage() {}
+
+func (x *TrackStreamCreateData) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackStreamCreateData.ProtoReflect.Descriptor instead.
+func (*TrackStreamCreateData) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *TrackStreamCreateData) GetTrackId() *TrackID {
+	if x != nil {
+		return x.TrackId
+	}
+	return nil
+}
+
+func (x *TrackStreamCreateData) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type TrackStreamUpdateData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId *StreamID `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId   *UserID   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Duration int64     `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *TrackStreamUpdateData) Reset() {
+	*x = TrackStreamUpdateData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackStreamUpdateData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackStreamUpdateData) ProtoMessage() {}
+
+func (x *TrackStreamUpdateData) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackStreamUpdateData.ProtoReflect.Descriptor instead.
+func (*TrackStreamUpdateData) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *TrackStreamUpdateData) GetStreamId() *StreamID {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *TrackStreamUpdateData) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *TrackStreamUpdateData) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type StreamIDWithUserID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId *StreamID `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId   *UserID   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+}
+
+func (x *StreamIDWithUserID) Reset() {
+	*x = StreamIDWithUserID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamIDWithUserID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamIDWithUserID) ProtoMessage() {}
+
+func (x *StreamIDWithUserID) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamIDWithUserID.ProtoReflect.Descriptor instead.
+func (*StreamIDWithUserID) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *StreamIDWithUserID) GetStreamId() *StreamID {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *StreamIDWithUserID) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+type StreamSegment struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Position int64   `protobuf:"varint,1,opt,name=position,proto3" json:"position,omitempty"`
+	Duration float64 `protobuf:"fixed64,2,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *StreamSegment) Reset() {
+	*x = StreamSegment{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamSegment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSegment) ProtoMessage() {}
+
+func (x *StreamSegment) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSegment.ProtoReflect.Descriptor instead.
+func (*StreamSegment) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *StreamSegment) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+func (x *StreamSegment) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type StreamVariant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codec    string           `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	Bitrate  int64            `protobuf:"varint,2,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	Segments []*StreamSegment `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+}
+
+func (x *StreamVariant) Reset() {
+	*x = StreamVariant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamVariant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamVariant) ProtoMessage() {}
+
+func (x *StreamVariant) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamVariant.ProtoReflect.Descriptor instead.
+func (*StreamVariant) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *StreamVariant) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *StreamVariant) GetBitrate() int64 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *StreamVariant) GetSegments() []*StreamSegment {
+	if x != nil {
+		return x.Segments
+	}
+	return nil
+}
+
+type StreamManifest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Variants []*StreamVariant `protobuf:"bytes,1,rep,name=variants,proto3" json:"variants,omitempty"`
+}
+
+func (x *StreamManifest) Reset() {
+	*x = StreamManifest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamManifest) ProtoMessage() {}
+
+func (x *StreamManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamManifest.ProtoReflect.Descriptor instead.
+func (*StreamManifest) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *StreamManifest) GetVariants() []*StreamVariant {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type StreamSegmentRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamId *StreamID `protobuf:"bytes,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	UserId   *UserID   `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Bitrate  int64     `protobuf:"varint,3,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	Position int64     `protobuf:"varint,4,opt,name=position,proto3" json:"position,omitempty"`
+}
+
+func (x *StreamSegmentRequest) Reset() {
+	*x = StreamSegmentRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamSegmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSegmentRequest) ProtoMessage() {}
+
+func (x *StreamSegmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSegmentRequest.ProtoReflect.Descriptor instead.
+func (*StreamSegmentRequest) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *StreamSegmentRequest) GetStreamId() *StreamID {
+	if x != nil {
+		return x.StreamId
+	}
+	return nil
+}
+
+func (x *StreamSegmentRequest) GetUserId() *UserID {
+	if x != nil {
+		return x.UserId
+	}
+	return nil
+}
+
+func (x *StreamSegmentRequest) GetBitrate() int64 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *StreamSegmentRequest) GetPosition() int64 {
+	if x != nil {
+		return x.Position
+	}
+	return 0
+}
+
+type StreamSegmentURL struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+}
+
+func (x *StreamSegmentURL) Reset() {
+	*x = StreamSegmentURL{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamSegmentURL) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSegmentURL) ProtoMessage() {}
+
+func (x *StreamSegmentURL) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSegmentURL.ProtoReflect.Descriptor instead.
+func (*StreamSegmentURL) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StreamSegmentURL) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type TrackStream struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	TrackId  *TrackID `protobuf:"bytes,2,opt,name=track_id,json=trackId,proto3" json:"track_id,omitempty"`
+	Duration int64    `protobuf:"varint,3,opt,name=duration,proto3" json:"duration,omitempty"`
+}
+
+func (x *TrackStream) Reset() {
+	*x = TrackStream{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackStream) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackStream) ProtoMessage() {}
+
+func (x *TrackStream) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackStream.ProtoReflect.Descriptor instead.
+func (*TrackStream) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TrackStream) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TrackStream) GetTrackId() *TrackID {
+	if x != nil {
+		return x.TrackId
+	}
+	return nil
+}
+
+func (x *TrackStream) GetDuration() int64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+type TrackStreamList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Streams []*TrackStream `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+}
+
+func (x *TrackStreamList) Reset() {
+	*x = TrackStreamList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackStreamList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackStreamList) ProtoMessage() {}
+
+func (x *TrackStreamList) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackStreamList.ProtoReflect.Descriptor instead.
+func (*TrackStreamList) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *TrackStreamList) GetStreams() []*TrackStream {
+	if x != nil {
+		return x.Streams
 	}
 	return nil
 }
@@ -1172,75 +2575,233 @@ type TrackStreamListWithFilters struct {
 func (x *TrackStreamListWithFilters) Reset() {
 	*x = TrackStreamListWithFilters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_track_track_proto_msgTypes[21]
+		mi := &file_track_track_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackStreamListWithFilters) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackStreamListWithFilters) ProtoMessage() {}
+
+func (x *TrackStreamListWithFilters) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackStreamListWithFilters.ProtoReflect.Descriptor instead.
+func (*TrackStreamListWithFilters) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *TrackStreamListWithFilters) GetStreams() *TrackStreamList {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+func (x *TrackStreamListWithFilters) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+type TrackRendition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Codec   string `protobuf:"bytes,1,opt,name=codec,proto3" json:"codec,omitempty"`
+	Bitrate int64  `protobuf:"varint,2,opt,name=bitrate,proto3" json:"bitrate,omitempty"`
+	FileUrl string `protobuf:"bytes,3,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+}
+
+func (x *TrackRendition) Reset() {
+	*x = TrackRendition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TrackRendition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackRendition) ProtoMessage() {}
+
+func (x *TrackRendition) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackRendition.ProtoReflect.Descriptor instead.
+func (*TrackRendition) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *TrackRendition) GetCodec() string {
+	if x != nil {
+		return x.Codec
+	}
+	return ""
+}
+
+func (x *TrackRendition) GetBitrate() int64 {
+	if x != nil {
+		return x.Bitrate
+	}
+	return 0
+}
+
+func (x *TrackRendition) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+type TrackDetailed struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Track        *Track            `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
+	FileUrl      string            `protobuf:"bytes,2,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	Renditions   []*TrackRendition `protobuf:"bytes,3,rep,name=renditions,proto3" json:"renditions,omitempty"`
+	LoudnessLufs float64           `protobuf:"fixed64,4,opt,name=loudness_lufs,json=loudnessLufs,proto3" json:"loudness_lufs,omitempty"`
+	ReplayGainDb float64           `protobuf:"fixed64,5,opt,name=replay_gain_db,json=replayGainDb,proto3" json:"replay_gain_db,omitempty"`
+	Key          string            `protobuf:"bytes,7,opt,name=key,proto3" json:"key,omitempty"`
+	HasLyrics    bool              `protobuf:"varint,8,opt,name=has_lyrics,json=hasLyrics,proto3" json:"has_lyrics,omitempty"`
+}
+
+func (x *TrackDetailed) Reset() {
+	*x = TrackDetailed{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_track_track_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrackStreamListWithFilters) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TrackDetailed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TrackDetailed) ProtoMessage() {}
+
+func (x *TrackDetailed) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TrackDetailed.ProtoReflect.Descriptor instead.
+func (*TrackDetailed) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *TrackDetailed) GetTrack() *Track {
+	if x != nil {
+		return x.Track
+	}
+	return nil
+}
+
+func (x *TrackDetailed) GetFileUrl() string {
+	if x != nil {
+		return x.FileUrl
+	}
+	return ""
+}
+
+func (x *TrackDetailed) GetRenditions() []*TrackRendition {
+	if x != nil {
+		return x.Renditions
+	}
+	return nil
 }
 
-func (*TrackStreamListWithFilters) ProtoMessage() {}
-
-func (x *TrackStreamListWithFilters) ProtoReflect() protoreflect.Message {
-	mi := &file_track_track_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TrackDetailed) GetLoudnessLufs() float64 {
+	if x != nil {
+		return x.LoudnessLufs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use TrackStreamListWithFilters.ProtoReflect.Descriptor instead.
-func (*TrackStreamListWithFilters) Descriptor() ([]byte, []int) {
-	return file_track_track_proto_rawDescGZIP(), []int{21}
+func (x *TrackDetailed) GetReplayGainDb() float64 {
+	if x != nil {
+		return x.ReplayGainDb
+	}
+	return 0
 }
 
-func (x *TrackStreamListWithFilters) GetStreams() *TrackStreamList {
+func (x *TrackDetailed) GetKey() string {
 	if x != nil {
-		return x.Streams
+		return x.Key
 	}
-	return nil
+	return ""
 }
 
-func (x *TrackStreamListWithFilters) GetFilters() *Filters {
+func (x *TrackDetailed) GetHasLyrics() bool {
 	if x != nil {
-		return x.Filters
+		return x.HasLyrics
 	}
-	return nil
+	return false
 }
 
-type TrackDetailed struct {
+type TrackWaveform struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Track   *Track `protobuf:"bytes,1,opt,name=track,proto3" json:"track,omitempty"`
-	FileUrl string `protobuf:"bytes,2,opt,name=file_url,json=fileUrl,proto3" json:"file_url,omitempty"`
+	Duration int64     `protobuf:"varint,1,opt,name=duration,proto3" json:"duration,omitempty"`
+	Peaks    []float64 `protobuf:"fixed64,2,rep,packed,name=peaks,proto3" json:"peaks,omitempty"`
 }
 
-func (x *TrackDetailed) Reset() {
-	*x = TrackDetailed{}
+func (x *TrackWaveform) Reset() {
+	*x = TrackWaveform{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_track_track_proto_msgTypes[22]
+		mi := &file_track_track_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TrackDetailed) String() string {
+func (x *TrackWaveform) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TrackDetailed) ProtoMessage() {}
+func (*TrackWaveform) ProtoMessage() {}
 
-func (x *TrackDetailed) ProtoReflect() protoreflect.Message {
-	mi := &file_track_track_proto_msgTypes[22]
+func (x *TrackWaveform) ProtoReflect() protoreflect.Message {
+	mi := &file_track_track_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1251,23 +2812,23 @@ func (x *TrackDetailed) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TrackDetailed.ProtoReflect.Descriptor instead.
-func (*TrackDetailed) Descriptor() ([]byte, []int) {
-	return file_track_track_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use TrackWaveform.ProtoReflect.Descriptor instead.
+func (*TrackWaveform) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *TrackDetailed) GetTrack() *Track {
+func (x *TrackWaveform) GetDuration() int64 {
 	if x != nil {
-		return x.Track
+		return x.Duration
 	}
-	return nil
+	return 0
 }
 
-func (x *TrackDetailed) GetFileUrl() string {
+func (x *TrackWaveform) GetPeaks() []float64 {
 	if x != nil {
-		return x.FileUrl
+		return x.Peaks
 	}
-	return ""
+	return nil
 }
 
 type Pagination struct {
@@ -1282,7 +2843,7 @@ type Pagination struct {
 func (x *Pagination) Reset() {
 	*x = Pagination{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_track_track_proto_msgTypes[23]
+		mi := &file_track_track_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1295,7 +2856,7 @@ func (x *Pagination) String() string {
 func (*Pagination) ProtoMessage() {}
 
 func (x *Pagination) ProtoReflect() protoreflect.Message {
-	mi := &file_track_track_proto_msgTypes[23]
+	mi := &file_track_track_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1308,7 +2869,7 @@ func (x *Pagination) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Pagination.ProtoReflect.Descriptor instead.
 func (*Pagination) Descriptor() ([]byte, []int) {
-	return file_track_track_proto_rawDescGZIP(), []int{23}
+	return file_track_track_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *Pagination) GetOffset() int64 {
@@ -1336,7 +2897,7 @@ type Filters struct {
 func (x *Filters) Reset() {
 	*x = Filters{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_track_track_proto_msgTypes[24]
+		mi := &file_track_track_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1349,7 +2910,7 @@ func (x *Filters) String() string {
 func (*Filters) ProtoMessage() {}
 
 func (x *Filters) ProtoReflect() protoreflect.Message {
-	mi := &file_track_track_proto_msgTypes[24]
+	mi := &file_track_track_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1362,7 +2923,7 @@ func (x *Filters) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Filters.ProtoReflect.Descriptor instead.
 func (*Filters) Descriptor() ([]byte, []int) {
-	return file_track_track_proto_rawDescGZIP(), []int{24}
+	return file_track_track_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *Filters) GetPagination() *Pagination {
@@ -1385,7 +2946,7 @@ type LikeRequest struct {
 func (x *LikeRequest) Reset() {
 	*x = LikeRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_track_track_proto_msgTypes[25]
+		mi := &file_track_track_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1398,7 +2959,7 @@ func (x *LikeRequest) String() string {
 func (*LikeRequest) ProtoMessage() {}
 
 func (x *LikeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_track_track_proto_msgTypes[25]
+	mi := &file_track_track_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1411,7 +2972,7 @@ func (x *LikeRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LikeRequest.ProtoReflect.Descriptor instead.
 func (*LikeRequest) Descriptor() ([]byte, []int) {
-	return file_track_track_proto_rawDescGZIP(), []int{25}
+	return file_track_track_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *LikeRequest) GetTrackId() *TrackID {
@@ -1448,7 +3009,7 @@ type FavoriteRequest struct {
 func (x *FavoriteRequest) Reset() {
 	*x = FavoriteRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_track_track_proto_msgTypes[26]
+		mi := &file_track_track_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1461,403 +3022,922 @@ func (x *FavoriteRequest) String() string {
 func (*FavoriteRequest) ProtoMessage() {}
 
 func (x *FavoriteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_track_track_proto_msgTypes[26]
+	mi := &file_track_track_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FavoriteRequest.ProtoReflect.Descriptor instead.
-func (*FavoriteRequest) Descriptor() ([]byte, []int) {
-	return file_track_track_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *FavoriteRequest) GetProfileUserId() *UserID {
-	if x != nil {
-		return x.ProfileUserId
-	}
-	return nil
-}
-
-func (x *FavoriteRequest) GetRequestUserId() *UserID {
-	if x != nil {
-		return x.RequestUserId
-	}
-	return nil
-}
-
-func (x *FavoriteRequest) GetFilters() *Filters {
-	if x != nil {
-		return x.Filters
-	}
-	return nil
-}
-
-var File_track_track_proto protoreflect.FileDescriptor
-
-var file_track_track_proto_rawDesc = []byte{
-	0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
-	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x30, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x35, 0x0a, 0x09, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65,
-	0x22, 0x82, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x57,
-	0x69, 0x74, 0x68, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x06, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x06, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41,
-	0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x22, 0x45, 0x0a, 0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x19, 0x0a, 0x07,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x11, 0x41, 0x6c, 0x62, 0x75, 0x6d,
-	0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x08,
-	0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
-	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07,
-	0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
-	0x2b, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e,
-	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x28, 0x0a, 0x0e,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x16,
-	0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x05, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75, 0x6d, 0x62,
-	0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x69,
-	0x73, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x22, 0x31, 0x0a, 0x09,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22,
-	0x19, 0x0a, 0x07, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x11, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x29, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49,
-	0x44, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x22, 0x57, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73,
-	0x74, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
-	0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x03, 0x69, 0x64, 0x73,
-	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x68, 0x0a, 0x16, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
-	0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
-	0x65, 0x0a, 0x11, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x73, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07,
-	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
-	0x69, 0x64, 0x22, 0x6a, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x08, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x89,
-	0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x52, 0x08, 0x73, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a,
-	0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x64, 0x0a, 0x0b, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x74, 0x72, 0x61,
-	0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x22, 0x3f, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x73, 0x22, 0x78, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12,
-	0x30, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x73, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x4e, 0x0a, 0x0d, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x05,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x3a, 0x0a, 0x0a, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65,
-	0x72, 0x73, 0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x50,
-	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12,
-	0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52,
-	0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6c, 0x69,
-	0x6b, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65,
-	0x22, 0xa9, 0x01, 0x0a, 0x0f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0d, 0x70, 0x72,
-	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x32, 0x81, 0x0a, 0x0a,
-	0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a,
-	0x0c, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68,
-	0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74,
-	0x54, 0x72, 0x61, 0x63, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x1a, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x16,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x43, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73,
-	0x74, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12,
-	0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x57, 0x69,
-	0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0e, 0x47,
-	0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x12, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c,
-	0x69, 0x73, 0x74, 0x12, 0x49, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73,
-	0x42, 0x79, 0x49, 0x44, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73,
-	0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35,
-	0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x42, 0x79, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c,
-	0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x73, 0x42, 0x79, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x69,
-	0x6e, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x55,
-	0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x4d, 0x69, 0x6e,
-	0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x19,
-	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-	0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12,
-	0x37, 0x0a, 0x09, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x2e, 0x74,
-	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
-	0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46,
-	0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x2e,
-	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x54, 0x6f, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x74, 0x72,
-	0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69,
-	0x74, 0x68, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x63,
-	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x42, 0x79,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
-	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12,
-	0x35, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
-	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73,
-	0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x54, 0x72,
-	0x61, 0x63, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65,
-	0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63,
-	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74,
-	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74,
-	0x68, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54,
-	0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d,
-	0x6f, 0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12,
-	0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10,
-	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
-}
-
-var (
-	file_track_track_proto_rawDescOnce sync.Once
-	file_track_track_proto_rawDescData = file_track_track_proto_rawDesc
-)
-
-func file_track_track_proto_rawDescGZIP() []byte {
-	file_track_track_proto_rawDescOnce.Do(func() {
-		file_track_track_proto_rawDescData = protoimpl.X.CompressGZIP(file_track_track_proto_rawDescData)
-	})
-	return file_track_track_proto_rawDescData
-}
-
-var file_track_track_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
-var file_track_track_proto_goTypes = []interface{}{
-	(*TrackIdsList)(nil),               // 0: track.TrackIdsList
-	(*TrackLoad)(nil),                  // 1: track.TrackLoad
-	(*TracksListWithAlbumID)(nil),      // 2: track.TracksListWithAlbumID
-	(*Query)(nil),                      // 3: track.Query
-	(*AlbumID)(nil),                    // 4: track.AlbumID
-	(*AlbumIDWithUserID)(nil),          // 5: track.AlbumIDWithUserID
-	(*MinutesListened)(nil),            // 6: track.MinutesListened
-	(*TracksListened)(nil),             // 7: track.TracksListened
-	(*Track)(nil),                      // 8: track.Track
-	(*TrackList)(nil),                  // 9: track.TrackList
-	(*TrackID)(nil),                    // 10: track.TrackID
-	(*TrackIDWithUserID)(nil),          // 11: track.TrackIDWithUserID
-	(*TrackIDList)(nil),                // 12: track.TrackIDList
-	(*TrackIDListWithFilters)(nil),     // 13: track.TrackIDListWithFilters
-	(*UserID)(nil),                     // 14: track.UserID
-	(*UserIDWithFilters)(nil),          // 15: track.UserIDWithFilters
-	(*StreamID)(nil),                   // 16: track.StreamID
-	(*TrackStreamCreateData)(nil),      // 17: track.TrackStreamCreateData
-	(*TrackStreamUpdateData)(nil),      // 18: track.TrackStreamUpdateData
-	(*TrackStream)(nil),                // 19: track.TrackStream
-	(*TrackStreamList)(nil),            // 20: track.TrackStreamList
-	(*TrackStreamListWithFilters)(nil), // 21: track.TrackStreamListWithFilters
-	(*TrackDetailed)(nil),              // 22: track.TrackDetailed
-	(*Pagination)(nil),                 // 23: track.Pagination
-	(*Filters)(nil),                    // 24: track.Filters
-	(*LikeRequest)(nil),                // 25: track.LikeRequest
-	(*FavoriteRequest)(nil),            // 26: track.FavoriteRequest
-	(*emptypb.Empty)(nil),              // 27: google.protobuf.Empty
-}
-var file_track_track_proto_depIdxs = []int32{
-	10, // 0: track.TrackIdsList.ids:type_name -> track.TrackID
-	1,  // 1: track.TracksListWithAlbumID.tracks:type_name -> track.TrackLoad
-	4,  // 2: track.TracksListWithAlbumID.album_id:type_name -> track.AlbumID
-	14, // 3: track.Query.user_id:type_name -> track.UserID
-	4,  // 4: track.AlbumIDWithUserID.album_id:type_name -> track.AlbumID
-	14, // 5: track.AlbumIDWithUserID.user_id:type_name -> track.UserID
-	8,  // 6: track.TrackList.tracks:type_name -> track.Track
-	10, // 7: track.TrackIDWithUserID.track_id:type_name -> track.TrackID
-	14, // 8: track.TrackIDWithUserID.user_id:type_name -> track.UserID
-	14, // 9: track.TrackIDList.user_id:type_name -> track.UserID
-	10, // 10: track.TrackIDList.ids:type_name -> track.TrackID
-	12, // 11: track.TrackIDListWithFilters.ids:type_name -> track.TrackIDList
-	24, // 12: track.TrackIDListWithFilters.filters:type_name -> track.Filters
-	14, // 13: track.UserIDWithFilters.user_id:type_name -> track.UserID
-	24, // 14: track.UserIDWithFilters.filters:type_name -> track.Filters
-	10, // 15: track.TrackStreamCreateData.track_id:type_name -> track.TrackID
-	14, // 16: track.TrackStreamCreateData.user_id:type_name -> track.UserID
-	16, // 17: track.TrackStreamUpdateData.stream_id:type_name -> track.StreamID
-	14, // 18: track.TrackStreamUpdateData.user_id:type_name -> track.UserID
-	10, // 19: track.TrackStream.track_id:type_name -> track.TrackID
-	19, // 20: track.TrackStreamList.streams:type_name -> track.TrackStream
-	20, // 21: track.TrackStreamListWithFilters.streams:type_name -> track.TrackStreamList
-	24, // 22: track.TrackStreamListWithFilters.filters:type_name -> track.Filters
-	8,  // 23: track.TrackDetailed.track:type_name -> track.Track
-	23, // 24: track.Filters.pagination:type_name -> track.Pagination
-	10, // 25: track.LikeRequest.track_id:type_name -> track.TrackID
-	14, // 26: track.LikeRequest.user_id:type_name -> track.UserID
-	14, // 27: track.FavoriteRequest.profile_user_id:type_name -> track.UserID
-	14, // 28: track.FavoriteRequest.request_user_id:type_name -> track.UserID
-	24, // 29: track.FavoriteRequest.filters:type_name -> track.Filters
-	15, // 30: track.TrackService.GetAllTracks:input_type -> track.UserIDWithFilters
-	11, // 31: track.TrackService.GetTrackByID:input_type -> track.TrackIDWithUserID
-	17, // 32: track.TrackService.CreateStream:input_type -> track.TrackStreamCreateData
-	18, // 33: track.TrackService.UpdateStreamDuration:input_type -> track.TrackStreamUpdateData
-	15, // 34: track.TrackService.GetLastListenedTracks:input_type -> track.UserIDWithFilters
-	12, // 35: track.TrackService.GetTracksByIDs:input_type -> track.TrackIDList
-	13, // 36: track.TrackService.GetTracksByIDsFiltered:input_type -> track.TrackIDListWithFilters
-	10, // 37: track.TrackService.GetAlbumIDByTrackID:input_type -> track.TrackID
-	5,  // 38: track.TrackService.GetTracksByAlbumID:input_type -> track.AlbumIDWithUserID
-	14, // 39: track.TrackService.GetMinutesListenedByUserID:input_type -> track.UserID
-	14, // 40: track.TrackService.GetTracksListenedByUserID:input_type -> track.UserID
-	25, // 41: track.TrackService.LikeTrack:input_type -> track.LikeRequest
-	3,  // 42: track.TrackService.SearchTracks:input_type -> track.Query
-	26, // 43: track.TrackService.GetFavoriteTracks:input_type -> track.FavoriteRequest
-	2,  // 44: track.TrackService.AddTracksToAlbum:input_type -> track.TracksListWithAlbumID
-	4,  // 45: track.TrackService.DeleteTracksByAlbumID:input_type -> track.AlbumID
-	14, // 46: track.TrackService.GetMostLikedTracks:input_type -> track.UserID
-	14, // 47: track.TrackService.GetMostLikedLastWeekTracks:input_type -> track.UserID
-	14, // 48: track.TrackService.GetMostListenedLastMonthTracks:input_type -> track.UserID
-	14, // 49: track.TrackService.GetMostRecentTracks:input_type -> track.UserID
-	9,  // 50: track.TrackService.GetAllTracks:output_type -> track.TrackList
-	22, // 51: track.TrackService.GetTrackByID:output_type -> track.TrackDetailed
-	16, // 52: track.TrackService.CreateStream:output_type -> track.StreamID
-	27, // 53: track.TrackService.UpdateStreamDuration:output_type -> google.protobuf.Empty
-	9,  // 54: track.TrackService.GetLastListenedTracks:output_type -> track.TrackList
-	9,  // 55: track.TrackService.GetTracksByIDs:output_type -> track.TrackList
-	9,  // 56: track.TrackService.GetTracksByIDsFiltered:output_type -> track.TrackList
-	4,  // 57: track.TrackService.GetAlbumIDByTrackID:output_type -> track.AlbumID
-	9,  // 58: track.TrackService.GetTracksByAlbumID:output_type -> track.TrackList
-	6,  // 59: track.TrackService.GetMinutesListenedByUserID:output_type -> track.MinutesListened
-	7,  // 60: track.TrackService.GetTracksListenedByUserID:output_type -> track.TracksListened
-	27, // 61: track.TrackService.LikeTrack:output_type -> google.protobuf.Empty
-	9,  // 62: track.TrackService.SearchTracks:output_type -> track.TrackList
-	9,  // 63: track.TrackService.GetFavoriteTracks:output_type -> track.TrackList
-	0,  // 64: track.TrackService.AddTracksToAlbum:output_type -> track.TrackIdsList
-	27, // 65: track.TrackService.DeleteTracksByAlbumID:output_type -> google.protobuf.Empty
-	9,  // 66: track.TrackService.GetMostLikedTracks:output_type -> track.TrackList
-	9,  // 67: track.TrackService.GetMostLikedLastWeekTracks:output_type -> track.TrackList
-	9,  // 68: track.TrackService.GetMostListenedLastMonthTracks:output_type -> track.TrackList
-	9,  // 69: track.TrackService.GetMostRecentTracks:output_type -> track.TrackList
-	50, // [50:70] is the sub-list for method output_type
-	30, // [30:50] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
-}
-
-func init() { file_track_track_proto_init() }
-func file_track_track_proto_init() {
-	if File_track_track_proto != nil {
-		return
-	}
-	if !protoimpl.UnsafeEnabled {
-		file_track_track_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FavoriteRequest.ProtoReflect.Descriptor instead.
+func (*FavoriteRequest) Descriptor() ([]byte, []int) {
+	return file_track_track_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FavoriteRequest) GetProfileUserId() *UserID {
+	if x != nil {
+		return x.ProfileUserId
+	}
+	return nil
+}
+
+func (x *FavoriteRequest) GetRequestUserId() *UserID {
+	if x != nil {
+		return x.RequestUserId
+	}
+	return nil
+}
+
+func (x *FavoriteRequest) GetFilters() *Filters {
+	if x != nil {
+		return x.Filters
+	}
+	return nil
+}
+
+var File_track_track_proto protoreflect.FileDescriptor
+
+var file_track_track_proto_rawDesc = []byte{
+	0x0a, 0x11, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x12, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x29, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x46, 0x69, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65,
+	0x5f, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65,
+	0x4b, 0x65, 0x79, 0x22, 0xf2, 0x01, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d,
+	0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x61, 0x72, 0x74, 0x69, 0x73, 0x74, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x67, 0x65, 0x6e, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x73,
+	0x72, 0x63, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x69, 0x73, 0x72, 0x63, 0x12, 0x1b,
+	0x0a, 0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x12, 0x1b, 0x0a, 0x09, 0x63,
+	0x6f, 0x76, 0x65, 0x72, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x55, 0x72, 0x6c, 0x22, 0x90, 0x01, 0x0a, 0x12, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12,
+	0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64,
+	0x12, 0x19, 0x0a, 0x08, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x49, 0x64, 0x22, 0x83, 0x02, 0x0a, 0x0d,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2f, 0x0a,
+	0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x3e,
+	0x0a, 0x0d, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x52, 0x0c, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0a, 0x73, 0x69, 0x6d, 0x69, 0x6c, 0x61, 0x72, 0x69, 0x74, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x22, 0x3f, 0x0a, 0x11, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c,
+	0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x44, 0x75,
+	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x22, 0x41, 0x0a, 0x17, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46,
+	0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x5f, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
+	0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x11, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61,
+	0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x45, 0x64, 0x69, 0x74,
+	0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a,
+	0x11, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x19, 0x0a,
+	0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x66, 0x69, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x22, 0xb0, 0x01, 0x0a, 0x11, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x1e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29,
+	0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44,
+	0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e,
+	0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x7a, 0x0a, 0x11, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x12, 0x1e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x34, 0x0a, 0x0a, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x8c, 0x01,
+	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x79, 0x6e, 0x63, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x73, 0x79, 0x6e,
+	0x63, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x4c, 0x79, 0x72, 0x69, 0x63,
+	0x73, 0x4c, 0x69, 0x6e, 0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x3d, 0x0a, 0x0f,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x2a, 0x0a, 0x06, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72,
+	0x69, 0x63, 0x73, 0x52, 0x06, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x22, 0x54, 0x0a, 0x0a, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62,
+	0x75, 0x6d, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65,
+	0x79, 0x22, 0x30, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x20, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x03,
+	0x69, 0x64, 0x73, 0x22, 0x3c, 0x0a, 0x09, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x6f, 0x61, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x4b, 0x65,
+	0x79, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x06, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x6f, 0x61, 0x64, 0x52, 0x06, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x29, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
+	0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64,
+	0x12, 0x1b, 0x0a, 0x09, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x22, 0x6f, 0x0a,
+	0x05, 0x51, 0x75, 0x65, 0x72, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x07,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0x19,
+	0x0a, 0x07, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x11, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x29,
+	0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44,
+	0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49,
+	0x64, 0x22, 0x2b, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74,
+	0x65, 0x6e, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x22, 0x28,
+	0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x12, 0x16, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0xde, 0x01, 0x0a, 0x05, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x61, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x64, 0x12, 0x1f, 0x0a,
+	0x0b, 0x69, 0x73, 0x5f, 0x66, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x73, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x12, 0x27,
+	0x0a, 0x0f, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x5f, 0x63, 0x6f, 0x6c, 0x6f,
+	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
+	0x69, 0x6c, 0x43, 0x6f, 0x6c, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x70, 0x6d, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x62, 0x70, 0x6d, 0x22, 0x31, 0x0a, 0x09, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x52, 0x06, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x22, 0x19, 0x0a, 0x07,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x66, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x29, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x57, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06,
+	0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x49, 0x44, 0x52, 0x03, 0x69, 0x64, 0x73, 0x22, 0x68, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x24, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x12, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x03, 0x69, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x22, 0x18, 0x0a, 0x06, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22, 0x65, 0x0a, 0x11,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x22, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x22,
+	0x6a, 0x0a, 0x15, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x89, 0x01, 0x0a, 0x15,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2c, 0x0a, 0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6a, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x2c, 0x0a,
+	0x09, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
+	0x44, 0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x47, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x67,
+	0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x71, 0x0a, 0x0d,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x12, 0x14, 0x0a,
+	0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x63, 0x6f,
+	0x64, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a,
+	0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22,
+	0x42, 0x0a, 0x0e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x12, 0x30, 0x0a, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x56, 0x61, 0x72, 0x69, 0x61, 0x6e, 0x74, 0x52, 0x08, 0x76, 0x61, 0x72, 0x69, 0x61,
+	0x6e, 0x74, 0x73, 0x22, 0xa2, 0x01, 0x0a, 0x14, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x09,
+	0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x52, 0x08, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64, 0x12, 0x26, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x24, 0x0a, 0x10, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x10, 0x0a, 0x03,
+	0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x22, 0x64,
+	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x29, 0x0a,
+	0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x52,
+	0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x3f, 0x0a, 0x0f, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x07, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x78, 0x0a, 0x1a, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x07, 0x73, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x73, 0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22,
+	0x5b, 0x0a, 0x0e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x62, 0x69, 0x74, 0x72, 0x61, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x22, 0x87, 0x02, 0x0a,
+	0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x22,
+	0x0a, 0x05, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52, 0x05, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x35, 0x0a,
+	0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x52,
+	0x65, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73,
+	0x5f, 0x6c, 0x75, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0c, 0x6c, 0x6f, 0x75,
+	0x64, 0x6e, 0x65, 0x73, 0x73, 0x4c, 0x75, 0x66, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x70,
+	0x6c, 0x61, 0x79, 0x5f, 0x67, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x62, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0c, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x47, 0x61, 0x69, 0x6e, 0x44, 0x62, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x68, 0x61, 0x73, 0x5f, 0x6c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73,
+	0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x41, 0x0a, 0x0d, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x57,
+	0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x65, 0x61, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x01, 0x52, 0x05, 0x70, 0x65, 0x61, 0x6b, 0x73, 0x22, 0x3a, 0x0a, 0x0a, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x12,
+	0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x3c, 0x0a, 0x07, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73,
+	0x12, 0x31, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x79, 0x0a, 0x0b, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x29, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x52, 0x07, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x26, 0x0a,
+	0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x06, 0x75,
+	0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x6b, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x4c, 0x69, 0x6b, 0x65, 0x22, 0xa9,
+	0x01, 0x0a, 0x0f, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x35, 0x0a, 0x0f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x5f, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x35, 0x0a, 0x0f, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x52, 0x0d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x28, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x73, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x32, 0xab, 0x11, 0x0a, 0x0c, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0c, 0x47,
+	0x65, 0x74, 0x41, 0x6c, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x46, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x42, 0x79, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x44, 0x1a, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x12, 0x3d, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x0f, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x4c, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x19, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65,
+	0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x43, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x73, 0x74,
+	0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x18,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x57, 0x69, 0x74,
+	0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x49, 0x44, 0x73, 0x12, 0x12, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74,
+	0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x49, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x42,
+	0x79, 0x49, 0x44, 0x73, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x65, 0x64, 0x12, 0x1d, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44, 0x4c, 0x69, 0x73, 0x74,
+	0x57, 0x69, 0x74, 0x68, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x1a, 0x10, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x42, 0x79, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x49, 0x44, 0x1a, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62,
+	0x75, 0x6d, 0x49, 0x44, 0x12, 0x40, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x42, 0x79, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x57, 0x69, 0x74, 0x68, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x4d, 0x69, 0x6e, 0x75,
+	0x74, 0x65, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x41, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64,
+	0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x15, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x12, 0x37,
+	0x0a, 0x09, 0x4c, 0x69, 0x6b, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x4c, 0x69, 0x6b, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x2e, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0c, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e,
+	0x51, 0x75, 0x65, 0x72, 0x79, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x61,
+	0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x16, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x61, 0x76, 0x6f, 0x72, 0x69, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x54, 0x6f, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x12, 0x1c, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x57, 0x69, 0x74,
+	0x68, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b,
+	0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x64, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3f, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x42, 0x79, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41,
+	0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x35,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x4c, 0x69, 0x6b, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74,
+	0x4c, 0x69, 0x6b, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x57, 0x65, 0x65, 0x6b, 0x54, 0x72, 0x61,
+	0x63, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x4c, 0x69, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x4d, 0x6f, 0x73, 0x74, 0x4c,
+	0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x64, 0x4c, 0x61, 0x73, 0x74, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0d, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4d, 0x6f,
+	0x73, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x12, 0x0d,
+	0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x44, 0x1a, 0x10, 0x2e,
+	0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12,
+	0x3b, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x42, 0x79, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x49, 0x44, 0x1a, 0x10, 0x2e, 0x74, 0x72, 0x61,
+	0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x11,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x73, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x12, 0x35, 0x0a, 0x09, 0x45, 0x64, 0x69, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b,
+	0x12, 0x10, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x45, 0x64,
+	0x69, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x45, 0x0a, 0x11, 0x52, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x12,
+	0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x41, 0x75, 0x64,
+	0x69, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x12, 0x43, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x6c, 0x62, 0x75, 0x6d,
+	0x54, 0x72, 0x61, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x12, 0x11, 0x2e, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x2e, 0x41, 0x6c, 0x62, 0x75, 0x6d, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x44, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x50, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61,
+	0x67, 0x73, 0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x46, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x73, 0x1a, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x44, 0x75, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65,
+	0x46, 0x6c, 0x61, 0x67, 0x12, 0x1e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x44, 0x75, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x46, 0x6c, 0x61, 0x67, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75,
+	0x74, 0x69, 0x6f, 0x6e, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x3e, 0x0a, 0x11,
+	0x52, 0x65, 0x61, 0x64, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x13, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x46,
+	0x69, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x1a, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54,
+	0x72, 0x61, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x38, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d,
+	0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
+	0x1a, 0x14, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x57, 0x61,
+	0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x3f, 0x0a, 0x0f, 0x53, 0x61, 0x76, 0x65, 0x54, 0x72,
+	0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x74, 0x72, 0x61, 0x63,
+	0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x1a, 0x12, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63,
+	0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73, 0x12, 0x18, 0x2e, 0x74,
+	0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x12, 0x38,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79, 0x72, 0x69, 0x63, 0x73,
+	0x12, 0x0e, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x49, 0x44,
+	0x1a, 0x16, 0x2e, 0x74, 0x72, 0x61, 0x63, 0x6b, 0x2e, 0x54, 0x72, 0x61, 0x63, 0x6b, 0x4c, 0x79,
+	0x72, 0x69, 0x63, 0x73, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x09, 0x5a, 0x07, 0x2e, 0x2f, 0x74, 0x72,
+	0x61, 0x63, 0x6b, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_track_track_proto_rawDescOnce sync.Once
+	file_track_track_proto_rawDescData = file_track_track_proto_rawDesc
+)
+
+func file_track_track_proto_rawDescGZIP() []byte {
+	file_track_track_proto_rawDescOnce.Do(func() {
+		file_track_track_proto_rawDescData = protoimpl.X.CompressGZIP(file_track_track_proto_rawDescData)
+	})
+	return file_track_track_proto_rawDescData
+}
+
+var file_track_track_proto_msgTypes = make([]protoimpl.MessageInfo, 51)
+var file_track_track_proto_goTypes = []interface{}{
+	(*TrackFileKey)(nil),               // 0: track.TrackFileKey
+	(*TrackMetadata)(nil),              // 1: track.TrackMetadata
+	(*DuplicateFlagTrack)(nil),         // 2: track.DuplicateFlagTrack
+	(*DuplicateFlag)(nil),              // 3: track.DuplicateFlag
+	(*DuplicateFlagList)(nil),          // 4: track.DuplicateFlagList
+	(*DuplicateFlagResolution)(nil),    // 5: track.DuplicateFlagResolution
+	(*TrackUpdate)(nil),                // 6: track.TrackUpdate
+	(*AlbumTracksUpdate)(nil),          // 7: track.AlbumTracksUpdate
+	(*TrackEdit)(nil),                  // 8: track.TrackEdit
+	(*TrackAudioReplace)(nil),          // 9: track.TrackAudioReplace
+	(*TrackLyricsUpload)(nil),          // 10: track.TrackLyricsUpload
+	(*TrackLyricsDelete)(nil),          // 11: track.TrackLyricsDelete
+	(*LyricsLine)(nil),                 // 12: track.LyricsLine
+	(*TrackLyrics)(nil),                // 13: track.TrackLyrics
+	(*TrackLyricsList)(nil),            // 14: track.TrackLyricsList
+	(*AlbumCover)(nil),                 // 15: track.AlbumCover
+	(*TrackIdsList)(nil),               // 16: track.TrackIdsList
+	(*TrackLoad)(nil),                  // 17: track.TrackLoad
+	(*TracksListWithAlbumID)(nil),      // 18: track.TracksListWithAlbumID
+	(*Query)(nil),                      // 19: track.Query
+	(*AlbumID)(nil),                    // 20: track.AlbumID
+	(*AlbumIDWithUserID)(nil),          // 21: track.AlbumIDWithUserID
+	(*MinutesListened)(nil),            // 22: track.MinutesListened
+	(*TracksListened)(nil),             // 23: track.TracksListened
+	(*Track)(nil),                      // 24: track.Track
+	(*TrackList)(nil),                  // 25: track.TrackList
+	(*TrackID)(nil),                    // 26: track.TrackID
+	(*TrackIDWithUserID)(nil),          // 27: track.TrackIDWithUserID
+	(*TrackIDList)(nil),                // 28: track.TrackIDList
+	(*TrackIDListWithFilters)(nil),     // 29: track.TrackIDListWithFilters
+	(*UserID)(nil),                     // 30: track.UserID
+	(*UserIDWithFilters)(nil),          // 31: track.UserIDWithFilters
+	(*StreamID)(nil),                   // 32: track.StreamID
+	(*TrackStreamCreateData)(nil),      // 33: track.TrackStreamCreateData
+	(*TrackStreamUpdateData)(nil),      // 34: track.TrackStreamUpdateData
+	(*StreamIDWithUserID)(nil),         // 35: track.StreamIDWithUserID
+	(*StreamSegment)(nil),              // 36: track.StreamSegment
+	(*StreamVariant)(nil),              // 37: track.StreamVariant
+	(*StreamManifest)(nil),             // 38: track.StreamManifest
+	(*StreamSegmentRequest)(nil),       // 39: track.StreamSegmentRequest
+	(*StreamSegmentURL)(nil),           // 40: track.StreamSegmentURL
+	(*TrackStream)(nil),                // 41: track.TrackStream
+	(*TrackStreamList)(nil),            // 42: track.TrackStreamList
+	(*TrackStreamListWithFilters)(nil), // 43: track.TrackStreamListWithFilters
+	(*TrackRendition)(nil),             // 44: track.TrackRendition
+	(*TrackDetailed)(nil),              // 45: track.TrackDetailed
+	(*TrackWaveform)(nil),              // 46: track.TrackWaveform
+	(*Pagination)(nil),                 // 47: track.Pagination
+	(*Filters)(nil),                    // 48: track.Filters
+	(*LikeRequest)(nil),                // 49: track.LikeRequest
+	(*FavoriteRequest)(nil),            // 50: track.FavoriteRequest
+	(*timestamppb.Timestamp)(nil),      // 51: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),              // 52: google.protobuf.Empty
+}
+var file_track_track_proto_depIdxs = []int32{
+	26, // 0: track.DuplicateFlagTrack.id:type_name -> track.TrackID
+	20, // 1: track.DuplicateFlagTrack.album_id:type_name -> track.AlbumID
+	2,  // 2: track.DuplicateFlag.track:type_name -> track.DuplicateFlagTrack
+	2,  // 3: track.DuplicateFlag.matched_track:type_name -> track.DuplicateFlagTrack
+	51, // 4: track.DuplicateFlag.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 5: track.DuplicateFlagList.flags:type_name -> track.DuplicateFlag
+	26, // 6: track.TrackUpdate.id:type_name -> track.TrackID
+	20, // 7: track.AlbumTracksUpdate.album_id:type_name -> track.AlbumID
+	6,  // 8: track.AlbumTracksUpdate.tracks:type_name -> track.TrackUpdate
+	26, // 9: track.TrackEdit.id:type_name -> track.TrackID
+	20, // 10: track.TrackEdit.album_id:type_name -> track.AlbumID
+	26, // 11: track.TrackAudioReplace.id:type_name -> track.TrackID
+	20, // 12: track.TrackAudioReplace.album_id:type_name -> track.AlbumID
+	26, // 13: track.TrackLyricsUpload.id:type_name -> track.TrackID
+	20, // 14: track.TrackLyricsUpload.album_id:type_name -> track.AlbumID
+	26, // 15: track.TrackLyricsDelete.id:type_name -> track.TrackID
+	20, // 16: track.TrackLyricsDelete.album_id:type_name -> track.AlbumID
+	12, // 17: track.TrackLyrics.lines:type_name -> track.LyricsLine
+	13, // 18: track.TrackLyricsList.lyrics:type_name -> track.TrackLyrics
+	20, // 19: track.AlbumCover.album_id:type_name -> track.AlbumID
+	26, // 20: track.TrackIdsList.ids:type_name -> track.TrackID
+	17, // 21: track.TracksListWithAlbumID.tracks:type_name -> track.TrackLoad
+	20, // 22: track.TracksListWithAlbumID.album_id:type_name -> track.AlbumID
+	30, // 23: track.Query.user_id:type_name -> track.UserID
+	48, // 24: track.Query.filters:type_name -> track.Filters
+	20, // 25: track.AlbumIDWithUserID.album_id:type_name -> track.AlbumID
+	30, // 26: track.AlbumIDWithUserID.user_id:type_name -> track.UserID
+	24, // 27: track.TrackList.tracks:type_name -> track.Track
+	26, // 28: track.TrackIDWithUserID.track_id:type_name -> track.TrackID
+	30, // 29: track.TrackIDWithUserID.user_id:type_name -> track.UserID
+	30, // 30: track.TrackIDList.user_id:type_name -> track.UserID
+	26, // 31: track.TrackIDList.ids:type_name -> track.TrackID
+	28, // 32: track.TrackIDListWithFilters.ids:type_name -> track.TrackIDList
+	48, // 33: track.TrackIDListWithFilters.filters:type_name -> track.Filters
+	30, // 34: track.UserIDWithFilters.user_id:type_name -> track.UserID
+	48, // 35: track.UserIDWithFilters.filters:type_name -> track.Filters
+	26, // 36: track.TrackStreamCreateData.track_id:type_name -> track.TrackID
+	30, // 37: track.TrackStreamCreateData.user_id:type_name -> track.UserID
+	32, // 38: track.TrackStreamUpdateData.stream_id:type_name -> track.StreamID
+	30, // 39: track.TrackStreamUpdateData.user_id:type_name -> track.UserID
+	32, // 40: track.StreamIDWithUserID.stream_id:type_name -> track.StreamID
+	30, // 41: track.StreamIDWithUserID.user_id:type_name -> track.UserID
+	36, // 42: track.StreamVariant.segments:type_name -> track.StreamSegment
+	37, // 43: track.StreamManifest.variants:type_name -> track.StreamVariant
+	32, // 44: track.StreamSegmentRequest.stream_id:type_name -> track.StreamID
+	30, // 45: track.StreamSegmentRequest.user_id:type_name -> track.UserID
+	26, // 46: track.TrackStream.track_id:type_name -> track.TrackID
+	41, // 47: track.TrackStreamList.streams:type_name -> track.TrackStream
+	42, // 48: track.TrackStreamListWithFilters.streams:type_name -> track.TrackStreamList
+	48, // 49: track.TrackStreamListWithFilters.filters:type_name -> track.Filters
+	24, // 50: track.TrackDetailed.track:type_name -> track.Track
+	44, // 51: track.TrackDetailed.renditions:type_name -> track.TrackRendition
+	47, // 52: track.Filters.pagination:type_name -> track.Pagination
+	26, // 53: track.LikeRequest.track_id:type_name -> track.TrackID
+	30, // 54: track.LikeRequest.user_id:type_name -> track.UserID
+	30, // 55: track.FavoriteRequest.profile_user_id:type_name -> track.UserID
+	30, // 56: track.FavoriteRequest.request_user_id:type_name -> track.UserID
+	48, // 57: track.FavoriteRequest.filters:type_name -> track.Filters
+	31, // 58: track.TrackService.GetAllTracks:input_type -> track.UserIDWithFilters
+	27, // 59: track.TrackService.GetTrackByID:input_type -> track.TrackIDWithUserID
+	33, // 60: track.TrackService.CreateStream:input_type -> track.TrackStreamCreateData
+	34, // 61: track.TrackService.UpdateStreamDuration:input_type -> track.TrackStreamUpdateData
+	35, // 62: track.TrackService.GetStreamManifest:input_type -> track.StreamIDWithUserID
+	39, // 63: track.TrackService.GetStreamSegment:input_type -> track.StreamSegmentRequest
+	31, // 64: track.TrackService.GetLastListenedTracks:input_type -> track.UserIDWithFilters
+	28, // 65: track.TrackService.GetTracksByIDs:input_type -> track.TrackIDList
+	29, // 66: track.TrackService.GetTracksByIDsFiltered:input_type -> track.TrackIDListWithFilters
+	26, // 67: track.TrackService.GetAlbumIDByTrackID:input_type -> track.TrackID
+	21, // 68: track.TrackService.GetTracksByAlbumID:input_type -> track.AlbumIDWithUserID
+	30, // 69: track.TrackService.GetMinutesListenedByUserID:input_type -> track.UserID
+	30, // 70: track.TrackService.GetTracksListenedByUserID:input_type -> track.UserID
+	49, // 71: track.TrackService.LikeTrack:input_type -> track.LikeRequest
+	19, // 72: track.TrackService.SearchTracks:input_type -> track.Query
+	50, // 73: track.TrackService.GetFavoriteTracks:input_type -> track.FavoriteRequest
+	18, // 74: track.TrackService.AddTracksToAlbum:input_type -> track.TracksListWithAlbumID
+	20, // 75: track.TrackService.DeleteTracksByAlbumID:input_type -> track.AlbumID
+	30, // 76: track.TrackService.GetMostLikedTracks:input_type -> track.UserID
+	30, // 77: track.TrackService.GetMostLikedLastWeekTracks:input_type -> track.UserID
+	30, // 78: track.TrackService.GetMostListenedLastMonthTracks:input_type -> track.UserID
+	30, // 79: track.TrackService.GetMostRecentTracks:input_type -> track.UserID
+	20, // 80: track.TrackService.GetLabelTracksByAlbumID:input_type -> track.AlbumID
+	7,  // 81: track.TrackService.UpdateAlbumTracks:input_type -> track.AlbumTracksUpdate
+	8,  // 82: track.TrackService.EditTrack:input_type -> track.TrackEdit
+	9,  // 83: track.TrackService.ReplaceTrackAudio:input_type -> track.TrackAudioReplace
+	15, // 84: track.TrackService.UpdateAlbumTracksCover:input_type -> track.AlbumCover
+	48, // 85: track.TrackService.GetPendingDuplicateFlags:input_type -> track.Filters
+	5,  // 86: track.TrackService.ResolveDuplicateFlag:input_type -> track.DuplicateFlagResolution
+	0,  // 87: track.TrackService.ReadTrackMetadata:input_type -> track.TrackFileKey
+	26, // 88: track.TrackService.GetTrackWaveform:input_type -> track.TrackID
+	10, // 89: track.TrackService.SaveTrackLyrics:input_type -> track.TrackLyricsUpload
+	11, // 90: track.TrackService.DeleteTrackLyrics:input_type -> track.TrackLyricsDelete
+	26, // 91: track.TrackService.GetTrackLyrics:input_type -> track.TrackID
+	25, // 92: track.TrackService.GetAllTracks:output_type -> track.TrackList
+	45, // 93: track.TrackService.GetTrackByID:output_type -> track.TrackDetailed
+	32, // 94: track.TrackService.CreateStream:output_type -> track.StreamID
+	52, // 95: track.TrackService.UpdateStreamDuration:output_type -> google.protobuf.Empty
+	38, // 96: track.TrackService.GetStreamManifest:output_type -> track.StreamManifest
+	40, // 97: track.TrackService.GetStreamSegment:output_type -> track.StreamSegmentURL
+	25, // 98: track.TrackService.GetLastListenedTracks:output_type -> track.TrackList
+	25, // 99: track.TrackService.GetTracksByIDs:output_type -> track.TrackList
+	25, // 100: track.TrackService.GetTracksByIDsFiltered:output_type -> track.TrackList
+	20, // 101: track.TrackService.GetAlbumIDByTrackID:output_type -> track.AlbumID
+	25, // 102: track.TrackService.GetTracksByAlbumID:output_type -> track.TrackList
+	22, // 103: track.TrackService.GetMinutesListenedByUserID:output_type -> track.MinutesListened
+	23, // 104: track.TrackService.GetTracksListenedByUserID:output_type -> track.TracksListened
+	52, // 105: track.TrackService.LikeTrack:output_type -> google.protobuf.Empty
+	25, // 106: track.TrackService.SearchTracks:output_type -> track.TrackList
+	25, // 107: track.TrackService.GetFavoriteTracks:output_type -> track.TrackList
+	16, // 108: track.TrackService.AddTracksToAlbum:output_type -> track.TrackIdsList
+	52, // 109: track.TrackService.DeleteTracksByAlbumID:output_type -> google.protobuf.Empty
+	25, // 110: track.TrackService.GetMostLikedTracks:output_type -> track.TrackList
+	25, // 111: track.TrackService.GetMostLikedLastWeekTracks:output_type -> track.TrackList
+	25, // 112: track.TrackService.GetMostListenedLastMonthTracks:output_type -> track.TrackList
+	25, // 113: track.TrackService.GetMostRecentTracks:output_type -> track.TrackList
+	25, // 114: track.TrackService.GetLabelTracksByAlbumID:output_type -> track.TrackList
+	52, // 115: track.TrackService.UpdateAlbumTracks:output_type -> google.protobuf.Empty
+	52, // 116: track.TrackService.EditTrack:output_type -> google.protobuf.Empty
+	52, // 117: track.TrackService.ReplaceTrackAudio:output_type -> google.protobuf.Empty
+	52, // 118: track.TrackService.UpdateAlbumTracksCover:output_type -> google.protobuf.Empty
+	4,  // 119: track.TrackService.GetPendingDuplicateFlags:output_type -> track.DuplicateFlagList
+	52, // 120: track.TrackService.ResolveDuplicateFlag:output_type -> google.protobuf.Empty
+	1,  // 121: track.TrackService.ReadTrackMetadata:output_type -> track.TrackMetadata
+	46, // 122: track.TrackService.GetTrackWaveform:output_type -> track.TrackWaveform
+	13, // 123: track.TrackService.SaveTrackLyrics:output_type -> track.TrackLyrics
+	52, // 124: track.TrackService.DeleteTrackLyrics:output_type -> google.protobuf.Empty
+	14, // 125: track.TrackService.GetTrackLyrics:output_type -> track.TrackLyricsList
+	92, // [92:126] is the sub-list for method output_type
+	58, // [58:92] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
+}
+
+func init() { file_track_track_proto_init() }
+func file_track_track_proto_init() {
+	if File_track_track_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_track_track_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackFileKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackMetadata); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateFlagTrack); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateFlag); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateFlagList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DuplicateFlagResolution); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumTracksUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackEdit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackAudioReplace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackLyricsUpload); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackLyricsDelete); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LyricsLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackLyrics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackLyricsList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AlbumCover); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackIdsList); i {
 			case 0:
 				return &v.state
@@ -1869,7 +3949,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackLoad); i {
 			case 0:
 				return &v.state
@@ -1881,7 +3961,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TracksListWithAlbumID); i {
 			case 0:
 				return &v.state
@@ -1893,7 +3973,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Query); i {
 			case 0:
 				return &v.state
@@ -1905,7 +3985,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AlbumID); i {
 			case 0:
 				return &v.state
@@ -1917,7 +3997,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AlbumIDWithUserID); i {
 			case 0:
 				return &v.state
@@ -1929,7 +4009,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*MinutesListened); i {
 			case 0:
 				return &v.state
@@ -1941,7 +4021,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TracksListened); i {
 			case 0:
 				return &v.state
@@ -1953,7 +4033,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Track); i {
 			case 0:
 				return &v.state
@@ -1965,7 +4045,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackList); i {
 			case 0:
 				return &v.state
@@ -1977,7 +4057,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackID); i {
 			case 0:
 				return &v.state
@@ -1989,7 +4069,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackIDWithUserID); i {
 			case 0:
 				return &v.state
@@ -2001,7 +4081,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackIDList); i {
 			case 0:
 				return &v.state
@@ -2013,7 +4093,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackIDListWithFilters); i {
 			case 0:
 				return &v.state
@@ -2025,7 +4105,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserID); i {
 			case 0:
 				return &v.state
@@ -2037,7 +4117,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*UserIDWithFilters); i {
 			case 0:
 				return &v.state
@@ -2049,7 +4129,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StreamID); i {
 			case 0:
 				return &v.state
@@ -2061,7 +4141,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackStreamCreateData); i {
 			case 0:
 				return &v.state
@@ -2073,7 +4153,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackStreamUpdateData); i {
 			case 0:
 				return &v.state
@@ -2085,7 +4165,79 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamIDWithUserID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSegment); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamVariant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamManifest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSegmentRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamSegmentURL); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackStream); i {
 			case 0:
 				return &v.state
@@ -2097,7 +4249,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackStreamList); i {
 			case 0:
 				return &v.state
@@ -2109,7 +4261,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackStreamListWithFilters); i {
 			case 0:
 				return &v.state
@@ -2121,7 +4273,19 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackRendition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TrackDetailed); i {
 			case 0:
 				return &v.state
@@ -2133,7 +4297,19 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TrackWaveform); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_track_track_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Pagination); i {
 			case 0:
 				return &v.state
@@ -2145,7 +4321,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Filters); i {
 			case 0:
 				return &v.state
@@ -2157,7 +4333,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LikeRequest); i {
 			case 0:
 				return &v.state
@@ -2169,7 +4345,7 @@ func file_track_track_proto_init() {
 				return nil
 			}
 		}
-		file_track_track_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_track_track_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*FavoriteRequest); i {
 			case 0:
 				return &v.state
@@ -2188,7 +4364,7 @@ func file_track_track_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_track_track_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   51,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -23,6 +23,8 @@ type TrackServiceClient interface {
 	GetTrackByID(ctx context.Context, in *TrackIDWithUserID, opts ...grpc.CallOption) (*TrackDetailed, error)
 	CreateStream(ctx context.Context, in *TrackStreamCreateData, opts ...grpc.CallOption) (*StreamID, error)
 	UpdateStreamDuration(ctx context.Context, in *TrackStreamUpdateData, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetStreamManifest(ctx context.Context, in *StreamIDWithUserID, opts ...grpc.CallOption) (*StreamManifest, error)
+	GetStreamSegment(ctx context.Context, in *StreamSegmentRequest, opts ...grpc.CallOption) (*StreamSegmentURL, error)
 	GetLastListenedTracks(ctx context.Context, in *UserIDWithFilters, opts ...grpc.CallOption) (*TrackList, error)
 	GetTracksByIDs(ctx context.Context, in *TrackIDList, opts ...grpc.CallOption) (*TrackList, error)
 	GetTracksByIDsFiltered(ctx context.Context, in *TrackIDListWithFilters, opts ...grpc.CallOption) (*TrackList, error)
@@ -39,6 +41,18 @@ type TrackServiceClient interface {
 	GetMostLikedLastWeekTracks(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TrackList, error)
 	GetMostListenedLastMonthTracks(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TrackList, error)
 	GetMostRecentTracks(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*TrackList, error)
+	GetLabelTracksByAlbumID(ctx context.Context, in *AlbumID, opts ...grpc.CallOption) (*TrackList, error)
+	UpdateAlbumTracks(ctx context.Context, in *AlbumTracksUpdate, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	EditTrack(ctx context.Context, in *TrackEdit, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReplaceTrackAudio(ctx context.Context, in *TrackAudioReplace, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UpdateAlbumTracksCover(ctx context.Context, in *AlbumCover, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetPendingDuplicateFlags(ctx context.Context, in *Filters, opts ...grpc.CallOption) (*DuplicateFlagList, error)
+	ResolveDuplicateFlag(ctx context.Context, in *DuplicateFlagResolution, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ReadTrackMetadata(ctx context.Context, in *TrackFileKey, opts ...grpc.CallOption) (*TrackMetadata, error)
+	GetTrackWaveform(ctx context.Context, in *TrackID, opts ...grpc.CallOption) (*TrackWaveform, error)
+	SaveTrackLyrics(ctx context.Context, in *TrackLyricsUpload, opts ...grpc.CallOption) (*TrackLyrics, error)
+	DeleteTrackLyrics(ctx context.Context, in *TrackLyricsDelete, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTrackLyrics(ctx context.Context, in *TrackID, opts ...grpc.CallOption) (*TrackLyricsList, error)
 }
 
 type trackServiceClient struct {
@@ -85,6 +99,24 @@ func (c *trackServiceClient) UpdateStreamDuration(ctx context.Context, in *Track
 	return out, nil
 }
 
+func (c *trackServiceClient) GetStreamManifest(ctx context.Context, in *StreamIDWithUserID, opts ...grpc.CallOption) (*StreamManifest, error) {
+	out := new(StreamManifest)
+	err := c.cc.Invoke(ctx, "/track.TrackService/GetStreamManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) GetStreamSegment(ctx context.Context, in *StreamSegmentRequest, opts ...grpc.CallOption) (*StreamSegmentURL, error) {
+	out := new(StreamSegmentURL)
+	err := c.cc.Invoke(ctx, "/track.TrackService/GetStreamSegment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *trackServiceClient) GetLastListenedTracks(ctx context.Context, in *UserIDWithFilters, opts ...grpc.CallOption) (*TrackList, error) {
 	out := new(TrackList)
 	err := c.cc.Invoke(ctx, "/track.TrackService/GetLastListenedTracks", in, out, opts...)
@@ -229,6 +261,114 @@ func (c *trackServiceClient) GetMostRecentTracks(ctx context.Context, in *UserID
 	return out, nil
 }
 
+func (c *trackServiceClient) GetLabelTracksByAlbumID(ctx context.Context, in *AlbumID, opts ...grpc.CallOption) (*TrackList, error) {
+	out := new(TrackList)
+	err := c.cc.Invoke(ctx, "/track.TrackService/GetLabelTracksByAlbumID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) UpdateAlbumTracks(ctx context.Context, in *AlbumTracksUpdate, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/track.TrackService/UpdateAlbumTracks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) EditTrack(ctx context.Context, in *TrackEdit, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/track.TrackService/EditTrack", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ReplaceTrackAudio(ctx context.Context, in *TrackAudioReplace, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/track.TrackService/ReplaceTrackAudio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) UpdateAlbumTracksCover(ctx context.Context, in *AlbumCover, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/track.TrackService/UpdateAlbumTracksCover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) GetPendingDuplicateFlags(ctx context.Context, in *Filters, opts ...grpc.CallOption) (*DuplicateFlagList, error) {
+	out := new(DuplicateFlagList)
+	err := c.cc.Invoke(ctx, "/track.TrackService/GetPendingDuplicateFlags", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ResolveDuplicateFlag(ctx context.Context, in *DuplicateFlagResolution, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/track.TrackService/ResolveDuplicateFlag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) ReadTrackMetadata(ctx context.Context, in *TrackFileKey, opts ...grpc.CallOption) (*TrackMetadata, error) {
+	out := new(TrackMetadata)
+	err := c.cc.Invoke(ctx, "/track.TrackService/ReadTrackMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) GetTrackWaveform(ctx context.Context, in *TrackID, opts ...grpc.CallOption) (*TrackWaveform, error) {
+	out := new(TrackWaveform)
+	err := c.cc.Invoke(ctx, "/track.TrackService/GetTrackWaveform", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) SaveTrackLyrics(ctx context.Context, in *TrackLyricsUpload, opts ...grpc.CallOption) (*TrackLyrics, error) {
+	out := new(TrackLyrics)
+	err := c.cc.Invoke(ctx, "/track.TrackService/SaveTrackLyrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) DeleteTrackLyrics(ctx context.Context, in *TrackLyricsDelete, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/track.TrackService/DeleteTrackLyrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *trackServiceClient) GetTrackLyrics(ctx context.Context, in *TrackID, opts ...grpc.CallOption) (*TrackLyricsList, error) {
+	out := new(TrackLyricsList)
+	err := c.cc.Invoke(ctx, "/track.TrackService/GetTrackLyrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TrackServiceServer is the server API for TrackService service.
 // All implementations must embed UnimplementedTrackServiceServer
 // for forward compatibility
@@ -237,6 +377,8 @@ type TrackServiceServer interface {
 	GetTrackByID(context.Context, *TrackIDWithUserID) (*TrackDetailed, error)
 	CreateStream(context.Context, *TrackStreamCreateData) (*StreamID, error)
 	UpdateStreamDuration(context.Context, *TrackStreamUpdateData) (*emptypb.Empty, error)
+	GetStreamManifest(context.Context, *StreamIDWithUserID) (*StreamManifest, error)
+	GetStreamSegment(context.Context, *StreamSegmentRequest) (*StreamSegmentURL, error)
 	GetLastListenedTracks(context.Context, *UserIDWithFilters) (*TrackList, error)
 	GetTracksByIDs(context.Context, *TrackIDList) (*TrackList, error)
 	GetTracksByIDsFiltered(context.Context, *TrackIDListWithFilters) (*TrackList, error)
@@ -253,6 +395,18 @@ type TrackServiceServer interface {
 	GetMostLikedLastWeekTracks(context.Context, *UserID) (*TrackList, error)
 	GetMostListenedLastMonthTracks(context.Context, *UserID) (*TrackList, error)
 	GetMostRecentTracks(context.Context, *UserID) (*TrackList, error)
+	GetLabelTracksByAlbumID(context.Context, *AlbumID) (*TrackList, error)
+	UpdateAlbumTracks(context.Context, *AlbumTracksUpdate) (*emptypb.Empty, error)
+	EditTrack(context.Context, *TrackEdit) (*emptypb.Empty, error)
+	ReplaceTrackAudio(context.Context, *TrackAudioReplace) (*emptypb.Empty, error)
+	UpdateAlbumTracksCover(context.Context, *AlbumCover) (*emptypb.Empty, error)
+	GetPendingDuplicateFlags(context.Context, *Filters) (*DuplicateFlagList, error)
+	ResolveDuplicateFlag(context.Context, *DuplicateFlagResolution) (*emptypb.Empty, error)
+	ReadTrackMetadata(context.Context, *TrackFileKey) (*TrackMetadata, error)
+	GetTrackWaveform(context.Context, *TrackID) (*TrackWaveform, error)
+	SaveTrackLyrics(context.Context, *TrackLyricsUpload) (*TrackLyrics, error)
+	DeleteTrackLyrics(context.Context, *TrackLyricsDelete) (*emptypb.Empty, error)
+	GetTrackLyrics(context.Context, *TrackID) (*TrackLyricsList, error)
 	mustEmbedUnimplementedTrackServiceServer()
 }
 
@@ -272,6 +426,12 @@ func (UnimplementedTrackServiceServer) CreateStream(context.Context, *TrackStrea
 func (UnimplementedTrackServiceServer) UpdateStreamDuration(context.Context, *TrackStreamUpdateData) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStreamDuration not implemented")
 }
+func (UnimplementedTrackServiceServer) GetStreamManifest(context.Context, *StreamIDWithUserID) (*StreamManifest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreamManifest not implemented")
+}
+func (UnimplementedTrackServiceServer) GetStreamSegment(context.Context, *StreamSegmentRequest) (*StreamSegmentURL, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreamSegment not implemented")
+}
 func (UnimplementedTrackServiceServer) GetLastListenedTracks(context.Context, *UserIDWithFilters) (*TrackList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetLastListenedTracks not implemented")
 }
@@ -320,6 +480,42 @@ func (UnimplementedTrackServiceServer) GetMostListenedLastMonthTracks(context.Co
 func (UnimplementedTrackServiceServer) GetMostRecentTracks(context.Context, *UserID) (*TrackList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMostRecentTracks not implemented")
 }
+func (UnimplementedTrackServiceServer) GetLabelTracksByAlbumID(context.Context, *AlbumID) (*TrackList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLabelTracksByAlbumID not implemented")
+}
+func (UnimplementedTrackServiceServer) UpdateAlbumTracks(context.Context, *AlbumTracksUpdate) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlbumTracks not implemented")
+}
+func (UnimplementedTrackServiceServer) EditTrack(context.Context, *TrackEdit) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditTrack not implemented")
+}
+func (UnimplementedTrackServiceServer) ReplaceTrackAudio(context.Context, *TrackAudioReplace) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplaceTrackAudio not implemented")
+}
+func (UnimplementedTrackServiceServer) UpdateAlbumTracksCover(context.Context, *AlbumCover) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAlbumTracksCover not implemented")
+}
+func (UnimplementedTrackServiceServer) GetPendingDuplicateFlags(context.Context, *Filters) (*DuplicateFlagList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingDuplicateFlags not implemented")
+}
+func (UnimplementedTrackServiceServer) ResolveDuplicateFlag(context.Context, *DuplicateFlagResolution) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveDuplicateFlag not implemented")
+}
+func (UnimplementedTrackServiceServer) ReadTrackMetadata(context.Context, *TrackFileKey) (*TrackMetadata, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReadTrackMetadata not implemented")
+}
+func (UnimplementedTrackServiceServer) GetTrackWaveform(context.Context, *TrackID) (*TrackWaveform, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrackWaveform not implemented")
+}
+func (UnimplementedTrackServiceServer) SaveTrackLyrics(context.Context, *TrackLyricsUpload) (*TrackLyrics, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveTrackLyrics not implemented")
+}
+func (UnimplementedTrackServiceServer) DeleteTrackLyrics(context.Context, *TrackLyricsDelete) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTrackLyrics not implemented")
+}
+func (UnimplementedTrackServiceServer) GetTrackLyrics(context.Context, *TrackID) (*TrackLyricsList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrackLyrics not implemented")
+}
 func (UnimplementedTrackServiceServer) mustEmbedUnimplementedTrackServiceServer() {}
 
 // UnsafeTrackServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -405,6 +601,42 @@ func _TrackService_UpdateStreamDuration_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TrackService_GetStreamManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamIDWithUserID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetStreamManifest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/GetStreamManifest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetStreamManifest(ctx, req.(*StreamIDWithUserID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_GetStreamSegment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamSegmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetStreamSegment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/GetStreamSegment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetStreamSegment(ctx, req.(*StreamSegmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TrackService_GetLastListenedTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserIDWithFilters)
 	if err := dec(in); err != nil {
@@ -693,6 +925,222 @@ func _TrackService_GetMostRecentTracks_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TrackService_GetLabelTracksByAlbumID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetLabelTracksByAlbumID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/GetLabelTracksByAlbumID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetLabelTracksByAlbumID(ctx, req.(*AlbumID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_UpdateAlbumTracks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumTracksUpdate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).UpdateAlbumTracks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/UpdateAlbumTracks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).UpdateAlbumTracks(ctx, req.(*AlbumTracksUpdate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_EditTrack_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackEdit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).EditTrack(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/EditTrack",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).EditTrack(ctx, req.(*TrackEdit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ReplaceTrackAudio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackAudioReplace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ReplaceTrackAudio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/ReplaceTrackAudio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ReplaceTrackAudio(ctx, req.(*TrackAudioReplace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_UpdateAlbumTracksCover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlbumCover)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).UpdateAlbumTracksCover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/UpdateAlbumTracksCover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).UpdateAlbumTracksCover(ctx, req.(*AlbumCover))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_GetPendingDuplicateFlags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Filters)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetPendingDuplicateFlags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/GetPendingDuplicateFlags",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetPendingDuplicateFlags(ctx, req.(*Filters))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ResolveDuplicateFlag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DuplicateFlagResolution)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ResolveDuplicateFlag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/ResolveDuplicateFlag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ResolveDuplicateFlag(ctx, req.(*DuplicateFlagResolution))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_ReadTrackMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackFileKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).ReadTrackMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/ReadTrackMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).ReadTrackMetadata(ctx, req.(*TrackFileKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_GetTrackWaveform_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetTrackWaveform(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/GetTrackWaveform",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetTrackWaveform(ctx, req.(*TrackID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_SaveTrackLyrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackLyricsUpload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).SaveTrackLyrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/SaveTrackLyrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).SaveTrackLyrics(ctx, req.(*TrackLyricsUpload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_DeleteTrackLyrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackLyricsDelete)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).DeleteTrackLyrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/DeleteTrackLyrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).DeleteTrackLyrics(ctx, req.(*TrackLyricsDelete))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TrackService_GetTrackLyrics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TrackID)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TrackServiceServer).GetTrackLyrics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/track.TrackService/GetTrackLyrics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TrackServiceServer).GetTrackLyrics(ctx, req.(*TrackID))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TrackService_ServiceDesc is the grpc.ServiceDesc for TrackService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -716,6 +1164,14 @@ var TrackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStreamDuration",
 			Handler:    _TrackService_UpdateStreamDuration_Handler,
 		},
+		{
+			MethodName: "GetStreamManifest",
+			Handler:    _TrackService_GetStreamManifest_Handler,
+		},
+		{
+			MethodName: "GetStreamSegment",
+			Handler:    _TrackService_GetStreamSegment_Handler,
+		},
 		{
 			MethodName: "GetLastListenedTracks",
 			Handler:    _TrackService_GetLastListenedTracks_Handler,
@@ -780,6 +1236,54 @@ var TrackService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetMostRecentTracks",
 			Handler:    _TrackService_GetMostRecentTracks_Handler,
 		},
+		{
+			MethodName: "GetLabelTracksByAlbumID",
+			Handler:    _TrackService_GetLabelTracksByAlbumID_Handler,
+		},
+		{
+			MethodName: "UpdateAlbumTracks",
+			Handler:    _TrackService_UpdateAlbumTracks_Handler,
+		},
+		{
+			MethodName: "EditTrack",
+			Handler:    _TrackService_EditTrack_Handler,
+		},
+		{
+			MethodName: "ReplaceTrackAudio",
+			Handler:    _TrackService_ReplaceTrackAudio_Handler,
+		},
+		{
+			MethodName: "UpdateAlbumTracksCover",
+			Handler:    _TrackService_UpdateAlbumTracksCover_Handler,
+		},
+		{
+			MethodName: "GetPendingDuplicateFlags",
+			Handler:    _TrackService_GetPendingDuplicateFlags_Handler,
+		},
+		{
+			MethodName: "ResolveDuplicateFlag",
+			Handler:    _TrackService_ResolveDuplicateFlag_Handler,
+		},
+		{
+			MethodName: "ReadTrackMetadata",
+			Handler:    _TrackService_ReadTrackMetadata_Handler,
+		},
+		{
+			MethodName: "GetTrackWaveform",
+			Handler:    _TrackService_GetTrackWaveform_Handler,
+		},
+		{
+			MethodName: "SaveTrackLyrics",
+			Handler:    _TrackService_SaveTrackLyrics_Handler,
+		},
+		{
+			MethodName: "DeleteTrackLyrics",
+			Handler:    _TrackService_DeleteTrackLyrics_Handler,
+		},
+		{
+			MethodName: "GetTrackLyrics",
+			Handler:    _TrackService_GetTrackLyrics_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "track/track.proto",
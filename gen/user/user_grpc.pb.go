@@ -29,12 +29,17 @@ type UserServiceClient interface {
 	GetIDByUsername(ctx context.Context, in *Username, opts ...grpc.CallOption) (*UserID, error)
 	GetUserPrivacyByID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*PrivacySettings, error)
 	GetUserAvatarURL(ctx context.Context, in *FileKey, opts ...grpc.CallOption) (*AvatarUrl, error)
-	UploadUserAvatar(ctx context.Context, in *AvatarImage, opts ...grpc.CallOption) (*FileKey, error)
+	UploadUserAvatar(ctx context.Context, in *AvatarImage, opts ...grpc.CallOption) (*UploadedAvatar, error)
 	GetLabelIDByUserID(ctx context.Context, in *UserID, opts ...grpc.CallOption) (*LabelID, error)
 	UpdateUsersLabelID(ctx context.Context, in *RequestUpdateUserLabelID, opts ...grpc.CallOption) (*Nothing, error)
 	ChecksUsersByUsernames(ctx context.Context, in *Usernames, opts ...grpc.CallOption) (*Nothing, error)
 	GetUsersByLabelID(ctx context.Context, in *LabelID, opts ...grpc.CallOption) (*Usernames, error)
 	RemoveUsersFromLabel(ctx context.Context, in *RequestRemoveUserLabelID, opts ...grpc.CallOption) (*Nothing, error)
+	FollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*Nothing, error)
+	UnfollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*Nothing, error)
+	GetFollowers(ctx context.Context, in *UserIDWithPagination, opts ...grpc.CallOption) (*UsersToFront, error)
+	GetFollowing(ctx context.Context, in *UserIDWithPagination, opts ...grpc.CallOption) (*UsersToFront, error)
+	GetActivityFeed(ctx context.Context, in *UserIDWithPagination, opts ...grpc.CallOption) (*ActivityList, error)
 }
 
 type userServiceClient struct {
@@ -144,8 +149,8 @@ func (c *userServiceClient) GetUserAvatarURL(ctx context.Context, in *FileKey, o
 	return out, nil
 }
 
-func (c *userServiceClient) UploadUserAvatar(ctx context.Context, in *AvatarImage, opts ...grpc.CallOption) (*FileKey, error) {
-	out := new(FileKey)
+func (c *userServiceClient) UploadUserAvatar(ctx context.Context, in *AvatarImage, opts ...grpc.CallOption) (*UploadedAvatar, error) {
+	out := new(UploadedAvatar)
 	err := c.cc.Invoke(ctx, "/user.UserService/UploadUserAvatar", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -198,6 +203,51 @@ func (c *userServiceClient) RemoveUsersFromLabel(ctx context.Context, in *Reques
 	return out, nil
 }
 
+func (c *userServiceClient) FollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/user.UserService/FollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) UnfollowUser(ctx context.Context, in *FollowRequest, opts ...grpc.CallOption) (*Nothing, error) {
+	out := new(Nothing)
+	err := c.cc.Invoke(ctx, "/user.UserService/UnfollowUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowers(ctx context.Context, in *UserIDWithPagination, opts ...grpc.CallOption) (*UsersToFront, error) {
+	out := new(UsersToFront)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetFollowers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetFollowing(ctx context.Context, in *UserIDWithPagination, opts ...grpc.CallOption) (*UsersToFront, error) {
+	out := new(UsersToFront)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetFollowing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetActivityFeed(ctx context.Context, in *UserIDWithPagination, opts ...grpc.CallOption) (*ActivityList, error) {
+	out := new(ActivityList)
+	err := c.cc.Invoke(ctx, "/user.UserService/GetActivityFeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServiceServer is the server API for UserService service.
 // All implementations must embed UnimplementedUserServiceServer
 // for forward compatibility
@@ -213,12 +263,17 @@ type UserServiceServer interface {
 	GetIDByUsername(context.Context, *Username) (*UserID, error)
 	GetUserPrivacyByID(context.Context, *UserID) (*PrivacySettings, error)
 	GetUserAvatarURL(context.Context, *FileKey) (*AvatarUrl, error)
-	UploadUserAvatar(context.Context, *AvatarImage) (*FileKey, error)
+	UploadUserAvatar(context.Context, *AvatarImage) (*UploadedAvatar, error)
 	GetLabelIDByUserID(context.Context, *UserID) (*LabelID, error)
 	UpdateUsersLabelID(context.Context, *RequestUpdateUserLabelID) (*Nothing, error)
 	ChecksUsersByUsernames(context.Context, *Usernames) (*Nothing, error)
 	GetUsersByLabelID(context.Context, *LabelID) (*Usernames, error)
 	RemoveUsersFromLabel(context.Context, *RequestRemoveUserLabelID) (*Nothing, error)
+	FollowUser(context.Context, *FollowRequest) (*Nothing, error)
+	UnfollowUser(context.Context, *FollowRequest) (*Nothing, error)
+	GetFollowers(context.Context, *UserIDWithPagination) (*UsersToFront, error)
+	GetFollowing(context.Context, *UserIDWithPagination) (*UsersToFront, error)
+	GetActivityFeed(context.Context, *UserIDWithPagination) (*ActivityList, error)
 	mustEmbedUnimplementedUserServiceServer()
 }
 
@@ -259,7 +314,7 @@ func (UnimplementedUserServiceServer) GetUserPrivacyByID(context.Context, *UserI
 func (UnimplementedUserServiceServer) GetUserAvatarURL(context.Context, *FileKey) (*AvatarUrl, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUserAvatarURL not implemented")
 }
-func (UnimplementedUserServiceServer) UploadUserAvatar(context.Context, *AvatarImage) (*FileKey, error) {
+func (UnimplementedUserServiceServer) UploadUserAvatar(context.Context, *AvatarImage) (*UploadedAvatar, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UploadUserAvatar not implemented")
 }
 func (UnimplementedUserServiceServer) GetLabelIDByUserID(context.Context, *UserID) (*LabelID, error) {
@@ -277,6 +332,21 @@ func (UnimplementedUserServiceServer) GetUsersByLabelID(context.Context, *LabelI
 func (UnimplementedUserServiceServer) RemoveUsersFromLabel(context.Context, *RequestRemoveUserLabelID) (*Nothing, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveUsersFromLabel not implemented")
 }
+func (UnimplementedUserServiceServer) FollowUser(context.Context, *FollowRequest) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FollowUser not implemented")
+}
+func (UnimplementedUserServiceServer) UnfollowUser(context.Context, *FollowRequest) (*Nothing, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfollowUser not implemented")
+}
+func (UnimplementedUserServiceServer) GetFollowers(context.Context, *UserIDWithPagination) (*UsersToFront, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowers not implemented")
+}
+func (UnimplementedUserServiceServer) GetFollowing(context.Context, *UserIDWithPagination) (*UsersToFront, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFollowing not implemented")
+}
+func (UnimplementedUserServiceServer) GetActivityFeed(context.Context, *UserIDWithPagination) (*ActivityList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivityFeed not implemented")
+}
 func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
 
 // UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -596,6 +666,96 @@ func _UserService_RemoveUsersFromLabel_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_FollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).FollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/FollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).FollowUser(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_UnfollowUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FollowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).UnfollowUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/UnfollowUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).UnfollowUser(ctx, req.(*FollowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetFollowers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDWithPagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetFollowers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowers(ctx, req.(*UserIDWithPagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetFollowing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDWithPagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetFollowing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetFollowing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetFollowing(ctx, req.(*UserIDWithPagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetActivityFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserIDWithPagination)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetActivityFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/GetActivityFeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetActivityFeed(ctx, req.(*UserIDWithPagination))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -671,6 +831,26 @@ var UserService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RemoveUsersFromLabel",
 			Handler:    _UserService_RemoveUsersFromLabel_Handler,
 		},
+		{
+			MethodName: "FollowUser",
+			Handler:    _UserService_FollowUser_Handler,
+		},
+		{
+			MethodName: "UnfollowUser",
+			Handler:    _UserService_UnfollowUser_Handler,
+		},
+		{
+			MethodName: "GetFollowers",
+			Handler:    _UserService_GetFollowers_Handler,
+		},
+		{
+			MethodName: "GetFollowing",
+			Handler:    _UserService_GetFollowing_Handler,
+		},
+		{
+			MethodName: "GetActivityFeed",
+			Handler:    _UserService_GetActivityFeed_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user/user.proto",
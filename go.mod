@@ -25,6 +25,7 @@ require (
 	go.uber.org/mock v0.5.1
 	go.uber.org/zap v1.27.0
 	golang.org/x/crypto v0.37.0
+	golang.org/x/image v0.26.0
 	google.golang.org/grpc v1.72.0
 	google.golang.org/protobuf v1.36.5
 )
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"net"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
@@ -27,6 +28,27 @@ func (w *statusResponseWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
 	return nil, nil, http.ErrNotSupported
 }
 
+// credentials that clients may still put in the query, they must not reach the logs
+var redactedQueryParams = []string{"invite", "password"}
+
+func redactedURL(u *url.URL) string {
+	query := u.Query()
+	redacted := false
+	for _, param := range redactedQueryParams {
+		if query.Has(param) {
+			query.Del(param)
+			redacted = true
+		}
+	}
+	if !redacted {
+		return u.String()
+	}
+
+	stripped := *u
+	stripped.RawQuery = query.Encode()
+	return stripped.String()
+}
+
 func AccessLog(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		logger := logger.LoggerFromContext(r.Context())
@@ -44,7 +66,7 @@ func AccessLog(next http.Handler) http.Handler {
 		logger.Infow(
 			"access",
 			"method", r.Method,
-			"url", r.URL.String(),
+			"url", redactedURL(r.URL),
 			"ip", r.RemoteAddr,
 			"user-agent", r.UserAgent(),
 			"status", sw.status,
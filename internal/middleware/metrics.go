@@ -16,6 +16,7 @@ var pathPatterns = []*regexp.Regexp{
 	regexp.MustCompile(`^/api/v1/tracks/([0-9]+)$`),
 	regexp.MustCompile(`^/api/v1/tracks/([0-9]+)/stream$`),
 	regexp.MustCompile(`^/api/v1/tracks/([0-9]+)/like$`),
+	regexp.MustCompile(`^/api/v1/tracks/([0-9]+)/lyrics$`),
 	regexp.MustCompile(`^/api/v1/tracks/search$`),
 	regexp.MustCompile(`^/api/v1/streams/([0-9]+)$`),
 
@@ -75,6 +76,7 @@ var pathReplacements = []string{
 	"/api/v1/tracks/{id}",
 	"/api/v1/tracks/{id}/stream",
 	"/api/v1/tracks/{id}/like",
+	"/api/v1/tracks/{id}/lyrics",
 	"/api/v1/tracks/search",
 	"/api/v1/streams/{id}",
 
@@ -12,6 +12,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/searchAnalytics"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
@@ -20,12 +21,13 @@ import (
 )
 
 type AlbumHandler struct {
-	usecase album.Usecase
-	cfg     *config.Config
+	usecase  album.Usecase
+	recorder searchAnalytics.Recorder
+	cfg      *config.Config
 }
 
-func NewAlbumHandler(usecase album.Usecase, cfg *config.Config) *AlbumHandler {
-	return &AlbumHandler{usecase: usecase, cfg: cfg}
+func NewAlbumHandler(usecase album.Usecase, recorder searchAnalytics.Recorder, cfg *config.Config) *AlbumHandler {
+	return &AlbumHandler{usecase: usecase, recorder: recorder, cfg: cfg}
 }
 
 // GetAllAlbums godoc
@@ -254,6 +256,8 @@ func (h *AlbumHandler) GetFavoriteAlbums(w http.ResponseWriter, r *http.Request)
 // @Accept json
 // @Produce json
 // @Param query query string true "Query"
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
 // @Success 200 {object} delivery.APIResponse{body=[]delivery.Album} "List of albums"
 // @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid query"
 // @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
@@ -269,13 +273,28 @@ func (h *AlbumHandler) SearchAlbums(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	usecaseAlbums, err := h.usecase.SearchAlbums(ctx, query)
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	usecaseAlbums, err := h.usecase.SearchAlbums(ctx, query, &usecaseModel.AlbumFilters{
+		Pagination: model.PaginationFromDeliveryToUsecase(pagination),
+	})
 	if err != nil {
 		logger.Error("failed to search albums", zap.Error(err))
 		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
 		return
 	}
 
+	h.recorder.LogQuery(ctx, &usecaseModel.SearchQueryLog{
+		Source:       usecaseModel.SearchSourceAlbums,
+		Query:        query,
+		ResultsCount: len(usecaseAlbums),
+	})
+
 	albums := model.AlbumsFromUsecaseToDelivery(usecaseAlbums)
 	json.WriteSuccessResponse(w, http.StatusOK, albums, nil)
 }
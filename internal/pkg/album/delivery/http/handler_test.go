@@ -17,6 +17,7 @@ import (
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_search "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/mocks"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -32,7 +33,7 @@ func setupTestHandler(t *testing.T) (*mock_album.MockUsecase, *AlbumHandler, *co
 			MaxLimit:     100,
 		},
 	}
-	handler := NewAlbumHandler(mockUsecase, cfg)
+	handler := NewAlbumHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 	return mockUsecase, handler, cfg
 }
 
@@ -643,7 +644,9 @@ func TestGetFavoriteAlbums(t *testing.T) {
 }
 
 func TestSearchAlbums(t *testing.T) {
-	mockUsecase, handler, _ := setupTestHandler(t)
+	mockUsecase, _, cfg := setupTestHandler(t)
+	mockRecorder := mock_search.NewMockUsecase(gomock.NewController(t))
+	handler := NewAlbumHandler(mockUsecase, mockRecorder, cfg)
 
 	tests := []struct {
 		name           string
@@ -659,6 +662,7 @@ func TestSearchAlbums(t *testing.T) {
 				mockUsecase.EXPECT().SearchAlbums(
 					gomock.Any(),
 					"test",
+					gomock.Any(),
 				).Return([]*usecaseModel.Album{
 					{
 						ID:          1,
@@ -675,6 +679,11 @@ func TestSearchAlbums(t *testing.T) {
 						IsLiked: true,
 					},
 				}, nil)
+				mockRecorder.EXPECT().LogQuery(gomock.Any(), &usecaseModel.SearchQueryLog{
+					Source:       usecaseModel.SearchSourceAlbums,
+					Query:        "test",
+					ResultsCount: 1,
+				})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: []*deliveryModel.Album{
@@ -709,6 +718,7 @@ func TestSearchAlbums(t *testing.T) {
 				mockUsecase.EXPECT().SearchAlbums(
 					gomock.Any(),
 					"test",
+					gomock.Any(),
 				).Return(nil, errors.New("usecase error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -116,16 +116,16 @@ func (mr *MockUsecaseMockRecorder) LikeAlbum(ctx, request any) *gomock.Call {
 }
 
 // SearchAlbums mocks base method.
-func (m *MockUsecase) SearchAlbums(ctx context.Context, query string) ([]*usecase.Album, error) {
+func (m *MockUsecase) SearchAlbums(ctx context.Context, query string, filters *usecase.AlbumFilters) ([]*usecase.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchAlbums", ctx, query)
+	ret := m.ctrl.Call(m, "SearchAlbums", ctx, query, filters)
 	ret0, _ := ret[0].([]*usecase.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchAlbums indicates an expected call of SearchAlbums.
-func (mr *MockUsecaseMockRecorder) SearchAlbums(ctx, query any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchAlbums(ctx, query, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlbums", reflect.TypeOf((*MockUsecase)(nil).SearchAlbums), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlbums", reflect.TypeOf((*MockUsecase)(nil).SearchAlbums), ctx, query, filters)
 }
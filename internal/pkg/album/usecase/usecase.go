@@ -177,15 +177,16 @@ func (u *albumUsecase) GetFavoriteAlbums(ctx context.Context, filters *usecaseMo
 	return albums, nil
 }
 
-func (u *albumUsecase) SearchAlbums(ctx context.Context, query string) ([]*usecaseModel.Album, error) {
+func (u *albumUsecase) SearchAlbums(ctx context.Context, query string, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.Album, error) {
 	userID, exists := ctxExtractor.UserFromContext(ctx)
 	if !exists {
 		userID = -1
 	}
 
 	protoRequest := &albumProto.Query{
-		Query:  query,
-		UserId: &albumProto.UserID{Id: userID},
+		Query:   query,
+		UserId:  &albumProto.UserID{Id: userID},
+		Filters: &albumProto.Filters{Pagination: model.PaginationFromUsecaseToAlbumProto(filters.Pagination)},
 	}
 
 	protoAlbums, err := u.albumClient.SearchAlbums(ctx, protoRequest)
@@ -444,9 +444,17 @@ func TestSearchAlbums(t *testing.T) {
 	ctx := context.Background()
 	query := "search query"
 
+	filters := &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
+
 	protoRequest := &album.Query{
-		Query:  query,
-		UserId: &album.UserID{Id: -1},
+		Query:   query,
+		UserId:  &album.UserID{Id: -1},
+		Filters: &album.Filters{Pagination: &album.Pagination{Offset: 0, Limit: 10}},
 	}
 
 	releaseDate := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
@@ -500,7 +508,7 @@ func TestSearchAlbums(t *testing.T) {
 	mockAlbumClient.EXPECT().SearchAlbums(ctx, protoRequest).Return(mockAlbums, nil)
 	mockArtistClient.EXPECT().GetArtistsByAlbumIDs(ctx, &artist.AlbumIDList{Ids: albumIDs}).Return(artistMap, nil)
 
-	albums, err := albumUsecase.SearchAlbums(ctx, query)
+	albums, err := albumUsecase.SearchAlbums(ctx, query, filters)
 	assert.NoError(t, err)
 	assert.Len(t, albums, 2)
 	assert.Equal(t, int64(1), albums[0].ID)
@@ -1038,14 +1046,22 @@ func TestSearchAlbumsError(t *testing.T) {
 	ctx := context.Background()
 	query := "search query"
 
+	filters := &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
+
 	protoRequest := &album.Query{
-		Query:  query,
-		UserId: &album.UserID{Id: -1},
+		Query:   query,
+		UserId:  &album.UserID{Id: -1},
+		Filters: &album.Filters{Pagination: &album.Pagination{Offset: 0, Limit: 10}},
 	}
 
 	mockAlbumClient.EXPECT().SearchAlbums(ctx, protoRequest).Return(nil, status.Error(codes.Internal, "search service error"))
 
-	albums, err := albumUsecase.SearchAlbums(ctx, query)
+	albums, err := albumUsecase.SearchAlbums(ctx, query, filters)
 	assert.Error(t, err)
 	assert.Nil(t, albums)
 }
@@ -1061,9 +1077,17 @@ func TestSearchAlbumsArtistError(t *testing.T) {
 	ctx := context.Background()
 	query := "search query"
 
+	filters := &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
+
 	protoRequest := &album.Query{
-		Query:  query,
-		UserId: &album.UserID{Id: -1},
+		Query:   query,
+		UserId:  &album.UserID{Id: -1},
+		Filters: &album.Filters{Pagination: &album.Pagination{Offset: 0, Limit: 10}},
 	}
 
 	mockAlbums := &album.AlbumList{
@@ -1084,7 +1108,7 @@ func TestSearchAlbumsArtistError(t *testing.T) {
 	mockAlbumClient.EXPECT().SearchAlbums(ctx, protoRequest).Return(mockAlbums, nil)
 	mockArtistClient.EXPECT().GetArtistsByAlbumIDs(ctx, &artist.AlbumIDList{Ids: albumIDs}).Return(nil, status.Error(codes.Internal, "artist service error"))
 
-	albums, err := albumUsecase.SearchAlbums(ctx, query)
+	albums, err := albumUsecase.SearchAlbums(ctx, query, filters)
 	assert.Error(t, err)
 	assert.Nil(t, albums)
 }
@@ -1101,9 +1125,17 @@ func TestSearchAlbumsWithAuthenticatedUser(t *testing.T) {
 	ctx := context.WithValue(context.Background(), ctxExtractor.UserContextKey{}, userID)
 	query := "authenticated search"
 
+	filters := &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
+
 	protoRequest := &album.Query{
-		Query:  query,
-		UserId: &album.UserID{Id: userID},
+		Query:   query,
+		UserId:  &album.UserID{Id: userID},
+		Filters: &album.Filters{Pagination: &album.Pagination{Offset: 0, Limit: 10}},
 	}
 
 	releaseDate := time.Date(2023, 8, 20, 0, 0, 0, 0, time.UTC)
@@ -1138,7 +1170,7 @@ func TestSearchAlbumsWithAuthenticatedUser(t *testing.T) {
 	mockAlbumClient.EXPECT().SearchAlbums(ctx, protoRequest).Return(mockAlbums, nil)
 	mockArtistClient.EXPECT().GetArtistsByAlbumIDs(ctx, &artist.AlbumIDList{Ids: albumIDs}).Return(artistMap, nil)
 
-	albums, err := albumUsecase.SearchAlbums(ctx, query)
+	albums, err := albumUsecase.SearchAlbums(ctx, query, filters)
 	assert.NoError(t, err)
 	assert.Len(t, albums, 1)
 	assert.Equal(t, int64(100), albums[0].ID)
@@ -1161,9 +1193,17 @@ func TestSearchAlbumsEmpty(t *testing.T) {
 	ctx := context.Background()
 	query := "no results query"
 
+	filters := &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
+
 	protoRequest := &album.Query{
-		Query:  query,
-		UserId: &album.UserID{Id: -1},
+		Query:   query,
+		UserId:  &album.UserID{Id: -1},
+		Filters: &album.Filters{Pagination: &album.Pagination{Offset: 0, Limit: 10}},
 	}
 
 	mockAlbums := &album.AlbumList{Albums: []*album.Album{}}
@@ -1172,7 +1212,7 @@ func TestSearchAlbumsEmpty(t *testing.T) {
 	mockAlbumClient.EXPECT().SearchAlbums(ctx, protoRequest).Return(mockAlbums, nil)
 	mockArtistClient.EXPECT().GetArtistsByAlbumIDs(ctx, &artist.AlbumIDList{Ids: []*artist.AlbumID{}}).Return(artistMap, nil)
 
-	albums, err := albumUsecase.SearchAlbums(ctx, query)
+	albums, err := albumUsecase.SearchAlbums(ctx, query, filters)
 	assert.NoError(t, err)
 	assert.Len(t, albums, 0)
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/searchAnalytics"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
@@ -20,12 +21,13 @@ import (
 )
 
 type ArtistHandler struct {
-	usecase artist.Usecase
-	cfg     *config.Config
+	usecase  artist.Usecase
+	recorder searchAnalytics.Recorder
+	cfg      *config.Config
 }
 
-func NewArtistHandler(usecase artist.Usecase, cfg *config.Config) *ArtistHandler {
-	return &ArtistHandler{usecase: usecase, cfg: cfg}
+func NewArtistHandler(usecase artist.Usecase, recorder searchAnalytics.Recorder, cfg *config.Config) *ArtistHandler {
+	return &ArtistHandler{usecase: usecase, recorder: recorder, cfg: cfg}
 }
 
 // GetAllArtists godoc
@@ -210,6 +212,8 @@ func (h *ArtistHandler) GetFavoriteArtists(w http.ResponseWriter, r *http.Reques
 // @Accept json
 // @Produce json
 // @Param query query string true "Query"
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
 // @Success 200 {object} delivery.APIResponse{body=[]delivery.Artist} "List of artists"
 // @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid query"
 // @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
@@ -225,13 +229,28 @@ func (h *ArtistHandler) SearchArtists(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	usecaseArtists, err := h.usecase.SearchArtists(ctx, query)
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	usecaseArtists, err := h.usecase.SearchArtists(ctx, query, &usecaseModel.ArtistFilters{
+		Pagination: model.PaginationFromDeliveryToUsecase(pagination),
+	})
 	if err != nil {
 		logger.Error("failed to search artists", zap.Error(err))
 		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
 		return
 	}
 
+	h.recorder.LogQuery(ctx, &usecaseModel.SearchQueryLog{
+		Source:       usecaseModel.SearchSourceArtists,
+		Query:        query,
+		ResultsCount: len(usecaseArtists),
+	})
+
 	artists := model.ArtistsFromUsecaseToDelivery(usecaseArtists)
 	json.WriteSuccessResponse(w, http.StatusOK, artists, nil)
 }
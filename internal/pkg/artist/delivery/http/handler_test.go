@@ -16,6 +16,7 @@ import (
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_search "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/mocks"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -31,7 +32,7 @@ func setupTestHandler(t *testing.T) (*mock_artist.MockUsecase, *ArtistHandler, *
 			MaxLimit:     100,
 		},
 	}
-	handler := NewArtistHandler(mockUsecase, cfg)
+	handler := NewArtistHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 	return mockUsecase, handler, cfg
 }
 
@@ -474,7 +475,9 @@ func TestGetFavoriteArtists(t *testing.T) {
 }
 
 func TestSearchArtists(t *testing.T) {
-	mockUsecase, handler, _ := setupTestHandler(t)
+	mockUsecase, _, cfg := setupTestHandler(t)
+	mockRecorder := mock_search.NewMockUsecase(gomock.NewController(t))
+	handler := NewArtistHandler(mockUsecase, mockRecorder, cfg)
 
 	tests := []struct {
 		name           string
@@ -490,6 +493,7 @@ func TestSearchArtists(t *testing.T) {
 				mockUsecase.EXPECT().SearchArtists(
 					gomock.Any(),
 					"test",
+					gomock.Any(),
 				).Return([]*usecaseModel.Artist{
 					{
 						ID:          1,
@@ -499,6 +503,11 @@ func TestSearchArtists(t *testing.T) {
 						IsLiked:     true,
 					},
 				}, nil)
+				mockRecorder.EXPECT().LogQuery(gomock.Any(), &usecaseModel.SearchQueryLog{
+					Source:       usecaseModel.SearchSourceArtists,
+					Query:        "test",
+					ResultsCount: 1,
+				})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: []*deliveryModel.Artist{
@@ -526,6 +535,7 @@ func TestSearchArtists(t *testing.T) {
 				mockUsecase.EXPECT().SearchArtists(
 					gomock.Any(),
 					"test",
+					gomock.Any(),
 				).Return(nil, errors.New("usecase error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
@@ -101,16 +101,16 @@ func (mr *MockUsecaseMockRecorder) LikeArtist(ctx, request any) *gomock.Call {
 }
 
 // SearchArtists mocks base method.
-func (m *MockUsecase) SearchArtists(ctx context.Context, query string) ([]*usecase.Artist, error) {
+func (m *MockUsecase) SearchArtists(ctx context.Context, query string, filters *usecase.ArtistFilters) ([]*usecase.Artist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchArtists", ctx, query)
+	ret := m.ctrl.Call(m, "SearchArtists", ctx, query, filters)
 	ret0, _ := ret[0].([]*usecase.Artist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchArtists indicates an expected call of SearchArtists.
-func (mr *MockUsecaseMockRecorder) SearchArtists(ctx, query any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchArtists(ctx, query, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockUsecase)(nil).SearchArtists), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockUsecase)(nil).SearchArtists), ctx, query, filters)
 }
@@ -106,15 +106,16 @@ func (u *artistUsecase) GetFavoriteArtists(ctx context.Context, filters *usecase
 	return model.ArtistsFromProtoToUsecase(protoArtists.Artists), nil
 }
 
-func (u *artistUsecase) SearchArtists(ctx context.Context, query string) ([]*usecaseModel.Artist, error) {
+func (u *artistUsecase) SearchArtists(ctx context.Context, query string, filters *usecaseModel.ArtistFilters) ([]*usecaseModel.Artist, error) {
 	userID, exists := ctxExtractor.UserFromContext(ctx)
 	if !exists {
 		userID = -1
 	}
 
 	protoRequest := &artistProto.Query{
-		Query:  query,
-		UserId: &artistProto.UserID{Id: userID},
+		Query:   query,
+		UserId:  &artistProto.UserID{Id: userID},
+		Filters: &artistProto.Filters{Pagination: model.PaginationFromUsecaseToArtistProto(filters.Pagination)},
 	}
 
 	protoArtists, err := u.artistClient.SearchArtists(ctx, protoRequest)
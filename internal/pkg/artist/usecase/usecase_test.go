@@ -617,6 +617,13 @@ func TestSearchArtists(t *testing.T) {
 
 	artistUsecase := NewUsecase(artistClient, userClient)
 
+	filters := &usecase.ArtistFilters{
+		Pagination: &usecase.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
+
 	tests := []struct {
 		name            string
 		query           string
@@ -633,8 +640,9 @@ func TestSearchArtists(t *testing.T) {
 				mockArtistClient.EXPECT().SearchArtists(
 					gomock.Any(),
 					&artistProto.Query{
-						Query:  "test",
-						UserId: &artistProto.UserID{Id: 1},
+						Query:   "test",
+						UserId:  &artistProto.UserID{Id: 1},
+						Filters: &artistProto.Filters{Pagination: &artistProto.Pagination{Offset: 0, Limit: 10}},
 					},
 				).Return(&artistProto.ArtistList{
 					Artists: []*artistProto.Artist{
@@ -667,8 +675,9 @@ func TestSearchArtists(t *testing.T) {
 				mockArtistClient.EXPECT().SearchArtists(
 					gomock.Any(),
 					&artistProto.Query{
-						Query:  "test",
-						UserId: &artistProto.UserID{Id: -1},
+						Query:   "test",
+						UserId:  &artistProto.UserID{Id: -1},
+						Filters: &artistProto.Filters{Pagination: &artistProto.Pagination{Offset: 0, Limit: 10}},
 					},
 				).Return(&artistProto.ArtistList{
 					Artists: []*artistProto.Artist{
@@ -701,8 +710,9 @@ func TestSearchArtists(t *testing.T) {
 				mockArtistClient.EXPECT().SearchArtists(
 					gomock.Any(),
 					&artistProto.Query{
-						Query:  "test",
-						UserId: &artistProto.UserID{Id: -1},
+						Query:   "test",
+						UserId:  &artistProto.UserID{Id: -1},
+						Filters: &artistProto.Filters{Pagination: &artistProto.Pagination{Offset: 0, Limit: 10}},
 					},
 				).Return(nil, status.Error(codes.Internal, "internal error"))
 			},
@@ -717,8 +727,9 @@ func TestSearchArtists(t *testing.T) {
 				mockArtistClient.EXPECT().SearchArtists(
 					gomock.Any(),
 					&artistProto.Query{
-						Query:  "nonexistent",
-						UserId: &artistProto.UserID{Id: -1},
+						Query:   "nonexistent",
+						UserId:  &artistProto.UserID{Id: -1},
+						Filters: &artistProto.Filters{Pagination: &artistProto.Pagination{Offset: 0, Limit: 10}},
 					},
 				).Return(&artistProto.ArtistList{
 					Artists: []*artistProto.Artist{},
@@ -733,7 +744,7 @@ func TestSearchArtists(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.setupMocks()
 
-			artists, err := artistUsecase.SearchArtists(tt.ctx, tt.query)
+			artists, err := artistUsecase.SearchArtists(tt.ctx, tt.query, filters)
 
 			if tt.expectedError != nil {
 				assert.Error(t, err)
@@ -2,6 +2,7 @@ package customErrors
 
 import (
 	"errors"
+	"fmt"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,8 +30,10 @@ var (
 	ErrPlaylistNotFound             = errors.New("playlist not found")
 	ErrPlaylistPermissionDenied     = errors.New("user does not have permission for this playlist")
 	ErrPlaylistBadRequest           = errors.New("invalid playlist request")
-	ErrUnsupportedImageFormat       = errors.New("unsupported image format: only JPEG and PNG are allowed")
+	ErrUnsupportedImageFormat       = errors.New("unsupported image format: only JPEG, PNG, GIF and WebP are allowed")
 	ErrImageTooBig                  = errors.New("image size exceeds 5MB limit")
+	ErrImageTooSmall                = errors.New("image must be at least 300x300 pixels")
+	ErrImageTooLarge                = errors.New("image must be at most 5000x5000 pixels")
 	ErrFailedToParseImage           = errors.New("failed to parse image")
 	ErrFailedToUploadImage          = errors.New("failed to upload image")
 	ErrFailedToCreatePlaylist       = errors.New("failed to create playlist")
@@ -45,8 +48,80 @@ var (
 	ErrCreateRoomNotAllDataProvided = errors.New("not all data provided")
 	ErrRoomIDRequired               = errors.New("room id is required")
 	ErrInvalidSelection             = errors.New("invalid selection")
+	ErrSearchQueryEmpty             = errors.New("search query is empty")
+	ErrInvalidSearchType            = errors.New("invalid search type")
+	ErrInvalidSearchClick           = errors.New("invalid search click")
+	ErrFollowYourself               = errors.New("you can't follow yourself")
+	ErrNotificationNotFound         = errors.New("notification not found")
+	ErrInvalidNotificationType      = errors.New("invalid notification type")
+	ErrJamInvalidMaxListeners       = errors.New("max listeners can't be negative")
+	ErrJamBanned                    = errors.New("you are banned from this jam")
+	ErrJamInviteRequired            = errors.New("jam is private, invite required")
+	ErrJamWrongPassword             = errors.New("wrong jam password")
+	ErrJamFull                      = errors.New("jam is full")
+	ErrJamRateLimited               = errors.New("too many messages, slow down")
+	ErrJamMuted                     = errors.New("you are muted in this jam")
+	ErrJamInvalidMessage            = errors.New("invalid chat message or reaction")
+	ErrJamJoinRequired              = errors.New("the first jam message must be a join")
+	ErrJamNotHost                   = errors.New("not host")
+	ErrJamUserNotInJam              = errors.New("user not in jam")
+	ErrJamInvalidPosition           = errors.New("invalid position")
+	ErrJamTrackIDRequired           = errors.New("track id required")
+	ErrJamInvalidModerationTarget   = errors.New("invalid moderation target")
+	ErrJamTrackNotSuggested         = errors.New("track not suggested")
+	ErrJamTrackNotQueued            = errors.New("track not in queue")
+	ErrJamChatMessageNotFound       = errors.New("message not found")
+	ErrJamTooManySuggestions        = errors.New("the jam has too many open suggestions")
+	ErrJamUserSuggestionLimit       = errors.New("you have too many open suggestions")
+	ErrJamSessionNotFound           = errors.New("jam session not found")
+	ErrJamSessionEmpty              = errors.New("no tracks were played in this jam session")
+	ErrJamPlaylistInvalidTitle      = errors.New("playlist title must be 1 to 100 characters")
+	ErrJamPlaylistTitleTaken        = errors.New("you already have a playlist with this title")
+	ErrUploadNotFound               = errors.New("upload not found")
+	ErrUploadInvalidKind            = errors.New("upload kind must be track or cover")
+	ErrUploadInvalidContentType     = errors.New("unsupported upload content type")
+	ErrUploadInvalidSize            = errors.New("upload size must be positive")
+	ErrUploadTooLarge               = errors.New("upload is too large")
+	ErrUploadInvalidPart            = errors.New("invalid upload part number")
+	ErrUploadIncomplete             = errors.New("upload is not complete")
+	ErrUploadAlreadyCompleted       = errors.New("upload is already completed")
+	ErrUploadInUse                  = errors.New("upload is already used by another release")
+	ErrUploadDuplicate              = errors.New("the same upload can't be used for more than one track")
+	ErrAlbumInvalidStatus           = errors.New("album status must be draft, in_review, scheduled, published or taken_down")
+	ErrAlbumInvalidType             = errors.New("album type must be album, ep, single or compilation")
+	ErrAlbumStatusTransition        = errors.New("album status can't be changed this way")
+	ErrAlbumReviewRequired          = errors.New("only reviewers can schedule albums in review")
+	ErrReleaseDateRequired          = errors.New("release date is required")
+	ErrReleaseDateInPast            = errors.New("release date can't be in the past")
+	ErrAlbumNotDraft                = errors.New("album is not a draft")
+	ErrAlbumTracksInvalid           = errors.New("every track of the album must be listed once with positions from 1")
+	ErrArtistsNotInLabel            = errors.New("only artists of the label can be credited")
+	ErrAlbumArtistRequired          = errors.New("album must have at least one artist")
+	ErrAlbumTitleInvalid            = errors.New("album title must be from 1 to 100 characters long")
+	ErrTrackTitleInvalid            = errors.New("track title must be from 1 to 100 characters long")
+	ErrAlbumCoverRequired           = errors.New("thumbnail image is required")
+	ErrTrackPositionInvalid         = errors.New("track position is out of range")
+	ErrTrackAudioInvalid            = errors.New("uploaded file is not a supported audio file (mp3, flac, wav or ogg)")
+	ErrTrackSegmentsNotFound        = errors.New("track is not segmented for hls yet")
+	ErrTrackSegmentNotFound         = errors.New("segment not found")
+	ErrTrackWaveformNotFound        = errors.New("track is not analysed for a waveform yet")
+	ErrTrackLyricsInvalid           = errors.New("lyrics must have from 1 to 1000 lines of up to 500 characters")
+	ErrTrackLyricsLanguageInvalid   = errors.New("lyrics language must be a two or three letter ISO 639 code")
+	ErrTrackLyricsNotFound          = errors.New("lyrics not found")
+	ErrTrackDuplicate               = errors.New("uploaded audio is already in the catalog")
+	ErrDuplicateFlagNotFound        = errors.New("duplicate flag not found")
+	ErrDuplicateFlagStatusInvalid   = errors.New("duplicate flag can only be confirmed or dismissed")
+	ErrMainArtistRequired           = errors.New("track must have at least one main artist")
+	ErrInvalidArtistRole            = errors.New("artist role must be main, featured, producer or writer")
 )
 
+// imageErrors are returned by the image pipeline of every service that stores images
+var imageErrors = map[string]error{
+	ErrUnsupportedImageFormat.Error(): ErrUnsupportedImageFormat,
+	ErrImageTooSmall.Error():          ErrImageTooSmall,
+	ErrImageTooLarge.Error():          ErrImageTooLarge,
+}
+
 func HandleAlbumGRPCError(err error) error {
 	if err == nil {
 		return nil
@@ -60,6 +135,24 @@ func HandleAlbumGRPCError(err error) error {
 	switch st.Code() {
 	case codes.NotFound:
 		return ErrAlbumNotFound
+	case codes.FailedPrecondition:
+		return ErrAlbumStatusTransition
+	case codes.PermissionDenied:
+		return ErrAlbumReviewRequired
+	case codes.InvalidArgument:
+		switch st.Message() {
+		case ErrReleaseDateRequired.Error():
+			return ErrReleaseDateRequired
+		case ErrReleaseDateInPast.Error():
+			return ErrReleaseDateInPast
+		case ErrAlbumTitleInvalid.Error():
+			return ErrAlbumTitleInvalid
+		default:
+			if imageErr, ok := imageErrors[st.Message()]; ok {
+				return imageErr
+			}
+			return err
+		}
 	case codes.Internal:
 		return errors.New("internal server error: " + st.Message())
 	default:
@@ -80,6 +173,27 @@ func HandleArtistGRPCError(err error) error {
 	switch st.Code() {
 	case codes.NotFound:
 		return ErrArtistNotFound
+	case codes.PermissionDenied:
+		switch st.Message() {
+		case ErrArtistsNotInLabel.Error():
+			return ErrArtistsNotInLabel
+		default:
+			return err
+		}
+	case codes.InvalidArgument:
+		switch st.Message() {
+		case ErrAlbumArtistRequired.Error():
+			return ErrAlbumArtistRequired
+		case ErrMainArtistRequired.Error():
+			return ErrMainArtistRequired
+		case ErrInvalidArtistRole.Error():
+			return ErrInvalidArtistRole
+		default:
+			if imageErr, ok := imageErrors[st.Message()]; ok {
+				return imageErr
+			}
+			return err
+		}
 	case codes.Internal:
 		return errors.New("internal server error: " + st.Message())
 	default:
@@ -104,11 +218,50 @@ func HandleTrackGRPCError(err error) error {
 			return ErrTrackNotFound
 		case "stream not found":
 			return ErrStreamNotFound
+		case "album not found":
+			return ErrAlbumNotFound
+		case ErrTrackSegmentsNotFound.Error():
+			return ErrTrackSegmentsNotFound
+		case ErrTrackSegmentNotFound.Error():
+			return ErrTrackSegmentNotFound
+		case ErrTrackWaveformNotFound.Error():
+			return ErrTrackWaveformNotFound
+		case ErrTrackLyricsNotFound.Error():
+			return ErrTrackLyricsNotFound
+		case ErrDuplicateFlagNotFound.Error():
+			return ErrDuplicateFlagNotFound
 		default:
 			return err
 		}
 	case codes.PermissionDenied:
 		return ErrStreamPermissionDenied
+	case codes.AlreadyExists:
+		// the message names the catalog track the upload duplicates
+		return fmt.Errorf("%w: %s", ErrTrackDuplicate, st.Message())
+	case codes.FailedPrecondition:
+		return ErrAlbumNotDraft
+	case codes.InvalidArgument:
+		switch st.Message() {
+		case ErrAlbumTracksInvalid.Error():
+			return ErrAlbumTracksInvalid
+		case ErrTrackTitleInvalid.Error():
+			return ErrTrackTitleInvalid
+		case ErrTrackPositionInvalid.Error():
+			return ErrTrackPositionInvalid
+		case ErrTrackAudioInvalid.Error():
+			return ErrTrackAudioInvalid
+		case ErrDuplicateFlagStatusInvalid.Error():
+			return ErrDuplicateFlagStatusInvalid
+		case ErrTrackLyricsInvalid.Error():
+			return ErrTrackLyricsInvalid
+		case ErrTrackLyricsLanguageInvalid.Error():
+			return ErrTrackLyricsLanguageInvalid
+		default:
+			if imageErr, ok := imageErrors[st.Message()]; ok {
+				return imageErr
+			}
+			return err
+		}
 	case codes.Internal:
 		switch st.Message() {
 		case "failed to update stream duration":
@@ -139,7 +292,15 @@ func HandleUserGRPCError(err error) error {
 	case codes.Unauthenticated:
 		return ErrWrongPassword
 	case codes.InvalidArgument:
-		return ErrPasswordRequired
+		switch st.Message() {
+		case ErrFollowYourself.Error():
+			return ErrFollowYourself
+		default:
+			if imageErr, ok := imageErrors[st.Message()]; ok {
+				return imageErr
+			}
+			return ErrPasswordRequired
+		}
 	case codes.Internal:
 		switch st.Message() {
 		case "failed to create salt":
@@ -196,8 +357,6 @@ func HandlePlaylistGRPCError(err error) error {
 		switch st.Message() {
 		case "invalid playlist request":
 			return ErrPlaylistBadRequest
-		case "unsupported image format: only JPEG and PNG are allowed":
-			return ErrUnsupportedImageFormat
 		case "image size exceeds 5MB limit":
 			return ErrImageTooBig
 		case "failed to parse image":
@@ -205,6 +364,9 @@ func HandlePlaylistGRPCError(err error) error {
 		case "failed to upload image":
 			return ErrFailedToUploadImage
 		default:
+			if imageErr, ok := imageErrors[st.Message()]; ok {
+				return imageErr
+			}
 			return err
 		}
 	case codes.AlreadyExists:
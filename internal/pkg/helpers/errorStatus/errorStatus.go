@@ -1,6 +1,7 @@
 package errorStatus
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
@@ -25,6 +26,8 @@ var mapErrorStatus = map[error]int{
 	customErrors.ErrInvalidLimit:                 http.StatusBadRequest,
 	customErrors.ErrPasswordRequired:             http.StatusBadRequest,
 	customErrors.ErrUnsupportedImageFormat:       http.StatusBadRequest,
+	customErrors.ErrImageTooSmall:                http.StatusBadRequest,
+	customErrors.ErrImageTooLarge:                http.StatusBadRequest,
 	customErrors.ErrFailedToParseImage:           http.StatusBadRequest,
 	customErrors.ErrArtistNotFound:               http.StatusNotFound,
 	customErrors.ErrAlbumNotFound:                http.StatusNotFound,
@@ -39,14 +42,67 @@ var mapErrorStatus = map[error]int{
 	customErrors.ErrPlaylistUnauthorized:         http.StatusUnauthorized,
 	customErrors.ErrCreateRoomNotAllDataProvided: http.StatusBadRequest,
 	customErrors.ErrRoomIDRequired:               http.StatusBadRequest,
+	customErrors.ErrJamInvalidMaxListeners:       http.StatusBadRequest,
+	customErrors.ErrJamBanned:                    http.StatusForbidden,
+	customErrors.ErrJamInviteRequired:            http.StatusForbidden,
+	customErrors.ErrJamWrongPassword:             http.StatusForbidden,
+	customErrors.ErrJamFull:                      http.StatusConflict,
+	customErrors.ErrJamSessionNotFound:           http.StatusNotFound,
+	customErrors.ErrJamSessionEmpty:              http.StatusBadRequest,
+	customErrors.ErrJamPlaylistInvalidTitle:      http.StatusBadRequest,
+	customErrors.ErrJamPlaylistTitleTaken:        http.StatusConflict,
+	customErrors.ErrUploadNotFound:               http.StatusNotFound,
+	customErrors.ErrUploadInvalidKind:            http.StatusBadRequest,
+	customErrors.ErrUploadInvalidContentType:     http.StatusBadRequest,
+	customErrors.ErrUploadInvalidSize:            http.StatusBadRequest,
+	customErrors.ErrUploadTooLarge:               http.StatusRequestEntityTooLarge,
+	customErrors.ErrUploadInvalidPart:            http.StatusBadRequest,
+	customErrors.ErrUploadIncomplete:             http.StatusConflict,
+	customErrors.ErrUploadInUse:                  http.StatusConflict,
+	customErrors.ErrUploadDuplicate:              http.StatusBadRequest,
+	customErrors.ErrUploadAlreadyCompleted:       http.StatusConflict,
+	customErrors.ErrAlbumInvalidStatus:           http.StatusBadRequest,
+	customErrors.ErrAlbumInvalidType:             http.StatusBadRequest,
+	customErrors.ErrAlbumStatusTransition:        http.StatusConflict,
+	customErrors.ErrAlbumReviewRequired:          http.StatusForbidden,
+	customErrors.ErrReleaseDateRequired:          http.StatusBadRequest,
+	customErrors.ErrReleaseDateInPast:            http.StatusBadRequest,
+	customErrors.ErrAlbumNotDraft:                http.StatusConflict,
+	customErrors.ErrAlbumTracksInvalid:           http.StatusBadRequest,
+	customErrors.ErrArtistsNotInLabel:            http.StatusForbidden,
+	customErrors.ErrAlbumArtistRequired:          http.StatusBadRequest,
+	customErrors.ErrAlbumTitleInvalid:            http.StatusBadRequest,
+	customErrors.ErrTrackTitleInvalid:            http.StatusBadRequest,
+	customErrors.ErrAlbumCoverRequired:           http.StatusBadRequest,
+	customErrors.ErrTrackPositionInvalid:         http.StatusBadRequest,
+	customErrors.ErrTrackAudioInvalid:            http.StatusBadRequest,
+	customErrors.ErrTrackSegmentsNotFound:        http.StatusNotFound,
+	customErrors.ErrTrackSegmentNotFound:         http.StatusNotFound,
+	customErrors.ErrTrackWaveformNotFound:        http.StatusNotFound,
+	customErrors.ErrTrackLyricsInvalid:           http.StatusBadRequest,
+	customErrors.ErrTrackLyricsLanguageInvalid:   http.StatusBadRequest,
+	customErrors.ErrTrackLyricsNotFound:          http.StatusNotFound,
+	customErrors.ErrTrackDuplicate:               http.StatusConflict,
+	customErrors.ErrDuplicateFlagNotFound:        http.StatusNotFound,
+	customErrors.ErrDuplicateFlagStatusInvalid:   http.StatusBadRequest,
+	customErrors.ErrMainArtistRequired:           http.StatusBadRequest,
+	customErrors.ErrInvalidArtistRole:            http.StatusBadRequest,
 	customErrors.ErrInvalidSelection:             http.StatusBadRequest,
+	customErrors.ErrSearchQueryEmpty:             http.StatusBadRequest,
+	customErrors.ErrInvalidSearchType:            http.StatusBadRequest,
+	customErrors.ErrInvalidSearchClick:           http.StatusBadRequest,
+	customErrors.ErrFollowYourself:               http.StatusBadRequest,
+	customErrors.ErrNotificationNotFound:         http.StatusNotFound,
+	customErrors.ErrInvalidNotificationType:      http.StatusBadRequest,
 	customErrors.ErrLableExist:                   http.StatusBadRequest,
 }
 
+// ErrorStatus also looks through wrapped errors, which carry details for the client
 func ErrorStatus(err error) int {
-	status, exists := mapErrorStatus[err]
-	if !exists {
-		return http.StatusInternalServerError
+	for ; err != nil; err = errors.Unwrap(err) {
+		if status, exists := mapErrorStatus[err]; exists {
+			return status
+		}
 	}
-	return status
+	return http.StatusInternalServerError
 }
@@ -0,0 +1,113 @@
+package imageVariants
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Sizes are the boxes every stored image is scaled into, smaller images are not upscaled
+var Sizes = []int64{64, 300, 640, 1200}
+
+const (
+	FormatAVIF = "avif"
+	FormatWebP = "webp"
+	FormatJPEG = "jpeg"
+)
+
+// Formats every size is encoded in, jpeg stays for clients without webp and avif
+var Formats = []string{FormatAVIF, FormatWebP, FormatJPEG}
+
+var extensions = map[string]string{
+	FormatAVIF: "avif",
+	FormatWebP: "webp",
+	FormatJPEG: "jpg",
+}
+
+// FallbackSize is the jpeg variant the thumbnail and avatar urls point to
+const FallbackSize = 1200
+
+type SizeVariant struct {
+	Size int64
+	AVIF string
+	WebP string
+	JPEG string
+}
+
+type Variants struct {
+	DominantColor string
+	Sizes         []*SizeVariant
+}
+
+func ContentType(format string) string {
+	return "image/" + format
+}
+
+func FileName(size int64, format string) string {
+	return fmt.Sprintf("%d.%s", size, extensions[format])
+}
+
+// Directory names the directory of one stored image. Stored images never change, a new
+// upload gets a new directory, the suffix tells apart uploads of one name within a second.
+func Directory(name string, date string, suffix string) string {
+	return fmt.Sprintf("%s-%s-%s", sanitizeName(name), date, suffix)
+}
+
+func FallbackFile(directory string) string {
+	return directory + "/" + FileName(FallbackSize, FormatJPEG)
+}
+
+// ForImage lists the variants stored next to the fallback jpeg url points to. The dominant
+// color is stored with every image that has variants, without it the image was stored
+// before the pipeline and only has the original file, so the result is nil.
+func ForImage(url string, dominantColor string) *Variants {
+	directory, fileName, found := cutLast(url, "/")
+	if dominantColor == "" || !found || fileName != FileName(FallbackSize, FormatJPEG) {
+		return nil
+	}
+
+	variants := &Variants{
+		DominantColor: dominantColor,
+		Sizes:         make([]*SizeVariant, 0, len(Sizes)),
+	}
+	for _, size := range Sizes {
+		variants.Sizes = append(variants.Sizes, &SizeVariant{
+			Size: size,
+			AVIF: directory + "/" + FileName(size, FormatAVIF),
+			WebP: directory + "/" + FileName(size, FormatWebP),
+			JPEG: directory + "/" + FileName(size, FormatJPEG),
+		})
+	}
+	return variants
+}
+
+func cutLast(s string, separator string) (string, string, bool) {
+	index := strings.LastIndex(s, separator)
+	if index < 0 {
+		return s, "", false
+	}
+	return s[:index], s[index+len(separator):], true
+}
+
+// sanitizeName keeps latin titles readable in the key and drops slashes and
+// anything else that would have to be escaped in the url
+func sanitizeName(name string) string {
+	var builder strings.Builder
+	dash := false
+	for _, r := range strings.ToLower(name) {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			builder.WriteRune(r)
+			dash = false
+			continue
+		}
+		if !dash && builder.Len() > 0 {
+			builder.WriteByte('-')
+			dash = true
+		}
+	}
+	sanitized := strings.TrimSuffix(builder.String(), "-")
+	if sanitized == "" {
+		return "image"
+	}
+	return sanitized
+}
@@ -0,0 +1,37 @@
+package keyboardLayout
+
+// Keys of the standard QWERTY and ЙЦУКЕН layouts in the same physical order,
+// so that a character typed with the wrong layout active can be mapped back.
+const (
+	qwerty = "`qwertyuiop[]asdfghjkl;'zxcvbnm,.~QWERTYUIOP{}ASDFGHJKL:\"ZXCVBNM<>"
+	jcuken = "ёйцукенгшщзхъфывапролджэячсмитьбюЁЙЦУКЕНГШЩЗХЪФЫВАПРОЛДЖЭЯЧСМИТЬБЮ"
+)
+
+var (
+	latinToCyrillic = make(map[rune]rune)
+	cyrillicToLatin = make(map[rune]rune)
+)
+
+func init() {
+	latin := []rune(qwerty)
+	cyrillic := []rune(jcuken)
+	for i := range latin {
+		latinToCyrillic[latin[i]] = cyrillic[i]
+		cyrillicToLatin[cyrillic[i]] = latin[i]
+	}
+}
+
+// Switch retypes s as if it had been entered with the other keyboard layout.
+// Latin keys become their ЙЦУКЕН counterparts and Cyrillic letters become
+// QWERTY keys; other characters are left untouched.
+func Switch(s string) string {
+	runes := []rune(s)
+	for i, r := range runes {
+		if c, ok := latinToCyrillic[r]; ok {
+			runes[i] = c
+		} else if l, ok := cyrillicToLatin[r]; ok {
+			runes[i] = l
+		}
+	}
+	return string(runes)
+}
@@ -8,6 +8,8 @@ import (
 type Metrics struct {
 	HTTPTotalNumberOfRequests *prometheus.CounterVec
 	HTTPRequestDuration       *prometheus.HistogramVec
+	SearchQueriesTotal        *prometheus.CounterVec
+	SearchClicksTotal         *prometheus.CounterVec
 	// MicroserviceRequests        *prometheus.CounterVec
 	// MicroserviceRequestDuration *prometheus.HistogramVec
 	// MicroserviceErrors          *prometheus.CounterVec
@@ -55,10 +57,28 @@ func NewMetrics(reg prometheus.Registerer, namespace string) *Metrics {
 			},
 			[]string{"method", "path"},
 		),
+		SearchQueriesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      "search_queries_total",
+				Help:      "Total number of search queries by source and whether anything was found",
+				Namespace: namespace,
+			},
+			[]string{"source", "outcome"},
+		),
+		SearchClicksTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:      "search_clicks_total",
+				Help:      "Total number of clicked search results by result type",
+				Namespace: namespace,
+			},
+			[]string{"type"},
+		),
 	}
 	reg.MustRegister(collectors.NewGoCollector())
 	reg.MustRegister(metrics.HTTPTotalNumberOfRequests)
 	reg.MustRegister(metrics.HTTPRequestDuration)
+	reg.MustRegister(metrics.SearchQueriesTotal)
+	reg.MustRegister(metrics.SearchClicksTotal)
 
 	return metrics
 }
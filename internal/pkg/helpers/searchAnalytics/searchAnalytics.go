@@ -0,0 +1,12 @@
+package searchAnalytics
+
+import (
+	"context"
+
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+)
+
+// Recorder collects search queries for analytics. Recording is best effort and never fails the request.
+type Recorder interface {
+	LogQuery(ctx context.Context, log *usecaseModel.SearchQueryLog)
+}
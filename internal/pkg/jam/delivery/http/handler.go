@@ -2,8 +2,13 @@ package http
 
 import (
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
 	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/asaskevich/govalidator"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
@@ -12,6 +17,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/errorStatus"
 	jsonPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	delivery "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
@@ -21,6 +27,37 @@ import (
 	"go.uber.org/zap"
 )
 
+// jamUserErrors are rejections of a single message, often from racing another listener, the
+// sender is told and keeps the connection
+var jamUserErrors = []error{
+	customErrors.ErrJamRateLimited,
+	customErrors.ErrJamMuted,
+	customErrors.ErrJamInvalidMessage,
+	customErrors.ErrJamNotHost,
+	customErrors.ErrJamUserNotInJam,
+	customErrors.ErrJamInvalidPosition,
+	customErrors.ErrJamTrackIDRequired,
+	customErrors.ErrJamInvalidModerationTarget,
+	customErrors.ErrJamTrackNotSuggested,
+	customErrors.ErrJamTrackNotQueued,
+	customErrors.ErrJamChatMessageNotFound,
+	customErrors.ErrJamTooManySuggestions,
+	customErrors.ErrJamUserSuggestionLimit,
+	customErrors.ErrTrackNotFound,
+}
+
+func isJamUserError(err error) bool {
+	for _, userErr := range jamUserErrors {
+		if errors.Is(err, userErr) {
+			return true
+		}
+	}
+	return false
+}
+
+// a socket that does not say which room credentials it has within this time is dropped
+const jamJoinTimeout = 10 * time.Second
+
 type JamHandler struct {
 	usecase jam.Usecase
 	cfg     *config.Config
@@ -79,6 +116,178 @@ func (h *JamHandler) CreateRoom(w http.ResponseWriter, r *http.Request) {
 	jsonPkg.WriteSuccessResponse(w, http.StatusCreated, response, nil)
 }
 
+// ListRooms godoc
+// @Summary List jam rooms
+// @Description Get public jam rooms and rooms hosted by people the current user follows, most recently active first
+// @Tags jam
+// @Produce json
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.JamSummary} "Jam rooms"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /jams [get]
+func (h *JamHandler) ListRooms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	// anonymous users still see public rooms
+	userID, ok := ctxExtractor.UserFromContext(ctx)
+	if !ok {
+		userID = 0
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	rooms, err := h.usecase.ListJams(ctx, userID, model.PaginationFromDeliveryToUsecase(pagination))
+	if err != nil {
+		logger.Error("failed to list jams", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusOK, model.JamSummariesFromUsecaseToDelivery(rooms), nil)
+}
+
+// GetSessions godoc
+// @Summary Get jam history
+// @Description Get the jam sessions the current user hosted or joined, newest first
+// @Tags user
+// @Produce json
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.JamSession} "Jam sessions"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/me/jams [get]
+func (h *JamHandler) GetSessions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, ok := ctxExtractor.UserFromContext(ctx)
+	if !ok {
+		logger.Error("user not found in context", zap.Error(customErrors.ErrUnauthorized))
+		jsonPkg.WriteErrorResponse(w, http.StatusUnauthorized, customErrors.ErrUnauthorized.Error(), nil)
+		return
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	sessions, err := h.usecase.GetSessions(ctx, userID, model.PaginationFromDeliveryToUsecase(pagination))
+	if err != nil {
+		logger.Error("failed to get jam sessions", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusOK, model.JamSessionsFromUsecaseToDelivery(sessions), nil)
+}
+
+// GetSession godoc
+// @Summary Get jam session
+// @Description Get who took part in a jam session and the tracks played in it, only for its participants
+// @Tags user
+// @Produce json
+// @Param id path integer true "Jam session ID"
+// @Success 200 {object} delivery.APIResponse{body=delivery.JamSessionDetails} "Jam session"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Jam session not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/me/jams/{id} [get]
+func (h *JamHandler) GetSession(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, ok := ctxExtractor.UserFromContext(ctx)
+	if !ok {
+		logger.Error("user not found in context", zap.Error(customErrors.ErrUnauthorized))
+		jsonPkg.WriteErrorResponse(w, http.StatusUnauthorized, customErrors.ErrUnauthorized.Error(), nil)
+		return
+	}
+
+	sessionID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse jam session id", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	session, err := h.usecase.GetSession(ctx, sessionID, userID)
+	if err != nil {
+		logger.Error("failed to get jam session", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusOK, model.JamSessionDetailsFromUsecaseToDelivery(session), nil)
+}
+
+// CreateSessionPlaylist godoc
+// @Summary Save jam as playlist
+// @Description Save every track played in a jam session as a playlist of the current user, titled after the day of the jam and numbered if that title is taken, unless a title is given
+// @Tags user
+// @Accept json
+// @Produce json
+// @Param id path integer true "Jam session ID"
+// @Param request body delivery.CreateJamPlaylistRequest false "Playlist title"
+// @Success 201 {object} delivery.APIResponse{body=delivery.CreateJamPlaylistResponse} "Playlist created"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID, invalid title or nothing was played"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Jam session not found"
+// @Failure 409 {object} delivery.APIErrorResponse "The given title is used by another playlist of the user"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/me/jams/{id}/playlist [post]
+func (h *JamHandler) CreateSessionPlaylist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, ok := ctxExtractor.UserFromContext(ctx)
+	if !ok {
+		logger.Error("user not found in context", zap.Error(customErrors.ErrUnauthorized))
+		jsonPkg.WriteErrorResponse(w, http.StatusUnauthorized, customErrors.ErrUnauthorized.Error(), nil)
+		return
+	}
+
+	sessionID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse jam session id", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	// the body is optional, one click saves the jam under the default title
+	var request delivery.CreateJamPlaylistRequest
+	if r.ContentLength != 0 {
+		err = jsonPkg.ReadJSON(w, r, &request)
+		if err != nil {
+			logger.Error("failed to read json", zap.Error(err))
+			jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+			return
+		}
+	}
+
+	playlistID, err := h.usecase.CreateSessionPlaylist(ctx, model.CreateJamPlaylistRequestFromDeliveryToUsecase(&request, userID, sessionID))
+	if err != nil {
+		logger.Error("failed to create playlist from jam session", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusCreated, &delivery.CreateJamPlaylistResponse{PlaylistID: playlistID}, nil)
+}
+
 func (h *JamHandler) WSHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	logger := loggerPkg.LoggerFromContext(ctx)
@@ -109,9 +318,40 @@ func (h *JamHandler) WSHandler(w http.ResponseWriter, r *http.Request) {
 
 	userIDStr := strconv.FormatInt(userID, 10)
 
+	// the invite and password come in the first message, a query string would end up in access
+	// and proxy logs
+	var join delivery.JamJoinMessage
+	err = wsConn.SetReadDeadline(time.Now().Add(jamJoinTimeout))
+	if err == nil {
+		err = wsConn.ReadJSON(&join)
+	}
+	if err == nil && join.Type != "join" {
+		err = customErrors.ErrJamJoinRequired
+	}
+	if err == nil {
+		err = wsConn.SetReadDeadline(time.Time{})
+	}
+	if err != nil {
+		logger.Error("failed to read jam join message", zap.Error(err))
+		err = wsConn.WriteJSON(delivery.JamMessage{
+			Type:  "error",
+			Error: customErrors.ErrJamJoinRequired.Error(),
+		})
+		if err != nil {
+			logger.Error("failed to write error message to websocket", zap.Error(err))
+		}
+		err = wsConn.Close()
+		if err != nil {
+			logger.Error("failed to close websocket", zap.Error(err))
+		}
+		return
+	}
+
 	usecaseRequest := usecase.JoinJamRequest{
-		RoomID: roomID,
-		UserID: userIDStr,
+		RoomID:      roomID,
+		UserID:      userIDStr,
+		InviteToken: join.InviteToken,
+		Password:    join.Password,
 	}
 	usecaseResponse, err := h.usecase.JoinJam(ctx, &usecaseRequest)
 	if err != nil {
@@ -146,46 +386,138 @@ func (h *JamHandler) WSHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	var kicked atomic.Bool
+
+	// pongs are written from the read loop while broadcasts are written here, and a websocket
+	// connection allows only one writer at a time
+	var writeMu sync.Mutex
+	writeJSON := func(v interface{}) error {
+		writeMu.Lock()
+		defer writeMu.Unlock()
+		return wsConn.WriteJSON(v)
+	}
+
+	// the socket reports presence on a timer so the reaper can tell it from one whose gateway died
+	stopKeepAlive := make(chan struct{})
+	defer close(stopKeepAlive)
+	if interval := h.cfg.Jam.PresenceInterval; interval > 0 {
+		go func() {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-stopKeepAlive:
+					return
+				case <-ticker.C:
+					if kicked.Load() {
+						return
+					}
+					err := h.usecase.KeepAlive(ctx, roomID, userIDStr)
+					if err != nil {
+						logger.Error("failed to keep jam alive", zap.Error(err))
+					}
+				}
+			}
+		}()
+	}
+
 	go func() {
 		for {
 			select {
 			case <-ctx.Done():
 				return
 			case usecaseMessage, ok := <-messageChan:
+				// the subscription dropped or this socket fell behind, the client resyncs on reconnect
 				if !ok {
+					err := wsConn.Close()
+					if err != nil {
+						logger.Error("failed to close websocket", zap.Error(err))
+					}
 					return
 				}
 				deliveryMessage := model.JamMessageFromUsecaseToDelivery(usecaseMessage)
-				err := wsConn.WriteJSON(deliveryMessage)
+				err := writeJSON(deliveryMessage)
 				if err != nil {
 					logger.Error("failed to write message to websocket", zap.Error(err))
 					return
 				}
+				if deliveryMessage.Type == "user:kicked" && deliveryMessage.UserID == userIDStr {
+					kicked.Store(true)
+					err = wsConn.Close()
+					if err != nil {
+						logger.Error("failed to close websocket", zap.Error(err))
+					}
+					return
+				}
 			}
 		}
 	}()
 
+	// whatever ends the read loop takes the listener out of the room and closes the socket,
+	// a kicked listener is already out
+	leave := func() {
+		if kicked.Load() {
+			return
+		}
+		err := h.usecase.LeaveJam(ctx, roomID, userIDStr)
+		if err != nil {
+			logger.Error("failed to leave jam", zap.Error(err))
+		}
+	}
+	defer func() {
+		err := wsConn.Close()
+		if err != nil && !errors.Is(err, net.ErrClosed) {
+			logger.Error("failed to close websocket", zap.Error(err))
+		}
+	}()
+
 	for {
 		_, data, err := wsConn.ReadMessage()
+		receivedAt := time.Now().UnixMilli()
 		if err != nil {
-			err := h.usecase.LeaveJam(ctx, roomID, userIDStr)
-			if err != nil {
-				logger.Error("failed to leave jam", zap.Error(err))
-			}
+			leave()
 			return
 		}
 		var m delivery.JamMessage
 		err = json.Unmarshal(data, &m)
 		if err != nil {
 			logger.Error("failed to unmarshal message", zap.Error(err))
+			leave()
 			return
 		}
 
+		// clock:ping is answered right here so the timestamps are as close to the wire as possible,
+		// the client estimates its offset from client_time, received_at and server_time
+		if m.Type == "clock:ping" {
+			err = writeJSON(delivery.JamMessage{
+				Type:       "clock:pong",
+				ClientTime: m.ClientTime,
+				ReceivedAt: receivedAt,
+				ServerTime: time.Now().UnixMilli(),
+			})
+			if err != nil {
+				logger.Error("failed to write clock pong to websocket", zap.Error(err))
+			}
+			continue
+		}
+
 		usecaseMessage := model.JamMessageFromDeliveryToUsecase(&m)
 
 		err = h.usecase.HandleClientMessage(ctx, roomID, userIDStr, usecaseMessage)
+		// the sender is told about a rejected message, it is no reason to drop the connection
+		if isJamUserError(err) {
+			err = writeJSON(delivery.JamMessage{
+				Type:  "error",
+				Error: err.Error(),
+			})
+			if err != nil {
+				logger.Error("failed to write error message to websocket", zap.Error(err))
+			}
+			continue
+		}
 		if err != nil {
 			logger.Error("failed to handle client message", zap.Error(err))
+			leave()
 			return
 		}
 	}
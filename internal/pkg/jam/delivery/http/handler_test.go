@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
@@ -225,6 +226,9 @@ func TestWSHandler_JoinJamError(t *testing.T) {
 	}
 	defer conn.Close()
 
+	err = conn.WriteJSON(deliveryModel.JamJoinMessage{Type: "join"})
+	assert.NoError(t, err)
+
 	var msg deliveryModel.JamMessage
 	err = conn.ReadJSON(&msg)
 	assert.NoError(t, err)
@@ -248,8 +252,10 @@ func TestWSHandler_Success(t *testing.T) {
 		mockUsecase.EXPECT().JoinJam(
 			gomock.Any(),
 			&usecaseModel.JoinJamRequest{
-				RoomID: "room123",
-				UserID: "123",
+				RoomID:      "room123",
+				UserID:      "123",
+				InviteToken: "invite123",
+				Password:    "secret",
 			},
 		).Return(&usecaseModel.JamMessage{
 			Type:   "join",
@@ -286,6 +292,9 @@ func TestWSHandler_Success(t *testing.T) {
 	}
 	defer conn.Close()
 
+	err = conn.WriteJSON(deliveryModel.JamJoinMessage{Type: "join", InviteToken: "invite123", Password: "secret"})
+	assert.NoError(t, err)
+
 	var joinMsg deliveryModel.JamMessage
 	err = conn.ReadJSON(&joinMsg)
 	assert.NoError(t, err)
@@ -317,6 +326,10 @@ func TestWSHandler_Success(t *testing.T) {
 	assert.Equal(t, "track123", broadcastMsg.TrackID)
 	assert.Equal(t, int64(150), broadcastMsg.Position)
 	assert.True(t, broadcastMsg.Paused)
+
+	// the socket is closed once its subscription ends so the client reconnects
+	err = conn.ReadJSON(&broadcastMsg)
+	assert.Error(t, err)
 }
 
 func TestWSHandler_SubscriptionError(t *testing.T) {
@@ -358,6 +371,9 @@ func TestWSHandler_SubscriptionError(t *testing.T) {
 	}
 	defer conn.Close()
 
+	err = conn.WriteJSON(deliveryModel.JamJoinMessage{Type: "join"})
+	assert.NoError(t, err)
+
 	var joinMsg deliveryModel.JamMessage
 	err = conn.ReadJSON(&joinMsg)
 	assert.NoError(t, err)
@@ -366,3 +382,439 @@ func TestWSHandler_SubscriptionError(t *testing.T) {
 	_, _, err = conn.ReadMessage()
 	assert.Error(t, err)
 }
+
+func TestWSHandler_FirstMessageMustBeJoin(t *testing.T) {
+	_, handler, _ := setupTestJamHandler(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+		ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+		r = r.WithContext(ctx)
+
+		vars := map[string]string{"id": "room123"}
+		r = mux.SetURLVars(r, vars)
+
+		handler.WSHandler(w, r)
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to WebSocket: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.WriteJSON(deliveryModel.JamMessage{Type: "play"})
+	assert.NoError(t, err)
+
+	var msg deliveryModel.JamMessage
+	err = conn.ReadJSON(&msg)
+	assert.NoError(t, err)
+	assert.Equal(t, "error", msg.Type)
+	assert.Equal(t, customErrors.ErrJamJoinRequired.Error(), msg.Error)
+
+	_, _, err = conn.ReadMessage()
+	assert.Error(t, err)
+}
+
+func TestWSHandler_ClockPing(t *testing.T) {
+	mockUsecase, handler, _ := setupTestJamHandler(t)
+
+	messageChan := make(chan *usecaseModel.JamMessage)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+		ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+		r = r.WithContext(ctx)
+
+		vars := map[string]string{"id": "room123"}
+		r = mux.SetURLVars(r, vars)
+
+		mockUsecase.EXPECT().JoinJam(gomock.Any(), gomock.Any()).Return(&usecaseModel.JamMessage{Type: "init"}, nil)
+		mockUsecase.EXPECT().SubscribeToJamMessages(gomock.Any(), "room123").Return((<-chan *usecaseModel.JamMessage)(messageChan), nil)
+		mockUsecase.EXPECT().LeaveJam(gomock.Any(), "room123", "123").Return(nil).AnyTimes()
+
+		handler.WSHandler(w, r)
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to WebSocket: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.WriteJSON(deliveryModel.JamJoinMessage{Type: "join"})
+	assert.NoError(t, err)
+
+	var initMsg deliveryModel.JamMessage
+	err = conn.ReadJSON(&initMsg)
+	assert.NoError(t, err)
+
+	err = conn.WriteJSON(deliveryModel.JamMessage{Type: "clock:ping", ClientTime: 12345})
+	assert.NoError(t, err)
+
+	var pong deliveryModel.JamMessage
+	err = conn.ReadJSON(&pong)
+	assert.NoError(t, err)
+	assert.Equal(t, "clock:pong", pong.Type)
+	assert.Equal(t, int64(12345), pong.ClientTime)
+	assert.NotZero(t, pong.ReceivedAt)
+	assert.GreaterOrEqual(t, pong.ServerTime, pong.ReceivedAt)
+}
+
+func TestWSHandler_RejectedChatMessageKeepsConnection(t *testing.T) {
+	mockUsecase, handler, _ := setupTestJamHandler(t)
+
+	messageChan := make(chan *usecaseModel.JamMessage)
+	handled := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+		ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+		r = r.WithContext(ctx)
+
+		vars := map[string]string{"id": "room123"}
+		r = mux.SetURLVars(r, vars)
+
+		mockUsecase.EXPECT().JoinJam(gomock.Any(), gomock.Any()).Return(&usecaseModel.JamMessage{Type: "init"}, nil)
+		mockUsecase.EXPECT().SubscribeToJamMessages(gomock.Any(), "room123").Return((<-chan *usecaseModel.JamMessage)(messageChan), nil)
+		gomock.InOrder(
+			mockUsecase.EXPECT().HandleClientMessage(gomock.Any(), "room123", "123", gomock.Any()).Return(customErrors.ErrJamRateLimited),
+			mockUsecase.EXPECT().HandleClientMessage(gomock.Any(), "room123", "123", gomock.Any()).DoAndReturn(
+				func(context.Context, string, string, *usecaseModel.JamMessage) error {
+					close(handled)
+					return nil
+				}),
+		)
+		mockUsecase.EXPECT().LeaveJam(gomock.Any(), "room123", "123").Return(nil).AnyTimes()
+
+		handler.WSHandler(w, r)
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to WebSocket: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.WriteJSON(deliveryModel.JamJoinMessage{Type: "join"})
+	assert.NoError(t, err)
+
+	var initMsg deliveryModel.JamMessage
+	err = conn.ReadJSON(&initMsg)
+	assert.NoError(t, err)
+
+	err = conn.WriteJSON(deliveryModel.JamMessage{Type: "chat:message", Text: "hello"})
+	assert.NoError(t, err)
+
+	var errorMsg deliveryModel.JamMessage
+	err = conn.ReadJSON(&errorMsg)
+	assert.NoError(t, err)
+	assert.Equal(t, "error", errorMsg.Type)
+	assert.Equal(t, customErrors.ErrJamRateLimited.Error(), errorMsg.Error)
+
+	err = conn.WriteJSON(deliveryModel.JamMessage{Type: "chat:message", Text: "hello again"})
+	assert.NoError(t, err)
+
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Fatal("second message was not handled")
+	}
+}
+
+func TestWSHandler_RejectedQueueMessageKeepsConnection(t *testing.T) {
+	mockUsecase, handler, _ := setupTestJamHandler(t)
+
+	messageChan := make(chan *usecaseModel.JamMessage)
+	handled := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+		ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+		r = r.WithContext(ctx)
+
+		vars := map[string]string{"id": "room123"}
+		r = mux.SetURLVars(r, vars)
+
+		mockUsecase.EXPECT().JoinJam(gomock.Any(), gomock.Any()).Return(&usecaseModel.JamMessage{Type: "init"}, nil)
+		mockUsecase.EXPECT().SubscribeToJamMessages(gomock.Any(), "room123").Return((<-chan *usecaseModel.JamMessage)(messageChan), nil)
+		gomock.InOrder(
+			mockUsecase.EXPECT().HandleClientMessage(gomock.Any(), "room123", "123", gomock.Any()).Return(customErrors.ErrJamTrackNotSuggested),
+			mockUsecase.EXPECT().HandleClientMessage(gomock.Any(), "room123", "123", gomock.Any()).DoAndReturn(
+				func(context.Context, string, string, *usecaseModel.JamMessage) error {
+					close(handled)
+					return nil
+				}),
+		)
+		mockUsecase.EXPECT().LeaveJam(gomock.Any(), "room123", "123").Return(nil).AnyTimes()
+
+		handler.WSHandler(w, r)
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to WebSocket: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.WriteJSON(deliveryModel.JamJoinMessage{Type: "join"})
+	assert.NoError(t, err)
+
+	var initMsg deliveryModel.JamMessage
+	err = conn.ReadJSON(&initMsg)
+	assert.NoError(t, err)
+
+	err = conn.WriteJSON(deliveryModel.JamMessage{Type: "queue:vote", TrackID: "42"})
+	assert.NoError(t, err)
+
+	var errorMsg deliveryModel.JamMessage
+	err = conn.ReadJSON(&errorMsg)
+	assert.NoError(t, err)
+	assert.Equal(t, "error", errorMsg.Type)
+	assert.Equal(t, customErrors.ErrJamTrackNotSuggested.Error(), errorMsg.Error)
+
+	err = conn.WriteJSON(deliveryModel.JamMessage{Type: "queue:vote", TrackID: "43"})
+	assert.NoError(t, err)
+
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Fatal("second message was not handled")
+	}
+}
+
+func TestWSHandler_FailedMessageLeavesJam(t *testing.T) {
+	mockUsecase, handler, _ := setupTestJamHandler(t)
+
+	messageChan := make(chan *usecaseModel.JamMessage)
+	left := make(chan struct{})
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+		ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+		r = r.WithContext(ctx)
+
+		vars := map[string]string{"id": "room123"}
+		r = mux.SetURLVars(r, vars)
+
+		mockUsecase.EXPECT().JoinJam(gomock.Any(), gomock.Any()).Return(&usecaseModel.JamMessage{Type: "init"}, nil)
+		mockUsecase.EXPECT().SubscribeToJamMessages(gomock.Any(), "room123").Return((<-chan *usecaseModel.JamMessage)(messageChan), nil)
+		mockUsecase.EXPECT().HandleClientMessage(gomock.Any(), "room123", "123", gomock.Any()).Return(errors.New("redis is down"))
+		mockUsecase.EXPECT().LeaveJam(gomock.Any(), "room123", "123").DoAndReturn(func(context.Context, string, string) error {
+			close(left)
+			return nil
+		})
+
+		handler.WSHandler(w, r)
+	}))
+	defer server.Close()
+
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, nil)
+	if err != nil {
+		t.Fatalf("Failed to connect to WebSocket: %v", err)
+	}
+	defer conn.Close()
+
+	err = conn.WriteJSON(deliveryModel.JamJoinMessage{Type: "join"})
+	assert.NoError(t, err)
+
+	var initMsg deliveryModel.JamMessage
+	err = conn.ReadJSON(&initMsg)
+	assert.NoError(t, err)
+
+	err = conn.WriteJSON(deliveryModel.JamMessage{Type: "host:pause"})
+	assert.NoError(t, err)
+
+	select {
+	case <-left:
+	case <-time.After(time.Second):
+		t.Fatal("listener did not leave the jam")
+	}
+
+	_, _, err = conn.ReadMessage()
+	assert.Error(t, err)
+}
+
+func TestListRooms(t *testing.T) {
+	mockUsecase, handler, cfg := setupTestJamHandler(t)
+	cfg.Pagination = config.PaginationConfig{MaxOffset: 100, MaxLimit: 100, DefaultOffset: 0, DefaultLimit: 10}
+
+	mockUsecase.EXPECT().ListJams(gomock.Any(), int64(123), &usecaseModel.Pagination{Offset: 0, Limit: 5}).
+		Return([]*usecaseModel.JamSummary{{RoomID: "room1", HostID: "7", TrackID: "track1", Listeners: 3, Friend: true}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jams?limit=5", nil)
+	ctx := loggerPkg.LoggerToContext(req.Context(), zap.NewNop().Sugar())
+	ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+	rr := httptest.NewRecorder()
+
+	handler.ListRooms(rr, req.WithContext(ctx))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var response struct {
+		Body []deliveryModel.JamSummary `json:"body"`
+	}
+	err := json.Unmarshal(rr.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Equal(t, []deliveryModel.JamSummary{{RoomID: "room1", HostID: "7", TrackID: "track1", Listeners: 3, Friend: true}}, response.Body)
+}
+
+func TestListRooms_Anonymous(t *testing.T) {
+	mockUsecase, handler, cfg := setupTestJamHandler(t)
+	cfg.Pagination = config.PaginationConfig{MaxOffset: 100, MaxLimit: 100, DefaultOffset: 0, DefaultLimit: 10}
+
+	mockUsecase.EXPECT().ListJams(gomock.Any(), int64(0), &usecaseModel.Pagination{Offset: 0, Limit: 10}).
+		Return([]*usecaseModel.JamSummary{}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jams", nil)
+	ctx := loggerPkg.LoggerToContext(req.Context(), zap.NewNop().Sugar())
+	rr := httptest.NewRecorder()
+
+	handler.ListRooms(rr, req.WithContext(ctx))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+}
+
+func TestListRooms_InvalidPagination(t *testing.T) {
+	_, handler, cfg := setupTestJamHandler(t)
+	cfg.Pagination = config.PaginationConfig{MaxOffset: 100, MaxLimit: 100, DefaultOffset: 0, DefaultLimit: 10}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/jams?limit=abc", nil)
+	ctx := loggerPkg.LoggerToContext(req.Context(), zap.NewNop().Sugar())
+	rr := httptest.NewRecorder()
+
+	handler.ListRooms(rr, req.WithContext(ctx))
+
+	assert.Equal(t, http.StatusBadRequest, rr.Code)
+}
+
+func TestGetSessions(t *testing.T) {
+	mockUsecase, handler, cfg := setupTestJamHandler(t)
+	cfg.Pagination = config.PaginationConfig{MaxOffset: 100, MaxLimit: 100, DefaultOffset: 0, DefaultLimit: 10}
+
+	mockUsecase.EXPECT().GetSessions(gomock.Any(), int64(123), &usecaseModel.Pagination{Offset: 0, Limit: 10}).
+		Return([]*usecaseModel.JamSession{{ID: 3, RoomID: "room3", HostID: 123, HostName: "host", Tracks: 2, Duration: 300}}, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/user/me/jams", nil)
+	ctx := loggerPkg.LoggerToContext(req.Context(), zap.NewNop().Sugar())
+	ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+	rr := httptest.NewRecorder()
+
+	handler.GetSessions(rr, req.WithContext(ctx))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	var response struct {
+		Body []deliveryModel.JamSession `json:"body"`
+	}
+	err := json.Unmarshal(rr.Body.Bytes(), &response)
+	assert.NoError(t, err)
+	assert.Len(t, response.Body, 1)
+	assert.Equal(t, int64(3), response.Body[0].ID)
+	assert.Equal(t, int64(300), response.Body[0].Duration)
+}
+
+func TestGetSessions_Unauthorized(t *testing.T) {
+	_, handler, _ := setupTestJamHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/user/me/jams", nil)
+	ctx := loggerPkg.LoggerToContext(req.Context(), zap.NewNop().Sugar())
+	rr := httptest.NewRecorder()
+
+	handler.GetSessions(rr, req.WithContext(ctx))
+
+	assert.Equal(t, http.StatusUnauthorized, rr.Code)
+}
+
+func TestGetSession(t *testing.T) {
+	tests := []struct {
+		name           string
+		err            error
+		expectedStatus int
+	}{
+		{name: "Success", expectedStatus: http.StatusOK},
+		{name: "Not a participant", err: customErrors.ErrJamSessionNotFound, expectedStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase, handler, _ := setupTestJamHandler(t)
+
+			var session *usecaseModel.JamSessionDetails
+			if tt.err == nil {
+				session = &usecaseModel.JamSessionDetails{
+					JamSession:      usecaseModel.JamSession{ID: 3},
+					ParticipantList: []*usecaseModel.JamSessionParticipant{{UserID: 123, Username: "host"}},
+					TrackList:       []*usecaseModel.JamSessionTrack{{TrackID: 42, Title: "Song"}},
+				}
+			}
+			mockUsecase.EXPECT().GetSession(gomock.Any(), int64(3), int64(123)).Return(session, tt.err)
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/user/me/jams/3", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "3"})
+			ctx := loggerPkg.LoggerToContext(req.Context(), zap.NewNop().Sugar())
+			ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+			rr := httptest.NewRecorder()
+
+			handler.GetSession(rr, req.WithContext(ctx))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestCreateSessionPlaylist(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           string
+		expectedTitle  string
+		err            error
+		expectedStatus int
+	}{
+		{name: "Default title", expectedStatus: http.StatusCreated},
+		{name: "Custom title", body: `{"title":"Friday night"}`, expectedTitle: "Friday night", expectedStatus: http.StatusCreated},
+		{name: "Title taken", body: `{"title":"Friday night"}`, expectedTitle: "Friday night", err: customErrors.ErrJamPlaylistTitleTaken, expectedStatus: http.StatusConflict},
+		{name: "Nothing played", err: customErrors.ErrJamSessionEmpty, expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockUsecase, handler, _ := setupTestJamHandler(t)
+
+			mockUsecase.EXPECT().CreateSessionPlaylist(gomock.Any(), &usecaseModel.CreateJamPlaylistRequest{
+				UserID:    123,
+				SessionID: 3,
+				Title:     tt.expectedTitle,
+			}).Return(int64(15), tt.err)
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/user/me/jams/3/playlist", strings.NewReader(tt.body))
+			req = mux.SetURLVars(req, map[string]string{"id": "3"})
+			ctx := loggerPkg.LoggerToContext(req.Context(), zap.NewNop().Sugar())
+			ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(123))
+			rr := httptest.NewRecorder()
+
+			handler.CreateSessionPlaylist(rr, req.WithContext(ctx))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.err == nil {
+				var response struct {
+					Body deliveryModel.CreateJamPlaylistResponse `json:"body"`
+				}
+				assert.NoError(t, json.Unmarshal(rr.Body.Bytes(), &response))
+				assert.Equal(t, int64(15), response.Body.PlaylistID)
+			}
+		})
+	}
+}
@@ -12,6 +12,7 @@ package mock_jam
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	repository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
 	gomock "go.uber.org/mock/gomock"
@@ -41,6 +42,35 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AcquireReaperLock mocks base method.
+func (m *MockRepository) AcquireReaperLock(ctx context.Context, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireReaperLock", ctx, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireReaperLock indicates an expected call of AcquireReaperLock.
+func (mr *MockRepositoryMockRecorder) AcquireReaperLock(ctx, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireReaperLock", reflect.TypeOf((*MockRepository)(nil).AcquireReaperLock), ctx, ttl)
+}
+
+// AddChatMessage mocks base method.
+func (m *MockRepository) AddChatMessage(ctx context.Context, roomID string, message *repository.JamChatMessage, historySize int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChatMessage", ctx, roomID, message, historySize)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChatMessage indicates an expected call of AddChatMessage.
+func (mr *MockRepositoryMockRecorder) AddChatMessage(ctx, roomID, message, historySize any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChatMessage", reflect.TypeOf((*MockRepository)(nil).AddChatMessage), ctx, roomID, message, historySize)
+}
+
 // AddUser mocks base method.
 func (m *MockRepository) AddUser(ctx context.Context, roomID, userID string) error {
 	m.ctrl.T.Helper()
@@ -55,6 +85,35 @@ func (mr *MockRepositoryMockRecorder) AddUser(ctx, roomID, userID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUser", reflect.TypeOf((*MockRepository)(nil).AddUser), ctx, roomID, userID)
 }
 
+// AllowMessage mocks base method.
+func (m *MockRepository) AllowMessage(ctx context.Context, roomID, userID, kind string, limit int64, window time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowMessage", ctx, roomID, userID, kind, limit, window)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowMessage indicates an expected call of AllowMessage.
+func (mr *MockRepositoryMockRecorder) AllowMessage(ctx, roomID, userID, kind, limit, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowMessage", reflect.TypeOf((*MockRepository)(nil).AllowMessage), ctx, roomID, userID, kind, limit, window)
+}
+
+// ApproveSuggestion mocks base method.
+func (m *MockRepository) ApproveSuggestion(ctx context.Context, roomID, trackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveSuggestion", ctx, roomID, trackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApproveSuggestion indicates an expected call of ApproveSuggestion.
+func (mr *MockRepositoryMockRecorder) ApproveSuggestion(ctx, roomID, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveSuggestion", reflect.TypeOf((*MockRepository)(nil).ApproveSuggestion), ctx, roomID, trackID)
+}
+
 // CheckAllReadyAndPlay mocks base method.
 func (m *MockRepository) CheckAllReadyAndPlay(ctx context.Context, roomID string) {
 	m.ctrl.T.Helper()
@@ -67,6 +126,50 @@ func (mr *MockRepositoryMockRecorder) CheckAllReadyAndPlay(ctx, roomID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAllReadyAndPlay", reflect.TypeOf((*MockRepository)(nil).CheckAllReadyAndPlay), ctx, roomID)
 }
 
+// ClaimTrackEnded mocks base method.
+func (m *MockRepository) ClaimTrackEnded(ctx context.Context, roomID, trackID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTrackEnded", ctx, roomID, trackID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimTrackEnded indicates an expected call of ClaimTrackEnded.
+func (mr *MockRepositoryMockRecorder) ClaimTrackEnded(ctx, roomID, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTrackEnded", reflect.TypeOf((*MockRepository)(nil).ClaimTrackEnded), ctx, roomID, trackID)
+}
+
+// ClearHostDisconnected mocks base method.
+func (m *MockRepository) ClearHostDisconnected(ctx context.Context, roomID, hostID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearHostDisconnected", ctx, roomID, hostID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearHostDisconnected indicates an expected call of ClearHostDisconnected.
+func (mr *MockRepositoryMockRecorder) ClearHostDisconnected(ctx, roomID, hostID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearHostDisconnected", reflect.TypeOf((*MockRepository)(nil).ClearHostDisconnected), ctx, roomID, hostID)
+}
+
+// ConsumeHostDisconnected mocks base method.
+func (m *MockRepository) ConsumeHostDisconnected(ctx context.Context, roomID, token string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeHostDisconnected", ctx, roomID, token)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeHostDisconnected indicates an expected call of ConsumeHostDisconnected.
+func (mr *MockRepositoryMockRecorder) ConsumeHostDisconnected(ctx, roomID, token any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeHostDisconnected", reflect.TypeOf((*MockRepository)(nil).ConsumeHostDisconnected), ctx, roomID, token)
+}
+
 // CreateJam mocks base method.
 func (m *MockRepository) CreateJam(ctx context.Context, request *repository.CreateJamRequest) (*repository.CreateJamResponse, error) {
 	m.ctrl.T.Helper()
@@ -82,6 +185,34 @@ func (mr *MockRepositoryMockRecorder) CreateJam(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJam", reflect.TypeOf((*MockRepository)(nil).CreateJam), ctx, request)
 }
 
+// DeleteChatMessage mocks base method.
+func (m *MockRepository) DeleteChatMessage(ctx context.Context, roomID, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteChatMessage", ctx, roomID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChatMessage indicates an expected call of DeleteChatMessage.
+func (mr *MockRepositoryMockRecorder) DeleteChatMessage(ctx, roomID, messageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChatMessage", reflect.TypeOf((*MockRepository)(nil).DeleteChatMessage), ctx, roomID, messageID)
+}
+
+// EnqueueTrack mocks base method.
+func (m *MockRepository) EnqueueTrack(ctx context.Context, roomID, trackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnqueueTrack", ctx, roomID, trackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnqueueTrack indicates an expected call of EnqueueTrack.
+func (mr *MockRepositoryMockRecorder) EnqueueTrack(ctx, roomID, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnqueueTrack", reflect.TypeOf((*MockRepository)(nil).EnqueueTrack), ctx, roomID, trackID)
+}
+
 // ExistsRoom mocks base method.
 func (m *MockRepository) ExistsRoom(ctx context.Context, roomID string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +228,21 @@ func (mr *MockRepositoryMockRecorder) ExistsRoom(ctx, roomID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExistsRoom", reflect.TypeOf((*MockRepository)(nil).ExistsRoom), ctx, roomID)
 }
 
+// GetActiveJams mocks base method.
+func (m *MockRepository) GetActiveJams(ctx context.Context) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveJams", ctx)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveJams indicates an expected call of GetActiveJams.
+func (mr *MockRepositoryMockRecorder) GetActiveJams(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveJams", reflect.TypeOf((*MockRepository)(nil).GetActiveJams), ctx)
+}
+
 // GetHostID mocks base method.
 func (m *MockRepository) GetHostID(ctx context.Context, roomID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +273,96 @@ func (mr *MockRepositoryMockRecorder) GetInitialJamData(ctx, roomID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInitialJamData", reflect.TypeOf((*MockRepository)(nil).GetInitialJamData), ctx, roomID)
 }
 
+// GetJamAccess mocks base method.
+func (m *MockRepository) GetJamAccess(ctx context.Context, roomID string) (*repository.JamAccess, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJamAccess", ctx, roomID)
+	ret0, _ := ret[0].(*repository.JamAccess)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJamAccess indicates an expected call of GetJamAccess.
+func (mr *MockRepositoryMockRecorder) GetJamAccess(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJamAccess", reflect.TypeOf((*MockRepository)(nil).GetJamAccess), ctx, roomID)
+}
+
+// GetJamSummaries mocks base method.
+func (m *MockRepository) GetJamSummaries(ctx context.Context, roomIDs []string) ([]*repository.JamSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJamSummaries", ctx, roomIDs)
+	ret0, _ := ret[0].([]*repository.JamSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJamSummaries indicates an expected call of GetJamSummaries.
+func (mr *MockRepositoryMockRecorder) GetJamSummaries(ctx, roomIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJamSummaries", reflect.TypeOf((*MockRepository)(nil).GetJamSummaries), ctx, roomIDs)
+}
+
+// GetLongestPresentUser mocks base method.
+func (m *MockRepository) GetLongestPresentUser(ctx context.Context, roomID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLongestPresentUser", ctx, roomID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLongestPresentUser indicates an expected call of GetLongestPresentUser.
+func (mr *MockRepositoryMockRecorder) GetLongestPresentUser(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLongestPresentUser", reflect.TypeOf((*MockRepository)(nil).GetLongestPresentUser), ctx, roomID)
+}
+
+// GetPlayback mocks base method.
+func (m *MockRepository) GetPlayback(ctx context.Context, roomID string) (*repository.JamPlayback, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlayback", ctx, roomID)
+	ret0, _ := ret[0].(*repository.JamPlayback)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlayback indicates an expected call of GetPlayback.
+func (mr *MockRepositoryMockRecorder) GetPlayback(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlayback", reflect.TypeOf((*MockRepository)(nil).GetPlayback), ctx, roomID)
+}
+
+// GetStaleJams mocks base method.
+func (m *MockRepository) GetStaleJams(ctx context.Context, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStaleJams", ctx, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStaleJams indicates an expected call of GetStaleJams.
+func (mr *MockRepositoryMockRecorder) GetStaleJams(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaleJams", reflect.TypeOf((*MockRepository)(nil).GetStaleJams), ctx, before)
+}
+
+// GetStaleUsers mocks base method.
+func (m *MockRepository) GetStaleUsers(ctx context.Context, roomID string, before time.Time) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStaleUsers", ctx, roomID, before)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStaleUsers indicates an expected call of GetStaleUsers.
+func (mr *MockRepositoryMockRecorder) GetStaleUsers(ctx, roomID, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaleUsers", reflect.TypeOf((*MockRepository)(nil).GetStaleUsers), ctx, roomID, before)
+}
+
 // GetUserInfo mocks base method.
 func (m *MockRepository) GetUserInfo(ctx context.Context, roomID, userID string) (string, string, error) {
 	m.ctrl.T.Helper()
@@ -143,18 +379,135 @@ func (mr *MockRepositoryMockRecorder) GetUserInfo(ctx, roomID, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserInfo", reflect.TypeOf((*MockRepository)(nil).GetUserInfo), ctx, roomID, userID)
 }
 
+// Heartbeat mocks base method.
+func (m *MockRepository) Heartbeat(ctx context.Context, roomID string, position int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Heartbeat", ctx, roomID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Heartbeat indicates an expected call of Heartbeat.
+func (mr *MockRepositoryMockRecorder) Heartbeat(ctx, roomID, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Heartbeat", reflect.TypeOf((*MockRepository)(nil).Heartbeat), ctx, roomID, position)
+}
+
+// IsCoHost mocks base method.
+func (m *MockRepository) IsCoHost(ctx context.Context, roomID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCoHost", ctx, roomID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsCoHost indicates an expected call of IsCoHost.
+func (mr *MockRepositoryMockRecorder) IsCoHost(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCoHost", reflect.TypeOf((*MockRepository)(nil).IsCoHost), ctx, roomID, userID)
+}
+
+// IsHostDisconnected mocks base method.
+func (m *MockRepository) IsHostDisconnected(ctx context.Context, roomID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsHostDisconnected", ctx, roomID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsHostDisconnected indicates an expected call of IsHostDisconnected.
+func (mr *MockRepositoryMockRecorder) IsHostDisconnected(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsHostDisconnected", reflect.TypeOf((*MockRepository)(nil).IsHostDisconnected), ctx, roomID)
+}
+
+// IsUserBanned mocks base method.
+func (m *MockRepository) IsUserBanned(ctx context.Context, roomID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserBanned", ctx, roomID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserBanned indicates an expected call of IsUserBanned.
+func (mr *MockRepositoryMockRecorder) IsUserBanned(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserBanned", reflect.TypeOf((*MockRepository)(nil).IsUserBanned), ctx, roomID, userID)
+}
+
+// IsUserInJam mocks base method.
+func (m *MockRepository) IsUserInJam(ctx context.Context, roomID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserInJam", ctx, roomID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserInJam indicates an expected call of IsUserInJam.
+func (mr *MockRepositoryMockRecorder) IsUserInJam(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserInJam", reflect.TypeOf((*MockRepository)(nil).IsUserInJam), ctx, roomID, userID)
+}
+
+// IsUserMuted mocks base method.
+func (m *MockRepository) IsUserMuted(ctx context.Context, roomID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsUserMuted", ctx, roomID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsUserMuted indicates an expected call of IsUserMuted.
+func (mr *MockRepositoryMockRecorder) IsUserMuted(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsUserMuted", reflect.TypeOf((*MockRepository)(nil).IsUserMuted), ctx, roomID, userID)
+}
+
+// KickUser mocks base method.
+func (m *MockRepository) KickUser(ctx context.Context, roomID, userID string, ban bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KickUser", ctx, roomID, userID, ban)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KickUser indicates an expected call of KickUser.
+func (mr *MockRepositoryMockRecorder) KickUser(ctx, roomID, userID, ban any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KickUser", reflect.TypeOf((*MockRepository)(nil).KickUser), ctx, roomID, userID, ban)
+}
+
 // LoadTrack mocks base method.
-func (m *MockRepository) LoadTrack(ctx context.Context, roomID, userID string) error {
+func (m *MockRepository) LoadTrack(ctx context.Context, roomID, trackID string, lyrics []*repository.JamLyrics) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "LoadTrack", ctx, roomID, userID)
+	ret := m.ctrl.Call(m, "LoadTrack", ctx, roomID, trackID, lyrics)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // LoadTrack indicates an expected call of LoadTrack.
-func (mr *MockRepositoryMockRecorder) LoadTrack(ctx, roomID, userID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) LoadTrack(ctx, roomID, trackID, lyrics any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTrack", reflect.TypeOf((*MockRepository)(nil).LoadTrack), ctx, roomID, trackID, lyrics)
+}
+
+// MarkHostDisconnected mocks base method.
+func (m *MockRepository) MarkHostDisconnected(ctx context.Context, roomID, hostID, token string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkHostDisconnected", ctx, roomID, hostID, token, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkHostDisconnected indicates an expected call of MarkHostDisconnected.
+func (mr *MockRepositoryMockRecorder) MarkHostDisconnected(ctx, roomID, hostID, token, ttl any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadTrack", reflect.TypeOf((*MockRepository)(nil).LoadTrack), ctx, roomID, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkHostDisconnected", reflect.TypeOf((*MockRepository)(nil).MarkHostDisconnected), ctx, roomID, hostID, token, ttl)
 }
 
 // MarkUserAsReady mocks base method.
@@ -171,6 +524,20 @@ func (mr *MockRepositoryMockRecorder) MarkUserAsReady(ctx, roomID, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkUserAsReady", reflect.TypeOf((*MockRepository)(nil).MarkUserAsReady), ctx, roomID, userID)
 }
 
+// MoveQueueTrack mocks base method.
+func (m *MockRepository) MoveQueueTrack(ctx context.Context, roomID, trackID string, position int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveQueueTrack", ctx, roomID, trackID, position)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MoveQueueTrack indicates an expected call of MoveQueueTrack.
+func (mr *MockRepositoryMockRecorder) MoveQueueTrack(ctx, roomID, trackID, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveQueueTrack", reflect.TypeOf((*MockRepository)(nil).MoveQueueTrack), ctx, roomID, trackID, position)
+}
+
 // PauseJam mocks base method.
 func (m *MockRepository) PauseJam(ctx context.Context, roomID string) error {
 	m.ctrl.T.Helper()
@@ -185,6 +552,35 @@ func (mr *MockRepositoryMockRecorder) PauseJam(ctx, roomID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseJam", reflect.TypeOf((*MockRepository)(nil).PauseJam), ctx, roomID)
 }
 
+// PopQueue mocks base method.
+func (m *MockRepository) PopQueue(ctx context.Context, roomID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PopQueue", ctx, roomID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PopQueue indicates an expected call of PopQueue.
+func (mr *MockRepositoryMockRecorder) PopQueue(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PopQueue", reflect.TypeOf((*MockRepository)(nil).PopQueue), ctx, roomID)
+}
+
+// RejectSuggestion mocks base method.
+func (m *MockRepository) RejectSuggestion(ctx context.Context, roomID, trackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectSuggestion", ctx, roomID, trackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RejectSuggestion indicates an expected call of RejectSuggestion.
+func (mr *MockRepositoryMockRecorder) RejectSuggestion(ctx, roomID, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectSuggestion", reflect.TypeOf((*MockRepository)(nil).RejectSuggestion), ctx, roomID, trackID)
+}
+
 // RemoveJam mocks base method.
 func (m *MockRepository) RemoveJam(ctx context.Context, roomID string) error {
 	m.ctrl.T.Helper()
@@ -227,18 +623,89 @@ func (mr *MockRepositoryMockRecorder) SeekJam(ctx, roomID, position any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SeekJam", reflect.TypeOf((*MockRepository)(nil).SeekJam), ctx, roomID, position)
 }
 
+// SendReaction mocks base method.
+func (m *MockRepository) SendReaction(ctx context.Context, roomID, userID, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendReaction", ctx, roomID, userID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendReaction indicates an expected call of SendReaction.
+func (mr *MockRepositoryMockRecorder) SendReaction(ctx, roomID, userID, emoji any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendReaction", reflect.TypeOf((*MockRepository)(nil).SendReaction), ctx, roomID, userID, emoji)
+}
+
+// SetAutoAdvance mocks base method.
+func (m *MockRepository) SetAutoAdvance(ctx context.Context, roomID string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAutoAdvance", ctx, roomID, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetAutoAdvance indicates an expected call of SetAutoAdvance.
+func (mr *MockRepositoryMockRecorder) SetAutoAdvance(ctx, roomID, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAutoAdvance", reflect.TypeOf((*MockRepository)(nil).SetAutoAdvance), ctx, roomID, enabled)
+}
+
+// SetCoHost mocks base method.
+func (m *MockRepository) SetCoHost(ctx context.Context, roomID, userID string, enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCoHost", ctx, roomID, userID, enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCoHost indicates an expected call of SetCoHost.
+func (mr *MockRepositoryMockRecorder) SetCoHost(ctx, roomID, userID, enabled any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCoHost", reflect.TypeOf((*MockRepository)(nil).SetCoHost), ctx, roomID, userID, enabled)
+}
+
+// SetUserMuted mocks base method.
+func (m *MockRepository) SetUserMuted(ctx context.Context, roomID, userID string, muted bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetUserMuted", ctx, roomID, userID, muted)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetUserMuted indicates an expected call of SetUserMuted.
+func (mr *MockRepositoryMockRecorder) SetUserMuted(ctx, roomID, userID, muted any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetUserMuted", reflect.TypeOf((*MockRepository)(nil).SetUserMuted), ctx, roomID, userID, muted)
+}
+
+// StopListening mocks base method.
+func (m *MockRepository) StopListening(ctx context.Context, roomID, userID string) (*repository.JamListen, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopListening", ctx, roomID, userID)
+	ret0, _ := ret[0].(*repository.JamListen)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopListening indicates an expected call of StopListening.
+func (mr *MockRepositoryMockRecorder) StopListening(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopListening", reflect.TypeOf((*MockRepository)(nil).StopListening), ctx, roomID, userID)
+}
+
 // StoreUserInfo mocks base method.
-func (m *MockRepository) StoreUserInfo(ctx context.Context, roomID, userID, username, avatarURL string) error {
+func (m *MockRepository) StoreUserInfo(ctx context.Context, roomID, userID, username, avatarURL, avatarColor string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "StoreUserInfo", ctx, roomID, userID, username, avatarURL)
+	ret := m.ctrl.Call(m, "StoreUserInfo", ctx, roomID, userID, username, avatarURL, avatarColor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // StoreUserInfo indicates an expected call of StoreUserInfo.
-func (mr *MockRepositoryMockRecorder) StoreUserInfo(ctx, roomID, userID, username, avatarURL any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) StoreUserInfo(ctx, roomID, userID, username, avatarURL, avatarColor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreUserInfo", reflect.TypeOf((*MockRepository)(nil).StoreUserInfo), ctx, roomID, userID, username, avatarURL)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreUserInfo", reflect.TypeOf((*MockRepository)(nil).StoreUserInfo), ctx, roomID, userID, username, avatarURL, avatarColor)
 }
 
 // SubscribeToJamMessages mocks base method.
@@ -255,3 +722,197 @@ func (mr *MockRepositoryMockRecorder) SubscribeToJamMessages(ctx, roomID any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToJamMessages", reflect.TypeOf((*MockRepository)(nil).SubscribeToJamMessages), ctx, roomID)
 }
+
+// SuggestTrack mocks base method.
+func (m *MockRepository) SuggestTrack(ctx context.Context, roomID, userID, trackID string, roomLimit, userLimit int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuggestTrack", ctx, roomID, userID, trackID, roomLimit, userLimit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SuggestTrack indicates an expected call of SuggestTrack.
+func (mr *MockRepositoryMockRecorder) SuggestTrack(ctx, roomID, userID, trackID, roomLimit, userLimit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuggestTrack", reflect.TypeOf((*MockRepository)(nil).SuggestTrack), ctx, roomID, userID, trackID, roomLimit, userLimit)
+}
+
+// TouchJam mocks base method.
+func (m *MockRepository) TouchJam(ctx context.Context, roomID string, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchJam", ctx, roomID, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchJam indicates an expected call of TouchJam.
+func (mr *MockRepositoryMockRecorder) TouchJam(ctx, roomID, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchJam", reflect.TypeOf((*MockRepository)(nil).TouchJam), ctx, roomID, ttl)
+}
+
+// TouchPresence mocks base method.
+func (m *MockRepository) TouchPresence(ctx context.Context, roomID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchPresence", ctx, roomID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchPresence indicates an expected call of TouchPresence.
+func (mr *MockRepositoryMockRecorder) TouchPresence(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchPresence", reflect.TypeOf((*MockRepository)(nil).TouchPresence), ctx, roomID, userID)
+}
+
+// TransferHost mocks base method.
+func (m *MockRepository) TransferHost(ctx context.Context, roomID, oldHostID, newHostID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransferHost", ctx, roomID, oldHostID, newHostID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TransferHost indicates an expected call of TransferHost.
+func (mr *MockRepositoryMockRecorder) TransferHost(ctx, roomID, oldHostID, newHostID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransferHost", reflect.TypeOf((*MockRepository)(nil).TransferHost), ctx, roomID, oldHostID, newHostID)
+}
+
+// UnbanUser mocks base method.
+func (m *MockRepository) UnbanUser(ctx context.Context, roomID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanUser", ctx, roomID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbanUser indicates an expected call of UnbanUser.
+func (mr *MockRepositoryMockRecorder) UnbanUser(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanUser", reflect.TypeOf((*MockRepository)(nil).UnbanUser), ctx, roomID, userID)
+}
+
+// VoteTrack mocks base method.
+func (m *MockRepository) VoteTrack(ctx context.Context, roomID, userID, trackID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoteTrack", ctx, roomID, userID, trackID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VoteTrack indicates an expected call of VoteTrack.
+func (mr *MockRepositoryMockRecorder) VoteTrack(ctx, roomID, userID, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoteTrack", reflect.TypeOf((*MockRepository)(nil).VoteTrack), ctx, roomID, userID, trackID)
+}
+
+// MockHistoryRepository is a mock of HistoryRepository interface.
+type MockHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockHistoryRepositoryMockRecorder is the mock recorder for MockHistoryRepository.
+type MockHistoryRepositoryMockRecorder struct {
+	mock *MockHistoryRepository
+}
+
+// NewMockHistoryRepository creates a new mock instance.
+func NewMockHistoryRepository(ctrl *gomock.Controller) *MockHistoryRepository {
+	mock := &MockHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockHistoryRepository) EXPECT() *MockHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddParticipant mocks base method.
+func (m *MockHistoryRepository) AddParticipant(ctx context.Context, roomID string, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddParticipant", ctx, roomID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddParticipant indicates an expected call of AddParticipant.
+func (mr *MockHistoryRepositoryMockRecorder) AddParticipant(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddParticipant", reflect.TypeOf((*MockHistoryRepository)(nil).AddParticipant), ctx, roomID, userID)
+}
+
+// EndSession mocks base method.
+func (m *MockHistoryRepository) EndSession(ctx context.Context, roomID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EndSession", ctx, roomID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EndSession indicates an expected call of EndSession.
+func (mr *MockHistoryRepositoryMockRecorder) EndSession(ctx, roomID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EndSession", reflect.TypeOf((*MockHistoryRepository)(nil).EndSession), ctx, roomID)
+}
+
+// GetSession mocks base method.
+func (m *MockHistoryRepository) GetSession(ctx context.Context, sessionID, userID int64) (*repository.JamSessionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID, userID)
+	ret0, _ := ret[0].(*repository.JamSessionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockHistoryRepositoryMockRecorder) GetSession(ctx, sessionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockHistoryRepository)(nil).GetSession), ctx, sessionID, userID)
+}
+
+// GetSessions mocks base method.
+func (m *MockHistoryRepository) GetSessions(ctx context.Context, userID int64, pagination *repository.Pagination) ([]*repository.JamSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*repository.JamSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockHistoryRepositoryMockRecorder) GetSessions(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockHistoryRepository)(nil).GetSessions), ctx, userID, pagination)
+}
+
+// RecordTrack mocks base method.
+func (m *MockHistoryRepository) RecordTrack(ctx context.Context, roomID string, trackID, duration int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecordTrack", ctx, roomID, trackID, duration)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecordTrack indicates an expected call of RecordTrack.
+func (mr *MockHistoryRepositoryMockRecorder) RecordTrack(ctx, roomID, trackID, duration any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordTrack", reflect.TypeOf((*MockHistoryRepository)(nil).RecordTrack), ctx, roomID, trackID, duration)
+}
+
+// StartSession mocks base method.
+func (m *MockHistoryRepository) StartSession(ctx context.Context, roomID string, hostID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSession", ctx, roomID, hostID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// StartSession indicates an expected call of StartSession.
+func (mr *MockHistoryRepositoryMockRecorder) StartSession(ctx, roomID, hostID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSession", reflect.TypeOf((*MockHistoryRepository)(nil).StartSession), ctx, roomID, hostID)
+}
@@ -56,6 +56,51 @@ func (mr *MockUsecaseMockRecorder) CreateJam(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJam", reflect.TypeOf((*MockUsecase)(nil).CreateJam), ctx, request)
 }
 
+// CreateSessionPlaylist mocks base method.
+func (m *MockUsecase) CreateSessionPlaylist(ctx context.Context, request *usecase.CreateJamPlaylistRequest) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSessionPlaylist", ctx, request)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSessionPlaylist indicates an expected call of CreateSessionPlaylist.
+func (mr *MockUsecaseMockRecorder) CreateSessionPlaylist(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSessionPlaylist", reflect.TypeOf((*MockUsecase)(nil).CreateSessionPlaylist), ctx, request)
+}
+
+// GetSession mocks base method.
+func (m *MockUsecase) GetSession(ctx context.Context, sessionID, userID int64) (*usecase.JamSessionDetails, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSession", ctx, sessionID, userID)
+	ret0, _ := ret[0].(*usecase.JamSessionDetails)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSession indicates an expected call of GetSession.
+func (mr *MockUsecaseMockRecorder) GetSession(ctx, sessionID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSession", reflect.TypeOf((*MockUsecase)(nil).GetSession), ctx, sessionID, userID)
+}
+
+// GetSessions mocks base method.
+func (m *MockUsecase) GetSessions(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.JamSession, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSessions", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*usecase.JamSession)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSessions indicates an expected call of GetSessions.
+func (mr *MockUsecaseMockRecorder) GetSessions(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSessions", reflect.TypeOf((*MockUsecase)(nil).GetSessions), ctx, userID, pagination)
+}
+
 // HandleClientMessage mocks base method.
 func (m_2 *MockUsecase) HandleClientMessage(ctx context.Context, roomID, userID string, m *usecase.JamMessage) error {
 	m_2.ctrl.T.Helper()
@@ -85,6 +130,20 @@ func (mr *MockUsecaseMockRecorder) JoinJam(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinJam", reflect.TypeOf((*MockUsecase)(nil).JoinJam), ctx, request)
 }
 
+// KeepAlive mocks base method.
+func (m *MockUsecase) KeepAlive(ctx context.Context, roomID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "KeepAlive", ctx, roomID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// KeepAlive indicates an expected call of KeepAlive.
+func (mr *MockUsecaseMockRecorder) KeepAlive(ctx, roomID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KeepAlive", reflect.TypeOf((*MockUsecase)(nil).KeepAlive), ctx, roomID, userID)
+}
+
 // LeaveJam mocks base method.
 func (m *MockUsecase) LeaveJam(ctx context.Context, roomID, userID string) error {
 	m.ctrl.T.Helper()
@@ -99,6 +158,21 @@ func (mr *MockUsecaseMockRecorder) LeaveJam(ctx, roomID, userID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveJam", reflect.TypeOf((*MockUsecase)(nil).LeaveJam), ctx, roomID, userID)
 }
 
+// ListJams mocks base method.
+func (m *MockUsecase) ListJams(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.JamSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListJams", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*usecase.JamSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListJams indicates an expected call of ListJams.
+func (mr *MockUsecaseMockRecorder) ListJams(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListJams", reflect.TypeOf((*MockUsecase)(nil).ListJams), ctx, userID, pagination)
+}
+
 // SubscribeToJamMessages mocks base method.
 func (m *MockUsecase) SubscribeToJamMessages(ctx context.Context, roomID string) (<-chan *usecase.JamMessage, error) {
 	m.ctrl.T.Helper()
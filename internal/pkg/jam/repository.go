@@ -2,6 +2,7 @@ package jam
 
 import (
 	"context"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
 )
@@ -12,14 +13,61 @@ type Repository interface {
 	PauseJam(ctx context.Context, roomID string) error
 	GetInitialJamData(ctx context.Context, roomID string) (*repository.JamMessage, error)
 	GetHostID(ctx context.Context, roomID string) (string, error)
-	LoadTrack(ctx context.Context, roomID string, userID string) error
+	LoadTrack(ctx context.Context, roomID string, trackID string, lyrics []*repository.JamLyrics) error
 	CheckAllReadyAndPlay(ctx context.Context, roomID string)
 	MarkUserAsReady(ctx context.Context, roomID string, userID string) error
 	RemoveUser(ctx context.Context, roomID string, userID string) error
 	RemoveJam(ctx context.Context, roomID string) error
 	ExistsRoom(ctx context.Context, roomID string) (bool, error)
+	IsUserInJam(ctx context.Context, roomID string, userID string) (bool, error)
+	GetLongestPresentUser(ctx context.Context, roomID string) (string, error)
+	TransferHost(ctx context.Context, roomID string, oldHostID string, newHostID string) error
+	MarkHostDisconnected(ctx context.Context, roomID string, hostID string, token string, ttl time.Duration) error
+	ClearHostDisconnected(ctx context.Context, roomID string, hostID string) error
+	ConsumeHostDisconnected(ctx context.Context, roomID string, token string) (bool, error)
+	SuggestTrack(ctx context.Context, roomID string, userID string, trackID string, roomLimit int64, userLimit int64) error
+	VoteTrack(ctx context.Context, roomID string, userID string, trackID string) error
+	EnqueueTrack(ctx context.Context, roomID string, trackID string) error
+	ApproveSuggestion(ctx context.Context, roomID string, trackID string) error
+	RejectSuggestion(ctx context.Context, roomID string, trackID string) error
+	MoveQueueTrack(ctx context.Context, roomID string, trackID string, position int64) error
+	PopQueue(ctx context.Context, roomID string) (string, error)
+	SetAutoAdvance(ctx context.Context, roomID string, enabled bool) error
+	ClaimTrackEnded(ctx context.Context, roomID string, trackID string) (bool, error)
+	GetJamAccess(ctx context.Context, roomID string) (*repository.JamAccess, error)
+	IsUserBanned(ctx context.Context, roomID string, userID string) (bool, error)
+	KickUser(ctx context.Context, roomID string, userID string, ban bool) error
+	UnbanUser(ctx context.Context, roomID string, userID string) error
+	IsCoHost(ctx context.Context, roomID string, userID string) (bool, error)
+	SetCoHost(ctx context.Context, roomID string, userID string, enabled bool) error
 	SeekJam(ctx context.Context, roomID string, position int64) error
-	StoreUserInfo(ctx context.Context, roomID string, userID string, username string, avatarURL string) error
+	Heartbeat(ctx context.Context, roomID string, position int64) error
+	AllowMessage(ctx context.Context, roomID string, userID string, kind string, limit int64, window time.Duration) (bool, error)
+	AddChatMessage(ctx context.Context, roomID string, message *repository.JamChatMessage, historySize int64) error
+	DeleteChatMessage(ctx context.Context, roomID string, messageID string) error
+	SendReaction(ctx context.Context, roomID string, userID string, emoji string) error
+	IsUserMuted(ctx context.Context, roomID string, userID string) (bool, error)
+	SetUserMuted(ctx context.Context, roomID string, userID string, muted bool) error
+	StoreUserInfo(ctx context.Context, roomID string, userID string, username string, avatarURL string, avatarColor string) error
 	GetUserInfo(ctx context.Context, roomID string, userID string) (string, string, error)
 	SubscribeToJamMessages(ctx context.Context, roomID string) (<-chan []byte, error)
+	TouchJam(ctx context.Context, roomID string, ttl time.Duration) error
+	TouchPresence(ctx context.Context, roomID string, userID string) error
+	GetStaleJams(ctx context.Context, before time.Time) ([]string, error)
+	GetActiveJams(ctx context.Context) ([]string, error)
+	GetStaleUsers(ctx context.Context, roomID string, before time.Time) ([]string, error)
+	IsHostDisconnected(ctx context.Context, roomID string) (bool, error)
+	GetJamSummaries(ctx context.Context, roomIDs []string) ([]*repository.JamSummary, error)
+	AcquireReaperLock(ctx context.Context, ttl time.Duration) (bool, error)
+	GetPlayback(ctx context.Context, roomID string) (*repository.JamPlayback, error)
+	StopListening(ctx context.Context, roomID string, userID string) (*repository.JamListen, error)
+}
+
+type HistoryRepository interface {
+	StartSession(ctx context.Context, roomID string, hostID int64) error
+	AddParticipant(ctx context.Context, roomID string, userID int64) error
+	RecordTrack(ctx context.Context, roomID string, trackID int64, duration int64) error
+	EndSession(ctx context.Context, roomID string) error
+	GetSessions(ctx context.Context, userID int64, pagination *repository.Pagination) ([]*repository.JamSession, error)
+	GetSession(ctx context.Context, sessionID int64, userID int64) (*repository.JamSessionDetails, error)
 }
@@ -0,0 +1,295 @@
+package redis
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"go.uber.org/zap"
+)
+
+const (
+	// Rooms are uuids, a retried start leaves the first session alone
+	StartSessionQuery = `
+		WITH session AS (
+			INSERT INTO jam_session (room_id, host_id)
+			VALUES ($1, $2)
+			ON CONFLICT (room_id) DO NOTHING
+			RETURNING id
+		)
+		INSERT INTO jam_session_participant (session_id, user_id)
+		SELECT id, $2 FROM session
+	`
+	AddParticipantQuery = `
+		INSERT INTO jam_session_participant (session_id, user_id)
+		SELECT id, $2 FROM jam_session WHERE room_id = $1
+		ON CONFLICT (session_id, user_id) DO NOTHING
+	`
+	// The track is recorded once the room moves on, so it started duration seconds ago
+	RecordTrackQuery = `
+		INSERT INTO jam_session_track (session_id, track_id, played_at, duration)
+		SELECT id, $2, NOW() - make_interval(secs => $3), $3
+		FROM jam_session
+		WHERE room_id = $1
+	`
+	EndSessionQuery = `
+		UPDATE jam_session
+		SET ended_at = NOW()
+		WHERE room_id = $1 AND ended_at IS NULL
+	`
+	GetSessionsQuery = `
+		SELECT s.id, s.room_id, s.host_id, u.username, s.started_at, s.ended_at,
+			(SELECT COUNT(*) FROM jam_session_participant sp WHERE sp.session_id = s.id),
+			(SELECT COUNT(*) FROM jam_session_track st WHERE st.session_id = s.id),
+			(SELECT COALESCE(SUM(st.duration), 0) FROM jam_session_track st WHERE st.session_id = s.id)
+		FROM jam_session s
+		JOIN jam_session_participant p ON p.session_id = s.id AND p.user_id = $1
+		JOIN "user" u ON u.id = s.host_id
+		ORDER BY s.started_at DESC, s.id DESC
+		LIMIT $2 OFFSET $3
+	`
+	// Only participants can see a session
+	GetSessionQuery = `
+		SELECT s.id, s.room_id, s.host_id, u.username, s.started_at, s.ended_at,
+			(SELECT COUNT(*) FROM jam_session_participant sp WHERE sp.session_id = s.id),
+			(SELECT COUNT(*) FROM jam_session_track st WHERE st.session_id = s.id),
+			(SELECT COALESCE(SUM(st.duration), 0) FROM jam_session_track st WHERE st.session_id = s.id)
+		FROM jam_session s
+		JOIN jam_session_participant p ON p.session_id = s.id AND p.user_id = $2
+		JOIN "user" u ON u.id = s.host_id
+		WHERE s.id = $1
+	`
+	GetSessionParticipantsQuery = `
+		SELECT u.id, u.username, u.thumbnail_url, u.thumbnail_color, sp.joined_at
+		FROM jam_session_participant sp
+		JOIN "user" u ON u.id = sp.user_id
+		WHERE sp.session_id = $1
+		ORDER BY sp.joined_at, u.id
+	`
+	GetSessionTracksQuery = `
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, st.duration, st.played_at
+		FROM jam_session_track st
+		JOIN track t ON t.id = st.track_id
+		WHERE st.session_id = $1
+		ORDER BY st.played_at, st.id
+	`
+)
+
+type jamHistoryPostgresRepository struct {
+	db *sql.DB
+}
+
+func NewJamHistoryPostgresRepository(db *sql.DB) jam.HistoryRepository {
+	return &jamHistoryPostgresRepository{
+		db: db,
+	}
+}
+
+func (r *jamHistoryPostgresRepository) exec(ctx context.Context, query string, args ...interface{}) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	_, err = stmt.ExecContext(ctx, args...)
+	return err
+}
+
+func (r *jamHistoryPostgresRepository) StartSession(ctx context.Context, roomID string, hostID int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Starting jam session", zap.String("roomID", roomID), zap.Int64("hostID", hostID))
+
+	if err := r.exec(ctx, StartSessionQuery, roomID, hostID); err != nil {
+		logger.Error("failed to start jam session", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *jamHistoryPostgresRepository) AddParticipant(ctx context.Context, roomID string, userID int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Adding jam session participant", zap.String("roomID", roomID), zap.Int64("userID", userID))
+
+	if err := r.exec(ctx, AddParticipantQuery, roomID, userID); err != nil {
+		logger.Error("failed to add jam session participant", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *jamHistoryPostgresRepository) RecordTrack(ctx context.Context, roomID string, trackID int64, duration int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Recording jam session track", zap.String("roomID", roomID), zap.Int64("trackID", trackID), zap.Int64("duration", duration))
+
+	if err := r.exec(ctx, RecordTrackQuery, roomID, trackID, duration); err != nil {
+		logger.Error("failed to record jam session track", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *jamHistoryPostgresRepository) EndSession(ctx context.Context, roomID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Ending jam session", zap.String("roomID", roomID))
+
+	if err := r.exec(ctx, EndSessionQuery, roomID); err != nil {
+		logger.Error("failed to end jam session", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *jamHistoryPostgresRepository) GetSessions(ctx context.Context, userID int64, pagination *repository.Pagination) ([]*repository.JamSession, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting jam sessions", zap.Int64("userID", userID))
+
+	stmt, err := r.db.PrepareContext(ctx, GetSessionsQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, userID, pagination.Limit, pagination.Offset)
+	if err != nil {
+		logger.Error("failed to query jam sessions", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	sessions := make([]*repository.JamSession, 0)
+	for rows.Next() {
+		session, err := scanSession(rows)
+		if err != nil {
+			logger.Error("failed to scan jam session", zap.Error(err))
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to iterate jam sessions", zap.Error(err))
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r *jamHistoryPostgresRepository) GetSession(ctx context.Context, sessionID int64, userID int64) (*repository.JamSessionDetails, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting jam session", zap.Int64("sessionID", sessionID), zap.Int64("userID", userID))
+
+	session, err := scanSession(r.db.QueryRowContext(ctx, GetSessionQuery, sessionID, userID))
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, customErrors.ErrJamSessionNotFound
+	}
+	if err != nil {
+		logger.Error("failed to get jam session", zap.Error(err))
+		return nil, err
+	}
+
+	participants, err := r.getParticipants(ctx, sessionID)
+	if err != nil {
+		logger.Error("failed to get jam session participants", zap.Error(err))
+		return nil, err
+	}
+
+	tracks, err := r.getTracks(ctx, sessionID)
+	if err != nil {
+		logger.Error("failed to get jam session tracks", zap.Error(err))
+		return nil, err
+	}
+
+	return &repository.JamSessionDetails{
+		JamSession:      *session,
+		ParticipantList: participants,
+		TrackList:       tracks,
+	}, nil
+}
+
+func (r *jamHistoryPostgresRepository) getParticipants(ctx context.Context, sessionID int64) ([]*repository.JamSessionParticipant, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetSessionParticipantsQuery, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	participants := make([]*repository.JamSessionParticipant, 0)
+	for rows.Next() {
+		var participant repository.JamSessionParticipant
+		err = rows.Scan(&participant.UserID, &participant.Username, &participant.Thumbnail, &participant.ThumbnailColor, &participant.JoinedAt)
+		if err != nil {
+			return nil, err
+		}
+		participants = append(participants, &participant)
+	}
+	return participants, rows.Err()
+}
+
+func (r *jamHistoryPostgresRepository) getTracks(ctx context.Context, sessionID int64) ([]*repository.JamSessionTrack, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetSessionTracksQuery, sessionID)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	tracks := make([]*repository.JamSessionTrack, 0)
+	for rows.Next() {
+		var track repository.JamSessionTrack
+		err = rows.Scan(&track.TrackID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.PlayedAt)
+		if err != nil {
+			return nil, err
+		}
+		tracks = append(tracks, &track)
+	}
+	return tracks, rows.Err()
+}
+
+type rowScanner interface {
+	Scan(dest ...interface{}) error
+}
+
+func scanSession(row rowScanner) (*repository.JamSession, error) {
+	var session repository.JamSession
+	var endedAt sql.NullTime
+	err := row.Scan(&session.ID, &session.RoomID, &session.HostID, &session.HostName, &session.StartedAt, &endedAt,
+		&session.Participants, &session.Tracks, &session.Duration)
+	if err != nil {
+		return nil, err
+	}
+	if endedAt.Valid {
+		session.EndedAt = &endedAt.Time
+	}
+	return &session, nil
+}
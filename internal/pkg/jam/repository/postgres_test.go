@@ -0,0 +1,165 @@
+package redis
+
+import (
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var sessionColumns = []string{"id", "room_id", "host_id", "username", "started_at", "ended_at", "participants", "tracks", "duration"}
+
+func setupMockPostgres(t *testing.T) (*jamHistoryPostgresRepository, sqlmock.Sqlmock, *sql.DB) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	repo := NewJamHistoryPostgresRepository(db).(*jamHistoryPostgresRepository)
+	return repo, mock, db
+}
+
+func TestStartSession(t *testing.T) {
+	repo, mock, db := setupMockPostgres(t)
+	defer db.Close()
+	ctx := setupTestContext()
+
+	mock.ExpectPrepare("INSERT INTO jam_session").
+		ExpectExec().
+		WithArgs("room123", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.StartSession(ctx, "room123", 1)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAddParticipant(t *testing.T) {
+	repo, mock, db := setupMockPostgres(t)
+	defer db.Close()
+	ctx := setupTestContext()
+
+	mock.ExpectPrepare("INSERT INTO jam_session_participant").
+		ExpectExec().
+		WithArgs("room123", int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.AddParticipant(ctx, "room123", 2)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordTrack(t *testing.T) {
+	repo, mock, db := setupMockPostgres(t)
+	defer db.Close()
+	ctx := setupTestContext()
+
+	mock.ExpectPrepare("INSERT INTO jam_session_track").
+		ExpectExec().
+		WithArgs("room123", int64(42), int64(180)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.RecordTrack(ctx, "room123", 42, 180)
+
+	require.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEndSession_Error(t *testing.T) {
+	repo, mock, db := setupMockPostgres(t)
+	defer db.Close()
+	ctx := setupTestContext()
+
+	mock.ExpectPrepare("UPDATE jam_session").
+		ExpectExec().
+		WithArgs("room123").
+		WillReturnError(errors.New("db error"))
+
+	err := repo.EndSession(ctx, "room123")
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSessions(t *testing.T) {
+	repo, mock, db := setupMockPostgres(t)
+	defer db.Close()
+	ctx := setupTestContext()
+
+	startedAt := time.Now()
+	endedAt := startedAt.Add(time.Hour)
+	rows := sqlmock.NewRows(sessionColumns).
+		AddRow(2, "room2", 1, "host", startedAt, nil, 3, 1, 120).
+		AddRow(1, "room1", 5, "other", startedAt.Add(-time.Hour), endedAt, 2, 4, 600)
+
+	mock.ExpectPrepare("SELECT s.id, s.room_id").
+		ExpectQuery().
+		WithArgs(int64(1), 10, 0).
+		WillReturnRows(rows)
+
+	sessions, err := repo.GetSessions(ctx, 1, &repository.Pagination{Offset: 0, Limit: 10})
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	assert.Equal(t, "room2", sessions[0].RoomID)
+	assert.Nil(t, sessions[0].EndedAt)
+	assert.Equal(t, int64(3), sessions[0].Participants)
+	require.NotNil(t, sessions[1].EndedAt)
+	assert.Equal(t, endedAt, *sessions[1].EndedAt)
+	assert.Equal(t, int64(600), sessions[1].Duration)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSession(t *testing.T) {
+	repo, mock, db := setupMockPostgres(t)
+	defer db.Close()
+	ctx := setupTestContext()
+
+	startedAt := time.Now()
+	mock.ExpectQuery("SELECT s.id, s.room_id").
+		WithArgs(int64(7), int64(1)).
+		WillReturnRows(sqlmock.NewRows(sessionColumns).AddRow(7, "room7", 1, "host", startedAt, nil, 2, 1, 90))
+	mock.ExpectQuery("FROM jam_session_participant sp").
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "username", "thumbnail_url", "thumbnail_color", "joined_at"}).
+			AddRow(1, "host", "avatar.png", "#a1b2c3", startedAt).
+			AddRow(2, "friend", "", "", startedAt.Add(time.Minute)))
+	mock.ExpectQuery("FROM jam_session_track st").
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "played_at"}).
+			AddRow(42, "Song", "track.png", "#a1b2c3", 90, startedAt))
+
+	session, err := repo.GetSession(ctx, 7, 1)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), session.ID)
+	require.Len(t, session.ParticipantList, 2)
+	assert.Equal(t, "#a1b2c3", session.ParticipantList[0].ThumbnailColor)
+	assert.Equal(t, "friend", session.ParticipantList[1].Username)
+	require.Len(t, session.TrackList, 1)
+	assert.Equal(t, int64(42), session.TrackList[0].TrackID)
+	assert.Equal(t, "#a1b2c3", session.TrackList[0].ThumbnailColor)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetSession_NotParticipant(t *testing.T) {
+	repo, mock, db := setupMockPostgres(t)
+	defer db.Close()
+	ctx := setupTestContext()
+
+	mock.ExpectQuery("SELECT s.id, s.room_id").
+		WithArgs(int64(7), int64(3)).
+		WillReturnRows(sqlmock.NewRows(sessionColumns))
+
+	session, err := repo.GetSession(ctx, 7, 3)
+
+	assert.ErrorIs(t, err, customErrors.ErrJamSessionNotFound)
+	assert.Nil(t, session)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,7 +3,11 @@ package redis
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
@@ -13,7 +17,9 @@ import (
 )
 
 type jamRedisRepository struct {
-	redisPool *redis.Pool
+	redisPool     *redis.Pool
+	now           func() time.Time
+	subscriptions *subscriptionHub
 }
 
 func (r *jamRedisRepository) getConn() (redis.Conn, error) {
@@ -25,7 +31,7 @@ func (r *jamRedisRepository) getConn() (redis.Conn, error) {
 }
 
 func NewJamRedisRepository(redisPool *redis.Pool) jam.Repository {
-	return &jamRedisRepository{redisPool: redisPool}
+	return &jamRedisRepository{redisPool: redisPool, now: time.Now, subscriptions: newSubscriptionHub()}
 }
 
 func (r *jamRedisRepository) CreateJam(ctx context.Context, request *repository.CreateJamRequest) (*repository.CreateJamResponse, error) {
@@ -54,12 +60,46 @@ func (r *jamRedisRepository) CreateJam(ctx context.Context, request *repository.
 		return nil, err
 	}
 
+	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+jamID+":listening", request.UserID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	private := 0
+	if request.Private {
+		private = 1
+	}
+	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+jamID+":settings",
+		"private", private,
+		"password_hash", request.PasswordHash,
+		"invite_token", request.InviteToken,
+		"max_listeners", request.MaxListeners,
+	)
+	if err != nil {
+		return nil, err
+	}
+
 	return &repository.CreateJamResponse{
 		RoomID: jamID,
 		HostID: request.UserID,
 	}, nil
 }
 
+// addListener adds a listener unless the room is at max_listeners. Counting and adding happen
+// in one script, so joins racing on different gateways can't both take the last seat. A
+// listener already in the room is let back in even when it is full.
+const addListenerSource = `
+local max = tonumber(redis.call('HGET', KEYS[2], 'max_listeners')) or 0
+if max > 0 and redis.call('SISMEMBER', KEYS[1], ARGV[1]) == 0 and redis.call('SCARD', KEYS[1]) >= max then
+	return 0
+end
+redis.call('SADD', KEYS[1], ARGV[1])
+return 1
+`
+
+var addListener = redis.NewScript(2, addListenerSource)
+
+// AddUser returns ErrJamFull when the room has no free seat
 func (r *jamRedisRepository) AddUser(ctx context.Context, roomID string, userID string) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
@@ -72,7 +112,32 @@ func (r *jamRedisRepository) AddUser(ctx context.Context, roomID string, userID
 		}
 	}()
 
-	_, err = redis.DoContext(conn, ctx, "SADD", "jam:"+roomID+":users", userID)
+	added, err := redis.Bool(addListener.DoContext(ctx, conn, "jam:"+roomID+":users", "jam:"+roomID+":settings", userID))
+	if err != nil {
+		return err
+	}
+	if !added {
+		// the joining user's info is stored ahead of the join, it has no room to expire with
+		_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":userinfo:"+userID)
+		if err != nil {
+			return err
+		}
+		return customErrors.ErrJamFull
+	}
+
+	// NX keeps the first join time, so a reconnect does not send a listener to the back of the line
+	_, err = redis.DoContext(conn, ctx, "ZADD", "jam:"+roomID+":joined", "NX", time.Now().UnixMilli(), userID)
+	if err != nil {
+		return err
+	}
+
+	// the listener hears the current track from here on, NX keeps the mark of a reconnecting
+	// listener and of a host who stays on as a listener
+	track, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":track"))
+	if err != nil {
+		return err
+	}
+	_, err = redis.DoContext(conn, ctx, "HSETNX", "jam:"+roomID+":listening", userID, listenClock(track, r.now().UnixMilli()))
 	if err != nil {
 		return err
 	}
@@ -109,13 +174,23 @@ func (r *jamRedisRepository) PauseJam(ctx context.Context, roomID string) error
 		}
 	}()
 
-	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+roomID+":track", "paused", true)
+	now := r.now().UnixMilli()
+	track, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":track"))
+	if err != nil {
+		return err
+	}
+	position := playbackPosition(track, now)
+
+	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+roomID+":track", "paused", true, "position", position, "anchor", now,
+		"played", listenClock(track, now))
 	if err != nil {
 		return err
 	}
 
 	pausedPayload, err := json.Marshal(repository.JamMessage{
-		Type: "pause",
+		Type:       "pause",
+		Position:   position,
+		ServerTime: now,
 	})
 	if err != nil {
 		return err
@@ -162,6 +237,8 @@ func (r *jamRedisRepository) GetInitialJamData(ctx context.Context, roomID strin
 	if err != nil {
 		return nil, err
 	}
+	now := r.now().UnixMilli()
+	position += elapsed(track, now)
 
 	loadedMap := make(map[string]bool)
 	for _, u := range users {
@@ -192,16 +269,43 @@ func (r *jamRedisRepository) GetInitialJamData(ctx context.Context, roomID strin
 		}
 	}
 
+	queue, err := r.getQueue(ctx, conn, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	coHosts, err := redis.Strings(redis.DoContext(conn, ctx, "SMEMBERS", "jam:"+roomID+":cohosts"))
+	if err != nil {
+		return nil, err
+	}
+
+	chat, err := r.getChat(ctx, conn, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	muted, err := redis.Strings(redis.DoContext(conn, ctx, "SMEMBERS", "jam:"+roomID+":muted"))
+	if err != nil {
+		return nil, err
+	}
+
 	return &repository.JamMessage{
-		Type:       "init",
-		TrackID:    track["id"],
-		Position:   position,
-		Paused:     paused,
-		Users:      users,
-		HostID:     hostID,
-		Loaded:     loadedMap,
-		UserNames:  userNames,
-		UserImages: userImages,
+		Type:        "init",
+		TrackID:     track["id"],
+		Position:    position,
+		Paused:      paused,
+		Users:       users,
+		HostID:      hostID,
+		Loaded:      loadedMap,
+		UserNames:   userNames,
+		UserImages:  userImages,
+		Queue:       queue.Queue,
+		Suggestions: queue.Suggestions,
+		AutoAdvance: queue.AutoAdvance,
+		CoHosts:     coHosts,
+		ServerTime:  now,
+		Chat:        chat,
+		Muted:       muted,
 	}, nil
 }
 
@@ -224,6 +328,23 @@ func (r *jamRedisRepository) GetHostID(ctx context.Context, roomID string) (stri
 	return hostID, nil
 }
 
+// playWhenReady starts a paused track once every listener has loaded it and returns the
+// position it starts from, or nil. It runs atomically, so when gateways race on the last
+// ready only one of them sees the track paused and emits play. Members are compared rather
+// than counted, a listener who loaded and left must not stand in for one who has not loaded.
+const playWhenReadySource = `
+if redis.call('HGET', KEYS[3], 'paused') ~= '1' then
+	return false
+end
+if #redis.call('SDIFF', KEYS[1], KEYS[2]) > 0 then
+	return false
+end
+redis.call('HSET', KEYS[3], 'paused', '0', 'anchor', ARGV[1], 'playing_since', ARGV[1])
+return tonumber(redis.call('HGET', KEYS[3], 'position')) or 0
+`
+
+var playWhenReady = redis.NewScript(3, playWhenReadySource)
+
 func (r *jamRedisRepository) CheckAllReadyAndPlay(ctx context.Context, roomID string) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
@@ -236,24 +357,31 @@ func (r *jamRedisRepository) CheckAllReadyAndPlay(ctx context.Context, roomID st
 		}
 	}()
 
-	total, _ := redis.Int(redis.DoContext(conn, ctx, "SCARD", "jam:"+roomID+":users"))
-	loaded, _ := redis.Int(redis.DoContext(conn, ctx, "SCARD", "jam:"+roomID+":loaded"))
-	if loaded >= total {
-		payload, err := json.Marshal(repository.JamMessage{
-			Type: "play",
-		})
-		if err != nil {
-			return
-		}
-		_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
-		if err != nil {
-			return
+	now := r.now().UnixMilli()
+	position, err := redis.Int64(playWhenReady.DoContext(ctx, conn,
+		"jam:"+roomID+":users", "jam:"+roomID+":loaded", "jam:"+roomID+":track", now))
+	if err != nil {
+		if !errors.Is(err, redis.ErrNil) {
+			logger.Error("failed to start jam playback", zap.Error(err))
 		}
 		return
 	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:       "play",
+		Position:   position,
+		ServerTime: now,
+	})
+	if err != nil {
+		return
+	}
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		logger.Error("failed to publish play", zap.Error(err))
+	}
 }
 
-func (r *jamRedisRepository) LoadTrack(ctx context.Context, roomID string, trackID string) error {
+func (r *jamRedisRepository) LoadTrack(ctx context.Context, roomID string, trackID string, lyrics []*repository.JamLyrics) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
 	if err != nil {
@@ -265,15 +393,38 @@ func (r *jamRedisRepository) LoadTrack(ctx context.Context, roomID string, track
 		}
 	}()
 
-	_, err = redis.DoContext(conn, ctx, "HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1)
+	_, err = redis.DoContext(conn, ctx, "HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1, "played", 0)
 	if err != nil {
 		return err
 	}
 
-	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":loaded")
+	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":loaded", "jam:"+roomID+":listening")
+	if err != nil {
+		return err
+	}
+
+	// everyone in the room hears the new track from its start
+	users, err := redis.Strings(redis.DoContext(conn, ctx, "SMEMBERS", "jam:"+roomID+":users"))
 	if err != nil {
 		return err
 	}
+	hostID, err := redis.String(redis.DoContext(conn, ctx, "GET", "jam:"+roomID+":host"))
+	if err != nil && !errors.Is(err, redis.ErrNil) {
+		return err
+	}
+	if hostID != "" {
+		users = append(users, hostID)
+	}
+	if len(users) > 0 {
+		args := redis.Args{"jam:" + roomID + ":listening"}
+		for _, userID := range users {
+			args = args.Add(userID, 0)
+		}
+		_, err = redis.DoContext(conn, ctx, "HSET", args...)
+		if err != nil {
+			return err
+		}
+	}
 
 	loadMessageKey := "jam:" + roomID + ":loadmessage:" + trackID
 	exists, err := redis.Bool(redis.DoContext(conn, ctx, "EXISTS", loadMessageKey))
@@ -293,6 +444,7 @@ func (r *jamRedisRepository) LoadTrack(ctx context.Context, roomID string, track
 	payload, err := json.Marshal(repository.JamMessage{
 		Type:    "load",
 		TrackID: trackID,
+		Lyrics:  lyrics,
 	})
 	if err != nil {
 		return err
@@ -366,6 +518,27 @@ func (r *jamRedisRepository) RemoveUser(ctx context.Context, roomID string, user
 		return err
 	}
 
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":loaded", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZREM", "jam:"+roomID+":joined", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZREM", "jam:"+roomID+":presence", userID)
+	if err != nil {
+		return err
+	}
+
+	// co-hosting does not survive leaving, a listener who rejoins has to be promoted again
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":cohosts", userID)
+	if err != nil {
+		return err
+	}
+
 	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":userinfo:"+userID)
 	if err != nil {
 		return err
@@ -437,6 +610,31 @@ func (r *jamRedisRepository) RemoveJam(ctx context.Context, roomID string) error
 		return err
 	}
 
+	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":joined", "jam:"+roomID+":host:disconnected", "jam:"+roomID+":listening")
+	if err != nil {
+		return err
+	}
+
+	suggested, _ := redis.Strings(redis.DoContext(conn, ctx, "HKEYS", "jam:"+roomID+":suggested_by"))
+	for _, trackID := range suggested {
+		_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":suggestion:"+trackID+":voters")
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":queue", "jam:"+roomID+":suggestions", "jam:"+roomID+":suggested_by", "jam:"+roomID+":settings",
+		"jam:"+roomID+":banned", "jam:"+roomID+":cohosts", "jam:"+roomID+":chat", "jam:"+roomID+":muted",
+		"jam:"+roomID+":presence", "jam:"+roomID+":touched")
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZREM", "jams:active", roomID)
+	if err != nil {
+		return err
+	}
+
 	payload, err := json.Marshal(repository.JamMessage{
 		Type: "jam:closed",
 	})
@@ -457,7 +655,7 @@ func (r *jamRedisRepository) RemoveJam(ctx context.Context, roomID string) error
 	return nil
 }
 
-func (r *jamRedisRepository) ExistsRoom(ctx context.Context, roomID string) (bool, error) {
+func (r *jamRedisRepository) IsUserInJam(ctx context.Context, roomID string, userID string) (bool, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
 	if err != nil {
@@ -469,14 +667,35 @@ func (r *jamRedisRepository) ExistsRoom(ctx context.Context, roomID string) (boo
 		}
 	}()
 
-	exists, err := redis.Bool(redis.DoContext(conn, ctx, "EXISTS", "jam:"+roomID+":host"))
+	return redis.Bool(redis.DoContext(conn, ctx, "SISMEMBER", "jam:"+roomID+":users", userID))
+}
+
+// GetLongestPresentUser returns the listener who joined first, or an empty string for an empty room
+func (r *jamRedisRepository) GetLongestPresentUser(ctx context.Context, roomID string) (string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
 	if err != nil {
-		return false, err
+		return "", err
 	}
-	return exists, nil
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	users, err := redis.Strings(redis.DoContext(conn, ctx, "ZRANGE", "jam:"+roomID+":joined", 0, 0))
+	if err != nil {
+		return "", err
+	}
+	if len(users) == 0 {
+		return "", nil
+	}
+	return users[0], nil
 }
 
-func (r *jamRedisRepository) SeekJam(ctx context.Context, roomID string, position int64) error {
+// TransferHost makes a listener the host. The host is not a listener, so the new one leaves the
+// listener sets; putting the previous host back as a listener is up to the caller.
+func (r *jamRedisRepository) TransferHost(ctx context.Context, roomID string, oldHostID string, newHostID string) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
 	if err != nil {
@@ -488,20 +707,46 @@ func (r *jamRedisRepository) SeekJam(ctx context.Context, roomID string, positio
 		}
 	}()
 
-	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+roomID+":track", "position", position)
+	_, err = redis.DoContext(conn, ctx, "SET", "jam:"+roomID+":host", newHostID)
 	if err != nil {
 		return err
 	}
 
-	seekPayload, err := json.Marshal(repository.JamMessage{
-		Type:     "seek",
-		Position: position,
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":users", newHostID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":loaded", newHostID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZREM", "jam:"+roomID+":joined", newHostID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":cohosts", newHostID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":host:disconnected")
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:   "host:changed",
+		HostID: newHostID,
+		UserID: oldHostID,
 	})
 	if err != nil {
 		return err
 	}
 
-	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(seekPayload))
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
 	if err != nil {
 		return err
 	}
@@ -509,7 +754,9 @@ func (r *jamRedisRepository) SeekJam(ctx context.Context, roomID string, positio
 	return nil
 }
 
-func (r *jamRedisRepository) StoreUserInfo(ctx context.Context, roomID string, userID string, username string, avatarURL string) error {
+// MarkHostDisconnected starts the host grace period. The token tells this disconnect apart from
+// a later one, so an old grace timer cannot hand the room over after the host came back and left again.
+func (r *jamRedisRepository) MarkHostDisconnected(ctx context.Context, roomID string, hostID string, token string, ttl time.Duration) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
 	if err != nil {
@@ -521,10 +768,20 @@ func (r *jamRedisRepository) StoreUserInfo(ctx context.Context, roomID string, u
 		}
 	}()
 
-	_, err = redis.DoContext(conn, ctx, "HMSET", "jam:"+roomID+":userinfo:"+userID,
-		"username", username,
-		"avatar", avatarURL,
-	)
+	_, err = redis.DoContext(conn, ctx, "SET", "jam:"+roomID+":host:disconnected", token, "PX", ttl.Milliseconds())
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:   "host:disconnected",
+		HostID: hostID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
 	if err != nil {
 		return err
 	}
@@ -532,11 +789,12 @@ func (r *jamRedisRepository) StoreUserInfo(ctx context.Context, roomID string, u
 	return nil
 }
 
-func (r *jamRedisRepository) GetUserInfo(ctx context.Context, roomID string, userID string) (string, string, error) {
+// ClearHostDisconnected ends the grace period when the host comes back in time
+func (r *jamRedisRepository) ClearHostDisconnected(ctx context.Context, roomID string, hostID string) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
 	if err != nil {
-		return "", "", err
+		return err
 	}
 	defer func() {
 		if err := conn.Close(); err != nil {
@@ -544,63 +802,1414 @@ func (r *jamRedisRepository) GetUserInfo(ctx context.Context, roomID string, use
 		}
 	}()
 
-	userInfo, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":userinfo:"+userID))
+	deleted, err := redis.Int(redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":host:disconnected"))
 	if err != nil {
-		return "", "", err
+		return err
+	}
+	if deleted == 0 {
+		return nil
 	}
 
-	username := userInfo["username"]
-	avatarURL := userInfo["avatar"]
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:   "host:reconnected",
+		HostID: hostID,
+	})
+	if err != nil {
+		return err
+	}
 
-	return username, avatarURL, nil
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
 
-func (r *jamRedisRepository) SubscribeToJamMessages(ctx context.Context, roomID string) (<-chan []byte, error) {
+// ConsumeHostDisconnected reports whether the grace period started with token is still running
+// and ends it. Only one caller gets true for a token.
+func (r *jamRedisRepository) ConsumeHostDisconnected(ctx context.Context, roomID string, token string) (bool, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	conn, err := r.getConn()
 	if err != nil {
-		return nil, err
+		return false, err
 	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
 
-	pubSub := redis.PubSubConn{Conn: conn}
-	err = pubSub.Subscribe("jam:" + roomID + ":pubsub")
+	current, err := redis.String(redis.DoContext(conn, ctx, "GET", "jam:"+roomID+":host:disconnected"))
+	if errors.Is(err, redis.ErrNil) {
+		return false, nil
+	}
 	if err != nil {
-		return nil, err
+		return false, err
+	}
+	if current != token {
+		return false, nil
 	}
 
-	messageChan := make(chan []byte, 100)
+	deleted, err := redis.Int(redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":host:disconnected"))
+	if err != nil {
+		return false, err
+	}
+	return deleted == 1, nil
+}
 
-	go func() {
-		defer func() {
-			if err := conn.Close(); err != nil {
-				logger.Error("Error closing connection:", zap.Error(err))
-			}
-		}()
-		defer close(messageChan)
-		defer func() {
-			if err := pubSub.Unsubscribe(); err != nil {
-				logger.Error("Error unsubscribing from jam messages:", zap.Error(err))
-			}
-		}()
-
-		for {
-			select {
-			case <-ctx.Done():
-				return
-			default:
-				switch v := pubSub.Receive().(type) {
-				case redis.Message:
-					select {
-					case messageChan <- v.Data:
-					case <-ctx.Done():
-						return
-					}
-				case error:
-					return
-				}
-			}
+// SuggestTrack adds a listener's suggestion to the room, suggesting a track that is already
+// suggested counts as a vote for it
+// claimSuggestion records who suggested a track while the room and the user are under their
+// limits, a track that is already suggested only gets a vote. It returns -1 when the room is
+// full of suggestions and -2 when the user is.
+const claimSuggestionSource = `
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 1 then
+	return 1
+end
+local owners = redis.call('HVALS', KEYS[1])
+local roomLimit, userLimit = tonumber(ARGV[3]), tonumber(ARGV[4])
+if roomLimit > 0 and #owners >= roomLimit then
+	return -1
+end
+local mine = 0
+for _, owner in ipairs(owners) do
+	if owner == ARGV[2] then
+		mine = mine + 1
+	end
+end
+if userLimit > 0 and mine >= userLimit then
+	return -2
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+return 1
+`
+
+var claimSuggestion = redis.NewScript(1, claimSuggestionSource)
+
+// SuggestTrack returns ErrJamTooManySuggestions or ErrJamUserSuggestionLimit when a limit is
+// reached, a limit of zero is no limit
+func (r *jamRedisRepository) SuggestTrack(ctx context.Context, roomID string, userID string, trackID string, roomLimit int64, userLimit int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	queued, err := r.isQueued(ctx, conn, roomID, trackID)
+	if err != nil {
+		return err
+	}
+	if queued {
+		return nil
+	}
+
+	claimed, err := redis.Int(claimSuggestion.DoContext(ctx, conn, "jam:"+roomID+":suggested_by", trackID, userID, roomLimit, userLimit))
+	if err != nil {
+		return err
+	}
+	switch claimed {
+	case -1:
+		return customErrors.ErrJamTooManySuggestions
+	case -2:
+		return customErrors.ErrJamUserSuggestionLimit
+	}
+
+	return r.vote(ctx, conn, roomID, userID, trackID)
+}
+
+func (r *jamRedisRepository) VoteTrack(ctx context.Context, roomID string, userID string, trackID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
 		}
 	}()
 
-	return messageChan, nil
+	suggested, err := redis.Bool(redis.DoContext(conn, ctx, "HEXISTS", "jam:"+roomID+":suggested_by", trackID))
+	if err != nil {
+		return err
+	}
+	if !suggested {
+		return customErrors.ErrJamTrackNotSuggested
+	}
+
+	return r.vote(ctx, conn, roomID, userID, trackID)
+}
+
+// vote counts one vote per user, the voters set is what keeps the score honest
+func (r *jamRedisRepository) vote(ctx context.Context, conn redis.Conn, roomID string, userID string, trackID string) error {
+	added, err := redis.Int(redis.DoContext(conn, ctx, "SADD", "jam:"+roomID+":suggestion:"+trackID+":voters", userID))
+	if err != nil {
+		return err
+	}
+	if added == 0 {
+		return nil
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZINCRBY", "jam:"+roomID+":suggestions", 1, trackID)
+	if err != nil {
+		return err
+	}
+
+	return r.publishQueue(ctx, conn, roomID)
+}
+
+// EnqueueTrack appends a track to the end of the queue, a pending suggestion of it is dropped
+func (r *jamRedisRepository) EnqueueTrack(ctx context.Context, roomID string, trackID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	queued, err := r.isQueued(ctx, conn, roomID, trackID)
+	if err != nil {
+		return err
+	}
+	if queued {
+		return nil
+	}
+
+	err = r.removeSuggestion(ctx, conn, roomID, trackID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "RPUSH", "jam:"+roomID+":queue", trackID)
+	if err != nil {
+		return err
+	}
+
+	return r.publishQueue(ctx, conn, roomID)
+}
+
+// ApproveSuggestion moves a suggestion to the end of the queue
+func (r *jamRedisRepository) ApproveSuggestion(ctx context.Context, roomID string, trackID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	removed, err := redis.Int(redis.DoContext(conn, ctx, "ZREM", "jam:"+roomID+":suggestions", trackID))
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return customErrors.ErrJamTrackNotSuggested
+	}
+
+	err = r.removeSuggestion(ctx, conn, roomID, trackID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "RPUSH", "jam:"+roomID+":queue", trackID)
+	if err != nil {
+		return err
+	}
+
+	return r.publishQueue(ctx, conn, roomID)
+}
+
+func (r *jamRedisRepository) RejectSuggestion(ctx context.Context, roomID string, trackID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	err = r.removeSuggestion(ctx, conn, roomID, trackID)
+	if err != nil {
+		return err
+	}
+
+	return r.publishQueue(ctx, conn, roomID)
+}
+
+func (r *jamRedisRepository) removeSuggestion(ctx context.Context, conn redis.Conn, roomID string, trackID string) error {
+	_, err := redis.DoContext(conn, ctx, "ZREM", "jam:"+roomID+":suggestions", trackID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "HDEL", "jam:"+roomID+":suggested_by", trackID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":suggestion:"+trackID+":voters")
+	return err
+}
+
+// MoveQueueTrack puts a queued track at position, positions past the end move it to the end
+func (r *jamRedisRepository) MoveQueueTrack(ctx context.Context, roomID string, trackID string, position int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	removed, err := redis.Int(redis.DoContext(conn, ctx, "LREM", "jam:"+roomID+":queue", 1, trackID))
+	if err != nil {
+		return err
+	}
+	if removed == 0 {
+		return customErrors.ErrJamTrackNotQueued
+	}
+
+	pivot, err := redis.String(redis.DoContext(conn, ctx, "LINDEX", "jam:"+roomID+":queue", position))
+	switch {
+	case errors.Is(err, redis.ErrNil):
+		_, err = redis.DoContext(conn, ctx, "RPUSH", "jam:"+roomID+":queue", trackID)
+	case err == nil:
+		_, err = redis.DoContext(conn, ctx, "LINSERT", "jam:"+roomID+":queue", "BEFORE", pivot, trackID)
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.publishQueue(ctx, conn, roomID)
+}
+
+// PopQueue takes the next track off the queue, an empty string means the queue is empty
+func (r *jamRedisRepository) PopQueue(ctx context.Context, roomID string) (string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	trackID, err := redis.String(redis.DoContext(conn, ctx, "LPOP", "jam:"+roomID+":queue"))
+	if errors.Is(err, redis.ErrNil) {
+		return "", nil
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return trackID, r.publishQueue(ctx, conn, roomID)
+}
+
+func (r *jamRedisRepository) SetAutoAdvance(ctx context.Context, roomID string, enabled bool) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+roomID+":settings", "auto_advance", enabled)
+	if err != nil {
+		return err
+	}
+
+	return r.publishQueue(ctx, conn, roomID)
+}
+
+// ClaimTrackEnded lets exactly one of the clients reporting the end of the current track advance
+// the queue. Reports for a track that is no longer current are ignored.
+func (r *jamRedisRepository) ClaimTrackEnded(ctx context.Context, roomID string, trackID string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	autoAdvance, err := redis.Bool(redis.DoContext(conn, ctx, "HGET", "jam:"+roomID+":settings", "auto_advance"))
+	if errors.Is(err, redis.ErrNil) {
+		return false, nil
+	}
+	if err != nil || !autoAdvance {
+		return false, err
+	}
+
+	currentTrackID, err := redis.String(redis.DoContext(conn, ctx, "HGET", "jam:"+roomID+":track", "id"))
+	if errors.Is(err, redis.ErrNil) {
+		return false, nil
+	}
+	if err != nil || currentTrackID != trackID {
+		return false, err
+	}
+
+	_, err = redis.String(redis.DoContext(conn, ctx, "SET", "jam:"+roomID+":ended:"+trackID, "1", "NX", "EX", 10))
+	if errors.Is(err, redis.ErrNil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *jamRedisRepository) isQueued(ctx context.Context, conn redis.Conn, roomID string, trackID string) (bool, error) {
+	_, err := redis.Int(redis.DoContext(conn, ctx, "LPOS", "jam:"+roomID+":queue", trackID))
+	if errors.Is(err, redis.ErrNil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *jamRedisRepository) getQueue(ctx context.Context, conn redis.Conn, roomID string) (*repository.JamQueue, error) {
+	queue, err := redis.Strings(redis.DoContext(conn, ctx, "LRANGE", "jam:"+roomID+":queue", 0, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	scores, err := redis.Strings(redis.DoContext(conn, ctx, "ZREVRANGE", "jam:"+roomID+":suggestions", 0, -1, "WITHSCORES"))
+	if err != nil {
+		return nil, err
+	}
+
+	suggestedBy, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":suggested_by"))
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*repository.JamSuggestion, 0, len(scores)/2)
+	for i := 0; i+1 < len(scores); i += 2 {
+		votes, err := strconv.ParseInt(scores[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		suggestions = append(suggestions, &repository.JamSuggestion{
+			TrackID:     scores[i],
+			Votes:       votes,
+			SuggestedBy: suggestedBy[scores[i]],
+		})
+	}
+
+	autoAdvance, err := redis.Bool(redis.DoContext(conn, ctx, "HGET", "jam:"+roomID+":settings", "auto_advance"))
+	if err != nil && !errors.Is(err, redis.ErrNil) {
+		return nil, err
+	}
+
+	return &repository.JamQueue{
+		Queue:       queue,
+		Suggestions: suggestions,
+		AutoAdvance: autoAdvance,
+	}, nil
+}
+
+func (r *jamRedisRepository) publishQueue(ctx context.Context, conn redis.Conn, roomID string) error {
+	queue, err := r.getQueue(ctx, conn, roomID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:        "queue:updated",
+		Queue:       queue.Queue,
+		Suggestions: queue.Suggestions,
+		AutoAdvance: queue.AutoAdvance,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	return err
+}
+
+func (r *jamRedisRepository) ExistsRoom(ctx context.Context, roomID string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	exists, err := redis.Bool(redis.DoContext(conn, ctx, "EXISTS", "jam:"+roomID+":host"))
+	if err != nil {
+		return false, err
+	}
+	return exists, nil
+}
+
+func (r *jamRedisRepository) SeekJam(ctx context.Context, roomID string, position int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	now := r.now().UnixMilli()
+	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+roomID+":track", "position", position, "anchor", now)
+	if err != nil {
+		return err
+	}
+
+	seekPayload, err := json.Marshal(repository.JamMessage{
+		Type:       "seek",
+		Position:   position,
+		ServerTime: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(seekPayload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *jamRedisRepository) StoreUserInfo(ctx context.Context, roomID string, userID string, username string, avatarURL string, avatarColor string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	_, err = redis.DoContext(conn, ctx, "HMSET", "jam:"+roomID+":userinfo:"+userID,
+		"username", username,
+		"avatar", avatarURL,
+		"avatar_color", avatarColor,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *jamRedisRepository) GetUserInfo(ctx context.Context, roomID string, userID string) (string, string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return "", "", err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	userInfo, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":userinfo:"+userID))
+	if err != nil {
+		return "", "", err
+	}
+
+	username := userInfo["username"]
+	avatarURL := userInfo["avatar"]
+
+	return username, avatarURL, nil
+}
+
+// GetJamAccess reads the join rules set at creation, rooms without them are open to everyone
+func (r *jamRedisRepository) GetJamAccess(ctx context.Context, roomID string) (*repository.JamAccess, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	settings, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":settings"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &repository.JamAccess{
+		Private:      settings["private"] == "1",
+		PasswordHash: settings["password_hash"],
+		InviteToken:  settings["invite_token"],
+	}, nil
+}
+
+func (r *jamRedisRepository) IsUserBanned(ctx context.Context, roomID string, userID string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Bool(redis.DoContext(conn, ctx, "SISMEMBER", "jam:"+roomID+":banned", userID))
+}
+
+// KickUser drops a listener from the room along with any co-host role, ban also keeps them
+// from joining again. The kicked client closes its own socket on user:kicked.
+func (r *jamRedisRepository) KickUser(ctx context.Context, roomID string, userID string, ban bool) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	if ban {
+		_, err = redis.DoContext(conn, ctx, "SADD", "jam:"+roomID+":banned", userID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":users", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":loaded", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZREM", "jam:"+roomID+":joined", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZREM", "jam:"+roomID+":presence", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":cohosts", userID)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "DEL", "jam:"+roomID+":userinfo:"+userID)
+	if err != nil {
+		return err
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:   "user:kicked",
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *jamRedisRepository) UnbanUser(ctx context.Context, roomID string, userID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	_, err = redis.DoContext(conn, ctx, "SREM", "jam:"+roomID+":banned", userID)
+	return err
+}
+
+func (r *jamRedisRepository) IsCoHost(ctx context.Context, roomID string, userID string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Bool(redis.DoContext(conn, ctx, "SISMEMBER", "jam:"+roomID+":cohosts", userID))
+}
+
+// SetCoHost grants or takes away the co-host role, it survives reconnects until the listener is kicked
+func (r *jamRedisRepository) SetCoHost(ctx context.Context, roomID string, userID string, enabled bool) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	command, messageType := "SADD", "cohost:added"
+	if !enabled {
+		command, messageType = "SREM", "cohost:removed"
+	}
+
+	changed, err := redis.Int(redis.DoContext(conn, ctx, command, "jam:"+roomID+":cohosts", userID))
+	if err != nil {
+		return err
+	}
+	if changed == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:   messageType,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Heartbeat moves the room anchor to the position the host reports and broadcasts it,
+// so listeners can correct the drift that builds up between play and seek events
+func (r *jamRedisRepository) Heartbeat(ctx context.Context, roomID string, position int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	now := r.now().UnixMilli()
+	_, err = redis.DoContext(conn, ctx, "HSET", "jam:"+roomID+":track", "position", position, "anchor", now)
+	if err != nil {
+		return err
+	}
+
+	paused, err := redis.Bool(redis.DoContext(conn, ctx, "HGET", "jam:"+roomID+":track", "paused"))
+	if err != nil && !errors.Is(err, redis.ErrNil) {
+		return err
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:       "heartbeat",
+		Position:   position,
+		Paused:     paused,
+		ServerTime: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// playbackPosition is where the track is at the given server time
+func playbackPosition(track map[string]string, now int64) int64 {
+	position, _ := strconv.ParseInt(track["position"], 10, 64)
+	return position + elapsed(track, now)
+}
+
+// listenClock is how long the current track has been playing at the given server time. Unlike the
+// position it does not move on seeks, so listeners are credited with the time they actually listened.
+func listenClock(track map[string]string, now int64) int64 {
+	played, _ := strconv.ParseInt(track["played"], 10, 64)
+	if track["paused"] == "1" {
+		return played
+	}
+	since, err := strconv.ParseInt(track["playing_since"], 10, 64)
+	if err != nil || since <= 0 || since > now {
+		return played
+	}
+	return played + now - since
+}
+
+// elapsed is how far a playing track has moved past the stored position, which is taken at the anchor time
+func elapsed(track map[string]string, now int64) int64 {
+	if track["paused"] == "1" {
+		return 0
+	}
+	anchor, err := strconv.ParseInt(track["anchor"], 10, 64)
+	if err != nil || anchor <= 0 || anchor > now {
+		return 0
+	}
+	return now - anchor
+}
+
+// AllowMessage counts a message of the given kind against a fixed window per user and reports
+// whether it still fits into the limit
+func (r *jamRedisRepository) AllowMessage(ctx context.Context, roomID string, userID string, kind string, limit int64, window time.Duration) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	key := "jam:" + roomID + ":ratelimit:" + kind + ":" + userID
+	count, err := redis.Int64(redis.DoContext(conn, ctx, "INCR", key))
+	if err != nil {
+		return false, err
+	}
+	if count == 1 {
+		_, err = redis.DoContext(conn, ctx, "PEXPIRE", key, window.Milliseconds())
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return count <= limit, nil
+}
+
+// AddChatMessage stores the message in the room history, keeping only the last historySize
+// messages for late joiners, and broadcasts it
+func (r *jamRedisRepository) AddChatMessage(ctx context.Context, roomID string, message *repository.JamChatMessage, historySize int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	message.ServerTime = r.now().UnixMilli()
+
+	if historySize > 0 {
+		entry, err := json.Marshal(message)
+		if err != nil {
+			return err
+		}
+
+		_, err = redis.DoContext(conn, ctx, "RPUSH", "jam:"+roomID+":chat", string(entry))
+		if err != nil {
+			return err
+		}
+
+		_, err = redis.DoContext(conn, ctx, "LTRIM", "jam:"+roomID+":chat", -historySize, -1)
+		if err != nil {
+			return err
+		}
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:       "chat:message",
+		MessageID:  message.ID,
+		UserID:     message.UserID,
+		Text:       message.Text,
+		ServerTime: message.ServerTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *jamRedisRepository) DeleteChatMessage(ctx context.Context, roomID string, messageID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	entries, err := redis.Strings(redis.DoContext(conn, ctx, "LRANGE", "jam:"+roomID+":chat", 0, -1))
+	if err != nil {
+		return err
+	}
+
+	found := false
+	for _, entry := range entries {
+		var message repository.JamChatMessage
+		if err := json.Unmarshal([]byte(entry), &message); err != nil || message.ID != messageID {
+			continue
+		}
+		_, err = redis.DoContext(conn, ctx, "LREM", "jam:"+roomID+":chat", 1, entry)
+		if err != nil {
+			return err
+		}
+		found = true
+		break
+	}
+	if !found {
+		return customErrors.ErrJamChatMessageNotFound
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:      "chat:deleted",
+		MessageID: messageID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// SendReaction broadcasts a reaction to the track that is loaded right now, reactions are not kept
+func (r *jamRedisRepository) SendReaction(ctx context.Context, roomID string, userID string, emoji string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	trackID, err := redis.String(redis.DoContext(conn, ctx, "HGET", "jam:"+roomID+":track", "id"))
+	if err != nil && !errors.Is(err, redis.ErrNil) {
+		return err
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:       "reaction",
+		UserID:     userID,
+		TrackID:    trackID,
+		Emoji:      emoji,
+		ServerTime: r.now().UnixMilli(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *jamRedisRepository) IsUserMuted(ctx context.Context, roomID string, userID string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Bool(redis.DoContext(conn, ctx, "SISMEMBER", "jam:"+roomID+":muted", userID))
+}
+
+func (r *jamRedisRepository) SetUserMuted(ctx context.Context, roomID string, userID string, muted bool) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	command, messageType := "SADD", "user:muted"
+	if !muted {
+		command, messageType = "SREM", "user:unmuted"
+	}
+
+	changed, err := redis.Int(redis.DoContext(conn, ctx, command, "jam:"+roomID+":muted", userID))
+	if err != nil {
+		return err
+	}
+	if changed == 0 {
+		return nil
+	}
+
+	payload, err := json.Marshal(repository.JamMessage{
+		Type:   messageType,
+		UserID: userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", "jam:"+roomID+":pubsub", string(payload))
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *jamRedisRepository) getChat(ctx context.Context, conn redis.Conn, roomID string) ([]*repository.JamChatMessage, error) {
+	entries, err := redis.Strings(redis.DoContext(conn, ctx, "LRANGE", "jam:"+roomID+":chat", 0, -1))
+	if err != nil {
+		return nil, err
+	}
+
+	chat := make([]*repository.JamChatMessage, 0, len(entries))
+	for _, entry := range entries {
+		var message repository.JamChatMessage
+		if err := json.Unmarshal([]byte(entry), &message); err != nil {
+			continue
+		}
+		chat = append(chat, &message)
+	}
+	return chat, nil
+}
+
+// TouchJam pushes back the expiry of every room key. It runs on any activity, so the
+// refresh is throttled to once per quarter of the ttl.
+func (r *jamRedisRepository) TouchJam(ctx context.Context, roomID string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return nil
+	}
+
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	reply, err := redis.DoContext(conn, ctx, "SET", "jam:"+roomID+":touched", "1", "NX", "PX", (ttl / 4).Milliseconds())
+	if err != nil {
+		return err
+	}
+	if reply == nil {
+		return nil
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZADD", "jams:active", r.now().UnixMilli(), roomID)
+	if err != nil {
+		return err
+	}
+
+	keys := []string{"host", "track", "users", "loaded", "joined", "listening", "presence", "queue", "suggestions",
+		"suggested_by", "settings", "banned", "cohosts", "chat", "muted"}
+	for _, key := range keys {
+		_, err = redis.DoContext(conn, ctx, "PEXPIRE", "jam:"+roomID+":"+key, ttl.Milliseconds())
+		if err != nil {
+			return err
+		}
+	}
+
+	users, err := redis.Strings(redis.DoContext(conn, ctx, "SMEMBERS", "jam:"+roomID+":users"))
+	if err != nil {
+		return err
+	}
+	hostID, err := redis.String(redis.DoContext(conn, ctx, "GET", "jam:"+roomID+":host"))
+	if err != nil && !errors.Is(err, redis.ErrNil) {
+		return err
+	}
+	if hostID != "" {
+		users = append(users, hostID)
+	}
+	for _, userID := range users {
+		_, err = redis.DoContext(conn, ctx, "PEXPIRE", "jam:"+roomID+":userinfo:"+userID, ttl.Milliseconds())
+		if err != nil {
+			return err
+		}
+	}
+
+	suggested, err := redis.Strings(redis.DoContext(conn, ctx, "HKEYS", "jam:"+roomID+":suggested_by"))
+	if err != nil {
+		return err
+	}
+	for _, trackID := range suggested {
+		_, err = redis.DoContext(conn, ctx, "PEXPIRE", "jam:"+roomID+":suggestion:"+trackID+":voters", ttl.Milliseconds())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// TouchPresence records that the user's socket is still alive
+func (r *jamRedisRepository) TouchPresence(ctx context.Context, roomID string, userID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	_, err = redis.DoContext(conn, ctx, "ZADD", "jam:"+roomID+":presence", r.now().UnixMilli(), userID)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetStaleJams returns rooms that have not been touched since before
+func (r *jamRedisRepository) GetStaleJams(ctx context.Context, before time.Time) ([]string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Strings(redis.DoContext(conn, ctx, "ZRANGEBYSCORE", "jams:active", "-inf", "("+strconv.FormatInt(before.UnixMilli(), 10)))
+}
+
+// GetActiveJams returns all known rooms, most recently active first
+func (r *jamRedisRepository) GetActiveJams(ctx context.Context) ([]string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Strings(redis.DoContext(conn, ctx, "ZREVRANGE", "jams:active", 0, -1))
+}
+
+// GetStaleUsers returns users whose socket has not reported since before
+func (r *jamRedisRepository) GetStaleUsers(ctx context.Context, roomID string, before time.Time) ([]string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Strings(redis.DoContext(conn, ctx, "ZRANGEBYSCORE", "jam:"+roomID+":presence", "-inf", "("+strconv.FormatInt(before.UnixMilli(), 10)))
+}
+
+func (r *jamRedisRepository) IsHostDisconnected(ctx context.Context, roomID string) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Bool(redis.DoContext(conn, ctx, "EXISTS", "jam:"+roomID+":host:disconnected"))
+}
+
+// GetJamSummaries keeps the order of roomIDs and skips rooms that no longer exist. The rooms
+// and then their hosts are each read in one pipeline, so a page costs two round trips
+func (r *jamRedisRepository) GetJamSummaries(ctx context.Context, roomIDs []string) ([]*repository.JamSummary, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	summaries := make([]*repository.JamSummary, 0, len(roomIDs))
+	if len(roomIDs) == 0 {
+		return summaries, nil
+	}
+
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	for _, roomID := range roomIDs {
+		if err := conn.Send("GET", "jam:"+roomID+":host"); err != nil {
+			return nil, err
+		}
+		if err := conn.Send("HGETALL", "jam:"+roomID+":track"); err != nil {
+			return nil, err
+		}
+		if err := conn.Send("SCARD", "jam:"+roomID+":users"); err != nil {
+			return nil, err
+		}
+		if err := conn.Send("HGETALL", "jam:"+roomID+":settings"); err != nil {
+			return nil, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+
+	for _, roomID := range roomIDs {
+		// every reply of the room has to be read before moving on, even when the room is gone
+		hostID, hostErr := redis.String(redis.ReceiveContext(conn, ctx))
+		track, trackErr := redis.StringMap(redis.ReceiveContext(conn, ctx))
+		listeners, listenersErr := redis.Int64(redis.ReceiveContext(conn, ctx))
+		settings, settingsErr := redis.StringMap(redis.ReceiveContext(conn, ctx))
+		if errors.Is(hostErr, redis.ErrNil) {
+			continue
+		}
+		if err := errors.Join(hostErr, trackErr, listenersErr, settingsErr); err != nil {
+			return nil, err
+		}
+
+		summaries = append(summaries, &repository.JamSummary{
+			RoomID:      roomID,
+			HostID:      hostID,
+			TrackID:     track["id"],
+			Paused:      track["paused"] == "1",
+			Listeners:   listeners,
+			Private:     settings["private"] == "1",
+			HasPassword: settings["password_hash"] != "",
+		})
+	}
+	if len(summaries) == 0 {
+		return summaries, nil
+	}
+
+	for _, summary := range summaries {
+		if err := conn.Send("HGETALL", "jam:"+summary.RoomID+":userinfo:"+summary.HostID); err != nil {
+			return nil, err
+		}
+	}
+	if err := conn.Flush(); err != nil {
+		return nil, err
+	}
+
+	for _, summary := range summaries {
+		hostInfo, err := redis.StringMap(redis.ReceiveContext(conn, ctx))
+		if err != nil {
+			return nil, err
+		}
+		summary.HostName = hostInfo["username"]
+		summary.HostAvatar = hostInfo["avatar"]
+		summary.HostAvatarColor = hostInfo["avatar_color"]
+	}
+
+	return summaries, nil
+}
+
+// AcquireReaperLock makes sure only one gateway sweeps stale rooms per interval
+func (r *jamRedisRepository) AcquireReaperLock(ctx context.Context, ttl time.Duration) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	reply, err := redis.DoContext(conn, ctx, "SET", "jams:reaper:lock", "1", "NX", "PX", ttl.Milliseconds())
+	if err != nil {
+		return false, err
+	}
+
+	return reply != nil, nil
+}
+
+// GetPlayback returns nil when the room no longer exists
+func (r *jamRedisRepository) GetPlayback(ctx context.Context, roomID string) (*repository.JamPlayback, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	hostID, err := redis.String(redis.DoContext(conn, ctx, "GET", "jam:"+roomID+":host"))
+	if errors.Is(err, redis.ErrNil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	track, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":track"))
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := redis.Strings(redis.DoContext(conn, ctx, "SMEMBERS", "jam:"+roomID+":users"))
+	if err != nil {
+		return nil, err
+	}
+
+	marks, err := redis.Int64Map(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":listening"))
+	if err != nil {
+		return nil, err
+	}
+
+	now := r.now().UnixMilli()
+	clock := listenClock(track, now)
+	listened := make(map[string]int64)
+	for _, userID := range append(users, hostID) {
+		if mark, ok := marks[userID]; ok {
+			listened[userID] = clock - mark
+		}
+	}
+
+	return &repository.JamPlayback{
+		TrackID:  track["id"],
+		Position: playbackPosition(track, now),
+		Played:   clock,
+		HostID:   hostID,
+		Listened: listened,
+	}, nil
+}
+
+// StopListening ends the user's listen of the current track and returns how long it lasted,
+// nil when the user was not listening or it has already been counted
+func (r *jamRedisRepository) StopListening(ctx context.Context, roomID string, userID string) (*repository.JamListen, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	track, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", "jam:"+roomID+":track"))
+	if err != nil {
+		return nil, err
+	}
+
+	mark, err := redis.Int64(redis.DoContext(conn, ctx, "HGET", "jam:"+roomID+":listening", userID))
+	if errors.Is(err, redis.ErrNil) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// only the caller that removes the mark counts the listen
+	removed, err := redis.Int64(redis.DoContext(conn, ctx, "HDEL", "jam:"+roomID+":listening", userID))
+	if err != nil {
+		return nil, err
+	}
+	if removed == 0 {
+		return nil, nil
+	}
+
+	return &repository.JamListen{
+		TrackID:  track["id"],
+		Listened: listenClock(track, r.now().UnixMilli()) - mark,
+	}, nil
 }
@@ -0,0 +1,168 @@
+//go:build loadtest
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/gomodule/redigo/redis"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Run against a real Redis with
+//
+//	REDIS_EXTERNAL_HOST=localhost REDIS_PORT=6379 go test -tags loadtest -run Load ./internal/pkg/jam/repository/
+//
+// Every repository below stands for one gateway replica: its own pool and its own subscriptions.
+
+const (
+	loadGateways          = 4
+	loadSocketsPerGateway = 25
+	loadRounds            = 20
+)
+
+func loadRedisPool(t *testing.T) *redis.Pool {
+	t.Helper()
+
+	host, port := os.Getenv("REDIS_EXTERNAL_HOST"), os.Getenv("REDIS_PORT")
+	if host == "" || port == "" {
+		t.Skip("REDIS_EXTERNAL_HOST and REDIS_PORT are not set")
+	}
+	address := host + ":" + port
+
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return redis.Dial("tcp", address)
+		},
+	}
+	conn := pool.Get()
+	defer conn.Close()
+	if _, err := conn.Do("PING"); err != nil {
+		t.Skipf("redis at %s is not reachable: %v", address, err)
+	}
+
+	return pool
+}
+
+func newLoadGateways(t *testing.T) []*jamRedisRepository {
+	gateways := make([]*jamRedisRepository, loadGateways)
+	for i := range gateways {
+		gateways[i] = NewJamRedisRepository(loadRedisPool(t)).(*jamRedisRepository)
+	}
+	return gateways
+}
+
+func numSub(t *testing.T, repo *jamRedisRepository, roomID string) int64 {
+	conn := repo.redisPool.Get()
+	defer conn.Close()
+
+	reply, err := redis.Values(conn.Do("PUBSUB", "NUMSUB", "jam:"+roomID+":pubsub"))
+	require.NoError(t, err)
+	count, err := redis.Int64(reply[1], nil)
+	require.NoError(t, err)
+	return count
+}
+
+// collectPlays counts play messages on every socket until the room goes quiet
+func collectPlays(sockets []<-chan []byte, quiet time.Duration) []int {
+	plays := make([]int, len(sockets))
+	var wg sync.WaitGroup
+	for i, socket := range sockets {
+		wg.Add(1)
+		go func(i int, socket <-chan []byte) {
+			defer wg.Done()
+			for {
+				select {
+				case data, ok := <-socket:
+					if !ok {
+						return
+					}
+					var message repository.JamMessage
+					if json.Unmarshal(data, &message) == nil && message.Type == "play" {
+						plays[i]++
+					}
+				case <-time.After(quiet):
+					return
+				}
+			}
+		}(i, socket)
+	}
+	wg.Wait()
+	return plays
+}
+
+func TestLoad_MultiGatewayReadyEmitsOnePlay(t *testing.T) {
+	gateways := newLoadGateways(t)
+	ctx, cancel := context.WithCancel(setupTestContext())
+	defer cancel()
+
+	created, err := gateways[0].CreateJam(ctx, &repository.CreateJamRequest{UserID: "host", TrackID: "track0"})
+	require.NoError(t, err)
+	roomID := created.RoomID
+	defer func() {
+		require.NoError(t, gateways[0].RemoveJam(context.WithoutCancel(ctx), roomID))
+	}()
+
+	listeners := make([]string, 0, loadGateways*loadSocketsPerGateway)
+	sockets := make([]<-chan []byte, 0, loadGateways*loadSocketsPerGateway)
+	for g, gateway := range gateways {
+		for s := 0; s < loadSocketsPerGateway; s++ {
+			userID := fmt.Sprintf("user-%d-%d", g, s)
+			require.NoError(t, gateway.AddUser(ctx, roomID, userID))
+			listeners = append(listeners, userID)
+
+			socket, err := gateway.SubscribeToJamMessages(ctx, roomID)
+			require.NoError(t, err)
+			sockets = append(sockets, socket)
+		}
+	}
+
+	assert.Equal(t, int64(loadGateways), numSub(t, gateways[0], roomID), "one subscription per gateway")
+	for _, gateway := range gateways {
+		assert.Equal(t, 1, roomCount(gateway))
+	}
+
+	// drop the join announcements
+	collectPlays(sockets, 200*time.Millisecond)
+
+	for round := 0; round < loadRounds; round++ {
+		// a round sends more messages than a socket buffers, so they are read as they come
+		collected := make(chan []int)
+		go func() {
+			collected <- collectPlays(sockets, 500*time.Millisecond)
+		}()
+
+		require.NoError(t, gateways[0].LoadTrack(ctx, roomID, "track"+strconv.Itoa(round+1)))
+
+		// every listener reports ready through its own gateway at the same time, and each
+		// of them checks whether the room can start
+		var wg sync.WaitGroup
+		for i, userID := range listeners {
+			wg.Add(1)
+			go func(gateway *jamRedisRepository, userID string) {
+				defer wg.Done()
+				assert.NoError(t, gateway.MarkUserAsReady(ctx, roomID, userID))
+				gateway.CheckAllReadyAndPlay(ctx, roomID)
+			}(gateways[i/loadSocketsPerGateway], userID)
+		}
+		wg.Wait()
+
+		for i, plays := range <-collected {
+			assert.Equal(t, 1, plays, "round %d, socket %d", round, i)
+		}
+	}
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		return numSub(t, gateways[0], roomID) == 0
+	}, 5*time.Second, 50*time.Millisecond, "subscriptions are closed with the last socket")
+}
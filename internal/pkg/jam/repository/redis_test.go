@@ -2,9 +2,13 @@ package redis
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"strconv"
 	"testing"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
 	"github.com/gomodule/redigo/redis"
@@ -14,6 +18,8 @@ import (
 	"go.uber.org/zap"
 )
 
+var testNow = time.UnixMilli(1700000000000)
+
 func setupMockRedis() (*jamRedisRepository, *redigomock.Conn) {
 	conn := redigomock.NewConn()
 	pool := &redis.Pool{
@@ -21,7 +27,7 @@ func setupMockRedis() (*jamRedisRepository, *redigomock.Conn) {
 			return conn, nil
 		},
 	}
-	repo := &jamRedisRepository{redisPool: pool}
+	repo := &jamRedisRepository{redisPool: pool, now: func() time.Time { return testNow }, subscriptions: newSubscriptionHub()}
 	return repo, conn
 }
 
@@ -30,24 +36,43 @@ func setupTestContext() context.Context {
 	return loggerPkg.LoggerToContext(context.Background(), logger)
 }
 
+// expectQueue registers the reads behind the queue state that is sent with init and queue:updated
+func expectQueue(mockConn *redigomock.Conn, roomID string) {
+	mockConn.Command("LRANGE", "jam:"+roomID+":queue", 0, -1).Expect([]interface{}{[]byte("track1")})
+	mockConn.Command("ZREVRANGE", "jam:"+roomID+":suggestions", 0, -1, "WITHSCORES").
+		Expect([]interface{}{[]byte("track2"), []byte("2"), []byte("track3"), []byte("1")})
+	mockConn.Command("HGETALL", "jam:"+roomID+":suggested_by").
+		Expect([]interface{}{[]byte("track2"), []byte("user1"), []byte("track3"), []byte("user2")})
+	mockConn.Command("HGET", "jam:"+roomID+":settings", "auto_advance").Expect([]byte("1"))
+}
+
 func TestCreateJam(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	request := &repository.CreateJamRequest{
-		UserID:   "user123",
-		TrackID:  "track456",
-		Position: 1000,
+		UserID:       "user123",
+		TrackID:      "track456",
+		Position:     1000,
+		Private:      true,
+		PasswordHash: "hash",
+		InviteToken:  "token",
+		MaxListeners: 5,
 	}
 
 	mockConn.Command("SET", redigomock.NewAnyData(), "user123").Expect("OK")
 	mockConn.Command("HMSET", redigomock.NewAnyData(), "id", "track456", "position", int64(1000), "paused", 1).Expect("OK")
+	listening := mockConn.Command("HSET", redigomock.NewAnyData(), "user123", 0).Expect(int64(1))
+	settings := mockConn.Command("HSET", redigomock.NewAnyData(),
+		"private", 1, "password_hash", "hash", "invite_token", "token", "max_listeners", int64(5)).Expect(int64(4))
 
 	response, err := repo.CreateJam(ctx, request)
 
 	require.NoError(t, err)
 	assert.NotEmpty(t, response.RoomID)
 	assert.Equal(t, "user123", response.HostID)
+	assert.Equal(t, 1, mockConn.Stats(listening))
+	assert.Equal(t, 1, mockConn.Stats(settings))
 }
 
 func TestCreateJam_SetError(t *testing.T) {
@@ -94,41 +119,77 @@ func TestAddUser(t *testing.T) {
 	roomID := "room123"
 	userID := "user456"
 
-	mockConn.Command("SADD", "jam:"+roomID+":users", userID).Expect(int64(1))
+	mockConn.Script([]byte(addListenerSource), 2, "jam:"+roomID+":users", "jam:"+roomID+":settings", userID).Expect(int64(1))
+	mockConn.Command("ZADD", "jam:"+roomID+":joined", "NX", redigomock.NewAnyInt(), userID).Expect(int64(1))
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{
+		[]byte("paused"), []byte("0"),
+		[]byte("played"), []byte("4000"),
+		[]byte("playing_since"), []byte("1699999997000"),
+	})
+	listening := mockConn.Command("HSETNX", "jam:"+roomID+":listening", userID, int64(7000)).Expect(int64(1))
 	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:"+userID).Expect([]interface{}{})
 	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
 	err := repo.AddUser(ctx, roomID, userID)
 
 	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(listening))
 }
 
-func TestAddUser_SADDError(t *testing.T) {
+func TestAddUser_ScriptError(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
 	userID := "user456"
 
-	mockConn.Command("SADD", "jam:"+roomID+":users", userID).ExpectError(errors.New("redis error"))
+	mockConn.Script([]byte(addListenerSource), 2, "jam:"+roomID+":users", "jam:"+roomID+":settings", userID).ExpectError(errors.New("redis error"))
 
 	err := repo.AddUser(ctx, roomID, userID)
 
 	assert.Error(t, err)
 }
 
+func TestAddUser_Full(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	userID := "user456"
+
+	mockConn.Script([]byte(addListenerSource), 2, "jam:"+roomID+":users", "jam:"+roomID+":settings", userID).Expect(int64(0))
+	userInfo := mockConn.Command("DEL", "jam:"+roomID+":userinfo:"+userID).Expect(int64(1))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
+
+	err := repo.AddUser(ctx, roomID, userID)
+
+	assert.ErrorIs(t, err, customErrors.ErrJamFull)
+	assert.Equal(t, 1, mockConn.Stats(userInfo))
+	assert.Equal(t, 0, mockConn.Stats(publish))
+}
+
 func TestPauseJam(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
 
-	mockConn.Command("HSET", "jam:"+roomID+":track", "paused", true).Expect(int64(1))
-	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
+	now := testNow.UnixMilli()
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{
+		[]byte("paused"), []byte("0"),
+		[]byte("position"), []byte("1000"),
+		[]byte("anchor"), []byte("1699999998000"),
+		[]byte("played"), []byte("500"),
+		[]byte("playing_since"), []byte("1699999999000"),
+	})
+	mockConn.Command("HSET", "jam:"+roomID+":track", "paused", true, "position", int64(3000), "anchor", now,
+		"played", int64(1500)).Expect(int64(1))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"pause","position":3000,"server_time":1700000000000}`).Expect(int64(1))
 
 	err := repo.PauseJam(ctx, roomID)
 
 	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(publish))
 }
 
 func TestPauseJam_HSETError(t *testing.T) {
@@ -137,7 +198,9 @@ func TestPauseJam_HSETError(t *testing.T) {
 
 	roomID := "room123"
 
-	mockConn.Command("HSET", "jam:"+roomID+":track", "paused", true).ExpectError(errors.New("redis error"))
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{})
+	mockConn.Command("HSET", "jam:"+roomID+":track", "paused", true, "position", int64(0), "anchor", testNow.UnixMilli(),
+		"played", int64(0)).ExpectError(errors.New("redis error"))
 
 	err := repo.PauseJam(ctx, roomID)
 
@@ -172,6 +235,14 @@ func TestGetInitialJamData(t *testing.T) {
 	user2Info := []interface{}{[]byte("username"), []byte("user2name"), []byte("avatar"), []byte("user2_avatar.jpg")}
 	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:user2").Expect(user2Info)
 
+	expectQueue(mockConn, roomID)
+	mockConn.Command("SMEMBERS", "jam:"+roomID+":cohosts").Expect([]interface{}{[]byte("user2")})
+	mockConn.Command("LRANGE", "jam:"+roomID+":chat", 0, -1).Expect([]interface{}{
+		[]byte(`{"message_id":"msg1","user_id":"user1","text":"hello","server_time":1699999999000}`),
+		[]byte("broken"),
+	})
+	mockConn.Command("SMEMBERS", "jam:"+roomID+":muted").Expect([]interface{}{[]byte("user1")})
+
 	result, err := repo.GetInitialJamData(ctx, roomID)
 
 	require.NoError(t, err)
@@ -189,6 +260,18 @@ func TestGetInitialJamData(t *testing.T) {
 	assert.Equal(t, "host_avatar.jpg", result.UserImages["host123"])
 	assert.Equal(t, "user1_avatar.jpg", result.UserImages["user1"])
 	assert.Equal(t, "user2_avatar.jpg", result.UserImages["user2"])
+	assert.Equal(t, []string{"track1"}, result.Queue)
+	assert.Equal(t, []*repository.JamSuggestion{
+		{TrackID: "track2", Votes: 2, SuggestedBy: "user1"},
+		{TrackID: "track3", Votes: 1, SuggestedBy: "user2"},
+	}, result.Suggestions)
+	assert.True(t, result.AutoAdvance)
+	assert.Equal(t, []string{"user2"}, result.CoHosts)
+	assert.Equal(t, testNow.UnixMilli(), result.ServerTime)
+	assert.Equal(t, []*repository.JamChatMessage{
+		{ID: "msg1", UserID: "user1", Text: "hello", ServerTime: 1699999999000},
+	}, result.Chat)
+	assert.Equal(t, []string{"user1"}, result.Muted)
 }
 
 func TestGetInitialJamData_TrackError(t *testing.T) {
@@ -240,11 +323,14 @@ func TestCheckAllReadyAndPlay_AllReady(t *testing.T) {
 
 	roomID := "room123"
 
-	mockConn.Command("SCARD", "jam:"+roomID+":users").Expect(int64(2))
-	mockConn.Command("SCARD", "jam:"+roomID+":loaded").Expect(int64(2))
-	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
+	play := mockConn.Script([]byte(playWhenReadySource), 3,
+		"jam:"+roomID+":users", "jam:"+roomID+":loaded", "jam:"+roomID+":track", testNow.UnixMilli()).Expect(int64(1000))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"play","position":1000,"server_time":1700000000000}`).Expect(int64(1))
 
 	repo.CheckAllReadyAndPlay(ctx, roomID)
+
+	assert.Equal(t, 1, mockConn.Stats(play))
+	assert.Equal(t, 1, mockConn.Stats(publish))
 }
 
 func TestCheckAllReadyAndPlay_NotAllReady(t *testing.T) {
@@ -253,10 +339,13 @@ func TestCheckAllReadyAndPlay_NotAllReady(t *testing.T) {
 
 	roomID := "room123"
 
-	mockConn.Command("SCARD", "jam:"+roomID+":users").Expect(int64(3))
-	mockConn.Command("SCARD", "jam:"+roomID+":loaded").Expect(int64(2))
+	mockConn.Script([]byte(playWhenReadySource), 3,
+		"jam:"+roomID+":users", "jam:"+roomID+":loaded", "jam:"+roomID+":track", testNow.UnixMilli()).Expect(nil)
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
 	repo.CheckAllReadyAndPlay(ctx, roomID)
+
+	assert.Equal(t, 0, mockConn.Stats(publish))
 }
 
 func TestLoadTrack(t *testing.T) {
@@ -266,15 +355,43 @@ func TestLoadTrack(t *testing.T) {
 	roomID := "room123"
 	trackID := "track456"
 
-	mockConn.Command("HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1).Expect("OK")
-	mockConn.Command("DEL", "jam:"+roomID+":loaded").Expect(int64(1))
+	mockConn.Command("HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1, "played", 0).Expect("OK")
+	mockConn.Command("DEL", "jam:"+roomID+":loaded", "jam:"+roomID+":listening").Expect(int64(2))
+	mockConn.Command("SMEMBERS", "jam:"+roomID+":users").Expect([]interface{}{[]byte("user1")})
+	mockConn.Command("GET", "jam:"+roomID+":host").Expect([]byte("host1"))
+	listening := mockConn.Command("HSET", "jam:"+roomID+":listening", "user1", 0, "host1", 0).Expect(int64(2))
 	mockConn.Command("EXISTS", "jam:"+roomID+":loadmessage:"+trackID).Expect(int64(0))
 	mockConn.Command("SETEX", "jam:"+roomID+":loadmessage:"+trackID, 10, "1").Expect("OK")
 	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
-	err := repo.LoadTrack(ctx, roomID, trackID)
+	err := repo.LoadTrack(ctx, roomID, trackID, nil)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(listening))
+}
+
+func TestLoadTrack_PublishesLyrics(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	trackID := "42"
+	lyrics := []*repository.JamLyrics{{Language: "en", Synced: true, Lines: []*repository.JamLyricsLine{{Time: 1000, Text: "Hello"}}}}
+	payload, _ := json.Marshal(repository.JamMessage{Type: "load", TrackID: trackID, Lyrics: lyrics})
+
+	mockConn.Command("HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1, "played", 0).Expect("OK")
+	mockConn.Command("DEL", "jam:"+roomID+":loaded", "jam:"+roomID+":listening").Expect(int64(1))
+	mockConn.Command("SMEMBERS", "jam:"+roomID+":users").Expect([]interface{}{})
+	mockConn.Command("GET", "jam:"+roomID+":host").Expect([]byte("host1"))
+	mockConn.Command("HSET", "jam:"+roomID+":listening", "host1", 0).Expect(int64(1))
+	mockConn.Command("EXISTS", "jam:"+roomID+":loadmessage:"+trackID).Expect(int64(0))
+	mockConn.Command("SETEX", "jam:"+roomID+":loadmessage:"+trackID, 10, "1").Expect("OK")
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", string(payload)).Expect(int64(1))
+
+	err := repo.LoadTrack(ctx, roomID, trackID, lyrics)
 
 	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(publish))
 }
 
 func TestLoadTrack_LoadMessageExists(t *testing.T) {
@@ -284,11 +401,14 @@ func TestLoadTrack_LoadMessageExists(t *testing.T) {
 	roomID := "room123"
 	trackID := "track456"
 
-	mockConn.Command("HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1).Expect("OK")
-	mockConn.Command("DEL", "jam:"+roomID+":loaded").Expect(int64(1))
+	mockConn.Command("HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1, "played", 0).Expect("OK")
+	mockConn.Command("DEL", "jam:"+roomID+":loaded", "jam:"+roomID+":listening").Expect(int64(1))
+	mockConn.Command("SMEMBERS", "jam:"+roomID+":users").Expect([]interface{}{})
+	mockConn.Command("GET", "jam:"+roomID+":host").Expect([]byte("host1"))
+	mockConn.Command("HSET", "jam:"+roomID+":listening", "host1", 0).Expect(int64(1))
 	mockConn.Command("EXISTS", "jam:"+roomID+":loadmessage:"+trackID).Expect(int64(1))
 
-	err := repo.LoadTrack(ctx, roomID, trackID)
+	err := repo.LoadTrack(ctx, roomID, trackID, nil)
 
 	assert.NoError(t, err)
 }
@@ -300,9 +420,9 @@ func TestLoadTrack_HMSETError(t *testing.T) {
 	roomID := "room123"
 	trackID := "track456"
 
-	mockConn.Command("HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1).ExpectError(errors.New("redis error"))
+	mockConn.Command("HMSET", "jam:"+roomID+":track", "id", trackID, "position", 0, "paused", 1, "played", 0).ExpectError(errors.New("redis error"))
 
-	err := repo.LoadTrack(ctx, roomID, trackID)
+	err := repo.LoadTrack(ctx, roomID, trackID, nil)
 
 	assert.Error(t, err)
 }
@@ -348,12 +468,18 @@ func TestRemoveUser(t *testing.T) {
 	userID := "user456"
 
 	mockConn.Command("SREM", "jam:"+roomID+":users", userID).Expect(int64(1))
+	loaded := mockConn.Command("SREM", "jam:"+roomID+":loaded", userID).Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":joined", userID).Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":presence", userID).Expect(int64(1))
+	cohosts := mockConn.Command("SREM", "jam:"+roomID+":cohosts", userID).Expect(int64(1))
 	mockConn.Command("DEL", "jam:"+roomID+":userinfo:"+userID).Expect(int64(1))
 	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
 	err := repo.RemoveUser(ctx, roomID, userID)
 
 	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(loaded))
+	assert.Equal(t, 1, mockConn.Stats(cohosts))
 }
 
 func TestRemoveUser_SREMError(t *testing.T) {
@@ -386,6 +512,13 @@ func TestRemoveJam(t *testing.T) {
 	mockConn.Command("DEL", "jam:"+roomID+":track").Expect(int64(1))
 	mockConn.Command("DEL", "jam:"+roomID+":users").Expect(int64(1))
 	mockConn.Command("DEL", "jam:"+roomID+":loaded").Expect(int64(1))
+	mockConn.Command("DEL", "jam:"+roomID+":joined", "jam:"+roomID+":host:disconnected", "jam:"+roomID+":listening").Expect(int64(1))
+	mockConn.Command("HKEYS", "jam:"+roomID+":suggested_by").Expect([]interface{}{[]byte("track2")})
+	mockConn.Command("DEL", "jam:"+roomID+":suggestion:track2:voters").Expect(int64(1))
+	mockConn.Command("DEL", "jam:"+roomID+":queue", "jam:"+roomID+":suggestions", "jam:"+roomID+":suggested_by", "jam:"+roomID+":settings",
+		"jam:"+roomID+":banned", "jam:"+roomID+":cohosts", "jam:"+roomID+":chat", "jam:"+roomID+":muted",
+		"jam:"+roomID+":presence", "jam:"+roomID+":touched").Expect(int64(4))
+	mockConn.Command("ZREM", "jams:active", roomID).Expect(int64(1))
 	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 	mockConn.Command("DEL", "jam:"+roomID+":pubsub").Expect(int64(1))
 
@@ -410,225 +543,1104 @@ func TestRemoveJam_DELError(t *testing.T) {
 	assert.Error(t, err)
 }
 
-func TestExistsRoom(t *testing.T) {
+func TestGetLongestPresentUser(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZRANGE", "jam:room123:joined", 0, 0).Expect([]interface{}{[]byte("user1")})
+
+	userID, err := repo.GetLongestPresentUser(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.Equal(t, "user1", userID)
+}
+
+func TestGetLongestPresentUser_EmptyRoom(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZRANGE", "jam:room123:joined", 0, 0).Expect([]interface{}{})
+
+	userID, err := repo.GetLongestPresentUser(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.Empty(t, userID)
+}
+
+func TestTransferHost(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
 
-	mockConn.Command("EXISTS", "jam:"+roomID+":host").Expect(int64(1))
+	mockConn.Command("SET", "jam:"+roomID+":host", "user1").Expect("OK")
+	mockConn.Command("SREM", "jam:"+roomID+":users", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":loaded", "user1").Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":joined", "user1").Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":presence", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":cohosts", "user1").Expect(int64(0))
+	mockConn.Command("DEL", "jam:"+roomID+":host:disconnected").Expect(int64(0))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
-	exists, err := repo.ExistsRoom(ctx, roomID)
+	err := repo.TransferHost(ctx, roomID, "host123", "user1")
 
 	require.NoError(t, err)
-	assert.True(t, exists)
+	assert.Equal(t, 1, mockConn.Stats(publish))
 }
 
-func TestExistsRoom_NotExists(t *testing.T) {
+func TestTransferHost_SETError(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("SET", "jam:room123:host", "user1").ExpectError(errors.New("redis error"))
+
+	err := repo.TransferHost(ctx, "room123", "host123", "user1")
+
+	assert.Error(t, err)
+}
+
+func TestMarkHostDisconnected(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("SET", "jam:room123:host:disconnected", "token", "PX", int64(60000)).Expect("OK")
+	mockConn.Command("PUBLISH", "jam:room123:pubsub", redigomock.NewAnyData()).Expect(int64(1))
+
+	err := repo.MarkHostDisconnected(ctx, "room123", "host123", "token", time.Minute)
+
+	assert.NoError(t, err)
+}
+
+func TestClearHostDisconnected_NotDisconnected(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("DEL", "jam:room123:host:disconnected").Expect(int64(0))
+	publish := mockConn.Command("PUBLISH", "jam:room123:pubsub", redigomock.NewAnyData()).Expect(int64(1))
+
+	err := repo.ClearHostDisconnected(ctx, "room123", "host123")
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, mockConn.Stats(publish))
+}
+
+func TestConsumeHostDisconnected(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "jam:room123:host:disconnected").Expect([]byte("token"))
+	mockConn.Command("DEL", "jam:room123:host:disconnected").Expect(int64(1))
+
+	expired, err := repo.ConsumeHostDisconnected(ctx, "room123", "token")
+
+	require.NoError(t, err)
+	assert.True(t, expired)
+}
+
+func TestConsumeHostDisconnected_OtherToken(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "jam:room123:host:disconnected").Expect([]byte("newer"))
+
+	expired, err := repo.ConsumeHostDisconnected(ctx, "room123", "token")
+
+	require.NoError(t, err)
+	assert.False(t, expired)
+}
+
+func TestConsumeHostDisconnected_Reconnected(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "jam:room123:host:disconnected").Expect(nil)
+
+	expired, err := repo.ConsumeHostDisconnected(ctx, "room123", "token")
+
+	require.NoError(t, err)
+	assert.False(t, expired)
+}
+
+func TestSuggestTrack(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
 
-	mockConn.Command("EXISTS", "jam:"+roomID+":host").Expect(int64(0))
+	mockConn.Command("LPOS", "jam:"+roomID+":queue", "track2").Expect(nil)
+	mockConn.Script([]byte(claimSuggestionSource), 1, "jam:"+roomID+":suggested_by", "track2", "user1", int64(50), int64(5)).Expect(int64(1))
+	mockConn.Command("SADD", "jam:"+roomID+":suggestion:track2:voters", "user1").Expect(int64(1))
+	incr := mockConn.Command("ZINCRBY", "jam:"+roomID+":suggestions", 1, "track2").Expect([]byte("1"))
+	expectQueue(mockConn, roomID)
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
-	exists, err := repo.ExistsRoom(ctx, roomID)
+	err := repo.SuggestTrack(ctx, roomID, "user1", "track2", 50, 5)
 
 	require.NoError(t, err)
-	assert.False(t, exists)
+	assert.Equal(t, 1, mockConn.Stats(incr))
+	assert.Equal(t, 1, mockConn.Stats(publish))
 }
 
-func TestExistsRoom_Error(t *testing.T) {
+func TestSuggestTrack_AlreadyQueued(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("LPOS", "jam:room123:queue", "track1").Expect(int64(0))
+
+	err := repo.SuggestTrack(ctx, "room123", "user1", "track1", 50, 5)
+
+	assert.NoError(t, err)
+}
+
+func TestSuggestTrack_Limits(t *testing.T) {
+	tests := []struct {
+		name     string
+		claimed  int64
+		expected error
+	}{
+		{"Room", -1, customErrors.ErrJamTooManySuggestions},
+		{"User", -2, customErrors.ErrJamUserSuggestionLimit},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mockConn := setupMockRedis()
+			ctx := setupTestContext()
+
+			mockConn.Command("LPOS", "jam:room123:queue", "track2").Expect(nil)
+			mockConn.Script([]byte(claimSuggestionSource), 1, "jam:room123:suggested_by", "track2", "user1", int64(50), int64(5)).Expect(tt.claimed)
+			incr := mockConn.Command("ZINCRBY", "jam:room123:suggestions", 1, "track2").Expect([]byte("1"))
+
+			err := repo.SuggestTrack(ctx, "room123", "user1", "track2", 50, 5)
+
+			assert.ErrorIs(t, err, tt.expected)
+			assert.Equal(t, 0, mockConn.Stats(incr))
+		})
+	}
+}
+
+func TestVoteTrack_AlreadyVoted(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
 
-	mockConn.Command("EXISTS", "jam:"+roomID+":host").ExpectError(errors.New("redis error"))
+	mockConn.Command("HEXISTS", "jam:"+roomID+":suggested_by", "track2").Expect(int64(1))
+	mockConn.Command("SADD", "jam:"+roomID+":suggestion:track2:voters", "user1").Expect(int64(0))
+	incr := mockConn.Command("ZINCRBY", "jam:"+roomID+":suggestions", 1, "track2").Expect([]byte("2"))
 
-	exists, err := repo.ExistsRoom(ctx, roomID)
+	err := repo.VoteTrack(ctx, roomID, "user1", "track2")
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, mockConn.Stats(incr))
+}
+
+func TestVoteTrack_NotSuggested(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("HEXISTS", "jam:room123:suggested_by", "track2").Expect(int64(0))
+
+	err := repo.VoteTrack(ctx, "room123", "user1", "track2")
 
 	assert.Error(t, err)
-	assert.False(t, exists)
 }
 
-func TestSeekJam(t *testing.T) {
+func TestApproveSuggestion(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
-	position := int64(5000)
 
-	mockConn.Command("HSET", "jam:"+roomID+":track", "position", position).Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":suggestions", "track2").Expect(int64(1))
+	mockConn.Command("HDEL", "jam:"+roomID+":suggested_by", "track2").Expect(int64(1))
+	mockConn.Command("DEL", "jam:"+roomID+":suggestion:track2:voters").Expect(int64(1))
+	push := mockConn.Command("RPUSH", "jam:"+roomID+":queue", "track2").Expect(int64(2))
+	expectQueue(mockConn, roomID)
 	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
-	err := repo.SeekJam(ctx, roomID, position)
+	err := repo.ApproveSuggestion(ctx, roomID, "track2")
 
-	assert.NoError(t, err)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(push))
 }
 
-func TestSeekJam_HSETError(t *testing.T) {
+func TestApproveSuggestion_NotSuggested(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
-	roomID := "room123"
-	position := int64(5000)
-
-	mockConn.Command("HSET", "jam:"+roomID+":track", "position", position).ExpectError(errors.New("redis error"))
+	mockConn.Command("ZREM", "jam:room123:suggestions", "track2").Expect(int64(0))
 
-	err := repo.SeekJam(ctx, roomID, position)
+	err := repo.ApproveSuggestion(ctx, "room123", "track2")
 
 	assert.Error(t, err)
 }
 
-func TestStoreUserInfo(t *testing.T) {
+func TestMoveQueueTrack(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
-	userID := "user456"
-	username := "testuser"
-	avatarURL := "avatar.jpg"
 
-	mockConn.Command("HMSET", "jam:"+roomID+":userinfo:"+userID, "username", username, "avatar", avatarURL).Expect("OK")
+	mockConn.Command("LREM", "jam:"+roomID+":queue", 1, "track3").Expect(int64(1))
+	mockConn.Command("LINDEX", "jam:"+roomID+":queue", int64(0)).Expect([]byte("track1"))
+	insert := mockConn.Command("LINSERT", "jam:"+roomID+":queue", "BEFORE", "track1", "track3").Expect(int64(3))
+	expectQueue(mockConn, roomID)
+	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
-	err := repo.StoreUserInfo(ctx, roomID, userID, username, avatarURL)
+	err := repo.MoveQueueTrack(ctx, roomID, "track3", 0)
 
-	assert.NoError(t, err)
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(insert))
 }
 
-func TestStoreUserInfo_Error(t *testing.T) {
+func TestMoveQueueTrack_PastEnd(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
-	userID := "user456"
-	username := "testuser"
-	avatarURL := "avatar.jpg"
 
-	mockConn.Command("HMSET", "jam:"+roomID+":userinfo:"+userID, "username", username, "avatar", avatarURL).ExpectError(errors.New("redis error"))
+	mockConn.Command("LREM", "jam:"+roomID+":queue", 1, "track3").Expect(int64(1))
+	mockConn.Command("LINDEX", "jam:"+roomID+":queue", int64(10)).Expect(nil)
+	push := mockConn.Command("RPUSH", "jam:"+roomID+":queue", "track3").Expect(int64(3))
+	expectQueue(mockConn, roomID)
+	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
+
+	err := repo.MoveQueueTrack(ctx, roomID, "track3", 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(push))
+}
+
+func TestMoveQueueTrack_NotQueued(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("LREM", "jam:room123:queue", 1, "track3").Expect(int64(0))
 
-	err := repo.StoreUserInfo(ctx, roomID, userID, username, avatarURL)
+	err := repo.MoveQueueTrack(ctx, "room123", "track3", 0)
 
 	assert.Error(t, err)
 }
 
-func TestGetUserInfo(t *testing.T) {
+func TestPopQueue(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
-	userID := "user456"
-	expectedUsername := "testuser"
-	expectedAvatarURL := "avatar.jpg"
 
-	userInfo := []interface{}{[]byte("username"), []byte(expectedUsername), []byte("avatar"), []byte(expectedAvatarURL)}
-	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:"+userID).Expect(userInfo)
+	mockConn.Command("LPOP", "jam:"+roomID+":queue").Expect([]byte("track1"))
+	expectQueue(mockConn, roomID)
+	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
 
-	username, avatarURL, err := repo.GetUserInfo(ctx, roomID, userID)
+	trackID, err := repo.PopQueue(ctx, roomID)
 
 	require.NoError(t, err)
-	assert.Equal(t, expectedUsername, username)
-	assert.Equal(t, expectedAvatarURL, avatarURL)
+	assert.Equal(t, "track1", trackID)
 }
 
-func TestGetUserInfo_EmptyResult(t *testing.T) {
+func TestPopQueue_Empty(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("LPOP", "jam:room123:queue").Expect(nil)
+
+	trackID, err := repo.PopQueue(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.Empty(t, trackID)
+}
+
+func TestClaimTrackEnded(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
-	userID := "user456"
 
-	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:"+userID).Expect([]interface{}{})
+	mockConn.Command("HGET", "jam:"+roomID+":settings", "auto_advance").Expect([]byte("1"))
+	mockConn.Command("HGET", "jam:"+roomID+":track", "id").Expect([]byte("track1"))
+	mockConn.Command("SET", "jam:"+roomID+":ended:track1", "1", "NX", "EX", 10).Expect("OK")
 
-	username, avatarURL, err := repo.GetUserInfo(ctx, roomID, userID)
+	claimed, err := repo.ClaimTrackEnded(ctx, roomID, "track1")
 
 	require.NoError(t, err)
-	assert.Empty(t, username)
-	assert.Empty(t, avatarURL)
+	assert.True(t, claimed)
 }
 
-func TestGetUserInfo_Error(t *testing.T) {
+func TestClaimTrackEnded_AlreadyClaimed(t *testing.T) {
 	repo, mockConn := setupMockRedis()
 	ctx := setupTestContext()
 
 	roomID := "room123"
-	userID := "user456"
 
-	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:"+userID).ExpectError(errors.New("redis error"))
+	mockConn.Command("HGET", "jam:"+roomID+":settings", "auto_advance").Expect([]byte("1"))
+	mockConn.Command("HGET", "jam:"+roomID+":track", "id").Expect([]byte("track1"))
+	mockConn.Command("SET", "jam:"+roomID+":ended:track1", "1", "NX", "EX", 10).Expect(nil)
 
-	username, avatarURL, err := repo.GetUserInfo(ctx, roomID, userID)
+	claimed, err := repo.ClaimTrackEnded(ctx, roomID, "track1")
 
-	assert.Error(t, err)
-	assert.Empty(t, username)
-	assert.Empty(t, avatarURL)
+	require.NoError(t, err)
+	assert.False(t, claimed)
 }
 
-func TestSubscribeToJamMessages(t *testing.T) {
+func TestClaimTrackEnded_StaleTrack(t *testing.T) {
 	repo, mockConn := setupMockRedis()
-	ctx, cancel := context.WithCancel(setupTestContext())
-	defer cancel()
+	ctx := setupTestContext()
 
 	roomID := "room123"
 
-	mockConn.Command("SUBSCRIBE", "jam:"+roomID+":pubsub").Expect([]interface{}{
-		[]byte("subscribe"),
-		[]byte("jam:" + roomID + ":pubsub"),
-		int64(1),
-	})
+	mockConn.Command("HGET", "jam:"+roomID+":settings", "auto_advance").Expect([]byte("1"))
+	mockConn.Command("HGET", "jam:"+roomID+":track", "id").Expect([]byte("track2"))
 
-	messageChan, err := repo.SubscribeToJamMessages(ctx, roomID)
+	claimed, err := repo.ClaimTrackEnded(ctx, roomID, "track1")
 
 	require.NoError(t, err)
-	assert.NotNil(t, messageChan)
+	assert.False(t, claimed)
+}
 
-	cancel()
+func TestClaimTrackEnded_AutoAdvanceOff(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
 
-	select {
-	case _, ok := <-messageChan:
-		if !ok {
-		}
-	}
+	mockConn.Command("HGET", "jam:room123:settings", "auto_advance").Expect(nil)
+
+	claimed, err := repo.ClaimTrackEnded(ctx, "room123", "track1")
+
+	require.NoError(t, err)
+	assert.False(t, claimed)
 }
 
-func TestNewJamRedisRepository(t *testing.T) {
-	pool := &redis.Pool{}
-	repo := NewJamRedisRepository(pool)
+func TestGetJamAccess(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
 
-	assert.NotNil(t, repo)
-	jamRepo, ok := repo.(*jamRedisRepository)
-	assert.True(t, ok)
-	assert.Equal(t, pool, jamRepo.redisPool)
+	mockConn.Command("HGETALL", "jam:room123:settings").Expect([]interface{}{
+		[]byte("private"), []byte("1"),
+		[]byte("password_hash"), []byte("hash"),
+		[]byte("invite_token"), []byte("token"),
+		[]byte("max_listeners"), []byte("5"),
+		[]byte("auto_advance"), []byte("1"),
+	})
+
+	access, err := repo.GetJamAccess(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.Equal(t, &repository.JamAccess{Private: true, PasswordHash: "hash", InviteToken: "token"}, access)
 }
 
-func TestGetConn_Success(t *testing.T) {
-	conn := redigomock.NewConn()
-	pool := &redis.Pool{
-		Dial: func() (redis.Conn, error) {
-			return conn, nil
-		},
-	}
-	repo := &jamRedisRepository{redisPool: pool}
+func TestGetJamAccess_NoSettings(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
 
-	result, err := repo.getConn()
+	mockConn.Command("HGETALL", "jam:room123:settings").Expect([]interface{}{})
 
-	assert.NoError(t, err)
-	assert.NotNil(t, result)
+	access, err := repo.GetJamAccess(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.Equal(t, &repository.JamAccess{}, access)
 }
 
-func TestGetConn_Error(t *testing.T) {
-	expectedErr := errors.New("connection error")
+func TestKickUser_Ban(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
 
-	pool := &redis.Pool{
-		Dial: func() (redis.Conn, error) {
-			return nil, expectedErr
-		},
-	}
-	repo := &jamRedisRepository{redisPool: pool}
+	roomID := "room123"
 
-	result, err := repo.getConn()
+	ban := mockConn.Command("SADD", "jam:"+roomID+":banned", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":users", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":loaded", "user1").Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":joined", "user1").Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":presence", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":cohosts", "user1").Expect(int64(0))
+	mockConn.Command("DEL", "jam:"+roomID+":userinfo:user1").Expect(int64(1))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"user:kicked","position":0,"user_id":"user1"}`).Expect(int64(1))
 
-	assert.Error(t, err)
-	assert.Nil(t, result)
-	assert.Equal(t, expectedErr, err)
+	err := repo.KickUser(ctx, roomID, "user1", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(ban))
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestKickUser_NoBan(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	ban := mockConn.Command("SADD", "jam:"+roomID+":banned", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":users", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":loaded", "user1").Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":joined", "user1").Expect(int64(1))
+	mockConn.Command("ZREM", "jam:"+roomID+":presence", "user1").Expect(int64(1))
+	mockConn.Command("SREM", "jam:"+roomID+":cohosts", "user1").Expect(int64(0))
+	mockConn.Command("DEL", "jam:"+roomID+":userinfo:user1").Expect(int64(1))
+	mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
+
+	err := repo.KickUser(ctx, roomID, "user1", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, mockConn.Stats(ban))
+}
+
+func TestSetCoHost(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("SADD", "jam:"+roomID+":cohosts", "user1").Expect(int64(1))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"cohost:added","position":0,"user_id":"user1"}`).Expect(int64(1))
+
+	err := repo.SetCoHost(ctx, roomID, "user1", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestSetCoHost_Unchanged(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("SREM", "jam:"+roomID+":cohosts", "user1").Expect(int64(0))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", redigomock.NewAnyData()).Expect(int64(1))
+
+	err := repo.SetCoHost(ctx, roomID, "user1", false)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, mockConn.Stats(publish))
+}
+
+func TestExistsRoom(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("EXISTS", "jam:"+roomID+":host").Expect(int64(1))
+
+	exists, err := repo.ExistsRoom(ctx, roomID)
+
+	require.NoError(t, err)
+	assert.True(t, exists)
+}
+
+func TestExistsRoom_NotExists(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("EXISTS", "jam:"+roomID+":host").Expect(int64(0))
+
+	exists, err := repo.ExistsRoom(ctx, roomID)
+
+	require.NoError(t, err)
+	assert.False(t, exists)
+}
+
+func TestExistsRoom_Error(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("EXISTS", "jam:"+roomID+":host").ExpectError(errors.New("redis error"))
+
+	exists, err := repo.ExistsRoom(ctx, roomID)
+
+	assert.Error(t, err)
+	assert.False(t, exists)
+}
+
+func TestSeekJam(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	position := int64(5000)
+
+	mockConn.Command("HSET", "jam:"+roomID+":track", "position", position, "anchor", testNow.UnixMilli()).Expect(int64(1))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"seek","position":5000,"server_time":1700000000000}`).Expect(int64(1))
+
+	err := repo.SeekJam(ctx, roomID, position)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestHeartbeat(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("HSET", "jam:"+roomID+":track", "position", int64(42000), "anchor", testNow.UnixMilli()).Expect(int64(0))
+	mockConn.Command("HGET", "jam:"+roomID+":track", "paused").Expect([]byte("0"))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"heartbeat","position":42000,"server_time":1700000000000}`).Expect(int64(1))
+
+	err := repo.Heartbeat(ctx, roomID, 42000)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestAllowMessage(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	key := "jam:room123:ratelimit:chat:user1"
+
+	mockConn.Command("INCR", key).Expect(int64(1))
+	expire := mockConn.Command("PEXPIRE", key, int64(5000)).Expect(int64(1))
+
+	allowed, err := repo.AllowMessage(ctx, "room123", "user1", "chat", 3, 5*time.Second)
+
+	require.NoError(t, err)
+	assert.True(t, allowed)
+	assert.Equal(t, 1, mockConn.Stats(expire))
+}
+
+func TestAllowMessage_OverLimit(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	key := "jam:room123:ratelimit:chat:user1"
+
+	mockConn.Command("INCR", key).Expect(int64(4))
+	expire := mockConn.Command("PEXPIRE", key, int64(5000)).Expect(int64(1))
+
+	allowed, err := repo.AllowMessage(ctx, "room123", "user1", "chat", 3, 5*time.Second)
+
+	require.NoError(t, err)
+	assert.False(t, allowed)
+	assert.Equal(t, 0, mockConn.Stats(expire))
+}
+
+func TestAddChatMessage(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	entry := `{"message_id":"msg1","user_id":"user1","text":"hello","server_time":1700000000000}`
+
+	push := mockConn.Command("RPUSH", "jam:"+roomID+":chat", entry).Expect(int64(1))
+	trim := mockConn.Command("LTRIM", "jam:"+roomID+":chat", int64(-100), -1).Expect("OK")
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub",
+		`{"type":"chat:message","position":0,"user_id":"user1","server_time":1700000000000,"message_id":"msg1","text":"hello"}`).Expect(int64(1))
+
+	err := repo.AddChatMessage(ctx, roomID, &repository.JamChatMessage{ID: "msg1", UserID: "user1", Text: "hello"}, 100)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(push))
+	assert.Equal(t, 1, mockConn.Stats(trim))
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestDeleteChatMessage(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	entry := `{"message_id":"msg2","user_id":"user1","text":"spam","server_time":1700000000000}`
+
+	mockConn.Command("LRANGE", "jam:"+roomID+":chat", 0, -1).Expect([]interface{}{
+		[]byte(`{"message_id":"msg1","user_id":"user1","text":"hello","server_time":1700000000000}`),
+		[]byte(entry),
+	})
+	remove := mockConn.Command("LREM", "jam:"+roomID+":chat", 1, entry).Expect(int64(1))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"chat:deleted","position":0,"message_id":"msg2"}`).Expect(int64(1))
+
+	err := repo.DeleteChatMessage(ctx, roomID, "msg2")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(remove))
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestDeleteChatMessage_NotFound(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("LRANGE", "jam:room123:chat", 0, -1).Expect([]interface{}{})
+
+	err := repo.DeleteChatMessage(ctx, "room123", "msg2")
+
+	assert.Error(t, err)
+}
+
+func TestSendReaction(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("HGET", "jam:"+roomID+":track", "id").Expect([]byte("track1"))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub",
+		`{"type":"reaction","track_id":"track1","position":0,"user_id":"user1","server_time":1700000000000,"emoji":"🔥"}`).Expect(int64(1))
+
+	err := repo.SendReaction(ctx, roomID, "user1", "🔥")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestSetUserMuted(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("SADD", "jam:"+roomID+":muted", "user1").Expect(int64(1))
+	publish := mockConn.Command("PUBLISH", "jam:"+roomID+":pubsub", `{"type":"user:muted","position":0,"user_id":"user1"}`).Expect(int64(1))
+
+	err := repo.SetUserMuted(ctx, roomID, "user1", true)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(publish))
+}
+
+func TestPlaybackPosition(t *testing.T) {
+	now := testNow.UnixMilli()
+
+	tests := []struct {
+		name     string
+		track    map[string]string
+		expected int64
+	}{
+		{"Playing", map[string]string{"paused": "0", "position": "1000", "anchor": "1699999995000"}, 6000},
+		{"Paused", map[string]string{"paused": "1", "position": "1000", "anchor": "1699999995000"}, 1000},
+		{"No anchor", map[string]string{"paused": "0", "position": "1000"}, 1000},
+		{"Anchor in the future", map[string]string{"paused": "0", "position": "1000", "anchor": "1700000005000"}, 1000},
+		{"Empty", map[string]string{}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, playbackPosition(tt.track, now))
+		})
+	}
+}
+
+func TestListenClock(t *testing.T) {
+	now := testNow.UnixMilli()
+
+	tests := []struct {
+		name     string
+		track    map[string]string
+		expected int64
+	}{
+		{"Playing", map[string]string{"paused": "0", "played": "2000", "playing_since": "1699999995000"}, 7000},
+		{"Paused", map[string]string{"paused": "1", "played": "2000", "playing_since": "1699999995000"}, 2000},
+		{"Seek does not count", map[string]string{"paused": "0", "position": "90000", "anchor": "1699999999000", "played": "0", "playing_since": "1699999995000"}, 5000},
+		{"Never played", map[string]string{"paused": "1"}, 0},
+		{"Start in the future", map[string]string{"paused": "0", "played": "2000", "playing_since": "1700000005000"}, 2000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, listenClock(tt.track, now))
+		})
+	}
+}
+
+func TestSeekJam_HSETError(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	position := int64(5000)
+
+	mockConn.Command("HSET", "jam:"+roomID+":track", "position", position, "anchor", testNow.UnixMilli()).ExpectError(errors.New("redis error"))
+
+	err := repo.SeekJam(ctx, roomID, position)
+
+	assert.Error(t, err)
+}
+
+func TestStoreUserInfo(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	userID := "user456"
+	username := "testuser"
+	avatarURL := "avatar.jpg"
+	avatarColor := "#a1b2c3"
+
+	mockConn.Command("HMSET", "jam:"+roomID+":userinfo:"+userID, "username", username, "avatar", avatarURL, "avatar_color", avatarColor).Expect("OK")
+
+	err := repo.StoreUserInfo(ctx, roomID, userID, username, avatarURL, avatarColor)
+
+	assert.NoError(t, err)
+}
+
+func TestStoreUserInfo_Error(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	userID := "user456"
+	username := "testuser"
+	avatarURL := "avatar.jpg"
+	avatarColor := "#a1b2c3"
+
+	mockConn.Command("HMSET", "jam:"+roomID+":userinfo:"+userID, "username", username, "avatar", avatarURL, "avatar_color", avatarColor).ExpectError(errors.New("redis error"))
+
+	err := repo.StoreUserInfo(ctx, roomID, userID, username, avatarURL, avatarColor)
+
+	assert.Error(t, err)
+}
+
+func TestGetUserInfo(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	userID := "user456"
+	expectedUsername := "testuser"
+	expectedAvatarURL := "avatar.jpg"
+
+	userInfo := []interface{}{[]byte("username"), []byte(expectedUsername), []byte("avatar"), []byte(expectedAvatarURL)}
+	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:"+userID).Expect(userInfo)
+
+	username, avatarURL, err := repo.GetUserInfo(ctx, roomID, userID)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedUsername, username)
+	assert.Equal(t, expectedAvatarURL, avatarURL)
+}
+
+func TestGetUserInfo_EmptyResult(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	userID := "user456"
+
+	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:"+userID).Expect([]interface{}{})
+
+	username, avatarURL, err := repo.GetUserInfo(ctx, roomID, userID)
+
+	require.NoError(t, err)
+	assert.Empty(t, username)
+	assert.Empty(t, avatarURL)
+}
+
+func TestGetUserInfo_Error(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	userID := "user456"
+
+	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:"+userID).ExpectError(errors.New("redis error"))
+
+	username, avatarURL, err := repo.GetUserInfo(ctx, roomID, userID)
+
+	assert.Error(t, err)
+	assert.Empty(t, username)
+	assert.Empty(t, avatarURL)
+}
+
+func TestNewJamRedisRepository(t *testing.T) {
+	pool := &redis.Pool{}
+	repo := NewJamRedisRepository(pool)
+
+	assert.NotNil(t, repo)
+	jamRepo, ok := repo.(*jamRedisRepository)
+	assert.True(t, ok)
+	assert.Equal(t, pool, jamRepo.redisPool)
+}
+
+func TestGetConn_Success(t *testing.T) {
+	conn := redigomock.NewConn()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return conn, nil
+		},
+	}
+	repo := &jamRedisRepository{redisPool: pool}
+
+	result, err := repo.getConn()
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+}
+
+func TestGetConn_Error(t *testing.T) {
+	expectedErr := errors.New("connection error")
+
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return nil, expectedErr
+		},
+	}
+	repo := &jamRedisRepository{redisPool: pool}
+
+	result, err := repo.getConn()
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestTouchJam(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("SET", "jam:"+roomID+":touched", "1", "NX", "PX", int64(15*60*1000)).Expect("OK")
+	active := mockConn.Command("ZADD", "jams:active", testNow.UnixMilli(), roomID).Expect(int64(1))
+	for _, key := range []string{"host", "track", "users", "loaded", "joined", "listening", "presence", "queue", "suggestions",
+		"suggested_by", "settings", "banned", "cohosts", "chat", "muted"} {
+		mockConn.Command("PEXPIRE", "jam:"+roomID+":"+key, int64(60*60*1000)).Expect(int64(1))
+	}
+	mockConn.Command("SMEMBERS", "jam:"+roomID+":users").Expect([]interface{}{[]byte("user1")})
+	mockConn.Command("GET", "jam:"+roomID+":host").Expect([]byte("host1"))
+	hostInfo := mockConn.Command("PEXPIRE", "jam:"+roomID+":userinfo:host1", int64(60*60*1000)).Expect(int64(1))
+	userInfo := mockConn.Command("PEXPIRE", "jam:"+roomID+":userinfo:user1", int64(60*60*1000)).Expect(int64(1))
+	mockConn.Command("HKEYS", "jam:"+roomID+":suggested_by").Expect([]interface{}{[]byte("track2")})
+	voters := mockConn.Command("PEXPIRE", "jam:"+roomID+":suggestion:track2:voters", int64(60*60*1000)).Expect(int64(1))
+
+	err := repo.TouchJam(ctx, roomID, time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(active))
+	assert.Equal(t, 1, mockConn.Stats(hostInfo))
+	assert.Equal(t, 1, mockConn.Stats(userInfo))
+	assert.Equal(t, 1, mockConn.Stats(voters))
+}
+
+func TestTouchJam_Throttled(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("SET", "jam:"+roomID+":touched", "1", "NX", "PX", int64(15*60*1000)).Expect(nil)
+	active := mockConn.Command("ZADD", "jams:active", testNow.UnixMilli(), roomID).Expect(int64(1))
+
+	err := repo.TouchJam(ctx, roomID, time.Hour)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0, mockConn.Stats(active))
+}
+
+func TestTouchJam_NoTTL(t *testing.T) {
+	repo, _ := setupMockRedis()
+	ctx := setupTestContext()
+
+	err := repo.TouchJam(ctx, "room123", 0)
+
+	assert.NoError(t, err)
+}
+
+func TestTouchPresence(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	touch := mockConn.Command("ZADD", "jam:room123:presence", testNow.UnixMilli(), "user1").Expect(int64(1))
+
+	err := repo.TouchPresence(ctx, "room123", "user1")
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(touch))
+}
+
+func TestGetStaleJams(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZRANGEBYSCORE", "jams:active", "-inf", "(1700000000000").Expect([]interface{}{[]byte("room1")})
+
+	rooms, err := repo.GetStaleJams(ctx, testNow)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"room1"}, rooms)
+}
+
+func TestGetStaleUsers(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZRANGEBYSCORE", "jam:room123:presence", "-inf", "(1700000000000").Expect([]interface{}{[]byte("user1")})
+
+	users, err := repo.GetStaleUsers(ctx, "room123", testNow)
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user1"}, users)
+}
+
+func TestGetJamSummaries(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("GET", "jam:"+roomID+":host").Expect([]byte("host1"))
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{
+		[]byte("id"), []byte("track1"), []byte("paused"), []byte("0"),
+	})
+	mockConn.Command("SCARD", "jam:"+roomID+":users").Expect(int64(3))
+	mockConn.Command("HGETALL", "jam:"+roomID+":settings").Expect([]interface{}{
+		[]byte("private"), []byte("0"), []byte("password_hash"), []byte("hash"),
+	})
+	mockConn.Command("GET", "jam:gone:host").Expect(nil)
+	mockConn.Command("HGETALL", "jam:gone:track").Expect([]interface{}{})
+	mockConn.Command("SCARD", "jam:gone:users").Expect(int64(0))
+	mockConn.Command("HGETALL", "jam:gone:settings").Expect([]interface{}{})
+	mockConn.Command("HGETALL", "jam:"+roomID+":userinfo:host1").Expect([]interface{}{
+		[]byte("username"), []byte("host"), []byte("avatar"), []byte("http://example.com/a.jpg"), []byte("avatar_color"), []byte("#a1b2c3"),
+	})
+
+	summaries, err := repo.GetJamSummaries(ctx, []string{"gone", roomID})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*repository.JamSummary{{
+		RoomID:          roomID,
+		HostID:          "host1",
+		HostName:        "host",
+		HostAvatar:      "http://example.com/a.jpg",
+		HostAvatarColor: "#a1b2c3",
+		TrackID:         "track1",
+		Listeners:       3,
+		HasPassword:     true,
+	}}, summaries)
+}
+
+func TestGetJamSummaries_AllGone(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "jam:room123:host").Expect(nil)
+	mockConn.Command("HGETALL", "jam:room123:track").Expect([]interface{}{})
+	mockConn.Command("SCARD", "jam:room123:users").Expect(int64(0))
+	mockConn.Command("HGETALL", "jam:room123:settings").Expect([]interface{}{})
+
+	summaries, err := repo.GetJamSummaries(ctx, []string{"room123"})
+
+	require.NoError(t, err)
+	assert.Empty(t, summaries)
+}
+
+func TestAcquireReaperLock(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("SET", "jams:reaper:lock", "1", "NX", "PX", int64(15000)).Expect("OK")
+
+	locked, err := repo.AcquireReaperLock(ctx, 15*time.Second)
+
+	require.NoError(t, err)
+	assert.True(t, locked)
+}
+
+func TestAcquireReaperLock_Held(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("SET", "jams:reaper:lock", "1", "NX", "PX", int64(15000)).Expect(nil)
+
+	locked, err := repo.AcquireReaperLock(ctx, 15*time.Second)
+
+	require.NoError(t, err)
+	assert.False(t, locked)
+}
+
+func TestGetPlayback(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+	anchor := testNow.UnixMilli() - 5000
+
+	mockConn.Command("GET", "jam:"+roomID+":host").Expect([]byte("host1"))
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{
+		[]byte("id"), []byte("42"), []byte("paused"), []byte("0"),
+		[]byte("position"), []byte("10000"), []byte("anchor"), []byte(strconv.FormatInt(anchor, 10)),
+		[]byte("played"), []byte("3000"), []byte("playing_since"), []byte("1699999992000"),
+	})
+	mockConn.Command("SMEMBERS", "jam:"+roomID+":users").Expect([]interface{}{[]byte("user1"), []byte("user2")})
+	mockConn.Command("HGETALL", "jam:"+roomID+":listening").Expect([]interface{}{
+		[]byte("host1"), []byte("0"), []byte("user1"), []byte("6000"), []byte("gone"), []byte("0"),
+	})
+
+	playback, err := repo.GetPlayback(ctx, roomID)
+
+	require.NoError(t, err)
+	assert.Equal(t, &repository.JamPlayback{
+		TrackID:  "42",
+		Position: 15000,
+		Played:   11000,
+		HostID:   "host1",
+		Listened: map[string]int64{"host1": 11000, "user1": 5000},
+	}, playback)
+}
+
+func TestGetPlayback_RoomGone(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "jam:room123:host").Expect(nil)
+
+	playback, err := repo.GetPlayback(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.Nil(t, playback)
+}
+
+func TestStopListening(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{
+		[]byte("id"), []byte("42"), []byte("paused"), []byte("1"), []byte("played"), []byte("9000"),
+	})
+	mockConn.Command("HGET", "jam:"+roomID+":listening", "user1").Expect([]byte("4000"))
+	remove := mockConn.Command("HDEL", "jam:"+roomID+":listening", "user1").Expect(int64(1))
+
+	listen, err := repo.StopListening(ctx, roomID, "user1")
+
+	require.NoError(t, err)
+	assert.Equal(t, &repository.JamListen{TrackID: "42", Listened: 5000}, listen)
+	assert.Equal(t, 1, mockConn.Stats(remove))
+}
+
+func TestStopListening_AlreadyCounted(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{[]byte("id"), []byte("42")})
+	mockConn.Command("HGET", "jam:"+roomID+":listening", "user1").Expect([]byte("4000"))
+	mockConn.Command("HDEL", "jam:"+roomID+":listening", "user1").Expect(int64(0))
+
+	listen, err := repo.StopListening(ctx, roomID, "user1")
+
+	require.NoError(t, err)
+	assert.Nil(t, listen)
+}
+
+func TestStopListening_NotListening(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	roomID := "room123"
+
+	mockConn.Command("HGETALL", "jam:"+roomID+":track").Expect([]interface{}{[]byte("id"), []byte("42")})
+	mockConn.Command("HGET", "jam:"+roomID+":listening", "user1").Expect(nil)
+	remove := mockConn.Command("HDEL", "jam:"+roomID+":listening", "user1").Expect(int64(0))
+
+	listen, err := repo.StopListening(ctx, roomID, "user1")
+
+	require.NoError(t, err)
+	assert.Nil(t, listen)
+	assert.Equal(t, 0, mockConn.Stats(remove))
 }
@@ -0,0 +1,159 @@
+package redis
+
+import (
+	"context"
+	"sync"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+)
+
+// subscriberBuffer is how far a socket may fall behind the room before it is cut off. A socket
+// that missed messages would drift from the room, so its channel is closed instead and the client
+// reconnects and resyncs.
+const subscriberBuffer = 100
+
+// subscriptionHub keeps one Redis subscription per room on this gateway and fans the room's
+// messages out to every local socket, so the number of pubsub connections grows with rooms
+// and not with listeners
+type subscriptionHub struct {
+	mu    sync.Mutex
+	rooms map[string]*roomSubscription
+}
+
+type roomSubscription struct {
+	pubSub      redis.PubSubConn
+	subscribers map[chan []byte]struct{}
+}
+
+func newSubscriptionHub() *subscriptionHub {
+	return &subscriptionHub{rooms: make(map[string]*roomSubscription)}
+}
+
+// SubscribeToJamMessages joins the room's shared subscription, opening it for the first
+// local socket. The returned channel is closed once ctx is done or the subscription drops.
+func (r *jamRedisRepository) SubscribeToJamMessages(ctx context.Context, roomID string) (<-chan []byte, error) {
+	hub := r.subscriptions
+	messageChan := make(chan []byte, subscriberBuffer)
+
+	hub.mu.Lock()
+	room, ok := hub.rooms[roomID]
+	if ok {
+		room.subscribers[messageChan] = struct{}{}
+		hub.mu.Unlock()
+	} else {
+		// dialing Redis under the lock would stall every room on this gateway
+		hub.mu.Unlock()
+
+		conn, err := r.getConn()
+		if err != nil {
+			return nil, err
+		}
+
+		pubSub := redis.PubSubConn{Conn: conn}
+		err = pubSub.Subscribe("jam:" + roomID + ":pubsub")
+		if err != nil {
+			_ = conn.Close()
+			return nil, err
+		}
+
+		hub.mu.Lock()
+		room, ok = hub.rooms[roomID]
+		if !ok {
+			room = &roomSubscription{pubSub: pubSub, subscribers: make(map[chan []byte]struct{})}
+			hub.rooms[roomID] = room
+			// the room outlives the socket that opened it
+			go hub.receive(context.WithoutCancel(ctx), roomID, room)
+		}
+		room.subscribers[messageChan] = struct{}{}
+		hub.mu.Unlock()
+
+		// another socket opened the room while this one was dialing
+		if ok {
+			if err := conn.Close(); err != nil {
+				loggerPkg.LoggerFromContext(ctx).Error("Error closing connection:", zap.Error(err))
+			}
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		hub.unsubscribe(ctx, roomID, room, messageChan)
+	}()
+
+	return messageChan, nil
+}
+
+// unsubscribe drops one socket, the last one out closes the room's subscription
+func (h *subscriptionHub) unsubscribe(ctx context.Context, roomID string, room *roomSubscription, messageChan chan []byte) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if err := h.dropSubscriber(roomID, room, messageChan); err != nil {
+		logger.Error("Error unsubscribing from jam messages:", zap.Error(err))
+	}
+}
+
+// dropSubscriber closes a socket's channel and the room's subscription once nobody is left,
+// h.mu must be held
+func (h *subscriptionHub) dropSubscriber(roomID string, room *roomSubscription, messageChan chan []byte) error {
+	if _, ok := room.subscribers[messageChan]; !ok {
+		return nil
+	}
+	delete(room.subscribers, messageChan)
+	close(messageChan)
+
+	if len(room.subscribers) > 0 || h.rooms[roomID] != room {
+		return nil
+	}
+	delete(h.rooms, roomID)
+
+	// receive exits once Redis confirms, it owns the connection
+	return room.pubSub.Unsubscribe()
+}
+
+func (h *subscriptionHub) receive(ctx context.Context, roomID string, room *roomSubscription) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	defer func() {
+		if err := room.pubSub.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	for {
+		switch v := room.pubSub.Receive().(type) {
+		case redis.Message:
+			h.mu.Lock()
+			for messageChan := range room.subscribers {
+				select {
+				case messageChan <- v.Data:
+				default:
+					logger.Warn("cutting off a slow jam socket", zap.String("room_id", roomID))
+					if err := h.dropSubscriber(roomID, room, messageChan); err != nil {
+						logger.Error("Error unsubscribing from jam messages:", zap.Error(err))
+					}
+				}
+			}
+			h.mu.Unlock()
+		case redis.Subscription:
+			if v.Count == 0 {
+				return
+			}
+		case error:
+			logger.Error("jam subscription lost", zap.String("room_id", roomID), zap.Error(v))
+			h.mu.Lock()
+			if h.rooms[roomID] == room {
+				delete(h.rooms, roomID)
+			}
+			for messageChan := range room.subscribers {
+				delete(room.subscribers, messageChan)
+				close(messageChan)
+			}
+			h.mu.Unlock()
+			return
+		}
+	}
+}
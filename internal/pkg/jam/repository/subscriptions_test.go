@@ -0,0 +1,210 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gomodule/redigo/redis"
+	"github.com/rafaeljusto/redigomock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func receiveMessage(t *testing.T, messageChan <-chan []byte) ([]byte, bool) {
+	t.Helper()
+	select {
+	case message, ok := <-messageChan:
+		return message, ok
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for jam message")
+		return nil, false
+	}
+}
+
+func roomCount(repo *jamRedisRepository) int {
+	repo.subscriptions.mu.Lock()
+	defer repo.subscriptions.mu.Unlock()
+	return len(repo.subscriptions.rooms)
+}
+
+func TestSubscribeToJamMessages_SharedSubscription(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	mockConn.ReceiveWait = true
+	mockConn.ReceiveNow = make(chan bool)
+
+	roomID := "room123"
+	channel := "jam:" + roomID + ":pubsub"
+
+	subscribe := mockConn.Command("SUBSCRIBE", channel).Expect([]interface{}{[]byte("subscribe"), []byte(channel), int64(1)})
+	unsubscribe := mockConn.Command("UNSUBSCRIBE").Expect([]interface{}{[]byte("unsubscribe"), []byte(channel), int64(0)})
+
+	firstCtx, cancelFirst := context.WithCancel(setupTestContext())
+	defer cancelFirst()
+	secondCtx, cancelSecond := context.WithCancel(setupTestContext())
+	defer cancelSecond()
+
+	first, err := repo.SubscribeToJamMessages(firstCtx, roomID)
+	require.NoError(t, err)
+	second, err := repo.SubscribeToJamMessages(secondCtx, roomID)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, mockConn.Stats(subscribe))
+
+	mockConn.AddSubscriptionMessage([]interface{}{[]byte("message"), []byte(channel), []byte("hello")})
+	mockConn.ReceiveNow <- true
+	mockConn.ReceiveNow <- true
+
+	message, ok := receiveMessage(t, first)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", string(message))
+	message, ok = receiveMessage(t, second)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", string(message))
+
+	cancelFirst()
+	_, ok = receiveMessage(t, first)
+	assert.False(t, ok)
+	assert.Equal(t, 1, roomCount(repo))
+	assert.Equal(t, 0, mockConn.Stats(unsubscribe))
+
+	cancelSecond()
+	_, ok = receiveMessage(t, second)
+	assert.False(t, ok)
+	assert.Equal(t, 0, roomCount(repo))
+	assert.Equal(t, 1, mockConn.Stats(unsubscribe))
+
+	// let the receiver see the unsubscribe confirmation and exit
+	mockConn.ReceiveNow <- true
+}
+
+func TestSubscribeToJamMessages_ConnectionLost(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx, cancel := context.WithCancel(setupTestContext())
+	defer cancel()
+
+	roomID := "room123"
+	channel := "jam:" + roomID + ":pubsub"
+
+	mockConn.Command("SUBSCRIBE", channel).Expect([]interface{}{[]byte("subscribe"), []byte(channel), int64(1)})
+
+	messageChan, err := repo.SubscribeToJamMessages(ctx, roomID)
+	require.NoError(t, err)
+
+	// the mock has nothing more to receive, which the hub treats as a dropped connection
+	_, ok := receiveMessage(t, messageChan)
+	assert.False(t, ok)
+	assert.Equal(t, 0, roomCount(repo))
+}
+
+func TestSubscribeToJamMessages_ConnError(t *testing.T) {
+	repo, _ := setupMockRedis()
+	repo.redisPool = &redis.Pool{Dial: func() (redis.Conn, error) {
+		return nil, errors.New("connection refused")
+	}}
+	ctx := setupTestContext()
+
+	messageChan, err := repo.SubscribeToJamMessages(ctx, "room123")
+
+	assert.Error(t, err)
+	assert.Nil(t, messageChan)
+	assert.Equal(t, 0, roomCount(repo))
+}
+
+func TestSubscribeToJamMessages_SlowSocketCutOff(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	mockConn.ReceiveWait = true
+	mockConn.ReceiveNow = make(chan bool)
+	ctx, cancel := context.WithCancel(setupTestContext())
+	defer cancel()
+
+	roomID := "room123"
+	channel := "jam:" + roomID + ":pubsub"
+
+	mockConn.Command("SUBSCRIBE", channel).Expect([]interface{}{[]byte("subscribe"), []byte(channel), int64(1)})
+	unsubscribe := mockConn.Command("UNSUBSCRIBE").Expect([]interface{}{[]byte("unsubscribe"), []byte(channel), int64(0)})
+	for i := 0; i <= subscriberBuffer; i++ {
+		mockConn.AddSubscriptionMessage([]interface{}{[]byte("message"), []byte(channel), []byte("hello")})
+	}
+
+	messageChan, err := repo.SubscribeToJamMessages(ctx, roomID)
+	require.NoError(t, err)
+
+	// the subscribe confirmation comes first, then nobody reads the socket
+	// and the message past its buffer cuts it off
+	for i := 0; i <= subscriberBuffer+1; i++ {
+		mockConn.ReceiveNow <- true
+	}
+	// the receiver is back waiting once the last message is handled, let it see
+	// the unsubscribe confirmation and exit
+	mockConn.ReceiveNow <- true
+
+	for i := 0; i < subscriberBuffer; i++ {
+		_, ok := receiveMessage(t, messageChan)
+		require.True(t, ok)
+	}
+	_, ok := receiveMessage(t, messageChan)
+	assert.False(t, ok)
+	assert.Equal(t, 0, roomCount(repo))
+	// closing the connection afterwards unsubscribes once more
+	assert.NotZero(t, mockConn.Stats(unsubscribe))
+}
+
+func TestSubscribeToJamMessages_RoomOpenedWhileDialing(t *testing.T) {
+	repo, _ := setupMockRedis()
+	ctx, cancel := context.WithCancel(setupTestContext())
+	defer cancel()
+
+	roomID := "room123"
+	channel := "jam:" + roomID + ":pubsub"
+
+	firstConn := redigomock.NewConn()
+	firstConn.Command("SUBSCRIBE", channel).Expect([]interface{}{[]byte("subscribe"), []byte(channel), int64(1)})
+	secondConn := redigomock.NewConn()
+	secondConn.ReceiveWait = true
+	secondConn.ReceiveNow = make(chan bool)
+	secondConn.Command("SUBSCRIBE", channel).Expect([]interface{}{[]byte("subscribe"), []byte(channel), int64(1)})
+	unsubscribe := secondConn.Command("UNSUBSCRIBE").Expect([]interface{}{[]byte("unsubscribe"), []byte(channel), int64(0)})
+
+	var second <-chan []byte
+	dials := 0
+	repo.redisPool = &redis.Pool{Dial: func() (redis.Conn, error) {
+		dials++
+		if dials > 1 {
+			return secondConn, nil
+		}
+		// another socket joins while the first one is dialing, this deadlocks if the hub stays locked
+		var err error
+		second, err = repo.SubscribeToJamMessages(ctx, roomID)
+		require.NoError(t, err)
+		return firstConn, nil
+	}}
+
+	first, err := repo.SubscribeToJamMessages(ctx, roomID)
+	require.NoError(t, err)
+	require.NotNil(t, second)
+	assert.Equal(t, 1, roomCount(repo))
+
+	secondConn.AddSubscriptionMessage([]interface{}{[]byte("message"), []byte(channel), []byte("hello")})
+	secondConn.ReceiveNow <- true
+	secondConn.ReceiveNow <- true
+
+	message, ok := receiveMessage(t, first)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", string(message))
+	message, ok = receiveMessage(t, second)
+	assert.True(t, ok)
+	assert.Equal(t, "hello", string(message))
+
+	cancel()
+	_, ok = receiveMessage(t, first)
+	assert.False(t, ok)
+	_, ok = receiveMessage(t, second)
+	assert.False(t, ok)
+	assert.Equal(t, 0, roomCount(repo))
+	assert.Equal(t, 1, secondConn.Stats(unsubscribe))
+
+	// let the receiver see the unsubscribe confirmation and exit
+	secondConn.ReceiveNow <- true
+}
@@ -12,4 +12,9 @@ type Usecase interface {
 	HandleClientMessage(ctx context.Context, roomID string, userID string, m *usecase.JamMessage) error
 	LeaveJam(ctx context.Context, roomID string, userID string) error
 	SubscribeToJamMessages(ctx context.Context, roomID string) (<-chan *usecase.JamMessage, error)
+	KeepAlive(ctx context.Context, roomID string, userID string) error
+	ListJams(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.JamSummary, error)
+	GetSessions(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.JamSession, error)
+	GetSession(ctx context.Context, sessionID int64, userID int64) (*usecase.JamSessionDetails, error)
+	CreateSessionPlaylist(ctx context.Context, request *usecase.CreateJamPlaylistRequest) (int64, error)
 }
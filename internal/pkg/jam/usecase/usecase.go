@@ -2,36 +2,101 @@ package usecase
 
 import (
 	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	playlistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
 	userProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
+	"golang.org/x/crypto/argon2"
 )
 
+// the listing only needs to know whose rooms belong to friends, a single page is enough
+const maxFollowingLookup = 1000
+
+// private rooms are filtered out after the lookup, so the listing reads rooms in batches
+// until the page is full instead of fetching exactly one page
+const jamSummaryBatch = 50
+
+// an emoji with skin tone and joiner sequences takes a few runes, anything longer is not a reaction
+const maxReactionLength = 8
+
+const maxJamPlaylistTitleLength = 100
+
+// saving the same jam again numbers the default title, "Jam 2025-05-16 (2)" and so on up to this
+const maxJamPlaylistTitleNumber = 20
+
+// track:ended is sent by every listener when its player stops, clients that started a bit
+// late or drifted still report within this of the server position
+const trackEndedTolerance = 5 * time.Second
+
+var hostOnlyMessages = map[string]bool{
+	"host:transfer":  true,
+	"host:promote":   true,
+	"host:demote":    true,
+	"host:heartbeat": true,
+}
+
 type Usecase struct {
-	jamRepository jam.Repository
-	userClient    userProto.UserServiceClient
+	jamRepository     jam.Repository
+	historyRepository jam.HistoryRepository
+	userClient        userProto.UserServiceClient
+	playlistClient    playlistProto.PlaylistServiceClient
+	trackUsecase      track.Usecase
+	cfg               config.JamConfig
 }
 
-func NewUsecase(jamRepository jam.Repository, userClient userProto.UserServiceClient) *Usecase {
+func NewUsecase(jamRepository jam.Repository, historyRepository jam.HistoryRepository, userClient userProto.UserServiceClient, playlistClient playlistProto.PlaylistServiceClient, trackUsecase track.Usecase, cfg config.JamConfig) *Usecase {
 	return &Usecase{
-		jamRepository: jamRepository,
-		userClient:    userClient,
+		jamRepository:     jamRepository,
+		historyRepository: historyRepository,
+		userClient:        userClient,
+		playlistClient:    playlistClient,
+		trackUsecase:      trackUsecase,
+		cfg:               cfg,
 	}
 }
 
 func (u *Usecase) CreateJam(ctx context.Context, request *usecase.CreateJamRequest) (*usecase.CreateJamResponse, error) {
+	if request.MaxListeners < 0 {
+		return nil, customErrors.ErrJamInvalidMaxListeners
+	}
+
+	passwordHash := ""
+	if request.Password != "" {
+		salt := make([]byte, 8)
+		if _, err := rand.Read(salt); err != nil {
+			return nil, err
+		}
+		passwordHash = hashPassword(salt, request.Password)
+	}
+
 	repoRequest := &repository.CreateJamRequest{
-		UserID:   request.UserID,
-		TrackID:  request.TrackID,
-		Position: request.Position,
+		UserID:       request.UserID,
+		TrackID:      request.TrackID,
+		Position:     request.Position,
+		Private:      request.Private,
+		PasswordHash: passwordHash,
+		InviteToken:  uuid.New().String(),
+		MaxListeners: request.MaxListeners,
 	}
 	jamResponse, err := u.jamRepository.CreateJam(ctx, repoRequest)
 	if err != nil {
@@ -44,9 +109,13 @@ func (u *Usecase) CreateJam(ctx context.Context, request *usecase.CreateJamReque
 		logger.Error("failed to store host user info", zap.Error(err))
 	}
 
+	u.touch(ctx, jamResponse.RoomID, request.UserID)
+	u.startSession(ctx, jamResponse.RoomID, request.UserID)
+
 	return &usecase.CreateJamResponse{
-		RoomID: jamResponse.RoomID,
-		HostID: jamResponse.HostID,
+		RoomID:      jamResponse.RoomID,
+		HostID:      jamResponse.HostID,
+		InviteToken: repoRequest.InviteToken,
 	}, nil
 }
 
@@ -66,13 +135,30 @@ func (u *Usecase) JoinJam(ctx context.Context, request *usecase.JoinJamRequest)
 		return nil, err
 	}
 
-	if hostID != request.UserID {
+	if hostID == request.UserID {
+		err = u.jamRepository.ClearHostDisconnected(ctx, request.RoomID, hostID)
+		if err != nil {
+			logger.Error("failed to clear host disconnect", zap.Error(err))
+			return nil, err
+		}
+	} else {
+		err = u.checkAccess(ctx, request)
+		if err != nil {
+			logger.Warn("jam access denied", zap.Error(err))
+			return nil, err
+		}
+
 		err = u.storeUserInfo(ctx, request.RoomID, request.UserID)
 		if err != nil {
 			logger.Error("failed to store user info", zap.Error(err))
 		}
 
+		// the listener limit is enforced by AddUser, a separate count could race with other joins
 		err = u.jamRepository.AddUser(ctx, request.RoomID, request.UserID)
+		if errors.Is(err, customErrors.ErrJamFull) {
+			logger.Warn("jam access denied", zap.Error(err))
+			return nil, err
+		}
 		if err != nil {
 			logger.Error("failed to add user", zap.Error(err))
 			return nil, err
@@ -83,8 +169,12 @@ func (u *Usecase) JoinJam(ctx context.Context, request *usecase.JoinJamRequest)
 			logger.Error("failed to pause jam", zap.Error(err))
 			return nil, err
 		}
+
+		u.addParticipant(ctx, request.RoomID, request.UserID)
 	}
 
+	u.touch(ctx, request.RoomID, request.UserID)
+
 	repoJamData, err := u.jamRepository.GetInitialJamData(ctx, request.RoomID)
 	if err != nil {
 		logger.Error("failed to get initial jam data", zap.Error(err))
@@ -92,10 +182,73 @@ func (u *Usecase) JoinJam(ctx context.Context, request *usecase.JoinJamRequest)
 	}
 
 	jamData := model.JamMessageFromRepositoryToUsecase(repoJamData)
+	jamData.Lyrics = u.trackLyrics(ctx, jamData.TrackID)
 
 	return jamData, nil
 }
 
+// trackLyrics gets the lyrics of the current track so that the room can sing along to the
+// synchronized position, the room plays on without them when they fail to load
+func (u *Usecase) trackLyrics(ctx context.Context, rawTrackID string) []*usecase.TrackLyrics {
+	trackID, err := strconv.ParseInt(rawTrackID, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	lyrics, err := u.trackUsecase.GetTrackLyrics(ctx, trackID)
+	if err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to get jam track lyrics", zap.Int64("track_id", trackID), zap.Error(err))
+		return nil
+	}
+	return lyrics
+}
+
+// checkAccess applies the room rules to a joining listener. A valid invite token lets the
+// listener skip the password, without one a private room is closed to them.
+func (u *Usecase) checkAccess(ctx context.Context, request *usecase.JoinJamRequest) error {
+	banned, err := u.jamRepository.IsUserBanned(ctx, request.RoomID, request.UserID)
+	if err != nil {
+		return err
+	}
+	if banned {
+		return customErrors.ErrJamBanned
+	}
+
+	access, err := u.jamRepository.GetJamAccess(ctx, request.RoomID)
+	if err != nil {
+		return err
+	}
+
+	invited := request.InviteToken != "" && request.InviteToken == access.InviteToken
+	if !invited {
+		if access.Private {
+			return customErrors.ErrJamInviteRequired
+		}
+		if access.PasswordHash != "" && !checkPasswordHash(access.PasswordHash, request.Password) {
+			return customErrors.ErrJamWrongPassword
+		}
+	}
+
+	return nil
+}
+
+func hashPassword(salt []byte, password string) string {
+	hashedPass := argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, 32)
+	combined := append(salt, hashedPass...)
+	return base64.StdEncoding.EncodeToString(combined)
+}
+
+func checkPasswordHash(encodedHash string, password string) bool {
+	decodedHash, err := base64.StdEncoding.DecodeString(encodedHash)
+	if err != nil || len(decodedHash) <= 8 {
+		return false
+	}
+	salt := decodedHash[:8]
+	passHash := argon2.IDKey([]byte(password), salt, 1, 64*1024, 4, 32)
+	return subtle.ConstantTimeCompare(passHash, decodedHash[8:]) == 1
+}
+
 func (u *Usecase) storeUserInfo(ctx context.Context, roomID string, userIDStr string) error {
 	userID, err := strconv.ParseInt(userIDStr, 10, 64)
 	if err != nil {
@@ -108,16 +261,17 @@ func (u *Usecase) storeUserInfo(ctx context.Context, roomID string, userIDStr st
 	}
 
 	username := userProtoData.Username
-	avatarURL := ""
+	avatarURL, avatarColor := "", ""
 
 	if userProtoData.Avatar != "" {
 		avatarURLProto, err := u.userClient.GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: userProtoData.Avatar})
 		if err == nil {
 			avatarURL = avatarURLProto.Url
+			avatarColor = userProtoData.AvatarColor
 		}
 	}
 
-	return u.jamRepository.StoreUserInfo(ctx, roomID, userIDStr, username, avatarURL)
+	return u.jamRepository.StoreUserInfo(ctx, roomID, userIDStr, username, avatarURL, avatarColor)
 }
 
 func (u *Usecase) HandleClientMessage(ctx context.Context, roomID string, userID string, m *usecase.JamMessage) error {
@@ -132,20 +286,23 @@ func (u *Usecase) HandleClientMessage(ctx context.Context, roomID string, userID
 
 	isHost := hostID == userID
 
-	switch m.Type {
-	case "host:load":
-		if !isHost {
-			return errors.New("not host")
+	// co-hosts may send host:* messages except the ones that decide who runs the room
+	if strings.HasPrefix(m.Type, "host:") && !isHost {
+		if hostOnlyMessages[m.Type] {
+			return customErrors.ErrJamNotHost
 		}
-		err := u.jamRepository.LoadTrack(ctx, roomID, m.TrackID)
+		isCoHost, err := u.jamRepository.IsCoHost(ctx, roomID, userID)
 		if err != nil {
 			return err
 		}
-		err = u.jamRepository.PauseJam(ctx, roomID)
-		if err != nil {
-			return err
+		if !isCoHost {
+			return customErrors.ErrJamNotHost
 		}
-		u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
+	}
+
+	switch m.Type {
+	case "host:load":
+		return u.loadTrack(ctx, roomID, m.TrackID)
 	case "client:ready":
 		if isHost {
 			return nil
@@ -156,30 +313,278 @@ func (u *Usecase) HandleClientMessage(ctx context.Context, roomID string, userID
 		}
 		u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
 	case "host:play":
-		if !isHost {
-			return errors.New("not host")
-		}
 		u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
 	case "host:pause":
-		if !isHost {
-			return errors.New("not host")
-		}
 		err := u.jamRepository.PauseJam(ctx, roomID)
 		if err != nil {
 			return err
 		}
 	case "host:seek":
-		if !isHost {
-			return errors.New("not host")
-		}
 		err := u.jamRepository.SeekJam(ctx, roomID, m.Position)
 		if err != nil {
 			return err
 		}
+	case "host:transfer":
+		return u.transferHost(ctx, roomID, userID, m.UserID)
+	case "host:heartbeat":
+		if m.Position < 0 {
+			return customErrors.ErrJamInvalidPosition
+		}
+		return u.jamRepository.Heartbeat(ctx, roomID, m.Position)
+	case "chat:message":
+		return u.sendChatMessage(ctx, roomID, userID, m.Text)
+	case "reaction":
+		return u.sendReaction(ctx, roomID, userID, m.Emoji)
+	case "host:delete_message":
+		return u.jamRepository.DeleteChatMessage(ctx, roomID, m.MessageID)
+	case "host:mute", "host:unmute":
+		err := u.checkModerationTarget(ctx, roomID, hostID, userID, m.UserID)
+		if err != nil {
+			return err
+		}
+		return u.jamRepository.SetUserMuted(ctx, roomID, m.UserID, m.Type == "host:mute")
+	case "host:kick", "host:ban":
+		return u.kickUser(ctx, roomID, hostID, userID, m.UserID, m.Type == "host:ban")
+	case "host:unban":
+		return u.jamRepository.UnbanUser(ctx, roomID, m.UserID)
+	case "host:promote":
+		inJam, err := u.jamRepository.IsUserInJam(ctx, roomID, m.UserID)
+		if err != nil {
+			return err
+		}
+		if !inJam {
+			return customErrors.ErrJamUserNotInJam
+		}
+		return u.jamRepository.SetCoHost(ctx, roomID, m.UserID, true)
+	case "host:demote":
+		return u.jamRepository.SetCoHost(ctx, roomID, m.UserID, false)
+	case "queue:suggest":
+		if m.TrackID == "" {
+			return customErrors.ErrJamTrackIDRequired
+		}
+		err := u.checkTrackExists(ctx, m.TrackID)
+		if err != nil {
+			return err
+		}
+		// the host has nobody to ask for approval
+		if isHost {
+			return u.jamRepository.EnqueueTrack(ctx, roomID, m.TrackID)
+		}
+		return u.jamRepository.SuggestTrack(ctx, roomID, userID, m.TrackID, u.cfg.SuggestionLimit, u.cfg.UserSuggestionLimit)
+	case "queue:vote":
+		return u.jamRepository.VoteTrack(ctx, roomID, userID, m.TrackID)
+	case "host:approve":
+		return u.jamRepository.ApproveSuggestion(ctx, roomID, m.TrackID)
+	case "host:reject":
+		return u.jamRepository.RejectSuggestion(ctx, roomID, m.TrackID)
+	case "host:reorder":
+		if m.Position < 0 {
+			return customErrors.ErrJamInvalidPosition
+		}
+		return u.jamRepository.MoveQueueTrack(ctx, roomID, m.TrackID, m.Position)
+	case "host:next":
+		return u.advanceQueue(ctx, roomID)
+	case "host:auto_advance":
+		return u.jamRepository.SetAutoAdvance(ctx, roomID, m.AutoAdvance)
+	case "track:ended":
+		ended, err := u.playbackEnded(ctx, roomID, m.TrackID)
+		if err != nil || !ended {
+			return err
+		}
+		claimed, err := u.jamRepository.ClaimTrackEnded(ctx, roomID, m.TrackID)
+		if err != nil || !claimed {
+			return err
+		}
+		return u.advanceQueue(ctx, roomID)
+	}
+	return nil
+}
+
+// checkTrackExists keeps ids the track service does not know, or will not play, out of the queue
+func (u *Usecase) checkTrackExists(ctx context.Context, trackID string) error {
+	id, err := strconv.ParseInt(trackID, 10, 64)
+	if err != nil || id <= 0 {
+		return customErrors.ErrTrackNotFound
+	}
+	_, err = u.trackUsecase.GetTrackByID(ctx, id)
+	return err
+}
+
+// checkModerationTarget stops moderators from acting on themselves or the host,
+// a co-host can only act on plain listeners
+func (u *Usecase) checkModerationTarget(ctx context.Context, roomID string, hostID string, userID string, targetID string) error {
+	if targetID == "" || targetID == userID || targetID == hostID {
+		return customErrors.ErrJamInvalidModerationTarget
+	}
+
+	if userID != hostID {
+		targetIsCoHost, err := u.jamRepository.IsCoHost(ctx, roomID, targetID)
+		if err != nil {
+			return err
+		}
+		if targetIsCoHost {
+			return customErrors.ErrJamNotHost
+		}
 	}
 	return nil
 }
 
+func (u *Usecase) kickUser(ctx context.Context, roomID string, hostID string, userID string, targetID string, ban bool) error {
+	err := u.checkModerationTarget(ctx, roomID, hostID, userID, targetID)
+	if err != nil {
+		return err
+	}
+
+	// a ban also applies to users who are not in the room right now
+	if !ban {
+		inJam, err := u.jamRepository.IsUserInJam(ctx, roomID, targetID)
+		if err != nil {
+			return err
+		}
+		if !inJam {
+			return customErrors.ErrJamUserNotInJam
+		}
+	}
+
+	err = u.jamRepository.KickUser(ctx, roomID, targetID, ban)
+	if err != nil {
+		return err
+	}
+	u.stopListening(ctx, roomID, targetID)
+
+	u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
+	return nil
+}
+
+func (u *Usecase) sendChatMessage(ctx context.Context, roomID string, userID string, text string) error {
+	text = strings.TrimSpace(text)
+	if text == "" || (u.cfg.ChatMaxLength > 0 && utf8.RuneCountInString(text) > u.cfg.ChatMaxLength) {
+		return customErrors.ErrJamInvalidMessage
+	}
+
+	err := u.checkCanPost(ctx, roomID, userID, "chat")
+	if err != nil {
+		return err
+	}
+
+	return u.jamRepository.AddChatMessage(ctx, roomID, &repository.JamChatMessage{
+		ID:     uuid.New().String(),
+		UserID: userID,
+		Text:   text,
+	}, u.cfg.ChatHistorySize)
+}
+
+func (u *Usecase) sendReaction(ctx context.Context, roomID string, userID string, emoji string) error {
+	if emoji == "" || utf8.RuneCountInString(emoji) > maxReactionLength || strings.ContainsFunc(emoji, unicode.IsSpace) {
+		return customErrors.ErrJamInvalidMessage
+	}
+
+	err := u.checkCanPost(ctx, roomID, userID, "reaction")
+	if err != nil {
+		return err
+	}
+
+	return u.jamRepository.SendReaction(ctx, roomID, userID, emoji)
+}
+
+// checkCanPost applies mutes and the per-user rate limit, chat and reactions are counted separately
+func (u *Usecase) checkCanPost(ctx context.Context, roomID string, userID string, kind string) error {
+	muted, err := u.jamRepository.IsUserMuted(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+	if muted {
+		return customErrors.ErrJamMuted
+	}
+
+	if u.cfg.MessageRateLimit <= 0 {
+		return nil
+	}
+	allowed, err := u.jamRepository.AllowMessage(ctx, roomID, userID, kind, u.cfg.MessageRateLimit, u.cfg.MessageRateWindow)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return customErrors.ErrJamRateLimited
+	}
+	return nil
+}
+
+func (u *Usecase) loadTrack(ctx context.Context, roomID string, trackID string) error {
+	u.finishTrack(ctx, roomID)
+
+	// the lyrics ride along with the published load, so the room fetches them once
+	lyrics := model.JamLyricsFromUsecaseToRepository(u.trackLyrics(ctx, trackID))
+	err := u.jamRepository.LoadTrack(ctx, roomID, trackID, lyrics)
+	if err != nil {
+		return err
+	}
+	err = u.jamRepository.PauseJam(ctx, roomID)
+	if err != nil {
+		return err
+	}
+	u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
+	return nil
+}
+
+// playbackEnded checks a listener's track:ended against the room's own playback position,
+// otherwise anyone could skip the track by sending it early
+func (u *Usecase) playbackEnded(ctx context.Context, roomID string, trackID string) (bool, error) {
+	playback, err := u.jamRepository.GetPlayback(ctx, roomID)
+	if err != nil || playback == nil || playback.TrackID != trackID {
+		return false, err
+	}
+
+	id, err := strconv.ParseInt(trackID, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+	trackData, err := u.trackUsecase.GetTrackByID(ctx, id)
+	if err != nil {
+		return false, err
+	}
+
+	end := time.Duration(trackData.Duration) * time.Second
+	return time.Duration(playback.Position)*time.Millisecond >= end-trackEndedTolerance, nil
+}
+
+// advanceQueue loads the next queued track, an empty queue leaves the current one in place
+func (u *Usecase) advanceQueue(ctx context.Context, roomID string) error {
+	trackID, err := u.jamRepository.PopQueue(ctx, roomID)
+	if err != nil || trackID == "" {
+		return err
+	}
+	return u.loadTrack(ctx, roomID, trackID)
+}
+
+// transferHost hands the room to a listener on the host's request, the host stays as a listener
+// that already has the current track loaded
+func (u *Usecase) transferHost(ctx context.Context, roomID string, hostID string, newHostID string) error {
+	if newHostID == hostID {
+		return nil
+	}
+
+	isListener, err := u.jamRepository.IsUserInJam(ctx, roomID, newHostID)
+	if err != nil {
+		return err
+	}
+	if !isListener {
+		return customErrors.ErrJamUserNotInJam
+	}
+
+	err = u.jamRepository.TransferHost(ctx, roomID, hostID, newHostID)
+	if err != nil {
+		return err
+	}
+
+	err = u.jamRepository.AddUser(ctx, roomID, hostID)
+	if err != nil {
+		return err
+	}
+
+	return u.jamRepository.MarkUserAsReady(ctx, roomID, hostID)
+}
+
 func (u *Usecase) LeaveJam(ctx context.Context, roomID string, userID string) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	hostID, err := u.jamRepository.GetHostID(ctx, roomID)
@@ -189,11 +594,23 @@ func (u *Usecase) LeaveJam(ctx context.Context, roomID string, userID string) er
 	}
 
 	if hostID == userID {
-		err = u.jamRepository.RemoveJam(ctx, roomID)
+		if u.cfg.HostGracePeriod <= 0 {
+			return u.replaceHost(ctx, roomID, hostID)
+		}
+
+		// The marker outlives the timer so the timer still finds it, it only expires on its own
+		// if this gateway goes away before the grace period ends
+		token := uuid.New().String()
+		err = u.jamRepository.MarkHostDisconnected(ctx, roomID, hostID, token, 2*u.cfg.HostGracePeriod)
 		if err != nil {
-			logger.Error("failed to remove jam", zap.Error(err))
+			logger.Error("failed to mark host as disconnected", zap.Error(err))
 			return err
 		}
+
+		graceCtx := context.WithoutCancel(ctx)
+		time.AfterFunc(u.cfg.HostGracePeriod, func() {
+			u.handleHostGraceExpired(graceCtx, roomID, hostID, token)
+		})
 		return nil
 	}
 
@@ -202,11 +619,514 @@ func (u *Usecase) LeaveJam(ctx context.Context, roomID string, userID string) er
 		logger.Error("failed to remove user", zap.Error(err))
 		return err
 	}
+	u.stopListening(ctx, roomID, userID)
+
+	u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
+	return nil
+}
+
+func (u *Usecase) handleHostGraceExpired(ctx context.Context, roomID string, hostID string, token string) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	expired, err := u.jamRepository.ConsumeHostDisconnected(ctx, roomID, token)
+	if err != nil {
+		logger.Error("failed to check host grace period", zap.Error(err))
+		return
+	}
+	if !expired {
+		return
+	}
+
+	err = u.replaceHost(ctx, roomID, hostID)
+	if err != nil {
+		logger.Error("failed to replace host", zap.Error(err))
+	}
+}
+
+// replaceHost gives the room of a host who is gone to the longest-present listener,
+// the room is closed only when nobody is left
+func (u *Usecase) replaceHost(ctx context.Context, roomID string, hostID string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	newHostID, err := u.jamRepository.GetLongestPresentUser(ctx, roomID)
+	if err != nil {
+		logger.Error("failed to get next host", zap.Error(err))
+		return err
+	}
+
+	if newHostID == "" {
+		err = u.closeJam(ctx, roomID)
+		if err != nil {
+			logger.Error("failed to remove jam", zap.Error(err))
+			return err
+		}
+		return nil
+	}
+
+	err = u.jamRepository.TransferHost(ctx, roomID, hostID, newHostID)
+	if err != nil {
+		logger.Error("failed to transfer host", zap.Error(err))
+		return err
+	}
+
+	err = u.jamRepository.RemoveUser(ctx, roomID, hostID)
+	if err != nil {
+		logger.Error("failed to remove previous host", zap.Error(err))
+		return err
+	}
+	u.stopListening(ctx, roomID, hostID)
 
 	u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
 	return nil
 }
 
+// touch is KeepAlive for places where a failed refresh must not fail the request
+func (u *Usecase) touch(ctx context.Context, roomID string, userID string) {
+	if err := u.KeepAlive(ctx, roomID, userID); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Error("failed to refresh jam ttl", zap.Error(err))
+	}
+}
+
+// KeepAlive is sent periodically by every open socket, it marks the user as present and
+// keeps the room keys from expiring
+func (u *Usecase) KeepAlive(ctx context.Context, roomID string, userID string) error {
+	err := u.jamRepository.TouchPresence(ctx, roomID, userID)
+	if err != nil {
+		return err
+	}
+
+	return u.jamRepository.TouchJam(ctx, roomID, u.cfg.RoomTTL)
+}
+
+// RunReaper sweeps stale rooms and users right away and then every interval until ctx is done
+func (u *Usecase) RunReaper(ctx context.Context, interval time.Duration) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.ReapStale(ctx); err != nil {
+			logger.Error("failed to reap stale jams", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ReapStale cleans up after gateways that died without running LeaveJam: rooms nobody
+// touched within the room ttl are closed and users whose socket stopped reporting are
+// removed. Only one gateway sweeps per interval.
+func (u *Usecase) ReapStale(ctx context.Context) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	locked, err := u.jamRepository.AcquireReaperLock(ctx, u.cfg.ReaperInterval/2)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return nil
+	}
+
+	now := time.Now()
+
+	if u.cfg.RoomTTL > 0 {
+		staleRooms, err := u.jamRepository.GetStaleJams(ctx, now.Add(-u.cfg.RoomTTL))
+		if err != nil {
+			return err
+		}
+		for _, roomID := range staleRooms {
+			if err := u.closeJam(ctx, roomID); err != nil {
+				logger.Error("failed to remove stale jam", zap.String("room_id", roomID), zap.Error(err))
+			}
+		}
+	}
+
+	if u.cfg.PresenceTimeout <= 0 {
+		return nil
+	}
+
+	rooms, err := u.jamRepository.GetActiveJams(ctx)
+	if err != nil {
+		return err
+	}
+	for _, roomID := range rooms {
+		if err := u.reapStaleUsers(ctx, roomID, now.Add(-u.cfg.PresenceTimeout)); err != nil {
+			logger.Error("failed to reap stale jam users", zap.String("room_id", roomID), zap.Error(err))
+		}
+	}
+
+	return nil
+}
+
+func (u *Usecase) reapStaleUsers(ctx context.Context, roomID string, before time.Time) error {
+	staleUsers, err := u.jamRepository.GetStaleUsers(ctx, roomID, before)
+	if err != nil {
+		return err
+	}
+	if len(staleUsers) == 0 {
+		return nil
+	}
+
+	hostID, err := u.jamRepository.GetHostID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	removed := false
+	for _, userID := range staleUsers {
+		if userID != hostID {
+			if err := u.jamRepository.RemoveUser(ctx, roomID, userID); err != nil {
+				return err
+			}
+			u.stopListening(ctx, roomID, userID)
+			removed = true
+			continue
+		}
+
+		// A host in the grace period is left to its timer, the marker expires on its own
+		// when that timer died with its gateway
+		disconnected, err := u.jamRepository.IsHostDisconnected(ctx, roomID)
+		if err != nil {
+			return err
+		}
+		if disconnected {
+			continue
+		}
+
+		if err := u.replaceHost(ctx, roomID, hostID); err != nil {
+			return err
+		}
+	}
+
+	if removed {
+		u.jamRepository.CheckAllReadyAndPlay(ctx, roomID)
+	}
+	return nil
+}
+
+// ListJams returns the rooms a user may discover: every public room and any room hosted
+// by someone they follow. userID is 0 for anonymous users, who only see public rooms.
+func (u *Usecase) ListJams(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.JamSummary, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	following := make(map[string]bool)
+	if userID != 0 {
+		followingProto, err := u.userClient.GetFollowing(ctx, &userProto.UserIDWithPagination{
+			UserId:     userID,
+			Pagination: &userProto.Pagination{Offset: 0, Limit: maxFollowingLookup},
+		})
+		if err != nil {
+			logger.Error("failed to get following", zap.Error(err))
+			return nil, err
+		}
+		for _, user := range followingProto.Users {
+			following[strconv.FormatInt(user.Id, 10)] = true
+		}
+	}
+
+	rooms, err := u.jamRepository.GetActiveJams(ctx)
+	if err != nil {
+		logger.Error("failed to get active jams", zap.Error(err))
+		return nil, err
+	}
+
+	summaries := make([]*usecase.JamSummary, 0)
+	skipped := 0
+	for start := 0; start < len(rooms) && len(summaries) < pagination.Limit; start += jamSummaryBatch {
+		repoSummaries, err := u.jamRepository.GetJamSummaries(ctx, rooms[start:min(start+jamSummaryBatch, len(rooms))])
+		if err != nil {
+			logger.Error("failed to get jam summaries", zap.Error(err))
+			return nil, err
+		}
+
+		for _, repoSummary := range repoSummaries {
+			if len(summaries) >= pagination.Limit {
+				break
+			}
+
+			friend := following[repoSummary.HostID]
+			if repoSummary.Private && !friend {
+				continue
+			}
+
+			if skipped < pagination.Offset {
+				skipped++
+				continue
+			}
+
+			summaries = append(summaries, model.JamSummaryFromRepositoryToUsecase(repoSummary, friend))
+		}
+	}
+
+	return summaries, nil
+}
+
+// startSession and addParticipant keep the history of the room, a failed write must not
+// stop the jam itself
+func (u *Usecase) startSession(ctx context.Context, roomID string, userIDStr string) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		logger.Error("failed to parse host id", zap.Error(err))
+		return
+	}
+	if err := u.historyRepository.StartSession(ctx, roomID, userID); err != nil {
+		logger.Error("failed to start jam session", zap.Error(err))
+	}
+}
+
+func (u *Usecase) addParticipant(ctx context.Context, roomID string, userIDStr string) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	userID, err := strconv.ParseInt(userIDStr, 10, 64)
+	if err != nil {
+		logger.Error("failed to parse user id", zap.Error(err))
+		return
+	}
+	if err := u.historyRepository.AddParticipant(ctx, roomID, userID); err != nil {
+		logger.Error("failed to add jam session participant", zap.Error(err))
+	}
+}
+
+// finishTrack records the track the room is moving away from and counts a stream for the host
+// and every listener, for as long as each of them listened to it. Streams no longer depend on
+// every client reporting them.
+func (u *Usecase) finishTrack(ctx context.Context, roomID string) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	playback, err := u.jamRepository.GetPlayback(ctx, roomID)
+	if err != nil {
+		logger.Error("failed to get jam playback", zap.Error(err))
+		return
+	}
+	if playback == nil {
+		return
+	}
+
+	trackID, err := strconv.ParseInt(playback.TrackID, 10, 64)
+	played := playback.Played / 1000
+	if err != nil || played <= 0 {
+		return
+	}
+
+	// a room left playing by a dead gateway keeps counting, the track length caps it
+	trackData, err := u.trackUsecase.GetTrackByID(ctx, trackID)
+	if err != nil {
+		logger.Error("failed to get jam track", zap.Int64("track_id", trackID), zap.Error(err))
+		return
+	}
+	if trackData.Duration > 0 {
+		played = min(played, trackData.Duration)
+	}
+
+	err = u.historyRepository.RecordTrack(ctx, roomID, trackID, played)
+	if err != nil {
+		logger.Error("failed to record jam track", zap.Error(err))
+	}
+
+	u.recordStreams(ctx, trackID, trackData.Duration, playback.Listened)
+}
+
+// stopListening counts a stream for a user who leaves the room before the track ends
+func (u *Usecase) stopListening(ctx context.Context, roomID string, userID string) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	listen, err := u.jamRepository.StopListening(ctx, roomID, userID)
+	if err != nil {
+		logger.Error("failed to stop jam listen", zap.Error(err))
+		return
+	}
+	if listen == nil || listen.Listened < 1000 {
+		return
+	}
+
+	trackID, err := strconv.ParseInt(listen.TrackID, 10, 64)
+	if err != nil {
+		return
+	}
+
+	trackData, err := u.trackUsecase.GetTrackByID(ctx, trackID)
+	if err != nil {
+		logger.Error("failed to get jam track", zap.Int64("track_id", trackID), zap.Error(err))
+		return
+	}
+
+	u.recordStreams(ctx, trackID, trackData.Duration, map[string]int64{userID: listen.Listened})
+}
+
+// recordStreams counts a stream for every user by the ms they listened, the track length caps it
+func (u *Usecase) recordStreams(ctx context.Context, trackID int64, trackDuration int64, listened map[string]int64) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	for userIDStr, listenedMs := range listened {
+		userID, err := strconv.ParseInt(userIDStr, 10, 64)
+		duration := listenedMs / 1000
+		if trackDuration > 0 {
+			duration = min(duration, trackDuration)
+		}
+		if err != nil || duration <= 0 {
+			continue
+		}
+		if err := u.recordStream(ctx, trackID, userID, duration); err != nil {
+			logger.Error("failed to record jam stream", zap.Int64("user_id", userID), zap.Error(err))
+		}
+	}
+}
+
+func (u *Usecase) recordStream(ctx context.Context, trackID int64, userID int64, duration int64) error {
+	streamID, err := u.trackUsecase.CreateStream(ctx, &usecase.TrackStreamCreateData{
+		TrackID: trackID,
+		UserID:  userID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return u.trackUsecase.UpdateStreamDuration(ctx, &usecase.TrackStreamUpdateData{
+		StreamID: streamID,
+		UserID:   userID,
+		Duration: duration,
+	})
+}
+
+// closeJam records what was playing and ends the session before the room is erased
+func (u *Usecase) closeJam(ctx context.Context, roomID string) error {
+	u.finishTrack(ctx, roomID)
+
+	if err := u.historyRepository.EndSession(ctx, roomID); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Error("failed to end jam session", zap.Error(err))
+	}
+
+	return u.jamRepository.RemoveJam(ctx, roomID)
+}
+
+// GetSessions returns the jams the user took part in, newest first
+func (u *Usecase) GetSessions(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.JamSession, error) {
+	sessions, err := u.historyRepository.GetSessions(ctx, userID, repository.PaginationFromUsecaseToRepository(pagination))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.JamSessionsFromRepositoryToUsecase(sessions), nil
+}
+
+func (u *Usecase) GetSession(ctx context.Context, sessionID int64, userID int64) (*usecase.JamSessionDetails, error) {
+	repoSession, err := u.historyRepository.GetSession(ctx, sessionID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	session := model.JamSessionDetailsFromRepositoryToUsecase(repoSession)
+	for _, participant := range session.ParticipantList {
+		if participant.AvatarURL == "" {
+			continue
+		}
+		avatarURL, err := u.userClient.GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: participant.AvatarURL})
+		if err != nil {
+			participant.AvatarURL = ""
+			participant.AvatarColor = ""
+			continue
+		}
+		participant.AvatarURL = avatarURL.Url
+	}
+
+	return session, nil
+}
+
+// CreateSessionPlaylist saves everything played in a session as a playlist of the user,
+// titled after the day of the jam unless the user picks a title. The playlist follows the
+// user's playlist privacy.
+func (u *Usecase) CreateSessionPlaylist(ctx context.Context, request *usecase.CreateJamPlaylistRequest) (int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	session, err := u.historyRepository.GetSession(ctx, request.SessionID, request.UserID)
+	if err != nil {
+		return 0, err
+	}
+	if len(session.TrackList) == 0 {
+		return 0, customErrors.ErrJamSessionEmpty
+	}
+
+	title := strings.TrimSpace(request.Title)
+	numbered := title == ""
+	if numbered {
+		title = "Jam " + session.StartedAt.Format("2006-01-02")
+	}
+	if utf8.RuneCountInString(title) > maxJamPlaylistTitleLength {
+		return 0, customErrors.ErrJamPlaylistInvalidTitle
+	}
+
+	privacy, err := u.userClient.GetUserPrivacyByID(ctx, &userProto.UserID{Id: request.UserID})
+	if err != nil {
+		logger.Error("failed to get user privacy", zap.Error(err))
+		return 0, customErrors.HandleUserGRPCError(err)
+	}
+
+	playlist, err := u.createPlaylist(ctx, request.UserID, title, numbered, privacy.GetIsPublicPlaylists())
+	if err != nil {
+		return 0, err
+	}
+
+	// tracks played more than once are added once, in the order they were first played
+	added := make(map[int64]bool, len(session.TrackList))
+	for _, track := range session.TrackList {
+		if added[track.TrackID] {
+			continue
+		}
+		added[track.TrackID] = true
+
+		_, err := u.playlistClient.AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{
+			UserId:     request.UserID,
+			PlaylistId: playlist.GetId(),
+			TrackId:    track.TrackID,
+		})
+		if err != nil {
+			logger.Error("failed to add jam track to playlist", zap.Int64("track_id", track.TrackID), zap.Error(err))
+			// half of a jam is not what the user asked to save
+			_, removeErr := u.playlistClient.RemovePlaylist(ctx, &playlistProto.RemovePlaylistRequest{
+				UserId:     request.UserID,
+				PlaylistId: playlist.GetId(),
+			})
+			if removeErr != nil {
+				logger.Error("failed to remove incomplete jam playlist", zap.Error(removeErr))
+			}
+			return 0, customErrors.HandlePlaylistGRPCError(err)
+		}
+	}
+
+	return playlist.GetId(), nil
+}
+
+// createPlaylist creates an empty playlist, a taken default title gets the next free number
+// while a title the user picked is reported as taken
+func (u *Usecase) createPlaylist(ctx context.Context, userID int64, title string, numbered bool, isPublic bool) (*playlistProto.Playlist, error) {
+	candidate := title
+	for number := 2; ; number++ {
+		playlist, err := u.playlistClient.CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{
+			UserId:   userID,
+			Title:    candidate,
+			IsPublic: isPublic,
+		})
+		if err == nil {
+			return playlist, nil
+		}
+
+		err = customErrors.HandlePlaylistGRPCError(err)
+		if !errors.Is(err, customErrors.ErrPlaylistDuplicate) {
+			return nil, err
+		}
+		if !numbered || number > maxJamPlaylistTitleNumber {
+			return nil, customErrors.ErrJamPlaylistTitleTaken
+		}
+		candidate = fmt.Sprintf("%s (%d)", title, number)
+	}
+}
+
 func (u *Usecase) SubscribeToJamMessages(ctx context.Context, roomID string) (<-chan *usecase.JamMessage, error) {
 	repoMessageChan, err := u.jamRepository.SubscribeToJamMessages(ctx, roomID)
 	if err != nil {
@@ -4,35 +4,58 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	playlistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
 	userProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	mock_jam "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/jam/mocks"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_track "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track/mocks"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func setupTest(t *testing.T) (*mock_jam.MockRepository, *mocks.MockUserServiceClient, *Usecase, context.Context) {
+	mockRepo, _, _, mockUserClient, uc, ctx := setupHistoryTest(t)
+	return mockRepo, mockUserClient, uc, ctx
+}
+
+func setupHistoryTest(t *testing.T) (*mock_jam.MockRepository, *mock_jam.MockHistoryRepository, *mock_track.MockUsecase, *mocks.MockUserServiceClient, *Usecase, context.Context) {
+	mockRepo, mockHistory, mockTrack, mockUserClient, _, uc, ctx := setupPlaylistTest(t)
+	return mockRepo, mockHistory, mockTrack, mockUserClient, uc, ctx
+}
+
+func setupPlaylistTest(t *testing.T) (*mock_jam.MockRepository, *mock_jam.MockHistoryRepository, *mock_track.MockUsecase, *mocks.MockUserServiceClient, *mocks.MockPlaylistServiceClient, *Usecase, context.Context) {
 	ctrl := gomock.NewController(t)
 	mockRepo := mock_jam.NewMockRepository(ctrl)
+	mockHistory := mock_jam.NewMockHistoryRepository(ctrl)
+	mockTrack := mock_track.NewMockUsecase(ctrl)
 	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+	mockPlaylistClient := mocks.NewMockPlaylistServiceClient(ctrl)
 
-	uc := NewUsecase(mockRepo, mockUserClient)
+	uc := NewUsecase(mockRepo, mockHistory, mockUserClient, mockPlaylistClient, mockTrack, config.JamConfig{})
 
 	logger := zap.NewNop().Sugar()
 	ctx := loggerPkg.LoggerToContext(context.Background(), logger)
 
-	return mockRepo, mockUserClient, uc, ctx
+	return mockRepo, mockHistory, mockTrack, mockUserClient, mockPlaylistClient, uc, ctx
 }
 
 func TestCreateJam(t *testing.T) {
-	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+	mockRepo, mockHistory, _, mockUserClient, uc, ctx := setupHistoryTest(t)
 
 	request := &usecase.CreateJamRequest{
 		UserID:   "123",
@@ -58,13 +81,59 @@ func TestCreateJam(t *testing.T) {
 	mockRepo.EXPECT().CreateJam(ctx, gomock.Any()).Return(repoResponse, nil)
 	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
 	mockUserClient.EXPECT().GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: "avatar.jpg"}).Return(avatarURL, nil)
-	mockRepo.EXPECT().StoreUserInfo(ctx, "room789", "123", "testuser", "http://example.com/avatar.jpg").Return(nil)
+	mockRepo.EXPECT().TouchPresence(ctx, "room789", "123").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room789", time.Duration(0)).Return(nil)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room789", "123", "testuser", "http://example.com/avatar.jpg", "").Return(nil)
+	mockHistory.EXPECT().StartSession(ctx, "room789", int64(123)).Return(nil)
 
 	response, err := uc.CreateJam(ctx, request)
 
 	require.NoError(t, err)
 	assert.Equal(t, "room789", response.RoomID)
 	assert.Equal(t, "123", response.HostID)
+	assert.NotEmpty(t, response.InviteToken)
+}
+
+func TestCreateJam_WithAccessRules(t *testing.T) {
+	mockRepo, mockHistory, _, mockUserClient, uc, ctx := setupHistoryTest(t)
+
+	request := &usecase.CreateJamRequest{
+		UserID:       "123",
+		TrackID:      "track456",
+		Private:      true,
+		Password:     "secret",
+		MaxListeners: 5,
+	}
+
+	var stored *repository.CreateJamRequest
+	mockRepo.EXPECT().CreateJam(ctx, gomock.Any()).DoAndReturn(
+		func(_ context.Context, repoRequest *repository.CreateJamRequest) (*repository.CreateJamResponse, error) {
+			stored = repoRequest
+			return &repository.CreateJamResponse{RoomID: "room789", HostID: "123"}, nil
+		})
+	mockUserClient.EXPECT().GetUserByID(ctx, gomock.Any()).Return(nil, errors.New("user error"))
+	mockRepo.EXPECT().TouchPresence(ctx, "room789", "123").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room789", time.Duration(0)).Return(nil)
+	mockHistory.EXPECT().StartSession(ctx, "room789", int64(123)).Return(nil)
+
+	response, err := uc.CreateJam(ctx, request)
+
+	require.NoError(t, err)
+	assert.True(t, stored.Private)
+	assert.Equal(t, int64(5), stored.MaxListeners)
+	assert.Equal(t, stored.InviteToken, response.InviteToken)
+	assert.NotEqual(t, "secret", stored.PasswordHash)
+	assert.True(t, checkPasswordHash(stored.PasswordHash, "secret"))
+	assert.False(t, checkPasswordHash(stored.PasswordHash, "wrong"))
+}
+
+func TestCreateJam_NegativeMaxListeners(t *testing.T) {
+	_, _, uc, ctx := setupTest(t)
+
+	response, err := uc.CreateJam(ctx, &usecase.CreateJamRequest{UserID: "123", TrackID: "track456", MaxListeners: -1})
+
+	assert.ErrorIs(t, err, customErrors.ErrJamInvalidMaxListeners)
+	assert.Nil(t, response)
 }
 
 func TestCreateJam_RepositoryError(t *testing.T) {
@@ -87,7 +156,7 @@ func TestCreateJam_RepositoryError(t *testing.T) {
 }
 
 func TestCreateJam_StoreUserInfoError(t *testing.T) {
-	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+	mockRepo, mockHistory, _, mockUserClient, uc, ctx := setupHistoryTest(t)
 
 	request := &usecase.CreateJamRequest{
 		UserID:   "123",
@@ -102,6 +171,8 @@ func TestCreateJam_StoreUserInfoError(t *testing.T) {
 
 	mockRepo.EXPECT().CreateJam(ctx, gomock.Any()).Return(repoResponse, nil)
 	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(nil, errors.New("user not found"))
+	mockRepo.EXPECT().TouchPresence(ctx, "room789", "123").Return(errors.New("redis error"))
+	mockHistory.EXPECT().StartSession(ctx, "room789", int64(123)).Return(errors.New("db error"))
 
 	response, err := uc.CreateJam(ctx, request)
 
@@ -111,7 +182,7 @@ func TestCreateJam_StoreUserInfoError(t *testing.T) {
 }
 
 func TestJoinJam_Success(t *testing.T) {
-	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+	mockRepo, mockHistory, _, mockUserClient, uc, ctx := setupHistoryTest(t)
 
 	request := &usecase.JoinJamRequest{
 		RoomID: "room123",
@@ -135,10 +206,15 @@ func TestJoinJam_Success(t *testing.T) {
 
 	mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+	mockRepo.EXPECT().IsUserBanned(ctx, "room123", "456").Return(false, nil)
+	mockRepo.EXPECT().GetJamAccess(ctx, "room123").Return(&repository.JamAccess{}, nil)
 	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 456}).Return(userProtoData, nil)
-	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "456", "joiner", "").Return(nil)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "456", "joiner", "", "").Return(nil)
 	mockRepo.EXPECT().AddUser(ctx, "room123", "456").Return(nil)
 	mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil)
+	mockHistory.EXPECT().AddParticipant(ctx, "room123", int64(456)).Return(nil)
+	mockRepo.EXPECT().TouchPresence(ctx, "room123", "456").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room123", time.Duration(0)).Return(nil)
 	mockRepo.EXPECT().GetInitialJamData(ctx, "room123").Return(repoJamData, nil)
 
 	response, err := uc.JoinJam(ctx, request)
@@ -150,6 +226,122 @@ func TestJoinJam_Success(t *testing.T) {
 	assert.True(t, response.Paused)
 }
 
+func TestJoinJam_AttachesLyrics(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	lyrics := []*usecase.TrackLyrics{
+		{Language: "en", Synced: true, Lines: []*usecase.LyricsLine{{Time: 1000, Text: "Hello"}}},
+	}
+
+	mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+	mockRepo.EXPECT().ClearHostDisconnected(ctx, "room123", "123").Return(nil)
+	mockRepo.EXPECT().TouchPresence(ctx, "room123", "123").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room123", time.Duration(0)).Return(nil)
+	mockRepo.EXPECT().GetInitialJamData(ctx, "room123").Return(&repository.JamMessage{Type: "init", TrackID: "42"}, nil)
+	mockTrack.EXPECT().GetTrackLyrics(ctx, int64(42)).Return(lyrics, nil)
+
+	response, err := uc.JoinJam(ctx, &usecase.JoinJamRequest{RoomID: "room123", UserID: "123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, lyrics, response.Lyrics)
+}
+
+func TestJoinJam_LyricsErrorIgnored(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+	mockRepo.EXPECT().ClearHostDisconnected(ctx, "room123", "123").Return(nil)
+	mockRepo.EXPECT().TouchPresence(ctx, "room123", "123").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room123", time.Duration(0)).Return(nil)
+	mockRepo.EXPECT().GetInitialJamData(ctx, "room123").Return(&repository.JamMessage{Type: "init", TrackID: "42"}, nil)
+	mockTrack.EXPECT().GetTrackLyrics(ctx, int64(42)).Return(nil, errors.New("track service is down"))
+
+	response, err := uc.JoinJam(ctx, &usecase.JoinJamRequest{RoomID: "room123", UserID: "123"})
+
+	require.NoError(t, err)
+	assert.Equal(t, "42", response.TrackID)
+	assert.Nil(t, response.Lyrics)
+}
+
+func TestJoinJam_AccessDenied(t *testing.T) {
+	passwordHash := hashPassword([]byte("saltsalt"), "secret")
+
+	tests := []struct {
+		name         string
+		request      *usecase.JoinJamRequest
+		banned       bool
+		access       *repository.JamAccess
+		expectedErr  error
+		checksAccess bool
+	}{
+		{
+			name:        "Banned",
+			request:     &usecase.JoinJamRequest{RoomID: "room123", UserID: "456"},
+			banned:      true,
+			expectedErr: customErrors.ErrJamBanned,
+		},
+		{
+			name:         "Private without invite",
+			request:      &usecase.JoinJamRequest{RoomID: "room123", UserID: "456", InviteToken: "wrong"},
+			access:       &repository.JamAccess{Private: true, InviteToken: "token"},
+			expectedErr:  customErrors.ErrJamInviteRequired,
+			checksAccess: true,
+		},
+		{
+			name:         "Wrong password",
+			request:      &usecase.JoinJamRequest{RoomID: "room123", UserID: "456", Password: "guess"},
+			access:       &repository.JamAccess{PasswordHash: passwordHash, InviteToken: "token"},
+			expectedErr:  customErrors.ErrJamWrongPassword,
+			checksAccess: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, _, uc, ctx := setupTest(t)
+
+			mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
+			mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+			mockRepo.EXPECT().IsUserBanned(ctx, "room123", "456").Return(tt.banned, nil)
+			if tt.checksAccess {
+				mockRepo.EXPECT().GetJamAccess(ctx, "room123").Return(tt.access, nil)
+			}
+
+			response, err := uc.JoinJam(ctx, tt.request)
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, response)
+		})
+	}
+}
+
+func TestJoinJam_WithPassword(t *testing.T) {
+	mockRepo, mockHistory, _, mockUserClient, uc, ctx := setupHistoryTest(t)
+
+	request := &usecase.JoinJamRequest{RoomID: "room123", UserID: "456", Password: "secret"}
+	access := &repository.JamAccess{PasswordHash: hashPassword([]byte("saltsalt"), "secret")}
+
+	mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+	mockRepo.EXPECT().IsUserBanned(ctx, "room123", "456").Return(false, nil)
+	mockRepo.EXPECT().GetJamAccess(ctx, "room123").Return(access, nil)
+	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 456}).Return(&userProto.UserFront{Id: 456, Username: "joiner"}, nil)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "456", "joiner", "", "").Return(nil)
+	mockRepo.EXPECT().AddUser(ctx, "room123", "456").Return(nil)
+	mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil)
+	mockHistory.EXPECT().AddParticipant(ctx, "room123", int64(456)).Return(nil)
+	mockRepo.EXPECT().TouchPresence(ctx, "room123", "456").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room123", time.Duration(0)).Return(nil)
+	mockRepo.EXPECT().GetInitialJamData(ctx, "room123").Return(&repository.JamMessage{Type: "init"}, nil)
+
+	response, err := uc.JoinJam(ctx, request)
+
+	require.NoError(t, err)
+	assert.Equal(t, "init", response.Type)
+}
+
 func TestJoinJam_RoomNotExists(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
@@ -204,6 +396,9 @@ func TestJoinJam_HostJoining(t *testing.T) {
 
 	mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+	mockRepo.EXPECT().ClearHostDisconnected(ctx, "room123", "123").Return(nil)
+	mockRepo.EXPECT().TouchPresence(ctx, "room123", "123").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room123", time.Duration(0)).Return(nil)
 	mockRepo.EXPECT().GetInitialJamData(ctx, "room123").Return(repoJamData, nil)
 
 	response, err := uc.JoinJam(ctx, request)
@@ -213,6 +408,23 @@ func TestJoinJam_HostJoining(t *testing.T) {
 	assert.Equal(t, "track789", response.TrackID)
 }
 
+func TestJoinJam_Full(t *testing.T) {
+	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+
+	mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+	mockRepo.EXPECT().IsUserBanned(ctx, "room123", "456").Return(false, nil)
+	mockRepo.EXPECT().GetJamAccess(ctx, "room123").Return(&repository.JamAccess{}, nil)
+	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 456}).Return(&userProto.UserFront{Id: 456, Username: "joiner"}, nil)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "456", "joiner", "", "").Return(nil)
+	mockRepo.EXPECT().AddUser(ctx, "room123", "456").Return(customErrors.ErrJamFull)
+
+	response, err := uc.JoinJam(ctx, &usecase.JoinJamRequest{RoomID: "room123", UserID: "456"})
+
+	assert.ErrorIs(t, err, customErrors.ErrJamFull)
+	assert.Nil(t, response)
+}
+
 func TestJoinJam_AddUserError(t *testing.T) {
 	mockRepo, mockUserClient, uc, ctx := setupTest(t)
 
@@ -230,8 +442,10 @@ func TestJoinJam_AddUserError(t *testing.T) {
 	expectedErr := errors.New("add user error")
 	mockRepo.EXPECT().ExistsRoom(ctx, "room123").Return(true, nil)
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("123", nil)
+	mockRepo.EXPECT().IsUserBanned(ctx, "room123", "456").Return(false, nil)
+	mockRepo.EXPECT().GetJamAccess(ctx, "room123").Return(&repository.JamAccess{}, nil)
 	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 456}).Return(userProtoData, nil)
-	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "456", "joiner", "").Return(nil)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "456", "joiner", "", "").Return(nil)
 	mockRepo.EXPECT().AddUser(ctx, "room123", "456").Return(expectedErr)
 
 	response, err := uc.JoinJam(ctx, request)
@@ -263,7 +477,8 @@ func TestHandleClientMessage_HostLoad(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
-	mockRepo.EXPECT().LoadTrack(ctx, "room123", "track789").Return(nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{HostID: "user456"}, nil)
+	mockRepo.EXPECT().LoadTrack(ctx, "room123", "track789", nil).Return(nil)
 	mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil)
 	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
 
@@ -281,6 +496,7 @@ func TestHandleClientMessage_HostLoad_NotHost(t *testing.T) {
 	}
 
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsCoHost(ctx, "room123", "user456").Return(false, nil)
 
 	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
@@ -364,228 +580,915 @@ func TestHandleClientMessage_HostSeek(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-func TestHandleClientMessage_GetHostIDError(t *testing.T) {
+func TestHandleClientMessage_HostTransfer(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
-	message := &usecase.JamMessage{
-		Type: "host:play",
-	}
+	message := &usecase.JamMessage{Type: "host:transfer", UserID: "user789"}
 
-	expectedErr := errors.New("get host error")
-	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("", expectedErr)
+	gomock.InOrder(
+		mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil),
+		mockRepo.EXPECT().IsUserInJam(ctx, "room123", "user789").Return(true, nil),
+		mockRepo.EXPECT().TransferHost(ctx, "room123", "user456", "user789").Return(nil),
+		mockRepo.EXPECT().AddUser(ctx, "room123", "user456").Return(nil),
+		mockRepo.EXPECT().MarkUserAsReady(ctx, "room123", "user456").Return(nil),
+	)
 
 	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
-	assert.Error(t, err)
-	assert.Equal(t, expectedErr, err)
+	assert.NoError(t, err)
 }
 
-func TestLeaveJam_HostLeaving(t *testing.T) {
+func TestHandleClientMessage_HostTransfer_NotHost(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
+	message := &usecase.JamMessage{Type: "host:transfer", UserID: "user789"}
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
-	mockRepo.EXPECT().RemoveJam(ctx, "room123").Return(nil)
 
-	err := uc.LeaveJam(ctx, "room123", "user456")
+	err := uc.HandleClientMessage(ctx, "room123", "user789", message)
 
-	assert.NoError(t, err)
+	assert.Error(t, err)
 }
 
-func TestLeaveJam_UserLeaving(t *testing.T) {
+func TestHandleClientMessage_HostTransfer_NotInJam(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
-	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
-	mockRepo.EXPECT().RemoveUser(ctx, "room123", "user456").Return(nil)
-	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
+	message := &usecase.JamMessage{Type: "host:transfer", UserID: "user789"}
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().IsUserInJam(ctx, "room123", "user789").Return(false, nil)
 
-	err := uc.LeaveJam(ctx, "room123", "user456")
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
-	assert.NoError(t, err)
+	assert.Error(t, err)
 }
 
-func TestLeaveJam_GetHostIDError(t *testing.T) {
+func TestHandleClientMessage_GetHostIDError(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
+	message := &usecase.JamMessage{
+		Type: "host:play",
+	}
+
 	expectedErr := errors.New("get host error")
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("", expectedErr)
 
-	err := uc.LeaveJam(ctx, "room123", "user456")
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
 
-func TestLeaveJam_RemoveJamError(t *testing.T) {
-	mockRepo, _, uc, ctx := setupTest(t)
+func TestHandleClientMessage_QueueSuggest(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+	uc.cfg.SuggestionLimit = 50
+	uc.cfg.UserSuggestionLimit = 5
+
+	message := &usecase.JamMessage{Type: "queue:suggest", TrackID: "789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(789)).Return(&usecase.TrackDetailed{Track: usecase.Track{ID: 789}}, nil)
+	mockRepo.EXPECT().SuggestTrack(ctx, "room123", "user456", "789", int64(50), int64(5)).Return(nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_QueueSuggest_HostEnqueues(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	message := &usecase.JamMessage{Type: "queue:suggest", TrackID: "789"}
 
-	expectedErr := errors.New("remove jam error")
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
-	mockRepo.EXPECT().RemoveJam(ctx, "room123").Return(expectedErr)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(789)).Return(&usecase.TrackDetailed{Track: usecase.Track{ID: 789}}, nil)
+	mockRepo.EXPECT().EnqueueTrack(ctx, "room123", "789").Return(nil)
 
-	err := uc.LeaveJam(ctx, "room123", "user456")
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
-	assert.Error(t, err)
-	assert.Equal(t, expectedErr, err)
+	assert.NoError(t, err)
 }
 
-func TestLeaveJam_RemoveUserError(t *testing.T) {
+func TestHandleClientMessage_QueueSuggest_NoTrack(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
-	expectedErr := errors.New("remove user error")
+	message := &usecase.JamMessage{Type: "queue:suggest"}
+
 	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
-	mockRepo.EXPECT().RemoveUser(ctx, "room123", "user456").Return(expectedErr)
 
-	err := uc.LeaveJam(ctx, "room123", "user456")
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
 	assert.Error(t, err)
-	assert.Equal(t, expectedErr, err)
 }
 
-func TestSubscribeToJamMessages_Success(t *testing.T) {
+func TestHandleClientMessage_QueueSuggest_InvalidTrackID(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
-	repoMessageChan := make(chan []byte, 1)
+	message := &usecase.JamMessage{Type: "queue:suggest", TrackID: "track789"}
 
-	repoJamMessage := &repository.JamMessage{
-		Type:     "play",
-		TrackID:  "track123",
-		Position: 1000,
-		Paused:   false,
-	}
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
 
-	messageBytes, _ := json.Marshal(repoJamMessage)
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
-	mockRepo.EXPECT().SubscribeToJamMessages(ctx, "room123").Return((<-chan []byte)(repoMessageChan), nil)
+	assert.ErrorIs(t, err, customErrors.ErrTrackNotFound)
+}
 
-	usecaseMessageChan, err := uc.SubscribeToJamMessages(ctx, "room123")
+func TestHandleClientMessage_QueueSuggest_UnknownTrack(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
 
-	require.NoError(t, err)
-	assert.NotNil(t, usecaseMessageChan)
+	message := &usecase.JamMessage{Type: "queue:suggest", TrackID: "789"}
 
-	repoMessageChan <- messageBytes
-	close(repoMessageChan)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(789)).Return(nil, customErrors.ErrTrackNotFound)
 
-	receivedMessage := <-usecaseMessageChan
-	assert.Equal(t, "play", receivedMessage.Type)
-	assert.Equal(t, "track123", receivedMessage.TrackID)
-	assert.Equal(t, int64(1000), receivedMessage.Position)
-	assert.False(t, receivedMessage.Paused)
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.ErrorIs(t, err, customErrors.ErrTrackNotFound)
 }
 
-func TestSubscribeToJamMessages_RepositoryError(t *testing.T) {
+func TestHandleClientMessage_QueueVote(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
-	expectedErr := errors.New("subscription error")
-	mockRepo.EXPECT().SubscribeToJamMessages(ctx, "room123").Return(nil, expectedErr)
+	message := &usecase.JamMessage{Type: "queue:vote", TrackID: "track789"}
 
-	usecaseMessageChan, err := uc.SubscribeToJamMessages(ctx, "room123")
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().VoteTrack(ctx, "room123", "user456", "track789").Return(nil)
 
-	assert.Error(t, err)
-	assert.Equal(t, expectedErr, err)
-	assert.Nil(t, usecaseMessageChan)
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
 }
 
-func TestSubscribeToJamMessages_InvalidJSON(t *testing.T) {
+func TestHandleClientMessage_HostApprove(t *testing.T) {
 	mockRepo, _, uc, ctx := setupTest(t)
 
-	repoMessageChan := make(chan []byte, 1)
-
-	mockRepo.EXPECT().SubscribeToJamMessages(ctx, "room123").Return((<-chan []byte)(repoMessageChan), nil)
-
-	usecaseMessageChan, err := uc.SubscribeToJamMessages(ctx, "room123")
+	message := &usecase.JamMessage{Type: "host:approve", TrackID: "track789"}
 
-	require.NoError(t, err)
-	assert.NotNil(t, usecaseMessageChan)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().ApproveSuggestion(ctx, "room123", "track789").Return(nil)
 
-	repoMessageChan <- []byte("invalid json")
-	close(repoMessageChan)
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
-	select {
-	case msg := <-usecaseMessageChan:
-		t.Errorf("Expected no message due to invalid JSON, but got: %v", msg)
-	default:
-	}
+	assert.NoError(t, err)
 }
 
-func TestStoreUserInfo_Success(t *testing.T) {
-	mockRepo, mockUserClient, uc, ctx := setupTest(t)
-
-	userProtoData := &userProto.UserFront{
-		Id:       123,
-		Username: "testuser",
-		Avatar:   "avatar.jpg",
-	}
+func TestHandleClientMessage_HostApprove_NotHost(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
 
-	avatarURL := &userProto.AvatarUrl{
-		Url: "http://example.com/avatar.jpg",
-	}
+	message := &usecase.JamMessage{Type: "host:approve", TrackID: "track789"}
 
-	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
-	mockUserClient.EXPECT().GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: "avatar.jpg"}).Return(avatarURL, nil)
-	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "http://example.com/avatar.jpg").Return(nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsCoHost(ctx, "room123", "user456").Return(false, nil)
 
-	err := uc.storeUserInfo(ctx, "room123", "123")
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
-	assert.NoError(t, err)
+	assert.Error(t, err)
+	assert.Equal(t, "not host", err.Error())
 }
 
-func TestStoreUserInfo_NoAvatar(t *testing.T) {
-	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+func TestHandleClientMessage_HostReorder_InvalidPosition(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
 
-	userProtoData := &userProto.UserFront{
-		Id:       123,
-		Username: "testuser",
-		Avatar:   "",
-	}
+	message := &usecase.JamMessage{Type: "host:reorder", TrackID: "track789", Position: -1}
 
-	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
-	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "").Return(nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
 
-	err := uc.storeUserInfo(ctx, "room123", "123")
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
-	assert.NoError(t, err)
+	assert.Error(t, err)
 }
 
-func TestStoreUserInfo_InvalidUserID(t *testing.T) {
-	_, _, uc, ctx := setupTest(t)
+func TestHandleClientMessage_HostNext(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
 
-	err := uc.storeUserInfo(ctx, "room123", "invalid")
+	message := &usecase.JamMessage{Type: "host:next"}
 
-	assert.Error(t, err)
+	gomock.InOrder(
+		mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil),
+		mockRepo.EXPECT().PopQueue(ctx, "room123").Return("track789", nil),
+		mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{HostID: "user456"}, nil),
+		mockRepo.EXPECT().LoadTrack(ctx, "room123", "track789", nil).Return(nil),
+		mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil),
+		mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123"),
+	)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
 }
 
-func TestStoreUserInfo_GetUserByIDError(t *testing.T) {
-	_, mockUserClient, uc, ctx := setupTest(t)
+func TestHandleClientMessage_HostNext_EmptyQueue(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
 
-	expectedErr := errors.New("user not found")
-	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(nil, expectedErr)
+	message := &usecase.JamMessage{Type: "host:next"}
 
-	err := uc.storeUserInfo(ctx, "room123", "123")
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().PopQueue(ctx, "room123").Return("", nil)
 
-	assert.Error(t, err)
-	assert.Equal(t, expectedErr, err)
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
 }
 
-func TestStoreUserInfo_GetAvatarURLError(t *testing.T) {
-	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+func TestHandleClientMessage_TrackEnded(t *testing.T) {
+	mockRepo, mockHistory, mockTrack, _, uc, ctx := setupHistoryTest(t)
 
-	userProtoData := &userProto.UserFront{
-		Id:       123,
-		Username: "testuser",
-		Avatar:   "avatar.jpg",
-	}
+	message := &usecase.JamMessage{Type: "track:ended", TrackID: "1"}
+	playback := &repository.JamPlayback{TrackID: "1", Position: 178000, Played: 178000, HostID: "host123"}
 
-	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
-	mockUserClient.EXPECT().GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: "avatar.jpg"}).Return(nil, errors.New("avatar error"))
-	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "").Return(nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(playback, nil).Times(2)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(1)).Return(&usecase.TrackDetailed{Track: usecase.Track{ID: 1, Duration: 180}}, nil).Times(2)
+	mockRepo.EXPECT().ClaimTrackEnded(ctx, "room123", "1").Return(true, nil)
+	mockRepo.EXPECT().PopQueue(ctx, "room123").Return("2", nil)
+	mockHistory.EXPECT().RecordTrack(ctx, "room123", int64(1), int64(178)).Return(nil)
+	mockTrack.EXPECT().GetTrackLyrics(ctx, int64(2)).Return(nil, nil)
+	mockRepo.EXPECT().LoadTrack(ctx, "room123", "2", nil).Return(nil)
+	mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
 
-	err := uc.storeUserInfo(ctx, "room123", "123")
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
 
 	assert.NoError(t, err)
 }
 
-func TestStoreUserInfo_StoreUserInfoError(t *testing.T) {
-	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+func TestHandleClientMessage_TrackEnded_AlreadyClaimed(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	message := &usecase.JamMessage{Type: "track:ended", TrackID: "1"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{TrackID: "1", Position: 180000, HostID: "host123"}, nil)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(1)).Return(&usecase.TrackDetailed{Track: usecase.Track{ID: 1, Duration: 180}}, nil)
+	mockRepo.EXPECT().ClaimTrackEnded(ctx, "room123", "1").Return(false, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_TrackEnded_Early(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	message := &usecase.JamMessage{Type: "track:ended", TrackID: "1"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{TrackID: "1", Position: 30000, HostID: "host123"}, nil)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(1)).Return(&usecase.TrackDetailed{Track: usecase.Track{ID: 1, Duration: 180}}, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_TrackEnded_OtherTrack(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "track:ended", TrackID: "1"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{TrackID: "2", Position: 180000, HostID: "host123"}, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_CoHostLoad(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:load", TrackID: "track789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsCoHost(ctx, "room123", "user456").Return(true, nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{HostID: "host123"}, nil)
+	mockRepo.EXPECT().LoadTrack(ctx, "room123", "track789", nil).Return(nil)
+	mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_CoHostHostOnly(t *testing.T) {
+	for _, messageType := range []string{"host:transfer", "host:promote", "host:demote", "host:heartbeat"} {
+		t.Run(messageType, func(t *testing.T) {
+			mockRepo, _, uc, ctx := setupTest(t)
+
+			mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+
+			err := uc.HandleClientMessage(ctx, "room123", "user456", &usecase.JamMessage{Type: messageType, UserID: "user789"})
+
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestHandleClientMessage_HostHeartbeat(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:heartbeat", Position: 42000}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().Heartbeat(ctx, "room123", int64(42000)).Return(nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_ChatMessage(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+	uc.cfg.ChatHistorySize = 100
+	uc.cfg.ChatMaxLength = 10
+	uc.cfg.MessageRateLimit = 3
+	uc.cfg.MessageRateWindow = 5 * time.Second
+
+	message := &usecase.JamMessage{Type: "chat:message", Text: "  hello  "}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsUserMuted(ctx, "room123", "user456").Return(false, nil)
+	mockRepo.EXPECT().AllowMessage(ctx, "room123", "user456", "chat", int64(3), 5*time.Second).Return(true, nil)
+	mockRepo.EXPECT().AddChatMessage(ctx, "room123", gomock.Any(), int64(100)).DoAndReturn(
+		func(_ context.Context, _ string, chatMessage *repository.JamChatMessage, _ int64) error {
+			assert.NotEmpty(t, chatMessage.ID)
+			assert.Equal(t, "user456", chatMessage.UserID)
+			assert.Equal(t, "hello", chatMessage.Text)
+			return nil
+		})
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_ChatMessage_Rejected(t *testing.T) {
+	tests := []struct {
+		name        string
+		text        string
+		muted       bool
+		allowed     bool
+		expectedErr error
+	}{
+		{name: "Empty", text: "   ", expectedErr: customErrors.ErrJamInvalidMessage},
+		{name: "Too long", text: "hello world!", expectedErr: customErrors.ErrJamInvalidMessage},
+		{name: "Muted", text: "hello", muted: true, expectedErr: customErrors.ErrJamMuted},
+		{name: "Rate limited", text: "hello", expectedErr: customErrors.ErrJamRateLimited},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, _, uc, ctx := setupTest(t)
+			uc.cfg.ChatMaxLength = 10
+			uc.cfg.MessageRateLimit = 3
+
+			mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+			if tt.expectedErr != customErrors.ErrJamInvalidMessage {
+				mockRepo.EXPECT().IsUserMuted(ctx, "room123", "user456").Return(tt.muted, nil)
+			}
+			if tt.expectedErr == customErrors.ErrJamRateLimited {
+				mockRepo.EXPECT().AllowMessage(ctx, "room123", "user456", "chat", int64(3), gomock.Any()).Return(false, nil)
+			}
+
+			err := uc.HandleClientMessage(ctx, "room123", "user456", &usecase.JamMessage{Type: "chat:message", Text: tt.text})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
+
+func TestHandleClientMessage_Reaction(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "reaction", Emoji: "🔥"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsUserMuted(ctx, "room123", "user456").Return(false, nil)
+	mockRepo.EXPECT().SendReaction(ctx, "room123", "user456", "🔥").Return(nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_Reaction_Invalid(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "reaction", Emoji: "not an emoji"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.ErrorIs(t, err, customErrors.ErrJamInvalidMessage)
+}
+
+func TestHandleClientMessage_HostMute(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:mute", UserID: "user789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().SetUserMuted(ctx, "room123", "user789", true).Return(nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_CoHostMuteHost(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:mute", UserID: "host123"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsCoHost(ctx, "room123", "user456").Return(true, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.Error(t, err)
+}
+
+func TestHandleClientMessage_HostDeleteMessage(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:delete_message", MessageID: "msg1"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().DeleteChatMessage(ctx, "room123", "msg1").Return(nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_HostKick(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:kick", UserID: "user789"}
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil),
+		mockRepo.EXPECT().IsUserInJam(ctx, "room123", "user789").Return(true, nil),
+		mockRepo.EXPECT().KickUser(ctx, "room123", "user789", false).Return(nil),
+		mockRepo.EXPECT().StopListening(ctx, "room123", "user789").Return(nil, nil),
+		mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123"),
+	)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_HostKick_NotInJam(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:kick", UserID: "user789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().IsUserInJam(ctx, "room123", "user789").Return(false, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.Error(t, err)
+}
+
+func TestHandleClientMessage_HostBan_NotInJam(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:ban", UserID: "user789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().KickUser(ctx, "room123", "user789", true).Return(nil)
+	mockRepo.EXPECT().StopListening(ctx, "room123", "user789").Return(nil, nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_CoHostKickHost(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:ban", UserID: "host123"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsCoHost(ctx, "room123", "user456").Return(true, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.Error(t, err)
+}
+
+func TestHandleClientMessage_CoHostKickCoHost(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:kick", UserID: "user789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().IsCoHost(ctx, "room123", "user456").Return(true, nil)
+	mockRepo.EXPECT().IsCoHost(ctx, "room123", "user789").Return(true, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.Error(t, err)
+	assert.Equal(t, "not host", err.Error())
+}
+
+func TestHandleClientMessage_HostPromote(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:promote", UserID: "user789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().IsUserInJam(ctx, "room123", "user789").Return(true, nil)
+	mockRepo.EXPECT().SetCoHost(ctx, "room123", "user789", true).Return(nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.NoError(t, err)
+}
+
+func TestHandleClientMessage_HostPromote_NotInJam(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	message := &usecase.JamMessage{Type: "host:promote", UserID: "user789"}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().IsUserInJam(ctx, "room123", "user789").Return(false, nil)
+
+	err := uc.HandleClientMessage(ctx, "room123", "user456", message)
+
+	assert.Error(t, err)
+}
+
+func TestLeaveJam_HostLeaving(t *testing.T) {
+	mockRepo, mockHistory, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	// the listener joined half a minute ago, the host heard the whole capped track
+	playback := &repository.JamPlayback{
+		TrackID:  "42",
+		Position: 120000,
+		Played:   120000,
+		HostID:   "456",
+		Listened: map[string]int64{"456": 120000, "789": 30500},
+	}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("456", nil)
+	mockRepo.EXPECT().GetLongestPresentUser(ctx, "room123").Return("", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(playback, nil)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(42)).Return(&usecase.TrackDetailed{Track: usecase.Track{ID: 42, Duration: 100}}, nil)
+	mockHistory.EXPECT().RecordTrack(ctx, "room123", int64(42), int64(100)).Return(nil)
+	mockTrack.EXPECT().CreateStream(ctx, &usecase.TrackStreamCreateData{TrackID: 42, UserID: 456}).Return(int64(1), nil)
+	mockTrack.EXPECT().UpdateStreamDuration(ctx, &usecase.TrackStreamUpdateData{StreamID: 1, UserID: 456, Duration: 100}).Return(nil)
+	mockTrack.EXPECT().CreateStream(ctx, &usecase.TrackStreamCreateData{TrackID: 42, UserID: 789}).Return(int64(2), nil)
+	mockTrack.EXPECT().UpdateStreamDuration(ctx, &usecase.TrackStreamUpdateData{StreamID: 2, UserID: 789, Duration: 30}).Return(nil)
+	mockHistory.EXPECT().EndSession(ctx, "room123").Return(nil)
+	mockRepo.EXPECT().RemoveJam(ctx, "room123").Return(nil)
+
+	err := uc.LeaveJam(ctx, "room123", "456")
+
+	assert.NoError(t, err)
+}
+
+func TestLeaveJam_HostLeavingTransfersHost(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	gomock.InOrder(
+		mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil),
+		mockRepo.EXPECT().GetLongestPresentUser(ctx, "room123").Return("user789", nil),
+		mockRepo.EXPECT().TransferHost(ctx, "room123", "user456", "user789").Return(nil),
+		mockRepo.EXPECT().RemoveUser(ctx, "room123", "user456").Return(nil),
+		mockRepo.EXPECT().StopListening(ctx, "room123", "user456").Return(nil, nil),
+		mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123"),
+	)
+
+	err := uc.LeaveJam(ctx, "room123", "user456")
+
+	assert.NoError(t, err)
+}
+
+func TestLeaveJam_HostLeavingGracePeriod(t *testing.T) {
+	mockRepo, mockHistory, _, _, uc, ctx := setupHistoryTest(t)
+	uc.cfg.HostGracePeriod = 10 * time.Millisecond
+
+	var token string
+	done := make(chan struct{})
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().MarkHostDisconnected(ctx, "room123", "user456", gomock.Any(), 20*time.Millisecond).
+		DoAndReturn(func(_ context.Context, _ string, _ string, markToken string, _ time.Duration) error {
+			token = markToken
+			return nil
+		})
+	mockRepo.EXPECT().ConsumeHostDisconnected(gomock.Any(), "room123", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ string, consumeToken string) (bool, error) {
+			assert.Equal(t, token, consumeToken)
+			return true, nil
+		})
+	mockRepo.EXPECT().GetLongestPresentUser(gomock.Any(), "room123").Return("", nil)
+	mockRepo.EXPECT().GetPlayback(gomock.Any(), "room123").Return(&repository.JamPlayback{HostID: "user456"}, nil)
+	mockHistory.EXPECT().EndSession(gomock.Any(), "room123").Return(nil)
+	mockRepo.EXPECT().RemoveJam(gomock.Any(), "room123").DoAndReturn(func(context.Context, string) error {
+		close(done)
+		return nil
+	})
+
+	err := uc.LeaveJam(ctx, "room123", "user456")
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("host grace period did not expire")
+	}
+}
+
+func TestLeaveJam_HostReconnectedDuringGracePeriod(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+	uc.cfg.HostGracePeriod = 10 * time.Millisecond
+
+	done := make(chan struct{})
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().MarkHostDisconnected(ctx, "room123", "user456", gomock.Any(), gomock.Any()).Return(nil)
+	mockRepo.EXPECT().ConsumeHostDisconnected(gomock.Any(), "room123", gomock.Any()).
+		DoAndReturn(func(context.Context, string, string) (bool, error) {
+			close(done)
+			return false, nil
+		})
+
+	err := uc.LeaveJam(ctx, "room123", "user456")
+	require.NoError(t, err)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("host grace period did not expire")
+	}
+}
+
+func TestLeaveJam_UserLeaving(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().RemoveUser(ctx, "room123", "user456").Return(nil)
+	mockRepo.EXPECT().StopListening(ctx, "room123", "user456").Return(nil, nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
+
+	err := uc.LeaveJam(ctx, "room123", "user456")
+
+	assert.NoError(t, err)
+}
+
+func TestLeaveJam_UserLeavingMidTrack(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	// the listener leaves 45 seconds into the track and gets a stream for them
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("456", nil)
+	mockRepo.EXPECT().RemoveUser(ctx, "room123", "789").Return(nil)
+	mockRepo.EXPECT().StopListening(ctx, "room123", "789").Return(&repository.JamListen{TrackID: "42", Listened: 45500}, nil)
+	mockTrack.EXPECT().GetTrackByID(ctx, int64(42)).Return(&usecase.TrackDetailed{Track: usecase.Track{ID: 42, Duration: 100}}, nil)
+	mockTrack.EXPECT().CreateStream(ctx, &usecase.TrackStreamCreateData{TrackID: 42, UserID: 789}).Return(int64(1), nil)
+	mockTrack.EXPECT().UpdateStreamDuration(ctx, &usecase.TrackStreamUpdateData{StreamID: 1, UserID: 789, Duration: 45}).Return(nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
+
+	err := uc.LeaveJam(ctx, "room123", "789")
+
+	assert.NoError(t, err)
+}
+
+func TestLeaveJam_GetHostIDError(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	expectedErr := errors.New("get host error")
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("", expectedErr)
+
+	err := uc.LeaveJam(ctx, "room123", "user456")
+
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestLeaveJam_RemoveJamError(t *testing.T) {
+	mockRepo, mockHistory, _, _, uc, ctx := setupHistoryTest(t)
+
+	expectedErr := errors.New("remove jam error")
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("user456", nil)
+	mockRepo.EXPECT().GetLongestPresentUser(ctx, "room123").Return("", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(nil, errors.New("redis error"))
+	mockHistory.EXPECT().EndSession(ctx, "room123").Return(errors.New("db error"))
+	mockRepo.EXPECT().RemoveJam(ctx, "room123").Return(expectedErr)
+
+	err := uc.LeaveJam(ctx, "room123", "user456")
+
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestLeaveJam_RemoveUserError(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	expectedErr := errors.New("remove user error")
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("host123", nil)
+	mockRepo.EXPECT().RemoveUser(ctx, "room123", "user456").Return(expectedErr)
+
+	err := uc.LeaveJam(ctx, "room123", "user456")
+
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestSubscribeToJamMessages_Success(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	repoMessageChan := make(chan []byte, 1)
+
+	repoJamMessage := &repository.JamMessage{
+		Type:     "play",
+		TrackID:  "track123",
+		Position: 1000,
+		Paused:   false,
+	}
+
+	messageBytes, _ := json.Marshal(repoJamMessage)
+
+	mockRepo.EXPECT().SubscribeToJamMessages(ctx, "room123").Return((<-chan []byte)(repoMessageChan), nil)
+
+	usecaseMessageChan, err := uc.SubscribeToJamMessages(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.NotNil(t, usecaseMessageChan)
+
+	repoMessageChan <- messageBytes
+	close(repoMessageChan)
+
+	receivedMessage := <-usecaseMessageChan
+	assert.Equal(t, "play", receivedMessage.Type)
+	assert.Equal(t, "track123", receivedMessage.TrackID)
+	assert.Equal(t, int64(1000), receivedMessage.Position)
+	assert.False(t, receivedMessage.Paused)
+}
+
+func TestHandleClientMessage_HostLoad_PublishesLyrics(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	lyrics := []*usecase.TrackLyrics{
+		{Language: "en", Synced: true, Lines: []*usecase.LyricsLine{{Time: 1000, Text: "Hello"}}},
+	}
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("456", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{HostID: "456"}, nil)
+	mockTrack.EXPECT().GetTrackLyrics(ctx, int64(43)).Return(lyrics, nil)
+	mockRepo.EXPECT().LoadTrack(ctx, "room123", "43", []*repository.JamLyrics{
+		{Language: "en", Synced: true, Lines: []*repository.JamLyricsLine{{Time: 1000, Text: "Hello"}}},
+	}).Return(nil)
+	mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
+
+	err := uc.HandleClientMessage(ctx, "room123", "456", &usecase.JamMessage{Type: "host:load", TrackID: "43"})
+
+	assert.NoError(t, err)
+}
+
+func TestSubscribeToJamMessages_LoadCarriesLyrics(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	repoMessageChan := make(chan []byte, 1)
+	messageBytes, _ := json.Marshal(&repository.JamMessage{
+		Type:    "load",
+		TrackID: "42",
+		Lyrics:  []*repository.JamLyrics{{Language: "en", Lines: []*repository.JamLyricsLine{{Text: "Hello"}}}},
+	})
+
+	mockRepo.EXPECT().SubscribeToJamMessages(ctx, "room123").Return((<-chan []byte)(repoMessageChan), nil)
+
+	usecaseMessageChan, err := uc.SubscribeToJamMessages(ctx, "room123")
+	require.NoError(t, err)
+
+	repoMessageChan <- messageBytes
+	close(repoMessageChan)
+
+	receivedMessage := <-usecaseMessageChan
+	assert.Equal(t, "load", receivedMessage.Type)
+	assert.Equal(t, []*usecase.TrackLyrics{
+		{Language: "en", Lines: []*usecase.LyricsLine{{Text: "Hello"}}},
+	}, receivedMessage.Lyrics)
+}
+
+func TestSubscribeToJamMessages_RepositoryError(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	expectedErr := errors.New("subscription error")
+	mockRepo.EXPECT().SubscribeToJamMessages(ctx, "room123").Return(nil, expectedErr)
+
+	usecaseMessageChan, err := uc.SubscribeToJamMessages(ctx, "room123")
+
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, usecaseMessageChan)
+}
+
+func TestSubscribeToJamMessages_InvalidJSON(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	repoMessageChan := make(chan []byte, 1)
+
+	mockRepo.EXPECT().SubscribeToJamMessages(ctx, "room123").Return((<-chan []byte)(repoMessageChan), nil)
+
+	usecaseMessageChan, err := uc.SubscribeToJamMessages(ctx, "room123")
+
+	require.NoError(t, err)
+	assert.NotNil(t, usecaseMessageChan)
+
+	repoMessageChan <- []byte("invalid json")
+	close(repoMessageChan)
+
+	select {
+	case msg := <-usecaseMessageChan:
+		t.Errorf("Expected no message due to invalid JSON, but got: %v", msg)
+	default:
+	}
+}
+
+func TestStoreUserInfo_Success(t *testing.T) {
+	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+
+	userProtoData := &userProto.UserFront{
+		Id:          123,
+		Username:    "testuser",
+		Avatar:      "avatar.jpg",
+		AvatarColor: "#a1b2c3",
+	}
+
+	avatarURL := &userProto.AvatarUrl{
+		Url: "http://example.com/avatar.jpg",
+	}
+
+	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
+	mockUserClient.EXPECT().GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: "avatar.jpg"}).Return(avatarURL, nil)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "http://example.com/avatar.jpg", "#a1b2c3").Return(nil)
+
+	err := uc.storeUserInfo(ctx, "room123", "123")
+
+	assert.NoError(t, err)
+}
+
+func TestStoreUserInfo_NoAvatar(t *testing.T) {
+	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+
+	userProtoData := &userProto.UserFront{
+		Id:       123,
+		Username: "testuser",
+		Avatar:   "",
+	}
+
+	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "", "").Return(nil)
+
+	err := uc.storeUserInfo(ctx, "room123", "123")
+
+	assert.NoError(t, err)
+}
+
+func TestStoreUserInfo_InvalidUserID(t *testing.T) {
+	_, _, uc, ctx := setupTest(t)
+
+	err := uc.storeUserInfo(ctx, "room123", "invalid")
+
+	assert.Error(t, err)
+}
+
+func TestStoreUserInfo_GetUserByIDError(t *testing.T) {
+	_, mockUserClient, uc, ctx := setupTest(t)
+
+	expectedErr := errors.New("user not found")
+	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(nil, expectedErr)
+
+	err := uc.storeUserInfo(ctx, "room123", "123")
+
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestStoreUserInfo_GetAvatarURLError(t *testing.T) {
+	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+
+	userProtoData := &userProto.UserFront{
+		Id:       123,
+		Username: "testuser",
+		Avatar:   "avatar.jpg",
+	}
+
+	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
+	mockUserClient.EXPECT().GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: "avatar.jpg"}).Return(nil, errors.New("avatar error"))
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "", "").Return(nil)
+
+	err := uc.storeUserInfo(ctx, "room123", "123")
+
+	assert.NoError(t, err)
+}
+
+func TestStoreUserInfo_StoreUserInfoError(t *testing.T) {
+	mockRepo, mockUserClient, uc, ctx := setupTest(t)
 
 	userProtoData := &userProto.UserFront{
 		Id:       123,
@@ -595,10 +1498,398 @@ func TestStoreUserInfo_StoreUserInfoError(t *testing.T) {
 
 	expectedErr := errors.New("store error")
 	mockUserClient.EXPECT().GetUserByID(ctx, &userProto.UserID{Id: 123}).Return(userProtoData, nil)
-	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "").Return(expectedErr)
+	mockRepo.EXPECT().StoreUserInfo(ctx, "room123", "123", "testuser", "", "").Return(expectedErr)
 
 	err := uc.storeUserInfo(ctx, "room123", "123")
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
+
+func TestKeepAlive(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+	uc.cfg.RoomTTL = time.Hour
+
+	mockRepo.EXPECT().TouchPresence(ctx, "room123", "456").Return(nil)
+	mockRepo.EXPECT().TouchJam(ctx, "room123", time.Hour).Return(nil)
+
+	err := uc.KeepAlive(ctx, "room123", "456")
+
+	assert.NoError(t, err)
+}
+
+func TestKeepAlive_PresenceError(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	expectedErr := errors.New("redis error")
+	mockRepo.EXPECT().TouchPresence(ctx, "room123", "456").Return(expectedErr)
+
+	err := uc.KeepAlive(ctx, "room123", "456")
+
+	assert.Equal(t, expectedErr, err)
+}
+
+func TestReapStale_LockHeld(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+	uc.cfg = config.JamConfig{RoomTTL: time.Hour, PresenceTimeout: time.Minute, ReaperInterval: 30 * time.Second}
+
+	mockRepo.EXPECT().AcquireReaperLock(ctx, 15*time.Second).Return(false, nil)
+
+	err := uc.ReapStale(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestReapStale(t *testing.T) {
+	mockRepo, mockHistory, _, _, uc, ctx := setupHistoryTest(t)
+	uc.cfg = config.JamConfig{RoomTTL: time.Hour, PresenceTimeout: time.Minute, ReaperInterval: 30 * time.Second}
+
+	mockRepo.EXPECT().AcquireReaperLock(ctx, 15*time.Second).Return(true, nil)
+	mockRepo.EXPECT().GetStaleJams(ctx, gomock.Any()).Return([]string{"dead"}, nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "dead").Return(&repository.JamPlayback{HostID: "host0"}, nil)
+	mockHistory.EXPECT().EndSession(ctx, "dead").Return(nil)
+	mockRepo.EXPECT().RemoveJam(ctx, "dead").Return(nil)
+	mockRepo.EXPECT().GetActiveJams(ctx).Return([]string{"room1", "room2"}, nil)
+
+	// room1 lost a listener and its host, the host's room goes to the longest-present listener
+	mockRepo.EXPECT().GetStaleUsers(ctx, "room1", gomock.Any()).Return([]string{"user1", "host1"}, nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room1").Return("host1", nil)
+	mockRepo.EXPECT().RemoveUser(ctx, "room1", "user1").Return(nil)
+	mockRepo.EXPECT().StopListening(ctx, "room1", "user1").Return(nil, nil)
+	mockRepo.EXPECT().IsHostDisconnected(ctx, "room1").Return(false, nil)
+	mockRepo.EXPECT().GetLongestPresentUser(ctx, "room1").Return("user2", nil)
+	mockRepo.EXPECT().TransferHost(ctx, "room1", "host1", "user2").Return(nil)
+	mockRepo.EXPECT().RemoveUser(ctx, "room1", "host1").Return(nil)
+	mockRepo.EXPECT().StopListening(ctx, "room1", "host1").Return(nil, nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room1").Times(2)
+
+	mockRepo.EXPECT().GetStaleUsers(ctx, "room2", gomock.Any()).Return(nil, nil)
+
+	err := uc.ReapStale(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestReapStale_HostInGracePeriod(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+	uc.cfg = config.JamConfig{PresenceTimeout: time.Minute, ReaperInterval: 30 * time.Second}
+
+	mockRepo.EXPECT().AcquireReaperLock(ctx, 15*time.Second).Return(true, nil)
+	mockRepo.EXPECT().GetActiveJams(ctx).Return([]string{"room1"}, nil)
+	mockRepo.EXPECT().GetStaleUsers(ctx, "room1", gomock.Any()).Return([]string{"host1"}, nil)
+	mockRepo.EXPECT().GetHostID(ctx, "room1").Return("host1", nil)
+	mockRepo.EXPECT().IsHostDisconnected(ctx, "room1").Return(true, nil)
+
+	err := uc.ReapStale(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestRunReaperStopsOnCancel(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+	ctx, cancel := context.WithCancel(ctx)
+
+	mockRepo.EXPECT().AcquireReaperLock(ctx, gomock.Any()).DoAndReturn(func(context.Context, time.Duration) (bool, error) {
+		cancel()
+		return false, nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		uc.RunReaper(ctx, time.Hour)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("reaper did not stop after cancel")
+	}
+}
+
+func TestListJams(t *testing.T) {
+	mockRepo, mockUserClient, uc, ctx := setupTest(t)
+
+	mockUserClient.EXPECT().GetFollowing(ctx, &userProto.UserIDWithPagination{
+		UserId:     1,
+		Pagination: &userProto.Pagination{Offset: 0, Limit: maxFollowingLookup},
+	}).Return(&userProto.UsersToFront{Users: []*userProto.UserFront{{Id: 2}}}, nil)
+	mockRepo.EXPECT().GetActiveJams(ctx).Return([]string{"public", "gone", "private", "friend", "extra"}, nil)
+	mockRepo.EXPECT().GetJamSummaries(ctx, []string{"public", "gone", "private", "friend", "extra"}).Return([]*repository.JamSummary{
+		{RoomID: "public", HostID: "3", Listeners: 2},
+		{RoomID: "private", HostID: "4", Private: true},
+		{RoomID: "friend", HostID: "2", Private: true},
+		{RoomID: "extra", HostID: "5"},
+	}, nil)
+
+	rooms, err := uc.ListJams(ctx, 1, &usecase.Pagination{Offset: 0, Limit: 2})
+
+	require.NoError(t, err)
+	require.Len(t, rooms, 2)
+	assert.Equal(t, "public", rooms[0].RoomID)
+	assert.False(t, rooms[0].Friend)
+	assert.Equal(t, int64(2), rooms[0].Listeners)
+	assert.Equal(t, "friend", rooms[1].RoomID)
+	assert.True(t, rooms[1].Friend)
+}
+
+func TestListJams_AnonymousWithOffset(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetActiveJams(ctx).Return([]string{"room1", "room2"}, nil)
+	mockRepo.EXPECT().GetJamSummaries(ctx, []string{"room1", "room2"}).Return([]*repository.JamSummary{
+		{RoomID: "room1", HostID: "3"},
+		{RoomID: "room2", HostID: "4"},
+	}, nil)
+
+	rooms, err := uc.ListJams(ctx, 0, &usecase.Pagination{Offset: 1, Limit: 10})
+
+	require.NoError(t, err)
+	require.Len(t, rooms, 1)
+	assert.Equal(t, "room2", rooms[0].RoomID)
+}
+
+func TestListJams_ReadsNextBatchUntilPageIsFull(t *testing.T) {
+	mockRepo, _, uc, ctx := setupTest(t)
+
+	rooms := make([]string, jamSummaryBatch+10)
+	private := make([]*repository.JamSummary, 0, jamSummaryBatch)
+	for i := range rooms {
+		rooms[i] = "room" + strconv.Itoa(i)
+		if i < jamSummaryBatch {
+			private = append(private, &repository.JamSummary{RoomID: rooms[i], HostID: "3", Private: true})
+		}
+	}
+
+	mockRepo.EXPECT().GetActiveJams(ctx).Return(rooms, nil)
+	mockRepo.EXPECT().GetJamSummaries(ctx, rooms[:jamSummaryBatch]).Return(private, nil)
+	mockRepo.EXPECT().GetJamSummaries(ctx, rooms[jamSummaryBatch:]).Return([]*repository.JamSummary{
+		{RoomID: rooms[jamSummaryBatch], HostID: "4"},
+	}, nil)
+
+	summaries, err := uc.ListJams(ctx, 0, &usecase.Pagination{Offset: 0, Limit: 1})
+
+	require.NoError(t, err)
+	require.Len(t, summaries, 1)
+	assert.Equal(t, rooms[jamSummaryBatch], summaries[0].RoomID)
+}
+
+func TestListJams_FollowingError(t *testing.T) {
+	_, mockUserClient, uc, ctx := setupTest(t)
+
+	expectedErr := errors.New("user service error")
+	mockUserClient.EXPECT().GetFollowing(ctx, gomock.Any()).Return(nil, expectedErr)
+
+	rooms, err := uc.ListJams(ctx, 1, &usecase.Pagination{Offset: 0, Limit: 10})
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, rooms)
+}
+
+func TestGetSessions(t *testing.T) {
+	_, mockHistory, _, _, uc, ctx := setupHistoryTest(t)
+
+	startedAt := time.Now()
+	mockHistory.EXPECT().GetSessions(ctx, int64(1), &repository.Pagination{Offset: 10, Limit: 5}).Return([]*repository.JamSession{
+		{ID: 3, RoomID: "room3", HostID: 1, HostName: "host", StartedAt: startedAt, Participants: 2, Tracks: 4, Duration: 600},
+	}, nil)
+
+	sessions, err := uc.GetSessions(ctx, 1, &usecase.Pagination{Offset: 10, Limit: 5})
+
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	assert.Equal(t, int64(3), sessions[0].ID)
+	assert.Equal(t, int64(600), sessions[0].Duration)
+}
+
+func TestGetSession_ResolvesAvatars(t *testing.T) {
+	_, mockHistory, _, mockUserClient, uc, ctx := setupHistoryTest(t)
+
+	mockHistory.EXPECT().GetSession(ctx, int64(3), int64(1)).Return(&repository.JamSessionDetails{
+		JamSession: repository.JamSession{ID: 3, HostID: 1},
+		ParticipantList: []*repository.JamSessionParticipant{
+			{UserID: 1, Username: "host", Thumbnail: "avatar.png"},
+			{UserID: 2, Username: "friend"},
+		},
+		TrackList: []*repository.JamSessionTrack{{TrackID: 42, Title: "Song", Duration: 90}},
+	}, nil)
+	mockUserClient.EXPECT().GetUserAvatarURL(ctx, &userProto.FileKey{FileKey: "avatar.png"}).
+		Return(&userProto.AvatarUrl{Url: "http://example.com/avatar.png"}, nil)
+
+	session, err := uc.GetSession(ctx, 3, 1)
+
+	require.NoError(t, err)
+	require.Len(t, session.ParticipantList, 2)
+	assert.Equal(t, "http://example.com/avatar.png", session.ParticipantList[0].AvatarURL)
+	assert.Empty(t, session.ParticipantList[1].AvatarURL)
+	require.Len(t, session.TrackList, 1)
+	assert.Equal(t, int64(42), session.TrackList[0].TrackID)
+}
+
+func TestGetSession_NotFound(t *testing.T) {
+	_, mockHistory, _, _, uc, ctx := setupHistoryTest(t)
+
+	mockHistory.EXPECT().GetSession(ctx, int64(3), int64(2)).Return(nil, customErrors.ErrJamSessionNotFound)
+
+	session, err := uc.GetSession(ctx, 3, 2)
+
+	assert.ErrorIs(t, err, customErrors.ErrJamSessionNotFound)
+	assert.Nil(t, session)
+}
+
+func TestCreateSessionPlaylist(t *testing.T) {
+	startedAt := time.Date(2025, 5, 16, 20, 0, 0, 0, time.UTC)
+	details := &repository.JamSessionDetails{
+		JamSession: repository.JamSession{ID: 3, StartedAt: startedAt},
+		TrackList:  []*repository.JamSessionTrack{{TrackID: 42}},
+	}
+
+	tests := []struct {
+		name          string
+		title         string
+		details       *repository.JamSessionDetails
+		sessionErr    error
+		expectedTitle string
+		expectedErr   error
+	}{
+		{
+			name:          "Default title",
+			title:         "  ",
+			details:       details,
+			expectedTitle: "Jam 2025-05-16",
+		},
+		{
+			name:          "Custom title",
+			title:         " Friday night ",
+			details:       details,
+			expectedTitle: "Friday night",
+		},
+		{
+			name:        "Title too long",
+			title:       strings.Repeat("a", maxJamPlaylistTitleLength+1),
+			details:     details,
+			expectedErr: customErrors.ErrJamPlaylistInvalidTitle,
+		},
+		{
+			name:        "Nothing played",
+			details:     &repository.JamSessionDetails{JamSession: repository.JamSession{ID: 3}},
+			expectedErr: customErrors.ErrJamSessionEmpty,
+		},
+		{
+			name:        "Not a participant",
+			sessionErr:  customErrors.ErrJamSessionNotFound,
+			expectedErr: customErrors.ErrJamSessionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, mockHistory, _, mockUserClient, mockPlaylistClient, uc, ctx := setupPlaylistTest(t)
+
+			mockHistory.EXPECT().GetSession(ctx, int64(3), int64(1)).Return(tt.details, tt.sessionErr)
+			if tt.expectedErr == nil {
+				mockUserClient.EXPECT().GetUserPrivacyByID(ctx, &userProto.UserID{Id: 1}).Return(&userProto.PrivacySettings{IsPublicPlaylists: true}, nil)
+				mockPlaylistClient.EXPECT().CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{UserId: 1, Title: tt.expectedTitle, IsPublic: true}).Return(&playlistProto.Playlist{Id: 15}, nil)
+				mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{UserId: 1, PlaylistId: 15, TrackId: 42}).Return(&emptypb.Empty{}, nil)
+			}
+
+			playlistID, err := uc.CreateSessionPlaylist(ctx, &usecase.CreateJamPlaylistRequest{UserID: 1, SessionID: 3, Title: tt.title})
+
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, int64(15), playlistID)
+		})
+	}
+}
+
+func TestCreateSessionPlaylist_AddsTracksOnce(t *testing.T) {
+	_, mockHistory, _, mockUserClient, mockPlaylistClient, uc, ctx := setupPlaylistTest(t)
+
+	mockHistory.EXPECT().GetSession(ctx, int64(3), int64(1)).Return(&repository.JamSessionDetails{
+		JamSession: repository.JamSession{ID: 3},
+		TrackList:  []*repository.JamSessionTrack{{TrackID: 42}, {TrackID: 7}, {TrackID: 42}},
+	}, nil)
+	mockUserClient.EXPECT().GetUserPrivacyByID(ctx, gomock.Any()).Return(&userProto.PrivacySettings{}, nil)
+	mockPlaylistClient.EXPECT().CreatePlaylist(ctx, gomock.Any()).Return(&playlistProto.Playlist{Id: 15}, nil)
+	gomock.InOrder(
+		mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{UserId: 1, PlaylistId: 15, TrackId: 42}).Return(&emptypb.Empty{}, nil),
+		mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{UserId: 1, PlaylistId: 15, TrackId: 7}).Return(&emptypb.Empty{}, nil),
+	)
+
+	playlistID, err := uc.CreateSessionPlaylist(ctx, &usecase.CreateJamPlaylistRequest{UserID: 1, SessionID: 3, Title: "Friday night"})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), playlistID)
+}
+
+func TestCreateSessionPlaylist_NumbersTakenDefaultTitle(t *testing.T) {
+	_, mockHistory, _, mockUserClient, mockPlaylistClient, uc, ctx := setupPlaylistTest(t)
+
+	duplicate := status.Error(codes.AlreadyExists, customErrors.ErrPlaylistDuplicate.Error())
+	mockHistory.EXPECT().GetSession(ctx, int64(3), int64(1)).Return(&repository.JamSessionDetails{
+		JamSession: repository.JamSession{ID: 3, StartedAt: time.Date(2025, 5, 16, 20, 0, 0, 0, time.UTC)},
+		TrackList:  []*repository.JamSessionTrack{{TrackID: 42}},
+	}, nil)
+	mockUserClient.EXPECT().GetUserPrivacyByID(ctx, gomock.Any()).Return(&userProto.PrivacySettings{}, nil)
+	gomock.InOrder(
+		mockPlaylistClient.EXPECT().CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{UserId: 1, Title: "Jam 2025-05-16"}).Return(nil, duplicate),
+		mockPlaylistClient.EXPECT().CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{UserId: 1, Title: "Jam 2025-05-16 (2)"}).Return(nil, duplicate),
+		mockPlaylistClient.EXPECT().CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{UserId: 1, Title: "Jam 2025-05-16 (3)"}).Return(&playlistProto.Playlist{Id: 15}, nil),
+	)
+	mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, gomock.Any()).Return(&emptypb.Empty{}, nil)
+
+	playlistID, err := uc.CreateSessionPlaylist(ctx, &usecase.CreateJamPlaylistRequest{UserID: 1, SessionID: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(15), playlistID)
+}
+
+func TestCreateSessionPlaylist_CustomTitleTaken(t *testing.T) {
+	_, mockHistory, _, mockUserClient, mockPlaylistClient, uc, ctx := setupPlaylistTest(t)
+
+	mockHistory.EXPECT().GetSession(ctx, int64(3), int64(1)).Return(&repository.JamSessionDetails{
+		JamSession: repository.JamSession{ID: 3},
+		TrackList:  []*repository.JamSessionTrack{{TrackID: 42}},
+	}, nil)
+	mockUserClient.EXPECT().GetUserPrivacyByID(ctx, gomock.Any()).Return(&userProto.PrivacySettings{}, nil)
+	mockPlaylistClient.EXPECT().CreatePlaylist(ctx, gomock.Any()).Return(nil, status.Error(codes.AlreadyExists, customErrors.ErrPlaylistDuplicate.Error()))
+
+	_, err := uc.CreateSessionPlaylist(ctx, &usecase.CreateJamPlaylistRequest{UserID: 1, SessionID: 3, Title: "Friday night"})
+
+	assert.ErrorIs(t, err, customErrors.ErrJamPlaylistTitleTaken)
+}
+
+func TestCreateSessionPlaylist_RemovesIncompletePlaylist(t *testing.T) {
+	_, mockHistory, _, mockUserClient, mockPlaylistClient, uc, ctx := setupPlaylistTest(t)
+
+	mockHistory.EXPECT().GetSession(ctx, int64(3), int64(1)).Return(&repository.JamSessionDetails{
+		JamSession: repository.JamSession{ID: 3},
+		TrackList:  []*repository.JamSessionTrack{{TrackID: 42}},
+	}, nil)
+	mockUserClient.EXPECT().GetUserPrivacyByID(ctx, gomock.Any()).Return(&userProto.PrivacySettings{}, nil)
+	mockPlaylistClient.EXPECT().CreatePlaylist(ctx, gomock.Any()).Return(&playlistProto.Playlist{Id: 15}, nil)
+	mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, gomock.Any()).Return(nil, status.Error(codes.Internal, "failed to add track to playlist"))
+	mockPlaylistClient.EXPECT().RemovePlaylist(ctx, &playlistProto.RemovePlaylistRequest{UserId: 1, PlaylistId: 15}).Return(&emptypb.Empty{}, nil)
+
+	_, err := uc.CreateSessionPlaylist(ctx, &usecase.CreateJamPlaylistRequest{UserID: 1, SessionID: 3, Title: "Friday night"})
+
+	assert.Error(t, err)
+}
+
+func TestHandleClientMessage_HostLoad_SkipsUnplayedTrack(t *testing.T) {
+	mockRepo, _, mockTrack, _, uc, ctx := setupHistoryTest(t)
+
+	mockRepo.EXPECT().GetHostID(ctx, "room123").Return("456", nil)
+	mockRepo.EXPECT().GetPlayback(ctx, "room123").Return(&repository.JamPlayback{TrackID: "42", Position: 400, HostID: "456"}, nil)
+	mockTrack.EXPECT().GetTrackLyrics(ctx, int64(43)).Return(nil, nil)
+	mockRepo.EXPECT().LoadTrack(ctx, "room123", "43", nil).Return(nil)
+	mockRepo.EXPECT().PauseJam(ctx, "room123").Return(nil)
+	mockRepo.EXPECT().CheckAllReadyAndPlay(ctx, "room123")
+
+	err := uc.HandleClientMessage(ctx, "room123", "456", &usecase.JamMessage{Type: "host:load", TrackID: "43"})
+
+	assert.NoError(t, err)
+}
@@ -378,21 +378,18 @@ func (h *LabelHandler) DeleteArtist(w http.ResponseWriter, r *http.Request) {
 
 // CreateAlbum godoc
 // @Summary Create a new album
-// @Description Creates a new album with tracks. Only accessible by label members.
+// @Description Creates a new album with tracks from completed uploads (see /label/uploads). Empty track titles and a missing cover are taken from the tags embedded in the tracks. Only accessible by label members.
 // @Tags label
-// @Accept multipart/form-data
+// @Accept json
 // @Produce json
 // @Security LabelAuth
-// @Param title formData string true "Album title (max 100 characters)"
-// @Param type formData string true "Album type (album, single, ep, compilation)"
-// @Param artists_ids formData string true "Comma-separated list of artist IDs"
-// @Param thumbnail formData file true "Album cover image"
-// @Param tracks[] formData file true "Array of track files"
-// @Param track_titles[] formData []string true "Array of track titles corresponding to tracks[]"
+// @Param request body delivery.CreateAlbumRequest true "Album with the upload ids of its cover and tracks"
 // @Success 201 {object} delivery.SuccessCreateAlbum "Created album ID"
-// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid input"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid input, upload of the wrong kind or an upload used for two tracks"
 // @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
 // @Failure 403 {object} delivery.APIForbiddenErrorResponse "Forbidden - user not in label"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Upload not found or expired"
+// @Failure 409 {object} delivery.APIErrorResponse "Upload is not complete or used by another release, or a track is already in the catalog"
 // @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
 // @Router /api/v1/label/album [post]
 func (h *LabelHandler) CreateAlbum(w http.ResponseWriter, r *http.Request) {
@@ -407,23 +404,16 @@ func (h *LabelHandler) CreateAlbum(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	if r.ContentLength > 300<<20 {
-		logger.Error("request body too large")
-		json.WriteErrorResponse(w, http.StatusBadRequest, "request body too large", nil)
-		return
-	}
-
-	err := r.ParseMultipartForm(300 << 20)
+	request := &delivery.CreateAlbumRequest{}
+	err := json.ReadJSON(w, r, request)
 	if err != nil {
-		logger.Error("failed to parse multipart form", zap.Error(err))
-		json.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse multipart form", nil)
+		logger.Error("failed to read json", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "failed to read json", nil)
 		return
 	}
-
-	request := &delivery.CreateAlbumRequest{}
 	request.LabelID = labelID
 
-	request.Title = r.FormValue("title")
+	request.Title = strings.TrimSpace(request.Title)
 	if request.Title == "" {
 		logger.Error("title is empty")
 		json.WriteErrorResponse(w, http.StatusBadRequest, "title is empty", nil)
@@ -435,7 +425,6 @@ func (h *LabelHandler) CreateAlbum(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	request.Type = r.FormValue("type")
 	if request.Type == "" {
 		logger.Error("type is empty")
 		json.WriteErrorResponse(w, http.StatusBadRequest, "type is empty", nil)
@@ -447,96 +436,26 @@ func (h *LabelHandler) CreateAlbum(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	artistsRaw := strings.TrimSpace(r.FormValue("artists_ids"))
-	if artistsRaw == "" {
-		logger.Error("artists_ids is empty")
-		json.WriteErrorResponse(w, http.StatusBadRequest, "artists_ids is empty", nil)
-		return
-	}
-	ArtistIDsSlice := strings.Split(artistsRaw, ",")
-	for _, id := range ArtistIDsSlice {
-		trimmedID := strings.TrimSpace(id)
-		if trimmedID == "" {
-			continue
-		}
-		parsedID, err := strconv.ParseInt(trimmedID, 10, 64)
-		if err != nil {
-			logger.Error("failed to parse artists_ids", zap.Error(err))
-			json.WriteErrorResponse(w, http.StatusBadRequest, "failed to parse artists_ids", nil)
-			return
-		}
-		request.ArtistsIDs = append(request.ArtistsIDs, parsedID)
-	}
 	if len(request.ArtistsIDs) == 0 {
 		logger.Error("artists_ids is empty")
 		json.WriteErrorResponse(w, http.StatusBadRequest, "artists_ids is empty", nil)
 		return
 	}
 
-	file, _, err := r.FormFile("thumbnail")
-	if err != nil {
-		logger.Error("failed to get thumbnail", zap.Error(err))
-		json.WriteErrorResponse(w, http.StatusBadRequest, "thumbnail image is required", nil)
-		return
-	}
-	request.Image, err = io.ReadAll(file)
-	if err != nil {
-		logger.Error("failed to read thumbnail", zap.Error(err))
-		json.WriteErrorResponse(w, http.StatusBadRequest, "failed to read thumbnail", nil)
-		return
-	}
-
-	fileHeaders := r.MultipartForm.File["tracks[]"]
-	if len(fileHeaders) == 0 {
+	if len(request.Tracks) == 0 {
 		logger.Error("tracks are empty")
 		json.WriteErrorResponse(w, http.StatusBadRequest, "tracks are empty", nil)
 		return
 	}
-
-	tracks := make([]*delivery.CreateTrackRequest, 0, len(fileHeaders))
-	trackTitles := r.Form["track_titles[]"]
-	if len(trackTitles) != len(fileHeaders) {
-		logger.Error("track titles count does not match tracks count")
-		json.WriteErrorResponse(w, http.StatusBadRequest, "track titles count does not match tracks count", nil)
-		return
-	}
-
-	for i, fileHeader := range fileHeaders {
-		track := &delivery.CreateTrackRequest{
-			Title: trackTitles[i],
-		}
-
-		if fileHeader.Size > 50<<20 {
-			logger.Error("track is too large")
-			json.WriteErrorResponse(w, http.StatusBadRequest, "track is too large", nil)
+	for _, track := range request.Tracks {
+		if track == nil || track.UploadID == "" {
+			logger.Error("track upload_id is empty")
+			json.WriteErrorResponse(w, http.StatusBadRequest, "track upload_id is empty", nil)
 			return
 		}
-
-		file, err := fileHeader.Open()
-		if err != nil {
-			logger.Error("failed to open track file", zap.Error(err))
-			json.WriteErrorResponse(w, http.StatusBadRequest, "failed to open track file", nil)
-			return
-		}
-		defer func() {
-			if err := file.Close(); err != nil {
-				logger.Error("failed to close track file", zap.Error(err))
-			}
-		}()
-
-		track.Track, err = io.ReadAll(file)
-		if err != nil {
-			logger.Error("failed to read track file", zap.Error(err))
-			json.WriteErrorResponse(w, http.StatusBadRequest, "failed to read track file", nil)
-			return
-		}
-
-		tracks = append(tracks, track)
 	}
 
-	request.Tracks = tracks
-
-	albumID, albumURL, err := h.usecase.CreateAlbum(ctx, model.NewAlbumFromDeliveryToUsecase(request))
+	createdAlbum, err := h.usecase.CreateAlbum(ctx, model.NewAlbumFromDeliveryToUsecase(request))
 	if err != nil {
 		logger.Error("failed to create album", zap.Error(err))
 		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
@@ -553,10 +472,11 @@ func (h *LabelHandler) CreateAlbum(w http.ResponseWriter, r *http.Request) {
 	}
 
 	deliveryAlbum := delivery.Album{
-		ID:          albumID,
+		ID:          createdAlbum.ID,
 		Title:       request.Title,
 		Type:        model.AlbumTypeConverter(request.Type),
-		Thumbnail:   albumURL,
+		Thumbnail:   createdAlbum.Thumbnail,
+		Images:      model.ImagesFor(createdAlbum.Thumbnail, createdAlbum.ThumbnailColor),
 		ReleaseDate: time.Now(),
 		Artists:     artists,
 	}
@@ -704,3 +624,672 @@ func (h *LabelHandler) GetAlbumsByLabelID(w http.ResponseWriter, r *http.Request
 	albumsDelivery := model.AlbumsFromUsecaseToDelivery(albums)
 	json.WriteSuccessResponse(w, http.StatusOK, albumsDelivery, nil)
 }
+
+// GetLabelAlbum godoc
+// @Summary Get a release of the label
+// @Description Retrieves an album of the label in any status together with all of its tracks. Accessible by label members for their own releases or administrators for any release.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Security AdminAuth
+// @Param id path integer true "Album ID"
+// @Success 200 {object} delivery.APIResponse{body=delivery.LabelAlbum} "Album with its tracks"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid album ID"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id} [get]
+func (h *LabelHandler) GetLabelAlbum(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	isAdmin := ctxExtractor.AdminFromContext(ctx)
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel && !isAdmin {
+		logger.Error("failed to authorize user")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	albumID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	labelAlbum, err := h.usecase.GetLabelAlbum(ctx, albumID, labelID, isAdmin)
+	if err != nil {
+		logger.Error("failed to get label album", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.LabelAlbumFromUsecaseToDelivery(labelAlbum), nil)
+}
+
+// ChangeAlbumStatus godoc
+// @Summary Change the status of a release
+// @Description Moves an album through the release lifecycle. Labels send drafts to review with a release date, administrators approve or reject them and take releases down.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Security AdminAuth
+// @Param id path integer true "Album ID"
+// @Param request body delivery.ChangeAlbumStatusRequest true "New status and release date"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Album} "Album with the new status"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid status or release date"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Forbidden - transition requires a reviewer"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album not found"
+// @Failure 409 {object} delivery.APIBadRequestErrorResponse "Conflict - transition not allowed from the current status"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id}/status [put]
+func (h *LabelHandler) ChangeAlbumStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	isAdmin := ctxExtractor.AdminFromContext(ctx)
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel && !isAdmin {
+		logger.Error("failed to authorize user")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	albumID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	var req delivery.ChangeAlbumStatusRequest
+	err = json.ReadJSON(w, r, &req)
+	if err != nil {
+		logger.Error("Failed to read JSON", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Failed to read JSON", nil)
+		return
+	}
+
+	var releaseDate time.Time
+	if req.ReleaseDate != "" {
+		releaseDate, err = time.Parse(time.DateOnly, req.ReleaseDate)
+		if err != nil {
+			logger.Error("Invalid release date", zap.Error(err))
+			json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid release date, expected YYYY-MM-DD", nil)
+			return
+		}
+	}
+
+	album, err := h.usecase.ChangeAlbumStatus(ctx, &usecase.ChangeAlbumStatusRequest{
+		AlbumID:     albumID,
+		LabelID:     labelID,
+		IsAdmin:     isAdmin,
+		Status:      usecase.AlbumStatus(req.Status),
+		ReleaseDate: releaseDate,
+	})
+	if err != nil {
+		logger.Error("failed to change album status", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.AlbumFromUsecaseToDelivery(album, album.Artists), nil)
+}
+
+// EditAlbumTracks godoc
+// @Summary Edit the tracks of a draft
+// @Description Renames and reorders every track of a draft album and replaces its artist credits. Only accessible by label members.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param id path integer true "Album ID"
+// @Param request body delivery.EditAlbumTracksRequest true "Album artists and every track of the album"
+// @Success 200 {object} delivery.Message "Tracks edited successfully"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid tracks or artists"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Forbidden - artists are not in the label"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album not found"
+// @Failure 409 {object} delivery.APIBadRequestErrorResponse "Conflict - album is not a draft"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id}/tracks [put]
+func (h *LabelHandler) EditAlbumTracks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel {
+		logger.Error("failed to get labelID")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	albumID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	var req delivery.EditAlbumTracksRequest
+	err = json.ReadJSON(w, r, &req)
+	if err != nil {
+		logger.Error("Failed to read JSON", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Failed to read JSON", nil)
+		return
+	}
+
+	err = h.usecase.EditAlbumTracks(ctx, model.EditAlbumTracksRequestFromDeliveryToUsecase(&req, albumID, labelID))
+	if err != nil {
+		logger.Error("failed to edit album tracks", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, "Tracks edited successfully", nil)
+}
+
+// EditAlbum godoc
+// @Summary Edit an album
+// @Description Changes the title, the type or the cover of an album of the label. Empty fields keep their current values, a new cover is also applied to every track of the album. Only accessible by label members.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param id path integer true "Album ID"
+// @Param request body delivery.EditAlbumRequest true "New album title, type and cover upload"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Album} "Edited album"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid title, type or upload"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album or upload not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id} [put]
+func (h *LabelHandler) EditAlbum(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel {
+		logger.Error("failed to get labelID")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	albumID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	var req delivery.EditAlbumRequest
+	err = json.ReadJSON(w, r, &req)
+	if err != nil {
+		logger.Error("Failed to read JSON", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Failed to read JSON", nil)
+		return
+	}
+
+	album, err := h.usecase.EditAlbum(ctx, model.EditAlbumRequestFromDeliveryToUsecase(&req, albumID, labelID))
+	if err != nil {
+		logger.Error("failed to edit album", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.AlbumFromUsecaseToDelivery(album, album.Artists), nil)
+}
+
+// EditTrack godoc
+// @Summary Edit a track of an album
+// @Description Renames a track, moves it to another position of the album and replaces the roles of its artists when they are given. Only accessible by label members.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param id path integer true "Album ID"
+// @Param track_id path integer true "Track ID"
+// @Param request body delivery.EditAlbumTrackRequest true "New track title, position and artist roles"
+// @Success 200 {object} delivery.Message "Track edited successfully"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid title, position or artist roles"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Forbidden - artists are not in the label"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album or track not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id}/tracks/{track_id} [put]
+func (h *LabelHandler) EditTrack(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel {
+		logger.Error("failed to get labelID")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	albumID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	trackID, err := strconv.ParseInt(vars["track_id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid track ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid track ID", nil)
+		return
+	}
+
+	var req delivery.EditAlbumTrackRequest
+	err = json.ReadJSON(w, r, &req)
+	if err != nil {
+		logger.Error("Failed to read JSON", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Failed to read JSON", nil)
+		return
+	}
+
+	err = h.usecase.EditTrack(ctx, model.EditAlbumTrackRequestFromDeliveryToUsecase(&req, albumID, trackID, labelID))
+	if err != nil {
+		logger.Error("failed to edit track", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, "Track edited successfully", nil)
+}
+
+// ReplaceTrackAudio godoc
+// @Summary Replace the audio of a track
+// @Description Swaps the file of a track for a completed upload and recomputes its duration. Only accessible by label members.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param id path integer true "Album ID"
+// @Param track_id path integer true "Track ID"
+// @Param request body delivery.ReplaceTrackAudioRequest true "Upload with the new audio file"
+// @Success 200 {object} delivery.Message "Track audio replaced successfully"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - upload is incomplete or not a supported audio file"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album, track or upload not found"
+// @Failure 409 {object} delivery.APIErrorResponse "The new audio is already in the catalog"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id}/tracks/{track_id}/audio [put]
+func (h *LabelHandler) ReplaceTrackAudio(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel {
+		logger.Error("failed to get labelID")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	albumID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	trackID, err := strconv.ParseInt(vars["track_id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid track ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid track ID", nil)
+		return
+	}
+
+	var req delivery.ReplaceTrackAudioRequest
+	err = json.ReadJSON(w, r, &req)
+	if err != nil {
+		logger.Error("Failed to read JSON", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Failed to read JSON", nil)
+		return
+	}
+
+	if req.UploadID == "" {
+		logger.Error("upload id is empty")
+		json.WriteErrorResponse(w, http.StatusBadRequest, "upload_id is required", nil)
+		return
+	}
+
+	err = h.usecase.ReplaceTrackAudio(ctx, &usecase.ReplaceTrackAudioRequest{
+		AlbumID:  albumID,
+		TrackID:  trackID,
+		LabelID:  labelID,
+		UploadID: req.UploadID,
+	})
+	if err != nil {
+		logger.Error("failed to replace track audio", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, "Track audio replaced successfully", nil)
+}
+
+// SaveTrackLyrics godoc
+// @Summary Upload lyrics of a track
+// @Description Stores plain or LRC lyrics of a track in one language, uploading a language again replaces its lyrics. Only accessible by label members.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param id path integer true "Album ID"
+// @Param track_id path integer true "Track ID"
+// @Param request body delivery.TrackLyricsRequest true "Lyrics and their language"
+// @Success 200 {object} delivery.APIResponse{body=delivery.TrackLyrics} "Lyrics as they were parsed"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid lyrics or language"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album or track not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id}/tracks/{track_id}/lyrics [put]
+func (h *LabelHandler) SaveTrackLyrics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel {
+		logger.Error("failed to get labelID")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	albumID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	trackID, err := strconv.ParseInt(vars["track_id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid track ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid track ID", nil)
+		return
+	}
+
+	var req delivery.TrackLyricsRequest
+	err = json.ReadJSON(w, r, &req)
+	if err != nil {
+		logger.Error("Failed to read JSON", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Failed to read JSON", nil)
+		return
+	}
+
+	lyrics, err := h.usecase.SaveTrackLyrics(ctx, &usecase.SaveTrackLyricsRequest{
+		AlbumID:     albumID,
+		TrackID:     trackID,
+		LabelID:     labelID,
+		Language:    req.Language,
+		Translation: req.Translation,
+		Lyrics:      req.Lyrics,
+	})
+	if err != nil {
+		logger.Error("failed to save track lyrics", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.TrackLyricsFromUsecaseToDelivery(lyrics), nil)
+}
+
+// DeleteTrackLyrics godoc
+// @Summary Delete lyrics of a track
+// @Description Deletes lyrics of a track in one language. Only accessible by label members.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param id path integer true "Album ID"
+// @Param track_id path integer true "Track ID"
+// @Param language path string true "ISO 639 code of the language"
+// @Success 200 {object} delivery.Message "Lyrics deleted successfully"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Album or lyrics not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/{id}/tracks/{track_id}/lyrics/{language} [delete]
+func (h *LabelHandler) DeleteTrackLyrics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel {
+		logger.Error("failed to get labelID")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	vars := mux.Vars(r)
+	albumID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid album ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid album ID", nil)
+		return
+	}
+
+	trackID, err := strconv.ParseInt(vars["track_id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid track ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid track ID", nil)
+		return
+	}
+
+	err = h.usecase.DeleteTrackLyrics(ctx, &usecase.DeleteTrackLyricsRequest{
+		AlbumID:  albumID,
+		TrackID:  trackID,
+		LabelID:  labelID,
+		Language: vars["language"],
+	})
+	if err != nil {
+		logger.Error("failed to delete track lyrics", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, "Lyrics deleted successfully", nil)
+}
+
+// GetAlbumsForReview godoc
+// @Summary Get releases waiting for review
+// @Description Retrieves albums of every label that were sent to review, oldest first. Only accessible by administrators.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security AdminAuth
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.Album} "Albums waiting for review"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination parameters"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized - admin access required"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/albums/review [get]
+func (h *LabelHandler) GetAlbumsForReview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if !ctxExtractor.AdminFromContext(ctx) {
+		logger.Error("Unauthorized access attempt")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	albums, err := h.usecase.GetAlbumsForReview(ctx, &usecase.AlbumFilters{
+		Pagination: model.PaginationFromDeliveryToUsecase(pagination),
+	})
+	if err != nil {
+		logger.Error("failed to get albums for review", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.AlbumsFromUsecaseToDelivery(albums), nil)
+}
+
+// GetDuplicateFlags godoc
+// @Summary Get uploads flagged as possible duplicates
+// @Description Retrieves uploaded tracks of every label that sound like a track already in the catalog, oldest first. Near certain duplicates are rejected at upload and never show up here. Only accessible by administrators.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security AdminAuth
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.DuplicateFlag} "Flags waiting for review"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination parameters"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized - admin access required"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/duplicates [get]
+func (h *LabelHandler) GetDuplicateFlags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if !ctxExtractor.AdminFromContext(ctx) {
+		logger.Error("Unauthorized access attempt")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	flags, err := h.usecase.GetDuplicateFlags(ctx, &usecase.AlbumFilters{
+		Pagination: model.PaginationFromDeliveryToUsecase(pagination),
+	})
+	if err != nil {
+		logger.Error("failed to get duplicate flags", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.DuplicateFlagsFromUsecaseToDelivery(flags), nil)
+}
+
+// PreviewAlbum godoc
+// @Summary Preview metadata of uploaded tracks
+// @Description Reads the ID3 tags and vorbis comments embedded in completed track uploads to prefill the release before it is created. Only accessible by label members.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param request body delivery.PreviewAlbumRequest true "Upload ids of the tracks"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.TrackMetadata} "Metadata of every track in the order of the request"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - upload of the wrong kind or not an audio file"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Upload not found or expired"
+// @Failure 409 {object} delivery.APIErrorResponse "Upload is not complete"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/album/preview [post]
+func (h *LabelHandler) PreviewAlbum(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, isLabel := ctxExtractor.LabelFromContext(ctx)
+	if !isLabel {
+		logger.Error("failed to get labelID")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return
+	}
+
+	request := &delivery.PreviewAlbumRequest{}
+	err := json.ReadJSON(w, r, request)
+	if err != nil {
+		logger.Error("failed to read json", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "failed to read json", nil)
+		return
+	}
+
+	if len(request.UploadIDs) == 0 {
+		logger.Error("upload_ids are empty")
+		json.WriteErrorResponse(w, http.StatusBadRequest, "upload_ids are empty", nil)
+		return
+	}
+	for _, uploadID := range request.UploadIDs {
+		if uploadID == "" {
+			logger.Error("upload_id is empty")
+			json.WriteErrorResponse(w, http.StatusBadRequest, "upload_id is empty", nil)
+			return
+		}
+	}
+
+	metadata, err := h.usecase.PreviewAlbum(ctx, labelID, request.UploadIDs)
+	if err != nil {
+		logger.Error("failed to preview album", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.TrackMetadataListFromUsecaseToDelivery(metadata), nil)
+}
+
+// ResolveDuplicateFlag godoc
+// @Summary Resolve a duplicate flag
+// @Description Confirms a flagged upload as a duplicate or dismisses the flag as a false match. Only accessible by administrators.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security AdminAuth
+// @Param id path integer true "Flag ID"
+// @Param request body delivery.ResolveDuplicateFlagRequest true "Either confirmed or dismissed"
+// @Success 200 {object} delivery.Message "Duplicate flag resolved successfully"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid status"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized - admin access required"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Flag not found or already resolved"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /api/v1/label/duplicates/{id} [put]
+func (h *LabelHandler) ResolveDuplicateFlag(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if !ctxExtractor.AdminFromContext(ctx) {
+		logger.Error("Unauthorized access attempt")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	flagID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("Invalid flag ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Invalid flag ID", nil)
+		return
+	}
+
+	var req delivery.ResolveDuplicateFlagRequest
+	err = json.ReadJSON(w, r, &req)
+	if err != nil {
+		logger.Error("Failed to read JSON", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, "Failed to read JSON", nil)
+		return
+	}
+
+	err = h.usecase.ResolveDuplicateFlag(ctx, flagID, req.Status)
+	if err != nil {
+		logger.Error("failed to resolve duplicate flag", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, "Duplicate flag resolved successfully", nil)
+}
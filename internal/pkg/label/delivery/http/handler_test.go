@@ -4,16 +4,17 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	mock_label "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/label/mocks"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
@@ -786,57 +787,6 @@ func TestLabelHandler_DeleteArtist(t *testing.T) {
 	}
 }
 
-func concatenateArtistIDs(artistIDs []int64, withSpaces bool) string {
-	var result string
-	separator := ","
-	if withSpaces {
-		separator = ", "
-	}
-	for i, id := range artistIDs {
-		if i > 0 {
-			result += separator
-		}
-		result += strconv.FormatInt(id, 10)
-	}
-	return result
-}
-
-func createAlbumMultipartFormData(t *testing.T, artistIDs []int64, albumType string, title string, image []byte, tracks []*delivery.CreateTrackRequest, artistIDsWithSpaces bool) (bytes.Buffer, string) {
-	var b bytes.Buffer
-	w := multipart.NewWriter(&b)
-
-	err := w.WriteField("artists_ids", concatenateArtistIDs(artistIDs, artistIDsWithSpaces))
-	assert.NoError(t, err)
-
-	err = w.WriteField("type", albumType)
-	assert.NoError(t, err)
-
-	err = w.WriteField("title", title)
-	assert.NoError(t, err)
-
-	if image != nil {
-		part, err := w.CreateFormFile("thumbnail", "album_image.jpg")
-		assert.NoError(t, err)
-		_, err = io.Copy(part, bytes.NewReader(image))
-		assert.NoError(t, err)
-	}
-
-	for i, track := range tracks {
-		trackPart, err := w.CreateFormFile("tracks[]", fmt.Sprintf("track_%d.mp3", i))
-		assert.NoError(t, err)
-		_, err = io.Copy(trackPart, bytes.NewReader(track.Track))
-		assert.NoError(t, err)
-
-		err = w.WriteField("track_titles[]", track.Title)
-		assert.NoError(t, err)
-	}
-
-	err = w.Close()
-	assert.NoError(t, err)
-
-	return b, w.FormDataContentType()
-}
-
 func TestLabelHandler_CreateAlbum(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -854,10 +804,9 @@ func TestLabelHandler_CreateAlbum(t *testing.T) {
 	tests := []struct {
 		name           string
 		artistIDs      []int64
-		artistIDsSpace bool
 		Type           string
 		title          string
-		image          []byte
+		coverUploadID  string
 		tracks         []*delivery.CreateTrackRequest
 		labelID        int64
 		mockBehavior   func()
@@ -865,22 +814,32 @@ func TestLabelHandler_CreateAlbum(t *testing.T) {
 		expectedBody   map[string]interface{}
 	}{
 		{
-			name:      "Create Album Success",
-			artistIDs: []int64{1, 2},
-			Type:      "album",
-			title:     "Test Album",
-			image:     []byte("fake image data"),
+			name:          "Create Album Success",
+			artistIDs:     []int64{1, 2},
+			Type:          "album",
+			title:         "Test Album",
+			coverUploadID: "cover-upload",
 			tracks: []*delivery.CreateTrackRequest{
 				{
-					Title: "Track 1",
-					Track: []byte("fake track data 1"),
+					Title:    "Track 1",
+					UploadID: "track-upload",
 				},
 			},
+			labelID: 3,
 			mockBehavior: func() {
 				mockUsecase.EXPECT().CreateAlbum(
 					gomock.Any(),
-					gomock.Any(),
-				).Return(int64(1), "album-url.jpg", nil)
+					&usecase.CreateAlbumRequest{
+						ArtistsIDs:    []int64{1, 2},
+						Type:          "album",
+						Title:         "Test Album",
+						CoverUploadID: "cover-upload",
+						Tracks: []*usecase.CreateTrackRequest{
+							{Title: "Track 1", UploadID: "track-upload"},
+						},
+						LabelID: 3,
+					},
+				).Return(&usecase.Album{ID: 1, Thumbnail: "album-url.jpg"}, nil)
 			},
 			expectedStatus: http.StatusCreated,
 			expectedBody: map[string]interface{}{
@@ -905,56 +864,41 @@ func TestLabelHandler_CreateAlbum(t *testing.T) {
 			},
 		},
 		{
-			name:           "Create Album Success With Spaces",
-			artistIDs:      []int64{1, 2},
-			artistIDsSpace: true,
-			Type:           "album",
-			title:          "Test Album",
-			image:          []byte("fake image data"),
+			name:          "Create Album - upload incomplete",
+			artistIDs:     []int64{1, 2},
+			Type:          "album",
+			title:         "Test Album",
+			coverUploadID: "cover-upload",
 			tracks: []*delivery.CreateTrackRequest{
 				{
-					Title: "Track 1",
-					Track: []byte("fake track data 1"),
+					Title:    "Track 1",
+					UploadID: "track-upload",
 				},
 			},
 			mockBehavior: func() {
 				mockUsecase.EXPECT().CreateAlbum(
 					gomock.Any(),
 					gomock.Any(),
-				).Return(int64(1), "album-url.jpg", nil)
+				).Return(nil, customErrors.ErrUploadIncomplete)
 			},
-			expectedStatus: http.StatusCreated,
+			expectedStatus: http.StatusConflict,
 			expectedBody: map[string]interface{}{
-				"status": int(http.StatusCreated),
-				"body": map[string]interface{}{
-					"id":            float64(1),
-					"title":         "Test Album",
-					"type":          "album",
-					"thumbnail_url": "album-url.jpg",
-					"is_liked":      false,
-					"artists": []interface{}{
-						map[string]interface{}{
-							"id":    float64(1),
-							"title": "",
-						},
-						map[string]interface{}{
-							"id":    float64(2),
-							"title": "",
-						},
-					},
+				"status": int(http.StatusConflict),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrUploadIncomplete.Error(),
 				},
 			},
 		},
 		{
-			name:      "Create Album - not label",
-			artistIDs: []int64{1, 2},
-			Type:      "album",
-			title:     "Test Album",
-			image:     []byte("fake image data"),
+			name:          "Create Album - not label",
+			artistIDs:     []int64{1, 2},
+			Type:          "album",
+			title:         "Test Album",
+			coverUploadID: "cover-upload",
 			tracks: []*delivery.CreateTrackRequest{
 				{
-					Title: "Track 1",
-					Track: []byte("fake track data 1"),
+					Title:    "Track 1",
+					UploadID: "track-upload",
 				},
 			},
 			mockBehavior:   func() {},
@@ -967,15 +911,15 @@ func TestLabelHandler_CreateAlbum(t *testing.T) {
 			},
 		},
 		{
-			name:      "Create Album - no title",
-			artistIDs: []int64{1, 2},
-			Type:      "album",
-			title:     "",
-			image:     []byte("fake image data"),
+			name:          "Create Album - no title",
+			artistIDs:     []int64{1, 2},
+			Type:          "album",
+			title:         "",
+			coverUploadID: "cover-upload",
 			tracks: []*delivery.CreateTrackRequest{
 				{
-					Title: "Track 1",
-					Track: []byte("fake track data 1"),
+					Title:    "Track 1",
+					UploadID: "track-upload",
 				},
 			},
 			mockBehavior: func() {
@@ -993,23 +937,18 @@ func TestLabelHandler_CreateAlbum(t *testing.T) {
 			},
 		},
 		{
-			name:      "Create Album - no type",
-			artistIDs: []int64{1, 2},
-			Type:      "",
-			title:     "album",
-			image:     []byte("fake image data"),
+			name:          "Create Album - no type",
+			artistIDs:     []int64{1, 2},
+			Type:          "",
+			title:         "album",
+			coverUploadID: "cover-upload",
 			tracks: []*delivery.CreateTrackRequest{
 				{
-					Title: "Track 1",
-					Track: []byte("fake track data 1"),
+					Title:    "Track 1",
+					UploadID: "track-upload",
 				},
 			},
-			mockBehavior: func() {
-				mockUsecase.EXPECT().CreateAlbum(
-					gomock.Any(),
-					gomock.Any(),
-				).Times(0)
-			},
+			mockBehavior:   func() {},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: map[string]interface{}{
 				"status": int(http.StatusBadRequest),
@@ -1019,28 +958,66 @@ func TestLabelHandler_CreateAlbum(t *testing.T) {
 			},
 		},
 		{
-			name:      "Create Album - wrong type",
+			name:          "Create Album - wrong type",
+			artistIDs:     []int64{1, 2},
+			Type:          "wrong",
+			title:         "album",
+			coverUploadID: "cover-upload",
+			tracks: []*delivery.CreateTrackRequest{
+				{
+					Title:    "Track 1",
+					UploadID: "track-upload",
+				},
+			},
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": "type is invalid",
+				},
+			},
+		},
+		{
+			name:      "Create Album - no cover",
 			artistIDs: []int64{1, 2},
-			Type:      "wrong_type",
+			Type:      "album",
 			title:     "album",
-			image:     []byte("fake image data"),
 			tracks: []*delivery.CreateTrackRequest{
 				{
-					Title: "Track 1",
-					Track: []byte("fake track data 1"),
+					Title:    "Track 1",
+					UploadID: "track-upload",
 				},
 			},
 			mockBehavior: func() {
-				mockUsecase.EXPECT().CreateAlbum(
-					gomock.Any(),
-					gomock.Any(),
-				).Times(0)
+				// the cover may still come from the tags embedded in the tracks
+				mockUsecase.EXPECT().CreateAlbum(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrAlbumCoverRequired)
 			},
 			expectedStatus: http.StatusBadRequest,
 			expectedBody: map[string]interface{}{
 				"status": int(http.StatusBadRequest),
 				"error": map[string]interface{}{
-					"message": "type is invalid",
+					"message": "thumbnail image is required",
+				},
+			},
+		},
+		{
+			name:          "Create Album - track without upload",
+			artistIDs:     []int64{1, 2},
+			Type:          "album",
+			title:         "album",
+			coverUploadID: "cover-upload",
+			tracks: []*delivery.CreateTrackRequest{
+				{
+					Title: "Track 1",
+				},
+			},
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": "track upload_id is empty",
 				},
 			},
 		},
@@ -1049,9 +1026,16 @@ func TestLabelHandler_CreateAlbum(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			tt.mockBehavior()
 
-			body, str := createAlbumMultipartFormData(t, tt.artistIDs, tt.Type, tt.title, tt.image, tt.tracks, tt.artistIDsSpace)
-			req, err := http.NewRequest("POST", "/label/album", &body)
-			req.Header.Set("Content-Type", str)
+			body, err := json.Marshal(&delivery.CreateAlbumRequest{
+				ArtistsIDs:    tt.artistIDs,
+				Type:          tt.Type,
+				Title:         tt.title,
+				CoverUploadID: tt.coverUploadID,
+				Tracks:        tt.tracks,
+			})
+			assert.NoError(t, err)
+			req, err := http.NewRequest("POST", "/label/album", bytes.NewReader(body))
+			req.Header.Set("Content-Type", "application/json")
 			assert.NoError(t, err)
 
 			req = setupTestLogger(req)
@@ -1253,3 +1237,922 @@ func TestLabelHandler_GetAlbumsByLabelID(t *testing.T) {
 		})
 	}
 }
+
+func TestLabelHandler_ChangeAlbumStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		albumID        string
+		body           string
+		isLabel        bool
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Change Status - not label",
+			albumID:        "1",
+			body:           `{"status":"in_review","release_date":"2030-01-01"}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusUnauthorized),
+				"error": map[string]interface{}{
+					"message": "user not in label",
+				},
+			},
+		},
+		{
+			name:    "Change Status - invalid release date",
+			albumID: "1",
+			body:    `{"status":"in_review","release_date":"01.01.2030"}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ChangeAlbumStatus(gomock.Any(), gomock.Any()).Times(0)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": "Invalid release date, expected YYYY-MM-DD",
+				},
+			},
+		},
+		{
+			name:    "Change Status - transition not allowed",
+			albumID: "1",
+			body:    `{"status":"taken_down"}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ChangeAlbumStatus(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrAlbumStatusTransition)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusConflict),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrAlbumStatusTransition.Error(),
+				},
+			},
+		},
+		{
+			name:    "Change Status - success",
+			albumID: "1",
+			body:    `{"status":"in_review","release_date":"2030-01-01"}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ChangeAlbumStatus(gomock.Any(), &usecase.ChangeAlbumStatusRequest{
+					AlbumID:     1,
+					LabelID:     2,
+					Status:      usecase.AlbumStatusInReview,
+					ReleaseDate: time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC),
+				}).Return(&usecase.Album{
+					ID:     1,
+					Title:  "Album 1",
+					Type:   "album",
+					Status: usecase.AlbumStatusInReview,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body": map[string]interface{}{
+					"id":            float64(1),
+					"title":         "Album 1",
+					"thumbnail_url": "",
+					"type":          "album",
+					"is_liked":      false,
+					"status":        "in_review",
+					"artists":       []interface{}{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("PUT", "/label/album/"+tt.albumID+"/status", bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.albumID})
+
+			req = setupTestLogger(req)
+			if tt.isLabel {
+				req = addLabelToContext(req, 2)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.ChangeAlbumStatus(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_EditAlbumTracks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		albumID        string
+		body           string
+		isLabel        bool
+		isAdmin        bool
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Edit Tracks - admin is not a label",
+			albumID:        "1",
+			body:           `{"artists_ids":[1],"tracks":[{"id":10,"title":"Track","position":1}]}`,
+			isAdmin:        true,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusUnauthorized),
+				"error": map[string]interface{}{
+					"message": "user not in label",
+				},
+			},
+		},
+		{
+			name:    "Edit Tracks - not draft",
+			albumID: "1",
+			body:    `{"artists_ids":[1],"tracks":[{"id":10,"title":"Track","position":1}]}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().EditAlbumTracks(gomock.Any(), gomock.Any()).Return(customErrors.ErrAlbumNotDraft)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusConflict),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrAlbumNotDraft.Error(),
+				},
+			},
+		},
+		{
+			name:    "Edit Tracks - success",
+			albumID: "1",
+			body:    `{"artists_ids":[1],"tracks":[{"id":10,"title":"Track","position":1,"artists_ids":[1,3]}]}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().EditAlbumTracks(gomock.Any(), &usecase.EditAlbumTracksRequest{
+					AlbumID:    1,
+					LabelID:    2,
+					ArtistsIDs: []int64{1},
+					Tracks: []*usecase.EditTrackRequest{
+						{ID: 10, Title: "Track", Position: 1, ArtistsIDs: []int64{1, 3}},
+					},
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body":   "Tracks edited successfully",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("PUT", "/label/album/"+tt.albumID+"/tracks", bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.albumID})
+
+			req = setupTestLogger(req)
+			if tt.isLabel {
+				req = addLabelToContext(req, 2)
+			}
+			if tt.isAdmin {
+				req = addAdminToContext(req)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.EditAlbumTracks(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_EditAlbum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		albumID        string
+		body           string
+		isLabel        bool
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Edit Album - not a label",
+			albumID:        "1",
+			body:           `{"title":"Album 2"}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusUnauthorized),
+				"error": map[string]interface{}{
+					"message": "user not in label",
+				},
+			},
+		},
+		{
+			name:    "Edit Album - invalid title",
+			albumID: "1",
+			body:    `{"title":" "}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().EditAlbum(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrAlbumTitleInvalid)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrAlbumTitleInvalid.Error(),
+				},
+			},
+		},
+		{
+			name:    "Edit Album - invalid type",
+			albumID: "1",
+			body:    `{"type":"mixtape"}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().EditAlbum(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrAlbumInvalidType)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrAlbumInvalidType.Error(),
+				},
+			},
+		},
+		{
+			name:    "Edit Album - success",
+			albumID: "1",
+			body:    `{"title":"Album 2","type":"ep","cover_upload_id":"cover-upload"}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().EditAlbum(gomock.Any(), &usecase.EditAlbumRequest{
+					AlbumID:       1,
+					LabelID:       2,
+					Title:         "Album 2",
+					Type:          "ep",
+					CoverUploadID: "cover-upload",
+				}).Return(&usecase.Album{
+					ID:        1,
+					Title:     "Album 2",
+					Type:      "ep",
+					Thumbnail: "new_cover",
+					Status:    usecase.AlbumStatusDraft,
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body": map[string]interface{}{
+					"id":            float64(1),
+					"title":         "Album 2",
+					"thumbnail_url": "new_cover",
+					"type":          "ep",
+					"is_liked":      false,
+					"status":        "draft",
+					"artists":       []interface{}{},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("PUT", "/label/album/"+tt.albumID, bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.albumID})
+
+			req = setupTestLogger(req)
+			if tt.isLabel {
+				req = addLabelToContext(req, 2)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.EditAlbum(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_EditTrack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		albumID        string
+		trackID        string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Edit Track - invalid track ID",
+			albumID:        "1",
+			trackID:        "abc",
+			body:           `{"title":"Track"}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": "Invalid track ID",
+				},
+			},
+		},
+		{
+			name:    "Edit Track - position out of range",
+			albumID: "1",
+			trackID: "10",
+			body:    `{"title":"Track","position":7}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().EditTrack(gomock.Any(), gomock.Any()).Return(customErrors.ErrTrackPositionInvalid)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrTrackPositionInvalid.Error(),
+				},
+			},
+		},
+		{
+			name:    "Edit Track - success",
+			albumID: "1",
+			trackID: "10",
+			body:    `{"title":"Track","position":2,"artists":[{"artist_id":5,"role":"main"},{"artist_id":6,"role":"featured"}]}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().EditTrack(gomock.Any(), &usecase.EditAlbumTrackRequest{
+					AlbumID:  1,
+					TrackID:  10,
+					LabelID:  2,
+					Title:    "Track",
+					Position: 2,
+					Artists: []*usecase.TrackArtistRole{
+						{ArtistID: 5, Role: "main"},
+						{ArtistID: 6, Role: "featured"},
+					},
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body":   "Track edited successfully",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("PUT", "/label/album/"+tt.albumID+"/tracks/"+tt.trackID, bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.albumID, "track_id": tt.trackID})
+
+			req = setupTestLogger(req)
+			req = addLabelToContext(req, 2)
+
+			rec := httptest.NewRecorder()
+			handler.EditTrack(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_ReplaceTrackAudio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Replace Audio - missing upload",
+			body:           `{}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": "upload_id is required",
+				},
+			},
+		},
+		{
+			name: "Replace Audio - not an mp3",
+			body: `{"upload_id":"track-upload"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ReplaceTrackAudio(gomock.Any(), gomock.Any()).Return(customErrors.ErrTrackAudioInvalid)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrTrackAudioInvalid.Error(),
+				},
+			},
+		},
+		{
+			name: "Replace Audio - success",
+			body: `{"upload_id":"track-upload"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ReplaceTrackAudio(gomock.Any(), &usecase.ReplaceTrackAudioRequest{
+					AlbumID:  1,
+					TrackID:  10,
+					LabelID:  2,
+					UploadID: "track-upload",
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body":   "Track audio replaced successfully",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("PUT", "/label/album/1/tracks/10/audio", bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": "1", "track_id": "10"})
+
+			req = setupTestLogger(req)
+			req = addLabelToContext(req, 2)
+
+			rec := httptest.NewRecorder()
+			handler.ReplaceTrackAudio(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_SaveTrackLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Save Lyrics - invalid JSON",
+			body:           `{`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": "Failed to read JSON",
+				},
+			},
+		},
+		{
+			name: "Save Lyrics - invalid language",
+			body: `{"language":"english","lyrics":"Hello"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().SaveTrackLyrics(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrTrackLyricsLanguageInvalid)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrTrackLyricsLanguageInvalid.Error(),
+				},
+			},
+		},
+		{
+			name: "Save Lyrics - success",
+			body: `{"language":"ru","translation":true,"lyrics":"[00:01.50]Привет"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().SaveTrackLyrics(gomock.Any(), &usecase.SaveTrackLyricsRequest{
+					AlbumID:     1,
+					TrackID:     10,
+					LabelID:     2,
+					Language:    "ru",
+					Translation: true,
+					Lyrics:      "[00:01.50]Привет",
+				}).Return(&usecase.TrackLyrics{
+					Language:    "ru",
+					Translation: true,
+					Synced:      true,
+					Lines:       []*usecase.LyricsLine{{Time: 1500, Text: "Привет"}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body": map[string]interface{}{
+					"language":    "ru",
+					"translation": true,
+					"synced":      true,
+					"lines": []interface{}{
+						map[string]interface{}{"time_ms": float64(1500), "text": "Привет"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("PUT", "/label/album/1/tracks/10/lyrics", bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": "1", "track_id": "10"})
+
+			req = setupTestLogger(req)
+			req = addLabelToContext(req, 2)
+
+			rec := httptest.NewRecorder()
+			handler.SaveTrackLyrics(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_DeleteTrackLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Delete Lyrics - not found",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteTrackLyrics(gomock.Any(), gomock.Any()).Return(customErrors.ErrTrackLyricsNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusNotFound),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrTrackLyricsNotFound.Error(),
+				},
+			},
+		},
+		{
+			name: "Delete Lyrics - success",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().DeleteTrackLyrics(gomock.Any(), &usecase.DeleteTrackLyricsRequest{
+					AlbumID:  1,
+					TrackID:  10,
+					LabelID:  2,
+					Language: "ru",
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body":   "Lyrics deleted successfully",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("DELETE", "/label/album/1/tracks/10/lyrics/ru", nil)
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": "1", "track_id": "10", "language": "ru"})
+
+			req = setupTestLogger(req)
+			req = addLabelToContext(req, 2)
+
+			rec := httptest.NewRecorder()
+			handler.DeleteTrackLyrics(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_GetDuplicateFlags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	cfg := &config.Config{
+		Pagination: config.PaginationConfig{
+			DefaultLimit: 10,
+			MaxLimit:     100,
+		},
+	}
+
+	handler := NewLabelHandler(mockUsecase, cfg)
+
+	tests := []struct {
+		name           string
+		isAdmin        bool
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Get Duplicate Flags - not admin",
+			isAdmin:        false,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusUnauthorized),
+				"error": map[string]interface{}{
+					"message": "Unauthorized",
+				},
+			},
+		},
+		{
+			name:    "Get Duplicate Flags - success",
+			isAdmin: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetDuplicateFlags(gomock.Any(), &usecase.AlbumFilters{
+					Pagination: &usecase.Pagination{Offset: 0, Limit: 10},
+				}).Return([]*usecase.DuplicateFlag{{
+					ID:           1,
+					Track:        usecase.DuplicateFlagTrack{ID: 7, Title: "Upload", AlbumID: 4, LabelID: 3},
+					MatchedTrack: usecase.DuplicateFlagTrack{ID: 5, Title: "Original", AlbumID: 2, LabelID: 1},
+					Similarity:   0.8,
+					Status:       "pending",
+					CreatedAt:    time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC),
+				}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body": []interface{}{
+					map[string]interface{}{
+						"id": float64(1),
+						"track": map[string]interface{}{
+							"id":       float64(7),
+							"title":    "Upload",
+							"album_id": float64(4),
+							"label_id": float64(3),
+						},
+						"matched_track": map[string]interface{}{
+							"id":       float64(5),
+							"title":    "Original",
+							"album_id": float64(2),
+							"label_id": float64(1),
+						},
+						"similarity": 0.8,
+						"status":     "pending",
+						"created_at": "2025-05-01T12:00:00Z",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("GET", "/label/duplicates", nil)
+			assert.NoError(t, err)
+
+			req = setupTestLogger(req)
+			if tt.isAdmin {
+				req = addAdminToContext(req)
+			}
+
+			rec := httptest.NewRecorder()
+			handler.GetDuplicateFlags(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_ResolveDuplicateFlag(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name: "Resolve Duplicate Flag - invalid status",
+			body: `{"status":"pending"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ResolveDuplicateFlag(gomock.Any(), int64(1), "pending").Return(customErrors.ErrDuplicateFlagStatusInvalid)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrDuplicateFlagStatusInvalid.Error(),
+				},
+			},
+		},
+		{
+			name: "Resolve Duplicate Flag - already resolved",
+			body: `{"status":"dismissed"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ResolveDuplicateFlag(gomock.Any(), int64(1), "dismissed").Return(customErrors.ErrDuplicateFlagNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusNotFound),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrDuplicateFlagNotFound.Error(),
+				},
+			},
+		},
+		{
+			name: "Resolve Duplicate Flag - success",
+			body: `{"status":"confirmed"}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().ResolveDuplicateFlag(gomock.Any(), int64(1), "confirmed").Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body":   "Duplicate flag resolved successfully",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("PUT", "/label/duplicates/1", bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+
+			req = setupTestLogger(req)
+			req = addAdminToContext(req)
+
+			rec := httptest.NewRecorder()
+			handler.ResolveDuplicateFlag(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestLabelHandler_PreviewAlbum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_label.NewMockUsecase(ctrl)
+	handler := NewLabelHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:           "Preview Album - no uploads",
+			body:           `{"upload_ids":[]}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": "upload_ids are empty",
+				},
+			},
+		},
+		{
+			name: "Preview Album - not an audio file",
+			body: `{"upload_ids":["track-upload"]}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().PreviewAlbum(gomock.Any(), int64(2), []string{"track-upload"}).Return(nil, customErrors.ErrTrackAudioInvalid)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusBadRequest),
+				"error": map[string]interface{}{
+					"message": customErrors.ErrTrackAudioInvalid.Error(),
+				},
+			},
+		},
+		{
+			name: "Preview Album - success",
+			body: `{"upload_ids":["track-upload"]}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().PreviewAlbum(gomock.Any(), int64(2), []string{"track-upload"}).Return([]*usecase.TrackMetadata{
+					{
+						UploadID:    "track-upload",
+						Format:      "mp3",
+						Title:       "Intro",
+						TrackNumber: 1,
+						Artists:     []string{"Artist", "Guest"},
+						ArtistIDs:   []int64{3},
+						Year:        2025,
+						CoverKey:    "uploads/track-upload-cover",
+						CoverURL:    "https://images.s3.cloud.ru/uploads/track-upload-cover",
+					},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": int(http.StatusOK),
+				"body": []interface{}{
+					map[string]interface{}{
+						"upload_id":    "track-upload",
+						"format":       "mp3",
+						"title":        "Intro",
+						"track_number": float64(1),
+						"artists":      []interface{}{"Artist", "Guest"},
+						"artist_ids":   []interface{}{float64(3)},
+						"genre":        "",
+						"year":         float64(2025),
+						"isrc":         "",
+						"cover_url":    "https://images.s3.cloud.ru/uploads/track-upload-cover",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req, err := http.NewRequest("POST", "/label/album/preview", bytes.NewBufferString(tt.body))
+			assert.NoError(t, err)
+
+			req = setupTestLogger(req)
+			req = addLabelToContext(req, 2)
+
+			rec := httptest.NewRecorder()
+			handler.PreviewAlbum(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
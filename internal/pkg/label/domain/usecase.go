@@ -2,6 +2,7 @@ package domain
 
 import (
 	"context"
+	"time"
 
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
 )
@@ -13,8 +14,21 @@ type Usecase interface {
 	EditArtist(ctx context.Context, artist *usecaseModel.ArtistEdit) (*usecaseModel.Artist, error)
 	GetArtists(ctx context.Context, labelID int64, filters *usecaseModel.ArtistFilters) ([]*usecaseModel.Artist, error)
 	DeleteArtist(ctx context.Context, artist *usecaseModel.ArtistDelete) error
-	CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (int64, string, error)
+	CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (*usecaseModel.Album, error)
 	UpdateLabel(ctx context.Context, labelID int64, toAdd, toRemove []string) error
 	DeleteAlbum(ctx context.Context, albumID, labelID int64) error
 	GetAlbumsByLabelID(ctx context.Context, labelID int64, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.Album, error)
+	GetLabelAlbum(ctx context.Context, albumID int64, labelID int64, isAdmin bool) (*usecaseModel.LabelAlbum, error)
+	ChangeAlbumStatus(ctx context.Context, request *usecaseModel.ChangeAlbumStatusRequest) (*usecaseModel.Album, error)
+	EditAlbumTracks(ctx context.Context, request *usecaseModel.EditAlbumTracksRequest) error
+	GetAlbumsForReview(ctx context.Context, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.Album, error)
+	RunPublisher(ctx context.Context, interval time.Duration)
+	EditAlbum(ctx context.Context, request *usecaseModel.EditAlbumRequest) (*usecaseModel.Album, error)
+	EditTrack(ctx context.Context, request *usecaseModel.EditAlbumTrackRequest) error
+	ReplaceTrackAudio(ctx context.Context, request *usecaseModel.ReplaceTrackAudioRequest) error
+	SaveTrackLyrics(ctx context.Context, request *usecaseModel.SaveTrackLyricsRequest) (*usecaseModel.TrackLyrics, error)
+	DeleteTrackLyrics(ctx context.Context, request *usecaseModel.DeleteTrackLyricsRequest) error
+	GetDuplicateFlags(ctx context.Context, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.DuplicateFlag, error)
+	ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error
+	PreviewAlbum(ctx context.Context, labelID int64, uploadIDs []string) ([]*usecaseModel.TrackMetadata, error)
 }
@@ -12,6 +12,7 @@ package mock_label
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	usecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
 	gomock "go.uber.org/mock/gomock"
@@ -41,14 +42,28 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangeAlbumStatus mocks base method.
+func (m *MockUsecase) ChangeAlbumStatus(ctx context.Context, request *usecase.ChangeAlbumStatusRequest) (*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeAlbumStatus", ctx, request)
+	ret0, _ := ret[0].(*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeAlbumStatus indicates an expected call of ChangeAlbumStatus.
+func (mr *MockUsecaseMockRecorder) ChangeAlbumStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAlbumStatus", reflect.TypeOf((*MockUsecase)(nil).ChangeAlbumStatus), ctx, request)
+}
+
 // CreateAlbum mocks base method.
-func (m *MockUsecase) CreateAlbum(ctx context.Context, album *usecase.CreateAlbumRequest) (int64, string, error) {
+func (m *MockUsecase) CreateAlbum(ctx context.Context, album *usecase.CreateAlbumRequest) (*usecase.Album, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateAlbum", ctx, album)
-	ret0, _ := ret[0].(int64)
-	ret1, _ := ret[1].(string)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret0, _ := ret[0].(*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
 // CreateAlbum indicates an expected call of CreateAlbum.
@@ -115,6 +130,49 @@ func (mr *MockUsecaseMockRecorder) DeleteArtist(ctx, artist any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtist", reflect.TypeOf((*MockUsecase)(nil).DeleteArtist), ctx, artist)
 }
 
+// DeleteTrackLyrics mocks base method.
+func (m *MockUsecase) DeleteTrackLyrics(ctx context.Context, request *usecase.DeleteTrackLyricsRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrackLyrics", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrackLyrics indicates an expected call of DeleteTrackLyrics.
+func (mr *MockUsecaseMockRecorder) DeleteTrackLyrics(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackLyrics", reflect.TypeOf((*MockUsecase)(nil).DeleteTrackLyrics), ctx, request)
+}
+
+// EditAlbum mocks base method.
+func (m *MockUsecase) EditAlbum(ctx context.Context, request *usecase.EditAlbumRequest) (*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAlbum", ctx, request)
+	ret0, _ := ret[0].(*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditAlbum indicates an expected call of EditAlbum.
+func (mr *MockUsecaseMockRecorder) EditAlbum(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAlbum", reflect.TypeOf((*MockUsecase)(nil).EditAlbum), ctx, request)
+}
+
+// EditAlbumTracks mocks base method.
+func (m *MockUsecase) EditAlbumTracks(ctx context.Context, request *usecase.EditAlbumTracksRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAlbumTracks", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditAlbumTracks indicates an expected call of EditAlbumTracks.
+func (mr *MockUsecaseMockRecorder) EditAlbumTracks(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAlbumTracks", reflect.TypeOf((*MockUsecase)(nil).EditAlbumTracks), ctx, request)
+}
+
 // EditArtist mocks base method.
 func (m *MockUsecase) EditArtist(ctx context.Context, artist *usecase.ArtistEdit) (*usecase.Artist, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +188,20 @@ func (mr *MockUsecaseMockRecorder) EditArtist(ctx, artist any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditArtist", reflect.TypeOf((*MockUsecase)(nil).EditArtist), ctx, artist)
 }
 
+// EditTrack mocks base method.
+func (m *MockUsecase) EditTrack(ctx context.Context, request *usecase.EditAlbumTrackRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditTrack", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditTrack indicates an expected call of EditTrack.
+func (mr *MockUsecaseMockRecorder) EditTrack(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditTrack", reflect.TypeOf((*MockUsecase)(nil).EditTrack), ctx, request)
+}
+
 // GetAlbumsByLabelID mocks base method.
 func (m *MockUsecase) GetAlbumsByLabelID(ctx context.Context, labelID int64, filters *usecase.AlbumFilters) ([]*usecase.Album, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +217,21 @@ func (mr *MockUsecaseMockRecorder) GetAlbumsByLabelID(ctx, labelID, filters any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByLabelID", reflect.TypeOf((*MockUsecase)(nil).GetAlbumsByLabelID), ctx, labelID, filters)
 }
 
+// GetAlbumsForReview mocks base method.
+func (m *MockUsecase) GetAlbumsForReview(ctx context.Context, filters *usecase.AlbumFilters) ([]*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumsForReview", ctx, filters)
+	ret0, _ := ret[0].([]*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumsForReview indicates an expected call of GetAlbumsForReview.
+func (mr *MockUsecaseMockRecorder) GetAlbumsForReview(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsForReview", reflect.TypeOf((*MockUsecase)(nil).GetAlbumsForReview), ctx, filters)
+}
+
 // GetArtists mocks base method.
 func (m *MockUsecase) GetArtists(ctx context.Context, labelID int64, filters *usecase.ArtistFilters) ([]*usecase.Artist, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +247,21 @@ func (mr *MockUsecaseMockRecorder) GetArtists(ctx, labelID, filters any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtists", reflect.TypeOf((*MockUsecase)(nil).GetArtists), ctx, labelID, filters)
 }
 
+// GetDuplicateFlags mocks base method.
+func (m *MockUsecase) GetDuplicateFlags(ctx context.Context, filters *usecase.AlbumFilters) ([]*usecase.DuplicateFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDuplicateFlags", ctx, filters)
+	ret0, _ := ret[0].([]*usecase.DuplicateFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDuplicateFlags indicates an expected call of GetDuplicateFlags.
+func (mr *MockUsecaseMockRecorder) GetDuplicateFlags(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDuplicateFlags", reflect.TypeOf((*MockUsecase)(nil).GetDuplicateFlags), ctx, filters)
+}
+
 // GetLabel mocks base method.
 func (m *MockUsecase) GetLabel(ctx context.Context, id int64) (*usecase.Label, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +277,91 @@ func (mr *MockUsecaseMockRecorder) GetLabel(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabel", reflect.TypeOf((*MockUsecase)(nil).GetLabel), ctx, id)
 }
 
+// GetLabelAlbum mocks base method.
+func (m *MockUsecase) GetLabelAlbum(ctx context.Context, albumID, labelID int64, isAdmin bool) (*usecase.LabelAlbum, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelAlbum", ctx, albumID, labelID, isAdmin)
+	ret0, _ := ret[0].(*usecase.LabelAlbum)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelAlbum indicates an expected call of GetLabelAlbum.
+func (mr *MockUsecaseMockRecorder) GetLabelAlbum(ctx, albumID, labelID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelAlbum", reflect.TypeOf((*MockUsecase)(nil).GetLabelAlbum), ctx, albumID, labelID, isAdmin)
+}
+
+// PreviewAlbum mocks base method.
+func (m *MockUsecase) PreviewAlbum(ctx context.Context, labelID int64, uploadIDs []string) ([]*usecase.TrackMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewAlbum", ctx, labelID, uploadIDs)
+	ret0, _ := ret[0].([]*usecase.TrackMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewAlbum indicates an expected call of PreviewAlbum.
+func (mr *MockUsecaseMockRecorder) PreviewAlbum(ctx, labelID, uploadIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewAlbum", reflect.TypeOf((*MockUsecase)(nil).PreviewAlbum), ctx, labelID, uploadIDs)
+}
+
+// ReplaceTrackAudio mocks base method.
+func (m *MockUsecase) ReplaceTrackAudio(ctx context.Context, request *usecase.ReplaceTrackAudioRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTrackAudio", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceTrackAudio indicates an expected call of ReplaceTrackAudio.
+func (mr *MockUsecaseMockRecorder) ReplaceTrackAudio(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTrackAudio", reflect.TypeOf((*MockUsecase)(nil).ReplaceTrackAudio), ctx, request)
+}
+
+// ResolveDuplicateFlag mocks base method.
+func (m *MockUsecase) ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDuplicateFlag", ctx, flagID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveDuplicateFlag indicates an expected call of ResolveDuplicateFlag.
+func (mr *MockUsecaseMockRecorder) ResolveDuplicateFlag(ctx, flagID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDuplicateFlag", reflect.TypeOf((*MockUsecase)(nil).ResolveDuplicateFlag), ctx, flagID, status)
+}
+
+// RunPublisher mocks base method.
+func (m *MockUsecase) RunPublisher(ctx context.Context, interval time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunPublisher", ctx, interval)
+}
+
+// RunPublisher indicates an expected call of RunPublisher.
+func (mr *MockUsecaseMockRecorder) RunPublisher(ctx, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunPublisher", reflect.TypeOf((*MockUsecase)(nil).RunPublisher), ctx, interval)
+}
+
+// SaveTrackLyrics mocks base method.
+func (m *MockUsecase) SaveTrackLyrics(ctx context.Context, request *usecase.SaveTrackLyricsRequest) (*usecase.TrackLyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTrackLyrics", ctx, request)
+	ret0, _ := ret[0].(*usecase.TrackLyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTrackLyrics indicates an expected call of SaveTrackLyrics.
+func (mr *MockUsecaseMockRecorder) SaveTrackLyrics(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrackLyrics", reflect.TypeOf((*MockUsecase)(nil).SaveTrackLyrics), ctx, request)
+}
+
 // UpdateLabel mocks base method.
 func (m *MockUsecase) UpdateLabel(ctx context.Context, labelID int64, toAdd, toRemove []string) error {
 	m.ctrl.T.Helper()
@@ -2,34 +2,53 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
 
 	albumProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/album"
 	artistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/artist"
 	trackProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/track"
 	userProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/label/domain"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
-func NewLabelUsecase(labelRepo domain.Repository, userProto userProto.UserServiceClient, artistProto artistProto.ArtistServiceClient, albumProto albumProto.AlbumServiceClient, trackProto trackProto.TrackServiceClient) domain.Usecase {
+func NewLabelUsecase(labelRepo domain.Repository, userProto userProto.UserServiceClient, artistProto artistProto.ArtistServiceClient, albumProto albumProto.AlbumServiceClient, trackProto trackProto.TrackServiceClient, notificationUsecase notification.Usecase, uploadUsecase upload.Usecase) domain.Usecase {
 	return &labelUsecase{
-		labelRepository: labelRepo,
-		userProto:       userProto,
-		artistProto:     artistProto,
-		albumProto:      albumProto,
-		trackProto:      trackProto,
+		labelRepository:     labelRepo,
+		userProto:           userProto,
+		artistProto:         artistProto,
+		albumProto:          albumProto,
+		trackProto:          trackProto,
+		notificationUsecase: notificationUsecase,
+		uploadUsecase:       uploadUsecase,
 	}
 }
 
+const (
+	maxTrackTitleLength = 100
+	// maxLabelArtists bounds the artists tagged names are matched against
+	maxLabelArtists = 1000
+)
+
 type labelUsecase struct {
-	labelRepository domain.Repository
-	userProto       userProto.UserServiceClient
-	artistProto     artistProto.ArtistServiceClient
-	albumProto      albumProto.AlbumServiceClient
-	trackProto      trackProto.TrackServiceClient
-	S3Repository    domain.S3Repository
+	labelRepository     domain.Repository
+	userProto           userProto.UserServiceClient
+	artistProto         artistProto.ArtistServiceClient
+	albumProto          albumProto.AlbumServiceClient
+	trackProto          trackProto.TrackServiceClient
+	S3Repository        domain.S3Repository
+	notificationUsecase notification.Usecase
+	uploadUsecase       upload.Usecase
 }
 
 func (u *labelUsecase) CheckIsLabelUnique(ctx context.Context, labelName string) (bool, error) {
@@ -147,25 +166,7 @@ func (u *labelUsecase) GetAlbumsByLabelID(ctx context.Context, labelID int64, fi
 		return nil, err
 	}
 
-	albumIDs := make([]*artistProto.AlbumID, 0, len(protoAlbums.Albums))
-	for _, protoAlbum := range protoAlbums.Albums {
-		albumIDs = append(albumIDs, &artistProto.AlbumID{Id: protoAlbum.Id})
-	}
-
-	protoArtists, err := u.artistProto.GetArtistsByAlbumIDs(ctx, &artistProto.AlbumIDList{Ids: albumIDs})
-	if err != nil {
-		return nil, customErrors.HandleArtistGRPCError(err)
-	}
-
-	artistWithTitleMap := model.ArtistWithTitleMapFromProtoToUsecase(protoArtists.Artists)
-
-	albums := make([]*usecaseModel.Album, 0, len(protoAlbums.Albums))
-	for _, protoAlbum := range protoAlbums.Albums {
-		usecaseAlbum := model.AlbumFromProtoToUsecase(protoAlbum)
-		usecaseAlbum.Artists = artistWithTitleMap[protoAlbum.Id]
-		albums = append(albums, usecaseAlbum)
-	}
-	return albums, nil
+	return u.albumsWithArtists(ctx, protoAlbums.Albums)
 }
 
 func (u *labelUsecase) DeleteArtist(ctx context.Context, artist *usecaseModel.ArtistDelete) error {
@@ -181,30 +182,78 @@ func (u *labelUsecase) DeleteArtist(ctx context.Context, artist *usecaseModel.Ar
 	return nil
 }
 
-func (u *labelUsecase) CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (int64, string, error) {
-	var albumType albumProto.AlbumType
-	switch album.Type {
-	case string(usecaseModel.AlbumTypeAlbum):
-		albumType = albumProto.AlbumType_AlbumTypeAlbum
-	case string(usecaseModel.AlbumTypeEP):
-		albumType = albumProto.AlbumType_AlbumTypeEP
-	case string(usecaseModel.AlbumTypeSingle):
-		albumType = albumProto.AlbumType_AlbumTypeSingle
-	case string(usecaseModel.AlbumTypeCompilation):
-		albumType = albumProto.AlbumType_AlbumTypeCompilation
-	default:
-		albumType = albumProto.AlbumType_AlbumTypeAlbum
+// CreateAlbum takes the titles left empty and a missing cover from the tags embedded in the tracks
+func (u *labelUsecase) CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (*usecaseModel.Album, error) {
+	uploadIDs := make(map[string]bool, len(album.Tracks))
+	for _, track := range album.Tracks {
+		if uploadIDs[track.UploadID] {
+			return nil, customErrors.ErrUploadDuplicate
+		}
+		uploadIDs[track.UploadID] = true
+	}
+
+	var claimed []string
+	createdAlbum, err := u.createAlbum(ctx, album, &claimed)
+	if err != nil {
+		// the label can retry with the same uploads
+		u.unclaimUploads(ctx, claimed)
+		return nil, err
+	}
+	return createdAlbum, nil
+}
+
+// createAlbum adds every upload it claims to claimed until the tracks own them
+func (u *labelUsecase) createAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest, claimed *[]string) (*usecaseModel.Album, error) {
+	var coverKey string
+	var err error
+	if album.CoverUploadID != "" {
+		coverKey, err = u.uploadUsecase.ResolveUpload(ctx, album.CoverUploadID, album.LabelID, usecaseModel.UploadKindCover)
+		if err != nil {
+			return nil, err
+		}
+		*claimed = append(*claimed, album.CoverUploadID)
+	}
+	for _, track := range album.Tracks {
+		track.FileKey, err = u.uploadUsecase.ResolveUpload(ctx, track.UploadID, album.LabelID, usecaseModel.UploadKindTrack)
+		if err != nil {
+			return nil, err
+		}
+		*claimed = append(*claimed, track.UploadID)
+
+		track.Title = strings.TrimSpace(track.Title)
+		if track.Title != "" && coverKey != "" {
+			continue
+		}
+		protoMetadata, err := u.trackProto.ReadTrackMetadata(ctx, &trackProto.TrackFileKey{FileKey: track.FileKey})
+		if err != nil {
+			return nil, customErrors.HandleTrackGRPCError(err)
+		}
+		if track.Title == "" {
+			track.Title = protoMetadata.Title
+		}
+		if coverKey == "" {
+			coverKey = protoMetadata.CoverKey
+		}
+	}
+
+	if coverKey == "" {
+		return nil, customErrors.ErrAlbumCoverRequired
+	}
+	for _, track := range album.Tracks {
+		if track.Title == "" || utf8.RuneCountInString(track.Title) > maxTrackTitleLength {
+			return nil, customErrors.ErrTrackTitleInvalid
+		}
 	}
 
 	albumProto := &albumProto.CreateAlbumRequest{
-		Type:    albumType,
-		Title:   album.Title,
-		LabelId: album.LabelID,
-		Image:   album.Image,
+		Type:     model.AlbumTypeFromUsecaseToProto(album.Type),
+		Title:    album.Title,
+		LabelId:  album.LabelID,
+		ImageKey: coverKey,
 	}
 	protoCreatedAlbum, err := u.albumProto.CreateAlbum(ctx, albumProto)
 	if err != nil {
-		return -1, "", err
+		return nil, err
 	}
 
 	artistIDs := make([]*artistProto.ArtistID, 0, len(album.ArtistsIDs))
@@ -213,16 +262,25 @@ func (u *labelUsecase) CreateAlbum(ctx context.Context, album *usecaseModel.Crea
 	}
 
 	tracksListWithAlbumId := &trackProto.TracksListWithAlbumID{
-		AlbumId: &trackProto.AlbumID{Id: protoCreatedAlbum.Id},
-		Tracks:  model.TrackListLoadFromUsecaseToProto(album.Tracks),
-		Cover:   album.Image,
+		AlbumId:  &trackProto.AlbumID{Id: protoCreatedAlbum.Id},
+		Tracks:   model.TrackListLoadFromUsecaseToProto(album.Tracks),
+		CoverKey: coverKey,
 	}
 
 	tracksIds, err := u.trackProto.AddTracksToAlbum(ctx, tracksListWithAlbumId)
 	if err != nil {
-		return -1, "", err
+		// a rejected duplicate must not leave an empty draft behind
+		if deleteErr := u.DeleteAlbum(ctx, protoCreatedAlbum.Id, album.LabelID); deleteErr != nil {
+			logger := loggerPkg.LoggerFromContext(ctx)
+			logger.Warn("failed to delete album without tracks", zap.Error(deleteErr), zap.Int64("albumID", protoCreatedAlbum.Id))
+		}
+		return nil, customErrors.HandleTrackGRPCError(err)
 	}
 
+	// the tracks point at the uploads now, the sweeper must not take them even if the rest fails
+	u.releaseUploads(ctx, album)
+	*claimed = nil
+
 	tracksIdsUsecase := model.TracksIdsFromProtoToUsecase(tracksIds)
 
 	_, err = u.artistProto.ConnectArtists(ctx, &artistProto.ArtistsIDWithAlbumID{
@@ -231,9 +289,85 @@ func (u *labelUsecase) CreateAlbum(ctx context.Context, album *usecaseModel.Crea
 		TrackIds:  model.TracksIdsFromUsecaseToProtoArtist(tracksIdsUsecase),
 	})
 	if err != nil {
-		return -1, "", err
+		return nil, err
 	}
-	return protoCreatedAlbum.Id, protoCreatedAlbum.Url, nil
+
+	// the album starts as a draft, fans are notified once the publisher releases it
+	return &usecaseModel.Album{
+		ID:             protoCreatedAlbum.Id,
+		Title:          album.Title,
+		Thumbnail:      protoCreatedAlbum.Url,
+		ThumbnailColor: protoCreatedAlbum.ThumbnailColor,
+		Type:           usecaseModel.AlbumType(album.Type),
+		Status:         usecaseModel.AlbumStatusDraft,
+	}, nil
+}
+
+func (u *labelUsecase) releaseUploads(ctx context.Context, album *usecaseModel.CreateAlbumRequest) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	var uploadIDs []string
+	if album.CoverUploadID != "" {
+		uploadIDs = append(uploadIDs, album.CoverUploadID)
+	}
+	for _, track := range album.Tracks {
+		uploadIDs = append(uploadIDs, track.UploadID)
+	}
+	for _, uploadID := range uploadIDs {
+		if err := u.uploadUsecase.ReleaseUpload(ctx, uploadID, album.LabelID); err != nil {
+			logger.Warn("failed to release upload", zap.Error(err), zap.String("uploadID", uploadID))
+		}
+	}
+}
+
+func (u *labelUsecase) unclaimUploads(ctx context.Context, uploadIDs []string) {
+	for _, uploadID := range uploadIDs {
+		if err := u.uploadUsecase.UnclaimUpload(ctx, uploadID); err != nil {
+			logger := loggerPkg.LoggerFromContext(ctx)
+			logger.Warn("failed to unclaim upload", zap.Error(err), zap.String("uploadID", uploadID))
+		}
+	}
+}
+
+// PreviewAlbum reads the tags embedded in track uploads so that the release form
+// can be prefilled before anything is created
+func (u *labelUsecase) PreviewAlbum(ctx context.Context, labelID int64, uploadIDs []string) ([]*usecaseModel.TrackMetadata, error) {
+	metadataList := make([]*usecaseModel.TrackMetadata, 0, len(uploadIDs))
+	for _, uploadID := range uploadIDs {
+		fileKey, err := u.uploadUsecase.PeekUpload(ctx, uploadID, labelID, usecaseModel.UploadKindTrack)
+		if err != nil {
+			return nil, err
+		}
+		protoMetadata, err := u.trackProto.ReadTrackMetadata(ctx, &trackProto.TrackFileKey{FileKey: fileKey})
+		if err != nil {
+			return nil, customErrors.HandleTrackGRPCError(err)
+		}
+		metadataList = append(metadataList, model.TrackMetadataFromProtoToUsecase(uploadID, protoMetadata))
+	}
+
+	protoArtists, err := u.artistProto.GetArtistsLabelID(ctx, &artistProto.FiltersWithLabelID{
+		Filters: &artistProto.Filters{
+			Pagination: &artistProto.Pagination{Offset: 0, Limit: maxLabelArtists},
+		},
+		LabelId: labelID,
+	})
+	if err != nil {
+		return nil, customErrors.HandleArtistGRPCError(err)
+	}
+
+	// tags only carry names, they are matched to the artists of the label regardless of case
+	artistIDs := make(map[string]int64, len(protoArtists.Artists))
+	for _, artist := range protoArtists.Artists {
+		artistIDs[strings.ToLower(artist.Title)] = artist.Id
+	}
+	for _, metadata := range metadataList {
+		metadata.ArtistIDs = make([]int64, 0, len(metadata.Artists))
+		for _, name := range metadata.Artists {
+			if id, ok := artistIDs[strings.ToLower(strings.TrimSpace(name))]; ok {
+				metadata.ArtistIDs = append(metadata.ArtistIDs, id)
+			}
+		}
+	}
+	return metadataList, nil
 }
 
 func (u *labelUsecase) UpdateLabel(ctx context.Context, labelID int64, toAdd, toRemove []string) error {
@@ -269,3 +403,398 @@ func (u *labelUsecase) DeleteAlbum(ctx context.Context, albumID, labelID int64)
 	}
 	return nil
 }
+
+// albumsWithArtists attaches the artists of every album with a single call
+func (u *labelUsecase) albumsWithArtists(ctx context.Context, protoAlbums []*albumProto.Album) ([]*usecaseModel.Album, error) {
+	albumIDs := make([]*artistProto.AlbumID, 0, len(protoAlbums))
+	for _, protoAlbum := range protoAlbums {
+		albumIDs = append(albumIDs, &artistProto.AlbumID{Id: protoAlbum.Id})
+	}
+
+	protoArtists, err := u.artistProto.GetArtistsByAlbumIDs(ctx, &artistProto.AlbumIDList{Ids: albumIDs})
+	if err != nil {
+		return nil, customErrors.HandleArtistGRPCError(err)
+	}
+
+	artistWithTitleMap := model.ArtistWithTitleMapFromProtoToUsecase(protoArtists.Artists)
+
+	albums := make([]*usecaseModel.Album, 0, len(protoAlbums))
+	for _, protoAlbum := range protoAlbums {
+		usecaseAlbum := model.AlbumFromProtoToUsecase(protoAlbum)
+		usecaseAlbum.Artists = artistWithTitleMap[protoAlbum.Id]
+		albums = append(albums, usecaseAlbum)
+	}
+	return albums, nil
+}
+
+func (u *labelUsecase) GetLabelAlbum(ctx context.Context, albumID int64, labelID int64, isAdmin bool) (*usecaseModel.LabelAlbum, error) {
+	protoAlbum, err := u.albumProto.GetLabelAlbumByID(ctx, &albumProto.LabelAlbumRequest{
+		AlbumId: &albumProto.AlbumID{Id: albumID},
+		LabelId: labelID,
+		IsAdmin: isAdmin,
+	})
+	if err != nil {
+		return nil, customErrors.HandleAlbumGRPCError(err)
+	}
+
+	albums, err := u.albumsWithArtists(ctx, []*albumProto.Album{protoAlbum})
+	if err != nil {
+		return nil, err
+	}
+
+	protoTracks, err := u.trackProto.GetLabelTracksByAlbumID(ctx, &trackProto.AlbumID{Id: albumID})
+	if err != nil {
+		return nil, customErrors.HandleTrackGRPCError(err)
+	}
+
+	trackIDs := make([]int64, 0, len(protoTracks.Tracks))
+	for _, protoTrack := range protoTracks.Tracks {
+		trackIDs = append(trackIDs, protoTrack.Id)
+	}
+
+	protoArtists, err := u.artistProto.GetArtistsByTrackIDs(ctx, &artistProto.TrackIDList{Ids: model.TrackIdsFromUsecaseToArtistProto(trackIDs)})
+	if err != nil {
+		return nil, customErrors.HandleArtistGRPCError(err)
+	}
+
+	albumTitle := &albumProto.AlbumTitle{Title: protoAlbum.Title}
+	tracks := make([]*usecaseModel.Track, 0, len(protoTracks.Tracks))
+	for _, protoTrack := range protoTracks.Tracks {
+		tracks = append(tracks, model.TrackFromProtoToUsecase(protoTrack, albumTitle, protoArtists.Artists[protoTrack.Id]))
+	}
+
+	return &usecaseModel.LabelAlbum{
+		Album:  albums[0],
+		Tracks: tracks,
+	}, nil
+}
+
+func (u *labelUsecase) ChangeAlbumStatus(ctx context.Context, request *usecaseModel.ChangeAlbumStatusRequest) (*usecaseModel.Album, error) {
+	switch request.Status {
+	case usecaseModel.AlbumStatusDraft, usecaseModel.AlbumStatusInReview, usecaseModel.AlbumStatusScheduled,
+		usecaseModel.AlbumStatusPublished, usecaseModel.AlbumStatusTakenDown:
+	default:
+		return nil, customErrors.ErrAlbumInvalidStatus
+	}
+
+	protoRequest := &albumProto.ChangeAlbumStatusRequest{
+		AlbumId: &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId: request.LabelID,
+		IsAdmin: request.IsAdmin,
+		Status:  string(request.Status),
+	}
+	if !request.ReleaseDate.IsZero() {
+		protoRequest.ReleaseDate = timestamppb.New(request.ReleaseDate)
+	}
+
+	protoAlbum, err := u.albumProto.ChangeAlbumStatus(ctx, protoRequest)
+	if err != nil {
+		return nil, customErrors.HandleAlbumGRPCError(err)
+	}
+
+	albums, err := u.albumsWithArtists(ctx, []*albumProto.Album{protoAlbum})
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+// EditAlbumTracks renames and reorders the tracks of a draft and replaces its artist credits
+func (u *labelUsecase) EditAlbumTracks(ctx context.Context, request *usecaseModel.EditAlbumTracksRequest) error {
+	protoAlbum, err := u.albumProto.GetLabelAlbumByID(ctx, &albumProto.LabelAlbumRequest{
+		AlbumId: &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId: request.LabelID,
+	})
+	if err != nil {
+		return customErrors.HandleAlbumGRPCError(err)
+	}
+	if usecaseModel.AlbumStatus(protoAlbum.Status) != usecaseModel.AlbumStatusDraft {
+		return customErrors.ErrAlbumNotDraft
+	}
+
+	trackUpdates := make([]*trackProto.TrackUpdate, 0, len(request.Tracks))
+	trackArtists := make([]*artistProto.TrackArtistIDs, 0, len(request.Tracks))
+	for _, track := range request.Tracks {
+		trackUpdates = append(trackUpdates, &trackProto.TrackUpdate{
+			Id:       &trackProto.TrackID{Id: track.ID},
+			Title:    track.Title,
+			Position: track.Position,
+		})
+		trackArtists = append(trackArtists, &artistProto.TrackArtistIDs{
+			TrackId:   &artistProto.TrackID{Id: track.ID},
+			ArtistIds: model.ArtistIDListFromUsecaseToProto(track.ArtistsIDs),
+		})
+	}
+
+	_, err = u.trackProto.UpdateAlbumTracks(ctx, &trackProto.AlbumTracksUpdate{
+		AlbumId: &trackProto.AlbumID{Id: request.AlbumID},
+		Tracks:  trackUpdates,
+	})
+	if err != nil {
+		return customErrors.HandleTrackGRPCError(err)
+	}
+
+	_, err = u.artistProto.SetArtistCredits(ctx, &artistProto.ArtistCredits{
+		AlbumId:   &artistProto.AlbumID{Id: request.AlbumID},
+		LabelId:   request.LabelID,
+		ArtistIds: model.ArtistIDListFromUsecaseToProto(request.ArtistsIDs),
+		Tracks:    trackArtists,
+	})
+	if err != nil {
+		return customErrors.HandleArtistGRPCError(err)
+	}
+	return nil
+}
+
+func (u *labelUsecase) GetAlbumsForReview(ctx context.Context, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.Album, error) {
+	protoAlbums, err := u.albumProto.GetAlbumsByStatus(ctx, &albumProto.FiltersWithStatus{
+		Filters: &albumProto.Filters{
+			Pagination: model.PaginationFromUsecaseToAlbumProto(filters.Pagination),
+		},
+		Status: string(usecaseModel.AlbumStatusInReview),
+	})
+	if err != nil {
+		return nil, customErrors.HandleAlbumGRPCError(err)
+	}
+
+	return u.albumsWithArtists(ctx, protoAlbums.Albums)
+}
+
+// GetDuplicateFlags lists uploads that sound like catalog tracks of any label, oldest first
+func (u *labelUsecase) GetDuplicateFlags(ctx context.Context, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.DuplicateFlag, error) {
+	protoFlags, err := u.trackProto.GetPendingDuplicateFlags(ctx, &trackProto.Filters{
+		Pagination: model.PaginationFromUsecaseToTrackProto(filters.Pagination),
+	})
+	if err != nil {
+		return nil, customErrors.HandleTrackGRPCError(err)
+	}
+	return model.DuplicateFlagsFromProtoToUsecase(protoFlags), nil
+}
+
+func (u *labelUsecase) ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error {
+	_, err := u.trackProto.ResolveDuplicateFlag(ctx, &trackProto.DuplicateFlagResolution{
+		Id:     flagID,
+		Status: status,
+	})
+	if err != nil {
+		return customErrors.HandleTrackGRPCError(err)
+	}
+	return nil
+}
+
+// publishScheduledAlbums releases the albums whose date has come and tells the fans about them
+func (u *labelUsecase) publishScheduledAlbums(ctx context.Context) error {
+	protoAlbumIDs, err := u.albumProto.PublishScheduledAlbums(ctx, &emptypb.Empty{})
+	if err != nil {
+		return customErrors.HandleAlbumGRPCError(err)
+	}
+
+	logger := loggerPkg.LoggerFromContext(ctx)
+	for _, albumID := range protoAlbumIDs.Ids {
+		// the album is already published, fans just will not hear about it
+		if err := u.notificationUsecase.NotifyAlbumReleased(ctx, albumID.Id); err != nil {
+			logger.Warn("failed to notify about album release", zap.Error(err), zap.Int64("albumID", albumID.Id))
+		}
+	}
+	return nil
+}
+
+// RunPublisher publishes scheduled albums right away and then every interval until ctx is done
+func (u *labelUsecase) RunPublisher(ctx context.Context, interval time.Duration) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.publishScheduledAlbums(ctx); err != nil {
+			logger.Error("failed to publish scheduled albums", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// EditAlbum renames the album, changes its type and swaps the cover of the album and its tracks
+func (u *labelUsecase) EditAlbum(ctx context.Context, request *usecaseModel.EditAlbumRequest) (*usecaseModel.Album, error) {
+	// the converter falls back to a plain album, an edit must not change the type by a typo
+	switch usecaseModel.AlbumType(request.Type) {
+	case "", usecaseModel.AlbumTypeAlbum, usecaseModel.AlbumTypeEP, usecaseModel.AlbumTypeSingle, usecaseModel.AlbumTypeCompilation:
+	default:
+		return nil, customErrors.ErrAlbumInvalidType
+	}
+
+	protoAlbum, err := u.albumProto.GetLabelAlbumByID(ctx, &albumProto.LabelAlbumRequest{
+		AlbumId: &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId: request.LabelID,
+	})
+	if err != nil {
+		return nil, customErrors.HandleAlbumGRPCError(err)
+	}
+
+	var coverKey string
+	if request.CoverUploadID != "" {
+		coverKey, err = u.uploadUsecase.ResolveUpload(ctx, request.CoverUploadID, request.LabelID, usecaseModel.UploadKindCover)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	editRequest := &albumProto.EditAlbumRequest{
+		AlbumId:  &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId:  request.LabelID,
+		Title:    protoAlbum.Title,
+		Type:     protoAlbum.Type,
+		ImageKey: coverKey,
+	}
+	if request.Title != "" {
+		editRequest.Title = request.Title
+	}
+	if request.Type != "" {
+		editRequest.Type = model.AlbumTypeFromUsecaseToProto(request.Type)
+	}
+
+	protoEditedAlbum, err := u.albumProto.EditAlbum(ctx, editRequest)
+	if err != nil {
+		if coverKey != "" {
+			u.unclaimUploads(ctx, []string{request.CoverUploadID})
+		}
+		return nil, customErrors.HandleAlbumGRPCError(err)
+	}
+
+	if coverKey != "" {
+		_, err = u.trackProto.UpdateAlbumTracksCover(ctx, &trackProto.AlbumCover{
+			AlbumId:  &trackProto.AlbumID{Id: request.AlbumID},
+			CoverKey: coverKey,
+		})
+		if err != nil {
+			u.unclaimUploads(ctx, []string{request.CoverUploadID})
+			return nil, customErrors.HandleTrackGRPCError(err)
+		}
+
+		if err := u.uploadUsecase.ReleaseUpload(ctx, request.CoverUploadID, request.LabelID); err != nil {
+			logger := loggerPkg.LoggerFromContext(ctx)
+			logger.Warn("failed to release upload", zap.Error(err), zap.String("uploadID", request.CoverUploadID))
+		}
+	}
+
+	albums, err := u.albumsWithArtists(ctx, []*albumProto.Album{protoEditedAlbum})
+	if err != nil {
+		return nil, err
+	}
+	return albums[0], nil
+}
+
+// EditTrack renames and moves a track of the label and replaces its artist credits when they are given
+func (u *labelUsecase) EditTrack(ctx context.Context, request *usecaseModel.EditAlbumTrackRequest) error {
+	_, err := u.albumProto.GetLabelAlbumByID(ctx, &albumProto.LabelAlbumRequest{
+		AlbumId: &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId: request.LabelID,
+	})
+	if err != nil {
+		return customErrors.HandleAlbumGRPCError(err)
+	}
+
+	// the track service checks that the track belongs to the album
+	_, err = u.trackProto.EditTrack(ctx, &trackProto.TrackEdit{
+		Id:       &trackProto.TrackID{Id: request.TrackID},
+		AlbumId:  &trackProto.AlbumID{Id: request.AlbumID},
+		Title:    request.Title,
+		Position: request.Position,
+	})
+	if err != nil {
+		return customErrors.HandleTrackGRPCError(err)
+	}
+
+	if len(request.Artists) == 0 {
+		return nil
+	}
+	_, err = u.artistProto.SetTrackArtistRoles(ctx, &artistProto.TrackArtistRoles{
+		TrackId: &artistProto.TrackID{Id: request.TrackID},
+		LabelId: request.LabelID,
+		Artists: model.TrackArtistRolesFromUsecaseToProto(request.Artists),
+	})
+	if err != nil {
+		return customErrors.HandleArtistGRPCError(err)
+	}
+	return nil
+}
+
+// ReplaceTrackAudio swaps the file of a track of the label for a completed upload
+func (u *labelUsecase) ReplaceTrackAudio(ctx context.Context, request *usecaseModel.ReplaceTrackAudioRequest) error {
+	_, err := u.albumProto.GetLabelAlbumByID(ctx, &albumProto.LabelAlbumRequest{
+		AlbumId: &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId: request.LabelID,
+	})
+	if err != nil {
+		return customErrors.HandleAlbumGRPCError(err)
+	}
+
+	fileKey, err := u.uploadUsecase.ResolveUpload(ctx, request.UploadID, request.LabelID, usecaseModel.UploadKindTrack)
+	if err != nil {
+		return err
+	}
+
+	_, err = u.trackProto.ReplaceTrackAudio(ctx, &trackProto.TrackAudioReplace{
+		Id:      &trackProto.TrackID{Id: request.TrackID},
+		AlbumId: &trackProto.AlbumID{Id: request.AlbumID},
+		FileKey: fileKey,
+	})
+	if err != nil {
+		u.unclaimUploads(ctx, []string{request.UploadID})
+		return customErrors.HandleTrackGRPCError(err)
+	}
+
+	if err := u.uploadUsecase.ReleaseUpload(ctx, request.UploadID, request.LabelID); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to release upload", zap.Error(err), zap.String("uploadID", request.UploadID))
+	}
+	return nil
+}
+
+// SaveTrackLyrics stores lyrics of one language for a track of the label
+func (u *labelUsecase) SaveTrackLyrics(ctx context.Context, request *usecaseModel.SaveTrackLyricsRequest) (*usecaseModel.TrackLyrics, error) {
+	_, err := u.albumProto.GetLabelAlbumByID(ctx, &albumProto.LabelAlbumRequest{
+		AlbumId: &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId: request.LabelID,
+	})
+	if err != nil {
+		return nil, customErrors.HandleAlbumGRPCError(err)
+	}
+
+	// the track service checks that the track belongs to the album
+	protoLyrics, err := u.trackProto.SaveTrackLyrics(ctx, &trackProto.TrackLyricsUpload{
+		Id:          &trackProto.TrackID{Id: request.TrackID},
+		AlbumId:     &trackProto.AlbumID{Id: request.AlbumID},
+		Language:    request.Language,
+		Translation: request.Translation,
+		Text:        request.Lyrics,
+	})
+	if err != nil {
+		return nil, customErrors.HandleTrackGRPCError(err)
+	}
+	return model.TrackLyricsFromProtoToUsecase(protoLyrics), nil
+}
+
+func (u *labelUsecase) DeleteTrackLyrics(ctx context.Context, request *usecaseModel.DeleteTrackLyricsRequest) error {
+	_, err := u.albumProto.GetLabelAlbumByID(ctx, &albumProto.LabelAlbumRequest{
+		AlbumId: &albumProto.AlbumID{Id: request.AlbumID},
+		LabelId: request.LabelID,
+	})
+	if err != nil {
+		return customErrors.HandleAlbumGRPCError(err)
+	}
+
+	_, err = u.trackProto.DeleteTrackLyrics(ctx, &trackProto.TrackLyricsDelete{
+		Id:       &trackProto.TrackID{Id: request.TrackID},
+		AlbumId:  &trackProto.AlbumID{Id: request.AlbumID},
+		Language: request.Language,
+	})
+	if err != nil {
+		return customErrors.HandleTrackGRPCError(err)
+	}
+	return nil
+}
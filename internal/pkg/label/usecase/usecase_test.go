@@ -2,22 +2,30 @@ package usecase
 
 import (
 	"context"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/album"
 	artistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/artist"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/track"
 	userProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	mock_domain "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/label/mocks"
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_notification "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification/mocks"
+	mock_upload "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload/mocks"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"go.uber.org/mock/gomock"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func setupTest(t *testing.T) (*mock_domain.MockRepository, context.Context) {
@@ -34,7 +42,7 @@ func TestCreateArtist(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil)
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -61,7 +69,7 @@ func TestCreateArtistError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil)
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -83,7 +91,7 @@ func TestEditArtist(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil)
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -111,7 +119,7 @@ func TestEditArtistError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil)
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -138,7 +146,7 @@ func TestCreateLabel(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockRepo.EXPECT().CheckIsLabelUnique(
 		gomock.Any(),
@@ -178,7 +186,7 @@ func TestCreateLabelError(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockRepo.EXPECT().CheckIsLabelUnique(
 		gomock.Any(),
@@ -202,7 +210,7 @@ func TestGetLabel(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockRepo.EXPECT().GetLabel(
 		gomock.Any(),
@@ -235,7 +243,7 @@ func TestGetLabelError(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockRepo.EXPECT().GetLabel(
 		gomock.Any(),
@@ -256,7 +264,7 @@ func TestGetArtists(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	filters := &usecaseModel.ArtistFilters{
 		Pagination: &usecaseModel.Pagination{
@@ -291,7 +299,7 @@ func TestGetArtistsError(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	filters := &usecaseModel.ArtistFilters{
 		Pagination: &usecaseModel.Pagination{
@@ -319,7 +327,7 @@ func TestGetAlbumsByLabelID(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockAlbumRepo.EXPECT().GetAlbumsLabelID(
 		gomock.Any(),
@@ -370,7 +378,7 @@ func TestGetAlbumsByLabelIDError(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockAlbumRepo.EXPECT().GetAlbumsLabelID(
 		gomock.Any(),
@@ -392,7 +400,7 @@ func TestDeleteArtist(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil)
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -413,7 +421,7 @@ func TestDeleteArtistError(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil)
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, nil, nil, nil, nil)
 
 	ctx := context.Background()
 
@@ -435,43 +443,67 @@ func TestCreateAlbum(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
 	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
 	mockArtistRepo := mocks.NewMockArtistServiceClient(ctrl)
+	mockNotificationUsecase := mock_notification.NewMockUsecase(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(nil, nil, mockArtistRepo, mockAlbumRepo, mockTrackRepo, mockNotificationUsecase, mockUploadUsecase)
 
-	ctx := context.Background()
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(1), usecaseModel.UploadKindTrack).Return("track-upload.mp3", nil)
 
 	mockAlbumRepo.EXPECT().CreateAlbum(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&album.AlbumIDAndURL{
-		Id:  1,
-		Url: "thumbnail_url",
-	}, nil)
+	).DoAndReturn(func(_ context.Context, request *album.CreateAlbumRequest, _ ...interface{}) (*album.AlbumIDAndURL, error) {
+		assert.Equal(t, "uploads/cover-upload", request.ImageKey)
+		return &album.AlbumIDAndURL{
+			Id:             1,
+			Url:            "thumbnail_url",
+			ThumbnailColor: "#a1b2c3",
+		}, nil
+	})
 
 	mockTrackRepo.EXPECT().AddTracksToAlbum(
 		gomock.Any(),
 		gomock.Any(),
-	).Return(&track.TrackIdsList{
-		Ids: []*track.TrackID{},
-	}, nil).AnyTimes()
+	).DoAndReturn(func(_ context.Context, request *track.TracksListWithAlbumID, _ ...interface{}) (*track.TrackIdsList, error) {
+		assert.Equal(t, "uploads/cover-upload", request.CoverKey)
+		require.Len(t, request.Tracks, 1)
+		assert.Equal(t, "track-upload.mp3", request.Tracks[0].FileKey)
+		return &track.TrackIdsList{
+			Ids: []*track.TrackID{{Id: 5}},
+		}, nil
+	})
+
+	mockUploadUsecase.EXPECT().ReleaseUpload(gomock.Any(), "cover-upload", int64(1)).Return(nil)
+	mockUploadUsecase.EXPECT().ReleaseUpload(gomock.Any(), "track-upload", int64(1)).Return(nil)
 
 	mockArtistRepo.EXPECT().ConnectArtists(
 		gomock.Any(),
 		gomock.Any(),
 	).Return(nil, nil).AnyTimes()
 
-	albumID, thumbnailURL, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
-		ArtistsIDs: []int64{1},
-		Title:      "new_album",
-		Type:       "album",
-		Image:      []byte("test image"),
-		LabelID:    1,
-		Tracks:     []*usecaseModel.CreateTrackRequest{},
+	// albums start as drafts, nobody is notified until the publisher releases them
+	mockNotificationUsecase.EXPECT().NotifyAlbumReleased(gomock.Any(), gomock.Any()).Times(0)
+
+	createdAlbum, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "new_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: "track", UploadID: "track-upload"},
+		},
 	})
 
 	require.NoError(t, err)
-	assert.Equal(t, int64(1), albumID)
-	assert.Equal(t, "thumbnail_url", thumbnailURL)
+	assert.Equal(t, int64(1), createdAlbum.ID)
+	assert.Equal(t, "thumbnail_url", createdAlbum.Thumbnail)
+	assert.Equal(t, "#a1b2c3", createdAlbum.ThumbnailColor)
+	assert.Equal(t, usecaseModel.AlbumStatusDraft, createdAlbum.Status)
 }
 
 func TestCreateAlbumError(t *testing.T) {
@@ -479,28 +511,31 @@ func TestCreateAlbumError(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
 	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
 	mockArtistRepo := mocks.NewMockArtistServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, mockArtistRepo, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(nil, nil, mockArtistRepo, mockAlbumRepo, mockTrackRepo, nil, mockUploadUsecase)
 
 	ctx := context.Background()
 
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "cover-upload").Return(nil)
+
 	mockAlbumRepo.EXPECT().CreateAlbum(
 		gomock.Any(),
 		gomock.Any(),
 	).Return(nil, assert.AnError)
 
-	albumID, thumbnailURL, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
-		ArtistsIDs: []int64{1},
-		Title:      "error_album",
-		Type:       "album",
-		Image:      []byte("test image"),
-		LabelID:    1,
-		Tracks:     []*usecaseModel.CreateTrackRequest{},
+	createdAlbum, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "error_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks:        []*usecaseModel.CreateTrackRequest{},
 	})
 
 	require.Error(t, err)
-	assert.Equal(t, int64(-1), albumID)
-	assert.Empty(t, thumbnailURL)
+	assert.Nil(t, createdAlbum)
 }
 
 func TestUpdateLabel(t *testing.T) {
@@ -511,7 +546,7 @@ func TestUpdateLabel(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockUserProto.EXPECT().RemoveUsersFromLabel(
 		gomock.Any(),
@@ -536,7 +571,7 @@ func TestUpdateLabelError(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(gomock.NewController(t))
 	mockTrackRepo := mocks.NewMockTrackServiceClient(gomock.NewController(t))
 
-	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(mockRepo, mockUserProto, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	mockUserProto.EXPECT().RemoveUsersFromLabel(
 		gomock.Any(),
@@ -553,7 +588,7 @@ func TestDeleteAlbum(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
 	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	ctx := context.Background()
 
@@ -577,7 +612,7 @@ func TestDeleteAlbumError(t *testing.T) {
 	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
 	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
 
-	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo)
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
 
 	ctx := context.Background()
 
@@ -590,3 +625,864 @@ func TestDeleteAlbumError(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestCreateAlbumReleaseUploadFailure(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockArtistRepo := mocks.NewMockArtistServiceClient(ctrl)
+	mockNotificationUsecase := mock_notification.NewMockUsecase(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistRepo, mockAlbumRepo, mockTrackRepo, mockNotificationUsecase, mockUploadUsecase)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	mockUploadUsecase.EXPECT().ReleaseUpload(gomock.Any(), "cover-upload", int64(1)).Return(assert.AnError)
+
+	mockAlbumRepo.EXPECT().CreateAlbum(gomock.Any(), gomock.Any()).Return(&album.AlbumIDAndURL{
+		Id:  2,
+		Url: "thumbnail_url",
+	}, nil)
+	mockTrackRepo.EXPECT().AddTracksToAlbum(gomock.Any(), gomock.Any()).Return(&track.TrackIdsList{
+		Ids: []*track.TrackID{},
+	}, nil)
+	mockArtistRepo.EXPECT().ConnectArtists(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	createdAlbum, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "new_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks:        []*usecaseModel.CreateTrackRequest{},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), createdAlbum.ID)
+}
+
+func TestCreateAlbumUploadIncomplete(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockTrackServiceClient(ctrl), nil, mockUploadUsecase)
+
+	ctx := context.Background()
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(1), usecaseModel.UploadKindTrack).Return("", customErrors.ErrUploadIncomplete)
+	// the cover claimed before the track failed is given back
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "cover-upload").Return(nil)
+
+	createdAlbum, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "new_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: "track", UploadID: "track-upload"},
+		},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadIncomplete)
+	assert.Nil(t, createdAlbum)
+}
+
+func TestChangeAlbumStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, mockAlbumRepo, nil, nil, nil)
+
+	ctx := context.Background()
+	releaseDate := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	mockAlbumRepo.EXPECT().ChangeAlbumStatus(gomock.Any(), &album.ChangeAlbumStatusRequest{
+		AlbumId:     &album.AlbumID{Id: 1},
+		LabelId:     2,
+		Status:      "in_review",
+		ReleaseDate: timestamppb.New(releaseDate),
+	}).Return(&album.Album{Id: 1, Title: "album1", Status: "in_review"}, nil)
+
+	mockArtistProto.EXPECT().GetArtistsByAlbumIDs(gomock.Any(), gomock.Any()).Return(&artistProto.ArtistWithTitleMap{
+		Artists: map[int64]*artistProto.ArtistWithTitleList{
+			1: {Artists: []*artistProto.ArtistWithTitle{{Id: 1, Title: "Artist 1"}}},
+		},
+	}, nil)
+
+	result, err := usecase.ChangeAlbumStatus(ctx, &usecaseModel.ChangeAlbumStatusRequest{
+		AlbumID:     1,
+		LabelID:     2,
+		Status:      usecaseModel.AlbumStatusInReview,
+		ReleaseDate: releaseDate,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, usecaseModel.AlbumStatusInReview, result.Status)
+	assert.Len(t, result.Artists, 1)
+}
+
+func TestChangeAlbumStatusInvalidStatus(t *testing.T) {
+	usecase := NewLabelUsecase(nil, nil, nil, nil, nil, nil, nil)
+
+	_, err := usecase.ChangeAlbumStatus(context.Background(), &usecaseModel.ChangeAlbumStatusRequest{
+		AlbumID: 1,
+		LabelID: 2,
+		Status:  "released",
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrAlbumInvalidStatus)
+}
+
+func TestCreateAlbumDuplicateUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockTrackServiceClient(ctrl), nil, mockUploadUsecase)
+
+	createdAlbum, err := usecase.CreateAlbum(context.Background(), &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "new_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: "first", UploadID: "track-upload"},
+			{Title: "second", UploadID: "track-upload"},
+		},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadDuplicate)
+	assert.Nil(t, createdAlbum)
+}
+
+func TestCreateAlbumUploadInUse(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockTrackServiceClient(ctrl), nil, mockUploadUsecase)
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "first-upload", int64(1), usecaseModel.UploadKindTrack).Return("first-upload.mp3", nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "second-upload", int64(1), usecaseModel.UploadKindTrack).Return("", customErrors.ErrUploadInUse)
+	// only the uploads this request claimed are given back, the second one belongs to another release
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "cover-upload").Return(nil)
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "first-upload").Return(nil)
+
+	createdAlbum, err := usecase.CreateAlbum(context.Background(), &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "new_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: "first", UploadID: "first-upload"},
+			{Title: "second", UploadID: "second-upload"},
+		},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadInUse)
+	assert.Nil(t, createdAlbum)
+}
+
+func TestEditAlbumTracks(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	ctx := context.Background()
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1, Status: "draft"}, nil)
+	mockTrackRepo.EXPECT().UpdateAlbumTracks(gomock.Any(), &track.AlbumTracksUpdate{
+		AlbumId: &track.AlbumID{Id: 1},
+		Tracks: []*track.TrackUpdate{
+			{Id: &track.TrackID{Id: 10}, Title: "second", Position: 2},
+			{Id: &track.TrackID{Id: 11}, Title: "first", Position: 1},
+		},
+	}).Return(nil, nil)
+	mockArtistProto.EXPECT().SetArtistCredits(gomock.Any(), gomock.Any()).Return(nil, nil)
+
+	err := usecase.EditAlbumTracks(ctx, &usecaseModel.EditAlbumTracksRequest{
+		AlbumID:    1,
+		LabelID:    2,
+		ArtistsIDs: []int64{5},
+		Tracks: []*usecaseModel.EditTrackRequest{
+			{ID: 10, Title: "second", Position: 2},
+			{ID: 11, Title: "first", Position: 1, ArtistsIDs: []int64{5, 6}},
+		},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestEditAlbumTracksNotDraft(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1, Status: "published"}, nil)
+
+	err := usecase.EditAlbumTracks(context.Background(), &usecaseModel.EditAlbumTracksRequest{
+		AlbumID: 1,
+		LabelID: 2,
+		Tracks:  []*usecaseModel.EditTrackRequest{{ID: 10, Title: "first", Position: 1}},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrAlbumNotDraft)
+}
+
+func TestRunPublisher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockNotificationUsecase := mock_notification.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, nil, mockNotificationUsecase, nil)
+
+	ctx, cancel := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar()))
+	cancel()
+
+	mockAlbumRepo.EXPECT().PublishScheduledAlbums(gomock.Any(), gomock.Any()).Return(&album.AlbumIDList{
+		Ids: []*album.AlbumID{{Id: 1}, {Id: 2}},
+	}, nil)
+	// a failed notification must not stop the rest of the fans from hearing about their releases
+	mockNotificationUsecase.EXPECT().NotifyAlbumReleased(gomock.Any(), int64(1)).Return(assert.AnError)
+	mockNotificationUsecase.EXPECT().NotifyAlbumReleased(gomock.Any(), int64(2)).Return(nil)
+
+	usecase.RunPublisher(ctx, time.Hour)
+}
+
+func TestEditAlbum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{
+		Id:    1,
+		Title: "old title",
+		Type:  album.AlbumType_AlbumTypeAlbum,
+	}, nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(2), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	// the title is kept when only the type and the cover are changed
+	mockAlbumRepo.EXPECT().EditAlbum(gomock.Any(), &album.EditAlbumRequest{
+		AlbumId:  &album.AlbumID{Id: 1},
+		LabelId:  2,
+		Title:    "old title",
+		Type:     album.AlbumType_AlbumTypeEP,
+		ImageKey: "uploads/cover-upload",
+	}).Return(&album.Album{Id: 1, Title: "old title", Type: album.AlbumType_AlbumTypeEP, Thumbnail: "new_cover"}, nil)
+	mockTrackRepo.EXPECT().UpdateAlbumTracksCover(gomock.Any(), &track.AlbumCover{
+		AlbumId:  &track.AlbumID{Id: 1},
+		CoverKey: "uploads/cover-upload",
+	}).Return(nil, nil)
+	mockUploadUsecase.EXPECT().ReleaseUpload(gomock.Any(), "cover-upload", int64(2)).Return(assert.AnError)
+	mockArtistProto.EXPECT().GetArtistsByAlbumIDs(gomock.Any(), gomock.Any()).Return(&artistProto.ArtistWithTitleMap{
+		Artists: map[int64]*artistProto.ArtistWithTitleList{
+			1: {Artists: []*artistProto.ArtistWithTitle{{Id: 1, Title: "Artist 1"}}},
+		},
+	}, nil)
+
+	result, err := usecase.EditAlbum(ctx, &usecaseModel.EditAlbumRequest{
+		AlbumID:       1,
+		LabelID:       2,
+		Type:          "ep",
+		CoverUploadID: "cover-upload",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "new_cover", result.Thumbnail)
+	assert.Len(t, result.Artists, 1)
+}
+
+func TestEditAlbumNotOwned(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, nil, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "album not found"))
+
+	_, err := usecase.EditAlbum(context.Background(), &usecaseModel.EditAlbumRequest{
+		AlbumID: 1,
+		LabelID: 2,
+		Title:   "new title",
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrAlbumNotFound)
+}
+
+func TestEditAlbumInvalidType(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, nil, nil, nil)
+
+	_, err := usecase.EditAlbum(context.Background(), &usecaseModel.EditAlbumRequest{
+		AlbumID: 1,
+		LabelID: 2,
+		Type:    "mixtape",
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrAlbumInvalidType)
+}
+
+func TestEditTrack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1}, nil)
+	mockTrackRepo.EXPECT().EditTrack(gomock.Any(), &track.TrackEdit{
+		Id:       &track.TrackID{Id: 10},
+		AlbumId:  &track.AlbumID{Id: 1},
+		Title:    "fixed title",
+		Position: 3,
+	}).Return(nil, nil)
+	mockArtistProto.EXPECT().SetTrackArtistRoles(gomock.Any(), &artistProto.TrackArtistRoles{
+		TrackId: &artistProto.TrackID{Id: 10},
+		LabelId: 2,
+		Artists: []*artistProto.ArtistRole{
+			{ArtistId: &artistProto.ArtistID{Id: 5}, Role: "main"},
+			{ArtistId: &artistProto.ArtistID{Id: 6}, Role: "producer"},
+		},
+	}).Return(nil, nil)
+
+	err := usecase.EditTrack(context.Background(), &usecaseModel.EditAlbumTrackRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		Title:    "fixed title",
+		Position: 3,
+		Artists: []*usecaseModel.TrackArtistRole{
+			{ArtistID: 5, Role: "main"},
+			{ArtistID: 6, Role: "producer"},
+		},
+	})
+
+	require.NoError(t, err)
+}
+
+func TestEditTrackInvalidRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockArtistProto := mocks.NewMockArtistServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistProto, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1}, nil)
+	mockTrackRepo.EXPECT().EditTrack(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockArtistProto.EXPECT().SetTrackArtistRoles(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "artist role must be main, featured, producer or writer"))
+
+	err := usecase.EditTrack(context.Background(), &usecaseModel.EditAlbumTrackRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		Title:    "fixed title",
+		Position: 1,
+		Artists:  []*usecaseModel.TrackArtistRole{{ArtistID: 5, Role: "drummer"}},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrInvalidArtistRole)
+}
+
+func TestReplaceTrackAudio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1}, nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(2), usecaseModel.UploadKindTrack).Return("track-upload.mp3", nil)
+	mockTrackRepo.EXPECT().ReplaceTrackAudio(gomock.Any(), &track.TrackAudioReplace{
+		Id:      &track.TrackID{Id: 10},
+		AlbumId: &track.AlbumID{Id: 1},
+		FileKey: "track-upload.mp3",
+	}).Return(nil, nil)
+	mockUploadUsecase.EXPECT().ReleaseUpload(gomock.Any(), "track-upload", int64(2)).Return(nil)
+
+	err := usecase.ReplaceTrackAudio(ctx, &usecaseModel.ReplaceTrackAudioRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		UploadID: "track-upload",
+	})
+
+	require.NoError(t, err)
+}
+
+func TestReplaceTrackAudioInvalidFile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, mockUploadUsecase)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1}, nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(2), usecaseModel.UploadKindTrack).Return("track-upload.mp3", nil)
+	mockTrackRepo.EXPECT().ReplaceTrackAudio(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "uploaded file is not a supported audio file (mp3, flac, wav or ogg)"))
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "track-upload").Return(nil)
+
+	err := usecase.ReplaceTrackAudio(context.Background(), &usecaseModel.ReplaceTrackAudioRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		UploadID: "track-upload",
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrTrackAudioInvalid)
+}
+
+func TestSaveTrackLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), &album.LabelAlbumRequest{
+		AlbumId: &album.AlbumID{Id: 1},
+		LabelId: 2,
+	}).Return(&album.Album{Id: 1}, nil)
+	mockTrackRepo.EXPECT().SaveTrackLyrics(gomock.Any(), &track.TrackLyricsUpload{
+		Id:       &track.TrackID{Id: 10},
+		AlbumId:  &track.AlbumID{Id: 1},
+		Language: "en",
+		Text:     "[00:01.50]Hello",
+	}).Return(&track.TrackLyrics{
+		Language: "en",
+		Synced:   true,
+		Lines:    []*track.LyricsLine{{Time: 1500, Text: "Hello"}},
+	}, nil)
+
+	lyrics, err := usecase.SaveTrackLyrics(context.Background(), &usecaseModel.SaveTrackLyricsRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		Language: "en",
+		Lyrics:   "[00:01.50]Hello",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &usecaseModel.TrackLyrics{
+		Language: "en",
+		Synced:   true,
+		Lines:    []*usecaseModel.LyricsLine{{Time: 1500, Text: "Hello"}},
+	}, lyrics)
+}
+
+func TestSaveTrackLyricsInvalid(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1}, nil)
+	mockTrackRepo.EXPECT().SaveTrackLyrics(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, "lyrics must have from 1 to 1000 lines of up to 500 characters"))
+
+	_, err := usecase.SaveTrackLyrics(context.Background(), &usecaseModel.SaveTrackLyricsRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		Language: "en",
+		Lyrics:   "",
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrTrackLyricsInvalid)
+}
+
+func TestSaveTrackLyricsForeignAlbum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "album not found"))
+
+	_, err := usecase.SaveTrackLyrics(context.Background(), &usecaseModel.SaveTrackLyricsRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  3,
+		Language: "en",
+		Lyrics:   "Hello",
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrAlbumNotFound)
+}
+
+func TestDeleteTrackLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1}, nil)
+	mockTrackRepo.EXPECT().DeleteTrackLyrics(gomock.Any(), &track.TrackLyricsDelete{
+		Id:       &track.TrackID{Id: 10},
+		AlbumId:  &track.AlbumID{Id: 1},
+		Language: "ru",
+	}).Return(nil, nil)
+
+	err := usecase.DeleteTrackLyrics(context.Background(), &usecaseModel.DeleteTrackLyricsRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		Language: "ru",
+	})
+
+	require.NoError(t, err)
+}
+
+func TestDeleteTrackLyricsNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, nil)
+
+	mockAlbumRepo.EXPECT().GetLabelAlbumByID(gomock.Any(), gomock.Any()).Return(&album.Album{Id: 1}, nil)
+	mockTrackRepo.EXPECT().DeleteTrackLyrics(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.NotFound, "lyrics not found"))
+
+	err := usecase.DeleteTrackLyrics(context.Background(), &usecaseModel.DeleteTrackLyricsRequest{
+		AlbumID:  1,
+		TrackID:  10,
+		LabelID:  2,
+		Language: "de",
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrTrackLyricsNotFound)
+}
+
+func TestCreateAlbumDuplicateTrack(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(1), usecaseModel.UploadKindTrack).Return("track-upload.mp3", nil)
+	mockAlbumRepo.EXPECT().CreateAlbum(gomock.Any(), gomock.Any()).Return(&album.AlbumIDAndURL{Id: 1, Url: "thumbnail_url"}, nil)
+	mockTrackRepo.EXPECT().AddTracksToAlbum(gomock.Any(), gomock.Any()).
+		Return(nil, status.Error(codes.AlreadyExists, `track "track" is the same recording as track 5 "Original" of album 2 "First album" by label 3 (97% similar)`))
+	// the draft is removed so that the label can upload the release again
+	mockAlbumRepo.EXPECT().DeleteAlbum(gomock.Any(), &album.AlbumID{Id: 1}).Return(nil, nil)
+	mockTrackRepo.EXPECT().DeleteTracksByAlbumID(gomock.Any(), &track.AlbumID{Id: 1}).Return(nil, nil)
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "cover-upload").Return(nil)
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "track-upload").Return(nil)
+
+	createdAlbum, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "new_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: "track", UploadID: "track-upload"},
+		},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrTrackDuplicate)
+	assert.Contains(t, err.Error(), `track 5 "Original" of album 2 "First album" by label 3`)
+	assert.Nil(t, createdAlbum)
+}
+
+func TestGetDuplicateFlags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, nil, mockTrackRepo, nil, nil)
+
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+	mockTrackRepo.EXPECT().GetPendingDuplicateFlags(gomock.Any(), &track.Filters{
+		Pagination: &track.Pagination{Offset: 0, Limit: 10},
+	}).Return(&track.DuplicateFlagList{
+		Flags: []*track.DuplicateFlag{{
+			Id:           1,
+			Track:        &track.DuplicateFlagTrack{Id: &track.TrackID{Id: 7}, Title: "Upload", AlbumId: &track.AlbumID{Id: 4}, LabelId: 3},
+			MatchedTrack: &track.DuplicateFlagTrack{Id: &track.TrackID{Id: 5}, Title: "Original", AlbumId: &track.AlbumID{Id: 2}, LabelId: 1},
+			Similarity:   0.8,
+			Status:       "pending",
+			CreatedAt:    timestamppb.New(createdAt),
+		}},
+	}, nil)
+
+	flags, err := usecase.GetDuplicateFlags(context.Background(), &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{Offset: 0, Limit: 10},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*usecaseModel.DuplicateFlag{{
+		ID:           1,
+		Track:        usecaseModel.DuplicateFlagTrack{ID: 7, Title: "Upload", AlbumID: 4, LabelID: 3},
+		MatchedTrack: usecaseModel.DuplicateFlagTrack{ID: 5, Title: "Original", AlbumID: 2, LabelID: 1},
+		Similarity:   0.8,
+		Status:       "pending",
+		CreatedAt:    createdAt,
+	}}, flags)
+}
+
+func TestResolveDuplicateFlagNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, nil, mockTrackRepo, nil, nil)
+
+	mockTrackRepo.EXPECT().ResolveDuplicateFlag(gomock.Any(), &track.DuplicateFlagResolution{Id: 1, Status: "dismissed"}).
+		Return(nil, status.Error(codes.NotFound, "duplicate flag not found"))
+
+	err := usecase.ResolveDuplicateFlag(context.Background(), 1, "dismissed")
+
+	assert.ErrorIs(t, err, customErrors.ErrDuplicateFlagNotFound)
+}
+
+func TestPreviewAlbum(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockArtistRepo := mocks.NewMockArtistServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistRepo, nil, mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	mockUploadUsecase.EXPECT().PeekUpload(gomock.Any(), "first-upload", int64(1), usecaseModel.UploadKindTrack).Return("first-upload.mp3", nil)
+	mockUploadUsecase.EXPECT().PeekUpload(gomock.Any(), "second-upload", int64(1), usecaseModel.UploadKindTrack).Return("second-upload.flac", nil)
+	mockTrackRepo.EXPECT().ReadTrackMetadata(gomock.Any(), &track.TrackFileKey{FileKey: "first-upload.mp3"}).Return(&track.TrackMetadata{
+		Format:      "mp3",
+		Title:       "Intro",
+		TrackNumber: 1,
+		Artists:     []string{"artist", "Guest"},
+		CoverKey:    "uploads/first-upload-cover",
+		CoverUrl:    "cover_url",
+	}, nil)
+	mockTrackRepo.EXPECT().ReadTrackMetadata(gomock.Any(), &track.TrackFileKey{FileKey: "second-upload.flac"}).Return(&track.TrackMetadata{
+		Format: "flac",
+	}, nil)
+	mockArtistRepo.EXPECT().GetArtistsLabelID(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *artistProto.FiltersWithLabelID, _ ...interface{}) (*artistProto.ArtistList, error) {
+		assert.Equal(t, int64(1), request.LabelId)
+		return &artistProto.ArtistList{Artists: []*artistProto.Artist{
+			{Id: 3, Title: "Artist"},
+			{Id: 4, Title: "Other"},
+		}}, nil
+	})
+
+	metadata, err := usecase.PreviewAlbum(ctx, 1, []string{"first-upload", "second-upload"})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*usecaseModel.TrackMetadata{
+		{
+			UploadID:    "first-upload",
+			Format:      "mp3",
+			Title:       "Intro",
+			TrackNumber: 1,
+			Artists:     []string{"artist", "Guest"},
+			ArtistIDs:   []int64{3},
+			CoverKey:    "uploads/first-upload-cover",
+			CoverURL:    "cover_url",
+		},
+		{
+			UploadID:  "second-upload",
+			Format:    "flac",
+			ArtistIDs: []int64{},
+		},
+	}, metadata)
+}
+
+func TestPreviewAlbumNotAudio(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, nil, mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := context.Background()
+
+	mockUploadUsecase.EXPECT().PeekUpload(gomock.Any(), "track-upload", int64(1), usecaseModel.UploadKindTrack).Return("track-upload.mp3", nil)
+	mockTrackRepo.EXPECT().ReadTrackMetadata(gomock.Any(), gomock.Any()).Return(nil, status.Error(codes.InvalidArgument, customErrors.ErrTrackAudioInvalid.Error()))
+
+	_, err := usecase.PreviewAlbum(ctx, 1, []string{"track-upload"})
+
+	assert.ErrorIs(t, err, customErrors.ErrTrackAudioInvalid)
+}
+
+func TestCreateAlbumFromTags(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockArtistRepo := mocks.NewMockArtistServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, mockArtistRepo, mockAlbumRepo, mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "first-upload", int64(1), usecaseModel.UploadKindTrack).Return("first-upload.mp3", nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "second-upload", int64(1), usecaseModel.UploadKindTrack).Return("second-upload.mp3", nil)
+	// the second track is only read for its title, the cover already came from the first one
+	mockTrackRepo.EXPECT().ReadTrackMetadata(gomock.Any(), &track.TrackFileKey{FileKey: "first-upload.mp3"}).Return(&track.TrackMetadata{
+		Title:    "Tagged",
+		CoverKey: "uploads/first-upload-cover",
+	}, nil)
+	mockTrackRepo.EXPECT().ReadTrackMetadata(gomock.Any(), &track.TrackFileKey{FileKey: "second-upload.mp3"}).Return(&track.TrackMetadata{
+		Title: "Second",
+	}, nil)
+
+	mockAlbumRepo.EXPECT().CreateAlbum(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *album.CreateAlbumRequest, _ ...interface{}) (*album.AlbumIDAndURL, error) {
+		assert.Equal(t, "uploads/first-upload-cover", request.ImageKey)
+		return &album.AlbumIDAndURL{Id: 1, Url: "thumbnail_url"}, nil
+	})
+	mockTrackRepo.EXPECT().AddTracksToAlbum(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, request *track.TracksListWithAlbumID, _ ...interface{}) (*track.TrackIdsList, error) {
+		assert.Equal(t, "uploads/first-upload-cover", request.CoverKey)
+		require.Len(t, request.Tracks, 2)
+		assert.Equal(t, "Typed", request.Tracks[0].Title)
+		assert.Equal(t, "Second", request.Tracks[1].Title)
+		return &track.TrackIdsList{Ids: []*track.TrackID{{Id: 5}, {Id: 6}}}, nil
+	})
+	mockArtistRepo.EXPECT().ConnectArtists(gomock.Any(), gomock.Any()).Return(nil, nil)
+	mockUploadUsecase.EXPECT().ReleaseUpload(gomock.Any(), "first-upload", int64(1)).Return(nil)
+	mockUploadUsecase.EXPECT().ReleaseUpload(gomock.Any(), "second-upload", int64(1)).Return(nil)
+
+	createdAlbum, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs: []int64{1},
+		Title:      "new_album",
+		Type:       "album",
+		LabelID:    1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: "Typed", UploadID: "first-upload"},
+			{UploadID: "second-upload"},
+		},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), createdAlbum.ID)
+}
+
+func TestCreateAlbumWithoutCover(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mocks.NewMockAlbumServiceClient(ctrl), mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := context.Background()
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(1), usecaseModel.UploadKindTrack).Return("track-upload.mp3", nil)
+	mockTrackRepo.EXPECT().ReadTrackMetadata(gomock.Any(), gomock.Any()).Return(&track.TrackMetadata{Title: "Tagged"}, nil)
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "track-upload").Return(nil)
+
+	createdAlbum, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs: []int64{1},
+		Title:      "new_album",
+		Type:       "album",
+		LabelID:    1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: "track", UploadID: "track-upload"},
+		},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrAlbumCoverRequired)
+	assert.Nil(t, createdAlbum)
+}
+
+func TestCreateAlbumWithoutTrackTitle(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockTrackRepo := mocks.NewMockTrackServiceClient(ctrl)
+	mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+	usecase := NewLabelUsecase(nil, nil, nil, mocks.NewMockAlbumServiceClient(ctrl), mockTrackRepo, nil, mockUploadUsecase)
+
+	ctx := context.Background()
+
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+	mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(1), usecaseModel.UploadKindTrack).Return("track-upload.wav", nil)
+	mockTrackRepo.EXPECT().ReadTrackMetadata(gomock.Any(), gomock.Any()).Return(&track.TrackMetadata{Format: "wav"}, nil)
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "cover-upload").Return(nil)
+	mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "track-upload").Return(nil)
+
+	_, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+		ArtistsIDs:    []int64{1},
+		Title:         "new_album",
+		Type:          "album",
+		CoverUploadID: "cover-upload",
+		LabelID:       1,
+		Tracks: []*usecaseModel.CreateTrackRequest{
+			{Title: " ", UploadID: "track-upload"},
+		},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrTrackTitleInvalid)
+}
+
+func TestCreateAlbumTrackTitleLength(t *testing.T) {
+	tests := []struct {
+		name        string
+		title       string
+		expectedErr error
+	}{
+		{name: "Multibyte title at the limit", title: strings.Repeat("я", 100), expectedErr: customErrors.ErrAlbumNotFound},
+		{name: "Title over the limit", title: strings.Repeat("я", 101), expectedErr: customErrors.ErrTrackTitleInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			mockAlbumRepo := mocks.NewMockAlbumServiceClient(ctrl)
+			mockUploadUsecase := mock_upload.NewMockUsecase(ctrl)
+
+			usecase := NewLabelUsecase(nil, nil, nil, mockAlbumRepo, nil, nil, mockUploadUsecase)
+
+			ctx := context.Background()
+
+			mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "cover-upload", int64(1), usecaseModel.UploadKindCover).Return("uploads/cover-upload", nil)
+			mockUploadUsecase.EXPECT().ResolveUpload(gomock.Any(), "track-upload", int64(1), usecaseModel.UploadKindTrack).Return("track-upload.mp3", nil)
+			mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "cover-upload").Return(nil)
+			mockUploadUsecase.EXPECT().UnclaimUpload(gomock.Any(), "track-upload").Return(nil)
+			if tt.expectedErr != customErrors.ErrTrackTitleInvalid {
+				// the title passed validation, stopping at the album creation is enough
+				mockAlbumRepo.EXPECT().CreateAlbum(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrAlbumNotFound)
+			}
+
+			_, err := usecase.CreateAlbum(ctx, &usecaseModel.CreateAlbumRequest{
+				ArtistsIDs:    []int64{1},
+				Title:         "new_album",
+				Type:          "album",
+				CoverUploadID: "cover-upload",
+				LabelID:       1,
+				Tracks: []*usecaseModel.CreateTrackRequest{
+					{Title: tt.title, UploadID: "track-upload"},
+				},
+			})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
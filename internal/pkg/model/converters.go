@@ -7,11 +7,35 @@ import (
 	playlistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
 	trackProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/track"
 	userProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/imageVariants"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
 )
 
+///////////////////////////////////// IMAGES ////////////////////////////////////
+
+func ImagesFor(url string, dominantColor string) *delivery.Images {
+	variants := imageVariants.ForImage(url, dominantColor)
+	if variants == nil {
+		return nil
+	}
+
+	images := &delivery.Images{
+		DominantColor: variants.DominantColor,
+		Variants:      make([]*delivery.ImageVariant, 0, len(variants.Sizes)),
+	}
+	for _, size := range variants.Sizes {
+		images.Variants = append(images.Variants, &delivery.ImageVariant{
+			Size: size.Size,
+			AVIF: size.AVIF,
+			WebP: size.WebP,
+			JPEG: size.JPEG,
+		})
+	}
+	return images
+}
+
 ///////////////////////////////////// PAGINATION ////////////////////////////////////
 
 func PaginationFromDeliveryToUsecase(deliveryPagination *delivery.Pagination) *usecase.Pagination {
@@ -58,9 +82,11 @@ func AlbumFromUsecaseToDelivery(usecaseAlbum *usecase.Album, usecaseAlbumArtists
 		Title:       usecaseAlbum.Title,
 		Type:        delivery.AlbumType(usecaseAlbum.Type),
 		Thumbnail:   usecaseAlbum.Thumbnail,
+		Images:      ImagesFor(usecaseAlbum.Thumbnail, usecaseAlbum.ThumbnailColor),
 		Artists:     AlbumArtistsFromUsecaseToDelivery(usecaseAlbumArtists),
 		ReleaseDate: usecaseAlbum.ReleaseDate,
 		IsLiked:     usecaseAlbum.IsLiked,
+		Status:      string(usecaseAlbum.Status),
 	}
 }
 
@@ -75,6 +101,19 @@ func AlbumArtistsFromUsecaseToDelivery(usecaseAlbumArtists []*usecase.AlbumArtis
 	return albumArtists
 }
 
+func AlbumTypeFromUsecaseToProto(albumType string) albumProto.AlbumType {
+	switch albumType {
+	case string(usecase.AlbumTypeEP):
+		return albumProto.AlbumType_AlbumTypeEP
+	case string(usecase.AlbumTypeSingle):
+		return albumProto.AlbumType_AlbumTypeSingle
+	case string(usecase.AlbumTypeCompilation):
+		return albumProto.AlbumType_AlbumTypeCompilation
+	default:
+		return albumProto.AlbumType_AlbumTypeAlbum
+	}
+}
+
 func AlbumFromProtoToUsecase(protoAlbum *albumProto.Album) *usecase.Album {
 	var albumType usecase.AlbumType
 
@@ -92,12 +131,14 @@ func AlbumFromProtoToUsecase(protoAlbum *albumProto.Album) *usecase.Album {
 	}
 
 	return &usecase.Album{
-		ID:          protoAlbum.Id,
-		Title:       protoAlbum.Title,
-		Type:        albumType,
-		Thumbnail:   protoAlbum.Thumbnail,
-		ReleaseDate: protoAlbum.ReleaseDate.AsTime(),
-		IsLiked:     protoAlbum.IsFavorite,
+		ID:             protoAlbum.Id,
+		Title:          protoAlbum.Title,
+		Type:           albumType,
+		Thumbnail:      protoAlbum.Thumbnail,
+		ThumbnailColor: protoAlbum.ThumbnailColor,
+		ReleaseDate:    protoAlbum.ReleaseDate.AsTime(),
+		IsLiked:        protoAlbum.IsFavorite,
+		Status:         usecase.AlbumStatus(protoAlbum.Status),
 	}
 }
 
@@ -127,8 +168,8 @@ func AlbumLikeRequestFromDeliveryToUsecase(isLike bool, userID int64, albumID in
 
 func TrackRequestFromDeliveryToUsecase(deliveryTrack *delivery.CreateTrackRequest) *usecase.CreateTrackRequest {
 	return &usecase.CreateTrackRequest{
-		Title: deliveryTrack.Title,
-		Track: deliveryTrack.Track,
+		Title:    deliveryTrack.Title,
+		UploadID: deliveryTrack.UploadID,
 	}
 }
 
@@ -139,12 +180,65 @@ func NewAlbumFromDeliveryToUsecase(deliveryAlbum *delivery.CreateAlbumRequest) *
 	}
 
 	return &usecase.CreateAlbumRequest{
-		Title:      deliveryAlbum.Title,
-		Image:      deliveryAlbum.Image,
-		Type:       deliveryAlbum.Type,
-		LabelID:    deliveryAlbum.LabelID,
+		Title:         deliveryAlbum.Title,
+		CoverUploadID: deliveryAlbum.CoverUploadID,
+		Type:          deliveryAlbum.Type,
+		LabelID:       deliveryAlbum.LabelID,
+		Tracks:        tracks,
+		ArtistsIDs:    deliveryAlbum.ArtistsIDs,
+	}
+}
+
+func EditAlbumTracksRequestFromDeliveryToUsecase(request *delivery.EditAlbumTracksRequest, albumID int64, labelID int64) *usecase.EditAlbumTracksRequest {
+	tracks := make([]*usecase.EditTrackRequest, 0, len(request.Tracks))
+	for _, track := range request.Tracks {
+		tracks = append(tracks, &usecase.EditTrackRequest{
+			ID:         track.ID,
+			Title:      track.Title,
+			Position:   track.Position,
+			ArtistsIDs: track.ArtistsIDs,
+		})
+	}
+	return &usecase.EditAlbumTracksRequest{
+		AlbumID:    albumID,
+		LabelID:    labelID,
+		ArtistsIDs: request.ArtistsIDs,
 		Tracks:     tracks,
-		ArtistsIDs: deliveryAlbum.ArtistsIDs,
+	}
+}
+
+func EditAlbumRequestFromDeliveryToUsecase(request *delivery.EditAlbumRequest, albumID int64, labelID int64) *usecase.EditAlbumRequest {
+	return &usecase.EditAlbumRequest{
+		AlbumID:       albumID,
+		LabelID:       labelID,
+		Title:         request.Title,
+		Type:          request.Type,
+		CoverUploadID: request.CoverUploadID,
+	}
+}
+
+func EditAlbumTrackRequestFromDeliveryToUsecase(request *delivery.EditAlbumTrackRequest, albumID int64, trackID int64, labelID int64) *usecase.EditAlbumTrackRequest {
+	artists := make([]*usecase.TrackArtistRole, 0, len(request.Artists))
+	for _, artist := range request.Artists {
+		artists = append(artists, &usecase.TrackArtistRole{
+			ArtistID: artist.ArtistID,
+			Role:     artist.Role,
+		})
+	}
+	return &usecase.EditAlbumTrackRequest{
+		AlbumID:  albumID,
+		TrackID:  trackID,
+		LabelID:  labelID,
+		Title:    request.Title,
+		Position: request.Position,
+		Artists:  artists,
+	}
+}
+
+func LabelAlbumFromUsecaseToDelivery(labelAlbum *usecase.LabelAlbum) *delivery.LabelAlbum {
+	return &delivery.LabelAlbum{
+		Album:  AlbumFromUsecaseToDelivery(labelAlbum.Album, labelAlbum.Album.Artists),
+		Tracks: TracksFromUsecaseToDelivery(labelAlbum.Tracks),
 	}
 }
 
@@ -197,6 +291,7 @@ func ArtistFromUsecaseToDelivery(usecaseArtist *usecase.Artist) *delivery.Artist
 		ID:          usecaseArtist.ID,
 		Title:       usecaseArtist.Title,
 		Thumbnail:   usecaseArtist.Thumbnail,
+		Images:      ImagesFor(usecaseArtist.Thumbnail, usecaseArtist.ThumbnailColor),
 		Description: usecaseArtist.Description,
 		IsLiked:     usecaseArtist.IsLiked,
 	}
@@ -212,11 +307,12 @@ func ArtistsFromProtoToUsecase(protoArtists []*artistProto.Artist) []*usecase.Ar
 
 func ArtistFromProtoToUsecase(protoArtist *artistProto.Artist) *usecase.Artist {
 	return &usecase.Artist{
-		ID:          protoArtist.Id,
-		Title:       protoArtist.Title,
-		Thumbnail:   protoArtist.Thumbnail,
-		Description: protoArtist.Description,
-		IsLiked:     protoArtist.IsFavorite,
+		ID:             protoArtist.Id,
+		Title:          protoArtist.Title,
+		Thumbnail:      protoArtist.Thumbnail,
+		ThumbnailColor: protoArtist.ThumbnailColor,
+		Description:    protoArtist.Description,
+		IsLiked:        protoArtist.IsFavorite,
 	}
 }
 
@@ -320,10 +416,12 @@ func TrackFromUsecaseToDelivery(usecaseTrack *usecase.Track) *delivery.Track {
 		ID:        usecaseTrack.ID,
 		Title:     usecaseTrack.Title,
 		Thumbnail: usecaseTrack.Thumbnail,
+		Images:    ImagesFor(usecaseTrack.Thumbnail, usecaseTrack.ThumbnailColor),
 		Duration:  usecaseTrack.Duration,
 		Album:     usecaseTrack.Album,
 		AlbumID:   usecaseTrack.AlbumID,
 		Artists:   TrackArtistsFromUsecaseToDelivery(usecaseTrack.Artists),
+		BPM:       usecaseTrack.BPM,
 		IsLiked:   usecaseTrack.IsLiked,
 	}
 }
@@ -337,9 +435,53 @@ func TracksDetailedFromUsecaseToDelivery(usecaseTracks []*usecase.TrackDetailed)
 }
 
 func TrackDetailedFromUsecaseToDelivery(usecaseTrackDetailed *usecase.TrackDetailed) *delivery.TrackDetailed {
+	renditions := make([]*delivery.TrackRendition, 0, len(usecaseTrackDetailed.Renditions))
+	for _, rendition := range usecaseTrackDetailed.Renditions {
+		renditions = append(renditions, &delivery.TrackRendition{
+			Codec:   rendition.Codec,
+			Bitrate: rendition.Bitrate,
+			FileUrl: rendition.FileUrl,
+		})
+	}
 	return &delivery.TrackDetailed{
-		Track:   *TrackFromUsecaseToDelivery(&usecaseTrackDetailed.Track),
-		FileUrl: usecaseTrackDetailed.FileUrl,
+		Track:        *TrackFromUsecaseToDelivery(&usecaseTrackDetailed.Track),
+		FileUrl:      usecaseTrackDetailed.FileUrl,
+		Renditions:   renditions,
+		LoudnessLUFS: usecaseTrackDetailed.LoudnessLUFS,
+		ReplayGainDB: usecaseTrackDetailed.ReplayGainDB,
+		Key:          usecaseTrackDetailed.Key,
+		HasLyrics:    usecaseTrackDetailed.HasLyrics,
+	}
+}
+
+func TrackLyricsFromUsecaseToDelivery(usecaseLyrics *usecase.TrackLyrics) *delivery.TrackLyrics {
+	lines := make([]*delivery.LyricsLine, 0, len(usecaseLyrics.Lines))
+	for _, line := range usecaseLyrics.Lines {
+		lines = append(lines, &delivery.LyricsLine{
+			Time: line.Time,
+			Text: line.Text,
+		})
+	}
+	return &delivery.TrackLyrics{
+		Language:    usecaseLyrics.Language,
+		Translation: usecaseLyrics.Translation,
+		Synced:      usecaseLyrics.Synced,
+		Lines:       lines,
+	}
+}
+
+func TrackLyricsListFromUsecaseToDelivery(usecaseLyrics []*usecase.TrackLyrics) []*delivery.TrackLyrics {
+	lyrics := make([]*delivery.TrackLyrics, 0, len(usecaseLyrics))
+	for _, usecaseLyric := range usecaseLyrics {
+		lyrics = append(lyrics, TrackLyricsFromUsecaseToDelivery(usecaseLyric))
+	}
+	return lyrics
+}
+
+func TrackWaveformFromUsecaseToDelivery(usecaseWaveform *usecase.TrackWaveform) *delivery.TrackWaveform {
+	return &delivery.TrackWaveform{
+		Duration: usecaseWaveform.Duration,
+		Peaks:    usecaseWaveform.Peaks,
 	}
 }
 
@@ -365,21 +507,67 @@ func TrackIdsFromUsecaseToTrackProto(usecaseTrackIds []*usecase.Track) []*trackP
 
 func TrackFromProtoToUsecase(protoTrack *trackProto.Track, protoAlbum *albumProto.AlbumTitle, protoArtists *artistProto.ArtistWithRoleList) *usecase.Track {
 	return &usecase.Track{
-		ID:        protoTrack.Id,
-		Title:     protoTrack.Title,
-		Thumbnail: protoTrack.Thumbnail,
-		Duration:  protoTrack.Duration,
-		AlbumID:   protoTrack.AlbumId,
-		Album:     protoAlbum.Title,
-		Artists:   ArtistWithRoleListFromProtoToUsecase(protoArtists.Artists),
-		IsLiked:   protoTrack.IsFavorite,
+		ID:             protoTrack.Id,
+		Title:          protoTrack.Title,
+		Thumbnail:      protoTrack.Thumbnail,
+		ThumbnailColor: protoTrack.ThumbnailColor,
+		Duration:       protoTrack.Duration,
+		AlbumID:        protoTrack.AlbumId,
+		Album:          protoAlbum.Title,
+		Artists:        ArtistWithRoleListFromProtoToUsecase(protoArtists.Artists),
+		BPM:            protoTrack.Bpm,
+		IsLiked:        protoTrack.IsFavorite,
 	}
 }
 
 func TrackDetailedFromProtoToUsecase(protoTrack *trackProto.TrackDetailed, protoAlbum *albumProto.AlbumTitle, protoArtists *artistProto.ArtistWithRoleList) *usecase.TrackDetailed {
+	renditions := make([]*usecase.TrackRendition, 0, len(protoTrack.Renditions))
+	for _, protoRendition := range protoTrack.Renditions {
+		renditions = append(renditions, &usecase.TrackRendition{
+			Codec:   protoRendition.Codec,
+			Bitrate: protoRendition.Bitrate,
+			FileUrl: protoRendition.FileUrl,
+		})
+	}
 	return &usecase.TrackDetailed{
-		Track:   *TrackFromProtoToUsecase(protoTrack.Track, protoAlbum, protoArtists),
-		FileUrl: protoTrack.FileUrl,
+		Track:        *TrackFromProtoToUsecase(protoTrack.Track, protoAlbum, protoArtists),
+		FileUrl:      protoTrack.FileUrl,
+		Renditions:   renditions,
+		LoudnessLUFS: protoTrack.LoudnessLufs,
+		ReplayGainDB: protoTrack.ReplayGainDb,
+		Key:          protoTrack.Key,
+		HasLyrics:    protoTrack.HasLyrics,
+	}
+}
+
+func TrackLyricsFromProtoToUsecase(protoLyrics *trackProto.TrackLyrics) *usecase.TrackLyrics {
+	lines := make([]*usecase.LyricsLine, 0, len(protoLyrics.Lines))
+	for _, protoLine := range protoLyrics.Lines {
+		lines = append(lines, &usecase.LyricsLine{
+			Time: protoLine.Time,
+			Text: protoLine.Text,
+		})
+	}
+	return &usecase.TrackLyrics{
+		Language:    protoLyrics.Language,
+		Translation: protoLyrics.Translation,
+		Synced:      protoLyrics.Synced,
+		Lines:       lines,
+	}
+}
+
+func TrackLyricsListFromProtoToUsecase(protoLyrics *trackProto.TrackLyricsList) []*usecase.TrackLyrics {
+	lyrics := make([]*usecase.TrackLyrics, 0, len(protoLyrics.Lyrics))
+	for _, protoLyric := range protoLyrics.Lyrics {
+		lyrics = append(lyrics, TrackLyricsFromProtoToUsecase(protoLyric))
+	}
+	return lyrics
+}
+
+func TrackWaveformFromProtoToUsecase(protoWaveform *trackProto.TrackWaveform) *usecase.TrackWaveform {
+	return &usecase.TrackWaveform{
+		Duration: protoWaveform.Duration,
+		Peaks:    protoWaveform.Peaks,
 	}
 }
 
@@ -453,6 +641,34 @@ func TrackStreamUpdateDataFromUsecaseToProto(usecaseTrackStream *usecase.TrackSt
 	}
 }
 
+func StreamManifestFromProtoToUsecase(protoManifest *trackProto.StreamManifest) []*usecase.StreamVariant {
+	variants := make([]*usecase.StreamVariant, len(protoManifest.Variants))
+	for i, protoVariant := range protoManifest.Variants {
+		segments := make([]*usecase.StreamSegment, len(protoVariant.Segments))
+		for j, protoSegment := range protoVariant.Segments {
+			segments[j] = &usecase.StreamSegment{
+				Position: protoSegment.Position,
+				Duration: protoSegment.Duration,
+			}
+		}
+		variants[i] = &usecase.StreamVariant{
+			Codec:    protoVariant.Codec,
+			Bitrate:  protoVariant.Bitrate,
+			Segments: segments,
+		}
+	}
+	return variants
+}
+
+func StreamSegmentRequestFromUsecaseToProto(request *usecase.StreamSegmentRequest) *trackProto.StreamSegmentRequest {
+	return &trackProto.StreamSegmentRequest{
+		StreamId: &trackProto.StreamID{Id: request.StreamID},
+		UserId:   &trackProto.UserID{Id: request.UserID},
+		Bitrate:  request.Bitrate,
+		Position: request.Position,
+	}
+}
+
 func ArtistIdsFromUsecaseToArtistProto(artistIDs []int64) *artistProto.ArtistIDList {
 	artistIds := make([]*artistProto.ArtistID, 0, len(artistIDs))
 	for _, id := range artistIDs {
@@ -473,6 +689,7 @@ func UserFullDataUsecaseToDelivery(usecaseUser *usecase.UserFullData) *delivery.
 	return &delivery.UserFullData{
 		Username:   usecaseUser.Username,
 		AvatarUrl:  usecaseUser.AvatarUrl,
+		Images:     ImagesFor(usecaseUser.AvatarUrl, usecaseUser.AvatarColor),
 		Email:      usecaseUser.Email,
 		Privacy:    PrivacyUsecaseToDelivery(usecaseUser.Privacy),
 		Statistics: StatisticsUsecaseToDelivery(usecaseUser.Statistics),
@@ -583,10 +800,11 @@ func MembersFromProtoToUsecase(users *userProto.UsersToFront) []*usecase.User {
 	members := make([]*usecase.User, 0, len(users.Users))
 	for _, user := range users.Users {
 		members = append(members, &usecase.User{
-			Username:  user.Username,
-			Email:     user.Email,
-			AvatarUrl: user.Avatar,
-			ID:        user.Id,
+			Username:    user.Username,
+			Email:       user.Email,
+			AvatarUrl:   user.Avatar,
+			AvatarColor: user.AvatarColor,
+			ID:          user.Id,
 		})
 	}
 	return members
@@ -655,21 +873,23 @@ func UploadPlaylistThumbnailRequestFromUsecaseToProto(title string, thumbnail []
 	}
 }
 
-func CreatePlaylistRequestFromUsecaseToProto(usecasePlaylist *usecase.CreatePlaylistRequest, thumbnail string, isPublic bool) *playlistProto.CreatePlaylistRequest {
+func CreatePlaylistRequestFromUsecaseToProto(usecasePlaylist *usecase.CreatePlaylistRequest, thumbnail string, thumbnailColor string, isPublic bool) *playlistProto.CreatePlaylistRequest {
 	return &playlistProto.CreatePlaylistRequest{
-		Title:     usecasePlaylist.Title,
-		UserId:    usecasePlaylist.UserID,
-		Thumbnail: thumbnail,
-		IsPublic:  isPublic,
+		Title:          usecasePlaylist.Title,
+		UserId:         usecasePlaylist.UserID,
+		Thumbnail:      thumbnail,
+		ThumbnailColor: thumbnailColor,
+		IsPublic:       isPublic,
 	}
 }
 
 func PlaylistFromProtoToUsecase(protoPlaylist *playlistProto.Playlist, username string) *usecase.Playlist {
 	return &usecase.Playlist{
-		ID:        protoPlaylist.Id,
-		Title:     protoPlaylist.Title,
-		Thumbnail: protoPlaylist.Thumbnail,
-		Username:  username,
+		ID:             protoPlaylist.Id,
+		Title:          protoPlaylist.Title,
+		Thumbnail:      protoPlaylist.Thumbnail,
+		ThumbnailColor: protoPlaylist.ThumbnailColor,
+		Username:       username,
 	}
 }
 
@@ -686,6 +906,7 @@ func PlaylistFromUsecaseToDelivery(usecasePlaylist *usecase.Playlist) *delivery.
 		ID:        usecasePlaylist.ID,
 		Title:     usecasePlaylist.Title,
 		Thumbnail: usecasePlaylist.Thumbnail,
+		Images:    ImagesFor(usecasePlaylist.Thumbnail, usecasePlaylist.ThumbnailColor),
 		Username:  usecasePlaylist.Username,
 	}
 }
@@ -730,12 +951,13 @@ func RemoveTrackFromPlaylistRequestFromUsecaseToProto(usecaseRemoveTrackFromPlay
 	}
 }
 
-func UpdatePlaylistRequestFromUsecaseToProto(usecaseUpdatePlaylist *usecase.UpdatePlaylistRequest, thumbnail string) *playlistProto.UpdatePlaylistRequest {
+func UpdatePlaylistRequestFromUsecaseToProto(usecaseUpdatePlaylist *usecase.UpdatePlaylistRequest, thumbnail string, thumbnailColor string) *playlistProto.UpdatePlaylistRequest {
 	return &playlistProto.UpdatePlaylistRequest{
-		Id:        usecaseUpdatePlaylist.PlaylistID,
-		Title:     usecaseUpdatePlaylist.Title,
-		Thumbnail: thumbnail,
-		UserId:    usecaseUpdatePlaylist.UserID,
+		Id:             usecaseUpdatePlaylist.PlaylistID,
+		Title:          usecaseUpdatePlaylist.Title,
+		Thumbnail:      thumbnail,
+		ThumbnailColor: thumbnailColor,
+		UserId:         usecaseUpdatePlaylist.UserID,
 	}
 }
 
@@ -808,11 +1030,12 @@ func RegisterDataFromUsecaseToProto(regData *usecase.User) *userProto.RegisterDa
 
 func UserFromProtoToUsecase(protoUser *userProto.UserFront) *usecase.User {
 	return &usecase.User{
-		ID:        protoUser.Id,
-		Username:  protoUser.Username,
-		Email:     protoUser.Email,
-		AvatarUrl: protoUser.Avatar,
-		LabelID:   protoUser.LabelId,
+		ID:          protoUser.Id,
+		Username:    protoUser.Username,
+		Email:       protoUser.Email,
+		AvatarUrl:   protoUser.Avatar,
+		AvatarColor: protoUser.AvatarColor,
+		LabelID:     protoUser.LabelId,
 	}
 }
 
@@ -834,10 +1057,11 @@ func LoginDataFromUsecaseToProto(loginData *usecase.User) *userProto.LoginData {
 	}
 }
 
-func AvatarDataFromUsecaseToProto(fileURL string, id int64) *userProto.AvatarData {
+func AvatarDataFromUsecaseToProto(fileURL string, avatarColor string, id int64) *userProto.AvatarData {
 	return &userProto.AvatarData{
-		AvatarPath: fileURL,
-		Id:         id,
+		AvatarPath:  fileURL,
+		AvatarColor: avatarColor,
+		Id:          id,
 	}
 }
 
@@ -869,10 +1093,11 @@ func PrivacyFromProtoToUsecase(protoPrivacy *userProto.PrivacySettings) *usecase
 func UserFullDataFromProtoToUsecase(protoUser *userProto.UserFullData) *usecase.UserFullData {
 	privacyUsecase := PrivacyFromProtoToUsecase(protoUser.Privacy)
 	return &usecase.UserFullData{
-		Username:  protoUser.Username,
-		Email:     protoUser.Email,
-		AvatarUrl: protoUser.Avatar,
-		Privacy:   privacyUsecase,
+		Username:    protoUser.Username,
+		Email:       protoUser.Email,
+		AvatarUrl:   protoUser.Avatar,
+		AvatarColor: protoUser.AvatarColor,
+		Privacy:     privacyUsecase,
 	}
 }
 
@@ -919,6 +1144,10 @@ func FileKeyFromProtoToUsecase(protoFileKey *userProto.FileKey) string {
 	return protoFileKey.FileKey
 }
 
+func UploadedAvatarFromProtoToUsecase(protoUploadedAvatar *userProto.UploadedAvatar) (string, string) {
+	return protoUploadedAvatar.FileKey, protoUploadedAvatar.AvatarColor
+}
+
 // ///////////////////////////////////// AUTH ////////////////////////////////////
 func SessionIDFromProtoToUsecase(protoSessionID *authProto.SessionID) string {
 	return protoSessionID.SessionId
@@ -942,8 +1171,8 @@ func UserIDFromUsecaseToProto(userID int64) *authProto.UserID {
 
 func TrackLoadFromUsecaseToProto(track *usecase.CreateTrackRequest) *trackProto.TrackLoad {
 	return &trackProto.TrackLoad{
-		Title: track.Title,
-		File:  track.Track,
+		Title:   track.Title,
+		FileKey: track.FileKey,
 	}
 }
 
@@ -975,59 +1204,885 @@ func TracksIdsFromUsecaseToProtoArtist(trackIDs []int64) *artistProto.TrackIDLis
 
 func CreateJamResponseFromUsecaseToDelivery(usecaseCreateJam *usecase.CreateJamResponse) *delivery.CreateJamResponse {
 	return &delivery.CreateJamResponse{
-		RoomID: usecaseCreateJam.RoomID,
-		HostID: usecaseCreateJam.HostID,
+		RoomID:      usecaseCreateJam.RoomID,
+		HostID:      usecaseCreateJam.HostID,
+		InviteToken: usecaseCreateJam.InviteToken,
 	}
 }
 
 func CreateJamRequestFromDeliveryToUsecase(deliveryCreateJam *delivery.CreateJamRequest, userID string) *usecase.CreateJamRequest {
 	return &usecase.CreateJamRequest{
-		UserID:   userID,
-		TrackID:  deliveryCreateJam.TrackID,
-		Position: deliveryCreateJam.Position,
+		UserID:       userID,
+		TrackID:      deliveryCreateJam.TrackID,
+		Position:     deliveryCreateJam.Position,
+		Private:      deliveryCreateJam.Private,
+		Password:     deliveryCreateJam.Password,
+		MaxListeners: deliveryCreateJam.MaxListeners,
 	}
 }
 
 func JamMessageFromUsecaseToDelivery(usecaseJamMessage *usecase.JamMessage) *delivery.JamMessage {
 	return &delivery.JamMessage{
-		Type:       usecaseJamMessage.Type,
-		TrackID:    usecaseJamMessage.TrackID,
-		Position:   usecaseJamMessage.Position,
-		Paused:     usecaseJamMessage.Paused,
-		Users:      usecaseJamMessage.Users,
-		HostID:     usecaseJamMessage.HostID,
-		Loaded:     usecaseJamMessage.Loaded,
-		UserID:     usecaseJamMessage.UserID,
-		UserImages: usecaseJamMessage.UserImages,
-		UserNames:  usecaseJamMessage.UserNames,
+		Type:        usecaseJamMessage.Type,
+		TrackID:     usecaseJamMessage.TrackID,
+		Position:    usecaseJamMessage.Position,
+		Paused:      usecaseJamMessage.Paused,
+		Users:       usecaseJamMessage.Users,
+		HostID:      usecaseJamMessage.HostID,
+		Loaded:      usecaseJamMessage.Loaded,
+		UserID:      usecaseJamMessage.UserID,
+		UserImages:  usecaseJamMessage.UserImages,
+		UserNames:   usecaseJamMessage.UserNames,
+		Queue:       usecaseJamMessage.Queue,
+		Suggestions: JamSuggestionsFromUsecaseToDelivery(usecaseJamMessage.Suggestions),
+		AutoAdvance: usecaseJamMessage.AutoAdvance,
+		CoHosts:     usecaseJamMessage.CoHosts,
+		ServerTime:  usecaseJamMessage.ServerTime,
+		MessageID:   usecaseJamMessage.MessageID,
+		Text:        usecaseJamMessage.Text,
+		Emoji:       usecaseJamMessage.Emoji,
+		Chat:        JamChatFromUsecaseToDelivery(usecaseJamMessage.Chat),
+		Muted:       usecaseJamMessage.Muted,
+		Lyrics:      TrackLyricsListFromUsecaseToDelivery(usecaseJamMessage.Lyrics),
 	}
 }
 
 func JamMessageFromRepositoryToUsecase(repoJamMessage *repository.JamMessage) *usecase.JamMessage {
 	return &usecase.JamMessage{
-		Type:       repoJamMessage.Type,
-		TrackID:    repoJamMessage.TrackID,
-		Position:   repoJamMessage.Position,
-		Paused:     repoJamMessage.Paused,
-		Users:      repoJamMessage.Users,
-		HostID:     repoJamMessage.HostID,
-		Loaded:     repoJamMessage.Loaded,
-		UserID:     repoJamMessage.UserID,
-		UserImages: repoJamMessage.UserImages,
-		UserNames:  repoJamMessage.UserNames,
+		Type:        repoJamMessage.Type,
+		TrackID:     repoJamMessage.TrackID,
+		Position:    repoJamMessage.Position,
+		Paused:      repoJamMessage.Paused,
+		Users:       repoJamMessage.Users,
+		HostID:      repoJamMessage.HostID,
+		Loaded:      repoJamMessage.Loaded,
+		UserID:      repoJamMessage.UserID,
+		UserImages:  repoJamMessage.UserImages,
+		UserNames:   repoJamMessage.UserNames,
+		Queue:       repoJamMessage.Queue,
+		Suggestions: JamSuggestionsFromRepositoryToUsecase(repoJamMessage.Suggestions),
+		AutoAdvance: repoJamMessage.AutoAdvance,
+		CoHosts:     repoJamMessage.CoHosts,
+		ServerTime:  repoJamMessage.ServerTime,
+		MessageID:   repoJamMessage.MessageID,
+		Text:        repoJamMessage.Text,
+		Emoji:       repoJamMessage.Emoji,
+		Chat:        JamChatFromRepositoryToUsecase(repoJamMessage.Chat),
+		Muted:       repoJamMessage.Muted,
+		Lyrics:      JamLyricsFromRepositoryToUsecase(repoJamMessage.Lyrics),
+	}
+}
+
+func JamLyricsFromUsecaseToRepository(usecaseLyrics []*usecase.TrackLyrics) []*repository.JamLyrics {
+	if usecaseLyrics == nil {
+		return nil
+	}
+	lyrics := make([]*repository.JamLyrics, 0, len(usecaseLyrics))
+	for _, usecaseLyric := range usecaseLyrics {
+		lines := make([]*repository.JamLyricsLine, 0, len(usecaseLyric.Lines))
+		for _, line := range usecaseLyric.Lines {
+			lines = append(lines, &repository.JamLyricsLine{
+				Time: line.Time,
+				Text: line.Text,
+			})
+		}
+		lyrics = append(lyrics, &repository.JamLyrics{
+			Language:    usecaseLyric.Language,
+			Translation: usecaseLyric.Translation,
+			Synced:      usecaseLyric.Synced,
+			Lines:       lines,
+		})
+	}
+	return lyrics
+}
+
+func JamLyricsFromRepositoryToUsecase(repoLyrics []*repository.JamLyrics) []*usecase.TrackLyrics {
+	if repoLyrics == nil {
+		return nil
 	}
+	lyrics := make([]*usecase.TrackLyrics, 0, len(repoLyrics))
+	for _, repoLyric := range repoLyrics {
+		lines := make([]*usecase.LyricsLine, 0, len(repoLyric.Lines))
+		for _, line := range repoLyric.Lines {
+			lines = append(lines, &usecase.LyricsLine{
+				Time: line.Time,
+				Text: line.Text,
+			})
+		}
+		lyrics = append(lyrics, &usecase.TrackLyrics{
+			Language:    repoLyric.Language,
+			Translation: repoLyric.Translation,
+			Synced:      repoLyric.Synced,
+			Lines:       lines,
+		})
+	}
+	return lyrics
 }
 
 func JamMessageFromDeliveryToUsecase(deliveryJamMessage *delivery.JamMessage) *usecase.JamMessage {
 	return &usecase.JamMessage{
-		Type:       deliveryJamMessage.Type,
-		TrackID:    deliveryJamMessage.TrackID,
-		Position:   deliveryJamMessage.Position,
-		Paused:     deliveryJamMessage.Paused,
-		Users:      deliveryJamMessage.Users,
-		HostID:     deliveryJamMessage.HostID,
-		Loaded:     deliveryJamMessage.Loaded,
-		UserImages: deliveryJamMessage.UserImages,
-		UserNames:  deliveryJamMessage.UserNames,
+		Type:        deliveryJamMessage.Type,
+		TrackID:     deliveryJamMessage.TrackID,
+		Position:    deliveryJamMessage.Position,
+		Paused:      deliveryJamMessage.Paused,
+		Users:       deliveryJamMessage.Users,
+		HostID:      deliveryJamMessage.HostID,
+		Loaded:      deliveryJamMessage.Loaded,
+		UserID:      deliveryJamMessage.UserID,
+		UserImages:  deliveryJamMessage.UserImages,
+		UserNames:   deliveryJamMessage.UserNames,
+		Queue:       deliveryJamMessage.Queue,
+		Suggestions: JamSuggestionsFromDeliveryToUsecase(deliveryJamMessage.Suggestions),
+		AutoAdvance: deliveryJamMessage.AutoAdvance,
+		CoHosts:     deliveryJamMessage.CoHosts,
+		ServerTime:  deliveryJamMessage.ServerTime,
+		MessageID:   deliveryJamMessage.MessageID,
+		Text:        deliveryJamMessage.Text,
+		Emoji:       deliveryJamMessage.Emoji,
+		Chat:        JamChatFromDeliveryToUsecase(deliveryJamMessage.Chat),
+		Muted:       deliveryJamMessage.Muted,
+	}
+}
+
+func JamSuggestionsFromRepositoryToUsecase(repoSuggestions []*repository.JamSuggestion) []*usecase.JamSuggestion {
+	if repoSuggestions == nil {
+		return nil
+	}
+	suggestions := make([]*usecase.JamSuggestion, 0, len(repoSuggestions))
+	for _, suggestion := range repoSuggestions {
+		suggestions = append(suggestions, &usecase.JamSuggestion{
+			TrackID:     suggestion.TrackID,
+			Votes:       suggestion.Votes,
+			SuggestedBy: suggestion.SuggestedBy,
+		})
+	}
+	return suggestions
+}
+
+func JamSuggestionsFromUsecaseToDelivery(usecaseSuggestions []*usecase.JamSuggestion) []*delivery.JamSuggestion {
+	if usecaseSuggestions == nil {
+		return nil
+	}
+	suggestions := make([]*delivery.JamSuggestion, 0, len(usecaseSuggestions))
+	for _, suggestion := range usecaseSuggestions {
+		suggestions = append(suggestions, &delivery.JamSuggestion{
+			TrackID:     suggestion.TrackID,
+			Votes:       suggestion.Votes,
+			SuggestedBy: suggestion.SuggestedBy,
+		})
+	}
+	return suggestions
+}
+
+func JamSuggestionsFromDeliveryToUsecase(deliverySuggestions []*delivery.JamSuggestion) []*usecase.JamSuggestion {
+	if deliverySuggestions == nil {
+		return nil
+	}
+	suggestions := make([]*usecase.JamSuggestion, 0, len(deliverySuggestions))
+	for _, suggestion := range deliverySuggestions {
+		suggestions = append(suggestions, &usecase.JamSuggestion{
+			TrackID:     suggestion.TrackID,
+			Votes:       suggestion.Votes,
+			SuggestedBy: suggestion.SuggestedBy,
+		})
+	}
+	return suggestions
+}
+
+func JamChatFromRepositoryToUsecase(repoChat []*repository.JamChatMessage) []*usecase.JamChatMessage {
+	if repoChat == nil {
+		return nil
+	}
+	chat := make([]*usecase.JamChatMessage, 0, len(repoChat))
+	for _, message := range repoChat {
+		chat = append(chat, &usecase.JamChatMessage{
+			ID:         message.ID,
+			UserID:     message.UserID,
+			Text:       message.Text,
+			ServerTime: message.ServerTime,
+		})
+	}
+	return chat
+}
+
+func JamChatFromUsecaseToDelivery(usecaseChat []*usecase.JamChatMessage) []*delivery.JamChatMessage {
+	if usecaseChat == nil {
+		return nil
+	}
+	chat := make([]*delivery.JamChatMessage, 0, len(usecaseChat))
+	for _, message := range usecaseChat {
+		chat = append(chat, &delivery.JamChatMessage{
+			ID:         message.ID,
+			UserID:     message.UserID,
+			Text:       message.Text,
+			ServerTime: message.ServerTime,
+		})
+	}
+	return chat
+}
+
+func JamChatFromDeliveryToUsecase(deliveryChat []*delivery.JamChatMessage) []*usecase.JamChatMessage {
+	if deliveryChat == nil {
+		return nil
+	}
+	chat := make([]*usecase.JamChatMessage, 0, len(deliveryChat))
+	for _, message := range deliveryChat {
+		chat = append(chat, &usecase.JamChatMessage{
+			ID:         message.ID,
+			UserID:     message.UserID,
+			Text:       message.Text,
+			ServerTime: message.ServerTime,
+		})
+	}
+	return chat
+}
+
+func JamSummaryFromRepositoryToUsecase(repoSummary *repository.JamSummary, friend bool) *usecase.JamSummary {
+	return &usecase.JamSummary{
+		RoomID:          repoSummary.RoomID,
+		HostID:          repoSummary.HostID,
+		HostName:        repoSummary.HostName,
+		HostAvatar:      repoSummary.HostAvatar,
+		HostAvatarColor: repoSummary.HostAvatarColor,
+		TrackID:         repoSummary.TrackID,
+		Paused:          repoSummary.Paused,
+		Listeners:       repoSummary.Listeners,
+		Private:         repoSummary.Private,
+		HasPassword:     repoSummary.HasPassword,
+		Friend:          friend,
+	}
+}
+
+func JamSummariesFromUsecaseToDelivery(usecaseSummaries []*usecase.JamSummary) []*delivery.JamSummary {
+	summaries := make([]*delivery.JamSummary, 0, len(usecaseSummaries))
+	for _, summary := range usecaseSummaries {
+		summaries = append(summaries, &delivery.JamSummary{
+			RoomID:      summary.RoomID,
+			HostID:      summary.HostID,
+			HostName:    summary.HostName,
+			HostAvatar:  summary.HostAvatar,
+			HostImages:  ImagesFor(summary.HostAvatar, summary.HostAvatarColor),
+			TrackID:     summary.TrackID,
+			Paused:      summary.Paused,
+			Listeners:   summary.Listeners,
+			Private:     summary.Private,
+			HasPassword: summary.HasPassword,
+			Friend:      summary.Friend,
+		})
+	}
+	return summaries
+}
+
+func JamSessionFromRepositoryToUsecase(repoSession *repository.JamSession) *usecase.JamSession {
+	return &usecase.JamSession{
+		ID:           repoSession.ID,
+		RoomID:       repoSession.RoomID,
+		HostID:       repoSession.HostID,
+		HostName:     repoSession.HostName,
+		StartedAt:    repoSession.StartedAt,
+		EndedAt:      repoSession.EndedAt,
+		Participants: repoSession.Participants,
+		Tracks:       repoSession.Tracks,
+		Duration:     repoSession.Duration,
+	}
+}
+
+func JamSessionsFromRepositoryToUsecase(repoSessions []*repository.JamSession) []*usecase.JamSession {
+	sessions := make([]*usecase.JamSession, 0, len(repoSessions))
+	for _, session := range repoSessions {
+		sessions = append(sessions, JamSessionFromRepositoryToUsecase(session))
+	}
+	return sessions
+}
+
+// JamSessionDetailsFromRepositoryToUsecase keeps participant avatars as file keys, the usecase resolves them
+func JamSessionDetailsFromRepositoryToUsecase(repoDetails *repository.JamSessionDetails) *usecase.JamSessionDetails {
+	participants := make([]*usecase.JamSessionParticipant, 0, len(repoDetails.ParticipantList))
+	for _, participant := range repoDetails.ParticipantList {
+		participants = append(participants, &usecase.JamSessionParticipant{
+			UserID:      participant.UserID,
+			Username:    participant.Username,
+			AvatarURL:   participant.Thumbnail,
+			AvatarColor: participant.ThumbnailColor,
+			JoinedAt:    participant.JoinedAt,
+		})
+	}
+
+	tracks := make([]*usecase.JamSessionTrack, 0, len(repoDetails.TrackList))
+	for _, track := range repoDetails.TrackList {
+		tracks = append(tracks, &usecase.JamSessionTrack{
+			TrackID:        track.TrackID,
+			Title:          track.Title,
+			Thumbnail:      track.Thumbnail,
+			ThumbnailColor: track.ThumbnailColor,
+			Duration:       track.Duration,
+			PlayedAt:       track.PlayedAt,
+		})
+	}
+
+	return &usecase.JamSessionDetails{
+		JamSession:      *JamSessionFromRepositoryToUsecase(&repoDetails.JamSession),
+		ParticipantList: participants,
+		TrackList:       tracks,
+	}
+}
+
+func JamSessionFromUsecaseToDelivery(usecaseSession *usecase.JamSession) *delivery.JamSession {
+	return &delivery.JamSession{
+		ID:           usecaseSession.ID,
+		RoomID:       usecaseSession.RoomID,
+		HostID:       usecaseSession.HostID,
+		HostName:     usecaseSession.HostName,
+		StartedAt:    usecaseSession.StartedAt,
+		EndedAt:      usecaseSession.EndedAt,
+		Participants: usecaseSession.Participants,
+		Tracks:       usecaseSession.Tracks,
+		Duration:     usecaseSession.Duration,
+	}
+}
+
+func JamSessionsFromUsecaseToDelivery(usecaseSessions []*usecase.JamSession) []*delivery.JamSession {
+	sessions := make([]*delivery.JamSession, 0, len(usecaseSessions))
+	for _, session := range usecaseSessions {
+		sessions = append(sessions, JamSessionFromUsecaseToDelivery(session))
+	}
+	return sessions
+}
+
+func JamSessionDetailsFromUsecaseToDelivery(usecaseDetails *usecase.JamSessionDetails) *delivery.JamSessionDetails {
+	participants := make([]*delivery.JamSessionParticipant, 0, len(usecaseDetails.ParticipantList))
+	for _, participant := range usecaseDetails.ParticipantList {
+		participants = append(participants, &delivery.JamSessionParticipant{
+			UserID:    participant.UserID,
+			Username:  participant.Username,
+			AvatarURL: participant.AvatarURL,
+			Images:    ImagesFor(participant.AvatarURL, participant.AvatarColor),
+			JoinedAt:  participant.JoinedAt,
+		})
+	}
+
+	tracks := make([]*delivery.JamSessionTrack, 0, len(usecaseDetails.TrackList))
+	for _, track := range usecaseDetails.TrackList {
+		tracks = append(tracks, &delivery.JamSessionTrack{
+			TrackID:   track.TrackID,
+			Title:     track.Title,
+			Thumbnail: track.Thumbnail,
+			Images:    ImagesFor(track.Thumbnail, track.ThumbnailColor),
+			Duration:  track.Duration,
+			PlayedAt:  track.PlayedAt,
+		})
+	}
+
+	return &delivery.JamSessionDetails{
+		JamSession:      *JamSessionFromUsecaseToDelivery(&usecaseDetails.JamSession),
+		ParticipantList: participants,
+		TrackList:       tracks,
+	}
+}
+
+func CreateJamPlaylistRequestFromDeliveryToUsecase(request *delivery.CreateJamPlaylistRequest, userID int64, sessionID int64) *usecase.CreateJamPlaylistRequest {
+	return &usecase.CreateJamPlaylistRequest{
+		UserID:    userID,
+		SessionID: sessionID,
+		Title:     request.Title,
+	}
+}
+
+///////////////////////////////////// UPLOADS ////////////////////////////////////
+
+func CreateUploadRequestFromDeliveryToUsecase(request *delivery.CreateUploadRequest, labelID int64) *usecase.CreateUploadRequest {
+	return &usecase.CreateUploadRequest{
+		LabelID:     labelID,
+		Kind:        request.Kind,
+		Size:        request.Size,
+		ContentType: request.ContentType,
+	}
+}
+
+func UploadFromUsecaseToDelivery(usecaseUpload *usecase.Upload) *delivery.Upload {
+	uploadedParts := usecaseUpload.UploadedParts
+	if uploadedParts == nil {
+		uploadedParts = []int64{}
+	}
+	return &delivery.Upload{
+		ID:            usecaseUpload.ID,
+		Kind:          usecaseUpload.Kind,
+		Size:          usecaseUpload.Size,
+		PartSize:      usecaseUpload.PartSize,
+		PartsCount:    usecaseUpload.PartsCount,
+		Status:        usecaseUpload.Status,
+		UploadedParts: uploadedParts,
+	}
+}
+
+func UploadPartsFromUsecaseToDelivery(usecaseParts []*usecase.UploadPart) []*delivery.UploadPart {
+	parts := make([]*delivery.UploadPart, 0, len(usecaseParts))
+	for _, part := range usecaseParts {
+		parts = append(parts, &delivery.UploadPart{
+			Number: part.Number,
+			URL:    part.URL,
+		})
+	}
+	return parts
+}
+
+///////////////////////////////////// SEARCH ////////////////////////////////////
+
+func SearchResultFromUsecaseToDelivery(usecaseResult *usecase.SearchResult) *delivery.SearchResult {
+	return &delivery.SearchResult{
+		Query:           usecaseResult.Query,
+		LayoutCorrected: usecaseResult.LayoutCorrected,
+		Tracks:          TracksFromUsecaseToDelivery(usecaseResult.Tracks),
+		Albums:          AlbumsFromUsecaseToDelivery(usecaseResult.Albums),
+		Artists:         ArtistsFromUsecaseToDelivery(usecaseResult.Artists),
+		Playlists:       PlaylistsFromUsecaseToDelivery(usecaseResult.Playlists),
+	}
+}
+
+func SuggestResultFromUsecaseToDelivery(usecaseResult *usecase.SuggestResult) *delivery.SuggestResult {
+	suggestions := make([]*delivery.Suggestion, 0, len(usecaseResult.Suggestions))
+	for _, suggestion := range usecaseResult.Suggestions {
+		suggestions = append(suggestions, &delivery.Suggestion{
+			Type:      string(suggestion.Type),
+			ID:        suggestion.ID,
+			Title:     suggestion.Title,
+			Thumbnail: suggestion.Thumbnail,
+			Images:    ImagesFor(suggestion.Thumbnail, suggestion.ThumbnailColor),
+		})
+	}
+	return &delivery.SuggestResult{
+		Query:           usecaseResult.Query,
+		LayoutCorrected: usecaseResult.LayoutCorrected,
+		Suggestions:     suggestions,
+	}
+}
+
+func SuggestResultFromUsecaseToRepository(usecaseResult *usecase.SuggestResult) *repository.SuggestResult {
+	suggestions := make([]*repository.Suggestion, 0, len(usecaseResult.Suggestions))
+	for _, suggestion := range usecaseResult.Suggestions {
+		suggestions = append(suggestions, &repository.Suggestion{
+			Type:           string(suggestion.Type),
+			ID:             suggestion.ID,
+			Title:          suggestion.Title,
+			Thumbnail:      suggestion.Thumbnail,
+			ThumbnailColor: suggestion.ThumbnailColor,
+		})
+	}
+	return &repository.SuggestResult{
+		Query:           usecaseResult.Query,
+		LayoutCorrected: usecaseResult.LayoutCorrected,
+		Suggestions:     suggestions,
+	}
+}
+
+func SuggestResultFromRepositoryToUsecase(repositoryResult *repository.SuggestResult) *usecase.SuggestResult {
+	suggestions := make([]*usecase.Suggestion, 0, len(repositoryResult.Suggestions))
+	for _, suggestion := range repositoryResult.Suggestions {
+		suggestions = append(suggestions, &usecase.Suggestion{
+			Type:           usecase.SearchType(suggestion.Type),
+			ID:             suggestion.ID,
+			Title:          suggestion.Title,
+			Thumbnail:      suggestion.Thumbnail,
+			ThumbnailColor: suggestion.ThumbnailColor,
+		})
+	}
+	return &usecase.SuggestResult{
+		Query:           repositoryResult.Query,
+		LayoutCorrected: repositoryResult.LayoutCorrected,
+		Suggestions:     suggestions,
+	}
+}
+
+func SearchClickFromDeliveryToUsecase(deliveryClick *delivery.SearchClick) *usecase.SearchClick {
+	return &usecase.SearchClick{
+		Query: deliveryClick.Query,
+		Type:  usecase.SearchType(deliveryClick.Type),
+		ID:    deliveryClick.ID,
+	}
+}
+
+func SearchResultStatsFromRepositoryToUsecase(repositoryStats []*repository.SearchResultStat) []*usecase.SearchResultStat {
+	stats := make([]*usecase.SearchResultStat, 0, len(repositoryStats))
+	for _, stat := range repositoryStats {
+		stats = append(stats, &usecase.SearchResultStat{
+			Type:   usecase.SearchType(stat.Type),
+			ID:     stat.ID,
+			Clicks: stat.Clicks,
+		})
+	}
+	return stats
+}
+
+func SearchQueryStatsFromRepositoryToUsecase(repositoryStats []*repository.SearchQueryStat) []*usecase.SearchQueryStat {
+	stats := make([]*usecase.SearchQueryStat, 0, len(repositoryStats))
+	for _, stat := range repositoryStats {
+		var clickThroughRate float64
+		if stat.Count > 0 {
+			clickThroughRate = float64(stat.Clicks) / float64(stat.Count)
+		}
+		stats = append(stats, &usecase.SearchQueryStat{
+			Query:            stat.Query,
+			Count:            stat.Count,
+			Clicks:           stat.Clicks,
+			ClickThroughRate: clickThroughRate,
+			TopResults:       SearchResultStatsFromRepositoryToUsecase(stat.TopResults),
+		})
+	}
+	return stats
+}
+
+func SearchAnalyticsFromRepositoryToUsecase(repositoryAnalytics *repository.SearchAnalytics) *usecase.SearchAnalytics {
+	var clickThroughRate float64
+	if repositoryAnalytics.TotalSearches > 0 {
+		clickThroughRate = float64(repositoryAnalytics.TotalClicks) / float64(repositoryAnalytics.TotalSearches)
+	}
+	return &usecase.SearchAnalytics{
+		TotalSearches:     repositoryAnalytics.TotalSearches,
+		TotalClicks:       repositoryAnalytics.TotalClicks,
+		ClickThroughRate:  clickThroughRate,
+		TopQueries:        SearchQueryStatsFromRepositoryToUsecase(repositoryAnalytics.TopQueries),
+		ZeroResultQueries: SearchQueryStatsFromRepositoryToUsecase(repositoryAnalytics.ZeroResultQueries),
+	}
+}
+
+func SearchResultStatsFromUsecaseToDelivery(usecaseStats []*usecase.SearchResultStat) []*delivery.SearchResultStat {
+	stats := make([]*delivery.SearchResultStat, 0, len(usecaseStats))
+	for _, stat := range usecaseStats {
+		stats = append(stats, &delivery.SearchResultStat{
+			Type:   string(stat.Type),
+			ID:     stat.ID,
+			Clicks: stat.Clicks,
+		})
+	}
+	return stats
+}
+
+func SearchQueryStatsFromUsecaseToDelivery(usecaseStats []*usecase.SearchQueryStat) []*delivery.SearchQueryStat {
+	stats := make([]*delivery.SearchQueryStat, 0, len(usecaseStats))
+	for _, stat := range usecaseStats {
+		stats = append(stats, &delivery.SearchQueryStat{
+			Query:            stat.Query,
+			Count:            stat.Count,
+			Clicks:           stat.Clicks,
+			ClickThroughRate: stat.ClickThroughRate,
+			TopResults:       SearchResultStatsFromUsecaseToDelivery(stat.TopResults),
+		})
+	}
+	return stats
+}
+
+func SearchAnalyticsFromUsecaseToDelivery(usecaseAnalytics *usecase.SearchAnalytics) *delivery.SearchAnalytics {
+	return &delivery.SearchAnalytics{
+		TotalSearches:     usecaseAnalytics.TotalSearches,
+		TotalClicks:       usecaseAnalytics.TotalClicks,
+		ClickThroughRate:  usecaseAnalytics.ClickThroughRate,
+		TopQueries:        SearchQueryStatsFromUsecaseToDelivery(usecaseAnalytics.TopQueries),
+		ZeroResultQueries: SearchQueryStatsFromUsecaseToDelivery(usecaseAnalytics.ZeroResultQueries),
+	}
+}
+
+func FollowRequestFromUsecaseToProto(followerID int64, followeeID int64) *userProto.FollowRequest {
+	return &userProto.FollowRequest{
+		FollowerId: followerID,
+		FolloweeId: followeeID,
+	}
+}
+
+func UserIDWithPaginationFromUsecaseToProto(userID int64, pagination *usecase.Pagination) *userProto.UserIDWithPagination {
+	return &userProto.UserIDWithPagination{
+		UserId: userID,
+		Pagination: &userProto.Pagination{
+			Offset: int64(pagination.Offset),
+			Limit:  int64(pagination.Limit),
+		},
+	}
+}
+
+func UserPreviewFromUsecaseToDelivery(user *usecase.User) *delivery.UserPreview {
+	return &delivery.UserPreview{
+		Username:  user.Username,
+		AvatarUrl: user.AvatarUrl,
+		Images:    ImagesFor(user.AvatarUrl, user.AvatarColor),
+	}
+}
+
+func UserPreviewListFromUsecaseToDelivery(users []*usecase.User) []*delivery.UserPreview {
+	previews := make([]*delivery.UserPreview, 0, len(users))
+	for _, user := range users {
+		previews = append(previews, UserPreviewFromUsecaseToDelivery(user))
+	}
+	return previews
+}
+
+func ActivityFromProtoToUsecase(protoActivity *userProto.Activity) *usecase.Activity {
+	return &usecase.Activity{
+		Type: protoActivity.Type,
+		User: &usecase.User{
+			ID:          protoActivity.User.Id,
+			Username:    protoActivity.User.Username,
+			AvatarUrl:   protoActivity.User.Avatar,
+			AvatarColor: protoActivity.User.AvatarColor,
+		},
+		EntityID:             protoActivity.EntityId,
+		EntityTitle:          protoActivity.EntityTitle,
+		EntityThumbnail:      protoActivity.EntityThumbnail,
+		EntityThumbnailColor: protoActivity.EntityThumbnailColor,
+		CreatedAt:            protoActivity.CreatedAt.AsTime(),
+	}
+}
+
+func ActivityListFromProtoToUsecase(protoActivityList *userProto.ActivityList) []*usecase.Activity {
+	activities := make([]*usecase.Activity, 0, len(protoActivityList.Activities))
+	for _, protoActivity := range protoActivityList.Activities {
+		activities = append(activities, ActivityFromProtoToUsecase(protoActivity))
+	}
+	return activities
+}
+
+func ActivityFromUsecaseToDelivery(activity *usecase.Activity) *delivery.Activity {
+	return &delivery.Activity{
+		Type:            activity.Type,
+		User:            UserPreviewFromUsecaseToDelivery(activity.User),
+		EntityID:        activity.EntityID,
+		EntityTitle:     activity.EntityTitle,
+		EntityThumbnail: activity.EntityThumbnail,
+		EntityImages:    ImagesFor(activity.EntityThumbnail, activity.EntityThumbnailColor),
+		CreatedAt:       activity.CreatedAt,
+	}
+}
+
+func ActivityListFromUsecaseToDelivery(activities []*usecase.Activity) []*delivery.Activity {
+	deliveryActivities := make([]*delivery.Activity, 0, len(activities))
+	for _, activity := range activities {
+		deliveryActivities = append(deliveryActivities, ActivityFromUsecaseToDelivery(activity))
+	}
+	return deliveryActivities
+}
+
+func NotificationFromRepositoryToUsecase(repositoryNotification *repository.Notification) *usecase.Notification {
+	return &usecase.Notification{
+		ID:                   repositoryNotification.ID,
+		UserID:               repositoryNotification.UserID,
+		Type:                 usecase.NotificationType(repositoryNotification.Type),
+		ActorName:            repositoryNotification.ActorName,
+		EntityID:             repositoryNotification.EntityID,
+		EntityTitle:          repositoryNotification.EntityTitle,
+		EntityThumbnail:      repositoryNotification.EntityThumbnail,
+		EntityThumbnailColor: repositoryNotification.EntityThumbnailColor,
+		IsRead:               repositoryNotification.IsRead,
+		CreatedAt:            repositoryNotification.CreatedAt,
+	}
+}
+
+func NotificationsFromRepositoryToUsecase(repositoryNotifications []*repository.Notification) []*usecase.Notification {
+	notifications := make([]*usecase.Notification, 0, len(repositoryNotifications))
+	for _, notification := range repositoryNotifications {
+		notifications = append(notifications, NotificationFromRepositoryToUsecase(notification))
+	}
+	return notifications
+}
+
+func NotificationFromUsecaseToDelivery(usecaseNotification *usecase.Notification) *delivery.Notification {
+	return &delivery.Notification{
+		ID:              usecaseNotification.ID,
+		Type:            string(usecaseNotification.Type),
+		ActorName:       usecaseNotification.ActorName,
+		EntityID:        usecaseNotification.EntityID,
+		EntityTitle:     usecaseNotification.EntityTitle,
+		EntityThumbnail: usecaseNotification.EntityThumbnail,
+		EntityImages:    ImagesFor(usecaseNotification.EntityThumbnail, usecaseNotification.EntityThumbnailColor),
+		IsRead:          usecaseNotification.IsRead,
+		CreatedAt:       usecaseNotification.CreatedAt,
+	}
+}
+
+func NotificationsFromUsecaseToDelivery(usecaseNotifications []*usecase.Notification) []*delivery.Notification {
+	notifications := make([]*delivery.Notification, 0, len(usecaseNotifications))
+	for _, notification := range usecaseNotifications {
+		notifications = append(notifications, NotificationFromUsecaseToDelivery(notification))
+	}
+	return notifications
+}
+
+func NotificationPreferencesFromRepositoryToUsecase(repositoryPreferences []*repository.NotificationPreference) []*usecase.NotificationPreference {
+	preferences := make([]*usecase.NotificationPreference, 0, len(repositoryPreferences))
+	for _, preference := range repositoryPreferences {
+		preferences = append(preferences, &usecase.NotificationPreference{
+			Type:      usecase.NotificationType(preference.Type),
+			IsEnabled: preference.IsEnabled,
+		})
+	}
+	return preferences
+}
+
+func NotificationPreferencesFromUsecaseToRepository(usecasePreferences []*usecase.NotificationPreference) []*repository.NotificationPreference {
+	preferences := make([]*repository.NotificationPreference, 0, len(usecasePreferences))
+	for _, preference := range usecasePreferences {
+		preferences = append(preferences, &repository.NotificationPreference{
+			Type:      string(preference.Type),
+			IsEnabled: preference.IsEnabled,
+		})
+	}
+	return preferences
+}
+
+func NotificationPreferencesFromUsecaseToDelivery(usecasePreferences []*usecase.NotificationPreference) *delivery.NotificationPreferences {
+	preferences := make([]*delivery.NotificationPreference, 0, len(usecasePreferences))
+	for _, preference := range usecasePreferences {
+		preferences = append(preferences, &delivery.NotificationPreference{
+			Type:      string(preference.Type),
+			IsEnabled: preference.IsEnabled,
+		})
+	}
+	return &delivery.NotificationPreferences{Preferences: preferences}
+}
+
+func NotificationPreferencesFromDeliveryToUsecase(deliveryPreferences *delivery.NotificationPreferences) []*usecase.NotificationPreference {
+	preferences := make([]*usecase.NotificationPreference, 0, len(deliveryPreferences.Preferences))
+	for _, preference := range deliveryPreferences.Preferences {
+		preferences = append(preferences, &usecase.NotificationPreference{
+			Type:      usecase.NotificationType(preference.Type),
+			IsEnabled: preference.IsEnabled,
+		})
+	}
+	return preferences
+}
+
+func ReleasesFromRepositoryToUsecase(repositoryReleases []*repository.Release) []*usecase.Release {
+	releases := make([]*usecase.Release, 0, len(repositoryReleases))
+	for _, release := range repositoryReleases {
+		releases = append(releases, &usecase.Release{
+			Type:           usecase.ReleaseType(release.Type),
+			ID:             release.ID,
+			Title:          release.Title,
+			Thumbnail:      release.Thumbnail,
+			ThumbnailColor: release.ThumbnailColor,
+			ArtistNames:    release.ArtistNames,
+			AlbumID:        release.AlbumID,
+			CreatedAt:      release.CreatedAt,
+		})
+	}
+	return releases
+}
+
+func ReleasesFromUsecaseToDelivery(usecaseReleases []*usecase.Release) []*delivery.Release {
+	releases := make([]*delivery.Release, 0, len(usecaseReleases))
+	for _, release := range usecaseReleases {
+		releases = append(releases, &delivery.Release{
+			Type:        string(release.Type),
+			ID:          release.ID,
+			Title:       release.Title,
+			Thumbnail:   release.Thumbnail,
+			Images:      ImagesFor(release.Thumbnail, release.ThumbnailColor),
+			ArtistNames: release.ArtistNames,
+			AlbumID:     release.AlbumID,
+			CreatedAt:   release.CreatedAt,
+		})
+	}
+	return releases
+}
+
+func ArtistIDListFromUsecaseToProto(ids []int64) *artistProto.ArtistIDList {
+	artistIDs := make([]*artistProto.ArtistID, 0, len(ids))
+	for _, id := range ids {
+		artistIDs = append(artistIDs, &artistProto.ArtistID{Id: id})
+	}
+	return &artistProto.ArtistIDList{Ids: artistIDs}
+}
+
+func TrackArtistRolesFromUsecaseToProto(artists []*usecase.TrackArtistRole) []*artistProto.ArtistRole {
+	roles := make([]*artistProto.ArtistRole, 0, len(artists))
+	for _, artist := range artists {
+		roles = append(roles, &artistProto.ArtistRole{
+			ArtistId: &artistProto.ArtistID{Id: artist.ArtistID},
+			Role:     artist.Role,
+		})
+	}
+	return roles
+}
+
+///////////////////////////////////// DUPLICATES ////////////////////////////////////
+
+func DuplicateFlagTrackFromProtoToUsecase(track *trackProto.DuplicateFlagTrack) usecase.DuplicateFlagTrack {
+	return usecase.DuplicateFlagTrack{
+		ID:      track.GetId().GetId(),
+		Title:   track.GetTitle(),
+		AlbumID: track.GetAlbumId().GetId(),
+		LabelID: track.GetLabelId(),
+	}
+}
+
+func DuplicateFlagsFromProtoToUsecase(protoFlags *trackProto.DuplicateFlagList) []*usecase.DuplicateFlag {
+	flags := make([]*usecase.DuplicateFlag, 0, len(protoFlags.Flags))
+	for _, flag := range protoFlags.Flags {
+		flags = append(flags, &usecase.DuplicateFlag{
+			ID:           flag.Id,
+			Track:        DuplicateFlagTrackFromProtoToUsecase(flag.Track),
+			MatchedTrack: DuplicateFlagTrackFromProtoToUsecase(flag.MatchedTrack),
+			Similarity:   flag.Similarity,
+			Status:       flag.Status,
+			CreatedAt:    flag.CreatedAt.AsTime(),
+		})
+	}
+	return flags
+}
+
+func DuplicateFlagTrackFromUsecaseToDelivery(track usecase.DuplicateFlagTrack) delivery.DuplicateFlagTrack {
+	return delivery.DuplicateFlagTrack{
+		ID:      track.ID,
+		Title:   track.Title,
+		AlbumID: track.AlbumID,
+		LabelID: track.LabelID,
+	}
+}
+
+func DuplicateFlagsFromUsecaseToDelivery(flags []*usecase.DuplicateFlag) []*delivery.DuplicateFlag {
+	deliveryFlags := make([]*delivery.DuplicateFlag, 0, len(flags))
+	for _, flag := range flags {
+		deliveryFlags = append(deliveryFlags, &delivery.DuplicateFlag{
+			ID:           flag.ID,
+			Track:        DuplicateFlagTrackFromUsecaseToDelivery(flag.Track),
+			MatchedTrack: DuplicateFlagTrackFromUsecaseToDelivery(flag.MatchedTrack),
+			Similarity:   flag.Similarity,
+			Status:       flag.Status,
+			CreatedAt:    flag.CreatedAt,
+		})
+	}
+	return deliveryFlags
+}
+
+///////////////////////////////////// TRACK METADATA ////////////////////////////////////
+
+func TrackMetadataFromProtoToUsecase(uploadID string, protoMetadata *trackProto.TrackMetadata) *usecase.TrackMetadata {
+	return &usecase.TrackMetadata{
+		UploadID:    uploadID,
+		Format:      protoMetadata.Format,
+		Title:       protoMetadata.Title,
+		TrackNumber: protoMetadata.TrackNumber,
+		Artists:     protoMetadata.Artists,
+		Genre:       protoMetadata.Genre,
+		Year:        protoMetadata.Year,
+		ISRC:        protoMetadata.Isrc,
+		CoverKey:    protoMetadata.CoverKey,
+		CoverURL:    protoMetadata.CoverUrl,
+	}
+}
+
+func TrackMetadataListFromUsecaseToDelivery(metadataList []*usecase.TrackMetadata) []*delivery.TrackMetadata {
+	deliveryMetadata := make([]*delivery.TrackMetadata, 0, len(metadataList))
+	for _, metadata := range metadataList {
+		deliveryMetadata = append(deliveryMetadata, &delivery.TrackMetadata{
+			UploadID:    metadata.UploadID,
+			Format:      metadata.Format,
+			Title:       metadata.Title,
+			TrackNumber: metadata.TrackNumber,
+			Artists:     metadata.Artists,
+			ArtistIDs:   metadata.ArtistIDs,
+			Genre:       metadata.Genre,
+			Year:        metadata.Year,
+			ISRC:        metadata.ISRC,
+			CoverURL:    metadata.CoverURL,
+		})
 	}
+	return deliveryMetadata
 }
@@ -551,6 +551,7 @@ func TestTrackFromProtoToUsecase(t *testing.T) {
 		Thumbnail:  "track.svg",
 		Duration:   200,
 		AlbumId:    2,
+		Bpm:        124.5,
 		IsFavorite: true,
 	}
 	protoAlbum := &albumProto.AlbumTitle{
@@ -571,6 +572,7 @@ func TestTrackFromProtoToUsecase(t *testing.T) {
 	assert.Equal(t, protoTrack.Duration, ucTrack.Duration)
 	assert.Equal(t, protoTrack.AlbumId, ucTrack.AlbumID)
 	assert.Equal(t, protoAlbum.Title, ucTrack.Album)
+	assert.Equal(t, protoTrack.Bpm, ucTrack.BPM)
 	assert.Equal(t, protoTrack.IsFavorite, ucTrack.IsLiked)
 	assert.Len(t, ucTrack.Artists, 2)
 	assert.Equal(t, protoArtists.Artists[0].Id, ucTrack.Artists[0].ID)
@@ -1209,9 +1211,10 @@ func TestAvatarDataFromUsecaseToProto(t *testing.T) {
 	fileURL := mockAvatarURL
 	userID := mockUserID12345
 
-	protoAvatarData := model.AvatarDataFromUsecaseToProto(fileURL, userID)
+	protoAvatarData := model.AvatarDataFromUsecaseToProto(fileURL, "#a1b2c3", userID)
 
 	assert.Equal(t, fileURL, protoAvatarData.AvatarPath)
+	assert.Equal(t, "#a1b2c3", protoAvatarData.AvatarColor)
 	assert.Equal(t, userID, protoAvatarData.Id)
 }
 
@@ -1398,11 +1401,12 @@ func TestCreatePlaylistRequestFromUsecaseToProto(t *testing.T) {
 	thumbnail := mockThumbnail
 	isPublic := true
 
-	protoRequest := model.CreatePlaylistRequestFromUsecaseToProto(ucRequest, thumbnail, isPublic)
+	protoRequest := model.CreatePlaylistRequestFromUsecaseToProto(ucRequest, thumbnail, "#a1b2c3", isPublic)
 
 	assert.Equal(t, ucRequest.Title, protoRequest.Title)
 	assert.Equal(t, ucRequest.UserID, protoRequest.UserId)
 	assert.Equal(t, thumbnail, protoRequest.Thumbnail)
+	assert.Equal(t, "#a1b2c3", protoRequest.ThumbnailColor)
 	assert.Equal(t, isPublic, protoRequest.IsPublic)
 }
 
@@ -1482,11 +1486,12 @@ func TestUpdatePlaylistRequestFromUsecaseToProto(t *testing.T) {
 	}
 	thumbnail := "updated-thumbnail.jpg"
 
-	protoRequest := model.UpdatePlaylistRequestFromUsecaseToProto(ucRequest, thumbnail)
+	protoRequest := model.UpdatePlaylistRequestFromUsecaseToProto(ucRequest, thumbnail, "#a1b2c3")
 
 	assert.Equal(t, ucRequest.PlaylistID, protoRequest.Id)
 	assert.Equal(t, ucRequest.Title, protoRequest.Title)
 	assert.Equal(t, thumbnail, protoRequest.Thumbnail)
+	assert.Equal(t, "#a1b2c3", protoRequest.ThumbnailColor)
 	assert.Equal(t, ucRequest.UserID, protoRequest.UserId)
 }
 
@@ -1665,49 +1670,49 @@ func TestAlbumFromProtoToUsecaseEdgeCases(t *testing.T) {
 
 func TestTrackRequestFromDeliveryToUsecase(t *testing.T) {
 	deliveryTrack := &delivery.CreateTrackRequest{
-		Title: mockTrackTitle,
-		Track: []byte("track-data"),
+		Title:    mockTrackTitle,
+		UploadID: "track-upload",
 	}
 
 	ucTrack := model.TrackRequestFromDeliveryToUsecase(deliveryTrack)
 
 	assert.Equal(t, deliveryTrack.Title, ucTrack.Title)
-	assert.Equal(t, deliveryTrack.Track, ucTrack.Track)
+	assert.Equal(t, deliveryTrack.UploadID, ucTrack.UploadID)
 }
 
 func TestNewAlbumFromDeliveryToUsecase(t *testing.T) {
 	deliveryTracks := []*delivery.CreateTrackRequest{
 		{
-			Title: "Track 1",
-			Track: []byte("track1-data"),
+			Title:    "Track 1",
+			UploadID: "track1-upload",
 		},
 		{
-			Title: "Track 2",
-			Track: []byte("track2-data"),
+			Title:    "Track 2",
+			UploadID: "track2-upload",
 		},
 	}
 
 	deliveryAlbum := &delivery.CreateAlbumRequest{
-		Title:      mockAlbumTitle,
-		Image:      []byte("album-image"),
-		Type:       "album",
-		LabelID:    1,
-		Tracks:     deliveryTracks,
-		ArtistsIDs: []int64{1, 2},
+		Title:         mockAlbumTitle,
+		CoverUploadID: "cover-upload",
+		Type:          "album",
+		LabelID:       1,
+		Tracks:        deliveryTracks,
+		ArtistsIDs:    []int64{1, 2},
 	}
 
 	ucAlbum := model.NewAlbumFromDeliveryToUsecase(deliveryAlbum)
 
 	assert.Equal(t, deliveryAlbum.Title, ucAlbum.Title)
-	assert.Equal(t, deliveryAlbum.Image, ucAlbum.Image)
+	assert.Equal(t, deliveryAlbum.CoverUploadID, ucAlbum.CoverUploadID)
 	assert.Equal(t, deliveryAlbum.Type, ucAlbum.Type)
 	assert.Equal(t, deliveryAlbum.LabelID, ucAlbum.LabelID)
 	assert.Equal(t, deliveryAlbum.ArtistsIDs, ucAlbum.ArtistsIDs)
 	assert.Len(t, ucAlbum.Tracks, 2)
 	assert.Equal(t, deliveryTracks[0].Title, ucAlbum.Tracks[0].Title)
-	assert.Equal(t, deliveryTracks[0].Track, ucAlbum.Tracks[0].Track)
+	assert.Equal(t, deliveryTracks[0].UploadID, ucAlbum.Tracks[0].UploadID)
 	assert.Equal(t, deliveryTracks[1].Title, ucAlbum.Tracks[1].Title)
-	assert.Equal(t, deliveryTracks[1].Track, ucAlbum.Tracks[1].Track)
+	assert.Equal(t, deliveryTracks[1].UploadID, ucAlbum.Tracks[1].UploadID)
 }
 
 func TestAlbumTypeConverter(t *testing.T) {
@@ -1912,25 +1917,26 @@ func TestArtistEditFromDeliveryToUsecase(t *testing.T) {
 
 func TestTrackLoadFromUsecaseToProto(t *testing.T) {
 	ucTrack := &usecase.CreateTrackRequest{
-		Title: mockTrackTitle,
-		Track: []byte("track-data"),
+		Title:    mockTrackTitle,
+		UploadID: "track-upload",
+		FileKey:  "track-upload.mp3",
 	}
 
 	protoTrack := model.TrackLoadFromUsecaseToProto(ucTrack)
 
 	assert.Equal(t, ucTrack.Title, protoTrack.Title)
-	assert.Equal(t, ucTrack.Track, protoTrack.File)
+	assert.Equal(t, ucTrack.FileKey, protoTrack.FileKey)
 }
 
 func TestTrackListLoadFromUsecaseToProto(t *testing.T) {
 	ucTracks := []*usecase.CreateTrackRequest{
 		{
-			Title: "Track 1",
-			Track: []byte("track1-data"),
+			Title:   "Track 1",
+			FileKey: "track1-upload.mp3",
 		},
 		{
-			Title: "Track 2",
-			Track: []byte("track2-data"),
+			Title:   "Track 2",
+			FileKey: "track2-upload.mp3",
 		},
 	}
 
@@ -1938,9 +1944,9 @@ func TestTrackListLoadFromUsecaseToProto(t *testing.T) {
 
 	assert.Len(t, protoTracks, 2)
 	assert.Equal(t, ucTracks[0].Title, protoTracks[0].Title)
-	assert.Equal(t, ucTracks[0].Track, protoTracks[0].File)
+	assert.Equal(t, ucTracks[0].FileKey, protoTracks[0].FileKey)
 	assert.Equal(t, ucTracks[1].Title, protoTracks[1].Title)
-	assert.Equal(t, ucTracks[1].Track, protoTracks[1].File)
+	assert.Equal(t, ucTracks[1].FileKey, protoTracks[1].FileKey)
 }
 
 func TestTracksIdsFromProtoToUsecase(t *testing.T) {
@@ -1973,20 +1979,25 @@ func TestTracksIdsFromUsecaseToProtoArtist(t *testing.T) {
 
 func TestCreateJamResponseFromUsecaseToDelivery(t *testing.T) {
 	ucCreateJam := &usecase.CreateJamResponse{
-		RoomID: "room123",
-		HostID: "host456",
+		RoomID:      "room123",
+		HostID:      "host456",
+		InviteToken: "token",
 	}
 
 	deliveryCreateJam := model.CreateJamResponseFromUsecaseToDelivery(ucCreateJam)
 
 	assert.Equal(t, ucCreateJam.RoomID, deliveryCreateJam.RoomID)
 	assert.Equal(t, ucCreateJam.HostID, deliveryCreateJam.HostID)
+	assert.Equal(t, ucCreateJam.InviteToken, deliveryCreateJam.InviteToken)
 }
 
 func TestCreateJamRequestFromDeliveryToUsecase(t *testing.T) {
 	deliveryRequest := &delivery.CreateJamRequest{
-		TrackID:  "track123",
-		Position: 100,
+		TrackID:      "track123",
+		Position:     100,
+		Private:      true,
+		Password:     "secret",
+		MaxListeners: 5,
 	}
 
 	ucRequest := model.CreateJamRequestFromDeliveryToUsecase(deliveryRequest, "user123")
@@ -1994,6 +2005,9 @@ func TestCreateJamRequestFromDeliveryToUsecase(t *testing.T) {
 	assert.Equal(t, "user123", ucRequest.UserID)
 	assert.Equal(t, deliveryRequest.TrackID, ucRequest.TrackID)
 	assert.Equal(t, deliveryRequest.Position, ucRequest.Position)
+	assert.Equal(t, deliveryRequest.Private, ucRequest.Private)
+	assert.Equal(t, deliveryRequest.Password, ucRequest.Password)
+	assert.Equal(t, deliveryRequest.MaxListeners, ucRequest.MaxListeners)
 }
 
 func TestJamMessageFromUsecaseToDelivery(t *testing.T) {
@@ -2008,6 +2022,19 @@ func TestJamMessageFromUsecaseToDelivery(t *testing.T) {
 		Loaded:     map[string]bool{"user1": true, "user2": false},
 		UserImages: map[string]string{"user1": "image1", "user2": "image2"},
 		UserNames:  map[string]string{"user1": "name1", "user2": "name2"},
+		ServerTime: 1700000000000,
+		MessageID:  "msg1",
+		Text:       "hi",
+		Emoji:      "🔥",
+		Muted:      []string{"user2"},
+		Chat: []*usecase.JamChatMessage{
+			{ID: "msg1", UserID: "user1", Text: "hi", ServerTime: 1700000000000},
+		},
+		Queue: []string{"track1"},
+		Suggestions: []*usecase.JamSuggestion{
+			{TrackID: "track2", Votes: 3, SuggestedBy: "user2"},
+		},
+		AutoAdvance: true,
 	}
 
 	deliveryJamMessage := model.JamMessageFromUsecaseToDelivery(ucJamMessage)
@@ -2022,6 +2049,20 @@ func TestJamMessageFromUsecaseToDelivery(t *testing.T) {
 	assert.Equal(t, ucJamMessage.Loaded, deliveryJamMessage.Loaded)
 	assert.Equal(t, ucJamMessage.UserImages, deliveryJamMessage.UserImages)
 	assert.Equal(t, ucJamMessage.UserNames, deliveryJamMessage.UserNames)
+	assert.Equal(t, ucJamMessage.ServerTime, deliveryJamMessage.ServerTime)
+	assert.Equal(t, ucJamMessage.Text, deliveryJamMessage.Text)
+	assert.Equal(t, ucJamMessage.Emoji, deliveryJamMessage.Emoji)
+	assert.Equal(t, ucJamMessage.MessageID, deliveryJamMessage.MessageID)
+	assert.Equal(t, ucJamMessage.Muted, deliveryJamMessage.Muted)
+	assert.Len(t, deliveryJamMessage.Chat, 1)
+	assert.Equal(t, "msg1", deliveryJamMessage.Chat[0].ID)
+	assert.Equal(t, "hi", deliveryJamMessage.Chat[0].Text)
+	assert.Equal(t, ucJamMessage.Queue, deliveryJamMessage.Queue)
+	assert.Len(t, deliveryJamMessage.Suggestions, 1)
+	assert.Equal(t, "track2", deliveryJamMessage.Suggestions[0].TrackID)
+	assert.Equal(t, int64(3), deliveryJamMessage.Suggestions[0].Votes)
+	assert.Equal(t, "user2", deliveryJamMessage.Suggestions[0].SuggestedBy)
+	assert.True(t, deliveryJamMessage.AutoAdvance)
 }
 
 func TestJamMessageFromRepositoryToUsecase(t *testing.T) {
@@ -2036,6 +2077,19 @@ func TestJamMessageFromRepositoryToUsecase(t *testing.T) {
 		Loaded:     map[string]bool{"user1": true, "user2": false},
 		UserImages: map[string]string{"user1": "image1", "user2": "image2"},
 		UserNames:  map[string]string{"user1": "name1", "user2": "name2"},
+		ServerTime: 1700000000000,
+		MessageID:  "msg1",
+		Text:       "hi",
+		Emoji:      "🔥",
+		Muted:      []string{"user2"},
+		Chat: []*repository.JamChatMessage{
+			{ID: "msg1", UserID: "user1", Text: "hi", ServerTime: 1700000000000},
+		},
+		Queue: []string{"track1"},
+		Suggestions: []*repository.JamSuggestion{
+			{TrackID: "track2", Votes: 3, SuggestedBy: "user2"},
+		},
+		AutoAdvance: true,
 	}
 
 	ucJamMessage := model.JamMessageFromRepositoryToUsecase(repoJamMessage)
@@ -2050,6 +2104,20 @@ func TestJamMessageFromRepositoryToUsecase(t *testing.T) {
 	assert.Equal(t, repoJamMessage.Loaded, ucJamMessage.Loaded)
 	assert.Equal(t, repoJamMessage.UserImages, ucJamMessage.UserImages)
 	assert.Equal(t, repoJamMessage.UserNames, ucJamMessage.UserNames)
+	assert.Equal(t, repoJamMessage.ServerTime, ucJamMessage.ServerTime)
+	assert.Equal(t, repoJamMessage.Text, ucJamMessage.Text)
+	assert.Equal(t, repoJamMessage.Emoji, ucJamMessage.Emoji)
+	assert.Equal(t, repoJamMessage.MessageID, ucJamMessage.MessageID)
+	assert.Equal(t, repoJamMessage.Muted, ucJamMessage.Muted)
+	assert.Len(t, ucJamMessage.Chat, 1)
+	assert.Equal(t, "msg1", ucJamMessage.Chat[0].ID)
+	assert.Equal(t, "hi", ucJamMessage.Chat[0].Text)
+	assert.Equal(t, repoJamMessage.Queue, ucJamMessage.Queue)
+	assert.Len(t, ucJamMessage.Suggestions, 1)
+	assert.Equal(t, "track2", ucJamMessage.Suggestions[0].TrackID)
+	assert.Equal(t, int64(3), ucJamMessage.Suggestions[0].Votes)
+	assert.Equal(t, "user2", ucJamMessage.Suggestions[0].SuggestedBy)
+	assert.True(t, ucJamMessage.AutoAdvance)
 }
 
 func TestJamMessageFromDeliveryToUsecase(t *testing.T) {
@@ -2064,6 +2132,14 @@ func TestJamMessageFromDeliveryToUsecase(t *testing.T) {
 		Loaded:     map[string]bool{"user1": true, "user2": false},
 		UserImages: map[string]string{"user1": "image1", "user2": "image2"},
 		UserNames:  map[string]string{"user1": "name1", "user2": "name2"},
+		ServerTime: 1700000000000,
+		MessageID:  "msg1",
+		Text:       "hi",
+		Emoji:      "🔥",
+		Muted:      []string{"user2"},
+		Chat: []*delivery.JamChatMessage{
+			{ID: "msg1", UserID: "user1", Text: "hi", ServerTime: 1700000000000},
+		},
 	}
 
 	ucJamMessage := model.JamMessageFromDeliveryToUsecase(deliveryJamMessage)
@@ -2072,10 +2148,43 @@ func TestJamMessageFromDeliveryToUsecase(t *testing.T) {
 	assert.Equal(t, deliveryJamMessage.TrackID, ucJamMessage.TrackID)
 	assert.Equal(t, deliveryJamMessage.Position, ucJamMessage.Position)
 	assert.Equal(t, deliveryJamMessage.Paused, ucJamMessage.Paused)
-	assert.Equal(t, "", ucJamMessage.UserID)
+	assert.Equal(t, deliveryJamMessage.UserID, ucJamMessage.UserID)
 	assert.Equal(t, deliveryJamMessage.HostID, ucJamMessage.HostID)
 	assert.Equal(t, deliveryJamMessage.Users, ucJamMessage.Users)
 	assert.Equal(t, deliveryJamMessage.Loaded, ucJamMessage.Loaded)
 	assert.Equal(t, deliveryJamMessage.UserImages, ucJamMessage.UserImages)
 	assert.Equal(t, deliveryJamMessage.UserNames, ucJamMessage.UserNames)
+	assert.Equal(t, deliveryJamMessage.ServerTime, ucJamMessage.ServerTime)
+	assert.Equal(t, deliveryJamMessage.Text, ucJamMessage.Text)
+	assert.Equal(t, deliveryJamMessage.Emoji, ucJamMessage.Emoji)
+	assert.Equal(t, deliveryJamMessage.MessageID, ucJamMessage.MessageID)
+	assert.Equal(t, deliveryJamMessage.Muted, ucJamMessage.Muted)
+	assert.Len(t, ucJamMessage.Chat, 1)
+	assert.Equal(t, "msg1", ucJamMessage.Chat[0].ID)
+	assert.Equal(t, "hi", ucJamMessage.Chat[0].Text)
+}
+
+func TestImagesFor(t *testing.T) {
+	images := model.ImagesFor("https://images.s3.cloud.ru/albums/black-album-20250101120000-1f2e3d/1200.jpg", "#1f2e3d")
+
+	assert.NotNil(t, images)
+	assert.Equal(t, "#1f2e3d", images.DominantColor)
+	assert.Len(t, images.Variants, 4)
+	assert.Equal(t, &delivery.ImageVariant{
+		Size: 300,
+		AVIF: "https://images.s3.cloud.ru/albums/black-album-20250101120000-1f2e3d/300.avif",
+		WebP: "https://images.s3.cloud.ru/albums/black-album-20250101120000-1f2e3d/300.webp",
+		JPEG: "https://images.s3.cloud.ru/albums/black-album-20250101120000-1f2e3d/300.jpg",
+	}, images.Variants[1])
+
+	album := model.AlbumFromUsecaseToDelivery(&usecase.Album{
+		Thumbnail:      "https://images.s3.cloud.ru/albums/black-album-20250101120000-1f2e3d/1200.jpg",
+		ThumbnailColor: "#1f2e3d",
+	}, nil)
+	assert.Equal(t, images, album.Images)
+
+	// covers uploaded before variants were generated
+	assert.Nil(t, model.ImagesFor("https://images.s3.cloud.ru/albums/Black Album-20240101120000.png", ""))
+	assert.Nil(t, model.ImagesFor("https://images.s3.cloud.ru/albums/black-album-20250101120000-1f2e3d/1200.jpg", ""))
+	assert.Nil(t, model.AlbumFromUsecaseToDelivery(&usecase.Album{Thumbnail: "default.png"}, nil).Images)
 }
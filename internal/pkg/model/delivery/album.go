@@ -24,10 +24,12 @@ type Album struct {
 	ID          int64          `json:"id" example:"1" description:"Unique identifier"`
 	Title       string         `json:"title" example:"Anticyclone" description:"Album title"`
 	Thumbnail   string         `json:"thumbnail_url" example:"https://example.com/album.jpg" description:"URL to the album thumbnail"`
+	Images      *Images        `json:"thumbnail_images,omitempty" description:"Variants of the album thumbnail"`
 	Artists     []*AlbumArtist `json:"artists" description:"Associated artists"`
 	Type        AlbumType      `json:"type" example:"album" description:"Type of the album"`
 	ReleaseDate time.Time      `json:"release_date" example:"2021-01-01" description:"Release date of the album"`
 	IsLiked     bool           `json:"is_liked" example:"true" description:"Whether the album is liked by the user"`
+	Status      string         `json:"status,omitempty" example:"published" description:"Release status, only shown to the label and reviewers"`
 }
 
 type AlbumLikeRequest struct {
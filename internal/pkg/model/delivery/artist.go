@@ -3,11 +3,12 @@ package delivery
 // Artist represents a music artist
 // @Description A music artist entity
 type Artist struct {
-	ID          int64  `json:"id" example:"1" description:"Unique identifier"`
-	Title       string `json:"title" example:"Inabakumori" description:"Artist name"`
-	Description string `json:"description" example:"Inabakumori is a Japanese artist" description:"Artist description"`
-	Thumbnail   string `json:"thumbnail_url" example:"https://example.com/artist.jpg" description:"URL to the artist thumbnail"`
-	IsLiked     bool   `json:"is_liked" example:"false" description:"Whether the artist is liked"`
+	ID          int64   `json:"id" example:"1" description:"Unique identifier"`
+	Title       string  `json:"title" example:"Inabakumori" description:"Artist name"`
+	Description string  `json:"description" example:"Inabakumori is a Japanese artist" description:"Artist description"`
+	Thumbnail   string  `json:"thumbnail_url" example:"https://example.com/artist.jpg" description:"URL to the artist thumbnail"`
+	Images      *Images `json:"thumbnail_images,omitempty" description:"Variants of the artist thumbnail"`
+	IsLiked     bool    `json:"is_liked" example:"false" description:"Whether the artist is liked"`
 }
 
 // ArtistDetailed represents a detailed music artist entity
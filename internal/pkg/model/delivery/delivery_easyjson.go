@@ -7,6 +7,7 @@ import (
 	easyjson "github.com/mailru/easyjson"
 	jlexer "github.com/mailru/easyjson/jlexer"
 	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
 )
 
 // suppress unused package warning
@@ -42,6 +43,16 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.Email = string(in.String())
 		case "avatar_url":
 			out.Avatar = string(in.String())
+		case "avatar_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
 		case "is_label":
 			out.IsLabel = bool(in.Bool())
 		default:
@@ -78,6 +89,11 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.String(string(in.Avatar))
 	}
+	if in.Images != nil {
+		const prefix string = ",\"avatar_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"is_label\":"
 		out.RawString(prefix)
@@ -109,7 +125,95 @@ func (v *UserToFront) UnmarshalJSON(data []byte) error {
 func (v *UserToFront) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(in *jlexer.Lexer, out *UserFullData) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(in *jlexer.Lexer, out *UserPreview) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "username":
+			out.Username = string(in.String())
+		case "avatar_url":
+			out.AvatarUrl = string(in.String())
+		case "avatar_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(out *jwriter.Writer, in UserPreview) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarUrl))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"avatar_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UserPreview) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UserPreview) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UserPreview) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UserPreview) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(in *jlexer.Lexer, out *UserFullData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -134,6 +238,16 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.Email = string(in.String())
 		case "avatar_url":
 			out.AvatarUrl = string(in.String())
+		case "avatar_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
 		case "privacy":
 			if in.IsNull() {
 				in.Skip()
@@ -164,7 +278,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(out *jwriter.Writer, in UserFullData) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(out *jwriter.Writer, in UserFullData) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -183,6 +297,11 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.String(string(in.AvatarUrl))
 	}
+	if in.Images != nil {
+		const prefix string = ",\"avatar_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
 	if in.Privacy != nil {
 		const prefix string = ",\"privacy\":"
 		out.RawString(prefix)
@@ -199,27 +318,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v UserFullData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserFullData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserFullData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserFullData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery1(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(in *jlexer.Lexer, out *UserDelete) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(in *jlexer.Lexer, out *UserDelete) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -254,7 +373,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(out *jwriter.Writer, in UserDelete) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(out *jwriter.Writer, in UserDelete) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -279,27 +398,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v UserDelete) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserDelete) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserDelete) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserDelete) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery2(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(in *jlexer.Lexer, out *UserChangeSettings) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(in *jlexer.Lexer, out *UserChangeSettings) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -346,7 +465,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(out *jwriter.Writer, in UserChangeSettings) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(out *jwriter.Writer, in UserChangeSettings) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -385,27 +504,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v UserChangeSettings) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v UserChangeSettings) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *UserChangeSettings) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *UserChangeSettings) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery3(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(in *jlexer.Lexer, out *UpdatePlaylistRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(in *jlexer.Lexer, out *UploadPartsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -424,14 +543,28 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "Title":
-			out.Title = string(in.String())
-		case "Thumbnail":
+		case "parts":
 			if in.IsNull() {
 				in.Skip()
-				out.Thumbnail = nil
+				out.Parts = nil
 			} else {
-				out.Thumbnail = in.Bytes()
+				in.Delim('[')
+				if out.Parts == nil {
+					if !in.IsDelim(']') {
+						out.Parts = make([]int64, 0, 8)
+					} else {
+						out.Parts = []int64{}
+					}
+				} else {
+					out.Parts = (out.Parts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v1 int64
+					v1 = int64(in.Int64())
+					out.Parts = append(out.Parts, v1)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -443,47 +576,53 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(out *jwriter.Writer, in UpdatePlaylistRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(out *jwriter.Writer, in UploadPartsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Title\":"
+		const prefix string = ",\"parts\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"Thumbnail\":"
-		out.RawString(prefix)
-		out.Base64Bytes(in.Thumbnail)
+		if in.Parts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v2, v3 := range in.Parts {
+				if v2 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v3))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v UpdatePlaylistRequest) MarshalJSON() ([]byte, error) {
+func (v UploadPartsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v UpdatePlaylistRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(w, v)
+func (v UploadPartsRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *UpdatePlaylistRequest) UnmarshalJSON(data []byte) error {
+func (v *UploadPartsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *UpdatePlaylistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery4(l, v)
+func (v *UploadPartsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(in *jlexer.Lexer, out *TrackStreamUpdateData) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(in *jlexer.Lexer, out *UploadPart) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -502,8 +641,10 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "duration":
-			out.Duration = int64(in.Int64())
+		case "number":
+			out.Number = int64(in.Int64())
+		case "url":
+			out.URL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -514,42 +655,47 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(out *jwriter.Writer, in TrackStreamUpdateData) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(out *jwriter.Writer, in UploadPart) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"duration\":"
+		const prefix string = ",\"number\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.Duration))
+		out.Int64(int64(in.Number))
+	}
+	{
+		const prefix string = ",\"url\":"
+		out.RawString(prefix)
+		out.String(string(in.URL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackStreamUpdateData) MarshalJSON() ([]byte, error) {
+func (v UploadPart) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackStreamUpdateData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(w, v)
+func (v UploadPart) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackStreamUpdateData) UnmarshalJSON(data []byte) error {
+func (v *UploadPart) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackStreamUpdateData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery5(l, v)
+func (v *UploadPart) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(in *jlexer.Lexer, out *TrackStreamCreateData) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(in *jlexer.Lexer, out *Upload) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -568,10 +714,41 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "TrackID":
-			out.TrackID = int64(in.Int64())
-		case "UserID":
-			out.UserID = int64(in.Int64())
+		case "id":
+			out.ID = string(in.String())
+		case "kind":
+			out.Kind = string(in.String())
+		case "size":
+			out.Size = int64(in.Int64())
+		case "part_size":
+			out.PartSize = int64(in.Int64())
+		case "parts_count":
+			out.PartsCount = int64(in.Int64())
+		case "status":
+			out.Status = string(in.String())
+		case "uploaded_parts":
+			if in.IsNull() {
+				in.Skip()
+				out.UploadedParts = nil
+			} else {
+				in.Delim('[')
+				if out.UploadedParts == nil {
+					if !in.IsDelim(']') {
+						out.UploadedParts = make([]int64, 0, 8)
+					} else {
+						out.UploadedParts = []int64{}
+					}
+				} else {
+					out.UploadedParts = (out.UploadedParts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v4 int64
+					v4 = int64(in.Int64())
+					out.UploadedParts = append(out.UploadedParts, v4)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -582,47 +759,83 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(out *jwriter.Writer, in TrackStreamCreateData) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(out *jwriter.Writer, in Upload) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"TrackID\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.TrackID))
+		out.String(string(in.ID))
 	}
 	{
-		const prefix string = ",\"UserID\":"
+		const prefix string = ",\"kind\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.UserID))
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"part_size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PartSize))
+	}
+	{
+		const prefix string = ",\"parts_count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.PartsCount))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"uploaded_parts\":"
+		out.RawString(prefix)
+		if in.UploadedParts == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v5, v6 := range in.UploadedParts {
+				if v5 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v6))
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackStreamCreateData) MarshalJSON() ([]byte, error) {
+func (v Upload) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackStreamCreateData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(w, v)
+func (v Upload) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackStreamCreateData) UnmarshalJSON(data []byte) error {
+func (v *Upload) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackStreamCreateData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery6(l, v)
+func (v *Upload) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(in *jlexer.Lexer, out *TrackStream) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(in *jlexer.Lexer, out *UpdatePlaylistRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -641,12 +854,15 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "track_id":
-			out.TrackID = int64(in.Int64())
-		case "duration":
-			out.Duration = int64(in.Int64())
+		case "Title":
+			out.Title = string(in.String())
+		case "Thumbnail":
+			if in.IsNull() {
+				in.Skip()
+				out.Thumbnail = nil
+			} else {
+				out.Thumbnail = in.Bytes()
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -657,52 +873,47 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(out *jwriter.Writer, in TrackStream) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(out *jwriter.Writer, in UpdatePlaylistRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"Title\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
+		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"track_id\":"
+		const prefix string = ",\"Thumbnail\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.TrackID))
-	}
-	{
-		const prefix string = ",\"duration\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.Duration))
+		out.Base64Bytes(in.Thumbnail)
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackStream) MarshalJSON() ([]byte, error) {
+func (v UpdatePlaylistRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackStream) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(w, v)
+func (v UpdatePlaylistRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackStream) UnmarshalJSON(data []byte) error {
+func (v *UpdatePlaylistRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackStream) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery7(l, v)
+func (v *UpdatePlaylistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(in *jlexer.Lexer, out *TrackLikeRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(in *jlexer.Lexer, out *UnreadNotificationsCount) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -721,8 +932,8 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "value":
-			out.IsLike = bool(in.Bool())
+		case "count":
+			out.Count = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -733,42 +944,42 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(out *jwriter.Writer, in TrackLikeRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(out *jwriter.Writer, in UnreadNotificationsCount) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"value\":"
+		const prefix string = ",\"count\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsLike))
+		out.Int64(int64(in.Count))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackLikeRequest) MarshalJSON() ([]byte, error) {
+func (v UnreadNotificationsCount) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackLikeRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(w, v)
+func (v UnreadNotificationsCount) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackLikeRequest) UnmarshalJSON(data []byte) error {
+func (v *UnreadNotificationsCount) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackLikeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery8(l, v)
+func (v *UnreadNotificationsCount) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(in *jlexer.Lexer, out *TrackFilters) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(in *jlexer.Lexer, out *TrackWaveform) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -787,15 +998,30 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "Pagination":
+		case "duration":
+			out.Duration = int64(in.Int64())
+		case "peaks":
 			if in.IsNull() {
 				in.Skip()
-				out.Pagination = nil
+				out.Peaks = nil
 			} else {
-				if out.Pagination == nil {
-					out.Pagination = new(Pagination)
+				in.Delim('[')
+				if out.Peaks == nil {
+					if !in.IsDelim(']') {
+						out.Peaks = make([]float64, 0, 8)
+					} else {
+						out.Peaks = []float64{}
+					}
+				} else {
+					out.Peaks = (out.Peaks)[:0]
 				}
-				(*out.Pagination).UnmarshalEasyJSON(in)
+				for !in.IsDelim(']') {
+					var v10 float64
+					v10 = float64(in.Float64())
+					out.Peaks = append(out.Peaks, v10)
+					in.WantComma()
+				}
+				in.Delim(']')
 			}
 		default:
 			in.SkipRecursive()
@@ -807,46 +1033,58 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(out *jwriter.Writer, in TrackFilters) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(out *jwriter.Writer, in TrackWaveform) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"Pagination\":"
+		const prefix string = ",\"duration\":"
 		out.RawString(prefix[1:])
-		if in.Pagination == nil {
+		out.Int64(int64(in.Duration))
+	}
+	{
+		const prefix string = ",\"peaks\":"
+		out.RawString(prefix)
+		if in.Peaks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
-			(*in.Pagination).MarshalEasyJSON(out)
+			out.RawByte('[')
+			for v11, v12 := range in.Peaks {
+				if v11 > 0 {
+					out.RawByte(',')
+				}
+				out.Float64(float64(v12))
+			}
+			out.RawByte(']')
 		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackFilters) MarshalJSON() ([]byte, error) {
+func (v TrackWaveform) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackFilters) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(w, v)
+func (v TrackWaveform) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackFilters) UnmarshalJSON(data []byte) error {
+func (v *TrackWaveform) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackFilters) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery9(l, v)
+func (v *TrackWaveform) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(in *jlexer.Lexer, out *TrackDetailed) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(in *jlexer.Lexer, out *TrackStreamUpdateData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -865,53 +1103,8 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "file_url":
-			out.FileUrl = string(in.String())
-		case "id":
-			out.ID = int64(in.Int64())
-		case "title":
-			out.Title = string(in.String())
-		case "thumbnail_url":
-			out.Thumbnail = string(in.String())
 		case "duration":
 			out.Duration = int64(in.Int64())
-		case "album_id":
-			out.AlbumID = int64(in.Int64())
-		case "album":
-			out.Album = string(in.String())
-		case "artists":
-			if in.IsNull() {
-				in.Skip()
-				out.Artists = nil
-			} else {
-				in.Delim('[')
-				if out.Artists == nil {
-					if !in.IsDelim(']') {
-						out.Artists = make([]*TrackArtist, 0, 8)
-					} else {
-						out.Artists = []*TrackArtist{}
-					}
-				} else {
-					out.Artists = (out.Artists)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v4 *TrackArtist
-					if in.IsNull() {
-						in.Skip()
-						v4 = nil
-					} else {
-						if v4 == nil {
-							v4 = new(TrackArtist)
-						}
-						(*v4).UnmarshalEasyJSON(in)
-					}
-					out.Artists = append(out.Artists, v4)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "is_liked":
-			out.IsLiked = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -922,97 +1115,42 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(out *jwriter.Writer, in TrackDetailed) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(out *jwriter.Writer, in TrackStreamUpdateData) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	{
-		const prefix string = ",\"file_url\":"
-		out.RawString(prefix[1:])
-		out.String(string(in.FileUrl))
-	}
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"thumbnail_url\":"
-		out.RawString(prefix)
-		out.String(string(in.Thumbnail))
-	}
 	{
 		const prefix string = ",\"duration\":"
-		out.RawString(prefix)
+		out.RawString(prefix[1:])
 		out.Int64(int64(in.Duration))
 	}
-	{
-		const prefix string = ",\"album_id\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.AlbumID))
-	}
-	{
-		const prefix string = ",\"album\":"
-		out.RawString(prefix)
-		out.String(string(in.Album))
-	}
-	{
-		const prefix string = ",\"artists\":"
-		out.RawString(prefix)
-		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v5, v6 := range in.Artists {
-				if v5 > 0 {
-					out.RawByte(',')
-				}
-				if v6 == nil {
-					out.RawString("null")
-				} else {
-					(*v6).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"is_liked\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsLiked))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackDetailed) MarshalJSON() ([]byte, error) {
+func (v TrackStreamUpdateData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackDetailed) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(w, v)
+func (v TrackStreamUpdateData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackDetailed) UnmarshalJSON(data []byte) error {
+func (v *TrackStreamUpdateData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackDetailed) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery10(l, v)
+func (v *TrackStreamUpdateData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(in *jlexer.Lexer, out *TrackArtist) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(in *jlexer.Lexer, out *TrackStreamCreateData) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1031,12 +1169,10 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "title":
-			out.Title = string(in.String())
-		case "role":
-			out.Role = string(in.String())
+		case "TrackID":
+			out.TrackID = int64(in.Int64())
+		case "UserID":
+			out.UserID = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -1047,52 +1183,47 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(out *jwriter.Writer, in TrackArtist) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(out *jwriter.Writer, in TrackStreamCreateData) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"TrackID\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
+		out.Int64(int64(in.TrackID))
 	}
 	{
-		const prefix string = ",\"role\":"
+		const prefix string = ",\"UserID\":"
 		out.RawString(prefix)
-		out.String(string(in.Role))
+		out.Int64(int64(in.UserID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v TrackArtist) MarshalJSON() ([]byte, error) {
+func (v TrackStreamCreateData) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v TrackArtist) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(w, v)
+func (v TrackStreamCreateData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *TrackArtist) UnmarshalJSON(data []byte) error {
+func (v *TrackStreamCreateData) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *TrackArtist) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery11(l, v)
+func (v *TrackStreamCreateData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(in *jlexer.Lexer, out *Track) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(in *jlexer.Lexer, out *TrackStream) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1113,49 +1244,10 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		switch key {
 		case "id":
 			out.ID = int64(in.Int64())
-		case "title":
-			out.Title = string(in.String())
-		case "thumbnail_url":
-			out.Thumbnail = string(in.String())
+		case "track_id":
+			out.TrackID = int64(in.Int64())
 		case "duration":
 			out.Duration = int64(in.Int64())
-		case "album_id":
-			out.AlbumID = int64(in.Int64())
-		case "album":
-			out.Album = string(in.String())
-		case "artists":
-			if in.IsNull() {
-				in.Skip()
-				out.Artists = nil
-			} else {
-				in.Delim('[')
-				if out.Artists == nil {
-					if !in.IsDelim(']') {
-						out.Artists = make([]*TrackArtist, 0, 8)
-					} else {
-						out.Artists = []*TrackArtist{}
-					}
-				} else {
-					out.Artists = (out.Artists)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v7 *TrackArtist
-					if in.IsNull() {
-						in.Skip()
-						v7 = nil
-					} else {
-						if v7 == nil {
-							v7 = new(TrackArtist)
-						}
-						(*v7).UnmarshalEasyJSON(in)
-					}
-					out.Artists = append(out.Artists, v7)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "is_liked":
-			out.IsLiked = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1166,7 +1258,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(out *jwriter.Writer, in Track) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(out *jwriter.Writer, in TrackStream) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -1176,148 +1268,42 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"thumbnail_url\":"
+		const prefix string = ",\"track_id\":"
 		out.RawString(prefix)
-		out.String(string(in.Thumbnail))
+		out.Int64(int64(in.TrackID))
 	}
 	{
 		const prefix string = ",\"duration\":"
 		out.RawString(prefix)
 		out.Int64(int64(in.Duration))
 	}
-	{
-		const prefix string = ",\"album_id\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.AlbumID))
-	}
-	{
-		const prefix string = ",\"album\":"
-		out.RawString(prefix)
-		out.String(string(in.Album))
-	}
-	{
-		const prefix string = ",\"artists\":"
-		out.RawString(prefix)
-		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v8, v9 := range in.Artists {
-				if v8 > 0 {
-					out.RawByte(',')
-				}
-				if v9 == nil {
-					out.RawString("null")
-				} else {
-					(*v9).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"is_liked\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsLiked))
-	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v Track) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Track) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *Track) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Track) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery12(l, v)
-}
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(in *jlexer.Lexer, out *SuccessCreateAlbum) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
-		}
-		in.Skip()
-		return
-	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "album_id":
-			out.AlbumID = int64(in.Int64())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
-	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
-	}
-}
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(out *jwriter.Writer, in SuccessCreateAlbum) {
-	out.RawByte('{')
-	first := true
-	_ = first
-	{
-		const prefix string = ",\"album_id\":"
-		out.RawString(prefix[1:])
-		out.Int64(int64(in.AlbumID))
-	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v SuccessCreateAlbum) MarshalJSON() ([]byte, error) {
+func (v TrackStream) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v SuccessCreateAlbum) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrackStream) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *SuccessCreateAlbum) UnmarshalJSON(data []byte) error {
+func (v *TrackStream) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *SuccessCreateAlbum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrackStream) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery13(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(in *jlexer.Lexer, out *StreamID) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(in *jlexer.Lexer, out *TrackRendition) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1336,8 +1322,12 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
+		case "codec":
+			out.Codec = string(in.String())
+		case "bitrate":
+			out.Bitrate = int64(in.Int64())
+		case "file_url":
+			out.FileUrl = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1348,42 +1338,52 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(out *jwriter.Writer, in StreamID) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(out *jwriter.Writer, in TrackRendition) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"id\":"
+		const prefix string = ",\"codec\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ID))
+		out.String(string(in.Codec))
+	}
+	{
+		const prefix string = ",\"bitrate\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Bitrate))
+	}
+	{
+		const prefix string = ",\"file_url\":"
+		out.RawString(prefix)
+		out.String(string(in.FileUrl))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v StreamID) MarshalJSON() ([]byte, error) {
+func (v TrackRendition) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v StreamID) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrackRendition) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *StreamID) UnmarshalJSON(data []byte) error {
+func (v *TrackRendition) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *StreamID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrackRendition) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery14(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(in *jlexer.Lexer, out *Statistics) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(in *jlexer.Lexer, out *TrackMetadata) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1402,12 +1402,68 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "minutes_listened":
-			out.MinutesListened = int64(in.Int64())
-		case "tracks_listened":
-			out.TracksListened = int64(in.Int64())
-		case "artists_listened":
-			out.ArtistsListened = int64(in.Int64())
+		case "upload_id":
+			out.UploadID = string(in.String())
+		case "format":
+			out.Format = string(in.String())
+		case "title":
+			out.Title = string(in.String())
+		case "track_number":
+			out.TrackNumber = int64(in.Int64())
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]string, 0, 4)
+					} else {
+						out.Artists = []string{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v13 string
+					v13 = string(in.String())
+					out.Artists = append(out.Artists, v13)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "artist_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ArtistIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ArtistIDs == nil {
+					if !in.IsDelim(']') {
+						out.ArtistIDs = make([]int64, 0, 8)
+					} else {
+						out.ArtistIDs = []int64{}
+					}
+				} else {
+					out.ArtistIDs = (out.ArtistIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v14 int64
+					v14 = int64(in.Int64())
+					out.ArtistIDs = append(out.ArtistIDs, v14)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "genre":
+			out.Genre = string(in.String())
+		case "year":
+			out.Year = int64(in.Int64())
+		case "isrc":
+			out.ISRC = string(in.String())
+		case "cover_url":
+			out.CoverURL = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1418,52 +1474,109 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(out *jwriter.Writer, in Statistics) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(out *jwriter.Writer, in TrackMetadata) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"minutes_listened\":"
+		const prefix string = ",\"upload_id\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.MinutesListened))
+		out.String(string(in.UploadID))
 	}
 	{
-		const prefix string = ",\"tracks_listened\":"
+		const prefix string = ",\"format\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.TracksListened))
+		out.String(string(in.Format))
 	}
 	{
-		const prefix string = ",\"artists_listened\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.ArtistsListened))
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"track_number\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TrackNumber))
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v15, v16 := range in.Artists {
+				if v15 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v16))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"artist_ids\":"
+		out.RawString(prefix)
+		if in.ArtistIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v17, v18 := range in.ArtistIDs {
+				if v17 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v18))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"genre\":"
+		out.RawString(prefix)
+		out.String(string(in.Genre))
+	}
+	{
+		const prefix string = ",\"year\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Year))
+	}
+	{
+		const prefix string = ",\"isrc\":"
+		out.RawString(prefix)
+		out.String(string(in.ISRC))
+	}
+	if in.CoverURL != "" {
+		const prefix string = ",\"cover_url\":"
+		out.RawString(prefix)
+		out.String(string(in.CoverURL))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Statistics) MarshalJSON() ([]byte, error) {
+func (v TrackMetadata) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Statistics) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrackMetadata) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Statistics) UnmarshalJSON(data []byte) error {
+func (v *TrackMetadata) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Statistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrackMetadata) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery15(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(in *jlexer.Lexer, out *RegisterData) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(in *jlexer.Lexer, out *TrackLyricsRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1482,12 +1595,12 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "username":
-			out.Username = string(in.String())
-		case "password":
-			out.Password = string(in.String())
-		case "email":
-			out.Email = string(in.String())
+		case "language":
+			out.Language = string(in.String())
+		case "translation":
+			out.Translation = bool(in.Bool())
+		case "lyrics":
+			out.Lyrics = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -1498,52 +1611,52 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(out *jwriter.Writer, in RegisterData) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(out *jwriter.Writer, in TrackLyricsRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"username\":"
+		const prefix string = ",\"language\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Username))
+		out.String(string(in.Language))
 	}
-	{
-		const prefix string = ",\"password\":"
+	if in.Translation {
+		const prefix string = ",\"translation\":"
 		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.Bool(bool(in.Translation))
 	}
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"lyrics\":"
 		out.RawString(prefix)
-		out.String(string(in.Email))
+		out.String(string(in.Lyrics))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v RegisterData) MarshalJSON() ([]byte, error) {
+func (v TrackLyricsRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v RegisterData) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrackLyricsRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *RegisterData) UnmarshalJSON(data []byte) error {
+func (v *TrackLyricsRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *RegisterData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrackLyricsRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery16(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(in *jlexer.Lexer, out *Privacy) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(in *jlexer.Lexer, out *TrackLyrics) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1562,18 +1675,43 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "is_public_playlists":
-			out.IsPublicPlaylists = bool(in.Bool())
-		case "is_public_minutes_listened":
-			out.IsPublicMinutesListened = bool(in.Bool())
-		case "is_public_favorite_artists":
-			out.IsPublicFavoriteArtists = bool(in.Bool())
-		case "is_public_tracks_listened":
-			out.IsPublicTracksListened = bool(in.Bool())
-		case "is_public_favorite_tracks":
-			out.IsPublicFavoriteTracks = bool(in.Bool())
-		case "is_public_artists_listened":
-			out.IsPublicArtistsListened = bool(in.Bool())
+		case "language":
+			out.Language = string(in.String())
+		case "translation":
+			out.Translation = bool(in.Bool())
+		case "synced":
+			out.Synced = bool(in.Bool())
+		case "lines":
+			if in.IsNull() {
+				in.Skip()
+				out.Lines = nil
+			} else {
+				in.Delim('[')
+				if out.Lines == nil {
+					if !in.IsDelim(']') {
+						out.Lines = make([]*LyricsLine, 0, 8)
+					} else {
+						out.Lines = []*LyricsLine{}
+					}
+				} else {
+					out.Lines = (out.Lines)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v19 *LyricsLine
+					if in.IsNull() {
+						in.Skip()
+						v19 = nil
+					} else {
+						if v19 == nil {
+							v19 = new(LyricsLine)
+						}
+						(*v19).UnmarshalEasyJSON(in)
+					}
+					out.Lines = append(out.Lines, v19)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1584,67 +1722,72 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(out *jwriter.Writer, in Privacy) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(out *jwriter.Writer, in TrackLyrics) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"is_public_playlists\":"
+		const prefix string = ",\"language\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsPublicPlaylists))
-	}
-	{
-		const prefix string = ",\"is_public_minutes_listened\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPublicMinutesListened))
+		out.String(string(in.Language))
 	}
 	{
-		const prefix string = ",\"is_public_favorite_artists\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.IsPublicFavoriteArtists))
-	}
-	{
-		const prefix string = ",\"is_public_tracks_listened\":"
+		const prefix string = ",\"translation\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsPublicTracksListened))
+		out.Bool(bool(in.Translation))
 	}
 	{
-		const prefix string = ",\"is_public_favorite_tracks\":"
+		const prefix string = ",\"synced\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsPublicFavoriteTracks))
+		out.Bool(bool(in.Synced))
 	}
 	{
-		const prefix string = ",\"is_public_artists_listened\":"
+		const prefix string = ",\"lines\":"
 		out.RawString(prefix)
-		out.Bool(bool(in.IsPublicArtistsListened))
+		if in.Lines == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v20, v21 := range in.Lines {
+				if v20 > 0 {
+					out.RawByte(',')
+				}
+				if v21 == nil {
+					out.RawString("null")
+				} else {
+					(*v21).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Privacy) MarshalJSON() ([]byte, error) {
+func (v TrackLyrics) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Privacy) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrackLyrics) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Privacy) UnmarshalJSON(data []byte) error {
+func (v *TrackLyrics) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Privacy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrackLyrics) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery17(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(in *jlexer.Lexer, out *PlaylistWithIsLiked) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(in *jlexer.Lexer, out *TrackLikeRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1663,16 +1806,8 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "is_liked":
-			out.IsLiked = bool(in.Bool())
-		case "id":
-			out.ID = int64(in.Int64())
-		case "title":
-			out.Title = string(in.String())
-		case "thumbnail_url":
-			out.Thumbnail = string(in.String())
-		case "username":
-			out.Username = string(in.String())
+		case "value":
+			out.IsLike = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1683,62 +1818,42 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(out *jwriter.Writer, in PlaylistWithIsLiked) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(out *jwriter.Writer, in TrackLikeRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"is_liked\":"
+		const prefix string = ",\"value\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsLiked))
-	}
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"thumbnail_url\":"
-		out.RawString(prefix)
-		out.String(string(in.Thumbnail))
-	}
-	{
-		const prefix string = ",\"username\":"
-		out.RawString(prefix)
-		out.String(string(in.Username))
+		out.Bool(bool(in.IsLike))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PlaylistWithIsLiked) MarshalJSON() ([]byte, error) {
+func (v TrackLikeRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PlaylistWithIsLiked) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrackLikeRequest) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PlaylistWithIsLiked) UnmarshalJSON(data []byte) error {
+func (v *TrackLikeRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PlaylistWithIsLiked) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrackLikeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery18(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(in *jlexer.Lexer, out *PlaylistWithIsIncludedTrack) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(in *jlexer.Lexer, out *TrackFilters) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1757,16 +1872,16 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "is_included":
-			out.IsIncluded = bool(in.Bool())
-		case "id":
-			out.ID = int64(in.Int64())
-		case "title":
-			out.Title = string(in.String())
-		case "thumbnail_url":
-			out.Thumbnail = string(in.String())
-		case "username":
-			out.Username = string(in.String())
+		case "Pagination":
+			if in.IsNull() {
+				in.Skip()
+				out.Pagination = nil
+			} else {
+				if out.Pagination == nil {
+					out.Pagination = new(Pagination)
+				}
+				(*out.Pagination).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -1777,62 +1892,46 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(out *jwriter.Writer, in PlaylistWithIsIncludedTrack) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(out *jwriter.Writer, in TrackFilters) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"is_included\":"
+		const prefix string = ",\"Pagination\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsIncluded))
-	}
-	{
-		const prefix string = ",\"id\":"
-		out.RawString(prefix)
-		out.Int64(int64(in.ID))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"thumbnail_url\":"
-		out.RawString(prefix)
-		out.String(string(in.Thumbnail))
-	}
-	{
-		const prefix string = ",\"username\":"
-		out.RawString(prefix)
-		out.String(string(in.Username))
+		if in.Pagination == nil {
+			out.RawString("null")
+		} else {
+			(*in.Pagination).MarshalEasyJSON(out)
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v PlaylistWithIsIncludedTrack) MarshalJSON() ([]byte, error) {
+func (v TrackFilters) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PlaylistWithIsIncludedTrack) MarshalEasyJSON(w *jwriter.Writer) {
+func (v TrackFilters) MarshalEasyJSON(w *jwriter.Writer) {
 	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *PlaylistWithIsIncludedTrack) UnmarshalJSON(data []byte) error {
+func (v *TrackFilters) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PlaylistWithIsIncludedTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+func (v *TrackFilters) UnmarshalEasyJSON(l *jlexer.Lexer) {
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery19(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(in *jlexer.Lexer, out *PlaylistLikeRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(in *jlexer.Lexer, out *TrackDetailed) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -1851,8 +1950,104 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "value":
-			out.IsLike = bool(in.Bool())
+		case "file_url":
+			out.FileUrl = string(in.String())
+		case "renditions":
+			if in.IsNull() {
+				in.Skip()
+				out.Renditions = nil
+			} else {
+				in.Delim('[')
+				if out.Renditions == nil {
+					if !in.IsDelim(']') {
+						out.Renditions = make([]*TrackRendition, 0, 8)
+					} else {
+						out.Renditions = []*TrackRendition{}
+					}
+				} else {
+					out.Renditions = (out.Renditions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v22 *TrackRendition
+					if in.IsNull() {
+						in.Skip()
+						v22 = nil
+					} else {
+						if v22 == nil {
+							v22 = new(TrackRendition)
+						}
+						(*v22).UnmarshalEasyJSON(in)
+					}
+					out.Renditions = append(out.Renditions, v22)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "loudness_lufs":
+			out.LoudnessLUFS = float64(in.Float64())
+		case "replay_gain_db":
+			out.ReplayGainDB = float64(in.Float64())
+		case "key":
+			out.Key = string(in.String())
+		case "has_lyrics":
+			out.HasLyrics = bool(in.Bool())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "duration":
+			out.Duration = int64(in.Int64())
+		case "album_id":
+			out.AlbumID = int64(in.Int64())
+		case "album":
+			out.Album = string(in.String())
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]*TrackArtist, 0, 8)
+					} else {
+						out.Artists = []*TrackArtist{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v23 *TrackArtist
+					if in.IsNull() {
+						in.Skip()
+						v23 = nil
+					} else {
+						if v23 == nil {
+							v23 = new(TrackArtist)
+						}
+						(*v23).UnmarshalEasyJSON(in)
+					}
+					out.Artists = append(out.Artists, v23)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "bpm":
+			out.BPM = float64(in.Float64())
+		case "is_liked":
+			out.IsLiked = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -1863,85 +2058,56 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(out *jwriter.Writer, in PlaylistLikeRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(out *jwriter.Writer, in TrackDetailed) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"value\":"
+		const prefix string = ",\"file_url\":"
 		out.RawString(prefix[1:])
-		out.Bool(bool(in.IsLike))
+		out.String(string(in.FileUrl))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v PlaylistLikeRequest) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v PlaylistLikeRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *PlaylistLikeRequest) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(&r, v)
-	return r.Error()
-}
-
-// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *PlaylistLikeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(l, v)
-}
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(in *jlexer.Lexer, out *Playlist) {
-	isTopLevel := in.IsStart()
-	if in.IsNull() {
-		if isTopLevel {
-			in.Consumed()
+	if len(in.Renditions) != 0 {
+		const prefix string = ",\"renditions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v24, v25 := range in.Renditions {
+				if v24 > 0 {
+					out.RawByte(',')
+				}
+				if v25 == nil {
+					out.RawString("null")
+				} else {
+					(*v25).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
 		}
-		in.Skip()
-		return
 	}
-	in.Delim('{')
-	for !in.IsDelim('}') {
-		key := in.UnsafeFieldName(false)
-		in.WantColon()
-		if in.IsNull() {
-			in.Skip()
-			in.WantComma()
-			continue
-		}
-		switch key {
-		case "id":
-			out.ID = int64(in.Int64())
-		case "title":
-			out.Title = string(in.String())
-		case "thumbnail_url":
-			out.Thumbnail = string(in.String())
-		case "username":
-			out.Username = string(in.String())
-		default:
-			in.SkipRecursive()
-		}
-		in.WantComma()
+	if in.LoudnessLUFS != 0 {
+		const prefix string = ",\"loudness_lufs\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.LoudnessLUFS))
 	}
-	in.Delim('}')
-	if isTopLevel {
-		in.Consumed()
+	if in.ReplayGainDB != 0 {
+		const prefix string = ",\"replay_gain_db\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ReplayGainDB))
+	}
+	if in.Key != "" {
+		const prefix string = ",\"key\":"
+		out.RawString(prefix)
+		out.String(string(in.Key))
+	}
+	{
+		const prefix string = ",\"has_lyrics\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasLyrics))
 	}
-}
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(out *jwriter.Writer, in Playlist) {
-	out.RawByte('{')
-	first := true
-	_ = first
 	{
 		const prefix string = ",\"id\":"
-		out.RawString(prefix[1:])
+		out.RawString(prefix)
 		out.Int64(int64(in.ID))
 	}
 	{
@@ -1954,38 +2120,83 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.String(string(in.Thumbnail))
 	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
 	{
-		const prefix string = ",\"username\":"
+		const prefix string = ",\"duration\":"
 		out.RawString(prefix)
-		out.String(string(in.Username))
+		out.Int64(int64(in.Duration))
+	}
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"album\":"
+		out.RawString(prefix)
+		out.String(string(in.Album))
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v26, v27 := range in.Artists {
+				if v26 > 0 {
+					out.RawByte(',')
+				}
+				if v27 == nil {
+					out.RawString("null")
+				} else {
+					(*v27).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.BPM != 0 {
+		const prefix string = ",\"bpm\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BPM))
+	}
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Playlist) MarshalJSON() ([]byte, error) {
+func (v TrackDetailed) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Playlist) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(w, v)
+func (v TrackDetailed) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Playlist) UnmarshalJSON(data []byte) error {
+func (v *TrackDetailed) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Playlist) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(l, v)
+func (v *TrackDetailed) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery20(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(in *jlexer.Lexer, out *Pagination) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(in *jlexer.Lexer, out *TrackArtistRole) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2004,10 +2215,10 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "offset":
-			out.Offset = int(in.Int())
-		case "limit":
-			out.Limit = int(in.Int())
+		case "artist_id":
+			out.ArtistID = int64(in.Int64())
+		case "role":
+			out.Role = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2018,47 +2229,47 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(out *jwriter.Writer, in Pagination) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(out *jwriter.Writer, in TrackArtistRole) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"offset\":"
+		const prefix string = ",\"artist_id\":"
 		out.RawString(prefix[1:])
-		out.Int(int(in.Offset))
+		out.Int64(int64(in.ArtistID))
 	}
 	{
-		const prefix string = ",\"limit\":"
+		const prefix string = ",\"role\":"
 		out.RawString(prefix)
-		out.Int(int(in.Limit))
+		out.String(string(in.Role))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Pagination) MarshalJSON() ([]byte, error) {
+func (v TrackArtistRole) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Pagination) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(w, v)
+func (v TrackArtistRole) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Pagination) UnmarshalJSON(data []byte) error {
+func (v *TrackArtistRole) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Pagination) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(l, v)
+func (v *TrackArtistRole) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery21(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(in *jlexer.Lexer, out *Message) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(in *jlexer.Lexer, out *TrackArtist) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2077,8 +2288,12 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "msg":
-			out.Message = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "role":
+			out.Role = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2089,42 +2304,52 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(out *jwriter.Writer, in Message) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(out *jwriter.Writer, in TrackArtist) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"msg\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Message))
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Message) MarshalJSON() ([]byte, error) {
+func (v TrackArtist) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(w, v)
+func (v TrackArtist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Message) UnmarshalJSON(data []byte) error {
+func (v *TrackArtist) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(l, v)
+func (v *TrackArtist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery22(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(in *jlexer.Lexer, out *LoginData) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(in *jlexer.Lexer, out *Track) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2143,12 +2368,63 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "username":
-			out.Username = string(in.String())
-		case "password":
-			out.Password = string(in.String())
-		case "email":
-			out.Email = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "duration":
+			out.Duration = int64(in.Int64())
+		case "album_id":
+			out.AlbumID = int64(in.Int64())
+		case "album":
+			out.Album = string(in.String())
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]*TrackArtist, 0, 8)
+					} else {
+						out.Artists = []*TrackArtist{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v28 *TrackArtist
+					if in.IsNull() {
+						in.Skip()
+						v28 = nil
+					} else {
+						if v28 == nil {
+							v28 = new(TrackArtist)
+						}
+						(*v28).UnmarshalEasyJSON(in)
+					}
+					out.Artists = append(out.Artists, v28)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "bpm":
+			out.BPM = float64(in.Float64())
+		case "is_liked":
+			out.IsLiked = bool(in.Bool())
 		default:
 			in.SkipRecursive()
 		}
@@ -2159,52 +2435,5169 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(out *jwriter.Writer, in LoginData) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(out *jwriter.Writer, in Track) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"username\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Username))
+		out.Int64(int64(in.ID))
 	}
 	{
-		const prefix string = ",\"password\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		out.String(string(in.Password))
+		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"email\":"
+		const prefix string = ",\"thumbnail_url\":"
 		out.RawString(prefix)
-		out.String(string(in.Email))
+		out.String(string(in.Thumbnail))
 	}
-	out.RawByte('}')
-}
-
-// MarshalJSON supports json.Marshaler interface
-func (v LoginData) MarshalJSON() ([]byte, error) {
-	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(&w, v)
-	return w.Buffer.BuildBytes(), w.Error
-}
-
-// MarshalEasyJSON supports easyjson.Marshaler interface
-func (v LoginData) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(w, v)
-}
-
-// UnmarshalJSON supports json.Unmarshaler interface
-func (v *LoginData) UnmarshalJSON(data []byte) error {
-	r := jlexer.Lexer{Data: data}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Duration))
+	}
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"album\":"
+		out.RawString(prefix)
+		out.String(string(in.Album))
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v29, v30 := range in.Artists {
+				if v29 > 0 {
+					out.RawByte(',')
+				}
+				if v30 == nil {
+					out.RawString("null")
+				} else {
+					(*v30).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.BPM != 0 {
+		const prefix string = ",\"bpm\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.BPM))
+	}
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsLiked))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Track) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Track) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Track) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Track) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery23(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(in *jlexer.Lexer, out *Suggestion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(out *jwriter.Writer, in Suggestion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumbnail))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Suggestion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Suggestion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Suggestion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
 	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *LoginData) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(l, v)
+func (v *Suggestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery24(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(in *jlexer.Lexer, out *SuggestResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query":
+			out.Query = string(in.String())
+		case "layout_corrected":
+			out.LayoutCorrected = bool(in.Bool())
+		case "suggestions":
+			if in.IsNull() {
+				in.Skip()
+				out.Suggestions = nil
+			} else {
+				in.Delim('[')
+				if out.Suggestions == nil {
+					if !in.IsDelim(']') {
+						out.Suggestions = make([]*Suggestion, 0, 8)
+					} else {
+						out.Suggestions = []*Suggestion{}
+					}
+				} else {
+					out.Suggestions = (out.Suggestions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v31 *Suggestion
+					if in.IsNull() {
+						in.Skip()
+						v31 = nil
+					} else {
+						if v31 == nil {
+							v31 = new(Suggestion)
+						}
+						(*v31).UnmarshalEasyJSON(in)
+					}
+					out.Suggestions = append(out.Suggestions, v31)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(out *jwriter.Writer, in SuggestResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"layout_corrected\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.LayoutCorrected))
+	}
+	{
+		const prefix string = ",\"suggestions\":"
+		out.RawString(prefix)
+		if in.Suggestions == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v32, v33 := range in.Suggestions {
+				if v32 > 0 {
+					out.RawByte(',')
+				}
+				if v33 == nil {
+					out.RawString("null")
+				} else {
+					(*v33).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuggestResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuggestResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuggestResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuggestResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(in *jlexer.Lexer, out *SuccessCreateAlbum) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "album_id":
+			out.AlbumID = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(out *jwriter.Writer, in SuccessCreateAlbum) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.AlbumID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SuccessCreateAlbum) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SuccessCreateAlbum) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SuccessCreateAlbum) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SuccessCreateAlbum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(in *jlexer.Lexer, out *StreamID) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(out *jwriter.Writer, in StreamID) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v StreamID) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v StreamID) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *StreamID) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *StreamID) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(in *jlexer.Lexer, out *Statistics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "minutes_listened":
+			out.MinutesListened = int64(in.Int64())
+		case "tracks_listened":
+			out.TracksListened = int64(in.Int64())
+		case "artists_listened":
+			out.ArtistsListened = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(out *jwriter.Writer, in Statistics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"minutes_listened\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.MinutesListened))
+	}
+	{
+		const prefix string = ",\"tracks_listened\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TracksListened))
+	}
+	{
+		const prefix string = ",\"artists_listened\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ArtistsListened))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Statistics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Statistics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Statistics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Statistics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(in *jlexer.Lexer, out *SearchResultStat) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "clicks":
+			out.Clicks = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(out *jwriter.Writer, in SearchResultStat) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"clicks\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Clicks))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchResultStat) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchResultStat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchResultStat) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchResultStat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(in *jlexer.Lexer, out *SearchResult) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query":
+			out.Query = string(in.String())
+		case "layout_corrected":
+			out.LayoutCorrected = bool(in.Bool())
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]*Track, 0, 8)
+					} else {
+						out.Tracks = []*Track{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v34 *Track
+					if in.IsNull() {
+						in.Skip()
+						v34 = nil
+					} else {
+						if v34 == nil {
+							v34 = new(Track)
+						}
+						(*v34).UnmarshalEasyJSON(in)
+					}
+					out.Tracks = append(out.Tracks, v34)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "albums":
+			if in.IsNull() {
+				in.Skip()
+				out.Albums = nil
+			} else {
+				in.Delim('[')
+				if out.Albums == nil {
+					if !in.IsDelim(']') {
+						out.Albums = make([]*Album, 0, 8)
+					} else {
+						out.Albums = []*Album{}
+					}
+				} else {
+					out.Albums = (out.Albums)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v35 *Album
+					if in.IsNull() {
+						in.Skip()
+						v35 = nil
+					} else {
+						if v35 == nil {
+							v35 = new(Album)
+						}
+						(*v35).UnmarshalEasyJSON(in)
+					}
+					out.Albums = append(out.Albums, v35)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "artists":
+			if in.IsNull() {
+				in.Skip()
+				out.Artists = nil
+			} else {
+				in.Delim('[')
+				if out.Artists == nil {
+					if !in.IsDelim(']') {
+						out.Artists = make([]*Artist, 0, 8)
+					} else {
+						out.Artists = []*Artist{}
+					}
+				} else {
+					out.Artists = (out.Artists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v36 *Artist
+					if in.IsNull() {
+						in.Skip()
+						v36 = nil
+					} else {
+						if v36 == nil {
+							v36 = new(Artist)
+						}
+						(*v36).UnmarshalEasyJSON(in)
+					}
+					out.Artists = append(out.Artists, v36)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "playlists":
+			if in.IsNull() {
+				in.Skip()
+				out.Playlists = nil
+			} else {
+				in.Delim('[')
+				if out.Playlists == nil {
+					if !in.IsDelim(']') {
+						out.Playlists = make([]*Playlist, 0, 8)
+					} else {
+						out.Playlists = []*Playlist{}
+					}
+				} else {
+					out.Playlists = (out.Playlists)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v37 *Playlist
+					if in.IsNull() {
+						in.Skip()
+						v37 = nil
+					} else {
+						if v37 == nil {
+							v37 = new(Playlist)
+						}
+						(*v37).UnmarshalEasyJSON(in)
+					}
+					out.Playlists = append(out.Playlists, v37)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(out *jwriter.Writer, in SearchResult) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"layout_corrected\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.LayoutCorrected))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v38, v39 := range in.Tracks {
+				if v38 > 0 {
+					out.RawByte(',')
+				}
+				if v39 == nil {
+					out.RawString("null")
+				} else {
+					(*v39).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"albums\":"
+		out.RawString(prefix)
+		if in.Albums == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v40, v41 := range in.Albums {
+				if v40 > 0 {
+					out.RawByte(',')
+				}
+				if v41 == nil {
+					out.RawString("null")
+				} else {
+					(*v41).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		if in.Artists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v42, v43 := range in.Artists {
+				if v42 > 0 {
+					out.RawByte(',')
+				}
+				if v43 == nil {
+					out.RawString("null")
+				} else {
+					(*v43).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"playlists\":"
+		out.RawString(prefix)
+		if in.Playlists == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v44, v45 := range in.Playlists {
+				if v44 > 0 {
+					out.RawByte(',')
+				}
+				if v45 == nil {
+					out.RawString("null")
+				} else {
+					(*v45).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchResult) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchResult) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchResult) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchResult) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(in *jlexer.Lexer, out *SearchQueryStat) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query":
+			out.Query = string(in.String())
+		case "count":
+			out.Count = int64(in.Int64())
+		case "clicks":
+			out.Clicks = int64(in.Int64())
+		case "click_through_rate":
+			out.ClickThroughRate = float64(in.Float64())
+		case "top_results":
+			if in.IsNull() {
+				in.Skip()
+				out.TopResults = nil
+			} else {
+				in.Delim('[')
+				if out.TopResults == nil {
+					if !in.IsDelim(']') {
+						out.TopResults = make([]*SearchResultStat, 0, 8)
+					} else {
+						out.TopResults = []*SearchResultStat{}
+					}
+				} else {
+					out.TopResults = (out.TopResults)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v46 *SearchResultStat
+					if in.IsNull() {
+						in.Skip()
+						v46 = nil
+					} else {
+						if v46 == nil {
+							v46 = new(SearchResultStat)
+						}
+						(*v46).UnmarshalEasyJSON(in)
+					}
+					out.TopResults = append(out.TopResults, v46)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(out *jwriter.Writer, in SearchQueryStat) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"count\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Count))
+	}
+	{
+		const prefix string = ",\"clicks\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Clicks))
+	}
+	{
+		const prefix string = ",\"click_through_rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ClickThroughRate))
+	}
+	if len(in.TopResults) != 0 {
+		const prefix string = ",\"top_results\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v47, v48 := range in.TopResults {
+				if v47 > 0 {
+					out.RawByte(',')
+				}
+				if v48 == nil {
+					out.RawString("null")
+				} else {
+					(*v48).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchQueryStat) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchQueryStat) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchQueryStat) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchQueryStat) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(in *jlexer.Lexer, out *SearchClick) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "query":
+			out.Query = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(out *jwriter.Writer, in SearchClick) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"query\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Query))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchClick) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchClick) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchClick) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchClick) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(in *jlexer.Lexer, out *SearchAnalytics) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "total_searches":
+			out.TotalSearches = int64(in.Int64())
+		case "total_clicks":
+			out.TotalClicks = int64(in.Int64())
+		case "click_through_rate":
+			out.ClickThroughRate = float64(in.Float64())
+		case "top_queries":
+			if in.IsNull() {
+				in.Skip()
+				out.TopQueries = nil
+			} else {
+				in.Delim('[')
+				if out.TopQueries == nil {
+					if !in.IsDelim(']') {
+						out.TopQueries = make([]*SearchQueryStat, 0, 8)
+					} else {
+						out.TopQueries = []*SearchQueryStat{}
+					}
+				} else {
+					out.TopQueries = (out.TopQueries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v49 *SearchQueryStat
+					if in.IsNull() {
+						in.Skip()
+						v49 = nil
+					} else {
+						if v49 == nil {
+							v49 = new(SearchQueryStat)
+						}
+						(*v49).UnmarshalEasyJSON(in)
+					}
+					out.TopQueries = append(out.TopQueries, v49)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "zero_result_queries":
+			if in.IsNull() {
+				in.Skip()
+				out.ZeroResultQueries = nil
+			} else {
+				in.Delim('[')
+				if out.ZeroResultQueries == nil {
+					if !in.IsDelim(']') {
+						out.ZeroResultQueries = make([]*SearchQueryStat, 0, 8)
+					} else {
+						out.ZeroResultQueries = []*SearchQueryStat{}
+					}
+				} else {
+					out.ZeroResultQueries = (out.ZeroResultQueries)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v50 *SearchQueryStat
+					if in.IsNull() {
+						in.Skip()
+						v50 = nil
+					} else {
+						if v50 == nil {
+							v50 = new(SearchQueryStat)
+						}
+						(*v50).UnmarshalEasyJSON(in)
+					}
+					out.ZeroResultQueries = append(out.ZeroResultQueries, v50)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(out *jwriter.Writer, in SearchAnalytics) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"total_searches\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TotalSearches))
+	}
+	{
+		const prefix string = ",\"total_clicks\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.TotalClicks))
+	}
+	{
+		const prefix string = ",\"click_through_rate\":"
+		out.RawString(prefix)
+		out.Float64(float64(in.ClickThroughRate))
+	}
+	{
+		const prefix string = ",\"top_queries\":"
+		out.RawString(prefix)
+		if in.TopQueries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v51, v52 := range in.TopQueries {
+				if v51 > 0 {
+					out.RawByte(',')
+				}
+				if v52 == nil {
+					out.RawString("null")
+				} else {
+					(*v52).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"zero_result_queries\":"
+		out.RawString(prefix)
+		if in.ZeroResultQueries == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v53, v54 := range in.ZeroResultQueries {
+				if v53 > 0 {
+					out.RawByte(',')
+				}
+				if v54 == nil {
+					out.RawString("null")
+				} else {
+					(*v54).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v SearchAnalytics) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v SearchAnalytics) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *SearchAnalytics) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *SearchAnalytics) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(in *jlexer.Lexer, out *ResolveDuplicateFlagRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(out *jwriter.Writer, in ResolveDuplicateFlagRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Status))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ResolveDuplicateFlagRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ResolveDuplicateFlagRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ResolveDuplicateFlagRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ResolveDuplicateFlagRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(in *jlexer.Lexer, out *ReplaceTrackAudioRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "upload_id":
+			out.UploadID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(out *jwriter.Writer, in ReplaceTrackAudioRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"upload_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.UploadID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ReplaceTrackAudioRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ReplaceTrackAudioRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ReplaceTrackAudioRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ReplaceTrackAudioRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(in *jlexer.Lexer, out *Release) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "artists":
+			out.ArtistNames = string(in.String())
+		case "album_id":
+			out.AlbumID = int64(in.Int64())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(out *jwriter.Writer, in Release) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumbnail))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"artists\":"
+		out.RawString(prefix)
+		out.String(string(in.ArtistNames))
+	}
+	{
+		const prefix string = ",\"album_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Release) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Release) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Release) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Release) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(in *jlexer.Lexer, out *RegisterData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "username":
+			out.Username = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(out *jwriter.Writer, in RegisterData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v RegisterData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v RegisterData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *RegisterData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *RegisterData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(in *jlexer.Lexer, out *Privacy) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_public_playlists":
+			out.IsPublicPlaylists = bool(in.Bool())
+		case "is_public_minutes_listened":
+			out.IsPublicMinutesListened = bool(in.Bool())
+		case "is_public_favorite_artists":
+			out.IsPublicFavoriteArtists = bool(in.Bool())
+		case "is_public_tracks_listened":
+			out.IsPublicTracksListened = bool(in.Bool())
+		case "is_public_favorite_tracks":
+			out.IsPublicFavoriteTracks = bool(in.Bool())
+		case "is_public_artists_listened":
+			out.IsPublicArtistsListened = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(out *jwriter.Writer, in Privacy) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_public_playlists\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsPublicPlaylists))
+	}
+	{
+		const prefix string = ",\"is_public_minutes_listened\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPublicMinutesListened))
+	}
+	{
+		const prefix string = ",\"is_public_favorite_artists\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPublicFavoriteArtists))
+	}
+	{
+		const prefix string = ",\"is_public_tracks_listened\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPublicTracksListened))
+	}
+	{
+		const prefix string = ",\"is_public_favorite_tracks\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPublicFavoriteTracks))
+	}
+	{
+		const prefix string = ",\"is_public_artists_listened\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsPublicArtistsListened))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Privacy) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Privacy) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Privacy) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Privacy) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(in *jlexer.Lexer, out *PreviewAlbumRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "upload_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.UploadIDs = nil
+			} else {
+				in.Delim('[')
+				if out.UploadIDs == nil {
+					if !in.IsDelim(']') {
+						out.UploadIDs = make([]string, 0, 4)
+					} else {
+						out.UploadIDs = []string{}
+					}
+				} else {
+					out.UploadIDs = (out.UploadIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v55 string
+					v55 = string(in.String())
+					out.UploadIDs = append(out.UploadIDs, v55)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(out *jwriter.Writer, in PreviewAlbumRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"upload_ids\":"
+		out.RawString(prefix[1:])
+		if in.UploadIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v56, v57 := range in.UploadIDs {
+				if v56 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v57))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PreviewAlbumRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PreviewAlbumRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PreviewAlbumRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PreviewAlbumRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(in *jlexer.Lexer, out *PlaylistWithIsLiked) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_liked":
+			out.IsLiked = bool(in.Bool())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(out *jwriter.Writer, in PlaylistWithIsLiked) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_liked\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsLiked))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumbnail))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistWithIsLiked) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistWithIsLiked) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistWithIsLiked) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistWithIsLiked) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(in *jlexer.Lexer, out *PlaylistWithIsIncludedTrack) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "is_included":
+			out.IsIncluded = bool(in.Bool())
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(out *jwriter.Writer, in PlaylistWithIsIncludedTrack) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"is_included\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsIncluded))
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumbnail))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistWithIsIncludedTrack) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistWithIsIncludedTrack) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistWithIsIncludedTrack) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistWithIsIncludedTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(in *jlexer.Lexer, out *PlaylistLikeRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "value":
+			out.IsLike = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(out *jwriter.Writer, in PlaylistLikeRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"value\":"
+		out.RawString(prefix[1:])
+		out.Bool(bool(in.IsLike))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v PlaylistLikeRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v PlaylistLikeRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *PlaylistLikeRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *PlaylistLikeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(in *jlexer.Lexer, out *Playlist) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "username":
+			out.Username = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(out *jwriter.Writer, in Playlist) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumbnail))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Playlist) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Playlist) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Playlist) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Playlist) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(in *jlexer.Lexer, out *Pagination) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "offset":
+			out.Offset = int(in.Int())
+		case "limit":
+			out.Limit = int(in.Int())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(out *jwriter.Writer, in Pagination) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"offset\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Offset))
+	}
+	{
+		const prefix string = ",\"limit\":"
+		out.RawString(prefix)
+		out.Int(int(in.Limit))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Pagination) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Pagination) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Pagination) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Pagination) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(in *jlexer.Lexer, out *NotificationPreferences) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "preferences":
+			if in.IsNull() {
+				in.Skip()
+				out.Preferences = nil
+			} else {
+				in.Delim('[')
+				if out.Preferences == nil {
+					if !in.IsDelim(']') {
+						out.Preferences = make([]*NotificationPreference, 0, 8)
+					} else {
+						out.Preferences = []*NotificationPreference{}
+					}
+				} else {
+					out.Preferences = (out.Preferences)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v58 *NotificationPreference
+					if in.IsNull() {
+						in.Skip()
+						v58 = nil
+					} else {
+						if v58 == nil {
+							v58 = new(NotificationPreference)
+						}
+						(*v58).UnmarshalEasyJSON(in)
+					}
+					out.Preferences = append(out.Preferences, v58)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(out *jwriter.Writer, in NotificationPreferences) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"preferences\":"
+		out.RawString(prefix[1:])
+		if in.Preferences == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v59, v60 := range in.Preferences {
+				if v59 > 0 {
+					out.RawByte(',')
+				}
+				if v60 == nil {
+					out.RawString("null")
+				} else {
+					(*v60).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationPreferences) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationPreferences) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationPreferences) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationPreferences) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(in *jlexer.Lexer, out *NotificationPreference) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "is_enabled":
+			out.IsEnabled = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(out *jwriter.Writer, in NotificationPreference) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"is_enabled\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsEnabled))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v NotificationPreference) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v NotificationPreference) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *NotificationPreference) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *NotificationPreference) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(in *jlexer.Lexer, out *Notification) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "type":
+			out.Type = string(in.String())
+		case "actor_name":
+			out.ActorName = string(in.String())
+		case "entity_id":
+			out.EntityID = int64(in.Int64())
+		case "entity_title":
+			out.EntityTitle = string(in.String())
+		case "entity_thumbnail":
+			out.EntityThumbnail = string(in.String())
+		case "entity_thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.EntityImages = nil
+			} else {
+				if out.EntityImages == nil {
+					out.EntityImages = new(Images)
+				}
+				(*out.EntityImages).UnmarshalEasyJSON(in)
+			}
+		case "is_read":
+			out.IsRead = bool(in.Bool())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(out *jwriter.Writer, in Notification) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	if in.ActorName != "" {
+		const prefix string = ",\"actor_name\":"
+		out.RawString(prefix)
+		out.String(string(in.ActorName))
+	}
+	{
+		const prefix string = ",\"entity_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EntityID))
+	}
+	{
+		const prefix string = ",\"entity_title\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityTitle))
+	}
+	{
+		const prefix string = ",\"entity_thumbnail\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityThumbnail))
+	}
+	if in.EntityImages != nil {
+		const prefix string = ",\"entity_thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.EntityImages).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"is_read\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.IsRead))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Notification) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Notification) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Notification) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Notification) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(in *jlexer.Lexer, out *Message) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "msg":
+			out.Message = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(out *jwriter.Writer, in Message) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"msg\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Message))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Message) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Message) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Message) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Message) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(in *jlexer.Lexer, out *LyricsLine) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "time_ms":
+			out.Time = int64(in.Int64())
+		case "text":
+			out.Text = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(out *jwriter.Writer, in LyricsLine) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"time_ms\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Time))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LyricsLine) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LyricsLine) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LyricsLine) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LyricsLine) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(in *jlexer.Lexer, out *LoginData) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "username":
+			out.Username = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "email":
+			out.Email = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(out *jwriter.Writer, in LoginData) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		out.String(string(in.Email))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LoginData) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LoginData) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LoginData) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LoginData) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(in *jlexer.Lexer, out *LabelAlbum) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "album":
+			if in.IsNull() {
+				in.Skip()
+				out.Album = nil
+			} else {
+				if out.Album == nil {
+					out.Album = new(Album)
+				}
+				(*out.Album).UnmarshalEasyJSON(in)
+			}
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]*Track, 0, 8)
+					} else {
+						out.Tracks = []*Track{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v61 *Track
+					if in.IsNull() {
+						in.Skip()
+						v61 = nil
+					} else {
+						if v61 == nil {
+							v61 = new(Track)
+						}
+						(*v61).UnmarshalEasyJSON(in)
+					}
+					out.Tracks = append(out.Tracks, v61)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(out *jwriter.Writer, in LabelAlbum) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"album\":"
+		out.RawString(prefix[1:])
+		if in.Album == nil {
+			out.RawString("null")
+		} else {
+			(*in.Album).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v62, v63 := range in.Tracks {
+				if v62 > 0 {
+					out.RawByte(',')
+				}
+				if v63 == nil {
+					out.RawString("null")
+				} else {
+					(*v63).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v LabelAlbum) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v LabelAlbum) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *LabelAlbum) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *LabelAlbum) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(in *jlexer.Lexer, out *Label) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.Id = int64(in.Int64())
+		case "usernames":
+			if in.IsNull() {
+				in.Skip()
+				out.Usernames = nil
+			} else {
+				in.Delim('[')
+				if out.Usernames == nil {
+					if !in.IsDelim(']') {
+						out.Usernames = make([]string, 0, 4)
+					} else {
+						out.Usernames = []string{}
+					}
+				} else {
+					out.Usernames = (out.Usernames)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v64 string
+					v64 = string(in.String())
+					out.Usernames = append(out.Usernames, v64)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "label_name":
+			out.LabelName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(out *jwriter.Writer, in Label) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Id != 0 {
+		const prefix string = ",\"id\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Id))
+	}
+	{
+		const prefix string = ",\"usernames\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
+		}
+		if in.Usernames == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v65, v66 := range in.Usernames {
+				if v65 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v66))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"label_name\":"
+		out.RawString(prefix)
+		out.String(string(in.LabelName))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Label) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Label) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Label) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Label) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(in *jlexer.Lexer, out *JamSummary) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "room_id":
+			out.RoomID = string(in.String())
+		case "host_id":
+			out.HostID = string(in.String())
+		case "host_username":
+			out.HostName = string(in.String())
+		case "host_avatar_url":
+			out.HostAvatar = string(in.String())
+		case "host_avatar_images":
+			if in.IsNull() {
+				in.Skip()
+				out.HostImages = nil
+			} else {
+				if out.HostImages == nil {
+					out.HostImages = new(Images)
+				}
+				(*out.HostImages).UnmarshalEasyJSON(in)
+			}
+		case "track_id":
+			out.TrackID = string(in.String())
+		case "paused":
+			out.Paused = bool(in.Bool())
+		case "listeners":
+			out.Listeners = int64(in.Int64())
+		case "private":
+			out.Private = bool(in.Bool())
+		case "has_password":
+			out.HasPassword = bool(in.Bool())
+		case "friend":
+			out.Friend = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(out *jwriter.Writer, in JamSummary) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"room_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.RoomID))
+	}
+	{
+		const prefix string = ",\"host_id\":"
+		out.RawString(prefix)
+		out.String(string(in.HostID))
+	}
+	{
+		const prefix string = ",\"host_username\":"
+		out.RawString(prefix)
+		out.String(string(in.HostName))
+	}
+	{
+		const prefix string = ",\"host_avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.HostAvatar))
+	}
+	if in.HostImages != nil {
+		const prefix string = ",\"host_avatar_images\":"
+		out.RawString(prefix)
+		(*in.HostImages).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackID))
+	}
+	{
+		const prefix string = ",\"paused\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Paused))
+	}
+	{
+		const prefix string = ",\"listeners\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Listeners))
+	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
+	{
+		const prefix string = ",\"has_password\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.HasPassword))
+	}
+	{
+		const prefix string = ",\"friend\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Friend))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamSummary) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamSummary) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamSummary) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamSummary) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(in *jlexer.Lexer, out *JamSuggestion) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "track_id":
+			out.TrackID = string(in.String())
+		case "votes":
+			out.Votes = int64(in.Int64())
+		case "suggested_by":
+			out.SuggestedBy = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(out *jwriter.Writer, in JamSuggestion) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.TrackID))
+	}
+	{
+		const prefix string = ",\"votes\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Votes))
+	}
+	{
+		const prefix string = ",\"suggested_by\":"
+		out.RawString(prefix)
+		out.String(string(in.SuggestedBy))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamSuggestion) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamSuggestion) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamSuggestion) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamSuggestion) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery55(in *jlexer.Lexer, out *JamSessionTrack) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.TrackID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "thumbnail_url":
+			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "duration":
+			out.Duration = int64(in.Int64())
+		case "played_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.PlayedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery55(out *jwriter.Writer, in JamSessionTrack) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.TrackID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"thumbnail_url\":"
+		out.RawString(prefix)
+		out.String(string(in.Thumbnail))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Duration))
+	}
+	{
+		const prefix string = ",\"played_at\":"
+		out.RawString(prefix)
+		out.Raw((in.PlayedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamSessionTrack) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery55(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamSessionTrack) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery55(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamSessionTrack) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery55(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamSessionTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery55(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery56(in *jlexer.Lexer, out *JamSessionParticipant) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.UserID = int64(in.Int64())
+		case "username":
+			out.Username = string(in.String())
+		case "avatar_url":
+			out.AvatarURL = string(in.String())
+		case "avatar_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
+		case "joined_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.JoinedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery56(out *jwriter.Writer, in JamSessionParticipant) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.UserID))
+	}
+	{
+		const prefix string = ",\"username\":"
+		out.RawString(prefix)
+		out.String(string(in.Username))
+	}
+	{
+		const prefix string = ",\"avatar_url\":"
+		out.RawString(prefix)
+		out.String(string(in.AvatarURL))
+	}
+	if in.Images != nil {
+		const prefix string = ",\"avatar_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"joined_at\":"
+		out.RawString(prefix)
+		out.Raw((in.JoinedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamSessionParticipant) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery56(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamSessionParticipant) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery56(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamSessionParticipant) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery56(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamSessionParticipant) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery56(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery57(in *jlexer.Lexer, out *JamSessionDetails) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "participant_list":
+			if in.IsNull() {
+				in.Skip()
+				out.ParticipantList = nil
+			} else {
+				in.Delim('[')
+				if out.ParticipantList == nil {
+					if !in.IsDelim(']') {
+						out.ParticipantList = make([]*JamSessionParticipant, 0, 8)
+					} else {
+						out.ParticipantList = []*JamSessionParticipant{}
+					}
+				} else {
+					out.ParticipantList = (out.ParticipantList)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v67 *JamSessionParticipant
+					if in.IsNull() {
+						in.Skip()
+						v67 = nil
+					} else {
+						if v67 == nil {
+							v67 = new(JamSessionParticipant)
+						}
+						(*v67).UnmarshalEasyJSON(in)
+					}
+					out.ParticipantList = append(out.ParticipantList, v67)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "track_list":
+			if in.IsNull() {
+				in.Skip()
+				out.TrackList = nil
+			} else {
+				in.Delim('[')
+				if out.TrackList == nil {
+					if !in.IsDelim(']') {
+						out.TrackList = make([]*JamSessionTrack, 0, 8)
+					} else {
+						out.TrackList = []*JamSessionTrack{}
+					}
+				} else {
+					out.TrackList = (out.TrackList)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v68 *JamSessionTrack
+					if in.IsNull() {
+						in.Skip()
+						v68 = nil
+					} else {
+						if v68 == nil {
+							v68 = new(JamSessionTrack)
+						}
+						(*v68).UnmarshalEasyJSON(in)
+					}
+					out.TrackList = append(out.TrackList, v68)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "id":
+			out.ID = int64(in.Int64())
+		case "room_id":
+			out.RoomID = string(in.String())
+		case "host_id":
+			out.HostID = int64(in.Int64())
+		case "host_username":
+			out.HostName = string(in.String())
+		case "started_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartedAt).UnmarshalJSON(data))
+			}
+		case "ended_at":
+			if in.IsNull() {
+				in.Skip()
+				out.EndedAt = nil
+			} else {
+				if out.EndedAt == nil {
+					out.EndedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EndedAt).UnmarshalJSON(data))
+				}
+			}
+		case "participants":
+			out.Participants = int64(in.Int64())
+		case "tracks":
+			out.Tracks = int64(in.Int64())
+		case "duration":
+			out.Duration = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery57(out *jwriter.Writer, in JamSessionDetails) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"participant_list\":"
+		out.RawString(prefix[1:])
+		if in.ParticipantList == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v69, v70 := range in.ParticipantList {
+				if v69 > 0 {
+					out.RawByte(',')
+				}
+				if v70 == nil {
+					out.RawString("null")
+				} else {
+					(*v70).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"track_list\":"
+		out.RawString(prefix)
+		if in.TrackList == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v71, v72 := range in.TrackList {
+				if v71 > 0 {
+					out.RawByte(',')
+				}
+				if v72 == nil {
+					out.RawString("null")
+				} else {
+					(*v72).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"room_id\":"
+		out.RawString(prefix)
+		out.String(string(in.RoomID))
+	}
+	{
+		const prefix string = ",\"host_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.HostID))
+	}
+	{
+		const prefix string = ",\"host_username\":"
+		out.RawString(prefix)
+		out.String(string(in.HostName))
+	}
+	{
+		const prefix string = ",\"started_at\":"
+		out.RawString(prefix)
+		out.Raw((in.StartedAt).MarshalJSON())
+	}
+	if in.EndedAt != nil {
+		const prefix string = ",\"ended_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.EndedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"participants\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Participants))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Tracks))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Duration))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamSessionDetails) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery57(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamSessionDetails) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery57(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamSessionDetails) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery57(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamSessionDetails) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery57(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery58(in *jlexer.Lexer, out *JamSession) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "room_id":
+			out.RoomID = string(in.String())
+		case "host_id":
+			out.HostID = int64(in.Int64())
+		case "host_username":
+			out.HostName = string(in.String())
+		case "started_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.StartedAt).UnmarshalJSON(data))
+			}
+		case "ended_at":
+			if in.IsNull() {
+				in.Skip()
+				out.EndedAt = nil
+			} else {
+				if out.EndedAt == nil {
+					out.EndedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.EndedAt).UnmarshalJSON(data))
+				}
+			}
+		case "participants":
+			out.Participants = int64(in.Int64())
+		case "tracks":
+			out.Tracks = int64(in.Int64())
+		case "duration":
+			out.Duration = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery58(out *jwriter.Writer, in JamSession) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"room_id\":"
+		out.RawString(prefix)
+		out.String(string(in.RoomID))
+	}
+	{
+		const prefix string = ",\"host_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.HostID))
+	}
+	{
+		const prefix string = ",\"host_username\":"
+		out.RawString(prefix)
+		out.String(string(in.HostName))
+	}
+	{
+		const prefix string = ",\"started_at\":"
+		out.RawString(prefix)
+		out.Raw((in.StartedAt).MarshalJSON())
+	}
+	if in.EndedAt != nil {
+		const prefix string = ",\"ended_at\":"
+		out.RawString(prefix)
+		out.Raw((*in.EndedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"participants\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Participants))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Tracks))
+	}
+	{
+		const prefix string = ",\"duration\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Duration))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamSession) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery58(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamSession) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery58(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamSession) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery58(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamSession) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery58(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery59(in *jlexer.Lexer, out *JamMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "track_id":
+			out.TrackID = string(in.String())
+		case "position":
+			out.Position = int64(in.Int64())
+		case "paused":
+			out.Paused = bool(in.Bool())
+		case "host_id":
+			out.HostID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "users":
+			if in.IsNull() {
+				in.Skip()
+				out.Users = nil
+			} else {
+				in.Delim('[')
+				if out.Users == nil {
+					if !in.IsDelim(']') {
+						out.Users = make([]string, 0, 4)
+					} else {
+						out.Users = []string{}
+					}
+				} else {
+					out.Users = (out.Users)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v73 string
+					v73 = string(in.String())
+					out.Users = append(out.Users, v73)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "loaded":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.Loaded = make(map[string]bool)
+				} else {
+					out.Loaded = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v74 bool
+					v74 = bool(in.Bool())
+					(out.Loaded)[key] = v74
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "error":
+			out.Error = string(in.String())
+		case "user_images":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.UserImages = make(map[string]string)
+				} else {
+					out.UserImages = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v75 string
+					v75 = string(in.String())
+					(out.UserImages)[key] = v75
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "user_names":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				in.Delim('{')
+				if !in.IsDelim('}') {
+					out.UserNames = make(map[string]string)
+				} else {
+					out.UserNames = nil
+				}
+				for !in.IsDelim('}') {
+					key := string(in.String())
+					in.WantColon()
+					var v76 string
+					v76 = string(in.String())
+					(out.UserNames)[key] = v76
+					in.WantComma()
+				}
+				in.Delim('}')
+			}
+		case "queue":
+			if in.IsNull() {
+				in.Skip()
+				out.Queue = nil
+			} else {
+				in.Delim('[')
+				if out.Queue == nil {
+					if !in.IsDelim(']') {
+						out.Queue = make([]string, 0, 4)
+					} else {
+						out.Queue = []string{}
+					}
+				} else {
+					out.Queue = (out.Queue)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v77 string
+					v77 = string(in.String())
+					out.Queue = append(out.Queue, v77)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "suggestions":
+			if in.IsNull() {
+				in.Skip()
+				out.Suggestions = nil
+			} else {
+				in.Delim('[')
+				if out.Suggestions == nil {
+					if !in.IsDelim(']') {
+						out.Suggestions = make([]*JamSuggestion, 0, 8)
+					} else {
+						out.Suggestions = []*JamSuggestion{}
+					}
+				} else {
+					out.Suggestions = (out.Suggestions)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v78 *JamSuggestion
+					if in.IsNull() {
+						in.Skip()
+						v78 = nil
+					} else {
+						if v78 == nil {
+							v78 = new(JamSuggestion)
+						}
+						(*v78).UnmarshalEasyJSON(in)
+					}
+					out.Suggestions = append(out.Suggestions, v78)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "auto_advance":
+			out.AutoAdvance = bool(in.Bool())
+		case "cohosts":
+			if in.IsNull() {
+				in.Skip()
+				out.CoHosts = nil
+			} else {
+				in.Delim('[')
+				if out.CoHosts == nil {
+					if !in.IsDelim(']') {
+						out.CoHosts = make([]string, 0, 4)
+					} else {
+						out.CoHosts = []string{}
+					}
+				} else {
+					out.CoHosts = (out.CoHosts)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v79 string
+					v79 = string(in.String())
+					out.CoHosts = append(out.CoHosts, v79)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "server_time":
+			out.ServerTime = int64(in.Int64())
+		case "message_id":
+			out.MessageID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "emoji":
+			out.Emoji = string(in.String())
+		case "chat":
+			if in.IsNull() {
+				in.Skip()
+				out.Chat = nil
+			} else {
+				in.Delim('[')
+				if out.Chat == nil {
+					if !in.IsDelim(']') {
+						out.Chat = make([]*JamChatMessage, 0, 8)
+					} else {
+						out.Chat = []*JamChatMessage{}
+					}
+				} else {
+					out.Chat = (out.Chat)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v80 *JamChatMessage
+					if in.IsNull() {
+						in.Skip()
+						v80 = nil
+					} else {
+						if v80 == nil {
+							v80 = new(JamChatMessage)
+						}
+						(*v80).UnmarshalEasyJSON(in)
+					}
+					out.Chat = append(out.Chat, v80)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "muted":
+			if in.IsNull() {
+				in.Skip()
+				out.Muted = nil
+			} else {
+				in.Delim('[')
+				if out.Muted == nil {
+					if !in.IsDelim(']') {
+						out.Muted = make([]string, 0, 4)
+					} else {
+						out.Muted = []string{}
+					}
+				} else {
+					out.Muted = (out.Muted)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v81 string
+					v81 = string(in.String())
+					out.Muted = append(out.Muted, v81)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "client_time":
+			out.ClientTime = int64(in.Int64())
+		case "received_at":
+			out.ReceivedAt = int64(in.Int64())
+		case "lyrics":
+			if in.IsNull() {
+				in.Skip()
+				out.Lyrics = nil
+			} else {
+				in.Delim('[')
+				if out.Lyrics == nil {
+					if !in.IsDelim(']') {
+						out.Lyrics = make([]*TrackLyrics, 0, 8)
+					} else {
+						out.Lyrics = []*TrackLyrics{}
+					}
+				} else {
+					out.Lyrics = (out.Lyrics)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v82 *TrackLyrics
+					if in.IsNull() {
+						in.Skip()
+						v82 = nil
+					} else {
+						if v82 == nil {
+							v82 = new(TrackLyrics)
+						}
+						(*v82).UnmarshalEasyJSON(in)
+					}
+					out.Lyrics = append(out.Lyrics, v82)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery59(out *jwriter.Writer, in JamMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.TrackID != "" {
+		const prefix string = ",\"track_id\":"
+		out.RawString(prefix)
+		out.String(string(in.TrackID))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Position))
+	}
+	if in.Paused {
+		const prefix string = ",\"paused\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Paused))
+	}
+	if in.HostID != "" {
+		const prefix string = ",\"host_id\":"
+		out.RawString(prefix)
+		out.String(string(in.HostID))
+	}
+	if in.UserID != "" {
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	if len(in.Users) != 0 {
+		const prefix string = ",\"users\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v83, v84 := range in.Users {
+				if v83 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v84))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Loaded) != 0 {
+		const prefix string = ",\"loaded\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v85First := true
+			for v85Name, v85Value := range in.Loaded {
+				if v85First {
+					v85First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v85Name))
+				out.RawByte(':')
+				out.Bool(bool(v85Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if in.Error != "" {
+		const prefix string = ",\"error\":"
+		out.RawString(prefix)
+		out.String(string(in.Error))
+	}
+	if len(in.UserImages) != 0 {
+		const prefix string = ",\"user_images\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v86First := true
+			for v86Name, v86Value := range in.UserImages {
+				if v86First {
+					v86First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v86Name))
+				out.RawByte(':')
+				out.String(string(v86Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.UserNames) != 0 {
+		const prefix string = ",\"user_names\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('{')
+			v87First := true
+			for v87Name, v87Value := range in.UserNames {
+				if v87First {
+					v87First = false
+				} else {
+					out.RawByte(',')
+				}
+				out.String(string(v87Name))
+				out.RawByte(':')
+				out.String(string(v87Value))
+			}
+			out.RawByte('}')
+		}
+	}
+	if len(in.Queue) != 0 {
+		const prefix string = ",\"queue\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v88, v89 := range in.Queue {
+				if v88 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v89))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Suggestions) != 0 {
+		const prefix string = ",\"suggestions\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v90, v91 := range in.Suggestions {
+				if v90 > 0 {
+					out.RawByte(',')
+				}
+				if v91 == nil {
+					out.RawString("null")
+				} else {
+					(*v91).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.AutoAdvance {
+		const prefix string = ",\"auto_advance\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.AutoAdvance))
+	}
+	if len(in.CoHosts) != 0 {
+		const prefix string = ",\"cohosts\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v92, v93 := range in.CoHosts {
+				if v92 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v93))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ServerTime != 0 {
+		const prefix string = ",\"server_time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ServerTime))
+	}
+	if in.MessageID != "" {
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix)
+		out.String(string(in.MessageID))
+	}
+	if in.Text != "" {
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	if in.Emoji != "" {
+		const prefix string = ",\"emoji\":"
+		out.RawString(prefix)
+		out.String(string(in.Emoji))
+	}
+	if len(in.Chat) != 0 {
+		const prefix string = ",\"chat\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v94, v95 := range in.Chat {
+				if v94 > 0 {
+					out.RawByte(',')
+				}
+				if v95 == nil {
+					out.RawString("null")
+				} else {
+					(*v95).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.Muted) != 0 {
+		const prefix string = ",\"muted\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v96, v97 := range in.Muted {
+				if v96 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v97))
+			}
+			out.RawByte(']')
+		}
+	}
+	if in.ClientTime != 0 {
+		const prefix string = ",\"client_time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ClientTime))
+	}
+	if in.ReceivedAt != 0 {
+		const prefix string = ",\"received_at\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ReceivedAt))
+	}
+	if len(in.Lyrics) != 0 {
+		const prefix string = ",\"lyrics\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v98, v99 := range in.Lyrics {
+				if v98 > 0 {
+					out.RawByte(',')
+				}
+				if v99 == nil {
+					out.RawString("null")
+				} else {
+					(*v99).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery59(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery59(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery59(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery59(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery60(in *jlexer.Lexer, out *JamJoinMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "invite":
+			out.InviteToken = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery60(out *jwriter.Writer, in JamJoinMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	if in.InviteToken != "" {
+		const prefix string = ",\"invite\":"
+		out.RawString(prefix)
+		out.String(string(in.InviteToken))
+	}
+	if in.Password != "" {
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamJoinMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery60(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamJoinMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery60(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamJoinMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery60(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamJoinMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery60(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery61(in *jlexer.Lexer, out *JamChatMessage) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "message_id":
+			out.ID = string(in.String())
+		case "user_id":
+			out.UserID = string(in.String())
+		case "text":
+			out.Text = string(in.String())
+		case "server_time":
+			out.ServerTime = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery61(out *jwriter.Writer, in JamChatMessage) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"message_id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"user_id\":"
+		out.RawString(prefix)
+		out.String(string(in.UserID))
+	}
+	{
+		const prefix string = ",\"text\":"
+		out.RawString(prefix)
+		out.String(string(in.Text))
+	}
+	{
+		const prefix string = ",\"server_time\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.ServerTime))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v JamChatMessage) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery61(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v JamChatMessage) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery61(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *JamChatMessage) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery61(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *JamChatMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery61(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery62(in *jlexer.Lexer, out *Images) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "dominant_color":
+			out.DominantColor = string(in.String())
+		case "variants":
+			if in.IsNull() {
+				in.Skip()
+				out.Variants = nil
+			} else {
+				in.Delim('[')
+				if out.Variants == nil {
+					if !in.IsDelim(']') {
+						out.Variants = make([]*ImageVariant, 0, 8)
+					} else {
+						out.Variants = []*ImageVariant{}
+					}
+				} else {
+					out.Variants = (out.Variants)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v100 *ImageVariant
+					if in.IsNull() {
+						in.Skip()
+						v100 = nil
+					} else {
+						if v100 == nil {
+							v100 = new(ImageVariant)
+						}
+						(*v100).UnmarshalEasyJSON(in)
+					}
+					out.Variants = append(out.Variants, v100)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery62(out *jwriter.Writer, in Images) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"dominant_color\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.DominantColor))
+	}
+	{
+		const prefix string = ",\"variants\":"
+		out.RawString(prefix)
+		if in.Variants == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v101, v102 := range in.Variants {
+				if v101 > 0 {
+					out.RawByte(',')
+				}
+				if v102 == nil {
+					out.RawString("null")
+				} else {
+					(*v102).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Images) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery62(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Images) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery62(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Images) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery62(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Images) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery62(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery63(in *jlexer.Lexer, out *ImageVariant) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "size":
+			out.Size = int64(in.Int64())
+		case "avif":
+			out.AVIF = string(in.String())
+		case "webp":
+			out.WebP = string(in.String())
+		case "jpeg":
+			out.JPEG = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery63(out *jwriter.Writer, in ImageVariant) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"avif\":"
+		out.RawString(prefix)
+		out.String(string(in.AVIF))
+	}
+	{
+		const prefix string = ",\"webp\":"
+		out.RawString(prefix)
+		out.String(string(in.WebP))
+	}
+	{
+		const prefix string = ",\"jpeg\":"
+		out.RawString(prefix)
+		out.String(string(in.JPEG))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ImageVariant) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery63(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ImageVariant) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery63(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ImageVariant) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery63(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ImageVariant) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery63(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery64(in *jlexer.Lexer, out *EditTrackRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "position":
+			out.Position = int64(in.Int64())
+		case "artists_ids":
+			if in.IsNull() {
+				in.Skip()
+				out.ArtistsIDs = nil
+			} else {
+				in.Delim('[')
+				if out.ArtistsIDs == nil {
+					if !in.IsDelim(']') {
+						out.ArtistsIDs = make([]int64, 0, 8)
+					} else {
+						out.ArtistsIDs = []int64{}
+					}
+				} else {
+					out.ArtistsIDs = (out.ArtistsIDs)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v103 int64
+					v103 = int64(in.Int64())
+					out.ArtistsIDs = append(out.ArtistsIDs, v103)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery64(out *jwriter.Writer, in EditTrackRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ID))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"position\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Position))
+	}
+	if len(in.ArtistsIDs) != 0 {
+		const prefix string = ",\"artists_ids\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v104, v105 := range in.ArtistsIDs {
+				if v104 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v105))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EditTrackRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery64(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EditTrackRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery64(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EditTrackRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery64(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EditTrackRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery64(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery65(in *jlexer.Lexer, out *EditLabelRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "label_id":
+			out.LabelID = int64(in.Int64())
+		case "to_add":
+			if in.IsNull() {
+				in.Skip()
+				out.ToAdd = nil
+			} else {
+				in.Delim('[')
+				if out.ToAdd == nil {
+					if !in.IsDelim(']') {
+						out.ToAdd = make([]string, 0, 4)
+					} else {
+						out.ToAdd = []string{}
+					}
+				} else {
+					out.ToAdd = (out.ToAdd)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v106 string
+					v106 = string(in.String())
+					out.ToAdd = append(out.ToAdd, v106)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "to_remove":
+			if in.IsNull() {
+				in.Skip()
+				out.ToRemove = nil
+			} else {
+				in.Delim('[')
+				if out.ToRemove == nil {
+					if !in.IsDelim(']') {
+						out.ToRemove = make([]string, 0, 4)
+					} else {
+						out.ToRemove = []string{}
+					}
+				} else {
+					out.ToRemove = (out.ToRemove)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v107 string
+					v107 = string(in.String())
+					out.ToRemove = append(out.ToRemove, v107)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery65(out *jwriter.Writer, in EditLabelRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"label_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.LabelID))
+	}
+	if len(in.ToAdd) != 0 {
+		const prefix string = ",\"to_add\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v108, v109 := range in.ToAdd {
+				if v108 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v109))
+			}
+			out.RawByte(']')
+		}
+	}
+	if len(in.ToRemove) != 0 {
+		const prefix string = ",\"to_remove\":"
+		out.RawString(prefix)
+		{
+			out.RawByte('[')
+			for v110, v111 := range in.ToRemove {
+				if v110 > 0 {
+					out.RawByte(',')
+				}
+				out.String(string(v111))
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EditLabelRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery65(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EditLabelRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery65(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EditLabelRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery65(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EditLabelRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery65(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery66(in *jlexer.Lexer, out *EditArtistRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "artist_id":
+			out.ArtistID = int64(in.Int64())
+		case "new_title":
+			out.NewTitle = string(in.String())
+		case "image":
+			if in.IsNull() {
+				in.Skip()
+				out.Image = nil
+			} else {
+				out.Image = in.Bytes()
+			}
+		case "label_id":
+			out.LabelID = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery66(out *jwriter.Writer, in EditArtistRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"artist_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.ArtistID))
+	}
+	if in.NewTitle != "" {
+		const prefix string = ",\"new_title\":"
+		out.RawString(prefix)
+		out.String(string(in.NewTitle))
+	}
+	if len(in.Image) != 0 {
+		const prefix string = ",\"image\":"
+		out.RawString(prefix)
+		out.Base64Bytes(in.Image)
+	}
+	{
+		const prefix string = ",\"label_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LabelID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EditArtistRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery66(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EditArtistRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery66(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EditArtistRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery66(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EditArtistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery66(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(in *jlexer.Lexer, out *Label) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery67(in *jlexer.Lexer, out *EditAlbumTracksRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2223,33 +7616,60 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "id":
-			out.Id = int64(in.Int64())
-		case "usernames":
+		case "artists_ids":
 			if in.IsNull() {
 				in.Skip()
-				out.Usernames = nil
+				out.ArtistsIDs = nil
 			} else {
 				in.Delim('[')
-				if out.Usernames == nil {
+				if out.ArtistsIDs == nil {
 					if !in.IsDelim(']') {
-						out.Usernames = make([]string, 0, 4)
+						out.ArtistsIDs = make([]int64, 0, 8)
 					} else {
-						out.Usernames = []string{}
+						out.ArtistsIDs = []int64{}
 					}
 				} else {
-					out.Usernames = (out.Usernames)[:0]
+					out.ArtistsIDs = (out.ArtistsIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v10 string
-					v10 = string(in.String())
-					out.Usernames = append(out.Usernames, v10)
+					var v115 int64
+					v115 = int64(in.Int64())
+					out.ArtistsIDs = append(out.ArtistsIDs, v115)
+					in.WantComma()
+				}
+				in.Delim(']')
+			}
+		case "tracks":
+			if in.IsNull() {
+				in.Skip()
+				out.Tracks = nil
+			} else {
+				in.Delim('[')
+				if out.Tracks == nil {
+					if !in.IsDelim(']') {
+						out.Tracks = make([]*EditTrackRequest, 0, 8)
+					} else {
+						out.Tracks = []*EditTrackRequest{}
+					}
+				} else {
+					out.Tracks = (out.Tracks)[:0]
+				}
+				for !in.IsDelim(']') {
+					var v116 *EditTrackRequest
+					if in.IsNull() {
+						in.Skip()
+						v116 = nil
+					} else {
+						if v116 == nil {
+							v116 = new(EditTrackRequest)
+						}
+						(*v116).UnmarshalEasyJSON(in)
+					}
+					out.Tracks = append(out.Tracks, v116)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "label_name":
-			out.LabelName = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2260,69 +7680,73 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(out *jwriter.Writer, in Label) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery67(out *jwriter.Writer, in EditAlbumTracksRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
-	if in.Id != 0 {
-		const prefix string = ",\"id\":"
-		first = false
-		out.RawString(prefix[1:])
-		out.Int64(int64(in.Id))
-	}
 	{
-		const prefix string = ",\"usernames\":"
-		if first {
-			first = false
-			out.RawString(prefix[1:])
-		} else {
-			out.RawString(prefix)
-		}
-		if in.Usernames == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		const prefix string = ",\"artists_ids\":"
+		out.RawString(prefix[1:])
+		if in.ArtistsIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v11, v12 := range in.Usernames {
-				if v11 > 0 {
+			for v117, v118 := range in.ArtistsIDs {
+				if v117 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v12))
+				out.Int64(int64(v118))
 			}
 			out.RawByte(']')
 		}
 	}
 	{
-		const prefix string = ",\"label_name\":"
+		const prefix string = ",\"tracks\":"
 		out.RawString(prefix)
-		out.String(string(in.LabelName))
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v119, v120 := range in.Tracks {
+				if v119 > 0 {
+					out.RawByte(',')
+				}
+				if v120 == nil {
+					out.RawString("null")
+				} else {
+					(*v120).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v Label) MarshalJSON() ([]byte, error) {
+func (v EditAlbumTracksRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery67(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v Label) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(w, v)
+func (v EditAlbumTracksRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery67(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *Label) UnmarshalJSON(data []byte) error {
+func (v *EditAlbumTracksRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery67(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *Label) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery25(l, v)
+func (v *EditAlbumTracksRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery67(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(in *jlexer.Lexer, out *JamMessage) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery68(in *jlexer.Lexer, out *EditAlbumTrackRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2341,103 +7765,41 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "type":
-			out.Type = string(in.String())
-		case "track_id":
-			out.TrackID = string(in.String())
+		case "title":
+			out.Title = string(in.String())
 		case "position":
 			out.Position = int64(in.Int64())
-		case "paused":
-			out.Paused = bool(in.Bool())
-		case "host_id":
-			out.HostID = string(in.String())
-		case "user_id":
-			out.UserID = string(in.String())
-		case "users":
+		case "artists":
 			if in.IsNull() {
 				in.Skip()
-				out.Users = nil
+				out.Artists = nil
 			} else {
 				in.Delim('[')
-				if out.Users == nil {
+				if out.Artists == nil {
 					if !in.IsDelim(']') {
-						out.Users = make([]string, 0, 4)
+						out.Artists = make([]*TrackArtistRole, 0, 8)
 					} else {
-						out.Users = []string{}
+						out.Artists = []*TrackArtistRole{}
 					}
 				} else {
-					out.Users = (out.Users)[:0]
+					out.Artists = (out.Artists)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v13 string
-					v13 = string(in.String())
-					out.Users = append(out.Users, v13)
+					var v121 *TrackArtistRole
+					if in.IsNull() {
+						in.Skip()
+						v121 = nil
+					} else {
+						if v121 == nil {
+							v121 = new(TrackArtistRole)
+						}
+						(*v121).UnmarshalEasyJSON(in)
+					}
+					out.Artists = append(out.Artists, v121)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "loaded":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.Loaded = make(map[string]bool)
-				} else {
-					out.Loaded = nil
-				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v14 bool
-					v14 = bool(in.Bool())
-					(out.Loaded)[key] = v14
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
-		case "error":
-			out.Error = string(in.String())
-		case "user_images":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.UserImages = make(map[string]string)
-				} else {
-					out.UserImages = nil
-				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v15 string
-					v15 = string(in.String())
-					(out.UserImages)[key] = v15
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
-		case "user_names":
-			if in.IsNull() {
-				in.Skip()
-			} else {
-				in.Delim('{')
-				if !in.IsDelim('}') {
-					out.UserNames = make(map[string]string)
-				} else {
-					out.UserNames = nil
-				}
-				for !in.IsDelim('}') {
-					key := string(in.String())
-					in.WantColon()
-					var v16 string
-					v16 = string(in.String())
-					(out.UserNames)[key] = v16
-					in.WantComma()
-				}
-				in.Delim('}')
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2448,143 +7810,156 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(out *jwriter.Writer, in JamMessage) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery68(out *jwriter.Writer, in EditAlbumTrackRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"type\":"
+		const prefix string = ",\"title\":"
 		out.RawString(prefix[1:])
-		out.String(string(in.Type))
-	}
-	if in.TrackID != "" {
-		const prefix string = ",\"track_id\":"
-		out.RawString(prefix)
-		out.String(string(in.TrackID))
+		out.String(string(in.Title))
 	}
-	{
+	if in.Position != 0 {
 		const prefix string = ",\"position\":"
 		out.RawString(prefix)
 		out.Int64(int64(in.Position))
 	}
-	if in.Paused {
-		const prefix string = ",\"paused\":"
-		out.RawString(prefix)
-		out.Bool(bool(in.Paused))
-	}
-	if in.HostID != "" {
-		const prefix string = ",\"host_id\":"
-		out.RawString(prefix)
-		out.String(string(in.HostID))
-	}
-	if in.UserID != "" {
-		const prefix string = ",\"user_id\":"
-		out.RawString(prefix)
-		out.String(string(in.UserID))
-	}
-	if len(in.Users) != 0 {
-		const prefix string = ",\"users\":"
+	if len(in.Artists) != 0 {
+		const prefix string = ",\"artists\":"
 		out.RawString(prefix)
 		{
 			out.RawByte('[')
-			for v17, v18 := range in.Users {
-				if v17 > 0 {
+			for v122, v123 := range in.Artists {
+				if v122 > 0 {
 					out.RawByte(',')
 				}
-				out.String(string(v18))
+				if v123 == nil {
+					out.RawString("null")
+				} else {
+					(*v123).MarshalEasyJSON(out)
+				}
 			}
 			out.RawByte(']')
 		}
 	}
-	if len(in.Loaded) != 0 {
-		const prefix string = ",\"loaded\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('{')
-			v19First := true
-			for v19Name, v19Value := range in.Loaded {
-				if v19First {
-					v19First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v19Name))
-				out.RawByte(':')
-				out.Bool(bool(v19Value))
-			}
-			out.RawByte('}')
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v EditAlbumTrackRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery68(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v EditAlbumTrackRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery68(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *EditAlbumTrackRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery68(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *EditAlbumTrackRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery68(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery69(in *jlexer.Lexer, out *EditAlbumRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		case "type":
+			out.Type = string(in.String())
+		case "cover_upload_id":
+			out.CoverUploadID = string(in.String())
+		default:
+			in.SkipRecursive()
 		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
 	}
-	if in.Error != "" {
-		const prefix string = ",\"error\":"
-		out.RawString(prefix)
-		out.String(string(in.Error))
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery69(out *jwriter.Writer, in EditAlbumRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	if in.Title != "" {
+		const prefix string = ",\"title\":"
+		first = false
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
 	}
-	if len(in.UserImages) != 0 {
-		const prefix string = ",\"user_images\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('{')
-			v20First := true
-			for v20Name, v20Value := range in.UserImages {
-				if v20First {
-					v20First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v20Name))
-				out.RawByte(':')
-				out.String(string(v20Value))
-			}
-			out.RawByte('}')
+	if in.Type != "" {
+		const prefix string = ",\"type\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
+		out.String(string(in.Type))
 	}
-	if len(in.UserNames) != 0 {
-		const prefix string = ",\"user_names\":"
-		out.RawString(prefix)
-		{
-			out.RawByte('{')
-			v21First := true
-			for v21Name, v21Value := range in.UserNames {
-				if v21First {
-					v21First = false
-				} else {
-					out.RawByte(',')
-				}
-				out.String(string(v21Name))
-				out.RawByte(':')
-				out.String(string(v21Value))
-			}
-			out.RawByte('}')
+	if in.CoverUploadID != "" {
+		const prefix string = ",\"cover_upload_id\":"
+		if first {
+			first = false
+			out.RawString(prefix[1:])
+		} else {
+			out.RawString(prefix)
 		}
+		out.String(string(in.CoverUploadID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v JamMessage) MarshalJSON() ([]byte, error) {
+func (v EditAlbumRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery69(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v JamMessage) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(w, v)
+func (v EditAlbumRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery69(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *JamMessage) UnmarshalJSON(data []byte) error {
+func (v *EditAlbumRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery69(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *JamMessage) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery26(l, v)
+func (v *EditAlbumRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery69(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(in *jlexer.Lexer, out *EditLabelRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery70(in *jlexer.Lexer, out *DuplicateFlagTrack) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2603,54 +7978,14 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
+		case "id":
+			out.ID = int64(in.Int64())
+		case "title":
+			out.Title = string(in.String())
+		case "album_id":
+			out.AlbumID = int64(in.Int64())
 		case "label_id":
 			out.LabelID = int64(in.Int64())
-		case "to_add":
-			if in.IsNull() {
-				in.Skip()
-				out.ToAdd = nil
-			} else {
-				in.Delim('[')
-				if out.ToAdd == nil {
-					if !in.IsDelim(']') {
-						out.ToAdd = make([]string, 0, 4)
-					} else {
-						out.ToAdd = []string{}
-					}
-				} else {
-					out.ToAdd = (out.ToAdd)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v22 string
-					v22 = string(in.String())
-					out.ToAdd = append(out.ToAdd, v22)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
-		case "to_remove":
-			if in.IsNull() {
-				in.Skip()
-				out.ToRemove = nil
-			} else {
-				in.Delim('[')
-				if out.ToRemove == nil {
-					if !in.IsDelim(']') {
-						out.ToRemove = make([]string, 0, 4)
-					} else {
-						out.ToRemove = []string{}
-					}
-				} else {
-					out.ToRemove = (out.ToRemove)[:0]
-				}
-				for !in.IsDelim(']') {
-					var v23 string
-					v23 = string(in.String())
-					out.ToRemove = append(out.ToRemove, v23)
-					in.WantComma()
-				}
-				in.Delim(']')
-			}
 		default:
 			in.SkipRecursive()
 		}
@@ -2661,70 +7996,57 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(out *jwriter.Writer, in EditLabelRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery70(out *jwriter.Writer, in DuplicateFlagTrack) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"label_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.LabelID))
+		out.Int64(int64(in.ID))
 	}
-	if len(in.ToAdd) != 0 {
-		const prefix string = ",\"to_add\":"
+	{
+		const prefix string = ",\"title\":"
 		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v24, v25 := range in.ToAdd {
-				if v24 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v25))
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Title))
 	}
-	if len(in.ToRemove) != 0 {
-		const prefix string = ",\"to_remove\":"
+	{
+		const prefix string = ",\"album_id\":"
 		out.RawString(prefix)
-		{
-			out.RawByte('[')
-			for v26, v27 := range in.ToRemove {
-				if v26 > 0 {
-					out.RawByte(',')
-				}
-				out.String(string(v27))
-			}
-			out.RawByte(']')
-		}
+		out.Int64(int64(in.AlbumID))
+	}
+	{
+		const prefix string = ",\"label_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.LabelID))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EditLabelRequest) MarshalJSON() ([]byte, error) {
+func (v DuplicateFlagTrack) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery70(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EditLabelRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(w, v)
+func (v DuplicateFlagTrack) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery70(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EditLabelRequest) UnmarshalJSON(data []byte) error {
+func (v *DuplicateFlagTrack) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery70(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EditLabelRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery27(l, v)
+func (v *DuplicateFlagTrack) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery70(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(in *jlexer.Lexer, out *EditArtistRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery71(in *jlexer.Lexer, out *DuplicateFlag) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2743,19 +8065,20 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			continue
 		}
 		switch key {
-		case "artist_id":
-			out.ArtistID = int64(in.Int64())
-		case "new_title":
-			out.NewTitle = string(in.String())
-		case "image":
-			if in.IsNull() {
-				in.Skip()
-				out.Image = nil
-			} else {
-				out.Image = in.Bytes()
+		case "id":
+			out.ID = int64(in.Int64())
+		case "track":
+			(out.Track).UnmarshalEasyJSON(in)
+		case "matched_track":
+			(out.MatchedTrack).UnmarshalEasyJSON(in)
+		case "similarity":
+			out.Similarity = float64(in.Float64())
+		case "status":
+			out.Status = string(in.String())
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
 			}
-		case "label_id":
-			out.LabelID = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -2766,57 +8089,67 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(out *jwriter.Writer, in EditArtistRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery71(out *jwriter.Writer, in DuplicateFlag) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"artist_id\":"
+		const prefix string = ",\"id\":"
 		out.RawString(prefix[1:])
-		out.Int64(int64(in.ArtistID))
+		out.Int64(int64(in.ID))
 	}
-	if in.NewTitle != "" {
-		const prefix string = ",\"new_title\":"
+	{
+		const prefix string = ",\"track\":"
 		out.RawString(prefix)
-		out.String(string(in.NewTitle))
+		(in.Track).MarshalEasyJSON(out)
 	}
-	if len(in.Image) != 0 {
-		const prefix string = ",\"image\":"
+	{
+		const prefix string = ",\"matched_track\":"
 		out.RawString(prefix)
-		out.Base64Bytes(in.Image)
+		(in.MatchedTrack).MarshalEasyJSON(out)
 	}
 	{
-		const prefix string = ",\"label_id\":"
+		const prefix string = ",\"similarity\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.LabelID))
+		out.Float64(float64(in.Similarity))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v EditArtistRequest) MarshalJSON() ([]byte, error) {
+func (v DuplicateFlag) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery71(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v EditArtistRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(w, v)
+func (v DuplicateFlag) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery71(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *EditArtistRequest) UnmarshalJSON(data []byte) error {
+func (v *DuplicateFlag) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery71(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *EditArtistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery28(l, v)
+func (v *DuplicateFlag) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery71(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(in *jlexer.Lexer, out *DeleteArtistRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery72(in *jlexer.Lexer, out *DeleteArtistRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2847,7 +8180,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(out *jwriter.Writer, in DeleteArtistRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery72(out *jwriter.Writer, in DeleteArtistRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2862,27 +8195,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v DeleteArtistRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery72(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DeleteArtistRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery72(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *DeleteArtistRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery72(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *DeleteArtistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery29(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery72(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(in *jlexer.Lexer, out *DeleteAlbumRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery73(in *jlexer.Lexer, out *DeleteAlbumRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2915,7 +8248,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(out *jwriter.Writer, in DeleteAlbumRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery73(out *jwriter.Writer, in DeleteAlbumRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -2935,27 +8268,107 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v DeleteAlbumRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery73(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v DeleteAlbumRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery73(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *DeleteAlbumRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery73(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *DeleteAlbumRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery73(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery74(in *jlexer.Lexer, out *CreateUploadRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "kind":
+			out.Kind = string(in.String())
+		case "size":
+			out.Size = int64(in.Int64())
+		case "content_type":
+			out.ContentType = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery74(out *jwriter.Writer, in CreateUploadRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"kind\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Kind))
+	}
+	{
+		const prefix string = ",\"size\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.Size))
+	}
+	{
+		const prefix string = ",\"content_type\":"
+		out.RawString(prefix)
+		out.String(string(in.ContentType))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateUploadRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery74(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateUploadRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery74(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *DeleteAlbumRequest) UnmarshalJSON(data []byte) error {
+func (v *CreateUploadRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery74(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *DeleteAlbumRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery30(l, v)
+func (v *CreateUploadRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery74(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(in *jlexer.Lexer, out *CreateTrackRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery75(in *jlexer.Lexer, out *CreateTrackRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -2976,13 +8389,8 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		switch key {
 		case "title":
 			out.Title = string(in.String())
-		case "track":
-			if in.IsNull() {
-				in.Skip()
-				out.Track = nil
-			} else {
-				out.Track = in.Bytes()
-			}
+		case "upload_id":
+			out.UploadID = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -2993,7 +8401,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(out *jwriter.Writer, in CreateTrackRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery75(out *jwriter.Writer, in CreateTrackRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3003,9 +8411,9 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.String(string(in.Title))
 	}
 	{
-		const prefix string = ",\"track\":"
+		const prefix string = ",\"upload_id\":"
 		out.RawString(prefix)
-		out.Base64Bytes(in.Track)
+		out.String(string(in.UploadID))
 	}
 	out.RawByte('}')
 }
@@ -3013,27 +8421,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v CreateTrackRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery75(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateTrackRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery75(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateTrackRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery75(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateTrackRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery31(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery75(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(in *jlexer.Lexer, out *CreatePlaylistRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery76(in *jlexer.Lexer, out *CreatePlaylistRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3071,7 +8479,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(out *jwriter.Writer, in CreatePlaylistRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery76(out *jwriter.Writer, in CreatePlaylistRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3091,27 +8499,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v CreatePlaylistRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery76(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreatePlaylistRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery76(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreatePlaylistRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery76(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreatePlaylistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery32(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery76(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(in *jlexer.Lexer, out *CreateJamResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery77(in *jlexer.Lexer, out *CreateJamResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3134,6 +8542,8 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.RoomID = string(in.String())
 		case "host_id":
 			out.HostID = string(in.String())
+		case "invite_token":
+			out.InviteToken = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -3144,7 +8554,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(out *jwriter.Writer, in CreateJamResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery77(out *jwriter.Writer, in CreateJamResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3158,33 +8568,38 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.String(string(in.HostID))
 	}
+	{
+		const prefix string = ",\"invite_token\":"
+		out.RawString(prefix)
+		out.String(string(in.InviteToken))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreateJamResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery77(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateJamResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery77(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateJamResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery77(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateJamResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery33(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery77(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(in *jlexer.Lexer, out *CreateJamRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery78(in *jlexer.Lexer, out *CreateJamRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3207,6 +8622,12 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.TrackID = string(in.String())
 		case "position":
 			out.Position = int64(in.Int64())
+		case "private":
+			out.Private = bool(in.Bool())
+		case "password":
+			out.Password = string(in.String())
+		case "max_listeners":
+			out.MaxListeners = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3217,7 +8638,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(out *jwriter.Writer, in CreateJamRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery78(out *jwriter.Writer, in CreateJamRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3231,33 +8652,180 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.Int64(int64(in.Position))
 	}
+	{
+		const prefix string = ",\"private\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Private))
+	}
+	{
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"max_listeners\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.MaxListeners))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v CreateJamRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery78(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateJamRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery78(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateJamRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery78(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateJamRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery34(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery78(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery79(in *jlexer.Lexer, out *CreateJamPlaylistResponse) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "playlist_id":
+			out.PlaylistID = int64(in.Int64())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery79(out *jwriter.Writer, in CreateJamPlaylistResponse) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"playlist_id\":"
+		out.RawString(prefix[1:])
+		out.Int64(int64(in.PlaylistID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateJamPlaylistResponse) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery79(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateJamPlaylistResponse) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery79(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateJamPlaylistResponse) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery79(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateJamPlaylistResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery79(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery80(in *jlexer.Lexer, out *CreateJamPlaylistRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "title":
+			out.Title = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery80(out *jwriter.Writer, in CreateJamPlaylistRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Title))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateJamPlaylistRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery80(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateJamPlaylistRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery80(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateJamPlaylistRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery80(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateJamPlaylistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery80(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(in *jlexer.Lexer, out *CreateArtistRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery81(in *jlexer.Lexer, out *CreateArtistRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3297,7 +8865,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(out *jwriter.Writer, in CreateArtistRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery81(out *jwriter.Writer, in CreateArtistRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3322,27 +8890,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v CreateArtistRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery81(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v CreateArtistRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery81(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *CreateArtistRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery81(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *CreateArtistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery35(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery81(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(in *jlexer.Lexer, out *CreateAlbumRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery82(in *jlexer.Lexer, out *CreateAlbumRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3377,9 +8945,9 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 					out.ArtistsIDs = (out.ArtistsIDs)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v40 int64
-					v40 = int64(in.Int64())
-					out.ArtistsIDs = append(out.ArtistsIDs, v40)
+					var v130 int64
+					v130 = int64(in.Int64())
+					out.ArtistsIDs = append(out.ArtistsIDs, v130)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -3388,13 +8956,8 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.Type = string(in.String())
 		case "title":
 			out.Title = string(in.String())
-		case "image":
-			if in.IsNull() {
-				in.Skip()
-				out.Image = nil
-			} else {
-				out.Image = in.Bytes()
-			}
+		case "cover_upload_id":
+			out.CoverUploadID = string(in.String())
 		case "tracks":
 			if in.IsNull() {
 				in.Skip()
@@ -3411,23 +8974,21 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 					out.Tracks = (out.Tracks)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v42 *CreateTrackRequest
+					var v131 *CreateTrackRequest
 					if in.IsNull() {
 						in.Skip()
-						v42 = nil
+						v131 = nil
 					} else {
-						if v42 == nil {
-							v42 = new(CreateTrackRequest)
+						if v131 == nil {
+							v131 = new(CreateTrackRequest)
 						}
-						(*v42).UnmarshalEasyJSON(in)
+						(*v131).UnmarshalEasyJSON(in)
 					}
-					out.Tracks = append(out.Tracks, v42)
+					out.Tracks = append(out.Tracks, v131)
 					in.WantComma()
 				}
 				in.Delim(']')
 			}
-		case "label_id":
-			out.LabelID = int64(in.Int64())
 		default:
 			in.SkipRecursive()
 		}
@@ -3438,93 +8999,161 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(out *jwriter.Writer, in CreateAlbumRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery82(out *jwriter.Writer, in CreateAlbumRequest) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"artists_ids\":"
+		out.RawString(prefix[1:])
+		if in.ArtistsIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v132, v133 := range in.ArtistsIDs {
+				if v132 > 0 {
+					out.RawByte(',')
+				}
+				out.Int64(int64(v133))
+			}
+			out.RawByte(']')
+		}
+	}
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix)
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"title\":"
+		out.RawString(prefix)
+		out.String(string(in.Title))
+	}
+	{
+		const prefix string = ",\"cover_upload_id\":"
+		out.RawString(prefix)
+		out.String(string(in.CoverUploadID))
+	}
+	{
+		const prefix string = ",\"tracks\":"
+		out.RawString(prefix)
+		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+			out.RawString("null")
+		} else {
+			out.RawByte('[')
+			for v134, v135 := range in.Tracks {
+				if v134 > 0 {
+					out.RawByte(',')
+				}
+				if v135 == nil {
+					out.RawString("null")
+				} else {
+					(*v135).MarshalEasyJSON(out)
+				}
+			}
+			out.RawByte(']')
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateAlbumRequest) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery82(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateAlbumRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery82(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateAlbumRequest) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery82(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateAlbumRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery82(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery83(in *jlexer.Lexer, out *ChangeAlbumStatusRequest) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "status":
+			out.Status = string(in.String())
+		case "release_date":
+			out.ReleaseDate = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery83(out *jwriter.Writer, in ChangeAlbumStatusRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
 	{
-		const prefix string = ",\"artists_ids\":"
+		const prefix string = ",\"status\":"
 		out.RawString(prefix[1:])
-		if in.ArtistsIDs == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v43, v44 := range in.ArtistsIDs {
-				if v43 > 0 {
-					out.RawByte(',')
-				}
-				out.Int64(int64(v44))
-			}
-			out.RawByte(']')
-		}
-	}
-	{
-		const prefix string = ",\"type\":"
-		out.RawString(prefix)
-		out.String(string(in.Type))
-	}
-	{
-		const prefix string = ",\"title\":"
-		out.RawString(prefix)
-		out.String(string(in.Title))
-	}
-	{
-		const prefix string = ",\"image\":"
-		out.RawString(prefix)
-		out.Base64Bytes(in.Image)
-	}
-	{
-		const prefix string = ",\"tracks\":"
-		out.RawString(prefix)
-		if in.Tracks == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
-			out.RawString("null")
-		} else {
-			out.RawByte('[')
-			for v47, v48 := range in.Tracks {
-				if v47 > 0 {
-					out.RawByte(',')
-				}
-				if v48 == nil {
-					out.RawString("null")
-				} else {
-					(*v48).MarshalEasyJSON(out)
-				}
-			}
-			out.RawByte(']')
-		}
+		out.String(string(in.Status))
 	}
-	{
-		const prefix string = ",\"label_id\":"
+	if in.ReleaseDate != "" {
+		const prefix string = ",\"release_date\":"
 		out.RawString(prefix)
-		out.Int64(int64(in.LabelID))
+		out.String(string(in.ReleaseDate))
 	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
-func (v CreateAlbumRequest) MarshalJSON() ([]byte, error) {
+func (v ChangeAlbumStatusRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery83(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
-func (v CreateAlbumRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(w, v)
+func (v ChangeAlbumStatusRequest) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery83(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
-func (v *CreateAlbumRequest) UnmarshalJSON(data []byte) error {
+func (v *ChangeAlbumStatusRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery83(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
-func (v *CreateAlbumRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery36(l, v)
+func (v *ChangeAlbumStatusRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery83(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(in *jlexer.Lexer, out *AvatarURL) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery84(in *jlexer.Lexer, out *AvatarURL) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3545,6 +9174,16 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		switch key {
 		case "avatar_url":
 			out.AvatarUrl = string(in.String())
+		case "avatar_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
 		default:
 			in.SkipRecursive()
 		}
@@ -3555,7 +9194,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(out *jwriter.Writer, in AvatarURL) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery84(out *jwriter.Writer, in AvatarURL) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3564,33 +9203,38 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix[1:])
 		out.String(string(in.AvatarUrl))
 	}
+	if in.Images != nil {
+		const prefix string = ",\"avatar_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v AvatarURL) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery84(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AvatarURL) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery84(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AvatarURL) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery84(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AvatarURL) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery37(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery84(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(in *jlexer.Lexer, out *ArtistLikeRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery85(in *jlexer.Lexer, out *ArtistLikeRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3621,7 +9265,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(out *jwriter.Writer, in ArtistLikeRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery85(out *jwriter.Writer, in ArtistLikeRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3636,27 +9280,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v ArtistLikeRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery85(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArtistLikeRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery85(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArtistLikeRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery85(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArtistLikeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery38(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery85(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(in *jlexer.Lexer, out *ArtistFilters) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery86(in *jlexer.Lexer, out *ArtistFilters) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3695,7 +9339,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(out *jwriter.Writer, in ArtistFilters) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery86(out *jwriter.Writer, in ArtistFilters) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3714,27 +9358,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v ArtistFilters) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery86(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArtistFilters) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery86(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArtistFilters) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery86(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArtistFilters) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery39(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery86(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(in *jlexer.Lexer, out *ArtistDetailed) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery87(in *jlexer.Lexer, out *ArtistDetailed) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3765,6 +9409,16 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.Description = string(in.String())
 		case "thumbnail_url":
 			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
 		case "is_liked":
 			out.IsLiked = bool(in.Bool())
 		default:
@@ -3777,7 +9431,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(out *jwriter.Writer, in ArtistDetailed) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery87(out *jwriter.Writer, in ArtistDetailed) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3811,6 +9465,11 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.String(string(in.Thumbnail))
 	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"is_liked\":"
 		out.RawString(prefix)
@@ -3822,27 +9481,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v ArtistDetailed) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery87(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v ArtistDetailed) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery87(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *ArtistDetailed) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery87(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *ArtistDetailed) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery40(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery87(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(in *jlexer.Lexer, out *Artist) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery88(in *jlexer.Lexer, out *Artist) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3869,6 +9528,16 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.Description = string(in.String())
 		case "thumbnail_url":
 			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
 		case "is_liked":
 			out.IsLiked = bool(in.Bool())
 		default:
@@ -3881,7 +9550,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(out *jwriter.Writer, in Artist) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery88(out *jwriter.Writer, in Artist) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3905,6 +9574,11 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.String(string(in.Thumbnail))
 	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"is_liked\":"
 		out.RawString(prefix)
@@ -3916,27 +9590,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v Artist) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery88(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Artist) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery88(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Artist) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery88(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Artist) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery41(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery88(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(in *jlexer.Lexer, out *AlbumLikeRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery89(in *jlexer.Lexer, out *AlbumLikeRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -3967,7 +9641,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(out *jwriter.Writer, in AlbumLikeRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery89(out *jwriter.Writer, in AlbumLikeRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -3982,27 +9656,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v AlbumLikeRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery89(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AlbumLikeRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery89(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AlbumLikeRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery89(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AlbumLikeRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery42(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery89(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(in *jlexer.Lexer, out *AlbumFilters) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery90(in *jlexer.Lexer, out *AlbumFilters) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4041,7 +9715,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(out *jwriter.Writer, in AlbumFilters) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery90(out *jwriter.Writer, in AlbumFilters) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4060,27 +9734,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v AlbumFilters) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery90(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AlbumFilters) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery90(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AlbumFilters) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery90(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AlbumFilters) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery43(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery90(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(in *jlexer.Lexer, out *AlbumArtist) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery91(in *jlexer.Lexer, out *AlbumArtist) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4113,7 +9787,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(out *jwriter.Writer, in AlbumArtist) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery91(out *jwriter.Writer, in AlbumArtist) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4133,27 +9807,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v AlbumArtist) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery91(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AlbumArtist) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery91(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AlbumArtist) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery91(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AlbumArtist) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery44(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery91(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(in *jlexer.Lexer, out *Album) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery92(in *jlexer.Lexer, out *Album) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4178,6 +9852,16 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.Title = string(in.String())
 		case "thumbnail_url":
 			out.Thumbnail = string(in.String())
+		case "thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.Images = nil
+			} else {
+				if out.Images == nil {
+					out.Images = new(Images)
+				}
+				(*out.Images).UnmarshalEasyJSON(in)
+			}
 		case "artists":
 			if in.IsNull() {
 				in.Skip()
@@ -4194,17 +9878,17 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 					out.Artists = (out.Artists)[:0]
 				}
 				for !in.IsDelim(']') {
-					var v49 *AlbumArtist
+					var v136 *AlbumArtist
 					if in.IsNull() {
 						in.Skip()
-						v49 = nil
+						v136 = nil
 					} else {
-						if v49 == nil {
-							v49 = new(AlbumArtist)
+						if v136 == nil {
+							v136 = new(AlbumArtist)
 						}
-						(*v49).UnmarshalEasyJSON(in)
+						(*v136).UnmarshalEasyJSON(in)
 					}
-					out.Artists = append(out.Artists, v49)
+					out.Artists = append(out.Artists, v136)
 					in.WantComma()
 				}
 				in.Delim(']')
@@ -4217,6 +9901,8 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			}
 		case "is_liked":
 			out.IsLiked = bool(in.Bool())
+		case "status":
+			out.Status = string(in.String())
 		default:
 			in.SkipRecursive()
 		}
@@ -4227,7 +9913,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(out *jwriter.Writer, in Album) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery92(out *jwriter.Writer, in Album) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4246,6 +9932,11 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.String(string(in.Thumbnail))
 	}
+	if in.Images != nil {
+		const prefix string = ",\"thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.Images).MarshalEasyJSON(out)
+	}
 	{
 		const prefix string = ",\"artists\":"
 		out.RawString(prefix)
@@ -4253,14 +9944,14 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 			out.RawString("null")
 		} else {
 			out.RawByte('[')
-			for v50, v51 := range in.Artists {
-				if v50 > 0 {
+			for v137, v138 := range in.Artists {
+				if v137 > 0 {
 					out.RawByte(',')
 				}
-				if v51 == nil {
+				if v138 == nil {
 					out.RawString("null")
 				} else {
-					(*v51).MarshalEasyJSON(out)
+					(*v138).MarshalEasyJSON(out)
 				}
 			}
 			out.RawByte(']')
@@ -4281,33 +9972,38 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		out.RawString(prefix)
 		out.Bool(bool(in.IsLiked))
 	}
+	if in.Status != "" {
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
 	out.RawByte('}')
 }
 
 // MarshalJSON supports json.Marshaler interface
 func (v Album) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery92(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v Album) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery92(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *Album) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery92(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *Album) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery45(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery92(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(in *jlexer.Lexer, out *AddTrackToPlaylistRequest) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery93(in *jlexer.Lexer, out *AddTrackToPlaylistRequest) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4338,7 +10034,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(out *jwriter.Writer, in AddTrackToPlaylistRequest) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery93(out *jwriter.Writer, in AddTrackToPlaylistRequest) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4353,27 +10049,157 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v AddTrackToPlaylistRequest) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery93(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v AddTrackToPlaylistRequest) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery93(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *AddTrackToPlaylistRequest) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery93(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *AddTrackToPlaylistRequest) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery46(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery93(l, v)
+}
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery94(in *jlexer.Lexer, out *Activity) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "type":
+			out.Type = string(in.String())
+		case "user":
+			if in.IsNull() {
+				in.Skip()
+				out.User = nil
+			} else {
+				if out.User == nil {
+					out.User = new(UserPreview)
+				}
+				(*out.User).UnmarshalEasyJSON(in)
+			}
+		case "entity_id":
+			out.EntityID = int64(in.Int64())
+		case "entity_title":
+			out.EntityTitle = string(in.String())
+		case "entity_thumbnail":
+			out.EntityThumbnail = string(in.String())
+		case "entity_thumbnail_images":
+			if in.IsNull() {
+				in.Skip()
+				out.EntityImages = nil
+			} else {
+				if out.EntityImages == nil {
+					out.EntityImages = new(Images)
+				}
+				(*out.EntityImages).UnmarshalEasyJSON(in)
+			}
+		case "created_at":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery94(out *jwriter.Writer, in Activity) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"type\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Type))
+	}
+	{
+		const prefix string = ",\"user\":"
+		out.RawString(prefix)
+		if in.User == nil {
+			out.RawString("null")
+		} else {
+			(*in.User).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"entity_id\":"
+		out.RawString(prefix)
+		out.Int64(int64(in.EntityID))
+	}
+	{
+		const prefix string = ",\"entity_title\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityTitle))
+	}
+	{
+		const prefix string = ",\"entity_thumbnail\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityThumbnail))
+	}
+	if in.EntityImages != nil {
+		const prefix string = ",\"entity_thumbnail_images\":"
+		out.RawString(prefix)
+		(*in.EntityImages).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created_at\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Activity) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery94(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Activity) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery94(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Activity) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery94(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Activity) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery94(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(in *jlexer.Lexer, out *APIUnauthorizedErrorResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery95(in *jlexer.Lexer, out *APIUnauthorizedErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4406,7 +10232,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(out *jwriter.Writer, in APIUnauthorizedErrorResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery95(out *jwriter.Writer, in APIUnauthorizedErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4426,27 +10252,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APIUnauthorizedErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery95(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIUnauthorizedErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery95(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIUnauthorizedErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery95(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIUnauthorizedErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery47(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery95(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(in *jlexer.Lexer, out *APIResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery96(in *jlexer.Lexer, out *APIResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4485,7 +10311,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(out *jwriter.Writer, in APIResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery96(out *jwriter.Writer, in APIResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4511,27 +10337,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APIResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery96(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery96(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery96(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery48(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery96(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(in *jlexer.Lexer, out *APIRequestEntityTooLargeErrorResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery97(in *jlexer.Lexer, out *APIRequestEntityTooLargeErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4564,7 +10390,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(out *jwriter.Writer, in APIRequestEntityTooLargeErrorResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery97(out *jwriter.Writer, in APIRequestEntityTooLargeErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4584,27 +10410,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APIRequestEntityTooLargeErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery97(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIRequestEntityTooLargeErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery97(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIRequestEntityTooLargeErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery97(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIRequestEntityTooLargeErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery49(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery97(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(in *jlexer.Lexer, out *APINotFoundErrorResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery98(in *jlexer.Lexer, out *APINotFoundErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4637,7 +10463,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(out *jwriter.Writer, in APINotFoundErrorResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery98(out *jwriter.Writer, in APINotFoundErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4657,27 +10483,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APINotFoundErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery98(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APINotFoundErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery98(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APINotFoundErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery98(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APINotFoundErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery50(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery98(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(in *jlexer.Lexer, out *APIInternalServerErrorResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery99(in *jlexer.Lexer, out *APIInternalServerErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4710,7 +10536,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(out *jwriter.Writer, in APIInternalServerErrorResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery99(out *jwriter.Writer, in APIInternalServerErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4730,27 +10556,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APIInternalServerErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery99(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIInternalServerErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery99(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIInternalServerErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery99(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIInternalServerErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery51(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery99(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(in *jlexer.Lexer, out *APIForbiddenErrorResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery100(in *jlexer.Lexer, out *APIForbiddenErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4783,7 +10609,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(out *jwriter.Writer, in APIForbiddenErrorResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery100(out *jwriter.Writer, in APIForbiddenErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4803,27 +10629,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APIForbiddenErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery100(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIForbiddenErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery100(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIForbiddenErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery100(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIForbiddenErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery52(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery100(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(in *jlexer.Lexer, out *APIErrorResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery101(in *jlexer.Lexer, out *APIErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4856,7 +10682,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(out *jwriter.Writer, in APIErrorResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery101(out *jwriter.Writer, in APIErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4876,27 +10702,27 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APIErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery101(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery101(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery101(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery53(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery101(l, v)
 }
-func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(in *jlexer.Lexer, out *APIBadRequestErrorResponse) {
+func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery102(in *jlexer.Lexer, out *APIBadRequestErrorResponse) {
 	isTopLevel := in.IsStart()
 	if in.IsNull() {
 		if isTopLevel {
@@ -4929,7 +10755,7 @@ func easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 		in.Consumed()
 	}
 }
-func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(out *jwriter.Writer, in APIBadRequestErrorResponse) {
+func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery102(out *jwriter.Writer, in APIBadRequestErrorResponse) {
 	out.RawByte('{')
 	first := true
 	_ = first
@@ -4949,23 +10775,23 @@ func easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelD
 // MarshalJSON supports json.Marshaler interface
 func (v APIBadRequestErrorResponse) MarshalJSON() ([]byte, error) {
 	w := jwriter.Writer{}
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(&w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery102(&w, v)
 	return w.Buffer.BuildBytes(), w.Error
 }
 
 // MarshalEasyJSON supports easyjson.Marshaler interface
 func (v APIBadRequestErrorResponse) MarshalEasyJSON(w *jwriter.Writer) {
-	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(w, v)
+	easyjsonCea158a8EncodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery102(w, v)
 }
 
 // UnmarshalJSON supports json.Unmarshaler interface
 func (v *APIBadRequestErrorResponse) UnmarshalJSON(data []byte) error {
 	r := jlexer.Lexer{Data: data}
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(&r, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery102(&r, v)
 	return r.Error()
 }
 
 // UnmarshalEasyJSON supports easyjson.Unmarshaler interface
 func (v *APIBadRequestErrorResponse) UnmarshalEasyJSON(l *jlexer.Lexer) {
-	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery54(l, v)
+	easyjsonCea158a8DecodeGithubComGoParkMailRu20251ReturnZeroInternalPkgModelDelivery102(l, v)
 }
@@ -0,0 +1,17 @@
+package delivery
+
+// Images represents the variants generated for an uploaded thumbnail or avatar
+// @Description Sizes and encodings of an image, missing for images uploaded before variants were generated
+type Images struct {
+	DominantColor string          `json:"dominant_color" example:"#1f2e3d" description:"Most common color of the image for theming the page around it"`
+	Variants      []*ImageVariant `json:"variants" description:"Variants from the smallest to the largest"`
+}
+
+// ImageVariant represents one size of an image
+// @Description An image scaled to fit into a square box, smaller images are not upscaled
+type ImageVariant struct {
+	Size int64  `json:"size" example:"300" description:"Side of the box the image fits into in pixels"`
+	AVIF string `json:"avif" example:"https://example.com/image/300.avif" description:"URL of the AVIF encoding"`
+	WebP string `json:"webp" example:"https://example.com/image/300.webp" description:"URL of the WebP encoding"`
+	JPEG string `json:"jpeg" example:"https://example.com/image/300.jpg" description:"URL of the JPEG encoding"`
+}
@@ -1,25 +1,76 @@
 package delivery
 
 type CreateJamRequest struct {
-	TrackID  string `json:"track_id" valid:"required"`
-	Position int64  `json:"position" valid:"optional"`
+	TrackID      string `json:"track_id" valid:"required"`
+	Position     int64  `json:"position" valid:"optional"`
+	Private      bool   `json:"private" valid:"optional"`
+	Password     string `json:"password" valid:"optional"`
+	MaxListeners int64  `json:"max_listeners" valid:"optional"`
 }
 
 type CreateJamResponse struct {
-	RoomID string `json:"room_id"`
-	HostID string `json:"host_id"`
+	RoomID      string `json:"room_id"`
+	HostID      string `json:"host_id"`
+	InviteToken string `json:"invite_token"`
+}
+
+// JamJoinMessage is the first message a client sends on the jam socket
+type JamJoinMessage struct {
+	Type        string `json:"type"`
+	InviteToken string `json:"invite,omitempty"`
+	Password    string `json:"password,omitempty"`
 }
 
 type JamMessage struct {
-	Type       string            `json:"type"`
-	TrackID    string            `json:"track_id,omitempty"`
-	Position   int64             `json:"position"`
-	Paused     bool              `json:"paused,omitempty"`
-	HostID     string            `json:"host_id,omitempty"`
-	UserID     string            `json:"user_id,omitempty"`
-	Users      []string          `json:"users,omitempty"`
-	Loaded     map[string]bool   `json:"loaded,omitempty"`
-	Error      string            `json:"error,omitempty"`
-	UserImages map[string]string `json:"user_images,omitempty"`
-	UserNames  map[string]string `json:"user_names,omitempty"`
+	Type        string            `json:"type"`
+	TrackID     string            `json:"track_id,omitempty"`
+	Position    int64             `json:"position"`
+	Paused      bool              `json:"paused,omitempty"`
+	HostID      string            `json:"host_id,omitempty"`
+	UserID      string            `json:"user_id,omitempty"`
+	Users       []string          `json:"users,omitempty"`
+	Loaded      map[string]bool   `json:"loaded,omitempty"`
+	Error       string            `json:"error,omitempty"`
+	UserImages  map[string]string `json:"user_images,omitempty"`
+	UserNames   map[string]string `json:"user_names,omitempty"`
+	Queue       []string          `json:"queue,omitempty"`
+	Suggestions []*JamSuggestion  `json:"suggestions,omitempty"`
+	AutoAdvance bool              `json:"auto_advance,omitempty"`
+	CoHosts     []string          `json:"cohosts,omitempty"`
+	ServerTime  int64             `json:"server_time,omitempty"`
+	MessageID   string            `json:"message_id,omitempty"`
+	Text        string            `json:"text,omitempty"`
+	Emoji       string            `json:"emoji,omitempty"`
+	Chat        []*JamChatMessage `json:"chat,omitempty"`
+	Muted       []string          `json:"muted,omitempty"`
+	ClientTime  int64             `json:"client_time,omitempty"`
+	ReceivedAt  int64             `json:"received_at,omitempty"`
+	Lyrics      []*TrackLyrics    `json:"lyrics,omitempty"`
+}
+
+type JamChatMessage struct {
+	ID         string `json:"message_id"`
+	UserID     string `json:"user_id"`
+	Text       string `json:"text"`
+	ServerTime int64  `json:"server_time"`
+}
+
+type JamSuggestion struct {
+	TrackID     string `json:"track_id"`
+	Votes       int64  `json:"votes"`
+	SuggestedBy string `json:"suggested_by"`
+}
+
+type JamSummary struct {
+	RoomID      string  `json:"room_id"`
+	HostID      string  `json:"host_id"`
+	HostName    string  `json:"host_username"`
+	HostAvatar  string  `json:"host_avatar_url"`
+	HostImages  *Images `json:"host_avatar_images,omitempty"`
+	TrackID     string  `json:"track_id"`
+	Paused      bool    `json:"paused"`
+	Listeners   int64   `json:"listeners"`
+	Private     bool    `json:"private"`
+	HasPassword bool    `json:"has_password"`
+	Friend      bool    `json:"friend"`
 }
@@ -0,0 +1,50 @@
+package delivery
+
+import "time"
+
+// JamSession
+// @Description A jam the user took part in, ended_at is empty while the room is still open
+type JamSession struct {
+	ID           int64      `json:"id" example:"1"`
+	RoomID       string     `json:"room_id" example:"0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"`
+	HostID       int64      `json:"host_id" example:"1"`
+	HostName     string     `json:"host_username" example:"user"`
+	StartedAt    time.Time  `json:"started_at" example:"2025-01-01T00:00:00Z"`
+	EndedAt      *time.Time `json:"ended_at,omitempty" example:"2025-01-01T01:00:00Z"`
+	Participants int64      `json:"participants" example:"3"`
+	Tracks       int64      `json:"tracks" example:"12"`
+	Duration     int64      `json:"duration" example:"2700"`
+}
+
+type JamSessionParticipant struct {
+	UserID    int64     `json:"id" example:"1"`
+	Username  string    `json:"username" example:"user"`
+	AvatarURL string    `json:"avatar_url" example:"https://example.com/avatar.jpg"`
+	Images    *Images   `json:"avatar_images,omitempty"`
+	JoinedAt  time.Time `json:"joined_at" example:"2025-01-01T00:00:00Z"`
+}
+
+// JamSessionTrack
+// @Description A track played in a jam, duration is how many seconds the room spent on it
+type JamSessionTrack struct {
+	TrackID   int64     `json:"id" example:"1"`
+	Title     string    `json:"title" example:"Numb"`
+	Thumbnail string    `json:"thumbnail_url" example:"https://example.com/image.jpg"`
+	Images    *Images   `json:"thumbnail_images,omitempty"`
+	Duration  int64     `json:"duration" example:"185"`
+	PlayedAt  time.Time `json:"played_at" example:"2025-01-01T00:03:05Z"`
+}
+
+type JamSessionDetails struct {
+	JamSession
+	ParticipantList []*JamSessionParticipant `json:"participant_list"`
+	TrackList       []*JamSessionTrack       `json:"track_list"`
+}
+
+type CreateJamPlaylistRequest struct {
+	Title string `json:"title" valid:"optional"`
+}
+
+type CreateJamPlaylistResponse struct {
+	PlaylistID int64 `json:"playlist_id" example:"1"`
+}
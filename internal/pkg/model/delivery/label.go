@@ -1,5 +1,7 @@
 package delivery
 
+import "time"
+
 type CreateArtistRequest struct {
 	Title   string `json:"title"`
 	Image   []byte `json:"image"`
@@ -19,17 +21,19 @@ type DeleteArtistRequest struct {
 }
 
 type CreateTrackRequest struct {
-	Title string `json:"title"`
-	Track []byte `json:"track"`
+	Title    string `json:"title" example:"Track"`
+	UploadID string `json:"upload_id" example:"0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"`
 }
 
+// CreateAlbumRequest
+// @Description Album release, the cover and every track reference completed uploads
 type CreateAlbumRequest struct {
-	ArtistsIDs []int64               `json:"artists_ids"`
-	Type       string                `json:"type"`
-	Title      string                `json:"title"`
-	Image      []byte                `json:"image"`
-	Tracks     []*CreateTrackRequest `json:"tracks"`
-	LabelID    int64                 `json:"label_id"`
+	ArtistsIDs    []int64               `json:"artists_ids"`
+	Type          string                `json:"type" example:"album"`
+	Title         string                `json:"title" example:"Album"`
+	CoverUploadID string                `json:"cover_upload_id" example:"0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"`
+	Tracks        []*CreateTrackRequest `json:"tracks"`
+	LabelID       int64                 `json:"-"`
 }
 
 type EditLabelRequest struct {
@@ -42,3 +46,109 @@ type DeleteAlbumRequest struct {
 	AlbumID int64 `json:"album_id"`
 	LabelID int64 `json:"label_id"`
 }
+
+// ChangeAlbumStatusRequest
+// @Description Moves a release between draft, in_review, scheduled, published and taken_down. The release date is required to send a draft to review.
+type ChangeAlbumStatusRequest struct {
+	Status      string `json:"status" example:"in_review"`
+	ReleaseDate string `json:"release_date,omitempty" example:"2025-06-01"`
+}
+
+type EditTrackRequest struct {
+	ID         int64   `json:"id" example:"1"`
+	Title      string  `json:"title" example:"Track"`
+	Position   int64   `json:"position" example:"1"`
+	ArtistsIDs []int64 `json:"artists_ids,omitempty"`
+}
+
+// EditAlbumTracksRequest
+// @Description Every track of the draft with its new title and position, tracks without artists are credited to the album artists
+type EditAlbumTracksRequest struct {
+	ArtistsIDs []int64             `json:"artists_ids"`
+	Tracks     []*EditTrackRequest `json:"tracks"`
+}
+
+// LabelAlbum
+// @Description A release of the label in any status with all of its tracks
+type LabelAlbum struct {
+	Album  *Album   `json:"album"`
+	Tracks []*Track `json:"tracks"`
+}
+
+// EditAlbumRequest
+// @Description Empty title and type keep the current ones, a completed cover upload swaps the cover of the album and its tracks
+type EditAlbumRequest struct {
+	Title         string `json:"title,omitempty" example:"Album"`
+	Type          string `json:"type,omitempty" example:"ep"`
+	CoverUploadID string `json:"cover_upload_id,omitempty" example:"0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"`
+}
+
+type TrackArtistRole struct {
+	ArtistID int64  `json:"artist_id" example:"1"`
+	Role     string `json:"role" example:"featured"`
+}
+
+// EditAlbumTrackRequest
+// @Description Position 0 keeps the track in place, other tracks are shifted when it moves. Artists replace every credit of the track when given.
+type EditAlbumTrackRequest struct {
+	Title    string             `json:"title" example:"Track"`
+	Position int64              `json:"position,omitempty" example:"2"`
+	Artists  []*TrackArtistRole `json:"artists,omitempty"`
+}
+
+type ReplaceTrackAudioRequest struct {
+	UploadID string `json:"upload_id" example:"0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"`
+}
+
+// TrackLyricsRequest
+// @Description Lyrics are plain text or LRC with a time for every line, uploading a language again replaces its lyrics
+type TrackLyricsRequest struct {
+	Language    string `json:"language" example:"en"`
+	Translation bool   `json:"translation,omitempty" example:"false"`
+	Lyrics      string `json:"lyrics" example:"[00:12.50]First line of the song"`
+}
+
+type DuplicateFlagTrack struct {
+	ID      int64  `json:"id" example:"1"`
+	Title   string `json:"title" example:"Track"`
+	AlbumID int64  `json:"album_id" example:"1"`
+	LabelID int64  `json:"label_id" example:"1"`
+}
+
+// DuplicateFlag
+// @Description An uploaded track that sounds like a track already in the catalog, waiting for an admin to decide
+type DuplicateFlag struct {
+	ID           int64              `json:"id" example:"1"`
+	Track        DuplicateFlagTrack `json:"track"`
+	MatchedTrack DuplicateFlagTrack `json:"matched_track"`
+	Similarity   float64            `json:"similarity" example:"0.82"`
+	Status       string             `json:"status" example:"pending"`
+	CreatedAt    time.Time          `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
+
+// ResolveDuplicateFlagRequest
+// @Description Confirmed flags stay on record as duplicates, dismissed ones were a false match
+type ResolveDuplicateFlagRequest struct {
+	Status string `json:"status" example:"dismissed"`
+}
+
+// PreviewAlbumRequest
+// @Description Completed track uploads to read the embedded tags of
+type PreviewAlbumRequest struct {
+	UploadIDs []string `json:"upload_ids"`
+}
+
+// TrackMetadata
+// @Description Embedded tags of an uploaded track to prefill the release with, artist_ids are the label artists whose names match the tagged artists
+type TrackMetadata struct {
+	UploadID    string   `json:"upload_id" example:"0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"`
+	Format      string   `json:"format" example:"mp3"`
+	Title       string   `json:"title" example:"Track"`
+	TrackNumber int64    `json:"track_number" example:"1"`
+	Artists     []string `json:"artists"`
+	ArtistIDs   []int64  `json:"artist_ids"`
+	Genre       string   `json:"genre" example:"Rock"`
+	Year        int64    `json:"year" example:"2025"`
+	ISRC        string   `json:"isrc" example:"USRC17607839"`
+	CoverURL    string   `json:"cover_url,omitempty" example:"https://example.com/uploads/cover"`
+}
@@ -0,0 +1,36 @@
+package delivery
+
+import "time"
+
+// Notification
+// @Description Inbox entry. album_released comes from a liked artist, playlist_liked from someone liking your playlist
+type Notification struct {
+	ID              int64     `json:"id" example:"1"`
+	Type            string    `json:"type" example:"album_released"`
+	ActorName       string    `json:"actor_name,omitempty" example:"Linkin Park"`
+	EntityID        int64     `json:"entity_id" example:"1"`
+	EntityTitle     string    `json:"entity_title" example:"Hybrid Theory"`
+	EntityThumbnail string    `json:"entity_thumbnail" example:"https://example.com/image.jpg"`
+	EntityImages    *Images   `json:"entity_thumbnail_images,omitempty"`
+	IsRead          bool      `json:"is_read" example:"false"`
+	CreatedAt       time.Time `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
+
+// UnreadNotificationsCount
+// @Description Number of unread notifications
+type UnreadNotificationsCount struct {
+	Count int64 `json:"count" example:"3"`
+}
+
+// NotificationPreference
+// @Description Whether notifications of a type are delivered
+type NotificationPreference struct {
+	Type      string `json:"type" valid:"in(album_released|playlist_liked),required" example:"playlist_liked"`
+	IsEnabled bool   `json:"is_enabled" example:"true"`
+}
+
+// NotificationPreferences
+// @Description Notification settings of the current user
+type NotificationPreferences struct {
+	Preferences []*NotificationPreference `json:"preferences"`
+}
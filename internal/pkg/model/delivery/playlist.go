@@ -10,10 +10,11 @@ type CreatePlaylistRequest struct {
 // Playlist
 // @Description Playlist structure
 type Playlist struct {
-	ID        int64  `json:"id"`
-	Title     string `json:"title"`
-	Thumbnail string `json:"thumbnail_url"`
-	Username  string `json:"username"`
+	ID        int64   `json:"id"`
+	Title     string  `json:"title"`
+	Thumbnail string  `json:"thumbnail_url"`
+	Images    *Images `json:"thumbnail_images,omitempty"`
+	Username  string  `json:"username"`
 }
 
 // PlaylistWithIsIncludedTrack
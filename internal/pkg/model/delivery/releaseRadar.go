@@ -0,0 +1,16 @@
+package delivery
+
+import "time"
+
+// Release
+// @Description New album or track from an artist the user liked. Tracks of a new album are listed only through the album
+type Release struct {
+	Type        string    `json:"type" example:"album"`
+	ID          int64     `json:"id" example:"1"`
+	Title       string    `json:"title" example:"Hybrid Theory"`
+	Thumbnail   string    `json:"thumbnail_url" example:"https://example.com/image.jpg"`
+	Images      *Images   `json:"thumbnail_images,omitempty"`
+	ArtistNames string    `json:"artists" example:"Linkin Park"`
+	AlbumID     int64     `json:"album_id" example:"1"`
+	CreatedAt   time.Time `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
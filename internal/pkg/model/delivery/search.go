@@ -0,0 +1,66 @@
+package delivery
+
+// SearchResult
+// @Description Unified search result grouped by entity type
+type SearchResult struct {
+	Query           string      `json:"query"`
+	LayoutCorrected bool        `json:"layout_corrected"`
+	Tracks          []*Track    `json:"tracks"`
+	Albums          []*Album    `json:"albums"`
+	Artists         []*Artist   `json:"artists"`
+	Playlists       []*Playlist `json:"playlists"`
+}
+
+// Suggestion
+// @Description Single autocomplete suggestion
+type Suggestion struct {
+	Type      string  `json:"type" example:"artists"`
+	ID        int64   `json:"id" example:"1"`
+	Title     string  `json:"title" example:"Linkin Park"`
+	Thumbnail string  `json:"thumbnail_url" example:"https://example.com/image.jpg"`
+	Images    *Images `json:"thumbnail_images,omitempty"`
+}
+
+// SuggestResult
+// @Description Autocomplete suggestions for a search prefix
+type SuggestResult struct {
+	Query           string        `json:"query"`
+	LayoutCorrected bool          `json:"layout_corrected"`
+	Suggestions     []*Suggestion `json:"suggestions"`
+}
+
+// SearchClick
+// @Description Search result the user opened
+type SearchClick struct {
+	Query string `json:"query" valid:"required" example:"linkin park"`
+	Type  string `json:"type" valid:"in(tracks|albums|artists|playlists),required" example:"artists"`
+	ID    int64  `json:"id" valid:"required" example:"1"`
+}
+
+// SearchResultStat
+// @Description Search result opened from a query
+type SearchResultStat struct {
+	Type   string `json:"type" example:"artists"`
+	ID     int64  `json:"id" example:"1"`
+	Clicks int64  `json:"clicks" example:"12"`
+}
+
+// SearchQueryStat
+// @Description Aggregated statistics for a normalized query
+type SearchQueryStat struct {
+	Query            string              `json:"query" example:"linkin park"`
+	Count            int64               `json:"count" example:"42"`
+	Clicks           int64               `json:"clicks" example:"17"`
+	ClickThroughRate float64             `json:"click_through_rate" example:"0.4"`
+	TopResults       []*SearchResultStat `json:"top_results,omitempty"`
+}
+
+// SearchAnalytics
+// @Description Aggregated search analytics
+type SearchAnalytics struct {
+	TotalSearches     int64              `json:"total_searches"`
+	TotalClicks       int64              `json:"total_clicks"`
+	ClickThroughRate  float64            `json:"click_through_rate"`
+	TopQueries        []*SearchQueryStat `json:"top_queries"`
+	ZeroResultQueries []*SearchQueryStat `json:"zero_result_queries"`
+}
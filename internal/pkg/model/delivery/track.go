@@ -18,10 +18,12 @@ type Track struct {
 	ID        int64          `json:"id" example:"1" description:"Unique identifier"`
 	Title     string         `json:"title" example:"Lagtrain" description:"Track title"`
 	Thumbnail string         `json:"thumbnail_url" example:"https://example.com/image.jpg" description:"URL to the track thumbnail"`
+	Images    *Images        `json:"thumbnail_images,omitempty" description:"Variants of the track thumbnail"`
 	Duration  int64          `json:"duration" example:"216" description:"Track duration in seconds"`
 	AlbumID   int64          `json:"album_id" example:"1" description:"Unique identifier of the associated album"`
 	Album     string         `json:"album" description:"Associated album"`
 	Artists   []*TrackArtist `json:"artists" description:"Associated artists"`
+	BPM       float64        `json:"bpm,omitempty" example:"124.5" description:"Estimated tempo in beats per minute, missing until the track is analyzed"`
 	IsLiked   bool           `json:"is_liked" example:"false" description:"Whether the track is liked by the user"`
 }
 
@@ -54,7 +56,43 @@ type StreamID struct {
 // Description A music track entity with file url
 type TrackDetailed struct {
 	Track
-	FileUrl string `json:"file_url" example:"https://example.com/track.mp3" description:"URL to the track file"`
+	FileUrl      string            `json:"file_url" example:"https://example.com/track.mp3" description:"URL to the track file"`
+	Renditions   []*TrackRendition `json:"renditions,omitempty" description:"Transcoded versions of the track, empty until it is transcoded"`
+	LoudnessLUFS float64           `json:"loudness_lufs,omitempty" example:"-9.2" description:"EBU R128 integrated loudness of the track"`
+	ReplayGainDB float64           `json:"replay_gain_db,omitempty" example:"-8.8" description:"Gain to apply to play the track at -18 LUFS"`
+	Key          string            `json:"key,omitempty" example:"A minor" description:"Estimated musical key"`
+	HasLyrics    bool              `json:"has_lyrics" example:"true" description:"Whether the track has lyrics"`
+}
+
+// TrackLyrics represents lyrics of a track in one language
+// @Description Lyrics of a track in one language, synced lyrics have the time of every line for karaoke-style display
+type TrackLyrics struct {
+	Language    string        `json:"language" example:"en" description:"ISO 639 code of the language"`
+	Translation bool          `json:"translation" example:"false" description:"Whether the lyrics are a translation"`
+	Synced      bool          `json:"synced" example:"true" description:"Whether every line has a time"`
+	Lines       []*LyricsLine `json:"lines" description:"Lines in the order they are sung"`
+}
+
+// LyricsLine represents a line of lyrics
+// @Description A line of lyrics, an empty line of synced lyrics is an instrumental break
+type LyricsLine struct {
+	Time int64  `json:"time_ms" example:"12500" description:"Milliseconds from the start of the track, 0 for plain lyrics"`
+	Text string `json:"text" example:"First line of the song"`
+}
+
+// TrackWaveform represents the peaks of a track for drawing a waveform
+// @Description Peak amplitudes from 0 to 1 spread evenly over the duration of a track
+type TrackWaveform struct {
+	Duration int64     `json:"duration" example:"216" description:"Track duration in seconds"`
+	Peaks    []float64 `json:"peaks" example:"0.12,0.5,0.98" description:"Peak amplitudes from 0 to 1"`
+}
+
+// TrackRendition represents a transcoded version of a track
+// @Description A track file encoded with a specific codec and bitrate
+type TrackRendition struct {
+	Codec   string `json:"codec" example:"opus" description:"Audio codec, mp3 or opus"`
+	Bitrate int64  `json:"bitrate" example:"160" description:"Bitrate in kbps"`
+	FileUrl string `json:"file_url" example:"https://example.com/track-opus-160.ogg" description:"URL to the rendition file"`
 }
 
 // TrackLikeRequest represents a request to like or unlike an track
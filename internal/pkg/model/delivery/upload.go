@@ -0,0 +1,34 @@
+package delivery
+
+// CreateUploadRequest
+// @Description Starts a resumable upload of a track (audio/mpeg) or a cover (image/jpeg, image/png, image/gif, image/webp)
+type CreateUploadRequest struct {
+	Kind        string `json:"kind" example:"track"`
+	Size        int64  `json:"size" example:"8388608"`
+	ContentType string `json:"content_type" example:"audio/mpeg"`
+}
+
+// Upload
+// @Description Upload session state, uploaded_parts lists the parts the storage already has so a client can resume
+type Upload struct {
+	ID            string  `json:"id" example:"0b6a5f0e-3f1c-4a53-9d0a-7cf1a3f8a0de"`
+	Kind          string  `json:"kind" example:"track"`
+	Size          int64   `json:"size" example:"8388608"`
+	PartSize      int64   `json:"part_size" example:"8388608"`
+	PartsCount    int64   `json:"parts_count" example:"1"`
+	Status        string  `json:"status" example:"pending"`
+	UploadedParts []int64 `json:"uploaded_parts"`
+}
+
+// UploadPartsRequest
+// @Description Part numbers to sign, an empty list signs every part that is not uploaded yet
+type UploadPartsRequest struct {
+	Parts []int64 `json:"parts"`
+}
+
+// UploadPart
+// @Description Presigned URL the client PUTs the part body to
+type UploadPart struct {
+	Number int64  `json:"number" example:"1"`
+	URL    string `json:"url" example:"https://bucket.s3.cloud.ru/key?partNumber=1&uploadId=..."`
+}
@@ -1,13 +1,16 @@
 package delivery
 
+import "time"
+
 // UserToFront represents user data
 // @Description User data
 type UserToFront struct {
-	ID       int64  `json:"-"`
-	Username string `json:"username"`
-	Email    string `json:"email"`
-	Avatar   string `json:"avatar_url"`
-	IsLabel  bool   `json:"is_label"`
+	ID       int64   `json:"-"`
+	Username string  `json:"username"`
+	Email    string  `json:"email"`
+	Avatar   string  `json:"avatar_url"`
+	Images   *Images `json:"avatar_images,omitempty"`
+	IsLabel  bool    `json:"is_label"`
 }
 
 type UserDelete struct {
@@ -51,6 +54,7 @@ type UserFullData struct {
 	Username   string      `json:"username"`
 	Email      string      `json:"email,omitempty"`
 	AvatarUrl  string      `json:"avatar_url"`
+	Images     *Images     `json:"avatar_images,omitempty"`
 	Privacy    *Privacy    `json:"privacy,omitempty"`
 	Statistics *Statistics `json:"statistics,omitempty"`
 }
@@ -64,7 +68,8 @@ type UserChangeSettings struct {
 }
 
 type AvatarURL struct {
-	AvatarUrl string `json:"avatar_url"`
+	AvatarUrl string  `json:"avatar_url"`
+	Images    *Images `json:"avatar_images,omitempty"`
 }
 
 type Label struct {
@@ -72,3 +77,23 @@ type Label struct {
 	Usernames []string `json:"usernames"`
 	LabelName string   `json:"label_name"`
 }
+
+// UserPreview represents a user in followers and following lists
+// @Description Public user data
+type UserPreview struct {
+	Username  string  `json:"username" example:"user123"`
+	AvatarUrl string  `json:"avatar_url" example:"https://example.com/avatar.jpg"`
+	Images    *Images `json:"avatar_images,omitempty"`
+}
+
+// Activity represents an entry of the activity feed
+// @Description What a followed user did: liked_track, liked_album, liked_artist, created_playlist or listening
+type Activity struct {
+	Type            string       `json:"type" example:"liked_album"`
+	User            *UserPreview `json:"user"`
+	EntityID        int64        `json:"entity_id" example:"1"`
+	EntityTitle     string       `json:"entity_title" example:"Hybrid Theory"`
+	EntityThumbnail string       `json:"entity_thumbnail" example:"https://example.com/image.jpg"`
+	EntityImages    *Images      `json:"entity_thumbnail_images,omitempty"`
+	CreatedAt       time.Time    `json:"created_at" example:"2025-01-01T00:00:00Z"`
+}
@@ -1,9 +1,13 @@
 package repository
 
 type CreateJamRequest struct {
-	UserID   string `json:"user_id"`
-	TrackID  string `json:"track_id"`
-	Position int64  `json:"position"`
+	UserID       string `json:"user_id"`
+	TrackID      string `json:"track_id"`
+	Position     int64  `json:"position"`
+	Private      bool   `json:"private"`
+	PasswordHash string `json:"password_hash"`
+	InviteToken  string `json:"invite_token"`
+	MaxListeners int64  `json:"max_listeners"`
 }
 
 type CreateJamResponse struct {
@@ -17,14 +21,79 @@ type JoinJamRequest struct {
 }
 
 type JamMessage struct {
-	Type       string            `json:"type"`
-	TrackID    string            `json:"track_id,omitempty"`
-	Position   int64             `json:"position"`
-	Paused     bool              `json:"paused,omitempty"`
-	UserID     string            `json:"user_id,omitempty"`
-	HostID     string            `json:"host_id,omitempty"`
-	Users      []string          `json:"users,omitempty"`
-	Loaded     map[string]bool   `json:"loaded,omitempty"`
-	UserImages map[string]string `json:"user_images,omitempty"`
-	UserNames  map[string]string `json:"user_names,omitempty"`
+	Type        string            `json:"type"`
+	TrackID     string            `json:"track_id,omitempty"`
+	Position    int64             `json:"position"`
+	Paused      bool              `json:"paused,omitempty"`
+	UserID      string            `json:"user_id,omitempty"`
+	HostID      string            `json:"host_id,omitempty"`
+	Users       []string          `json:"users,omitempty"`
+	Loaded      map[string]bool   `json:"loaded,omitempty"`
+	UserImages  map[string]string `json:"user_images,omitempty"`
+	UserNames   map[string]string `json:"user_names,omitempty"`
+	Queue       []string          `json:"queue,omitempty"`
+	Suggestions []*JamSuggestion  `json:"suggestions,omitempty"`
+	AutoAdvance bool              `json:"auto_advance,omitempty"`
+	CoHosts     []string          `json:"cohosts,omitempty"`
+	ServerTime  int64             `json:"server_time,omitempty"`
+	MessageID   string            `json:"message_id,omitempty"`
+	Text        string            `json:"text,omitempty"`
+	Emoji       string            `json:"emoji,omitempty"`
+	Chat        []*JamChatMessage `json:"chat,omitempty"`
+	Muted       []string          `json:"muted,omitempty"`
+	Lyrics      []*JamLyrics      `json:"lyrics,omitempty"`
+}
+
+type JamChatMessage struct {
+	ID         string `json:"message_id"`
+	UserID     string `json:"user_id"`
+	Text       string `json:"text"`
+	ServerTime int64  `json:"server_time"`
+}
+
+// JamLyrics travels with the load message so that listeners don't each fetch them
+type JamLyrics struct {
+	Language    string           `json:"language"`
+	Translation bool             `json:"translation,omitempty"`
+	Synced      bool             `json:"synced,omitempty"`
+	Lines       []*JamLyricsLine `json:"lines"`
+}
+
+type JamLyricsLine struct {
+	Time int64  `json:"time"`
+	Text string `json:"text"`
+}
+
+type JamSuggestion struct {
+	TrackID     string `json:"track_id"`
+	Votes       int64  `json:"votes"`
+	SuggestedBy string `json:"suggested_by"`
+}
+
+// JamQueue is the shared queue of a room: approved tracks in play order and suggestions by votes
+type JamQueue struct {
+	Queue       []string
+	Suggestions []*JamSuggestion
+	AutoAdvance bool
+}
+
+// JamSummary is what the room listing shows about a room
+type JamSummary struct {
+	RoomID          string
+	HostID          string
+	HostName        string
+	HostAvatar      string
+	HostAvatarColor string
+	TrackID         string
+	Paused          bool
+	Listeners       int64
+	Private         bool
+	HasPassword     bool
+}
+
+// JamAccess holds who may join a room, an empty password hash means the room has no password
+type JamAccess struct {
+	Private      bool
+	PasswordHash string
+	InviteToken  string
 }
@@ -0,0 +1,55 @@
+package repository
+
+import "time"
+
+// JamPlayback is what the room is playing right now, used to record the track once the room moves on
+type JamPlayback struct {
+	TrackID  string
+	Position int64
+	// Played is the ms the track has been playing for, seeks do not change it
+	Played int64
+	HostID string
+	// Listened maps the host and listeners to the ms they have listened to the track for
+	Listened map[string]int64
+}
+
+// JamListen is how long a user who left the room listened to the track it was playing
+type JamListen struct {
+	TrackID  string
+	Listened int64
+}
+
+type JamSession struct {
+	ID           int64
+	RoomID       string
+	HostID       int64
+	HostName     string
+	StartedAt    time.Time
+	EndedAt      *time.Time
+	Participants int64
+	Tracks       int64
+	Duration     int64
+}
+
+type JamSessionParticipant struct {
+	UserID         int64
+	Username       string
+	Thumbnail      string
+	ThumbnailColor string
+	JoinedAt       time.Time
+}
+
+type JamSessionTrack struct {
+	TrackID        int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+	Duration       int64
+	PlayedAt       time.Time
+}
+
+type JamSessionDetails struct {
+	JamSession
+	ParticipantList []*JamSessionParticipant
+	TrackList       []*JamSessionTrack
+}
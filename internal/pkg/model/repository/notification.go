@@ -0,0 +1,21 @@
+package repository
+
+import "time"
+
+type Notification struct {
+	ID                   int64     `json:"id"`
+	UserID               int64     `json:"user_id"`
+	Type                 string    `json:"type"`
+	ActorName            string    `json:"actor_name"`
+	EntityID             int64     `json:"entity_id"`
+	EntityTitle          string    `json:"entity_title"`
+	EntityThumbnail      string    `json:"entity_thumbnail"`
+	EntityThumbnailColor string    `json:"entity_thumbnail_color"`
+	IsRead               bool      `json:"is_read"`
+	CreatedAt            time.Time `json:"created_at"`
+}
+
+type NotificationPreference struct {
+	Type      string
+	IsEnabled bool
+}
@@ -0,0 +1,14 @@
+package repository
+
+import "time"
+
+type Release struct {
+	Type           string
+	ID             int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+	ArtistNames    string
+	AlbumID        int64
+	CreatedAt      time.Time
+}
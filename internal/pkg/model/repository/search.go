@@ -0,0 +1,35 @@
+package repository
+
+type Suggestion struct {
+	Type           string `json:"type"`
+	ID             int64  `json:"id"`
+	Title          string `json:"title"`
+	Thumbnail      string `json:"thumbnail_url"`
+	ThumbnailColor string `json:"thumbnail_color"`
+}
+
+type SuggestResult struct {
+	Query           string        `json:"query"`
+	LayoutCorrected bool          `json:"layout_corrected"`
+	Suggestions     []*Suggestion `json:"suggestions"`
+}
+
+type SearchResultStat struct {
+	Type   string
+	ID     int64
+	Clicks int64
+}
+
+type SearchQueryStat struct {
+	Query      string
+	Count      int64
+	Clicks     int64
+	TopResults []*SearchResultStat
+}
+
+type SearchAnalytics struct {
+	TotalSearches     int64
+	TotalClicks       int64
+	TopQueries        []*SearchQueryStat
+	ZeroResultQueries []*SearchQueryStat
+}
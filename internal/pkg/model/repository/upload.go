@@ -0,0 +1,20 @@
+package repository
+
+// Upload is a resumable upload session, the parts themselves are tracked by the storage
+type Upload struct {
+	ID          string
+	LabelID     int64
+	Kind        string
+	Key         string
+	S3UploadID  string
+	Size        int64
+	ContentType string
+	PartSize    int64
+	Status      string
+}
+
+type UploadedPart struct {
+	Number int64
+	ETag   string
+	Size   int64
+}
@@ -11,19 +11,31 @@ const (
 	AlbumTypeCompilation AlbumType = "compilation"
 )
 
+type AlbumStatus string
+
+const (
+	AlbumStatusDraft     AlbumStatus = "draft"
+	AlbumStatusInReview  AlbumStatus = "in_review"
+	AlbumStatusScheduled AlbumStatus = "scheduled"
+	AlbumStatusPublished AlbumStatus = "published"
+	AlbumStatusTakenDown AlbumStatus = "taken_down"
+)
+
 type AlbumArtist struct {
 	ID    int64
 	Title string
 }
 
 type Album struct {
-	ID          int64
-	Title       string
-	Thumbnail   string
-	Type        AlbumType
-	ReleaseDate time.Time
-	Artists     []*AlbumArtist
-	IsLiked     bool
+	ID             int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+	Type           AlbumType
+	ReleaseDate    time.Time
+	Artists        []*AlbumArtist
+	IsLiked        bool
+	Status         AlbumStatus
 }
 
 type AlbumFilters struct {
@@ -37,15 +49,124 @@ type AlbumLikeRequest struct {
 }
 
 type CreateTrackRequest struct {
-	Title string
-	Track []byte
+	Title    string
+	UploadID string
+	// FileKey is resolved from the upload right before the release is created
+	FileKey string
 }
 
 type CreateAlbumRequest struct {
-	ArtistsIDs []int64
-	Type       string
+	ArtistsIDs    []int64
+	Type          string
+	Title         string
+	CoverUploadID string
+	Tracks        []*CreateTrackRequest
+	LabelID       int64
+}
+
+type LabelAlbum struct {
+	Album  *Album
+	Tracks []*Track
+}
+
+type ChangeAlbumStatusRequest struct {
+	AlbumID     int64
+	LabelID     int64
+	IsAdmin     bool
+	Status      AlbumStatus
+	ReleaseDate time.Time
+}
+
+type EditTrackRequest struct {
+	ID         int64
 	Title      string
-	Image      []byte
-	Tracks     []*CreateTrackRequest
+	Position   int64
+	ArtistsIDs []int64
+}
+
+type EditAlbumTracksRequest struct {
+	AlbumID    int64
 	LabelID    int64
+	ArtistsIDs []int64
+	Tracks     []*EditTrackRequest
+}
+
+// EditAlbumRequest keeps the current title and type when they are empty
+type EditAlbumRequest struct {
+	AlbumID       int64
+	LabelID       int64
+	Title         string
+	Type          string
+	CoverUploadID string
+}
+
+type TrackArtistRole struct {
+	ArtistID int64
+	Role     string
+}
+
+// EditAlbumTrackRequest keeps the current position when Position is 0
+// and the current credits when Artists is empty
+type EditAlbumTrackRequest struct {
+	AlbumID  int64
+	TrackID  int64
+	LabelID  int64
+	Title    string
+	Position int64
+	Artists  []*TrackArtistRole
+}
+
+type ReplaceTrackAudioRequest struct {
+	AlbumID  int64
+	TrackID  int64
+	LabelID  int64
+	UploadID string
+}
+
+type SaveTrackLyricsRequest struct {
+	AlbumID     int64
+	TrackID     int64
+	LabelID     int64
+	Language    string
+	Translation bool
+	Lyrics      string
+}
+
+type DeleteTrackLyricsRequest struct {
+	AlbumID  int64
+	TrackID  int64
+	LabelID  int64
+	Language string
+}
+
+type DuplicateFlagTrack struct {
+	ID      int64
+	Title   string
+	AlbumID int64
+	LabelID int64
+}
+
+type DuplicateFlag struct {
+	ID           int64
+	Track        DuplicateFlagTrack
+	MatchedTrack DuplicateFlagTrack
+	Similarity   float64
+	Status       string
+	CreatedAt    time.Time
+}
+
+// TrackMetadata is what the embedded tags of an uploaded track suggest for the
+// release, ArtistIDs are the label artists whose names match the tagged ones
+type TrackMetadata struct {
+	UploadID    string
+	Format      string
+	Title       string
+	TrackNumber int64
+	Artists     []string
+	ArtistIDs   []int64
+	Genre       string
+	Year        int64
+	ISRC        string
+	CoverKey    string
+	CoverURL    string
 }
@@ -1,12 +1,13 @@
 package usecase
 
 type Artist struct {
-	ID          int64
-	Title       string
-	Description string
-	Thumbnail   string
-	IsLiked     bool
-	LabelID     int64
+	ID             int64
+	Title          string
+	Description    string
+	Thumbnail      string
+	ThumbnailColor string
+	IsLiked        bool
+	LabelID        int64
 }
 
 type ArtistDetailed struct {
@@ -1,30 +1,74 @@
 package usecase
 
 type CreateJamRequest struct {
-	UserID   string
-	TrackID  string
-	Position int64
+	UserID       string
+	TrackID      string
+	Position     int64
+	Private      bool
+	Password     string
+	MaxListeners int64
 }
 
 type CreateJamResponse struct {
-	RoomID string
-	HostID string
+	RoomID      string
+	HostID      string
+	InviteToken string
 }
 
 type JoinJamRequest struct {
-	RoomID string
-	UserID string
+	RoomID      string
+	UserID      string
+	InviteToken string
+	Password    string
 }
 
 type JamMessage struct {
-	Type       string
-	TrackID    string
-	Position   int64
-	Paused     bool
+	Type        string
+	TrackID     string
+	Position    int64
+	Paused      bool
+	UserID      string
+	HostID      string
+	Users       []string
+	Loaded      map[string]bool
+	UserImages  map[string]string
+	UserNames   map[string]string
+	Queue       []string
+	Suggestions []*JamSuggestion
+	AutoAdvance bool
+	CoHosts     []string
+	ServerTime  int64
+	MessageID   string
+	Text        string
+	Emoji       string
+	Chat        []*JamChatMessage
+	Muted       []string
+	Lyrics      []*TrackLyrics
+}
+
+type JamChatMessage struct {
+	ID         string
 	UserID     string
-	HostID     string
-	Users      []string
-	Loaded     map[string]bool
-	UserImages map[string]string
-	UserNames  map[string]string
+	Text       string
+	ServerTime int64
+}
+
+type JamSuggestion struct {
+	TrackID     string
+	Votes       int64
+	SuggestedBy string
+}
+
+type JamSummary struct {
+	RoomID          string
+	HostID          string
+	HostName        string
+	HostAvatar      string
+	HostAvatarColor string
+	TrackID         string
+	Paused          bool
+	Listeners       int64
+	Private         bool
+	HasPassword     bool
+	Friend          bool
 }
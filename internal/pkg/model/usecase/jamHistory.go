@@ -0,0 +1,44 @@
+package usecase
+
+import "time"
+
+type JamSession struct {
+	ID           int64
+	RoomID       string
+	HostID       int64
+	HostName     string
+	StartedAt    time.Time
+	EndedAt      *time.Time
+	Participants int64
+	Tracks       int64
+	Duration     int64
+}
+
+type JamSessionParticipant struct {
+	UserID      int64
+	Username    string
+	AvatarURL   string
+	AvatarColor string
+	JoinedAt    time.Time
+}
+
+type JamSessionTrack struct {
+	TrackID        int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+	Duration       int64
+	PlayedAt       time.Time
+}
+
+type JamSessionDetails struct {
+	JamSession
+	ParticipantList []*JamSessionParticipant
+	TrackList       []*JamSessionTrack
+}
+
+type CreateJamPlaylistRequest struct {
+	UserID    int64
+	SessionID int64
+	Title     string
+}
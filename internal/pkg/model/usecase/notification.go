@@ -0,0 +1,34 @@
+package usecase
+
+import "time"
+
+type NotificationType string
+
+const (
+	NotificationTypeAlbumReleased NotificationType = "album_released"
+	NotificationTypePlaylistLiked NotificationType = "playlist_liked"
+)
+
+// NotificationTypes lists every type a user can switch on or off
+var NotificationTypes = []NotificationType{
+	NotificationTypeAlbumReleased,
+	NotificationTypePlaylistLiked,
+}
+
+type Notification struct {
+	ID                   int64
+	UserID               int64
+	Type                 NotificationType
+	ActorName            string
+	EntityID             int64
+	EntityTitle          string
+	EntityThumbnail      string
+	EntityThumbnailColor string
+	IsRead               bool
+	CreatedAt            time.Time
+}
+
+type NotificationPreference struct {
+	Type      NotificationType
+	IsEnabled bool
+}
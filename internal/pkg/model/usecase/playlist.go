@@ -1,10 +1,15 @@
 package usecase
 
 type Playlist struct {
-	ID        int64  `json:"id"`
-	Title     string `json:"title"`
-	Username  string `json:"username"`
-	Thumbnail string `json:"thumbnail_url"`
+	ID             int64  `json:"id"`
+	Title          string `json:"title"`
+	Username       string `json:"username"`
+	Thumbnail      string `json:"thumbnail_url"`
+	ThumbnailColor string `json:"thumbnail_color"`
+}
+
+type PlaylistFilters struct {
+	Pagination *Pagination
 }
 
 type PlaylistWithIsIncludedTrack struct {
@@ -0,0 +1,21 @@
+package usecase
+
+import "time"
+
+type ReleaseType string
+
+const (
+	ReleaseTypeAlbum ReleaseType = "album"
+	ReleaseTypeTrack ReleaseType = "track"
+)
+
+type Release struct {
+	Type           ReleaseType
+	ID             int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+	ArtistNames    string
+	AlbumID        int64
+	CreatedAt      time.Time
+}
@@ -0,0 +1,84 @@
+package usecase
+
+type SearchType string
+
+const (
+	SearchTypeTracks    SearchType = "tracks"
+	SearchTypeAlbums    SearchType = "albums"
+	SearchTypeArtists   SearchType = "artists"
+	SearchTypePlaylists SearchType = "playlists"
+)
+
+type SearchRequest struct {
+	Query      string
+	Types      []SearchType
+	Pagination *Pagination
+}
+
+type SearchResult struct {
+	Query           string
+	LayoutCorrected bool
+	Tracks          []*Track
+	Albums          []*Album
+	Artists         []*Artist
+	Playlists       []*Playlist
+}
+
+type Suggestion struct {
+	Type           SearchType
+	ID             int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+}
+
+type SuggestResult struct {
+	Query           string
+	LayoutCorrected bool
+	Suggestions     []*Suggestion
+}
+
+type SearchSource string
+
+const (
+	SearchSourceTracks    SearchSource = "tracks"
+	SearchSourceAlbums    SearchSource = "albums"
+	SearchSourceArtists   SearchSource = "artists"
+	SearchSourcePlaylists SearchSource = "playlists"
+	SearchSourceUnified   SearchSource = "search"
+	SearchSourceSuggest   SearchSource = "suggest"
+)
+
+type SearchQueryLog struct {
+	Source       SearchSource
+	Query        string
+	ResultsCount int
+}
+
+type SearchClick struct {
+	Query string
+	Type  SearchType
+	ID    int64
+}
+
+type SearchResultStat struct {
+	Type   SearchType
+	ID     int64
+	Clicks int64
+}
+
+type SearchQueryStat struct {
+	Query            string
+	Count            int64
+	Clicks           int64
+	ClickThroughRate float64
+	TopResults       []*SearchResultStat
+}
+
+type SearchAnalytics struct {
+	TotalSearches     int64
+	TotalClicks       int64
+	ClickThroughRate  float64
+	TopQueries        []*SearchQueryStat
+	ZeroResultQueries []*SearchQueryStat
+}
@@ -7,14 +7,16 @@ type TrackArtist struct {
 }
 
 type Track struct {
-	ID        int64
-	Title     string
-	Thumbnail string
-	Duration  int64
-	AlbumID   int64
-	Album     string
-	Artists   []*TrackArtist
-	IsLiked   bool
+	ID             int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+	Duration       int64
+	AlbumID        int64
+	Album          string
+	Artists        []*TrackArtist
+	BPM            float64
+	IsLiked        bool
 }
 
 type TrackStreamUpdateData struct {
@@ -36,9 +38,56 @@ type TrackStream struct {
 
 type TrackDetailed struct {
 	Track
+	FileUrl      string
+	Renditions   []*TrackRendition
+	LoudnessLUFS float64
+	ReplayGainDB float64
+	Key          string
+	HasLyrics    bool
+}
+
+type TrackLyrics struct {
+	Language    string
+	Translation bool
+	Synced      bool
+	Lines       []*LyricsLine
+}
+
+type LyricsLine struct {
+	Time int64
+	Text string
+}
+
+type TrackWaveform struct {
+	Duration int64
+	Peaks    []float64
+}
+
+type TrackRendition struct {
+	Codec   string
+	Bitrate int64
 	FileUrl string
 }
 
+// StreamVariant is one bitrate of the HLS manifest of a stream
+type StreamVariant struct {
+	Codec    string
+	Bitrate  int64
+	Segments []*StreamSegment
+}
+
+type StreamSegment struct {
+	Position int64
+	Duration float64
+}
+
+type StreamSegmentRequest struct {
+	StreamID int64
+	UserID   int64
+	Bitrate  int64
+	Position int64
+}
+
 type TrackFilters struct {
 	Pagination *Pagination
 }
@@ -0,0 +1,37 @@
+package usecase
+
+const (
+	UploadKindTrack = "track"
+	UploadKindCover = "cover"
+
+	UploadStatusPending   = "pending"
+	UploadStatusCompleted = "completed"
+)
+
+type CreateUploadRequest struct {
+	LabelID     int64
+	Kind        string
+	Size        int64
+	ContentType string
+}
+
+type Upload struct {
+	ID            string
+	Kind          string
+	Size          int64
+	PartSize      int64
+	PartsCount    int64
+	Status        string
+	UploadedParts []int64
+}
+
+type UploadPartsRequest struct {
+	UploadID string
+	LabelID  int64
+	Parts    []int64
+}
+
+type UploadPart struct {
+	Number int64
+	URL    string
+}
@@ -2,16 +2,18 @@ package usecase
 
 import (
 	"io"
+	"time"
 )
 
 type User struct {
-	ID        int64
-	Email     string
-	Username  string
-	Avatar    io.Reader
-	Password  string
-	AvatarUrl string
-	LabelID   int64
+	ID          int64
+	Email       string
+	Username    string
+	Avatar      io.Reader
+	Password    string
+	AvatarUrl   string
+	AvatarColor string
+	LabelID     int64
 }
 
 type ChangeUserData struct {
@@ -47,11 +49,12 @@ type UserStatistics struct {
 }
 
 type UserFullData struct {
-	Username   string
-	Email      string
-	AvatarUrl  string
-	Privacy    *UserPrivacy
-	Statistics *UserStatistics
+	Username    string
+	Email       string
+	AvatarUrl   string
+	AvatarColor string
+	Privacy     *UserPrivacy
+	Statistics  *UserStatistics
 }
 
 type UserChangeSettings struct {
@@ -74,3 +77,13 @@ type Label struct {
 	Name    string
 	Members []string
 }
+
+type Activity struct {
+	Type                 string
+	User                 *User
+	EntityID             int64
+	EntityTitle          string
+	EntityThumbnail      string
+	EntityThumbnailColor string
+	CreatedAt            time.Time
+}
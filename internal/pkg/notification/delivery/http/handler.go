@@ -0,0 +1,330 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/errorStatus"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+)
+
+type NotificationHandler struct {
+	usecase notification.Usecase
+	cfg     *config.Config
+}
+
+func NewNotificationHandler(usecase notification.Usecase, cfg *config.Config) *NotificationHandler {
+	return &NotificationHandler{usecase: usecase, cfg: cfg}
+}
+
+// GetNotifications godoc
+// @Summary Get notifications
+// @Description Get the current user's notification inbox, newest first
+// @Tags notifications
+// @Produce json
+// @Param unread query boolean false "Only unread notifications"
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.Notification} "List of notifications"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination or unread flag"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /notifications [get]
+func (h *NotificationHandler) GetNotifications(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		err := customErrors.ErrUnauthorized
+		logger.Warn("attempt to get notifications for unauthorized user")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	unreadOnly := false
+	if unread := r.URL.Query().Get("unread"); unread != "" {
+		var err error
+		unreadOnly, err = strconv.ParseBool(unread)
+		if err != nil {
+			logger.Error("failed to parse unread flag", zap.Error(err))
+			json.WriteErrorResponse(w, http.StatusBadRequest, "invalid unread flag", nil)
+			return
+		}
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	notifications, err := h.usecase.GetNotifications(ctx, userID, unreadOnly, model.PaginationFromDeliveryToUsecase(pagination))
+	if err != nil {
+		logger.Error("failed to get notifications", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.NotificationsFromUsecaseToDelivery(notifications), nil)
+}
+
+// GetUnreadCount godoc
+// @Summary Get unread notifications count
+// @Description Get how many notifications the current user has not read yet
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} delivery.APIResponse{body=delivery.UnreadNotificationsCount} "Unread count"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /notifications/unread-count [get]
+func (h *NotificationHandler) GetUnreadCount(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		err := customErrors.ErrUnauthorized
+		logger.Warn("attempt to get unread count for unauthorized user")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	count, err := h.usecase.GetUnreadCount(ctx, userID)
+	if err != nil {
+		logger.Error("failed to get unread count", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, &delivery.UnreadNotificationsCount{Count: count}, nil)
+}
+
+// MarkAsRead godoc
+// @Summary Mark notification as read
+// @Description Mark a single notification of the current user as read
+// @Tags notifications
+// @Produce json
+// @Param id path integer true "Notification ID"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Message} "Notification marked as read"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Notification not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /notifications/{id}/read [post]
+func (h *NotificationHandler) MarkAsRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		err := customErrors.ErrUnauthorized
+		logger.Warn("attempt to mark notification as read for unauthorized user")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	notificationID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse notification id", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	err = h.usecase.MarkAsRead(ctx, userID, notificationID)
+	if err != nil {
+		logger.Error("failed to mark notification as read", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, &delivery.Message{Message: "Notification marked as read"}, nil)
+}
+
+// MarkAllAsRead godoc
+// @Summary Mark all notifications as read
+// @Description Mark every notification of the current user as read
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} delivery.APIResponse{body=delivery.Message} "Notifications marked as read"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /notifications/read [post]
+func (h *NotificationHandler) MarkAllAsRead(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		err := customErrors.ErrUnauthorized
+		logger.Warn("attempt to mark notifications as read for unauthorized user")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	err := h.usecase.MarkAllAsRead(ctx, userID)
+	if err != nil {
+		logger.Error("failed to mark all notifications as read", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, &delivery.Message{Message: "Notifications marked as read"}, nil)
+}
+
+// GetPreferences godoc
+// @Summary Get notification preferences
+// @Description Get which notification types the current user receives
+// @Tags notifications
+// @Produce json
+// @Success 200 {object} delivery.APIResponse{body=delivery.NotificationPreferences} "Notification preferences"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /notifications/preferences [get]
+func (h *NotificationHandler) GetPreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		err := customErrors.ErrUnauthorized
+		logger.Warn("attempt to get notification preferences for unauthorized user")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	preferences, err := h.usecase.GetPreferences(ctx, userID)
+	if err != nil {
+		logger.Error("failed to get notification preferences", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.NotificationPreferencesFromUsecaseToDelivery(preferences), nil)
+}
+
+// UpdatePreferences godoc
+// @Summary Update notification preferences
+// @Description Switch notification types on or off, types left out of the request keep their setting
+// @Tags notifications
+// @Accept json
+// @Produce json
+// @Param preferences body delivery.NotificationPreferences true "Preferences to change"
+// @Success 200 {object} delivery.APIResponse{body=delivery.NotificationPreferences} "Updated notification preferences"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid body or notification type"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /notifications/preferences [put]
+func (h *NotificationHandler) UpdatePreferences(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		err := customErrors.ErrUnauthorized
+		logger.Warn("attempt to update notification preferences for unauthorized user")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	var request delivery.NotificationPreferences
+	err := json.ReadJSON(w, r, &request)
+	if err != nil {
+		logger.Error("failed to read notification preferences", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	for _, preference := range request.Preferences {
+		if _, err := govalidator.ValidateStruct(preference); err != nil {
+			logger.Error("invalid notification preference", zap.Error(err))
+			json.WriteErrorResponse(w, errorStatus.ErrorStatus(customErrors.ErrInvalidNotificationType), customErrors.ErrInvalidNotificationType.Error(), nil)
+			return
+		}
+	}
+
+	preferences, err := h.usecase.UpdatePreferences(ctx, userID, model.NotificationPreferencesFromDeliveryToUsecase(&request))
+	if err != nil {
+		logger.Error("failed to update notification preferences", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.NotificationPreferencesFromUsecaseToDelivery(preferences), nil)
+}
+
+// WSHandler streams new notifications of the current user over a WebSocket until the client disconnects
+func (h *NotificationHandler) WSHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, ok := ctxExtractor.UserFromContext(ctx)
+	if !ok {
+		logger.Error("user not found in context", zap.Error(customErrors.ErrUnauthorized))
+		http.Error(w, customErrors.ErrUnauthorized.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	upgrader := websocket.Upgrader{CheckOrigin: func(r *http.Request) bool {
+		return true
+	}}
+
+	wsConn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.Error("failed to upgrade to websocket", zap.Error(err))
+		return
+	}
+	defer func() {
+		if err := wsConn.Close(); err != nil {
+			logger.Error("failed to close websocket", zap.Error(err))
+		}
+	}()
+
+	messageChan, err := h.usecase.SubscribeToNotifications(ctx, userID)
+	if err != nil {
+		logger.Error("failed to subscribe to notifications", zap.Error(err))
+		return
+	}
+
+	// the client never sends anything, reading only notices when it goes away
+	closed := make(chan struct{})
+	go func() {
+		defer close(closed)
+		for {
+			if _, _, err := wsConn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-closed:
+			return
+		case usecaseNotification, ok := <-messageChan:
+			if !ok {
+				return
+			}
+			err := wsConn.WriteJSON(model.NotificationFromUsecaseToDelivery(usecaseNotification))
+			if err != nil {
+				logger.Error("failed to write notification to websocket", zap.Error(err))
+				return
+			}
+		}
+	}
+}
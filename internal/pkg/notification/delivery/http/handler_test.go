@@ -0,0 +1,218 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_notification "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification/mocks"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func setupTestHandler(t *testing.T) (*mock_notification.MockUsecase, *NotificationHandler) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_notification.NewMockUsecase(ctrl)
+	cfg := &config.Config{
+		Pagination: config.PaginationConfig{
+			DefaultLimit: 10,
+			MaxLimit:     100,
+			MaxOffset:    1000,
+		},
+	}
+	handler := NewNotificationHandler(mockUsecase, cfg)
+	return mockUsecase, handler
+}
+
+func newTestRequest(method string, target string, body string, isAuth bool) *http.Request {
+	req, _ := http.NewRequest(method, target, strings.NewReader(body))
+	ctx := context.WithValue(req.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+	if isAuth {
+		ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(1))
+	}
+	return req.WithContext(ctx)
+}
+
+func TestGetNotifications(t *testing.T) {
+	mockUsecase, handler := setupTestHandler(t)
+
+	tests := []struct {
+		name           string
+		query          string
+		isAuth         bool
+		mockBehavior   func()
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:   "Success unread only",
+			query:  "?unread=true&limit=5",
+			isAuth: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetNotifications(gomock.Any(), int64(1), true, &usecaseModel.Pagination{Offset: 0, Limit: 5}).
+					Return([]*usecaseModel.Notification{
+						{ID: 1, Type: usecaseModel.NotificationTypePlaylistLiked, ActorName: "friend"},
+						{ID: 2, Type: usecaseModel.NotificationTypeAlbumReleased, ActorName: "Artist"},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  2,
+		},
+		{
+			name:           "Unauthorized",
+			query:          "",
+			isAuth:         false,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Invalid unread flag",
+			query:          "?unread=maybe",
+			isAuth:         true,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Usecase error",
+			query:  "",
+			isAuth: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetNotifications(gomock.Any(), int64(1), false, gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			req := newTestRequest(http.MethodGet, "/notifications"+tt.query, "", tt.isAuth)
+
+			handler.GetNotifications(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response struct {
+					Body []*deliveryModel.Notification `json:"body"`
+				}
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				require.NoError(t, err)
+				assert.Len(t, response.Body, tt.expectedCount)
+			}
+		})
+	}
+}
+
+func TestMarkAsRead(t *testing.T) {
+	mockUsecase, handler := setupTestHandler(t)
+
+	tests := []struct {
+		name           string
+		id             string
+		mockBehavior   func()
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			id:   "4",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().MarkAsRead(gomock.Any(), int64(1), int64(4)).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Not found",
+			id:   "5",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().MarkAsRead(gomock.Any(), int64(1), int64(5)).Return(customErrors.ErrNotificationNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+		{
+			name:           "Invalid id",
+			id:             "abc",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			req := newTestRequest(http.MethodPost, "/notifications/"+tt.id+"/read", "", true)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.id})
+
+			handler.MarkAsRead(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestUpdatePreferences(t *testing.T) {
+	mockUsecase, handler := setupTestHandler(t)
+
+	tests := []struct {
+		name           string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"preferences": [{"type": "playlist_liked", "is_enabled": false}]}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().UpdatePreferences(gomock.Any(), int64(1), []*usecaseModel.NotificationPreference{
+					{Type: usecaseModel.NotificationTypePlaylistLiked, IsEnabled: false},
+				}).Return([]*usecaseModel.NotificationPreference{
+					{Type: usecaseModel.NotificationTypeAlbumReleased, IsEnabled: true},
+					{Type: usecaseModel.NotificationTypePlaylistLiked, IsEnabled: false},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid json",
+			body:           `{"preferences": `,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid type",
+			body:           `{"preferences": [{"type": "track_liked", "is_enabled": false}]}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			req := newTestRequest(http.MethodPut, "/notifications/preferences", tt.body, true)
+
+			handler.UpdatePreferences(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
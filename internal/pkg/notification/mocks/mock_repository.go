@@ -0,0 +1,212 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mocks/mock_repository.go
+//
+
+// Package mock_notification is a generated GoMock package.
+package mock_notification
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAlbumReleasedNotifications mocks base method.
+func (m *MockRepository) CreateAlbumReleasedNotifications(ctx context.Context, albumID int64) ([]*repository.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbumReleasedNotifications", ctx, albumID)
+	ret0, _ := ret[0].([]*repository.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbumReleasedNotifications indicates an expected call of CreateAlbumReleasedNotifications.
+func (mr *MockRepositoryMockRecorder) CreateAlbumReleasedNotifications(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbumReleasedNotifications", reflect.TypeOf((*MockRepository)(nil).CreateAlbumReleasedNotifications), ctx, albumID)
+}
+
+// CreatePlaylistLikedNotification mocks base method.
+func (m *MockRepository) CreatePlaylistLikedNotification(ctx context.Context, playlistID, actorID int64) ([]*repository.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePlaylistLikedNotification", ctx, playlistID, actorID)
+	ret0, _ := ret[0].([]*repository.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePlaylistLikedNotification indicates an expected call of CreatePlaylistLikedNotification.
+func (mr *MockRepositoryMockRecorder) CreatePlaylistLikedNotification(ctx, playlistID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePlaylistLikedNotification", reflect.TypeOf((*MockRepository)(nil).CreatePlaylistLikedNotification), ctx, playlistID, actorID)
+}
+
+// GetNotifications mocks base method.
+func (m *MockRepository) GetNotifications(ctx context.Context, userID int64, unreadOnly bool, pagination *repository.Pagination) ([]*repository.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID, unreadOnly, pagination)
+	ret0, _ := ret[0].([]*repository.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockRepositoryMockRecorder) GetNotifications(ctx, userID, unreadOnly, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockRepository)(nil).GetNotifications), ctx, userID, unreadOnly, pagination)
+}
+
+// GetPreferences mocks base method.
+func (m *MockRepository) GetPreferences(ctx context.Context, userID int64) ([]*repository.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx, userID)
+	ret0, _ := ret[0].([]*repository.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockRepositoryMockRecorder) GetPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockRepository)(nil).GetPreferences), ctx, userID)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockRepository) GetUnreadCount(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockRepositoryMockRecorder) GetUnreadCount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockRepository)(nil).GetUnreadCount), ctx, userID)
+}
+
+// MarkAllAsRead mocks base method.
+func (m *MockRepository) MarkAllAsRead(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllAsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllAsRead indicates an expected call of MarkAllAsRead.
+func (mr *MockRepositoryMockRecorder) MarkAllAsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllAsRead", reflect.TypeOf((*MockRepository)(nil).MarkAllAsRead), ctx, userID)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockRepository) MarkAsRead(ctx context.Context, userID, notificationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", ctx, userID, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockRepositoryMockRecorder) MarkAsRead(ctx, userID, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockRepository)(nil).MarkAsRead), ctx, userID, notificationID)
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockRepository) UpdatePreferences(ctx context.Context, userID int64, preferences []*repository.NotificationPreference) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", ctx, userID, preferences)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockRepositoryMockRecorder) UpdatePreferences(ctx, userID, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockRepository)(nil).UpdatePreferences), ctx, userID, preferences)
+}
+
+// MockPubSubRepository is a mock of PubSubRepository interface.
+type MockPubSubRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockPubSubRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockPubSubRepositoryMockRecorder is the mock recorder for MockPubSubRepository.
+type MockPubSubRepositoryMockRecorder struct {
+	mock *MockPubSubRepository
+}
+
+// NewMockPubSubRepository creates a new mock instance.
+func NewMockPubSubRepository(ctrl *gomock.Controller) *MockPubSubRepository {
+	mock := &MockPubSubRepository{ctrl: ctrl}
+	mock.recorder = &MockPubSubRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPubSubRepository) EXPECT() *MockPubSubRepositoryMockRecorder {
+	return m.recorder
+}
+
+// PublishNotification mocks base method.
+func (m *MockPubSubRepository) PublishNotification(ctx context.Context, notification *repository.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishNotification", ctx, notification)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PublishNotification indicates an expected call of PublishNotification.
+func (mr *MockPubSubRepositoryMockRecorder) PublishNotification(ctx, notification any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishNotification", reflect.TypeOf((*MockPubSubRepository)(nil).PublishNotification), ctx, notification)
+}
+
+// SubscribeToNotifications mocks base method.
+func (m *MockPubSubRepository) SubscribeToNotifications(ctx context.Context, userID int64) (<-chan []byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToNotifications", ctx, userID)
+	ret0, _ := ret[0].(<-chan []byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToNotifications indicates an expected call of SubscribeToNotifications.
+func (mr *MockPubSubRepositoryMockRecorder) SubscribeToNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToNotifications", reflect.TypeOf((*MockPubSubRepository)(nil).SubscribeToNotifications), ctx, userID)
+}
@@ -0,0 +1,173 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=usecase.go -destination=mocks/mock_usecase.go
+//
+
+// Package mock_notification is a generated GoMock package.
+package mock_notification
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetNotifications mocks base method.
+func (m *MockUsecase) GetNotifications(ctx context.Context, userID int64, unreadOnly bool, pagination *usecase.Pagination) ([]*usecase.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", ctx, userID, unreadOnly, pagination)
+	ret0, _ := ret[0].([]*usecase.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockUsecaseMockRecorder) GetNotifications(ctx, userID, unreadOnly, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockUsecase)(nil).GetNotifications), ctx, userID, unreadOnly, pagination)
+}
+
+// GetPreferences mocks base method.
+func (m *MockUsecase) GetPreferences(ctx context.Context, userID int64) ([]*usecase.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPreferences", ctx, userID)
+	ret0, _ := ret[0].([]*usecase.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPreferences indicates an expected call of GetPreferences.
+func (mr *MockUsecaseMockRecorder) GetPreferences(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPreferences", reflect.TypeOf((*MockUsecase)(nil).GetPreferences), ctx, userID)
+}
+
+// GetUnreadCount mocks base method.
+func (m *MockUsecase) GetUnreadCount(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUnreadCount", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUnreadCount indicates an expected call of GetUnreadCount.
+func (mr *MockUsecaseMockRecorder) GetUnreadCount(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnreadCount", reflect.TypeOf((*MockUsecase)(nil).GetUnreadCount), ctx, userID)
+}
+
+// MarkAllAsRead mocks base method.
+func (m *MockUsecase) MarkAllAsRead(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllAsRead", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllAsRead indicates an expected call of MarkAllAsRead.
+func (mr *MockUsecaseMockRecorder) MarkAllAsRead(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllAsRead", reflect.TypeOf((*MockUsecase)(nil).MarkAllAsRead), ctx, userID)
+}
+
+// MarkAsRead mocks base method.
+func (m *MockUsecase) MarkAsRead(ctx context.Context, userID, notificationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAsRead", ctx, userID, notificationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAsRead indicates an expected call of MarkAsRead.
+func (mr *MockUsecaseMockRecorder) MarkAsRead(ctx, userID, notificationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsRead", reflect.TypeOf((*MockUsecase)(nil).MarkAsRead), ctx, userID, notificationID)
+}
+
+// NotifyAlbumReleased mocks base method.
+func (m *MockUsecase) NotifyAlbumReleased(ctx context.Context, albumID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyAlbumReleased", ctx, albumID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyAlbumReleased indicates an expected call of NotifyAlbumReleased.
+func (mr *MockUsecaseMockRecorder) NotifyAlbumReleased(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyAlbumReleased", reflect.TypeOf((*MockUsecase)(nil).NotifyAlbumReleased), ctx, albumID)
+}
+
+// NotifyPlaylistLiked mocks base method.
+func (m *MockUsecase) NotifyPlaylistLiked(ctx context.Context, playlistID, actorID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyPlaylistLiked", ctx, playlistID, actorID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyPlaylistLiked indicates an expected call of NotifyPlaylistLiked.
+func (mr *MockUsecaseMockRecorder) NotifyPlaylistLiked(ctx, playlistID, actorID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyPlaylistLiked", reflect.TypeOf((*MockUsecase)(nil).NotifyPlaylistLiked), ctx, playlistID, actorID)
+}
+
+// SubscribeToNotifications mocks base method.
+func (m *MockUsecase) SubscribeToNotifications(ctx context.Context, userID int64) (<-chan *usecase.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeToNotifications", ctx, userID)
+	ret0, _ := ret[0].(<-chan *usecase.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeToNotifications indicates an expected call of SubscribeToNotifications.
+func (mr *MockUsecaseMockRecorder) SubscribeToNotifications(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeToNotifications", reflect.TypeOf((*MockUsecase)(nil).SubscribeToNotifications), ctx, userID)
+}
+
+// UpdatePreferences mocks base method.
+func (m *MockUsecase) UpdatePreferences(ctx context.Context, userID int64, preferences []*usecase.NotificationPreference) ([]*usecase.NotificationPreference, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePreferences", ctx, userID, preferences)
+	ret0, _ := ret[0].([]*usecase.NotificationPreference)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePreferences indicates an expected call of UpdatePreferences.
+func (mr *MockUsecaseMockRecorder) UpdatePreferences(ctx, userID, preferences any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePreferences", reflect.TypeOf((*MockUsecase)(nil).UpdatePreferences), ctx, userID, preferences)
+}
@@ -0,0 +1,23 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+)
+
+type Repository interface {
+	CreateAlbumReleasedNotifications(ctx context.Context, albumID int64) ([]*repository.Notification, error)
+	CreatePlaylistLikedNotification(ctx context.Context, playlistID int64, actorID int64) ([]*repository.Notification, error)
+	GetNotifications(ctx context.Context, userID int64, unreadOnly bool, pagination *repository.Pagination) ([]*repository.Notification, error)
+	GetUnreadCount(ctx context.Context, userID int64) (int64, error)
+	MarkAsRead(ctx context.Context, userID int64, notificationID int64) error
+	MarkAllAsRead(ctx context.Context, userID int64) error
+	GetPreferences(ctx context.Context, userID int64) ([]*repository.NotificationPreference, error)
+	UpdatePreferences(ctx context.Context, userID int64, preferences []*repository.NotificationPreference) error
+}
+
+type PubSubRepository interface {
+	PublishNotification(ctx context.Context, notification *repository.Notification) error
+	SubscribeToNotifications(ctx context.Context, userID int64) (<-chan []byte, error)
+}
@@ -0,0 +1,330 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification"
+	"go.uber.org/zap"
+)
+
+const (
+	// Every fan of any artist of the album gets one notification, unless they switched the type off
+	CreateAlbumReleasedNotificationsQuery = `
+		INSERT INTO notification (user_id, type, actor_name, entity_id, entity_title, entity_thumbnail, entity_thumbnail_color)
+		SELECT fan.user_id, 'album_released', artists.titles, a.id, a.title, a.thumbnail_url, a.thumbnail_color
+		FROM album a
+		JOIN LATERAL (
+			SELECT string_agg(ar.title, ', ' ORDER BY ar.title) AS titles
+			FROM album_artist aa
+			JOIN artist ar ON ar.id = aa.artist_id
+			WHERE aa.album_id = a.id
+		) artists ON TRUE
+		JOIN (
+			SELECT DISTINCT fa.user_id
+			FROM favorite_artist fa
+			JOIN album_artist aa ON aa.artist_id = fa.artist_id
+			WHERE aa.album_id = $1
+		) fan ON TRUE
+		WHERE a.id = $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM notification_preference np
+			WHERE np.user_id = fan.user_id AND np.type = 'album_released' AND NOT np.is_enabled
+		  )
+		RETURNING id, user_id, type, actor_name, entity_id, entity_title, entity_thumbnail, entity_thumbnail_color, is_read, created_at
+	`
+	// Liking your own playlist is not news, and liking it again before the owner read the first one is not either
+	CreatePlaylistLikedNotificationQuery = `
+		INSERT INTO notification (user_id, type, actor_name, entity_id, entity_title, entity_thumbnail, entity_thumbnail_color)
+		SELECT p.user_id, 'playlist_liked', u.username, p.id, p.title, p.thumbnail_url, p.thumbnail_color
+		FROM playlist p
+		JOIN "user" u ON u.id = $2
+		WHERE p.id = $1
+		  AND p.user_id <> $2
+		  AND NOT EXISTS (
+			SELECT 1 FROM notification_preference np
+			WHERE np.user_id = p.user_id AND np.type = 'playlist_liked' AND NOT np.is_enabled
+		  )
+		  AND NOT EXISTS (
+			SELECT 1 FROM notification n
+			WHERE n.user_id = p.user_id AND n.type = 'playlist_liked' AND n.entity_id = p.id
+			  AND n.actor_name = u.username AND NOT n.is_read
+		  )
+		RETURNING id, user_id, type, actor_name, entity_id, entity_title, entity_thumbnail, entity_thumbnail_color, is_read, created_at
+	`
+	GetNotificationsQuery = `
+		SELECT id, user_id, type, actor_name, entity_id, entity_title, entity_thumbnail, entity_thumbnail_color, is_read, created_at
+		FROM notification
+		WHERE user_id = $1 AND (NOT $2 OR NOT is_read)
+		ORDER BY created_at DESC, id DESC
+		LIMIT $3 OFFSET $4
+	`
+	GetUnreadCountQuery = `
+		SELECT COUNT(*)
+		FROM notification
+		WHERE user_id = $1 AND NOT is_read
+	`
+	MarkAsReadQuery = `
+		UPDATE notification
+		SET is_read = TRUE
+		WHERE id = $1 AND user_id = $2
+	`
+	MarkAllAsReadQuery = `
+		UPDATE notification
+		SET is_read = TRUE
+		WHERE user_id = $1 AND NOT is_read
+	`
+	GetPreferencesQuery = `
+		SELECT type, is_enabled
+		FROM notification_preference
+		WHERE user_id = $1
+	`
+	UpsertPreferenceQuery = `
+		INSERT INTO notification_preference (user_id, type, is_enabled)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, type) DO UPDATE
+		SET is_enabled = EXCLUDED.is_enabled, updated_at = NOW()
+	`
+)
+
+type notificationPostgresRepository struct {
+	db *sql.DB
+}
+
+func NewNotificationPostgresRepository(db *sql.DB) notification.Repository {
+	return &notificationPostgresRepository{
+		db: db,
+	}
+}
+
+func (r *notificationPostgresRepository) CreateAlbumReleasedNotifications(ctx context.Context, albumID int64) ([]*repoModel.Notification, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Creating album released notifications", zap.Int64("albumID", albumID))
+
+	return r.queryNotifications(ctx, CreateAlbumReleasedNotificationsQuery, albumID)
+}
+
+func (r *notificationPostgresRepository) CreatePlaylistLikedNotification(ctx context.Context, playlistID int64, actorID int64) ([]*repoModel.Notification, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Creating playlist liked notification", zap.Int64("playlistID", playlistID), zap.Int64("actorID", actorID))
+
+	return r.queryNotifications(ctx, CreatePlaylistLikedNotificationQuery, playlistID, actorID)
+}
+
+func (r *notificationPostgresRepository) GetNotifications(ctx context.Context, userID int64, unreadOnly bool, pagination *repoModel.Pagination) ([]*repoModel.Notification, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting notifications", zap.Int64("userID", userID), zap.Bool("unreadOnly", unreadOnly))
+
+	return r.queryNotifications(ctx, GetNotificationsQuery, userID, unreadOnly, pagination.Limit, pagination.Offset)
+}
+
+func (r *notificationPostgresRepository) queryNotifications(ctx context.Context, query string, args ...interface{}) ([]*repoModel.Notification, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, args...)
+	if err != nil {
+		logger.Error("failed to query notifications", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	notifications := make([]*repoModel.Notification, 0)
+	for rows.Next() {
+		var n repoModel.Notification
+		err = rows.Scan(&n.ID, &n.UserID, &n.Type, &n.ActorName, &n.EntityID, &n.EntityTitle, &n.EntityThumbnail, &n.EntityThumbnailColor, &n.IsRead, &n.CreatedAt)
+		if err != nil {
+			logger.Error("failed to scan notification", zap.Error(err))
+			return nil, err
+		}
+		notifications = append(notifications, &n)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to iterate notifications", zap.Error(err))
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationPostgresRepository) GetUnreadCount(ctx context.Context, userID int64) (int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting unread notifications count", zap.Int64("userID", userID))
+
+	stmt, err := r.db.PrepareContext(ctx, GetUnreadCountQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return 0, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	var count int64
+	err = stmt.QueryRowContext(ctx, userID).Scan(&count)
+	if err != nil {
+		logger.Error("failed to get unread notifications count", zap.Error(err))
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *notificationPostgresRepository) MarkAsRead(ctx context.Context, userID int64, notificationID int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Marking notification as read", zap.Int64("userID", userID), zap.Int64("notificationID", notificationID))
+
+	stmt, err := r.db.PrepareContext(ctx, MarkAsReadQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	result, err := stmt.ExecContext(ctx, notificationID, userID)
+	if err != nil {
+		logger.Error("failed to mark notification as read", zap.Error(err))
+		return err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed to get affected rows", zap.Error(err))
+		return err
+	}
+	// someone else's notification looks exactly like a missing one
+	if affected == 0 {
+		return customErrors.ErrNotificationNotFound
+	}
+	return nil
+}
+
+func (r *notificationPostgresRepository) MarkAllAsRead(ctx context.Context, userID int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Marking all notifications as read", zap.Int64("userID", userID))
+
+	stmt, err := r.db.PrepareContext(ctx, MarkAllAsReadQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	_, err = stmt.ExecContext(ctx, userID)
+	if err != nil {
+		logger.Error("failed to mark all notifications as read", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (r *notificationPostgresRepository) GetPreferences(ctx context.Context, userID int64) ([]*repoModel.NotificationPreference, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting notification preferences", zap.Int64("userID", userID))
+
+	stmt, err := r.db.PrepareContext(ctx, GetPreferencesQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, userID)
+	if err != nil {
+		logger.Error("failed to query notification preferences", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	preferences := make([]*repoModel.NotificationPreference, 0)
+	for rows.Next() {
+		var preference repoModel.NotificationPreference
+		err = rows.Scan(&preference.Type, &preference.IsEnabled)
+		if err != nil {
+			logger.Error("failed to scan notification preference", zap.Error(err))
+			return nil, err
+		}
+		preferences = append(preferences, &preference)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to iterate notification preferences", zap.Error(err))
+		return nil, err
+	}
+
+	return preferences, nil
+}
+
+func (r *notificationPostgresRepository) UpdatePreferences(ctx context.Context, userID int64, preferences []*repoModel.NotificationPreference) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Updating notification preferences", zap.Int64("userID", userID))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+			logger.Error("failed to rollback transaction", zap.Error(err))
+		}
+	}()
+
+	stmt, err := tx.PrepareContext(ctx, UpsertPreferenceQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	for _, preference := range preferences {
+		_, err = stmt.ExecContext(ctx, userID, preference.Type, preference.IsEnabled)
+		if err != nil {
+			logger.Error("failed to update notification preference", zap.Error(err), zap.String("type", preference.Type))
+			return err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		logger.Error("failed to commit transaction", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,249 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var notificationColumns = []string{"id", "user_id", "type", "actor_name", "entity_id", "entity_title", "entity_thumbnail", "entity_thumbnail_color", "is_read", "created_at"}
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, context.Context) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	return db, mock, setupTestContext()
+}
+
+func setupTestContext() context.Context {
+	return loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+}
+
+func TestCreateAlbumReleasedNotifications(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows(notificationColumns).
+		AddRow(1, 10, "album_released", "Artist", 5, "Album", "album.png", "#a1b2c3", false, now).
+		AddRow(2, 11, "album_released", "Artist", 5, "Album", "album.png", "#a1b2c3", false, now)
+
+	mock.ExpectPrepare("INSERT INTO notification").
+		ExpectQuery().
+		WithArgs(int64(5)).
+		WillReturnRows(rows)
+
+	notifications, err := repo.CreateAlbumReleasedNotifications(ctx, 5)
+
+	require.NoError(t, err)
+	require.Len(t, notifications, 2)
+	assert.Equal(t, int64(10), notifications[0].UserID)
+	assert.Equal(t, "album_released", notifications[0].Type)
+	assert.Equal(t, "Artist", notifications[0].ActorName)
+	assert.Equal(t, "#a1b2c3", notifications[0].EntityThumbnailColor)
+	assert.Equal(t, int64(11), notifications[1].UserID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCreatePlaylistLikedNotificationSkipped(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectPrepare("INSERT INTO notification").
+		ExpectQuery().
+		WithArgs(int64(3), int64(7)).
+		WillReturnRows(sqlmock.NewRows(notificationColumns))
+
+	notifications, err := repo.CreatePlaylistLikedNotification(ctx, 3, 7)
+
+	require.NoError(t, err)
+	assert.Empty(t, notifications)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetNotifications(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+	now := time.Now()
+
+	rows := sqlmock.NewRows(notificationColumns).
+		AddRow(4, 10, "playlist_liked", "friend", 3, "Road trip", "playlist.png", "", false, now)
+
+	mock.ExpectPrepare("SELECT (.+) FROM notification").
+		ExpectQuery().
+		WithArgs(int64(10), true, 20, 0).
+		WillReturnRows(rows)
+
+	notifications, err := repo.GetNotifications(ctx, 10, true, &repoModel.Pagination{Offset: 0, Limit: 20})
+
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, int64(4), notifications[0].ID)
+	assert.Equal(t, "friend", notifications[0].ActorName)
+	assert.Equal(t, now, notifications[0].CreatedAt)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetNotificationsError(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectPrepare("SELECT (.+) FROM notification").
+		ExpectQuery().
+		WithArgs(int64(10), false, 20, 0).
+		WillReturnError(sql.ErrConnDone)
+
+	notifications, err := repo.GetNotifications(ctx, 10, false, &repoModel.Pagination{Offset: 0, Limit: 20})
+
+	assert.Error(t, err)
+	assert.Nil(t, notifications)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectPrepare("SELECT COUNT").
+		ExpectQuery().
+		WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+	count, err := repo.GetUnreadCount(ctx, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkAsRead(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectPrepare("UPDATE notification").
+		ExpectExec().
+		WithArgs(int64(4), int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.MarkAsRead(ctx, 10, 4)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkAsReadNotFound(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectPrepare("UPDATE notification").
+		ExpectExec().
+		WithArgs(int64(4), int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.MarkAsRead(ctx, 10, 4)
+
+	assert.ErrorIs(t, err, customErrors.ErrNotificationNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestMarkAllAsRead(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectPrepare("UPDATE notification").
+		ExpectExec().
+		WithArgs(int64(10)).
+		WillReturnResult(sqlmock.NewResult(0, 5))
+
+	err := repo.MarkAllAsRead(ctx, 10)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPreferences(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectPrepare("SELECT type, is_enabled FROM notification_preference").
+		ExpectQuery().
+		WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"type", "is_enabled"}).AddRow("playlist_liked", false))
+
+	preferences, err := repo.GetPreferences(ctx, 10)
+
+	require.NoError(t, err)
+	require.Len(t, preferences, 1)
+	assert.Equal(t, "playlist_liked", preferences[0].Type)
+	assert.False(t, preferences[0].IsEnabled)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePreferences(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectBegin()
+	stmt := mock.ExpectPrepare("INSERT INTO notification_preference")
+	stmt.ExpectExec().WithArgs(int64(10), "album_released", true).WillReturnResult(sqlmock.NewResult(0, 1))
+	stmt.ExpectExec().WithArgs(int64(10), "playlist_liked", false).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.UpdatePreferences(ctx, 10, []*repoModel.NotificationPreference{
+		{Type: "album_released", IsEnabled: true},
+		{Type: "playlist_liked", IsEnabled: false},
+	})
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdatePreferencesRollback(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewNotificationPostgresRepository(db)
+
+	mock.ExpectBegin()
+	mock.ExpectPrepare("INSERT INTO notification_preference").
+		ExpectExec().
+		WithArgs(int64(10), "album_released", false).
+		WillReturnError(sql.ErrConnDone)
+	mock.ExpectRollback()
+
+	err := repo.UpdatePreferences(ctx, 10, []*repoModel.NotificationPreference{
+		{Type: "album_released", IsEnabled: false},
+	})
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
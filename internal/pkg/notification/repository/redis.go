@@ -0,0 +1,107 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+)
+
+type notificationRedisRepository struct {
+	redisPool *redis.Pool
+}
+
+func (r *notificationRedisRepository) getConn() (redis.Conn, error) {
+	conn := r.redisPool.Get()
+	if err := conn.Err(); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func NewNotificationRedisRepository(redisPool *redis.Pool) notification.PubSubRepository {
+	return &notificationRedisRepository{redisPool: redisPool}
+}
+
+func channelName(userID int64) string {
+	return "notification:" + strconv.FormatInt(userID, 10) + ":pubsub"
+}
+
+func (r *notificationRedisRepository) PublishNotification(ctx context.Context, notification *repoModel.Notification) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "PUBLISH", channelName(notification.UserID), string(payload))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (r *notificationRedisRepository) SubscribeToNotifications(ctx context.Context, userID int64) (<-chan []byte, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+
+	pubSub := redis.PubSubConn{Conn: conn}
+	err = pubSub.Subscribe(channelName(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	messageChan := make(chan []byte, 100)
+
+	go func() {
+		defer func() {
+			if err := conn.Close(); err != nil {
+				logger.Error("Error closing connection:", zap.Error(err))
+			}
+		}()
+		defer close(messageChan)
+		defer func() {
+			if err := pubSub.Unsubscribe(); err != nil {
+				logger.Error("Error unsubscribing from notifications:", zap.Error(err))
+			}
+		}()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				switch v := pubSub.Receive().(type) {
+				case redis.Message:
+					select {
+					case messageChan <- v.Data:
+					case <-ctx.Done():
+						return
+					}
+				case error:
+					return
+				}
+			}
+		}
+	}()
+
+	return messageChan, nil
+}
@@ -0,0 +1,60 @@
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/gomodule/redigo/redis"
+	"github.com/rafaeljusto/redigomock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func setupMockRedis() (*notificationRedisRepository, *redigomock.Conn) {
+	conn := redigomock.NewConn()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return conn, nil
+		},
+	}
+	repo := &notificationRedisRepository{redisPool: pool}
+	return repo, conn
+}
+
+func TestPublishNotification(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	notification := &repoModel.Notification{
+		ID:          1,
+		UserID:      10,
+		Type:        "playlist_liked",
+		ActorName:   "friend",
+		EntityID:    3,
+		EntityTitle: "Road trip",
+		CreatedAt:   time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC),
+	}
+	payload, err := json.Marshal(notification)
+	require.NoError(t, err)
+
+	cmd := mockConn.Command("PUBLISH", "notification:10:pubsub", string(payload)).Expect(int64(1))
+
+	err = repo.PublishNotification(ctx, notification)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(cmd))
+}
+
+func TestPublishNotificationError(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("PUBLISH", "notification:10:pubsub", redigomock.NewAnyData()).ExpectError(errors.New("redis error"))
+
+	err := repo.PublishNotification(ctx, &repoModel.Notification{UserID: 10})
+
+	assert.Error(t, err)
+}
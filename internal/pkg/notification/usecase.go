@@ -0,0 +1,19 @@
+package notification
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+)
+
+type Usecase interface {
+	NotifyAlbumReleased(ctx context.Context, albumID int64) error
+	NotifyPlaylistLiked(ctx context.Context, playlistID int64, actorID int64) error
+	GetNotifications(ctx context.Context, userID int64, unreadOnly bool, pagination *usecase.Pagination) ([]*usecase.Notification, error)
+	GetUnreadCount(ctx context.Context, userID int64) (int64, error)
+	MarkAsRead(ctx context.Context, userID int64, notificationID int64) error
+	MarkAllAsRead(ctx context.Context, userID int64) error
+	GetPreferences(ctx context.Context, userID int64) ([]*usecase.NotificationPreference, error)
+	UpdatePreferences(ctx context.Context, userID int64, preferences []*usecase.NotificationPreference) ([]*usecase.NotificationPreference, error)
+	SubscribeToNotifications(ctx context.Context, userID int64) (<-chan *usecase.Notification, error)
+}
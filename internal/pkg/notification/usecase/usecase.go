@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification"
+	"go.uber.org/zap"
+)
+
+func NewUsecase(notificationRepository notification.Repository, pubSubRepository notification.PubSubRepository) notification.Usecase {
+	return &notificationUsecase{notificationRepository: notificationRepository, pubSubRepository: pubSubRepository}
+}
+
+type notificationUsecase struct {
+	notificationRepository notification.Repository
+	pubSubRepository       notification.PubSubRepository
+}
+
+func (u *notificationUsecase) NotifyAlbumReleased(ctx context.Context, albumID int64) error {
+	notifications, err := u.notificationRepository.CreateAlbumReleasedNotifications(ctx, albumID)
+	if err != nil {
+		return err
+	}
+	u.publish(ctx, notifications)
+	return nil
+}
+
+func (u *notificationUsecase) NotifyPlaylistLiked(ctx context.Context, playlistID int64, actorID int64) error {
+	notifications, err := u.notificationRepository.CreatePlaylistLikedNotification(ctx, playlistID, actorID)
+	if err != nil {
+		return err
+	}
+	u.publish(ctx, notifications)
+	return nil
+}
+
+// publish pushes freshly stored notifications to connected clients. The inbox already has them,
+// so a client that missed the push still sees them on the next fetch.
+func (u *notificationUsecase) publish(ctx context.Context, notifications []*repository.Notification) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	for _, n := range notifications {
+		if err := u.pubSubRepository.PublishNotification(ctx, n); err != nil {
+			logger.Warn("failed to publish notification", zap.Error(err), zap.Int64("userID", n.UserID))
+		}
+	}
+}
+
+func (u *notificationUsecase) GetNotifications(ctx context.Context, userID int64, unreadOnly bool, pagination *usecaseModel.Pagination) ([]*usecaseModel.Notification, error) {
+	notifications, err := u.notificationRepository.GetNotifications(ctx, userID, unreadOnly, repository.PaginationFromUsecaseToRepository(pagination))
+	if err != nil {
+		return nil, err
+	}
+	return model.NotificationsFromRepositoryToUsecase(notifications), nil
+}
+
+func (u *notificationUsecase) GetUnreadCount(ctx context.Context, userID int64) (int64, error) {
+	return u.notificationRepository.GetUnreadCount(ctx, userID)
+}
+
+func (u *notificationUsecase) MarkAsRead(ctx context.Context, userID int64, notificationID int64) error {
+	return u.notificationRepository.MarkAsRead(ctx, userID, notificationID)
+}
+
+func (u *notificationUsecase) MarkAllAsRead(ctx context.Context, userID int64) error {
+	return u.notificationRepository.MarkAllAsRead(ctx, userID)
+}
+
+// GetPreferences returns a setting for every notification type, types the user never touched are enabled
+func (u *notificationUsecase) GetPreferences(ctx context.Context, userID int64) ([]*usecaseModel.NotificationPreference, error) {
+	stored, err := u.notificationRepository.GetPreferences(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make(map[usecaseModel.NotificationType]bool, len(stored))
+	for _, preference := range model.NotificationPreferencesFromRepositoryToUsecase(stored) {
+		enabled[preference.Type] = preference.IsEnabled
+	}
+
+	preferences := make([]*usecaseModel.NotificationPreference, 0, len(usecaseModel.NotificationTypes))
+	for _, notificationType := range usecaseModel.NotificationTypes {
+		isEnabled, ok := enabled[notificationType]
+		preferences = append(preferences, &usecaseModel.NotificationPreference{
+			Type:      notificationType,
+			IsEnabled: !ok || isEnabled,
+		})
+	}
+	return preferences, nil
+}
+
+func (u *notificationUsecase) UpdatePreferences(ctx context.Context, userID int64, preferences []*usecaseModel.NotificationPreference) ([]*usecaseModel.NotificationPreference, error) {
+	for _, preference := range preferences {
+		if !slices.Contains(usecaseModel.NotificationTypes, preference.Type) {
+			return nil, customErrors.ErrInvalidNotificationType
+		}
+	}
+
+	err := u.notificationRepository.UpdatePreferences(ctx, userID, model.NotificationPreferencesFromUsecaseToRepository(preferences))
+	if err != nil {
+		return nil, err
+	}
+	return u.GetPreferences(ctx, userID)
+}
+
+func (u *notificationUsecase) SubscribeToNotifications(ctx context.Context, userID int64) (<-chan *usecaseModel.Notification, error) {
+	repoMessageChan, err := u.pubSubRepository.SubscribeToNotifications(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	usecaseMessageChan := make(chan *usecaseModel.Notification, 100)
+
+	go func() {
+		defer close(usecaseMessageChan)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case repoMessage, ok := <-repoMessageChan:
+				if !ok {
+					return
+				}
+
+				var repoNotification repository.Notification
+				err := json.Unmarshal(repoMessage, &repoNotification)
+				if err != nil {
+					continue
+				}
+
+				select {
+				case usecaseMessageChan <- model.NotificationFromRepositoryToUsecase(&repoNotification):
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return usecaseMessageChan, nil
+}
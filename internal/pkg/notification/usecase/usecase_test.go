@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_notification "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (*mock_notification.MockRepository, *mock_notification.MockPubSubRepository, context.Context) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mock_notification.NewMockRepository(ctrl)
+	mockPubSub := mock_notification.NewMockPubSubRepository(ctrl)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+
+	return mockRepo, mockPubSub, ctx
+}
+
+func TestNotifyAlbumReleased(t *testing.T) {
+	mockRepo, mockPubSub, ctx := setupTest(t)
+	u := NewUsecase(mockRepo, mockPubSub)
+
+	notifications := []*repository.Notification{
+		{ID: 1, UserID: 10, Type: "album_released", EntityID: 5},
+		{ID: 2, UserID: 11, Type: "album_released", EntityID: 5},
+	}
+
+	mockRepo.EXPECT().CreateAlbumReleasedNotifications(ctx, int64(5)).Return(notifications, nil)
+	mockPubSub.EXPECT().PublishNotification(ctx, notifications[0]).Return(nil)
+	mockPubSub.EXPECT().PublishNotification(ctx, notifications[1]).Return(errors.New("redis error"))
+
+	err := u.NotifyAlbumReleased(ctx, 5)
+
+	assert.NoError(t, err)
+}
+
+func TestNotifyPlaylistLikedError(t *testing.T) {
+	mockRepo, mockPubSub, ctx := setupTest(t)
+	u := NewUsecase(mockRepo, mockPubSub)
+
+	mockRepo.EXPECT().CreatePlaylistLikedNotification(ctx, int64(3), int64(7)).Return(nil, errors.New("db error"))
+
+	err := u.NotifyPlaylistLiked(ctx, 3, 7)
+
+	assert.Error(t, err)
+}
+
+func TestGetNotifications(t *testing.T) {
+	mockRepo, mockPubSub, ctx := setupTest(t)
+	u := NewUsecase(mockRepo, mockPubSub)
+
+	mockRepo.EXPECT().GetNotifications(ctx, int64(10), true, &repository.Pagination{Offset: 0, Limit: 10}).
+		Return([]*repository.Notification{{ID: 4, UserID: 10, Type: "playlist_liked", ActorName: "friend"}}, nil)
+
+	notifications, err := u.GetNotifications(ctx, 10, true, &usecaseModel.Pagination{Offset: 0, Limit: 10})
+
+	require.NoError(t, err)
+	require.Len(t, notifications, 1)
+	assert.Equal(t, usecaseModel.NotificationTypePlaylistLiked, notifications[0].Type)
+	assert.Equal(t, "friend", notifications[0].ActorName)
+}
+
+func TestGetPreferencesDefaults(t *testing.T) {
+	mockRepo, mockPubSub, ctx := setupTest(t)
+	u := NewUsecase(mockRepo, mockPubSub)
+
+	mockRepo.EXPECT().GetPreferences(ctx, int64(10)).
+		Return([]*repository.NotificationPreference{{Type: "playlist_liked", IsEnabled: false}}, nil)
+
+	preferences, err := u.GetPreferences(ctx, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*usecaseModel.NotificationPreference{
+		{Type: usecaseModel.NotificationTypeAlbumReleased, IsEnabled: true},
+		{Type: usecaseModel.NotificationTypePlaylistLiked, IsEnabled: false},
+	}, preferences)
+}
+
+func TestUpdatePreferences(t *testing.T) {
+	mockRepo, mockPubSub, ctx := setupTest(t)
+	u := NewUsecase(mockRepo, mockPubSub)
+
+	mockRepo.EXPECT().UpdatePreferences(ctx, int64(10), []*repository.NotificationPreference{{Type: "album_released", IsEnabled: false}}).Return(nil)
+	mockRepo.EXPECT().GetPreferences(ctx, int64(10)).
+		Return([]*repository.NotificationPreference{{Type: "album_released", IsEnabled: false}}, nil)
+
+	preferences, err := u.UpdatePreferences(ctx, 10, []*usecaseModel.NotificationPreference{
+		{Type: usecaseModel.NotificationTypeAlbumReleased, IsEnabled: false},
+	})
+
+	require.NoError(t, err)
+	require.Len(t, preferences, 2)
+	assert.False(t, preferences[0].IsEnabled)
+	assert.True(t, preferences[1].IsEnabled)
+}
+
+func TestUpdatePreferencesInvalidType(t *testing.T) {
+	mockRepo, mockPubSub, ctx := setupTest(t)
+	u := NewUsecase(mockRepo, mockPubSub)
+
+	preferences, err := u.UpdatePreferences(ctx, 10, []*usecaseModel.NotificationPreference{
+		{Type: "track_liked", IsEnabled: false},
+	})
+
+	assert.ErrorIs(t, err, customErrors.ErrInvalidNotificationType)
+	assert.Nil(t, preferences)
+}
+
+func TestSubscribeToNotifications(t *testing.T) {
+	mockRepo, mockPubSub, ctx := setupTest(t)
+	u := NewUsecase(mockRepo, mockPubSub)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	payload, err := json.Marshal(&repository.Notification{ID: 1, UserID: 10, Type: "album_released", EntityTitle: "Album", CreatedAt: createdAt})
+	require.NoError(t, err)
+
+	repoChan := make(chan []byte, 2)
+	repoChan <- []byte("not json")
+	repoChan <- payload
+	close(repoChan)
+
+	mockPubSub.EXPECT().SubscribeToNotifications(ctx, int64(10)).Return((<-chan []byte)(repoChan), nil)
+
+	notifications, err := u.SubscribeToNotifications(ctx, 10)
+	require.NoError(t, err)
+
+	notification, ok := <-notifications
+	require.True(t, ok)
+	assert.Equal(t, int64(1), notification.ID)
+	assert.Equal(t, "Album", notification.EntityTitle)
+	assert.Equal(t, createdAt, notification.CreatedAt)
+
+	_, ok = <-notifications
+	assert.False(t, ok)
+}
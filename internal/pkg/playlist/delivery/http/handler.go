@@ -11,9 +11,12 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/errorStatus"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/query"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/searchAnalytics"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/playlist"
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
@@ -22,12 +25,13 @@ import (
 const ()
 
 type PlaylistHandler struct {
-	usecase playlist.Usecase
-	cfg     *config.Config
+	usecase  playlist.Usecase
+	recorder searchAnalytics.Recorder
+	cfg      *config.Config
 }
 
-func NewPlaylistHandler(usecase playlist.Usecase, cfg *config.Config) *PlaylistHandler {
-	return &PlaylistHandler{usecase: usecase, cfg: cfg}
+func NewPlaylistHandler(usecase playlist.Usecase, recorder searchAnalytics.Recorder, cfg *config.Config) *PlaylistHandler {
+	return &PlaylistHandler{usecase: usecase, recorder: recorder, cfg: cfg}
 }
 
 // CreatePlaylist godoc
@@ -595,6 +599,8 @@ func (h *PlaylistHandler) GetProfilePlaylists(w http.ResponseWriter, r *http.Req
 // @Accept json
 // @Produce json
 // @Param query query string true "Search query"
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
 // @Success 200 {object} delivery.APIResponse{body=[]delivery.Playlist} "List of playlists"
 // @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid query"
 // @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
@@ -611,13 +617,28 @@ func (h *PlaylistHandler) SearchPlaylists(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	playlists, err := h.usecase.SearchPlaylists(ctx, query)
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	playlists, err := h.usecase.SearchPlaylists(ctx, query, &usecaseModel.PlaylistFilters{
+		Pagination: model.PaginationFromDeliveryToUsecase(pagination),
+	})
 	if err != nil {
 		logger.Error("failed to search playlists", zap.Error(err))
 		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
 		return
 	}
 
+	h.recorder.LogQuery(ctx, &usecaseModel.SearchQueryLog{
+		Source:       usecaseModel.SearchSourcePlaylists,
+		Query:        query,
+		ResultsCount: len(playlists),
+	})
+
 	deliveryPlaylists := model.PlaylistsFromUsecaseToDelivery(playlists)
 
 	json.WriteSuccessResponse(w, http.StatusOK, deliveryPlaylists, nil)
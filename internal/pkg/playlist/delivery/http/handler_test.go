@@ -19,6 +19,7 @@ import (
 	customErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	mock_playlist "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/playlist/mocks"
+	mock_search "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/mocks"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
@@ -39,7 +40,7 @@ func setupTestHandler(t *testing.T) (*mock_playlist.MockUsecase, *PlaylistHandle
 	ctrl := gomock.NewController(t)
 	mockUsecase := mock_playlist.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewPlaylistHandler(mockUsecase, cfg)
+	handler := NewPlaylistHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 	return mockUsecase, handler, cfg
 }
 
@@ -1264,7 +1265,9 @@ func TestPlaylistHandler_GetProfilePlaylists(t *testing.T) {
 }
 
 func TestPlaylistHandler_SearchPlaylists(t *testing.T) {
-	mockUsecase, handler, _ := setupTestHandler(t)
+	mockUsecase, _, cfg := setupTestHandler(t)
+	mockRecorder := mock_search.NewMockUsecase(gomock.NewController(t))
+	handler := NewPlaylistHandler(mockUsecase, mockRecorder, cfg)
 
 	testCases := []struct {
 		name           string
@@ -1278,7 +1281,7 @@ func TestPlaylistHandler_SearchPlaylists(t *testing.T) {
 			query: "?query=rock",
 			mockBehavior: func() {
 				mockUsecase.EXPECT().
-					SearchPlaylists(gomock.Any(), "rock").
+					SearchPlaylists(gomock.Any(), "rock", gomock.Any()).
 					Return([]*usecaseModel.Playlist{
 						{
 							ID:        1,
@@ -1293,6 +1296,11 @@ func TestPlaylistHandler_SearchPlaylists(t *testing.T) {
 							Thumbnail: "thumbnail2.jpg",
 						},
 					}, nil)
+				mockRecorder.EXPECT().LogQuery(gomock.Any(), &usecaseModel.SearchQueryLog{
+					Source:       usecaseModel.SearchSourcePlaylists,
+					Query:        "rock",
+					ResultsCount: 2,
+				})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: map[string]interface{}{
@@ -1330,8 +1338,13 @@ func TestPlaylistHandler_SearchPlaylists(t *testing.T) {
 			query: "?query=nonexistent",
 			mockBehavior: func() {
 				mockUsecase.EXPECT().
-					SearchPlaylists(gomock.Any(), "nonexistent").
+					SearchPlaylists(gomock.Any(), "nonexistent", gomock.Any()).
 					Return([]*usecaseModel.Playlist{}, nil)
+				mockRecorder.EXPECT().LogQuery(gomock.Any(), &usecaseModel.SearchQueryLog{
+					Source:       usecaseModel.SearchSourcePlaylists,
+					Query:        "nonexistent",
+					ResultsCount: 0,
+				})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: map[string]interface{}{
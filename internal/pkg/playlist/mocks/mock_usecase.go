@@ -173,18 +173,18 @@ func (mr *MockUsecaseMockRecorder) RemoveTrackFromPlaylist(ctx, request any) *go
 }
 
 // SearchPlaylists mocks base method.
-func (m *MockUsecase) SearchPlaylists(ctx context.Context, query string) ([]*usecase.Playlist, error) {
+func (m *MockUsecase) SearchPlaylists(ctx context.Context, query string, filters *usecase.PlaylistFilters) ([]*usecase.Playlist, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchPlaylists", ctx, query)
+	ret := m.ctrl.Call(m, "SearchPlaylists", ctx, query, filters)
 	ret0, _ := ret[0].([]*usecase.Playlist)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchPlaylists indicates an expected call of SearchPlaylists.
-func (mr *MockUsecaseMockRecorder) SearchPlaylists(ctx, query any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchPlaylists(ctx, query, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPlaylists", reflect.TypeOf((*MockUsecase)(nil).SearchPlaylists), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchPlaylists", reflect.TypeOf((*MockUsecase)(nil).SearchPlaylists), ctx, query, filters)
 }
 
 // UpdatePlaylist mocks base method.
@@ -17,5 +17,5 @@ type Usecase interface {
 	GetPlaylistsToAdd(ctx context.Context, request *usecaseModel.GetPlaylistsToAddRequest) ([]*usecaseModel.PlaylistWithIsIncludedTrack, error)
 	LikePlaylist(ctx context.Context, request *usecaseModel.LikePlaylistRequest) error
 	GetProfilePlaylists(ctx context.Context, username string) ([]*usecaseModel.Playlist, error)
-	SearchPlaylists(ctx context.Context, query string) ([]*usecaseModel.Playlist, error)
+	SearchPlaylists(ctx context.Context, query string, filters *usecaseModel.PlaylistFilters) ([]*usecaseModel.Playlist, error)
 }
@@ -3,6 +3,7 @@ package usecase
 import (
 	"context"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/notification"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/playlist"
 
 	playlistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
@@ -16,13 +17,14 @@ import (
 	"go.uber.org/zap"
 )
 
-func NewUsecase(playlistClient *playlistProto.PlaylistServiceClient, userClient *userProto.UserServiceClient) playlist.Usecase {
-	return &playlistUsecase{playlistClient: playlistClient, userClient: userClient}
+func NewUsecase(playlistClient *playlistProto.PlaylistServiceClient, userClient *userProto.UserServiceClient, notificationUsecase notification.Usecase) playlist.Usecase {
+	return &playlistUsecase{playlistClient: playlistClient, userClient: userClient, notificationUsecase: notificationUsecase}
 }
 
 type playlistUsecase struct {
-	playlistClient *playlistProto.PlaylistServiceClient
-	userClient     *userProto.UserServiceClient
+	playlistClient      *playlistProto.PlaylistServiceClient
+	userClient          *userProto.UserServiceClient
+	notificationUsecase notification.Usecase
 }
 
 func (u *playlistUsecase) CreatePlaylist(ctx context.Context, request *usecaseModel.CreatePlaylistRequest) (*usecaseModel.Playlist, error) {
@@ -40,7 +42,7 @@ func (u *playlistUsecase) CreatePlaylist(ctx context.Context, request *usecaseMo
 		return nil, customErrors.HandleUserGRPCError(err)
 	}
 
-	playlist, err := (*u.playlistClient).CreatePlaylist(ctx, model.CreatePlaylistRequestFromUsecaseToProto(request, thumbnail.GetThumbnail(), privacy.IsPublicPlaylists))
+	playlist, err := (*u.playlistClient).CreatePlaylist(ctx, model.CreatePlaylistRequestFromUsecaseToProto(request, thumbnail.GetThumbnail(), thumbnail.GetThumbnailColor(), privacy.IsPublicPlaylists))
 	if err != nil {
 		return nil, customErrors.HandlePlaylistGRPCError(err)
 	}
@@ -96,16 +98,17 @@ func (u *playlistUsecase) RemoveTrackFromPlaylist(ctx context.Context, request *
 }
 
 func (u *playlistUsecase) UpdatePlaylist(ctx context.Context, request *usecaseModel.UpdatePlaylistRequest) (*usecaseModel.Playlist, error) {
-	thumbnail := ""
+	thumbnail, thumbnailColor := "", ""
 	if request.Thumbnail != nil {
 		thumbnailObject, err := (*u.playlistClient).UploadPlaylistThumbnail(ctx, model.UploadPlaylistThumbnailRequestFromUsecaseToProto(request.Title, request.Thumbnail))
 		if err != nil {
 			return nil, customErrors.HandlePlaylistGRPCError(err)
 		}
 		thumbnail = thumbnailObject.GetThumbnail()
+		thumbnailColor = thumbnailObject.GetThumbnailColor()
 	}
 
-	playlist, err := (*u.playlistClient).UpdatePlaylist(ctx, model.UpdatePlaylistRequestFromUsecaseToProto(request, thumbnail))
+	playlist, err := (*u.playlistClient).UpdatePlaylist(ctx, model.UpdatePlaylistRequestFromUsecaseToProto(request, thumbnail, thumbnailColor))
 	if err != nil {
 		return nil, customErrors.HandlePlaylistGRPCError(err)
 	}
@@ -173,6 +176,13 @@ func (u *playlistUsecase) LikePlaylist(ctx context.Context, request *usecaseMode
 	if err != nil {
 		return customErrors.HandlePlaylistGRPCError(err)
 	}
+
+	if request.IsLike {
+		err = u.notificationUsecase.NotifyPlaylistLiked(ctx, request.PlaylistID, request.UserID)
+		if err != nil {
+			loggerPkg.LoggerFromContext(ctx).Warn("failed to notify about playlist like", zap.Error(err), zap.Int64("playlistID", request.PlaylistID))
+		}
+	}
 	return nil
 }
 
@@ -210,7 +220,7 @@ func (u *playlistUsecase) GetProfilePlaylists(ctx context.Context, username stri
 	return model.PlaylistsFromProtoToUsecase(playlists.GetPlaylists(), username), nil
 }
 
-func (u *playlistUsecase) SearchPlaylists(ctx context.Context, query string) ([]*usecaseModel.Playlist, error) {
+func (u *playlistUsecase) SearchPlaylists(ctx context.Context, query string, filters *usecaseModel.PlaylistFilters) ([]*usecaseModel.Playlist, error) {
 	userID, exists := ctxExtractor.UserFromContext(ctx)
 	if !exists {
 		userID = -1
@@ -219,6 +229,8 @@ func (u *playlistUsecase) SearchPlaylists(ctx context.Context, query string) ([]
 	playlists, err := (*u.playlistClient).SearchPlaylists(ctx, &playlistProto.SearchPlaylistsRequest{
 		Query:  query,
 		UserId: userID,
+		Offset: int64(filters.Pagination.Offset),
+		Limit:  int64(filters.Pagination.Limit),
 	})
 	if err != nil {
 		return nil, customErrors.HandlePlaylistGRPCError(err)
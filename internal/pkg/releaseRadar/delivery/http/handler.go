@@ -0,0 +1,64 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/errorStatus"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar"
+	"go.uber.org/zap"
+)
+
+type ReleaseRadarHandler struct {
+	usecase releaseRadar.Usecase
+	cfg     *config.Config
+}
+
+func NewReleaseRadarHandler(usecase releaseRadar.Usecase, cfg *config.Config) *ReleaseRadarHandler {
+	return &ReleaseRadarHandler{usecase: usecase, cfg: cfg}
+}
+
+// GetReleases godoc
+// @Summary Get release radar
+// @Description Get albums and tracks recently released by the artists the current user liked, newest first
+// @Tags user
+// @Produce json
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.Release} "New releases"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/me/releases [get]
+func (h *ReleaseRadarHandler) GetReleases(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exist := ctxExtractor.UserFromContext(ctx)
+	if !exist {
+		logger.Error("user not auth")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not auth", nil)
+		return
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	releases, err := h.usecase.GetReleases(ctx, userID, model.PaginationFromDeliveryToUsecase(pagination))
+	if err != nil {
+		logger.Error("failed to get new releases", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.ReleasesFromUsecaseToDelivery(releases), nil)
+}
@@ -0,0 +1,106 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_releaseRadar "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestGetReleases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_releaseRadar.NewMockUsecase(ctrl)
+	cfg := &config.Config{
+		Pagination: config.PaginationConfig{
+			DefaultLimit: 10,
+			MaxLimit:     100,
+			MaxOffset:    1000,
+		},
+	}
+	handler := NewReleaseRadarHandler(mockUsecase, cfg)
+
+	tests := []struct {
+		name           string
+		query          string
+		isAuth         bool
+		mockBehavior   func()
+		expectedStatus int
+		expectedCount  int
+	}{
+		{
+			name:   "Success",
+			query:  "?limit=5",
+			isAuth: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetReleases(gomock.Any(), int64(1), &usecaseModel.Pagination{Offset: 0, Limit: 5}).
+					Return([]*usecaseModel.Release{
+						{Type: usecaseModel.ReleaseTypeAlbum, ID: 5, Title: "Album", AlbumID: 5},
+						{Type: usecaseModel.ReleaseTypeTrack, ID: 9, Title: "Feature", AlbumID: 3},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedCount:  2,
+		},
+		{
+			name:           "Unauthorized",
+			isAuth:         false,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Invalid pagination",
+			query:          "?limit=-1",
+			isAuth:         true,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:   "Usecase error",
+			isAuth: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetReleases(gomock.Any(), int64(1), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req := httptest.NewRequest(http.MethodGet, "/user/me/releases"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+			if tt.isAuth {
+				ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(1))
+			}
+			rec := httptest.NewRecorder()
+
+			handler.GetReleases(rec, req.WithContext(ctx))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response struct {
+					Body []*deliveryModel.Release `json:"body"`
+				}
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				require.NoError(t, err)
+				assert.Len(t, response.Body, tt.expectedCount)
+				assert.Equal(t, "album", response.Body[0].Type)
+			}
+		})
+	}
+}
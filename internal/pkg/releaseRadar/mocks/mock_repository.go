@@ -0,0 +1,132 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mocks/mock_repository.go
+//
+
+// Package mock_releaseRadar is a generated GoMock package.
+package mock_releaseRadar
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	repository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetPlaylistID mocks base method.
+func (m *MockRepository) GetPlaylistID(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPlaylistID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPlaylistID indicates an expected call of GetPlaylistID.
+func (mr *MockRepositoryMockRecorder) GetPlaylistID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPlaylistID", reflect.TypeOf((*MockRepository)(nil).GetPlaylistID), ctx, userID)
+}
+
+// GetReleases mocks base method.
+func (m *MockRepository) GetReleases(ctx context.Context, userID int64, since time.Time, pagination *repository.Pagination) ([]*repository.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleases", ctx, userID, since, pagination)
+	ret0, _ := ret[0].([]*repository.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleases indicates an expected call of GetReleases.
+func (mr *MockRepositoryMockRecorder) GetReleases(ctx, userID, since, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleases", reflect.TypeOf((*MockRepository)(nil).GetReleases), ctx, userID, since, pagination)
+}
+
+// GetTrackIDs mocks base method.
+func (m *MockRepository) GetTrackIDs(ctx context.Context, userID int64, since time.Time, limit int64) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackIDs", ctx, userID, since, limit)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackIDs indicates an expected call of GetTrackIDs.
+func (mr *MockRepositoryMockRecorder) GetTrackIDs(ctx, userID, since, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackIDs", reflect.TypeOf((*MockRepository)(nil).GetTrackIDs), ctx, userID, since, limit)
+}
+
+// GetUsersToRefresh mocks base method.
+func (m *MockRepository) GetUsersToRefresh(ctx context.Context, refreshedBefore time.Time) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersToRefresh", ctx, refreshedBefore)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersToRefresh indicates an expected call of GetUsersToRefresh.
+func (mr *MockRepositoryMockRecorder) GetUsersToRefresh(ctx, refreshedBefore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersToRefresh", reflect.TypeOf((*MockRepository)(nil).GetUsersToRefresh), ctx, refreshedBefore)
+}
+
+// LinkPlaylist mocks base method.
+func (m *MockRepository) LinkPlaylist(ctx context.Context, userID, playlistID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkPlaylist", ctx, userID, playlistID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkPlaylist indicates an expected call of LinkPlaylist.
+func (mr *MockRepositoryMockRecorder) LinkPlaylist(ctx, userID, playlistID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkPlaylist", reflect.TypeOf((*MockRepository)(nil).LinkPlaylist), ctx, userID, playlistID)
+}
+
+// TouchPlaylist mocks base method.
+func (m *MockRepository) TouchPlaylist(ctx context.Context, userID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchPlaylist", ctx, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchPlaylist indicates an expected call of TouchPlaylist.
+func (mr *MockRepositoryMockRecorder) TouchPlaylist(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchPlaylist", reflect.TypeOf((*MockRepository)(nil).TouchPlaylist), ctx, userID)
+}
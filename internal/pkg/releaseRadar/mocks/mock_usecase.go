@@ -0,0 +1,84 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=usecase.go -destination=mocks/mock_usecase.go
+//
+
+// Package mock_releaseRadar is a generated GoMock package.
+package mock_releaseRadar
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	usecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetReleases mocks base method.
+func (m *MockUsecase) GetReleases(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.Release, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReleases", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*usecase.Release)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReleases indicates an expected call of GetReleases.
+func (mr *MockUsecaseMockRecorder) GetReleases(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReleases", reflect.TypeOf((*MockUsecase)(nil).GetReleases), ctx, userID, pagination)
+}
+
+// RefreshPlaylists mocks base method.
+func (m *MockUsecase) RefreshPlaylists(ctx context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefreshPlaylists", ctx)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RefreshPlaylists indicates an expected call of RefreshPlaylists.
+func (mr *MockUsecaseMockRecorder) RefreshPlaylists(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefreshPlaylists", reflect.TypeOf((*MockUsecase)(nil).RefreshPlaylists), ctx)
+}
+
+// RunRefresh mocks base method.
+func (m *MockUsecase) RunRefresh(ctx context.Context, interval time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunRefresh", ctx, interval)
+}
+
+// RunRefresh indicates an expected call of RunRefresh.
+func (mr *MockUsecaseMockRecorder) RunRefresh(ctx, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRefresh", reflect.TypeOf((*MockUsecase)(nil).RunRefresh), ctx, interval)
+}
@@ -0,0 +1,17 @@
+package releaseRadar
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+)
+
+type Repository interface {
+	GetReleases(ctx context.Context, userID int64, since time.Time, pagination *repository.Pagination) ([]*repository.Release, error)
+	GetUsersToRefresh(ctx context.Context, refreshedBefore time.Time) ([]int64, error)
+	GetPlaylistID(ctx context.Context, userID int64) (int64, error)
+	LinkPlaylist(ctx context.Context, userID int64, playlistID int64) (bool, error)
+	GetTrackIDs(ctx context.Context, userID int64, since time.Time, limit int64) ([]int64, error)
+	TouchPlaylist(ctx context.Context, userID int64) error
+}
@@ -0,0 +1,275 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar"
+	"go.uber.org/zap"
+)
+
+const (
+	// Tracks of a new album from a liked artist are already in the feed through the album,
+	// only tracks that show up elsewhere (features, singles added to old albums) are listed on their own.
+	// A release counts as new from the day it was published, not from when the label uploaded it
+	GetReleasesQuery = `
+		WITH liked AS (
+			SELECT artist_id FROM favorite_artist WHERE user_id = $1
+		), new_album AS (
+			SELECT a.id, a.title, a.thumbnail_url, a.thumbnail_color, a.published_at AS created_at
+			FROM album a
+			WHERE a.status = 'published' AND a.published_at >= $2
+			  AND EXISTS (
+				SELECT 1 FROM album_artist aa
+				JOIN liked l ON l.artist_id = aa.artist_id
+				WHERE aa.album_id = a.id
+			  )
+		)
+		SELECT 'album' AS type, na.id, na.title, na.thumbnail_url, na.thumbnail_color, artists.titles, na.id AS album_id, na.created_at
+		FROM new_album na
+		JOIN LATERAL (
+			SELECT COALESCE(string_agg(ar.title, ', ' ORDER BY ar.title), '') AS titles
+			FROM album_artist aa
+			JOIN artist ar ON ar.id = aa.artist_id
+			WHERE aa.album_id = na.id
+		) artists ON TRUE
+		UNION ALL
+		SELECT 'track' AS type, t.id, t.title, t.thumbnail_url, t.thumbnail_color, artists.titles, t.album_id, GREATEST(t.created_at, ta_album.published_at)
+		FROM track t
+		JOIN album ta_album ON ta_album.id = t.album_id AND ta_album.status = 'published'
+		JOIN LATERAL (
+			SELECT COALESCE(string_agg(DISTINCT ar.title, ', ' ORDER BY ar.title), '') AS titles
+			FROM track_artist ta
+			JOIN artist ar ON ar.id = ta.artist_id
+			WHERE ta.track_id = t.id
+		) artists ON TRUE
+		WHERE GREATEST(t.created_at, ta_album.published_at) >= $2
+		  AND EXISTS (
+			SELECT 1 FROM track_artist ta
+			JOIN liked l ON l.artist_id = ta.artist_id
+			WHERE ta.track_id = t.id
+		  )
+		  AND NOT EXISTS (SELECT 1 FROM new_album na WHERE na.id = t.album_id)
+		ORDER BY created_at DESC, type, id DESC
+		LIMIT $3 OFFSET $4
+	`
+	// Users whose radar was never built or is older than the refresh interval
+	GetUsersToRefreshQuery = `
+		SELECT DISTINCT fa.user_id
+		FROM favorite_artist fa
+		LEFT JOIN release_radar_playlist rrp ON rrp.user_id = fa.user_id
+		WHERE rrp.refreshed_at IS NULL OR rrp.refreshed_at < $1
+		ORDER BY fa.user_id
+	`
+	GetRadarPlaylistIDQuery = `
+		SELECT playlist_id
+		FROM release_radar_playlist
+		WHERE user_id = $1
+	`
+	// Two gateways refreshing the same user race here, the one that loses removes its playlist
+	LinkRadarPlaylistQuery = `
+		INSERT INTO release_radar_playlist (user_id, playlist_id)
+		VALUES ($1, $2)
+		ON CONFLICT (user_id) DO NOTHING
+	`
+	GetRadarTrackIDsQuery = `
+		SELECT t.id
+		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		WHERE GREATEST(t.created_at, a.published_at) >= $2
+		  AND (
+			EXISTS (
+				SELECT 1 FROM track_artist ta
+				JOIN favorite_artist fa ON fa.artist_id = ta.artist_id
+				WHERE ta.track_id = t.id AND fa.user_id = $1
+			)
+			OR EXISTS (
+				SELECT 1 FROM album_artist aa
+				JOIN favorite_artist fa ON fa.artist_id = aa.artist_id
+				WHERE aa.album_id = t.album_id AND fa.user_id = $1
+			)
+		  )
+		ORDER BY GREATEST(t.created_at, a.published_at) DESC, t.album_id, t.position
+		LIMIT $3
+	`
+	TouchRadarPlaylistQuery = `
+		UPDATE release_radar_playlist
+		SET refreshed_at = NOW()
+		WHERE user_id = $1
+	`
+)
+
+type releaseRadarPostgresRepository struct {
+	db *sql.DB
+}
+
+func NewReleaseRadarPostgresRepository(db *sql.DB) releaseRadar.Repository {
+	return &releaseRadarPostgresRepository{
+		db: db,
+	}
+}
+
+func (r *releaseRadarPostgresRepository) GetReleases(ctx context.Context, userID int64, since time.Time, pagination *repoModel.Pagination) ([]*repoModel.Release, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting new releases", zap.Int64("userID", userID), zap.Time("since", since))
+
+	stmt, err := r.db.PrepareContext(ctx, GetReleasesQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, userID, since, pagination.Limit, pagination.Offset)
+	if err != nil {
+		logger.Error("failed to query new releases", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	releases := make([]*repoModel.Release, 0)
+	for rows.Next() {
+		var release repoModel.Release
+		err = rows.Scan(&release.Type, &release.ID, &release.Title, &release.Thumbnail, &release.ThumbnailColor, &release.ArtistNames, &release.AlbumID, &release.CreatedAt)
+		if err != nil {
+			logger.Error("failed to scan release", zap.Error(err))
+			return nil, err
+		}
+		releases = append(releases, &release)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to iterate releases", zap.Error(err))
+		return nil, err
+	}
+
+	return releases, nil
+}
+
+func (r *releaseRadarPostgresRepository) GetUsersToRefresh(ctx context.Context, refreshedBefore time.Time) ([]int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting users with a stale release radar", zap.Time("refreshedBefore", refreshedBefore))
+
+	stmt, err := r.db.PrepareContext(ctx, GetUsersToRefreshQuery)
+	if err != nil {
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, refreshedBefore)
+	if err != nil {
+		logger.Error("failed to query users to refresh", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	userIDs := make([]int64, 0)
+	for rows.Next() {
+		var userID int64
+		if err := rows.Scan(&userID); err != nil {
+			logger.Error("failed to scan user id", zap.Error(err))
+			return nil, err
+		}
+		userIDs = append(userIDs, userID)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to iterate users to refresh", zap.Error(err))
+		return nil, err
+	}
+
+	return userIDs, nil
+}
+
+// GetPlaylistID returns 0 when the user has no radar playlist yet
+func (r *releaseRadarPostgresRepository) GetPlaylistID(ctx context.Context, userID int64) (int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var playlistID int64
+	err := r.db.QueryRowContext(ctx, GetRadarPlaylistIDQuery, userID).Scan(&playlistID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		logger.Error("failed to get release radar playlist", zap.Error(err))
+		return 0, err
+	}
+	return playlistID, nil
+}
+
+// LinkPlaylist reports false when the user got a radar playlist in the meantime
+func (r *releaseRadarPostgresRepository) LinkPlaylist(ctx context.Context, userID int64, playlistID int64) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	result, err := r.db.ExecContext(ctx, LinkRadarPlaylistQuery, userID, playlistID)
+	if err != nil {
+		logger.Error("failed to link release radar playlist", zap.Error(err))
+		return false, err
+	}
+	linked, err := result.RowsAffected()
+	if err != nil {
+		logger.Error("failed to count linked release radar playlists", zap.Error(err))
+		return false, err
+	}
+	return linked > 0, nil
+}
+
+// GetTrackIDs lists the newest tracks of the user's liked artists released since the given time
+func (r *releaseRadarPostgresRepository) GetTrackIDs(ctx context.Context, userID int64, since time.Time, limit int64) ([]int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	rows, err := r.db.QueryContext(ctx, GetRadarTrackIDsQuery, userID, since, limit)
+	if err != nil {
+		logger.Error("failed to query release radar tracks", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	trackIDs := make([]int64, 0)
+	for rows.Next() {
+		var trackID int64
+		if err := rows.Scan(&trackID); err != nil {
+			logger.Error("failed to scan release radar track", zap.Error(err))
+			return nil, err
+		}
+		trackIDs = append(trackIDs, trackID)
+	}
+	if err := rows.Err(); err != nil {
+		logger.Error("failed to iterate release radar tracks", zap.Error(err))
+		return nil, err
+	}
+	return trackIDs, nil
+}
+
+func (r *releaseRadarPostgresRepository) TouchPlaylist(ctx context.Context, userID int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if _, err := r.db.ExecContext(ctx, TouchRadarPlaylistQuery, userID); err != nil {
+		logger.Error("failed to update release radar refresh time", zap.Error(err))
+		return err
+	}
+	return nil
+}
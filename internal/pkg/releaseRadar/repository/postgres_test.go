@@ -0,0 +1,162 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupTest(t *testing.T) (*sql.DB, sqlmock.Sqlmock, context.Context) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+	return db, mock, ctx
+}
+
+func TestGetReleases(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewReleaseRadarPostgresRepository(db)
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+
+	rows := sqlmock.NewRows([]string{"type", "id", "title", "thumbnail_url", "thumbnail_color", "titles", "album_id", "created_at"}).
+		AddRow("album", 5, "Album", "album.png", "#a1b2c3", "Artist", 5, now).
+		AddRow("track", 9, "Feature", "track.png", "", "Artist, Friend", 3, now.Add(-time.Hour))
+
+	mock.ExpectPrepare("WITH liked AS").
+		ExpectQuery().
+		WithArgs(int64(10), since, 20, 0).
+		WillReturnRows(rows)
+
+	releases, err := repo.GetReleases(ctx, 10, since, &repoModel.Pagination{Offset: 0, Limit: 20})
+
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	assert.Equal(t, "album", releases[0].Type)
+	assert.Equal(t, int64(5), releases[0].AlbumID)
+	assert.Equal(t, "#a1b2c3", releases[0].ThumbnailColor)
+	assert.Equal(t, "track", releases[1].Type)
+	assert.Equal(t, "Artist, Friend", releases[1].ArtistNames)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetReleasesError(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewReleaseRadarPostgresRepository(db)
+	since := time.Now()
+
+	mock.ExpectPrepare("WITH liked AS").
+		ExpectQuery().
+		WithArgs(int64(10), since, 20, 0).
+		WillReturnError(sql.ErrConnDone)
+
+	releases, err := repo.GetReleases(ctx, 10, since, &repoModel.Pagination{Offset: 0, Limit: 20})
+
+	assert.Error(t, err)
+	assert.Nil(t, releases)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetUsersToRefresh(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewReleaseRadarPostgresRepository(db)
+	refreshedBefore := time.Now()
+
+	mock.ExpectPrepare("SELECT DISTINCT fa.user_id").
+		ExpectQuery().
+		WithArgs(refreshedBefore).
+		WillReturnRows(sqlmock.NewRows([]string{"user_id"}).AddRow(10).AddRow(11))
+
+	userIDs, err := repo.GetUsersToRefresh(ctx, refreshedBefore)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{10, 11}, userIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPlaylistID(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewReleaseRadarPostgresRepository(db)
+
+	mock.ExpectQuery("SELECT playlist_id FROM release_radar_playlist").
+		WithArgs(int64(10)).
+		WillReturnRows(sqlmock.NewRows([]string{"playlist_id"}).AddRow(7))
+	mock.ExpectQuery("SELECT playlist_id FROM release_radar_playlist").
+		WithArgs(int64(11)).
+		WillReturnError(sql.ErrNoRows)
+
+	playlistID, err := repo.GetPlaylistID(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), playlistID)
+
+	playlistID, err = repo.GetPlaylistID(ctx, 11)
+	require.NoError(t, err)
+	assert.Zero(t, playlistID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestLinkPlaylist(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewReleaseRadarPostgresRepository(db)
+
+	mock.ExpectExec("INSERT INTO release_radar_playlist").WithArgs(int64(10), int64(8)).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO release_radar_playlist").WithArgs(int64(10), int64(9)).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	linked, err := repo.LinkPlaylist(ctx, 10, 8)
+	require.NoError(t, err)
+	assert.True(t, linked)
+
+	linked, err = repo.LinkPlaylist(ctx, 10, 9)
+	require.NoError(t, err)
+	assert.False(t, linked)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackIDs(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewReleaseRadarPostgresRepository(db)
+	since := time.Now()
+
+	mock.ExpectQuery("SELECT t.id FROM track t").
+		WithArgs(int64(10), since, int64(50)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(1))
+
+	trackIDs, err := repo.GetTrackIDs(ctx, 10, since, 50)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 1}, trackIDs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTouchPlaylist(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewReleaseRadarPostgresRepository(db)
+
+	mock.ExpectExec("UPDATE release_radar_playlist").WithArgs(int64(10)).WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assert.NoError(t, repo.TouchPlaylist(ctx, 10))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
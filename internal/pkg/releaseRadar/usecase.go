@@ -0,0 +1,14 @@
+package releaseRadar
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+)
+
+type Usecase interface {
+	GetReleases(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.Release, error)
+	RefreshPlaylists(ctx context.Context) error
+	RunRefresh(ctx context.Context, interval time.Duration)
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	playlistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar"
+	"go.uber.org/zap"
+)
+
+const PlaylistTitle = "Release Radar"
+
+// a user who already has playlists called "Release Radar" gets "Release Radar (2)" and so on up to this
+const maxPlaylistTitleNumber = 20
+
+func NewUsecase(releaseRadarRepository releaseRadar.Repository, playlistClient playlistProto.PlaylistServiceClient, cfg config.ReleaseRadarConfig) releaseRadar.Usecase {
+	return &releaseRadarUsecase{releaseRadarRepository: releaseRadarRepository, playlistClient: playlistClient, cfg: cfg, now: time.Now}
+}
+
+type releaseRadarUsecase struct {
+	releaseRadarRepository releaseRadar.Repository
+	playlistClient         playlistProto.PlaylistServiceClient
+	cfg                    config.ReleaseRadarConfig
+	now                    func() time.Time
+}
+
+func (u *releaseRadarUsecase) GetReleases(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.Release, error) {
+	since := u.now().Add(-u.cfg.Window)
+	releases, err := u.releaseRadarRepository.GetReleases(ctx, userID, since, repository.PaginationFromUsecaseToRepository(pagination))
+	if err != nil {
+		return nil, err
+	}
+	return model.ReleasesFromRepositoryToUsecase(releases), nil
+}
+
+// RefreshPlaylists rebuilds every radar playlist that is older than the refresh interval
+// from the tracks released during that interval. One user failing does not stop the others.
+func (u *releaseRadarUsecase) RefreshPlaylists(ctx context.Context) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	since := u.now().Add(-u.cfg.RefreshInterval)
+
+	userIDs, err := u.releaseRadarRepository.GetUsersToRefresh(ctx, since)
+	if err != nil {
+		return err
+	}
+
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err := u.refreshPlaylist(ctx, userID, since); err != nil {
+			logger.Warn("failed to refresh release radar playlist", zap.Error(err), zap.Int64("userID", userID))
+		}
+	}
+	return nil
+}
+
+// refreshPlaylist replaces the tracks of the user's radar playlist, creating the playlist on the first run.
+// The playlist service owns the playlist, only the link to the user and the refresh time are kept here.
+func (u *releaseRadarUsecase) refreshPlaylist(ctx context.Context, userID int64, since time.Time) error {
+	playlistID, err := u.playlistID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	trackIDs, err := u.releaseRadarRepository.GetTrackIDs(ctx, userID, since, u.cfg.PlaylistSize)
+	if err != nil {
+		return err
+	}
+
+	current, err := u.playlistClient.GetPlaylistTrackIds(ctx, &playlistProto.GetPlaylistTrackIdsRequest{
+		UserId:     userID,
+		PlaylistId: playlistID,
+	})
+	if err != nil {
+		return customErrors.HandlePlaylistGRPCError(err)
+	}
+	for _, trackID := range current.GetTrackIds() {
+		_, err := u.playlistClient.RemoveTrackFromPlaylist(ctx, &playlistProto.RemoveTrackFromPlaylistRequest{
+			UserId:     userID,
+			PlaylistId: playlistID,
+			TrackId:    trackID,
+		})
+		if err != nil {
+			return customErrors.HandlePlaylistGRPCError(err)
+		}
+	}
+	for _, trackID := range trackIDs {
+		_, err := u.playlistClient.AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{
+			UserId:     userID,
+			PlaylistId: playlistID,
+			TrackId:    trackID,
+		})
+		if err != nil {
+			return customErrors.HandlePlaylistGRPCError(err)
+		}
+	}
+
+	return u.releaseRadarRepository.TouchPlaylist(ctx, userID)
+}
+
+// playlistID returns the user's radar playlist, a new one is created and linked when there is none
+func (u *releaseRadarUsecase) playlistID(ctx context.Context, userID int64) (int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	playlistID, err := u.releaseRadarRepository.GetPlaylistID(ctx, userID)
+	if err != nil || playlistID != 0 {
+		return playlistID, err
+	}
+
+	playlist, err := u.createPlaylist(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	linked, err := u.releaseRadarRepository.LinkPlaylist(ctx, userID, playlist.GetId())
+	if err == nil && linked {
+		return playlist.GetId(), nil
+	}
+
+	_, removeErr := u.playlistClient.RemovePlaylist(ctx, &playlistProto.RemovePlaylistRequest{
+		UserId:     userID,
+		PlaylistId: playlist.GetId(),
+	})
+	if removeErr != nil {
+		logger.Error("failed to remove unlinked release radar playlist", zap.Error(removeErr), zap.Int64("playlistID", playlist.GetId()))
+	}
+	if err != nil {
+		return 0, err
+	}
+	return u.releaseRadarRepository.GetPlaylistID(ctx, userID)
+}
+
+// createPlaylist creates an empty private playlist under the first free title, so a playlist
+// the user named "Release Radar" themselves is left alone
+func (u *releaseRadarUsecase) createPlaylist(ctx context.Context, userID int64) (*playlistProto.Playlist, error) {
+	title := PlaylistTitle
+	for number := 2; ; number++ {
+		playlist, err := u.playlistClient.CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{
+			UserId: userID,
+			Title:  title,
+		})
+		if err == nil {
+			return playlist, nil
+		}
+
+		err = customErrors.HandlePlaylistGRPCError(err)
+		if !errors.Is(err, customErrors.ErrPlaylistDuplicate) || number > maxPlaylistTitleNumber {
+			return nil, err
+		}
+		title = fmt.Sprintf("%s (%d)", PlaylistTitle, number)
+	}
+}
+
+// RunRefresh checks for stale playlists right away and then every interval until ctx is done,
+// so restarts do not push the weekly refresh back
+func (u *releaseRadarUsecase) RunRefresh(ctx context.Context, interval time.Duration) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.RefreshPlaylists(ctx); err != nil {
+			logger.Error("failed to refresh release radar playlists", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	playlistProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_releaseRadar "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/releaseRadar/mocks"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	testNow = time.Date(2025, 1, 8, 12, 0, 0, 0, time.UTC)
+	testCfg = config.ReleaseRadarConfig{
+		Window:          30 * 24 * time.Hour,
+		RefreshInterval: 7 * 24 * time.Hour,
+		PlaylistSize:    50,
+	}
+)
+
+func setupTest(t *testing.T) (*mock_releaseRadar.MockRepository, *releaseRadarUsecase, context.Context) {
+	mockRepo, _, u, ctx := setupPlaylistTest(t)
+	return mockRepo, u, ctx
+}
+
+func setupPlaylistTest(t *testing.T) (*mock_releaseRadar.MockRepository, *mocks.MockPlaylistServiceClient, *releaseRadarUsecase, context.Context) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mock_releaseRadar.NewMockRepository(ctrl)
+	mockPlaylistClient := mocks.NewMockPlaylistServiceClient(ctrl)
+
+	u := NewUsecase(mockRepo, mockPlaylistClient, testCfg).(*releaseRadarUsecase)
+	u.now = func() time.Time { return testNow }
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+	return mockRepo, mockPlaylistClient, u, ctx
+}
+
+func TestGetReleases(t *testing.T) {
+	mockRepo, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetReleases(ctx, int64(10), testNow.Add(-testCfg.Window), &repository.Pagination{Offset: 0, Limit: 10}).
+		Return([]*repository.Release{
+			{Type: "album", ID: 5, Title: "Album", ArtistNames: "Artist", AlbumID: 5},
+			{Type: "track", ID: 9, Title: "Feature", ArtistNames: "Artist, Friend", AlbumID: 3},
+		}, nil)
+
+	releases, err := u.GetReleases(ctx, 10, &usecaseModel.Pagination{Offset: 0, Limit: 10})
+
+	require.NoError(t, err)
+	require.Len(t, releases, 2)
+	assert.Equal(t, usecaseModel.ReleaseTypeAlbum, releases[0].Type)
+	assert.Equal(t, usecaseModel.ReleaseTypeTrack, releases[1].Type)
+	assert.Equal(t, "Artist, Friend", releases[1].ArtistNames)
+}
+
+func TestGetReleasesError(t *testing.T) {
+	mockRepo, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetReleases(ctx, int64(10), gomock.Any(), gomock.Any()).Return(nil, errors.New("db error"))
+
+	releases, err := u.GetReleases(ctx, 10, &usecaseModel.Pagination{Offset: 0, Limit: 10})
+
+	assert.Error(t, err)
+	assert.Nil(t, releases)
+}
+
+func TestRefreshPlaylists(t *testing.T) {
+	mockRepo, mockPlaylistClient, u, ctx := setupPlaylistTest(t)
+	since := testNow.Add(-testCfg.RefreshInterval)
+
+	mockRepo.EXPECT().GetUsersToRefresh(ctx, since).Return([]int64{10, 11, 12}, nil)
+
+	mockRepo.EXPECT().GetPlaylistID(ctx, int64(10)).Return(int64(7), nil)
+	mockRepo.EXPECT().GetTrackIDs(ctx, int64(10), since, int64(50)).Return([]int64{3, 1}, nil)
+	mockPlaylistClient.EXPECT().GetPlaylistTrackIds(ctx, &playlistProto.GetPlaylistTrackIdsRequest{UserId: 10, PlaylistId: 7}).
+		Return(&playlistProto.GetPlaylistTrackIdsResponse{TrackIds: []int64{5}}, nil)
+	mockPlaylistClient.EXPECT().RemoveTrackFromPlaylist(ctx, &playlistProto.RemoveTrackFromPlaylistRequest{UserId: 10, PlaylistId: 7, TrackId: 5}).Return(&emptypb.Empty{}, nil)
+	gomock.InOrder(
+		mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{UserId: 10, PlaylistId: 7, TrackId: 3}).Return(&emptypb.Empty{}, nil),
+		mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{UserId: 10, PlaylistId: 7, TrackId: 1}).Return(&emptypb.Empty{}, nil),
+	)
+	mockRepo.EXPECT().TouchPlaylist(ctx, int64(10)).Return(nil)
+
+	mockRepo.EXPECT().GetPlaylistID(ctx, int64(11)).Return(int64(0), errors.New("db error"))
+
+	mockRepo.EXPECT().GetPlaylistID(ctx, int64(12)).Return(int64(8), nil)
+	mockRepo.EXPECT().GetTrackIDs(ctx, int64(12), since, int64(50)).Return([]int64{}, nil)
+	mockPlaylistClient.EXPECT().GetPlaylistTrackIds(ctx, gomock.Any()).Return(&playlistProto.GetPlaylistTrackIdsResponse{}, nil)
+	mockRepo.EXPECT().TouchPlaylist(ctx, int64(12)).Return(nil)
+
+	err := u.RefreshPlaylists(ctx)
+
+	assert.NoError(t, err)
+}
+
+func TestRefreshPlaylistCreatesUnderFreeTitle(t *testing.T) {
+	mockRepo, mockPlaylistClient, u, ctx := setupPlaylistTest(t)
+	since := testNow.Add(-testCfg.RefreshInterval)
+	duplicate := status.Error(codes.AlreadyExists, customErrors.ErrPlaylistDuplicate.Error())
+
+	mockRepo.EXPECT().GetPlaylistID(ctx, int64(10)).Return(int64(0), nil)
+	gomock.InOrder(
+		mockPlaylistClient.EXPECT().CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{UserId: 10, Title: PlaylistTitle}).Return(nil, duplicate),
+		mockPlaylistClient.EXPECT().CreatePlaylist(ctx, &playlistProto.CreatePlaylistRequest{UserId: 10, Title: PlaylistTitle + " (2)"}).Return(&playlistProto.Playlist{Id: 8}, nil),
+	)
+	mockRepo.EXPECT().LinkPlaylist(ctx, int64(10), int64(8)).Return(true, nil)
+	mockRepo.EXPECT().GetTrackIDs(ctx, int64(10), since, int64(50)).Return([]int64{3}, nil)
+	mockPlaylistClient.EXPECT().GetPlaylistTrackIds(ctx, gomock.Any()).Return(&playlistProto.GetPlaylistTrackIdsResponse{}, nil)
+	mockPlaylistClient.EXPECT().AddTrackToPlaylist(ctx, &playlistProto.AddTrackToPlaylistRequest{UserId: 10, PlaylistId: 8, TrackId: 3}).Return(&emptypb.Empty{}, nil)
+	mockRepo.EXPECT().TouchPlaylist(ctx, int64(10)).Return(nil)
+
+	assert.NoError(t, u.refreshPlaylist(ctx, 10, since))
+}
+
+func TestRefreshPlaylistLosesCreateRace(t *testing.T) {
+	mockRepo, mockPlaylistClient, u, ctx := setupPlaylistTest(t)
+
+	mockRepo.EXPECT().GetPlaylistID(ctx, int64(10)).Return(int64(0), nil)
+	mockPlaylistClient.EXPECT().CreatePlaylist(ctx, gomock.Any()).Return(&playlistProto.Playlist{Id: 8}, nil)
+	mockRepo.EXPECT().LinkPlaylist(ctx, int64(10), int64(8)).Return(false, nil)
+	mockPlaylistClient.EXPECT().RemovePlaylist(ctx, &playlistProto.RemovePlaylistRequest{UserId: 10, PlaylistId: 8}).Return(&emptypb.Empty{}, nil)
+	mockRepo.EXPECT().GetPlaylistID(ctx, int64(10)).Return(int64(7), nil)
+
+	playlistID, err := u.playlistID(ctx, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), playlistID)
+}
+
+func TestRefreshPlaylistsError(t *testing.T) {
+	mockRepo, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUsersToRefresh(ctx, gomock.Any()).Return(nil, errors.New("db error"))
+
+	err := u.RefreshPlaylists(ctx)
+
+	assert.Error(t, err)
+}
+
+func TestRunRefreshStopsOnCancel(t *testing.T) {
+	mockRepo, u, ctx := setupTest(t)
+	ctx, cancel := context.WithCancel(ctx)
+
+	mockRepo.EXPECT().GetUsersToRefresh(ctx, gomock.Any()).DoAndReturn(func(context.Context, time.Time) ([]int64, error) {
+		cancel()
+		return nil, nil
+	})
+
+	done := make(chan struct{})
+	go func() {
+		u.RunRefresh(ctx, time.Hour)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("RunRefresh did not stop after cancel")
+	}
+}
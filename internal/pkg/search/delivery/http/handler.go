@@ -0,0 +1,241 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/asaskevich/govalidator"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	customErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/errorStatus"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
+	model "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultAnalyticsLimit = 20
+	maxAnalyticsLimit     = 100
+)
+
+var allSearchTypes = []usecaseModel.SearchType{
+	usecaseModel.SearchTypeTracks,
+	usecaseModel.SearchTypeAlbums,
+	usecaseModel.SearchTypeArtists,
+	usecaseModel.SearchTypePlaylists,
+}
+
+type SearchHandler struct {
+	usecase search.Usecase
+	cfg     *config.Config
+}
+
+func NewSearchHandler(usecase search.Usecase, cfg *config.Config) *SearchHandler {
+	return &SearchHandler{usecase: usecase, cfg: cfg}
+}
+
+func parseSearchTypes(raw string) ([]usecaseModel.SearchType, error) {
+	if raw == "" {
+		return allSearchTypes, nil
+	}
+
+	seen := make(map[usecaseModel.SearchType]bool)
+	types := make([]usecaseModel.SearchType, 0, len(allSearchTypes))
+	for _, part := range strings.Split(raw, ",") {
+		searchType := usecaseModel.SearchType(strings.TrimSpace(part))
+		switch searchType {
+		case usecaseModel.SearchTypeTracks, usecaseModel.SearchTypeAlbums, usecaseModel.SearchTypeArtists, usecaseModel.SearchTypePlaylists:
+		default:
+			return nil, customErrors.ErrInvalidSearchType
+		}
+		if !seen[searchType] {
+			seen[searchType] = true
+			types = append(types, searchType)
+		}
+	}
+	return types, nil
+}
+
+// Search godoc
+// @Summary Unified search
+// @Description Search tracks, albums, artists and playlists in one call. Results are grouped by type and ranked by relevance and popularity. If nothing is found, the query is retried in the other keyboard layout (ЙЦУКЕН↔QWERTY).
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search query"
+// @Param type query string false "Comma-separated list of types to search (tracks, albums, artists, playlists). Default: all"
+// @Param offset query integer false "Offset per type (default: 0)"
+// @Param limit query integer false "Limit per type (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=delivery.SearchResult} "Grouped search results"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid query, type or pagination"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /search [get]
+func (h *SearchHandler) Search(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		logger.Warn("attempt to search for empty query")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(customErrors.ErrSearchQueryEmpty), customErrors.ErrSearchQueryEmpty.Error(), nil)
+		return
+	}
+
+	types, err := parseSearchTypes(r.URL.Query().Get("type"))
+	if err != nil {
+		logger.Error("failed to parse search types", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	usecaseResult, err := h.usecase.Search(ctx, &usecaseModel.SearchRequest{
+		Query:      query,
+		Types:      types,
+		Pagination: model.PaginationFromDeliveryToUsecase(pagination),
+	})
+	if err != nil {
+		logger.Error("failed to search", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	h.usecase.LogQuery(ctx, &usecaseModel.SearchQueryLog{
+		Source:       usecaseModel.SearchSourceUnified,
+		Query:        query,
+		ResultsCount: len(usecaseResult.Tracks) + len(usecaseResult.Albums) + len(usecaseResult.Artists) + len(usecaseResult.Playlists),
+	})
+
+	result := model.SearchResultFromUsecaseToDelivery(usecaseResult)
+	json.WriteSuccessResponse(w, http.StatusOK, result, nil)
+}
+
+// Suggest godoc
+// @Summary Search suggestions
+// @Description Autocomplete for the search box: top prefix matches across artists, albums and tracks. Best effort - types that do not answer within the latency budget are omitted. Popular queries are served from cache.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param q query string true "Search prefix"
+// @Success 200 {object} delivery.APIResponse{body=delivery.SuggestResult} "Suggestions"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - empty query"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /search/suggest [get]
+func (h *SearchHandler) Suggest(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	query := strings.TrimSpace(r.URL.Query().Get("q"))
+	if query == "" {
+		logger.Warn("attempt to get suggestions for empty query")
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(customErrors.ErrSearchQueryEmpty), customErrors.ErrSearchQueryEmpty.Error(), nil)
+		return
+	}
+
+	usecaseResult, err := h.usecase.Suggest(ctx, query)
+	if err != nil {
+		logger.Error("failed to get suggestions", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	result := model.SuggestResultFromUsecaseToDelivery(usecaseResult)
+	json.WriteSuccessResponse(w, http.StatusOK, result, nil)
+}
+
+// Click godoc
+// @Summary Register a search result click
+// @Description Records that a search result was opened, used for click-through rate. No user data is stored.
+// @Tags search
+// @Accept json
+// @Produce json
+// @Param click body delivery.SearchClick true "Clicked result"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Message} "Click recorded"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid click"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /search/click [post]
+func (h *SearchHandler) Click(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var click delivery.SearchClick
+	err := json.ReadJSON(w, r, &click)
+	if err != nil {
+		logger.Error("failed to read json", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	valid, err := govalidator.ValidateStruct(click)
+	if err != nil || !valid {
+		logger.Error("failed to validate search click", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(customErrors.ErrInvalidSearchClick), customErrors.ErrInvalidSearchClick.Error(), nil)
+		return
+	}
+
+	err = h.usecase.LogClick(ctx, model.SearchClickFromDeliveryToUsecase(&click))
+	if err != nil {
+		logger.Error("failed to log search click", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, &delivery.Message{Message: "click recorded"}, nil)
+}
+
+// GetAnalytics godoc
+// @Summary Search analytics
+// @Description Top queries, top zero-result queries and click-through rate. Only accessible by administrators. The same data is exported to Prometheus as api_search_queries_total and api_search_clicks_total.
+// @Tags search
+// @Produce json
+// @Security AdminAuth
+// @Param limit query integer false "Number of queries in each list (default: 20, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=delivery.SearchAnalytics} "Search analytics"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid limit"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized - admin access required"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /search/analytics [get]
+func (h *SearchHandler) GetAnalytics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	if !ctxExtractor.AdminFromContext(ctx) {
+		logger.Error("Unauthorized access attempt")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "Unauthorized", nil)
+		return
+	}
+
+	limit := int64(defaultAnalyticsLimit)
+	if rawLimit := r.URL.Query().Get("limit"); rawLimit != "" {
+		parsed, err := strconv.ParseInt(rawLimit, 10, 64)
+		if err != nil || parsed <= 0 {
+			logger.Error("invalid analytics limit", zap.String("limit", rawLimit))
+			json.WriteErrorResponse(w, http.StatusBadRequest, "invalid limit", nil)
+			return
+		}
+		limit = min(parsed, maxAnalyticsLimit)
+	}
+
+	usecaseAnalytics, err := h.usecase.GetAnalytics(ctx, limit)
+	if err != nil {
+		logger.Error("failed to get search analytics", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	analytics := model.SearchAnalyticsFromUsecaseToDelivery(usecaseAnalytics)
+	json.WriteSuccessResponse(w, http.StatusOK, analytics, nil)
+}
@@ -0,0 +1,365 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_search "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func setupTestHandler(t *testing.T) (*mock_search.MockUsecase, *SearchHandler) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_search.NewMockUsecase(ctrl)
+	cfg := &config.Config{
+		Pagination: config.PaginationConfig{
+			DefaultLimit: 10,
+			MaxLimit:     100,
+			MaxOffset:    1000,
+		},
+	}
+	handler := NewSearchHandler(mockUsecase, cfg)
+	return mockUsecase, handler
+}
+
+func TestSearch(t *testing.T) {
+	mockUsecase, handler := setupTestHandler(t)
+
+	tests := []struct {
+		name           string
+		query          string
+		mockBehavior   func()
+		expectedStatus int
+		expectedQuery  string
+		expectedTracks int
+	}{
+		{
+			name:  "Success all types",
+			query: "?q=test",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().Search(gomock.Any(), &usecaseModel.SearchRequest{
+					Query: "test",
+					Types: []usecaseModel.SearchType{
+						usecaseModel.SearchTypeTracks,
+						usecaseModel.SearchTypeAlbums,
+						usecaseModel.SearchTypeArtists,
+						usecaseModel.SearchTypePlaylists,
+					},
+					Pagination: &usecaseModel.Pagination{Offset: 0, Limit: 10},
+				}).Return(&usecaseModel.SearchResult{
+					Query:  "test",
+					Tracks: []*usecaseModel.Track{{ID: 1, Title: "Test Track"}},
+				}, nil)
+				mockUsecase.EXPECT().LogQuery(gomock.Any(), &usecaseModel.SearchQueryLog{
+					Source:       usecaseModel.SearchSourceUnified,
+					Query:        "test",
+					ResultsCount: 1,
+				})
+			},
+			expectedStatus: http.StatusOK,
+			expectedQuery:  "test",
+			expectedTracks: 1,
+		},
+		{
+			name:  "Success selected type with pagination",
+			query: "?q=test&type=tracks,tracks&offset=20&limit=5",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().Search(gomock.Any(), &usecaseModel.SearchRequest{
+					Query:      "test",
+					Types:      []usecaseModel.SearchType{usecaseModel.SearchTypeTracks},
+					Pagination: &usecaseModel.Pagination{Offset: 20, Limit: 5},
+				}).Return(&usecaseModel.SearchResult{
+					Query:  "test",
+					Tracks: []*usecaseModel.Track{{ID: 1}, {ID: 2}},
+				}, nil)
+				mockUsecase.EXPECT().LogQuery(gomock.Any(), gomock.Any())
+			},
+			expectedStatus: http.StatusOK,
+			expectedQuery:  "test",
+			expectedTracks: 2,
+		},
+		{
+			name:           "Empty query",
+			query:          "?q=%20",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid type",
+			query:          "?q=test&type=songs",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid pagination",
+			query:          "?q=test&limit=abc",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Usecase error",
+			query: "?q=test",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().Search(gomock.Any(), gomock.Any()).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/search"+tt.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar()))
+
+			handler.Search(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response deliveryModel.APIResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				respBodyBytes, err := json.Marshal(response.Body)
+				require.NoError(t, err)
+
+				var result deliveryModel.SearchResult
+				err = json.Unmarshal(respBodyBytes, &result)
+				require.NoError(t, err)
+
+				assert.Equal(t, tt.expectedQuery, result.Query)
+				assert.Len(t, result.Tracks, tt.expectedTracks)
+				assert.NotNil(t, result.Albums)
+			}
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	mockUsecase, handler := setupTestHandler(t)
+
+	tests := []struct {
+		name                string
+		query               string
+		mockBehavior        func()
+		expectedStatus      int
+		expectedSuggestions int
+	}{
+		{
+			name:  "Success",
+			query: "?q=lin",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().Suggest(gomock.Any(), "lin").Return(&usecaseModel.SuggestResult{
+					Query: "lin",
+					Suggestions: []*usecaseModel.Suggestion{
+						{Type: usecaseModel.SearchTypeArtists, ID: 1, Title: "Linkin Park"},
+						{Type: usecaseModel.SearchTypeTracks, ID: 2, Title: "Lithium"},
+					},
+				}, nil)
+			},
+			expectedStatus:      http.StatusOK,
+			expectedSuggestions: 2,
+		},
+		{
+			name:           "Empty query",
+			query:          "?q=",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:  "Usecase error",
+			query: "?q=lin",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().Suggest(gomock.Any(), "lin").Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/search/suggest"+tt.query, nil)
+			req = req.WithContext(context.WithValue(req.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar()))
+
+			handler.Suggest(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response deliveryModel.APIResponse
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				require.NoError(t, err)
+
+				respBodyBytes, err := json.Marshal(response.Body)
+				require.NoError(t, err)
+
+				var result deliveryModel.SuggestResult
+				err = json.Unmarshal(respBodyBytes, &result)
+				require.NoError(t, err)
+
+				require.Len(t, result.Suggestions, tt.expectedSuggestions)
+				assert.Equal(t, "artists", result.Suggestions[0].Type)
+			}
+		})
+	}
+}
+
+func TestClick(t *testing.T) {
+	mockUsecase, handler := setupTestHandler(t)
+
+	tests := []struct {
+		name           string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+	}{
+		{
+			name: "Success",
+			body: `{"query": "linkin park", "type": "artists", "id": 1}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().LogClick(gomock.Any(), &usecaseModel.SearchClick{
+					Query: "linkin park",
+					Type:  usecaseModel.SearchTypeArtists,
+					ID:    1,
+				}).Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid json",
+			body:           `{"query": `,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Invalid type",
+			body:           `{"query": "linkin park", "type": "songs", "id": 1}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Missing query",
+			body:           `{"type": "artists", "id": 1}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "Usecase error",
+			body: `{"query": "linkin park", "type": "artists", "id": 1}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().LogClick(gomock.Any(), gomock.Any()).Return(errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodPost, "/search/click", strings.NewReader(tt.body))
+			req = req.WithContext(context.WithValue(req.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar()))
+
+			handler.Click(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
+
+func TestGetAnalytics(t *testing.T) {
+	mockUsecase, handler := setupTestHandler(t)
+
+	tests := []struct {
+		name           string
+		query          string
+		isAdmin        bool
+		mockBehavior   func()
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			query:   "",
+			isAdmin: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetAnalytics(gomock.Any(), int64(20)).Return(&usecaseModel.SearchAnalytics{
+					TotalSearches:    10,
+					TotalClicks:      5,
+					ClickThroughRate: 0.5,
+					TopQueries:       []*usecaseModel.SearchQueryStat{{Query: "numb", Count: 10, Clicks: 5}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:    "Limit is capped",
+			query:   "?limit=1000",
+			isAdmin: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetAnalytics(gomock.Any(), int64(100)).Return(&usecaseModel.SearchAnalytics{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid limit",
+			query:          "?limit=-1",
+			isAdmin:        true,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Not admin",
+			query:          "",
+			isAdmin:        false,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:    "Usecase error",
+			query:   "",
+			isAdmin: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetAnalytics(gomock.Any(), int64(20)).Return(nil, errors.New("usecase error"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			req, _ := http.NewRequest(http.MethodGet, "/search/analytics"+tt.query, nil)
+			ctx := context.WithValue(req.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+			if tt.isAdmin {
+				ctx = context.WithValue(ctx, ctxExtractor.AdminContextKey{}, int64(1))
+			}
+			req = req.WithContext(ctx)
+
+			handler.GetAnalytics(rec, req)
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+		})
+	}
+}
@@ -0,0 +1,130 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mocks/mock_repository.go
+//
+
+// Package mock_search is a generated GoMock package.
+package mock_search
+
+import (
+	context "context"
+	reflect "reflect"
+
+	repository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CacheSuggestions mocks base method.
+func (m *MockRepository) CacheSuggestions(ctx context.Context, query string, result *repository.SuggestResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CacheSuggestions", ctx, query, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CacheSuggestions indicates an expected call of CacheSuggestions.
+func (mr *MockRepositoryMockRecorder) CacheSuggestions(ctx, query, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CacheSuggestions", reflect.TypeOf((*MockRepository)(nil).CacheSuggestions), ctx, query, result)
+}
+
+// CountSuggestQuery mocks base method.
+func (m *MockRepository) CountSuggestQuery(ctx context.Context, query string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountSuggestQuery", ctx, query)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountSuggestQuery indicates an expected call of CountSuggestQuery.
+func (mr *MockRepositoryMockRecorder) CountSuggestQuery(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountSuggestQuery", reflect.TypeOf((*MockRepository)(nil).CountSuggestQuery), ctx, query)
+}
+
+// GetAnalytics mocks base method.
+func (m *MockRepository) GetAnalytics(ctx context.Context, limit int64) (*repository.SearchAnalytics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalytics", ctx, limit)
+	ret0, _ := ret[0].(*repository.SearchAnalytics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalytics indicates an expected call of GetAnalytics.
+func (mr *MockRepositoryMockRecorder) GetAnalytics(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalytics", reflect.TypeOf((*MockRepository)(nil).GetAnalytics), ctx, limit)
+}
+
+// GetCachedSuggestions mocks base method.
+func (m *MockRepository) GetCachedSuggestions(ctx context.Context, query string) (*repository.SuggestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCachedSuggestions", ctx, query)
+	ret0, _ := ret[0].(*repository.SuggestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCachedSuggestions indicates an expected call of GetCachedSuggestions.
+func (mr *MockRepositoryMockRecorder) GetCachedSuggestions(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCachedSuggestions", reflect.TypeOf((*MockRepository)(nil).GetCachedSuggestions), ctx, query)
+}
+
+// LogClick mocks base method.
+func (m *MockRepository) LogClick(ctx context.Context, query, resultType string, resultID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogClick", ctx, query, resultType, resultID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogClick indicates an expected call of LogClick.
+func (mr *MockRepositoryMockRecorder) LogClick(ctx, query, resultType, resultID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogClick", reflect.TypeOf((*MockRepository)(nil).LogClick), ctx, query, resultType, resultID)
+}
+
+// LogQuery mocks base method.
+func (m *MockRepository) LogQuery(ctx context.Context, query string, resultsCount int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogQuery", ctx, query, resultsCount)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogQuery indicates an expected call of LogQuery.
+func (mr *MockRepositoryMockRecorder) LogQuery(ctx, query, resultsCount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogQuery", reflect.TypeOf((*MockRepository)(nil).LogQuery), ctx, query, resultsCount)
+}
@@ -0,0 +1,113 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=usecase.go -destination=mocks/mock_usecase.go
+//
+
+// Package mock_search is a generated GoMock package.
+package mock_search
+
+import (
+	context "context"
+	reflect "reflect"
+
+	usecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// GetAnalytics mocks base method.
+func (m *MockUsecase) GetAnalytics(ctx context.Context, limit int64) (*usecase.SearchAnalytics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAnalytics", ctx, limit)
+	ret0, _ := ret[0].(*usecase.SearchAnalytics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAnalytics indicates an expected call of GetAnalytics.
+func (mr *MockUsecaseMockRecorder) GetAnalytics(ctx, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnalytics", reflect.TypeOf((*MockUsecase)(nil).GetAnalytics), ctx, limit)
+}
+
+// LogClick mocks base method.
+func (m *MockUsecase) LogClick(ctx context.Context, click *usecase.SearchClick) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogClick", ctx, click)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LogClick indicates an expected call of LogClick.
+func (mr *MockUsecaseMockRecorder) LogClick(ctx, click any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogClick", reflect.TypeOf((*MockUsecase)(nil).LogClick), ctx, click)
+}
+
+// LogQuery mocks base method.
+func (m *MockUsecase) LogQuery(ctx context.Context, log *usecase.SearchQueryLog) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "LogQuery", ctx, log)
+}
+
+// LogQuery indicates an expected call of LogQuery.
+func (mr *MockUsecaseMockRecorder) LogQuery(ctx, log any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogQuery", reflect.TypeOf((*MockUsecase)(nil).LogQuery), ctx, log)
+}
+
+// Search mocks base method.
+func (m *MockUsecase) Search(ctx context.Context, request *usecase.SearchRequest) (*usecase.SearchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Search", ctx, request)
+	ret0, _ := ret[0].(*usecase.SearchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Search indicates an expected call of Search.
+func (mr *MockUsecaseMockRecorder) Search(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Search", reflect.TypeOf((*MockUsecase)(nil).Search), ctx, request)
+}
+
+// Suggest mocks base method.
+func (m *MockUsecase) Suggest(ctx context.Context, query string) (*usecase.SuggestResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suggest", ctx, query)
+	ret0, _ := ret[0].(*usecase.SuggestResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Suggest indicates an expected call of Suggest.
+func (mr *MockUsecaseMockRecorder) Suggest(ctx, query any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suggest", reflect.TypeOf((*MockUsecase)(nil).Suggest), ctx, query)
+}
@@ -0,0 +1,16 @@
+package search
+
+import (
+	"context"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+)
+
+type Repository interface {
+	GetCachedSuggestions(ctx context.Context, query string) (*repository.SuggestResult, error)
+	CacheSuggestions(ctx context.Context, query string, result *repository.SuggestResult) error
+	LogQuery(ctx context.Context, query string, resultsCount int) (int64, error)
+	CountSuggestQuery(ctx context.Context, query string) (int64, error)
+	LogClick(ctx context.Context, query string, resultType string, resultID int64) error
+	GetAnalytics(ctx context.Context, limit int64) (*repository.SearchAnalytics, error)
+}
@@ -0,0 +1,282 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+)
+
+const (
+	suggestCachePrefix = "search:suggest:"
+	suggestCacheTTL    = 300
+
+	queriesKey          = "search:queries"
+	noResultsQueriesKey = "search:queries:noresults"
+	clickedQueriesKey   = "search:queries:clicks"
+	totalSearchesKey    = "search:total"
+	totalClicksKey      = "search:clicks:total"
+
+	// opened results are counted per query under this prefix as "<type>:<id>" members
+	queryResultsPrefix = "search:query:results:"
+	queryTopResults    = 3
+
+	// typed prefixes are kept apart from the reports above, only the suggestion cache reads them
+	suggestQueriesKey = "search:prefixes"
+)
+
+type searchRedisRepository struct {
+	redisPool *redis.Pool
+}
+
+func (r *searchRedisRepository) getConn() (redis.Conn, error) {
+	conn := r.redisPool.Get()
+	if err := conn.Err(); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func NewSearchRedisRepository(redisPool *redis.Pool) search.Repository {
+	return &searchRedisRepository{redisPool: redisPool}
+}
+
+// GetCachedSuggestions returns nil without an error on a cache miss
+func (r *searchRedisRepository) GetCachedSuggestions(ctx context.Context, query string) (*repository.SuggestResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	data, err := redis.Bytes(redis.DoContext(conn, ctx, "GET", suggestCachePrefix+query))
+	if err == redis.ErrNil {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var result repository.SuggestResult
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (r *searchRedisRepository) CacheSuggestions(ctx context.Context, query string, result *repository.SuggestResult) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "SETEX", suggestCachePrefix+query, suggestCacheTTL, data)
+	return err
+}
+
+// LogQuery counts the query and, if it found nothing, counts it as a no-result query too.
+// Returns how many times the query has been seen so far.
+func (r *searchRedisRepository) LogQuery(ctx context.Context, query string, resultsCount int) (int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	count, err := redis.Int64(redis.DoContext(conn, ctx, "ZINCRBY", queriesKey, 1, query))
+	if err != nil {
+		return 0, err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "INCR", totalSearchesKey)
+	if err != nil {
+		return 0, err
+	}
+
+	if resultsCount == 0 {
+		_, err = redis.DoContext(conn, ctx, "ZINCRBY", noResultsQueriesKey, 1, query)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+// CountSuggestQuery counts a prefix typed into the search box and returns how many times it has been seen
+func (r *searchRedisRepository) CountSuggestQuery(ctx context.Context, query string) (int64, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	return redis.Int64(redis.DoContext(conn, ctx, "ZINCRBY", suggestQueriesKey, 1, query))
+}
+
+func (r *searchRedisRepository) LogClick(ctx context.Context, query string, resultType string, resultID int64) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	_, err = redis.DoContext(conn, ctx, "ZINCRBY", clickedQueriesKey, 1, query)
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "ZINCRBY", queryResultsPrefix+query, 1, resultType+":"+strconv.FormatInt(resultID, 10))
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "INCR", totalClicksKey)
+	return err
+}
+
+func (r *searchRedisRepository) GetAnalytics(ctx context.Context, limit int64) (*repository.SearchAnalytics, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			logger.Error("Error closing connection:", zap.Error(err))
+		}
+	}()
+
+	totalSearches, err := getCounter(ctx, conn, totalSearchesKey)
+	if err != nil {
+		return nil, err
+	}
+
+	totalClicks, err := getCounter(ctx, conn, totalClicksKey)
+	if err != nil {
+		return nil, err
+	}
+
+	topQueries, err := getTopQueries(ctx, conn, queriesKey, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	zeroResultQueries, err := getTopQueries(ctx, conn, noResultsQueriesKey, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, stat := range topQueries {
+		clicks, err := redis.Int64(redis.DoContext(conn, ctx, "ZSCORE", clickedQueriesKey, stat.Query))
+		if err != nil && err != redis.ErrNil {
+			return nil, err
+		}
+		stat.Clicks = clicks
+		if clicks == 0 {
+			continue
+		}
+
+		stat.TopResults, err = getTopResults(ctx, conn, stat.Query)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &repository.SearchAnalytics{
+		TotalSearches:     totalSearches,
+		TotalClicks:       totalClicks,
+		TopQueries:        topQueries,
+		ZeroResultQueries: zeroResultQueries,
+	}, nil
+}
+
+func getCounter(ctx context.Context, conn redis.Conn, key string) (int64, error) {
+	value, err := redis.Int64(redis.DoContext(conn, ctx, "GET", key))
+	if err == redis.ErrNil {
+		return 0, nil
+	}
+	return value, err
+}
+
+func getTopResults(ctx context.Context, conn redis.Conn, query string) ([]*repository.SearchResultStat, error) {
+	values, err := redis.Strings(redis.DoContext(conn, ctx, "ZREVRANGE", queryResultsPrefix+query, 0, queryTopResults-1, "WITHSCORES"))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*repository.SearchResultStat, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		resultType, rawID, found := strings.Cut(values[i], ":")
+		if !found {
+			continue
+		}
+		id, err := strconv.ParseInt(rawID, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		clicks, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, &repository.SearchResultStat{Type: resultType, ID: id, Clicks: clicks})
+	}
+
+	return stats, nil
+}
+
+func getTopQueries(ctx context.Context, conn redis.Conn, key string, limit int64) ([]*repository.SearchQueryStat, error) {
+	values, err := redis.Strings(redis.DoContext(conn, ctx, "ZREVRANGE", key, 0, limit-1, "WITHSCORES"))
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*repository.SearchQueryStat, 0, len(values)/2)
+	for i := 0; i+1 < len(values); i += 2 {
+		count, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		stats = append(stats, &repository.SearchQueryStat{Query: values[i], Count: count})
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,213 @@
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/gomodule/redigo/redis"
+	"github.com/rafaeljusto/redigomock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func setupMockRedis() (*searchRedisRepository, *redigomock.Conn) {
+	conn := redigomock.NewConn()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return conn, nil
+		},
+	}
+	repo := &searchRedisRepository{redisPool: pool}
+	return repo, conn
+}
+
+func setupTestContext() context.Context {
+	logger := zap.NewNop().Sugar()
+	return loggerPkg.LoggerToContext(context.Background(), logger)
+}
+
+func TestGetCachedSuggestions(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	cached := &repository.SuggestResult{
+		Query:       "numb",
+		Suggestions: []*repository.Suggestion{{Type: "tracks", ID: 3, Title: "Numb"}},
+	}
+	data, err := json.Marshal(cached)
+	require.NoError(t, err)
+
+	mockConn.Command("GET", "search:suggest:numb").Expect(data)
+
+	result, err := repo.GetCachedSuggestions(ctx, "numb")
+
+	require.NoError(t, err)
+	assert.Equal(t, cached, result)
+}
+
+func TestGetCachedSuggestions_Miss(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "search:suggest:numb").Expect(nil)
+
+	result, err := repo.GetCachedSuggestions(ctx, "numb")
+
+	require.NoError(t, err)
+	assert.Nil(t, result)
+}
+
+func TestGetCachedSuggestions_Error(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "search:suggest:numb").ExpectError(errors.New("redis error"))
+
+	result, err := repo.GetCachedSuggestions(ctx, "numb")
+
+	assert.Error(t, err)
+	assert.Nil(t, result)
+}
+
+func TestCacheSuggestions(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	result := &repository.SuggestResult{Query: "numb", Suggestions: []*repository.Suggestion{}}
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+
+	cmd := mockConn.Command("SETEX", "search:suggest:numb", suggestCacheTTL, data).Expect("OK")
+
+	err = repo.CacheSuggestions(ctx, "numb", result)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(cmd))
+}
+
+func TestLogQuery(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZINCRBY", "search:queries", 1, "numb").Expect([]byte("4"))
+	mockConn.Command("INCR", "search:total").Expect(int64(10))
+
+	count, err := repo.LogQuery(ctx, "numb", 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), count)
+}
+
+func TestLogQuery_NoResults(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZINCRBY", "search:queries", 1, "qwerty").Expect([]byte("1"))
+	mockConn.Command("INCR", "search:total").Expect(int64(10))
+	cmd := mockConn.Command("ZINCRBY", "search:queries:noresults", 1, "qwerty").Expect([]byte("1"))
+
+	count, err := repo.LogQuery(ctx, "qwerty", 0)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), count)
+	assert.Equal(t, 1, mockConn.Stats(cmd))
+}
+
+func TestLogQuery_Error(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZINCRBY", "search:queries", 1, "numb").ExpectError(errors.New("redis error"))
+
+	count, err := repo.LogQuery(ctx, "numb", 1)
+
+	assert.Error(t, err)
+	assert.Equal(t, int64(0), count)
+}
+
+func TestCountSuggestQuery(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	cmd := mockConn.Command("ZINCRBY", "search:prefixes", 1, "lin").Expect([]byte("3"))
+
+	count, err := repo.CountSuggestQuery(ctx, "lin")
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+	assert.Equal(t, 1, mockConn.Stats(cmd))
+}
+
+func TestLogClick(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	zincr := mockConn.Command("ZINCRBY", "search:queries:clicks", 1, "numb").Expect([]byte("1"))
+	result := mockConn.Command("ZINCRBY", "search:query:results:numb", 1, "tracks:3").Expect([]byte("1"))
+	incr := mockConn.Command("INCR", "search:clicks:total").Expect(int64(1))
+
+	err := repo.LogClick(ctx, "numb", "tracks", 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(zincr))
+	assert.Equal(t, 1, mockConn.Stats(result))
+	assert.Equal(t, 1, mockConn.Stats(incr))
+}
+
+func TestLogClick_Error(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZINCRBY", "search:queries:clicks", 1, "numb").ExpectError(errors.New("redis error"))
+
+	err := repo.LogClick(ctx, "numb", "tracks", 3)
+
+	assert.Error(t, err)
+}
+
+func TestGetAnalytics(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "search:total").Expect([]byte("100"))
+	mockConn.Command("GET", "search:clicks:total").Expect(nil)
+	mockConn.Command("ZREVRANGE", "search:queries", 0, int64(1), "WITHSCORES").
+		Expect([]interface{}{[]byte("numb"), []byte("20"), []byte("in the end"), []byte("7")})
+	mockConn.Command("ZREVRANGE", "search:queries:noresults", 0, int64(1), "WITHSCORES").
+		Expect([]interface{}{[]byte("qwerty"), []byte("3")})
+	mockConn.Command("ZSCORE", "search:queries:clicks", "numb").Expect([]byte("5"))
+	mockConn.Command("ZSCORE", "search:queries:clicks", "in the end").Expect(nil)
+	mockConn.Command("ZREVRANGE", "search:query:results:numb", 0, 2, "WITHSCORES").
+		Expect([]interface{}{[]byte("tracks:3"), []byte("4"), []byte("artists:1"), []byte("1")})
+
+	analytics, err := repo.GetAnalytics(ctx, 2)
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), analytics.TotalSearches)
+	assert.Equal(t, int64(0), analytics.TotalClicks)
+	assert.Equal(t, []*repository.SearchQueryStat{
+		{Query: "numb", Count: 20, Clicks: 5, TopResults: []*repository.SearchResultStat{
+			{Type: "tracks", ID: 3, Clicks: 4},
+			{Type: "artists", ID: 1, Clicks: 1},
+		}},
+		{Query: "in the end", Count: 7, Clicks: 0},
+	}, analytics.TopQueries)
+	assert.Equal(t, []*repository.SearchQueryStat{{Query: "qwerty", Count: 3}}, analytics.ZeroResultQueries)
+}
+
+func TestGetAnalytics_Error(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("GET", "search:total").ExpectError(errors.New("redis error"))
+
+	analytics, err := repo.GetAnalytics(ctx, 2)
+
+	assert.Error(t, err)
+	assert.Nil(t, analytics)
+}
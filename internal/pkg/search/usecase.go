@@ -0,0 +1,15 @@
+package search
+
+import (
+	"context"
+
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+)
+
+type Usecase interface {
+	Search(ctx context.Context, request *usecaseModel.SearchRequest) (*usecaseModel.SearchResult, error)
+	Suggest(ctx context.Context, query string) (*usecaseModel.SuggestResult, error)
+	LogQuery(ctx context.Context, log *usecaseModel.SearchQueryLog)
+	LogClick(ctx context.Context, click *usecaseModel.SearchClick) error
+	GetAnalytics(ctx context.Context, limit int64) (*usecaseModel.SearchAnalytics, error)
+}
@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/album"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/artist"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/keyboardLayout"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/playlist"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track"
+	"go.uber.org/zap"
+)
+
+const (
+	suggestLimit   = 5
+	suggestTimeout = 300 * time.Millisecond
+	// queries seen at least this many times get their suggestions cached
+	suggestCacheThreshold = 3
+	// logged queries are cut to this many runes so a single request cannot bloat the aggregates
+	maxLoggedQueryLength = 100
+)
+
+var (
+	emailPattern  = regexp.MustCompile(`\S+@\S+`)
+	numberPattern = regexp.MustCompile(`\+?\d{5,}`)
+)
+
+func NewUsecase(searchRepository search.Repository, metrics *metrics.Metrics, trackUsecase track.Usecase, albumUsecase album.Usecase, artistUsecase artist.Usecase, playlistUsecase playlist.Usecase) search.Usecase {
+	return &searchUsecase{searchRepository: searchRepository, metrics: metrics, trackUsecase: trackUsecase, albumUsecase: albumUsecase, artistUsecase: artistUsecase, playlistUsecase: playlistUsecase}
+}
+
+type searchUsecase struct {
+	searchRepository search.Repository
+	metrics          *metrics.Metrics
+	trackUsecase     track.Usecase
+	albumUsecase     album.Usecase
+	artistUsecase    artist.Usecase
+	playlistUsecase  playlist.Usecase
+}
+
+func (u *searchUsecase) Search(ctx context.Context, request *usecaseModel.SearchRequest) (*usecaseModel.SearchResult, error) {
+	result, err := u.searchAll(ctx, request.Query, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isEmpty(result) {
+		return result, nil
+	}
+
+	switchedQuery := keyboardLayout.Switch(request.Query)
+	if switchedQuery == request.Query {
+		return result, nil
+	}
+
+	switchedResult, err := u.searchAll(ctx, switchedQuery, request)
+	if err != nil {
+		return nil, err
+	}
+
+	if isEmpty(switchedResult) {
+		return result, nil
+	}
+
+	switchedResult.LayoutCorrected = true
+	return switchedResult, nil
+}
+
+func (u *searchUsecase) searchAll(ctx context.Context, query string, request *usecaseModel.SearchRequest) (*usecaseModel.SearchResult, error) {
+	result := &usecaseModel.SearchResult{
+		Query:     query,
+		Tracks:    []*usecaseModel.Track{},
+		Albums:    []*usecaseModel.Album{},
+		Artists:   []*usecaseModel.Artist{},
+		Playlists: []*usecaseModel.Playlist{},
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+
+	setErr := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	for _, searchType := range request.Types {
+		wg.Add(1)
+		go func(searchType usecaseModel.SearchType) {
+			defer wg.Done()
+
+			switch searchType {
+			case usecaseModel.SearchTypeTracks:
+				tracks, err := u.trackUsecase.SearchTracks(ctx, query, &usecaseModel.TrackFilters{Pagination: request.Pagination})
+				if err != nil {
+					setErr(err)
+					return
+				}
+				result.Tracks = tracks
+			case usecaseModel.SearchTypeAlbums:
+				albums, err := u.albumUsecase.SearchAlbums(ctx, query, &usecaseModel.AlbumFilters{Pagination: request.Pagination})
+				if err != nil {
+					setErr(err)
+					return
+				}
+				result.Albums = albums
+			case usecaseModel.SearchTypeArtists:
+				artists, err := u.artistUsecase.SearchArtists(ctx, query, &usecaseModel.ArtistFilters{Pagination: request.Pagination})
+				if err != nil {
+					setErr(err)
+					return
+				}
+				result.Artists = artists
+			case usecaseModel.SearchTypePlaylists:
+				playlists, err := u.playlistUsecase.SearchPlaylists(ctx, query, &usecaseModel.PlaylistFilters{Pagination: request.Pagination})
+				if err != nil {
+					setErr(err)
+					return
+				}
+				result.Playlists = playlists
+			}
+		}(searchType)
+	}
+
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	return result, nil
+}
+
+func isEmpty(result *usecaseModel.SearchResult) bool {
+	return len(result.Tracks) == 0 && len(result.Albums) == 0 && len(result.Artists) == 0 && len(result.Playlists) == 0
+}
+
+func (u *searchUsecase) Suggest(ctx context.Context, query string) (*usecaseModel.SuggestResult, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	query = normalizeQuery(query)
+
+	cached, err := u.searchRepository.GetCachedSuggestions(ctx, query)
+	if err != nil {
+		logger.Warn("failed to get cached suggestions", zap.Error(err))
+	}
+	if cached != nil {
+		result := model.SuggestResultFromRepositoryToUsecase(cached)
+		u.logQuery(ctx, usecaseModel.SearchSourceSuggest, query, len(result.Suggestions))
+		return result, nil
+	}
+
+	suggestCtx, cancel := context.WithTimeout(ctx, suggestTimeout)
+	defer cancel()
+
+	result, complete := u.suggestAll(suggestCtx, query)
+	if len(result.Suggestions) == 0 {
+		switchedQuery := keyboardLayout.Switch(query)
+		if switchedQuery != query {
+			switchedResult, switchedComplete := u.suggestAll(suggestCtx, switchedQuery)
+			complete = complete && switchedComplete
+			if len(switchedResult.Suggestions) > 0 {
+				switchedResult.LayoutCorrected = true
+				result = switchedResult
+			}
+		}
+	}
+
+	// a result missing a type would be served from the cache long after the failure is gone
+	complete = complete && suggestCtx.Err() == nil
+	if u.logQuery(ctx, usecaseModel.SearchSourceSuggest, query, len(result.Suggestions)) >= suggestCacheThreshold && complete {
+		if err := u.searchRepository.CacheSuggestions(ctx, query, model.SuggestResultFromUsecaseToRepository(result)); err != nil {
+			logger.Warn("failed to cache suggestions", zap.Error(err))
+		}
+	}
+
+	return result, nil
+}
+
+// suggestAll queries artists, albums and tracks in parallel. Suggestions are best effort:
+// a type that fails or does not answer within the latency budget is left out and the
+// result is reported as incomplete.
+func (u *searchUsecase) suggestAll(ctx context.Context, query string) (*usecaseModel.SuggestResult, bool) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	pagination := &usecaseModel.Pagination{Offset: 0, Limit: suggestLimit}
+
+	var (
+		wg      sync.WaitGroup
+		artists []*usecaseModel.Suggestion
+		albums  []*usecaseModel.Suggestion
+		tracks  []*usecaseModel.Suggestion
+
+		artistsErr, albumsErr, tracksErr error
+	)
+
+	wg.Add(3)
+	go func() {
+		defer wg.Done()
+		found, err := u.artistUsecase.SearchArtists(ctx, query, &usecaseModel.ArtistFilters{Pagination: pagination})
+		if err != nil {
+			logger.Warn("failed to suggest artists", zap.Error(err))
+			artistsErr = err
+			return
+		}
+		for _, artist := range found {
+			artists = append(artists, &usecaseModel.Suggestion{Type: usecaseModel.SearchTypeArtists, ID: artist.ID, Title: artist.Title, Thumbnail: artist.Thumbnail, ThumbnailColor: artist.ThumbnailColor})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		found, err := u.albumUsecase.SearchAlbums(ctx, query, &usecaseModel.AlbumFilters{Pagination: pagination})
+		if err != nil {
+			logger.Warn("failed to suggest albums", zap.Error(err))
+			albumsErr = err
+			return
+		}
+		for _, album := range found {
+			albums = append(albums, &usecaseModel.Suggestion{Type: usecaseModel.SearchTypeAlbums, ID: album.ID, Title: album.Title, Thumbnail: album.Thumbnail, ThumbnailColor: album.ThumbnailColor})
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		found, err := u.trackUsecase.SearchTracks(ctx, query, &usecaseModel.TrackFilters{Pagination: pagination})
+		if err != nil {
+			logger.Warn("failed to suggest tracks", zap.Error(err))
+			tracksErr = err
+			return
+		}
+		for _, track := range found {
+			tracks = append(tracks, &usecaseModel.Suggestion{Type: usecaseModel.SearchTypeTracks, ID: track.ID, Title: track.Title, Thumbnail: track.Thumbnail, ThumbnailColor: track.ThumbnailColor})
+		}
+	}()
+	wg.Wait()
+
+	suggestions := make([]*usecaseModel.Suggestion, 0, len(artists)+len(albums)+len(tracks))
+	suggestions = append(suggestions, artists...)
+	suggestions = append(suggestions, albums...)
+	suggestions = append(suggestions, tracks...)
+
+	return &usecaseModel.SuggestResult{
+		Query:       query,
+		Suggestions: suggestions,
+	}, artistsErr == nil && albumsErr == nil && tracksErr == nil
+}
+
+func (u *searchUsecase) LogQuery(ctx context.Context, log *usecaseModel.SearchQueryLog) {
+	u.logQuery(ctx, log.Source, log.Query, log.ResultsCount)
+}
+
+func (u *searchUsecase) LogClick(ctx context.Context, click *usecaseModel.SearchClick) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	query := anonymizeQuery(normalizeQuery(click.Query))
+
+	logger.Info("search result clicked", zap.String("query", query), zap.String("type", string(click.Type)), zap.Int64("id", click.ID))
+	u.metrics.SearchClicksTotal.WithLabelValues(string(click.Type)).Inc()
+
+	return u.searchRepository.LogClick(ctx, query, string(click.Type), click.ID)
+}
+
+func (u *searchUsecase) GetAnalytics(ctx context.Context, limit int64) (*usecaseModel.SearchAnalytics, error) {
+	analytics, err := u.searchRepository.GetAnalytics(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+	return model.SearchAnalyticsFromRepositoryToUsecase(analytics), nil
+}
+
+// logQuery records an anonymized query and returns how many times it has been seen, 0 if unknown
+func (u *searchUsecase) logQuery(ctx context.Context, source usecaseModel.SearchSource, query string, resultsCount int) int64 {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	query = anonymizeQuery(normalizeQuery(query))
+
+	outcome := "found"
+	if resultsCount == 0 {
+		outcome = "zero"
+	}
+	logger.Info("search query", zap.String("source", string(source)), zap.String("query", query), zap.Int("results", resultsCount))
+	u.metrics.SearchQueriesTotal.WithLabelValues(string(source), outcome).Inc()
+
+	// suggest fires on every keystroke, its prefixes would crowd out the submitted queries in the
+	// reports, so they are only counted for the suggestion cache
+	var count int64
+	var err error
+	if source == usecaseModel.SearchSourceSuggest {
+		count, err = u.searchRepository.CountSuggestQuery(ctx, query)
+	} else {
+		count, err = u.searchRepository.LogQuery(ctx, query, resultsCount)
+	}
+	if err != nil {
+		logger.Warn("failed to log search query", zap.Error(err))
+	}
+	return count
+}
+
+func normalizeQuery(query string) string {
+	return strings.Join(strings.Fields(strings.ToLower(query)), " ")
+}
+
+// anonymizeQuery masks anything that looks like an email or a phone/card number
+func anonymizeQuery(query string) string {
+	query = emailPattern.ReplaceAllString(query, "<email>")
+	query = numberPattern.ReplaceAllString(query, "<number>")
+	if runes := []rune(query); len(runes) > maxLoggedQueryLength {
+		query = string(runes[:maxLoggedQueryLength])
+	}
+	return query
+}
@@ -0,0 +1,365 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	mock_album "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/album/mocks"
+	mock_artist "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/artist/mocks"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/metrics"
+	repositoryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_playlist "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/playlist/mocks"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search"
+	mock_search "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/mocks"
+	mock_track "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track/mocks"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+type testMocks struct {
+	repository *mock_search.MockRepository
+	track      *mock_track.MockUsecase
+	album      *mock_album.MockUsecase
+	artist     *mock_artist.MockUsecase
+	playlist   *mock_playlist.MockUsecase
+}
+
+func setupTest(t *testing.T) (search.Usecase, *testMocks) {
+	ctrl := gomock.NewController(t)
+	m := &testMocks{
+		repository: mock_search.NewMockRepository(ctrl),
+		track:      mock_track.NewMockUsecase(ctrl),
+		album:      mock_album.NewMockUsecase(ctrl),
+		artist:     mock_artist.NewMockUsecase(ctrl),
+		playlist:   mock_playlist.NewMockUsecase(ctrl),
+	}
+	return NewUsecase(m.repository, metrics.NewMetrics(prometheus.NewRegistry(), "test"), m.track, m.album, m.artist, m.playlist), m
+}
+
+func allTypesRequest(query string) *usecaseModel.SearchRequest {
+	return &usecaseModel.SearchRequest{
+		Query: query,
+		Types: []usecaseModel.SearchType{
+			usecaseModel.SearchTypeTracks,
+			usecaseModel.SearchTypeAlbums,
+			usecaseModel.SearchTypeArtists,
+			usecaseModel.SearchTypePlaylists,
+		},
+		Pagination: &usecaseModel.Pagination{Offset: 0, Limit: 10},
+	}
+}
+
+func TestSearch(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := context.Background()
+	pagination := &usecaseModel.Pagination{Offset: 0, Limit: 10}
+
+	m.track.EXPECT().SearchTracks(ctx, "rock", &usecaseModel.TrackFilters{Pagination: pagination}).
+		Return([]*usecaseModel.Track{{ID: 1, Title: "Rock Track"}}, nil)
+	m.album.EXPECT().SearchAlbums(ctx, "rock", &usecaseModel.AlbumFilters{Pagination: pagination}).
+		Return([]*usecaseModel.Album{{ID: 2, Title: "Rock Album"}}, nil)
+	m.artist.EXPECT().SearchArtists(ctx, "rock", &usecaseModel.ArtistFilters{Pagination: pagination}).
+		Return([]*usecaseModel.Artist{{ID: 3, Title: "Rock Artist"}}, nil)
+	m.playlist.EXPECT().SearchPlaylists(ctx, "rock", &usecaseModel.PlaylistFilters{Pagination: pagination}).
+		Return([]*usecaseModel.Playlist{{ID: 4, Title: "Rock Playlist"}}, nil)
+
+	result, err := u.Search(ctx, allTypesRequest("rock"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "rock", result.Query)
+	assert.False(t, result.LayoutCorrected)
+	require.Len(t, result.Tracks, 1)
+	assert.Equal(t, int64(1), result.Tracks[0].ID)
+	require.Len(t, result.Albums, 1)
+	assert.Equal(t, int64(2), result.Albums[0].ID)
+	require.Len(t, result.Artists, 1)
+	assert.Equal(t, int64(3), result.Artists[0].ID)
+	require.Len(t, result.Playlists, 1)
+	assert.Equal(t, int64(4), result.Playlists[0].ID)
+}
+
+func TestSearchSelectedTypes(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := context.Background()
+
+	m.album.EXPECT().SearchAlbums(ctx, "rock", gomock.Any()).
+		Return([]*usecaseModel.Album{{ID: 2, Title: "Rock Album"}}, nil)
+
+	result, err := u.Search(ctx, &usecaseModel.SearchRequest{
+		Query:      "rock",
+		Types:      []usecaseModel.SearchType{usecaseModel.SearchTypeAlbums},
+		Pagination: &usecaseModel.Pagination{Offset: 10, Limit: 10},
+	})
+
+	require.NoError(t, err)
+	assert.Len(t, result.Albums, 1)
+	assert.Empty(t, result.Tracks)
+	assert.Empty(t, result.Artists)
+	assert.Empty(t, result.Playlists)
+}
+
+func TestSearchLayoutCorrected(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := context.Background()
+
+	m.track.EXPECT().SearchTracks(ctx, "ckjy", gomock.Any()).Return([]*usecaseModel.Track{}, nil)
+	m.album.EXPECT().SearchAlbums(ctx, "ckjy", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.artist.EXPECT().SearchArtists(ctx, "ckjy", gomock.Any()).Return([]*usecaseModel.Artist{}, nil)
+	m.playlist.EXPECT().SearchPlaylists(ctx, "ckjy", gomock.Any()).Return([]*usecaseModel.Playlist{}, nil)
+
+	m.track.EXPECT().SearchTracks(ctx, "слон", gomock.Any()).Return([]*usecaseModel.Track{{ID: 1, Title: "Слон"}}, nil)
+	m.album.EXPECT().SearchAlbums(ctx, "слон", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.artist.EXPECT().SearchArtists(ctx, "слон", gomock.Any()).Return([]*usecaseModel.Artist{}, nil)
+	m.playlist.EXPECT().SearchPlaylists(ctx, "слон", gomock.Any()).Return([]*usecaseModel.Playlist{}, nil)
+
+	result, err := u.Search(ctx, allTypesRequest("ckjy"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "слон", result.Query)
+	assert.True(t, result.LayoutCorrected)
+	require.Len(t, result.Tracks, 1)
+	assert.Equal(t, int64(1), result.Tracks[0].ID)
+}
+
+func TestSearchNothingFound(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := context.Background()
+
+	for _, query := range []string{"ckjy", "слон"} {
+		m.track.EXPECT().SearchTracks(ctx, query, gomock.Any()).Return([]*usecaseModel.Track{}, nil)
+		m.album.EXPECT().SearchAlbums(ctx, query, gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+		m.artist.EXPECT().SearchArtists(ctx, query, gomock.Any()).Return([]*usecaseModel.Artist{}, nil)
+		m.playlist.EXPECT().SearchPlaylists(ctx, query, gomock.Any()).Return([]*usecaseModel.Playlist{}, nil)
+	}
+
+	result, err := u.Search(ctx, allTypesRequest("ckjy"))
+
+	require.NoError(t, err)
+	assert.Equal(t, "ckjy", result.Query)
+	assert.False(t, result.LayoutCorrected)
+	assert.NotNil(t, result.Tracks)
+	assert.Empty(t, result.Tracks)
+}
+
+func TestSearchError(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := context.Background()
+	expectedErr := errors.New("track service error")
+
+	m.track.EXPECT().SearchTracks(ctx, "rock", gomock.Any()).Return(nil, expectedErr)
+	m.album.EXPECT().SearchAlbums(ctx, "rock", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.artist.EXPECT().SearchArtists(ctx, "rock", gomock.Any()).Return([]*usecaseModel.Artist{}, nil)
+	m.playlist.EXPECT().SearchPlaylists(ctx, "rock", gomock.Any()).Return([]*usecaseModel.Playlist{}, nil)
+
+	result, err := u.Search(ctx, allTypesRequest("rock"))
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, result)
+}
+
+func setupTestContext() context.Context {
+	return loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+}
+
+func TestSuggest(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetCachedSuggestions(ctx, "linkin park").Return(nil, nil)
+	m.artist.EXPECT().SearchArtists(gomock.Any(), "linkin park", &usecaseModel.ArtistFilters{Pagination: &usecaseModel.Pagination{Offset: 0, Limit: 5}}).
+		Return([]*usecaseModel.Artist{{ID: 1, Title: "Linkin Park"}}, nil)
+	m.album.EXPECT().SearchAlbums(gomock.Any(), "linkin park", gomock.Any()).
+		Return([]*usecaseModel.Album{{ID: 2, Title: "Meteora"}}, nil)
+	m.track.EXPECT().SearchTracks(gomock.Any(), "linkin park", gomock.Any()).
+		Return([]*usecaseModel.Track{{ID: 3, Title: "Numb"}}, nil)
+	m.repository.EXPECT().CountSuggestQuery(ctx, "linkin park").Return(int64(1), nil)
+
+	result, err := u.Suggest(ctx, "  Linkin   PARK ")
+
+	require.NoError(t, err)
+	assert.Equal(t, "linkin park", result.Query)
+	require.Len(t, result.Suggestions, 3)
+	assert.Equal(t, usecaseModel.SearchTypeArtists, result.Suggestions[0].Type)
+	assert.Equal(t, usecaseModel.SearchTypeAlbums, result.Suggestions[1].Type)
+	assert.Equal(t, usecaseModel.SearchTypeTracks, result.Suggestions[2].Type)
+}
+
+func TestSuggestCachesPopularQuery(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetCachedSuggestions(ctx, "numb").Return(nil, nil)
+	m.artist.EXPECT().SearchArtists(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Artist{}, nil)
+	m.album.EXPECT().SearchAlbums(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.track.EXPECT().SearchTracks(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Track{{ID: 3, Title: "Numb"}}, nil)
+	m.repository.EXPECT().CountSuggestQuery(ctx, "numb").Return(int64(3), nil)
+	m.repository.EXPECT().CacheSuggestions(ctx, "numb", &repositoryModel.SuggestResult{
+		Query:       "numb",
+		Suggestions: []*repositoryModel.Suggestion{{Type: "tracks", ID: 3, Title: "Numb"}},
+	}).Return(nil)
+
+	result, err := u.Suggest(ctx, "numb")
+
+	require.NoError(t, err)
+	require.Len(t, result.Suggestions, 1)
+}
+
+func TestSuggestFromCache(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetCachedSuggestions(ctx, "numb").Return(&repositoryModel.SuggestResult{
+		Query:       "numb",
+		Suggestions: []*repositoryModel.Suggestion{{Type: "tracks", ID: 3, Title: "Numb"}},
+	}, nil)
+	m.repository.EXPECT().CountSuggestQuery(ctx, "numb").Return(int64(10), nil)
+
+	result, err := u.Suggest(ctx, "numb")
+
+	require.NoError(t, err)
+	require.Len(t, result.Suggestions, 1)
+	assert.Equal(t, usecaseModel.SearchTypeTracks, result.Suggestions[0].Type)
+}
+
+func TestSuggestPartialFailure(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetCachedSuggestions(ctx, "numb").Return(nil, errors.New("redis down"))
+	m.artist.EXPECT().SearchArtists(gomock.Any(), "numb", gomock.Any()).Return(nil, errors.New("artist service error"))
+	m.album.EXPECT().SearchAlbums(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.track.EXPECT().SearchTracks(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Track{{ID: 3, Title: "Numb"}}, nil)
+	m.repository.EXPECT().CountSuggestQuery(ctx, "numb").Return(int64(0), errors.New("redis down"))
+
+	result, err := u.Suggest(ctx, "numb")
+
+	require.NoError(t, err)
+	require.Len(t, result.Suggestions, 1)
+	assert.Equal(t, int64(3), result.Suggestions[0].ID)
+}
+
+func TestSuggestSkipsCachingPartialResult(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetCachedSuggestions(ctx, "numb").Return(nil, nil)
+	m.artist.EXPECT().SearchArtists(gomock.Any(), "numb", gomock.Any()).Return(nil, errors.New("artist service error"))
+	m.album.EXPECT().SearchAlbums(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.track.EXPECT().SearchTracks(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Track{{ID: 3, Title: "Numb"}}, nil)
+	m.repository.EXPECT().CountSuggestQuery(ctx, "numb").Return(int64(3), nil)
+	m.repository.EXPECT().CacheSuggestions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := u.Suggest(ctx, "numb")
+
+	require.NoError(t, err)
+	require.Len(t, result.Suggestions, 1)
+}
+
+func TestSuggestSkipsCachingAfterTimeout(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetCachedSuggestions(ctx, "numb").Return(nil, nil)
+	m.artist.EXPECT().SearchArtists(gomock.Any(), "numb", gomock.Any()).DoAndReturn(
+		func(ctx context.Context, _ string, _ *usecaseModel.ArtistFilters) ([]*usecaseModel.Artist, error) {
+			<-ctx.Done()
+			return []*usecaseModel.Artist{}, nil
+		})
+	m.album.EXPECT().SearchAlbums(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.track.EXPECT().SearchTracks(gomock.Any(), "numb", gomock.Any()).Return([]*usecaseModel.Track{{ID: 3, Title: "Numb"}}, nil)
+	m.repository.EXPECT().CountSuggestQuery(ctx, "numb").Return(int64(3), nil)
+	m.repository.EXPECT().CacheSuggestions(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+	result, err := u.Suggest(ctx, "numb")
+
+	require.NoError(t, err)
+	require.Len(t, result.Suggestions, 1)
+}
+
+func TestSuggestLayoutCorrected(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetCachedSuggestions(ctx, "ckjy").Return(nil, nil)
+	m.artist.EXPECT().SearchArtists(gomock.Any(), "ckjy", gomock.Any()).Return([]*usecaseModel.Artist{}, nil)
+	m.album.EXPECT().SearchAlbums(gomock.Any(), "ckjy", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.track.EXPECT().SearchTracks(gomock.Any(), "ckjy", gomock.Any()).Return([]*usecaseModel.Track{}, nil)
+	m.artist.EXPECT().SearchArtists(gomock.Any(), "слон", gomock.Any()).Return([]*usecaseModel.Artist{{ID: 1, Title: "Слон"}}, nil)
+	m.album.EXPECT().SearchAlbums(gomock.Any(), "слон", gomock.Any()).Return([]*usecaseModel.Album{}, nil)
+	m.track.EXPECT().SearchTracks(gomock.Any(), "слон", gomock.Any()).Return([]*usecaseModel.Track{}, nil)
+	m.repository.EXPECT().CountSuggestQuery(ctx, "ckjy").Return(int64(1), nil)
+
+	result, err := u.Suggest(ctx, "ckjy")
+
+	require.NoError(t, err)
+	assert.Equal(t, "слон", result.Query)
+	assert.True(t, result.LayoutCorrected)
+	require.Len(t, result.Suggestions, 1)
+}
+
+func TestLogQueryAnonymizes(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().LogQuery(ctx, "call me <number> or <email>", 0).Return(int64(1), nil)
+
+	u.LogQuery(ctx, &usecaseModel.SearchQueryLog{
+		Source:       usecaseModel.SearchSourceTracks,
+		Query:        "Call me  +79161234567 or John.Doe@mail.ru",
+		ResultsCount: 0,
+	})
+}
+
+func TestLogClick(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().LogClick(ctx, "linkin park", "artists", int64(1)).Return(nil)
+
+	err := u.LogClick(ctx, &usecaseModel.SearchClick{Query: " Linkin Park", Type: usecaseModel.SearchTypeArtists, ID: 1})
+
+	require.NoError(t, err)
+}
+
+func TestGetAnalytics(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetAnalytics(ctx, int64(10)).Return(&repositoryModel.SearchAnalytics{
+		TotalSearches: 200,
+		TotalClicks:   50,
+		TopQueries: []*repositoryModel.SearchQueryStat{{Query: "numb", Count: 20, Clicks: 10, TopResults: []*repositoryModel.SearchResultStat{
+			{Type: "tracks", ID: 3, Clicks: 8},
+		}}},
+		ZeroResultQueries: []*repositoryModel.SearchQueryStat{{Query: "qwerty", Count: 5}},
+	}, nil)
+
+	analytics, err := u.GetAnalytics(ctx, 10)
+
+	require.NoError(t, err)
+	assert.Equal(t, 0.25, analytics.ClickThroughRate)
+	require.Len(t, analytics.TopQueries, 1)
+	assert.Equal(t, int64(10), analytics.TopQueries[0].Clicks)
+	assert.Equal(t, 0.5, analytics.TopQueries[0].ClickThroughRate)
+	assert.Equal(t, []*usecaseModel.SearchResultStat{{Type: usecaseModel.SearchTypeTracks, ID: 3, Clicks: 8}}, analytics.TopQueries[0].TopResults)
+	require.Len(t, analytics.ZeroResultQueries, 1)
+	assert.Equal(t, "qwerty", analytics.ZeroResultQueries[0].Query)
+}
+
+func TestGetAnalyticsError(t *testing.T) {
+	u, m := setupTest(t)
+	ctx := setupTestContext()
+
+	m.repository.EXPECT().GetAnalytics(ctx, int64(10)).Return(nil, errors.New("redis down"))
+
+	analytics, err := u.GetAnalytics(ctx, 10)
+
+	assert.Error(t, err)
+	assert.Nil(t, analytics)
+}
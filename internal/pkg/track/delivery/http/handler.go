@@ -12,6 +12,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/searchAnalytics"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
@@ -23,12 +24,13 @@ import (
 const ()
 
 type TrackHandler struct {
-	usecase track.Usecase
-	cfg     *config.Config
+	usecase  track.Usecase
+	recorder searchAnalytics.Recorder
+	cfg      *config.Config
 }
 
-func NewTrackHandler(usecase track.Usecase, cfg *config.Config) *TrackHandler {
-	return &TrackHandler{usecase: usecase, cfg: cfg}
+func NewTrackHandler(usecase track.Usecase, recorder searchAnalytics.Recorder, cfg *config.Config) *TrackHandler {
+	return &TrackHandler{usecase: usecase, recorder: recorder, cfg: cfg}
 }
 
 // GetAllTracks godoc
@@ -105,6 +107,80 @@ func (h *TrackHandler) GetTrackByID(w http.ResponseWriter, r *http.Request) {
 	json.WriteSuccessResponse(w, http.StatusOK, trackDetailed, nil)
 }
 
+// GetTrackWaveform godoc
+// @Summary Get track waveform
+// @Description Retrieves peak amplitudes of a track for drawing its waveform, available once the track is analysed
+// @Tags tracks
+// @Accept json
+// @Produce json
+// @Param id path int true "Track ID"
+// @Success 200 {object} delivery.APIResponse{body=delivery.TrackWaveform} "Track waveform"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Track not found or not analysed yet"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /tracks/{id}/waveform [get]
+func (h *TrackHandler) GetTrackWaveform(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		logger.Error("failed to parse track ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	usecaseWaveform, err := h.usecase.GetTrackWaveform(ctx, id)
+	if err != nil {
+		logger.Error("failed to get track waveform", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	waveform := model.TrackWaveformFromUsecaseToDelivery(usecaseWaveform)
+
+	json.WriteSuccessResponse(w, http.StatusOK, waveform, nil)
+}
+
+// GetTrackLyrics godoc
+// @Summary Get track lyrics
+// @Description Retrieves lyrics of a track in every language they were uploaded in, originals first. Synced lyrics have the time of every line for karaoke-style display.
+// @Tags tracks
+// @Accept json
+// @Produce json
+// @Param id path int true "Track ID"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.TrackLyrics} "Track lyrics, empty when the track has none"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /tracks/{id}/lyrics [get]
+func (h *TrackHandler) GetTrackLyrics(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	vars := mux.Vars(r)
+	idStr := vars["id"]
+	id, err := strconv.ParseInt(idStr, 10, 64)
+	if err != nil {
+		logger.Error("failed to parse track ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	usecaseLyrics, err := h.usecase.GetTrackLyrics(ctx, id)
+	if err != nil {
+		logger.Error("failed to get track lyrics", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	lyrics := model.TrackLyricsListFromUsecaseToDelivery(usecaseLyrics)
+
+	json.WriteSuccessResponse(w, http.StatusOK, lyrics, nil)
+}
+
 // GetTracksByArtistID godoc
 // @Summary Get tracks by artist ID
 // @Description Get a list of tracks by a specific artist with optional pagination filters
@@ -262,6 +338,180 @@ func (h *TrackHandler) UpdateStreamDuration(w http.ResponseWriter, r *http.Reque
 	json.WriteSuccessResponse(w, http.StatusOK, responseMessage, nil)
 }
 
+// GetStreamMasterPlaylist godoc
+// @Summary Get HLS master playlist of a stream
+// @Description Lists the bitrates the track of the stream can be played at, players switch between them by the bandwidth they measure
+// @Tags tracks
+// @Produce application/vnd.apple.mpegurl
+// @Param id path integer true "Stream ID"
+// @Success 200 {string} string "HLS master playlist"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Forbidden"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Stream not found or track is not segmented yet"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /streams/{id}/hls/master.m3u8 [get]
+func (h *TrackHandler) GetStreamMasterPlaylist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	streamID, err := strconv.ParseInt(mux.Vars(r)["id"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse stream ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		logger.Warn("attempt to get stream playlist for unauthorized user")
+		err := customErrors.ErrUnauthorized
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	variants, err := h.usecase.GetStreamManifest(ctx, streamID, userID)
+	if err != nil {
+		logger.Error("failed to get stream manifest", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	writePlaylist(w, masterPlaylist(variants))
+}
+
+// GetStreamMediaPlaylist godoc
+// @Summary Get HLS media playlist of a stream
+// @Description Lists the segments of one bitrate of the track of the stream
+// @Tags tracks
+// @Produce application/vnd.apple.mpegurl
+// @Param id path integer true "Stream ID"
+// @Param bitrate path integer true "Bitrate in kbit/s"
+// @Success 200 {string} string "HLS media playlist"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID or bitrate"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Forbidden"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Stream or bitrate not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /streams/{id}/hls/{bitrate}.m3u8 [get]
+func (h *TrackHandler) GetStreamMediaPlaylist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	vars := mux.Vars(r)
+	streamID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse stream ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	bitrate, err := strconv.ParseInt(vars["bitrate"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse bitrate", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		logger.Warn("attempt to get stream playlist for unauthorized user")
+		err := customErrors.ErrUnauthorized
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	variants, err := h.usecase.GetStreamManifest(ctx, streamID, userID)
+	if err != nil {
+		logger.Error("failed to get stream manifest", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	for _, variant := range variants {
+		if variant.Bitrate == bitrate {
+			writePlaylist(w, mediaPlaylist(variant))
+			return
+		}
+	}
+
+	logger.Warn("stream has no such bitrate", zap.Int64("bitrate", bitrate))
+	err = customErrors.ErrTrackSegmentNotFound
+	json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+}
+
+// GetStreamSegment godoc
+// @Summary Get HLS segment of a stream
+// @Description Counts the segment towards the stream duration and redirects to its file
+// @Tags tracks
+// @Param id path integer true "Stream ID"
+// @Param bitrate path integer true "Bitrate in kbit/s"
+// @Param position path integer true "Segment position"
+// @Success 302 "Redirect to the presigned segment url"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid ID, bitrate or position"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 403 {object} delivery.APIForbiddenErrorResponse "Forbidden"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Stream or segment not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /streams/{id}/hls/{bitrate}/{position}.ts [get]
+func (h *TrackHandler) GetStreamSegment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	vars := mux.Vars(r)
+	streamID, err := strconv.ParseInt(vars["id"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse stream ID", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	bitrate, err := strconv.ParseInt(vars["bitrate"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse bitrate", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	position, err := strconv.ParseInt(vars["position"], 10, 64)
+	if err != nil {
+		logger.Error("failed to parse segment position", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	userID, exists := ctxExtractor.UserFromContext(ctx)
+	if !exists {
+		logger.Warn("attempt to get stream segment for unauthorized user")
+		err := customErrors.ErrUnauthorized
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	segmentURL, err := h.usecase.GetStreamSegmentURL(ctx, &usecaseModel.StreamSegmentRequest{
+		StreamID: streamID,
+		UserID:   userID,
+		Bitrate:  bitrate,
+		Position: position,
+	})
+	if err != nil {
+		logger.Error("failed to get stream segment", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	// presigned urls expire, the player has to come back here for every fetch
+	w.Header().Set("Cache-Control", "no-store")
+	http.Redirect(w, r, segmentURL, http.StatusFound)
+}
+
+func writePlaylist(w http.ResponseWriter, playlist string) {
+	w.Header().Set("Content-Type", hlsPlaylistContentType)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write([]byte(playlist))
+}
+
 // GetLastListenedTracks godoc
 // @Summary Get last listened tracks for a user
 // @Description Retrieves a list of tracks last listened by a specific user with pagination
@@ -491,6 +741,8 @@ func (h *TrackHandler) GetFavoriteTracks(w http.ResponseWriter, r *http.Request)
 // @Accept json
 // @Produce json
 // @Param query path string true "Query"
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
 // @Success 200 {object} delivery.APIResponse{body=[]delivery.Track} "List of tracks"
 // @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid query"
 // @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
@@ -506,13 +758,28 @@ func (h *TrackHandler) SearchTracks(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	usecaseTracks, err := h.usecase.SearchTracks(ctx, query)
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	usecaseTracks, err := h.usecase.SearchTracks(ctx, query, &usecaseModel.TrackFilters{
+		Pagination: model.PaginationFromDeliveryToUsecase(pagination),
+	})
 	if err != nil {
 		logger.Error("failed to search tracks", zap.Error(err))
 		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
 		return
 	}
 
+	h.recorder.LogQuery(ctx, &usecaseModel.SearchQueryLog{
+		Source:       usecaseModel.SearchSourceTracks,
+		Query:        query,
+		ResultsCount: len(usecaseTracks),
+	})
+
 	tracks := model.TracksFromUsecaseToDelivery(usecaseTracks)
 	json.WriteSuccessResponse(w, http.StatusOK, tracks, nil)
 }
@@ -14,6 +14,7 @@ import (
 	customErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_search "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/search/mocks"
 	mock_track "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track/mocks"
 
 	"github.com/gorilla/mux"
@@ -102,7 +103,7 @@ func TestTrackHandler_GetAllTracks(t *testing.T) {
 		},
 	}
 
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -120,6 +121,7 @@ func TestTrackHandler_GetAllTracks(t *testing.T) {
 						ID:       1,
 						Title:    "Test Track",
 						Duration: 180,
+						BPM:      124.5,
 					},
 				}, nil)
 			},
@@ -131,6 +133,7 @@ func TestTrackHandler_GetAllTracks(t *testing.T) {
 						"id":       float64(1),
 						"title":    "Test Track",
 						"duration": float64(180),
+						"bpm":      124.5,
 					},
 				},
 			},
@@ -188,7 +191,7 @@ func TestTrackHandler_GetTrackByID(t *testing.T) {
 
 	mockUsecase := mock_track.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -206,8 +209,10 @@ func TestTrackHandler_GetTrackByID(t *testing.T) {
 						ID:       1,
 						Title:    "Test Track",
 						Duration: 180,
+						BPM:      124.5,
 					},
 					FileUrl: "test.jpg",
+					Key:     "A minor",
 				}, nil)
 			},
 			expectedStatus: http.StatusOK,
@@ -217,6 +222,8 @@ func TestTrackHandler_GetTrackByID(t *testing.T) {
 					"id":       float64(1),
 					"title":    "Test Track",
 					"duration": float64(180),
+					"bpm":      124.5,
+					"key":      "A minor",
 				},
 			},
 		},
@@ -270,6 +277,177 @@ func TestTrackHandler_GetTrackByID(t *testing.T) {
 	}
 }
 
+func TestTrackHandler_GetTrackLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	cfg := &config.Config{}
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
+
+	tests := []struct {
+		name           string
+		trackID        string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:    "OK",
+			trackID: "1",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetTrackLyrics(gomock.Any(), int64(1)).Return([]*usecaseModel.TrackLyrics{
+					{Language: "en", Synced: true, Lines: []*usecaseModel.LyricsLine{{Time: 1500, Text: "Hello"}}},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": "success",
+				"body": []interface{}{
+					map[string]interface{}{
+						"language":    "en",
+						"translation": false,
+						"synced":      true,
+						"lines": []interface{}{
+							map[string]interface{}{"time_ms": float64(1500), "text": "Hello"},
+						},
+					},
+				},
+			},
+		},
+		{
+			name:    "No Lyrics",
+			trackID: "2",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetTrackLyrics(gomock.Any(), int64(2)).Return([]*usecaseModel.TrackLyrics{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": "success",
+				"body":   []interface{}{},
+			},
+		},
+		{
+			name:           "Invalid ID",
+			trackID:        "invalid",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": "error",
+				"error": map[string]interface{}{
+					"message": "strconv.ParseInt: parsing \"invalid\": invalid syntax",
+				},
+			},
+		},
+		{
+			name:    "Track Not Found",
+			trackID: "3",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetTrackLyrics(gomock.Any(), int64(3)).Return(nil, customErrors.ErrTrackNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"status": "error",
+				"error": map[string]interface{}{
+					"message": customErrors.ErrTrackNotFound.Error(),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req := httptest.NewRequest("GET", "/tracks/"+tt.trackID+"/lyrics", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.trackID})
+			req = setupTestLogger(req)
+
+			rec := httptest.NewRecorder()
+			handler.GetTrackLyrics(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
+func TestTrackHandler_GetTrackWaveform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	cfg := &config.Config{}
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
+
+	tests := []struct {
+		name           string
+		trackID        string
+		mockBehavior   func()
+		expectedStatus int
+		expectedBody   map[string]interface{}
+	}{
+		{
+			name:    "OK",
+			trackID: "1",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetTrackWaveform(gomock.Any(), int64(1)).Return(&usecaseModel.TrackWaveform{
+					Duration: 180,
+					Peaks:    []float64{0.5, 0.25},
+				}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: map[string]interface{}{
+				"status": "success",
+				"body": map[string]interface{}{
+					"duration": float64(180),
+					"peaks":    []interface{}{0.5, 0.25},
+				},
+			},
+		},
+		{
+			name:           "Invalid ID",
+			trackID:        "invalid",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody: map[string]interface{}{
+				"status": "error",
+				"error": map[string]interface{}{
+					"message": "strconv.ParseInt: parsing \"invalid\": invalid syntax",
+				},
+			},
+		},
+		{
+			name:    "Not Analysed",
+			trackID: "2",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetTrackWaveform(gomock.Any(), int64(2)).Return(nil, customErrors.ErrTrackWaveformNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody: map[string]interface{}{
+				"status": "error",
+				"error": map[string]interface{}{
+					"message": customErrors.ErrTrackWaveformNotFound.Error(),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			req := httptest.NewRequest("GET", "/tracks/"+tt.trackID+"/waveform", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": tt.trackID})
+			req = setupTestLogger(req)
+
+			rec := httptest.NewRecorder()
+			handler.GetTrackWaveform(rec, req)
+
+			verifyResponse(t, rec, tt.expectedStatus, tt.expectedBody)
+		})
+	}
+}
+
 func TestTrackHandler_GetTracksByArtistID(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -281,7 +459,7 @@ func TestTrackHandler_GetTracksByArtistID(t *testing.T) {
 			MaxLimit:     100,
 		},
 	}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -373,7 +551,7 @@ func TestTrackHandler_CreateStream(t *testing.T) {
 
 	mockUsecase := mock_track.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -477,7 +655,7 @@ func TestTrackHandler_UpdateStreamDuration(t *testing.T) {
 
 	mockUsecase := mock_track.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -607,7 +785,7 @@ func TestTrackHandler_GetLastListenedTracks(t *testing.T) {
 			MaxLimit:     100,
 		},
 	}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -717,7 +895,7 @@ func TestTrackHandler_LikeTrack(t *testing.T) {
 
 	mockUsecase := mock_track.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -859,7 +1037,7 @@ func TestTrackHandler_GetPlaylistTracks(t *testing.T) {
 
 	mockUsecase := mock_track.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -951,7 +1129,7 @@ func TestTrackHandler_GetFavoriteTracks(t *testing.T) {
 			MaxLimit:     100,
 		},
 	}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -1055,8 +1233,9 @@ func TestTrackHandler_SearchTracks(t *testing.T) {
 	defer ctrl.Finish()
 
 	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	mockRecorder := mock_search.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mockRecorder, cfg)
 
 	tests := []struct {
 		name           string
@@ -1069,13 +1248,18 @@ func TestTrackHandler_SearchTracks(t *testing.T) {
 			name:  "OK",
 			query: "?query=test",
 			mockBehavior: func() {
-				mockUsecase.EXPECT().SearchTracks(gomock.Any(), "test").Return([]*usecaseModel.Track{
+				mockUsecase.EXPECT().SearchTracks(gomock.Any(), "test", gomock.Any()).Return([]*usecaseModel.Track{
 					{
 						ID:       1,
 						Title:    "Test Track",
 						Duration: 180,
 					},
 				}, nil)
+				mockRecorder.EXPECT().LogQuery(gomock.Any(), &usecaseModel.SearchQueryLog{
+					Source:       usecaseModel.SearchSourceTracks,
+					Query:        "test",
+					ResultsCount: 1,
+				})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: map[string]interface{}{
@@ -1105,7 +1289,12 @@ func TestTrackHandler_SearchTracks(t *testing.T) {
 			name:  "No Results",
 			query: "?query=nonexistent",
 			mockBehavior: func() {
-				mockUsecase.EXPECT().SearchTracks(gomock.Any(), "nonexistent").Return([]*usecaseModel.Track{}, nil)
+				mockUsecase.EXPECT().SearchTracks(gomock.Any(), "nonexistent", gomock.Any()).Return([]*usecaseModel.Track{}, nil)
+				mockRecorder.EXPECT().LogQuery(gomock.Any(), &usecaseModel.SearchQueryLog{
+					Source:       usecaseModel.SearchSourceTracks,
+					Query:        "nonexistent",
+					ResultsCount: 0,
+				})
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody: map[string]interface{}{
@@ -1117,7 +1306,7 @@ func TestTrackHandler_SearchTracks(t *testing.T) {
 			name:  "Internal Error",
 			query: "?query=test",
 			mockBehavior: func() {
-				mockUsecase.EXPECT().SearchTracks(gomock.Any(), "test").Return(nil, errors.New("internal error"))
+				mockUsecase.EXPECT().SearchTracks(gomock.Any(), "test", gomock.Any()).Return(nil, errors.New("internal error"))
 			},
 			expectedStatus: http.StatusInternalServerError,
 			expectedBody: map[string]interface{}{
@@ -1151,7 +1340,7 @@ func TestTrackHandler_GetTracksByAlbumID(t *testing.T) {
 
 	mockUsecase := mock_track.NewMockUsecase(ctrl)
 	cfg := &config.Config{}
-	handler := NewTrackHandler(mockUsecase, cfg)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), cfg)
 
 	tests := []struct {
 		name           string
@@ -1232,3 +1421,201 @@ func TestTrackHandler_GetTracksByAlbumID(t *testing.T) {
 		})
 	}
 }
+
+func streamVariants() []*usecaseModel.StreamVariant {
+	return []*usecaseModel.StreamVariant{
+		{Codec: "aac", Bitrate: 64, Segments: []*usecaseModel.StreamSegment{{Position: 0, Duration: 6.016}, {Position: 1, Duration: 2.5}}},
+		{Codec: "aac", Bitrate: 128, Segments: []*usecaseModel.StreamSegment{{Position: 0, Duration: 6.016}, {Position: 1, Duration: 2.5}}},
+	}
+}
+
+func newStreamRequest(path string, vars map[string]string, authenticated bool) *http.Request {
+	req := httptest.NewRequest("GET", path, nil)
+	req = mux.SetURLVars(req, vars)
+
+	ctx := req.Context()
+	if authenticated {
+		ctx = context.WithValue(ctx, ctxExtractor.UserContextKey{}, int64(2))
+	}
+	ctx = loggerPkg.LoggerToContext(ctx, zap.NewNop().Sugar())
+	return req.WithContext(ctx)
+}
+
+func TestTrackHandler_GetStreamMasterPlaylist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), &config.Config{})
+
+	mockUsecase.EXPECT().GetStreamManifest(gomock.Any(), int64(1), int64(2)).Return(streamVariants(), nil)
+
+	rec := httptest.NewRecorder()
+	handler.GetStreamMasterPlaylist(rec, newStreamRequest("/streams/1/hls/master.m3u8", map[string]string{"id": "1"}, true))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "application/vnd.apple.mpegurl", rec.Header().Get("Content-Type"))
+	assert.Equal(t, "#EXTM3U\n#EXT-X-VERSION:3\n"+
+		"#EXT-X-STREAM-INF:BANDWIDTH=70400,AVERAGE-BANDWIDTH=64000,CODECS=\"mp4a.40.2\"\n64.m3u8\n"+
+		"#EXT-X-STREAM-INF:BANDWIDTH=140800,AVERAGE-BANDWIDTH=128000,CODECS=\"mp4a.40.2\"\n128.m3u8\n", rec.Body.String())
+}
+
+func TestTrackHandler_GetStreamMasterPlaylistErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), &config.Config{})
+
+	tests := []struct {
+		name           string
+		streamID       string
+		authenticated  bool
+		mockBehavior   func()
+		expectedStatus int
+		expectedError  string
+	}{
+		{
+			name:           "Unauthorized",
+			streamID:       "1",
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  customErrors.ErrUnauthorized.Error(),
+		},
+		{
+			name:          "Not Segmented",
+			streamID:      "1",
+			authenticated: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetStreamManifest(gomock.Any(), int64(1), int64(2)).Return(nil, customErrors.ErrTrackSegmentsNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedError:  customErrors.ErrTrackSegmentsNotFound.Error(),
+		},
+		{
+			name:          "Foreign Stream",
+			streamID:      "1",
+			authenticated: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetStreamManifest(gomock.Any(), int64(1), int64(2)).Return(nil, customErrors.ErrStreamPermissionDenied)
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedError:  customErrors.ErrStreamPermissionDenied.Error(),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			handler.GetStreamMasterPlaylist(rec, newStreamRequest("/streams/"+tt.streamID+"/hls/master.m3u8", map[string]string{"id": tt.streamID}, tt.authenticated))
+
+			verifyResponse(t, rec, tt.expectedStatus, map[string]interface{}{
+				"status": "error",
+				"error":  map[string]interface{}{"message": tt.expectedError},
+			})
+		})
+	}
+}
+
+func TestTrackHandler_GetStreamMediaPlaylist(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), &config.Config{})
+
+	mockUsecase.EXPECT().GetStreamManifest(gomock.Any(), int64(1), int64(2)).Return(streamVariants(), nil)
+
+	rec := httptest.NewRecorder()
+	handler.GetStreamMediaPlaylist(rec, newStreamRequest("/streams/1/hls/128.m3u8", map[string]string{"id": "1", "bitrate": "128"}, true))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "#EXTM3U\n#EXT-X-VERSION:3\n#EXT-X-TARGETDURATION:7\n#EXT-X-MEDIA-SEQUENCE:0\n#EXT-X-PLAYLIST-TYPE:VOD\n"+
+		"#EXTINF:6.016,\n128/00000.ts\n"+
+		"#EXTINF:2.500,\n128/00001.ts\n"+
+		"#EXT-X-ENDLIST\n", rec.Body.String())
+}
+
+func TestTrackHandler_GetStreamMediaPlaylistUnknownBitrate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), &config.Config{})
+
+	mockUsecase.EXPECT().GetStreamManifest(gomock.Any(), int64(1), int64(2)).Return(streamVariants(), nil)
+
+	rec := httptest.NewRecorder()
+	handler.GetStreamMediaPlaylist(rec, newStreamRequest("/streams/1/hls/320.m3u8", map[string]string{"id": "1", "bitrate": "320"}, true))
+
+	verifyResponse(t, rec, http.StatusNotFound, map[string]interface{}{
+		"status": "error",
+		"error":  map[string]interface{}{"message": customErrors.ErrTrackSegmentNotFound.Error()},
+	})
+}
+
+func TestTrackHandler_GetStreamSegment(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUsecase := mock_track.NewMockUsecase(ctrl)
+	handler := NewTrackHandler(mockUsecase, mock_search.NewMockUsecase(ctrl), &config.Config{})
+
+	tests := []struct {
+		name             string
+		vars             map[string]string
+		authenticated    bool
+		mockBehavior     func()
+		expectedStatus   int
+		expectedLocation string
+	}{
+		{
+			name:          "OK",
+			vars:          map[string]string{"id": "1", "bitrate": "128", "position": "3"},
+			authenticated: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetStreamSegmentURL(gomock.Any(), &usecaseModel.StreamSegmentRequest{
+					StreamID: 1, UserID: 2, Bitrate: 128, Position: 3,
+				}).Return("https://s3/hls/upload/aac-128/00003.ts", nil)
+			},
+			expectedStatus:   http.StatusFound,
+			expectedLocation: "https://s3/hls/upload/aac-128/00003.ts",
+		},
+		{
+			name:           "Invalid Position",
+			vars:           map[string]string{"id": "1", "bitrate": "128", "position": "last"},
+			authenticated:  true,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Unauthorized",
+			vars:           map[string]string{"id": "1", "bitrate": "128", "position": "3"},
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:          "Segment Not Found",
+			vars:          map[string]string{"id": "1", "bitrate": "128", "position": "99"},
+			authenticated: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetStreamSegmentURL(gomock.Any(), gomock.Any()).Return("", customErrors.ErrTrackSegmentNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			handler.GetStreamSegment(rec, newStreamRequest("/streams/1/hls/128/00003.ts", tt.vars, tt.authenticated))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+			assert.Equal(t, tt.expectedLocation, rec.Header().Get("Location"))
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package http
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+)
+
+const hlsPlaylistContentType = "application/vnd.apple.mpegurl"
+
+// hlsCodecs are the RFC 6381 names players check before picking a variant
+var hlsCodecs = map[string]string{
+	"aac": "mp4a.40.2",
+}
+
+// mpeg-ts packaging adds roughly a tenth on top of the audio bitrate
+const hlsBandwidthOverhead = 1.1
+
+// masterPlaylist points every variant at its media playlist next to the master one
+func masterPlaylist(variants []*usecaseModel.StreamVariant) string {
+	var playlist strings.Builder
+	playlist.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	for _, variant := range variants {
+		bandwidth := int64(float64(variant.Bitrate*1000) * hlsBandwidthOverhead)
+		fmt.Fprintf(&playlist, "#EXT-X-STREAM-INF:BANDWIDTH=%d,AVERAGE-BANDWIDTH=%d,CODECS=\"%s\"\n", bandwidth, variant.Bitrate*1000, hlsCodecs[variant.Codec])
+		fmt.Fprintf(&playlist, "%d.m3u8\n", variant.Bitrate)
+	}
+	return playlist.String()
+}
+
+// mediaPlaylist lists segments relative to the playlist so that every fetch goes
+// through the gateway and is counted towards the stream
+func mediaPlaylist(variant *usecaseModel.StreamVariant) string {
+	var targetDuration float64
+	for _, segment := range variant.Segments {
+		targetDuration = math.Max(targetDuration, segment.Duration)
+	}
+
+	var playlist strings.Builder
+	playlist.WriteString("#EXTM3U\n#EXT-X-VERSION:3\n")
+	fmt.Fprintf(&playlist, "#EXT-X-TARGETDURATION:%d\n", int64(math.Ceil(targetDuration)))
+	playlist.WriteString("#EXT-X-MEDIA-SEQUENCE:0\n#EXT-X-PLAYLIST-TYPE:VOD\n")
+	for _, segment := range variant.Segments {
+		fmt.Fprintf(&playlist, "#EXTINF:%.3f,\n", segment.Duration)
+		fmt.Fprintf(&playlist, "%d/%05d.ts\n", variant.Bitrate, segment.Position)
+	}
+	playlist.WriteString("#EXT-X-ENDLIST\n")
+	return playlist.String()
+}
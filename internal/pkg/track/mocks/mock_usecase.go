@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: usecase.go
+// Source: internal/pkg/track/usecase.go
 //
 // Generated by this command:
 //
-//	mockgen -source=usecase.go -destination=mocks/mock_usecase.go
+//	mockgen -source=internal/pkg/track/usecase.go -destination=internal/pkg/track/mocks/mock_usecase.go -package=mock_track
 //
 
 // Package mock_track is a generated GoMock package.
@@ -131,6 +131,36 @@ func (mr *MockUsecaseMockRecorder) GetSelectionTracks(ctx, selection any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSelectionTracks", reflect.TypeOf((*MockUsecase)(nil).GetSelectionTracks), ctx, selection)
 }
 
+// GetStreamManifest mocks base method.
+func (m *MockUsecase) GetStreamManifest(ctx context.Context, streamID, userID int64) ([]*usecase.StreamVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamManifest", ctx, streamID, userID)
+	ret0, _ := ret[0].([]*usecase.StreamVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamManifest indicates an expected call of GetStreamManifest.
+func (mr *MockUsecaseMockRecorder) GetStreamManifest(ctx, streamID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamManifest", reflect.TypeOf((*MockUsecase)(nil).GetStreamManifest), ctx, streamID, userID)
+}
+
+// GetStreamSegmentURL mocks base method.
+func (m *MockUsecase) GetStreamSegmentURL(ctx context.Context, request *usecase.StreamSegmentRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamSegmentURL", ctx, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamSegmentURL indicates an expected call of GetStreamSegmentURL.
+func (mr *MockUsecaseMockRecorder) GetStreamSegmentURL(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamSegmentURL", reflect.TypeOf((*MockUsecase)(nil).GetStreamSegmentURL), ctx, request)
+}
+
 // GetTrackByID mocks base method.
 func (m *MockUsecase) GetTrackByID(ctx context.Context, id int64) (*usecase.TrackDetailed, error) {
 	m.ctrl.T.Helper()
@@ -146,6 +176,36 @@ func (mr *MockUsecaseMockRecorder) GetTrackByID(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackByID", reflect.TypeOf((*MockUsecase)(nil).GetTrackByID), ctx, id)
 }
 
+// GetTrackLyrics mocks base method.
+func (m *MockUsecase) GetTrackLyrics(ctx context.Context, id int64) ([]*usecase.TrackLyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackLyrics", ctx, id)
+	ret0, _ := ret[0].([]*usecase.TrackLyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackLyrics indicates an expected call of GetTrackLyrics.
+func (mr *MockUsecaseMockRecorder) GetTrackLyrics(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackLyrics", reflect.TypeOf((*MockUsecase)(nil).GetTrackLyrics), ctx, id)
+}
+
+// GetTrackWaveform mocks base method.
+func (m *MockUsecase) GetTrackWaveform(ctx context.Context, id int64) (*usecase.TrackWaveform, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackWaveform", ctx, id)
+	ret0, _ := ret[0].(*usecase.TrackWaveform)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackWaveform indicates an expected call of GetTrackWaveform.
+func (mr *MockUsecaseMockRecorder) GetTrackWaveform(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackWaveform", reflect.TypeOf((*MockUsecase)(nil).GetTrackWaveform), ctx, id)
+}
+
 // GetTracksByAlbumID mocks base method.
 func (m *MockUsecase) GetTracksByAlbumID(ctx context.Context, id int64) ([]*usecase.Track, error) {
 	m.ctrl.T.Helper()
@@ -191,18 +251,18 @@ func (mr *MockUsecaseMockRecorder) LikeTrack(ctx, request any) *gomock.Call {
 }
 
 // SearchTracks mocks base method.
-func (m *MockUsecase) SearchTracks(ctx context.Context, query string) ([]*usecase.Track, error) {
+func (m *MockUsecase) SearchTracks(ctx context.Context, query string, filters *usecase.TrackFilters) ([]*usecase.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchTracks", ctx, query)
+	ret := m.ctrl.Call(m, "SearchTracks", ctx, query, filters)
 	ret0, _ := ret[0].([]*usecase.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchTracks indicates an expected call of SearchTracks.
-func (mr *MockUsecaseMockRecorder) SearchTracks(ctx, query any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchTracks(ctx, query, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockUsecase)(nil).SearchTracks), ctx, query)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockUsecase)(nil).SearchTracks), ctx, query, filters)
 }
 
 // UpdateStreamDuration mocks base method.
@@ -9,14 +9,18 @@ import (
 type Usecase interface {
 	GetAllTracks(ctx context.Context, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error)
 	GetTrackByID(ctx context.Context, id int64) (*usecaseModel.TrackDetailed, error)
+	GetTrackWaveform(ctx context.Context, id int64) (*usecaseModel.TrackWaveform, error)
+	GetTrackLyrics(ctx context.Context, id int64) ([]*usecaseModel.TrackLyrics, error)
 	GetTracksByArtistID(ctx context.Context, id int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error)
 	CreateStream(ctx context.Context, stream *usecaseModel.TrackStreamCreateData) (int64, error)
 	UpdateStreamDuration(ctx context.Context, endedStream *usecaseModel.TrackStreamUpdateData) error
+	GetStreamManifest(ctx context.Context, streamID int64, userID int64) ([]*usecaseModel.StreamVariant, error)
+	GetStreamSegmentURL(ctx context.Context, request *usecaseModel.StreamSegmentRequest) (string, error)
 	GetLastListenedTracks(ctx context.Context, userID int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error)
 	GetTracksByAlbumID(ctx context.Context, id int64) ([]*usecaseModel.Track, error)
 	LikeTrack(ctx context.Context, request *usecaseModel.TrackLikeRequest) error
 	GetPlaylistTracks(ctx context.Context, id int64) ([]*usecaseModel.Track, error)
 	GetFavoriteTracks(ctx context.Context, filters *usecaseModel.TrackFilters, username string) ([]*usecaseModel.Track, error)
-	SearchTracks(ctx context.Context, query string) ([]*usecaseModel.Track, error)
+	SearchTracks(ctx context.Context, query string, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error)
 	GetSelectionTracks(ctx context.Context, selection string) ([]*usecaseModel.Track, error)
 }
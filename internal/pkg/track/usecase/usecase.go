@@ -97,6 +97,24 @@ func (u *trackUsecase) GetTrackByID(ctx context.Context, id int64) (*usecaseMode
 	return trackDetailed, nil
 }
 
+func (u *trackUsecase) GetTrackWaveform(ctx context.Context, id int64) (*usecaseModel.TrackWaveform, error) {
+	protoWaveform, err := u.trackClient.GetTrackWaveform(ctx, &trackProto.TrackID{Id: id})
+	if err != nil {
+		return nil, customErrors.HandleTrackGRPCError(err)
+	}
+
+	return model.TrackWaveformFromProtoToUsecase(protoWaveform), nil
+}
+
+func (u *trackUsecase) GetTrackLyrics(ctx context.Context, id int64) ([]*usecaseModel.TrackLyrics, error) {
+	protoLyrics, err := u.trackClient.GetTrackLyrics(ctx, &trackProto.TrackID{Id: id})
+	if err != nil {
+		return nil, customErrors.HandleTrackGRPCError(err)
+	}
+
+	return model.TrackLyricsListFromProtoToUsecase(protoLyrics), nil
+}
+
 func (u *trackUsecase) GetTracksByArtistID(ctx context.Context, id int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error) {
 	userID, exists := ctxExtractor.UserFromContext(ctx)
 	if !exists {
@@ -195,6 +213,27 @@ func (u *trackUsecase) UpdateStreamDuration(ctx context.Context, endedStream *us
 	return nil
 }
 
+func (u *trackUsecase) GetStreamManifest(ctx context.Context, streamID int64, userID int64) ([]*usecaseModel.StreamVariant, error) {
+	protoManifest, err := u.trackClient.GetStreamManifest(ctx, &trackProto.StreamIDWithUserID{
+		StreamId: &trackProto.StreamID{Id: streamID},
+		UserId:   &trackProto.UserID{Id: userID},
+	})
+	if err != nil {
+		return nil, customErrors.HandleTrackGRPCError(err)
+	}
+
+	return model.StreamManifestFromProtoToUsecase(protoManifest), nil
+}
+
+func (u *trackUsecase) GetStreamSegmentURL(ctx context.Context, request *usecaseModel.StreamSegmentRequest) (string, error) {
+	segmentURL, err := u.trackClient.GetStreamSegment(ctx, model.StreamSegmentRequestFromUsecaseToProto(request))
+	if err != nil {
+		return "", customErrors.HandleTrackGRPCError(err)
+	}
+
+	return segmentURL.Url, nil
+}
+
 func (u *trackUsecase) GetLastListenedTracks(ctx context.Context, userID int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error) {
 
 	protoUserIDWithFilters := &trackProto.UserIDWithFilters{
@@ -401,13 +440,17 @@ func (u *trackUsecase) GetFavoriteTracks(ctx context.Context, filters *usecaseMo
 	return tracks, nil
 }
 
-func (u *trackUsecase) SearchTracks(ctx context.Context, query string) ([]*usecaseModel.Track, error) {
+func (u *trackUsecase) SearchTracks(ctx context.Context, query string, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error) {
 	userID, exists := ctxExtractor.UserFromContext(ctx)
 	if !exists {
 		userID = -1
 	}
 
-	protoTracks, err := u.trackClient.SearchTracks(ctx, &trackProto.Query{Query: query, UserId: &trackProto.UserID{Id: userID}})
+	protoTracks, err := u.trackClient.SearchTracks(ctx, &trackProto.Query{
+		Query:   query,
+		UserId:  &trackProto.UserID{Id: userID},
+		Filters: &trackProto.Filters{Pagination: model.PaginationFromUsecaseToTrackProto(filters.Pagination)},
+	})
 	if err != nil {
 		return nil, customErrors.HandleTrackGRPCError(err)
 	}
@@ -10,11 +10,14 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/track"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
+	customErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
 	trackUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/track/usecase"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -251,6 +254,113 @@ func TestUpdateStreamDuration(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestGetStreamManifest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackClient := mocks.NewMockTrackServiceClient(ctrl)
+	trackUC := trackUsecase.NewUsecase(mockTrackClient, mocks.NewMockArtistServiceClient(ctrl), mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockPlaylistServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx := context.Background()
+	mockTrackClient.EXPECT().GetStreamManifest(ctx, &track.StreamIDWithUserID{
+		StreamId: &track.StreamID{Id: 1},
+		UserId:   &track.UserID{Id: 2},
+	}).Return(&track.StreamManifest{
+		Variants: []*track.StreamVariant{
+			{Codec: "aac", Bitrate: 128, Segments: []*track.StreamSegment{{Position: 0, Duration: 6}}},
+		},
+	}, nil)
+
+	variants, err := trackUC.GetStreamManifest(ctx, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []*usecase.StreamVariant{
+		{Codec: "aac", Bitrate: 128, Segments: []*usecase.StreamSegment{{Position: 0, Duration: 6}}},
+	}, variants)
+}
+
+func TestGetStreamSegmentURLNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackClient := mocks.NewMockTrackServiceClient(ctrl)
+	trackUC := trackUsecase.NewUsecase(mockTrackClient, mocks.NewMockArtistServiceClient(ctrl), mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockPlaylistServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx := context.Background()
+	mockTrackClient.EXPECT().GetStreamSegment(ctx, gomock.Any()).Return(nil, status.Error(codes.NotFound, "segment not found"))
+
+	_, err := trackUC.GetStreamSegmentURL(ctx, &usecase.StreamSegmentRequest{StreamID: 1, UserID: 2, Bitrate: 128, Position: 99})
+	assert.ErrorIs(t, err, customErrors.ErrTrackSegmentNotFound)
+}
+
+func TestGetTrackWaveform(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackClient := mocks.NewMockTrackServiceClient(ctrl)
+	trackUC := trackUsecase.NewUsecase(mockTrackClient, mocks.NewMockArtistServiceClient(ctrl), mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockPlaylistServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx := context.Background()
+	mockTrackClient.EXPECT().GetTrackWaveform(ctx, &track.TrackID{Id: 1}).Return(&track.TrackWaveform{
+		Duration: 180,
+		Peaks:    []float64{0.5, 0.25},
+	}, nil)
+
+	waveform, err := trackUC.GetTrackWaveform(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, &usecase.TrackWaveform{Duration: 180, Peaks: []float64{0.5, 0.25}}, waveform)
+}
+
+func TestGetTrackWaveformNotAnalysed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackClient := mocks.NewMockTrackServiceClient(ctrl)
+	trackUC := trackUsecase.NewUsecase(mockTrackClient, mocks.NewMockArtistServiceClient(ctrl), mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockPlaylistServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx := context.Background()
+	mockTrackClient.EXPECT().GetTrackWaveform(ctx, gomock.Any()).Return(nil, status.Error(codes.NotFound, "track is not analysed for a waveform yet"))
+
+	_, err := trackUC.GetTrackWaveform(ctx, 1)
+	assert.ErrorIs(t, err, customErrors.ErrTrackWaveformNotFound)
+}
+
+func TestGetTrackLyrics(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackClient := mocks.NewMockTrackServiceClient(ctrl)
+	trackUC := trackUsecase.NewUsecase(mockTrackClient, mocks.NewMockArtistServiceClient(ctrl), mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockPlaylistServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx := context.Background()
+	mockTrackClient.EXPECT().GetTrackLyrics(ctx, &track.TrackID{Id: 1}).Return(&track.TrackLyricsList{
+		Lyrics: []*track.TrackLyrics{
+			{Language: "en", Synced: true, Lines: []*track.LyricsLine{{Time: 1500, Text: "Hello"}}},
+			{Language: "ru", Translation: true, Lines: []*track.LyricsLine{{Text: "Привет"}}},
+		},
+	}, nil)
+
+	lyrics, err := trackUC.GetTrackLyrics(ctx, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []*usecase.TrackLyrics{
+		{Language: "en", Synced: true, Lines: []*usecase.LyricsLine{{Time: 1500, Text: "Hello"}}},
+		{Language: "ru", Translation: true, Lines: []*usecase.LyricsLine{{Text: "Привет"}}},
+	}, lyrics)
+}
+
+func TestGetTrackLyricsTrackNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockTrackClient := mocks.NewMockTrackServiceClient(ctrl)
+	trackUC := trackUsecase.NewUsecase(mockTrackClient, mocks.NewMockArtistServiceClient(ctrl), mocks.NewMockAlbumServiceClient(ctrl), mocks.NewMockPlaylistServiceClient(ctrl), mocks.NewMockUserServiceClient(ctrl))
+
+	ctx := context.Background()
+	mockTrackClient.EXPECT().GetTrackLyrics(ctx, gomock.Any()).Return(nil, status.Error(codes.NotFound, "track not found"))
+
+	_, err := trackUC.GetTrackLyrics(ctx, 1)
+	assert.ErrorIs(t, err, customErrors.ErrTrackNotFound)
+}
+
 func TestGetPlaylistTracks(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -324,6 +434,12 @@ func TestSearchTracks(t *testing.T) {
 
 	ctx := context.Background()
 	query := "test"
+	filters := &usecase.TrackFilters{
+		Pagination: &usecase.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
 
 	trackList := &track.TrackList{
 		Tracks: []*track.Track{
@@ -358,7 +474,7 @@ func TestSearchTracks(t *testing.T) {
 	mockAlbumClient.EXPECT().GetAlbumTitleByIDs(gomock.Any(), gomock.Any()).Return(albumTitleMap, nil)
 	mockArtistClient.EXPECT().GetArtistsByTrackIDs(gomock.Any(), gomock.Any()).Return(artistsMap, nil)
 
-	tracks, err := trackUC.SearchTracks(ctx, query)
+	tracks, err := trackUC.SearchTracks(ctx, query, filters)
 
 	assert.NoError(t, err)
 	assert.Equal(t, 1, len(tracks))
@@ -0,0 +1,218 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/errorStatus"
+	jsonPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload"
+	"github.com/gorilla/mux"
+	"go.uber.org/zap"
+)
+
+type UploadHandler struct {
+	usecase upload.Usecase
+	cfg     *config.Config
+}
+
+func NewUploadHandler(usecase upload.Usecase, cfg *config.Config) *UploadHandler {
+	return &UploadHandler{usecase: usecase, cfg: cfg}
+}
+
+// labelFromRequest writes the error itself, uploads are only open to label members
+func labelFromRequest(w http.ResponseWriter, r *http.Request) (int64, bool) {
+	labelID, isLabel := ctxExtractor.LabelFromContext(r.Context())
+	if !isLabel {
+		loggerPkg.LoggerFromContext(r.Context()).Error("failed to get labelID")
+		jsonPkg.WriteErrorResponse(w, http.StatusUnauthorized, "user not in label", nil)
+		return 0, false
+	}
+	return labelID, true
+}
+
+// CreateUpload godoc
+// @Summary Start an upload
+// @Description Starts a resumable multipart upload of a track or a cover. The file is sent straight to the storage in parts of part_size bytes through presigned URLs.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param request body delivery.CreateUploadRequest true "File kind, size and content type"
+// @Success 201 {object} delivery.APIResponse{body=delivery.Upload} "Upload started"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid kind, content type or size"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 413 {object} delivery.APIRequestEntityTooLargeErrorResponse "File is too large"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /label/uploads [post]
+func (h *UploadHandler) CreateUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, ok := labelFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var request delivery.CreateUploadRequest
+	if err := jsonPkg.ReadJSON(w, r, &request); err != nil {
+		logger.Error("failed to read json", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, http.StatusBadRequest, "failed to read json", nil)
+		return
+	}
+
+	createdUpload, err := h.usecase.CreateUpload(ctx, model.CreateUploadRequestFromDeliveryToUsecase(&request, labelID))
+	if err != nil {
+		logger.Error("failed to create upload", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusCreated, model.UploadFromUsecaseToDelivery(createdUpload), nil)
+}
+
+// GetUpload godoc
+// @Summary Get upload state
+// @Description Returns the upload with the parts the storage already has, used to resume an interrupted upload
+// @Tags label
+// @Produce json
+// @Security LabelAuth
+// @Param id path string true "Upload ID"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Upload} "Upload state"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Upload not found or expired"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /label/uploads/{id} [get]
+func (h *UploadHandler) GetUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, ok := labelFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	currentUpload, err := h.usecase.GetUpload(ctx, mux.Vars(r)["id"], labelID)
+	if err != nil {
+		logger.Error("failed to get upload", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusOK, model.UploadFromUsecaseToDelivery(currentUpload), nil)
+}
+
+// GetPartURLs godoc
+// @Summary Sign upload parts
+// @Description Returns presigned URLs to PUT the given parts to. Without part numbers every part that is not uploaded yet is signed.
+// @Tags label
+// @Accept json
+// @Produce json
+// @Security LabelAuth
+// @Param id path string true "Upload ID"
+// @Param request body delivery.UploadPartsRequest false "Part numbers, starting from 1"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.UploadPart} "Presigned part URLs"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid part number"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Upload not found or expired"
+// @Failure 409 {object} delivery.APIErrorResponse "Upload is already completed"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /label/uploads/{id}/parts [post]
+func (h *UploadHandler) GetPartURLs(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, ok := labelFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	var request delivery.UploadPartsRequest
+	if r.ContentLength != 0 {
+		if err := jsonPkg.ReadJSON(w, r, &request); err != nil {
+			logger.Error("failed to read json", zap.Error(err))
+			jsonPkg.WriteErrorResponse(w, http.StatusBadRequest, "failed to read json", nil)
+			return
+		}
+	}
+
+	parts, err := h.usecase.GetPartURLs(ctx, &usecase.UploadPartsRequest{
+		UploadID: mux.Vars(r)["id"],
+		LabelID:  labelID,
+		Parts:    request.Parts,
+	})
+	if err != nil {
+		logger.Error("failed to sign upload parts", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusOK, model.UploadPartsFromUsecaseToDelivery(parts), nil)
+}
+
+// CompleteUpload godoc
+// @Summary Complete an upload
+// @Description Assembles the file once every part is uploaded, the upload id can then be used to create a release
+// @Tags label
+// @Produce json
+// @Security LabelAuth
+// @Param id path string true "Upload ID"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Upload} "Completed upload"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Upload not found or expired"
+// @Failure 409 {object} delivery.APIErrorResponse "Some parts are missing or the size does not match"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /label/uploads/{id}/complete [post]
+func (h *UploadHandler) CompleteUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, ok := labelFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	completedUpload, err := h.usecase.CompleteUpload(ctx, mux.Vars(r)["id"], labelID)
+	if err != nil {
+		logger.Error("failed to complete upload", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusOK, model.UploadFromUsecaseToDelivery(completedUpload), nil)
+}
+
+// AbortUpload godoc
+// @Summary Abort an upload
+// @Description Drops the upload and every part that was already sent
+// @Tags label
+// @Produce json
+// @Security LabelAuth
+// @Param id path string true "Upload ID"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Message} "Upload aborted"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "Upload not found or expired"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /label/uploads/{id} [delete]
+func (h *UploadHandler) AbortUpload(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	labelID, ok := labelFromRequest(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.usecase.AbortUpload(ctx, mux.Vars(r)["id"], labelID); err != nil {
+		logger.Error("failed to abort upload", zap.Error(err))
+		jsonPkg.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	jsonPkg.WriteSuccessResponse(w, http.StatusOK, &delivery.Message{Message: "upload aborted"}, nil)
+}
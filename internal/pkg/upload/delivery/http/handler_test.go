@@ -0,0 +1,265 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_upload "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload/mocks"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func newRequest(method string, target string, body string, isLabel bool) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	ctx := context.WithValue(req.Context(), loggerPkg.LoggerKey{}, zap.NewNop().Sugar())
+	if isLabel {
+		ctx = context.WithValue(ctx, ctxExtractor.LabelContextKey{}, int64(3))
+	}
+	return mux.SetURLVars(req.WithContext(ctx), map[string]string{"id": "upload"})
+}
+
+func TestCreateUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_upload.NewMockUsecase(ctrl)
+	handler := NewUploadHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		body           string
+		isLabel        bool
+		mockBehavior   func()
+		expectedStatus int
+	}{
+		{
+			name:    "Success",
+			body:    `{"kind":"track","size":100,"content_type":"audio/mpeg"}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().CreateUpload(gomock.Any(), &usecaseModel.CreateUploadRequest{
+					LabelID:     3,
+					Kind:        "track",
+					Size:        100,
+					ContentType: "audio/mpeg",
+				}).Return(&usecaseModel.Upload{
+					ID:            "upload",
+					Kind:          "track",
+					Size:          100,
+					PartSize:      64,
+					PartsCount:    2,
+					Status:        usecaseModel.UploadStatusPending,
+					UploadedParts: []int64{},
+				}, nil)
+			},
+			expectedStatus: http.StatusCreated,
+		},
+		{
+			name:           "Not a label member",
+			body:           `{"kind":"track","size":100,"content_type":"audio/mpeg"}`,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Invalid json",
+			body:           `{"kind":`,
+			isLabel:        true,
+			mockBehavior:   func() {},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:    "Too large",
+			body:    `{"kind":"cover","size":100000000,"content_type":"image/png"}`,
+			isLabel: true,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().CreateUpload(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrUploadTooLarge)
+			},
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			handler.CreateUpload(rec, newRequest(http.MethodPost, "/label/uploads", tt.body, tt.isLabel))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusCreated {
+				var response struct {
+					Body *deliveryModel.Upload `json:"body"`
+				}
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				require.NoError(t, err)
+				assert.Equal(t, "upload", response.Body.ID)
+				assert.Equal(t, int64(2), response.Body.PartsCount)
+				assert.Empty(t, response.Body.UploadedParts)
+			}
+		})
+	}
+}
+
+func TestGetUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_upload.NewMockUsecase(ctrl)
+	handler := NewUploadHandler(mockUsecase, &config.Config{})
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().GetUpload(gomock.Any(), "upload", int64(3)).Return(&usecaseModel.Upload{
+			ID:            "upload",
+			PartsCount:    2,
+			Status:        usecaseModel.UploadStatusPending,
+			UploadedParts: []int64{1},
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		handler.GetUpload(rec, newRequest(http.MethodGet, "/label/uploads/upload", "", true))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		var response struct {
+			Body *deliveryModel.Upload `json:"body"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+		assert.Equal(t, []int64{1}, response.Body.UploadedParts)
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		mockUsecase.EXPECT().GetUpload(gomock.Any(), "upload", int64(3)).Return(nil, customErrors.ErrUploadNotFound)
+
+		rec := httptest.NewRecorder()
+		handler.GetUpload(rec, newRequest(http.MethodGet, "/label/uploads/upload", "", true))
+
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+}
+
+func TestGetPartURLs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_upload.NewMockUsecase(ctrl)
+	handler := NewUploadHandler(mockUsecase, &config.Config{})
+
+	tests := []struct {
+		name           string
+		body           string
+		mockBehavior   func()
+		expectedStatus int
+	}{
+		{
+			name: "Requested parts",
+			body: `{"parts":[2]}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetPartURLs(gomock.Any(), &usecaseModel.UploadPartsRequest{
+					UploadID: "upload",
+					LabelID:  3,
+					Parts:    []int64{2},
+				}).Return([]*usecaseModel.UploadPart{{Number: 2, URL: "https://part/2"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Missing parts without a body",
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetPartURLs(gomock.Any(), &usecaseModel.UploadPartsRequest{
+					UploadID: "upload",
+					LabelID:  3,
+				}).Return([]*usecaseModel.UploadPart{{Number: 2, URL: "https://part/2"}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name: "Already completed",
+			body: `{"parts":[1]}`,
+			mockBehavior: func() {
+				mockUsecase.EXPECT().GetPartURLs(gomock.Any(), gomock.Any()).Return(nil, customErrors.ErrUploadAlreadyCompleted)
+			},
+			expectedStatus: http.StatusConflict,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.mockBehavior()
+
+			rec := httptest.NewRecorder()
+			handler.GetPartURLs(rec, newRequest(http.MethodPost, "/label/uploads/upload/parts", tt.body, true))
+
+			assert.Equal(t, tt.expectedStatus, rec.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response struct {
+					Body []*deliveryModel.UploadPart `json:"body"`
+				}
+				err := json.Unmarshal(rec.Body.Bytes(), &response)
+				require.NoError(t, err)
+				assert.Equal(t, []*deliveryModel.UploadPart{{Number: 2, URL: "https://part/2"}}, response.Body)
+			}
+		})
+	}
+}
+
+func TestCompleteUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_upload.NewMockUsecase(ctrl)
+	handler := NewUploadHandler(mockUsecase, &config.Config{})
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().CompleteUpload(gomock.Any(), "upload", int64(3)).Return(&usecaseModel.Upload{
+			ID:            "upload",
+			PartsCount:    1,
+			Status:        usecaseModel.UploadStatusCompleted,
+			UploadedParts: []int64{1},
+		}, nil)
+
+		rec := httptest.NewRecorder()
+		handler.CompleteUpload(rec, newRequest(http.MethodPost, "/label/uploads/upload/complete", "", true))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Missing parts", func(t *testing.T) {
+		mockUsecase.EXPECT().CompleteUpload(gomock.Any(), "upload", int64(3)).Return(nil, customErrors.ErrUploadIncomplete)
+
+		rec := httptest.NewRecorder()
+		handler.CompleteUpload(rec, newRequest(http.MethodPost, "/label/uploads/upload/complete", "", true))
+
+		assert.Equal(t, http.StatusConflict, rec.Code)
+	})
+}
+
+func TestAbortUpload(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockUsecase := mock_upload.NewMockUsecase(ctrl)
+	handler := NewUploadHandler(mockUsecase, &config.Config{})
+
+	t.Run("Success", func(t *testing.T) {
+		mockUsecase.EXPECT().AbortUpload(gomock.Any(), "upload", int64(3)).Return(nil)
+
+		rec := httptest.NewRecorder()
+		handler.AbortUpload(rec, newRequest(http.MethodDelete, "/label/uploads/upload", "", true))
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("Storage error", func(t *testing.T) {
+		mockUsecase.EXPECT().AbortUpload(gomock.Any(), "upload", int64(3)).Return(errors.New("s3 error"))
+
+		rec := httptest.NewRecorder()
+		handler.AbortUpload(rec, newRequest(http.MethodDelete, "/label/uploads/upload", "", true))
+
+		assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	})
+}
@@ -0,0 +1,270 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: repository.go
+//
+// Generated by this command:
+//
+//	mockgen -source=repository.go -destination=mocks/mock_repository.go
+//
+
+// Package mock_upload is a generated GoMock package.
+package mock_upload
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	repository "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockRepository is a mock of Repository interface.
+type MockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRepositoryMockRecorder is the mock recorder for MockRepository.
+type MockRepositoryMockRecorder struct {
+	mock *MockRepository
+}
+
+// NewMockRepository creates a new mock instance.
+func NewMockRepository(ctrl *gomock.Controller) *MockRepository {
+	mock := &MockRepository{ctrl: ctrl}
+	mock.recorder = &MockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcquireSweeperLock mocks base method.
+func (m *MockRepository) AcquireSweeperLock(ctx context.Context, ttl time.Duration) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquireSweeperLock", ctx, ttl)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquireSweeperLock indicates an expected call of AcquireSweeperLock.
+func (mr *MockRepositoryMockRecorder) AcquireSweeperLock(ctx, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquireSweeperLock", reflect.TypeOf((*MockRepository)(nil).AcquireSweeperLock), ctx, ttl)
+}
+
+// ClaimUpload mocks base method.
+func (m *MockRepository) ClaimUpload(ctx context.Context, uploadID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimUpload", ctx, uploadID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimUpload indicates an expected call of ClaimUpload.
+func (mr *MockRepositoryMockRecorder) ClaimUpload(ctx, uploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimUpload", reflect.TypeOf((*MockRepository)(nil).ClaimUpload), ctx, uploadID)
+}
+
+// DeleteUpload mocks base method.
+func (m *MockRepository) DeleteUpload(ctx context.Context, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUpload", ctx, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUpload indicates an expected call of DeleteUpload.
+func (mr *MockRepositoryMockRecorder) DeleteUpload(ctx, uploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUpload", reflect.TypeOf((*MockRepository)(nil).DeleteUpload), ctx, uploadID)
+}
+
+// GetUpload mocks base method.
+func (m *MockRepository) GetUpload(ctx context.Context, uploadID string) (*repository.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpload", ctx, uploadID)
+	ret0, _ := ret[0].(*repository.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpload indicates an expected call of GetUpload.
+func (mr *MockRepositoryMockRecorder) GetUpload(ctx, uploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpload", reflect.TypeOf((*MockRepository)(nil).GetUpload), ctx, uploadID)
+}
+
+// GetUploadsCreatedBefore mocks base method.
+func (m *MockRepository) GetUploadsCreatedBefore(ctx context.Context, before time.Time) ([]*repository.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadsCreatedBefore", ctx, before)
+	ret0, _ := ret[0].([]*repository.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadsCreatedBefore indicates an expected call of GetUploadsCreatedBefore.
+func (mr *MockRepositoryMockRecorder) GetUploadsCreatedBefore(ctx, before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadsCreatedBefore", reflect.TypeOf((*MockRepository)(nil).GetUploadsCreatedBefore), ctx, before)
+}
+
+// MarkCompleted mocks base method.
+func (m *MockRepository) MarkCompleted(ctx context.Context, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkCompleted", ctx, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkCompleted indicates an expected call of MarkCompleted.
+func (mr *MockRepositoryMockRecorder) MarkCompleted(ctx, uploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkCompleted", reflect.TypeOf((*MockRepository)(nil).MarkCompleted), ctx, uploadID)
+}
+
+// SaveUpload mocks base method.
+func (m *MockRepository) SaveUpload(ctx context.Context, upload *repository.Upload, ttl time.Duration) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveUpload", ctx, upload, ttl)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveUpload indicates an expected call of SaveUpload.
+func (mr *MockRepositoryMockRecorder) SaveUpload(ctx, upload, ttl any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveUpload", reflect.TypeOf((*MockRepository)(nil).SaveUpload), ctx, upload, ttl)
+}
+
+// UnclaimUpload mocks base method.
+func (m *MockRepository) UnclaimUpload(ctx context.Context, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnclaimUpload", ctx, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnclaimUpload indicates an expected call of UnclaimUpload.
+func (mr *MockRepositoryMockRecorder) UnclaimUpload(ctx, uploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnclaimUpload", reflect.TypeOf((*MockRepository)(nil).UnclaimUpload), ctx, uploadID)
+}
+
+// MockStorageRepository is a mock of StorageRepository interface.
+type MockStorageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStorageRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStorageRepositoryMockRecorder is the mock recorder for MockStorageRepository.
+type MockStorageRepositoryMockRecorder struct {
+	mock *MockStorageRepository
+}
+
+// NewMockStorageRepository creates a new mock instance.
+func NewMockStorageRepository(ctrl *gomock.Controller) *MockStorageRepository {
+	mock := &MockStorageRepository{ctrl: ctrl}
+	mock.recorder = &MockStorageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStorageRepository) EXPECT() *MockStorageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AbortMultipartUpload mocks base method.
+func (m *MockStorageRepository) AbortMultipartUpload(ctx context.Context, kind, key, s3UploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortMultipartUpload", ctx, kind, key, s3UploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortMultipartUpload indicates an expected call of AbortMultipartUpload.
+func (mr *MockStorageRepositoryMockRecorder) AbortMultipartUpload(ctx, kind, key, s3UploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortMultipartUpload", reflect.TypeOf((*MockStorageRepository)(nil).AbortMultipartUpload), ctx, kind, key, s3UploadID)
+}
+
+// CompleteMultipartUpload mocks base method.
+func (m *MockStorageRepository) CompleteMultipartUpload(ctx context.Context, kind, key, s3UploadID string, parts []*repository.UploadedPart) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteMultipartUpload", ctx, kind, key, s3UploadID, parts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteMultipartUpload indicates an expected call of CompleteMultipartUpload.
+func (mr *MockStorageRepositoryMockRecorder) CompleteMultipartUpload(ctx, kind, key, s3UploadID, parts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteMultipartUpload", reflect.TypeOf((*MockStorageRepository)(nil).CompleteMultipartUpload), ctx, kind, key, s3UploadID, parts)
+}
+
+// CreateMultipartUpload mocks base method.
+func (m *MockStorageRepository) CreateMultipartUpload(ctx context.Context, kind, key, contentType string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMultipartUpload", ctx, kind, key, contentType)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMultipartUpload indicates an expected call of CreateMultipartUpload.
+func (mr *MockStorageRepositoryMockRecorder) CreateMultipartUpload(ctx, kind, key, contentType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMultipartUpload", reflect.TypeOf((*MockStorageRepository)(nil).CreateMultipartUpload), ctx, kind, key, contentType)
+}
+
+// DeleteObject mocks base method.
+func (m *MockStorageRepository) DeleteObject(ctx context.Context, kind, key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObject", ctx, kind, key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteObject indicates an expected call of DeleteObject.
+func (mr *MockStorageRepositoryMockRecorder) DeleteObject(ctx, kind, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObject", reflect.TypeOf((*MockStorageRepository)(nil).DeleteObject), ctx, kind, key)
+}
+
+// ListParts mocks base method.
+func (m *MockStorageRepository) ListParts(ctx context.Context, kind, key, s3UploadID string) ([]*repository.UploadedPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListParts", ctx, kind, key, s3UploadID)
+	ret0, _ := ret[0].([]*repository.UploadedPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListParts indicates an expected call of ListParts.
+func (mr *MockStorageRepositoryMockRecorder) ListParts(ctx, kind, key, s3UploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListParts", reflect.TypeOf((*MockStorageRepository)(nil).ListParts), ctx, kind, key, s3UploadID)
+}
+
+// PresignPart mocks base method.
+func (m *MockStorageRepository) PresignPart(ctx context.Context, kind, key, s3UploadID string, partNumber int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PresignPart", ctx, kind, key, s3UploadID, partNumber)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PresignPart indicates an expected call of PresignPart.
+func (mr *MockStorageRepositoryMockRecorder) PresignPart(ctx, kind, key, s3UploadID, partNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PresignPart", reflect.TypeOf((*MockStorageRepository)(nil).PresignPart), ctx, kind, key, s3UploadID, partNumber)
+}
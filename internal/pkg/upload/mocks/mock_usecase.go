@@ -0,0 +1,187 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+//
+// Generated by this command:
+//
+//	mockgen -source=usecase.go -destination=mocks/mock_usecase.go
+//
+
+// Package mock_upload is a generated GoMock package.
+package mock_upload
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	usecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockUsecase is a mock of Usecase interface.
+type MockUsecase struct {
+	ctrl     *gomock.Controller
+	recorder *MockUsecaseMockRecorder
+	isgomock struct{}
+}
+
+// MockUsecaseMockRecorder is the mock recorder for MockUsecase.
+type MockUsecaseMockRecorder struct {
+	mock *MockUsecase
+}
+
+// NewMockUsecase creates a new mock instance.
+func NewMockUsecase(ctrl *gomock.Controller) *MockUsecase {
+	mock := &MockUsecase{ctrl: ctrl}
+	mock.recorder = &MockUsecaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
+	return m.recorder
+}
+
+// AbortUpload mocks base method.
+func (m *MockUsecase) AbortUpload(ctx context.Context, uploadID string, labelID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortUpload", ctx, uploadID, labelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AbortUpload indicates an expected call of AbortUpload.
+func (mr *MockUsecaseMockRecorder) AbortUpload(ctx, uploadID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortUpload", reflect.TypeOf((*MockUsecase)(nil).AbortUpload), ctx, uploadID, labelID)
+}
+
+// CompleteUpload mocks base method.
+func (m *MockUsecase) CompleteUpload(ctx context.Context, uploadID string, labelID int64) (*usecase.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteUpload", ctx, uploadID, labelID)
+	ret0, _ := ret[0].(*usecase.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteUpload indicates an expected call of CompleteUpload.
+func (mr *MockUsecaseMockRecorder) CompleteUpload(ctx, uploadID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteUpload", reflect.TypeOf((*MockUsecase)(nil).CompleteUpload), ctx, uploadID, labelID)
+}
+
+// CreateUpload mocks base method.
+func (m *MockUsecase) CreateUpload(ctx context.Context, request *usecase.CreateUploadRequest) (*usecase.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUpload", ctx, request)
+	ret0, _ := ret[0].(*usecase.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUpload indicates an expected call of CreateUpload.
+func (mr *MockUsecaseMockRecorder) CreateUpload(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUpload", reflect.TypeOf((*MockUsecase)(nil).CreateUpload), ctx, request)
+}
+
+// GetPartURLs mocks base method.
+func (m *MockUsecase) GetPartURLs(ctx context.Context, request *usecase.UploadPartsRequest) ([]*usecase.UploadPart, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPartURLs", ctx, request)
+	ret0, _ := ret[0].([]*usecase.UploadPart)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPartURLs indicates an expected call of GetPartURLs.
+func (mr *MockUsecaseMockRecorder) GetPartURLs(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPartURLs", reflect.TypeOf((*MockUsecase)(nil).GetPartURLs), ctx, request)
+}
+
+// GetUpload mocks base method.
+func (m *MockUsecase) GetUpload(ctx context.Context, uploadID string, labelID int64) (*usecase.Upload, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpload", ctx, uploadID, labelID)
+	ret0, _ := ret[0].(*usecase.Upload)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpload indicates an expected call of GetUpload.
+func (mr *MockUsecaseMockRecorder) GetUpload(ctx, uploadID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpload", reflect.TypeOf((*MockUsecase)(nil).GetUpload), ctx, uploadID, labelID)
+}
+
+// PeekUpload mocks base method.
+func (m *MockUsecase) PeekUpload(ctx context.Context, uploadID string, labelID int64, kind string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PeekUpload", ctx, uploadID, labelID, kind)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PeekUpload indicates an expected call of PeekUpload.
+func (mr *MockUsecaseMockRecorder) PeekUpload(ctx, uploadID, labelID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PeekUpload", reflect.TypeOf((*MockUsecase)(nil).PeekUpload), ctx, uploadID, labelID, kind)
+}
+
+// ReleaseUpload mocks base method.
+func (m *MockUsecase) ReleaseUpload(ctx context.Context, uploadID string, labelID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseUpload", ctx, uploadID, labelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseUpload indicates an expected call of ReleaseUpload.
+func (mr *MockUsecaseMockRecorder) ReleaseUpload(ctx, uploadID, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseUpload", reflect.TypeOf((*MockUsecase)(nil).ReleaseUpload), ctx, uploadID, labelID)
+}
+
+// ResolveUpload mocks base method.
+func (m *MockUsecase) ResolveUpload(ctx context.Context, uploadID string, labelID int64, kind string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveUpload", ctx, uploadID, labelID, kind)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveUpload indicates an expected call of ResolveUpload.
+func (mr *MockUsecaseMockRecorder) ResolveUpload(ctx, uploadID, labelID, kind any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveUpload", reflect.TypeOf((*MockUsecase)(nil).ResolveUpload), ctx, uploadID, labelID, kind)
+}
+
+// RunSweeper mocks base method.
+func (m *MockUsecase) RunSweeper(ctx context.Context, interval time.Duration) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RunSweeper", ctx, interval)
+}
+
+// RunSweeper indicates an expected call of RunSweeper.
+func (mr *MockUsecaseMockRecorder) RunSweeper(ctx, interval any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunSweeper", reflect.TypeOf((*MockUsecase)(nil).RunSweeper), ctx, interval)
+}
+
+// UnclaimUpload mocks base method.
+func (m *MockUsecase) UnclaimUpload(ctx context.Context, uploadID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnclaimUpload", ctx, uploadID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnclaimUpload indicates an expected call of UnclaimUpload.
+func (mr *MockUsecaseMockRecorder) UnclaimUpload(ctx, uploadID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnclaimUpload", reflect.TypeOf((*MockUsecase)(nil).UnclaimUpload), ctx, uploadID)
+}
@@ -0,0 +1,30 @@
+package upload
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+)
+
+// Repository keeps upload sessions between the requests of a resumable upload
+type Repository interface {
+	SaveUpload(ctx context.Context, upload *repository.Upload, ttl time.Duration) error
+	GetUpload(ctx context.Context, uploadID string) (*repository.Upload, error)
+	MarkCompleted(ctx context.Context, uploadID string) error
+	ClaimUpload(ctx context.Context, uploadID string) (bool, error)
+	UnclaimUpload(ctx context.Context, uploadID string) error
+	DeleteUpload(ctx context.Context, uploadID string) error
+	GetUploadsCreatedBefore(ctx context.Context, before time.Time) ([]*repository.Upload, error)
+	AcquireSweeperLock(ctx context.Context, ttl time.Duration) (bool, error)
+}
+
+// StorageRepository talks to the object storage, kind selects the bucket
+type StorageRepository interface {
+	CreateMultipartUpload(ctx context.Context, kind string, key string, contentType string) (string, error)
+	PresignPart(ctx context.Context, kind string, key string, s3UploadID string, partNumber int64) (string, error)
+	ListParts(ctx context.Context, kind string, key string, s3UploadID string) ([]*repository.UploadedPart, error)
+	CompleteMultipartUpload(ctx context.Context, kind string, key string, s3UploadID string, parts []*repository.UploadedPart) error
+	AbortMultipartUpload(ctx context.Context, kind string, key string, s3UploadID string) error
+	DeleteObject(ctx context.Context, kind string, key string) error
+}
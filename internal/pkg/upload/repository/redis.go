@@ -0,0 +1,248 @@
+package repository
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload"
+	"github.com/gomodule/redigo/redis"
+	"go.uber.org/zap"
+)
+
+type uploadRedisRepository struct {
+	redisPool *redis.Pool
+	now       func() time.Time
+}
+
+func (r *uploadRedisRepository) getConn() (redis.Conn, error) {
+	conn := r.redisPool.Get()
+	if err := conn.Err(); err != nil {
+		return nil, err
+	}
+	return conn, nil
+}
+
+func NewUploadRedisRepository(redisPool *redis.Pool) upload.Repository {
+	return &uploadRedisRepository{redisPool: redisPool, now: time.Now}
+}
+
+// uploadsCreatedKey orders sessions by the time they were created. What a session holds in the
+// storage is kept apart from it without a ttl, so an expired session can still be cleaned up.
+const uploadsCreatedKey = "uploads:created"
+
+func uploadKey(uploadID string) string {
+	return "upload:" + uploadID
+}
+
+func uploadStorageKey(uploadID string) string {
+	return "upload:" + uploadID + ":storage"
+}
+
+func (r *uploadRedisRepository) closeConn(ctx context.Context, conn redis.Conn) {
+	if err := conn.Close(); err != nil {
+		loggerPkg.LoggerFromContext(ctx).Error("Error closing connection:", zap.Error(err))
+	}
+}
+
+func (r *uploadRedisRepository) SaveUpload(ctx context.Context, upload *repoModel.Upload, ttl time.Duration) error {
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer r.closeConn(ctx, conn)
+
+	key := uploadKey(upload.ID)
+	_, err = redis.DoContext(conn, ctx, "HSET", key,
+		"label_id", upload.LabelID,
+		"kind", upload.Kind,
+		"key", upload.Key,
+		"s3_upload_id", upload.S3UploadID,
+		"size", upload.Size,
+		"content_type", upload.ContentType,
+		"part_size", upload.PartSize,
+		"status", upload.Status,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = redis.DoContext(conn, ctx, "EXPIRE", key, int64(ttl.Seconds()))
+	if err != nil {
+		return err
+	}
+
+	_, err = redis.DoContext(conn, ctx, "HSET", uploadStorageKey(upload.ID),
+		"kind", upload.Kind,
+		"key", upload.Key,
+		"s3_upload_id", upload.S3UploadID,
+	)
+	if err != nil {
+		return err
+	}
+	_, err = redis.DoContext(conn, ctx, "ZADD", uploadsCreatedKey, r.now().UnixMilli(), upload.ID)
+	return err
+}
+
+func (r *uploadRedisRepository) GetUpload(ctx context.Context, uploadID string) (*repoModel.Upload, error) {
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer r.closeConn(ctx, conn)
+
+	fields, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", uploadKey(uploadID)))
+	if err != nil {
+		return nil, err
+	}
+	if len(fields) == 0 {
+		return nil, customErrors.ErrUploadNotFound
+	}
+
+	labelID, err := strconv.ParseInt(fields["label_id"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	size, err := strconv.ParseInt(fields["size"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	partSize, err := strconv.ParseInt(fields["part_size"], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &repoModel.Upload{
+		ID:          uploadID,
+		LabelID:     labelID,
+		Kind:        fields["kind"],
+		Key:         fields["key"],
+		S3UploadID:  fields["s3_upload_id"],
+		Size:        size,
+		ContentType: fields["content_type"],
+		PartSize:    partSize,
+		Status:      fields["status"],
+	}, nil
+}
+
+func (r *uploadRedisRepository) MarkCompleted(ctx context.Context, uploadID string) error {
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer r.closeConn(ctx, conn)
+
+	// HSET on an expired session would bring back a hash without a ttl
+	exists, err := redis.Bool(redis.DoContext(conn, ctx, "EXISTS", uploadKey(uploadID)))
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return customErrors.ErrUploadNotFound
+	}
+
+	_, err = redis.DoContext(conn, ctx, "HSET", uploadKey(uploadID), "status", "completed")
+	return err
+}
+
+// claimUpload marks a session as taken by a release. A session that expired is not brought
+// back as a hash without a ttl, it returns -1 instead.
+const claimUploadSource = `
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	return -1
+end
+return redis.call('HSETNX', KEYS[1], 'claimed', '1')
+`
+
+var claimUpload = redis.NewScript(1, claimUploadSource)
+
+// ClaimUpload returns false when another release has claimed the upload already
+func (r *uploadRedisRepository) ClaimUpload(ctx context.Context, uploadID string) (bool, error) {
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer r.closeConn(ctx, conn)
+
+	claimed, err := redis.Int(claimUpload.DoContext(ctx, conn, uploadKey(uploadID)))
+	if err != nil {
+		return false, err
+	}
+	if claimed < 0 {
+		return false, customErrors.ErrUploadNotFound
+	}
+	return claimed == 1, nil
+}
+
+func (r *uploadRedisRepository) UnclaimUpload(ctx context.Context, uploadID string) error {
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer r.closeConn(ctx, conn)
+
+	_, err = redis.DoContext(conn, ctx, "HDEL", uploadKey(uploadID), "claimed")
+	return err
+}
+
+func (r *uploadRedisRepository) DeleteUpload(ctx context.Context, uploadID string) error {
+	conn, err := r.getConn()
+	if err != nil {
+		return err
+	}
+	defer r.closeConn(ctx, conn)
+
+	_, err = redis.DoContext(conn, ctx, "DEL", uploadKey(uploadID), uploadStorageKey(uploadID))
+	if err != nil {
+		return err
+	}
+	_, err = redis.DoContext(conn, ctx, "ZREM", uploadsCreatedKey, uploadID)
+	return err
+}
+
+// GetUploadsCreatedBefore returns what the sessions created before the given time hold in the
+// storage, the sessions themselves may have expired already
+func (r *uploadRedisRepository) GetUploadsCreatedBefore(ctx context.Context, before time.Time) ([]*repoModel.Upload, error) {
+	conn, err := r.getConn()
+	if err != nil {
+		return nil, err
+	}
+	defer r.closeConn(ctx, conn)
+
+	uploadIDs, err := redis.Strings(redis.DoContext(conn, ctx, "ZRANGEBYSCORE", uploadsCreatedKey, "-inf", "("+strconv.FormatInt(before.UnixMilli(), 10)))
+	if err != nil {
+		return nil, err
+	}
+
+	uploads := make([]*repoModel.Upload, 0, len(uploadIDs))
+	for _, uploadID := range uploadIDs {
+		fields, err := redis.StringMap(redis.DoContext(conn, ctx, "HGETALL", uploadStorageKey(uploadID)))
+		if err != nil {
+			return nil, err
+		}
+		uploads = append(uploads, &repoModel.Upload{
+			ID:         uploadID,
+			Kind:       fields["kind"],
+			Key:        fields["key"],
+			S3UploadID: fields["s3_upload_id"],
+		})
+	}
+	return uploads, nil
+}
+
+// AcquireSweeperLock makes sure only one gateway sweeps expired uploads per interval
+func (r *uploadRedisRepository) AcquireSweeperLock(ctx context.Context, ttl time.Duration) (bool, error) {
+	conn, err := r.getConn()
+	if err != nil {
+		return false, err
+	}
+	defer r.closeConn(ctx, conn)
+
+	reply, err := redis.DoContext(conn, ctx, "SET", "uploads:sweeper:lock", "1", "NX", "PX", ttl.Milliseconds())
+	if err != nil {
+		return false, err
+	}
+	return reply != nil, nil
+}
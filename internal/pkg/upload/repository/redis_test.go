@@ -0,0 +1,243 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/gomodule/redigo/redis"
+	"github.com/rafaeljusto/redigomock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var testNow = time.UnixMilli(1700000000000)
+
+func setupMockRedis() (*uploadRedisRepository, *redigomock.Conn) {
+	conn := redigomock.NewConn()
+	pool := &redis.Pool{
+		Dial: func() (redis.Conn, error) {
+			return conn, nil
+		},
+	}
+	repo := &uploadRedisRepository{redisPool: pool, now: func() time.Time { return testNow }}
+	return repo, conn
+}
+
+func setupTestContext() context.Context {
+	return loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+}
+
+func testUpload() *repoModel.Upload {
+	return &repoModel.Upload{
+		ID:          "upload",
+		LabelID:     3,
+		Kind:        "track",
+		Key:         "upload.mp3",
+		S3UploadID:  "s3-upload",
+		Size:        100,
+		ContentType: "audio/mpeg",
+		PartSize:    64,
+		Status:      "pending",
+	}
+}
+
+func TestSaveUpload(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	hset := mockConn.Command("HSET", "upload:upload",
+		"label_id", int64(3),
+		"kind", "track",
+		"key", "upload.mp3",
+		"s3_upload_id", "s3-upload",
+		"size", int64(100),
+		"content_type", "audio/mpeg",
+		"part_size", int64(64),
+		"status", "pending",
+	).Expect(int64(8))
+	expire := mockConn.Command("EXPIRE", "upload:upload", int64(3600)).Expect(int64(1))
+	storage := mockConn.Command("HSET", "upload:upload:storage",
+		"kind", "track",
+		"key", "upload.mp3",
+		"s3_upload_id", "s3-upload",
+	).Expect(int64(3))
+	created := mockConn.Command("ZADD", "uploads:created", testNow.UnixMilli(), "upload").Expect(int64(1))
+
+	err := repo.SaveUpload(ctx, testUpload(), time.Hour)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(hset))
+	assert.Equal(t, 1, mockConn.Stats(expire))
+	assert.Equal(t, 1, mockConn.Stats(storage))
+	assert.Equal(t, 1, mockConn.Stats(created))
+}
+
+func TestSaveUploadError(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.GenericCommand("HSET").ExpectError(errors.New("redis error"))
+
+	err := repo.SaveUpload(ctx, testUpload(), time.Hour)
+
+	assert.Error(t, err)
+}
+
+func TestGetUpload(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("HGETALL", "upload:upload").ExpectMap(map[string]string{
+		"label_id":     "3",
+		"kind":         "track",
+		"key":          "upload.mp3",
+		"s3_upload_id": "s3-upload",
+		"size":         "100",
+		"content_type": "audio/mpeg",
+		"part_size":    "64",
+		"status":       "pending",
+	})
+
+	upload, err := repo.GetUpload(ctx, "upload")
+
+	require.NoError(t, err)
+	assert.Equal(t, testUpload(), upload)
+}
+
+func TestGetUploadNotFound(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("HGETALL", "upload:missing").Expect([]interface{}{})
+
+	upload, err := repo.GetUpload(ctx, "missing")
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadNotFound)
+	assert.Nil(t, upload)
+}
+
+func TestMarkCompleted(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("EXISTS", "upload:upload").Expect(int64(1))
+	hset := mockConn.Command("HSET", "upload:upload", "status", "completed").Expect(int64(0))
+
+	err := repo.MarkCompleted(ctx, "upload")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(hset))
+}
+
+func TestMarkCompletedExpired(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("EXISTS", "upload:upload").Expect(int64(0))
+	hset := mockConn.Command("HSET", "upload:upload", "status", "completed").Expect(int64(1))
+
+	err := repo.MarkCompleted(ctx, "upload")
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadNotFound)
+	assert.Equal(t, 0, mockConn.Stats(hset))
+}
+
+func TestClaimUpload(t *testing.T) {
+	tests := []struct {
+		name    string
+		reply   int64
+		claimed bool
+		err     error
+	}{
+		{name: "free", reply: 1, claimed: true},
+		{name: "claimed by another release", reply: 0},
+		{name: "expired", reply: -1, err: customErrors.ErrUploadNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mockConn := setupMockRedis()
+			ctx := setupTestContext()
+
+			mockConn.Script([]byte(claimUploadSource), 1, "upload:upload").Expect(tt.reply)
+
+			claimed, err := repo.ClaimUpload(ctx, "upload")
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.claimed, claimed)
+		})
+	}
+}
+
+func TestUnclaimUpload(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	hdel := mockConn.Command("HDEL", "upload:upload", "claimed").Expect(int64(1))
+
+	err := repo.UnclaimUpload(ctx, "upload")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(hdel))
+}
+
+func TestDeleteUpload(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	del := mockConn.Command("DEL", "upload:upload", "upload:upload:storage").Expect(int64(2))
+	zrem := mockConn.Command("ZREM", "uploads:created", "upload").Expect(int64(1))
+
+	err := repo.DeleteUpload(ctx, "upload")
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, mockConn.Stats(del))
+	assert.Equal(t, 1, mockConn.Stats(zrem))
+}
+
+func TestGetUploadsCreatedBefore(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("ZRANGEBYSCORE", "uploads:created", "-inf", "(1700000000000").Expect([]interface{}{[]byte("upload")})
+	mockConn.Command("HGETALL", "upload:upload:storage").Expect([]interface{}{
+		[]byte("kind"), []byte("track"),
+		[]byte("key"), []byte("upload.mp3"),
+		[]byte("s3_upload_id"), []byte("s3-upload"),
+	})
+
+	uploads, err := repo.GetUploadsCreatedBefore(ctx, testNow)
+
+	require.NoError(t, err)
+	assert.Equal(t, []*repoModel.Upload{{ID: "upload", Kind: "track", Key: "upload.mp3", S3UploadID: "s3-upload"}}, uploads)
+}
+
+func TestAcquireSweeperLock(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("SET", "uploads:sweeper:lock", "1", "NX", "PX", int64(300000)).Expect("OK")
+
+	locked, err := repo.AcquireSweeperLock(ctx, 5*time.Minute)
+
+	require.NoError(t, err)
+	assert.True(t, locked)
+}
+
+func TestAcquireSweeperLockHeld(t *testing.T) {
+	repo, mockConn := setupMockRedis()
+	ctx := setupTestContext()
+
+	mockConn.Command("SET", "uploads:sweeper:lock", "1", "NX", "PX", int64(300000)).Expect(nil)
+
+	locked, err := repo.AcquireSweeperLock(ctx, 5*time.Minute)
+
+	require.NoError(t, err)
+	assert.False(t, locked)
+}
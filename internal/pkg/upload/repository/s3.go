@@ -0,0 +1,150 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload"
+	"go.uber.org/zap"
+)
+
+type uploadS3Repository struct {
+	s3                *s3.S3
+	trackBucketName   string
+	imageBucketName   string
+	partURLExpiration time.Duration
+}
+
+func NewUploadS3Repository(s3 *s3.S3, trackBucketName string, imageBucketName string, partURLExpiration time.Duration) upload.StorageRepository {
+	return &uploadS3Repository{
+		s3:                s3,
+		trackBucketName:   trackBucketName,
+		imageBucketName:   imageBucketName,
+		partURLExpiration: partURLExpiration,
+	}
+}
+
+// tracks land in the bucket they are streamed from, covers are staged next to
+// the images and copied into place by the album and track services
+func (r *uploadS3Repository) bucket(kind string) string {
+	if kind == usecase.UploadKindCover {
+		return r.imageBucketName
+	}
+	return r.trackBucketName
+}
+
+func uploadError(err error) error {
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == s3.ErrCodeNoSuchUpload {
+		return customErrors.ErrUploadNotFound
+	}
+	return err
+}
+
+func (r *uploadS3Repository) CreateMultipartUpload(ctx context.Context, kind string, key string, contentType string) (string, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	output, err := r.s3.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		Bucket:      aws.String(r.bucket(kind)),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+	})
+	if err != nil {
+		logger.Error("failed to create multipart upload", zap.String("key", key), zap.Error(err))
+		return "", err
+	}
+	return aws.StringValue(output.UploadId), nil
+}
+
+func (r *uploadS3Repository) PresignPart(ctx context.Context, kind string, key string, s3UploadID string, partNumber int64) (string, error) {
+	req, _ := r.s3.UploadPartRequest(&s3.UploadPartInput{
+		Bucket:     aws.String(r.bucket(kind)),
+		Key:        aws.String(key),
+		UploadId:   aws.String(s3UploadID),
+		PartNumber: aws.Int64(partNumber),
+	})
+	req.SetContext(ctx)
+	url, err := req.Presign(r.partURLExpiration)
+	if err != nil {
+		loggerPkg.LoggerFromContext(ctx).Error("failed to presign upload part", zap.String("key", key), zap.Error(err))
+		return "", err
+	}
+	return url, nil
+}
+
+func (r *uploadS3Repository) ListParts(ctx context.Context, kind string, key string, s3UploadID string) ([]*repoModel.UploadedPart, error) {
+	parts := make([]*repoModel.UploadedPart, 0)
+	err := r.s3.ListPartsPagesWithContext(ctx, &s3.ListPartsInput{
+		Bucket:   aws.String(r.bucket(kind)),
+		Key:      aws.String(key),
+		UploadId: aws.String(s3UploadID),
+	}, func(page *s3.ListPartsOutput, lastPage bool) bool {
+		for _, part := range page.Parts {
+			parts = append(parts, &repoModel.UploadedPart{
+				Number: aws.Int64Value(part.PartNumber),
+				ETag:   aws.StringValue(part.ETag),
+				Size:   aws.Int64Value(part.Size),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		loggerPkg.LoggerFromContext(ctx).Error("failed to list upload parts", zap.String("key", key), zap.Error(err))
+		return nil, uploadError(err)
+	}
+	return parts, nil
+}
+
+func (r *uploadS3Repository) CompleteMultipartUpload(ctx context.Context, kind string, key string, s3UploadID string, parts []*repoModel.UploadedPart) error {
+	completed := make([]*s3.CompletedPart, 0, len(parts))
+	for _, part := range parts {
+		completed = append(completed, &s3.CompletedPart{
+			ETag:       aws.String(part.ETag),
+			PartNumber: aws.Int64(part.Number),
+		})
+	}
+
+	_, err := r.s3.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+		Bucket:          aws.String(r.bucket(kind)),
+		Key:             aws.String(key),
+		UploadId:        aws.String(s3UploadID),
+		MultipartUpload: &s3.CompletedMultipartUpload{Parts: completed},
+	})
+	if err != nil {
+		loggerPkg.LoggerFromContext(ctx).Error("failed to complete multipart upload", zap.String("key", key), zap.Error(err))
+		return uploadError(err)
+	}
+	return nil
+}
+
+func (r *uploadS3Repository) AbortMultipartUpload(ctx context.Context, kind string, key string, s3UploadID string) error {
+	_, err := r.s3.AbortMultipartUploadWithContext(ctx, &s3.AbortMultipartUploadInput{
+		Bucket:   aws.String(r.bucket(kind)),
+		Key:      aws.String(key),
+		UploadId: aws.String(s3UploadID),
+	})
+	if err != nil {
+		loggerPkg.LoggerFromContext(ctx).Error("failed to abort multipart upload", zap.String("key", key), zap.Error(err))
+		return uploadError(err)
+	}
+	return nil
+}
+
+func (r *uploadS3Repository) DeleteObject(ctx context.Context, kind string, key string) error {
+	_, err := r.s3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(r.bucket(kind)),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		loggerPkg.LoggerFromContext(ctx).Error("failed to delete object", zap.String("key", key), zap.Error(err))
+		return err
+	}
+	return nil
+}
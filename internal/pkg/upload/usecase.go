@@ -0,0 +1,21 @@
+package upload
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+)
+
+type Usecase interface {
+	CreateUpload(ctx context.Context, request *usecase.CreateUploadRequest) (*usecase.Upload, error)
+	GetUpload(ctx context.Context, uploadID string, labelID int64) (*usecase.Upload, error)
+	GetPartURLs(ctx context.Context, request *usecase.UploadPartsRequest) ([]*usecase.UploadPart, error)
+	CompleteUpload(ctx context.Context, uploadID string, labelID int64) (*usecase.Upload, error)
+	AbortUpload(ctx context.Context, uploadID string, labelID int64) error
+	ResolveUpload(ctx context.Context, uploadID string, labelID int64, kind string) (string, error)
+	PeekUpload(ctx context.Context, uploadID string, labelID int64, kind string) (string, error)
+	UnclaimUpload(ctx context.Context, uploadID string) error
+	ReleaseUpload(ctx context.Context, uploadID string, labelID int64) error
+	RunSweeper(ctx context.Context, interval time.Duration)
+}
@@ -0,0 +1,423 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// S3 refuses to assemble an object out of more parts than this
+const maxUploadParts = 10000
+
+// sweepGrace leaves a release that resolved its uploads right before they expired the time to release them
+const sweepGrace = 10 * time.Minute
+
+var allowedContentTypes = map[string]map[string]bool{
+	usecaseModel.UploadKindTrack: {
+		"audio/mpeg":   true,
+		"audio/mp3":    true,
+		"audio/flac":   true,
+		"audio/x-flac": true,
+		"audio/wav":    true,
+		"audio/x-wav":  true,
+		"audio/wave":   true,
+		"audio/ogg":    true,
+	},
+	usecaseModel.UploadKindCover: {
+		"image/jpeg": true,
+		"image/png":  true,
+		"image/gif":  true,
+		"image/webp": true,
+	},
+}
+
+// trackExtensions only name the object, the track service detects the real format itself
+var trackExtensions = map[string]string{
+	"audio/mpeg":   ".mp3",
+	"audio/mp3":    ".mp3",
+	"audio/flac":   ".flac",
+	"audio/x-flac": ".flac",
+	"audio/wav":    ".wav",
+	"audio/x-wav":  ".wav",
+	"audio/wave":   ".wav",
+	"audio/ogg":    ".ogg",
+}
+
+func NewUsecase(uploadRepository upload.Repository, storageRepository upload.StorageRepository, cfg config.UploadConfig) upload.Usecase {
+	return &uploadUsecase{
+		uploadRepository:  uploadRepository,
+		storageRepository: storageRepository,
+		cfg:               cfg,
+		newID:             func() string { return uuid.New().String() },
+	}
+}
+
+type uploadUsecase struct {
+	uploadRepository  upload.Repository
+	storageRepository upload.StorageRepository
+	cfg               config.UploadConfig
+	newID             func() string
+}
+
+func (u *uploadUsecase) maxSize(kind string) int64 {
+	if kind == usecaseModel.UploadKindCover {
+		return u.cfg.CoverMaxSize
+	}
+	return u.cfg.TrackMaxSize
+}
+
+func partsCount(upload *repository.Upload) int64 {
+	return (upload.Size + upload.PartSize - 1) / upload.PartSize
+}
+
+// objectKey keeps the uploaded track where it will be transcoded from, covers are
+// only staged because the album and track services copy them under their own names
+func objectKey(kind string, uploadID string, contentType string) string {
+	if kind == usecaseModel.UploadKindCover {
+		return "uploads/" + uploadID
+	}
+	return uploadID + trackExtensions[contentType]
+}
+
+// embeddedCoverKey is where the track service stages the cover it reads from the tags of a track upload
+func embeddedCoverKey(uploadID string) string {
+	return "uploads/" + uploadID + "-cover"
+}
+
+func uploadFromRepository(upload *repository.Upload, uploadedParts []int64) *usecaseModel.Upload {
+	return &usecaseModel.Upload{
+		ID:            upload.ID,
+		Kind:          upload.Kind,
+		Size:          upload.Size,
+		PartSize:      upload.PartSize,
+		PartsCount:    partsCount(upload),
+		Status:        upload.Status,
+		UploadedParts: uploadedParts,
+	}
+}
+
+func allParts(count int64) []int64 {
+	parts := make([]int64, 0, count)
+	for number := int64(1); number <= count; number++ {
+		parts = append(parts, number)
+	}
+	return parts
+}
+
+// getOwnedUpload hides uploads of other labels behind not found
+func (u *uploadUsecase) getOwnedUpload(ctx context.Context, uploadID string, labelID int64) (*repository.Upload, error) {
+	upload, err := u.uploadRepository.GetUpload(ctx, uploadID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.LabelID != labelID {
+		return nil, customErrors.ErrUploadNotFound
+	}
+	return upload, nil
+}
+
+func (u *uploadUsecase) uploadedParts(ctx context.Context, upload *repository.Upload) ([]*repository.UploadedPart, error) {
+	return u.storageRepository.ListParts(ctx, upload.Kind, upload.Key, upload.S3UploadID)
+}
+
+func (u *uploadUsecase) CreateUpload(ctx context.Context, request *usecaseModel.CreateUploadRequest) (*usecaseModel.Upload, error) {
+	contentTypes, ok := allowedContentTypes[request.Kind]
+	if !ok {
+		return nil, customErrors.ErrUploadInvalidKind
+	}
+	if !contentTypes[request.ContentType] {
+		return nil, customErrors.ErrUploadInvalidContentType
+	}
+	if request.Size <= 0 {
+		return nil, customErrors.ErrUploadInvalidSize
+	}
+	if request.Size > u.maxSize(request.Kind) {
+		return nil, customErrors.ErrUploadTooLarge
+	}
+
+	upload := &repository.Upload{
+		ID:          u.newID(),
+		LabelID:     request.LabelID,
+		Kind:        request.Kind,
+		Size:        request.Size,
+		ContentType: request.ContentType,
+		PartSize:    u.cfg.PartSize,
+		Status:      usecaseModel.UploadStatusPending,
+	}
+	if partsCount(upload) > maxUploadParts {
+		return nil, customErrors.ErrUploadTooLarge
+	}
+	upload.Key = objectKey(upload.Kind, upload.ID, upload.ContentType)
+
+	s3UploadID, err := u.storageRepository.CreateMultipartUpload(ctx, upload.Kind, upload.Key, upload.ContentType)
+	if err != nil {
+		return nil, err
+	}
+	upload.S3UploadID = s3UploadID
+
+	if err := u.uploadRepository.SaveUpload(ctx, upload, u.cfg.TTL); err != nil {
+		if abortErr := u.storageRepository.AbortMultipartUpload(ctx, upload.Kind, upload.Key, s3UploadID); abortErr != nil {
+			loggerPkg.LoggerFromContext(ctx).Warn("failed to abort orphaned multipart upload", zap.Error(abortErr), zap.String("key", upload.Key))
+		}
+		return nil, err
+	}
+
+	return uploadFromRepository(upload, []int64{}), nil
+}
+
+func (u *uploadUsecase) GetUpload(ctx context.Context, uploadID string, labelID int64) (*usecaseModel.Upload, error) {
+	upload, err := u.getOwnedUpload(ctx, uploadID, labelID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Status == usecaseModel.UploadStatusCompleted {
+		return uploadFromRepository(upload, allParts(partsCount(upload))), nil
+	}
+
+	parts, err := u.uploadedParts(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	numbers := make([]int64, 0, len(parts))
+	for _, part := range parts {
+		numbers = append(numbers, part.Number)
+	}
+	return uploadFromRepository(upload, numbers), nil
+}
+
+// GetPartURLs signs the requested parts, or every part the storage does not have yet
+// so a client that lost its state can resume with a single call
+func (u *uploadUsecase) GetPartURLs(ctx context.Context, request *usecaseModel.UploadPartsRequest) ([]*usecaseModel.UploadPart, error) {
+	upload, err := u.getOwnedUpload(ctx, request.UploadID, request.LabelID)
+	if err != nil {
+		return nil, err
+	}
+	if upload.Status == usecaseModel.UploadStatusCompleted {
+		return nil, customErrors.ErrUploadAlreadyCompleted
+	}
+
+	count := partsCount(upload)
+	numbers := request.Parts
+	if len(numbers) == 0 {
+		parts, err := u.uploadedParts(ctx, upload)
+		if err != nil {
+			return nil, err
+		}
+		uploaded := make(map[int64]bool, len(parts))
+		for _, part := range parts {
+			uploaded[part.Number] = true
+		}
+		for _, number := range allParts(count) {
+			if !uploaded[number] {
+				numbers = append(numbers, number)
+			}
+		}
+	}
+
+	urls := make([]*usecaseModel.UploadPart, 0, len(numbers))
+	for _, number := range numbers {
+		if number < 1 || number > count {
+			return nil, customErrors.ErrUploadInvalidPart
+		}
+		url, err := u.storageRepository.PresignPart(ctx, upload.Kind, upload.Key, upload.S3UploadID, number)
+		if err != nil {
+			return nil, err
+		}
+		urls = append(urls, &usecaseModel.UploadPart{Number: number, URL: url})
+	}
+	return urls, nil
+}
+
+// CompleteUpload assembles the object once every part is in place. The declared size
+// is checked against the parts because presigned URLs do not limit the body size.
+func (u *uploadUsecase) CompleteUpload(ctx context.Context, uploadID string, labelID int64) (*usecaseModel.Upload, error) {
+	upload, err := u.getOwnedUpload(ctx, uploadID, labelID)
+	if err != nil {
+		return nil, err
+	}
+	count := partsCount(upload)
+	if upload.Status == usecaseModel.UploadStatusCompleted {
+		return uploadFromRepository(upload, allParts(count)), nil
+	}
+
+	parts, err := u.uploadedParts(ctx, upload)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(parts)) != count {
+		return nil, customErrors.ErrUploadIncomplete
+	}
+	var size int64
+	for i, part := range parts {
+		if part.Number != int64(i+1) {
+			return nil, customErrors.ErrUploadIncomplete
+		}
+		size += part.Size
+	}
+	if size != upload.Size {
+		return nil, customErrors.ErrUploadIncomplete
+	}
+
+	if err := u.storageRepository.CompleteMultipartUpload(ctx, upload.Kind, upload.Key, upload.S3UploadID, parts); err != nil {
+		return nil, err
+	}
+	if err := u.uploadRepository.MarkCompleted(ctx, upload.ID); err != nil {
+		return nil, err
+	}
+
+	upload.Status = usecaseModel.UploadStatusCompleted
+	return uploadFromRepository(upload, allParts(count)), nil
+}
+
+func (u *uploadUsecase) AbortUpload(ctx context.Context, uploadID string, labelID int64) error {
+	upload, err := u.getOwnedUpload(ctx, uploadID, labelID)
+	if err != nil {
+		return err
+	}
+
+	if upload.Status == usecaseModel.UploadStatusCompleted {
+		err = u.deleteObjects(ctx, upload)
+	} else {
+		err = u.storageRepository.AbortMultipartUpload(ctx, upload.Kind, upload.Key, upload.S3UploadID)
+	}
+	// the storage may have already dropped an upload that was left for too long
+	if err != nil && err != customErrors.ErrUploadNotFound {
+		return err
+	}
+
+	return u.uploadRepository.DeleteUpload(ctx, upload.ID)
+}
+
+// ResolveUpload returns the object key of a completed upload of the given kind and claims it,
+// so that two releases can't be made from the same file. The claim holds until ReleaseUpload
+// or UnclaimUpload.
+func (u *uploadUsecase) ResolveUpload(ctx context.Context, uploadID string, labelID int64, kind string) (string, error) {
+	key, err := u.PeekUpload(ctx, uploadID, labelID, kind)
+	if err != nil {
+		return "", err
+	}
+	claimed, err := u.uploadRepository.ClaimUpload(ctx, uploadID)
+	if err != nil {
+		return "", err
+	}
+	if !claimed {
+		return "", customErrors.ErrUploadInUse
+	}
+	return key, nil
+}
+
+// PeekUpload returns the object key of a completed upload of the given kind without claiming it
+func (u *uploadUsecase) PeekUpload(ctx context.Context, uploadID string, labelID int64, kind string) (string, error) {
+	upload, err := u.getOwnedUpload(ctx, uploadID, labelID)
+	if err != nil {
+		return "", err
+	}
+	if upload.Kind != kind {
+		return "", customErrors.ErrUploadInvalidKind
+	}
+	if upload.Status != usecaseModel.UploadStatusCompleted {
+		return "", customErrors.ErrUploadIncomplete
+	}
+	return upload.Key, nil
+}
+
+// UnclaimUpload gives an upload back after the release that claimed it failed
+func (u *uploadUsecase) UnclaimUpload(ctx context.Context, uploadID string) error {
+	return u.uploadRepository.UnclaimUpload(ctx, uploadID)
+}
+
+// ReleaseUpload forgets an upload that has been turned into a release. Tracks stay
+// where they were uploaded, staged covers are removed once the services copied them.
+func (u *uploadUsecase) ReleaseUpload(ctx context.Context, uploadID string, labelID int64) error {
+	upload, err := u.uploadRepository.GetUpload(ctx, uploadID)
+	if err == customErrors.ErrUploadNotFound {
+		// the session expired while the release was created, the sweeper must not take its objects
+		return u.uploadRepository.DeleteUpload(ctx, uploadID)
+	}
+	if err != nil {
+		return err
+	}
+	if upload.LabelID != labelID {
+		return customErrors.ErrUploadNotFound
+	}
+	if upload.Kind == usecaseModel.UploadKindCover {
+		if err := u.storageRepository.DeleteObject(ctx, upload.Kind, upload.Key); err != nil {
+			return err
+		}
+	}
+	return u.uploadRepository.DeleteUpload(ctx, upload.ID)
+}
+
+// RunSweeper cleans up after expired uploads right away and then every interval until ctx is done
+func (u *uploadUsecase) RunSweeper(ctx context.Context, interval time.Duration) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := u.sweepExpired(ctx, interval); err != nil {
+			logger.Error("failed to sweep expired uploads", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// sweepExpired removes what uploads that were neither released nor aborted before their
+// session expired left in the storage. Only one gateway sweeps per interval.
+func (u *uploadUsecase) sweepExpired(ctx context.Context, interval time.Duration) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	locked, err := u.uploadRepository.AcquireSweeperLock(ctx, interval/2)
+	if err != nil {
+		return err
+	}
+	if !locked {
+		return nil
+	}
+
+	uploads, err := u.uploadRepository.GetUploadsCreatedBefore(ctx, time.Now().Add(-u.cfg.TTL-sweepGrace))
+	if err != nil {
+		return err
+	}
+	for _, upload := range uploads {
+		// the session is gone with its status, a completed upload has no multipart upload left
+		// to abort and an unfinished one has no object to delete
+		err := u.storageRepository.AbortMultipartUpload(ctx, upload.Kind, upload.Key, upload.S3UploadID)
+		if err == nil || err == customErrors.ErrUploadNotFound {
+			err = u.deleteObjects(ctx, upload)
+		}
+		if err != nil {
+			logger.Error("failed to remove expired upload", zap.String("upload_id", upload.ID), zap.Error(err))
+			continue
+		}
+		if err := u.uploadRepository.DeleteUpload(ctx, upload.ID); err != nil {
+			logger.Error("failed to forget expired upload", zap.String("upload_id", upload.ID), zap.Error(err))
+		}
+	}
+	return nil
+}
+
+// deleteObjects removes an assembled upload together with the cover the track service
+// may have staged from its tags, deleting a missing object is not an error
+func (u *uploadUsecase) deleteObjects(ctx context.Context, upload *repository.Upload) error {
+	if err := u.storageRepository.DeleteObject(ctx, upload.Kind, upload.Key); err != nil {
+		return err
+	}
+	if upload.Kind != usecaseModel.UploadKindTrack {
+		return nil
+	}
+	return u.storageRepository.DeleteObject(ctx, usecaseModel.UploadKindCover, embeddedCoverKey(upload.ID))
+}
@@ -0,0 +1,489 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
+	mock_upload "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/upload/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+var testCfg = config.UploadConfig{
+	PartSize:     64,
+	TrackMaxSize: 1000,
+	CoverMaxSize: 100,
+	TTL:          time.Hour,
+}
+
+func setupTest(t *testing.T) (*mock_upload.MockRepository, *mock_upload.MockStorageRepository, *uploadUsecase, context.Context) {
+	ctrl := gomock.NewController(t)
+	mockRepo := mock_upload.NewMockRepository(ctrl)
+	mockStorage := mock_upload.NewMockStorageRepository(ctrl)
+
+	u := NewUsecase(mockRepo, mockStorage, testCfg).(*uploadUsecase)
+	u.newID = func() string { return "upload" }
+
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+	return mockRepo, mockStorage, u, ctx
+}
+
+// pendingTrack is a 100 byte track split into two parts
+func pendingTrack() *repository.Upload {
+	return &repository.Upload{
+		ID:          "upload",
+		LabelID:     3,
+		Kind:        usecaseModel.UploadKindTrack,
+		Key:         "upload.mp3",
+		S3UploadID:  "s3-upload",
+		Size:        100,
+		ContentType: "audio/mpeg",
+		PartSize:    64,
+		Status:      usecaseModel.UploadStatusPending,
+	}
+}
+
+func TestCreateUpload(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+
+	mockStorage.EXPECT().CreateMultipartUpload(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "audio/mpeg").Return("s3-upload", nil)
+	mockRepo.EXPECT().SaveUpload(ctx, pendingTrack(), time.Hour).Return(nil)
+
+	upload, err := u.CreateUpload(ctx, &usecaseModel.CreateUploadRequest{
+		LabelID:     3,
+		Kind:        usecaseModel.UploadKindTrack,
+		Size:        100,
+		ContentType: "audio/mpeg",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, &usecaseModel.Upload{
+		ID:            "upload",
+		Kind:          usecaseModel.UploadKindTrack,
+		Size:          100,
+		PartSize:      64,
+		PartsCount:    2,
+		Status:        usecaseModel.UploadStatusPending,
+		UploadedParts: []int64{},
+	}, upload)
+}
+
+func TestCreateUploadStagesCovers(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+
+	mockStorage.EXPECT().CreateMultipartUpload(ctx, usecaseModel.UploadKindCover, "uploads/upload", "image/png").Return("s3-upload", nil)
+	mockRepo.EXPECT().SaveUpload(ctx, gomock.Any(), time.Hour).Return(nil)
+
+	upload, err := u.CreateUpload(ctx, &usecaseModel.CreateUploadRequest{
+		LabelID:     3,
+		Kind:        usecaseModel.UploadKindCover,
+		Size:        10,
+		ContentType: "image/png",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), upload.PartsCount)
+}
+
+func TestCreateUploadKeepsMasterExtension(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+
+	mockStorage.EXPECT().CreateMultipartUpload(ctx, usecaseModel.UploadKindTrack, "upload.flac", "audio/flac").Return("s3-upload", nil)
+	mockRepo.EXPECT().SaveUpload(ctx, gomock.Any(), time.Hour).Return(nil)
+
+	_, err := u.CreateUpload(ctx, &usecaseModel.CreateUploadRequest{
+		LabelID:     3,
+		Kind:        usecaseModel.UploadKindTrack,
+		Size:        100,
+		ContentType: "audio/flac",
+	})
+
+	require.NoError(t, err)
+}
+
+func TestCreateUploadValidation(t *testing.T) {
+	_, _, u, ctx := setupTest(t)
+
+	tests := []struct {
+		name    string
+		request *usecaseModel.CreateUploadRequest
+		err     error
+	}{
+		{
+			name:    "unknown kind",
+			request: &usecaseModel.CreateUploadRequest{Kind: "video", Size: 10, ContentType: "video/mp4"},
+			err:     customErrors.ErrUploadInvalidKind,
+		},
+		{
+			name:    "image as a track",
+			request: &usecaseModel.CreateUploadRequest{Kind: usecaseModel.UploadKindTrack, Size: 10, ContentType: "image/png"},
+			err:     customErrors.ErrUploadInvalidContentType,
+		},
+		{
+			name:    "empty file",
+			request: &usecaseModel.CreateUploadRequest{Kind: usecaseModel.UploadKindTrack, Size: 0, ContentType: "audio/mpeg"},
+			err:     customErrors.ErrUploadInvalidSize,
+		},
+		{
+			name:    "cover over the limit",
+			request: &usecaseModel.CreateUploadRequest{Kind: usecaseModel.UploadKindCover, Size: 101, ContentType: "image/jpeg"},
+			err:     customErrors.ErrUploadTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			upload, err := u.CreateUpload(ctx, tt.request)
+			assert.ErrorIs(t, err, tt.err)
+			assert.Nil(t, upload)
+		})
+	}
+}
+
+func TestCreateUploadAbortsWhenSessionIsNotSaved(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+	expectedErr := errors.New("redis error")
+
+	mockStorage.EXPECT().CreateMultipartUpload(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "audio/mpeg").Return("s3-upload", nil)
+	mockRepo.EXPECT().SaveUpload(ctx, gomock.Any(), time.Hour).Return(expectedErr)
+	mockStorage.EXPECT().AbortMultipartUpload(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload").Return(nil)
+
+	upload, err := u.CreateUpload(ctx, &usecaseModel.CreateUploadRequest{
+		LabelID:     3,
+		Kind:        usecaseModel.UploadKindTrack,
+		Size:        100,
+		ContentType: "audio/mpeg",
+	})
+
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, upload)
+}
+
+func TestGetUploadListsUploadedParts(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+	mockStorage.EXPECT().ListParts(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload").Return([]*repository.UploadedPart{
+		{Number: 2, ETag: "b", Size: 36},
+	}, nil)
+
+	upload, err := u.GetUpload(ctx, "upload", 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, upload.UploadedParts)
+	assert.Equal(t, usecaseModel.UploadStatusPending, upload.Status)
+}
+
+func TestGetUploadOfAnotherLabel(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+
+	upload, err := u.GetUpload(ctx, "upload", 4)
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadNotFound)
+	assert.Nil(t, upload)
+}
+
+func TestGetPartURLsSignsMissingParts(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+	mockStorage.EXPECT().ListParts(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload").Return([]*repository.UploadedPart{
+		{Number: 2, ETag: "b", Size: 36},
+	}, nil)
+	mockStorage.EXPECT().PresignPart(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload", int64(1)).Return("https://part/1", nil)
+
+	parts, err := u.GetPartURLs(ctx, &usecaseModel.UploadPartsRequest{UploadID: "upload", LabelID: 3})
+
+	require.NoError(t, err)
+	assert.Equal(t, []*usecaseModel.UploadPart{{Number: 1, URL: "https://part/1"}}, parts)
+}
+
+func TestGetPartURLsInvalidPart(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+
+	parts, err := u.GetPartURLs(ctx, &usecaseModel.UploadPartsRequest{UploadID: "upload", LabelID: 3, Parts: []int64{3}})
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadInvalidPart)
+	assert.Nil(t, parts)
+}
+
+func TestGetPartURLsCompleted(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+	completed := pendingTrack()
+	completed.Status = usecaseModel.UploadStatusCompleted
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(completed, nil)
+
+	parts, err := u.GetPartURLs(ctx, &usecaseModel.UploadPartsRequest{UploadID: "upload", LabelID: 3, Parts: []int64{1}})
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadAlreadyCompleted)
+	assert.Nil(t, parts)
+}
+
+func TestCompleteUpload(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+	parts := []*repository.UploadedPart{
+		{Number: 1, ETag: "a", Size: 64},
+		{Number: 2, ETag: "b", Size: 36},
+	}
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+	mockStorage.EXPECT().ListParts(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload").Return(parts, nil)
+	mockStorage.EXPECT().CompleteMultipartUpload(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload", parts).Return(nil)
+	mockRepo.EXPECT().MarkCompleted(ctx, "upload").Return(nil)
+
+	upload, err := u.CompleteUpload(ctx, "upload", 3)
+
+	require.NoError(t, err)
+	assert.Equal(t, usecaseModel.UploadStatusCompleted, upload.Status)
+	assert.Equal(t, []int64{1, 2}, upload.UploadedParts)
+}
+
+func TestCompleteUploadIncomplete(t *testing.T) {
+	tests := []struct {
+		name  string
+		parts []*repository.UploadedPart
+	}{
+		{
+			name:  "missing part",
+			parts: []*repository.UploadedPart{{Number: 2, ETag: "b", Size: 36}},
+		},
+		{
+			name: "gap between parts",
+			parts: []*repository.UploadedPart{
+				{Number: 1, ETag: "a", Size: 64},
+				{Number: 3, ETag: "c", Size: 36},
+			},
+		},
+		{
+			name: "more bytes than declared",
+			parts: []*repository.UploadedPart{
+				{Number: 1, ETag: "a", Size: 64},
+				{Number: 2, ETag: "b", Size: 640},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, mockStorage, u, ctx := setupTest(t)
+
+			mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+			mockStorage.EXPECT().ListParts(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload").Return(tt.parts, nil)
+
+			upload, err := u.CompleteUpload(ctx, "upload", 3)
+
+			assert.ErrorIs(t, err, customErrors.ErrUploadIncomplete)
+			assert.Nil(t, upload)
+		})
+	}
+}
+
+func TestAbortUpload(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+	mockStorage.EXPECT().AbortMultipartUpload(ctx, usecaseModel.UploadKindTrack, "upload.mp3", "s3-upload").Return(customErrors.ErrUploadNotFound)
+	mockRepo.EXPECT().DeleteUpload(ctx, "upload").Return(nil)
+
+	err := u.AbortUpload(ctx, "upload", 3)
+
+	assert.NoError(t, err)
+}
+
+func TestAbortCompletedUploadRemovesEmbeddedCover(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+	completed := pendingTrack()
+	completed.Status = usecaseModel.UploadStatusCompleted
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(completed, nil)
+	mockStorage.EXPECT().DeleteObject(ctx, usecaseModel.UploadKindTrack, "upload.mp3").Return(nil)
+	mockStorage.EXPECT().DeleteObject(ctx, usecaseModel.UploadKindCover, "uploads/upload-cover").Return(nil)
+	mockRepo.EXPECT().DeleteUpload(ctx, "upload").Return(nil)
+
+	err := u.AbortUpload(ctx, "upload", 3)
+
+	assert.NoError(t, err)
+}
+
+func TestResolveUpload(t *testing.T) {
+	completed := pendingTrack()
+	completed.Status = usecaseModel.UploadStatusCompleted
+
+	tests := []struct {
+		name   string
+		upload *repository.Upload
+		kind   string
+		key    string
+		err    error
+	}{
+		{
+			name:   "completed track",
+			upload: completed,
+			kind:   usecaseModel.UploadKindTrack,
+			key:    "upload.mp3",
+		},
+		{
+			name:   "track used as a cover",
+			upload: completed,
+			kind:   usecaseModel.UploadKindCover,
+			err:    customErrors.ErrUploadInvalidKind,
+		},
+		{
+			name:   "pending track",
+			upload: pendingTrack(),
+			kind:   usecaseModel.UploadKindTrack,
+			err:    customErrors.ErrUploadIncomplete,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, _, u, ctx := setupTest(t)
+
+			mockRepo.EXPECT().GetUpload(ctx, "upload").Return(tt.upload, nil)
+			if tt.err == nil {
+				mockRepo.EXPECT().ClaimUpload(ctx, "upload").Return(true, nil)
+			}
+
+			key, err := u.ResolveUpload(ctx, "upload", 3, tt.kind)
+
+			assert.ErrorIs(t, err, tt.err)
+			assert.Equal(t, tt.key, key)
+		})
+	}
+}
+
+func TestResolveUploadInUse(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+	completed := pendingTrack()
+	completed.Status = usecaseModel.UploadStatusCompleted
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(completed, nil)
+	mockRepo.EXPECT().ClaimUpload(ctx, "upload").Return(false, nil)
+
+	key, err := u.ResolveUpload(ctx, "upload", 3, usecaseModel.UploadKindTrack)
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadInUse)
+	assert.Empty(t, key)
+}
+
+func TestPeekUploadDoesNotClaim(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+	completed := pendingTrack()
+	completed.Status = usecaseModel.UploadStatusCompleted
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(completed, nil)
+
+	key, err := u.PeekUpload(ctx, "upload", 3, usecaseModel.UploadKindTrack)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "upload.mp3", key)
+}
+
+func TestReleaseUploadRemovesStagedCover(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+	cover := &repository.Upload{
+		ID:      "upload",
+		LabelID: 3,
+		Kind:    usecaseModel.UploadKindCover,
+		Key:     "uploads/upload",
+		Status:  usecaseModel.UploadStatusCompleted,
+	}
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(cover, nil)
+	mockStorage.EXPECT().DeleteObject(ctx, usecaseModel.UploadKindCover, "uploads/upload").Return(nil)
+	mockRepo.EXPECT().DeleteUpload(ctx, "upload").Return(nil)
+
+	err := u.ReleaseUpload(ctx, "upload", 3)
+
+	assert.NoError(t, err)
+}
+
+func TestReleaseUploadKeepsTrack(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+	completed := pendingTrack()
+	completed.Status = usecaseModel.UploadStatusCompleted
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(completed, nil)
+	mockRepo.EXPECT().DeleteUpload(ctx, "upload").Return(nil)
+
+	err := u.ReleaseUpload(ctx, "upload", 3)
+
+	assert.NoError(t, err)
+}
+
+func TestReleaseUploadAfterSessionExpired(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(nil, customErrors.ErrUploadNotFound)
+	mockRepo.EXPECT().DeleteUpload(ctx, "upload").Return(nil)
+
+	err := u.ReleaseUpload(ctx, "upload", 3)
+
+	assert.NoError(t, err)
+}
+
+func TestReleaseUploadOfAnotherLabel(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().GetUpload(ctx, "upload").Return(pendingTrack(), nil)
+
+	err := u.ReleaseUpload(ctx, "upload", 4)
+
+	assert.ErrorIs(t, err, customErrors.ErrUploadNotFound)
+}
+
+func TestSweepExpired(t *testing.T) {
+	mockRepo, mockStorage, u, ctx := setupTest(t)
+	pending := &repository.Upload{ID: "pending", Kind: usecaseModel.UploadKindTrack, Key: "pending.mp3", S3UploadID: "s3-pending"}
+	cover := &repository.Upload{ID: "cover", Kind: usecaseModel.UploadKindCover, Key: "uploads/cover", S3UploadID: "s3-cover"}
+	broken := &repository.Upload{ID: "broken", Kind: usecaseModel.UploadKindCover, Key: "uploads/broken", S3UploadID: "s3-broken"}
+
+	before := time.Now().Add(-testCfg.TTL - sweepGrace)
+	mockRepo.EXPECT().AcquireSweeperLock(ctx, 5*time.Minute).Return(true, nil)
+	mockRepo.EXPECT().GetUploadsCreatedBefore(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, createdBefore time.Time) ([]*repository.Upload, error) {
+		assert.WithinDuration(t, before, createdBefore, time.Minute)
+		return []*repository.Upload{pending, cover, broken}, nil
+	})
+
+	mockStorage.EXPECT().AbortMultipartUpload(ctx, usecaseModel.UploadKindTrack, "pending.mp3", "s3-pending").Return(nil)
+	mockStorage.EXPECT().DeleteObject(ctx, usecaseModel.UploadKindTrack, "pending.mp3").Return(nil)
+	mockStorage.EXPECT().DeleteObject(ctx, usecaseModel.UploadKindCover, "uploads/pending-cover").Return(nil)
+	mockRepo.EXPECT().DeleteUpload(ctx, "pending").Return(nil)
+
+	// a completed upload has nothing left to abort
+	mockStorage.EXPECT().AbortMultipartUpload(ctx, usecaseModel.UploadKindCover, "uploads/cover", "s3-cover").Return(customErrors.ErrUploadNotFound)
+	mockStorage.EXPECT().DeleteObject(ctx, usecaseModel.UploadKindCover, "uploads/cover").Return(nil)
+	mockRepo.EXPECT().DeleteUpload(ctx, "cover").Return(nil)
+
+	// kept for the next sweep when the storage fails
+	mockStorage.EXPECT().AbortMultipartUpload(ctx, usecaseModel.UploadKindCover, "uploads/broken", "s3-broken").Return(errors.New("s3 error"))
+
+	err := u.sweepExpired(ctx, 10*time.Minute)
+
+	assert.NoError(t, err)
+}
+
+func TestSweepExpiredLockHeld(t *testing.T) {
+	mockRepo, _, u, ctx := setupTest(t)
+
+	mockRepo.EXPECT().AcquireSweeperLock(ctx, 5*time.Minute).Return(false, nil)
+
+	err := u.sweepExpired(ctx, 10*time.Minute)
+
+	assert.NoError(t, err)
+}
@@ -9,10 +9,12 @@ import (
 	"github.com/asaskevich/govalidator"
 	"github.com/gorilla/mux"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/ctxExtractor"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/errorStatus"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/json"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/pagination"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model"
 	deliveryModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/delivery"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
@@ -26,11 +28,13 @@ var (
 
 type UserHandler struct {
 	usecase user.Usecase
+	cfg     *config.Config
 }
 
-func NewUserHandler(usecase user.Usecase) *UserHandler {
+func NewUserHandler(usecase user.Usecase, cfg *config.Config) *UserHandler {
 	return &UserHandler{
 		usecase: usecase,
+		cfg:     cfg,
 	}
 }
 
@@ -59,6 +63,7 @@ func toUserToFront(user *usecaseModel.User) *deliveryModel.UserToFront {
 		Username: user.Username,
 		Email:    user.Email,
 		Avatar:   user.AvatarUrl,
+		Images:   model.ImagesFor(user.AvatarUrl, user.AvatarColor),
 		IsLabel:  isLabel,
 	}
 }
@@ -293,7 +298,7 @@ func (h *UserHandler) UploadAvatar(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	avatarURL, err := h.usecase.UploadAvatar(ctx, userAuth.Username, file, userID)
+	avatarURL, avatarColor, err := h.usecase.UploadAvatar(ctx, userAuth.Username, file, userID)
 	if err != nil {
 		logger.Error("failed to upload avatar", zap.Error(err))
 		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
@@ -301,6 +306,7 @@ func (h *UserHandler) UploadAvatar(w http.ResponseWriter, r *http.Request) {
 	}
 	msg := &deliveryModel.AvatarURL{
 		AvatarUrl: avatarURL,
+		Images:    model.ImagesFor(avatarURL, avatarColor),
 	}
 	json.WriteSuccessResponse(w, http.StatusOK, msg, nil)
 }
@@ -502,3 +508,180 @@ func (h *UserHandler) ChangeUserData(w http.ResponseWriter, r *http.Request) {
 	newUserDelivery := model.UserFullDataUsecaseToDelivery(newUser)
 	json.WriteSuccessResponse(w, http.StatusOK, newUserDelivery, nil)
 }
+
+// FollowUser godoc
+// @Summary Follow user
+// @Description Subscribes the current user to another user's activity
+// @Tags user
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Message} "User followed"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - trying to follow yourself"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "User not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/{username}/follow [post]
+func (h *UserHandler) FollowUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exist := ctxExtractor.UserFromContext(ctx)
+	if !exist {
+		logger.Error("user not auth")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not auth", nil)
+		return
+	}
+
+	username := mux.Vars(r)["username"]
+	err := h.usecase.FollowUser(ctx, userID, username)
+	if err != nil {
+		logger.Error("failed to follow user", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, &deliveryModel.Message{Message: "User followed"}, nil)
+}
+
+// UnfollowUser godoc
+// @Summary Unfollow user
+// @Description Unsubscribes the current user from another user's activity
+// @Tags user
+// @Produce json
+// @Param username path string true "Username"
+// @Success 200 {object} delivery.APIResponse{body=delivery.Message} "User unfollowed"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "User not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/{username}/follow [delete]
+func (h *UserHandler) UnfollowUser(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exist := ctxExtractor.UserFromContext(ctx)
+	if !exist {
+		logger.Error("user not auth")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not auth", nil)
+		return
+	}
+
+	username := mux.Vars(r)["username"]
+	err := h.usecase.UnfollowUser(ctx, userID, username)
+	if err != nil {
+		logger.Error("failed to unfollow user", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, &deliveryModel.Message{Message: "User unfollowed"}, nil)
+}
+
+// GetFollowers godoc
+// @Summary Get followers
+// @Description Get users following the given user
+// @Tags user
+// @Produce json
+// @Param username path string true "Username"
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.UserPreview} "List of followers"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "User not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/{username}/followers [get]
+func (h *UserHandler) GetFollowers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	username := mux.Vars(r)["username"]
+	followers, err := h.usecase.GetFollowers(ctx, username, model.PaginationFromDeliveryToUsecase(pagination))
+	if err != nil {
+		logger.Error("failed to get followers", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.UserPreviewListFromUsecaseToDelivery(followers), nil)
+}
+
+// GetFollowing godoc
+// @Summary Get following
+// @Description Get users the given user follows
+// @Tags user
+// @Produce json
+// @Param username path string true "Username"
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.UserPreview} "List of followed users"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination"
+// @Failure 404 {object} delivery.APINotFoundErrorResponse "User not found"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/{username}/following [get]
+func (h *UserHandler) GetFollowing(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	username := mux.Vars(r)["username"]
+	following, err := h.usecase.GetFollowing(ctx, username, model.PaginationFromDeliveryToUsecase(pagination))
+	if err != nil {
+		logger.Error("failed to get following", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.UserPreviewListFromUsecaseToDelivery(following), nil)
+}
+
+// GetActivityFeed godoc
+// @Summary Get activity feed
+// @Description Get recent likes, created playlists and current listening of followed users, respecting their privacy settings
+// @Tags user
+// @Produce json
+// @Param offset query integer false "Offset (default: 0)"
+// @Param limit query integer false "Limit (default: 10, max: 100)"
+// @Success 200 {object} delivery.APIResponse{body=[]delivery.Activity} "Activity feed"
+// @Failure 400 {object} delivery.APIBadRequestErrorResponse "Bad request - invalid pagination"
+// @Failure 401 {object} delivery.APIUnauthorizedErrorResponse "Unauthorized"
+// @Failure 500 {object} delivery.APIInternalServerErrorResponse "Internal server error"
+// @Router /user/me/feed [get]
+func (h *UserHandler) GetActivityFeed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	userID, exist := ctxExtractor.UserFromContext(ctx)
+	if !exist {
+		logger.Error("user not auth")
+		json.WriteErrorResponse(w, http.StatusUnauthorized, "user not auth", nil)
+		return
+	}
+
+	pagination, err := pagination.GetPagination(r, &h.cfg.Pagination)
+	if err != nil {
+		logger.Error("failed to get pagination", zap.Error(err))
+		json.WriteErrorResponse(w, http.StatusBadRequest, err.Error(), nil)
+		return
+	}
+
+	activities, err := h.usecase.GetActivityFeed(ctx, userID, model.PaginationFromDeliveryToUsecase(pagination))
+	if err != nil {
+		logger.Error("failed to get activity feed", zap.Error(err))
+		json.WriteErrorResponse(w, errorStatus.ErrorStatus(err), err.Error(), nil)
+		return
+	}
+
+	json.WriteSuccessResponse(w, http.StatusOK, model.ActivityListFromUsecaseToDelivery(activities), nil)
+}
@@ -87,6 +87,80 @@ func (mr *MockUsecaseMockRecorder) DeleteUser(ctx, user, SID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUsecase)(nil).DeleteUser), ctx, user, SID)
 }
 
+// FollowUser mocks base method.
+func (m *MockUsecase) FollowUser(ctx context.Context, followerID int64, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowUser", ctx, followerID, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockUsecaseMockRecorder) FollowUser(ctx, followerID, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockUsecase)(nil).FollowUser), ctx, followerID, username)
+}
+
+// GetActivityFeed mocks base method.
+func (m *MockUsecase) GetActivityFeed(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*usecase.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed.
+func (mr *MockUsecaseMockRecorder) GetActivityFeed(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockUsecase)(nil).GetActivityFeed), ctx, userID, pagination)
+}
+
+// GetFollowers mocks base method.
+func (m *MockUsecase) GetFollowers(ctx context.Context, username string, pagination *usecase.Pagination) ([]*usecase.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, username, pagination)
+	ret0, _ := ret[0].([]*usecase.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockUsecaseMockRecorder) GetFollowers(ctx, username, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockUsecase)(nil).GetFollowers), ctx, username, pagination)
+}
+
+// GetFollowing mocks base method.
+func (m *MockUsecase) GetFollowing(ctx context.Context, username string, pagination *usecase.Pagination) ([]*usecase.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, username, pagination)
+	ret0, _ := ret[0].([]*usecase.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockUsecaseMockRecorder) GetFollowing(ctx, username, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockUsecase)(nil).GetFollowing), ctx, username, pagination)
+}
+
+// GetLabelIDByUserID mocks base method.
+func (m *MockUsecase) GetLabelIDByUserID(ctx context.Context, userID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelIDByUserID", ctx, userID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelIDByUserID indicates an expected call of GetLabelIDByUserID.
+func (mr *MockUsecaseMockRecorder) GetLabelIDByUserID(ctx, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelIDByUserID", reflect.TypeOf((*MockUsecase)(nil).GetLabelIDByUserID), ctx, userID)
+}
+
 // GetUserByID mocks base method.
 func (m *MockUsecase) GetUserByID(ctx context.Context, id int64) (*usecase.User, error) {
 	m.ctrl.T.Helper()
@@ -162,13 +236,28 @@ func (mr *MockUsecaseMockRecorder) Logout(ctx, SID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockUsecase)(nil).Logout), ctx, SID)
 }
 
+// UnfollowUser mocks base method.
+func (m *MockUsecase) UnfollowUser(ctx context.Context, followerID int64, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowUser", ctx, followerID, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockUsecaseMockRecorder) UnfollowUser(ctx, followerID, username any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockUsecase)(nil).UnfollowUser), ctx, followerID, username)
+}
+
 // UploadAvatar mocks base method.
-func (m *MockUsecase) UploadAvatar(ctx context.Context, username string, fileAvatar io.Reader, ID int64) (string, error) {
+func (m *MockUsecase) UploadAvatar(ctx context.Context, username string, fileAvatar io.Reader, ID int64) (string, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadAvatar", ctx, username, fileAvatar, ID)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UploadAvatar indicates an expected call of UploadAvatar.
@@ -12,10 +12,15 @@ type Usecase interface {
 	GetUserBySID(ctx context.Context, SID string) (*usecaseModel.User, error)
 	LoginUser(ctx context.Context, user *usecaseModel.User) (*usecaseModel.User, string, error)
 	Logout(ctx context.Context, SID string) error
-	UploadAvatar(ctx context.Context, username string, fileAvatar io.Reader, ID int64) (string, error)
+	UploadAvatar(ctx context.Context, username string, fileAvatar io.Reader, ID int64) (string, string, error)
 	ChangeUserData(ctx context.Context, username string, userChangeData *usecaseModel.UserChangeSettings, userID int64) (*usecaseModel.UserFullData, error)
 	DeleteUser(ctx context.Context, user *usecaseModel.User, SID string) error
 	GetUserData(ctx context.Context, username string) (*usecaseModel.UserFullData, error)
 	GetUserByID(ctx context.Context, id int64) (*usecaseModel.User, error)
 	GetLabelIDByUserID(ctx context.Context, userID int64) (int64, error)
+	FollowUser(ctx context.Context, followerID int64, username string) error
+	UnfollowUser(ctx context.Context, followerID int64, username string) error
+	GetFollowers(ctx context.Context, username string, pagination *usecaseModel.Pagination) ([]*usecaseModel.User, error)
+	GetFollowing(ctx context.Context, username string, pagination *usecaseModel.Pagination) ([]*usecaseModel.User, error)
+	GetActivityFeed(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.Activity, error)
 }
@@ -108,27 +108,27 @@ func (u *userUsecase) Logout(ctx context.Context, SID string) error {
 	return nil
 }
 
-func (u *userUsecase) UploadAvatar(ctx context.Context, username string, fileAvatar io.Reader, ID int64) (string, error) {
+func (u *userUsecase) UploadAvatar(ctx context.Context, username string, fileAvatar io.Reader, ID int64) (string, string, error) {
 	image, err := io.ReadAll(fileAvatar)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	fileURL, err := (*u.userClient).UploadUserAvatar(ctx, model.AvatarImageFromUsecaseToProto(username, image))
+	uploadedAvatar, err := (*u.userClient).UploadUserAvatar(ctx, model.AvatarImageFromUsecaseToProto(username, image))
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	fileUrlUsecase := model.FileKeyFromProtoToUsecase(fileURL)
-	_, err = (*u.userClient).UploadAvatar(ctx, model.AvatarDataFromUsecaseToProto(fileUrlUsecase, ID))
+	fileUrlUsecase, avatarColor := model.UploadedAvatarFromProtoToUsecase(uploadedAvatar)
+	_, err = (*u.userClient).UploadAvatar(ctx, model.AvatarDataFromUsecaseToProto(fileUrlUsecase, avatarColor, ID))
 	if err != nil {
-		return "", cusstomErrors.HandleUserGRPCError(err)
+		return "", "", cusstomErrors.HandleUserGRPCError(err)
 	}
 
 	avatarUrl, err := (*u.userClient).GetUserAvatarURL(ctx, model.FileKeyFromUsecaseToProto(fileUrlUsecase))
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 	avatarUrlUsecase := model.AvatarUrlFromProtoToUsecase(avatarUrl)
-	return avatarUrlUsecase, nil
+	return avatarUrlUsecase, avatarColor, nil
 }
 
 func (u *userUsecase) DeleteUser(ctx context.Context, user *usecaseModel.User, SID string) error {
@@ -268,3 +268,59 @@ func (u *userUsecase) GetLabelIDByUserID(ctx context.Context, userID int64) (int
 	labelIDUsecase := model.LabelIDFromProtoToUsecase(labelID)
 	return labelIDUsecase, nil
 }
+
+func (u *userUsecase) FollowUser(ctx context.Context, followerID int64, username string) error {
+	followeeID, err := (*u.userClient).GetIDByUsername(ctx, model.UsernameFromUsecaseToProto(username))
+	if err != nil {
+		return cusstomErrors.HandleUserGRPCError(err)
+	}
+	_, err = (*u.userClient).FollowUser(ctx, model.FollowRequestFromUsecaseToProto(followerID, followeeID.Id))
+	if err != nil {
+		return cusstomErrors.HandleUserGRPCError(err)
+	}
+	return nil
+}
+
+func (u *userUsecase) UnfollowUser(ctx context.Context, followerID int64, username string) error {
+	followeeID, err := (*u.userClient).GetIDByUsername(ctx, model.UsernameFromUsecaseToProto(username))
+	if err != nil {
+		return cusstomErrors.HandleUserGRPCError(err)
+	}
+	_, err = (*u.userClient).UnfollowUser(ctx, model.FollowRequestFromUsecaseToProto(followerID, followeeID.Id))
+	if err != nil {
+		return cusstomErrors.HandleUserGRPCError(err)
+	}
+	return nil
+}
+
+func (u *userUsecase) GetFollowers(ctx context.Context, username string, pagination *usecaseModel.Pagination) ([]*usecaseModel.User, error) {
+	userID, err := (*u.userClient).GetIDByUsername(ctx, model.UsernameFromUsecaseToProto(username))
+	if err != nil {
+		return nil, cusstomErrors.HandleUserGRPCError(err)
+	}
+	followers, err := (*u.userClient).GetFollowers(ctx, model.UserIDWithPaginationFromUsecaseToProto(userID.Id, pagination))
+	if err != nil {
+		return nil, cusstomErrors.HandleUserGRPCError(err)
+	}
+	return model.MembersFromProtoToUsecase(followers), nil
+}
+
+func (u *userUsecase) GetFollowing(ctx context.Context, username string, pagination *usecaseModel.Pagination) ([]*usecaseModel.User, error) {
+	userID, err := (*u.userClient).GetIDByUsername(ctx, model.UsernameFromUsecaseToProto(username))
+	if err != nil {
+		return nil, cusstomErrors.HandleUserGRPCError(err)
+	}
+	following, err := (*u.userClient).GetFollowing(ctx, model.UserIDWithPaginationFromUsecaseToProto(userID.Id, pagination))
+	if err != nil {
+		return nil, cusstomErrors.HandleUserGRPCError(err)
+	}
+	return model.MembersFromProtoToUsecase(following), nil
+}
+
+func (u *userUsecase) GetActivityFeed(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.Activity, error) {
+	activities, err := (*u.userClient).GetActivityFeed(ctx, model.UserIDWithPaginationFromUsecaseToProto(userID, pagination))
+	if err != nil {
+		return nil, cusstomErrors.HandleUserGRPCError(err)
+	}
+	return model.ActivityListFromProtoToUsecase(activities), nil
+}
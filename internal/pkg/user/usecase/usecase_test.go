@@ -5,18 +5,23 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/artist"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/auth"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/playlist"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/track"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/customErrors"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/model/usecase"
 	userUsecase "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/user/usecase"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/mocks"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func TestCreateUser(t *testing.T) {
@@ -239,21 +244,24 @@ func TestUploadAvatar(t *testing.T) {
 
 	uploadURL := "http://example.com/avatars/upload.jpg"
 	newAvatarUrl := "avatar_12345.jpg"
+	avatarColor := "#a1b2c3"
 
-	mockUserClient.EXPECT().UploadUserAvatar(gomock.Any(), gomock.Any()).Return(&user.FileKey{FileKey: newAvatarUrl}, nil)
+	mockUserClient.EXPECT().UploadUserAvatar(gomock.Any(), gomock.Any()).Return(&user.UploadedAvatar{FileKey: newAvatarUrl, AvatarColor: avatarColor}, nil)
 
 	mockUserClient.EXPECT().UploadAvatar(gomock.Any(), &user.AvatarData{
-		Id:         userID,
-		AvatarPath: newAvatarUrl,
+		Id:          userID,
+		AvatarPath:  newAvatarUrl,
+		AvatarColor: avatarColor,
 	}).Return(&user.Nothing{}, nil)
 
 	mockUserClient.EXPECT().GetUserAvatarURL(gomock.Any(), &user.FileKey{FileKey: newAvatarUrl}).
 		Return(&user.AvatarUrl{Url: uploadURL}, nil)
 
-	resultURL, err := userUC.UploadAvatar(ctx, username, fileAvatar, userID)
+	resultURL, resultColor, err := userUC.UploadAvatar(ctx, username, fileAvatar, userID)
 
 	assert.NoError(t, err)
 	assert.Equal(t, uploadURL, resultURL)
+	assert.Equal(t, avatarColor, resultColor)
 }
 
 func TestGetUserData(t *testing.T) {
@@ -587,4 +595,138 @@ func TestGetLabelIDByUserIDError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 	assert.Equal(t, int64(-1), labelID)
-}
\ No newline at end of file
+}
+
+func TestFollowUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+
+	userClient := user.UserServiceClient(mockUserClient)
+
+	userClientPtr := &userClient
+
+	userUC := userUsecase.NewUserUsecase(userClientPtr, nil, nil, nil, nil)
+
+	ctx := context.Background()
+
+	mockUserClient.EXPECT().GetIDByUsername(gomock.Any(), &user.Username{Username: "friend"}).Return(&user.UserID{Id: 2}, nil)
+	mockUserClient.EXPECT().FollowUser(gomock.Any(), &user.FollowRequest{FollowerId: 1, FolloweeId: 2}).Return(&user.Nothing{}, nil)
+
+	err := userUC.FollowUser(ctx, 1, "friend")
+
+	assert.NoError(t, err)
+}
+
+func TestFollowUserYourself(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+
+	userClient := user.UserServiceClient(mockUserClient)
+
+	userClientPtr := &userClient
+
+	userUC := userUsecase.NewUserUsecase(userClientPtr, nil, nil, nil, nil)
+
+	ctx := context.Background()
+
+	mockUserClient.EXPECT().GetIDByUsername(gomock.Any(), &user.Username{Username: "testuser"}).Return(&user.UserID{Id: 1}, nil)
+	mockUserClient.EXPECT().FollowUser(gomock.Any(), &user.FollowRequest{FollowerId: 1, FolloweeId: 1}).
+		Return(nil, status.Error(codes.InvalidArgument, "you can't follow yourself"))
+
+	err := userUC.FollowUser(ctx, 1, "testuser")
+
+	assert.ErrorIs(t, err, customErrors.ErrFollowYourself)
+}
+
+func TestUnfollowUserNotFound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+
+	userClient := user.UserServiceClient(mockUserClient)
+
+	userClientPtr := &userClient
+
+	userUC := userUsecase.NewUserUsecase(userClientPtr, nil, nil, nil, nil)
+
+	ctx := context.Background()
+
+	mockUserClient.EXPECT().GetIDByUsername(gomock.Any(), &user.Username{Username: "ghost"}).
+		Return(nil, status.Error(codes.NotFound, "user not found"))
+
+	err := userUC.UnfollowUser(ctx, 1, "ghost")
+
+	assert.ErrorIs(t, err, customErrors.ErrUserNotFound)
+}
+
+func TestGetFollowers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+
+	userClient := user.UserServiceClient(mockUserClient)
+
+	userClientPtr := &userClient
+
+	userUC := userUsecase.NewUserUsecase(userClientPtr, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	pagination := &usecase.Pagination{Offset: 0, Limit: 10}
+
+	mockUserClient.EXPECT().GetIDByUsername(gomock.Any(), &user.Username{Username: "testuser"}).Return(&user.UserID{Id: 1}, nil)
+	mockUserClient.EXPECT().GetFollowers(gomock.Any(), &user.UserIDWithPagination{UserId: 1, Pagination: &user.Pagination{Offset: 0, Limit: 10}}).
+		Return(&user.UsersToFront{Users: []*user.UserFront{
+			{Id: 2, Username: "friend", Avatar: "http://example.com/avatars/friend.png"},
+		}}, nil)
+
+	followers, err := userUC.GetFollowers(ctx, "testuser", pagination)
+
+	assert.NoError(t, err)
+	assert.Len(t, followers, 1)
+	assert.Equal(t, "friend", followers[0].Username)
+	assert.Equal(t, "http://example.com/avatars/friend.png", followers[0].AvatarUrl)
+}
+
+func TestGetActivityFeed(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockUserClient := mocks.NewMockUserServiceClient(ctrl)
+
+	userClient := user.UserServiceClient(mockUserClient)
+
+	userClientPtr := &userClient
+
+	userUC := userUsecase.NewUserUsecase(userClientPtr, nil, nil, nil, nil)
+
+	ctx := context.Background()
+	pagination := &usecase.Pagination{Offset: 0, Limit: 10}
+	createdAt := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	mockUserClient.EXPECT().GetActivityFeed(gomock.Any(), &user.UserIDWithPagination{UserId: 1, Pagination: &user.Pagination{Offset: 0, Limit: 10}}).
+		Return(&user.ActivityList{Activities: []*user.Activity{
+			{
+				Type:            "created_playlist",
+				User:            &user.UserFront{Id: 2, Username: "friend", Avatar: "http://example.com/avatars/friend.png"},
+				EntityId:        5,
+				EntityTitle:     "Road trip",
+				EntityThumbnail: "http://example.com/playlists/5.png",
+				CreatedAt:       timestamppb.New(createdAt),
+			},
+		}}, nil)
+
+	activities, err := userUC.GetActivityFeed(ctx, 1, pagination)
+
+	assert.NoError(t, err)
+	assert.Len(t, activities, 1)
+	assert.Equal(t, "created_playlist", activities[0].Type)
+	assert.Equal(t, "friend", activities[0].User.Username)
+	assert.Equal(t, int64(5), activities[0].EntityID)
+	assert.Equal(t, createdAt, activities[0].CreatedAt)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -15,8 +16,10 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/internal/delivery"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/internal/repository"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/internal/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/imagePipeline"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/interceptors"
 	metrics "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
@@ -59,8 +62,6 @@ func main() {
 
 	server := grpc.NewServer(
 		grpc.UnaryInterceptor(accessInterceptor.UnaryServerInterceptor()),
-		grpc.MaxRecvMsgSize(500*1024*1024), // 500 MB
-		grpc.MaxSendMsgSize(500*1024*1024), // 500 MB
 	)
 
 	postgresPool, err := postgres.ConnectPostgres(cfg.Postgres)
@@ -91,8 +92,16 @@ func main() {
 	}()
 
 	albumRepository := repository.NewAlbumPostgresRepository(postgresPool, metrics)
-	s3Repository := repository.NewS3Repository(s3, cfg.S3.S3ImagesBucket, metrics)
-	albumUsecase := usecase.NewAlbumUsecase(albumRepository, s3Repository)
+	s3Repository := repository.NewS3Repository(s3, cfg.S3.S3ImagesBucket, imagePipeline.New(cfg.Images, metrics), metrics)
+	ctx, cancel := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+	defer cancel()
+	postgresSearchIndex := repository.NewAlbumPostgresSearchIndex(postgresPool, metrics)
+	albumSearchIndex, err := searchIndex.New(ctx, cfg.Search, "albums", postgresSearchIndex, postgresSearchIndex.LoadDocuments, metrics)
+	if err != nil {
+		logger.Error("Error initializing search index:", zap.Error(err))
+		return
+	}
+	albumUsecase := usecase.NewAlbumUsecase(albumRepository, s3Repository, albumSearchIndex)
 	albumService := delivery.NewAlbumService(albumUsecase)
 	albumProto.RegisterAlbumServiceServer(server, albumService)
 
@@ -6,6 +6,7 @@ import (
 	albumProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/album"
 	domain "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/internal/domain"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/usecase"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -97,7 +98,7 @@ func (s *AlbumService) GetFavoriteAlbums(ctx context.Context, req *albumProto.Fi
 }
 
 func (s *AlbumService) SearchAlbums(ctx context.Context, req *albumProto.Query) (*albumProto.AlbumList, error) {
-	albums, err := s.albumUsecase.SearchAlbums(ctx, req.Query, req.UserId.Id)
+	albums, err := s.albumUsecase.SearchAlbums(ctx, req.Query, req.UserId.Id, model.AlbumFiltersFromProtoToUsecase(req.Filters))
 	if err != nil {
 		return nil, err
 	}
@@ -105,13 +106,14 @@ func (s *AlbumService) SearchAlbums(ctx context.Context, req *albumProto.Query)
 }
 
 func (s *AlbumService) CreateAlbum(ctx context.Context, req *albumProto.CreateAlbumRequest) (*albumProto.AlbumIDAndURL, error) {
-	albumID, url, err := s.albumUsecase.CreateAlbum(ctx, model.AlbumRequestFromProtoToUsecase(req))
+	album, err := s.albumUsecase.CreateAlbum(ctx, model.AlbumRequestFromProtoToUsecase(req))
 	if err != nil {
 		return nil, err
 	}
 	return &albumProto.AlbumIDAndURL{
-		Id:  albumID,
-		Url: url,
+		Id:             album.ID,
+		Url:            album.Thumbnail,
+		ThumbnailColor: album.ThumbnailColor,
 	}, nil
 }
 
@@ -130,3 +132,43 @@ func (s *AlbumService) GetAlbumsLabelID(ctx context.Context, req *albumProto.Fil
 	}
 	return model.AlbumListFromUsecaseToProto(albums), nil
 }
+
+func (s *AlbumService) GetLabelAlbumByID(ctx context.Context, req *albumProto.LabelAlbumRequest) (*albumProto.Album, error) {
+	album, err := s.albumUsecase.GetLabelAlbumByID(ctx, req.GetAlbumId().GetId(), req.LabelId, req.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+	return model.AlbumFromUsecaseToProto(album), nil
+}
+
+func (s *AlbumService) GetAlbumsByStatus(ctx context.Context, req *albumProto.FiltersWithStatus) (*albumProto.AlbumList, error) {
+	albums, err := s.albumUsecase.GetAlbumsByStatus(ctx, model.AlbumFiltersFromProtoToUsecase(req.Filters), usecaseModel.AlbumStatus(req.Status))
+	if err != nil {
+		return nil, err
+	}
+	return model.AlbumListFromUsecaseToProto(albums), nil
+}
+
+func (s *AlbumService) ChangeAlbumStatus(ctx context.Context, req *albumProto.ChangeAlbumStatusRequest) (*albumProto.Album, error) {
+	album, err := s.albumUsecase.ChangeAlbumStatus(ctx, model.ChangeAlbumStatusRequestFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return model.AlbumFromUsecaseToProto(album), nil
+}
+
+func (s *AlbumService) PublishScheduledAlbums(ctx context.Context, req *emptypb.Empty) (*albumProto.AlbumIDList, error) {
+	albumIDs, err := s.albumUsecase.PublishScheduledAlbums(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return model.AlbumIDListFromUsecaseToProto(albumIDs), nil
+}
+
+func (s *AlbumService) EditAlbum(ctx context.Context, req *albumProto.EditAlbumRequest) (*albumProto.Album, error) {
+	album, err := s.albumUsecase.EditAlbum(ctx, model.EditAlbumRequestFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return model.AlbumFromUsecaseToProto(album), nil
+}
@@ -17,12 +17,17 @@ type Repository interface {
 	CheckAlbumExists(ctx context.Context, albumID int64) (bool, error)
 	UnlikeAlbum(ctx context.Context, request *repoModel.LikeRequest) error
 	GetFavoriteAlbums(ctx context.Context, filters *repoModel.AlbumFilters, userID int64) ([]*repoModel.Album, error)
-	SearchAlbums(ctx context.Context, query string, userID int64) ([]*repoModel.Album, error)
 	CreateAlbum(ctx context.Context, album *repoModel.CreateAlbumRequest) (int64, error)
 	DeleteAlbum(ctx context.Context, albumID int64) error
 	GetAlbumsLabelID(ctx context.Context, filters *repoModel.AlbumFilters, labelID int64) ([]*repoModel.Album, error)
+	CheckAlbumPublished(ctx context.Context, albumID int64) (bool, error)
+	GetAlbumWithStatus(ctx context.Context, albumID int64) (*repoModel.Album, error)
+	GetAlbumsByStatus(ctx context.Context, filters *repoModel.AlbumFilters, status repoModel.AlbumStatus) ([]*repoModel.Album, error)
+	ChangeAlbumStatus(ctx context.Context, change *repoModel.AlbumStatusChange) error
+	PublishScheduledAlbums(ctx context.Context) ([]int64, error)
+	EditAlbum(ctx context.Context, edit *repoModel.AlbumEdit) error
 }
 
 type S3Repository interface {
-	UploadAlbumAvatar(ctx context.Context, albumTitle string, file []byte) (string, error)
+	CopyAlbumAvatar(ctx context.Context, albumTitle string, sourceKey string) (string, string, error)
 }
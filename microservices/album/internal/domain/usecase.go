@@ -15,8 +15,13 @@ type Usecase interface {
 	CreateStream(ctx context.Context, albumID int64, userID int64) error
 	LikeAlbum(ctx context.Context, request *usecaseModel.LikeRequest) error
 	GetFavoriteAlbums(ctx context.Context, filters *usecaseModel.AlbumFilters, userID int64) ([]*usecaseModel.Album, error)
-	SearchAlbums(ctx context.Context, query string, userID int64) ([]*usecaseModel.Album, error)
-	CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (int64, string, error)
+	SearchAlbums(ctx context.Context, query string, userID int64, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.Album, error)
+	CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (*usecaseModel.Album, error)
 	DeleteAlbum(ctx context.Context, albumID int64) error
 	GetAlbumsLabelID(ctx context.Context, filters *usecaseModel.AlbumFilters, labelID int64) ([]*usecaseModel.Album, error)
+	GetLabelAlbumByID(ctx context.Context, albumID int64, labelID int64, isAdmin bool) (*usecaseModel.Album, error)
+	GetAlbumsByStatus(ctx context.Context, filters *usecaseModel.AlbumFilters, status usecaseModel.AlbumStatus) ([]*usecaseModel.Album, error)
+	ChangeAlbumStatus(ctx context.Context, request *usecaseModel.ChangeAlbumStatusRequest) (*usecaseModel.Album, error)
+	PublishScheduledAlbums(ctx context.Context) ([]int64, error)
+	EditAlbum(ctx context.Context, request *usecaseModel.EditAlbumRequest) (*usecaseModel.Album, error)
 }
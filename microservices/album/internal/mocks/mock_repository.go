@@ -41,6 +41,20 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 	return m.recorder
 }
 
+// ChangeAlbumStatus mocks base method.
+func (m *MockRepository) ChangeAlbumStatus(ctx context.Context, change *repository.AlbumStatusChange) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeAlbumStatus", ctx, change)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeAlbumStatus indicates an expected call of ChangeAlbumStatus.
+func (mr *MockRepositoryMockRecorder) ChangeAlbumStatus(ctx, change any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAlbumStatus", reflect.TypeOf((*MockRepository)(nil).ChangeAlbumStatus), ctx, change)
+}
+
 // CheckAlbumExists mocks base method.
 func (m *MockRepository) CheckAlbumExists(ctx context.Context, albumID int64) (bool, error) {
 	m.ctrl.T.Helper()
@@ -56,6 +70,21 @@ func (mr *MockRepositoryMockRecorder) CheckAlbumExists(ctx, albumID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAlbumExists", reflect.TypeOf((*MockRepository)(nil).CheckAlbumExists), ctx, albumID)
 }
 
+// CheckAlbumPublished mocks base method.
+func (m *MockRepository) CheckAlbumPublished(ctx context.Context, albumID int64) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAlbumPublished", ctx, albumID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckAlbumPublished indicates an expected call of CheckAlbumPublished.
+func (mr *MockRepositoryMockRecorder) CheckAlbumPublished(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAlbumPublished", reflect.TypeOf((*MockRepository)(nil).CheckAlbumPublished), ctx, albumID)
+}
+
 // CreateAlbum mocks base method.
 func (m *MockRepository) CreateAlbum(ctx context.Context, album *repository.CreateAlbumRequest) (int64, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +128,20 @@ func (mr *MockRepositoryMockRecorder) DeleteAlbum(ctx, albumID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockRepository)(nil).DeleteAlbum), ctx, albumID)
 }
 
+// EditAlbum mocks base method.
+func (m *MockRepository) EditAlbum(ctx context.Context, edit *repository.AlbumEdit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAlbum", ctx, edit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditAlbum indicates an expected call of EditAlbum.
+func (mr *MockRepositoryMockRecorder) EditAlbum(ctx, edit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAlbum", reflect.TypeOf((*MockRepository)(nil).EditAlbum), ctx, edit)
+}
+
 // GetAlbumByID mocks base method.
 func (m *MockRepository) GetAlbumByID(ctx context.Context, id, userID int64) (*repository.Album, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +187,21 @@ func (mr *MockRepositoryMockRecorder) GetAlbumTitleByIDs(ctx, ids any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumTitleByIDs", reflect.TypeOf((*MockRepository)(nil).GetAlbumTitleByIDs), ctx, ids)
 }
 
+// GetAlbumWithStatus mocks base method.
+func (m *MockRepository) GetAlbumWithStatus(ctx context.Context, albumID int64) (*repository.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumWithStatus", ctx, albumID)
+	ret0, _ := ret[0].(*repository.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumWithStatus indicates an expected call of GetAlbumWithStatus.
+func (mr *MockRepositoryMockRecorder) GetAlbumWithStatus(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumWithStatus", reflect.TypeOf((*MockRepository)(nil).GetAlbumWithStatus), ctx, albumID)
+}
+
 // GetAlbumsByIDs mocks base method.
 func (m *MockRepository) GetAlbumsByIDs(ctx context.Context, ids []int64, userID int64) ([]*repository.Album, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +217,21 @@ func (mr *MockRepositoryMockRecorder) GetAlbumsByIDs(ctx, ids, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByIDs", reflect.TypeOf((*MockRepository)(nil).GetAlbumsByIDs), ctx, ids, userID)
 }
 
+// GetAlbumsByStatus mocks base method.
+func (m *MockRepository) GetAlbumsByStatus(ctx context.Context, filters *repository.AlbumFilters, status repository.AlbumStatus) ([]*repository.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumsByStatus", ctx, filters, status)
+	ret0, _ := ret[0].([]*repository.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumsByStatus indicates an expected call of GetAlbumsByStatus.
+func (mr *MockRepositoryMockRecorder) GetAlbumsByStatus(ctx, filters, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByStatus", reflect.TypeOf((*MockRepository)(nil).GetAlbumsByStatus), ctx, filters, status)
+}
+
 // GetAlbumsLabelID mocks base method.
 func (m *MockRepository) GetAlbumsLabelID(ctx context.Context, filters *repository.AlbumFilters, labelID int64) ([]*repository.Album, error) {
 	m.ctrl.T.Helper()
@@ -218,19 +291,19 @@ func (mr *MockRepositoryMockRecorder) LikeAlbum(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeAlbum", reflect.TypeOf((*MockRepository)(nil).LikeAlbum), ctx, request)
 }
 
-// SearchAlbums mocks base method.
-func (m *MockRepository) SearchAlbums(ctx context.Context, query string, userID int64) ([]*repository.Album, error) {
+// PublishScheduledAlbums mocks base method.
+func (m *MockRepository) PublishScheduledAlbums(ctx context.Context) ([]int64, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchAlbums", ctx, query, userID)
-	ret0, _ := ret[0].([]*repository.Album)
+	ret := m.ctrl.Call(m, "PublishScheduledAlbums", ctx)
+	ret0, _ := ret[0].([]int64)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchAlbums indicates an expected call of SearchAlbums.
-func (mr *MockRepositoryMockRecorder) SearchAlbums(ctx, query, userID any) *gomock.Call {
+// PublishScheduledAlbums indicates an expected call of PublishScheduledAlbums.
+func (mr *MockRepositoryMockRecorder) PublishScheduledAlbums(ctx any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlbums", reflect.TypeOf((*MockRepository)(nil).SearchAlbums), ctx, query, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledAlbums", reflect.TypeOf((*MockRepository)(nil).PublishScheduledAlbums), ctx)
 }
 
 // UnlikeAlbum mocks base method.
@@ -271,17 +344,18 @@ func (m *MockS3Repository) EXPECT() *MockS3RepositoryMockRecorder {
 	return m.recorder
 }
 
-// UploadAlbumAvatar mocks base method.
-func (m *MockS3Repository) UploadAlbumAvatar(ctx context.Context, albumTitle string, file []byte) (string, error) {
+// CopyAlbumAvatar mocks base method.
+func (m *MockS3Repository) CopyAlbumAvatar(ctx context.Context, albumTitle, sourceKey string) (string, string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadAlbumAvatar", ctx, albumTitle, file)
+	ret := m.ctrl.Call(m, "CopyAlbumAvatar", ctx, albumTitle, sourceKey)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
-// UploadAlbumAvatar indicates an expected call of UploadAlbumAvatar.
-func (mr *MockS3RepositoryMockRecorder) UploadAlbumAvatar(ctx, albumTitle, file any) *gomock.Call {
+// CopyAlbumAvatar indicates an expected call of CopyAlbumAvatar.
+func (mr *MockS3RepositoryMockRecorder) CopyAlbumAvatar(ctx, albumTitle, sourceKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAlbumAvatar", reflect.TypeOf((*MockS3Repository)(nil).UploadAlbumAvatar), ctx, albumTitle, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyAlbumAvatar", reflect.TypeOf((*MockS3Repository)(nil).CopyAlbumAvatar), ctx, albumTitle, sourceKey)
 }
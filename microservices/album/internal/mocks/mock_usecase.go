@@ -41,6 +41,36 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ChangeAlbumStatus mocks base method.
+func (m *MockUsecase) ChangeAlbumStatus(ctx context.Context, request *usecase.ChangeAlbumStatusRequest) (*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeAlbumStatus", ctx, request)
+	ret0, _ := ret[0].(*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeAlbumStatus indicates an expected call of ChangeAlbumStatus.
+func (mr *MockUsecaseMockRecorder) ChangeAlbumStatus(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAlbumStatus", reflect.TypeOf((*MockUsecase)(nil).ChangeAlbumStatus), ctx, request)
+}
+
+// CreateAlbum mocks base method.
+func (m *MockUsecase) CreateAlbum(ctx context.Context, album *usecase.CreateAlbumRequest) (*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAlbum", ctx, album)
+	ret0, _ := ret[0].(*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAlbum indicates an expected call of CreateAlbum.
+func (mr *MockUsecaseMockRecorder) CreateAlbum(ctx, album any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlbum", reflect.TypeOf((*MockUsecase)(nil).CreateAlbum), ctx, album)
+}
+
 // CreateStream mocks base method.
 func (m *MockUsecase) CreateStream(ctx context.Context, albumID, userID int64) error {
 	m.ctrl.T.Helper()
@@ -55,6 +85,35 @@ func (mr *MockUsecaseMockRecorder) CreateStream(ctx, albumID, userID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockUsecase)(nil).CreateStream), ctx, albumID, userID)
 }
 
+// DeleteAlbum mocks base method.
+func (m *MockUsecase) DeleteAlbum(ctx context.Context, albumID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlbum", ctx, albumID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteAlbum indicates an expected call of DeleteAlbum.
+func (mr *MockUsecaseMockRecorder) DeleteAlbum(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockUsecase)(nil).DeleteAlbum), ctx, albumID)
+}
+
+// EditAlbum mocks base method.
+func (m *MockUsecase) EditAlbum(ctx context.Context, request *usecase.EditAlbumRequest) (*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAlbum", ctx, request)
+	ret0, _ := ret[0].(*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditAlbum indicates an expected call of EditAlbum.
+func (mr *MockUsecaseMockRecorder) EditAlbum(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAlbum", reflect.TypeOf((*MockUsecase)(nil).EditAlbum), ctx, request)
+}
+
 // GetAlbumByID mocks base method.
 func (m *MockUsecase) GetAlbumByID(ctx context.Context, id, userID int64) (*usecase.Album, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +174,36 @@ func (mr *MockUsecaseMockRecorder) GetAlbumsByIDs(ctx, ids, userID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByIDs", reflect.TypeOf((*MockUsecase)(nil).GetAlbumsByIDs), ctx, ids, userID)
 }
 
+// GetAlbumsByStatus mocks base method.
+func (m *MockUsecase) GetAlbumsByStatus(ctx context.Context, filters *usecase.AlbumFilters, status usecase.AlbumStatus) ([]*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumsByStatus", ctx, filters, status)
+	ret0, _ := ret[0].([]*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumsByStatus indicates an expected call of GetAlbumsByStatus.
+func (mr *MockUsecaseMockRecorder) GetAlbumsByStatus(ctx, filters, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByStatus", reflect.TypeOf((*MockUsecase)(nil).GetAlbumsByStatus), ctx, filters, status)
+}
+
+// GetAlbumsLabelID mocks base method.
+func (m *MockUsecase) GetAlbumsLabelID(ctx context.Context, filters *usecase.AlbumFilters, labelID int64) ([]*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumsLabelID", ctx, filters, labelID)
+	ret0, _ := ret[0].([]*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumsLabelID indicates an expected call of GetAlbumsLabelID.
+func (mr *MockUsecaseMockRecorder) GetAlbumsLabelID(ctx, filters, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsLabelID", reflect.TypeOf((*MockUsecase)(nil).GetAlbumsLabelID), ctx, filters, labelID)
+}
+
 // GetAllAlbums mocks base method.
 func (m *MockUsecase) GetAllAlbums(ctx context.Context, filters *usecase.AlbumFilters, userID int64) ([]*usecase.Album, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +234,21 @@ func (mr *MockUsecaseMockRecorder) GetFavoriteAlbums(ctx, filters, userID any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteAlbums", reflect.TypeOf((*MockUsecase)(nil).GetFavoriteAlbums), ctx, filters, userID)
 }
 
+// GetLabelAlbumByID mocks base method.
+func (m *MockUsecase) GetLabelAlbumByID(ctx context.Context, albumID, labelID int64, isAdmin bool) (*usecase.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelAlbumByID", ctx, albumID, labelID, isAdmin)
+	ret0, _ := ret[0].(*usecase.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelAlbumByID indicates an expected call of GetLabelAlbumByID.
+func (mr *MockUsecaseMockRecorder) GetLabelAlbumByID(ctx, albumID, labelID, isAdmin any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelAlbumByID", reflect.TypeOf((*MockUsecase)(nil).GetLabelAlbumByID), ctx, albumID, labelID, isAdmin)
+}
+
 // LikeAlbum mocks base method.
 func (m *MockUsecase) LikeAlbum(ctx context.Context, request *usecase.LikeRequest) error {
 	m.ctrl.T.Helper()
@@ -159,17 +263,32 @@ func (mr *MockUsecaseMockRecorder) LikeAlbum(ctx, request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeAlbum", reflect.TypeOf((*MockUsecase)(nil).LikeAlbum), ctx, request)
 }
 
+// PublishScheduledAlbums mocks base method.
+func (m *MockUsecase) PublishScheduledAlbums(ctx context.Context) ([]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledAlbums", ctx)
+	ret0, _ := ret[0].([]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledAlbums indicates an expected call of PublishScheduledAlbums.
+func (mr *MockUsecaseMockRecorder) PublishScheduledAlbums(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledAlbums", reflect.TypeOf((*MockUsecase)(nil).PublishScheduledAlbums), ctx)
+}
+
 // SearchAlbums mocks base method.
-func (m *MockUsecase) SearchAlbums(ctx context.Context, query string, userID int64) ([]*usecase.Album, error) {
+func (m *MockUsecase) SearchAlbums(ctx context.Context, query string, userID int64, filters *usecase.AlbumFilters) ([]*usecase.Album, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchAlbums", ctx, query, userID)
+	ret := m.ctrl.Call(m, "SearchAlbums", ctx, query, userID, filters)
 	ret0, _ := ret[0].([]*usecase.Album)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchAlbums indicates an expected call of SearchAlbums.
-func (mr *MockUsecaseMockRecorder) SearchAlbums(ctx, query, userID any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchAlbums(ctx, query, userID, filters any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlbums", reflect.TypeOf((*MockUsecase)(nil).SearchAlbums), ctx, query, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAlbums", reflect.TypeOf((*MockUsecase)(nil).SearchAlbums), ctx, query, userID, filters)
 }
@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"strings"
 	"time"
 
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
@@ -18,18 +17,19 @@ import (
 
 const (
 	GetAllAlbumsQuery = `
-		SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date, (fa.user_id IS NOT NULL) AS is_favorite
+		SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date, (fa.user_id IS NOT NULL) AS is_favorite
 		FROM album a
 		LEFT JOIN album_stats als ON a.id = als.album_id
 		LEFT JOIN favorite_album fa ON a.id = fa.album_id AND fa.user_id = $3
+		WHERE a.status = 'published'
 		ORDER BY als.listeners_count DESC, a.id DESC
 		LIMIT $1 OFFSET $2
 	`
 	GetAlbumByIDQuery = `
-		SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date, (fa.user_id IS NOT NULL) AS is_favorite
+		SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date, (fa.user_id IS NOT NULL) AS is_favorite
 		FROM album a
 		LEFT JOIN favorite_album fa ON a.id = fa.album_id AND fa.user_id = $2
-		WHERE a.id = $1
+		WHERE a.id = $1 AND a.status = 'published'
 	`
 	GetAlbumTitleByIDQuery = `
 		SELECT title
@@ -42,11 +42,11 @@ const (
 		WHERE id = ANY($1)
 	`
 	GetAlbumsByIDsQuery = `
-		SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date, (fa.user_id IS NOT NULL) AS is_favorite
+		SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date, (fa.user_id IS NOT NULL) AS is_favorite
 		FROM album a
 		LEFT JOIN album_stats als ON a.id = als.album_id
 		LEFT JOIN favorite_album fa ON a.id = fa.album_id AND fa.user_id = $2
-		WHERE a.id = ANY($1)
+		WHERE a.id = ANY($1) AND a.status = 'published'
 		ORDER BY als.listeners_count DESC, a.id DESC
 	`
 
@@ -63,6 +63,14 @@ const (
 		)
 	`
 
+	CheckAlbumPublishedQuery = `
+		SELECT EXISTS (
+			SELECT 1
+			FROM album
+			WHERE id = $1 AND status = 'published'
+		)
+	`
+
 	LikeAlbumQuery = `
 		INSERT INTO favorite_album (album_id, user_id)
 		VALUES ($1, $2) ON CONFLICT DO NOTHING
@@ -74,43 +82,65 @@ const (
 	`
 
 	GetFavoriteAlbumsQuery = `
-		SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date
+		SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date
 		FROM album a
 		JOIN favorite_album fa ON a.id = fa.album_id
-		WHERE fa.user_id = $1
+		WHERE fa.user_id = $1 AND a.status = 'published'
 		ORDER BY fa.created_at DESC, a.id DESC
 		LIMIT $2 OFFSET $3
 	`
 
-	SearchAlbumsQuery = `
-		SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date, (fa.user_id IS NOT NULL) AS is_favorite
-		FROM album a
-		LEFT JOIN favorite_album fa ON a.id = fa.album_id AND fa.user_id = $2
-		WHERE a.search_vector @@ to_tsquery('multilingual', $1)
-		   OR similarity(a.title_trgm, $3) > 0.3
-		ORDER BY 
-		    CASE WHEN a.search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
-		    ts_rank(a.search_vector, to_tsquery('multilingual', $1)) DESC,
-		    similarity(a.title_trgm, $3) DESC
-	`
-
 	CreateAlbumQuery = `
-		INSERT INTO album (title, type, thumbnail_url, label_id)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO album (title, type, thumbnail_url, thumbnail_color, label_id)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id`
 
 	DeleteAlbumQuery = `
 		DELETE FROM album
 		WHERE id = $1
 	`
+	// Labels see their releases in every status
 	GetAlbumsLabelIDQuery = `
-		SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date, FALSE AS is_favorite
+		SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date, FALSE AS is_favorite, a.status
 		FROM album a
 		JOIN album_stats als ON a.id = als.album_id
 		WHERE a.label_id = $1
 		ORDER BY als.listeners_count DESC, a.id DESC
 		LIMIT $2 OFFSET $3
 	`
+	GetAlbumWithStatusQuery = `
+		SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date, a.status, COALESCE(a.label_id, 0)
+		FROM album a
+		WHERE a.id = $1
+	`
+	// Oldest first, so the review queue is worked through in submission order
+	GetAlbumsByStatusQuery = `
+		SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date, a.status
+		FROM album a
+		WHERE a.status = $1
+		ORDER BY a.updated_at ASC, a.id ASC
+		LIMIT $2 OFFSET $3
+	`
+	// The status is only changed if nobody changed it since it was read
+	ChangeAlbumStatusQuery = `
+		UPDATE album
+		SET status = $3,
+			release_date = COALESCE($4, release_date),
+			published_at = CASE WHEN $3::text = 'published' THEN NOW() ELSE published_at END,
+			updated_at = NOW()
+		WHERE id = $1 AND status = $2
+	`
+	EditAlbumQuery = `
+		UPDATE album
+		SET title = $3, type = $4, thumbnail_url = $5, thumbnail_color = $6, updated_at = NOW()
+		WHERE id = $1 AND label_id = $2
+	`
+	PublishScheduledAlbumsQuery = `
+		UPDATE album
+		SET status = 'published', published_at = NOW(), updated_at = NOW()
+		WHERE status = 'scheduled' AND release_date <= CURRENT_DATE
+		RETURNING id
+	`
 )
 
 type albumPostgresRepository struct {
@@ -157,7 +187,7 @@ func (r *albumPostgresRepository) GetAllAlbums(ctx context.Context, filters *rep
 	albums := make([]*repoModel.Album, 0)
 	for rows.Next() {
 		var album repoModel.Album
-		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ReleaseDate, &album.IsFavorite)
+		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ThumbnailColor, &album.ReleaseDate, &album.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetAllAlbums").Inc()
 			logger.Error("failed to scan album", zap.Error(err))
@@ -196,7 +226,7 @@ func (r *albumPostgresRepository) GetAlbumByID(ctx context.Context, id int64, us
 	row := stmt.QueryRowContext(ctx, id, userID)
 
 	var albumObject repoModel.Album
-	err = row.Scan(&albumObject.ID, &albumObject.Title, &albumObject.Type, &albumObject.Thumbnail, &albumObject.ReleaseDate, &albumObject.IsFavorite)
+	err = row.Scan(&albumObject.ID, &albumObject.Title, &albumObject.Type, &albumObject.Thumbnail, &albumObject.ThumbnailColor, &albumObject.ReleaseDate, &albumObject.IsFavorite)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("GetAlbumByID").Inc()
 		if errors.Is(err, sql.ErrNoRows) {
@@ -330,7 +360,7 @@ func (r *albumPostgresRepository) GetAlbumsByIDs(ctx context.Context, ids []int6
 	var albums []*repoModel.Album
 	for rows.Next() {
 		var album repoModel.Album
-		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ReleaseDate, &album.IsFavorite)
+		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ThumbnailColor, &album.ReleaseDate, &album.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetAlbumsByIDs").Inc()
 			logger.Error("failed to scan album", zap.Error(err))
@@ -467,7 +497,7 @@ func (r *albumPostgresRepository) GetFavoriteAlbums(ctx context.Context, filters
 		var album repoModel.Album
 		// Ставим по дефолту, так как запрашивашиваются избранные, то есть заведомо известно, что они лайкнуты
 		album.IsFavorite = true
-		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ReleaseDate)
+		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ThumbnailColor, &album.ReleaseDate)
 		if err != nil {
 			logger.Error("failed to scan album", zap.Error(err))
 			return nil, albumErrors.NewInternalError("failed to scan album: %v", err)
@@ -483,50 +513,6 @@ func (r *albumPostgresRepository) GetFavoriteAlbums(ctx context.Context, filters
 	return albums, nil
 }
 
-func (r *albumPostgresRepository) SearchAlbums(ctx context.Context, query string, userID int64) ([]*repoModel.Album, error) {
-	logger := loggerPkg.LoggerFromContext(ctx)
-	logger.Info("Searching albums by query", zap.String("query", query), zap.String("query", SearchAlbumsQuery))
-	stmt, err := r.db.PrepareContext(ctx, SearchAlbumsQuery)
-	if err != nil {
-		logger.Error("failed to prepare statement", zap.Error(err))
-		return nil, albumErrors.NewInternalError("failed to prepare statement: %v", err)
-	}
-	words := strings.Fields(query)
-	for i, word := range words {
-		words[i] = word + ":*"
-	}
-	tsQueryString := strings.Join(words, " & ")
-
-	rows, err := stmt.QueryContext(ctx, tsQueryString, userID, query)
-	if err != nil {
-		logger.Error("failed to search albums", zap.Error(err))
-		return nil, albumErrors.NewInternalError("failed to search albums: %v", err)
-	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			logger.Error("failed to close rows", zap.Error(err))
-		}
-	}()
-
-	var albums []*repoModel.Album
-	for rows.Next() {
-		var album repoModel.Album
-		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ReleaseDate, &album.IsFavorite)
-		if err != nil {
-			logger.Error("failed to scan album", zap.Error(err))
-			return nil, albumErrors.NewInternalError("failed to scan album: %v", err)
-		}
-		albums = append(albums, &album)
-	}
-
-	if err := rows.Err(); err != nil {
-		logger.Error("failed to search albums", zap.Error(err))
-		return nil, albumErrors.NewInternalError("failed to search albums: %v", err)
-	}
-
-	return albums, nil
-}
-
 func (r *albumPostgresRepository) CreateAlbum(ctx context.Context, album *repoModel.CreateAlbumRequest) (int64, error) {
 	start := time.Now()
 	logger := loggerPkg.LoggerFromContext(ctx)
@@ -544,7 +530,7 @@ func (r *albumPostgresRepository) CreateAlbum(ctx context.Context, album *repoMo
 		}
 	}()
 	var albumID int64
-	err = stmt.QueryRowContext(ctx, album.Title, album.Type, album.Thumbnail, album.LabelID).Scan(&albumID)
+	err = stmt.QueryRowContext(ctx, album.Title, album.Type, album.Thumbnail, album.ThumbnailColor, album.LabelID).Scan(&albumID)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("CreateAlbum").Inc()
 		logger.Error("failed to create album", zap.Error(err))
@@ -621,7 +607,7 @@ func (r *albumPostgresRepository) GetAlbumsLabelID(ctx context.Context, filters
 	for rows.Next() {
 		var album repoModel.Album
 		var isFavorite sql.NullBool
-		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ReleaseDate, &isFavorite)
+		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ThumbnailColor, &album.ReleaseDate, &isFavorite, &album.Status)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetAlbumsLabelID").Inc()
 			logger.Error("failed to scan album", zap.Error(err))
@@ -634,3 +620,223 @@ func (r *albumPostgresRepository) GetAlbumsLabelID(ctx context.Context, filters
 	r.metrics.DatabaseDuration.WithLabelValues("GetAlbumsLabelID").Observe(duration)
 	return albums, nil
 }
+
+func (r *albumPostgresRepository) CheckAlbumPublished(ctx context.Context, albumID int64) (bool, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Checking if album is published in db", zap.Int64("albumID", albumID), zap.String("query", CheckAlbumPublishedQuery))
+	stmt, err := r.db.PrepareContext(ctx, CheckAlbumPublishedQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CheckAlbumPublished").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return false, albumErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	var published bool
+	err = stmt.QueryRowContext(ctx, albumID).Scan(&published)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CheckAlbumPublished").Inc()
+		logger.Error("failed to check if album is published", zap.Error(err))
+		return false, albumErrors.NewInternalError("failed to check if album is published: %v", err)
+	}
+	return published, nil
+}
+
+func (r *albumPostgresRepository) GetAlbumWithStatus(ctx context.Context, albumID int64) (*repoModel.Album, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting album with status from db", zap.Int64("albumID", albumID), zap.String("query", GetAlbumWithStatusQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetAlbumWithStatusQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetAlbumWithStatus").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, albumErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	var album repoModel.Album
+	err = stmt.QueryRowContext(ctx, albumID).Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ThumbnailColor, &album.ReleaseDate, &album.Status, &album.LabelID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetAlbumWithStatus").Inc()
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("album not found", zap.Error(err))
+			return nil, albumErrors.ErrAlbumNotFound
+		}
+		logger.Error("failed to get album with status", zap.Error(err))
+		return nil, albumErrors.NewInternalError("failed to get album with status: %v", err)
+	}
+	r.metrics.DatabaseDuration.WithLabelValues("GetAlbumWithStatus").Observe(time.Since(start).Seconds())
+	return &album, nil
+}
+
+func (r *albumPostgresRepository) GetAlbumsByStatus(ctx context.Context, filters *repoModel.AlbumFilters, status repoModel.AlbumStatus) ([]*repoModel.Album, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting albums by status from db", zap.String("status", string(status)), zap.Any("filters", filters), zap.String("query", GetAlbumsByStatusQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetAlbumsByStatusQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetAlbumsByStatus").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, albumErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, status, filters.Pagination.Limit, filters.Pagination.Offset)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetAlbumsByStatus").Inc()
+		logger.Error("failed to get albums by status", zap.Error(err))
+		return nil, albumErrors.NewInternalError("failed to get albums by status: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	albums := make([]*repoModel.Album, 0)
+	for rows.Next() {
+		var album repoModel.Album
+		err = rows.Scan(&album.ID, &album.Title, &album.Type, &album.Thumbnail, &album.ThumbnailColor, &album.ReleaseDate, &album.Status)
+		if err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetAlbumsByStatus").Inc()
+			logger.Error("failed to scan album", zap.Error(err))
+			return nil, albumErrors.NewInternalError("failed to scan album: %v", err)
+		}
+		albums = append(albums, &album)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetAlbumsByStatus").Inc()
+		logger.Error("failed to get albums by status", zap.Error(err))
+		return nil, albumErrors.NewInternalError("failed to get albums by status: %v", err)
+	}
+	r.metrics.DatabaseDuration.WithLabelValues("GetAlbumsByStatus").Observe(time.Since(start).Seconds())
+	return albums, nil
+}
+
+func (r *albumPostgresRepository) ChangeAlbumStatus(ctx context.Context, change *repoModel.AlbumStatusChange) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Changing album status in db", zap.Any("change", change), zap.String("query", ChangeAlbumStatusQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, ChangeAlbumStatusQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ChangeAlbumStatus").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return albumErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	releaseDate := sql.NullTime{Time: change.ReleaseDate, Valid: !change.ReleaseDate.IsZero()}
+	result, err := stmt.ExecContext(ctx, change.AlbumID, change.From, change.To, releaseDate)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ChangeAlbumStatus").Inc()
+		logger.Error("failed to change album status", zap.Error(err))
+		return albumErrors.NewInternalError("failed to change album status: %v", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ChangeAlbumStatus").Inc()
+		logger.Error("failed to get affected rows", zap.Error(err))
+		return albumErrors.NewInternalError("failed to get affected rows: %v", err)
+	}
+	if affected == 0 {
+		logger.Warn("album status was changed concurrently", zap.Int64("albumID", change.AlbumID))
+		return albumErrors.ErrInvalidStatusTransition
+	}
+
+	r.metrics.DatabaseDuration.WithLabelValues("ChangeAlbumStatus").Observe(time.Since(start).Seconds())
+	return nil
+}
+
+func (r *albumPostgresRepository) PublishScheduledAlbums(ctx context.Context) ([]int64, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Publishing scheduled albums in db", zap.String("query", PublishScheduledAlbumsQuery))
+
+	rows, err := r.db.QueryContext(ctx, PublishScheduledAlbumsQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("PublishScheduledAlbums").Inc()
+		logger.Error("failed to publish scheduled albums", zap.Error(err))
+		return nil, albumErrors.NewInternalError("failed to publish scheduled albums: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	albumIDs := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("PublishScheduledAlbums").Inc()
+			logger.Error("failed to scan album id", zap.Error(err))
+			return nil, albumErrors.NewInternalError("failed to scan album id: %v", err)
+		}
+		albumIDs = append(albumIDs, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("PublishScheduledAlbums").Inc()
+		logger.Error("failed to publish scheduled albums", zap.Error(err))
+		return nil, albumErrors.NewInternalError("failed to publish scheduled albums: %v", err)
+	}
+	r.metrics.DatabaseDuration.WithLabelValues("PublishScheduledAlbums").Observe(time.Since(start).Seconds())
+	return albumIDs, nil
+}
+
+func (r *albumPostgresRepository) EditAlbum(ctx context.Context, edit *repoModel.AlbumEdit) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Editing album in db", zap.Any("edit", edit), zap.String("query", EditAlbumQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, EditAlbumQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("EditAlbum").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return albumErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	result, err := stmt.ExecContext(ctx, edit.ID, edit.LabelID, edit.Title, edit.Type, edit.Thumbnail, edit.ThumbnailColor)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("EditAlbum").Inc()
+		logger.Error("failed to edit album", zap.Error(err))
+		return albumErrors.NewInternalError("failed to edit album: %v", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("EditAlbum").Inc()
+		logger.Error("failed to get affected rows", zap.Error(err))
+		return albumErrors.NewInternalError("failed to get affected rows: %v", err)
+	}
+	if affected == 0 {
+		return albumErrors.ErrAlbumNotFound
+	}
+
+	r.metrics.DatabaseDuration.WithLabelValues("EditAlbum").Observe(time.Since(start).Seconds())
+	return nil
+}
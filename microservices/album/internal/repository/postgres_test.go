@@ -4,11 +4,13 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"regexp"
 	"testing"
 	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	albumErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/errors"
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/repository"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
 	"github.com/lib/pq"
@@ -41,11 +43,11 @@ func TestGetAllAlbums(t *testing.T) {
 	}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "release_date", "is_favorite"}).
-		AddRow(1, "Album 1", "album", "url1", time.Now(), true).
-		AddRow(2, "Album 2", "album", "url2", time.Now(), false)
+	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "thumbnail_color", "release_date", "is_favorite"}).
+		AddRow(1, "Album 1", "album", "url1", "", time.Now(), true).
+		AddRow(2, "Album 2", "album", "url2", "", time.Now(), false)
 
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnRows(rows)
@@ -72,10 +74,10 @@ func TestGetAlbumByID(t *testing.T) {
 	userID := int64(1)
 	releaseDate := time.Now()
 
-	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "release_date", "is_favorite"}).
-		AddRow(albumID, "Test Album", "album", "thumbnail_url", releaseDate, true)
+	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "thumbnail_color", "release_date", "is_favorite"}).
+		AddRow(albumID, "Test Album", "album", "thumbnail_url", "", releaseDate, true)
 
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(albumID, userID).
 		WillReturnRows(rows)
@@ -99,7 +101,7 @@ func TestGetAlbumByIDNotFound(t *testing.T) {
 	albumID := int64(999)
 	userID := int64(1)
 
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(albumID, userID).
 		WillReturnError(sql.ErrNoRows)
@@ -184,11 +186,11 @@ func TestGetAlbumsByIDs(t *testing.T) {
 	userID := int64(1)
 	releaseDate := time.Now()
 
-	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "release_date", "is_favorite"}).
-		AddRow(1, "Album 1", "album", "url1", releaseDate, true).
-		AddRow(2, "Album 2", "album", "url2", releaseDate, false)
+	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "thumbnail_color", "release_date", "is_favorite"}).
+		AddRow(1, "Album 1", "album", "url1", "", releaseDate, true).
+		AddRow(2, "Album 2", "album", "url2", "", releaseDate, false)
 
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(pq.Array(albumIDs), userID).
 		WillReturnRows(rows)
@@ -303,11 +305,11 @@ func TestGetFavoriteAlbums(t *testing.T) {
 	userID := int64(1)
 	releaseDate := time.Now()
 
-	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "release_date"}).
-		AddRow(1, "Album 1", "album", "url1", releaseDate).
-		AddRow(2, "Album 2", "album", "url2", releaseDate)
+	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "thumbnail_color", "release_date"}).
+		AddRow(1, "Album 1", "album", "url1", "", releaseDate).
+		AddRow(2, "Album 2", "album", "url2", "", releaseDate)
 
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(userID, filters.Pagination.Limit, filters.Pagination.Offset).
 		WillReturnRows(rows)
@@ -324,64 +326,6 @@ func TestGetFavoriteAlbums(t *testing.T) {
 	assert.True(t, albums[1].IsFavorite)
 }
 
-func TestSearchAlbums(t *testing.T) {
-	db, mock, ctx := setupTest(t)
-	defer db.Close()
-
-	repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
-
-	query := "test"
-	userID := int64(1)
-	releaseDate := time.Now()
-
-	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "release_date", "is_favorite"}).
-		AddRow(1, "Test Album", "album", "url1", releaseDate, true).
-		AddRow(2, "Another Test", "album", "url2", releaseDate, false)
-
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
-		ExpectQuery().
-		WithArgs("test:*", userID, query).
-		WillReturnRows(rows)
-
-	albums, err := repo.SearchAlbums(ctx, query, userID)
-
-	require.NoError(t, err)
-	assert.Len(t, albums, 2)
-	assert.Equal(t, int64(1), albums[0].ID)
-	assert.Equal(t, "Test Album", albums[0].Title)
-	assert.True(t, albums[0].IsFavorite)
-	assert.Equal(t, int64(2), albums[1].ID)
-	assert.Equal(t, "Another Test", albums[1].Title)
-	assert.False(t, albums[1].IsFavorite)
-}
-
-func TestSearchAlbumsMultipleWords(t *testing.T) {
-	db, mock, ctx := setupTest(t)
-	defer db.Close()
-
-	repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
-
-	query := "test album"
-	userID := int64(1)
-	releaseDate := time.Now()
-
-	rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "release_date", "is_favorite"}).
-		AddRow(1, "Test Album", "album", "url1", releaseDate, true)
-
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
-		ExpectQuery().
-		WithArgs("test:* & album:*", userID, query).
-		WillReturnRows(rows)
-
-	albums, err := repo.SearchAlbums(ctx, query, userID)
-
-	require.NoError(t, err)
-	assert.Len(t, albums, 1)
-	assert.Equal(t, int64(1), albums[0].ID)
-	assert.Equal(t, "Test Album", albums[0].Title)
-	assert.True(t, albums[0].IsFavorite)
-}
-
 func TestGetAllAlbumsError(t *testing.T) {
 	db, mock, ctx := setupTest(t)
 	defer db.Close()
@@ -397,7 +341,7 @@ func TestGetAllAlbumsError(t *testing.T) {
 	userID := int64(1)
 
 	expectedErr := errors.New("database error")
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnError(expectedErr)
@@ -417,7 +361,7 @@ func TestGetAlbumsByIDsError(t *testing.T) {
 	userID := int64(1)
 
 	expectedErr := errors.New("database error")
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(pq.Array(albumIDs), userID).
 		WillReturnError(expectedErr)
@@ -476,7 +420,6 @@ func TestCreateAlbum(t *testing.T) {
         Title:     "New Album",
         Type:      repoModel.AlbumTypeAlbum,
         Thumbnail: "thumbnail_url",
-        Image:     nil,
         LabelID:   1,
     }
 
@@ -484,7 +427,7 @@ func TestCreateAlbum(t *testing.T) {
 
     mock.ExpectPrepare("INSERT INTO album").
         ExpectQuery().
-        WithArgs(album.Title, album.Type, album.Thumbnail, album.LabelID).
+        WithArgs(album.Title, album.Type, album.Thumbnail, album.ThumbnailColor, album.LabelID).
         WillReturnRows(rows)
 
     id, err := repo.CreateAlbum(ctx, album)
@@ -503,13 +446,12 @@ func TestCreateAlbumError(t *testing.T) {
         Title:     "New Album",
         Type:      repoModel.AlbumTypeAlbum,
         Thumbnail: "thumbnail_url",
-        Image:     nil,
         LabelID:   1,
     }
 
     mock.ExpectPrepare("INSERT INTO album").
         ExpectQuery().
-        WithArgs(album.Title, album.Image, album.Thumbnail, album.LabelID).
+        WithArgs(album.Title, album.Type, album.Thumbnail, album.ThumbnailColor, album.LabelID).
         WillReturnError(errors.New("database error"))
 
     id, err := repo.CreateAlbum(ctx, album)
@@ -569,11 +511,11 @@ func TestGetAlbumsLabelID(t *testing.T) {
     }
     releaseDate := time.Now()
 
-    rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "release_date", "is_favorite"}).
-        AddRow(1, "Album 1", "album", "url1", releaseDate, false).
-        AddRow(2, "Album 2", "album", "url2", releaseDate, false)
+    rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "thumbnail_color", "release_date", "is_favorite", "status"}).
+        AddRow(1, "Album 1", "album", "url1", "", releaseDate, false, "published").
+        AddRow(2, "Album 2", "album", "url2", "", releaseDate, false, "draft")
 
-    mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+    mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
         ExpectQuery().
         WithArgs(labelID, filters.Pagination.Limit, filters.Pagination.Offset).
         WillReturnRows(rows)
@@ -584,8 +526,10 @@ func TestGetAlbumsLabelID(t *testing.T) {
     assert.Len(t, albums, 2)
     assert.Equal(t, int64(1), albums[0].ID)
     assert.Equal(t, "Album 1", albums[0].Title)
+    assert.Equal(t, repoModel.AlbumStatusPublished, albums[0].Status)
     assert.Equal(t, int64(2), albums[1].ID)
     assert.Equal(t, "Album 2", albums[1].Title)
+    assert.Equal(t, repoModel.AlbumStatusDraft, albums[1].Status)
 }
 
 func TestGetAlbumsLabelIDError(t *testing.T) {
@@ -603,7 +547,7 @@ func TestGetAlbumsLabelIDError(t *testing.T) {
 	}
 
 	expectedErr := errors.New("database error")
-	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.release_date").
+	mock.ExpectPrepare("SELECT a.id, a.title, a.type, a.thumbnail_url, a.thumbnail_color, a.release_date").
 		ExpectQuery().
 		WithArgs(labelID, filters.Pagination.Limit, filters.Pagination.Offset).
 		WillReturnError(expectedErr)
@@ -611,4 +555,160 @@ func TestGetAlbumsLabelIDError(t *testing.T) {
 	_, err := repo.GetAlbumsLabelID(ctx, filters, labelID)
 
 	require.Error(t, err)
-}
\ No newline at end of file
+}
+func TestGetAlbumWithStatus(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    releaseDate := time.Now()
+    rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "thumbnail_color", "release_date", "status", "label_id"}).
+        AddRow(1, "Album 1", "album", "url1", "", releaseDate, "draft", 2)
+
+    mock.ExpectPrepare(regexp.QuoteMeta(GetAlbumWithStatusQuery)).
+        ExpectQuery().
+        WithArgs(int64(1)).
+        WillReturnRows(rows)
+
+    album, err := repo.GetAlbumWithStatus(ctx, 1)
+
+    require.NoError(t, err)
+    assert.Equal(t, repoModel.AlbumStatusDraft, album.Status)
+    assert.Equal(t, int64(2), album.LabelID)
+}
+
+func TestGetAlbumWithStatusNotFound(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    mock.ExpectPrepare(regexp.QuoteMeta(GetAlbumWithStatusQuery)).
+        ExpectQuery().
+        WithArgs(int64(1)).
+        WillReturnError(sql.ErrNoRows)
+
+    album, err := repo.GetAlbumWithStatus(ctx, 1)
+
+    assert.ErrorIs(t, err, albumErrors.ErrAlbumNotFound)
+    assert.Nil(t, album)
+}
+
+func TestGetAlbumsByStatus(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    filters := &repoModel.AlbumFilters{
+        Pagination: &repoModel.Pagination{
+            Limit:  10,
+            Offset: 0,
+        },
+    }
+    rows := sqlmock.NewRows([]string{"id", "title", "type", "thumbnail_url", "thumbnail_color", "release_date", "status"}).
+        AddRow(1, "Album 1", "album", "url1", "", time.Now(), "in_review")
+
+    mock.ExpectPrepare(regexp.QuoteMeta(GetAlbumsByStatusQuery)).
+        ExpectQuery().
+        WithArgs(repoModel.AlbumStatusInReview, filters.Pagination.Limit, filters.Pagination.Offset).
+        WillReturnRows(rows)
+
+    albums, err := repo.GetAlbumsByStatus(ctx, filters, repoModel.AlbumStatusInReview)
+
+    require.NoError(t, err)
+    assert.Len(t, albums, 1)
+    assert.Equal(t, repoModel.AlbumStatusInReview, albums[0].Status)
+}
+
+func TestChangeAlbumStatus(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    releaseDate := time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC)
+    mock.ExpectPrepare(regexp.QuoteMeta(ChangeAlbumStatusQuery)).
+        ExpectExec().
+        WithArgs(int64(1), repoModel.AlbumStatusDraft, repoModel.AlbumStatusInReview, sql.NullTime{Time: releaseDate, Valid: true}).
+        WillReturnResult(sqlmock.NewResult(0, 1))
+
+    err := repo.ChangeAlbumStatus(ctx, &repoModel.AlbumStatusChange{
+        AlbumID:     1,
+        From:        repoModel.AlbumStatusDraft,
+        To:          repoModel.AlbumStatusInReview,
+        ReleaseDate: releaseDate,
+    })
+
+    require.NoError(t, err)
+    require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestChangeAlbumStatusChangedConcurrently(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    mock.ExpectPrepare(regexp.QuoteMeta(ChangeAlbumStatusQuery)).
+        ExpectExec().
+        WithArgs(int64(1), repoModel.AlbumStatusInReview, repoModel.AlbumStatusScheduled, sql.NullTime{}).
+        WillReturnResult(sqlmock.NewResult(0, 0))
+
+    err := repo.ChangeAlbumStatus(ctx, &repoModel.AlbumStatusChange{
+        AlbumID: 1,
+        From:    repoModel.AlbumStatusInReview,
+        To:      repoModel.AlbumStatusScheduled,
+    })
+
+    assert.ErrorIs(t, err, albumErrors.ErrInvalidStatusTransition)
+}
+
+func TestPublishScheduledAlbums(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    mock.ExpectQuery(regexp.QuoteMeta(PublishScheduledAlbumsQuery)).
+        WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(3))
+
+    albumIDs, err := repo.PublishScheduledAlbums(ctx)
+
+    require.NoError(t, err)
+    assert.Equal(t, []int64{1, 3}, albumIDs)
+}
+
+func TestEditAlbum(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    mock.ExpectPrepare(regexp.QuoteMeta(EditAlbumQuery)).
+        ExpectExec().
+        WithArgs(int64(1), int64(2), "New", repoModel.AlbumTypeEP, "new.jpg", "#a1b2c3").
+        WillReturnResult(sqlmock.NewResult(0, 1))
+
+    err := repo.EditAlbum(ctx, &repoModel.AlbumEdit{ID: 1, LabelID: 2, Title: "New", Type: repoModel.AlbumTypeEP, Thumbnail: "new.jpg", ThumbnailColor: "#a1b2c3"})
+
+    require.NoError(t, err)
+    require.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEditAlbumNotFound(t *testing.T) {
+    db, mock, ctx := setupTest(t)
+    defer db.Close()
+
+    repo := NewAlbumPostgresRepository(db, metrics.NewMockMetrics())
+
+    mock.ExpectPrepare(regexp.QuoteMeta(EditAlbumQuery)).
+        ExpectExec().
+        WithArgs(int64(1), int64(2), "New", repoModel.AlbumTypeEP, "new.jpg", "#a1b2c3").
+        WillReturnResult(sqlmock.NewResult(0, 0))
+
+    err := repo.EditAlbum(ctx, &repoModel.AlbumEdit{ID: 1, LabelID: 2, Title: "New", Type: repoModel.AlbumTypeEP, Thumbnail: "new.jpg", ThumbnailColor: "#a1b2c3"})
+
+    assert.ErrorIs(t, err, albumErrors.ErrAlbumNotFound)
+}
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"database/sql"
+
+	albumErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/errors"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+)
+
+// Only published albums are searchable, the rest would take up a page slot and then be
+// dropped when the page is materialized
+const (
+	SearchAlbumIDsQuery = `
+		SELECT a.id
+		FROM album a
+		LEFT JOIN album_stats ast ON a.id = ast.album_id
+		WHERE a.status = 'published'
+		  AND (a.search_vector @@ to_tsquery('multilingual', $1)
+		   OR similarity(a.title_trgm, $2) > 0.3)
+		ORDER BY 
+		    CASE WHEN a.search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
+		    (ts_rank(a.search_vector, to_tsquery('multilingual', $1)) + similarity(a.title_trgm, $2))
+		        * (1 + ln(1 + COALESCE(ast.listeners_count, 0) + COALESCE(ast.favorites_count, 0))) DESC,
+		    a.id DESC
+		LIMIT NULLIF($3, 0) OFFSET $4
+	`
+
+	GetAlbumSearchDocumentsQuery = `
+		SELECT a.id, a.title, COALESCE(ast.listeners_count, 0) + COALESCE(ast.favorites_count, 0)
+		FROM album a
+		LEFT JOIN album_stats ast ON a.id = ast.album_id
+		WHERE a.status = 'published'
+		  AND ($1::bigint[] IS NULL OR a.id = ANY($1))
+	`
+)
+
+// NewAlbumPostgresSearchIndex is the search backend built on the generated search_vector and
+// title_trgm columns of the album table
+func NewAlbumPostgresSearchIndex(db *sql.DB, metrics *metrics.Metrics) *searchIndex.PostgresIndex {
+	return searchIndex.NewPostgresIndex(db, metrics, searchIndex.PostgresQueries{
+		Entity:    "Album",
+		Search:    SearchAlbumIDsQuery,
+		Documents: GetAlbumSearchDocumentsQuery,
+	}, albumErrors.NewInternalError)
+}
@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	albumErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/errors"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAlbumSearchIndexQueries(t *testing.T) {
+	// drafts and scheduled releases must stay out of both the search and the in-process index
+	assert.Contains(t, SearchAlbumIDsQuery, "a.status = 'published'")
+	assert.Contains(t, SearchAlbumIDsQuery, "album_stats")
+	assert.Contains(t, GetAlbumSearchDocumentsQuery, "a.status = 'published'")
+	assert.Contains(t, GetAlbumSearchDocumentsQuery, "a.title")
+}
+
+func TestAlbumSearchIndexRunsItsQueries(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	index := NewAlbumPostgresSearchIndex(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare(regexp.QuoteMeta(SearchAlbumIDsQuery)).
+		ExpectQuery().
+		WithArgs("first:*", "first", int64(10), int64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectPrepare(regexp.QuoteMeta(GetAlbumSearchDocumentsQuery)).
+		ExpectQuery().
+		WithArgs(pq.Array([]int64{1})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "text", "popularity"}).AddRow(1, "First Album", 10))
+
+	ids, err := index.Search(ctx, "first", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	docs, err := index.LoadDocuments(ctx, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []*searchIndex.Document{{ID: 1, Text: "First Album", Popularity: 10}}, docs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestAlbumSearchIndexReturnsAlbumErrors(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	index := NewAlbumPostgresSearchIndex(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare(regexp.QuoteMeta(SearchAlbumIDsQuery)).
+		ExpectQuery().
+		WillReturnError(errors.New("db error"))
+
+	ids, err := index.Search(ctx, "first", 10, 0)
+	assert.Nil(t, ids)
+	var albumErr *albumErrors.AlbumError
+	require.ErrorAs(t, err, &albumErr)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -2,20 +2,49 @@ package usecase
 
 import (
 	"context"
+	"strings"
+	"time"
+	"unicode/utf8"
 
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/internal/domain"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model"
 	albumErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/errors"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+	"go.uber.org/zap"
 )
 
 type AlbumUsecase struct {
 	albumRepository domain.Repository
 	s3Repository    domain.S3Repository
+	searchIndex     searchIndex.Index
+	now             func() time.Time
 }
 
-func NewAlbumUsecase(albumRepository domain.Repository, s3Repository domain.S3Repository) domain.Usecase {
-	return &AlbumUsecase{albumRepository: albumRepository, s3Repository: s3Repository}
+func NewAlbumUsecase(albumRepository domain.Repository, s3Repository domain.S3Repository, searchIndex searchIndex.Index) domain.Usecase {
+	return &AlbumUsecase{albumRepository: albumRepository, s3Repository: s3Repository, searchIndex: searchIndex, now: time.Now}
+}
+
+// albumTransitions lists the allowed status changes, the value tells whether only reviewers can make it
+var albumTransitions = map[usecaseModel.AlbumStatus]map[usecaseModel.AlbumStatus]bool{
+	usecaseModel.AlbumStatusDraft: {
+		usecaseModel.AlbumStatusInReview: false,
+	},
+	usecaseModel.AlbumStatusInReview: {
+		usecaseModel.AlbumStatusDraft:     false,
+		usecaseModel.AlbumStatusScheduled: true,
+	},
+	usecaseModel.AlbumStatusScheduled: {
+		usecaseModel.AlbumStatusDraft: false,
+	},
+	usecaseModel.AlbumStatusPublished: {
+		usecaseModel.AlbumStatusTakenDown: false,
+	},
+	usecaseModel.AlbumStatusTakenDown: {
+		usecaseModel.AlbumStatusDraft: false,
+	},
 }
 
 func (u *AlbumUsecase) GetAllAlbums(ctx context.Context, filters *usecaseModel.AlbumFilters, userID int64) ([]*usecaseModel.Album, error) {
@@ -76,12 +105,12 @@ func (u *AlbumUsecase) CreateStream(ctx context.Context, albumID int64, userID i
 func (u *AlbumUsecase) LikeAlbum(ctx context.Context, request *usecaseModel.LikeRequest) error {
 	repoRequest := model.LikeRequestFromUsecaseToRepository(request)
 
-	exists, err := u.albumRepository.CheckAlbumExists(ctx, request.AlbumID)
+	published, err := u.albumRepository.CheckAlbumPublished(ctx, request.AlbumID)
 	if err != nil {
 		return err
 	}
 
-	if !exists {
+	if !published {
 		return albumErrors.NewNotFoundError("album not found")
 	}
 	if request.IsLike {
@@ -109,28 +138,59 @@ func (u *AlbumUsecase) GetFavoriteAlbums(ctx context.Context, filters *usecaseMo
 	return model.AlbumListFromRepositoryToUsecase(albums), nil
 }
 
-func (u *AlbumUsecase) SearchAlbums(ctx context.Context, query string, userID int64) ([]*usecaseModel.Album, error) {
-	albums, err := u.albumRepository.SearchAlbums(ctx, query, userID)
+func (u *AlbumUsecase) SearchAlbums(ctx context.Context, query string, userID int64, filters *usecaseModel.AlbumFilters) ([]*usecaseModel.Album, error) {
+	ids, err := u.searchIndex.Search(ctx, query, filters.Pagination.Limit, filters.Pagination.Offset)
 	if err != nil {
 		return nil, err
 	}
 
-	return model.AlbumListFromRepositoryToUsecase(albums), nil
+	albums, err := u.albumRepository.GetAlbumsByIDs(ctx, ids, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	albumsByID := make(map[int64]*repoModel.Album, len(albums))
+	for _, album := range albums {
+		albumsByID[album.ID] = album
+	}
+
+	ranked := make([]*repoModel.Album, 0, len(ids))
+	for _, id := range ids {
+		if album, ok := albumsByID[id]; ok {
+			ranked = append(ranked, album)
+		}
+	}
+
+	return model.AlbumListFromRepositoryToUsecase(ranked), nil
 }
 
-func (u *AlbumUsecase) CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (int64, string, error) {
+// CreateAlbum stores the cover and creates a draft album, the result has the new id and the cover
+func (u *AlbumUsecase) CreateAlbum(ctx context.Context, album *usecaseModel.CreateAlbumRequest) (*usecaseModel.Album, error) {
 	repoAlbum := model.AlbumRequestFromUsecaseToRepository(album)
-	avatarThumbnail, err := u.s3Repository.UploadAlbumAvatar(ctx, album.Title, album.Image)
+	avatarThumbnail, thumbnailColor, err := u.s3Repository.CopyAlbumAvatar(ctx, album.Title, album.ImageKey)
 	if err != nil {
-		return 0, "", err
+		return nil, err
 	}
 	repoAlbum.Thumbnail = avatarThumbnail
+	repoAlbum.ThumbnailColor = thumbnailColor
 	albumID, err := u.albumRepository.CreateAlbum(ctx, repoAlbum)
 	if err != nil {
-		return 0, "", err
+		return nil, err
 	}
 
-	return albumID, avatarThumbnail, nil
+	if err := u.searchIndex.Refresh(ctx, []int64{albumID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+
+	return &usecaseModel.Album{
+		ID:             albumID,
+		Title:          album.Title,
+		Type:           album.Type,
+		Thumbnail:      avatarThumbnail,
+		ThumbnailColor: thumbnailColor,
+		Status:         usecaseModel.AlbumStatusDraft,
+	}, nil
 }
 
 func (u *AlbumUsecase) DeleteAlbum(ctx context.Context, albumID int64) error {
@@ -145,6 +205,11 @@ func (u *AlbumUsecase) DeleteAlbum(ctx context.Context, albumID int64) error {
 	if err != nil {
 		return err
 	}
+
+	if err := u.searchIndex.Remove(ctx, []int64{albumID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to remove album from search index", zap.Error(err))
+	}
 	return nil
 }
 
@@ -157,3 +222,153 @@ func (u *AlbumUsecase) GetAlbumsLabelID(ctx context.Context, filters *usecaseMod
 
 	return model.AlbumListFromRepositoryToUsecase(albums), nil
 }
+
+// getLabelAlbum hides albums of other labels as if they did not exist, reviewers see every album
+func (u *AlbumUsecase) getLabelAlbum(ctx context.Context, albumID int64, labelID int64, isAdmin bool) (*repoModel.Album, error) {
+	album, err := u.albumRepository.GetAlbumWithStatus(ctx, albumID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && album.LabelID != labelID {
+		return nil, albumErrors.ErrAlbumNotFound
+	}
+	return album, nil
+}
+
+func (u *AlbumUsecase) GetLabelAlbumByID(ctx context.Context, albumID int64, labelID int64, isAdmin bool) (*usecaseModel.Album, error) {
+	album, err := u.getLabelAlbum(ctx, albumID, labelID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.AlbumFromRepositoryToUsecase(album), nil
+}
+
+func (u *AlbumUsecase) GetAlbumsByStatus(ctx context.Context, filters *usecaseModel.AlbumFilters, status usecaseModel.AlbumStatus) ([]*usecaseModel.Album, error) {
+	if _, ok := albumTransitions[status]; !ok {
+		return nil, albumErrors.NewInvalidArgumentError("unknown album status %q", status)
+	}
+
+	repoFilters := model.FiltersFromUsecaseToRepository(filters)
+	albums, err := u.albumRepository.GetAlbumsByStatus(ctx, repoFilters, repoModel.AlbumStatus(status))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.AlbumListFromRepositoryToUsecase(albums), nil
+}
+
+func (u *AlbumUsecase) ChangeAlbumStatus(ctx context.Context, request *usecaseModel.ChangeAlbumStatusRequest) (*usecaseModel.Album, error) {
+	if _, ok := albumTransitions[request.Status]; !ok {
+		return nil, albumErrors.NewInvalidArgumentError("unknown album status %q", request.Status)
+	}
+
+	album, err := u.getLabelAlbum(ctx, request.AlbumID, request.LabelID, request.IsAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	from := usecaseModel.AlbumStatus(album.Status)
+	reviewOnly, ok := albumTransitions[from][request.Status]
+	if !ok {
+		return nil, albumErrors.ErrInvalidStatusTransition
+	}
+	if reviewOnly && !request.IsAdmin {
+		return nil, albumErrors.ErrReviewRequired
+	}
+
+	change := &repoModel.AlbumStatusChange{
+		AlbumID: album.ID,
+		From:    album.Status,
+		To:      repoModel.AlbumStatus(request.Status),
+	}
+
+	// The release date is fixed when the album is sent to review, reviewers schedule it as is
+	if request.Status == usecaseModel.AlbumStatusInReview {
+		if request.ReleaseDate.IsZero() {
+			return nil, albumErrors.ErrReleaseDateRequired
+		}
+		today := u.now().UTC().Truncate(24 * time.Hour)
+		if request.ReleaseDate.UTC().Before(today) {
+			return nil, albumErrors.ErrReleaseDateInPast
+		}
+		change.ReleaseDate = request.ReleaseDate
+	}
+
+	if err := u.albumRepository.ChangeAlbumStatus(ctx, change); err != nil {
+		return nil, err
+	}
+
+	album.Status = change.To
+	if !change.ReleaseDate.IsZero() {
+		album.ReleaseDate = change.ReleaseDate
+	}
+
+	// Only published albums are searchable, the refresh adds or drops the album accordingly
+	if err := u.searchIndex.Refresh(ctx, []int64{album.ID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+	return model.AlbumFromRepositoryToUsecase(album), nil
+}
+
+func (u *AlbumUsecase) PublishScheduledAlbums(ctx context.Context) ([]int64, error) {
+	albumIDs, err := u.albumRepository.PublishScheduledAlbums(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(albumIDs) > 0 {
+		if err := u.searchIndex.Refresh(ctx, albumIDs); err != nil {
+			logger := loggerPkg.LoggerFromContext(ctx)
+			logger.Warn("failed to refresh search index", zap.Error(err))
+		}
+	}
+	return albumIDs, nil
+}
+
+const maxAlbumTitleLength = 100
+
+// EditAlbum renames an album of the label, changes its type and swaps the cover when a new one is uploaded
+func (u *AlbumUsecase) EditAlbum(ctx context.Context, request *usecaseModel.EditAlbumRequest) (*usecaseModel.Album, error) {
+	title := strings.TrimSpace(request.Title)
+	titleLength := utf8.RuneCountInString(title)
+	if titleLength == 0 || titleLength > maxAlbumTitleLength {
+		return nil, albumErrors.ErrAlbumTitleInvalid
+	}
+
+	album, err := u.getLabelAlbum(ctx, request.AlbumID, request.LabelID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	edit := &repoModel.AlbumEdit{
+		ID:             album.ID,
+		LabelID:        request.LabelID,
+		Title:          title,
+		Type:           repoModel.AlbumType(request.Type),
+		Thumbnail:      album.Thumbnail,
+		ThumbnailColor: album.ThumbnailColor,
+	}
+	if request.ImageKey != "" {
+		edit.Thumbnail, edit.ThumbnailColor, err = u.s3Repository.CopyAlbumAvatar(ctx, title, request.ImageKey)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if err := u.albumRepository.EditAlbum(ctx, edit); err != nil {
+		return nil, err
+	}
+
+	if err := u.searchIndex.Refresh(ctx, []int64{album.ID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+
+	album.Title = edit.Title
+	album.Type = edit.Type
+	album.Thumbnail = edit.Thumbnail
+	album.ThumbnailColor = edit.ThumbnailColor
+	return model.AlbumFromRepositoryToUsecase(album), nil
+}
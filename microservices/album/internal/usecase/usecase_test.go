@@ -3,11 +3,15 @@ package usecase
 import (
 	"context"
 	"testing"
+	"time"
 
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	mock_domain "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/internal/mocks"
+	mock_searchIndex "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex/mocks"
 	"errors"
+	"strings"
 
+	albumErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/errors"
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/usecase"
 	"github.com/stretchr/testify/assert"
@@ -29,7 +33,8 @@ func setupTest(t *testing.T) (*mock_domain.MockRepository, context.Context) {
 func TestCreateAlbum(t *testing.T) {
 	mockRepo, ctx := setupTest(t)
 	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
-	usecase := NewAlbumUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mockIndex)
 
 	createAlbumRequest := &usecaseModel.CreateAlbumRequest{
 		Title:   "New Album",
@@ -39,41 +44,66 @@ func TestCreateAlbum(t *testing.T) {
 
 	mockRepo.EXPECT().CreateAlbum(ctx, gomock.Any()).Return(int64(1), nil)
 
-	mockS3Repo.EXPECT().UploadAlbumAvatar(ctx, "New Album", gomock.Any()).Return("thumbnail_url", nil)
+	mockS3Repo.EXPECT().CopyAlbumAvatar(ctx, "New Album", gomock.Any()).Return("thumbnail_url", "#a1b2c3", nil)
 
-	albumID, thumbnail_url, err := usecase.CreateAlbum(ctx, createAlbumRequest)
+	mockIndex.EXPECT().Refresh(ctx, []int64{1}).Return(nil)
+
+	album, err := usecase.CreateAlbum(ctx, createAlbumRequest)
 	require.NoError(t, err)
-	assert.Equal(t, int64(1), albumID)
-	assert.Equal(t, "thumbnail_url", thumbnail_url)
+	assert.Equal(t, int64(1), album.ID)
+	assert.Equal(t, "thumbnail_url", album.Thumbnail)
+	assert.Equal(t, "#a1b2c3", album.ThumbnailColor)
 }
 
 
+func TestCreateAlbumSearchIndexError(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mockIndex)
+
+	createAlbumRequest := &usecaseModel.CreateAlbumRequest{
+		Title:   "New Album",
+		Type:    "album",
+		LabelID: 1,
+	}
+
+	mockS3Repo.EXPECT().CopyAlbumAvatar(ctx, "New Album", gomock.Any()).Return("thumbnail_url", "#a1b2c3", nil)
+	mockRepo.EXPECT().CreateAlbum(ctx, gomock.Any()).Return(int64(1), nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{1}).Return(errors.New("index error"))
+
+	// the album is already stored, the index catches up on the next rebuild
+	album, err := usecase.CreateAlbum(ctx, createAlbumRequest)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), album.ID)
+}
+
 func TestCreateAlbumError(t *testing.T) {
     mockRepo, ctx := setupTest(t)
     mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
-    usecase := NewAlbumUsecase(mockRepo, mockS3Repo)
+    usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
     createAlbumRequest := &usecaseModel.CreateAlbumRequest{
         Title:   "New Album",
         Type:    "album",
         LabelID: 1,
-        Image:   []byte("test image"),
+        ImageKey: "uploads/cover",
     }
 
-    mockS3Repo.EXPECT().UploadAlbumAvatar(ctx, "New Album", gomock.Any()).Return("thumbnail_url", nil)
+    mockS3Repo.EXPECT().CopyAlbumAvatar(ctx, "New Album", gomock.Any()).Return("thumbnail_url", "#a1b2c3", nil)
     
     mockRepo.EXPECT().CreateAlbum(ctx, gomock.Any()).Return(int64(0), errors.New("failed to create album"))
 
-    albumID, thumbnail_url, err := usecase.CreateAlbum(ctx, createAlbumRequest)
+    album, err := usecase.CreateAlbum(ctx, createAlbumRequest)
     require.Error(t, err)
-    assert.Equal(t, int64(0), albumID)
-    assert.Empty(t, thumbnail_url)
+    assert.Nil(t, album)
 }
 
 func TestDeleteAlbum(t *testing.T) {
     mockRepo, ctx := setupTest(t)
     mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
-    usecase := NewAlbumUsecase(mockRepo, mockS3Repo)
+    mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+    usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mockIndex)
 
     albumID := int64(1)
 
@@ -81,6 +111,8 @@ func TestDeleteAlbum(t *testing.T) {
     
     mockRepo.EXPECT().DeleteAlbum(ctx, albumID).Return(nil)
 
+    mockIndex.EXPECT().Remove(ctx, []int64{albumID}).Return(nil)
+
     err := usecase.DeleteAlbum(ctx, albumID)
     require.NoError(t, err)
 }
@@ -88,7 +120,7 @@ func TestDeleteAlbum(t *testing.T) {
 func TestDeleteAlbumNotFound(t *testing.T) {
 	mockRepo, ctx := setupTest(t)
 	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
-	usecase := NewAlbumUsecase(mockRepo, mockS3Repo)
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	albumID := int64(1)
 
@@ -102,7 +134,7 @@ func TestDeleteAlbumNotFound(t *testing.T) {
 func TestDeleteAlbumError(t *testing.T) {
 	mockRepo, ctx := setupTest(t)
 	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
-	usecase := NewAlbumUsecase(mockRepo, mockS3Repo)
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	albumID := int64(1)
 
@@ -118,7 +150,7 @@ func TestDeleteAlbumError(t *testing.T) {
 func TestGetAlbumsLabelID(t *testing.T) {
     mockRepo, ctx := setupTest(t)
     mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
-    usecase := NewAlbumUsecase(mockRepo, mockS3Repo)
+    usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
     labelID := int64(1)
     expectedAlbums := []*repoModel.Album{
@@ -146,7 +178,7 @@ func TestGetAlbumsLabelID(t *testing.T) {
 func TestGetAlbumsLabelIDError(t *testing.T) {
 	mockRepo, ctx := setupTest(t)
 	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
-	usecase := NewAlbumUsecase(mockRepo, mockS3Repo)
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	labelID := int64(1)
 	filters := &usecaseModel.AlbumFilters{
@@ -161,4 +193,270 @@ func TestGetAlbumsLabelIDError(t *testing.T) {
 	albums, err := usecase.GetAlbumsLabelID(ctx, filters, labelID)
 	require.Error(t, err)
 	assert.Nil(t, albums)
-}
\ No newline at end of file
+}
+
+func TestSearchAlbums(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mockIndex)
+
+	query := "test"
+	userID := int64(1)
+	filters := &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{
+			Limit:  10,
+			Offset: 0,
+		},
+	}
+
+	// the database returns albums in its own order, the index order wins
+	repoAlbums := []*repoModel.Album{
+		{ID: 1, Title: "Test Album", Type: repoModel.AlbumTypeAlbum, IsFavorite: true},
+		{ID: 2, Title: "Another Test", Type: repoModel.AlbumTypeAlbum},
+	}
+
+	mockIndex.EXPECT().Search(ctx, query, int64(10), int64(0)).Return([]int64{2, 1}, nil)
+	mockRepo.EXPECT().GetAlbumsByIDs(ctx, []int64{2, 1}, userID).Return(repoAlbums, nil)
+
+	albums, err := usecase.SearchAlbums(ctx, query, userID, filters)
+	require.NoError(t, err)
+	require.Len(t, albums, 2)
+	assert.Equal(t, int64(2), albums[0].ID)
+	assert.Equal(t, int64(1), albums[1].ID)
+	assert.True(t, albums[1].IsFavorite)
+}
+
+func TestSearchAlbumsError(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mockIndex)
+
+	filters := &usecaseModel.AlbumFilters{
+		Pagination: &usecaseModel.Pagination{
+			Limit:  10,
+			Offset: 0,
+		},
+	}
+
+	mockIndex.EXPECT().Search(ctx, "test", int64(10), int64(0)).Return(nil, errors.New("index error"))
+
+	albums, err := usecase.SearchAlbums(ctx, "test", 1, filters)
+	require.Error(t, err)
+	assert.Nil(t, albums)
+}
+
+func newStatusUsecase(t *testing.T) (*AlbumUsecase, *mock_domain.MockRepository, *mock_searchIndex.MockIndex, context.Context) {
+	mockRepo, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := &AlbumUsecase{
+		albumRepository: mockRepo,
+		searchIndex:     mockIndex,
+		now: func() time.Time {
+			return time.Date(2030, 1, 10, 15, 0, 0, 0, time.UTC)
+		},
+	}
+	return usecase, mockRepo, mockIndex, ctx
+}
+
+func TestChangeAlbumStatus(t *testing.T) {
+	releaseDate := time.Date(2030, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		album        *repoModel.Album
+		request      *usecaseModel.ChangeAlbumStatusRequest
+		expectChange *repoModel.AlbumStatusChange
+		expectedErr  error
+	}{
+		{
+			name:  "Send draft to review",
+			album: &repoModel.Album{ID: 1, LabelID: 2, Status: repoModel.AlbumStatusDraft},
+			request: &usecaseModel.ChangeAlbumStatusRequest{
+				AlbumID: 1, LabelID: 2, Status: usecaseModel.AlbumStatusInReview, ReleaseDate: releaseDate,
+			},
+			expectChange: &repoModel.AlbumStatusChange{
+				AlbumID: 1, From: repoModel.AlbumStatusDraft, To: repoModel.AlbumStatusInReview, ReleaseDate: releaseDate,
+			},
+		},
+		{
+			name:  "Review without release date",
+			album: &repoModel.Album{ID: 1, LabelID: 2, Status: repoModel.AlbumStatusDraft},
+			request: &usecaseModel.ChangeAlbumStatusRequest{
+				AlbumID: 1, LabelID: 2, Status: usecaseModel.AlbumStatusInReview,
+			},
+			expectedErr: albumErrors.ErrReleaseDateRequired,
+		},
+		{
+			name:  "Review with past release date",
+			album: &repoModel.Album{ID: 1, LabelID: 2, Status: repoModel.AlbumStatusDraft},
+			request: &usecaseModel.ChangeAlbumStatusRequest{
+				AlbumID: 1, LabelID: 2, Status: usecaseModel.AlbumStatusInReview, ReleaseDate: releaseDate.AddDate(0, 0, -1),
+			},
+			expectedErr: albumErrors.ErrReleaseDateInPast,
+		},
+		{
+			name:  "Label can't schedule",
+			album: &repoModel.Album{ID: 1, LabelID: 2, Status: repoModel.AlbumStatusInReview},
+			request: &usecaseModel.ChangeAlbumStatusRequest{
+				AlbumID: 1, LabelID: 2, Status: usecaseModel.AlbumStatusScheduled,
+			},
+			expectedErr: albumErrors.ErrReviewRequired,
+		},
+		{
+			name:  "Reviewer schedules",
+			album: &repoModel.Album{ID: 1, LabelID: 2, Status: repoModel.AlbumStatusInReview},
+			request: &usecaseModel.ChangeAlbumStatusRequest{
+				AlbumID: 1, IsAdmin: true, Status: usecaseModel.AlbumStatusScheduled,
+			},
+			expectChange: &repoModel.AlbumStatusChange{
+				AlbumID: 1, From: repoModel.AlbumStatusInReview, To: repoModel.AlbumStatusScheduled,
+			},
+		},
+		{
+			name:  "Draft can't be published directly",
+			album: &repoModel.Album{ID: 1, LabelID: 2, Status: repoModel.AlbumStatusDraft},
+			request: &usecaseModel.ChangeAlbumStatusRequest{
+				AlbumID: 1, LabelID: 2, IsAdmin: true, Status: usecaseModel.AlbumStatusPublished,
+			},
+			expectedErr: albumErrors.ErrInvalidStatusTransition,
+		},
+		{
+			name:  "Album of another label",
+			album: &repoModel.Album{ID: 1, LabelID: 3, Status: repoModel.AlbumStatusPublished},
+			request: &usecaseModel.ChangeAlbumStatusRequest{
+				AlbumID: 1, LabelID: 2, Status: usecaseModel.AlbumStatusTakenDown,
+			},
+			expectedErr: albumErrors.ErrAlbumNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			usecase, mockRepo, mockIndex, ctx := newStatusUsecase(t)
+
+			mockRepo.EXPECT().GetAlbumWithStatus(ctx, tt.request.AlbumID).Return(tt.album, nil)
+			if tt.expectChange != nil {
+				mockRepo.EXPECT().ChangeAlbumStatus(ctx, tt.expectChange).Return(nil)
+				mockIndex.EXPECT().Refresh(ctx, []int64{tt.expectChange.AlbumID}).Return(nil)
+			}
+
+			album, err := usecase.ChangeAlbumStatus(ctx, tt.request)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Nil(t, album)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.request.Status, album.Status)
+		})
+	}
+}
+
+func TestChangeAlbumStatusUnknownStatus(t *testing.T) {
+	usecase, _, _, ctx := newStatusUsecase(t)
+
+	album, err := usecase.ChangeAlbumStatus(ctx, &usecaseModel.ChangeAlbumStatusRequest{AlbumID: 1, Status: "live"})
+
+	assert.Error(t, err)
+	assert.Nil(t, album)
+}
+
+func TestPublishScheduledAlbums(t *testing.T) {
+	usecase, mockRepo, mockIndex, ctx := newStatusUsecase(t)
+
+	mockRepo.EXPECT().PublishScheduledAlbums(ctx).Return([]int64{1, 2}, nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{1, 2}).Return(errors.New("index error"))
+
+	albumIDs, err := usecase.PublishScheduledAlbums(ctx)
+
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, albumIDs)
+}
+
+func TestPublishScheduledAlbumsNothingDue(t *testing.T) {
+	usecase, mockRepo, _, ctx := newStatusUsecase(t)
+
+	mockRepo.EXPECT().PublishScheduledAlbums(ctx).Return(nil, nil)
+
+	albumIDs, err := usecase.PublishScheduledAlbums(ctx)
+
+	require.NoError(t, err)
+	assert.Empty(t, albumIDs)
+}
+
+func TestEditAlbum(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewAlbumUsecase(mockRepo, mockS3Repo, mockIndex)
+
+	mockRepo.EXPECT().GetAlbumWithStatus(ctx, int64(1)).Return(&repoModel.Album{
+		ID: 1, LabelID: 2, Title: "Old", Type: repoModel.AlbumTypeAlbum, Thumbnail: "old.jpg", Status: repoModel.AlbumStatusPublished,
+	}, nil)
+	mockS3Repo.EXPECT().CopyAlbumAvatar(ctx, "New", "uploads/cover").Return("new.jpg", "#a1b2c3", nil)
+	mockRepo.EXPECT().EditAlbum(ctx, &repoModel.AlbumEdit{
+		ID: 1, LabelID: 2, Title: "New", Type: repoModel.AlbumTypeEP, Thumbnail: "new.jpg", ThumbnailColor: "#a1b2c3",
+	}).Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{1}).Return(nil)
+
+	album, err := usecase.EditAlbum(ctx, &usecaseModel.EditAlbumRequest{
+		AlbumID: 1, LabelID: 2, Title: " New ", Type: usecaseModel.AlbumTypeEP, ImageKey: "uploads/cover",
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "New", album.Title)
+	assert.Equal(t, usecaseModel.AlbumTypeEP, album.Type)
+	assert.Equal(t, "new.jpg", album.Thumbnail)
+	assert.Equal(t, "#a1b2c3", album.ThumbnailColor)
+	assert.Equal(t, usecaseModel.AlbumStatusPublished, album.Status)
+}
+
+func TestEditAlbumKeepsCover(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewAlbumUsecase(mockRepo, nil, mockIndex)
+
+	mockRepo.EXPECT().GetAlbumWithStatus(ctx, int64(1)).Return(&repoModel.Album{ID: 1, LabelID: 2, Thumbnail: "old.jpg", ThumbnailColor: "#000000"}, nil)
+	mockRepo.EXPECT().EditAlbum(ctx, &repoModel.AlbumEdit{
+		ID: 1, LabelID: 2, Title: "New", Type: repoModel.AlbumTypeAlbum, Thumbnail: "old.jpg", ThumbnailColor: "#000000",
+	}).Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{1}).Return(errors.New("index error"))
+
+	album, err := usecase.EditAlbum(ctx, &usecaseModel.EditAlbumRequest{
+		AlbumID: 1, LabelID: 2, Title: "New", Type: usecaseModel.AlbumTypeAlbum,
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, "old.jpg", album.Thumbnail)
+}
+
+func TestEditAlbumErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		title       string
+		album       *repoModel.Album
+		expectedErr error
+	}{
+		{name: "Empty title", title: "   ", expectedErr: albumErrors.ErrAlbumTitleInvalid},
+		{name: "Title too long", title: strings.Repeat("а", 101), expectedErr: albumErrors.ErrAlbumTitleInvalid},
+		{name: "Album of another label", title: "New", album: &repoModel.Album{ID: 1, LabelID: 3}, expectedErr: albumErrors.ErrAlbumNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, ctx := setupTest(t)
+			usecase := NewAlbumUsecase(mockRepo, nil, nil)
+
+			if tt.album != nil {
+				mockRepo.EXPECT().GetAlbumWithStatus(ctx, int64(1)).Return(tt.album, nil)
+			}
+
+			album, err := usecase.EditAlbum(ctx, &usecaseModel.EditAlbumRequest{AlbumID: 1, LabelID: 2, Title: tt.title})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.Nil(t, album)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"time"
+
 	albumProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/album"
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/album/model/usecase"
@@ -22,14 +24,14 @@ func FiltersFromUsecaseToRepository(filters *usecaseModel.AlbumFilters) *repoMod
 
 func PaginationFromProtoToUsecase(pagination *albumProto.Pagination) *usecaseModel.Pagination {
 	return &usecaseModel.Pagination{
-		Offset: pagination.Offset,
-		Limit:  pagination.Limit,
+		Offset: pagination.GetOffset(),
+		Limit:  pagination.GetLimit(),
 	}
 }
 
 func AlbumFiltersFromProtoToUsecase(filters *albumProto.Filters) *usecaseModel.AlbumFilters {
 	return &usecaseModel.AlbumFilters{
-		Pagination: PaginationFromProtoToUsecase(filters.Pagination),
+		Pagination: PaginationFromProtoToUsecase(filters.GetPagination()),
 	}
 }
 
@@ -53,23 +55,27 @@ func AlbumTypeFromUsecaseToProto(albumType usecaseModel.AlbumType) albumProto.Al
 
 func AlbumFromRepositoryToUsecase(album *repoModel.Album) *usecaseModel.Album {
 	return &usecaseModel.Album{
-		ID:          album.ID,
-		Title:       album.Title,
-		Type:        AlbumTypeFromRepositoryToUsecase(album.Type),
-		Thumbnail:   album.Thumbnail,
-		ReleaseDate: album.ReleaseDate,
-		IsFavorite:  album.IsFavorite,
+		ID:             album.ID,
+		Title:          album.Title,
+		Type:           AlbumTypeFromRepositoryToUsecase(album.Type),
+		Thumbnail:      album.Thumbnail,
+		ThumbnailColor: album.ThumbnailColor,
+		ReleaseDate:    album.ReleaseDate,
+		IsFavorite:     album.IsFavorite,
+		Status:         usecaseModel.AlbumStatus(album.Status),
 	}
 }
 
 func AlbumFromUsecaseToProto(album *usecaseModel.Album) *albumProto.Album {
 	return &albumProto.Album{
-		Id:          album.ID,
-		Title:       album.Title,
-		Type:        AlbumTypeFromUsecaseToProto(album.Type),
-		Thumbnail:   album.Thumbnail,
-		ReleaseDate: timestamppb.New(album.ReleaseDate),
-		IsFavorite:  album.IsFavorite,
+		Id:             album.ID,
+		Title:          album.Title,
+		Type:           AlbumTypeFromUsecaseToProto(album.Type),
+		Thumbnail:      album.Thumbnail,
+		ThumbnailColor: album.ThumbnailColor,
+		ReleaseDate:    timestamppb.New(album.ReleaseDate),
+		IsFavorite:     album.IsFavorite,
+		Status:         string(album.Status),
 	}
 }
 
@@ -165,18 +171,50 @@ func AlbumTypeFromProtoToUsecase(albumType albumProto.AlbumType) usecaseModel.Al
 
 func AlbumRequestFromProtoToUsecase(albumRequest *albumProto.CreateAlbumRequest) *usecaseModel.CreateAlbumRequest {
 	return &usecaseModel.CreateAlbumRequest{
-		Type:    AlbumTypeFromProtoToUsecase(albumRequest.Type),
-		Title:   albumRequest.Title,
-		Image:   albumRequest.Image,
-		LabelID: albumRequest.LabelId,
+		Type:     AlbumTypeFromProtoToUsecase(albumRequest.Type),
+		Title:    albumRequest.Title,
+		ImageKey: albumRequest.ImageKey,
+		LabelID:  albumRequest.LabelId,
 	}
 }
 
 func AlbumRequestFromUsecaseToRepository(albumRequest *usecaseModel.CreateAlbumRequest) *repoModel.CreateAlbumRequest {
 	return &repoModel.CreateAlbumRequest{
-		Type:    repoModel.AlbumType(albumRequest.Type),
-		Title:   albumRequest.Title,
-		Image:   albumRequest.Image,
-		LabelID: albumRequest.LabelID,
+		Type:     repoModel.AlbumType(albumRequest.Type),
+		Title:    albumRequest.Title,
+		ImageKey: albumRequest.ImageKey,
+		LabelID:  albumRequest.LabelID,
+	}
+}
+
+func ChangeAlbumStatusRequestFromProtoToUsecase(request *albumProto.ChangeAlbumStatusRequest) *usecaseModel.ChangeAlbumStatusRequest {
+	var releaseDate time.Time
+	if request.ReleaseDate != nil {
+		releaseDate = request.ReleaseDate.AsTime()
+	}
+	return &usecaseModel.ChangeAlbumStatusRequest{
+		AlbumID:     request.GetAlbumId().GetId(),
+		LabelID:     request.LabelId,
+		IsAdmin:     request.IsAdmin,
+		Status:      usecaseModel.AlbumStatus(request.Status),
+		ReleaseDate: releaseDate,
+	}
+}
+
+func EditAlbumRequestFromProtoToUsecase(request *albumProto.EditAlbumRequest) *usecaseModel.EditAlbumRequest {
+	return &usecaseModel.EditAlbumRequest{
+		AlbumID:  request.GetAlbumId().GetId(),
+		LabelID:  request.LabelId,
+		Title:    request.Title,
+		Type:     AlbumTypeFromProtoToUsecase(request.Type),
+		ImageKey: request.ImageKey,
+	}
+}
+
+func AlbumIDListFromUsecaseToProto(ids []int64) *albumProto.AlbumIDList {
+	albumIDs := make([]*albumProto.AlbumID, 0, len(ids))
+	for _, id := range ids {
+		albumIDs = append(albumIDs, &albumProto.AlbumID{Id: id})
 	}
+	return &albumProto.AlbumIDList{Ids: albumIDs}
 }
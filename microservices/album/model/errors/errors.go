@@ -55,6 +55,32 @@ func NewFailedToUploadAvatarError(format string, args ...interface{}) error {
 	}
 }
 
+func NewInvalidArgumentError(format string, args ...interface{}) error {
+	return &AlbumError{
+		Code:    codes.InvalidArgument,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func NewFailedPreconditionError(format string, args ...interface{}) error {
+	return &AlbumError{
+		Code:    codes.FailedPrecondition,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func NewPermissionDeniedError(format string, args ...interface{}) error {
+	return &AlbumError{
+		Code:    codes.PermissionDenied,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
 var (
-	ErrAlbumNotFound = NewNotFoundError("album not found")
+	ErrAlbumNotFound           = NewNotFoundError("album not found")
+	ErrReleaseDateRequired     = NewInvalidArgumentError("release date is required")
+	ErrReleaseDateInPast       = NewInvalidArgumentError("release date can't be in the past")
+	ErrInvalidStatusTransition = NewFailedPreconditionError("album status can't be changed this way")
+	ErrReviewRequired          = NewPermissionDeniedError("only reviewers can schedule albums in review")
+	ErrAlbumTitleInvalid       = NewInvalidArgumentError("album title must be from 1 to 100 characters long")
 )
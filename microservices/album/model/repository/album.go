@@ -13,13 +13,27 @@ const (
 	AlbumTypeCompilation AlbumType = "compilation"
 )
 
+type AlbumStatus string
+
+const (
+	AlbumStatusDraft     AlbumStatus = "draft"
+	AlbumStatusInReview  AlbumStatus = "in_review"
+	AlbumStatusScheduled AlbumStatus = "scheduled"
+	AlbumStatusPublished AlbumStatus = "published"
+	AlbumStatusTakenDown AlbumStatus = "taken_down"
+)
+
 type Album struct {
-	ID          int64     `sql:"id"`
-	Title       string    `sql:"title"`
-	Type        AlbumType `sql:"type"`
-	Thumbnail   string    `sql:"thumbnail_url"`
-	ReleaseDate time.Time `sql:"release_date"`
-	IsFavorite  bool      `sql:"is_favorite"`
+	ID        int64     `sql:"id"`
+	Title     string    `sql:"title"`
+	Type      AlbumType `sql:"type"`
+	Thumbnail string    `sql:"thumbnail_url"`
+	// ThumbnailColor is the dominant color of the cover, empty for covers without variants
+	ThumbnailColor string      `sql:"thumbnail_color"`
+	ReleaseDate    time.Time   `sql:"release_date"`
+	IsFavorite     bool        `sql:"is_favorite"`
+	Status         AlbumStatus `sql:"status"`
+	LabelID        int64       `sql:"label_id"`
 }
 
 type Pagination struct {
@@ -42,9 +56,29 @@ type LikeRequest struct {
 }
 
 type CreateAlbumRequest struct {
-	Title     string
-	Type      AlbumType
-	Image     []byte
-	LabelID   int64
-	Thumbnail string
+	Title          string
+	Type           AlbumType
+	ImageKey       string
+	LabelID        int64
+	Thumbnail      string
+	ThumbnailColor string
+}
+
+// AlbumEdit only applies to an album of the LabelID label
+type AlbumEdit struct {
+	ID             int64
+	LabelID        int64
+	Title          string
+	Type           AlbumType
+	Thumbnail      string
+	ThumbnailColor string
+}
+
+// AlbumStatusChange only applies while the album is still in the From status,
+// a zero ReleaseDate keeps the current one
+type AlbumStatusChange struct {
+	AlbumID     int64
+	From        AlbumStatus
+	To          AlbumStatus
+	ReleaseDate time.Time
 }
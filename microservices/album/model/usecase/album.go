@@ -13,13 +13,26 @@ const (
 	AlbumTypeCompilation AlbumType = "compilation"
 )
 
+type AlbumStatus string
+
+const (
+	AlbumStatusDraft     AlbumStatus = "draft"
+	AlbumStatusInReview  AlbumStatus = "in_review"
+	AlbumStatusScheduled AlbumStatus = "scheduled"
+	AlbumStatusPublished AlbumStatus = "published"
+	AlbumStatusTakenDown AlbumStatus = "taken_down"
+)
+
 type Album struct {
-	ID          int64
-	Title       string
-	Type        AlbumType
-	Thumbnail   string
-	ReleaseDate time.Time
-	IsFavorite  bool
+	ID        int64
+	Title     string
+	Type      AlbumType
+	Thumbnail string
+	// ThumbnailColor is the dominant color of the cover, empty for covers without variants
+	ThumbnailColor string
+	ReleaseDate    time.Time
+	IsFavorite     bool
+	Status         AlbumStatus
 }
 
 type AlbumList struct {
@@ -55,8 +68,24 @@ type LikeRequest struct {
 }
 
 type CreateAlbumRequest struct {
-	Title   string
-	Type    AlbumType
-	Image   []byte
-	LabelID int64
+	Title    string
+	Type     AlbumType
+	ImageKey string
+	LabelID  int64
+}
+
+type ChangeAlbumStatusRequest struct {
+	AlbumID     int64
+	LabelID     int64
+	IsAdmin     bool
+	Status      AlbumStatus
+	ReleaseDate time.Time
+}
+
+type EditAlbumRequest struct {
+	AlbumID  int64
+	LabelID  int64
+	Title    string
+	Type     AlbumType
+	ImageKey string
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -14,8 +15,10 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/internal/delivery"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/internal/repository"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/internal/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/imagePipeline"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/interceptors"
 	metrics "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.uber.org/zap"
@@ -92,8 +95,16 @@ func main() {
 	}()
 
 	artistRepository := repository.NewArtistPostgresRepository(postgresPool, metrics)
-	s3Repository := repository.NewS3Repository(s3, cfg.S3.S3ImagesBucket, metrics)
-	artistUsecase := usecase.NewArtistUsecase(artistRepository, s3Repository)
+	s3Repository := repository.NewS3Repository(s3, cfg.S3.S3ImagesBucket, imagePipeline.New(cfg.Images, metrics), metrics)
+	ctx, cancel := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+	defer cancel()
+	postgresSearchIndex := repository.NewArtistPostgresSearchIndex(postgresPool, metrics)
+	artistSearchIndex, err := searchIndex.New(ctx, cfg.Search, "artists", postgresSearchIndex, postgresSearchIndex.LoadDocuments, metrics)
+	if err != nil {
+		logger.Error("Error initializing search index:", zap.Error(err))
+		return
+	}
+	artistUsecase := usecase.NewArtistUsecase(artistRepository, s3Repository, artistSearchIndex)
 	artistService := delivery.NewArtistService(artistUsecase)
 	artistProto.RegisterArtistServiceServer(server, artistService)
 
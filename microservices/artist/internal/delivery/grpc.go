@@ -139,7 +139,7 @@ func (s *ArtistService) GetFavoriteArtists(ctx context.Context, req *artistProto
 }
 
 func (s *ArtistService) SearchArtists(ctx context.Context, req *artistProto.Query) (*artistProto.ArtistList, error) {
-	artists, err := s.artistUsecase.SearchArtists(ctx, req.Query, req.UserId.Id)
+	artists, err := s.artistUsecase.SearchArtists(ctx, req.Query, req.UserId.Id, model.ArtistFiltersFromProtoToUsecase(req.Filters))
 	if err != nil {
 		return nil, err
 	}
@@ -186,3 +186,19 @@ func (s *ArtistService) ConnectArtists(ctx context.Context, req *artistProto.Art
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *ArtistService) SetArtistCredits(ctx context.Context, req *artistProto.ArtistCredits) (*emptypb.Empty, error) {
+	err := s.artistUsecase.SetArtistCredits(ctx, model.ArtistCreditsFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *ArtistService) SetTrackArtistRoles(ctx context.Context, req *artistProto.TrackArtistRoles) (*emptypb.Empty, error) {
+	err := s.artistUsecase.SetTrackArtistRoles(ctx, model.TrackArtistRolesFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
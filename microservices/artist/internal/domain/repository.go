@@ -9,6 +9,7 @@ import (
 type Repository interface {
 	GetAllArtists(ctx context.Context, filters *repoModel.Filters, userID int64) ([]*repoModel.Artist, error)
 	GetArtistByID(ctx context.Context, id int64, userID int64) (*repoModel.Artist, error)
+	GetArtistsByIDs(ctx context.Context, ids []int64, userID int64) ([]*repoModel.Artist, error)
 	GetArtistTitleByID(ctx context.Context, id int64) (string, error)
 	GetArtistsByTrackID(ctx context.Context, id int64) ([]*repoModel.ArtistWithRole, error)
 	GetArtistsByTrackIDs(ctx context.Context, trackIDs []int64) (map[int64][]*repoModel.ArtistWithRole, error)
@@ -23,20 +24,22 @@ type Repository interface {
 	UnlikeArtist(ctx context.Context, request *repoModel.LikeRequest) error
 	CheckArtistExists(ctx context.Context, id int64) (bool, error)
 	GetFavoriteArtists(ctx context.Context, filters *repoModel.Filters, userID int64) ([]*repoModel.Artist, error)
-	SearchArtists(ctx context.Context, query string, userID int64) ([]*repoModel.Artist, error)
 	CreateArtist(ctx context.Context, artist *repoModel.Artist) (*repoModel.Artist, error)
 	CheckArtistNameExist(ctx context.Context, id int64) (bool, error)
 	ChangeArtistTitle(ctx context.Context, newTitle string, id int64) error
 	GetArtistByIDWithoutUser(ctx context.Context, id int64) (*repoModel.Artist, error)
-	UploadAvatar(ctx context.Context, artistID int64, avatarURL string) error
+	UploadAvatar(ctx context.Context, artistID int64, avatarURL string, avatarColor string) error
 	GetArtistLabelID(ctx context.Context, artistID int64) (int64, error)
 	GetArtistsLabelID(ctx context.Context, filters *repoModel.Filters, labelID int64) ([]*repoModel.Artist, error)
 	DeleteArtist(ctx context.Context, artistID int64) error
 	AddArtistsToAlbum(ctx context.Context, artistsIDs []int64, albumID int64) error
 	AddArtistsToTracks(ctx context.Context, artistsIDs []int64, trackIDs []int64) error
+	CountLabelArtists(ctx context.Context, artistIDs []int64, labelID int64) (int64, error)
+	SetArtistCredits(ctx context.Context, credits *repoModel.ArtistCredits) error
+	SetTrackArtistRoles(ctx context.Context, roles *repoModel.TrackArtistRoles) error
 }
 
 type S3Repository interface {
 	GetAvatarURL(ctx context.Context, fileKey string) (string, error)
-	UploadArtistAvatar(ctx context.Context, artistTitle string, file []byte) (string, error)
+	UploadArtistAvatar(ctx context.Context, artistTitle string, file []byte) (string, string, error)
 }
@@ -20,10 +20,12 @@ type Usecase interface {
 	GetArtistsListenedByUserID(ctx context.Context, userID int64) (int64, error)
 	LikeArtist(ctx context.Context, request *usecaseModel.LikeRequest) error
 	GetFavoriteArtists(ctx context.Context, filters *usecaseModel.Filters, userID int64) (*usecaseModel.ArtistList, error)
-	SearchArtists(ctx context.Context, query string, userID int64) (*usecaseModel.ArtistList, error)
+	SearchArtists(ctx context.Context, query string, userID int64, filters *usecaseModel.Filters) (*usecaseModel.ArtistList, error)
 	CreateArtist(ctx context.Context, artist *usecaseModel.ArtistLoad) (*usecaseModel.Artist, error)
 	EditArtist(ctx context.Context, artist *usecaseModel.ArtistEdit) (*usecaseModel.Artist, error)
 	GetArtistsLabelID(ctx context.Context, filters *usecaseModel.Filters, labelID int64) (*usecaseModel.ArtistList, error)
 	DeleteArtist(ctx context.Context, artist *usecaseModel.ArtistDelete) error
 	ConnectArtists(ctx context.Context, artistIDs []int64, albumID int64, trackIDs []int64) error
+	SetArtistCredits(ctx context.Context, credits *usecaseModel.ArtistCredits) error
+	SetTrackArtistRoles(ctx context.Context, roles *usecaseModel.TrackArtistRoles) error
 }
@@ -113,6 +113,21 @@ func (mr *MockRepositoryMockRecorder) CheckArtistNameExist(ctx, id any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckArtistNameExist", reflect.TypeOf((*MockRepository)(nil).CheckArtistNameExist), ctx, id)
 }
 
+// CountLabelArtists mocks base method.
+func (m *MockRepository) CountLabelArtists(ctx context.Context, artistIDs []int64, labelID int64) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountLabelArtists", ctx, artistIDs, labelID)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountLabelArtists indicates an expected call of CountLabelArtists.
+func (mr *MockRepositoryMockRecorder) CountLabelArtists(ctx, artistIDs, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountLabelArtists", reflect.TypeOf((*MockRepository)(nil).CountLabelArtists), ctx, artistIDs, labelID)
+}
+
 // CreateArtist mocks base method.
 func (m *MockRepository) CreateArtist(ctx context.Context, artist *repository.Artist) (*repository.Artist, error) {
 	m.ctrl.T.Helper()
@@ -291,6 +306,21 @@ func (mr *MockRepositoryMockRecorder) GetArtistsByAlbumIDs(ctx, albumIDs any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistsByAlbumIDs", reflect.TypeOf((*MockRepository)(nil).GetArtistsByAlbumIDs), ctx, albumIDs)
 }
 
+// GetArtistsByIDs mocks base method.
+func (m *MockRepository) GetArtistsByIDs(ctx context.Context, ids []int64, userID int64) ([]*repository.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArtistsByIDs", ctx, ids, userID)
+	ret0, _ := ret[0].([]*repository.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArtistsByIDs indicates an expected call of GetArtistsByIDs.
+func (mr *MockRepositoryMockRecorder) GetArtistsByIDs(ctx, ids, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistsByIDs", reflect.TypeOf((*MockRepository)(nil).GetArtistsByIDs), ctx, ids, userID)
+}
+
 // GetArtistsByTrackID mocks base method.
 func (m *MockRepository) GetArtistsByTrackID(ctx context.Context, id int64) ([]*repository.ArtistWithRole, error) {
 	m.ctrl.T.Helper()
@@ -395,19 +425,32 @@ func (mr *MockRepositoryMockRecorder) LikeArtist(ctx, request any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeArtist", reflect.TypeOf((*MockRepository)(nil).LikeArtist), ctx, request)
 }
 
-// SearchArtists mocks base method.
-func (m *MockRepository) SearchArtists(ctx context.Context, query string, userID int64) ([]*repository.Artist, error) {
+// SetArtistCredits mocks base method.
+func (m *MockRepository) SetArtistCredits(ctx context.Context, credits *repository.ArtistCredits) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchArtists", ctx, query, userID)
-	ret0, _ := ret[0].([]*repository.Artist)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "SetArtistCredits", ctx, credits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArtistCredits indicates an expected call of SetArtistCredits.
+func (mr *MockRepositoryMockRecorder) SetArtistCredits(ctx, credits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArtistCredits", reflect.TypeOf((*MockRepository)(nil).SetArtistCredits), ctx, credits)
+}
+
+// SetTrackArtistRoles mocks base method.
+func (m *MockRepository) SetTrackArtistRoles(ctx context.Context, roles *repository.TrackArtistRoles) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrackArtistRoles", ctx, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SearchArtists indicates an expected call of SearchArtists.
-func (mr *MockRepositoryMockRecorder) SearchArtists(ctx, query, userID any) *gomock.Call {
+// SetTrackArtistRoles indicates an expected call of SetTrackArtistRoles.
+func (mr *MockRepositoryMockRecorder) SetTrackArtistRoles(ctx, roles any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockRepository)(nil).SearchArtists), ctx, query, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackArtistRoles", reflect.TypeOf((*MockRepository)(nil).SetTrackArtistRoles), ctx, roles)
 }
 
 // UnlikeArtist mocks base method.
@@ -425,17 +468,17 @@ func (mr *MockRepositoryMockRecorder) UnlikeArtist(ctx, request any) *gomock.Cal
 }
 
 // UploadAvatar mocks base method.
-func (m *MockRepository) UploadAvatar(ctx context.Context, artistID int64, avatarURL string) error {
+func (m *MockRepository) UploadAvatar(ctx context.Context, artistID int64, avatarURL, avatarColor string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadAvatar", ctx, artistID, avatarURL)
+	ret := m.ctrl.Call(m, "UploadAvatar", ctx, artistID, avatarURL, avatarColor)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UploadAvatar indicates an expected call of UploadAvatar.
-func (mr *MockRepositoryMockRecorder) UploadAvatar(ctx, artistID, avatarURL any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) UploadAvatar(ctx, artistID, avatarURL, avatarColor any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockRepository)(nil).UploadAvatar), ctx, artistID, avatarURL)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockRepository)(nil).UploadAvatar), ctx, artistID, avatarURL, avatarColor)
 }
 
 // MockS3Repository is a mock of S3Repository interface.
@@ -478,12 +521,13 @@ func (mr *MockS3RepositoryMockRecorder) GetAvatarURL(ctx, fileKey any) *gomock.C
 }
 
 // UploadArtistAvatar mocks base method.
-func (m *MockS3Repository) UploadArtistAvatar(ctx context.Context, artistTitle string, file []byte) (string, error) {
+func (m *MockS3Repository) UploadArtistAvatar(ctx context.Context, artistTitle string, file []byte) (string, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadArtistAvatar", ctx, artistTitle, file)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UploadArtistAvatar indicates an expected call of UploadArtistAvatar.
@@ -41,6 +41,35 @@ func (m *MockUsecase) EXPECT() *MockUsecaseMockRecorder {
 	return m.recorder
 }
 
+// ConnectArtists mocks base method.
+func (m *MockUsecase) ConnectArtists(ctx context.Context, artistIDs []int64, albumID int64, trackIDs []int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConnectArtists", ctx, artistIDs, albumID, trackIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ConnectArtists indicates an expected call of ConnectArtists.
+func (mr *MockUsecaseMockRecorder) ConnectArtists(ctx, artistIDs, albumID, trackIDs any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConnectArtists", reflect.TypeOf((*MockUsecase)(nil).ConnectArtists), ctx, artistIDs, albumID, trackIDs)
+}
+
+// CreateArtist mocks base method.
+func (m *MockUsecase) CreateArtist(ctx context.Context, artist *usecase.ArtistLoad) (*usecase.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArtist", ctx, artist)
+	ret0, _ := ret[0].(*usecase.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateArtist indicates an expected call of CreateArtist.
+func (mr *MockUsecaseMockRecorder) CreateArtist(ctx, artist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArtist", reflect.TypeOf((*MockUsecase)(nil).CreateArtist), ctx, artist)
+}
+
 // CreateStreamsByArtistIDs mocks base method.
 func (m *MockUsecase) CreateStreamsByArtistIDs(ctx context.Context, data *usecase.ArtistStreamCreateDataList) error {
 	m.ctrl.T.Helper()
@@ -55,6 +84,35 @@ func (mr *MockUsecaseMockRecorder) CreateStreamsByArtistIDs(ctx, data any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStreamsByArtistIDs", reflect.TypeOf((*MockUsecase)(nil).CreateStreamsByArtistIDs), ctx, data)
 }
 
+// DeleteArtist mocks base method.
+func (m *MockUsecase) DeleteArtist(ctx context.Context, artist *usecase.ArtistDelete) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArtist", ctx, artist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArtist indicates an expected call of DeleteArtist.
+func (mr *MockUsecaseMockRecorder) DeleteArtist(ctx, artist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArtist", reflect.TypeOf((*MockUsecase)(nil).DeleteArtist), ctx, artist)
+}
+
+// EditArtist mocks base method.
+func (m *MockUsecase) EditArtist(ctx context.Context, artist *usecase.ArtistEdit) (*usecase.Artist, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditArtist", ctx, artist)
+	ret0, _ := ret[0].(*usecase.Artist)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditArtist indicates an expected call of EditArtist.
+func (mr *MockUsecaseMockRecorder) EditArtist(ctx, artist any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditArtist", reflect.TypeOf((*MockUsecase)(nil).EditArtist), ctx, artist)
+}
+
 // GetAlbumIDsByArtistID mocks base method.
 func (m *MockUsecase) GetAlbumIDsByArtistID(ctx context.Context, id int64) ([]int64, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +233,21 @@ func (mr *MockUsecaseMockRecorder) GetArtistsByTrackIDs(ctx, trackIDs any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistsByTrackIDs", reflect.TypeOf((*MockUsecase)(nil).GetArtistsByTrackIDs), ctx, trackIDs)
 }
 
+// GetArtistsLabelID mocks base method.
+func (m *MockUsecase) GetArtistsLabelID(ctx context.Context, filters *usecase.Filters, labelID int64) (*usecase.ArtistList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArtistsLabelID", ctx, filters, labelID)
+	ret0, _ := ret[0].(*usecase.ArtistList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArtistsLabelID indicates an expected call of GetArtistsLabelID.
+func (mr *MockUsecaseMockRecorder) GetArtistsLabelID(ctx, filters, labelID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArtistsLabelID", reflect.TypeOf((*MockUsecase)(nil).GetArtistsLabelID), ctx, filters, labelID)
+}
+
 // GetArtistsListenedByUserID mocks base method.
 func (m *MockUsecase) GetArtistsListenedByUserID(ctx context.Context, userID int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -235,16 +308,44 @@ func (mr *MockUsecaseMockRecorder) LikeArtist(ctx, request any) *gomock.Call {
 }
 
 // SearchArtists mocks base method.
-func (m *MockUsecase) SearchArtists(ctx context.Context, query string, userID int64) (*usecase.ArtistList, error) {
+func (m *MockUsecase) SearchArtists(ctx context.Context, query string, userID int64, filters *usecase.Filters) (*usecase.ArtistList, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchArtists", ctx, query, userID)
+	ret := m.ctrl.Call(m, "SearchArtists", ctx, query, userID, filters)
 	ret0, _ := ret[0].(*usecase.ArtistList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchArtists indicates an expected call of SearchArtists.
-func (mr *MockUsecaseMockRecorder) SearchArtists(ctx, query, userID any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchArtists(ctx, query, userID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockUsecase)(nil).SearchArtists), ctx, query, userID, filters)
+}
+
+// SetArtistCredits mocks base method.
+func (m *MockUsecase) SetArtistCredits(ctx context.Context, credits *usecase.ArtistCredits) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArtistCredits", ctx, credits)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetArtistCredits indicates an expected call of SetArtistCredits.
+func (mr *MockUsecaseMockRecorder) SetArtistCredits(ctx, credits any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArtistCredits", reflect.TypeOf((*MockUsecase)(nil).SetArtistCredits), ctx, credits)
+}
+
+// SetTrackArtistRoles mocks base method.
+func (m *MockUsecase) SetTrackArtistRoles(ctx context.Context, roles *usecase.TrackArtistRoles) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrackArtistRoles", ctx, roles)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTrackArtistRoles indicates an expected call of SetTrackArtistRoles.
+func (mr *MockUsecaseMockRecorder) SetTrackArtistRoles(ctx, roles any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockUsecase)(nil).SearchArtists), ctx, query, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackArtistRoles", reflect.TypeOf((*MockUsecase)(nil).SetTrackArtistRoles), ctx, roles)
 }
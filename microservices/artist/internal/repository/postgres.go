@@ -4,7 +4,6 @@ import (
 	"context"
 	"database/sql"
 	"errors"
-	"strings"
 	"time"
 
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
@@ -18,7 +17,7 @@ import (
 
 const (
 	GetAllArtistsQuery = `
-		SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, (favorite_artist.user_id IS NOT NULL) AS is_favorite
+		SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, artist.thumbnail_color, (favorite_artist.user_id IS NOT NULL) AS is_favorite
 		FROM artist
 		JOIN artist_stats ON artist.id = artist_stats.artist_id
 		LEFT JOIN favorite_artist ON artist.id = favorite_artist.artist_id AND favorite_artist.user_id = $3
@@ -26,11 +25,17 @@ const (
 		LIMIT $1 OFFSET $2
 	`
 	GetArtistByIDQuery = `
-		SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, (favorite_artist.user_id IS NOT NULL) AS is_favorite
+		SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, artist.thumbnail_color, (favorite_artist.user_id IS NOT NULL) AS is_favorite
 		FROM artist
 		LEFT JOIN favorite_artist ON artist.id = favorite_artist.artist_id AND favorite_artist.user_id = $2
 		WHERE artist.id = $1
 	`
+	GetArtistsByIDsQuery = `
+		SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, artist.thumbnail_color, (favorite_artist.user_id IS NOT NULL) AS is_favorite
+		FROM artist
+		LEFT JOIN favorite_artist ON artist.id = favorite_artist.artist_id AND favorite_artist.user_id = $2
+		WHERE artist.id = ANY($1)
+	`
 	GetArtistTitleByIDQuery = `
 		SELECT title
 		FROM artist
@@ -118,7 +123,7 @@ const (
 	`
 
 	GetFavoriteArtistsQuery = `
-		SELECT artist.id, artist.title, artist.description, artist.thumbnail_url
+		SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, artist.thumbnail_color
 		FROM artist
 		JOIN favorite_artist ON artist.id = favorite_artist.artist_id
 		WHERE favorite_artist.user_id = $1
@@ -126,21 +131,9 @@ const (
 		LIMIT $2 OFFSET $3
 	`
 
-	SearchArtistsQuery = `
-		SELECT a.id, a.title, a.description, a.thumbnail_url
-		FROM artist a
-		LEFT JOIN favorite_artist fa ON a.id = fa.artist_id AND fa.user_id = $2
-		WHERE a.search_vector @@ to_tsquery('multilingual', $1)
-		   OR similarity(a.title_trgm, $3) > 0.3
-		ORDER BY 
-		    CASE WHEN a.search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
-		    ts_rank(a.search_vector, to_tsquery('multilingual', $1)) DESC,
-		    similarity(a.title_trgm, $3) DESC
-	`
-
 	CreateArtistQuery = `
-		INSERT INTO artist (title, thumbnail_url, label_id)
-		VALUES ($1, $2, $3)
+		INSERT INTO artist (title, thumbnail_url, thumbnail_color, label_id)
+		VALUES ($1, $2, $3, $4)
 		RETURNING id
 	`
 
@@ -157,15 +150,15 @@ const (
 	`
 
 	GetArtistByIdWithoutUserQuery = `
-		SELECT id, title, description, thumbnail_url
+		SELECT id, title, description, thumbnail_url, thumbnail_color
 		FROM artist
 		WHERE id = $1
 	`
 
 	UpdateArtistAvatarQuery = `
 		UPDATE artist
-		SET thumbnail_url = $1
-		WHERE id = $2
+		SET thumbnail_url = $1, thumbnail_color = $2
+		WHERE id = $3
 	`
 
 	GetArtistLabelIdByIDQuery = `
@@ -175,7 +168,7 @@ const (
 	`
 
 	GetArtistsLabelIDQuery = `
-        SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, FALSE AS is_favorite
+        SELECT artist.id, artist.title, artist.description, artist.thumbnail_url, artist.thumbnail_color, FALSE AS is_favorite
         FROM artist
         JOIN artist_stats ON artist.id = artist_stats.artist_id
         WHERE artist.label_id = $3
@@ -201,6 +194,40 @@ const (
         CROSS JOIN unnest($2::bigint[]) AS t
         ON CONFLICT (track_id, artist_id, role) DO NOTHING
 	`
+
+	CountLabelArtistsQuery = `
+		SELECT COUNT(*)
+		FROM artist
+		WHERE id = ANY($1::bigint[]) AND label_id = $2
+	`
+
+	DeleteAlbumArtistsQuery = `
+		DELETE FROM album_artist
+		WHERE album_id = $1
+	`
+
+	// Only main credits are replaced, featured and other roles are kept
+	DeleteTrackMainArtistsQuery = `
+		DELETE FROM track_artist
+		WHERE track_id = $1 AND role = 'main'
+	`
+
+	AddMainArtistsToTrackQuery = `
+		INSERT INTO track_artist (artist_id, track_id, role)
+		SELECT unnest($1::bigint[]), $2, 'main'
+		ON CONFLICT (track_id, artist_id, role) DO NOTHING
+	`
+
+	DeleteTrackArtistsQuery = `
+		DELETE FROM track_artist
+		WHERE track_id = $1
+	`
+
+	AddTrackArtistRolesQuery = `
+		INSERT INTO track_artist (artist_id, track_id, role)
+		SELECT unnest($1::bigint[]), $2, unnest($3::text[])
+		ON CONFLICT (track_id, artist_id, role) DO NOTHING
+	`
 )
 
 type artistPostgresRepository struct {
@@ -243,7 +270,7 @@ func (r *artistPostgresRepository) GetAllArtists(ctx context.Context, filters *r
 	for rows.Next() {
 		var artist repoModel.Artist
 		var isFavorite sql.NullBool
-		err = rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail, &isFavorite)
+		err = rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail, &artist.ThumbnailColor, &isFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetAllArtitst").Inc()
 			logger.Error("failed to scan artist", zap.Error(err))
@@ -276,7 +303,7 @@ func (r *artistPostgresRepository) GetArtistByID(ctx context.Context, id int64,
 
 	var artistObject repoModel.Artist
 	var isFavorite sql.NullBool
-	err = row.Scan(&artistObject.ID, &artistObject.Title, &artistObject.Description, &artistObject.Thumbnail, &isFavorite)
+	err = row.Scan(&artistObject.ID, &artistObject.Title, &artistObject.Description, &artistObject.Thumbnail, &artistObject.ThumbnailColor, &isFavorite)
 
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("GetArtistByID").Inc()
@@ -295,6 +322,58 @@ func (r *artistPostgresRepository) GetArtistByID(ctx context.Context, id int64,
 	return &artistObject, nil
 }
 
+func (r *artistPostgresRepository) GetArtistsByIDs(ctx context.Context, ids []int64, userID int64) ([]*repoModel.Artist, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting artists by ids from db", zap.Any("ids", ids), zap.String("query", GetArtistsByIDsQuery))
+	stmt, err := r.db.PrepareContext(ctx, GetArtistsByIDsQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetArtistsByIDs").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, artistErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, pq.Array(ids), userID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetArtistsByIDs").Inc()
+		logger.Error("failed to get artists by ids", zap.Error(err))
+		return nil, artistErrors.NewInternalError("failed to get artists by ids: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	var artists []*repoModel.Artist
+	for rows.Next() {
+		var artist repoModel.Artist
+		var isFavorite sql.NullBool
+		err := rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail, &artist.ThumbnailColor, &isFavorite)
+		if err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetArtistsByIDs").Inc()
+			logger.Error("failed to scan artist", zap.Error(err))
+			return nil, artistErrors.NewInternalError("failed to scan artist: %v", err)
+		}
+		artist.IsFavorite = isFavorite.Valid && isFavorite.Bool
+		artists = append(artists, &artist)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetArtistsByIDs").Inc()
+		logger.Error("failed to get artists by ids", zap.Error(err))
+		return nil, artistErrors.NewInternalError("failed to get artists by ids: %v", err)
+	}
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetArtistsByIDs").Observe(duration)
+	return artists, nil
+}
+
 func (r *artistPostgresRepository) GetArtistTitleByID(ctx context.Context, id int64) (string, error) {
 	start := time.Now()
 	logger := loggerPkg.LoggerFromContext(ctx)
@@ -802,7 +881,7 @@ func (r *artistPostgresRepository) GetFavoriteArtists(ctx context.Context, filte
 	var artists []*repoModel.Artist
 	for rows.Next() {
 		var artist repoModel.Artist
-		err := rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail)
+		err := rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail, &artist.ThumbnailColor)
 		// Так как это мы не отображаем в списке, то можно не делать лишнюю проверку
 		// В идеале поменяем к рк4
 		artist.IsFavorite = false
@@ -816,50 +895,6 @@ func (r *artistPostgresRepository) GetFavoriteArtists(ctx context.Context, filte
 	return artists, nil
 }
 
-func (r *artistPostgresRepository) SearchArtists(ctx context.Context, query string, userID int64) ([]*repoModel.Artist, error) {
-	logger := loggerPkg.LoggerFromContext(ctx)
-	logger.Info("Requesting search artists by query from db", zap.String("query", query), zap.Int64("userID", userID), zap.String("query", SearchArtistsQuery))
-	stmt, err := r.db.PrepareContext(ctx, SearchArtistsQuery)
-	if err != nil {
-		logger.Error("failed to prepare statement", zap.Error(err))
-		return nil, artistErrors.NewInternalError("failed to prepare statement: %v", err)
-	}
-	defer func() {
-		if err := stmt.Close(); err != nil {
-			logger.Error("failed to close statement", zap.Error(err))
-		}
-	}()
-
-	words := strings.Fields(query)
-	for i, word := range words {
-		words[i] = word + ":*"
-	}
-	tsQueryString := strings.Join(words, " & ")
-
-	rows, err := stmt.QueryContext(ctx, tsQueryString, userID, query)
-	if err != nil {
-		logger.Error("failed to search artists", zap.Error(err))
-		return nil, artistErrors.NewInternalError("failed to search artists: %v", err)
-	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			logger.Error("failed to close rows", zap.Error(err))
-		}
-	}()
-
-	var artists []*repoModel.Artist
-	for rows.Next() {
-		var artist repoModel.Artist
-		err := rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail)
-		if err != nil {
-			logger.Error("failed to scan artist", zap.Error(err))
-			return nil, artistErrors.NewInternalError("failed to scan artist: %v", err)
-		}
-		artists = append(artists, &artist)
-	}
-	return artists, nil
-}
-
 func (r *artistPostgresRepository) CreateArtist(ctx context.Context, artist *repoModel.Artist) (*repoModel.Artist, error) {
 	start := time.Now()
 	logger := loggerPkg.LoggerFromContext(ctx)
@@ -877,7 +912,7 @@ func (r *artistPostgresRepository) CreateArtist(ctx context.Context, artist *rep
 	}()
 
 	var artistID int64
-	err = stmt.QueryRowContext(ctx, artist.Title, artist.Thumbnail, artist.LabelID).Scan(&artistID)
+	err = stmt.QueryRowContext(ctx, artist.Title, artist.Thumbnail, artist.ThumbnailColor, artist.LabelID).Scan(&artistID)
 	if err != nil {
 		logger.Error("failed to create artist", zap.Error(err))
 		r.metrics.DatabaseErrors.WithLabelValues("CreateArtist").Inc()
@@ -961,7 +996,7 @@ func (r *artistPostgresRepository) GetArtistByIDWithoutUser(ctx context.Context,
 	row := stmt.QueryRowContext(ctx, id)
 
 	var artistObject repoModel.Artist
-	err = row.Scan(&artistObject.ID, &artistObject.Title, &artistObject.Description, &artistObject.Thumbnail)
+	err = row.Scan(&artistObject.ID, &artistObject.Title, &artistObject.Description, &artistObject.Thumbnail, &artistObject.ThumbnailColor)
 
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -975,7 +1010,7 @@ func (r *artistPostgresRepository) GetArtistByIDWithoutUser(ctx context.Context,
 	return &artistObject, nil
 }
 
-func (r *artistPostgresRepository) UploadAvatar(ctx context.Context, artistID int64, avatarURL string) error {
+func (r *artistPostgresRepository) UploadAvatar(ctx context.Context, artistID int64, avatarURL string, avatarColor string) error {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	logger.Info("Uploading artist avatar", zap.Int64("artistID", artistID), zap.String("avatarURL", avatarURL))
 	stmt, err := r.db.PrepareContext(ctx, UpdateArtistAvatarQuery)
@@ -989,7 +1024,7 @@ func (r *artistPostgresRepository) UploadAvatar(ctx context.Context, artistID in
 		}
 	}()
 
-	_, err = stmt.ExecContext(ctx, avatarURL, artistID)
+	_, err = stmt.ExecContext(ctx, avatarURL, avatarColor, artistID)
 	if err != nil {
 		logger.Error("failed to upload artist avatar", zap.Error(err))
 		return err
@@ -1058,7 +1093,7 @@ func (r *artistPostgresRepository) GetArtistsLabelID(ctx context.Context, filter
 	for rows.Next() {
 		var artist repoModel.Artist
 		var isFavorite sql.NullBool
-		err = rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail, &isFavorite)
+		err = rows.Scan(&artist.ID, &artist.Title, &artist.Description, &artist.Thumbnail, &artist.ThumbnailColor, &isFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetArtistsLabelID").Inc()
 			logger.Error("failed to scan artist", zap.Error(err))
@@ -1165,3 +1200,125 @@ func (r *artistPostgresRepository) AddArtistsToTracks(ctx context.Context, artis
 	r.metrics.DatabaseDuration.WithLabelValues("AddArtistsToTracks").Observe(duration)
 	return nil
 }
+
+func (r *artistPostgresRepository) CountLabelArtists(ctx context.Context, artistIDs []int64, labelID int64) (int64, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Counting label artists", zap.Any("artistIDs", artistIDs), zap.Int64("labelID", labelID), zap.String("query", CountLabelArtistsQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, CountLabelArtistsQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CountLabelArtists").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return 0, artistErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	var count int64
+	err = stmt.QueryRowContext(ctx, pq.Array(artistIDs), labelID).Scan(&count)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CountLabelArtists").Inc()
+		logger.Error("failed to count label artists", zap.Error(err))
+		return 0, artistErrors.NewInternalError("failed to count label artists: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("CountLabelArtists").Observe(duration)
+	return count, nil
+}
+
+func (r *artistPostgresRepository) SetArtistCredits(ctx context.Context, credits *repoModel.ArtistCredits) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Setting artist credits", zap.Any("credits", credits))
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("SetArtistCredits").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return artistErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	fail := func(message string, err error) error {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+		}
+		r.metrics.DatabaseErrors.WithLabelValues("SetArtistCredits").Inc()
+		logger.Error(message, zap.Error(err))
+		return artistErrors.NewInternalError("%s: %v", message, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, DeleteAlbumArtistsQuery, credits.AlbumID); err != nil {
+		return fail("failed to delete album artists", err)
+	}
+	if _, err := tx.ExecContext(ctx, AddArtistsToAlbum, pq.Array(credits.ArtistIDs), credits.AlbumID); err != nil {
+		return fail("failed to add artists to album", err)
+	}
+
+	for _, track := range credits.Tracks {
+		if _, err := tx.ExecContext(ctx, DeleteTrackMainArtistsQuery, track.TrackID); err != nil {
+			return fail("failed to delete track artists", err)
+		}
+		if _, err := tx.ExecContext(ctx, AddMainArtistsToTrackQuery, pq.Array(track.ArtistIDs), track.TrackID); err != nil {
+			return fail("failed to add artists to track", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("SetArtistCredits").Inc()
+		logger.Error("failed to commit transaction", zap.Error(err))
+		return artistErrors.NewInternalError("failed to commit transaction: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("SetArtistCredits").Observe(duration)
+	return nil
+}
+
+func (r *artistPostgresRepository) SetTrackArtistRoles(ctx context.Context, roles *repoModel.TrackArtistRoles) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Setting track artist roles", zap.Any("roles", roles))
+
+	artistIDs := make([]int64, 0, len(roles.Artists))
+	artistRoles := make([]string, 0, len(roles.Artists))
+	for _, artist := range roles.Artists {
+		artistIDs = append(artistIDs, artist.ArtistID)
+		artistRoles = append(artistRoles, artist.Role)
+	}
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("SetTrackArtistRoles").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return artistErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	fail := func(message string, err error) error {
+		if rollbackErr := tx.Rollback(); rollbackErr != nil {
+			logger.Error("failed to rollback transaction", zap.Error(rollbackErr))
+		}
+		r.metrics.DatabaseErrors.WithLabelValues("SetTrackArtistRoles").Inc()
+		logger.Error(message, zap.Error(err))
+		return artistErrors.NewInternalError("%s: %v", message, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, DeleteTrackArtistsQuery, roles.TrackID); err != nil {
+		return fail("failed to delete track artists", err)
+	}
+	if _, err := tx.ExecContext(ctx, AddTrackArtistRolesQuery, pq.Array(artistIDs), roles.TrackID, pq.Array(artistRoles)); err != nil {
+		return fail("failed to add artists to track", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("SetTrackArtistRoles").Inc()
+		logger.Error("failed to commit transaction", zap.Error(err))
+		return artistErrors.NewInternalError("failed to commit transaction: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("SetTrackArtistRoles").Observe(duration)
+	return nil
+}
@@ -40,9 +40,9 @@ func TestGetAllArtists(t *testing.T) {
 	}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "is_favorite"}).
-		AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", true).
-		AddRow(2, "Artist 2", "Description 2", "thumbnail2.jpg", false)
+	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "thumbnail_color", "is_favorite"}).
+		AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", "", true).
+		AddRow(2, "Artist 2", "Description 2", "thumbnail2.jpg", "", false)
 
 	mock.ExpectPrepare("SELECT artist.id, artist.title, artist.description, artist.thumbnail_url").
 		ExpectQuery().
@@ -99,8 +99,8 @@ func TestGetArtistByID(t *testing.T) {
 	artistID := int64(1)
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "is_favorite"}).
-		AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", true)
+	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "thumbnail_color", "is_favorite"}).
+		AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("SELECT artist.id, artist.title, artist.description, artist.thumbnail_url").
 		ExpectQuery().
@@ -140,6 +140,34 @@ func TestGetArtistByIDNotFound(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestGetArtistsByIDs(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
+	artistIDs := []int64{1, 2}
+	userID := int64(1)
+
+	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "thumbnail_color", "is_favorite"}).
+		AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", "", true).
+		AddRow(2, "Artist 2", "Description 2", "thumbnail2.jpg", "", nil)
+
+	mock.ExpectPrepare("SELECT artist.id, artist.title, artist.description, artist.thumbnail_url").
+		ExpectQuery().
+		WithArgs(pq.Array(artistIDs), userID).
+		WillReturnRows(rows)
+
+	artists, err := repo.GetArtistsByIDs(ctx, artistIDs, userID)
+	assert.NoError(t, err)
+	assert.Len(t, artists, 2)
+	assert.Equal(t, int64(1), artists[0].ID)
+	assert.True(t, artists[0].IsFavorite)
+	assert.Equal(t, int64(2), artists[1].ID)
+	assert.False(t, artists[1].IsFavorite)
+
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestGetArtistTitleByID(t *testing.T) {
 	db, mock, ctx := setupTest(t)
 	defer db.Close()
@@ -517,9 +545,9 @@ func TestGetFavoriteArtists(t *testing.T) {
 	}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url"}).
-		AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg").
-		AddRow(2, "Artist 2", "Description 2", "thumbnail2.jpg")
+	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "thumbnail_color"}).
+		AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", "").
+		AddRow(2, "Artist 2", "Description 2", "thumbnail2.jpg", "")
 
 	mock.ExpectPrepare("SELECT artist.id, artist.title, artist.description, artist.thumbnail_url").
 		ExpectQuery().
@@ -544,39 +572,6 @@ func TestGetFavoriteArtists(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestSearchArtists(t *testing.T) {
-	db, mock, ctx := setupTest(t)
-	defer db.Close()
-
-	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
-	query := "test artist"
-	userID := int64(1)
-
-	rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url"}).
-		AddRow(1, "Test Artist", "Description 1", "thumbnail1.jpg").
-		AddRow(2, "Artist Test", "Description 2", "thumbnail2.jpg")
-
-	mock.ExpectPrepare("SELECT a.id, a.title, a.description, a.thumbnail_url").
-		ExpectQuery().
-		WithArgs("test:* & artist:*", userID, query).
-		WillReturnRows(rows)
-
-	artists, err := repo.SearchArtists(ctx, query, userID)
-	assert.NoError(t, err)
-	assert.Len(t, artists, 2)
-	assert.Equal(t, int64(1), artists[0].ID)
-	assert.Equal(t, "Test Artist", artists[0].Title)
-	assert.Equal(t, "Description 1", artists[0].Description)
-	assert.Equal(t, "thumbnail1.jpg", artists[0].Thumbnail)
-
-	assert.Equal(t, int64(2), artists[1].ID)
-	assert.Equal(t, "Artist Test", artists[1].Title)
-	assert.Equal(t, "Description 2", artists[1].Description)
-	assert.Equal(t, "thumbnail2.jpg", artists[1].Thumbnail)
-
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
-
 func TestCreateArtist(t *testing.T) {
     db, mock, ctx := setupTest(t)
     defer db.Close()
@@ -591,7 +586,7 @@ func TestCreateArtist(t *testing.T) {
     rows := sqlmock.NewRows([]string{"id"}).AddRow(1)
     mock.ExpectPrepare("INSERT INTO artist").
         ExpectQuery().
-        WithArgs(artist.Title, artist.Thumbnail, artist.LabelID).
+        WithArgs(artist.Title, artist.Thumbnail, artist.ThumbnailColor, artist.LabelID).
         WillReturnRows(rows)
         
     mock.ExpectExec("REFRESH MATERIALIZED VIEW").
@@ -617,7 +612,7 @@ func TestCreateArtistError(t *testing.T) {
 
 	mock.ExpectPrepare("INSERT INTO artist").
 		ExpectQuery().
-		WithArgs(artist.Title, artist.Thumbnail, artist.LabelID).
+		WithArgs(artist.Title, artist.Thumbnail, artist.ThumbnailColor, artist.LabelID).
 		WillReturnError(stderrors.New("db error"))
 
 	artistNew, err := repo.CreateArtist(ctx, artist)
@@ -727,8 +722,8 @@ func TestGetArtistByIDWithoutUserID(t *testing.T) {
     repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
     artistID := int64(1)
 
-    rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url"}).
-        AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg")
+    rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "thumbnail_color"}).
+        AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", "")
 
     mock.ExpectPrepare("SELECT id, title, description, thumbnail_url").
         ExpectQuery().
@@ -791,13 +786,14 @@ func TestUploadAvatar(t *testing.T) {
 	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
 	artistID := int64(1)
 	avatarURL := "new_avatar.jpg"
+	avatarColor := "#a1b2c3"
 
 	mock.ExpectPrepare("UPDATE artist").
 		ExpectExec().
-		WithArgs(avatarURL, artistID).
+		WithArgs(avatarURL, avatarColor, artistID).
 		WillReturnResult(sqlmock.NewResult(1, 1))
 
-	err := repo.UploadAvatar(ctx, artistID, avatarURL)
+	err := repo.UploadAvatar(ctx, artistID, avatarURL, avatarColor)
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -809,13 +805,14 @@ func TestUploadAvatarError(t *testing.T) {
 	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
 	artistID := int64(1)
 	avatarURL := "new_avatar.jpg"
+	avatarColor := "#a1b2c3"
 
 	mock.ExpectPrepare("UPDATE artist").
 		ExpectExec().
-		WithArgs(avatarURL, artistID).
+		WithArgs(avatarURL, avatarColor, artistID).
 		WillReturnError(stderrors.New("db error"))
 
-	err := repo.UploadAvatar(ctx, artistID, avatarURL)
+	err := repo.UploadAvatar(ctx, artistID, avatarURL, avatarColor)
 	assert.Error(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -893,9 +890,9 @@ func TestGetArtistsLabelID(t *testing.T) {
         },
     }
 
-    rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "is_favorite"}).
-        AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", false).
-        AddRow(2, "Artist 2", "Description 2", "thumbnail2.jpg", false)
+    rows := sqlmock.NewRows([]string{"id", "title", "description", "thumbnail_url", "thumbnail_color", "is_favorite"}).
+        AddRow(1, "Artist 1", "Description 1", "thumbnail1.jpg", "", false).
+        AddRow(2, "Artist 2", "Description 2", "thumbnail2.jpg", "", false)
 
     mock.ExpectPrepare("SELECT artist.id, artist.title, artist.description, artist.thumbnail_url").
         ExpectQuery().
@@ -1043,4 +1040,91 @@ func TestAddArtistsToTracksError(t *testing.T) {
 	err := repo.AddArtistsToTracks(ctx, artistIDs, trackIDs)
 	assert.Error(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+func TestSetArtistCredits(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
+	credits := &repoModel.ArtistCredits{
+		AlbumID:   1,
+		ArtistIDs: []int64{1, 2},
+		Tracks: []*repoModel.TrackArtists{
+			{TrackID: 3, ArtistIDs: []int64{1}},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM album_artist").WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO album_artist").WithArgs(pq.Array([]int64{1, 2}), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM track_artist").WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO track_artist").WithArgs(pq.Array([]int64{1}), int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.SetArtistCredits(ctx, credits)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetArtistCreditsError(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM album_artist").WithArgs(int64(1)).
+		WillReturnError(stderrors.New("db error"))
+	mock.ExpectRollback()
+
+	err := repo.SetArtistCredits(ctx, &repoModel.ArtistCredits{AlbumID: 1, ArtistIDs: []int64{1}})
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetTrackArtistRoles(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
+	roles := &repoModel.TrackArtistRoles{
+		TrackID: 3,
+		Artists: []*repoModel.ArtistRole{
+			{ArtistID: 1, Role: "main"},
+			{ArtistID: 2, Role: "featured"},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM track_artist").WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO track_artist").WithArgs(pq.Array([]int64{1, 2}), int64(3), pq.Array([]string{"main", "featured"})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectCommit()
+
+	err := repo.SetTrackArtistRoles(ctx, roles)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSetTrackArtistRolesError(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewArtistPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM track_artist").WithArgs(int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO track_artist").
+		WillReturnError(stderrors.New("db error"))
+	mock.ExpectRollback()
+
+	err := repo.SetTrackArtistRoles(ctx, &repoModel.TrackArtistRoles{TrackID: 3, Artists: []*repoModel.ArtistRole{{ArtistID: 1, Role: "main"}}})
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
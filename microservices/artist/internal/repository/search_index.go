@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"database/sql"
+
+	artistErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/errors"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+)
+
+const (
+	SearchArtistIDsQuery = `
+		SELECT a.id
+		FROM artist a
+		LEFT JOIN artist_stats ast ON a.id = ast.artist_id
+		WHERE a.search_vector @@ to_tsquery('multilingual', $1)
+		   OR similarity(a.title_trgm, $2) > 0.3
+		ORDER BY 
+		    CASE WHEN a.search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
+		    (ts_rank(a.search_vector, to_tsquery('multilingual', $1)) + similarity(a.title_trgm, $2))
+		        * (1 + ln(1 + COALESCE(ast.listeners_count, 0) + COALESCE(ast.favorites_count, 0))) DESC,
+		    a.id DESC
+		LIMIT NULLIF($3, 0) OFFSET $4
+	`
+
+	GetArtistSearchDocumentsQuery = `
+		SELECT a.id, a.title, COALESCE(ast.listeners_count, 0) + COALESCE(ast.favorites_count, 0)
+		FROM artist a
+		LEFT JOIN artist_stats ast ON a.id = ast.artist_id
+		WHERE $1::bigint[] IS NULL OR a.id = ANY($1)
+	`
+)
+
+// NewArtistPostgresSearchIndex is the search backend built on the generated search_vector and
+// title_trgm columns of the artist table
+func NewArtistPostgresSearchIndex(db *sql.DB, metrics *metrics.Metrics) *searchIndex.PostgresIndex {
+	return searchIndex.NewPostgresIndex(db, metrics, searchIndex.PostgresQueries{
+		Entity:    "Artist",
+		Search:    SearchArtistIDsQuery,
+		Documents: GetArtistSearchDocumentsQuery,
+	}, artistErrors.NewInternalError)
+}
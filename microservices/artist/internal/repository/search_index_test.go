@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	artistErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/errors"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestArtistSearchIndexQueries(t *testing.T) {
+	// artists have no release status, every artist is searchable
+	assert.Contains(t, SearchArtistIDsQuery, "FROM artist a")
+	assert.Contains(t, SearchArtistIDsQuery, "artist_stats")
+	assert.Contains(t, GetArtistSearchDocumentsQuery, "a.title")
+	assert.Contains(t, GetArtistSearchDocumentsQuery, "artist_stats")
+}
+
+func TestArtistSearchIndexRunsItsQueries(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	index := NewArtistPostgresSearchIndex(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare(regexp.QuoteMeta(SearchArtistIDsQuery)).
+		ExpectQuery().
+		WithArgs("first:*", "first", int64(10), int64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectPrepare(regexp.QuoteMeta(GetArtistSearchDocumentsQuery)).
+		ExpectQuery().
+		WithArgs(pq.Array([]int64{1})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "text", "popularity"}).AddRow(1, "First Artist", 10))
+
+	ids, err := index.Search(ctx, "first", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	docs, err := index.LoadDocuments(ctx, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []*searchIndex.Document{{ID: 1, Text: "First Artist", Popularity: 10}}, docs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestArtistSearchIndexReturnsArtistErrors(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	index := NewArtistPostgresSearchIndex(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare(regexp.QuoteMeta(SearchArtistIDsQuery)).
+		ExpectQuery().
+		WillReturnError(errors.New("db error"))
+
+	ids, err := index.Search(ctx, "first", 10, 0)
+	assert.Nil(t, ids)
+	var artistErr *artistErrors.ArtistError
+	require.ErrorAs(t, err, &artistErr)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -3,22 +3,28 @@ package usecase
 import (
 	"context"
 
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	domain "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/internal/domain"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model"
 	artistErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/errors"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/usecase"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+	"go.uber.org/zap"
 )
 
-func NewArtistUsecase(artistRepository domain.Repository, s3Repo domain.S3Repository) domain.Usecase {
+func NewArtistUsecase(artistRepository domain.Repository, s3Repo domain.S3Repository, searchIndex searchIndex.Index) domain.Usecase {
 	return &artistUsecase{
-		artistRepo: artistRepository,
-		s3Repo:     s3Repo,
+		artistRepo:  artistRepository,
+		s3Repo:      s3Repo,
+		searchIndex: searchIndex,
 	}
 }
 
 type artistUsecase struct {
-	artistRepo domain.Repository
-	s3Repo     domain.S3Repository
+	artistRepo  domain.Repository
+	s3Repo      domain.S3Repository
+	searchIndex searchIndex.Index
 }
 
 func (u *artistUsecase) GetArtistByID(ctx context.Context, id int64, userID int64) (*usecaseModel.ArtistDetailed, error) {
@@ -152,32 +158,55 @@ func (u *artistUsecase) GetFavoriteArtists(ctx context.Context, filters *usecase
 	return model.ArtistListFromRepositoryToUsecase(repoArtists), nil
 }
 
-func (u *artistUsecase) SearchArtists(ctx context.Context, query string, userID int64) (*usecaseModel.ArtistList, error) {
-	repoArtists, err := u.artistRepo.SearchArtists(ctx, query, userID)
+func (u *artistUsecase) SearchArtists(ctx context.Context, query string, userID int64, filters *usecaseModel.Filters) (*usecaseModel.ArtistList, error) {
+	ids, err := u.searchIndex.Search(ctx, query, filters.Pagination.Limit, filters.Pagination.Offset)
 	if err != nil {
 		return nil, err
 	}
-	return model.ArtistListFromRepositoryToUsecase(repoArtists), nil
+
+	repoArtists, err := u.artistRepo.GetArtistsByIDs(ctx, ids, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	artistsByID := make(map[int64]*repoModel.Artist, len(repoArtists))
+	for _, artist := range repoArtists {
+		artistsByID[artist.ID] = artist
+	}
+
+	ranked := make([]*repoModel.Artist, 0, len(ids))
+	for _, id := range ids {
+		if artist, ok := artistsByID[id]; ok {
+			ranked = append(ranked, artist)
+		}
+	}
+	return model.ArtistListFromRepositoryToUsecase(ranked), nil
 }
 
 func (u *artistUsecase) CreateArtist(ctx context.Context, artist *usecaseModel.ArtistLoad) (*usecaseModel.Artist, error) {
 	artistLoad := model.ArtistLoadFromUsecaseToRepository(artist)
-	avatarURL, err := u.s3Repo.UploadArtistAvatar(ctx, artistLoad.Title, artist.Image)
+	avatarURL, avatarColor, err := u.s3Repo.UploadArtistAvatar(ctx, artistLoad.Title, artist.Image)
 	if err != nil {
 		return nil, err
 	}
 	artistLoad.Thumbnail = avatarURL
+	artistLoad.ThumbnailColor = avatarColor
 	createdArtist, err := u.artistRepo.CreateArtist(ctx, artistLoad)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := u.searchIndex.Refresh(ctx, []int64{createdArtist.ID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+
 	createdArtistUsecase := model.ArtistFromRepositoryToUsecase(createdArtist)
 	return createdArtistUsecase, nil
 }
 
-func (u *artistUsecase) UploadAvatar(ctx context.Context, artistID int64, avatar string) error {
-	err := u.artistRepo.UploadAvatar(ctx, artistID, avatar)
+func (u *artistUsecase) UploadAvatar(ctx context.Context, artistID int64, avatar string, avatarColor string) error {
+	err := u.artistRepo.UploadAvatar(ctx, artistID, avatar, avatarColor)
 	if err != nil {
 		return err
 	}
@@ -210,16 +239,20 @@ func (u *artistUsecase) EditArtist(ctx context.Context, artist *usecaseModel.Art
 		if err != nil {
 			return nil, err
 		}
+		if err := u.searchIndex.Refresh(ctx, []int64{artist.ArtistID}); err != nil {
+			logger := loggerPkg.LoggerFromContext(ctx)
+			logger.Warn("failed to refresh search index", zap.Error(err))
+		}
 		artistTitle = artistEdit.NewTitle
 	} else {
 		artistTitle = artistName
 	}
 	if artist.Image != nil {
-		avatarURL, err := u.s3Repo.UploadArtistAvatar(ctx, artistTitle, artist.Image)
+		avatarURL, avatarColor, err := u.s3Repo.UploadArtistAvatar(ctx, artistTitle, artist.Image)
 		if err != nil {
 			return nil, err
 		}
-		err = u.UploadAvatar(ctx, artist.ArtistID, avatarURL)
+		err = u.UploadAvatar(ctx, artist.ArtistID, avatarURL, avatarColor)
 		if err != nil {
 			return nil, err
 		}
@@ -254,6 +287,11 @@ func (u *artistUsecase) DeleteArtist(ctx context.Context, artist *usecaseModel.A
 	if err != nil {
 		return err
 	}
+
+	if err := u.searchIndex.Remove(ctx, []int64{artist.ArtistID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to remove artist from search index", zap.Error(err))
+	}
 	return nil
 }
 
@@ -268,3 +306,76 @@ func (u *artistUsecase) ConnectArtists(ctx context.Context, artistIDs []int64, a
 	}
 	return nil
 }
+
+// SetArtistCredits replaces the artists of the album, a track without its own artists gets the album ones
+func (u *artistUsecase) SetArtistCredits(ctx context.Context, credits *usecaseModel.ArtistCredits) error {
+	if len(credits.ArtistIDs) == 0 {
+		return artistErrors.ErrAlbumArtistRequired
+	}
+
+	uniqueIDs := make(map[int64]bool)
+	for _, id := range credits.ArtistIDs {
+		uniqueIDs[id] = true
+	}
+	for _, track := range credits.Tracks {
+		if len(track.ArtistIDs) == 0 {
+			track.ArtistIDs = credits.ArtistIDs
+		}
+		for _, id := range track.ArtistIDs {
+			uniqueIDs[id] = true
+		}
+	}
+
+	artistIDs := make([]int64, 0, len(uniqueIDs))
+	for id := range uniqueIDs {
+		artistIDs = append(artistIDs, id)
+	}
+	count, err := u.artistRepo.CountLabelArtists(ctx, artistIDs, credits.LabelID)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(artistIDs)) {
+		return artistErrors.ErrArtistsNotInLabel
+	}
+
+	return u.artistRepo.SetArtistCredits(ctx, model.ArtistCreditsFromUsecaseToRepository(credits))
+}
+
+var artistRoles = map[string]bool{
+	"main":     true,
+	"featured": true,
+	"producer": true,
+	"writer":   true,
+}
+
+// SetTrackArtistRoles replaces every credit of the track, all of them have to be artists of the label
+func (u *artistUsecase) SetTrackArtistRoles(ctx context.Context, roles *usecaseModel.TrackArtistRoles) error {
+	hasMain := false
+	uniqueIDs := make(map[int64]bool)
+	for _, artist := range roles.Artists {
+		if !artistRoles[artist.Role] {
+			return artistErrors.ErrInvalidArtistRole
+		}
+		if artist.Role == "main" {
+			hasMain = true
+		}
+		uniqueIDs[artist.ArtistID] = true
+	}
+	if !hasMain {
+		return artistErrors.ErrMainArtistRequired
+	}
+
+	artistIDs := make([]int64, 0, len(uniqueIDs))
+	for id := range uniqueIDs {
+		artistIDs = append(artistIDs, id)
+	}
+	count, err := u.artistRepo.CountLabelArtists(ctx, artistIDs, roles.LabelID)
+	if err != nil {
+		return err
+	}
+	if count != int64(len(artistIDs)) {
+		return artistErrors.ErrArtistsNotInLabel
+	}
+
+	return u.artistRepo.SetTrackArtistRoles(ctx, model.TrackArtistRolesFromUsecaseToRepository(roles))
+}
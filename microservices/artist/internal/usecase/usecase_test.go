@@ -7,8 +7,10 @@ import (
 
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
 	mock_domain "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/internal/mocks"
+	artistErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/errors"
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/artist/model/usecase"
+	mock_searchIndex "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
@@ -42,7 +44,7 @@ func setupTest(t *testing.T) (*mock_domain.MockRepository, *mock_domain.MockS3Re
 
 func TestGetArtistByID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedArtist := &repoModel.Artist{
 		ID:          mockArtistID,
@@ -71,7 +73,7 @@ func TestGetArtistByID(t *testing.T) {
 
 func TestGetArtistByIDRepositoryError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().GetArtistByID(ctx, mockArtistID, mockUserID).Return(nil, expectedErr)
@@ -85,7 +87,7 @@ func TestGetArtistByIDRepositoryError(t *testing.T) {
 
 func TestGetArtistByIDStatsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedArtist := &repoModel.Artist{
 		ID:          mockArtistID,
@@ -108,7 +110,7 @@ func TestGetArtistByIDStatsError(t *testing.T) {
 
 func TestGetAllArtists(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	filters := &usecaseModel.Filters{
 		Pagination: &usecaseModel.Pagination{
@@ -139,7 +141,7 @@ func TestGetAllArtists(t *testing.T) {
 
 func TestGetAllArtistsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	filters := &usecaseModel.Filters{
 		Pagination: &usecaseModel.Pagination{
@@ -160,7 +162,7 @@ func TestGetAllArtistsError(t *testing.T) {
 
 func TestGetArtistTitleByID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	mockRepo.EXPECT().GetArtistTitleByID(ctx, mockArtistID).Return(mockArtistTitle, nil)
 
@@ -172,7 +174,7 @@ func TestGetArtistTitleByID(t *testing.T) {
 
 func TestGetArtistTitleByIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().GetArtistTitleByID(ctx, mockArtistID).Return("", expectedErr)
@@ -186,7 +188,7 @@ func TestGetArtistTitleByIDError(t *testing.T) {
 
 func TestGetArtistsByTrackID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedArtists := []*repoModel.ArtistWithRole{
 		{
@@ -209,7 +211,7 @@ func TestGetArtistsByTrackID(t *testing.T) {
 
 func TestGetArtistsByTrackIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().GetArtistsByTrackID(ctx, mockTrackID).Return(nil, expectedErr)
@@ -223,7 +225,7 @@ func TestGetArtistsByTrackIDError(t *testing.T) {
 
 func TestGetArtistsByTrackIDs(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	trackIDs := []int64{mockTrackID, mockTrackID + 1}
 	expectedArtists := map[int64][]*repoModel.ArtistWithRole{
@@ -247,7 +249,7 @@ func TestGetArtistsByTrackIDs(t *testing.T) {
 
 func TestGetArtistsByTrackIDsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	trackIDs := []int64{mockTrackID}
 	expectedErr := errors.New("repository error")
@@ -262,7 +264,7 @@ func TestGetArtistsByTrackIDsError(t *testing.T) {
 
 func TestGetArtistsByAlbumID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedArtists := []*repoModel.ArtistWithTitle{
 		{
@@ -283,7 +285,7 @@ func TestGetArtistsByAlbumID(t *testing.T) {
 
 func TestGetArtistsByAlbumIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().GetArtistsByAlbumID(ctx, mockAlbumID).Return(nil, expectedErr)
@@ -297,7 +299,7 @@ func TestGetArtistsByAlbumIDError(t *testing.T) {
 
 func TestGetArtistsByAlbumIDs(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	albumIDs := []int64{mockAlbumID, mockAlbumID + 1}
 	expectedArtists := map[int64][]*repoModel.ArtistWithTitle{
@@ -320,7 +322,7 @@ func TestGetArtistsByAlbumIDs(t *testing.T) {
 
 func TestGetArtistsByAlbumIDsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	albumIDs := []int64{mockAlbumID}
 	expectedErr := errors.New("repository error")
@@ -335,7 +337,7 @@ func TestGetArtistsByAlbumIDsError(t *testing.T) {
 
 func TestGetAlbumIDsByArtistID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedAlbumIDs := []int64{mockAlbumID, mockAlbumID + 1}
 	mockRepo.EXPECT().GetAlbumIDsByArtistID(ctx, mockArtistID).Return(expectedAlbumIDs, nil)
@@ -348,7 +350,7 @@ func TestGetAlbumIDsByArtistID(t *testing.T) {
 
 func TestGetAlbumIDsByArtistIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().GetAlbumIDsByArtistID(ctx, mockArtistID).Return(nil, expectedErr)
@@ -362,7 +364,7 @@ func TestGetAlbumIDsByArtistIDError(t *testing.T) {
 
 func TestGetTrackIDsByArtistID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedTrackIDs := []int64{mockTrackID, mockTrackID + 1}
 	mockRepo.EXPECT().GetTrackIDsByArtistID(ctx, mockArtistID).Return(expectedTrackIDs, nil)
@@ -375,7 +377,7 @@ func TestGetTrackIDsByArtistID(t *testing.T) {
 
 func TestGetTrackIDsByArtistIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().GetTrackIDsByArtistID(ctx, mockArtistID).Return(nil, expectedErr)
@@ -389,7 +391,7 @@ func TestGetTrackIDsByArtistIDError(t *testing.T) {
 
 func TestCreateStreamsByArtistIDs(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	streamData := &usecaseModel.ArtistStreamCreateDataList{
 		ArtistIDs: []int64{mockArtistID},
@@ -405,7 +407,7 @@ func TestCreateStreamsByArtistIDs(t *testing.T) {
 
 func TestCreateStreamsByArtistIDsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	streamData := &usecaseModel.ArtistStreamCreateDataList{
 		ArtistIDs: []int64{mockArtistID},
@@ -423,7 +425,7 @@ func TestCreateStreamsByArtistIDsError(t *testing.T) {
 
 func TestGetArtistsListenedByUserID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedCount := int64(42)
 	mockRepo.EXPECT().GetArtistsListenedByUserID(ctx, mockUserID).Return(expectedCount, nil)
@@ -436,7 +438,7 @@ func TestGetArtistsListenedByUserID(t *testing.T) {
 
 func TestGetArtistsListenedByUserIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().GetArtistsListenedByUserID(ctx, mockUserID).Return(int64(0), expectedErr)
@@ -450,7 +452,7 @@ func TestGetArtistsListenedByUserIDError(t *testing.T) {
 
 func TestLikeArtist(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	likeRequest := &usecaseModel.LikeRequest{
 		ArtistID: mockArtistID,
@@ -468,7 +470,7 @@ func TestLikeArtist(t *testing.T) {
 
 func TestLikeArtistUnlike(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	likeRequest := &usecaseModel.LikeRequest{
 		ArtistID: mockArtistID,
@@ -486,7 +488,7 @@ func TestLikeArtistUnlike(t *testing.T) {
 
 func TestLikeArtistNotFound(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	likeRequest := &usecaseModel.LikeRequest{
 		ArtistID: mockArtistID,
@@ -504,7 +506,7 @@ func TestLikeArtistNotFound(t *testing.T) {
 
 func TestLikeArtistCheckExistsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	likeRequest := &usecaseModel.LikeRequest{
 		ArtistID: mockArtistID,
@@ -523,7 +525,7 @@ func TestLikeArtistCheckExistsError(t *testing.T) {
 
 func TestLikeArtistLikeError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	likeRequest := &usecaseModel.LikeRequest{
 		ArtistID: mockArtistID,
@@ -543,7 +545,7 @@ func TestLikeArtistLikeError(t *testing.T) {
 
 func TestGetFavoriteArtists(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	filters := &usecaseModel.Filters{
 		Pagination: &usecaseModel.Pagination{
@@ -574,7 +576,7 @@ func TestGetFavoriteArtists(t *testing.T) {
 
 func TestGetFavoriteArtistsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	filters := &usecaseModel.Filters{
 		Pagination: &usecaseModel.Pagination{
@@ -595,7 +597,15 @@ func TestGetFavoriteArtistsError(t *testing.T) {
 
 func TestSearchArtists(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mockIndex)
+
+	filters := &usecaseModel.Filters{
+		Pagination: &usecaseModel.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
 
 	expectedArtists := []*repoModel.Artist{
 		{
@@ -605,26 +615,43 @@ func TestSearchArtists(t *testing.T) {
 			Thumbnail:   mockThumbnail,
 			IsFavorite:  false,
 		},
+		{
+			ID:          mockArtistID + 1,
+			Title:       mockNewTitle,
+			Description: mockDescription,
+			Thumbnail:   mockThumbnail,
+			IsFavorite:  true,
+		},
 	}
 
-	mockRepo.EXPECT().SearchArtists(ctx, mockSearchQuery, mockUserID).Return(expectedArtists, nil)
+	mockIndex.EXPECT().Search(ctx, mockSearchQuery, int64(10), int64(0)).Return([]int64{mockArtistID + 1, mockArtistID}, nil)
+	mockRepo.EXPECT().GetArtistsByIDs(ctx, []int64{mockArtistID + 1, mockArtistID}, mockUserID).Return(expectedArtists, nil)
 
-	result, err := usecase.SearchArtists(ctx, mockSearchQuery, mockUserID)
+	result, err := usecase.SearchArtists(ctx, mockSearchQuery, mockUserID, filters)
 
 	require.NoError(t, err)
-	assert.Len(t, result.Artists, 1)
-	assert.Equal(t, expectedArtists[0].ID, result.Artists[0].ID)
-	assert.Equal(t, expectedArtists[0].Title, result.Artists[0].Title)
+	assert.Len(t, result.Artists, 2)
+	assert.Equal(t, expectedArtists[1].ID, result.Artists[0].ID)
+	assert.Equal(t, expectedArtists[1].Title, result.Artists[0].Title)
+	assert.Equal(t, expectedArtists[0].ID, result.Artists[1].ID)
 }
 
 func TestSearchArtistsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mockIndex)
+
+	filters := &usecaseModel.Filters{
+		Pagination: &usecaseModel.Pagination{
+			Offset: 0,
+			Limit:  10,
+		},
+	}
 
 	expectedErr := errors.New("search error")
-	mockRepo.EXPECT().SearchArtists(ctx, mockSearchQuery, mockUserID).Return(nil, expectedErr)
+	mockIndex.EXPECT().Search(ctx, mockSearchQuery, int64(10), int64(0)).Return(nil, expectedErr)
 
-	result, err := usecase.SearchArtists(ctx, mockSearchQuery, mockUserID)
+	result, err := usecase.SearchArtists(ctx, mockSearchQuery, mockUserID, filters)
 
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
@@ -633,7 +660,8 @@ func TestSearchArtistsError(t *testing.T) {
 
 func TestCreateArtist(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mockIndex)
 
 	artistLoad := &usecaseModel.ArtistLoad{
 		Title:   mockArtistTitle,
@@ -649,8 +677,9 @@ func TestCreateArtist(t *testing.T) {
 		IsFavorite:  false,
 	}
 
-	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistLoad.Image).Return(mockAvatarURL, nil)
+	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistLoad.Image).Return(mockAvatarURL, "#a1b2c3", nil)
 	mockRepo.EXPECT().CreateArtist(ctx, gomock.Any()).Return(expectedCreatedArtist, nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{mockArtistID}).Return(nil)
 
 	result, err := usecase.CreateArtist(ctx, artistLoad)
 
@@ -662,7 +691,7 @@ func TestCreateArtist(t *testing.T) {
 
 func TestCreateArtistS3Error(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistLoad := &usecaseModel.ArtistLoad{
 		Title:   mockArtistTitle,
@@ -671,7 +700,7 @@ func TestCreateArtistS3Error(t *testing.T) {
 	}
 
 	expectedErr := errors.New("s3 upload error")
-	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistLoad.Image).Return("", expectedErr)
+	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistLoad.Image).Return("", "", expectedErr)
 
 	result, err := usecase.CreateArtist(ctx, artistLoad)
 
@@ -682,7 +711,7 @@ func TestCreateArtistS3Error(t *testing.T) {
 
 func TestCreateArtistRepositoryError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistLoad := &usecaseModel.ArtistLoad{
 		Title:   mockArtistTitle,
@@ -690,7 +719,7 @@ func TestCreateArtistRepositoryError(t *testing.T) {
 		LabelID: mockLabelID,
 	}
 
-	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistLoad.Image).Return(mockAvatarURL, nil)
+	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistLoad.Image).Return(mockAvatarURL, "#a1b2c3", nil)
 
 	expectedErr := errors.New("repository error")
 	mockRepo.EXPECT().CreateArtist(ctx, gomock.Any()).Return(nil, expectedErr)
@@ -704,7 +733,8 @@ func TestCreateArtistRepositoryError(t *testing.T) {
 
 func TestEditArtist(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mockIndex)
 
 	artistEdit := &usecaseModel.ArtistEdit{
 		ArtistID: mockArtistID,
@@ -725,8 +755,9 @@ func TestEditArtist(t *testing.T) {
 	mockRepo.EXPECT().GetArtistTitleByID(ctx, mockArtistID).Return(mockArtistTitle, nil)
 	mockRepo.EXPECT().CheckArtistNameExist(ctx, mockArtistID).Return(true, nil)
 	mockRepo.EXPECT().ChangeArtistTitle(ctx, mockNewTitle, mockArtistID).Return(nil)
-	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockNewTitle, artistEdit.Image).Return(mockAvatarURL, nil)
-	mockRepo.EXPECT().UploadAvatar(ctx, mockArtistID, mockAvatarURL).Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{mockArtistID}).Return(nil)
+	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockNewTitle, artistEdit.Image).Return(mockAvatarURL, "#a1b2c3", nil)
+	mockRepo.EXPECT().UploadAvatar(ctx, mockArtistID, mockAvatarURL, "#a1b2c3").Return(nil)
 	mockRepo.EXPECT().GetArtistByIDWithoutUser(ctx, mockArtistID).Return(expectedArtist, nil)
 
 	result, err := usecase.EditArtist(ctx, artistEdit)
@@ -739,7 +770,7 @@ func TestEditArtist(t *testing.T) {
 
 func TestEditArtistForbidden(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistEdit := &usecaseModel.ArtistEdit{
 		ArtistID: mockArtistID,
@@ -759,7 +790,7 @@ func TestEditArtistForbidden(t *testing.T) {
 
 func TestEditArtistWithoutTitleChange(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistEdit := &usecaseModel.ArtistEdit{
 		ArtistID: mockArtistID,
@@ -778,8 +809,8 @@ func TestEditArtistWithoutTitleChange(t *testing.T) {
 
 	mockRepo.EXPECT().GetArtistLabelID(ctx, mockArtistID).Return(mockLabelID, nil)
 	mockRepo.EXPECT().GetArtistTitleByID(ctx, mockArtistID).Return(mockArtistTitle, nil)
-	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistEdit.Image).Return(mockAvatarURL, nil)
-	mockRepo.EXPECT().UploadAvatar(ctx, mockArtistID, mockAvatarURL).Return(nil)
+	mockS3Repo.EXPECT().UploadArtistAvatar(ctx, mockArtistTitle, artistEdit.Image).Return(mockAvatarURL, "#a1b2c3", nil)
+	mockRepo.EXPECT().UploadAvatar(ctx, mockArtistID, mockAvatarURL, "#a1b2c3").Return(nil)
 	mockRepo.EXPECT().GetArtistByIDWithoutUser(ctx, mockArtistID).Return(expectedArtist, nil)
 
 	result, err := usecase.EditArtist(ctx, artistEdit)
@@ -791,7 +822,7 @@ func TestEditArtistWithoutTitleChange(t *testing.T) {
 
 func TestEditArtistNotFound(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistEdit := &usecaseModel.ArtistEdit{
 		ArtistID: mockArtistID,
@@ -812,7 +843,7 @@ func TestEditArtistNotFound(t *testing.T) {
 
 func TestGetArtistsLabelID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	filters := &usecaseModel.Filters{
 		Pagination: &usecaseModel.Pagination{
@@ -842,7 +873,7 @@ func TestGetArtistsLabelID(t *testing.T) {
 
 func TestGetArtistsLabelIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	filters := &usecaseModel.Filters{
 		Pagination: &usecaseModel.Pagination{
@@ -863,7 +894,8 @@ func TestGetArtistsLabelIDError(t *testing.T) {
 
 func TestDeleteArtist(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mockIndex)
 
 	artistDelete := &usecaseModel.ArtistDelete{
 		ArtistID: mockArtistID,
@@ -872,6 +904,8 @@ func TestDeleteArtist(t *testing.T) {
 
 	mockRepo.EXPECT().GetArtistLabelID(ctx, mockArtistID).Return(mockLabelID, nil)
 	mockRepo.EXPECT().DeleteArtist(ctx, mockArtistID).Return(nil)
+	// the artist is already gone from the database, a stale index entry is dropped on materialization
+	mockIndex.EXPECT().Remove(ctx, []int64{mockArtistID}).Return(errors.New("index error"))
 
 	err := usecase.DeleteArtist(ctx, artistDelete)
 
@@ -880,7 +914,7 @@ func TestDeleteArtist(t *testing.T) {
 
 func TestDeleteArtistForbidden(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistDelete := &usecaseModel.ArtistDelete{
 		ArtistID: mockArtistID,
@@ -898,7 +932,7 @@ func TestDeleteArtistForbidden(t *testing.T) {
 
 func TestDeleteArtistGetLabelIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistDelete := &usecaseModel.ArtistDelete{
 		ArtistID: mockArtistID,
@@ -916,7 +950,7 @@ func TestDeleteArtistGetLabelIDError(t *testing.T) {
 
 func TestDeleteArtistRepositoryError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistDelete := &usecaseModel.ArtistDelete{
 		ArtistID: mockArtistID,
@@ -935,7 +969,7 @@ func TestDeleteArtistRepositoryError(t *testing.T) {
 
 func TestConnectArtists(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistIDs := []int64{mockArtistID, mockArtistID + 1}
 	trackIDs := []int64{mockTrackID, mockTrackID + 1}
@@ -950,7 +984,7 @@ func TestConnectArtists(t *testing.T) {
 
 func TestConnectArtistsAlbumError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistIDs := []int64{mockArtistID}
 	trackIDs := []int64{mockTrackID}
@@ -966,7 +1000,7 @@ func TestConnectArtistsAlbumError(t *testing.T) {
 
 func TestConnectArtistsTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	usecase := NewArtistUsecase(mockRepo, mockS3Repo)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
 
 	artistIDs := []int64{mockArtistID}
 	trackIDs := []int64{mockTrackID}
@@ -980,3 +1014,128 @@ func TestConnectArtistsTracksError(t *testing.T) {
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 }
+
+func TestSetArtistCredits(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
+
+	credits := &usecaseModel.ArtistCredits{
+		AlbumID:   mockAlbumID,
+		LabelID:   mockLabelID,
+		ArtistIDs: []int64{mockArtistID},
+		Tracks: []*usecaseModel.TrackArtists{
+			{TrackID: mockTrackID},
+			{TrackID: mockTrackID + 1, ArtistIDs: []int64{mockArtistID + 1}},
+		},
+	}
+
+	mockRepo.EXPECT().CountLabelArtists(ctx, gomock.InAnyOrder([]int64{mockArtistID, mockArtistID + 1}), mockLabelID).Return(int64(2), nil)
+	mockRepo.EXPECT().SetArtistCredits(ctx, &repoModel.ArtistCredits{
+		AlbumID:   mockAlbumID,
+		ArtistIDs: []int64{mockArtistID},
+		Tracks: []*repoModel.TrackArtists{
+			{TrackID: mockTrackID, ArtistIDs: []int64{mockArtistID}},
+			{TrackID: mockTrackID + 1, ArtistIDs: []int64{mockArtistID + 1}},
+		},
+	}).Return(nil)
+
+	err := usecase.SetArtistCredits(ctx, credits)
+
+	require.NoError(t, err)
+}
+
+func TestSetArtistCreditsForeignArtist(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
+
+	credits := &usecaseModel.ArtistCredits{
+		AlbumID:   mockAlbumID,
+		LabelID:   mockLabelID,
+		ArtistIDs: []int64{mockArtistID, mockArtistID + 1},
+	}
+
+	mockRepo.EXPECT().CountLabelArtists(ctx, gomock.Any(), mockLabelID).Return(int64(1), nil)
+
+	err := usecase.SetArtistCredits(ctx, credits)
+
+	assert.ErrorIs(t, err, artistErrors.ErrArtistsNotInLabel)
+}
+
+func TestSetArtistCreditsWithoutArtists(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
+
+	err := usecase.SetArtistCredits(ctx, &usecaseModel.ArtistCredits{AlbumID: mockAlbumID, LabelID: mockLabelID})
+
+	assert.ErrorIs(t, err, artistErrors.ErrAlbumArtistRequired)
+}
+
+func TestSetTrackArtistRoles(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
+
+	roles := &usecaseModel.TrackArtistRoles{
+		TrackID: mockTrackID,
+		LabelID: mockLabelID,
+		Artists: []*usecaseModel.ArtistRole{
+			{ArtistID: mockArtistID, Role: "main"},
+			{ArtistID: mockArtistID, Role: "writer"},
+			{ArtistID: mockArtistID + 1, Role: "featured"},
+		},
+	}
+
+	mockRepo.EXPECT().CountLabelArtists(ctx, gomock.InAnyOrder([]int64{mockArtistID, mockArtistID + 1}), mockLabelID).Return(int64(2), nil)
+	mockRepo.EXPECT().SetTrackArtistRoles(ctx, &repoModel.TrackArtistRoles{
+		TrackID: mockTrackID,
+		Artists: []*repoModel.ArtistRole{
+			{ArtistID: mockArtistID, Role: "main"},
+			{ArtistID: mockArtistID, Role: "writer"},
+			{ArtistID: mockArtistID + 1, Role: "featured"},
+		},
+	}).Return(nil)
+
+	err := usecase.SetTrackArtistRoles(ctx, roles)
+
+	require.NoError(t, err)
+}
+
+func TestSetTrackArtistRolesErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		artists     []*usecaseModel.ArtistRole
+		labelCount  int64
+		expectedErr error
+	}{
+		{
+			name:        "Unknown role",
+			artists:     []*usecaseModel.ArtistRole{{ArtistID: mockArtistID, Role: "main"}, {ArtistID: mockArtistID, Role: "dj"}},
+			expectedErr: artistErrors.ErrInvalidArtistRole,
+		},
+		{
+			name:        "No main artist",
+			artists:     []*usecaseModel.ArtistRole{{ArtistID: mockArtistID, Role: "featured"}},
+			expectedErr: artistErrors.ErrMainArtistRequired,
+		},
+		{
+			name:        "Artist of another label",
+			artists:     []*usecaseModel.ArtistRole{{ArtistID: mockArtistID, Role: "main"}, {ArtistID: mockArtistID + 1, Role: "producer"}},
+			labelCount:  1,
+			expectedErr: artistErrors.ErrArtistsNotInLabel,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, mockS3Repo, ctx := setupTest(t)
+			usecase := NewArtistUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)))
+
+			if tt.labelCount > 0 {
+				mockRepo.EXPECT().CountLabelArtists(ctx, gomock.Any(), mockLabelID).Return(tt.labelCount, nil)
+			}
+
+			err := usecase.SetTrackArtistRoles(ctx, &usecaseModel.TrackArtistRoles{TrackID: mockTrackID, LabelID: mockLabelID, Artists: tt.artists})
+
+			assert.ErrorIs(t, err, tt.expectedErr)
+		})
+	}
+}
@@ -8,21 +8,23 @@ import (
 
 func ArtistFromRepositoryToUsecase(artist *repoModel.Artist) *usecaseModel.Artist {
 	return &usecaseModel.Artist{
-		ID:          artist.ID,
-		Title:       artist.Title,
-		Description: artist.Description,
-		Thumbnail:   artist.Thumbnail,
-		IsFavorite:  artist.IsFavorite,
+		ID:             artist.ID,
+		Title:          artist.Title,
+		Description:    artist.Description,
+		Thumbnail:      artist.Thumbnail,
+		ThumbnailColor: artist.ThumbnailColor,
+		IsFavorite:     artist.IsFavorite,
 	}
 }
 
 func ArtistFromUsecaseToProto(artist *usecaseModel.Artist) *protoModel.Artist {
 	return &protoModel.Artist{
-		Id:          artist.ID,
-		Title:       artist.Title,
-		Description: artist.Description,
-		Thumbnail:   artist.Thumbnail,
-		IsFavorite:  artist.IsFavorite,
+		Id:             artist.ID,
+		Title:          artist.Title,
+		Description:    artist.Description,
+		Thumbnail:      artist.Thumbnail,
+		ThumbnailColor: artist.ThumbnailColor,
+		IsFavorite:     artist.IsFavorite,
 	}
 }
 
@@ -202,14 +204,14 @@ func AlbumIDListFromProtoToUsecase(ids []*protoModel.AlbumID) []int64 {
 
 func PaginationFromProtoToUsecase(pagination *protoModel.Pagination) *usecaseModel.Pagination {
 	return &usecaseModel.Pagination{
-		Offset: pagination.Offset,
-		Limit:  pagination.Limit,
+		Offset: pagination.GetOffset(),
+		Limit:  pagination.GetLimit(),
 	}
 }
 
 func ArtistFiltersFromProtoToUsecase(filters *protoModel.Filters) *usecaseModel.Filters {
 	return &usecaseModel.Filters{
-		Pagination: PaginationFromProtoToUsecase(filters.Pagination),
+		Pagination: PaginationFromProtoToUsecase(filters.GetPagination()),
 	}
 }
 
@@ -296,3 +298,71 @@ func ArtistsIDWithAlbumIDFromProtoToUsecase(artists *protoModel.ArtistsIDWithAlb
 	}
 	return artistIDs, artists.AlbumId.Id, tracksIDs
 }
+
+func artistIDListFromProto(ids *protoModel.ArtistIDList) []int64 {
+	artistIDs := make([]int64, 0, len(ids.GetIds()))
+	for _, id := range ids.GetIds() {
+		artistIDs = append(artistIDs, id.Id)
+	}
+	return artistIDs
+}
+
+func ArtistCreditsFromProtoToUsecase(credits *protoModel.ArtistCredits) *usecaseModel.ArtistCredits {
+	tracks := make([]*usecaseModel.TrackArtists, 0, len(credits.Tracks))
+	for _, track := range credits.Tracks {
+		tracks = append(tracks, &usecaseModel.TrackArtists{
+			TrackID:   track.GetTrackId().GetId(),
+			ArtistIDs: artistIDListFromProto(track.ArtistIds),
+		})
+	}
+	return &usecaseModel.ArtistCredits{
+		AlbumID:   credits.GetAlbumId().GetId(),
+		LabelID:   credits.LabelId,
+		ArtistIDs: artistIDListFromProto(credits.ArtistIds),
+		Tracks:    tracks,
+	}
+}
+
+func ArtistCreditsFromUsecaseToRepository(credits *usecaseModel.ArtistCredits) *repoModel.ArtistCredits {
+	tracks := make([]*repoModel.TrackArtists, 0, len(credits.Tracks))
+	for _, track := range credits.Tracks {
+		tracks = append(tracks, &repoModel.TrackArtists{
+			TrackID:   track.TrackID,
+			ArtistIDs: track.ArtistIDs,
+		})
+	}
+	return &repoModel.ArtistCredits{
+		AlbumID:   credits.AlbumID,
+		ArtistIDs: credits.ArtistIDs,
+		Tracks:    tracks,
+	}
+}
+
+func TrackArtistRolesFromProtoToUsecase(roles *protoModel.TrackArtistRoles) *usecaseModel.TrackArtistRoles {
+	artists := make([]*usecaseModel.ArtistRole, 0, len(roles.Artists))
+	for _, artist := range roles.Artists {
+		artists = append(artists, &usecaseModel.ArtistRole{
+			ArtistID: artist.GetArtistId().GetId(),
+			Role:     artist.Role,
+		})
+	}
+	return &usecaseModel.TrackArtistRoles{
+		TrackID: roles.GetTrackId().GetId(),
+		LabelID: roles.LabelId,
+		Artists: artists,
+	}
+}
+
+func TrackArtistRolesFromUsecaseToRepository(roles *usecaseModel.TrackArtistRoles) *repoModel.TrackArtistRoles {
+	artists := make([]*repoModel.ArtistRole, 0, len(roles.Artists))
+	for _, artist := range roles.Artists {
+		artists = append(artists, &repoModel.ArtistRole{
+			ArtistID: artist.ArtistID,
+			Role:     artist.Role,
+		})
+	}
+	return &repoModel.TrackArtistRoles{
+		TrackID: roles.TrackID,
+		Artists: artists,
+	}
+}
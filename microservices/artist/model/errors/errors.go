@@ -77,5 +77,9 @@ func NewForbiddenError(format string, args ...interface{}) error {
 }
 
 var (
-	ErrArtistNotFound = NewNotFoundError("artist not found")
+	ErrArtistNotFound      = NewNotFoundError("artist not found")
+	ErrArtistsNotInLabel   = NewForbiddenError("only artists of the label can be credited")
+	ErrAlbumArtistRequired = NewBadRequestError("album must have at least one artist")
+	ErrMainArtistRequired  = NewBadRequestError("track must have at least one main artist")
+	ErrInvalidArtistRole   = NewBadRequestError("artist role must be main, featured, producer or writer")
 )
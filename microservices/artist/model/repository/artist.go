@@ -5,8 +5,10 @@ type Artist struct {
 	Title       string `sql:"title"`
 	Description string `sql:"description"`
 	Thumbnail   string `sql:"thumbnail_url"`
-	IsFavorite  bool   `sql:"is_favorite"`
-	LabelID     int64  `sql:"label_id"`
+	// ThumbnailColor is the dominant color of the thumbnail, empty for images stored before the variants
+	ThumbnailColor string `sql:"thumbnail_color"`
+	IsFavorite     bool   `sql:"is_favorite"`
+	LabelID        int64  `sql:"label_id"`
 }
 
 type ArtistWithTitle struct {
@@ -50,3 +52,24 @@ type ArtistEdit struct {
 	Image    []byte
 	LabelID  int64
 }
+
+type TrackArtists struct {
+	TrackID   int64
+	ArtistIDs []int64
+}
+
+type ArtistCredits struct {
+	AlbumID   int64
+	ArtistIDs []int64
+	Tracks    []*TrackArtists
+}
+
+type ArtistRole struct {
+	ArtistID int64
+	Role     string
+}
+
+type TrackArtistRoles struct {
+	TrackID int64
+	Artists []*ArtistRole
+}
@@ -1,12 +1,13 @@
 package usecase
 
 type Artist struct {
-	ID          int64
-	Title       string
-	Description string
-	Thumbnail   string
-	IsFavorite  bool
-	LabelID     int64
+	ID             int64
+	Title          string
+	Description    string
+	Thumbnail      string
+	ThumbnailColor string
+	IsFavorite     bool
+	LabelID        int64
 }
 
 type ArtistList struct {
@@ -83,3 +84,26 @@ type ArtistDelete struct {
 	ArtistID int64
 	LabelID  int64
 }
+
+type TrackArtists struct {
+	TrackID   int64
+	ArtistIDs []int64
+}
+
+type ArtistCredits struct {
+	AlbumID   int64
+	LabelID   int64
+	ArtistIDs []int64
+	Tracks    []*TrackArtists
+}
+
+type ArtistRole struct {
+	ArtistID int64
+	Role     string
+}
+
+type TrackArtistRoles struct {
+	TrackID int64
+	LabelID int64
+	Artists []*ArtistRole
+}
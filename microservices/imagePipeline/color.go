@@ -0,0 +1,56 @@
+package imagePipeline
+
+import (
+	"fmt"
+	"image"
+)
+
+// colors are counted in buckets of 4 bits per channel so that noise and gradients
+// of one color land in the same bucket
+const colorBucketShift = 4
+
+// dominantColor is the average of the most common bucket of opaque pixels as #rrggbb,
+// the smallest variant has plenty of pixels for that
+func dominantColor(img *image.RGBA) string {
+	type bucket struct {
+		count            int64
+		red, green, blue int64
+	}
+
+	buckets := make(map[uint32]*bucket)
+	var best *bucket
+	bounds := img.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			offset := img.PixOffset(x, y)
+			pixel := img.Pix[offset : offset+4]
+			// transparent pixels show the page behind the image
+			if pixel[3] < 0x80 {
+				continue
+			}
+			// RGBA is premultiplied, undo it to get the color that is seen
+			red := uint32(pixel[0]) * 0xFF / uint32(pixel[3])
+			green := uint32(pixel[1]) * 0xFF / uint32(pixel[3])
+			blue := uint32(pixel[2]) * 0xFF / uint32(pixel[3])
+
+			key := red>>colorBucketShift<<16 | green>>colorBucketShift<<8 | blue>>colorBucketShift
+			current, ok := buckets[key]
+			if !ok {
+				current = &bucket{}
+				buckets[key] = current
+			}
+			current.count++
+			current.red += int64(red)
+			current.green += int64(green)
+			current.blue += int64(blue)
+			if best == nil || current.count > best.count {
+				best = current
+			}
+		}
+	}
+
+	if best == nil {
+		return "#000000"
+	}
+	return fmt.Sprintf("#%02x%02x%02x", best.red/best.count, best.green/best.count, best.blue/best.count)
+}
@@ -0,0 +1,108 @@
+package imagePipeline
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type jpegEncoder struct {
+	quality int
+}
+
+func NewJPEGEncoder(quality int) Encoder {
+	return &jpegEncoder{quality: quality}
+}
+
+// Encode puts transparent images on white, jpeg has no alpha and would show them on black
+func (e *jpegEncoder) Encode(ctx context.Context, img *image.RGBA) ([]byte, error) {
+	opaque := image.NewRGBA(img.Bounds())
+	for i := 0; i < len(img.Pix); i += 4 {
+		// premultiplied pixels over white only need the uncovered part added
+		uncovered := 0xFF - img.Pix[i+3]
+		opaque.Pix[i] = img.Pix[i] + uncovered
+		opaque.Pix[i+1] = img.Pix[i+1] + uncovered
+		opaque.Pix[i+2] = img.Pix[i+2] + uncovered
+		opaque.Pix[i+3] = 0xFF
+	}
+
+	var buffer bytes.Buffer
+	if err := jpeg.Encode(&buffer, opaque, &jpeg.Options{Quality: e.quality}); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// commandEncoder hands the variant as png to an encoder binary, there is no webp
+// or avif encoder in the standard library
+type commandEncoder struct {
+	path      string
+	extension string
+	workDir   string
+	args      func(sourcePath string, destinationPath string) []string
+}
+
+func NewCwebpEncoder(cwebpPath string, quality int, workDir string) Encoder {
+	return &commandEncoder{
+		path:      cwebpPath,
+		extension: "webp",
+		workDir:   workDir,
+		args: func(sourcePath string, destinationPath string) []string {
+			return []string{"-quiet", "-metadata", "none", "-q", strconv.Itoa(quality), sourcePath, "-o", destinationPath}
+		},
+	}
+}
+
+func NewAvifencEncoder(avifencPath string, quality int, speed int, workDir string) Encoder {
+	return &commandEncoder{
+		path:      avifencPath,
+		extension: "avif",
+		workDir:   workDir,
+		args: func(sourcePath string, destinationPath string) []string {
+			return []string{"--speed", strconv.Itoa(speed), "-q", strconv.Itoa(quality), sourcePath, destinationPath}
+		},
+	}
+}
+
+func (e *commandEncoder) Encode(ctx context.Context, img *image.RGBA) ([]byte, error) {
+	dir, err := os.MkdirTemp(e.workDir, "image-*")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(dir)
+
+	sourcePath := filepath.Join(dir, "source.png")
+	destinationPath := filepath.Join(dir, "variant."+e.extension)
+
+	source, err := os.Create(sourcePath)
+	if err != nil {
+		return nil, err
+	}
+	// the png is thrown away right after, compressing it hard is wasted time
+	encoder := &png.Encoder{CompressionLevel: png.BestSpeed}
+	err = encoder.Encode(source, img)
+	if closeErr := source.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var output bytes.Buffer
+	cmd := exec.CommandContext(ctx, e.path, e.args(sourcePath, destinationPath)...)
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("%s: %v: %s", filepath.Base(e.path), err, strings.TrimSpace(output.String()))
+	}
+
+	return os.ReadFile(destinationPath)
+}
@@ -0,0 +1,287 @@
+package imagePipeline
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	"runtime"
+	"sync"
+	"time"
+
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+
+	_ "golang.org/x/image/webp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/imageVariants"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	xdraw "golang.org/x/image/draw"
+)
+
+const (
+	// MinSide keeps covers sharp at the 300 variant
+	MinSide = 300
+	// MaxSide bounds the memory a decoded image takes, about 100MB at 5000x5000
+	MaxSide = 5000
+)
+
+// cacheControl lets browsers and the cdn keep variants forever, a new upload gets a new key
+const cacheControl = "public, max-age=31536000, immutable"
+
+var (
+	ErrUnsupportedFormat = errors.New("unsupported image format: only JPEG, PNG, GIF and WebP are allowed")
+	ErrTooSmall          = fmt.Errorf("image must be at least %dx%d pixels", MinSide, MinSide)
+	ErrTooLarge          = fmt.Errorf("image must be at most %dx%d pixels", MaxSide, MaxSide)
+)
+
+// InvalidImage tells errors caused by the uploaded file from failures of the pipeline itself
+func InvalidImage(err error) bool {
+	return errors.Is(err, ErrUnsupportedFormat) || errors.Is(err, ErrTooSmall) || errors.Is(err, ErrTooLarge)
+}
+
+var supportedFormats = map[string]bool{
+	"jpeg": true,
+	"png":  true,
+	"gif":  true,
+	"webp": true,
+}
+
+type Variant struct {
+	Size   int64
+	Format string
+	Data   []byte
+}
+
+// Image is an upload decoded, turned upright and stripped of its metadata,
+// ready to be stored with Store
+type Image struct {
+	Width         int64
+	Height        int64
+	DominantColor string
+	Variants      []*Variant
+}
+
+// Encoder turns a scaled variant into one of imageVariants.Formats
+type Encoder interface {
+	Encode(ctx context.Context, img *image.RGBA) ([]byte, error)
+}
+
+type Pipeline struct {
+	encoders map[string]Encoder
+	// slots caps the encoders running at once across every upload the service handles
+	slots   chan struct{}
+	timeout time.Duration
+	metrics *metrics.Metrics
+}
+
+func New(cfg config.ImageConfig, metrics *metrics.Metrics) *Pipeline {
+	return newPipeline(map[string]Encoder{
+		imageVariants.FormatAVIF: NewAvifencEncoder(cfg.AvifencPath, cfg.AVIFQuality, cfg.AVIFSpeed, cfg.WorkDir),
+		imageVariants.FormatWebP: NewCwebpEncoder(cfg.CwebpPath, cfg.WebPQuality, cfg.WorkDir),
+		imageVariants.FormatJPEG: NewJPEGEncoder(cfg.JPEGQuality),
+	}, cfg.MaxEncoders, cfg.Timeout, metrics)
+}
+
+func newPipeline(encoders map[string]Encoder, maxEncoders int, timeout time.Duration, metrics *metrics.Metrics) *Pipeline {
+	if maxEncoders <= 0 {
+		maxEncoders = runtime.NumCPU()
+	}
+	return &Pipeline{
+		encoders: encoders,
+		slots:    make(chan struct{}, maxEncoders),
+		timeout:  timeout,
+		metrics:  metrics,
+	}
+}
+
+// Process checks the upload and encodes every size in every format. Metadata such
+// as EXIF never reaches the variants because they are encoded from decoded pixels.
+func (p *Pipeline) Process(ctx context.Context, data []byte) (*Image, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	// the header is enough to reject an image before it is decoded into memory
+	imageConfig, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil || !supportedFormats[format] {
+		p.metrics.DatabaseErrors.WithLabelValues("ProcessImage").Inc()
+		logger.Warn("unsupported or invalid image", zap.String("format", format), zap.Error(err))
+		return nil, ErrUnsupportedFormat
+	}
+	if imageConfig.Width > MaxSide || imageConfig.Height > MaxSide {
+		p.metrics.DatabaseErrors.WithLabelValues("ProcessImage").Inc()
+		return nil, ErrTooLarge
+	}
+	if imageConfig.Width < MinSide || imageConfig.Height < MinSide {
+		p.metrics.DatabaseErrors.WithLabelValues("ProcessImage").Inc()
+		return nil, ErrTooSmall
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		p.metrics.DatabaseErrors.WithLabelValues("ProcessImage").Inc()
+		logger.Warn("failed to decode image", zap.String("format", format), zap.Error(err))
+		return nil, ErrUnsupportedFormat
+	}
+
+	upright := toRGBA(decoded)
+	if format == "jpeg" {
+		upright = orient(upright, jpegOrientation(data))
+	}
+
+	processed := &Image{
+		Width:  int64(upright.Bounds().Dx()),
+		Height: int64(upright.Bounds().Dy()),
+	}
+
+	// every size is scaled from the next bigger one, which is much cheaper than
+	// scaling a large original four times and looks the same. The dimensions come
+	// from the original so that rounding does not add up.
+	scaled := make(map[int64]*image.RGBA, len(imageVariants.Sizes))
+	source := upright
+	for i := len(imageVariants.Sizes) - 1; i >= 0; i-- {
+		size := imageVariants.Sizes[i]
+		width, height := fitDimensions(processed.Width, processed.Height, size)
+		source = scale(source, width, height)
+		scaled[size] = source
+	}
+	processed.DominantColor = dominantColor(scaled[imageVariants.Sizes[0]])
+
+	if p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+	processed.Variants, err = p.encode(ctx, scaled)
+	if err != nil {
+		p.metrics.DatabaseErrors.WithLabelValues("ProcessImage").Inc()
+		logger.Error("failed to encode image variants", zap.Error(err))
+		return nil, err
+	}
+
+	duration := time.Since(start).Seconds()
+	p.metrics.DatabaseDuration.WithLabelValues("ProcessImage").Observe(duration)
+	return processed, nil
+}
+
+// encode runs an encoder for every size and format, as many at once as there are free slots.
+// The first failure cancels the rest, an encoder binary that hangs is killed by the timeout.
+func (p *Pipeline) encode(ctx context.Context, scaled map[int64]*image.RGBA) ([]*Variant, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg      sync.WaitGroup
+		once    sync.Once
+		failure error
+	)
+	fail := func(variant *Variant, err error) {
+		once.Do(func() {
+			failure = fmt.Errorf("failed to encode %d %s variant: %w", variant.Size, variant.Format, err)
+			cancel()
+		})
+	}
+
+	variants := make([]*Variant, 0, len(imageVariants.Sizes)*len(imageVariants.Formats))
+	for _, size := range imageVariants.Sizes {
+		for _, variantFormat := range imageVariants.Formats {
+			variant := &Variant{Size: size, Format: variantFormat}
+			variants = append(variants, variant)
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				select {
+				case p.slots <- struct{}{}:
+					defer func() { <-p.slots }()
+				case <-ctx.Done():
+					fail(variant, ctx.Err())
+					return
+				}
+
+				encoded, err := p.encoders[variant.Format].Encode(ctx, scaled[variant.Size])
+				if err != nil {
+					fail(variant, err)
+					return
+				}
+				variant.Data = encoded
+			}()
+		}
+	}
+	wg.Wait()
+
+	if failure != nil {
+		return nil, failure
+	}
+	return variants, nil
+}
+
+// Store uploads the variants into a new directory under prefix and returns the key
+// of the fallback jpeg relative to prefix, e.g. /title-20250101120000-1f2e3d4c/1200.jpg.
+// The caller stores processed.DominantColor along with the key.
+func (p *Pipeline) Store(ctx context.Context, client *s3.S3, bucketName string, prefix string, name string, processed *Image) (string, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	directory := "/" + imageVariants.Directory(name, time.Now().Format("20060102150405"), uuid.New().String()[:8])
+	for _, variant := range processed.Variants {
+		key := prefix + directory + "/" + imageVariants.FileName(variant.Size, variant.Format)
+		_, err := client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+			Bucket:       aws.String(bucketName),
+			Key:          aws.String(key),
+			Body:         bytes.NewReader(variant.Data),
+			ContentType:  aws.String(imageVariants.ContentType(variant.Format)),
+			CacheControl: aws.String(cacheControl),
+			ACL:          aws.String("public-read"),
+		})
+		if err != nil {
+			p.metrics.DatabaseErrors.WithLabelValues("StoreImage").Inc()
+			logger.Error("failed to upload image variant", zap.String("key", key), zap.Error(err))
+			return "", fmt.Errorf("failed to upload %s: %w", key, err)
+		}
+	}
+
+	duration := time.Since(start).Seconds()
+	p.metrics.DatabaseDuration.WithLabelValues("StoreImage").Observe(duration)
+	return imageVariants.FallbackFile(directory), nil
+}
+
+func toRGBA(img image.Image) *image.RGBA {
+	if rgba, ok := img.(*image.RGBA); ok && rgba.Bounds().Min == (image.Point{}) {
+		return rgba
+	}
+	bounds := img.Bounds()
+	rgba := image.NewRGBA(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(rgba, rgba.Bounds(), img, bounds.Min, draw.Src)
+	return rgba
+}
+
+// fitDimensions scales width and height down to fit into a size x size box
+// keeping the aspect ratio
+func fitDimensions(width int64, height int64, size int64) (int64, int64) {
+	if width <= size && height <= size {
+		return width, height
+	}
+	if width > height {
+		return size, max((height*size+width/2)/width, 1)
+	}
+	return max((width*size+height/2)/height, 1), size
+}
+
+func scale(img *image.RGBA, width int64, height int64) *image.RGBA {
+	if int64(img.Bounds().Dx()) == width && int64(img.Bounds().Dy()) == height {
+		return img
+	}
+	scaled := image.NewRGBA(image.Rect(0, 0, int(width), int(height)))
+	xdraw.CatmullRom.Scale(scaled, scaled.Bounds(), img, img.Bounds(), xdraw.Src, nil)
+	return scaled
+}
@@ -0,0 +1,306 @@
+package imagePipeline
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/imageVariants"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+// boundsEncoder stands in for cwebp and avifenc and remembers what it was given
+type boundsEncoder struct {
+	format string
+	mu     sync.Mutex
+	bounds map[int]image.Rectangle
+}
+
+func (e *boundsEncoder) Encode(ctx context.Context, img *image.RGBA) ([]byte, error) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.bounds[max(img.Bounds().Dx(), img.Bounds().Dy())] = img.Bounds()
+	return []byte(e.format), nil
+}
+
+// busyEncoder counts the encoders running at once and blocks until released or cancelled
+type busyEncoder struct {
+	mu      sync.Mutex
+	running int
+	peak    int
+	release chan struct{}
+}
+
+func (e *busyEncoder) Encode(ctx context.Context, img *image.RGBA) ([]byte, error) {
+	e.mu.Lock()
+	e.running++
+	e.peak = max(e.peak, e.running)
+	e.mu.Unlock()
+	defer func() {
+		e.mu.Lock()
+		e.running--
+		e.mu.Unlock()
+	}()
+
+	select {
+	case <-e.release:
+		return []byte("busy"), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func newTestPipeline() (*Pipeline, *boundsEncoder) {
+	avif := &boundsEncoder{format: imageVariants.FormatAVIF, bounds: map[int]image.Rectangle{}}
+	return newPipeline(map[string]Encoder{
+		imageVariants.FormatAVIF: avif,
+		imageVariants.FormatWebP: &boundsEncoder{format: imageVariants.FormatWebP, bounds: map[int]image.Rectangle{}},
+		imageVariants.FormatJPEG: NewJPEGEncoder(85),
+	}, 4, time.Minute, metrics.NewMockMetrics()), avif
+}
+
+func newBusyPipeline(maxEncoders int, timeout time.Duration) (*Pipeline, *busyEncoder) {
+	busy := &busyEncoder{release: make(chan struct{})}
+	return newPipeline(map[string]Encoder{
+		imageVariants.FormatAVIF: busy,
+		imageVariants.FormatWebP: busy,
+		imageVariants.FormatJPEG: busy,
+	}, maxEncoders, timeout, metrics.NewMockMetrics()), busy
+}
+
+func testContext() context.Context {
+	return loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+}
+
+func filledImage(width int, height int, fill color.Color) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, fill)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buffer bytes.Buffer
+	require.NoError(t, png.Encode(&buffer, img))
+	return buffer.Bytes()
+}
+
+func encodeJPEG(t *testing.T, img image.Image) []byte {
+	var buffer bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buffer, img, nil))
+	return buffer.Bytes()
+}
+
+// withOrientation puts an APP1 segment with only the orientation tag right after SOI
+func withOrientation(data []byte, orientation uint16) []byte {
+	tiff := []byte("MM\x00\x2a\x00\x00\x00\x08")
+	tiff = binary.BigEndian.AppendUint16(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, exifOrientationTag)
+	tiff = binary.BigEndian.AppendUint16(tiff, 3)
+	tiff = binary.BigEndian.AppendUint32(tiff, 1)
+	tiff = binary.BigEndian.AppendUint16(tiff, orientation)
+	tiff = append(tiff, 0, 0, 0, 0, 0, 0)
+
+	segment := append(append([]byte{}, exifHeader...), tiff...)
+	result := []byte{0xFF, 0xD8, 0xFF, jpegMarkerAPP1}
+	result = binary.BigEndian.AppendUint16(result, uint16(len(segment)+2))
+	result = append(result, segment...)
+	return append(result, data[2:]...)
+}
+
+func TestProcess(t *testing.T) {
+	pipeline, avif := newTestPipeline()
+
+	img := filledImage(1500, 1000, color.RGBA{R: 0xFF, A: 0xFF})
+	for y := 0; y < 100; y++ {
+		for x := 0; x < 100; x++ {
+			img.Set(x, y, color.RGBA{B: 0xFF, A: 0xFF})
+		}
+	}
+
+	processed, err := pipeline.Process(testContext(), encodePNG(t, img))
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1500), processed.Width)
+	assert.Equal(t, int64(1000), processed.Height)
+	assert.Equal(t, "#ff0000", processed.DominantColor)
+	require.Len(t, processed.Variants, len(imageVariants.Sizes)*len(imageVariants.Formats))
+	assert.Equal(t, &Variant{Size: 64, Format: imageVariants.FormatAVIF, Data: []byte("avif")}, processed.Variants[0])
+	assert.Equal(t, map[int]image.Rectangle{
+		1200: image.Rect(0, 0, 1200, 800),
+		640:  image.Rect(0, 0, 640, 427),
+		300:  image.Rect(0, 0, 300, 200),
+		64:   image.Rect(0, 0, 64, 43),
+	}, avif.bounds)
+
+	fallback := processed.Variants[len(processed.Variants)-1]
+	assert.Equal(t, int64(imageVariants.FallbackSize), fallback.Size)
+	assert.Equal(t, imageVariants.FormatJPEG, fallback.Format)
+	decoded, format, err := image.Decode(bytes.NewReader(fallback.Data))
+	require.NoError(t, err)
+	assert.Equal(t, "jpeg", format)
+	assert.Equal(t, image.Rect(0, 0, 1200, 800), decoded.Bounds())
+}
+
+func TestProcessDoesNotUpscale(t *testing.T) {
+	pipeline, avif := newTestPipeline()
+
+	_, err := pipeline.Process(testContext(), encodePNG(t, filledImage(400, 400, color.White)))
+	require.NoError(t, err)
+
+	// 640 and 1200 get the image as it is
+	assert.Equal(t, map[int]image.Rectangle{
+		400: image.Rect(0, 0, 400, 400),
+		300: image.Rect(0, 0, 300, 300),
+		64:  image.Rect(0, 0, 64, 64),
+	}, avif.bounds)
+}
+
+func TestProcessRotatesByOrientation(t *testing.T) {
+	pipeline, _ := newTestPipeline()
+
+	data := withOrientation(encodeJPEG(t, filledImage(400, 300, color.White)), 6)
+	require.Equal(t, 6, jpegOrientation(data))
+
+	processed, err := pipeline.Process(testContext(), data)
+	require.NoError(t, err)
+	assert.Equal(t, int64(300), processed.Width)
+	assert.Equal(t, int64(400), processed.Height)
+}
+
+func TestProcessInvalidImages(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		err  error
+	}{
+		{name: "not an image", data: []byte("GIF89 or not"), err: ErrUnsupportedFormat},
+		{name: "too small", data: encodePNG(t, filledImage(MinSide-1, 400, color.White)), err: ErrTooSmall},
+		{name: "too large", data: encodePNG(t, filledImage(MaxSide+1, MinSide, color.White)), err: ErrTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pipeline, _ := newTestPipeline()
+			_, err := pipeline.Process(testContext(), tt.data)
+			assert.ErrorIs(t, err, tt.err)
+			assert.True(t, InvalidImage(err))
+		})
+	}
+}
+
+func TestProcessCapsRunningEncoders(t *testing.T) {
+	pipeline, busy := newBusyPipeline(2, time.Minute)
+	close(busy.release)
+
+	processed, err := pipeline.Process(testContext(), encodePNG(t, filledImage(400, 400, color.White)))
+	require.NoError(t, err)
+
+	require.Len(t, processed.Variants, len(imageVariants.Sizes)*len(imageVariants.Formats))
+	for _, variant := range processed.Variants {
+		assert.Equal(t, []byte("busy"), variant.Data)
+	}
+	assert.LessOrEqual(t, busy.peak, 2)
+}
+
+func TestProcessTimeout(t *testing.T) {
+	pipeline, _ := newBusyPipeline(2, 50*time.Millisecond)
+
+	processed, err := pipeline.Process(testContext(), encodePNG(t, filledImage(400, 400, color.White)))
+
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	assert.False(t, InvalidImage(err))
+	assert.Nil(t, processed)
+}
+
+func TestJPEGOrientationWithoutExif(t *testing.T) {
+	assert.Equal(t, 1, jpegOrientation(encodeJPEG(t, filledImage(8, 8, color.White))))
+	assert.Equal(t, 1, jpegOrientation([]byte("not a jpeg")))
+	assert.Equal(t, 1, jpegOrientation(withOrientation(encodeJPEG(t, filledImage(8, 8, color.White)), 42)))
+}
+
+func TestOrient(t *testing.T) {
+	left := color.RGBA{R: 0xFF, A: 0xFF}
+	right := color.RGBA{B: 0xFF, A: 0xFF}
+	img := image.NewRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, left)
+	img.Set(1, 0, right)
+
+	tests := []struct {
+		orientation int
+		bounds      image.Rectangle
+		first       image.Point
+		second      image.Point
+	}{
+		{orientation: 1, bounds: image.Rect(0, 0, 2, 1), first: image.Pt(0, 0), second: image.Pt(1, 0)},
+		{orientation: 2, bounds: image.Rect(0, 0, 2, 1), first: image.Pt(1, 0), second: image.Pt(0, 0)},
+		{orientation: 3, bounds: image.Rect(0, 0, 2, 1), first: image.Pt(1, 0), second: image.Pt(0, 0)},
+		{orientation: 6, bounds: image.Rect(0, 0, 1, 2), first: image.Pt(0, 0), second: image.Pt(0, 1)},
+		{orientation: 8, bounds: image.Rect(0, 0, 1, 2), first: image.Pt(0, 1), second: image.Pt(0, 0)},
+	}
+
+	for _, tt := range tests {
+		oriented := orient(img, tt.orientation)
+		assert.Equal(t, tt.bounds, oriented.Bounds(), "orientation %d", tt.orientation)
+		assert.Equal(t, left, oriented.RGBAAt(tt.first.X, tt.first.Y), "orientation %d", tt.orientation)
+		assert.Equal(t, right, oriented.RGBAAt(tt.second.X, tt.second.Y), "orientation %d", tt.orientation)
+	}
+}
+
+func TestJPEGEncoderPutsTransparencyOnWhite(t *testing.T) {
+	data, err := NewJPEGEncoder(100).Encode(context.Background(), image.NewRGBA(image.Rect(0, 0, 8, 8)))
+	require.NoError(t, err)
+
+	decoded, err := jpeg.Decode(bytes.NewReader(data))
+	require.NoError(t, err)
+	red, green, blue, _ := decoded.At(4, 4).RGBA()
+	assert.Greater(t, red>>8, uint32(0xF0))
+	assert.Greater(t, green>>8, uint32(0xF0))
+	assert.Greater(t, blue>>8, uint32(0xF0))
+}
+
+func TestDominantColor(t *testing.T) {
+	img := filledImage(10, 10, color.RGBA{R: 0x20, G: 0x40, B: 0x80, A: 0xFF})
+	for x := 0; x < 10; x++ {
+		img.Set(x, 0, color.White)
+	}
+	assert.Equal(t, "#204080", dominantColor(img))
+
+	// transparent pixels do not count
+	assert.Equal(t, "#000000", dominantColor(image.NewRGBA(image.Rect(0, 0, 4, 4))))
+}
+
+func TestVariantURLs(t *testing.T) {
+	key := imageVariants.FallbackFile("/" + imageVariants.Directory("Black Album / Remaster", "20250101120000", "1f2e3d4c"))
+	assert.Equal(t, "/black-album-remaster-20250101120000-1f2e3d4c/1200.jpg", key)
+
+	variants := imageVariants.ForImage("https://images.s3.cloud.ru/albums"+key, "#1f2e3d")
+	require.NotNil(t, variants)
+	assert.Equal(t, "#1f2e3d", variants.DominantColor)
+	require.Len(t, variants.Sizes, len(imageVariants.Sizes))
+	assert.Equal(t, &imageVariants.SizeVariant{
+		Size: 64,
+		AVIF: "https://images.s3.cloud.ru/albums/black-album-remaster-20250101120000-1f2e3d4c/64.avif",
+		WebP: "https://images.s3.cloud.ru/albums/black-album-remaster-20250101120000-1f2e3d4c/64.webp",
+		JPEG: "https://images.s3.cloud.ru/albums/black-album-remaster-20250101120000-1f2e3d4c/64.jpg",
+	}, variants.Sizes[0])
+
+	// images stored before the pipeline only have the original and no color
+	assert.Nil(t, imageVariants.ForImage("https://images.s3.cloud.ru/albums/Black Album-20240101120000.png", ""))
+	assert.Nil(t, imageVariants.ForImage("https://images.s3.cloud.ru/albums/Black Album-20240101120000.png", "#1f2e3d"))
+	assert.Nil(t, imageVariants.ForImage("", ""))
+}
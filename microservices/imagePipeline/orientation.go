@@ -0,0 +1,126 @@
+package imagePipeline
+
+import (
+	"bytes"
+	"encoding/binary"
+	"image"
+)
+
+const (
+	jpegMarkerAPP1     = 0xE1
+	jpegMarkerSOS      = 0xDA
+	jpegMarkerEOI      = 0xD9
+	exifOrientationTag = 0x0112
+)
+
+var exifHeader = []byte("Exif\x00\x00")
+
+// jpegOrientation reads the EXIF orientation cameras write instead of rotating the
+// pixels, 1 means the image is upright or has no EXIF
+func jpegOrientation(data []byte) int {
+	if len(data) < 4 || data[0] != 0xFF || data[1] != 0xD8 {
+		return 1
+	}
+
+	// EXIF lives in an APP1 segment, all of them come before the first scan
+	offset := 2
+	for offset+4 <= len(data) {
+		if data[offset] != 0xFF {
+			return 1
+		}
+		marker := data[offset+1]
+		if marker == jpegMarkerSOS || marker == jpegMarkerEOI {
+			return 1
+		}
+		length := int(binary.BigEndian.Uint16(data[offset+2:]))
+		if length < 2 || offset+2+length > len(data) {
+			return 1
+		}
+		segment := data[offset+4 : offset+2+length]
+		if marker == jpegMarkerAPP1 && bytes.HasPrefix(segment, exifHeader) {
+			return tiffOrientation(segment[len(exifHeader):])
+		}
+		offset += 2 + length
+	}
+	return 1
+}
+
+// tiffOrientation looks the orientation tag up in the first IFD of the EXIF block
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+
+	ifd := int(order.Uint32(tiff[4:]))
+	if ifd < 8 || ifd+2 > len(tiff) {
+		return 1
+	}
+	entries := int(order.Uint16(tiff[ifd:]))
+	for i := 0; i < entries; i++ {
+		entry := ifd + 2 + i*12
+		if entry+12 > len(tiff) {
+			return 1
+		}
+		if order.Uint16(tiff[entry:]) != exifOrientationTag {
+			continue
+		}
+		orientation := int(order.Uint16(tiff[entry+8:]))
+		if orientation < 1 || orientation > 8 {
+			return 1
+		}
+		return orientation
+	}
+	return 1
+}
+
+// orient turns the pixels the way the EXIF orientation says the image is viewed
+func orient(img *image.RGBA, orientation int) *image.RGBA {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+
+	width := img.Bounds().Dx()
+	height := img.Bounds().Dy()
+	outputWidth, outputHeight := width, height
+	// 5 to 8 turn the image by 90 degrees
+	if orientation >= 5 {
+		outputWidth, outputHeight = height, width
+	}
+	output := image.NewRGBA(image.Rect(0, 0, outputWidth, outputHeight))
+
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			var outputX, outputY int
+			switch orientation {
+			case 2:
+				outputX, outputY = width-1-x, y
+			case 3:
+				outputX, outputY = width-1-x, height-1-y
+			case 4:
+				outputX, outputY = x, height-1-y
+			case 5:
+				outputX, outputY = y, x
+			case 6:
+				outputX, outputY = height-1-y, x
+			case 7:
+				outputX, outputY = height-1-y, width-1-x
+			case 8:
+				outputX, outputY = y, width-1-x
+			}
+			source := img.PixOffset(x, y)
+			destination := output.PixOffset(outputX, outputY)
+			copy(output.Pix[destination:destination+4], img.Pix[source:source+4])
+		}
+	}
+	return output
+}
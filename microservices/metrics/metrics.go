@@ -10,6 +10,8 @@ type Metrics struct {
 	GRPCRequestDuration       *prometheus.HistogramVec
 	DatabaseDuration          *prometheus.HistogramVec
 	DatabaseErrors            *prometheus.CounterVec
+	SearchIndexDuration       *prometheus.HistogramVec
+	SearchIndexOverlap        *prometheus.HistogramVec
 }
 
 /*
@@ -71,12 +73,32 @@ func NewMetrics(reg prometheus.Registerer, namespace string) *Metrics {
 			},
 			[]string{"operation"},
 		),
+		SearchIndexDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:      "search_index_duration_seconds",
+				Help:      "Duration of search index lookups in seconds, recorded while comparing backends",
+				Namespace: namespace,
+				Buckets:   []float64{0.0001, 0.0003, 0.0005, 0.001, 0.002, 0.005, 0.01, 0.03, 0.1, 0.3, 1},
+			},
+			[]string{"index", "backend"},
+		),
+		SearchIndexOverlap: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:      "search_index_overlap_ratio",
+				Help:      "Share of results the primary and shadow search backends agree on",
+				Namespace: namespace,
+				Buckets:   prometheus.LinearBuckets(0, 0.1, 11),
+			},
+			[]string{"index"},
+		),
 	}
 	reg.MustRegister(collectors.NewGoCollector())
 	reg.MustRegister(metrics.GRPCTotalNumberOfRequests)
 	reg.MustRegister(metrics.GRPCRequestDuration)
 	reg.MustRegister(metrics.DatabaseDuration)
 	reg.MustRegister(metrics.DatabaseErrors)
+	reg.MustRegister(metrics.SearchIndexDuration)
+	reg.MustRegister(metrics.SearchIndexOverlap)
 
 	return metrics
 }
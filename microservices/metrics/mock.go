@@ -22,5 +22,13 @@ func NewMockMetrics() *Metrics {
 			prometheus.CounterOpts{Name: "mock_db_errors"},
 			[]string{"operation"},
 		),
+		SearchIndexDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: "mock_search_index_duration"},
+			[]string{"index", "backend"},
+		),
+		SearchIndexOverlap: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{Name: "mock_search_index_overlap"},
+			[]string{"index"},
+		),
 	}
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/postgres"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/s3"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/imagePipeline"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/interceptors"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/playlist/internal/delivery"
@@ -89,7 +90,7 @@ func main() {
 	}
 
 	playlistRepository := repository.NewPlaylistPostgresRepository(postgresPool, metrics)
-	playlistS3Repository := repository.NewPlaylistS3Repository(s3, cfg.S3.S3ImagesBucket)
+	playlistS3Repository := repository.NewPlaylistS3Repository(s3, cfg.S3.S3ImagesBucket, imagePipeline.New(cfg.Images, metrics))
 	playlistUsecase := usecase.NewPlaylistUsecase(playlistRepository, playlistS3Repository)
 	playlistService := delivery.NewPlaylistService(playlistUsecase, playlistS3Repository)
 	playlistProto.RegisterPlaylistServiceServer(server, playlistService)
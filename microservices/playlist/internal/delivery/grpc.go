@@ -37,7 +37,8 @@ func (s *PlaylistService) UploadPlaylistThumbnail(ctx context.Context, req *play
 		return nil, err
 	}
 	return &playlistProto.UploadPlaylistThumbnailResponse{
-		Thumbnail: thumbnail,
+		Thumbnail:      thumbnail.URL,
+		ThumbnailColor: thumbnail.Color,
 	}, nil
 }
 
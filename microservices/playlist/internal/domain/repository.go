@@ -28,5 +28,5 @@ type Repository interface {
 }
 
 type S3Repository interface {
-	UploadThumbnail(ctx context.Context, file io.Reader, key string) (string, error)
+	UploadThumbnail(ctx context.Context, file io.Reader, key string) (string, string, error)
 }
@@ -8,7 +8,7 @@ import (
 
 type Usecase interface {
 	CreatePlaylist(ctx context.Context, playlist *usecase.CreatePlaylistRequest) (*usecase.Playlist, error)
-	UploadPlaylistThumbnail(ctx context.Context, playlist *usecase.UploadPlaylistThumbnailRequest) (string, error)
+	UploadPlaylistThumbnail(ctx context.Context, playlist *usecase.UploadPlaylistThumbnailRequest) (*usecase.PlaylistThumbnail, error)
 	GetCombinedPlaylistsByUserID(ctx context.Context, request *usecase.GetCombinedPlaylistsByUserIDRequest) (*usecase.PlaylistList, error)
 	AddTrackToPlaylist(ctx context.Context, request *usecase.AddTrackToPlaylistRequest) error
 	RemoveTrackFromPlaylist(ctx context.Context, request *usecase.RemoveTrackFromPlaylistRequest) error
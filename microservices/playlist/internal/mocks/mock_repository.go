@@ -316,12 +316,13 @@ func (m *MockS3Repository) EXPECT() *MockS3RepositoryMockRecorder {
 }
 
 // UploadThumbnail mocks base method.
-func (m *MockS3Repository) UploadThumbnail(ctx context.Context, file io.Reader, key string) (string, error) {
+func (m *MockS3Repository) UploadThumbnail(ctx context.Context, file io.Reader, key string) (string, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadThumbnail", ctx, file, key)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UploadThumbnail indicates an expected call of UploadThumbnail.
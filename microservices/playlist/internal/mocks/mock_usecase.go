@@ -232,10 +232,10 @@ func (mr *MockUsecaseMockRecorder) UpdatePlaylistsPublisityByUserID(ctx, request
 }
 
 // UploadPlaylistThumbnail mocks base method.
-func (m *MockUsecase) UploadPlaylistThumbnail(ctx context.Context, playlist *usecase.UploadPlaylistThumbnailRequest) (string, error) {
+func (m *MockUsecase) UploadPlaylistThumbnail(ctx context.Context, playlist *usecase.UploadPlaylistThumbnailRequest) (*usecase.PlaylistThumbnail, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadPlaylistThumbnail", ctx, playlist)
-	ret0, _ := ret[0].(string)
+	ret0, _ := ret[0].(*usecase.PlaylistThumbnail)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -16,20 +16,20 @@ import (
 
 const (
 	CreatePlaylistQuery = `
-		INSERT INTO playlist (title, user_id, thumbnail_url, is_public)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO playlist (title, user_id, thumbnail_url, thumbnail_color, is_public)
+		VALUES ($1, $2, $3, $4, $5)
 		RETURNING id
 	`
 
 	GetPlaylistByIDQuery = `
-		SELECT id, title, user_id, thumbnail_url, is_public
+		SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public
 		FROM playlist
 		WHERE id = $1
 	`
 
 	// Owned and favorite playlists
 	GetPlaylistsByUserIDQuery = `
-		SELECT p.id, p.title, p.user_id, p.thumbnail_url
+		SELECT p.id, p.title, p.user_id, p.thumbnail_url, p.thumbnail_color
 		FROM playlist p
 		LEFT JOIN favorite_playlist fp ON p.id = fp.playlist_id
 		WHERE p.user_id = $1 OR (fp.user_id = $1 AND p.is_public = true)
@@ -64,8 +64,8 @@ const (
 
 	UpdatePlaylistWithThumbnailQuery = `
 		UPDATE playlist
-		SET title = $2, thumbnail_url = $3
-		WHERE id = $1 AND user_id = $4
+		SET title = $2, thumbnail_url = $3, thumbnail_color = $4
+		WHERE id = $1 AND user_id = $5
 		RETURNING id
 	`
 
@@ -82,7 +82,7 @@ const (
 	`
 
 	GetPlaylistsToAddQuery = `
-		SELECT p.id, p.title, p.user_id, p.thumbnail_url, 
+		SELECT p.id, p.title, p.user_id, p.thumbnail_url, p.thumbnail_color, 
 		       EXISTS (
 		           SELECT 1 
 		           FROM playlist_track pt 
@@ -118,28 +118,37 @@ const (
 	`
 
 	GetPlaylistWithIsLikedByIDQuery = `
-		SELECT p.id, p.title, p.user_id, p.thumbnail_url, (fp.user_id IS NOT NULL) as is_liked
+		SELECT p.id, p.title, p.user_id, p.thumbnail_url, p.thumbnail_color, (fp.user_id IS NOT NULL) as is_liked
 		FROM playlist p
 		LEFT JOIN favorite_playlist fp ON p.id = fp.playlist_id AND fp.user_id = $2
 		WHERE p.id = $1
 	`
 
 	GetProfilePlaylistsQuery = `
-		SELECT p.id, p.title, p.user_id, p.thumbnail_url
+		SELECT p.id, p.title, p.user_id, p.thumbnail_url, p.thumbnail_color
 		FROM playlist p
 		WHERE p.user_id = $1
 		ORDER BY p.created_at DESC
 	`
 
+	// Playlists stay out of searchIndex.Index: what a search finds depends on who asks, since private
+	// playlists are visible to their owner only, and the index ranks one shared catalog for everyone
 	SearchPlaylistsQuery = `
-		SELECT id, title, user_id, thumbnail_url
-		FROM playlist
-		WHERE (is_public = true OR user_id = $2) AND (search_vector @@ to_tsquery('multilingual', $1)
-		   OR similarity(title_trgm, $3) > 0.3)
+		SELECT p.id, p.title, p.user_id, p.thumbnail_url, p.thumbnail_color
+		FROM playlist p
+		LEFT JOIN (
+			SELECT playlist_id, COUNT(*) AS favorites_count
+			FROM favorite_playlist
+			GROUP BY playlist_id
+		) fp ON p.id = fp.playlist_id
+		WHERE (p.is_public = true OR p.user_id = $2) AND (p.search_vector @@ to_tsquery('multilingual', $1)
+		   OR similarity(p.title_trgm, $3) > 0.3)
 		ORDER BY 
-		    CASE WHEN search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
-		    ts_rank(search_vector, to_tsquery('multilingual', $1)) DESC,
-		    similarity(title_trgm, $3) DESC
+		    CASE WHEN p.search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
+		    (ts_rank(p.search_vector, to_tsquery('multilingual', $1)) + similarity(p.title_trgm, $3))
+		        * (1 + ln(1 + COALESCE(fp.favorites_count, 0))) DESC,
+		    p.id DESC
+		LIMIT NULLIF($4, 0) OFFSET $5
 	`
 )
 
@@ -171,7 +180,7 @@ func (r *PlaylistPostgresRepository) GetPlaylistByID(ctx context.Context, id int
 	}()
 
 	var playlist repoModel.Playlist
-	err = stmt.QueryRowContext(ctx, id).Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &playlist.IsPublic)
+	err = stmt.QueryRowContext(ctx, id).Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &playlist.ThumbnailColor, &playlist.IsPublic)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("GetPlaylistByID").Inc()
 		if err == sql.ErrNoRows {
@@ -206,7 +215,7 @@ func (r *PlaylistPostgresRepository) CreatePlaylist(ctx context.Context, playlis
 	}()
 
 	var id int64
-	err = stmt.QueryRowContext(ctx, playlistCreateRequest.Title, playlistCreateRequest.UserID, playlistCreateRequest.Thumbnail, playlistCreateRequest.IsPublic).Scan(&id)
+	err = stmt.QueryRowContext(ctx, playlistCreateRequest.Title, playlistCreateRequest.UserID, playlistCreateRequest.Thumbnail, playlistCreateRequest.ThumbnailColor, playlistCreateRequest.IsPublic).Scan(&id)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("CreatePlaylist").Inc()
 		if strings.Contains(err.Error(), "duplicate key value violates unique constraint") {
@@ -260,7 +269,7 @@ func (r *PlaylistPostgresRepository) GetCombinedPlaylistsByUserID(ctx context.Co
 
 	for rows.Next() {
 		var playlist repoModel.Playlist
-		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail)
+		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &playlist.ThumbnailColor)
 		if err != nil {
 			logger.Error("Failed to scan playlist", zap.Error(err))
 			return nil, playlistErrors.NewInternalError("failed to scan playlist: %v", err)
@@ -473,7 +482,7 @@ func (r *PlaylistPostgresRepository) UpdatePlaylist(ctx context.Context, request
 
 	var id int64
 	query := UpdatePlaylistWithThumbnailQuery
-	args := []any{request.PlaylistID, request.Title, request.Thumbnail, request.UserID}
+	args := []any{request.PlaylistID, request.Title, request.Thumbnail, request.ThumbnailColor, request.UserID}
 
 	if request.Thumbnail != "" {
 		logger.Debug("Updating playlist with thumbnail")
@@ -565,7 +574,7 @@ func (r *PlaylistPostgresRepository) GetPlaylistsToAdd(ctx context.Context, requ
 	for rows.Next() {
 		var playlist repoModel.Playlist
 		var isIncluded sql.NullBool
-		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &isIncluded)
+		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &playlist.ThumbnailColor, &isIncluded)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetPlaylistsToAdd").Inc()
 			logger.Error("Failed to scan playlist", zap.Error(err))
@@ -744,7 +753,7 @@ func (r *PlaylistPostgresRepository) GetPlaylistWithIsLikedByID(ctx context.Cont
 
 	var playlist repoModel.Playlist
 	var isLiked sql.NullBool
-	err = stmt.QueryRowContext(ctx, id, userID).Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &isLiked)
+	err = stmt.QueryRowContext(ctx, id, userID).Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &playlist.ThumbnailColor, &isLiked)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("GetPlaylistWithIsLikedByID").Inc()
 		logger.Error("Failed to get playlist with is liked by id", zap.Error(err))
@@ -792,7 +801,7 @@ func (r *PlaylistPostgresRepository) GetProfilePlaylists(ctx context.Context, re
 
 	for rows.Next() {
 		var playlist repoModel.Playlist
-		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail)
+		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &playlist.ThumbnailColor)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetProfilePlaylists").Inc()
 			logger.Error("Failed to scan playlist", zap.Error(err))
@@ -838,7 +847,7 @@ func (r *PlaylistPostgresRepository) SearchPlaylists(ctx context.Context, reques
 	tsQueryString := strings.Join(words, " & ")
 
 	var playlists repoModel.PlaylistList
-	rows, err := stmt.QueryContext(ctx, tsQueryString, request.UserID, request.Query)
+	rows, err := stmt.QueryContext(ctx, tsQueryString, request.UserID, request.Query, request.Limit, request.Offset)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("SearchPlaylists").Inc()
 		logger.Error("Failed to search playlists", zap.Error(err))
@@ -852,7 +861,7 @@ func (r *PlaylistPostgresRepository) SearchPlaylists(ctx context.Context, reques
 
 	for rows.Next() {
 		var playlist repoModel.Playlist
-		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail)
+		err := rows.Scan(&playlist.ID, &playlist.Title, &playlist.UserID, &playlist.Thumbnail, &playlist.ThumbnailColor)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("SearchPlaylists").Inc()
 			logger.Error("Failed to scan playlist", zap.Error(err))
@@ -38,11 +38,11 @@ func TestGetPlaylistByID(t *testing.T) {
 	repo := NewPlaylistPostgresRepository(db, metrics.NewMockMetrics())
 	playlistID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(playlistID).
 		WillReturnRows(rows)
 
@@ -69,8 +69,8 @@ func TestGetPlaylistByIDNotFound(t *testing.T) {
 	repo := NewPlaylistPostgresRepository(db, metrics.NewMockMetrics())
 	playlistID := int64(1)
 
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(playlistID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -102,14 +102,14 @@ func TestCreatePlaylist(t *testing.T) {
 
 	mock.ExpectPrepare("INSERT INTO playlist")
 	mock.ExpectQuery("INSERT INTO playlist").
-		WithArgs(request.Title, request.UserID, request.Thumbnail, request.IsPublic).
+		WithArgs(request.Title, request.UserID, request.Thumbnail, request.ThumbnailColor, request.IsPublic).
 		WillReturnRows(rows)
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "New Playlist", 1, "thumbnail.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "New Playlist", 1, "thumbnail.jpg", "", true)
 
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(int64(1)).
 		WillReturnRows(playlistRows)
 
@@ -143,7 +143,7 @@ func TestCreatePlaylistDuplicate(t *testing.T) {
 
 	mock.ExpectPrepare("INSERT INTO playlist")
 	mock.ExpectQuery("INSERT INTO playlist").
-		WithArgs(request.Title, request.UserID, request.Thumbnail, request.IsPublic).
+		WithArgs(request.Title, request.UserID, request.Thumbnail, request.ThumbnailColor, request.IsPublic).
 		WillReturnError(stderrors.New("duplicate key value violates unique constraint"))
 
 	playlist, err := repo.CreatePlaylist(ctx, request)
@@ -165,9 +165,9 @@ func TestGetCombinedPlaylistsByUserID(t *testing.T) {
 	repo := NewPlaylistPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg").
-		AddRow(2, "Playlist 2", 2, "thumbnail2.jpg")
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "").
+		AddRow(2, "Playlist 2", 2, "thumbnail2.jpg", "")
 
 	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
 	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
@@ -278,12 +278,12 @@ func TestAddTrackToPlaylist(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("INSERT INTO playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -320,8 +320,8 @@ func TestAddTrackToPlaylistGetPlaylistError(t *testing.T) {
 	}
 
 	mock.ExpectPrepare("INSERT INTO playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -346,12 +346,12 @@ func TestAddTrackToPlaylistTrackExistsError(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("INSERT INTO playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -381,12 +381,12 @@ func TestAddTrackToPlaylistInsertError(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("INSERT INTO playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -422,12 +422,12 @@ func TestAddTrackToPlaylistPermissionDenied(t *testing.T) {
 		UserID:     2,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("INSERT INTO playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -453,12 +453,12 @@ func TestAddTrackToPlaylistDuplicate(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("INSERT INTO playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -491,12 +491,12 @@ func TestRemoveTrackFromPlaylist(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("DELETE FROM playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -533,8 +533,8 @@ func TestRemoveTrackFromPlaylistGetPlaylistError(t *testing.T) {
 	}
 
 	mock.ExpectPrepare("DELETE FROM playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -559,12 +559,12 @@ func TestRemoveTrackFromPlaylistPermissionDenied(t *testing.T) {
 		UserID:     2,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("DELETE FROM playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -590,12 +590,12 @@ func TestRemoveTrackFromPlaylistTrackNotFound(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("DELETE FROM playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -628,12 +628,12 @@ func TestRemoveTrackFromPlaylistTrackExistsError(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("DELETE FROM playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -663,12 +663,12 @@ func TestRemoveTrackFromPlaylistDeleteError(t *testing.T) {
 		UserID:     1,
 	}
 
-	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_public"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	playlistRows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_public"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("DELETE FROM playlist_track")
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(request.PlaylistID).
 		WillReturnRows(playlistRows)
 
@@ -811,9 +811,9 @@ func TestGetPlaylistsToAdd(t *testing.T) {
 		UserID:  2,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_included"}).
-		AddRow(1, "Playlist 1", 2, "thumbnail1.jpg", true).
-		AddRow(2, "Playlist 2", 2, "thumbnail2.jpg", false)
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_included"}).
+		AddRow(1, "Playlist 1", 2, "thumbnail1.jpg", "", true).
+		AddRow(2, "Playlist 2", 2, "thumbnail2.jpg", "", false)
 
 	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
 	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
@@ -874,8 +874,8 @@ func TestGetPlaylistsToAddScanError(t *testing.T) {
 		UserID:  2,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_included"}).
-		AddRow(1, "Playlist 1", 2, "thumbnail1.jpg", "invalid_bool")
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_included"}).
+		AddRow(1, "Playlist 1", 2, "thumbnail1.jpg", "", "invalid_bool")
 
 	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
 	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
@@ -1091,8 +1091,8 @@ func TestGetPlaylistWithIsLikedByID(t *testing.T) {
 	playlistID := int64(1)
 	userID := int64(2)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "is_liked"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", true)
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color", "is_liked"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "", true)
 
 	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
 	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
@@ -1148,9 +1148,9 @@ func TestGetProfilePlaylists(t *testing.T) {
 		UserID: 1,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url"}).
-		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg").
-		AddRow(2, "Playlist 2", 1, "thumbnail2.jpg")
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color"}).
+		AddRow(1, "Playlist 1", 1, "thumbnail1.jpg", "").
+		AddRow(2, "Playlist 2", 1, "thumbnail2.jpg", "")
 
 	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
 	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
@@ -1211,8 +1211,8 @@ func TestGetProfilePlaylistsScanError(t *testing.T) {
 		UserID: 1,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url"}).
-		AddRow("invalid_id", "Playlist 1", 1, "thumbnail1.jpg")
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color"}).
+		AddRow("invalid_id", "Playlist 1", 1, "thumbnail1.jpg", "")
 
 	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
 	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
@@ -1238,15 +1238,17 @@ func TestSearchPlaylists(t *testing.T) {
 	request := &repoModel.SearchPlaylistsRequest{
 		Query:  "test playlist",
 		UserID: 1,
+		Offset: 0,
+		Limit:  10,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url"}).
-		AddRow(1, "Test Playlist", 1, "thumbnail1.jpg").
-		AddRow(2, "Playlist Test", 2, "thumbnail2.jpg")
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color"}).
+		AddRow(1, "Test Playlist", 1, "thumbnail1.jpg", "").
+		AddRow(2, "Playlist Test", 2, "thumbnail2.jpg", "")
 
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url").
-		WithArgs("test:* & playlist:*", request.UserID, request.Query).
+	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
+	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
+		WithArgs("test:* & playlist:*", request.UserID, request.Query, request.Limit, request.Offset).
 		WillReturnRows(rows)
 
 	playlists, err := repo.SearchPlaylists(ctx, request)
@@ -1275,11 +1277,13 @@ func TestSearchPlaylistsError(t *testing.T) {
 	request := &repoModel.SearchPlaylistsRequest{
 		Query:  "test playlist",
 		UserID: 1,
+		Offset: 0,
+		Limit:  10,
 	}
 
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url").
-		WithArgs("test:* & playlist:*", request.UserID, request.Query).
+	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
+	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
+		WithArgs("test:* & playlist:*", request.UserID, request.Query, request.Limit, request.Offset).
 		WillReturnError(stderrors.New("db error"))
 
 	playlists, err := repo.SearchPlaylists(ctx, request)
@@ -1301,14 +1305,16 @@ func TestSearchPlaylistsScanError(t *testing.T) {
 	request := &repoModel.SearchPlaylistsRequest{
 		Query:  "test playlist",
 		UserID: 1,
+		Offset: 0,
+		Limit:  10,
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url"}).
-		AddRow("invalid_id", "Test Playlist", 1, "thumbnail1.jpg")
+	rows := sqlmock.NewRows([]string{"id", "title", "user_id", "thumbnail_url", "thumbnail_color"}).
+		AddRow("invalid_id", "Test Playlist", 1, "thumbnail1.jpg", "")
 
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url").
-		WithArgs("test:* & playlist:*", request.UserID, request.Query).
+	mock.ExpectPrepare("SELECT p.id, p.title, p.user_id, p.thumbnail_url")
+	mock.ExpectQuery("SELECT p.id, p.title, p.user_id, p.thumbnail_url").
+		WithArgs("test:* & playlist:*", request.UserID, request.Query, request.Limit, request.Offset).
 		WillReturnRows(rows)
 
 	playlists, err := repo.SearchPlaylists(ctx, request)
@@ -1338,11 +1344,11 @@ func TestCreatePlaylistGetPlaylistError(t *testing.T) {
 
 	mock.ExpectPrepare("INSERT INTO playlist")
 	mock.ExpectQuery("INSERT INTO playlist").
-		WithArgs(request.Title, request.UserID, request.Thumbnail, request.IsPublic).
+		WithArgs(request.Title, request.UserID, request.Thumbnail, request.ThumbnailColor, request.IsPublic).
 		WillReturnRows(rows)
 
-	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, is_public")
-	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, is_public").
+	mock.ExpectPrepare("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public")
+	mock.ExpectQuery("SELECT id, title, user_id, thumbnail_url, thumbnail_color, is_public").
 		WithArgs(int64(1)).
 		WillReturnError(stderrors.New("db error"))
 
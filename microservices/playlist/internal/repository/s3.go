@@ -4,23 +4,17 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"image"
 	"io"
-	"time"
 
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/imagePipeline"
 	playlistErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/playlist/model/errors"
 
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/playlist/internal/domain"
 
-	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 
 	"go.uber.org/zap"
-
-	_ "image/jpeg"
-	_ "image/png"
 )
 
 const (
@@ -29,66 +23,48 @@ const (
 
 type playlistS3Repository struct {
 	s3         *s3.S3
-	uploader   *s3manager.Uploader
 	bucketName string
+	images     *imagePipeline.Pipeline
 }
 
-func NewPlaylistS3Repository(s3 *s3.S3, bucketName string) domain.S3Repository {
-	uploader := s3manager.NewUploaderWithClient(s3)
+func NewPlaylistS3Repository(s3 *s3.S3, bucketName string, images *imagePipeline.Pipeline) domain.S3Repository {
 	return &playlistS3Repository{
 		s3:         s3,
 		bucketName: bucketName,
-		uploader:   uploader,
+		images:     images,
 	}
 }
 
-func (r *playlistS3Repository) UploadThumbnail(ctx context.Context, file io.Reader, key string) (string, error) {
+// UploadThumbnail stores the cover variants and returns the url of the fallback jpeg and the
+// dominant color of the cover
+func (r *playlistS3Repository) UploadThumbnail(ctx context.Context, file io.Reader, key string) (string, string, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	logger.Info("Uploading thumbnail", zap.String("key", key))
 	buf := new(bytes.Buffer)
 	_, err := io.Copy(buf, file)
 	if err != nil {
 		logger.Error("failed to parse image", "error", err)
-		return "", playlistErrors.ErrFailedToParseImage
+		return "", "", playlistErrors.ErrFailedToParseImage
 	}
 
 	if buf.Len() > MaxImageSize {
 		logger.Error("image size exceeds 5MB limit", "error", playlistErrors.ErrImageTooBig)
-		return "", playlistErrors.ErrImageTooBig
+		return "", "", playlistErrors.ErrImageTooBig
 	}
 
-	_, format, err := image.Decode(bytes.NewReader(buf.Bytes()))
+	processed, err := r.images.Process(ctx, buf.Bytes())
 	if err != nil {
-		logger.Error("failed to parse image", "error", err)
-		return "", playlistErrors.ErrFailedToParseImage
-	}
-
-	if format != "png" && format != "jpeg" {
-		logger.Error("unsupported image format", "error", playlistErrors.ErrUnsupportedImageFormat)
-		return "", playlistErrors.ErrUnsupportedImageFormat
+		if imagePipeline.InvalidImage(err) {
+			return "", "", playlistErrors.NewBadRequestError("%v", err)
+		}
+		return "", "", playlistErrors.ErrFailedToUploadImage
 	}
 
-	timestampedKey := fmt.Sprintf("%s-%s.%s", key, time.Now().Format("20060102150405"), format)
-
-	s3Key := fmt.Sprintf("playlists/%s", timestampedKey)
-
-	output, err := r.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
-		Bucket:      aws.String(r.bucketName),
-		Key:         aws.String(s3Key),
-		Body:        bytes.NewReader(buf.Bytes()),
-		ContentType: aws.String("image/" + format),
-		ACL:         aws.String("public-read"),
-		Metadata: map[string]*string{
-			"Cache-Control": aws.String("max-age=86400, public"),
-		},
-	})
-
+	fileKey, err := r.images.Store(ctx, r.s3, r.bucketName, "playlists", key, processed)
 	if err != nil {
 		logger.Error("failed to upload image", "error", err)
-		return "", playlistErrors.NewInternalError("failed to upload image: %v", err)
+		return "", "", playlistErrors.ErrFailedToUploadImage
 	}
 
-	outloadedUrl := output.Location
-
-	return outloadedUrl, nil
+	return fmt.Sprintf("https://%s.s3.cloud.ru/playlists%s", r.bucketName, fileKey), processed.DominantColor, nil
 }
@@ -10,6 +10,10 @@ import (
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/playlist/model/usecase"
 )
 
+// DefaultThumbnail is the cover of playlists created without one, such as the ones saved
+// from a jam or built for the release radar
+const DefaultThumbnail = "/default_playlist.png"
+
 type PlaylistUsecase struct {
 	playlistRepo domain.Repository
 	s3Repo       domain.S3Repository
@@ -20,6 +24,9 @@ func NewPlaylistUsecase(playlistRepo domain.Repository, s3Repo domain.S3Reposito
 }
 
 func (u *PlaylistUsecase) CreatePlaylist(ctx context.Context, playlist *usecaseModel.CreatePlaylistRequest) (*usecaseModel.Playlist, error) {
+	if playlist.Thumbnail == "" {
+		playlist.Thumbnail = DefaultThumbnail
+	}
 	repoCreatePlaylistRequest := model.CreatePlaylistRequestFromUsecaseToRepository(playlist)
 	repoPlaylist, err := u.playlistRepo.CreatePlaylist(ctx, repoCreatePlaylistRequest)
 	if err != nil {
@@ -28,12 +35,12 @@ func (u *PlaylistUsecase) CreatePlaylist(ctx context.Context, playlist *usecaseM
 	return model.PlaylistFromRepositoryToUsecase(repoPlaylist), nil
 }
 
-func (u *PlaylistUsecase) UploadPlaylistThumbnail(ctx context.Context, playlist *usecaseModel.UploadPlaylistThumbnailRequest) (string, error) {
-	thumbnail, err := u.s3Repo.UploadThumbnail(ctx, playlist.Thumbnail, playlist.Title)
+func (u *PlaylistUsecase) UploadPlaylistThumbnail(ctx context.Context, playlist *usecaseModel.UploadPlaylistThumbnailRequest) (*usecaseModel.PlaylistThumbnail, error) {
+	url, color, err := u.s3Repo.UploadThumbnail(ctx, playlist.Thumbnail, playlist.Title)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return thumbnail, nil
+	return &usecaseModel.PlaylistThumbnail{URL: url, Color: color}, nil
 }
 
 func (u *PlaylistUsecase) GetCombinedPlaylistsByUserID(ctx context.Context, request *usecaseModel.GetCombinedPlaylistsByUserIDRequest) (*usecaseModel.PlaylistList, error) {
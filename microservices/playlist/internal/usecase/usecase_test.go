@@ -103,6 +103,26 @@ func TestCreatePlaylist(t *testing.T) {
 	assert.Equal(t, mockPlaylist.UserID, result.UserID)
 }
 
+func TestCreatePlaylistWithoutThumbnail(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+
+	usecase := NewPlaylistUsecase(mockRepo, mockS3Repo)
+
+	mockRepo.EXPECT().CreatePlaylist(ctx, &repoModel.CreatePlaylistRequest{
+		Title:     "Jam 2025-05-16",
+		UserID:    2,
+		Thumbnail: DefaultThumbnail,
+	}).Return(&repoModel.Playlist{ID: 1, Title: "Jam 2025-05-16", Thumbnail: DefaultThumbnail, UserID: 2}, nil)
+
+	result, err := usecase.CreatePlaylist(ctx, &usecaseModel.CreatePlaylistRequest{
+		Title:  "Jam 2025-05-16",
+		UserID: 2,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, DefaultThumbnail, result.Thumbnail)
+}
+
 func TestUploadPlaylistThumbnail(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
 
@@ -110,7 +130,7 @@ func TestUploadPlaylistThumbnail(t *testing.T) {
 
 	randomImage := io.NopCloser(bytes.NewReader([]byte("test")))
 
-	mockS3Repo.EXPECT().UploadThumbnail(ctx, randomImage, "Test Playlist").Return("test.jpg", nil)
+	mockS3Repo.EXPECT().UploadThumbnail(ctx, randomImage, "Test Playlist").Return("test.jpg", "#a1b2c3", nil)
 
 	result, err := usecase.UploadPlaylistThumbnail(ctx, &usecaseModel.UploadPlaylistThumbnailRequest{
 		Title:     "Test Playlist",
@@ -119,7 +139,7 @@ func TestUploadPlaylistThumbnail(t *testing.T) {
 
 	assert.NoError(t, err)
 	assert.NotNil(t, result)
-	assert.Equal(t, "test.jpg", result)
+	assert.Equal(t, &usecaseModel.PlaylistThumbnail{URL: "test.jpg", Color: "#a1b2c3"}, result)
 }
 
 func TestGetCombinedPlaylistsByUserID(t *testing.T) {
@@ -10,45 +10,50 @@ import (
 
 func CreatePlaylistRequestFromProtoToUsecase(proto *protoModel.CreatePlaylistRequest) *usecaseModel.CreatePlaylistRequest {
 	return &usecaseModel.CreatePlaylistRequest{
-		Title:     proto.GetTitle(),
-		UserID:    proto.GetUserId(),
-		Thumbnail: proto.GetThumbnail(),
-		IsPublic:  proto.GetIsPublic(),
+		Title:          proto.GetTitle(),
+		UserID:         proto.GetUserId(),
+		Thumbnail:      proto.GetThumbnail(),
+		ThumbnailColor: proto.GetThumbnailColor(),
+		IsPublic:       proto.GetIsPublic(),
 	}
 }
 
 func CreatePlaylistRequestFromUsecaseToRepository(usecase *usecaseModel.CreatePlaylistRequest) *repoModel.CreatePlaylistRequest {
 	return &repoModel.CreatePlaylistRequest{
-		Title:     usecase.Title,
-		UserID:    usecase.UserID,
-		Thumbnail: usecase.Thumbnail,
-		IsPublic:  usecase.IsPublic,
+		Title:          usecase.Title,
+		UserID:         usecase.UserID,
+		Thumbnail:      usecase.Thumbnail,
+		ThumbnailColor: usecase.ThumbnailColor,
+		IsPublic:       usecase.IsPublic,
 	}
 }
 
 func PlaylistFromRepositoryToProto(repo *repoModel.Playlist) *protoModel.Playlist {
 	return &protoModel.Playlist{
-		Id:        repo.ID,
-		Title:     repo.Title,
-		Thumbnail: repo.Thumbnail,
+		Id:             repo.ID,
+		Title:          repo.Title,
+		Thumbnail:      repo.Thumbnail,
+		ThumbnailColor: repo.ThumbnailColor,
 	}
 }
 
 func PlaylistFromUsecaseToProto(usecase *usecaseModel.Playlist) *protoModel.Playlist {
 	return &protoModel.Playlist{
-		Id:        usecase.ID,
-		Title:     usecase.Title,
-		Thumbnail: usecase.Thumbnail,
-		UserId:    usecase.UserID,
+		Id:             usecase.ID,
+		Title:          usecase.Title,
+		Thumbnail:      usecase.Thumbnail,
+		ThumbnailColor: usecase.ThumbnailColor,
+		UserId:         usecase.UserID,
 	}
 }
 
 func PlaylistFromRepositoryToUsecase(repo *repoModel.Playlist) *usecaseModel.Playlist {
 	return &usecaseModel.Playlist{
-		ID:        repo.ID,
-		Title:     repo.Title,
-		Thumbnail: repo.Thumbnail,
-		UserID:    repo.UserID,
+		ID:             repo.ID,
+		Title:          repo.Title,
+		Thumbnail:      repo.Thumbnail,
+		ThumbnailColor: repo.ThumbnailColor,
+		UserID:         repo.UserID,
 	}
 }
 
@@ -141,19 +146,21 @@ func GetPlaylistTrackIdsRequestFromUsecaseToRepository(usecase *usecaseModel.Get
 
 func UpdatePlaylistRequestFromProtoToUsecase(proto *protoModel.UpdatePlaylistRequest) *usecaseModel.UpdatePlaylistRequest {
 	return &usecaseModel.UpdatePlaylistRequest{
-		UserID:     proto.GetUserId(),
-		PlaylistID: proto.GetId(),
-		Title:      proto.GetTitle(),
-		Thumbnail:  proto.GetThumbnail(),
+		UserID:         proto.GetUserId(),
+		PlaylistID:     proto.GetId(),
+		Title:          proto.GetTitle(),
+		Thumbnail:      proto.GetThumbnail(),
+		ThumbnailColor: proto.GetThumbnailColor(),
 	}
 }
 
 func UpdatePlaylistRequestFromUsecaseToRepository(usecase *usecaseModel.UpdatePlaylistRequest) *repoModel.UpdatePlaylistRequest {
 	return &repoModel.UpdatePlaylistRequest{
-		UserID:     usecase.UserID,
-		PlaylistID: usecase.PlaylistID,
-		Title:      usecase.Title,
-		Thumbnail:  usecase.Thumbnail,
+		UserID:         usecase.UserID,
+		PlaylistID:     usecase.PlaylistID,
+		Title:          usecase.Title,
+		Thumbnail:      usecase.Thumbnail,
+		ThumbnailColor: usecase.ThumbnailColor,
 	}
 }
 
@@ -305,6 +312,8 @@ func SearchPlaylistsRequestFromProtoToUsecase(proto *protoModel.SearchPlaylistsR
 	return &usecaseModel.SearchPlaylistsRequest{
 		UserID: proto.GetUserId(),
 		Query:  proto.GetQuery(),
+		Offset: proto.GetOffset(),
+		Limit:  proto.GetLimit(),
 	}
 }
 
@@ -312,5 +321,7 @@ func SearchPlaylistsRequestFromUsecaseToRepository(usecase *usecaseModel.SearchP
 	return &repoModel.SearchPlaylistsRequest{
 		UserID: usecase.UserID,
 		Query:  usecase.Query,
+		Offset: usecase.Offset,
+		Limit:  usecase.Limit,
 	}
 }
@@ -393,15 +393,21 @@ func TestSearchPlaylistsRequestConversions(t *testing.T) {
 	protoReq := &protoModel.SearchPlaylistsRequest{
 		UserId: 123,
 		Query:  "test search",
+		Offset: 10,
+		Limit:  20,
 	}
 
 	usecaseReq := SearchPlaylistsRequestFromProtoToUsecase(protoReq)
 	assert.Equal(t, protoReq.GetUserId(), usecaseReq.UserID)
 	assert.Equal(t, protoReq.GetQuery(), usecaseReq.Query)
+	assert.Equal(t, protoReq.GetOffset(), usecaseReq.Offset)
+	assert.Equal(t, protoReq.GetLimit(), usecaseReq.Limit)
 
 	repoReq := SearchPlaylistsRequestFromUsecaseToRepository(usecaseReq)
 	assert.Equal(t, usecaseReq.UserID, repoReq.UserID)
 	assert.Equal(t, usecaseReq.Query, repoReq.Query)
+	assert.Equal(t, usecaseReq.Offset, repoReq.Offset)
+	assert.Equal(t, usecaseReq.Limit, repoReq.Limit)
 }
 
 func TestGetCombinedPlaylistsByUserIDRequestConversion(t *testing.T) {
@@ -58,7 +58,6 @@ func NewPermissionDeniedError(format string, args ...interface{}) error {
 var (
 	ErrPlaylistNotFound         = NewNotFoundError("playlist not found")
 	ErrPlaylistPermissionDenied = NewPermissionDeniedError("user does not have permission to update this playlist")
-	ErrUnsupportedImageFormat   = NewBadRequestError("unsupported image format: only JPEG and PNG are allowed")
 	ErrImageTooBig              = NewBadRequestError("image size exceeds 5MB limit")
 	ErrFailedToParseImage       = NewInternalError("failed to parse image")
 	ErrFailedToUploadImage      = NewInternalError("failed to upload image")
@@ -4,8 +4,11 @@ type Playlist struct {
 	ID        int64  `sql:"id"`
 	Title     string `sql:"title"`
 	Thumbnail string `sql:"thumbnail"`
-	UserID    int64  `sql:"user_id"`
-	IsPublic  bool   `sql:"is_public"`
+	// ThumbnailColor is the dominant color of the thumbnail, empty for the default cover and
+	// for images stored before the variants
+	ThumbnailColor string `sql:"thumbnail_color"`
+	UserID         int64  `sql:"user_id"`
+	IsPublic       bool   `sql:"is_public"`
 }
 
 type PlaylistList struct {
@@ -13,10 +16,11 @@ type PlaylistList struct {
 }
 
 type CreatePlaylistRequest struct {
-	Title     string `sql:"title"`
-	UserID    int64  `sql:"user_id"`
-	Thumbnail string `sql:"thumbnail"`
-	IsPublic  bool   `sql:"is_public"`
+	Title          string `sql:"title"`
+	UserID         int64  `sql:"user_id"`
+	Thumbnail      string `sql:"thumbnail"`
+	ThumbnailColor string `sql:"thumbnail_color"`
+	IsPublic       bool   `sql:"is_public"`
 }
 
 type GetToAddByUserIdRequest struct {
@@ -43,10 +47,11 @@ type GetPlaylistTrackIdsRequest struct {
 }
 
 type UpdatePlaylistRequest struct {
-	UserID     int64
-	PlaylistID int64
-	Title      string
-	Thumbnail  string
+	UserID         int64
+	PlaylistID     int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
 }
 
 type RemovePlaylistRequest struct {
@@ -94,4 +99,6 @@ type GetProfilePlaylistsResponse struct {
 type SearchPlaylistsRequest struct {
 	UserID int64
 	Query  string
+	Offset int64
+	Limit  int64
 }
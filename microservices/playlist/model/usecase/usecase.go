@@ -5,10 +5,11 @@ import (
 )
 
 type Playlist struct {
-	ID        int64
-	Title     string
-	UserID    int64
-	Thumbnail string
+	ID             int64
+	Title          string
+	UserID         int64
+	Thumbnail      string
+	ThumbnailColor string
 }
 
 type PlaylistList struct {
@@ -16,10 +17,17 @@ type PlaylistList struct {
 }
 
 type CreatePlaylistRequest struct {
-	Title     string
-	UserID    int64
-	Thumbnail string
-	IsPublic  bool
+	Title          string
+	UserID         int64
+	Thumbnail      string
+	ThumbnailColor string
+	IsPublic       bool
+}
+
+// PlaylistThumbnail is an uploaded cover and its dominant color
+type PlaylistThumbnail struct {
+	URL   string
+	Color string
 }
 
 type UploadPlaylistThumbnailRequest struct {
@@ -49,10 +57,11 @@ type GetPlaylistTrackIdsRequest struct {
 }
 
 type UpdatePlaylistRequest struct {
-	UserID     int64
-	PlaylistID int64
-	Title      string
-	Thumbnail  string
+	UserID         int64
+	PlaylistID     int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
 }
 
 type GetPlaylistByIDRequest struct {
@@ -106,4 +115,6 @@ type GetProfilePlaylistsResponse struct {
 type SearchPlaylistsRequest struct {
 	UserID int64
 	Query  string
+	Offset int64
+	Limit  int64
 }
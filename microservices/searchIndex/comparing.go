@@ -0,0 +1,101 @@
+package searchIndex
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"go.uber.org/zap"
+)
+
+const shadowSearchTimeout = 5 * time.Second
+
+// ComparingIndex answers from the primary index and replays every search against
+// the shadow one in the background to compare relevance of the two backends.
+type ComparingIndex struct {
+	name        string
+	primaryName string
+	primary     Index
+	shadowName  string
+	shadow      Index
+	metrics     *metrics.Metrics
+}
+
+func NewComparingIndex(name string, primaryName string, primary Index, shadowName string, shadow Index, metrics *metrics.Metrics) *ComparingIndex {
+	return &ComparingIndex{
+		name:        name,
+		primaryName: primaryName,
+		primary:     primary,
+		shadowName:  shadowName,
+		shadow:      shadow,
+		metrics:     metrics,
+	}
+}
+
+func (c *ComparingIndex) Search(ctx context.Context, query string, limit int64, offset int64) ([]int64, error) {
+	start := time.Now()
+	ids, err := c.primary.Search(ctx, query, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+	c.metrics.SearchIndexDuration.WithLabelValues(c.name, c.primaryName).Observe(time.Since(start).Seconds())
+
+	go c.compare(context.WithoutCancel(ctx), query, limit, offset, ids)
+
+	return ids, nil
+}
+
+func (c *ComparingIndex) compare(ctx context.Context, query string, limit int64, offset int64, primaryIDs []int64) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	ctx, cancel := context.WithTimeout(ctx, shadowSearchTimeout)
+	defer cancel()
+
+	start := time.Now()
+	shadowIDs, err := c.shadow.Search(ctx, query, limit, offset)
+	if err != nil {
+		logger.Warn("shadow search failed", zap.String("index", c.name), zap.String("backend", c.shadowName), zap.Error(err))
+		return
+	}
+	c.metrics.SearchIndexDuration.WithLabelValues(c.name, c.shadowName).Observe(time.Since(start).Seconds())
+
+	overlap := Overlap(primaryIDs, shadowIDs)
+	c.metrics.SearchIndexOverlap.WithLabelValues(c.name).Observe(overlap)
+	logger.Info("search backends compared",
+		zap.String("index", c.name),
+		zap.String("query", query),
+		zap.Int64s(c.primaryName, primaryIDs),
+		zap.Int64s(c.shadowName, shadowIDs),
+		zap.Float64("overlap", overlap),
+	)
+}
+
+func (c *ComparingIndex) Refresh(ctx context.Context, ids []int64) error {
+	return errors.Join(c.primary.Refresh(ctx, ids), c.shadow.Refresh(ctx, ids))
+}
+
+func (c *ComparingIndex) Remove(ctx context.Context, ids []int64) error {
+	return errors.Join(c.primary.Remove(ctx, ids), c.shadow.Remove(ctx, ids))
+}
+
+// Overlap is the share of results both lists agree on, regardless of order. Two empty lists agree fully.
+func Overlap(a []int64, b []int64) float64 {
+	if len(a) == 0 && len(b) == 0 {
+		return 1
+	}
+
+	inA := make(map[int64]bool, len(a))
+	for _, id := range a {
+		inA[id] = true
+	}
+
+	common := 0
+	for _, id := range b {
+		if inA[id] {
+			common++
+		}
+	}
+
+	return float64(common) / float64(max(len(a), len(b)))
+}
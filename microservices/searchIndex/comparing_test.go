@@ -0,0 +1,84 @@
+package searchIndex
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+type fakeIndex struct {
+	ids      []int64
+	err      error
+	searched chan struct{}
+	removed  []int64
+}
+
+func (f *fakeIndex) Search(ctx context.Context, query string, limit int64, offset int64) ([]int64, error) {
+	if f.searched != nil {
+		defer close(f.searched)
+	}
+	return f.ids, f.err
+}
+
+func (f *fakeIndex) Refresh(ctx context.Context, ids []int64) error {
+	return f.err
+}
+
+func (f *fakeIndex) Remove(ctx context.Context, ids []int64) error {
+	f.removed = append(f.removed, ids...)
+	return f.err
+}
+
+func setupTestContext() context.Context {
+	return loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+}
+
+func TestComparingIndexSearch(t *testing.T) {
+	primary := &fakeIndex{ids: []int64{1, 2}}
+	shadow := &fakeIndex{ids: []int64{2, 3}, searched: make(chan struct{})}
+	index := NewComparingIndex("tracks", BackendPostgres, primary, BackendMemory, shadow, metrics.NewMockMetrics())
+
+	ids, err := index.Search(setupTestContext(), "query", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, ids)
+
+	// the shadow backend is queried in the background
+	<-shadow.searched
+}
+
+func TestComparingIndexSearchPrimaryError(t *testing.T) {
+	expectedErr := errors.New("db error")
+	primary := &fakeIndex{err: expectedErr}
+	shadow := &fakeIndex{}
+	index := NewComparingIndex("tracks", BackendPostgres, primary, BackendMemory, shadow, metrics.NewMockMetrics())
+
+	ids, err := index.Search(setupTestContext(), "query", 10, 0)
+	assert.ErrorIs(t, err, expectedErr)
+	assert.Nil(t, ids)
+}
+
+func TestComparingIndexRemove(t *testing.T) {
+	expectedErr := errors.New("db error")
+	primary := &fakeIndex{err: expectedErr}
+	shadow := &fakeIndex{}
+	index := NewComparingIndex("tracks", BackendPostgres, primary, BackendMemory, shadow, metrics.NewMockMetrics())
+
+	err := index.Remove(setupTestContext(), []int64{1})
+	assert.ErrorIs(t, err, expectedErr)
+	// a failing primary does not stop the shadow from being updated
+	assert.Equal(t, []int64{1}, shadow.removed)
+}
+
+func TestOverlap(t *testing.T) {
+	assert.Equal(t, 1.0, Overlap(nil, nil))
+	assert.Equal(t, 1.0, Overlap([]int64{1, 2}, []int64{2, 1}))
+	assert.Equal(t, 0.5, Overlap([]int64{1, 2}, []int64{2, 3}))
+	assert.Equal(t, 0.5, Overlap([]int64{1, 2}, []int64{1}))
+	assert.Equal(t, 0.0, Overlap([]int64{1}, nil))
+}
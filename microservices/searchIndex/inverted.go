@@ -0,0 +1,249 @@
+package searchIndex
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"go.uber.org/zap"
+)
+
+// prefixMatchWeight is how much a term that only starts with the query token counts compared to an exact term
+const prefixMatchWeight = 0.5
+
+type indexedDocument struct {
+	terms      []string
+	popularity int64
+}
+
+// InvertedIndex is an in-process index over document texts. It mirrors the Postgres ranking:
+// every query token is matched as a prefix, documents matching all tokens go first, and the text
+// score is boosted by popularity.
+type InvertedIndex struct {
+	loader Loader
+
+	mu       sync.RWMutex
+	docs     map[int64]*indexedDocument
+	postings map[string]map[int64]struct{}
+	// terms is kept sorted for prefix lookups
+	terms []string
+}
+
+func NewInvertedIndex(loader Loader) *InvertedIndex {
+	return &InvertedIndex{
+		loader:   loader,
+		docs:     make(map[int64]*indexedDocument),
+		postings: make(map[string]map[int64]struct{}),
+	}
+}
+
+func (i *InvertedIndex) Search(ctx context.Context, query string, limit int64, offset int64) ([]int64, error) {
+	tokens := tokenize(query)
+	if len(tokens) == 0 {
+		return []int64{}, nil
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	type candidate struct {
+		id      int64
+		matched int
+		score   float64
+	}
+	candidates := make(map[int64]*candidate)
+	total := float64(len(i.docs))
+
+	for _, token := range tokens {
+		tokenScores := make(map[int64]float64)
+		start := sort.SearchStrings(i.terms, token)
+		for _, term := range i.terms[start:] {
+			if !strings.HasPrefix(term, token) {
+				break
+			}
+			postings := i.postings[term]
+			weight := math.Log(1 + total/float64(len(postings)))
+			if term != token {
+				weight *= prefixMatchWeight
+			}
+			for id := range postings {
+				tokenScores[id] = max(tokenScores[id], weight)
+			}
+		}
+
+		for id, score := range tokenScores {
+			c, ok := candidates[id]
+			if !ok {
+				c = &candidate{id: id}
+				candidates[id] = c
+			}
+			c.matched++
+			c.score += score
+		}
+	}
+
+	ranked := make([]*candidate, 0, len(candidates))
+	for _, c := range candidates {
+		c.score = c.score / float64(len(tokens)) * (1 + math.Log(1+float64(i.docs[c.id].popularity)))
+		ranked = append(ranked, c)
+	}
+
+	sort.Slice(ranked, func(a, b int) bool {
+		allA, allB := ranked[a].matched == len(tokens), ranked[b].matched == len(tokens)
+		if allA != allB {
+			return allA
+		}
+		if ranked[a].score != ranked[b].score {
+			return ranked[a].score > ranked[b].score
+		}
+		return ranked[a].id > ranked[b].id
+	})
+
+	if offset >= int64(len(ranked)) {
+		return []int64{}, nil
+	}
+	ranked = ranked[offset:]
+	if limit > 0 && limit < int64(len(ranked)) {
+		ranked = ranked[:limit]
+	}
+
+	ids := make([]int64, 0, len(ranked))
+	for _, c := range ranked {
+		ids = append(ids, c.id)
+	}
+	return ids, nil
+}
+
+func (i *InvertedIndex) Refresh(ctx context.Context, ids []int64) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	docs, err := i.loader(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	found := make(map[int64]bool, len(docs))
+	for _, doc := range docs {
+		found[doc.ID] = true
+		i.upsert(doc)
+	}
+	// whatever the loader did not return is gone from the catalog
+	for _, id := range ids {
+		if !found[id] {
+			i.remove(id)
+		}
+	}
+	return nil
+}
+
+func (i *InvertedIndex) Remove(ctx context.Context, ids []int64) error {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	for _, id := range ids {
+		i.remove(id)
+	}
+	return nil
+}
+
+// Rebuild reloads the whole catalog. Popularity changes with every stream,
+// so the index is rebuilt periodically rather than on every listen.
+func (i *InvertedIndex) Rebuild(ctx context.Context) error {
+	docs, err := i.loader(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	fresh := NewInvertedIndex(i.loader)
+	for _, doc := range docs {
+		fresh.upsert(doc)
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.docs, i.postings, i.terms = fresh.docs, fresh.postings, fresh.terms
+	return nil
+}
+
+func (i *InvertedIndex) RunRebuild(ctx context.Context, interval time.Duration) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := i.Rebuild(ctx); err != nil {
+				logger.Error("failed to rebuild search index", zap.Error(err))
+			}
+		}
+	}
+}
+
+func (i *InvertedIndex) upsert(doc *Document) {
+	i.remove(doc.ID)
+
+	terms := uniqueTerms(tokenize(doc.Text))
+	i.docs[doc.ID] = &indexedDocument{terms: terms, popularity: doc.Popularity}
+	for _, term := range terms {
+		postings, ok := i.postings[term]
+		if !ok {
+			postings = make(map[int64]struct{})
+			i.postings[term] = postings
+			pos := sort.SearchStrings(i.terms, term)
+			i.terms = append(i.terms, "")
+			copy(i.terms[pos+1:], i.terms[pos:])
+			i.terms[pos] = term
+		}
+		postings[doc.ID] = struct{}{}
+	}
+}
+
+func (i *InvertedIndex) remove(id int64) {
+	doc, ok := i.docs[id]
+	if !ok {
+		return
+	}
+	delete(i.docs, id)
+
+	for _, term := range doc.terms {
+		postings := i.postings[term]
+		delete(postings, id)
+		if len(postings) == 0 {
+			delete(i.postings, term)
+			pos := sort.SearchStrings(i.terms, term)
+			i.terms = append(i.terms[:pos], i.terms[pos+1:]...)
+		}
+	}
+}
+
+func tokenize(text string) []string {
+	text = strings.ReplaceAll(strings.ToLower(text), "ё", "е")
+	return strings.FieldsFunc(text, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+func uniqueTerms(tokens []string) []string {
+	seen := make(map[string]bool, len(tokens))
+	terms := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if !seen[token] {
+			seen[token] = true
+			terms = append(terms, token)
+		}
+	}
+	return terms
+}
@@ -0,0 +1,148 @@
+package searchIndex
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticLoader(docs ...*Document) Loader {
+	return func(ctx context.Context, ids []int64) ([]*Document, error) {
+		if ids == nil {
+			return docs, nil
+		}
+		wanted := make(map[int64]bool, len(ids))
+		for _, id := range ids {
+			wanted[id] = true
+		}
+		var result []*Document
+		for _, doc := range docs {
+			if wanted[doc.ID] {
+				result = append(result, doc)
+			}
+		}
+		return result, nil
+	}
+}
+
+func TestInvertedIndexSearch(t *testing.T) {
+	index := NewInvertedIndex(staticLoader(
+		&Document{ID: 1, Text: "Moonlight Sonata"},
+		&Document{ID: 2, Text: "Moon River", Popularity: 100},
+		&Document{ID: 3, Text: "River Flows In You"},
+	))
+	require.NoError(t, index.Rebuild(context.Background()))
+
+	ids, err := index.Search(context.Background(), "moon", 10, 0)
+	require.NoError(t, err)
+	// the exact term match outranks the prefix one
+	assert.Equal(t, []int64{2, 1}, ids)
+
+	ids, err = index.Search(context.Background(), "moon river", 10, 0)
+	require.NoError(t, err)
+	// documents matching every token go first
+	assert.Equal(t, int64(2), ids[0])
+	assert.ElementsMatch(t, []int64{1, 2, 3}, ids)
+}
+
+func TestInvertedIndexSearchNormalizesText(t *testing.T) {
+	index := NewInvertedIndex(staticLoader(
+		&Document{ID: 1, Text: "Ёлка"},
+	))
+	require.NoError(t, index.Rebuild(context.Background()))
+
+	ids, err := index.Search(context.Background(), "ЕЛ", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	ids, err = index.Search(context.Background(), "  !!! ", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestInvertedIndexSearchPagination(t *testing.T) {
+	index := NewInvertedIndex(staticLoader(
+		&Document{ID: 1, Text: "song"},
+		&Document{ID: 2, Text: "song"},
+		&Document{ID: 3, Text: "song"},
+	))
+	require.NoError(t, index.Rebuild(context.Background()))
+
+	ids, err := index.Search(context.Background(), "song", 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, ids)
+
+	ids, err = index.Search(context.Background(), "song", 2, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	ids, err = index.Search(context.Background(), "song", 0, 0)
+	require.NoError(t, err)
+	assert.Len(t, ids, 3)
+
+	ids, err = index.Search(context.Background(), "song", 2, 10)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestInvertedIndexRefresh(t *testing.T) {
+	docs := map[int64]*Document{
+		1: {ID: 1, Text: "Old Title"},
+	}
+	loader := func(ctx context.Context, ids []int64) ([]*Document, error) {
+		var result []*Document
+		for _, doc := range docs {
+			result = append(result, doc)
+		}
+		return result, nil
+	}
+	index := NewInvertedIndex(loader)
+	require.NoError(t, index.Rebuild(context.Background()))
+
+	docs[1] = &Document{ID: 1, Text: "New Title"}
+	require.NoError(t, index.Refresh(context.Background(), []int64{1}))
+
+	ids, err := index.Search(context.Background(), "old", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	ids, err = index.Search(context.Background(), "new", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	// the loader no longer knows the document, so it is dropped
+	delete(docs, 1)
+	require.NoError(t, index.Refresh(context.Background(), []int64{1}))
+	ids, err = index.Search(context.Background(), "new", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+}
+
+func TestInvertedIndexRefreshError(t *testing.T) {
+	expectedErr := errors.New("db error")
+	index := NewInvertedIndex(func(ctx context.Context, ids []int64) ([]*Document, error) {
+		return nil, expectedErr
+	})
+
+	assert.ErrorIs(t, index.Refresh(context.Background(), []int64{1}), expectedErr)
+	assert.ErrorIs(t, index.Rebuild(context.Background()), expectedErr)
+}
+
+func TestInvertedIndexRemove(t *testing.T) {
+	index := NewInvertedIndex(staticLoader(
+		&Document{ID: 1, Text: "Blue Moon"},
+		&Document{ID: 2, Text: "Blue Sky"},
+	))
+	require.NoError(t, index.Rebuild(context.Background()))
+
+	require.NoError(t, index.Remove(context.Background(), []int64{1, 42}))
+
+	ids, err := index.Search(context.Background(), "moon", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	ids, err = index.Search(context.Background(), "blue", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{2}, ids)
+}
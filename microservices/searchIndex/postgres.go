@@ -0,0 +1,160 @@
+package searchIndex
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// PostgresQueries describes the catalog entity a PostgresIndex searches
+type PostgresQueries struct {
+	// Entity is the capitalized singular name, e.g. "Album". It names the logs and the metric labels.
+	Entity string
+	// Search takes the prefix tsquery, the raw query, the limit and the offset and returns matching IDs best first
+	Search string
+	// Documents takes an ID array, NULL meaning the whole catalog, and returns id, text and popularity rows
+	Documents string
+}
+
+// InternalError builds the service's own internal error so it maps to the right gRPC status
+type InternalError func(format string, args ...interface{}) error
+
+// PostgresIndex is the search backend built on the generated search_vector and title_trgm
+// columns. Postgres keeps them up to date itself, so Refresh and Remove do nothing.
+type PostgresIndex struct {
+	db            *sql.DB
+	metrics       *metrics.Metrics
+	queries       PostgresQueries
+	internalError InternalError
+}
+
+func NewPostgresIndex(db *sql.DB, metrics *metrics.Metrics, queries PostgresQueries, internalError InternalError) *PostgresIndex {
+	return &PostgresIndex{db: db, metrics: metrics, queries: queries, internalError: internalError}
+}
+
+func (r *PostgresIndex) Search(ctx context.Context, query string, limit int64, offset int64) ([]int64, error) {
+	start := time.Now()
+	entity := strings.ToLower(r.queries.Entity)
+	label := "Search" + r.queries.Entity + "s"
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Searching "+entity+" in db", zap.String("search query", query), zap.Int64("limit", limit), zap.Int64("offset", offset), zap.String("query", r.queries.Search))
+
+	stmt, err := r.db.PrepareContext(ctx, r.queries.Search)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, r.internalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("Error closing statement:", zap.Error(err))
+		}
+	}()
+
+	words := strings.Fields(query)
+	for i, word := range words {
+		words[i] = word + ":*"
+	}
+	tsQueryString := strings.Join(words, " & ")
+
+	rows, err := stmt.QueryContext(ctx, tsQueryString, query, limit, offset)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+		logger.Error("failed to search "+entity+"s", zap.Error(err))
+		return nil, r.internalError("failed to search %ss: %v", entity, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("Error closing rows:", zap.Error(err))
+		}
+	}()
+
+	ids := make([]int64, 0)
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+			logger.Error("failed to scan "+entity+" id", zap.Error(err))
+			return nil, r.internalError("failed to scan %s id: %v", entity, err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+		logger.Error("failed to search "+entity+"s", zap.Error(err))
+		return nil, r.internalError("failed to search %ss: %v", entity, err)
+	}
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues(label).Observe(duration)
+
+	return ids, nil
+}
+
+func (r *PostgresIndex) Refresh(ctx context.Context, ids []int64) error {
+	return nil
+}
+
+func (r *PostgresIndex) Remove(ctx context.Context, ids []int64) error {
+	return nil
+}
+
+// LoadDocuments feeds the in-process search index
+func (r *PostgresIndex) LoadDocuments(ctx context.Context, ids []int64) ([]*Document, error) {
+	start := time.Now()
+	entity := strings.ToLower(r.queries.Entity)
+	label := "Get" + r.queries.Entity + "SearchDocuments"
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting "+entity+" search documents from db", zap.Int("count", len(ids)), zap.String("query", r.queries.Documents))
+
+	stmt, err := r.db.PrepareContext(ctx, r.queries.Documents)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, r.internalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("Error closing statement:", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, pq.Array(ids))
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+		logger.Error("failed to get "+entity+" search documents", zap.Error(err))
+		return nil, r.internalError("failed to get %s search documents: %v", entity, err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("Error closing rows:", zap.Error(err))
+		}
+	}()
+
+	docs := make([]*Document, 0)
+	for rows.Next() {
+		var doc Document
+		if err := rows.Scan(&doc.ID, &doc.Text, &doc.Popularity); err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+			logger.Error("failed to scan "+entity+" search document", zap.Error(err))
+			return nil, r.internalError("failed to scan %s search document: %v", entity, err)
+		}
+		docs = append(docs, &doc)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(label).Inc()
+		logger.Error("failed to get "+entity+" search documents", zap.Error(err))
+		return nil, r.internalError("failed to get %s search documents: %v", entity, err)
+	}
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues(label).Observe(duration)
+
+	return docs, nil
+}
@@ -0,0 +1,170 @@
+package searchIndex
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+var errInternal = errors.New("internal")
+
+func newTestPostgresIndex(t *testing.T) (*PostgresIndex, sqlmock.Sqlmock, context.Context) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() { db.Close() })
+
+	queries := PostgresQueries{Entity: "Song", Search: "SELECT search", Documents: "SELECT documents"}
+	internalError := func(format string, args ...interface{}) error {
+		return fmt.Errorf("%w: "+format, append([]interface{}{errInternal}, args...)...)
+	}
+	ctx := loggerPkg.LoggerToContext(context.Background(), zap.NewNop().Sugar())
+	return NewPostgresIndex(db, metrics.NewMockMetrics(), queries, internalError), mock, ctx
+}
+
+func TestPostgresIndexSearch(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT search").
+		ExpectQuery().
+		WithArgs("first:* & song:*", "first song", int64(10), int64(5)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(3).AddRow(1))
+
+	ids, err := index.Search(ctx, "first song", 10, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 1}, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexSearchError(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT search").
+		ExpectQuery().
+		WillReturnError(errors.New("db error"))
+
+	ids, err := index.Search(ctx, "song", 10, 0)
+	assert.ErrorIs(t, err, errInternal)
+	assert.Nil(t, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexLoadDocuments(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT documents").
+		ExpectQuery().
+		WithArgs(pq.Array([]int64(nil))).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "text", "popularity"}).AddRow(1, "Song", 7))
+
+	docs, err := index.LoadDocuments(ctx, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []*Document{{ID: 1, Text: "Song", Popularity: 7}}, docs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexLoadDocumentsScanError(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT documents").
+		ExpectQuery().
+		WithArgs(pq.Array([]int64{1})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "text", "popularity"}).AddRow("not a number", "Song", 7))
+
+	docs, err := index.LoadDocuments(ctx, []int64{1})
+	assert.ErrorIs(t, err, errInternal)
+	assert.Nil(t, docs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexSearchSingleWord(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT search").
+		ExpectQuery().
+		WithArgs("song:*", "song", int64(10), int64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+	ids, err := index.Search(ctx, "song", 10, 0)
+	require.NoError(t, err)
+	assert.Empty(t, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexSearchPrepareError(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT search").WillReturnError(errors.New("db error"))
+
+	ids, err := index.Search(ctx, "song", 10, 0)
+	assert.ErrorIs(t, err, errInternal)
+	assert.Nil(t, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexSearchScanError(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT search").
+		ExpectQuery().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("not a number"))
+
+	ids, err := index.Search(ctx, "song", 10, 0)
+	assert.ErrorIs(t, err, errInternal)
+	assert.Nil(t, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexSearchRowsError(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT search").
+		ExpectQuery().
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).RowError(0, errors.New("row error")))
+
+	ids, err := index.Search(ctx, "song", 10, 0)
+	assert.ErrorIs(t, err, errInternal)
+	assert.Nil(t, ids)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexLoadDocumentsPrepareError(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT documents").WillReturnError(errors.New("db error"))
+
+	docs, err := index.LoadDocuments(ctx, nil)
+	assert.ErrorIs(t, err, errInternal)
+	assert.Nil(t, docs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexLoadDocumentsQueryError(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	mock.ExpectPrepare("SELECT documents").
+		ExpectQuery().
+		WithArgs(pq.Array([]int64{1, 2})).
+		WillReturnError(errors.New("db error"))
+
+	docs, err := index.LoadDocuments(ctx, []int64{1, 2})
+	assert.ErrorIs(t, err, errInternal)
+	assert.Nil(t, docs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestPostgresIndexRefreshAndRemoveAreNoops(t *testing.T) {
+	index, mock, ctx := newTestPostgresIndex(t)
+
+	assert.NoError(t, index.Refresh(ctx, []int64{1}))
+	assert.NoError(t, index.Remove(ctx, []int64{1}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,77 @@
+package searchIndex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+)
+
+const (
+	BackendPostgres = "postgres"
+	BackendMemory   = "memory"
+)
+
+// Document is the searchable representation of a catalog entity
+type Document struct {
+	ID         int64
+	Text       string
+	Popularity int64
+}
+
+// Index finds catalog entity IDs for a text query, best match first.
+// Implementations must tolerate IDs of entities that no longer exist - callers
+// materialize the IDs from the database and drop the missing ones.
+type Index interface {
+	Search(ctx context.Context, query string, limit int64, offset int64) ([]int64, error)
+	// Refresh re-reads the given entities after they were created or changed
+	Refresh(ctx context.Context, ids []int64) error
+	Remove(ctx context.Context, ids []int64) error
+}
+
+// Loader reads documents from the source of truth. Nil ids means the whole catalog.
+type Loader func(ctx context.Context, ids []int64) ([]*Document, error)
+
+// New builds the index selected in cfg. The in-process index is filled from loader before
+// New returns and rebuilt every cfg.RebuildInterval until ctx is done. When cfg.ShadowBackend
+// is set, both backends receive every request but only the primary one answers it.
+func New(ctx context.Context, cfg config.SearchConfig, name string, postgres Index, loader Loader, metrics *metrics.Metrics) (Index, error) {
+	primary, err := newBackend(ctx, cfg, cfg.Backend, postgres, loader)
+	if err != nil {
+		return nil, err
+	}
+
+	if cfg.ShadowBackend == "" {
+		return primary, nil
+	}
+
+	if cfg.ShadowBackend == cfg.Backend {
+		return nil, fmt.Errorf("search shadow backend must differ from the primary one, got %q for both", cfg.Backend)
+	}
+
+	shadow, err := newBackend(ctx, cfg, cfg.ShadowBackend, postgres, loader)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewComparingIndex(name, cfg.Backend, primary, cfg.ShadowBackend, shadow, metrics), nil
+}
+
+func newBackend(ctx context.Context, cfg config.SearchConfig, backend string, postgres Index, loader Loader) (Index, error) {
+	switch backend {
+	case BackendPostgres, "":
+		return postgres, nil
+	case BackendMemory:
+		index := NewInvertedIndex(loader)
+		if err := index.Rebuild(ctx); err != nil {
+			return nil, fmt.Errorf("failed to build in-process search index: %w", err)
+		}
+		if cfg.RebuildInterval > 0 {
+			go index.RunRebuild(ctx, cfg.RebuildInterval)
+		}
+		return index, nil
+	default:
+		return nil, fmt.Errorf("unknown search backend %q", backend)
+	}
+}
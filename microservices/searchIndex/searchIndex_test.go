@@ -0,0 +1,49 @@
+package searchIndex
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	postgres := &fakeIndex{}
+	loader := staticLoader(&Document{ID: 1, Text: "Song"})
+
+	index, err := New(context.Background(), config.SearchConfig{}, "tracks", postgres, loader, metrics.NewMockMetrics())
+	require.NoError(t, err)
+	assert.Same(t, postgres, index)
+
+	index, err = New(context.Background(), config.SearchConfig{Backend: BackendMemory}, "tracks", postgres, loader, metrics.NewMockMetrics())
+	require.NoError(t, err)
+	require.IsType(t, &InvertedIndex{}, index)
+	ids, err := index.Search(context.Background(), "song", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	index, err = New(context.Background(), config.SearchConfig{Backend: BackendPostgres, ShadowBackend: BackendMemory}, "tracks", postgres, loader, metrics.NewMockMetrics())
+	require.NoError(t, err)
+	assert.IsType(t, &ComparingIndex{}, index)
+}
+
+func TestNewErrors(t *testing.T) {
+	postgres := &fakeIndex{}
+	loader := staticLoader()
+
+	_, err := New(context.Background(), config.SearchConfig{Backend: "elastic"}, "tracks", postgres, loader, metrics.NewMockMetrics())
+	assert.Error(t, err)
+
+	_, err = New(context.Background(), config.SearchConfig{Backend: BackendMemory, ShadowBackend: BackendMemory}, "tracks", postgres, loader, metrics.NewMockMetrics())
+	assert.Error(t, err)
+
+	failingLoader := func(ctx context.Context, ids []int64) ([]*Document, error) {
+		return nil, errors.New("db error")
+	}
+	_, err = New(context.Background(), config.SearchConfig{Backend: BackendMemory}, "tracks", postgres, failingLoader, metrics.NewMockMetrics())
+	assert.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -11,9 +12,12 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/postgres"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/s3"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/imagePipeline"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/interceptors"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/delivery"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/domain"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/repository"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/usecase"
 	"github.com/prometheus/client_golang/prometheus"
@@ -60,8 +64,6 @@ func main() {
 
 	server := grpc.NewServer(
 		grpc.UnaryInterceptor(accessInterceptor.UnaryServerInterceptor()),
-		grpc.MaxRecvMsgSize(500*1024*1024), // 500 MB
-		grpc.MaxSendMsgSize(500*1024*1024), // 500 MB
 	)
 
 	go func() {
@@ -91,8 +93,25 @@ func main() {
 	}
 
 	trackRepository := repository.NewTrackPostgresRepository(postgresPool, metrics)
-	trackS3Repository := repository.NewTrackS3Repository(s3, cfg.S3.S3TracksBucket, cfg.S3.S3ImagesBucket, cfg.S3.S3Duration, metrics)
-	trackUsecase := usecase.NewTrackUsecase(trackRepository, trackS3Repository)
+	trackS3Repository := repository.NewTrackS3Repository(s3, cfg.S3.S3TracksBucket, cfg.S3.S3ImagesBucket, cfg.S3.S3Duration, imagePipeline.New(cfg.Images, metrics), metrics)
+	ctx, cancel := context.WithCancel(loggerPkg.LoggerToContext(context.Background(), logger))
+	defer cancel()
+	postgresSearchIndex := repository.NewTrackPostgresSearchIndex(postgresPool, metrics)
+	trackSearchIndex, err := searchIndex.New(ctx, cfg.Search, "tracks", postgresSearchIndex, postgresSearchIndex.LoadDocuments, metrics)
+	if err != nil {
+		logger.Error("Error initializing search index:", zap.Error(err))
+		return
+	}
+	var fingerprinter domain.Fingerprinter
+	if cfg.Fingerprints.FpcalcPath != "" {
+		fingerprinter = repository.NewChromaprintFingerprinter(cfg.Fingerprints.FpcalcPath, cfg.Fingerprints.Length, metrics)
+	}
+	trackUsecase := usecase.NewTrackUsecase(trackRepository, trackS3Repository, trackSearchIndex, fingerprinter, cfg.Fingerprints)
+	if cfg.Transcoding.Interval > 0 {
+		transcoder := repository.NewFFmpegTranscoder(cfg.Transcoding.FFmpegPath, metrics)
+		transcodeWorker := usecase.NewTranscodeWorker(trackRepository, trackS3Repository, transcoder, cfg.Transcoding)
+		go transcodeWorker.Run(ctx)
+	}
 	trackService := delivery.NewTrackService(trackUsecase)
 	trackProto.RegisterTrackServiceServer(server, trackService)
 
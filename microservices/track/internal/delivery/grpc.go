@@ -59,6 +59,22 @@ func (s *TrackService) UpdateStreamDuration(ctx context.Context, req *trackProto
 	return &emptypb.Empty{}, nil
 }
 
+func (s *TrackService) GetStreamManifest(ctx context.Context, req *trackProto.StreamIDWithUserID) (*trackProto.StreamManifest, error) {
+	variants, err := s.trackUsecase.GetStreamManifest(ctx, req.StreamId.Id, req.UserId.Id)
+	if err != nil {
+		return nil, err
+	}
+	return model.StreamManifestFromUsecaseToProto(variants), nil
+}
+
+func (s *TrackService) GetStreamSegment(ctx context.Context, req *trackProto.StreamSegmentRequest) (*trackProto.StreamSegmentURL, error) {
+	url, err := s.trackUsecase.GetStreamSegment(ctx, model.StreamSegmentRequestFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return &trackProto.StreamSegmentURL{Url: url}, nil
+}
+
 func (s *TrackService) GetLastListenedTracks(ctx context.Context, req *trackProto.UserIDWithFilters) (*trackProto.TrackList, error) {
 	tracks, err := s.trackUsecase.GetLastListenedTracks(ctx, req.UserId.Id, model.FiltersFromProtoToUsecase(req.Filters))
 	if err != nil {
@@ -134,7 +150,7 @@ func (s *TrackService) GetFavoriteTracks(ctx context.Context, req *trackProto.Fa
 }
 
 func (s *TrackService) SearchTracks(ctx context.Context, req *trackProto.Query) (*trackProto.TrackList, error) {
-	tracks, err := s.trackUsecase.SearchTracks(ctx, req.Query, req.UserId.Id)
+	tracks, err := s.trackUsecase.SearchTracks(ctx, req.Query, req.UserId.Id, model.FiltersFromProtoToUsecase(req.Filters))
 	if err != nil {
 		return nil, err
 	}
@@ -189,3 +205,99 @@ func (s *TrackService) GetMostLikedLastWeekTracks(ctx context.Context, req *trac
 	}
 	return model.TrackListFromUsecaseToProto(tracks), nil
 }
+
+func (s *TrackService) GetLabelTracksByAlbumID(ctx context.Context, req *trackProto.AlbumID) (*trackProto.TrackList, error) {
+	tracks, err := s.trackUsecase.GetLabelTracksByAlbumID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackListFromUsecaseToProto(tracks), nil
+}
+
+func (s *TrackService) UpdateAlbumTracks(ctx context.Context, req *trackProto.AlbumTracksUpdate) (*emptypb.Empty, error) {
+	err := s.trackUsecase.UpdateAlbumTracks(ctx, model.AlbumTracksUpdateFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackService) EditTrack(ctx context.Context, req *trackProto.TrackEdit) (*emptypb.Empty, error) {
+	err := s.trackUsecase.EditTrack(ctx, model.TrackEditFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackService) ReplaceTrackAudio(ctx context.Context, req *trackProto.TrackAudioReplace) (*emptypb.Empty, error) {
+	err := s.trackUsecase.ReplaceTrackAudio(ctx, model.TrackAudioReplaceFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackService) UpdateAlbumTracksCover(ctx context.Context, req *trackProto.AlbumCover) (*emptypb.Empty, error) {
+	err := s.trackUsecase.UpdateAlbumTracksCover(ctx, req.GetAlbumId().GetId(), req.CoverKey)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackService) GetPendingDuplicateFlags(ctx context.Context, req *trackProto.Filters) (*trackProto.DuplicateFlagList, error) {
+	flags, err := s.trackUsecase.GetPendingDuplicateFlags(ctx, model.FiltersFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return model.DuplicateFlagListFromUsecaseToProto(flags), nil
+}
+
+func (s *TrackService) ResolveDuplicateFlag(ctx context.Context, req *trackProto.DuplicateFlagResolution) (*emptypb.Empty, error) {
+	err := s.trackUsecase.ResolveDuplicateFlag(ctx, req.Id, req.Status)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackService) ReadTrackMetadata(ctx context.Context, req *trackProto.TrackFileKey) (*trackProto.TrackMetadata, error) {
+	metadata, err := s.trackUsecase.ReadTrackMetadata(ctx, req.FileKey)
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackMetadataFromUsecaseToProto(metadata), nil
+}
+
+func (s *TrackService) GetTrackWaveform(ctx context.Context, req *trackProto.TrackID) (*trackProto.TrackWaveform, error) {
+	waveform, err := s.trackUsecase.GetTrackWaveform(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackWaveformFromUsecaseToProto(waveform), nil
+}
+
+func (s *TrackService) SaveTrackLyrics(ctx context.Context, req *trackProto.TrackLyricsUpload) (*trackProto.TrackLyrics, error) {
+	lyrics, err := s.trackUsecase.SaveTrackLyrics(ctx, model.TrackLyricsUploadFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackLyricsFromUsecaseToProto(lyrics), nil
+}
+
+func (s *TrackService) DeleteTrackLyrics(ctx context.Context, req *trackProto.TrackLyricsDelete) (*emptypb.Empty, error) {
+	err := s.trackUsecase.DeleteTrackLyrics(ctx, model.TrackLyricsDeleteFromProtoToUsecase(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *TrackService) GetTrackLyrics(ctx context.Context, req *trackProto.TrackID) (*trackProto.TrackLyricsList, error) {
+	lyrics, err := s.trackUsecase.GetTrackLyrics(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackLyricsListFromUsecaseToProto(lyrics), nil
+}
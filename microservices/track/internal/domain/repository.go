@@ -2,6 +2,8 @@ package domain
 
 import (
 	"context"
+	"io"
+	"time"
 
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
 )
@@ -23,17 +25,52 @@ type Repository interface {
 	CheckTrackExists(ctx context.Context, trackID int64) (bool, error)
 	UnlikeTrack(ctx context.Context, likeRequest *repoModel.LikeRequest) error
 	GetFavoriteTracks(ctx context.Context, favoriteRequest *repoModel.FavoriteRequest) ([]*repoModel.Track, error)
-	SearchTracks(ctx context.Context, query string, userID int64) ([]*repoModel.Track, error)
-	AddTracksToAlbum(ctx context.Context, tracksList []*repoModel.Track) ([]int64, error)
+	AddTracksToAlbum(ctx context.Context, tracksList []*repoModel.TrackWithFileKey) ([]int64, error)
 	DeleteTracksByAlbumID(ctx context.Context, albumID int64) error
 	GetMostLikedTracks(ctx context.Context, userID int64) ([]*repoModel.Track, error)
 	GetMostRecentTracks(ctx context.Context, userID int64) ([]*repoModel.Track, error)
 	GetMostListenedLastMonthTracks(ctx context.Context, userID int64) ([]*repoModel.Track, error)
 	GetMostLikedLastWeekTracks(ctx context.Context, userID int64) ([]*repoModel.Track, error)
+	GetLabelTracksByAlbumID(ctx context.Context, albumID int64) ([]*repoModel.Track, error)
+	UpdateAlbumTracks(ctx context.Context, update *repoModel.AlbumTracksUpdate) error
+	EditTrack(ctx context.Context, edit *repoModel.TrackEdit) error
+	ReplaceTrackFile(ctx context.Context, replace *repoModel.TrackAudioReplace) error
+	UpdateAlbumTracksThumbnail(ctx context.Context, albumID int64, thumbnail string, thumbnailColor string) error
+	GetTrackRenditions(ctx context.Context, trackID int64) ([]*repoModel.TrackRendition, error)
+	ClaimTranscodeJob(ctx context.Context, staleAfter time.Duration) (*repoModel.TranscodeJob, error)
+	CompleteTranscodeJob(ctx context.Context, result *repoModel.TranscodeResult) error
+	FailTranscodeJob(ctx context.Context, job *repoModel.TranscodeJob, maxAttempts int64) error
+	GetTrackSegments(ctx context.Context, trackID int64) ([]*repoModel.TrackSegment, error)
+	GetTrackWaveform(ctx context.Context, trackID int64) (*repoModel.TrackWaveform, error)
+	SaveTrackLyrics(ctx context.Context, lyrics *repoModel.TrackLyrics) error
+	DeleteTrackLyrics(ctx context.Context, request *repoModel.TrackLyricsDelete) error
+	GetTrackLyrics(ctx context.Context, trackID int64) ([]*repoModel.TrackLyrics, error)
+	GetTrackSegment(ctx context.Context, trackID int64, bitrate int64, position int64) (*repoModel.TrackSegment, error)
+	RecordStreamSegment(ctx context.Context, fetch *repoModel.StreamSegmentFetch) error
+	FindFingerprintCandidates(ctx context.Context, fingerprint []int32, excludeTrackID int64, limit int64) ([]*repoModel.FingerprintCandidate, error)
+	SaveTrackFingerprint(ctx context.Context, fingerprint *repoModel.TrackFingerprint) error
+	GetPendingDuplicateFlags(ctx context.Context, filters *repoModel.TrackFilters) ([]*repoModel.DuplicateFlag, error)
+	ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error
 }
 
 type S3Repository interface {
 	GetPresignedURL(trackKey string) (string, error)
-	UploadTrack(ctx context.Context, fileKey string, file []byte) error
-	UploadTrackAvatar(ctx context.Context, trackTitle string, file []byte) (string, error)
+	OpenTrack(ctx context.Context, fileKey string) (io.ReadCloser, error)
+	CopyTrackAvatar(ctx context.Context, trackTitle string, sourceKey string) (string, string, error)
+	UploadRendition(ctx context.Context, fileKey string, file io.ReadSeeker, contentType string) error
+	UploadEmbeddedCover(ctx context.Context, coverKey string, data []byte, format string) (string, error)
+	DeleteEmbeddedCover(ctx context.Context, coverKey string) error
+}
+
+// Transcoder works on local files so that large masters are never held in memory
+type Transcoder interface {
+	Transcode(ctx context.Context, sourcePath string, profile *repoModel.RenditionProfile, destinationPath string) error
+	MeasureLoudness(ctx context.Context, sourcePath string) (*repoModel.Loudness, error)
+	Segment(ctx context.Context, sourcePath string, profile *repoModel.RenditionProfile, destinationDir string) ([]*repoModel.HLSSegment, error)
+	DecodePCM(ctx context.Context, sourcePath string, sampleRate int64, destinationPath string) error
+}
+
+// Fingerprinter computes acoustic fingerprints that survive re-encoding
+type Fingerprinter interface {
+	Fingerprint(ctx context.Context, sourcePath string) ([]int32, error)
 }
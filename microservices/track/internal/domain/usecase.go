@@ -11,6 +11,8 @@ type Usecase interface {
 	GetTrackByID(ctx context.Context, id int64, userID int64) (*usecaseModel.TrackDetailed, error)
 	CreateStream(ctx context.Context, stream *usecaseModel.TrackStreamCreateData) (int64, error)
 	UpdateStreamDuration(ctx context.Context, endedStream *usecaseModel.TrackStreamUpdateData) error
+	GetStreamManifest(ctx context.Context, streamID int64, userID int64) ([]*usecaseModel.StreamVariant, error)
+	GetStreamSegment(ctx context.Context, request *usecaseModel.StreamSegmentRequest) (string, error)
 	GetLastListenedTracks(ctx context.Context, userID int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error)
 	GetTracksByIDs(ctx context.Context, ids []int64, userID int64) ([]*usecaseModel.Track, error)
 	GetTracksByIDsFiltered(ctx context.Context, ids []int64, filters *usecaseModel.TrackFilters, userID int64) ([]*usecaseModel.Track, error)
@@ -20,11 +22,23 @@ type Usecase interface {
 	GetTracksListenedByUserID(ctx context.Context, userID int64) (int64, error)
 	LikeTrack(ctx context.Context, likeRequest *usecaseModel.LikeRequest) error
 	GetFavoriteTracks(ctx context.Context, favoriteRequest *usecaseModel.FavoriteRequest) ([]*usecaseModel.Track, error)
-	SearchTracks(ctx context.Context, query string, userID int64) ([]*usecaseModel.Track, error)
+	SearchTracks(ctx context.Context, query string, userID int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error)
 	AddTracksToAlbum(ctx context.Context, tracksList *usecaseModel.TracksListWithAlbumID) ([]int64, error)
 	DeleteTracksByAlbumID(ctx context.Context, albumID int64) error
 	GetMostLikedTracks(ctx context.Context, userID int64) ([]*usecaseModel.Track, error)
 	GetMostRecentTracks(ctx context.Context, userID int64) ([]*usecaseModel.Track, error)
 	GetMostListenedLastMonthTracks(ctx context.Context, userID int64) ([]*usecaseModel.Track, error)
 	GetMostLikedLastWeekTracks(ctx context.Context, userID int64) ([]*usecaseModel.Track, error)
+	GetLabelTracksByAlbumID(ctx context.Context, albumID int64) ([]*usecaseModel.Track, error)
+	UpdateAlbumTracks(ctx context.Context, update *usecaseModel.AlbumTracksUpdate) error
+	EditTrack(ctx context.Context, edit *usecaseModel.TrackEdit) error
+	ReplaceTrackAudio(ctx context.Context, replace *usecaseModel.TrackAudioReplace) error
+	UpdateAlbumTracksCover(ctx context.Context, albumID int64, coverKey string) error
+	GetPendingDuplicateFlags(ctx context.Context, filters *usecaseModel.TrackFilters) ([]*usecaseModel.DuplicateFlag, error)
+	ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error
+	ReadTrackMetadata(ctx context.Context, fileKey string) (*usecaseModel.TrackMetadata, error)
+	GetTrackWaveform(ctx context.Context, trackID int64) (*usecaseModel.TrackWaveform, error)
+	SaveTrackLyrics(ctx context.Context, upload *usecaseModel.TrackLyricsUpload) (*usecaseModel.TrackLyrics, error)
+	DeleteTrackLyrics(ctx context.Context, request *usecaseModel.TrackLyricsDelete) error
+	GetTrackLyrics(ctx context.Context, trackID int64) ([]*usecaseModel.TrackLyrics, error)
 }
@@ -11,7 +11,9 @@ package mock_domain
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
+	time "time"
 
 	repository "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
 	gomock "go.uber.org/mock/gomock"
@@ -42,7 +44,7 @@ func (m *MockRepository) EXPECT() *MockRepositoryMockRecorder {
 }
 
 // AddTracksToAlbum mocks base method.
-func (m *MockRepository) AddTracksToAlbum(ctx context.Context, tracksList []*repository.Track) ([]int64, error) {
+func (m *MockRepository) AddTracksToAlbum(ctx context.Context, tracksList []*repository.TrackWithFileKey) ([]int64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "AddTracksToAlbum", ctx, tracksList)
 	ret0, _ := ret[0].([]int64)
@@ -71,6 +73,35 @@ func (mr *MockRepositoryMockRecorder) CheckTrackExists(ctx, trackID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckTrackExists", reflect.TypeOf((*MockRepository)(nil).CheckTrackExists), ctx, trackID)
 }
 
+// ClaimTranscodeJob mocks base method.
+func (m *MockRepository) ClaimTranscodeJob(ctx context.Context, staleAfter time.Duration) (*repository.TranscodeJob, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimTranscodeJob", ctx, staleAfter)
+	ret0, _ := ret[0].(*repository.TranscodeJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimTranscodeJob indicates an expected call of ClaimTranscodeJob.
+func (mr *MockRepositoryMockRecorder) ClaimTranscodeJob(ctx, staleAfter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimTranscodeJob", reflect.TypeOf((*MockRepository)(nil).ClaimTranscodeJob), ctx, staleAfter)
+}
+
+// CompleteTranscodeJob mocks base method.
+func (m *MockRepository) CompleteTranscodeJob(ctx context.Context, result *repository.TranscodeResult) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTranscodeJob", ctx, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CompleteTranscodeJob indicates an expected call of CompleteTranscodeJob.
+func (mr *MockRepositoryMockRecorder) CompleteTranscodeJob(ctx, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTranscodeJob", reflect.TypeOf((*MockRepository)(nil).CompleteTranscodeJob), ctx, result)
+}
+
 // CreateStream mocks base method.
 func (m *MockRepository) CreateStream(ctx context.Context, stream *repository.TrackStreamCreateData) (int64, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +117,20 @@ func (mr *MockRepositoryMockRecorder) CreateStream(ctx, stream any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockRepository)(nil).CreateStream), ctx, stream)
 }
 
+// DeleteTrackLyrics mocks base method.
+func (m *MockRepository) DeleteTrackLyrics(ctx context.Context, request *repository.TrackLyricsDelete) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrackLyrics", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrackLyrics indicates an expected call of DeleteTrackLyrics.
+func (mr *MockRepositoryMockRecorder) DeleteTrackLyrics(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackLyrics", reflect.TypeOf((*MockRepository)(nil).DeleteTrackLyrics), ctx, request)
+}
+
 // DeleteTracksByAlbumID mocks base method.
 func (m *MockRepository) DeleteTracksByAlbumID(ctx context.Context, albumID int64) error {
 	m.ctrl.T.Helper()
@@ -100,6 +145,49 @@ func (mr *MockRepositoryMockRecorder) DeleteTracksByAlbumID(ctx, albumID any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTracksByAlbumID", reflect.TypeOf((*MockRepository)(nil).DeleteTracksByAlbumID), ctx, albumID)
 }
 
+// EditTrack mocks base method.
+func (m *MockRepository) EditTrack(ctx context.Context, edit *repository.TrackEdit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditTrack", ctx, edit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditTrack indicates an expected call of EditTrack.
+func (mr *MockRepositoryMockRecorder) EditTrack(ctx, edit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditTrack", reflect.TypeOf((*MockRepository)(nil).EditTrack), ctx, edit)
+}
+
+// FailTranscodeJob mocks base method.
+func (m *MockRepository) FailTranscodeJob(ctx context.Context, job *repository.TranscodeJob, maxAttempts int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailTranscodeJob", ctx, job, maxAttempts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FailTranscodeJob indicates an expected call of FailTranscodeJob.
+func (mr *MockRepositoryMockRecorder) FailTranscodeJob(ctx, job, maxAttempts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailTranscodeJob", reflect.TypeOf((*MockRepository)(nil).FailTranscodeJob), ctx, job, maxAttempts)
+}
+
+// FindFingerprintCandidates mocks base method.
+func (m *MockRepository) FindFingerprintCandidates(ctx context.Context, fingerprint []int32, excludeTrackID, limit int64) ([]*repository.FingerprintCandidate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFingerprintCandidates", ctx, fingerprint, excludeTrackID, limit)
+	ret0, _ := ret[0].([]*repository.FingerprintCandidate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFingerprintCandidates indicates an expected call of FindFingerprintCandidates.
+func (mr *MockRepositoryMockRecorder) FindFingerprintCandidates(ctx, fingerprint, excludeTrackID, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFingerprintCandidates", reflect.TypeOf((*MockRepository)(nil).FindFingerprintCandidates), ctx, fingerprint, excludeTrackID, limit)
+}
+
 // GetAlbumIDByTrackID mocks base method.
 func (m *MockRepository) GetAlbumIDByTrackID(ctx context.Context, id int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +233,21 @@ func (mr *MockRepositoryMockRecorder) GetFavoriteTracks(ctx, favoriteRequest any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockRepository)(nil).GetFavoriteTracks), ctx, favoriteRequest)
 }
 
+// GetLabelTracksByAlbumID mocks base method.
+func (m *MockRepository) GetLabelTracksByAlbumID(ctx context.Context, albumID int64) ([]*repository.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelTracksByAlbumID", ctx, albumID)
+	ret0, _ := ret[0].([]*repository.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelTracksByAlbumID indicates an expected call of GetLabelTracksByAlbumID.
+func (mr *MockRepositoryMockRecorder) GetLabelTracksByAlbumID(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelTracksByAlbumID", reflect.TypeOf((*MockRepository)(nil).GetLabelTracksByAlbumID), ctx, albumID)
+}
+
 // GetMinutesListenedByUserID mocks base method.
 func (m *MockRepository) GetMinutesListenedByUserID(ctx context.Context, userID int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +323,21 @@ func (mr *MockRepositoryMockRecorder) GetMostRecentTracks(ctx, userID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMostRecentTracks", reflect.TypeOf((*MockRepository)(nil).GetMostRecentTracks), ctx, userID)
 }
 
+// GetPendingDuplicateFlags mocks base method.
+func (m *MockRepository) GetPendingDuplicateFlags(ctx context.Context, filters *repository.TrackFilters) ([]*repository.DuplicateFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingDuplicateFlags", ctx, filters)
+	ret0, _ := ret[0].([]*repository.DuplicateFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingDuplicateFlags indicates an expected call of GetPendingDuplicateFlags.
+func (mr *MockRepositoryMockRecorder) GetPendingDuplicateFlags(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDuplicateFlags", reflect.TypeOf((*MockRepository)(nil).GetPendingDuplicateFlags), ctx, filters)
+}
+
 // GetStreamByID mocks base method.
 func (m *MockRepository) GetStreamByID(ctx context.Context, streamID int64) (*repository.TrackStream, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +383,81 @@ func (mr *MockRepositoryMockRecorder) GetTrackByID(ctx, id, userID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackByID", reflect.TypeOf((*MockRepository)(nil).GetTrackByID), ctx, id, userID)
 }
 
+// GetTrackLyrics mocks base method.
+func (m *MockRepository) GetTrackLyrics(ctx context.Context, trackID int64) ([]*repository.TrackLyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackLyrics", ctx, trackID)
+	ret0, _ := ret[0].([]*repository.TrackLyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackLyrics indicates an expected call of GetTrackLyrics.
+func (mr *MockRepositoryMockRecorder) GetTrackLyrics(ctx, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackLyrics", reflect.TypeOf((*MockRepository)(nil).GetTrackLyrics), ctx, trackID)
+}
+
+// GetTrackRenditions mocks base method.
+func (m *MockRepository) GetTrackRenditions(ctx context.Context, trackID int64) ([]*repository.TrackRendition, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackRenditions", ctx, trackID)
+	ret0, _ := ret[0].([]*repository.TrackRendition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackRenditions indicates an expected call of GetTrackRenditions.
+func (mr *MockRepositoryMockRecorder) GetTrackRenditions(ctx, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackRenditions", reflect.TypeOf((*MockRepository)(nil).GetTrackRenditions), ctx, trackID)
+}
+
+// GetTrackSegment mocks base method.
+func (m *MockRepository) GetTrackSegment(ctx context.Context, trackID, bitrate, position int64) (*repository.TrackSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackSegment", ctx, trackID, bitrate, position)
+	ret0, _ := ret[0].(*repository.TrackSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackSegment indicates an expected call of GetTrackSegment.
+func (mr *MockRepositoryMockRecorder) GetTrackSegment(ctx, trackID, bitrate, position any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackSegment", reflect.TypeOf((*MockRepository)(nil).GetTrackSegment), ctx, trackID, bitrate, position)
+}
+
+// GetTrackSegments mocks base method.
+func (m *MockRepository) GetTrackSegments(ctx context.Context, trackID int64) ([]*repository.TrackSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackSegments", ctx, trackID)
+	ret0, _ := ret[0].([]*repository.TrackSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackSegments indicates an expected call of GetTrackSegments.
+func (mr *MockRepositoryMockRecorder) GetTrackSegments(ctx, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackSegments", reflect.TypeOf((*MockRepository)(nil).GetTrackSegments), ctx, trackID)
+}
+
+// GetTrackWaveform mocks base method.
+func (m *MockRepository) GetTrackWaveform(ctx context.Context, trackID int64) (*repository.TrackWaveform, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackWaveform", ctx, trackID)
+	ret0, _ := ret[0].(*repository.TrackWaveform)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackWaveform indicates an expected call of GetTrackWaveform.
+func (mr *MockRepositoryMockRecorder) GetTrackWaveform(ctx, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackWaveform", reflect.TypeOf((*MockRepository)(nil).GetTrackWaveform), ctx, trackID)
+}
+
 // GetTracksByAlbumID mocks base method.
 func (m *MockRepository) GetTracksByAlbumID(ctx context.Context, id, userID int64) ([]*repository.Track, error) {
 	m.ctrl.T.Helper()
@@ -339,19 +532,74 @@ func (mr *MockRepositoryMockRecorder) LikeTrack(ctx, likeRequest any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeTrack", reflect.TypeOf((*MockRepository)(nil).LikeTrack), ctx, likeRequest)
 }
 
-// SearchTracks mocks base method.
-func (m *MockRepository) SearchTracks(ctx context.Context, query string, userID int64) ([]*repository.Track, error) {
+// RecordStreamSegment mocks base method.
+func (m *MockRepository) RecordStreamSegment(ctx context.Context, fetch *repository.StreamSegmentFetch) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchTracks", ctx, query, userID)
-	ret0, _ := ret[0].([]*repository.Track)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "RecordStreamSegment", ctx, fetch)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// SearchTracks indicates an expected call of SearchTracks.
-func (mr *MockRepositoryMockRecorder) SearchTracks(ctx, query, userID any) *gomock.Call {
+// RecordStreamSegment indicates an expected call of RecordStreamSegment.
+func (mr *MockRepositoryMockRecorder) RecordStreamSegment(ctx, fetch any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockRepository)(nil).SearchTracks), ctx, query, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecordStreamSegment", reflect.TypeOf((*MockRepository)(nil).RecordStreamSegment), ctx, fetch)
+}
+
+// ReplaceTrackFile mocks base method.
+func (m *MockRepository) ReplaceTrackFile(ctx context.Context, replace *repository.TrackAudioReplace) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTrackFile", ctx, replace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceTrackFile indicates an expected call of ReplaceTrackFile.
+func (mr *MockRepositoryMockRecorder) ReplaceTrackFile(ctx, replace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTrackFile", reflect.TypeOf((*MockRepository)(nil).ReplaceTrackFile), ctx, replace)
+}
+
+// ResolveDuplicateFlag mocks base method.
+func (m *MockRepository) ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDuplicateFlag", ctx, flagID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveDuplicateFlag indicates an expected call of ResolveDuplicateFlag.
+func (mr *MockRepositoryMockRecorder) ResolveDuplicateFlag(ctx, flagID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDuplicateFlag", reflect.TypeOf((*MockRepository)(nil).ResolveDuplicateFlag), ctx, flagID, status)
+}
+
+// SaveTrackFingerprint mocks base method.
+func (m *MockRepository) SaveTrackFingerprint(ctx context.Context, fingerprint *repository.TrackFingerprint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTrackFingerprint", ctx, fingerprint)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTrackFingerprint indicates an expected call of SaveTrackFingerprint.
+func (mr *MockRepositoryMockRecorder) SaveTrackFingerprint(ctx, fingerprint any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrackFingerprint", reflect.TypeOf((*MockRepository)(nil).SaveTrackFingerprint), ctx, fingerprint)
+}
+
+// SaveTrackLyrics mocks base method.
+func (m *MockRepository) SaveTrackLyrics(ctx context.Context, lyrics *repository.TrackLyrics) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTrackLyrics", ctx, lyrics)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveTrackLyrics indicates an expected call of SaveTrackLyrics.
+func (mr *MockRepositoryMockRecorder) SaveTrackLyrics(ctx, lyrics any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrackLyrics", reflect.TypeOf((*MockRepository)(nil).SaveTrackLyrics), ctx, lyrics)
 }
 
 // UnlikeTrack mocks base method.
@@ -368,6 +616,34 @@ func (mr *MockRepositoryMockRecorder) UnlikeTrack(ctx, likeRequest any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnlikeTrack", reflect.TypeOf((*MockRepository)(nil).UnlikeTrack), ctx, likeRequest)
 }
 
+// UpdateAlbumTracks mocks base method.
+func (m *MockRepository) UpdateAlbumTracks(ctx context.Context, update *repository.AlbumTracksUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbumTracks", ctx, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlbumTracks indicates an expected call of UpdateAlbumTracks.
+func (mr *MockRepositoryMockRecorder) UpdateAlbumTracks(ctx, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracks", reflect.TypeOf((*MockRepository)(nil).UpdateAlbumTracks), ctx, update)
+}
+
+// UpdateAlbumTracksThumbnail mocks base method.
+func (m *MockRepository) UpdateAlbumTracksThumbnail(ctx context.Context, albumID int64, thumbnail, thumbnailColor string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbumTracksThumbnail", ctx, albumID, thumbnail, thumbnailColor)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlbumTracksThumbnail indicates an expected call of UpdateAlbumTracksThumbnail.
+func (mr *MockRepositoryMockRecorder) UpdateAlbumTracksThumbnail(ctx, albumID, thumbnail, thumbnailColor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracksThumbnail", reflect.TypeOf((*MockRepository)(nil).UpdateAlbumTracksThumbnail), ctx, albumID, thumbnail, thumbnailColor)
+}
+
 // UpdateStreamDuration mocks base method.
 func (m *MockRepository) UpdateStreamDuration(ctx context.Context, endedStream *repository.TrackStreamUpdateData) error {
 	m.ctrl.T.Helper()
@@ -406,6 +682,36 @@ func (m *MockS3Repository) EXPECT() *MockS3RepositoryMockRecorder {
 	return m.recorder
 }
 
+// CopyTrackAvatar mocks base method.
+func (m *MockS3Repository) CopyTrackAvatar(ctx context.Context, trackTitle, sourceKey string) (string, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTrackAvatar", ctx, trackTitle, sourceKey)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CopyTrackAvatar indicates an expected call of CopyTrackAvatar.
+func (mr *MockS3RepositoryMockRecorder) CopyTrackAvatar(ctx, trackTitle, sourceKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTrackAvatar", reflect.TypeOf((*MockS3Repository)(nil).CopyTrackAvatar), ctx, trackTitle, sourceKey)
+}
+
+// DeleteEmbeddedCover mocks base method.
+func (m *MockS3Repository) DeleteEmbeddedCover(ctx context.Context, coverKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmbeddedCover", ctx, coverKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmbeddedCover indicates an expected call of DeleteEmbeddedCover.
+func (mr *MockS3RepositoryMockRecorder) DeleteEmbeddedCover(ctx, coverKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmbeddedCover", reflect.TypeOf((*MockS3Repository)(nil).DeleteEmbeddedCover), ctx, coverKey)
+}
+
 // GetPresignedURL mocks base method.
 func (m *MockS3Repository) GetPresignedURL(trackKey string) (string, error) {
 	m.ctrl.T.Helper()
@@ -421,31 +727,167 @@ func (mr *MockS3RepositoryMockRecorder) GetPresignedURL(trackKey any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresignedURL", reflect.TypeOf((*MockS3Repository)(nil).GetPresignedURL), trackKey)
 }
 
-// UploadTrack mocks base method.
-func (m *MockS3Repository) UploadTrack(ctx context.Context, fileKey string, file []byte) error {
+// OpenTrack mocks base method.
+func (m *MockS3Repository) OpenTrack(ctx context.Context, fileKey string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenTrack", ctx, fileKey)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenTrack indicates an expected call of OpenTrack.
+func (mr *MockS3RepositoryMockRecorder) OpenTrack(ctx, fileKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenTrack", reflect.TypeOf((*MockS3Repository)(nil).OpenTrack), ctx, fileKey)
+}
+
+// UploadEmbeddedCover mocks base method.
+func (m *MockS3Repository) UploadEmbeddedCover(ctx context.Context, coverKey string, data []byte, format string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadEmbeddedCover", ctx, coverKey, data, format)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadEmbeddedCover indicates an expected call of UploadEmbeddedCover.
+func (mr *MockS3RepositoryMockRecorder) UploadEmbeddedCover(ctx, coverKey, data, format any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadEmbeddedCover", reflect.TypeOf((*MockS3Repository)(nil).UploadEmbeddedCover), ctx, coverKey, data, format)
+}
+
+// UploadRendition mocks base method.
+func (m *MockS3Repository) UploadRendition(ctx context.Context, fileKey string, file io.ReadSeeker, contentType string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadTrack", ctx, fileKey, file)
+	ret := m.ctrl.Call(m, "UploadRendition", ctx, fileKey, file, contentType)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// UploadTrack indicates an expected call of UploadTrack.
-func (mr *MockS3RepositoryMockRecorder) UploadTrack(ctx, fileKey, file any) *gomock.Call {
+// UploadRendition indicates an expected call of UploadRendition.
+func (mr *MockS3RepositoryMockRecorder) UploadRendition(ctx, fileKey, file, contentType any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTrack", reflect.TypeOf((*MockS3Repository)(nil).UploadTrack), ctx, fileKey, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadRendition", reflect.TypeOf((*MockS3Repository)(nil).UploadRendition), ctx, fileKey, file, contentType)
+}
+
+// MockTranscoder is a mock of Transcoder interface.
+type MockTranscoder struct {
+	ctrl     *gomock.Controller
+	recorder *MockTranscoderMockRecorder
+	isgomock struct{}
+}
+
+// MockTranscoderMockRecorder is the mock recorder for MockTranscoder.
+type MockTranscoderMockRecorder struct {
+	mock *MockTranscoder
+}
+
+// NewMockTranscoder creates a new mock instance.
+func NewMockTranscoder(ctrl *gomock.Controller) *MockTranscoder {
+	mock := &MockTranscoder{ctrl: ctrl}
+	mock.recorder = &MockTranscoderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTranscoder) EXPECT() *MockTranscoderMockRecorder {
+	return m.recorder
 }
 
-// UploadTrackAvatar mocks base method.
-func (m *MockS3Repository) UploadTrackAvatar(ctx context.Context, trackTitle string, file []byte) (string, error) {
+// DecodePCM mocks base method.
+func (m *MockTranscoder) DecodePCM(ctx context.Context, sourcePath string, sampleRate int64, destinationPath string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadTrackAvatar", ctx, trackTitle, file)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "DecodePCM", ctx, sourcePath, sampleRate, destinationPath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DecodePCM indicates an expected call of DecodePCM.
+func (mr *MockTranscoderMockRecorder) DecodePCM(ctx, sourcePath, sampleRate, destinationPath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DecodePCM", reflect.TypeOf((*MockTranscoder)(nil).DecodePCM), ctx, sourcePath, sampleRate, destinationPath)
+}
+
+// MeasureLoudness mocks base method.
+func (m *MockTranscoder) MeasureLoudness(ctx context.Context, sourcePath string) (*repository.Loudness, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MeasureLoudness", ctx, sourcePath)
+	ret0, _ := ret[0].(*repository.Loudness)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MeasureLoudness indicates an expected call of MeasureLoudness.
+func (mr *MockTranscoderMockRecorder) MeasureLoudness(ctx, sourcePath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MeasureLoudness", reflect.TypeOf((*MockTranscoder)(nil).MeasureLoudness), ctx, sourcePath)
+}
+
+// Segment mocks base method.
+func (m *MockTranscoder) Segment(ctx context.Context, sourcePath string, profile *repository.RenditionProfile, destinationDir string) ([]*repository.HLSSegment, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Segment", ctx, sourcePath, profile, destinationDir)
+	ret0, _ := ret[0].([]*repository.HLSSegment)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Segment indicates an expected call of Segment.
+func (mr *MockTranscoderMockRecorder) Segment(ctx, sourcePath, profile, destinationDir any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Segment", reflect.TypeOf((*MockTranscoder)(nil).Segment), ctx, sourcePath, profile, destinationDir)
+}
+
+// Transcode mocks base method.
+func (m *MockTranscoder) Transcode(ctx context.Context, sourcePath string, profile *repository.RenditionProfile, destinationPath string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transcode", ctx, sourcePath, profile, destinationPath)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Transcode indicates an expected call of Transcode.
+func (mr *MockTranscoderMockRecorder) Transcode(ctx, sourcePath, profile, destinationPath any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transcode", reflect.TypeOf((*MockTranscoder)(nil).Transcode), ctx, sourcePath, profile, destinationPath)
+}
+
+// MockFingerprinter is a mock of Fingerprinter interface.
+type MockFingerprinter struct {
+	ctrl     *gomock.Controller
+	recorder *MockFingerprinterMockRecorder
+	isgomock struct{}
+}
+
+// MockFingerprinterMockRecorder is the mock recorder for MockFingerprinter.
+type MockFingerprinterMockRecorder struct {
+	mock *MockFingerprinter
+}
+
+// NewMockFingerprinter creates a new mock instance.
+func NewMockFingerprinter(ctrl *gomock.Controller) *MockFingerprinter {
+	mock := &MockFingerprinter{ctrl: ctrl}
+	mock.recorder = &MockFingerprinterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFingerprinter) EXPECT() *MockFingerprinterMockRecorder {
+	return m.recorder
+}
+
+// Fingerprint mocks base method.
+func (m *MockFingerprinter) Fingerprint(ctx context.Context, sourcePath string) ([]int32, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Fingerprint", ctx, sourcePath)
+	ret0, _ := ret[0].([]int32)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// UploadTrackAvatar indicates an expected call of UploadTrackAvatar.
-func (mr *MockS3RepositoryMockRecorder) UploadTrackAvatar(ctx, trackTitle, file any) *gomock.Call {
+// Fingerprint indicates an expected call of Fingerprint.
+func (mr *MockFingerprinterMockRecorder) Fingerprint(ctx, sourcePath any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTrackAvatar", reflect.TypeOf((*MockS3Repository)(nil).UploadTrackAvatar), ctx, trackTitle, file)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Fingerprint", reflect.TypeOf((*MockFingerprinter)(nil).Fingerprint), ctx, sourcePath)
 }
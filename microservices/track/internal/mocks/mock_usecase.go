@@ -71,6 +71,20 @@ func (mr *MockUsecaseMockRecorder) CreateStream(ctx, stream any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockUsecase)(nil).CreateStream), ctx, stream)
 }
 
+// DeleteTrackLyrics mocks base method.
+func (m *MockUsecase) DeleteTrackLyrics(ctx context.Context, request *usecase.TrackLyricsDelete) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrackLyrics", ctx, request)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTrackLyrics indicates an expected call of DeleteTrackLyrics.
+func (mr *MockUsecaseMockRecorder) DeleteTrackLyrics(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackLyrics", reflect.TypeOf((*MockUsecase)(nil).DeleteTrackLyrics), ctx, request)
+}
+
 // DeleteTracksByAlbumID mocks base method.
 func (m *MockUsecase) DeleteTracksByAlbumID(ctx context.Context, albumID int64) error {
 	m.ctrl.T.Helper()
@@ -85,6 +99,20 @@ func (mr *MockUsecaseMockRecorder) DeleteTracksByAlbumID(ctx, albumID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTracksByAlbumID", reflect.TypeOf((*MockUsecase)(nil).DeleteTracksByAlbumID), ctx, albumID)
 }
 
+// EditTrack mocks base method.
+func (m *MockUsecase) EditTrack(ctx context.Context, edit *usecase.TrackEdit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditTrack", ctx, edit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditTrack indicates an expected call of EditTrack.
+func (mr *MockUsecaseMockRecorder) EditTrack(ctx, edit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditTrack", reflect.TypeOf((*MockUsecase)(nil).EditTrack), ctx, edit)
+}
+
 // GetAlbumIDByTrackID mocks base method.
 func (m *MockUsecase) GetAlbumIDByTrackID(ctx context.Context, id int64) (int64, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +158,21 @@ func (mr *MockUsecaseMockRecorder) GetFavoriteTracks(ctx, favoriteRequest any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockUsecase)(nil).GetFavoriteTracks), ctx, favoriteRequest)
 }
 
+// GetLabelTracksByAlbumID mocks base method.
+func (m *MockUsecase) GetLabelTracksByAlbumID(ctx context.Context, albumID int64) ([]*usecase.Track, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelTracksByAlbumID", ctx, albumID)
+	ret0, _ := ret[0].([]*usecase.Track)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelTracksByAlbumID indicates an expected call of GetLabelTracksByAlbumID.
+func (mr *MockUsecaseMockRecorder) GetLabelTracksByAlbumID(ctx, albumID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelTracksByAlbumID", reflect.TypeOf((*MockUsecase)(nil).GetLabelTracksByAlbumID), ctx, albumID)
+}
+
 // GetLastListenedTracks mocks base method.
 func (m *MockUsecase) GetLastListenedTracks(ctx context.Context, userID int64, filters *usecase.TrackFilters) ([]*usecase.Track, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +263,51 @@ func (mr *MockUsecaseMockRecorder) GetMostRecentTracks(ctx, userID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMostRecentTracks", reflect.TypeOf((*MockUsecase)(nil).GetMostRecentTracks), ctx, userID)
 }
 
+// GetPendingDuplicateFlags mocks base method.
+func (m *MockUsecase) GetPendingDuplicateFlags(ctx context.Context, filters *usecase.TrackFilters) ([]*usecase.DuplicateFlag, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingDuplicateFlags", ctx, filters)
+	ret0, _ := ret[0].([]*usecase.DuplicateFlag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingDuplicateFlags indicates an expected call of GetPendingDuplicateFlags.
+func (mr *MockUsecaseMockRecorder) GetPendingDuplicateFlags(ctx, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDuplicateFlags", reflect.TypeOf((*MockUsecase)(nil).GetPendingDuplicateFlags), ctx, filters)
+}
+
+// GetStreamManifest mocks base method.
+func (m *MockUsecase) GetStreamManifest(ctx context.Context, streamID, userID int64) ([]*usecase.StreamVariant, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamManifest", ctx, streamID, userID)
+	ret0, _ := ret[0].([]*usecase.StreamVariant)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamManifest indicates an expected call of GetStreamManifest.
+func (mr *MockUsecaseMockRecorder) GetStreamManifest(ctx, streamID, userID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamManifest", reflect.TypeOf((*MockUsecase)(nil).GetStreamManifest), ctx, streamID, userID)
+}
+
+// GetStreamSegment mocks base method.
+func (m *MockUsecase) GetStreamSegment(ctx context.Context, request *usecase.StreamSegmentRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamSegment", ctx, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamSegment indicates an expected call of GetStreamSegment.
+func (mr *MockUsecaseMockRecorder) GetStreamSegment(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamSegment", reflect.TypeOf((*MockUsecase)(nil).GetStreamSegment), ctx, request)
+}
+
 // GetTrackByID mocks base method.
 func (m *MockUsecase) GetTrackByID(ctx context.Context, id, userID int64) (*usecase.TrackDetailed, error) {
 	m.ctrl.T.Helper()
@@ -235,6 +323,36 @@ func (mr *MockUsecaseMockRecorder) GetTrackByID(ctx, id, userID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackByID", reflect.TypeOf((*MockUsecase)(nil).GetTrackByID), ctx, id, userID)
 }
 
+// GetTrackLyrics mocks base method.
+func (m *MockUsecase) GetTrackLyrics(ctx context.Context, trackID int64) ([]*usecase.TrackLyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackLyrics", ctx, trackID)
+	ret0, _ := ret[0].([]*usecase.TrackLyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackLyrics indicates an expected call of GetTrackLyrics.
+func (mr *MockUsecaseMockRecorder) GetTrackLyrics(ctx, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackLyrics", reflect.TypeOf((*MockUsecase)(nil).GetTrackLyrics), ctx, trackID)
+}
+
+// GetTrackWaveform mocks base method.
+func (m *MockUsecase) GetTrackWaveform(ctx context.Context, trackID int64) (*usecase.TrackWaveform, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackWaveform", ctx, trackID)
+	ret0, _ := ret[0].(*usecase.TrackWaveform)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackWaveform indicates an expected call of GetTrackWaveform.
+func (mr *MockUsecaseMockRecorder) GetTrackWaveform(ctx, trackID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackWaveform", reflect.TypeOf((*MockUsecase)(nil).GetTrackWaveform), ctx, trackID)
+}
+
 // GetTracksByAlbumID mocks base method.
 func (m *MockUsecase) GetTracksByAlbumID(ctx context.Context, id, userID int64) ([]*usecase.Track, error) {
 	m.ctrl.T.Helper()
@@ -309,19 +427,105 @@ func (mr *MockUsecaseMockRecorder) LikeTrack(ctx, likeRequest any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeTrack", reflect.TypeOf((*MockUsecase)(nil).LikeTrack), ctx, likeRequest)
 }
 
+// ReadTrackMetadata mocks base method.
+func (m *MockUsecase) ReadTrackMetadata(ctx context.Context, fileKey string) (*usecase.TrackMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTrackMetadata", ctx, fileKey)
+	ret0, _ := ret[0].(*usecase.TrackMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTrackMetadata indicates an expected call of ReadTrackMetadata.
+func (mr *MockUsecaseMockRecorder) ReadTrackMetadata(ctx, fileKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTrackMetadata", reflect.TypeOf((*MockUsecase)(nil).ReadTrackMetadata), ctx, fileKey)
+}
+
+// ReplaceTrackAudio mocks base method.
+func (m *MockUsecase) ReplaceTrackAudio(ctx context.Context, replace *usecase.TrackAudioReplace) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTrackAudio", ctx, replace)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceTrackAudio indicates an expected call of ReplaceTrackAudio.
+func (mr *MockUsecaseMockRecorder) ReplaceTrackAudio(ctx, replace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTrackAudio", reflect.TypeOf((*MockUsecase)(nil).ReplaceTrackAudio), ctx, replace)
+}
+
+// ResolveDuplicateFlag mocks base method.
+func (m *MockUsecase) ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDuplicateFlag", ctx, flagID, status)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveDuplicateFlag indicates an expected call of ResolveDuplicateFlag.
+func (mr *MockUsecaseMockRecorder) ResolveDuplicateFlag(ctx, flagID, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDuplicateFlag", reflect.TypeOf((*MockUsecase)(nil).ResolveDuplicateFlag), ctx, flagID, status)
+}
+
+// SaveTrackLyrics mocks base method.
+func (m *MockUsecase) SaveTrackLyrics(ctx context.Context, upload *usecase.TrackLyricsUpload) (*usecase.TrackLyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTrackLyrics", ctx, upload)
+	ret0, _ := ret[0].(*usecase.TrackLyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTrackLyrics indicates an expected call of SaveTrackLyrics.
+func (mr *MockUsecaseMockRecorder) SaveTrackLyrics(ctx, upload any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrackLyrics", reflect.TypeOf((*MockUsecase)(nil).SaveTrackLyrics), ctx, upload)
+}
+
 // SearchTracks mocks base method.
-func (m *MockUsecase) SearchTracks(ctx context.Context, query string, userID int64) ([]*usecase.Track, error) {
+func (m *MockUsecase) SearchTracks(ctx context.Context, query string, userID int64, filters *usecase.TrackFilters) ([]*usecase.Track, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchTracks", ctx, query, userID)
+	ret := m.ctrl.Call(m, "SearchTracks", ctx, query, userID, filters)
 	ret0, _ := ret[0].([]*usecase.Track)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // SearchTracks indicates an expected call of SearchTracks.
-func (mr *MockUsecaseMockRecorder) SearchTracks(ctx, query, userID any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) SearchTracks(ctx, query, userID, filters any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockUsecase)(nil).SearchTracks), ctx, query, userID, filters)
+}
+
+// UpdateAlbumTracks mocks base method.
+func (m *MockUsecase) UpdateAlbumTracks(ctx context.Context, update *usecase.AlbumTracksUpdate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbumTracks", ctx, update)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlbumTracks indicates an expected call of UpdateAlbumTracks.
+func (mr *MockUsecaseMockRecorder) UpdateAlbumTracks(ctx, update any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracks", reflect.TypeOf((*MockUsecase)(nil).UpdateAlbumTracks), ctx, update)
+}
+
+// UpdateAlbumTracksCover mocks base method.
+func (m *MockUsecase) UpdateAlbumTracksCover(ctx context.Context, albumID int64, coverKey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbumTracksCover", ctx, albumID, coverKey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAlbumTracksCover indicates an expected call of UpdateAlbumTracksCover.
+func (mr *MockUsecaseMockRecorder) UpdateAlbumTracksCover(ctx, albumID, coverKey any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockUsecase)(nil).SearchTracks), ctx, query, userID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracksCover", reflect.TypeOf((*MockUsecase)(nil).UpdateAlbumTracksCover), ctx, albumID, coverKey)
 }
 
 // UpdateStreamDuration mocks base method.
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"time"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/domain"
+	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
+	"go.uber.org/zap"
+)
+
+type chromaprintFingerprinter struct {
+	fpcalcPath string
+	length     int64
+	metrics    *metrics.Metrics
+}
+
+// NewChromaprintFingerprinter fingerprints the first length seconds of a track with fpcalc
+func NewChromaprintFingerprinter(fpcalcPath string, length int64, metrics *metrics.Metrics) domain.Fingerprinter {
+	return &chromaprintFingerprinter{
+		fpcalcPath: fpcalcPath,
+		length:     length,
+		metrics:    metrics,
+	}
+}
+
+func (f *chromaprintFingerprinter) Fingerprint(ctx context.Context, sourcePath string) ([]int32, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, f.fpcalcPath, "-raw", "-json", "-length", strconv.FormatInt(f.length, 10), sourcePath)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		f.metrics.DatabaseErrors.WithLabelValues("Fingerprint").Inc()
+		logger.Error("fpcalc failed to fingerprint track", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to fingerprint track: %v: %s", err, lastLine(stderr.Bytes()))
+	}
+
+	fingerprint, err := parseFpcalcOutput(stdout.Bytes())
+	if err != nil {
+		f.metrics.DatabaseErrors.WithLabelValues("Fingerprint").Inc()
+		logger.Error("failed to parse fingerprint", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to parse fingerprint: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	f.metrics.DatabaseDuration.WithLabelValues("Fingerprint").Observe(duration)
+	return fingerprint, nil
+}
+
+// parseFpcalcOutput reads the raw sub-fingerprints, fpcalc prints them unsigned
+// while postgres only has signed integers
+func parseFpcalcOutput(output []byte) ([]int32, error) {
+	var result struct {
+		Fingerprint []uint32 `json:"fingerprint"`
+	}
+	if err := json.Unmarshal(output, &result); err != nil {
+		return nil, err
+	}
+	if len(result.Fingerprint) == 0 {
+		return nil, fmt.Errorf("fpcalc returned an empty fingerprint")
+	}
+
+	fingerprint := make([]int32, len(result.Fingerprint))
+	for i, value := range result.Fingerprint {
+		fingerprint[i] = int32(value)
+	}
+	return fingerprint, nil
+}
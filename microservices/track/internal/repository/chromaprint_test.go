@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFpcalcOutput(t *testing.T) {
+	fingerprint, err := parseFpcalcOutput([]byte(`{"duration": 185.03, "fingerprint": [1, 4294967295, 2147483648]}`))
+	require.NoError(t, err)
+	assert.Equal(t, []int32{1, -1, -2147483648}, fingerprint)
+}
+
+func TestParseFpcalcOutputEmpty(t *testing.T) {
+	_, err := parseFpcalcOutput([]byte(`{"duration": 0.5, "fingerprint": []}`))
+	assert.Error(t, err)
+}
+
+func TestParseFpcalcOutputInvalid(t *testing.T) {
+	_, err := parseFpcalcOutput([]byte("ERROR: Could not open the input file"))
+	assert.Error(t, err)
+}
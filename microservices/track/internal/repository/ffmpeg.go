@@ -0,0 +1,271 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/domain"
+	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
+	"go.uber.org/zap"
+)
+
+// loudnorm reports silence as -inf, it gates everything below this level anyway
+const silenceLUFS = -70.0
+
+// hlsSegmentSeconds is short enough to switch bitrates quickly on a bad network
+const hlsSegmentSeconds = 6
+
+const hlsPlaylistName = "index.m3u8"
+
+var ffmpegEncoders = map[string]string{
+	"mp3":  "libmp3lame",
+	"opus": "libopus",
+	"aac":  "aac",
+}
+
+type ffmpegTranscoder struct {
+	ffmpegPath string
+	metrics    *metrics.Metrics
+}
+
+func NewFFmpegTranscoder(ffmpegPath string, metrics *metrics.Metrics) domain.Transcoder {
+	return &ffmpegTranscoder{
+		ffmpegPath: ffmpegPath,
+		metrics:    metrics,
+	}
+}
+
+func (t *ffmpegTranscoder) run(ctx context.Context, args ...string) ([]byte, error) {
+	var stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, t.ffmpegPath, args...)
+	cmd.Stderr = &stderr
+	err := cmd.Run()
+	return stderr.Bytes(), err
+}
+
+// lastLine keeps ffmpeg errors short, the reason is always printed last
+func lastLine(output []byte) string {
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	return lines[len(lines)-1]
+}
+
+func (t *ffmpegTranscoder) Transcode(ctx context.Context, sourcePath string, profile *repoModel.RenditionProfile, destinationPath string) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	encoder, ok := ffmpegEncoders[profile.Codec]
+	if !ok {
+		t.metrics.DatabaseErrors.WithLabelValues("Transcode").Inc()
+		return trackErrors.NewInternalError("unsupported rendition codec %s", profile.Codec)
+	}
+
+	output, err := t.run(ctx,
+		"-hide_banner", "-nostdin", "-y",
+		"-i", sourcePath,
+		"-vn", "-map_metadata", "-1",
+		"-c:a", encoder,
+		"-b:a", fmt.Sprintf("%dk", profile.Bitrate),
+		"-f", profile.Format,
+		destinationPath,
+	)
+	if err != nil {
+		t.metrics.DatabaseErrors.WithLabelValues("Transcode").Inc()
+		logger.Error("ffmpeg failed to transcode track", zap.String("codec", profile.Codec), zap.Int64("bitrate", profile.Bitrate), zap.Error(err))
+		return trackErrors.NewInternalError("failed to transcode track: %v: %s", err, lastLine(output))
+	}
+
+	duration := time.Since(start).Seconds()
+	t.metrics.DatabaseDuration.WithLabelValues("Transcode").Observe(duration)
+	return nil
+}
+
+func (t *ffmpegTranscoder) MeasureLoudness(ctx context.Context, sourcePath string) (*repoModel.Loudness, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	output, err := t.run(ctx,
+		"-hide_banner", "-nostdin", "-nostats",
+		"-i", sourcePath,
+		"-vn", "-af", "loudnorm=print_format=json",
+		"-f", "null", "-",
+	)
+	if err != nil {
+		t.metrics.DatabaseErrors.WithLabelValues("MeasureLoudness").Inc()
+		logger.Error("ffmpeg failed to measure loudness", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to measure loudness: %v: %s", err, lastLine(output))
+	}
+
+	loudness, err := parseLoudnormOutput(output)
+	if err != nil {
+		t.metrics.DatabaseErrors.WithLabelValues("MeasureLoudness").Inc()
+		logger.Error("failed to parse loudness", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to parse loudness: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	t.metrics.DatabaseDuration.WithLabelValues("MeasureLoudness").Observe(duration)
+	return loudness, nil
+}
+
+// Segment writes the variant as mpeg-ts segments into destinationDir, the playlist
+// ffmpeg writes next to them tells how long every segment is
+func (t *ffmpegTranscoder) Segment(ctx context.Context, sourcePath string, profile *repoModel.RenditionProfile, destinationDir string) ([]*repoModel.HLSSegment, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	encoder, ok := ffmpegEncoders[profile.Codec]
+	if !ok {
+		t.metrics.DatabaseErrors.WithLabelValues("Segment").Inc()
+		return nil, trackErrors.NewInternalError("unsupported segment codec %s", profile.Codec)
+	}
+
+	playlistPath := filepath.Join(destinationDir, hlsPlaylistName)
+	output, err := t.run(ctx,
+		"-hide_banner", "-nostdin", "-y",
+		"-i", sourcePath,
+		"-vn", "-map_metadata", "-1",
+		"-c:a", encoder,
+		"-b:a", fmt.Sprintf("%dk", profile.Bitrate),
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(hlsSegmentSeconds),
+		"-hls_playlist_type", "vod",
+		"-hls_segment_type", "mpegts",
+		"-hls_segment_filename", filepath.Join(destinationDir, "%05d."+profile.Extension),
+		playlistPath,
+	)
+	if err != nil {
+		t.metrics.DatabaseErrors.WithLabelValues("Segment").Inc()
+		logger.Error("ffmpeg failed to segment track", zap.String("codec", profile.Codec), zap.Int64("bitrate", profile.Bitrate), zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to segment track: %v: %s", err, lastLine(output))
+	}
+
+	playlist, err := os.ReadFile(playlistPath)
+	if err != nil {
+		t.metrics.DatabaseErrors.WithLabelValues("Segment").Inc()
+		logger.Error("failed to read hls playlist", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to read hls playlist: %v", err)
+	}
+
+	segments, err := parseMediaPlaylist(playlist, destinationDir)
+	if err != nil {
+		t.metrics.DatabaseErrors.WithLabelValues("Segment").Inc()
+		logger.Error("failed to parse hls playlist", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to parse hls playlist: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	t.metrics.DatabaseDuration.WithLabelValues("Segment").Observe(duration)
+	return segments, nil
+}
+
+// DecodePCM writes the track as raw 16 bit little endian mono samples, a low sample
+// rate keeps the analysis of long tracks cheap
+func (t *ffmpegTranscoder) DecodePCM(ctx context.Context, sourcePath string, sampleRate int64, destinationPath string) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	output, err := t.run(ctx,
+		"-hide_banner", "-nostdin", "-y",
+		"-i", sourcePath,
+		"-vn", "-map_metadata", "-1",
+		"-ac", "1",
+		"-ar", strconv.FormatInt(sampleRate, 10),
+		"-c:a", "pcm_s16le",
+		"-f", "s16le",
+		destinationPath,
+	)
+	if err != nil {
+		t.metrics.DatabaseErrors.WithLabelValues("DecodePCM").Inc()
+		logger.Error("ffmpeg failed to decode track", zap.Error(err))
+		return trackErrors.NewInternalError("failed to decode track: %v: %s", err, lastLine(output))
+	}
+
+	duration := time.Since(start).Seconds()
+	t.metrics.DatabaseDuration.WithLabelValues("DecodePCM").Observe(duration)
+	return nil
+}
+
+// parseMediaPlaylist pairs every #EXTINF tag with the segment uri on the line after it
+func parseMediaPlaylist(playlist []byte, segmentDir string) ([]*repoModel.HLSSegment, error) {
+	segments := make([]*repoModel.HLSSegment, 0)
+	var pending *repoModel.HLSSegment
+	for _, line := range strings.Split(string(playlist), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasPrefix(line, "#EXTINF:"):
+			value, _, _ := strings.Cut(strings.TrimPrefix(line, "#EXTINF:"), ",")
+			duration, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("broken segment duration %q", value)
+			}
+			pending = &repoModel.HLSSegment{Position: int64(len(segments)), Duration: duration}
+		case strings.HasPrefix(line, "#"):
+		default:
+			if pending == nil {
+				return nil, fmt.Errorf("segment %s has no duration", line)
+			}
+			pending.Path = filepath.Join(segmentDir, filepath.Base(line))
+			segments = append(segments, pending)
+			pending = nil
+		}
+	}
+
+	if len(segments) == 0 {
+		return nil, fmt.Errorf("hls playlist has no segments")
+	}
+	return segments, nil
+}
+
+// parseLoudnormOutput reads the json summary loudnorm prints after the rest of the ffmpeg log
+func parseLoudnormOutput(output []byte) (*repoModel.Loudness, error) {
+	begin := bytes.LastIndexByte(output, '{')
+	end := bytes.LastIndexByte(output, '}')
+	if begin == -1 || end < begin {
+		return nil, fmt.Errorf("no loudnorm summary in ffmpeg output")
+	}
+
+	var summary struct {
+		InputI  string `json:"input_i"`
+		InputTP string `json:"input_tp"`
+	}
+	if err := json.Unmarshal(output[begin:end+1], &summary); err != nil {
+		return nil, err
+	}
+
+	integrated, err := parseLoudnessValue(summary.InputI)
+	if err != nil {
+		return nil, err
+	}
+	truePeak, err := parseLoudnessValue(summary.InputTP)
+	if err != nil {
+		return nil, err
+	}
+	return &repoModel.Loudness{
+		IntegratedLUFS: integrated,
+		TruePeakDB:     truePeak,
+	}, nil
+}
+
+func parseLoudnessValue(value string) (float64, error) {
+	parsed, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0, err
+	}
+	if math.IsInf(parsed, 0) || parsed < silenceLUFS {
+		return silenceLUFS, nil
+	}
+	return parsed, nil
+}
@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"testing"
+
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const loudnormOutput = `Input #0, flac, from 'source.flac':
+  Duration: 00:03:05.00, start: 0.000000, bitrate: 1015 kb/s
+  Stream #0:0: Audio: flac, 44100 Hz, stereo, s16
+[Parsed_loudnorm_0 @ 0x55d1c2a4e2c0]
+{
+	"input_i" : "-10.47",
+	"input_tp" : "-0.31",
+	"input_lra" : "6.20",
+	"input_thresh" : "-20.63",
+	"output_i" : "-24.10",
+	"output_tp" : "-13.95",
+	"output_lra" : "5.90",
+	"output_thresh" : "-34.24",
+	"normalization_type" : "dynamic",
+	"target_offset" : "0.10"
+}
+`
+
+func TestParseLoudnormOutput(t *testing.T) {
+	loudness, err := parseLoudnormOutput([]byte(loudnormOutput))
+	require.NoError(t, err)
+	assert.Equal(t, &repoModel.Loudness{IntegratedLUFS: -10.47, TruePeakDB: -0.31}, loudness)
+}
+
+func TestParseLoudnormOutputSilence(t *testing.T) {
+	loudness, err := parseLoudnormOutput([]byte(`{"input_i" : "-inf", "input_tp" : "-inf"}`))
+	require.NoError(t, err)
+	assert.Equal(t, &repoModel.Loudness{IntegratedLUFS: silenceLUFS, TruePeakDB: silenceLUFS}, loudness)
+}
+
+func TestParseLoudnormOutputMissingSummary(t *testing.T) {
+	_, err := parseLoudnormOutput([]byte("source.flac: Invalid data found when processing input"))
+	assert.Error(t, err)
+}
+
+const mediaPlaylist = `#EXTM3U
+#EXT-X-VERSION:3
+#EXT-X-TARGETDURATION:6
+#EXT-X-MEDIA-SEQUENCE:0
+#EXT-X-PLAYLIST-TYPE:VOD
+#EXTINF:6.013333,
+00000.ts
+#EXTINF:2.480000,
+00001.ts
+#EXT-X-ENDLIST
+`
+
+func TestParseMediaPlaylist(t *testing.T) {
+	segments, err := parseMediaPlaylist([]byte(mediaPlaylist), "/tmp/hls-aac-128")
+	require.NoError(t, err)
+	assert.Equal(t, []*repoModel.HLSSegment{
+		{Position: 0, Duration: 6.013333, Path: "/tmp/hls-aac-128/00000.ts"},
+		{Position: 1, Duration: 2.48, Path: "/tmp/hls-aac-128/00001.ts"},
+	}, segments)
+}
+
+func TestParseMediaPlaylistWithoutDuration(t *testing.T) {
+	_, err := parseMediaPlaylist([]byte("#EXTM3U\n00000.ts\n"), "/tmp")
+	assert.Error(t, err)
+}
+
+func TestParseMediaPlaylistEmpty(t *testing.T) {
+	_, err := parseMediaPlaylist([]byte("#EXTM3U\n#EXT-X-ENDLIST\n"), "/tmp")
+	assert.Error(t, err)
+}
+
+func TestLastLine(t *testing.T) {
+	assert.Equal(t, "source.flac: Invalid data found when processing input", lastLine([]byte("ffmpeg version 6.1\nsource.flac: Invalid data found when processing input\n")))
+}
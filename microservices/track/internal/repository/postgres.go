@@ -18,24 +18,33 @@ import (
 )
 
 const (
+	// Catalog queries only see tracks of published albums
 	GetAllTracksQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN track_stats ts ON t.id = ts.track_id
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $3
 		ORDER BY ts.listeners_count DESC, t.id DESC
 		LIMIT $1 OFFSET $2
 	`
 	GetTrackByIDQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, t.file_url, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), t.file_url, (ft.user_id IS NOT NULL) AS is_favorite,
+			COALESCE(t.loudness_lufs, 0), COALESCE(t.replay_gain_db, 0), COALESCE(t.musical_key, ''),
+			t.lyrics_text IS NOT NULL
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $2
 		WHERE t.id = $1
 	`
 
+	// unpublished tracks can't be streamed, nothing is inserted for them
 	CreateStreamQuery = `
-		INSERT INTO track_stream (track_id, user_id) 
-		VALUES ($1, $2)
+		INSERT INTO track_stream (track_id, user_id)
+		SELECT t.id, $2
+		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		WHERE t.id = $1
 		RETURNING id
 	`
 
@@ -65,15 +74,17 @@ const (
 	`
 
 	GetTracksByIDsQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $2
 		WHERE t.id = ANY($1)
 	`
 
 	GetTracksByIDsFilteredQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		JOIN track_stats ts ON t.id = ts.track_id
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $4
 		WHERE t.id = ANY($1)
@@ -88,8 +99,9 @@ const (
 	`
 
 	GetTracksByAlbumIDQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $2
 		WHERE t.album_id = $1
 		ORDER BY position ASC
@@ -108,7 +120,12 @@ const (
 	`
 
 	CheckTrackExistsQuery = `
-		SELECT EXISTS(SELECT 1 FROM track WHERE id = $1)
+		SELECT EXISTS(
+			SELECT 1
+			FROM track t
+			JOIN album a ON a.id = t.album_id AND a.status = 'published'
+			WHERE t.id = $1
+		)
 	`
 
 	LikeTrackQuery = `
@@ -120,8 +137,9 @@ const (
 	`
 
 	GetFavoriteTracksQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft_req.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft_req.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		JOIN favorite_track ft_prof ON t.id = ft_prof.track_id
 		LEFT JOIN favorite_track ft_req ON t.id = ft_req.track_id AND ft_req.user_id = $1
 		WHERE ft_prof.user_id = $2
@@ -129,29 +147,18 @@ const (
 		LIMIT $3 OFFSET $4
 	`
 
-	SearchTracksQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
-		FROM track t
-		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $2
-		WHERE t.search_vector @@ to_tsquery('multilingual', $1)
-		   OR similarity(t.title_trgm, $3) > 0.3
-		ORDER BY 
-		    CASE WHEN t.search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
-		    ts_rank(t.search_vector, to_tsquery('multilingual', $1)) DESC,
-		    similarity(t.title_trgm, $3) DESC
-	`
-
 	AddTracksToAlbumQuery = `
-		INSERT INTO track (title, thumbnail_url, duration, album_id, file_url)
-		VALUES ($1, $2, $3, $4, $5)
+		INSERT INTO track (title, thumbnail_url, thumbnail_color, duration, album_id, file_url)
+		VALUES ($1, $2, $3, $4, $5, $6)
 	`
 	DeleteTracksByAlbumIDQuery = `
 		DELETE FROM track
 		WHERE album_id = $1
 `
 	GetMostLikedTracksQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN track_stats ts ON t.id = ts.track_id
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $1
 		ORDER BY ts.favorites_count DESC, t.id DESC
@@ -159,16 +166,18 @@ const (
 	`
 
 	GetMostRecentTracksQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $1
 		ORDER BY t.created_at DESC, t.id DESC
 		LIMIT 20
 	`
 
 	GetMostListenedLastMonthTracksQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN track_stats ts ON t.id = ts.track_id
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $1
 		ORDER BY ts.listeners_count_last_month DESC, t.id DESC
@@ -176,13 +185,289 @@ const (
 	`
 
 	GetMostLikedLastWeekTracksQuery = `
-		SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, (ft.user_id IS NOT NULL) AS is_favorite
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), (ft.user_id IS NOT NULL) AS is_favorite
 		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
 		LEFT JOIN track_stats ts ON t.id = ts.track_id
 		LEFT JOIN favorite_track ft ON t.id = ft.track_id AND ft.user_id = $1
 		ORDER BY ts.favorites_count_last_week DESC, t.id DESC
 		LIMIT 20
 	`
+
+	// Labels see the tracks of their albums in every status
+	GetLabelTracksByAlbumIDQuery = `
+		SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE(t.bpm, 0), FALSE AS is_favorite
+		FROM track t
+		WHERE t.album_id = $1
+		ORDER BY t.position ASC
+	`
+
+	// Locks the album so it can't leave the draft status while its tracks are edited
+	GetAlbumStatusForUpdateQuery = `
+		SELECT status
+		FROM album
+		WHERE id = $1
+		FOR SHARE
+	`
+
+	// Positions are unique within an album, so they are moved out of the way before being reassigned
+	HideAlbumTrackPositionsQuery = `
+		UPDATE track
+		SET position = -position
+		WHERE album_id = $1
+	`
+
+	UpdateAlbumTrackQuery = `
+		UPDATE track
+		SET title = $3, position = $4, updated_at = NOW()
+		WHERE id = $1 AND album_id = $2 AND position < 0
+	`
+
+	CheckAlbumTracksHiddenQuery = `
+		SELECT EXISTS(SELECT 1 FROM track WHERE album_id = $1 AND position < 0)
+	`
+
+	LockAlbumTrackPositionsQuery = `
+		SELECT id, position
+		FROM track
+		WHERE album_id = $1
+		FOR UPDATE
+	`
+
+	// Moves the track from position $3 to $4 and shifts the tracks in between by one,
+	// the new positions are negative until RestoreAlbumTrackPositionsQuery to keep them unique
+	MoveAlbumTrackQuery = `
+		UPDATE track
+		SET position = -(CASE
+			WHEN id = $2 THEN $4::int
+			WHEN position BETWEEN LEAST($3::int, $4::int) AND GREATEST($3::int, $4::int) THEN position + SIGN($3::int - $4::int)
+			ELSE position
+		END)
+		WHERE album_id = $1
+	`
+
+	RestoreAlbumTrackPositionsQuery = `
+		UPDATE track
+		SET position = -position, updated_at = NOW()
+		WHERE album_id = $1 AND position < 0
+	`
+
+	UpdateTrackTitleQuery = `
+		UPDATE track
+		SET title = $2, updated_at = NOW()
+		WHERE id = $1
+	`
+
+	// the renditions of the previous file stay hidden until the new one is transcoded
+	ReplaceTrackFileQuery = `
+		UPDATE track
+		SET file_url = $3, duration = $4, source_format = $5, transcode_status = 'pending', transcode_attempts = 0,
+			transcode_started_at = NULL, loudness_lufs = NULL, true_peak_db = NULL, replay_gain_db = NULL, bpm = NULL, musical_key = NULL,
+			updated_at = NOW()
+		WHERE id = $1 AND album_id = $2
+	`
+
+	UpdateAlbumTracksThumbnailQuery = `
+		UPDATE track
+		SET thumbnail_url = $2, thumbnail_color = $3, updated_at = NOW()
+		WHERE album_id = $1
+	`
+
+	GetTrackRenditionsQuery = `
+		SELECT r.codec, r.bitrate, r.file_key
+		FROM track_rendition r
+		JOIN track t ON t.id = r.track_id AND t.transcode_status = 'ready'
+		WHERE r.track_id = $1
+		ORDER BY r.codec, r.bitrate
+	`
+
+	// a job left in processing for too long belongs to a worker that died
+	ClaimTranscodeJobQuery = `
+		UPDATE track
+		SET transcode_status = 'processing', transcode_attempts = transcode_attempts + 1, transcode_started_at = NOW()
+		WHERE id = (
+			SELECT id
+			FROM track
+			WHERE transcode_status = 'pending'
+				OR (transcode_status = 'processing' AND transcode_started_at < NOW() - $1 * INTERVAL '1 second')
+			ORDER BY created_at, id
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING id, file_url, source_format, transcode_attempts
+	`
+
+	FinishTranscodeJobQuery = `
+		UPDATE track
+		SET transcode_status = 'ready', loudness_lufs = $3, true_peak_db = $4, replay_gain_db = $5,
+			bpm = NULLIF($6::real, 0), musical_key = NULLIF($7::text, ''), updated_at = NOW()
+		WHERE id = $1 AND file_url = $2 AND transcode_status = 'processing'
+	`
+
+	SaveTrackWaveformQuery = `
+		INSERT INTO track_waveform (track_id, peaks)
+		VALUES ($1, $2)
+		ON CONFLICT (track_id) DO UPDATE
+		SET peaks = EXCLUDED.peaks, updated_at = NOW()
+	`
+
+	// the waveform of a replaced file stays hidden until the new one is analysed
+	GetTrackWaveformQuery = `
+		SELECT t.duration, w.peaks
+		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		LEFT JOIN track_waveform w ON w.track_id = t.id AND t.transcode_status = 'ready'
+		WHERE t.id = $1
+	`
+
+	SaveTrackLyricsQuery = `
+		INSERT INTO track_lyrics (track_id, language, is_translation, lines, line_times)
+		SELECT t.id, $3, $4, $5, $6
+		FROM track t
+		WHERE t.id = $1 AND t.album_id = $2
+		ON CONFLICT (track_id, language) DO UPDATE
+		SET is_translation = EXCLUDED.is_translation, lines = EXCLUDED.lines, line_times = EXCLUDED.line_times, updated_at = NOW()
+	`
+
+	DeleteTrackLyricsQuery = `
+		DELETE FROM track_lyrics l
+		USING track t
+		WHERE l.track_id = t.id AND t.id = $1 AND t.album_id = $2 AND l.language = $3
+	`
+
+	// originals go before translations so that search ranks them the same way listeners read them
+	UpdateTrackLyricsTextQuery = `
+		UPDATE track
+		SET lyrics_text = (
+			SELECT string_agg(array_to_string(l.lines, E'\n'), E'\n' ORDER BY l.is_translation, l.language)
+			FROM track_lyrics l
+			WHERE l.track_id = $1
+		), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	GetTrackLyricsQuery = `
+		SELECT l.language, l.is_translation, l.lines, l.line_times
+		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		LEFT JOIN track_lyrics l ON l.track_id = t.id
+		WHERE t.id = $1
+		ORDER BY l.is_translation, l.language
+	`
+
+	DeleteTrackRenditionsQuery = `
+		DELETE FROM track_rendition
+		WHERE track_id = $1
+	`
+
+	AddTrackRenditionsQuery = `
+		INSERT INTO track_rendition (track_id, codec, bitrate, file_key)
+		SELECT $1, codec, bitrate, file_key
+		FROM unnest($2::text[], $3::int[], $4::text[]) AS r(codec, bitrate, file_key)
+	`
+
+	DeleteTrackSegmentsQuery = `
+		DELETE FROM track_segment
+		WHERE track_id = $1
+	`
+
+	AddTrackSegmentsQuery = `
+		INSERT INTO track_segment (track_id, codec, bitrate, position, duration, file_key)
+		SELECT $1, codec, bitrate, position, duration, file_key
+		FROM unnest($2::text[], $3::int[], $4::int[], $5::real[], $6::text[]) AS s(codec, bitrate, position, duration, file_key)
+	`
+
+	GetTrackSegmentsQuery = `
+		SELECT s.codec, s.bitrate, s.position, s.duration, s.file_key
+		FROM track_segment s
+		JOIN track t ON t.id = s.track_id AND t.transcode_status = 'ready'
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		WHERE s.track_id = $1
+		ORDER BY s.bitrate, s.position
+	`
+
+	GetTrackSegmentQuery = `
+		SELECT s.codec, s.bitrate, s.position, s.duration, s.file_key
+		FROM track_segment s
+		JOIN track t ON t.id = s.track_id AND t.transcode_status = 'ready'
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		WHERE s.track_id = $1 AND s.bitrate = $2 AND s.position = $3
+	`
+
+	// the stream lasts as long as the distinct segments it fetched, refetches and
+	// bitrate switches do not count twice
+	RecordStreamSegmentQuery = `
+		WITH fetched AS (
+			INSERT INTO track_stream_segment (stream_id, position, duration)
+			VALUES ($1, $2, $3)
+			ON CONFLICT (stream_id, position) DO NOTHING
+			RETURNING duration
+		)
+		UPDATE track_stream
+		SET duration = (
+			SELECT ROUND(COALESCE(SUM(duration), 0) + COALESCE((SELECT duration FROM fetched), 0))
+			FROM track_stream_segment
+			WHERE stream_id = $1
+		), updated_at = NOW()
+		WHERE id = $1
+	`
+
+	// candidates are ordered by how many exact sub-fingerprints they share with the upload,
+	// the similarity itself is scored by the caller
+	FindFingerprintCandidatesQuery = `
+		SELECT t.id, t.title, a.id, a.title, COALESCE(a.label_id, 0), f.fingerprint
+		FROM track_fingerprint f
+		JOIN track t ON t.id = f.track_id
+		JOIN album a ON a.id = t.album_id
+		WHERE f.fingerprint && $1::int[] AND f.track_id <> $2
+		ORDER BY (SELECT COUNT(*) FROM unnest(f.fingerprint) AS v WHERE v = ANY($1::int[])) DESC, f.track_id
+		LIMIT $3
+	`
+
+	UpsertTrackFingerprintQuery = `
+		INSERT INTO track_fingerprint (track_id, fingerprint)
+		VALUES ($1, $2)
+		ON CONFLICT (track_id) DO UPDATE
+		SET fingerprint = EXCLUDED.fingerprint, updated_at = NOW()
+	`
+
+	DeletePendingDuplicateFlagsQuery = `
+		DELETE FROM track_duplicate_flag
+		WHERE track_id = $1 AND status = 'pending'
+	`
+
+	// a pair an admin already reviewed is not brought up again
+	AddDuplicateFlagsQuery = `
+		INSERT INTO track_duplicate_flag (track_id, matched_track_id, similarity)
+		SELECT $1, matched_track_id, similarity
+		FROM unnest($2::bigint[], $3::real[]) AS d(matched_track_id, similarity)
+		ON CONFLICT (track_id, matched_track_id) DO NOTHING
+	`
+
+	GetPendingDuplicateFlagsQuery = `
+		SELECT d.id, t.id, t.title, ta.id, COALESCE(ta.label_id, 0), m.id, m.title, ma.id, COALESCE(ma.label_id, 0),
+			d.similarity, d.status, d.created_at
+		FROM track_duplicate_flag d
+		JOIN track t ON t.id = d.track_id
+		JOIN album ta ON ta.id = t.album_id
+		JOIN track m ON m.id = d.matched_track_id
+		JOIN album ma ON ma.id = m.album_id
+		WHERE d.status = 'pending'
+		ORDER BY d.created_at, d.id
+		LIMIT $1 OFFSET $2
+	`
+
+	ResolveDuplicateFlagQuery = `
+		UPDATE track_duplicate_flag
+		SET status = $2, reviewed_at = NOW()
+		WHERE id = $1 AND status = 'pending'
+	`
+
+	FailTranscodeJobQuery = `
+		UPDATE track
+		SET transcode_status = CASE WHEN transcode_attempts >= $3 THEN 'failed' ELSE 'pending' END, updated_at = NOW()
+		WHERE id = $1 AND file_url = $2 AND transcode_status = 'processing'
+	`
 )
 
 type TrackPostgresRepository struct {
@@ -227,7 +512,7 @@ func (r *TrackPostgresRepository) GetAllTracks(ctx context.Context, filters *rep
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetAllTracks").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -259,7 +544,7 @@ func (r *TrackPostgresRepository) GetTrackByID(ctx context.Context, id int64, us
 	}
 
 	var trackObject repoModel.TrackWithFileKey
-	err = stmt.QueryRowContext(ctx, id, userID).Scan(&trackObject.ID, &trackObject.Title, &trackObject.Thumbnail, &trackObject.Duration, &trackObject.AlbumID, &trackObject.FileKey, &trackObject.IsFavorite)
+	err = stmt.QueryRowContext(ctx, id, userID).Scan(&trackObject.ID, &trackObject.Title, &trackObject.Thumbnail, &trackObject.ThumbnailColor, &trackObject.Duration, &trackObject.AlbumID, &trackObject.BPM, &trackObject.FileKey, &trackObject.IsFavorite, &trackObject.LoudnessLUFS, &trackObject.ReplayGainDB, &trackObject.Key, &trackObject.HasLyrics)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("GetTrackByID").Inc()
 		if errors.Is(err, sql.ErrNoRows) {
@@ -294,6 +579,9 @@ func (r *TrackPostgresRepository) CreateStream(ctx context.Context, createData *
 	var streamID int64
 	err = stmt.QueryRowContext(ctx, createData.TrackID, createData.UserID).Scan(&streamID)
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, trackErrors.ErrTrackNotFound
+		}
 		r.metrics.DatabaseErrors.WithLabelValues("CreateStream").Inc()
 		logger.Error("failed to create stream", zap.Error(err))
 		return 0, trackErrors.NewInternalError("failed to create stream: %v", err)
@@ -461,7 +749,7 @@ func (r *TrackPostgresRepository) GetTracksByIDs(ctx context.Context, ids []int6
 	tracks := make(map[int64]*repoModel.Track)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetTracksByIDs").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -527,7 +815,7 @@ func (r *TrackPostgresRepository) GetTracksByIDsFiltered(ctx context.Context, id
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetTracksByIDsFiltered").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -607,7 +895,7 @@ func (r *TrackPostgresRepository) GetTracksByAlbumID(ctx context.Context, id int
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetTracksByAlbumID").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -799,7 +1087,7 @@ func (r *TrackPostgresRepository) GetFavoriteTracks(ctx context.Context, favorit
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetFavoriteTracks").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -818,66 +1106,7 @@ func (r *TrackPostgresRepository) GetFavoriteTracks(ctx context.Context, favorit
 	return tracks, nil
 }
 
-func (r *TrackPostgresRepository) SearchTracks(ctx context.Context, query string, userID int64) ([]*repoModel.Track, error) {
-	logger := loggerPkg.LoggerFromContext(ctx)
-	logger.Info("Searching tracks in db", zap.String("search query", query), zap.String("query", SearchTracksQuery))
-
-	start := time.Now()
-
-	stmt, err := r.db.PrepareContext(ctx, SearchTracksQuery)
-	if err != nil {
-		r.metrics.DatabaseErrors.WithLabelValues("SearchTracks").Inc()
-		logger.Error("failed to prepare statement", zap.Error(err))
-		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
-	}
-	defer func() {
-		if err := stmt.Close(); err != nil {
-			logger.Error("Error closing statement:", zap.Error(err))
-		}
-	}()
-
-	words := strings.Fields(query)
-	for i, word := range words {
-		words[i] = word + ":*"
-	}
-	tsQueryString := strings.Join(words, " & ")
-
-	rows, err := stmt.QueryContext(ctx, tsQueryString, userID, query)
-	if err != nil {
-		r.metrics.DatabaseErrors.WithLabelValues("SearchTracks").Inc()
-		logger.Error("failed to search tracks", zap.Error(err))
-		return nil, trackErrors.NewInternalError("failed to search tracks: %v", err)
-	}
-	defer func() {
-		if err := rows.Close(); err != nil {
-			logger.Error("Error closing rows:", zap.Error(err))
-		}
-	}()
-
-	var tracks []*repoModel.Track
-	for rows.Next() {
-		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
-		if err != nil {
-			r.metrics.DatabaseErrors.WithLabelValues("SearchTracks").Inc()
-			logger.Error("failed to scan track", zap.Error(err))
-			return nil, trackErrors.NewInternalError("failed to scan track: %v", err)
-		}
-		tracks = append(tracks, &track)
-	}
-
-	if err := rows.Err(); err != nil {
-		r.metrics.DatabaseErrors.WithLabelValues("SearchTracks").Inc()
-		logger.Error("failed to search tracks", zap.Error(err))
-		return nil, trackErrors.NewInternalError("failed to search tracks: %v", err)
-	}
-	duration := time.Since(start).Seconds()
-	r.metrics.DatabaseDuration.WithLabelValues("SearchTracks").Observe(duration)
-
-	return tracks, nil
-}
-
-func (r *TrackPostgresRepository) AddTracksToAlbum(ctx context.Context, tracksList []*repoModel.Track) ([]int64, error) {
+func (r *TrackPostgresRepository) AddTracksToAlbum(ctx context.Context, tracksList []*repoModel.TrackWithFileKey) ([]int64, error) {
 	logger := loggerPkg.LoggerFromContext(ctx)
 	logger.Info("Adding tracks to album in db", zap.Any("tracksList", tracksList))
 
@@ -891,23 +1120,26 @@ func (r *TrackPostgresRepository) AddTracksToAlbum(ctx context.Context, tracksLi
 	}
 
 	valueStrings := make([]string, 0, len(tracksList))
-	valueArgs := make([]interface{}, 0, len(tracksList)*6)
+	valueArgs := make([]interface{}, 0, len(tracksList)*8)
 
 	for i, track := range tracksList {
-		baseIndex := i * 6
-		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d)",
-			baseIndex+1, baseIndex+2, baseIndex+3, baseIndex+4, baseIndex+5, baseIndex+6))
+		baseIndex := i * 8
+		valueStrings = append(valueStrings, fmt.Sprintf("($%d, $%d, $%d, $%d, $%d, $%d, $%d, $%d)",
+			baseIndex+1, baseIndex+2, baseIndex+3, baseIndex+4, baseIndex+5, baseIndex+6, baseIndex+7, baseIndex+8))
 
 		valueArgs = append(valueArgs,
 			track.Title,
 			track.Thumbnail,
+			track.ThumbnailColor,
 			track.Duration,
 			track.AlbumID,
-			fmt.Sprintf("%s.mp3", track.Title),
+			track.FileKey,
+			track.SourceFormat,
 			i+1)
 	}
 
-	stmt := fmt.Sprintf("INSERT INTO track (title, thumbnail_url, duration, album_id, file_url, position) VALUES %s RETURNING id",
+	// new tracks are queued for transcoding by the column default
+	stmt := fmt.Sprintf("INSERT INTO track (title, thumbnail_url, thumbnail_color, duration, album_id, file_url, source_format, position) VALUES %s RETURNING id",
 		strings.Join(valueStrings, ","))
 
 	rows, err := tx.QueryContext(ctx, stmt, valueArgs...)
@@ -1017,7 +1249,7 @@ func (r *TrackPostgresRepository) GetMostLikedTracks(ctx context.Context, userID
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetMostLikedTracks").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -1055,7 +1287,7 @@ func (r *TrackPostgresRepository) GetMostRecentTracks(ctx context.Context, userI
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetMostRecentTracks").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -1093,7 +1325,7 @@ func (r *TrackPostgresRepository) GetMostListenedLastMonthTracks(ctx context.Con
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetMostListenedLastMonthTracks").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -1131,7 +1363,7 @@ func (r *TrackPostgresRepository) GetMostLikedLastWeekTracks(ctx context.Context
 	tracks := make([]*repoModel.Track, 0)
 	for rows.Next() {
 		var track repoModel.Track
-		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.Duration, &track.AlbumID, &track.IsFavorite)
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
 		if err != nil {
 			r.metrics.DatabaseErrors.WithLabelValues("GetMostLikedLastWeekTracks").Inc()
 			logger.Error("failed to scan track", zap.Error(err))
@@ -1149,3 +1381,1015 @@ func (r *TrackPostgresRepository) GetMostLikedLastWeekTracks(ctx context.Context
 	r.metrics.DatabaseDuration.WithLabelValues("GetMostLikedLastWeekTracks").Observe(duration)
 	return tracks, nil
 }
+
+func (r *TrackPostgresRepository) GetLabelTracksByAlbumID(ctx context.Context, albumID int64) ([]*repoModel.Track, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting label tracks by album id from db", zap.Int64("albumID", albumID), zap.String("query", GetLabelTracksByAlbumIDQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetLabelTracksByAlbumIDQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetLabelTracksByAlbumID").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("Error closing statement:", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, albumID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetLabelTracksByAlbumID").Inc()
+		logger.Error("failed to get label tracks by album id", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get label tracks by album id: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("Error closing rows:", zap.Error(err))
+		}
+	}()
+
+	tracks := make([]*repoModel.Track, 0)
+	for rows.Next() {
+		var track repoModel.Track
+		err := rows.Scan(&track.ID, &track.Title, &track.Thumbnail, &track.ThumbnailColor, &track.Duration, &track.AlbumID, &track.BPM, &track.IsFavorite)
+		if err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetLabelTracksByAlbumID").Inc()
+			logger.Error("failed to scan track", zap.Error(err))
+			return nil, trackErrors.NewInternalError("failed to scan track: %v", err)
+		}
+		tracks = append(tracks, &track)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetLabelTracksByAlbumID").Inc()
+		logger.Error("failed to iterate over result rows", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to iterate over result rows: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetLabelTracksByAlbumID").Observe(duration)
+	return tracks, nil
+}
+
+func (r *TrackPostgresRepository) UpdateAlbumTracks(ctx context.Context, update *repoModel.AlbumTracksUpdate) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Updating album tracks in db", zap.Any("update", update))
+
+	start := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracks").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	rollback := func() {
+		if err := tx.Rollback(); err != nil {
+			logger.Error("failed to rollback transaction", zap.Error(err))
+		}
+	}
+
+	var status string
+	err = tx.QueryRowContext(ctx, GetAlbumStatusForUpdateQuery, update.AlbumID).Scan(&status)
+	if err != nil {
+		rollback()
+		if errors.Is(err, sql.ErrNoRows) {
+			return trackErrors.NewNotFoundError("album not found")
+		}
+		r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracks").Inc()
+		logger.Error("failed to get album status", zap.Error(err))
+		return trackErrors.NewInternalError("failed to get album status: %v", err)
+	}
+	if status != "draft" {
+		rollback()
+		return trackErrors.ErrAlbumNotDraft
+	}
+
+	if _, err := tx.ExecContext(ctx, HideAlbumTrackPositionsQuery, update.AlbumID); err != nil {
+		rollback()
+		r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracks").Inc()
+		logger.Error("failed to hide track positions", zap.Error(err))
+		return trackErrors.NewInternalError("failed to hide track positions: %v", err)
+	}
+
+	for _, track := range update.Tracks {
+		result, err := tx.ExecContext(ctx, UpdateAlbumTrackQuery, track.ID, update.AlbumID, track.Title, track.Position)
+		if err != nil {
+			rollback()
+			r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracks").Inc()
+			logger.Error("failed to update track", zap.Error(err))
+			return trackErrors.NewInternalError("failed to update track: %v", err)
+		}
+		affected, err := result.RowsAffected()
+		if err != nil {
+			rollback()
+			r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracks").Inc()
+			logger.Error("failed to get affected rows", zap.Error(err))
+			return trackErrors.NewInternalError("failed to get affected rows: %v", err)
+		}
+		// The track is from another album or was listed twice
+		if affected == 0 {
+			rollback()
+			return trackErrors.ErrAlbumTracksInvalid
+		}
+	}
+
+	var hidden bool
+	if err := tx.QueryRowContext(ctx, CheckAlbumTracksHiddenQuery, update.AlbumID).Scan(&hidden); err != nil {
+		rollback()
+		r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracks").Inc()
+		logger.Error("failed to check album tracks", zap.Error(err))
+		return trackErrors.NewInternalError("failed to check album tracks: %v", err)
+	}
+	if hidden {
+		rollback()
+		return trackErrors.ErrAlbumTracksInvalid
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracks").Inc()
+		logger.Error("failed to commit transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to commit transaction: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("UpdateAlbumTracks").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) EditTrack(ctx context.Context, edit *repoModel.TrackEdit) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Editing track in db", zap.Any("edit", edit))
+
+	start := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("EditTrack").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	rollback := func() {
+		if err := tx.Rollback(); err != nil {
+			logger.Error("failed to rollback transaction", zap.Error(err))
+		}
+	}
+	fail := func(message string, err error) error {
+		rollback()
+		r.metrics.DatabaseErrors.WithLabelValues("EditTrack").Inc()
+		logger.Error(message, zap.Error(err))
+		return trackErrors.NewInternalError("%s: %v", message, err)
+	}
+
+	rows, err := tx.QueryContext(ctx, LockAlbumTrackPositionsQuery, edit.AlbumID)
+	if err != nil {
+		return fail("failed to lock album tracks", err)
+	}
+	var tracksCount, currentPosition int64
+	for rows.Next() {
+		var id, position int64
+		if err := rows.Scan(&id, &position); err != nil {
+			rows.Close()
+			return fail("failed to scan album track", err)
+		}
+		if id == edit.ID {
+			currentPosition = position
+		}
+		tracksCount++
+	}
+	if err := rows.Close(); err != nil {
+		return fail("failed to close rows", err)
+	}
+	if err := rows.Err(); err != nil {
+		return fail("failed to read album tracks", err)
+	}
+
+	if currentPosition == 0 {
+		rollback()
+		return trackErrors.ErrTrackNotFound
+	}
+	if edit.Position < 0 || edit.Position > tracksCount {
+		rollback()
+		return trackErrors.ErrTrackPositionInvalid
+	}
+
+	if edit.Position != 0 && edit.Position != currentPosition {
+		if _, err := tx.ExecContext(ctx, MoveAlbumTrackQuery, edit.AlbumID, edit.ID, currentPosition, edit.Position); err != nil {
+			return fail("failed to move track", err)
+		}
+		if _, err := tx.ExecContext(ctx, RestoreAlbumTrackPositionsQuery, edit.AlbumID); err != nil {
+			return fail("failed to restore track positions", err)
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, UpdateTrackTitleQuery, edit.ID, edit.Title); err != nil {
+		return fail("failed to update track title", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("EditTrack").Inc()
+		logger.Error("failed to commit transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to commit transaction: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("EditTrack").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) ReplaceTrackFile(ctx context.Context, replace *repoModel.TrackAudioReplace) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Replacing track file in db", zap.Any("replace", replace), zap.String("query", ReplaceTrackFileQuery))
+
+	start := time.Now()
+
+	stmt, err := r.db.PrepareContext(ctx, ReplaceTrackFileQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ReplaceTrackFile").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	result, err := stmt.ExecContext(ctx, replace.ID, replace.AlbumID, replace.FileKey, replace.Duration, replace.SourceFormat)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ReplaceTrackFile").Inc()
+		logger.Error("failed to replace track file", zap.Error(err))
+		return trackErrors.NewInternalError("failed to replace track file: %v", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ReplaceTrackFile").Inc()
+		logger.Error("failed to get affected rows", zap.Error(err))
+		return trackErrors.NewInternalError("failed to get affected rows: %v", err)
+	}
+	if affected == 0 {
+		return trackErrors.ErrTrackNotFound
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("ReplaceTrackFile").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) UpdateAlbumTracksThumbnail(ctx context.Context, albumID int64, thumbnail string, thumbnailColor string) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Updating album tracks thumbnail in db", zap.Int64("albumID", albumID), zap.String("query", UpdateAlbumTracksThumbnailQuery))
+
+	start := time.Now()
+
+	stmt, err := r.db.PrepareContext(ctx, UpdateAlbumTracksThumbnailQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracksThumbnail").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	if _, err := stmt.ExecContext(ctx, albumID, thumbnail, thumbnailColor); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("UpdateAlbumTracksThumbnail").Inc()
+		logger.Error("failed to update album tracks thumbnail", zap.Error(err))
+		return trackErrors.NewInternalError("failed to update album tracks thumbnail: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("UpdateAlbumTracksThumbnail").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) GetTrackRenditions(ctx context.Context, trackID int64) ([]*repoModel.TrackRendition, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting track renditions from db", zap.Int64("trackID", trackID), zap.String("query", GetTrackRenditionsQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetTrackRenditionsQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackRenditions").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, trackID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackRenditions").Inc()
+		logger.Error("failed to get track renditions", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get track renditions: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	renditions := make([]*repoModel.TrackRendition, 0)
+	for rows.Next() {
+		var rendition repoModel.TrackRendition
+		if err := rows.Scan(&rendition.Codec, &rendition.Bitrate, &rendition.FileKey); err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetTrackRenditions").Inc()
+			logger.Error("failed to scan track rendition", zap.Error(err))
+			return nil, trackErrors.NewInternalError("failed to scan track rendition: %v", err)
+		}
+		renditions = append(renditions, &rendition)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackRenditions").Inc()
+		logger.Error("failed to read track renditions", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to read track renditions: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetTrackRenditions").Observe(duration)
+	return renditions, nil
+}
+
+// ClaimTranscodeJob returns nil when there is nothing to transcode
+func (r *TrackPostgresRepository) ClaimTranscodeJob(ctx context.Context, staleAfter time.Duration) (*repoModel.TranscodeJob, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	stmt, err := r.db.PrepareContext(ctx, ClaimTranscodeJobQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ClaimTranscodeJob").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	var job repoModel.TranscodeJob
+	err = stmt.QueryRowContext(ctx, int64(staleAfter.Seconds())).Scan(&job.TrackID, &job.FileKey, &job.SourceFormat, &job.Attempts)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		r.metrics.DatabaseErrors.WithLabelValues("ClaimTranscodeJob").Inc()
+		logger.Error("failed to claim transcode job", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to claim transcode job: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("ClaimTranscodeJob").Observe(duration)
+	return &job, nil
+}
+
+// CompleteTranscodeJob replaces the renditions and segments of the track, a result for a file that
+// was replaced while it was being transcoded is dropped
+func (r *TrackPostgresRepository) CompleteTranscodeJob(ctx context.Context, result *repoModel.TranscodeResult) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Saving transcode result in db", zap.Int64("trackID", result.TrackID), zap.Int("renditions", len(result.Renditions)), zap.Int("segments", len(result.Segments)))
+
+	start := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CompleteTranscodeJob").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	rollback := func() {
+		if err := tx.Rollback(); err != nil {
+			logger.Error("failed to rollback transaction", zap.Error(err))
+		}
+	}
+	fail := func(message string, err error) error {
+		rollback()
+		r.metrics.DatabaseErrors.WithLabelValues("CompleteTranscodeJob").Inc()
+		logger.Error(message, zap.Error(err))
+		return trackErrors.NewInternalError("%s: %v", message, err)
+	}
+
+	analysis := result.Analysis
+	if analysis == nil {
+		analysis = &repoModel.TrackAnalysis{}
+	}
+	updated, err := tx.ExecContext(ctx, FinishTranscodeJobQuery, result.TrackID, result.FileKey, result.LoudnessLUFS, result.TruePeakDB, result.ReplayGainDB, analysis.BPM, analysis.Key)
+	if err != nil {
+		return fail("failed to finish transcode job", err)
+	}
+	affected, err := updated.RowsAffected()
+	if err != nil {
+		return fail("failed to get affected rows", err)
+	}
+	if affected == 0 {
+		rollback()
+		logger.Warn("track changed while it was transcoded, dropping renditions", zap.Int64("trackID", result.TrackID))
+		return nil
+	}
+
+	if _, err := tx.ExecContext(ctx, DeleteTrackRenditionsQuery, result.TrackID); err != nil {
+		return fail("failed to delete track renditions", err)
+	}
+
+	codecs := make([]string, len(result.Renditions))
+	bitrates := make([]int64, len(result.Renditions))
+	fileKeys := make([]string, len(result.Renditions))
+	for i, rendition := range result.Renditions {
+		codecs[i] = rendition.Codec
+		bitrates[i] = rendition.Bitrate
+		fileKeys[i] = rendition.FileKey
+	}
+	if _, err := tx.ExecContext(ctx, AddTrackRenditionsQuery, result.TrackID, pq.Array(codecs), pq.Array(bitrates), pq.Array(fileKeys)); err != nil {
+		return fail("failed to add track renditions", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, DeleteTrackSegmentsQuery, result.TrackID); err != nil {
+		return fail("failed to delete track segments", err)
+	}
+
+	segmentCodecs := make([]string, len(result.Segments))
+	segmentBitrates := make([]int64, len(result.Segments))
+	positions := make([]int64, len(result.Segments))
+	durations := make([]float64, len(result.Segments))
+	segmentKeys := make([]string, len(result.Segments))
+	for i, segment := range result.Segments {
+		segmentCodecs[i] = segment.Codec
+		segmentBitrates[i] = segment.Bitrate
+		positions[i] = segment.Position
+		durations[i] = segment.Duration
+		segmentKeys[i] = segment.FileKey
+	}
+	if _, err := tx.ExecContext(ctx, AddTrackSegmentsQuery, result.TrackID, pq.Array(segmentCodecs), pq.Array(segmentBitrates), pq.Array(positions), pq.Array(durations), pq.Array(segmentKeys)); err != nil {
+		return fail("failed to add track segments", err)
+	}
+
+	if len(analysis.Waveform) > 0 {
+		if _, err := tx.ExecContext(ctx, SaveTrackWaveformQuery, result.TrackID, pq.Array(analysis.Waveform)); err != nil {
+			return fail("failed to save track waveform", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CompleteTranscodeJob").Inc()
+		logger.Error("failed to commit transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to commit transaction: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("CompleteTranscodeJob").Observe(duration)
+	return nil
+}
+
+// FailTranscodeJob puts the track back in the queue until it runs out of attempts
+func (r *TrackPostgresRepository) FailTranscodeJob(ctx context.Context, job *repoModel.TranscodeJob, maxAttempts int64) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Failing transcode job in db", zap.Any("job", job), zap.String("query", FailTranscodeJobQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, FailTranscodeJobQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("FailTranscodeJob").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	if _, err := stmt.ExecContext(ctx, job.TrackID, job.FileKey, maxAttempts); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("FailTranscodeJob").Inc()
+		logger.Error("failed to fail transcode job", zap.Error(err))
+		return trackErrors.NewInternalError("failed to fail transcode job: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("FailTranscodeJob").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) GetTrackWaveform(ctx context.Context, trackID int64) (*repoModel.TrackWaveform, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting track waveform from db", zap.Int64("trackID", trackID), zap.String("query", GetTrackWaveformQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetTrackWaveformQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackWaveform").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	var waveform repoModel.TrackWaveform
+	var peaks pq.Float64Array
+	err = stmt.QueryRowContext(ctx, trackID).Scan(&waveform.Duration, &peaks)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackWaveform").Inc()
+		if errors.Is(err, sql.ErrNoRows) {
+			logger.Error("track not found", zap.Error(err))
+			return nil, trackErrors.ErrTrackNotFound
+		}
+		logger.Error("failed to get track waveform", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get track waveform: %v", err)
+	}
+	if peaks == nil {
+		return nil, trackErrors.ErrTrackWaveformNotFound
+	}
+	waveform.Peaks = peaks
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetTrackWaveform").Observe(duration)
+	return &waveform, nil
+}
+
+func (r *TrackPostgresRepository) GetTrackSegments(ctx context.Context, trackID int64) ([]*repoModel.TrackSegment, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting track segments from db", zap.Int64("trackID", trackID), zap.String("query", GetTrackSegmentsQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetTrackSegmentsQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackSegments").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, trackID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackSegments").Inc()
+		logger.Error("failed to get track segments", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get track segments: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	segments := make([]*repoModel.TrackSegment, 0)
+	for rows.Next() {
+		var segment repoModel.TrackSegment
+		if err := rows.Scan(&segment.Codec, &segment.Bitrate, &segment.Position, &segment.Duration, &segment.FileKey); err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetTrackSegments").Inc()
+			logger.Error("failed to scan track segment", zap.Error(err))
+			return nil, trackErrors.NewInternalError("failed to scan track segment: %v", err)
+		}
+		segments = append(segments, &segment)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackSegments").Inc()
+		logger.Error("failed to read track segments", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to read track segments: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetTrackSegments").Observe(duration)
+	return segments, nil
+}
+
+func (r *TrackPostgresRepository) GetTrackSegment(ctx context.Context, trackID int64, bitrate int64, position int64) (*repoModel.TrackSegment, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting track segment from db", zap.Int64("trackID", trackID), zap.Int64("bitrate", bitrate), zap.Int64("position", position), zap.String("query", GetTrackSegmentQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetTrackSegmentQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackSegment").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	var segment repoModel.TrackSegment
+	err = stmt.QueryRowContext(ctx, trackID, bitrate, position).Scan(&segment.Codec, &segment.Bitrate, &segment.Position, &segment.Duration, &segment.FileKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, trackErrors.ErrTrackSegmentNotFound
+		}
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackSegment").Inc()
+		logger.Error("failed to get track segment", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get track segment: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetTrackSegment").Observe(duration)
+	return &segment, nil
+}
+
+func (r *TrackPostgresRepository) RecordStreamSegment(ctx context.Context, fetch *repoModel.StreamSegmentFetch) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Recording stream segment in db", zap.Any("fetch", fetch), zap.String("query", RecordStreamSegmentQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, RecordStreamSegmentQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("RecordStreamSegment").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	if _, err := stmt.ExecContext(ctx, fetch.StreamID, fetch.Position, fetch.Duration); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("RecordStreamSegment").Inc()
+		logger.Error("failed to record stream segment", zap.Error(err))
+		return trackErrors.NewInternalError("failed to record stream segment: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("RecordStreamSegment").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) FindFingerprintCandidates(ctx context.Context, fingerprint []int32, excludeTrackID int64, limit int64) ([]*repoModel.FingerprintCandidate, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting fingerprint candidates from db", zap.Int("length", len(fingerprint)), zap.Int64("excludeTrackID", excludeTrackID), zap.String("query", FindFingerprintCandidatesQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, FindFingerprintCandidatesQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("FindFingerprintCandidates").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, pq.Array(fingerprint), excludeTrackID, limit)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("FindFingerprintCandidates").Inc()
+		logger.Error("failed to find fingerprint candidates", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to find fingerprint candidates: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	candidates := make([]*repoModel.FingerprintCandidate, 0)
+	for rows.Next() {
+		var candidate repoModel.FingerprintCandidate
+		if err := rows.Scan(&candidate.TrackID, &candidate.Title, &candidate.AlbumID, &candidate.AlbumTitle, &candidate.LabelID, pq.Array(&candidate.Fingerprint)); err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("FindFingerprintCandidates").Inc()
+			logger.Error("failed to scan fingerprint candidate", zap.Error(err))
+			return nil, trackErrors.NewInternalError("failed to scan fingerprint candidate: %v", err)
+		}
+		candidates = append(candidates, &candidate)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("FindFingerprintCandidates").Inc()
+		logger.Error("failed to read fingerprint candidates", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to read fingerprint candidates: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("FindFingerprintCandidates").Observe(duration)
+	return candidates, nil
+}
+
+// SaveTrackFingerprint replaces the fingerprint of the track and the flags that
+// are still waiting for review
+func (r *TrackPostgresRepository) SaveTrackFingerprint(ctx context.Context, fingerprint *repoModel.TrackFingerprint) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Saving track fingerprint in db", zap.Int64("trackID", fingerprint.TrackID), zap.Int("duplicates", len(fingerprint.Duplicates)))
+
+	start := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("SaveTrackFingerprint").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	fail := func(message string, err error) error {
+		if err := tx.Rollback(); err != nil {
+			logger.Error("failed to rollback transaction", zap.Error(err))
+		}
+		r.metrics.DatabaseErrors.WithLabelValues("SaveTrackFingerprint").Inc()
+		logger.Error(message, zap.Error(err))
+		return trackErrors.NewInternalError("%s: %v", message, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, UpsertTrackFingerprintQuery, fingerprint.TrackID, pq.Array(fingerprint.Fingerprint)); err != nil {
+		return fail("failed to save track fingerprint", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, DeletePendingDuplicateFlagsQuery, fingerprint.TrackID); err != nil {
+		return fail("failed to delete duplicate flags", err)
+	}
+
+	if len(fingerprint.Duplicates) > 0 {
+		matchedTrackIDs := make([]int64, len(fingerprint.Duplicates))
+		similarities := make([]float64, len(fingerprint.Duplicates))
+		for i, duplicate := range fingerprint.Duplicates {
+			matchedTrackIDs[i] = duplicate.TrackID
+			similarities[i] = duplicate.Similarity
+		}
+		if _, err := tx.ExecContext(ctx, AddDuplicateFlagsQuery, fingerprint.TrackID, pq.Array(matchedTrackIDs), pq.Array(similarities)); err != nil {
+			return fail("failed to add duplicate flags", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("SaveTrackFingerprint").Inc()
+		logger.Error("failed to commit transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to commit transaction: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("SaveTrackFingerprint").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) GetPendingDuplicateFlags(ctx context.Context, filters *repoModel.TrackFilters) ([]*repoModel.DuplicateFlag, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting pending duplicate flags from db", zap.Any("filters", filters), zap.String("query", GetPendingDuplicateFlagsQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetPendingDuplicateFlagsQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetPendingDuplicateFlags").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, filters.Pagination.Limit, filters.Pagination.Offset)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetPendingDuplicateFlags").Inc()
+		logger.Error("failed to get duplicate flags", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get duplicate flags: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	flags := make([]*repoModel.DuplicateFlag, 0)
+	for rows.Next() {
+		var flag repoModel.DuplicateFlag
+		err := rows.Scan(&flag.ID,
+			&flag.Track.ID, &flag.Track.Title, &flag.Track.AlbumID, &flag.Track.LabelID,
+			&flag.MatchedTrack.ID, &flag.MatchedTrack.Title, &flag.MatchedTrack.AlbumID, &flag.MatchedTrack.LabelID,
+			&flag.Similarity, &flag.Status, &flag.CreatedAt)
+		if err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetPendingDuplicateFlags").Inc()
+			logger.Error("failed to scan duplicate flag", zap.Error(err))
+			return nil, trackErrors.NewInternalError("failed to scan duplicate flag: %v", err)
+		}
+		flags = append(flags, &flag)
+	}
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetPendingDuplicateFlags").Inc()
+		logger.Error("failed to read duplicate flags", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to read duplicate flags: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetPendingDuplicateFlags").Observe(duration)
+	return flags, nil
+}
+
+func (r *TrackPostgresRepository) ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Resolving duplicate flag in db", zap.Int64("flagID", flagID), zap.String("status", status), zap.String("query", ResolveDuplicateFlagQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, ResolveDuplicateFlagQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ResolveDuplicateFlag").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	result, err := stmt.ExecContext(ctx, flagID, status)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ResolveDuplicateFlag").Inc()
+		logger.Error("failed to resolve duplicate flag", zap.Error(err))
+		return trackErrors.NewInternalError("failed to resolve duplicate flag: %v", err)
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("ResolveDuplicateFlag").Inc()
+		logger.Error("failed to get affected rows", zap.Error(err))
+		return trackErrors.NewInternalError("failed to get affected rows: %v", err)
+	}
+	// flags that were already reviewed are gone from the queue as well
+	if affected == 0 {
+		return trackErrors.ErrDuplicateFlagNotFound
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("ResolveDuplicateFlag").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) SaveTrackLyrics(ctx context.Context, lyrics *repoModel.TrackLyrics) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Saving track lyrics in db", zap.Int64("trackID", lyrics.TrackID), zap.String("language", lyrics.Language), zap.Int("lines", len(lyrics.Lines)))
+
+	start := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("SaveTrackLyrics").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	rollback := func() {
+		if err := tx.Rollback(); err != nil {
+			logger.Error("failed to rollback transaction", zap.Error(err))
+		}
+	}
+	fail := func(message string, err error) error {
+		rollback()
+		r.metrics.DatabaseErrors.WithLabelValues("SaveTrackLyrics").Inc()
+		logger.Error(message, zap.Error(err))
+		return trackErrors.NewInternalError("%s: %v", message, err)
+	}
+
+	saved, err := tx.ExecContext(ctx, SaveTrackLyricsQuery, lyrics.TrackID, lyrics.AlbumID, lyrics.Language, lyrics.Translation, pq.Array(lyrics.Lines), pq.Array(lyrics.LineTimes))
+	if err != nil {
+		return fail("failed to save track lyrics", err)
+	}
+	affected, err := saved.RowsAffected()
+	if err != nil {
+		return fail("failed to get affected rows", err)
+	}
+	if affected == 0 {
+		rollback()
+		return trackErrors.ErrTrackNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, UpdateTrackLyricsTextQuery, lyrics.TrackID); err != nil {
+		return fail("failed to update track lyrics text", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fail("failed to commit transaction", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("SaveTrackLyrics").Observe(duration)
+	return nil
+}
+
+func (r *TrackPostgresRepository) DeleteTrackLyrics(ctx context.Context, request *repoModel.TrackLyricsDelete) error {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Deleting track lyrics from db", zap.Int64("trackID", request.TrackID), zap.String("language", request.Language))
+
+	start := time.Now()
+
+	tx, err := r.db.BeginTx(ctx, nil)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("DeleteTrackLyrics").Inc()
+		logger.Error("failed to begin transaction", zap.Error(err))
+		return trackErrors.NewInternalError("failed to begin transaction: %v", err)
+	}
+	rollback := func() {
+		if err := tx.Rollback(); err != nil {
+			logger.Error("failed to rollback transaction", zap.Error(err))
+		}
+	}
+	fail := func(message string, err error) error {
+		rollback()
+		r.metrics.DatabaseErrors.WithLabelValues("DeleteTrackLyrics").Inc()
+		logger.Error(message, zap.Error(err))
+		return trackErrors.NewInternalError("%s: %v", message, err)
+	}
+
+	deleted, err := tx.ExecContext(ctx, DeleteTrackLyricsQuery, request.TrackID, request.AlbumID, request.Language)
+	if err != nil {
+		return fail("failed to delete track lyrics", err)
+	}
+	affected, err := deleted.RowsAffected()
+	if err != nil {
+		return fail("failed to get affected rows", err)
+	}
+	if affected == 0 {
+		rollback()
+		return trackErrors.ErrTrackLyricsNotFound
+	}
+
+	if _, err := tx.ExecContext(ctx, UpdateTrackLyricsTextQuery, request.TrackID); err != nil {
+		return fail("failed to update track lyrics text", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fail("failed to commit transaction", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("DeleteTrackLyrics").Observe(duration)
+	return nil
+}
+
+// GetTrackLyrics returns no lyrics for a published track without any and ErrTrackNotFound
+// when the track is not published
+func (r *TrackPostgresRepository) GetTrackLyrics(ctx context.Context, trackID int64) ([]*repoModel.TrackLyrics, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Requesting track lyrics from db", zap.Int64("trackID", trackID), zap.String("query", GetTrackLyricsQuery))
+
+	stmt, err := r.db.PrepareContext(ctx, GetTrackLyricsQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackLyrics").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to prepare statement: %v", err)
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("failed to close statement", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, trackID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackLyrics").Inc()
+		logger.Error("failed to get track lyrics", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get track lyrics: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("failed to close rows", zap.Error(err))
+		}
+	}()
+
+	found := false
+	lyricsList := make([]*repoModel.TrackLyrics, 0)
+	for rows.Next() {
+		found = true
+		var language sql.NullString
+		var translation sql.NullBool
+		var lines pq.StringArray
+		var lineTimes pq.Int64Array
+		if err := rows.Scan(&language, &translation, &lines, &lineTimes); err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetTrackLyrics").Inc()
+			logger.Error("failed to scan track lyrics", zap.Error(err))
+			return nil, trackErrors.NewInternalError("failed to scan track lyrics: %v", err)
+		}
+		if !language.Valid {
+			continue
+		}
+		lyricsList = append(lyricsList, &repoModel.TrackLyrics{
+			TrackID:     trackID,
+			Language:    language.String,
+			Translation: translation.Bool,
+			Lines:       lines,
+			LineTimes:   lineTimes,
+		})
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetTrackLyrics").Inc()
+		logger.Error("failed to get track lyrics", zap.Error(err))
+		return nil, trackErrors.NewInternalError("failed to get track lyrics: %v", err)
+	}
+	if !found {
+		return nil, trackErrors.ErrTrackNotFound
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetTrackLyrics").Observe(duration)
+	return lyricsList, nil
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	stderrors "errors"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
@@ -40,12 +41,12 @@ func TestGetAllTracks(t *testing.T) {
 	}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", 200, 1, true).
-		AddRow(2, "Track 2", "thumbnail2.jpg", 200, 1, false)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", 200, 1, 124.5, true).
+		AddRow(2, "Track 2", "thumbnail2.jpg", "", 200, 1, 0, false)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnRows(rows)
 
@@ -57,6 +58,7 @@ func TestGetAllTracks(t *testing.T) {
 	assert.Equal(t, "thumbnail1.jpg", tracks[0].Thumbnail)
 	assert.Equal(t, int64(200), tracks[0].Duration)
 	assert.Equal(t, int64(1), tracks[0].AlbumID)
+	assert.Equal(t, 124.5, tracks[0].BPM)
 	assert.True(t, tracks[0].IsFavorite)
 
 	assert.Equal(t, int64(2), tracks[1].ID)
@@ -64,6 +66,7 @@ func TestGetAllTracks(t *testing.T) {
 	assert.Equal(t, "thumbnail2.jpg", tracks[1].Thumbnail)
 	assert.Equal(t, int64(200), tracks[1].Duration)
 	assert.Equal(t, int64(1), tracks[1].AlbumID)
+	assert.Zero(t, tracks[1].BPM)
 	assert.False(t, tracks[1].IsFavorite)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -82,8 +85,8 @@ func TestGetAllTracksError(t *testing.T) {
 	}
 	userID := int64(1)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -101,11 +104,11 @@ func TestGetTrackByID(t *testing.T) {
 	trackID := int64(1)
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "file_url", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", 200, 1, "file_key.mp3", true)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "file_url", "is_favorite", "loudness_lufs", "replay_gain_db", "musical_key", "has_lyrics"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", 200, 1, 124.5, "file_key.mp3", true, -9.5, -8.5, "A minor", true)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, t.file_url")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, t.file_url").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), t.file_url")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), t.file_url").
 		WithArgs(trackID, userID).
 		WillReturnRows(rows)
 
@@ -119,6 +122,10 @@ func TestGetTrackByID(t *testing.T) {
 	assert.Equal(t, int64(1), track.AlbumID)
 	assert.Equal(t, "file_key.mp3", track.FileKey)
 	assert.True(t, track.IsFavorite)
+	assert.Equal(t, -8.5, track.ReplayGainDB)
+	assert.Equal(t, 124.5, track.BPM)
+	assert.Equal(t, "A minor", track.Key)
+	assert.True(t, track.HasLyrics)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
@@ -131,8 +138,8 @@ func TestGetTrackByIDNotFound(t *testing.T) {
 	trackID := int64(1)
 	userID := int64(1)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, t.file_url")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, t.file_url").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), t.file_url")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), t.file_url").
 		WithArgs(trackID, userID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -168,6 +175,27 @@ func TestCreateStream(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
+func TestCreateStream_Unpublished(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+	createData := &repoModel.TrackStreamCreateData{
+		TrackID: 1,
+		UserID:  1,
+	}
+
+	mock.ExpectPrepare("INSERT INTO track_stream .* JOIN album a ON a.id = t.album_id AND a.status = 'published'")
+	mock.ExpectQuery("INSERT INTO track_stream").
+		WithArgs(createData.TrackID, createData.UserID).
+		WillReturnError(sql.ErrNoRows)
+
+	streamID, err := repo.CreateStream(ctx, createData)
+	assert.ErrorIs(t, err, trackErrors.ErrTrackNotFound)
+	assert.Equal(t, int64(0), streamID)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
 func TestCreateStreamError(t *testing.T) {
 	db, mock, ctx := setupTest(t)
 	defer db.Close()
@@ -345,12 +373,12 @@ func TestGetTracksByIDs(t *testing.T) {
 	trackIDs := []int64{1, 2}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", 200, 1, true).
-		AddRow(2, "Track 2", "thumbnail2.jpg", 200, 1, false)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", 200, 1, 0, true).
+		AddRow(2, "Track 2", "thumbnail2.jpg", "", 200, 1, 0, false)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(pq.Array(trackIDs), userID).
 		WillReturnRows(rows)
 
@@ -373,8 +401,8 @@ func TestGetTracksByIDsError(t *testing.T) {
 	trackIDs := []int64{1, 2}
 	userID := int64(1)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(pq.Array(trackIDs), userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -398,12 +426,12 @@ func TestGetTracksByIDsFiltered(t *testing.T) {
 	}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", 200, 1, true).
-		AddRow(2, "Track 2", "thumbnail2.jpg", 200, 1, false)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", 200, 1, 0, true).
+		AddRow(2, "Track 2", "thumbnail2.jpg", "", 200, 1, 0, false)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(pq.Array(trackIDs), filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnRows(rows)
 
@@ -432,8 +460,8 @@ func TestGetTracksByIDsFilteredError(t *testing.T) {
 	}
 	userID := int64(1)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(pq.Array(trackIDs), filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -490,12 +518,12 @@ func TestGetTracksByAlbumID(t *testing.T) {
 	albumID := int64(1)
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", 200, 1, true).
-		AddRow(2, "Track 2", "thumbnail2.jpg", 200, 1, false)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", 200, 1, 0, true).
+		AddRow(2, "Track 2", "thumbnail2.jpg", "", 200, 1, 0, false)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(albumID, userID).
 		WillReturnRows(rows)
 
@@ -518,8 +546,8 @@ func TestGetTracksByAlbumIDError(t *testing.T) {
 	albumID := int64(1)
 	userID := int64(1)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(albumID, userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -742,12 +770,12 @@ func TestGetFavoriteTracks(t *testing.T) {
 		},
 	}
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", 200, 1, true).
-		AddRow(2, "Track 2", "thumbnail2.jpg", 200, 1, false)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", 200, 1, 0, true).
+		AddRow(2, "Track 2", "thumbnail2.jpg", "", 200, 1, 0, false)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(favoriteRequest.RequestUserID, favoriteRequest.ProfileUserID, favoriteRequest.Filters.Pagination.Limit, favoriteRequest.Filters.Pagination.Offset).
 		WillReturnRows(rows)
 
@@ -778,8 +806,8 @@ func TestGetFavoriteTracksError(t *testing.T) {
 		},
 	}
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(favoriteRequest.RequestUserID, favoriteRequest.ProfileUserID, favoriteRequest.Filters.Pagination.Limit, favoriteRequest.Filters.Pagination.Offset).
 		WillReturnError(stderrors.New("db error"))
 
@@ -789,53 +817,6 @@ func TestGetFavoriteTracksError(t *testing.T) {
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
 
-func TestSearchTracks(t *testing.T) {
-	db, mock, ctx := setupTest(t)
-	defer db.Close()
-
-	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
-	query := "test track"
-	userID := int64(1)
-
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Test Track", "thumbnail1.jpg", 200, 1, true).
-		AddRow(2, "Track Test", "thumbnail2.jpg", 200, 1, false)
-
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
-		WithArgs("test:* & track:*", userID, query).
-		WillReturnRows(rows)
-
-	tracks, err := repo.SearchTracks(ctx, query, userID)
-	assert.NoError(t, err)
-	assert.Len(t, tracks, 2)
-	assert.Equal(t, int64(1), tracks[0].ID)
-	assert.Equal(t, "Test Track", tracks[0].Title)
-	assert.Equal(t, int64(2), tracks[1].ID)
-	assert.Equal(t, "Track Test", tracks[1].Title)
-
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
-
-func TestSearchTracksError(t *testing.T) {
-	db, mock, ctx := setupTest(t)
-	defer db.Close()
-
-	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
-	query := "test track"
-	userID := int64(1)
-
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
-		WithArgs("test:* & track:*", userID, query).
-		WillReturnError(stderrors.New("db error"))
-
-	tracks, err := repo.SearchTracks(ctx, query, userID)
-	assert.Error(t, err)
-	assert.Nil(t, tracks)
-	assert.NoError(t, mock.ExpectationsWereMet())
-}
-
 func TestGetTracksByIDsScanError(t *testing.T) {
 	db, mock, ctx := setupTest(t)
 	defer db.Close()
@@ -844,11 +825,11 @@ func TestGetTracksByIDsScanError(t *testing.T) {
 	trackIDs := []int64{1, 2}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", "invalid_duration", 1, true)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", "invalid_duration", 1, 0, true)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(pq.Array(trackIDs), userID).
 		WillReturnRows(rows)
 
@@ -872,11 +853,11 @@ func TestGetTracksByIDsFilteredScanError(t *testing.T) {
 	}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", "invalid_duration", 1, true)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", "invalid_duration", 1, 0, true)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(pq.Array(trackIDs), filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnRows(rows)
 
@@ -899,11 +880,11 @@ func TestGetAllTracksScanError(t *testing.T) {
 	}
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Track 1", "thumbnail1.jpg", "invalid_duration", 1, true)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Track 1", "thumbnail1.jpg", "", "invalid_duration", 1, 0, true)
 
-	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id")
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id").
+	mock.ExpectPrepare("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id")
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id").
 		WithArgs(filters.Pagination.Limit, filters.Pagination.Offset, userID).
 		WillReturnRows(rows)
 
@@ -947,18 +928,26 @@ func TestAddTracksToAlbum(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	albumID := int64(1)
 
-	tracks := []*repoModel.Track{
+	tracks := []*repoModel.TrackWithFileKey{
 		{
-			Title:     "Track 1",
-			Thumbnail: "thumbnail1.jpg",
-			Duration:  200,
-			AlbumID:   albumID,
+			Track: repoModel.Track{
+				Title:     "Track 1",
+				Thumbnail: "thumbnail1.jpg",
+				Duration:  200,
+				AlbumID:   albumID,
+			},
+			FileKey:      "upload-1.mp3",
+			SourceFormat: "mp3",
 		},
 		{
-			Title:     "Track 2",
-			Thumbnail: "thumbnail2.jpg",
-			Duration:  180,
-			AlbumID:   albumID,
+			Track: repoModel.Track{
+				Title:     "Track 2",
+				Thumbnail: "thumbnail2.jpg",
+				Duration:  180,
+				AlbumID:   albumID,
+			},
+			FileKey:      "upload-2.flac",
+			SourceFormat: "flac",
 		},
 	}
 
@@ -966,8 +955,8 @@ func TestAddTracksToAlbum(t *testing.T) {
 
 	mock.ExpectQuery("INSERT INTO track").
 		WithArgs(
-			tracks[0].Title, tracks[0].Thumbnail, tracks[0].Duration, tracks[0].AlbumID, "Track 1.mp3", 1,
-			tracks[1].Title, tracks[1].Thumbnail, tracks[1].Duration, tracks[1].AlbumID, "Track 2.mp3", 2,
+			tracks[0].Title, tracks[0].Thumbnail, tracks[0].ThumbnailColor, tracks[0].Duration, tracks[0].AlbumID, tracks[0].FileKey, "mp3", 1,
+			tracks[1].Title, tracks[1].Thumbnail, tracks[1].ThumbnailColor, tracks[1].Duration, tracks[1].AlbumID, tracks[1].FileKey, "flac", 2,
 		).
 		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1).AddRow(2))
 
@@ -992,18 +981,26 @@ func TestAddTracksToAlbumError(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	albumID := int64(1)
 
-	tracks := []*repoModel.Track{
+	tracks := []*repoModel.TrackWithFileKey{
 		{
-			Title:     "Track 1",
-			Thumbnail: "thumbnail1.jpg",
-			Duration:  200,
-			AlbumID:   albumID,
+			Track: repoModel.Track{
+				Title:     "Track 1",
+				Thumbnail: "thumbnail1.jpg",
+				Duration:  200,
+				AlbumID:   albumID,
+			},
+			FileKey:      "upload-1.mp3",
+			SourceFormat: "mp3",
 		},
 		{
-			Title:     "Track 2",
-			Thumbnail: "thumbnail2.jpg",
-			Duration:  180,
-			AlbumID:   albumID,
+			Track: repoModel.Track{
+				Title:     "Track 2",
+				Thumbnail: "thumbnail2.jpg",
+				Duration:  180,
+				AlbumID:   albumID,
+			},
+			FileKey:      "upload-2.flac",
+			SourceFormat: "flac",
 		},
 	}
 
@@ -1011,8 +1008,8 @@ func TestAddTracksToAlbumError(t *testing.T) {
 
 	mock.ExpectQuery("INSERT INTO track").
 		WithArgs(
-			tracks[0].Title, tracks[0].Thumbnail, tracks[0].Duration, tracks[0].AlbumID, "Track 1.mp3", 1,
-			tracks[1].Title, tracks[1].Thumbnail, tracks[1].Duration, tracks[1].AlbumID, "Track 2.mp3", 2,
+			tracks[0].Title, tracks[0].Thumbnail, tracks[0].ThumbnailColor, tracks[0].Duration, tracks[0].AlbumID, tracks[0].FileKey, "mp3", 1,
+			tracks[1].Title, tracks[1].Thumbnail, tracks[1].ThumbnailColor, tracks[1].Duration, tracks[1].AlbumID, tracks[1].FileKey, "flac", 2,
 		).
 		WillReturnError(stderrors.New("db error"))
 
@@ -1068,10 +1065,10 @@ func TestGetMostLikedTracks(t *testing.T) {
     repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
     userID := int64(1)
 
-    rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-        AddRow(1, "Most Liked Track", "thumbnail1.jpg", 200, 1, true)
+    rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+        AddRow(1, "Most Liked Track", "thumbnail1.jpg", "", 200, 1, 0, true)
 
-    mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+    mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
         WithArgs(userID).
         WillReturnRows(rows)
 
@@ -1091,7 +1088,7 @@ func TestGetMostLikedTracksError(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
 		WithArgs(userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -1109,10 +1106,10 @@ func TestGetMostRecentTracks(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Most Recent Track", "thumbnail1.jpg", 200, 1, true)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Most Recent Track", "thumbnail1.jpg", "", 200, 1, 0, true)
 
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
 		WithArgs(userID).
 		WillReturnRows(rows)
 
@@ -1132,7 +1129,7 @@ func TestGetMostRecentTracksError(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
 		WithArgs(userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -1150,10 +1147,10 @@ func TestGetMostListenedLastMonthTracks(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Most Listened Last Month Track", "thumbnail1.jpg", 200, 1, true)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Most Listened Last Month Track", "thumbnail1.jpg", "", 200, 1, 0, true)
 
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
 		WithArgs(userID).
 		WillReturnRows(rows)
 
@@ -1173,7 +1170,7 @@ func TestGetMostListenedLastMonthTracksError(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
 		WithArgs(userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -1191,10 +1188,10 @@ func TestGetMostLikedLastWeekTracks(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "duration", "album_id", "is_favorite"}).
-		AddRow(1, "Most Liked Last Week Track", "thumbnail1.jpg", 200, 1, true)
+	rows := sqlmock.NewRows([]string{"id", "title", "thumbnail_url", "thumbnail_color", "duration", "album_id", "bpm", "is_favorite"}).
+		AddRow(1, "Most Liked Last Week Track", "thumbnail1.jpg", "", 200, 1, 0, true)
 
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
 		WithArgs(userID).
 		WillReturnRows(rows)
 
@@ -1214,7 +1211,7 @@ func TestGetMostLikedLastWeekTracksError(t *testing.T) {
 	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
 	userID := int64(1)
 
-	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.duration, t.album_id, \\(ft.user_id IS NOT NULL\\) AS is_favorite").
+	mock.ExpectQuery("SELECT t.id, t.title, t.thumbnail_url, t.thumbnail_color, t.duration, t.album_id, COALESCE\\(t.bpm, 0\\), \\(ft.user_id IS NOT NULL\\) AS is_favorite").
 		WithArgs(userID).
 		WillReturnError(stderrors.New("db error"))
 
@@ -1223,4 +1220,721 @@ func TestGetMostLikedLastWeekTracksError(t *testing.T) {
 	assert.Nil(t, tracks)
 
 	assert.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+func TestUpdateAlbumTracks(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+	update := &repoModel.AlbumTracksUpdate{
+		AlbumID: 1,
+		Tracks: []*repoModel.TrackUpdate{
+			{ID: 2, Title: "Track 2", Position: 1},
+			{ID: 1, Title: "Track 1", Position: 2},
+		},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("draft"))
+	mock.ExpectExec("SET position = -position").WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE track").WithArgs(int64(2), int64(1), "Track 2", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE track").WithArgs(int64(1), int64(1), "Track 1", int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+	mock.ExpectCommit()
+
+	err := repo.UpdateAlbumTracks(ctx, update)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAlbumTracksNotDraft(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("published"))
+	mock.ExpectRollback()
+
+	err := repo.UpdateAlbumTracks(ctx, &repoModel.AlbumTracksUpdate{AlbumID: 1})
+	assert.ErrorIs(t, err, trackErrors.ErrAlbumNotDraft)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUpdateAlbumTracksMissingTrack(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+	update := &repoModel.AlbumTracksUpdate{
+		AlbumID: 1,
+		Tracks:  []*repoModel.TrackUpdate{{ID: 1, Title: "Track 1", Position: 1}},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT status").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"status"}).AddRow("draft"))
+	mock.ExpectExec("SET position = -position").WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE track").WithArgs(int64(1), int64(1), "Track 1", int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectQuery("SELECT EXISTS").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+	mock.ExpectRollback()
+
+	err := repo.UpdateAlbumTracks(ctx, update)
+	assert.ErrorIs(t, err, trackErrors.ErrAlbumTracksInvalid)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEditTrack(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, position").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "position"}).AddRow(5, 1).AddRow(6, 2).AddRow(7, 3))
+	mock.ExpectExec("WHEN id = \\$2").WithArgs(int64(1), int64(7), int64(3), int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("SET position = -position, updated_at").WithArgs(int64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("SET title").WithArgs(int64(7), "Intro").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.EditTrack(ctx, &repoModel.TrackEdit{ID: 7, AlbumID: 1, Title: "Intro", Position: 1})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEditTrackKeepsPosition(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT id, position").WithArgs(int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "position"}).AddRow(5, 1).AddRow(7, 2))
+	mock.ExpectExec("SET title").WithArgs(int64(7), "Outro").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.EditTrack(ctx, &repoModel.TrackEdit{ID: 7, AlbumID: 1, Title: "Outro"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEditTrackErrors(t *testing.T) {
+	tests := []struct {
+		name        string
+		edit        *repoModel.TrackEdit
+		expectedErr error
+	}{
+		{name: "Track of another album", edit: &repoModel.TrackEdit{ID: 9, AlbumID: 1, Title: "Intro"}, expectedErr: trackErrors.ErrTrackNotFound},
+		{name: "Position out of range", edit: &repoModel.TrackEdit{ID: 7, AlbumID: 1, Title: "Intro", Position: 3}, expectedErr: trackErrors.ErrTrackPositionInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, ctx := setupTest(t)
+			defer db.Close()
+
+			repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+			mock.ExpectBegin()
+			mock.ExpectQuery("SELECT id, position").WithArgs(int64(1)).
+				WillReturnRows(sqlmock.NewRows([]string{"id", "position"}).AddRow(5, 1).AddRow(7, 2))
+			mock.ExpectRollback()
+
+			err := repo.EditTrack(ctx, tt.edit)
+			assert.ErrorIs(t, err, tt.expectedErr)
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestReplaceTrackFile(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SET file_url").
+		ExpectExec().
+		WithArgs(int64(7), int64(1), "uploads/new.wav", int64(180), "wav").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.ReplaceTrackFile(ctx, &repoModel.TrackAudioReplace{ID: 7, AlbumID: 1, FileKey: "uploads/new.wav", SourceFormat: "wav", Duration: 180})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestReplaceTrackFileNotFound(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SET file_url").
+		ExpectExec().
+		WithArgs(int64(7), int64(2), "uploads/new.mp3", int64(180), "mp3").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.ReplaceTrackFile(ctx, &repoModel.TrackAudioReplace{ID: 7, AlbumID: 2, FileKey: "uploads/new.mp3", SourceFormat: "mp3", Duration: 180})
+	assert.ErrorIs(t, err, trackErrors.ErrTrackNotFound)
+}
+
+func TestUpdateAlbumTracksThumbnail(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SET thumbnail_url").
+		ExpectExec().
+		WithArgs(int64(1), "cover.jpg", "#a1b2c3").
+		WillReturnResult(sqlmock.NewResult(0, 3))
+
+	err := repo.UpdateAlbumTracksThumbnail(ctx, 1, "cover.jpg", "#a1b2c3")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackRenditions(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("FROM track_rendition").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"codec", "bitrate", "file_key"}).
+			AddRow("mp3", 320, "renditions/upload/mp3-320.mp3").
+			AddRow("opus", 160, "renditions/upload/opus-160.ogg"))
+
+	renditions, err := repo.GetTrackRenditions(ctx, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, []*repoModel.TrackRendition{
+		{Codec: "mp3", Bitrate: 320, FileKey: "renditions/upload/mp3-320.mp3"},
+		{Codec: "opus", Bitrate: 160, FileKey: "renditions/upload/opus-160.ogg"},
+	}, renditions)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimTranscodeJob(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SET transcode_status = 'processing'").
+		ExpectQuery().
+		WithArgs(int64(1800)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "file_url", "source_format", "transcode_attempts"}).
+			AddRow(7, "upload.flac", "flac", 1))
+
+	job, err := repo.ClaimTranscodeJob(ctx, 30*time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, &repoModel.TranscodeJob{TrackID: 7, FileKey: "upload.flac", SourceFormat: "flac", Attempts: 1}, job)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestClaimTranscodeJobEmptyQueue(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SET transcode_status = 'processing'").
+		ExpectQuery().
+		WithArgs(int64(1800)).
+		WillReturnError(sql.ErrNoRows)
+
+	job, err := repo.ClaimTranscodeJob(ctx, 30*time.Minute)
+	assert.NoError(t, err)
+	assert.Nil(t, job)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func transcodeResult() *repoModel.TranscodeResult {
+	return &repoModel.TranscodeResult{
+		TrackID: 7,
+		FileKey: "upload.flac",
+		Renditions: []*repoModel.TrackRendition{
+			{Codec: "mp3", Bitrate: 320, FileKey: "renditions/upload/mp3-320.mp3"},
+			{Codec: "opus", Bitrate: 160, FileKey: "renditions/upload/opus-160.ogg"},
+		},
+		Segments: []*repoModel.TrackSegment{
+			{Codec: "aac", Bitrate: 128, Position: 0, Duration: 6, FileKey: "hls/upload/aac-128/00000.ts"},
+			{Codec: "aac", Bitrate: 128, Position: 1, Duration: 2.5, FileKey: "hls/upload/aac-128/00001.ts"},
+		},
+		LoudnessLUFS: -10.5,
+		TruePeakDB:   -0.3,
+		ReplayGainDB: -7.5,
+		Analysis: &repoModel.TrackAnalysis{
+			Waveform: []float64{0.5, 0.25},
+			BPM:      124.5,
+			Key:      "A minor",
+		},
+	}
+}
+
+func TestCompleteTranscodeJob(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET transcode_status = 'ready'").
+		WithArgs(int64(7), "upload.flac", -10.5, -0.3, -7.5, 124.5, "A minor").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM track_rendition").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectExec("INSERT INTO track_rendition").
+		WithArgs(int64(7), pq.Array([]string{"mp3", "opus"}), pq.Array([]int64{320, 160}), pq.Array([]string{"renditions/upload/mp3-320.mp3", "renditions/upload/opus-160.ogg"})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("DELETE FROM track_segment").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO track_segment").
+		WithArgs(int64(7), pq.Array([]string{"aac", "aac"}), pq.Array([]int64{128, 128}), pq.Array([]int64{0, 1}), pq.Array([]float64{6, 2.5}), pq.Array([]string{"hls/upload/aac-128/00000.ts", "hls/upload/aac-128/00001.ts"})).
+		WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("INSERT INTO track_waveform").
+		WithArgs(int64(7), pq.Array([]float64{0.5, 0.25})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.CompleteTranscodeJob(ctx, transcodeResult())
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestCompleteTranscodeJobReplacedFile(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("SET transcode_status = 'ready'").
+		WithArgs(int64(7), "upload.flac", -10.5, -0.3, -7.5, 124.5, "A minor").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := repo.CompleteTranscodeJob(ctx, transcodeResult())
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackWaveform(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SELECT t.duration, w.peaks").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"duration", "peaks"}).AddRow(200, "{0.5,0.25,0}"))
+
+	waveform, err := repo.GetTrackWaveform(ctx, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, &repoModel.TrackWaveform{Duration: 200, Peaks: []float64{0.5, 0.25, 0}}, waveform)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackWaveformNotAnalysed(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SELECT t.duration, w.peaks").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"duration", "peaks"}).AddRow(200, nil))
+
+	_, err := repo.GetTrackWaveform(ctx, 7)
+	assert.ErrorIs(t, err, trackErrors.ErrTrackWaveformNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackWaveformNotFound(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SELECT t.duration, w.peaks").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetTrackWaveform(ctx, 7)
+	assert.ErrorIs(t, err, trackErrors.ErrTrackNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTrackLyrics(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	lyrics := &repoModel.TrackLyrics{
+		TrackID:   7,
+		AlbumID:   3,
+		Language:  "en",
+		Lines:     []string{"Hello", "", "World"},
+		LineTimes: []int64{1000, 5000, 9000},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO track_lyrics").
+		WithArgs(int64(7), int64(3), "en", false, pq.Array(lyrics.Lines), pq.Array(lyrics.LineTimes)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE track\\s+SET lyrics_text").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.SaveTrackLyrics(ctx, lyrics)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTrackLyricsOtherAlbum(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO track_lyrics").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := repo.SaveTrackLyrics(ctx, &repoModel.TrackLyrics{TrackID: 7, AlbumID: 4, Language: "en", Lines: []string{"Hello"}})
+	assert.ErrorIs(t, err, trackErrors.ErrTrackNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteTrackLyrics(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM track_lyrics").
+		WithArgs(int64(7), int64(3), "de").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE track\\s+SET lyrics_text").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.DeleteTrackLyrics(ctx, &repoModel.TrackLyricsDelete{TrackID: 7, AlbumID: 3, Language: "de"})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestDeleteTrackLyricsNotFound(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM track_lyrics").
+		WithArgs(int64(7), int64(3), "de").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectRollback()
+
+	err := repo.DeleteTrackLyrics(ctx, &repoModel.TrackLyricsDelete{TrackID: 7, AlbumID: 3, Language: "de"})
+	assert.ErrorIs(t, err, trackErrors.ErrTrackLyricsNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackLyrics(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SELECT l.language, l.is_translation, l.lines, l.line_times").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"language", "is_translation", "lines", "line_times"}).
+			AddRow("en", false, "{Hello,World}", "{1000,5000}").
+			AddRow("ru", true, "{Привет,Мир}", nil))
+
+	lyrics, err := repo.GetTrackLyrics(ctx, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, []*repoModel.TrackLyrics{
+		{TrackID: 7, Language: "en", Lines: []string{"Hello", "World"}, LineTimes: []int64{1000, 5000}},
+		{TrackID: 7, Language: "ru", Translation: true, Lines: []string{"Привет", "Мир"}},
+	}, lyrics)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackLyricsNone(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SELECT l.language, l.is_translation, l.lines, l.line_times").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"language", "is_translation", "lines", "line_times"}).
+			AddRow(nil, nil, nil, nil))
+
+	lyrics, err := repo.GetTrackLyrics(ctx, 7)
+	assert.NoError(t, err)
+	assert.Empty(t, lyrics)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackLyricsTrackNotFound(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("SELECT l.language, l.is_translation, l.lines, l.line_times").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"language", "is_translation", "lines", "line_times"}))
+
+	_, err := repo.GetTrackLyrics(ctx, 7)
+	assert.ErrorIs(t, err, trackErrors.ErrTrackNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFailTranscodeJob(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("THEN 'failed' ELSE 'pending'").
+		ExpectExec().
+		WithArgs(int64(7), "upload.flac", int64(3)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.FailTranscodeJob(ctx, &repoModel.TranscodeJob{TrackID: 7, FileKey: "upload.flac"}, 3)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackSegments(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("FROM track_segment .* JOIN album a ON a.id = t.album_id AND a.status = 'published'").
+		ExpectQuery().
+		WithArgs(int64(7)).
+		WillReturnRows(sqlmock.NewRows([]string{"codec", "bitrate", "position", "duration", "file_key"}).
+			AddRow("aac", 64, 0, 6.0, "hls/upload/aac-64/00000.ts").
+			AddRow("aac", 128, 0, 6.0, "hls/upload/aac-128/00000.ts"))
+
+	segments, err := repo.GetTrackSegments(ctx, 7)
+	assert.NoError(t, err)
+	assert.Equal(t, []*repoModel.TrackSegment{
+		{Codec: "aac", Bitrate: 64, Position: 0, Duration: 6, FileKey: "hls/upload/aac-64/00000.ts"},
+		{Codec: "aac", Bitrate: 128, Position: 0, Duration: 6, FileKey: "hls/upload/aac-128/00000.ts"},
+	}, segments)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackSegment(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("FROM track_segment .* JOIN album a ON a.id = t.album_id AND a.status = 'published'").
+		ExpectQuery().
+		WithArgs(int64(7), int64(128), int64(3)).
+		WillReturnRows(sqlmock.NewRows([]string{"codec", "bitrate", "position", "duration", "file_key"}).
+			AddRow("aac", 128, 3, 6.0, "hls/upload/aac-128/00003.ts"))
+
+	segment, err := repo.GetTrackSegment(ctx, 7, 128, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, &repoModel.TrackSegment{Codec: "aac", Bitrate: 128, Position: 3, Duration: 6, FileKey: "hls/upload/aac-128/00003.ts"}, segment)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetTrackSegmentNotFound(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("FROM track_segment").
+		ExpectQuery().
+		WithArgs(int64(7), int64(128), int64(99)).
+		WillReturnError(sql.ErrNoRows)
+
+	_, err := repo.GetTrackSegment(ctx, 7, 128, 99)
+	assert.ErrorIs(t, err, trackErrors.ErrTrackSegmentNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestRecordStreamSegment(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("INSERT INTO track_stream_segment").
+		ExpectExec().
+		WithArgs(int64(1), int64(3), 6.0).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.RecordStreamSegment(ctx, &repoModel.StreamSegmentFetch{StreamID: 1, Position: 3, Duration: 6})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFindFingerprintCandidates(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("FROM track_fingerprint").
+		ExpectQuery().
+		WithArgs(pq.Array([]int32{1, -2, 3}), int64(7), int64(20)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "title", "album_id", "album_title", "label_id", "fingerprint"}).
+			AddRow(5, "Track", 2, "Album", 3, "{1,-2,4}"))
+
+	candidates, err := repo.FindFingerprintCandidates(ctx, []int32{1, -2, 3}, 7, 20)
+	assert.NoError(t, err)
+	assert.Equal(t, []*repoModel.FingerprintCandidate{
+		{TrackID: 5, Title: "Track", AlbumID: 2, AlbumTitle: "Album", LabelID: 3, Fingerprint: []int32{1, -2, 4}},
+	}, candidates)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTrackFingerprint(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO track_fingerprint").
+		WithArgs(int64(7), pq.Array([]int32{1, -2, 3})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM track_duplicate_flag").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("INSERT INTO track_duplicate_flag").
+		WithArgs(int64(7), pq.Array([]int64{5}), pq.Array([]float64{0.8})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.SaveTrackFingerprint(ctx, &repoModel.TrackFingerprint{
+		TrackID:     7,
+		Fingerprint: []int32{1, -2, 3},
+		Duplicates:  []*repoModel.DuplicateMatch{{TrackID: 5, Similarity: 0.8}},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSaveTrackFingerprintWithoutDuplicates(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO track_fingerprint").
+		WithArgs(int64(7), pq.Array([]int32{1, -2, 3})).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("DELETE FROM track_duplicate_flag").
+		WithArgs(int64(7)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+
+	err := repo.SaveTrackFingerprint(ctx, &repoModel.TrackFingerprint{TrackID: 7, Fingerprint: []int32{1, -2, 3}})
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetPendingDuplicateFlags(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mock.ExpectPrepare("FROM track_duplicate_flag").
+		ExpectQuery().
+		WithArgs(int64(10), int64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "track_id", "track_title", "track_album_id", "track_label_id",
+			"matched_id", "matched_title", "matched_album_id", "matched_label_id", "similarity", "status", "created_at"}).
+			AddRow(1, 7, "Upload", 4, 3, 5, "Original", 2, 1, 0.8, "pending", createdAt))
+
+	flags, err := repo.GetPendingDuplicateFlags(ctx, &repoModel.TrackFilters{Pagination: &repoModel.Pagination{Limit: 10, Offset: 0}})
+	assert.NoError(t, err)
+	assert.Equal(t, []*repoModel.DuplicateFlag{{
+		ID:           1,
+		Track:        repoModel.DuplicateFlagTrack{ID: 7, Title: "Upload", AlbumID: 4, LabelID: 3},
+		MatchedTrack: repoModel.DuplicateFlagTrack{ID: 5, Title: "Original", AlbumID: 2, LabelID: 1},
+		Similarity:   0.8,
+		Status:       "pending",
+		CreatedAt:    createdAt,
+	}}, flags)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveDuplicateFlag(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("UPDATE track_duplicate_flag").
+		ExpectExec().
+		WithArgs(int64(1), "dismissed").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.ResolveDuplicateFlag(ctx, 1, "dismissed")
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestResolveDuplicateFlagNotFound(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewTrackPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("UPDATE track_duplicate_flag").
+		ExpectExec().
+		WithArgs(int64(1), "confirmed").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.ResolveDuplicateFlag(ctx, 1, "confirmed")
+	assert.ErrorIs(t, err, trackErrors.ErrDuplicateFlagNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
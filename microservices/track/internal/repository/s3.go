@@ -4,21 +4,18 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"image"
-
-	_ "image/gif"
-	_ "image/jpeg"
-	_ "image/png"
+	"io"
 	"time"
 
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/imagePipeline"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/domain"
 	"go.uber.org/zap"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/service/s3"
-	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
 )
 
@@ -26,19 +23,18 @@ type trackS3Repository struct {
 	s3              *s3.S3
 	trackBucketName string
 	imageBucketName string
-	uploader        *s3manager.Uploader
 	expiration      time.Duration
+	images          *imagePipeline.Pipeline
 	metrics         *metrics.Metrics
 }
 
-func NewTrackS3Repository(s3 *s3.S3, trackBucketName string, imageBucketName string, expiration time.Duration, metrics *metrics.Metrics) domain.S3Repository {
-	uploader := s3manager.NewUploaderWithClient(s3)
+func NewTrackS3Repository(s3 *s3.S3, trackBucketName string, imageBucketName string, expiration time.Duration, images *imagePipeline.Pipeline, metrics *metrics.Metrics) domain.S3Repository {
 	return &trackS3Repository{
 		s3:              s3,
 		trackBucketName: trackBucketName,
 		imageBucketName: imageBucketName,
 		expiration:      expiration,
-		uploader:        uploader,
+		images:          images,
 		metrics:         metrics,
 	}
 }
@@ -68,77 +64,137 @@ func (r *trackS3Repository) GetPresignedURL(trackKey string) (string, error) {
 	return url, nil
 }
 
-func (r *trackS3Repository) UploadTrack(ctx context.Context, fileKey string, file []byte) error {
+func (r *trackS3Repository) OpenTrack(ctx context.Context, fileKey string) (io.ReadCloser, error) {
 	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
 
 	if fileKey == "" {
-		r.metrics.DatabaseErrors.WithLabelValues("UploadTrack").Inc()
-		return fmt.Errorf("empty file key provided")
+		r.metrics.DatabaseErrors.WithLabelValues("OpenTrack").Inc()
+		return nil, trackErrors.NewNotFoundError("empty file key provided")
 	}
 
-	input := &s3manager.UploadInput{
+	output, err := r.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
 		Bucket: aws.String(r.trackBucketName),
-		Key:    aws.String(fmt.Sprintf("%s.mp3", fileKey)),
-		Body:   bytes.NewReader(file),
+		Key:    aws.String(fileKey),
+	})
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("OpenTrack").Inc()
+		logger.Error("failed to open uploaded track", zap.String("key", fileKey), zap.Error(err))
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, trackErrors.NewNotFoundError("uploaded track not found")
+		}
+		return nil, trackErrors.NewInternalError("failed to open uploaded track: %v", err)
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("OpenTrack").Observe(duration)
+	return output.Body, nil
+}
+
+// CopyTrackAvatar turns the uploaded cover into variants and returns the url of the fallback jpeg
+// and the dominant color of the cover
+func (r *trackS3Repository) CopyTrackAvatar(ctx context.Context, trackTitle string, sourceKey string) (string, string, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	output, err := r.s3.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(r.imageBucketName),
+		Key:    aws.String(sourceKey),
+	})
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CopyTrackAvatar").Inc()
+		logger.Error("failed to open uploaded cover", zap.String("key", sourceKey), zap.Error(err))
+		return "", "", trackErrors.NewUnsupportedImageFormatError("uploaded cover not found")
+	}
+	file, err := io.ReadAll(output.Body)
+	output.Body.Close()
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CopyTrackAvatar").Inc()
+		logger.Error("failed to read uploaded cover", zap.String("key", sourceKey), zap.Error(err))
+		return "", "", trackErrors.NewFailedToUploadAvatarError("failed to upload avatar")
 	}
 
-	_, err := r.uploader.UploadWithContext(ctx, input)
+	processed, err := r.images.Process(ctx, file)
 	if err != nil {
-		r.metrics.DatabaseErrors.WithLabelValues("UploadTrack").Inc()
-		return fmt.Errorf("failed to upload file: %w", err)
+		r.metrics.DatabaseErrors.WithLabelValues("CopyTrackAvatar").Inc()
+		if imagePipeline.InvalidImage(err) {
+			return "", "", trackErrors.NewUnsupportedImageFormatError("%v", err)
+		}
+		return "", "", trackErrors.NewFailedToUploadAvatarError("failed to upload avatar")
 	}
 
+	fileKey, err := r.images.Store(ctx, r.s3, r.imageBucketName, "tracks", trackTitle, processed)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("CopyTrackAvatar").Inc()
+		return "", "", trackErrors.NewFailedToUploadAvatarError("failed to upload avatar")
+	}
 	duration := time.Since(start).Seconds()
-	r.metrics.DatabaseDuration.WithLabelValues("UploadTrack").Observe(duration)
+	r.metrics.DatabaseDuration.WithLabelValues("CopyTrackAvatar").Observe(duration)
 
-	return nil
+	return fmt.Sprintf("https://%s.s3.cloud.ru/tracks%s", r.imageBucketName, fileKey), processed.DominantColor, nil
 }
 
-func (r *trackS3Repository) UploadTrackAvatar(ctx context.Context, trackTitle string, file []byte) (string, error) {
+func (r *trackS3Repository) UploadRendition(ctx context.Context, fileKey string, file io.ReadSeeker, contentType string) error {
 	start := time.Now()
 	logger := loggerPkg.LoggerFromContext(ctx)
-	date := time.Now()
-	dateString := date.Format("20060102150405")
 
-	_, format, err := image.Decode(bytes.NewReader(file))
-	fmt.Println("FORMAT TRACK", format)
-	logger.Info("format", zap.String("format", format))
+	_, err := r.s3.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(r.trackBucketName),
+		Key:         aws.String(fileKey),
+		Body:        file,
+		ContentType: aws.String(contentType),
+	})
 	if err != nil {
-		r.metrics.DatabaseErrors.WithLabelValues("UploadTrackAvatar").Inc()
-		logger.Error("unsupported or invalid image format", zap.Error(err))
-		return "", trackErrors.NewUnsupportedImageFormatError("unsupported or invalid image format")
+		r.metrics.DatabaseErrors.WithLabelValues("UploadRendition").Inc()
+		logger.Error("failed to upload rendition", zap.String("key", fileKey), zap.Error(err))
+		return trackErrors.NewInternalError("failed to upload rendition: %v", err)
 	}
 
-	if format != "jpeg" && format != "png" && format != "gif" {
-		r.metrics.DatabaseErrors.WithLabelValues("UploadTrackAvatar").Inc()
-		logger.Error("unsupported image format", zap.String("format", format))
-		return "", trackErrors.NewUnsupportedImageFormatError("unsupported or invalid image format")
-	}
-
-	// webpBuf := new(bytes.Buffer)
-	// if err := webp.Encode(webpBuf, img, &webp.Options{Lossless: true}); err != nil {
-	// 	logger.Error("failed to encode webp", zap.Error(err))
-	// 	return "", userAvatarFile.ErrFailedToEncodeWebp
-	// }
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("UploadRendition").Observe(duration)
+	return nil
+}
 
-	fileKey := fmt.Sprintf("/%s-%s.%s", trackTitle, dateString, format)
-	s3Key := fmt.Sprintf("tracks%s", fileKey)
+// UploadEmbeddedCover stages a cover extracted from the tags next to the covers
+// labels upload themselves, reading the tags again overwrites it
+func (r *trackS3Repository) UploadEmbeddedCover(ctx context.Context, coverKey string, data []byte, format string) (string, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
 
-	_, err = r.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+	_, err := r.s3.PutObjectWithContext(ctx, &s3.PutObjectInput{
 		Bucket:      aws.String(r.imageBucketName),
-		Key:         aws.String(s3Key),
-		Body:        bytes.NewReader(file),
+		Key:         aws.String(coverKey),
+		Body:        bytes.NewReader(data),
 		ContentType: aws.String("image/" + format),
 		ACL:         aws.String("public-read"),
 	})
-
 	if err != nil {
-		r.metrics.DatabaseErrors.WithLabelValues("UploadTrackAvatar").Inc()
-		logger.Error("upload failed", zap.Error(err))
-		return "", trackErrors.NewFailedToUploadAvatarError("failed to upload avatar")
+		r.metrics.DatabaseErrors.WithLabelValues("UploadEmbeddedCover").Inc()
+		logger.Error("failed to upload embedded cover", zap.String("key", coverKey), zap.Error(err))
+		return "", trackErrors.NewFailedToUploadAvatarError("failed to upload embedded cover")
 	}
+
 	duration := time.Since(start).Seconds()
-	r.metrics.DatabaseDuration.WithLabelValues("UploadTrackAvatar").Observe(duration)
+	r.metrics.DatabaseDuration.WithLabelValues("UploadEmbeddedCover").Observe(duration)
+	return fmt.Sprintf("https://%s.s3.cloud.ru/%s", r.imageBucketName, coverKey), nil
+}
 
-	return fmt.Sprintf("https://%s.s3.cloud.ru/tracks%s", r.imageBucketName, fileKey), nil
+// DeleteEmbeddedCover removes a staged cover once the release copied it, a missing one is not an error
+func (r *trackS3Repository) DeleteEmbeddedCover(ctx context.Context, coverKey string) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	_, err := r.s3.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(r.imageBucketName),
+		Key:    aws.String(coverKey),
+	})
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("DeleteEmbeddedCover").Inc()
+		logger.Error("failed to delete embedded cover", zap.String("key", coverKey), zap.Error(err))
+		return trackErrors.NewInternalError("failed to delete embedded cover")
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("DeleteEmbeddedCover").Observe(duration)
+	return nil
 }
@@ -0,0 +1,46 @@
+package repository
+
+import (
+	"database/sql"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
+)
+
+// A track is searchable once its album is published. The album status changes in the album service,
+// so the in-memory index catches up on its next rebuild while the postgres backend is exact
+const (
+	SearchTrackIDsQuery = `
+		SELECT t.id
+		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		LEFT JOIN track_stats ts ON t.id = ts.track_id
+		WHERE t.search_vector @@ to_tsquery('multilingual', $1)
+		   OR similarity(t.title_trgm, $2) > 0.3
+		ORDER BY 
+		    CASE WHEN t.search_vector @@ to_tsquery('multilingual', $1) THEN 0 ELSE 1 END,
+		    (ts_rank(t.search_vector, to_tsquery('multilingual', $1)) + similarity(t.title_trgm, $2))
+		        * (1 + ln(1 + COALESCE(ts.listeners_count, 0) + COALESCE(ts.favorites_count, 0))) DESC,
+		    t.id DESC
+		LIMIT NULLIF($3, 0) OFFSET $4
+	`
+
+	GetTrackSearchDocumentsQuery = `
+		SELECT t.id, t.title || COALESCE(E'\n' || t.lyrics_text, ''), COALESCE(ts.listeners_count, 0) + COALESCE(ts.favorites_count, 0)
+		FROM track t
+		JOIN album a ON a.id = t.album_id AND a.status = 'published'
+		LEFT JOIN track_stats ts ON t.id = ts.track_id
+		WHERE $1::bigint[] IS NULL OR t.id = ANY($1)
+	`
+)
+
+// NewTrackPostgresSearchIndex is the search backend built on the generated search_vector and
+// title_trgm columns of the track table
+func NewTrackPostgresSearchIndex(db *sql.DB, metrics *metrics.Metrics) *searchIndex.PostgresIndex {
+	return searchIndex.NewPostgresIndex(db, metrics, searchIndex.PostgresQueries{
+		Entity:    "Track",
+		Search:    SearchTrackIDsQuery,
+		Documents: GetTrackSearchDocumentsQuery,
+	}, trackErrors.NewInternalError)
+}
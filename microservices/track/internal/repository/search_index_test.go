@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
+	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestTrackSearchIndexQueries(t *testing.T) {
+	// tracks follow the status of their album and lyrics are searchable along with the title
+	assert.Contains(t, SearchTrackIDsQuery, "a.status = 'published'")
+	assert.Contains(t, SearchTrackIDsQuery, "track_stats")
+	assert.Contains(t, GetTrackSearchDocumentsQuery, "a.status = 'published'")
+	assert.Contains(t, GetTrackSearchDocumentsQuery, "t.lyrics_text")
+}
+
+func TestTrackSearchIndexRunsItsQueries(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	index := NewTrackPostgresSearchIndex(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare(regexp.QuoteMeta(SearchTrackIDsQuery)).
+		ExpectQuery().
+		WithArgs("first:*", "first", int64(10), int64(0)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectPrepare(regexp.QuoteMeta(GetTrackSearchDocumentsQuery)).
+		ExpectQuery().
+		WithArgs(pq.Array([]int64{1})).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "text", "popularity"}).AddRow(1, "First Track\nfirst line of the lyrics", 10))
+
+	ids, err := index.Search(ctx, "first", 10, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	docs, err := index.LoadDocuments(ctx, ids)
+	require.NoError(t, err)
+	assert.Equal(t, []*searchIndex.Document{{ID: 1, Text: "First Track\nfirst line of the lyrics", Popularity: 10}}, docs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestTrackSearchIndexReturnsTrackErrors(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	index := NewTrackPostgresSearchIndex(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare(regexp.QuoteMeta(SearchTrackIDsQuery)).
+		ExpectQuery().
+		WillReturnError(errors.New("db error"))
+
+	ids, err := index.Search(ctx, "first", 10, 0)
+	assert.Nil(t, ids)
+	var trackErr *trackErrors.TrackError
+	require.ErrorAs(t, err, &trackErr)
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
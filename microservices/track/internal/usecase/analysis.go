@@ -0,0 +1,257 @@
+package usecase
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math"
+	"os"
+
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
+)
+
+// the analysis runs on mono audio decoded at a quarter of the cd sample rate,
+// everything above 5 kHz is of no use for tempo, key or a waveform
+const analysisSampleRate = 11025
+
+// waveformPeaks is enough for a scrubber on a wide screen
+const waveformPeaks = 1000
+
+const (
+	// onsetHop samples are reduced to one point of the onset envelope, about 23 ms
+	onsetHop = 256
+	// chromaFrame samples are analysed together for pitch, a multiple of onsetHop
+	chromaFrame = 4096
+)
+
+const (
+	minBPM = 60.0
+	maxBPM = 200.0
+	// tempos an octave away from this one are only picked when they are clearly stronger
+	preferredBPM = 120.0
+)
+
+// chroma is measured on the pitches from C3 to B6 as MIDI note numbers
+const (
+	lowestChromaNote  = 48
+	highestChromaNote = 95
+)
+
+var pitchClassNames = []string{"C", "C#", "D", "D#", "E", "F", "F#", "G", "G#", "A", "A#", "B"}
+
+// Krumhansl-Kessler key profiles, starting from the tonic
+var (
+	majorKeyProfile = []float64{6.35, 2.23, 3.48, 2.33, 4.38, 4.09, 2.52, 5.19, 2.39, 3.66, 2.29, 2.88}
+	minorKeyProfile = []float64{6.33, 2.68, 3.52, 5.38, 2.60, 3.53, 2.54, 4.75, 3.98, 2.69, 3.34, 3.17}
+)
+
+// analyzeFile reads the samples DecodePCM wrote
+func analyzeFile(pcmPath string) (*repoModel.TrackAnalysis, error) {
+	file, err := os.Open(pcmPath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return analyzeAudio(bufio.NewReader(file), info.Size()/2)
+}
+
+// analyzeAudio goes through 16 bit mono samples once, a track of any length only
+// keeps its waveform, onset envelope and chroma in memory
+func analyzeAudio(reader io.Reader, sampleCount int64) (*repoModel.TrackAnalysis, error) {
+	if sampleCount <= 0 {
+		return nil, errors.New("no samples to analyse")
+	}
+
+	peaks := make([]float64, min(waveformPeaks, sampleCount))
+	envelope := make([]float64, 0, sampleCount/onsetHop)
+	chroma := newChromaAnalyzer()
+
+	raw := make([]byte, 2*chromaFrame)
+	samples := make([]float64, chromaFrame)
+	var position int64
+	for position < sampleCount {
+		n, err := io.ReadFull(reader, raw[:2*min(chromaFrame, sampleCount-position)])
+		// a file shorter than it claimed to be is analysed as far as it goes
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, err
+		}
+		count := n / 2
+		if count == 0 {
+			break
+		}
+
+		for i := 0; i < count; i++ {
+			samples[i] = float64(int16(binary.LittleEndian.Uint16(raw[2*i:]))) / 32768
+			bucket := (position + int64(i)) * int64(len(peaks)) / sampleCount
+			peaks[bucket] = math.Max(peaks[bucket], math.Abs(samples[i]))
+		}
+		for start := 0; start+onsetHop <= count; start += onsetHop {
+			var energy float64
+			for _, sample := range samples[start : start+onsetHop] {
+				energy += sample * sample
+			}
+			// loudness is perceived logarithmically, so are beats
+			envelope = append(envelope, math.Log1p(1000*energy/onsetHop))
+		}
+		if count == chromaFrame {
+			chroma.add(samples)
+		}
+		position += int64(count)
+	}
+
+	for i := range peaks {
+		peaks[i] = math.Round(peaks[i]*1000) / 1000
+	}
+	return &repoModel.TrackAnalysis{
+		Waveform: peaks,
+		BPM:      estimateTempo(envelope, float64(analysisSampleRate)/onsetHop),
+		Key:      estimateKey(chroma.chroma),
+	}, nil
+}
+
+// estimateTempo finds the beat period as the strongest autocorrelation of the
+// onset strength, 0 when the track has no steady beat to speak of
+func estimateTempo(envelope []float64, frameRate float64) float64 {
+	minLag := int(math.Floor(60 * frameRate / maxBPM))
+	maxLag := int(math.Ceil(60 * frameRate / minBPM))
+	if len(envelope) < 4*maxLag {
+		return 0
+	}
+
+	// only rising energy marks an onset
+	onsets := make([]float64, len(envelope)-1)
+	var mean float64
+	for i := range onsets {
+		onsets[i] = math.Max(0, envelope[i+1]-envelope[i])
+		mean += onsets[i]
+	}
+	mean /= float64(len(onsets))
+	for i := range onsets {
+		onsets[i] -= mean
+	}
+
+	autocorrelation := make([]float64, maxLag+2)
+	for lag := minLag - 1; lag <= maxLag+1; lag++ {
+		var sum float64
+		for i := 0; i+lag < len(onsets); i++ {
+			sum += onsets[i] * onsets[i+lag]
+		}
+		autocorrelation[lag] = sum / float64(len(onsets)-lag)
+	}
+
+	best := -1
+	var bestScore float64
+	for lag := minLag; lag <= maxLag; lag++ {
+		if autocorrelation[lag] <= 0 {
+			continue
+		}
+		octaves := math.Log2(60 * frameRate / float64(lag) / preferredBPM)
+		score := autocorrelation[lag] * math.Exp(-0.5*octaves*octaves)
+		if best == -1 || score > bestScore {
+			best, bestScore = lag, score
+		}
+	}
+	if best == -1 {
+		return 0
+	}
+
+	// the beat rarely falls on a whole number of frames
+	period := float64(best)
+	before, peak, after := autocorrelation[best-1], autocorrelation[best], autocorrelation[best+1]
+	if curvature := before - 2*peak + after; curvature < 0 {
+		period += 0.5 * (before - after) / curvature
+	}
+	return math.Round(600*frameRate/period) / 10
+}
+
+// chromaAnalyzer sums the energy of every pitch class over the frames of a track
+type chromaAnalyzer struct {
+	window       []float64
+	coefficients []float64
+	chroma       []float64
+}
+
+func newChromaAnalyzer() *chromaAnalyzer {
+	window := make([]float64, chromaFrame)
+	for i := range window {
+		window[i] = 0.5 - 0.5*math.Cos(2*math.Pi*float64(i)/float64(chromaFrame-1))
+	}
+	coefficients := make([]float64, 0, highestChromaNote-lowestChromaNote+1)
+	for note := lowestChromaNote; note <= highestChromaNote; note++ {
+		frequency := 440 * math.Pow(2, float64(note-69)/12)
+		coefficients = append(coefficients, 2*math.Cos(2*math.Pi*frequency/analysisSampleRate))
+	}
+	return &chromaAnalyzer{
+		window:       window,
+		coefficients: coefficients,
+		chroma:       make([]float64, len(pitchClassNames)),
+	}
+}
+
+// add measures every pitch of a frame with the Goertzel algorithm, which is
+// cheaper than a full fft for a few dozen frequencies
+func (c *chromaAnalyzer) add(frame []float64) {
+	for i, coefficient := range c.coefficients {
+		var previous, beforePrevious float64
+		for j, sample := range frame {
+			current := sample*c.window[j] + coefficient*previous - beforePrevious
+			beforePrevious, previous = previous, current
+		}
+		power := previous*previous + beforePrevious*beforePrevious - coefficient*previous*beforePrevious
+		c.chroma[(lowestChromaNote+i)%12] += math.Sqrt(math.Max(power, 0))
+	}
+}
+
+// estimateKey picks the major or minor key whose profile correlates best with the chroma
+func estimateKey(chroma []float64) string {
+	var total float64
+	for _, value := range chroma {
+		total += value
+	}
+	if total == 0 {
+		return ""
+	}
+
+	var key string
+	bestCorrelation := math.Inf(-1)
+	for tonic := range pitchClassNames {
+		rotated := make([]float64, len(chroma))
+		for i := range rotated {
+			rotated[i] = chroma[(tonic+i)%len(chroma)]
+		}
+		if correlation := pearsonCorrelation(rotated, majorKeyProfile); correlation > bestCorrelation {
+			key, bestCorrelation = pitchClassNames[tonic]+" major", correlation
+		}
+		if correlation := pearsonCorrelation(rotated, minorKeyProfile); correlation > bestCorrelation {
+			key, bestCorrelation = pitchClassNames[tonic]+" minor", correlation
+		}
+	}
+	return key
+}
+
+func pearsonCorrelation(a, b []float64) float64 {
+	var meanA, meanB float64
+	for i := range a {
+		meanA += a[i]
+		meanB += b[i]
+	}
+	meanA /= float64(len(a))
+	meanB /= float64(len(b))
+
+	var covariance, varianceA, varianceB float64
+	for i := range a {
+		covariance += (a[i] - meanA) * (b[i] - meanB)
+		varianceA += (a[i] - meanA) * (a[i] - meanA)
+		varianceB += (b[i] - meanB) * (b[i] - meanB)
+	}
+	if varianceA == 0 || varianceB == 0 {
+		return 0
+	}
+	return covariance / math.Sqrt(varianceA*varianceB)
+}
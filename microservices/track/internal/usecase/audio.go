@@ -0,0 +1,224 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/tcolgate/mp3"
+)
+
+const (
+	audioFormatMP3  = "mp3"
+	audioFormatFLAC = "flac"
+	audioFormatWAV  = "wav"
+	audioFormatOGG  = "ogg"
+)
+
+// audioHeaderSize is enough to tell every supported container apart
+const audioHeaderSize = 12
+
+// even WAVE_FORMAT_EXTENSIBLE only needs 40 bytes
+const maxWavFormatSize = 1024
+
+var errAudioLength = errors.New("audio length is unknown")
+
+// detectAudioFormat looks at the magic bytes only, the file name and the
+// content type of an upload are whatever the label claimed them to be
+func detectAudioFormat(header []byte) string {
+	switch {
+	case bytes.HasPrefix(header, []byte("fLaC")):
+		return audioFormatFLAC
+	case len(header) >= 12 && bytes.HasPrefix(header, []byte("RIFF")) && bytes.Equal(header[8:12], []byte("WAVE")):
+		return audioFormatWAV
+	case bytes.HasPrefix(header, []byte("OggS")):
+		return audioFormatOGG
+	case bytes.HasPrefix(header, []byte("ID3")):
+		return audioFormatMP3
+	case len(header) >= 2 && header[0] == 0xFF && header[1]&0xE0 == 0xE0:
+		return audioFormatMP3
+	}
+	return ""
+}
+
+// getAudioDuration measures the track in seconds without decoding its samples
+// where the container already knows its length
+func getAudioDuration(reader *bufio.Reader) (string, int64, error) {
+	header, err := reader.Peek(audioHeaderSize)
+	if err != nil {
+		return "", 0, err
+	}
+
+	format := detectAudioFormat(header)
+	var duration int64
+	switch format {
+	case audioFormatMP3:
+		duration, err = getMp3Duration(reader)
+	case audioFormatFLAC:
+		duration, err = getFlacDuration(reader)
+	case audioFormatWAV:
+		duration, err = getWavDuration(reader)
+	case audioFormatOGG:
+		duration, err = getOggDuration(reader)
+	default:
+		err = errors.New("unsupported audio format")
+	}
+	return format, duration, err
+}
+
+func getMp3Duration(reader io.Reader) (int64, error) {
+	decoder := mp3.NewDecoder(reader)
+	var frame mp3.Frame
+	var skipped int
+	var duration time.Duration
+
+	for {
+		err := decoder.Decode(&frame, &skipped)
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return 0, err
+		}
+		duration += frame.Duration()
+	}
+
+	return int64(duration.Seconds()), nil
+}
+
+// getFlacDuration reads the STREAMINFO block that every flac file starts with
+func getFlacDuration(reader io.Reader) (int64, error) {
+	// "fLaC", the metadata block header and the 34 bytes of STREAMINFO
+	header := make([]byte, 4+4+34)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return 0, err
+	}
+	if header[4]&0x7F != 0 {
+		return 0, errors.New("flac stream does not start with STREAMINFO")
+	}
+
+	// 20 bits of sample rate, 3 of channels, 5 of bits per sample and 36 of total samples
+	packed := binary.BigEndian.Uint64(header[8+10 : 8+18])
+	sampleRate := packed >> 44
+	totalSamples := packed & (1<<36 - 1)
+	if sampleRate == 0 || totalSamples == 0 {
+		return 0, errAudioLength
+	}
+	return int64(totalSamples / sampleRate), nil
+}
+
+// getWavDuration walks the RIFF chunks up to the sample data
+func getWavDuration(reader io.Reader) (int64, error) {
+	if _, err := io.CopyN(io.Discard, reader, 12); err != nil {
+		return 0, err
+	}
+
+	var byteRate uint32
+	chunkHeader := make([]byte, 8)
+	for {
+		if _, err := io.ReadFull(reader, chunkHeader); err != nil {
+			return 0, err
+		}
+		id := string(chunkHeader[:4])
+		size := int64(binary.LittleEndian.Uint32(chunkHeader[4:]))
+
+		switch id {
+		case "fmt ":
+			if size < 16 || size > maxWavFormatSize {
+				return 0, errors.New("broken wav fmt chunk")
+			}
+			format := make([]byte, size)
+			if _, err := io.ReadFull(reader, format); err != nil {
+				return 0, err
+			}
+			byteRate = binary.LittleEndian.Uint32(format[8:12])
+		case "data":
+			if byteRate == 0 {
+				return 0, errAudioLength
+			}
+			return size / int64(byteRate), nil
+		default:
+			if _, err := io.CopyN(io.Discard, reader, size); err != nil {
+				return 0, err
+			}
+		}
+		// chunks are padded to an even size
+		if size%2 == 1 {
+			if _, err := io.CopyN(io.Discard, reader, 1); err != nil {
+				return 0, err
+			}
+		}
+	}
+}
+
+// getOggDuration reads the granule position of the last page of the first
+// logical stream, which counts samples for both vorbis and opus
+func getOggDuration(reader io.Reader) (int64, error) {
+	var (
+		serial      uint32
+		sampleRate  uint64
+		preSkip     uint64
+		lastGranule uint64
+		firstPage   = true
+	)
+
+	header := make([]byte, 27)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return 0, err
+		}
+		if !bytes.Equal(header[:4], []byte("OggS")) {
+			return 0, errors.New("broken ogg page")
+		}
+
+		segments := make([]byte, header[26])
+		if _, err := io.ReadFull(reader, segments); err != nil {
+			return 0, err
+		}
+		var bodySize int64
+		for _, segment := range segments {
+			bodySize += int64(segment)
+		}
+
+		pageSerial := binary.LittleEndian.Uint32(header[14:18])
+		if firstPage {
+			body := make([]byte, bodySize)
+			if _, err := io.ReadFull(reader, body); err != nil {
+				return 0, err
+			}
+			switch {
+			case len(body) >= 16 && bytes.HasPrefix(body, []byte("\x01vorbis")):
+				sampleRate = uint64(binary.LittleEndian.Uint32(body[12:16]))
+			case len(body) >= 12 && bytes.HasPrefix(body, []byte("OpusHead")):
+				// opus granule positions always count 48 kHz samples
+				sampleRate = 48000
+				preSkip = uint64(binary.LittleEndian.Uint16(body[10:12]))
+			default:
+				return 0, errors.New("unsupported ogg codec")
+			}
+			serial = pageSerial
+			firstPage = false
+			continue
+		}
+
+		if _, err := io.CopyN(io.Discard, reader, bodySize); err != nil {
+			return 0, err
+		}
+		granule := binary.LittleEndian.Uint64(header[6:14])
+		// pages that finish no packet carry -1
+		if pageSerial == serial && granule != ^uint64(0) {
+			lastGranule = granule
+		}
+	}
+
+	if sampleRate == 0 || lastGranule <= preSkip {
+		return 0, errAudioLength
+	}
+	return int64((lastGranule - preSkip) / sampleRate), nil
+}
@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"context"
+	"math/bits"
+
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model"
+	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
+	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/usecase"
+	"go.uber.org/zap"
+)
+
+// chromaprint emits about eight sub-fingerprints a second, uploads are aligned
+// against each other within ten seconds of silence or intro either way
+const maxFingerprintOffset = 80
+
+// fingerprintSimilarity is the share of matching bits at the best alignment of
+// two fingerprints, unrelated recordings score around one half
+func fingerprintSimilarity(a, b []int32) float64 {
+	shorter := min(len(a), len(b))
+	if shorter == 0 {
+		return 0
+	}
+	// an alignment that overlaps only a few seconds proves nothing
+	minOverlap := max(shorter/2, 1)
+
+	var best float64
+	for offset := -maxFingerprintOffset; offset <= maxFingerprintOffset; offset++ {
+		var bitErrors, overlap int
+		for i := max(0, -offset); i < len(a) && i+offset < len(b); i++ {
+			bitErrors += bits.OnesCount32(uint32(a[i] ^ b[i+offset]))
+			overlap++
+		}
+		if overlap < minOverlap {
+			continue
+		}
+		best = max(best, 1-float64(bitErrors)/float64(overlap*32))
+	}
+	return best
+}
+
+// findDuplicates rejects an upload that is the same recording as a catalog track
+// and returns the weaker matches to be flagged for review
+func (u *TrackUsecase) findDuplicates(ctx context.Context, subject string, fingerprint []int32, excludeTrackID int64) ([]*repoModel.DuplicateMatch, error) {
+	if len(fingerprint) == 0 {
+		return nil, nil
+	}
+
+	candidates, err := u.trackRepo.FindFingerprintCandidates(ctx, fingerprint, excludeTrackID, u.fingerprintCfg.MaxCandidates)
+	if err != nil {
+		return nil, err
+	}
+
+	var duplicates []*repoModel.DuplicateMatch
+	var rejected *repoModel.FingerprintCandidate
+	var rejectedSimilarity float64
+	for _, candidate := range candidates {
+		similarity := fingerprintSimilarity(fingerprint, candidate.Fingerprint)
+		switch {
+		case similarity >= u.fingerprintCfg.RejectSimilarity:
+			if similarity > rejectedSimilarity {
+				rejected, rejectedSimilarity = candidate, similarity
+			}
+		case similarity >= u.fingerprintCfg.FlagSimilarity:
+			duplicates = append(duplicates, &repoModel.DuplicateMatch{TrackID: candidate.TrackID, Similarity: similarity})
+		}
+	}
+
+	if rejected != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("rejecting duplicate upload", zap.String("subject", subject), zap.Int64("matchedTrackID", rejected.TrackID), zap.Float64("similarity", rejectedSimilarity))
+		return nil, trackErrors.NewAlreadyExistsError("%s is the same recording as track %d %q of album %d %q by label %d (%.0f%% similar)",
+			subject, rejected.TrackID, rejected.Title, rejected.AlbumID, rejected.AlbumTitle, rejected.LabelID, rejectedSimilarity*100)
+	}
+	return duplicates, nil
+}
+
+// saveFingerprint only logs failures, the track is already in the catalog and a
+// missing fingerprint just keeps it out of later duplicate checks
+func (u *TrackUsecase) saveFingerprint(ctx context.Context, fingerprint *repoModel.TrackFingerprint) {
+	if len(fingerprint.Fingerprint) == 0 {
+		return
+	}
+	if err := u.trackRepo.SaveTrackFingerprint(ctx, fingerprint); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to save track fingerprint", zap.Int64("trackID", fingerprint.TrackID), zap.Error(err))
+	}
+}
+
+// GetPendingDuplicateFlags is the admin review queue of uploads that resemble catalog tracks
+func (u *TrackUsecase) GetPendingDuplicateFlags(ctx context.Context, filters *usecaseModel.TrackFilters) ([]*usecaseModel.DuplicateFlag, error) {
+	repoFlags, err := u.trackRepo.GetPendingDuplicateFlags(ctx, model.FiltersFromUsecaseToRepository(filters))
+	if err != nil {
+		return nil, err
+	}
+	return model.DuplicateFlagListFromRepositoryToUsecase(repoFlags), nil
+}
+
+func (u *TrackUsecase) ResolveDuplicateFlag(ctx context.Context, flagID int64, status string) error {
+	if status != usecaseModel.DuplicateFlagStatusConfirmed && status != usecaseModel.DuplicateFlagStatusDismissed {
+		return trackErrors.ErrDuplicateFlagStatusInvalid
+	}
+	return u.trackRepo.ResolveDuplicateFlag(ctx, flagID, status)
+}
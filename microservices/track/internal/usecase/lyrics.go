@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
+)
+
+const (
+	maxLyricsLines      = 1000
+	maxLyricsLineLength = 500
+)
+
+var (
+	lyricsLanguagePattern = regexp.MustCompile(`^[a-z]{2,3}$`)
+	// [mm:ss], [mm:ss.xx] or [mm:ss.xxx], some editors put a colon before the fraction
+	lrcTimeTag = regexp.MustCompile(`^\[(\d+):(\d{1,2})(?:[.:](\d{1,3}))?\]`)
+	// id tags such as [ar:Artist] or [offset:+250] take a whole line
+	lrcIDTag = regexp.MustCompile(`^\[([a-z#]+):([^\]]*)\]$`)
+	// enhanced lrc times every word, karaoke by line does without it
+	lrcWordTag = regexp.MustCompile(`<\d+:\d{1,2}(?:[.:]\d{1,3})?>`)
+)
+
+// parseLyrics reads plain lyrics or LRC, a single time tag makes the whole text LRC.
+// Times are nil for plain lyrics.
+func parseLyrics(text string) ([]string, []int64, error) {
+	text = strings.ReplaceAll(text, "\r\n", "\n")
+	rawLines := strings.Split(strings.ReplaceAll(text, "\r", "\n"), "\n")
+
+	var lines []string
+	var times []int64
+	synced := false
+	for _, rawLine := range rawLines {
+		if lrcTimeTag.MatchString(strings.TrimSpace(rawLine)) {
+			synced = true
+			break
+		}
+	}
+	if synced {
+		lines, times = parseLRC(rawLines)
+	} else {
+		lines = parsePlainLyrics(rawLines)
+	}
+
+	if !validLyrics(lines) {
+		return nil, nil, trackErrors.ErrTrackLyricsInvalid
+	}
+	return lines, times, nil
+}
+
+func parseLRC(rawLines []string) ([]string, []int64) {
+	type timedLine struct {
+		time int64
+		text string
+	}
+
+	var offset int64
+	timed := make([]timedLine, 0, len(rawLines))
+	for _, rawLine := range rawLines {
+		rawLine = strings.TrimSpace(rawLine)
+		if tag := lrcIDTag.FindStringSubmatch(rawLine); tag != nil {
+			if tag[1] == "offset" {
+				if value, err := strconv.ParseInt(strings.TrimSpace(tag[2]), 10, 64); err == nil {
+					offset = value
+				}
+			}
+			continue
+		}
+
+		// a line sung several times lists all of its times
+		var lineTimes []int64
+		for {
+			tag := lrcTimeTag.FindStringSubmatch(rawLine)
+			if tag == nil {
+				break
+			}
+			lineTimes = append(lineTimes, lrcTime(tag))
+			rawLine = rawLine[len(tag[0]):]
+		}
+		// there is no telling when to show a line without a time
+		if len(lineTimes) == 0 {
+			continue
+		}
+
+		text := strings.Join(strings.Fields(lrcWordTag.ReplaceAllString(rawLine, "")), " ")
+		for _, lineTime := range lineTimes {
+			// a positive offset shows the lyrics sooner
+			timed = append(timed, timedLine{time: max(lineTime-offset, 0), text: text})
+		}
+	}
+
+	sort.SliceStable(timed, func(i, j int) bool {
+		return timed[i].time < timed[j].time
+	})
+
+	lines := make([]string, len(timed))
+	times := make([]int64, len(timed))
+	for i, line := range timed {
+		lines[i] = line.text
+		times[i] = line.time
+	}
+	return lines, times
+}
+
+func lrcTime(tag []string) int64 {
+	minutes, _ := strconv.ParseInt(tag[1], 10, 64)
+	seconds, _ := strconv.ParseInt(tag[2], 10, 64)
+	var milliseconds int64
+	if tag[3] != "" {
+		fraction, _ := strconv.ParseInt(tag[3], 10, 64)
+		for digits := len(tag[3]); digits < 3; digits++ {
+			fraction *= 10
+		}
+		milliseconds = fraction
+	}
+	return (minutes*60+seconds)*1000 + milliseconds
+}
+
+// parsePlainLyrics keeps stanzas apart by a single empty line, id tags of an
+// LRC file without times are dropped as well
+func parsePlainLyrics(rawLines []string) []string {
+	lines := make([]string, 0, len(rawLines))
+	for _, rawLine := range rawLines {
+		line := strings.Join(strings.Fields(rawLine), " ")
+		if lrcIDTag.MatchString(line) {
+			continue
+		}
+		if line == "" && (len(lines) == 0 || lines[len(lines)-1] == "") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func validLyrics(lines []string) bool {
+	if len(lines) == 0 || len(lines) > maxLyricsLines {
+		return false
+	}
+	hasText := false
+	for _, line := range lines {
+		if utf8.RuneCountInString(line) > maxLyricsLineLength {
+			return false
+		}
+		if line != "" {
+			hasText = true
+		}
+	}
+	return hasText
+}
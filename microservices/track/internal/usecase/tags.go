@@ -0,0 +1,580 @@
+package usecase
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// tags live at the start of the file, anything bigger than this is not worth
+// reading through just to prefill a form
+const maxTagsSize = 16 << 20
+
+// apicFrontCover is the picture type of the front cover in both ID3 and flac
+const apicFrontCover = 3
+
+// trackTags are the parts of the embedded tags a release can be prefilled from
+type trackTags struct {
+	title       string
+	trackNumber int64
+	artists     []string
+	genre       string
+	year        int64
+	isrc        string
+	cover       []byte
+	coverType   int
+}
+
+// setCover keeps the front cover when a file embeds several pictures
+func (t *trackTags) setCover(data []byte, pictureType int) {
+	if len(data) == 0 {
+		return
+	}
+	if t.cover == nil || (pictureType == apicFrontCover && t.coverType != apicFrontCover) {
+		t.cover = data
+		t.coverType = pictureType
+	}
+}
+
+// readTags parses ID3v2 tags of mp3 files and vorbis comments of flac and ogg
+// files, wav files carry no tags worth reading. Broken tags still return
+// whatever was read before the error.
+func readTags(reader *bufio.Reader) (string, *trackTags, error) {
+	header, err := reader.Peek(audioHeaderSize)
+	if err != nil {
+		return "", nil, err
+	}
+
+	format := detectAudioFormat(header)
+	tags := &trackTags{}
+	limited := io.LimitReader(reader, maxTagsSize)
+	switch {
+	case bytes.HasPrefix(header, []byte("ID3")):
+		err = readID3Tags(limited, tags)
+	case format == audioFormatFLAC:
+		err = readFlacTags(limited, tags)
+	case format == audioFormatOGG:
+		err = readOggTags(limited, tags)
+	case format == "":
+		err = errors.New("unsupported audio format")
+	}
+	return format, tags, err
+}
+
+// syncsafe integers keep the top bit of every byte clear
+func syncsafe(data []byte) int {
+	var value int
+	for _, b := range data {
+		value = value<<7 | int(b&0x7F)
+	}
+	return value
+}
+
+// removeUnsynchronisation drops the zero bytes inserted after every 0xFF
+func removeUnsynchronisation(data []byte) []byte {
+	return bytes.ReplaceAll(data, []byte{0xFF, 0x00}, []byte{0xFF})
+}
+
+func readID3Tags(reader io.Reader, tags *trackTags) error {
+	header := make([]byte, 10)
+	if _, err := io.ReadFull(reader, header); err != nil {
+		return err
+	}
+	version := header[3]
+	flags := header[5]
+	size := syncsafe(header[6:10])
+	if version < 2 || version > 4 {
+		return errors.New("unsupported ID3 version")
+	}
+	// the reader is limited anyway, this keeps a forged size from allocating up to 256 MiB
+	if size > maxTagsSize-len(header) {
+		return errors.New("ID3 tag is too big")
+	}
+
+	body := make([]byte, size)
+	if _, err := io.ReadFull(reader, body); err != nil {
+		return err
+	}
+	// ID3v2.4 marks unsynchronisation on every frame instead
+	if flags&0x80 != 0 && version < 4 {
+		body = removeUnsynchronisation(body)
+	}
+	if flags&0x40 != 0 && version > 2 {
+		if len(body) < 4 {
+			return errors.New("broken ID3 extended header")
+		}
+		extendedSize := int(binary.BigEndian.Uint32(body[:4])) + 4
+		if version == 4 {
+			extendedSize = syncsafe(body[:4])
+		}
+		if extendedSize > len(body) {
+			return errors.New("broken ID3 extended header")
+		}
+		body = body[extendedSize:]
+	}
+
+	idSize, headerSize := 4, 10
+	if version == 2 {
+		idSize, headerSize = 3, 6
+	}
+	for len(body) >= headerSize && body[0] != 0 {
+		id := string(body[:idSize])
+		var frameSize int
+		var frameFlags byte
+		switch version {
+		case 2:
+			frameSize = int(body[3])<<16 | int(body[4])<<8 | int(body[5])
+		case 3:
+			frameSize = int(binary.BigEndian.Uint32(body[4:8]))
+			frameFlags = body[9]
+		case 4:
+			frameSize = syncsafe(body[4:8])
+			frameFlags = body[9]
+		}
+		if frameSize > len(body)-headerSize {
+			return errors.New("broken ID3 frame")
+		}
+		frame := body[headerSize : headerSize+frameSize]
+		body = body[headerSize+frameSize:]
+
+		frame, ok := decodeID3FrameFlags(frame, version, frameFlags)
+		if !ok {
+			continue
+		}
+		readID3Frame(id, frame, tags)
+	}
+	return nil
+}
+
+// decodeID3FrameFlags strips what the frame flags add in front of the data,
+// compressed and encrypted frames are skipped
+func decodeID3FrameFlags(frame []byte, version byte, flags byte) ([]byte, bool) {
+	switch version {
+	case 3:
+		if flags&0xC0 != 0 {
+			return nil, false
+		}
+		// grouping identity
+		if flags&0x20 != 0 {
+			if len(frame) < 1 {
+				return nil, false
+			}
+			frame = frame[1:]
+		}
+	case 4:
+		if flags&0x0C != 0 {
+			return nil, false
+		}
+		if flags&0x40 != 0 {
+			if len(frame) < 1 {
+				return nil, false
+			}
+			frame = frame[1:]
+		}
+		if flags&0x01 != 0 {
+			if len(frame) < 4 {
+				return nil, false
+			}
+			frame = frame[4:]
+		}
+		if flags&0x02 != 0 {
+			frame = removeUnsynchronisation(frame)
+		}
+	}
+	return frame, true
+}
+
+func readID3Frame(id string, frame []byte, tags *trackTags) {
+	switch id {
+	case "TIT2", "TT2":
+		tags.title = firstValue(decodeID3Text(frame))
+	case "TPE1", "TP1":
+		tags.artists = decodeID3Text(frame)
+	case "TRCK", "TRK":
+		tags.trackNumber = parseTrackNumber(firstValue(decodeID3Text(frame)))
+	case "TCON", "TCO":
+		tags.genre = parseID3Genre(firstValue(decodeID3Text(frame)))
+	case "TYER", "TDRC", "TYE":
+		tags.year = parseYear(firstValue(decodeID3Text(frame)))
+	case "TSRC", "TRC":
+		tags.isrc = firstValue(decodeID3Text(frame))
+	case "APIC":
+		readID3Picture(frame, tags, false)
+	case "PIC":
+		readID3Picture(frame, tags, true)
+	}
+}
+
+// readID3Picture skips the mime type (a three letter format in ID3v2.2) and the
+// description up to the image data
+func readID3Picture(frame []byte, tags *trackTags, legacy bool) {
+	if len(frame) < 2 {
+		return
+	}
+	encoding := frame[0]
+	rest := frame[1:]
+	if legacy {
+		if len(rest) < 3 {
+			return
+		}
+		rest = rest[3:]
+	} else {
+		end := bytes.IndexByte(rest, 0)
+		if end < 0 {
+			return
+		}
+		rest = rest[end+1:]
+	}
+	if len(rest) < 1 {
+		return
+	}
+	pictureType := int(rest[0])
+	_, data := splitID3String(rest[1:], encoding)
+	tags.setCover(data, pictureType)
+}
+
+// splitID3String cuts a terminated string off the front of data, UTF-16
+// strings end with two zero bytes on an even offset
+func splitID3String(data []byte, encoding byte) ([]byte, []byte) {
+	if encoding == 1 || encoding == 2 {
+		for i := 0; i+1 < len(data); i += 2 {
+			if data[i] == 0 && data[i+1] == 0 {
+				return data[:i], data[i+2:]
+			}
+		}
+		return data, nil
+	}
+	end := bytes.IndexByte(data, 0)
+	if end < 0 {
+		return data, nil
+	}
+	return data[:end], data[end+1:]
+}
+
+// decodeID3Text returns every value of a text frame, ID3v2.4 separates them with zero bytes
+func decodeID3Text(frame []byte) []string {
+	if len(frame) < 1 {
+		return nil
+	}
+	encoding := frame[0]
+	data := frame[1:]
+
+	var values []string
+	for len(data) > 0 {
+		var value []byte
+		value, data = splitID3String(data, encoding)
+		if text := strings.TrimSpace(decodeID3String(value, encoding)); text != "" {
+			values = append(values, text)
+		}
+	}
+	return values
+}
+
+func decodeID3String(data []byte, encoding byte) string {
+	switch encoding {
+	case 0:
+		// ISO-8859-1 maps one to one onto the first unicode code points
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+		}
+		return string(runes)
+	case 1, 2:
+		bigEndian := encoding == 2
+		if len(data) >= 2 {
+			switch {
+			case data[0] == 0xFE && data[1] == 0xFF:
+				bigEndian, data = true, data[2:]
+			case data[0] == 0xFF && data[1] == 0xFE:
+				bigEndian, data = false, data[2:]
+			}
+		}
+		units := make([]uint16, len(data)/2)
+		for i := range units {
+			if bigEndian {
+				units[i] = binary.BigEndian.Uint16(data[2*i:])
+			} else {
+				units[i] = binary.LittleEndian.Uint16(data[2*i:])
+			}
+		}
+		return string(utf16.Decode(units))
+	default:
+		return string(data)
+	}
+}
+
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+// parseTrackNumber reads "3" as well as "3/12"
+func parseTrackNumber(value string) int64 {
+	number, _, _ := strings.Cut(value, "/")
+	position, err := strconv.ParseInt(strings.TrimSpace(number), 10, 64)
+	if err != nil || position < 0 {
+		return 0
+	}
+	return position
+}
+
+// parseYear takes the year of a full recording date such as 2021-04-09
+func parseYear(value string) int64 {
+	if len(value) < 4 {
+		return 0
+	}
+	year, err := strconv.ParseInt(value[:4], 10, 64)
+	if err != nil {
+		return 0
+	}
+	return year
+}
+
+// parseID3Genre resolves the "(17)" and "17" references to the ID3v1 genre list
+// that older taggers write instead of the name
+func parseID3Genre(value string) string {
+	reference := value
+	if strings.HasPrefix(value, "(") {
+		end := strings.IndexByte(value, ')')
+		if end < 0 {
+			return value
+		}
+		if name := strings.TrimSpace(value[end+1:]); name != "" {
+			return name
+		}
+		reference = value[1:end]
+	}
+	index, err := strconv.Atoi(reference)
+	if err != nil {
+		return value
+	}
+	if index < 0 || index >= len(id3v1Genres) {
+		return ""
+	}
+	return id3v1Genres[index]
+}
+
+var id3v1Genres = []string{
+	"Blues", "Classic Rock", "Country", "Dance", "Disco", "Funk", "Grunge", "Hip-Hop",
+	"Jazz", "Metal", "New Age", "Oldies", "Other", "Pop", "R&B", "Rap",
+	"Reggae", "Rock", "Techno", "Industrial", "Alternative", "Ska", "Death Metal", "Pranks",
+	"Soundtrack", "Euro-Techno", "Ambient", "Trip-Hop", "Vocal", "Jazz+Funk", "Fusion", "Trance",
+	"Classical", "Instrumental", "Acid", "House", "Game", "Sound Clip", "Gospel", "Noise",
+	"Alternative Rock", "Bass", "Soul", "Punk", "Space", "Meditative", "Instrumental Pop", "Instrumental Rock",
+	"Ethnic", "Gothic", "Darkwave", "Techno-Industrial", "Electronic", "Pop-Folk", "Eurodance", "Dream",
+	"Southern Rock", "Comedy", "Cult", "Gangsta", "Top 40", "Christian Rap", "Pop/Funk", "Jungle",
+	"Native American", "Cabaret", "New Wave", "Psychedelic", "Rave", "Showtunes", "Trailer", "Lo-Fi",
+	"Tribal", "Acid Punk", "Acid Jazz", "Polka", "Retro", "Musical", "Rock & Roll", "Hard Rock",
+}
+
+// readFlacTags walks the metadata blocks in front of the first audio frame
+func readFlacTags(reader io.Reader, tags *trackTags) error {
+	if _, err := io.CopyN(io.Discard, reader, 4); err != nil {
+		return err
+	}
+
+	header := make([]byte, 4)
+	for {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			return err
+		}
+		last := header[0]&0x80 != 0
+		blockType := header[0] & 0x7F
+		size := int64(header[1])<<16 | int64(header[2])<<8 | int64(header[3])
+
+		switch blockType {
+		case 4, 6:
+			block := make([]byte, size)
+			if _, err := io.ReadFull(reader, block); err != nil {
+				return err
+			}
+			if blockType == 4 {
+				readVorbisComments(block, tags)
+			} else {
+				readFlacPicture(block, tags)
+			}
+		default:
+			if _, err := io.CopyN(io.Discard, reader, size); err != nil {
+				return err
+			}
+		}
+		if last {
+			return nil
+		}
+	}
+}
+
+// readFlacPicture reads a PICTURE block, ogg files embed the same structure in base64
+func readFlacPicture(block []byte, tags *trackTags) {
+	next := func(size int) []byte {
+		if size < 0 || size > len(block) {
+			block = nil
+			return nil
+		}
+		value := block[:size]
+		block = block[size:]
+		return value
+	}
+	nextUint32 := func() int {
+		value := next(4)
+		if value == nil {
+			return -1
+		}
+		return int(binary.BigEndian.Uint32(value))
+	}
+
+	pictureType := nextUint32()
+	next(nextUint32()) // mime type
+	next(nextUint32()) // description
+	next(16)           // width, height, colour depth and palette size
+	data := next(nextUint32())
+	if pictureType < 0 || data == nil {
+		return
+	}
+	tags.setCover(data, pictureType)
+}
+
+// readVorbisComments reads the KEY=value list that flac, vorbis and opus share
+func readVorbisComments(block []byte, tags *trackTags) {
+	next := func() ([]byte, bool) {
+		if len(block) < 4 {
+			return nil, false
+		}
+		size := int(binary.LittleEndian.Uint32(block[:4]))
+		if size < 0 || size > len(block)-4 {
+			return nil, false
+		}
+		value := block[4 : 4+size]
+		block = block[4+size:]
+		return value, true
+	}
+
+	if _, ok := next(); !ok { // vendor string
+		return
+	}
+	if len(block) < 4 {
+		return
+	}
+	count := int(binary.LittleEndian.Uint32(block[:4]))
+	block = block[4:]
+
+	for i := 0; i < count; i++ {
+		comment, ok := next()
+		if !ok {
+			return
+		}
+		key, value, found := strings.Cut(string(comment), "=")
+		if !found {
+			continue
+		}
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+
+		switch strings.ToUpper(key) {
+		case "TITLE":
+			tags.title = value
+		case "ARTIST":
+			tags.artists = append(tags.artists, value)
+		case "TRACKNUMBER":
+			tags.trackNumber = parseTrackNumber(value)
+		case "GENRE":
+			tags.genre = value
+		case "DATE", "YEAR":
+			tags.year = parseYear(value)
+		case "ISRC":
+			tags.isrc = value
+		case "METADATA_BLOCK_PICTURE":
+			picture, err := base64.StdEncoding.DecodeString(value)
+			if err == nil {
+				readFlacPicture(picture, tags)
+			}
+		}
+	}
+}
+
+// readOggTags reassembles the comment header, the second packet of the first
+// logical stream, which spans several pages when a cover is embedded
+func readOggTags(reader io.Reader, tags *trackTags) error {
+	packets, err := readOggPackets(reader, 2)
+	if err != nil {
+		return err
+	}
+
+	comments := packets[1]
+	switch {
+	case bytes.HasPrefix(comments, []byte("\x03vorbis")):
+		readVorbisComments(comments[7:], tags)
+	case bytes.HasPrefix(comments, []byte("OpusTags")):
+		readVorbisComments(comments[8:], tags)
+	default:
+		return errors.New("unsupported ogg codec")
+	}
+	return nil
+}
+
+// readOggPackets joins page segments into packets until count packets of the
+// first logical stream are complete
+func readOggPackets(reader io.Reader, count int) ([][]byte, error) {
+	var (
+		serial    uint32
+		firstPage = true
+		packets   [][]byte
+		packet    []byte
+	)
+
+	header := make([]byte, 27)
+	for len(packets) < count {
+		if _, err := io.ReadFull(reader, header); err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(header[:4], []byte("OggS")) {
+			return nil, errors.New("broken ogg page")
+		}
+
+		segments := make([]byte, header[26])
+		if _, err := io.ReadFull(reader, segments); err != nil {
+			return nil, err
+		}
+		var bodySize int
+		for _, segment := range segments {
+			bodySize += int(segment)
+		}
+		body := make([]byte, bodySize)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			return nil, err
+		}
+
+		pageSerial := binary.LittleEndian.Uint32(header[14:18])
+		if firstPage {
+			serial = pageSerial
+			firstPage = false
+		}
+		if pageSerial != serial {
+			continue
+		}
+
+		// a lacing value below 255 ends the packet
+		for _, segment := range segments {
+			packet = append(packet, body[:segment]...)
+			body = body[segment:]
+			if segment < 255 {
+				packets = append(packets, packet)
+				packet = nil
+				if len(packets) == count {
+					break
+				}
+			}
+		}
+	}
+	return packets, nil
+}
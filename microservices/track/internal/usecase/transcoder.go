@@ -0,0 +1,219 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
+	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/domain"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
+	"go.uber.org/zap"
+)
+
+// ReplayGain 2.0 normalizes every track to -18 LUFS
+const replayGainReferenceLUFS = -18.0
+
+// renditionProfiles are produced for every track whatever its master is
+var renditionProfiles = []*repoModel.RenditionProfile{
+	{Codec: "mp3", Bitrate: 128, Format: "mp3", Extension: "mp3", ContentType: "audio/mpeg"},
+	{Codec: "mp3", Bitrate: 320, Format: "mp3", Extension: "mp3", ContentType: "audio/mpeg"},
+	{Codec: "opus", Bitrate: 160, Format: "ogg", Extension: "ogg", ContentType: "audio/ogg"},
+}
+
+// hlsProfiles are the variants of the adaptive stream, players pick one
+// by the bandwidth they measure and switch at any segment boundary
+var hlsProfiles = []*repoModel.RenditionProfile{
+	{Codec: "aac", Bitrate: 64, Format: "hls", Extension: "ts", ContentType: "video/mp2t"},
+	{Codec: "aac", Bitrate: 128, Format: "hls", Extension: "ts", ContentType: "video/mp2t"},
+	{Codec: "aac", Bitrate: 256, Format: "hls", Extension: "ts", ContentType: "video/mp2t"},
+}
+
+// defaultRendition is streamed to clients that do not pick a rendition themselves
+var defaultRendition = renditionProfiles[1]
+
+type TranscodeWorker struct {
+	trackRepo  domain.Repository
+	s3Repo     domain.S3Repository
+	transcoder domain.Transcoder
+	cfg        config.TranscodeConfig
+}
+
+func NewTranscodeWorker(trackRepo domain.Repository, s3Repo domain.S3Repository, transcoder domain.Transcoder, cfg config.TranscodeConfig) *TranscodeWorker {
+	return &TranscodeWorker{trackRepo: trackRepo, s3Repo: s3Repo, transcoder: transcoder, cfg: cfg}
+}
+
+// renditionKey keeps the renditions next to each other and apart from the ones
+// of a previous file of the same track
+func renditionKey(fileKey string, profile *repoModel.RenditionProfile) string {
+	base := strings.TrimSuffix(fileKey, path.Ext(fileKey))
+	return fmt.Sprintf("renditions/%s/%s-%d.%s", strings.TrimPrefix(base, "/"), profile.Codec, profile.Bitrate, profile.Extension)
+}
+
+func segmentKey(fileKey string, profile *repoModel.RenditionProfile, position int64) string {
+	base := strings.TrimSuffix(fileKey, path.Ext(fileKey))
+	return fmt.Sprintf("hls/%s/%s-%d/%05d.%s", strings.TrimPrefix(base, "/"), profile.Codec, profile.Bitrate, position, profile.Extension)
+}
+
+// Run transcodes queued tracks one by one until the queue is empty and then waits for the next tick
+func (w *TranscodeWorker) Run(ctx context.Context) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	ticker := time.NewTicker(w.cfg.Interval)
+	defer ticker.Stop()
+
+	for {
+		for ctx.Err() == nil {
+			processed, err := w.processNext(ctx)
+			if err != nil {
+				logger.Error("failed to process transcode job", zap.Error(err))
+				break
+			}
+			if !processed {
+				break
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (w *TranscodeWorker) processNext(ctx context.Context) (bool, error) {
+	job, err := w.trackRepo.ClaimTranscodeJob(ctx, w.cfg.StaleAfter)
+	if err != nil {
+		return false, err
+	}
+	if job == nil {
+		return false, nil
+	}
+
+	if err := w.transcode(ctx, job); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Error("failed to transcode track", zap.Int64("trackID", job.TrackID), zap.Int64("attempt", job.Attempts), zap.Error(err))
+		if err := w.trackRepo.FailTranscodeJob(ctx, job, w.cfg.MaxAttempts); err != nil {
+			return true, err
+		}
+	}
+	return true, nil
+}
+
+func (w *TranscodeWorker) transcode(ctx context.Context, job *repoModel.TranscodeJob) error {
+	workDir, err := os.MkdirTemp(w.cfg.WorkDir, "transcode-*")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(workDir)
+
+	sourcePath := filepath.Join(workDir, "source."+job.SourceFormat)
+	if err := downloadTrack(ctx, w.s3Repo, job.FileKey, sourcePath); err != nil {
+		return err
+	}
+
+	loudness, err := w.transcoder.MeasureLoudness(ctx, sourcePath)
+	if err != nil {
+		return err
+	}
+
+	pcmPath := filepath.Join(workDir, "analysis.pcm")
+	if err := w.transcoder.DecodePCM(ctx, sourcePath, analysisSampleRate, pcmPath); err != nil {
+		return err
+	}
+	analysis, err := analyzeFile(pcmPath)
+	if err != nil {
+		return err
+	}
+
+	renditions := make([]*repoModel.TrackRendition, 0, len(renditionProfiles))
+	for _, profile := range renditionProfiles {
+		destinationPath := filepath.Join(workDir, fmt.Sprintf("%s-%d.%s", profile.Codec, profile.Bitrate, profile.Extension))
+		if err := w.transcoder.Transcode(ctx, sourcePath, profile, destinationPath); err != nil {
+			return err
+		}
+
+		fileKey := renditionKey(job.FileKey, profile)
+		if err := w.upload(ctx, destinationPath, fileKey, profile.ContentType); err != nil {
+			return err
+		}
+		renditions = append(renditions, &repoModel.TrackRendition{
+			Codec:   profile.Codec,
+			Bitrate: profile.Bitrate,
+			FileKey: fileKey,
+		})
+	}
+
+	segments := make([]*repoModel.TrackSegment, 0)
+	for _, profile := range hlsProfiles {
+		variantDir := filepath.Join(workDir, fmt.Sprintf("hls-%s-%d", profile.Codec, profile.Bitrate))
+		if err := os.Mkdir(variantDir, 0o700); err != nil {
+			return err
+		}
+
+		hlsSegments, err := w.transcoder.Segment(ctx, sourcePath, profile, variantDir)
+		if err != nil {
+			return err
+		}
+
+		for _, hlsSegment := range hlsSegments {
+			fileKey := segmentKey(job.FileKey, profile, hlsSegment.Position)
+			if err := w.upload(ctx, hlsSegment.Path, fileKey, profile.ContentType); err != nil {
+				return err
+			}
+			segments = append(segments, &repoModel.TrackSegment{
+				Codec:    profile.Codec,
+				Bitrate:  profile.Bitrate,
+				Position: hlsSegment.Position,
+				Duration: hlsSegment.Duration,
+				FileKey:  fileKey,
+			})
+		}
+	}
+
+	return w.trackRepo.CompleteTranscodeJob(ctx, &repoModel.TranscodeResult{
+		TrackID:      job.TrackID,
+		FileKey:      job.FileKey,
+		Renditions:   renditions,
+		Segments:     segments,
+		LoudnessLUFS: loudness.IntegratedLUFS,
+		TruePeakDB:   loudness.TruePeakDB,
+		ReplayGainDB: replayGainReferenceLUFS - loudness.IntegratedLUFS,
+		Analysis:     analysis,
+	})
+}
+
+// downloadTrack copies an uploaded file to disk for the tools that only work on local files
+func downloadTrack(ctx context.Context, s3Repo domain.S3Repository, fileKey string, destinationPath string) error {
+	source, err := s3Repo.OpenTrack(ctx, fileKey)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	destination, err := os.Create(destinationPath)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(destination, source); err != nil {
+		destination.Close()
+		return err
+	}
+	return destination.Close()
+}
+
+func (w *TranscodeWorker) upload(ctx context.Context, sourcePath string, fileKey string, contentType string) error {
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return w.s3Repo.UploadRendition(ctx, fileKey, file, contentType)
+}
@@ -1,27 +1,49 @@
 package usecase
 
 import (
+	"bufio"
 	"bytes"
 	"context"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
 	"io"
-	"time"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/domain"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model"
 	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
+	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/usecase"
-	"github.com/tcolgate/mp3"
 	"go.uber.org/zap"
 )
 
 type TrackUsecase struct {
-	trackRepo domain.Repository
-	s3Repo    domain.S3Repository
+	trackRepo      domain.Repository
+	s3Repo         domain.S3Repository
+	searchIndex    searchIndex.Index
+	fingerprinter  domain.Fingerprinter
+	fingerprintCfg config.FingerprintConfig
 }
 
-func NewTrackUsecase(trackRepo domain.Repository, s3Repo domain.S3Repository) domain.Usecase {
-	return &TrackUsecase{trackRepo: trackRepo, s3Repo: s3Repo}
+// NewTrackUsecase skips duplicate detection when no fingerprinter is given
+func NewTrackUsecase(trackRepo domain.Repository, s3Repo domain.S3Repository, searchIndex searchIndex.Index, fingerprinter domain.Fingerprinter, fingerprintCfg config.FingerprintConfig) domain.Usecase {
+	return &TrackUsecase{
+		trackRepo:      trackRepo,
+		s3Repo:         s3Repo,
+		searchIndex:    searchIndex,
+		fingerprinter:  fingerprinter,
+		fingerprintCfg: fingerprintCfg,
+	}
 }
 
 func (u *TrackUsecase) GetAllTracks(ctx context.Context, filters *usecaseModel.TrackFilters, userID int64) ([]*usecaseModel.Track, error) {
@@ -39,12 +61,35 @@ func (u *TrackUsecase) GetTrackByID(ctx context.Context, id int64, userID int64)
 		return nil, err
 	}
 
-	trackFileUrl, err := u.s3Repo.GetPresignedURL(repoTrack.FileKey)
+	repoRenditions, err := u.trackRepo.GetTrackRenditions(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// the original upload is only streamed until the track is transcoded
+	fileKey := repoTrack.FileKey
+	renditions := make([]*usecaseModel.TrackRendition, 0, len(repoRenditions))
+	for _, repoRendition := range repoRenditions {
+		if repoRendition.Codec == defaultRendition.Codec && repoRendition.Bitrate == defaultRendition.Bitrate {
+			fileKey = repoRendition.FileKey
+		}
+		renditionUrl, err := u.s3Repo.GetPresignedURL(repoRendition.FileKey)
+		if err != nil {
+			return nil, err
+		}
+		renditions = append(renditions, &usecaseModel.TrackRendition{
+			Codec:   repoRendition.Codec,
+			Bitrate: repoRendition.Bitrate,
+			FileUrl: renditionUrl,
+		})
+	}
+
+	trackFileUrl, err := u.s3Repo.GetPresignedURL(fileKey)
 	if err != nil {
 		return nil, err
 	}
 
-	return model.TrackDetailedFromRepositoryToUsecase(repoTrack, trackFileUrl), nil
+	return model.TrackDetailedFromRepositoryToUsecase(repoTrack, trackFileUrl, renditions), nil
 }
 
 func (u *TrackUsecase) CreateStream(ctx context.Context, stream *usecaseModel.TrackStreamCreateData) (int64, error) {
@@ -76,6 +121,76 @@ func (u *TrackUsecase) UpdateStreamDuration(ctx context.Context, stream *usecase
 	return nil
 }
 
+// GetStreamManifest lists the hls variants of the streamed track, the segments
+// themselves are only handed out one by one so that every fetch is counted
+func (u *TrackUsecase) GetStreamManifest(ctx context.Context, streamID int64, userID int64) ([]*usecaseModel.StreamVariant, error) {
+	stream, err := u.ownStream(ctx, streamID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	repoSegments, err := u.trackRepo.GetTrackSegments(ctx, stream.TrackID)
+	if err != nil {
+		return nil, err
+	}
+	if len(repoSegments) == 0 {
+		return nil, trackErrors.ErrTrackSegmentsNotFound
+	}
+
+	// segments come ordered by bitrate and position
+	variants := make([]*usecaseModel.StreamVariant, 0, len(hlsProfiles))
+	var variant *usecaseModel.StreamVariant
+	for _, repoSegment := range repoSegments {
+		if variant == nil || variant.Bitrate != repoSegment.Bitrate {
+			variant = &usecaseModel.StreamVariant{Codec: repoSegment.Codec, Bitrate: repoSegment.Bitrate}
+			variants = append(variants, variant)
+		}
+		variant.Segments = append(variant.Segments, &usecaseModel.StreamSegment{
+			Position: repoSegment.Position,
+			Duration: repoSegment.Duration,
+		})
+	}
+	return variants, nil
+}
+
+// GetStreamSegment counts the segment towards the stream duration before handing out its url
+func (u *TrackUsecase) GetStreamSegment(ctx context.Context, request *usecaseModel.StreamSegmentRequest) (string, error) {
+	stream, err := u.ownStream(ctx, request.StreamID, request.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	segment, err := u.trackRepo.GetTrackSegment(ctx, stream.TrackID, request.Bitrate, request.Position)
+	if err != nil {
+		return "", err
+	}
+
+	err = u.trackRepo.RecordStreamSegment(ctx, &repoModel.StreamSegmentFetch{
+		StreamID: stream.ID,
+		Position: segment.Position,
+		Duration: segment.Duration,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return u.s3Repo.GetPresignedURL(segment.FileKey)
+}
+
+func (u *TrackUsecase) ownStream(ctx context.Context, streamID int64, userID int64) (*repoModel.TrackStream, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+	stream, err := u.trackRepo.GetStreamByID(ctx, streamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stream.UserID != userID {
+		logger.Warn("stream doesn't belong to user", zap.Error(trackErrors.ErrStreamPermissionDenied))
+		return nil, trackErrors.ErrStreamPermissionDenied
+	}
+	return stream, nil
+}
+
 func (u *TrackUsecase) GetLastListenedTracks(ctx context.Context, userID int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error) {
 	repoFilters := model.FiltersFromUsecaseToRepository(filters)
 	repoStreams, err := u.trackRepo.GetStreamsByUserID(ctx, userID, repoFilters)
@@ -147,6 +262,67 @@ func (u *TrackUsecase) GetAlbumIDByTrackID(ctx context.Context, id int64) (int64
 	return repoAlbumID, nil
 }
 
+func (u *TrackUsecase) GetTrackWaveform(ctx context.Context, trackID int64) (*usecaseModel.TrackWaveform, error) {
+	repoWaveform, err := u.trackRepo.GetTrackWaveform(ctx, trackID)
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackWaveformFromRepositoryToUsecase(repoWaveform), nil
+}
+
+// SaveTrackLyrics stores plain or LRC lyrics of one language, uploading the same
+// language again replaces them
+func (u *TrackUsecase) SaveTrackLyrics(ctx context.Context, upload *usecaseModel.TrackLyricsUpload) (*usecaseModel.TrackLyrics, error) {
+	language := strings.ToLower(strings.TrimSpace(upload.Language))
+	if !lyricsLanguagePattern.MatchString(language) {
+		return nil, trackErrors.ErrTrackLyricsLanguageInvalid
+	}
+
+	lines, times, err := parseLyrics(upload.Text)
+	if err != nil {
+		return nil, err
+	}
+
+	lyrics := &repoModel.TrackLyrics{
+		TrackID:     upload.TrackID,
+		AlbumID:     upload.AlbumID,
+		Language:    language,
+		Translation: upload.Translation,
+		Lines:       lines,
+		LineTimes:   times,
+	}
+	if err := u.trackRepo.SaveTrackLyrics(ctx, lyrics); err != nil {
+		return nil, err
+	}
+
+	if err := u.searchIndex.Refresh(ctx, []int64{upload.TrackID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+	return model.TrackLyricsFromRepositoryToUsecase(lyrics), nil
+}
+
+func (u *TrackUsecase) DeleteTrackLyrics(ctx context.Context, request *usecaseModel.TrackLyricsDelete) error {
+	request.Language = strings.ToLower(strings.TrimSpace(request.Language))
+	if err := u.trackRepo.DeleteTrackLyrics(ctx, model.TrackLyricsDeleteFromUsecaseToRepository(request)); err != nil {
+		return err
+	}
+
+	if err := u.searchIndex.Refresh(ctx, []int64{request.TrackID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+	return nil
+}
+
+func (u *TrackUsecase) GetTrackLyrics(ctx context.Context, trackID int64) ([]*usecaseModel.TrackLyrics, error) {
+	repoLyrics, err := u.trackRepo.GetTrackLyrics(ctx, trackID)
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackLyricsListFromRepositoryToUsecase(repoLyrics), nil
+}
+
 func (u *TrackUsecase) GetTracksByAlbumID(ctx context.Context, id int64, userID int64) ([]*usecaseModel.Track, error) {
 	repoTracks, err := u.trackRepo.GetTracksByAlbumID(ctx, id, userID)
 	if err != nil {
@@ -212,66 +388,147 @@ func (u *TrackUsecase) GetFavoriteTracks(ctx context.Context, favoriteRequest *u
 	return model.TrackListFromRepositoryToUsecase(repoTracks), nil
 }
 
-func (u *TrackUsecase) SearchTracks(ctx context.Context, query string, userID int64) ([]*usecaseModel.Track, error) {
-	repoTracks, err := u.trackRepo.SearchTracks(ctx, query, userID)
+func (u *TrackUsecase) SearchTracks(ctx context.Context, query string, userID int64, filters *usecaseModel.TrackFilters) ([]*usecaseModel.Track, error) {
+	ids, err := u.searchIndex.Search(ctx, query, filters.Pagination.Limit, filters.Pagination.Offset)
+	if err != nil {
+		return nil, err
+	}
+
+	tracksMap, err := u.trackRepo.GetTracksByIDs(ctx, ids, userID)
 	if err != nil {
 		return nil, err
 	}
+
+	repoTracks := make([]*repoModel.Track, 0, len(ids))
+	for _, id := range ids {
+		if track, ok := tracksMap[id]; ok {
+			repoTracks = append(repoTracks, track)
+		}
+	}
 	return model.TrackListFromRepositoryToUsecase(repoTracks), nil
 }
 
-func getMp3Duration(fileData []byte) (int64, error) {
-	reader := bytes.NewReader(fileData)
-	decoder := mp3.NewDecoder(reader)
-	var frame mp3.Frame
-	var skipped int
-	var duration time.Duration
+type probedTrack struct {
+	format      string
+	duration    int64
+	fingerprint []int32
+}
 
-	for {
-		err := decoder.Decode(&frame, &skipped)
+// probeTrack streams an already uploaded track from storage to detect its format
+// and measure it, so the file never has to be held in memory as a whole.
+// Fingerprinting needs a local file, so with a fingerprinter the track is downloaded once instead.
+func (u *TrackUsecase) probeTrack(ctx context.Context, fileKey string) (*probedTrack, error) {
+	if u.fingerprinter == nil {
+		file, err := u.s3Repo.OpenTrack(ctx, fileKey)
 		if err != nil {
-			if err == io.EOF {
-				break
-			}
-			return 0, err
+			return nil, err
 		}
-		duration += frame.Duration()
+		defer file.Close()
+		return measureTrack(ctx, fileKey, file)
+	}
+
+	workDir, err := os.MkdirTemp(u.fingerprintCfg.WorkDir, "fingerprint-*")
+	if err != nil {
+		return nil, trackErrors.NewInternalError("failed to create fingerprint directory: %v", err)
+	}
+	defer os.RemoveAll(workDir)
+
+	sourcePath := filepath.Join(workDir, "source")
+	if err := downloadTrack(ctx, u.s3Repo, fileKey, sourcePath); err != nil {
+		return nil, err
+	}
+	file, err := os.Open(sourcePath)
+	if err != nil {
+		return nil, trackErrors.NewInternalError("failed to open downloaded track: %v", err)
+	}
+	defer file.Close()
+
+	track, err := measureTrack(ctx, fileKey, file)
+	if err != nil {
+		return nil, err
+	}
+
+	// a track that cannot be fingerprinted is still accepted, it just escapes duplicate detection
+	track.fingerprint, err = u.fingerprinter.Fingerprint(ctx, sourcePath)
+	if err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to fingerprint uploaded track", zap.String("key", fileKey), zap.Error(err))
 	}
+	return track, nil
+}
 
-	return int64(duration.Seconds()), nil
+func measureTrack(ctx context.Context, fileKey string, file io.Reader) (*probedTrack, error) {
+	format, duration, err := getAudioDuration(bufio.NewReader(file))
+	if err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to probe uploaded track", zap.String("key", fileKey), zap.String("format", format), zap.Error(err))
+		return nil, trackErrors.ErrTrackAudioInvalid
+	}
+	// anything that is not audio decodes to no frames at all
+	if duration == 0 {
+		return nil, trackErrors.ErrTrackAudioInvalid
+	}
+	return &probedTrack{format: format, duration: duration}, nil
 }
 
 func (u *TrackUsecase) AddTracksToAlbum(ctx context.Context, tracksList *usecaseModel.TracksListWithAlbumID) ([]int64, error) {
-	var tracksToUpload []*usecaseModel.Track
-	if len(tracksList.Cover) == 0 {
+	var tracksToUpload []*repoModel.TrackWithFileKey
+	if tracksList.CoverKey == "" {
 		return nil, trackErrors.NewUnsupportedImageFormatError("пустое изображение обложки")
 	}
-	trackCover, err := u.s3Repo.UploadTrackAvatar(ctx, tracksList.Tracks[0].Title, tracksList.Cover)
+	trackCover, trackCoverColor, err := u.s3Repo.CopyTrackAvatar(ctx, tracksList.Tracks[0].Title, tracksList.CoverKey)
 	if err != nil {
 		return nil, err
 	}
+	fingerprints := make([]*repoModel.TrackFingerprint, 0, len(tracksList.Tracks))
 	for _, track := range tracksList.Tracks {
-		err := u.s3Repo.UploadTrack(ctx, track.Title, track.File)
+		probed, err := u.probeTrack(ctx, track.FileKey)
 		if err != nil {
 			return nil, err
 		}
-		duration, err := getMp3Duration(track.File)
+		duplicates, err := u.findDuplicates(ctx, fmt.Sprintf("track %q", track.Title), probed.fingerprint, 0)
 		if err != nil {
 			return nil, err
 		}
-		tracksToUpload = append(tracksToUpload, &usecaseModel.Track{
-			Title:     track.Title,
-			Thumbnail: trackCover,
-			AlbumID:   tracksList.AlbumID,
-			Duration:  duration,
+		fingerprints = append(fingerprints, &repoModel.TrackFingerprint{
+			Fingerprint: probed.fingerprint,
+			Duplicates:  duplicates,
+		})
+		tracksToUpload = append(tracksToUpload, &repoModel.TrackWithFileKey{
+			Track: repoModel.Track{
+				Title:          track.Title,
+				Thumbnail:      trackCover,
+				ThumbnailColor: trackCoverColor,
+				AlbumID:        tracksList.AlbumID,
+				Duration:       probed.duration,
+			},
+			FileKey:      track.FileKey,
+			SourceFormat: probed.format,
 		})
 	}
-	trackListRepo := model.TrackListFromUsecaseToRepository(tracksToUpload)
 
-	trackIDs, err := u.trackRepo.AddTracksToAlbum(ctx, trackListRepo)
+	trackIDs, err := u.trackRepo.AddTracksToAlbum(ctx, tracksToUpload)
 	if err != nil {
 		return nil, err
 	}
+
+	for i, trackID := range trackIDs {
+		fingerprints[i].TrackID = trackID
+		u.saveFingerprint(ctx, fingerprints[i])
+	}
+
+	logger := loggerPkg.LoggerFromContext(ctx)
+	// Covers staged by ReadTrackMetadata have been copied by now, whether the album took one of them
+	// or the label uploaded its own
+	for _, track := range tracksList.Tracks {
+		if err := u.s3Repo.DeleteEmbeddedCover(ctx, embeddedCoverKey(track.FileKey)); err != nil {
+			logger.Warn("failed to delete embedded cover", zap.String("key", track.FileKey), zap.Error(err))
+		}
+	}
+
+	if err := u.searchIndex.Refresh(ctx, trackIDs); err != nil {
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
 	return trackIDs, nil
 }
 
@@ -310,3 +567,169 @@ func (u *TrackUsecase) GetMostLikedLastWeekTracks(ctx context.Context, userID in
 	}
 	return model.TrackListFromRepositoryToUsecase(repoTracks), nil
 }
+
+func (u *TrackUsecase) GetLabelTracksByAlbumID(ctx context.Context, albumID int64) ([]*usecaseModel.Track, error) {
+	repoTracks, err := u.trackRepo.GetLabelTracksByAlbumID(ctx, albumID)
+	if err != nil {
+		return nil, err
+	}
+	return model.TrackListFromRepositoryToUsecase(repoTracks), nil
+}
+
+const maxTrackTitleLength = 100
+
+func validTrackTitle(title string) bool {
+	titleLength := utf8.RuneCountInString(title)
+	return titleLength > 0 && titleLength <= maxTrackTitleLength
+}
+
+func (u *TrackUsecase) UpdateAlbumTracks(ctx context.Context, update *usecaseModel.AlbumTracksUpdate) error {
+	// Positions have to stay 1..N, the repository checks that no track of the album is left out
+	positions := make(map[int64]bool, len(update.Tracks))
+	for _, track := range update.Tracks {
+		if track.Position < 1 || track.Position > int64(len(update.Tracks)) || positions[track.Position] {
+			return trackErrors.ErrAlbumTracksInvalid
+		}
+		positions[track.Position] = true
+
+		track.Title = strings.TrimSpace(track.Title)
+		if !validTrackTitle(track.Title) {
+			return trackErrors.ErrTrackTitleInvalid
+		}
+	}
+	if len(update.Tracks) == 0 {
+		return trackErrors.ErrAlbumTracksInvalid
+	}
+
+	if err := u.trackRepo.UpdateAlbumTracks(ctx, model.AlbumTracksUpdateFromUsecaseToRepository(update)); err != nil {
+		return err
+	}
+
+	trackIDs := make([]int64, 0, len(update.Tracks))
+	for _, track := range update.Tracks {
+		trackIDs = append(trackIDs, track.ID)
+	}
+	if err := u.searchIndex.Refresh(ctx, trackIDs); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+	return nil
+}
+
+// EditTrack renames a track and moves it to another position, shifting the tracks in between
+func (u *TrackUsecase) EditTrack(ctx context.Context, edit *usecaseModel.TrackEdit) error {
+	edit.Title = strings.TrimSpace(edit.Title)
+	if !validTrackTitle(edit.Title) {
+		return trackErrors.ErrTrackTitleInvalid
+	}
+	if edit.Position < 0 {
+		return trackErrors.ErrTrackPositionInvalid
+	}
+
+	if err := u.trackRepo.EditTrack(ctx, model.TrackEditFromUsecaseToRepository(edit)); err != nil {
+		return err
+	}
+
+	if err := u.searchIndex.Refresh(ctx, []int64{edit.ID}); err != nil {
+		logger := loggerPkg.LoggerFromContext(ctx)
+		logger.Warn("failed to refresh search index", zap.Error(err))
+	}
+	return nil
+}
+
+// ReplaceTrackAudio points the track to a newly uploaded file, measures its duration again
+// and queues it for transcoding
+func (u *TrackUsecase) ReplaceTrackAudio(ctx context.Context, replace *usecaseModel.TrackAudioReplace) error {
+	probed, err := u.probeTrack(ctx, replace.FileKey)
+	if err != nil {
+		return err
+	}
+	duplicates, err := u.findDuplicates(ctx, fmt.Sprintf("new audio of track %d", replace.ID), probed.fingerprint, replace.ID)
+	if err != nil {
+		return err
+	}
+
+	err = u.trackRepo.ReplaceTrackFile(ctx, &repoModel.TrackAudioReplace{
+		ID:           replace.ID,
+		AlbumID:      replace.AlbumID,
+		FileKey:      replace.FileKey,
+		SourceFormat: probed.format,
+		Duration:     probed.duration,
+	})
+	if err != nil {
+		return err
+	}
+
+	u.saveFingerprint(ctx, &repoModel.TrackFingerprint{
+		TrackID:     replace.ID,
+		Fingerprint: probed.fingerprint,
+		Duplicates:  duplicates,
+	})
+	return nil
+}
+
+// ReadTrackMetadata reads the tags of an upload so that a label does not have to
+// type in what the file already says about itself
+func (u *TrackUsecase) ReadTrackMetadata(ctx context.Context, fileKey string) (*usecaseModel.TrackMetadata, error) {
+	logger := loggerPkg.LoggerFromContext(ctx)
+
+	file, err := u.s3Repo.OpenTrack(ctx, fileKey)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	format, tags, err := readTags(bufio.NewReader(file))
+	if format == "" {
+		logger.Warn("failed to detect format of uploaded track", zap.String("key", fileKey), zap.Error(err))
+		return nil, trackErrors.ErrTrackAudioInvalid
+	}
+	// the tags are only a suggestion, a broken frame should not hide the ones before it
+	if err != nil {
+		logger.Warn("failed to read tags of uploaded track", zap.String("key", fileKey), zap.Error(err))
+	}
+
+	metadata := &usecaseModel.TrackMetadata{
+		Format:      format,
+		Title:       tags.title,
+		TrackNumber: tags.trackNumber,
+		Artists:     tags.artists,
+		Genre:       tags.genre,
+		Year:        tags.year,
+		ISRC:        tags.isrc,
+	}
+	if tags.cover == nil {
+		return metadata, nil
+	}
+
+	_, coverFormat, err := image.DecodeConfig(bytes.NewReader(tags.cover))
+	if err != nil || (coverFormat != "jpeg" && coverFormat != "png" && coverFormat != "gif") {
+		logger.Warn("skipping embedded cover in unsupported format", zap.String("key", fileKey), zap.String("format", coverFormat), zap.Error(err))
+		return metadata, nil
+	}
+	coverKey := embeddedCoverKey(fileKey)
+	coverURL, err := u.s3Repo.UploadEmbeddedCover(ctx, coverKey, tags.cover, coverFormat)
+	if err != nil {
+		return nil, err
+	}
+	metadata.CoverKey = coverKey
+	metadata.CoverURL = coverURL
+	return metadata, nil
+}
+
+// embeddedCoverKey is where the cover extracted from the tags of a track upload is staged
+func embeddedCoverKey(fileKey string) string {
+	return "uploads/" + strings.TrimSuffix(fileKey, path.Ext(fileKey)) + "-cover"
+}
+
+// UpdateAlbumTracksCover gives every track of the album a copy of the new album cover
+func (u *TrackUsecase) UpdateAlbumTracksCover(ctx context.Context, albumID int64, coverKey string) error {
+	if coverKey == "" {
+		return trackErrors.NewUnsupportedImageFormatError("пустое изображение обложки")
+	}
+	trackCover, trackCoverColor, err := u.s3Repo.CopyTrackAvatar(ctx, fmt.Sprintf("album-%d", albumID), coverKey)
+	if err != nil {
+		return err
+	}
+	return u.trackRepo.UpdateAlbumTracksThumbnail(ctx, albumID, trackCover, trackCoverColor)
+}
@@ -1,11 +1,27 @@
 package usecase
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/binary"
 	"errors"
+	"image"
+	"image/png"
+	"io"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/config"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	mock_searchIndex "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/searchIndex/mocks"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/domain"
 	mock_domain "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/internal/mocks"
 	trackErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/errors"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
@@ -29,7 +45,7 @@ func setupTest(t *testing.T) (*mock_domain.MockRepository, *mock_domain.MockS3Re
 
 func TestGetAllTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	filters := &usecase.TrackFilters{
 		Pagination: &usecase.Pagination{
@@ -88,7 +104,7 @@ func TestGetAllTracks(t *testing.T) {
 
 func TestGetAllTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	filters := &usecase.TrackFilters{
 		Pagination: &usecase.Pagination{
@@ -116,7 +132,7 @@ func TestGetAllTracksError(t *testing.T) {
 
 func TestGetTrackByID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	trackID := int64(1)
 	userID := int64(1)
@@ -138,6 +154,7 @@ func TestGetTrackByID(t *testing.T) {
 	presignedURL := "https://example.com/tracks/file_key.mp3"
 
 	mockRepo.EXPECT().GetTrackByID(ctx, trackID, userID).Return(repoTrack, nil)
+	mockRepo.EXPECT().GetTrackRenditions(ctx, trackID).Return([]*repository.TrackRendition{}, nil)
 	mockS3Repo.EXPECT().GetPresignedURL(repoTrack.FileKey).Return(presignedURL, nil)
 
 	trackResult, err := u.GetTrackByID(ctx, trackID, userID)
@@ -155,7 +172,7 @@ func TestGetTrackByID(t *testing.T) {
 
 func TestGetTrackByIDRepositoryError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	trackID := int64(1)
 	userID := int64(1)
@@ -171,7 +188,7 @@ func TestGetTrackByIDRepositoryError(t *testing.T) {
 
 func TestGetTrackByIDS3Error(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	trackID := int64(1)
 	userID := int64(1)
@@ -193,6 +210,7 @@ func TestGetTrackByIDS3Error(t *testing.T) {
 	expectedErr := errors.New("s3 error")
 
 	mockRepo.EXPECT().GetTrackByID(ctx, trackID, userID).Return(repoTrack, nil)
+	mockRepo.EXPECT().GetTrackRenditions(ctx, trackID).Return([]*repository.TrackRendition{}, nil)
 	mockS3Repo.EXPECT().GetPresignedURL(repoTrack.FileKey).Return("", expectedErr)
 
 	trackResult, err := u.GetTrackByID(ctx, trackID, userID)
@@ -203,7 +221,7 @@ func TestGetTrackByIDS3Error(t *testing.T) {
 
 func TestCreateStream(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	streamData := &usecase.TrackStreamCreateData{
 		TrackID: 1,
@@ -226,7 +244,7 @@ func TestCreateStream(t *testing.T) {
 
 func TestCreateStreamError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	streamData := &usecase.TrackStreamCreateData{
 		TrackID: 1,
@@ -250,7 +268,7 @@ func TestCreateStreamError(t *testing.T) {
 
 func TestUpdateStreamDuration(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	updateData := &usecase.TrackStreamUpdateData{
 		StreamID: 1,
@@ -279,7 +297,7 @@ func TestUpdateStreamDuration(t *testing.T) {
 
 func TestUpdateStreamDurationNotFound(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	updateData := &usecase.TrackStreamUpdateData{
 		StreamID: 1,
@@ -298,7 +316,7 @@ func TestUpdateStreamDurationNotFound(t *testing.T) {
 
 func TestUpdateStreamDurationPermissionDenied(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	updateData := &usecase.TrackStreamUpdateData{
 		StreamID: 1,
@@ -322,7 +340,7 @@ func TestUpdateStreamDurationPermissionDenied(t *testing.T) {
 
 func TestUpdateStreamDurationUpdateError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	updateData := &usecase.TrackStreamUpdateData{
 		StreamID: 1,
@@ -354,7 +372,7 @@ func TestUpdateStreamDurationUpdateError(t *testing.T) {
 
 func TestGetLastListenedTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	filters := &usecase.TrackFilters{
@@ -422,7 +440,7 @@ func TestGetLastListenedTracks(t *testing.T) {
 
 func TestGetLastListenedTracksNoStreams(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	filters := &usecase.TrackFilters{
@@ -448,7 +466,7 @@ func TestGetLastListenedTracksNoStreams(t *testing.T) {
 
 func TestGetLastListenedTracksStreamError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	filters := &usecase.TrackFilters{
@@ -477,7 +495,7 @@ func TestGetLastListenedTracksStreamError(t *testing.T) {
 
 func TestGetLastListenedTracksGetTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	filters := &usecase.TrackFilters{
@@ -522,7 +540,7 @@ func TestGetLastListenedTracksGetTracksError(t *testing.T) {
 
 func TestGetTracksByIDs(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	trackIDs := []int64{1, 2}
@@ -560,7 +578,7 @@ func TestGetTracksByIDs(t *testing.T) {
 
 func TestGetTracksByIDsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	trackIDs := []int64{1, 2}
@@ -577,7 +595,7 @@ func TestGetTracksByIDsError(t *testing.T) {
 
 func TestGetTracksByIDsFiltered(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	trackIDs := []int64{1, 2}
@@ -628,7 +646,7 @@ func TestGetTracksByIDsFiltered(t *testing.T) {
 
 func TestGetTracksByIDsFilteredError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	trackIDs := []int64{1, 2}
@@ -658,7 +676,7 @@ func TestGetTracksByIDsFilteredError(t *testing.T) {
 
 func TestGetAlbumIDByTrackID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	trackID := int64(1)
 	expectedAlbumID := int64(42)
@@ -672,7 +690,7 @@ func TestGetAlbumIDByTrackID(t *testing.T) {
 
 func TestGetAlbumIDByTrackIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	trackID := int64(1)
 	expectedErr := errors.New("database error")
@@ -685,9 +703,35 @@ func TestGetAlbumIDByTrackIDError(t *testing.T) {
 	assert.Equal(t, int64(0), albumID)
 }
 
+func TestGetTrackWaveform(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetTrackWaveform(ctx, int64(1)).Return(&repository.TrackWaveform{
+		Duration: 200,
+		Peaks:    []float64{0.5, 0.25},
+	}, nil)
+
+	waveform, err := u.GetTrackWaveform(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(200), waveform.Duration)
+	assert.Equal(t, []float64{0.5, 0.25}, waveform.Peaks)
+}
+
+func TestGetTrackWaveformError(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetTrackWaveform(ctx, int64(1)).Return(nil, trackErrors.ErrTrackWaveformNotFound)
+
+	waveform, err := u.GetTrackWaveform(ctx, 1)
+	assert.ErrorIs(t, err, trackErrors.ErrTrackWaveformNotFound)
+	assert.Nil(t, waveform)
+}
+
 func TestGetTracksByAlbumID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	albumID := int64(1)
 	userID := int64(1)
@@ -725,7 +769,7 @@ func TestGetTracksByAlbumID(t *testing.T) {
 
 func TestGetTracksByAlbumIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	albumID := int64(1)
 	userID := int64(1)
@@ -741,7 +785,7 @@ func TestGetTracksByAlbumIDError(t *testing.T) {
 
 func TestGetMinutesListenedByUserID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedMinutes := int64(120)
@@ -755,7 +799,7 @@ func TestGetMinutesListenedByUserID(t *testing.T) {
 
 func TestGetMinutesListenedByUserIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedErr := errors.New("database error")
@@ -770,7 +814,7 @@ func TestGetMinutesListenedByUserIDError(t *testing.T) {
 
 func TestGetTracksListenedByUserID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedCount := int64(42)
@@ -784,7 +828,7 @@ func TestGetTracksListenedByUserID(t *testing.T) {
 
 func TestGetTracksListenedByUserIDError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedErr := errors.New("database error")
@@ -799,7 +843,7 @@ func TestGetTracksListenedByUserIDError(t *testing.T) {
 
 func TestLikeTrackSuccess(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	likeRequest := &usecase.LikeRequest{
 		TrackID: 1,
@@ -821,7 +865,7 @@ func TestLikeTrackSuccess(t *testing.T) {
 
 func TestLikeTrackCheckExistsError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	likeRequest := &usecase.LikeRequest{
 		TrackID: 1,
@@ -840,7 +884,7 @@ func TestLikeTrackCheckExistsError(t *testing.T) {
 
 func TestLikeTrackNotFound(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	likeRequest := &usecase.LikeRequest{
 		TrackID: 1,
@@ -857,7 +901,7 @@ func TestLikeTrackNotFound(t *testing.T) {
 
 func TestLikeTrackLikeError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	likeRequest := &usecase.LikeRequest{
 		TrackID: 1,
@@ -882,7 +926,7 @@ func TestLikeTrackLikeError(t *testing.T) {
 
 func TestUnlikeTrackSuccess(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	likeRequest := &usecase.LikeRequest{
 		TrackID: 1,
@@ -904,7 +948,7 @@ func TestUnlikeTrackSuccess(t *testing.T) {
 
 func TestUnlikeTrackError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	likeRequest := &usecase.LikeRequest{
 		TrackID: 1,
@@ -929,7 +973,7 @@ func TestUnlikeTrackError(t *testing.T) {
 
 func TestGetFavoriteTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	favoriteRequest := &usecase.FavoriteRequest{
 		RequestUserID: 1,
@@ -986,7 +1030,7 @@ func TestGetFavoriteTracks(t *testing.T) {
 
 func TestGetFavoriteTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	favoriteRequest := &usecase.FavoriteRequest{
 		RequestUserID: 1,
@@ -1022,13 +1066,20 @@ func TestGetFavoriteTracksError(t *testing.T) {
 
 func TestSearchTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mockIndex, nil, config.FingerprintConfig{})
 
 	query := "test track"
 	userID := int64(1)
+	filters := &usecase.TrackFilters{
+		Pagination: &usecase.Pagination{
+			Limit:  10,
+			Offset: 0,
+		},
+	}
 
-	repoTracks := []*repository.Track{
-		{
+	repoTracks := map[int64]*repository.Track{
+		1: {
 			ID:         1,
 			Title:      "Test Track",
 			Thumbnail:  "thumbnail1.jpg",
@@ -1036,7 +1087,7 @@ func TestSearchTracks(t *testing.T) {
 			AlbumID:    1,
 			IsFavorite: true,
 		},
-		{
+		2: {
 			ID:         2,
 			Title:      "Track Test",
 			Thumbnail:  "thumbnail2.jpg",
@@ -1046,30 +1097,64 @@ func TestSearchTracks(t *testing.T) {
 		},
 	}
 
-	mockRepo.EXPECT().SearchTracks(ctx, query, userID).Return(repoTracks, nil)
+	// id 3 was deleted after the index had been built
+	mockIndex.EXPECT().Search(ctx, query, int64(10), int64(0)).Return([]int64{2, 3, 1}, nil)
+	mockRepo.EXPECT().GetTracksByIDs(ctx, []int64{2, 3, 1}, userID).Return(repoTracks, nil)
 
-	tracks, err := u.SearchTracks(ctx, query, userID)
+	tracks, err := u.SearchTracks(ctx, query, userID, filters)
 	require.NoError(t, err)
 	require.Len(t, tracks, 2)
 
-	assert.Equal(t, int64(1), tracks[0].ID)
-	assert.Equal(t, "Test Track", tracks[0].Title)
-	assert.Equal(t, int64(2), tracks[1].ID)
-	assert.Equal(t, "Track Test", tracks[1].Title)
+	assert.Equal(t, int64(2), tracks[0].ID)
+	assert.Equal(t, "Track Test", tracks[0].Title)
+	assert.Equal(t, int64(1), tracks[1].ID)
+	assert.Equal(t, "Test Track", tracks[1].Title)
 }
 
 func TestSearchTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mockIndex, nil, config.FingerprintConfig{})
 
 	query := "test track"
 	userID := int64(1)
+	filters := &usecase.TrackFilters{
+		Pagination: &usecase.Pagination{
+			Limit:  10,
+			Offset: 0,
+		},
+	}
 
 	expectedErr := errors.New("database error")
 
-	mockRepo.EXPECT().SearchTracks(ctx, query, userID).Return(nil, expectedErr)
+	mockIndex.EXPECT().Search(ctx, query, int64(10), int64(0)).Return(nil, expectedErr)
 
-	tracks, err := u.SearchTracks(ctx, query, userID)
+	tracks, err := u.SearchTracks(ctx, query, userID, filters)
+	assert.Error(t, err)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, tracks)
+}
+
+func TestSearchTracksRepositoryError(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mockIndex, nil, config.FingerprintConfig{})
+
+	query := "test track"
+	userID := int64(1)
+	filters := &usecase.TrackFilters{
+		Pagination: &usecase.Pagination{
+			Limit:  10,
+			Offset: 0,
+		},
+	}
+
+	expectedErr := errors.New("database error")
+
+	mockIndex.EXPECT().Search(ctx, query, int64(10), int64(0)).Return([]int64{1}, nil)
+	mockRepo.EXPECT().GetTracksByIDs(ctx, []int64{1}, userID).Return(nil, expectedErr)
+
+	tracks, err := u.SearchTracks(ctx, query, userID, filters)
 	assert.Error(t, err)
 	assert.Equal(t, expectedErr, err)
 	assert.Nil(t, tracks)
@@ -1077,7 +1162,7 @@ func TestSearchTracksError(t *testing.T) {
 
 func TestDeleteTracksByAlbumID(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	albumID := int64(1)
 
@@ -1089,7 +1174,7 @@ func TestDeleteTracksByAlbumID(t *testing.T) {
 
 func TestGetMostLikedTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 
@@ -1116,7 +1201,7 @@ func TestGetMostLikedTracks(t *testing.T) {
 
 func TestGetMostLikedTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedErr := errors.New("database error")
@@ -1131,7 +1216,7 @@ func TestGetMostLikedTracksError(t *testing.T) {
 
 func TestGetMostRecentTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 
@@ -1158,7 +1243,7 @@ func TestGetMostRecentTracks(t *testing.T) {
 
 func TestGetMostRecentTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedErr := errors.New("database error")
@@ -1173,7 +1258,7 @@ func TestGetMostRecentTracksError(t *testing.T) {
 
 func TestGetMostListenedLastMonthTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 
@@ -1200,7 +1285,7 @@ func TestGetMostListenedLastMonthTracks(t *testing.T) {
 
 func TestGetMostListenedLastMonthTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedErr := errors.New("database error")
@@ -1215,7 +1300,7 @@ func TestGetMostListenedLastMonthTracksError(t *testing.T) {
 
 func TestGetMostLikedLastWeekTracks(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 
@@ -1242,7 +1327,7 @@ func TestGetMostLikedLastWeekTracks(t *testing.T) {
 
 func TestGetMostLikedLastWeekTracksError(t *testing.T) {
 	mockRepo, mockS3Repo, ctx := setupTest(t)
-	u := NewTrackUsecase(mockRepo, mockS3Repo)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
 
 	userID := int64(1)
 	expectedErr := errors.New("database error")
@@ -1254,3 +1339,1166 @@ func TestGetMostLikedLastWeekTracksError(t *testing.T) {
 	assert.Equal(t, expectedErr, err)
 	assert.Nil(t, tracks)
 }
+
+func TestAddTracksToAlbum(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mockIndex, nil, config.FingerprintConfig{})
+
+	tracksList := &usecase.TracksListWithAlbumID{
+		Tracks: []*usecase.TrackLoad{
+			{Title: "Track 1", FileKey: "upload-1.mp3"},
+		},
+		AlbumID:  3,
+		CoverKey: "uploads/cover",
+	}
+
+	mockS3Repo.EXPECT().CopyTrackAvatar(ctx, "Track 1", "uploads/cover").Return("cover.jpg", "#a1b2c3", nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.mp3").Return(io.NopCloser(strings.NewReader(silentMp3(80))), nil)
+	mockRepo.EXPECT().AddTracksToAlbum(ctx, []*repository.TrackWithFileKey{
+		{
+			Track: repository.Track{
+				Title:          "Track 1",
+				Thumbnail:      "cover.jpg",
+				ThumbnailColor: "#a1b2c3",
+				AlbumID:        3,
+				Duration:       2,
+			},
+			FileKey:      "upload-1.mp3",
+			SourceFormat: "mp3",
+		},
+	}).Return([]int64{7}, nil)
+	mockS3Repo.EXPECT().DeleteEmbeddedCover(ctx, "uploads/upload-1-cover").Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{7}).Return(nil)
+
+	trackIDs, err := u.AddTracksToAlbum(ctx, tracksList)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, trackIDs)
+}
+
+func TestAddTracksToAlbumMissingUpload(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	tracksList := &usecase.TracksListWithAlbumID{
+		Tracks: []*usecase.TrackLoad{
+			{Title: "Track 1", FileKey: "missing.mp3"},
+		},
+		AlbumID:  3,
+		CoverKey: "uploads/cover",
+	}
+	expectedErr := trackErrors.NewNotFoundError("uploaded track not found")
+
+	mockS3Repo.EXPECT().CopyTrackAvatar(ctx, "Track 1", "uploads/cover").Return("cover.jpg", "#a1b2c3", nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "missing.mp3").Return(nil, expectedErr)
+
+	trackIDs, err := u.AddTracksToAlbum(ctx, tracksList)
+	assert.Equal(t, expectedErr, err)
+	assert.Nil(t, trackIDs)
+}
+
+func TestAddTracksToAlbumEmptyCover(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	trackIDs, err := u.AddTracksToAlbum(ctx, &usecase.TracksListWithAlbumID{
+		Tracks: []*usecase.TrackLoad{{Title: "Track 1", FileKey: "upload-1.mp3"}},
+	})
+	assert.Error(t, err)
+	assert.Nil(t, trackIDs)
+}
+
+func TestUpdateAlbumTracks(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mockIndex, nil, config.FingerprintConfig{})
+
+	update := &usecase.AlbumTracksUpdate{
+		AlbumID: 1,
+		Tracks: []*usecase.TrackUpdate{
+			{ID: 2, Title: " Track 2 ", Position: 1},
+			{ID: 1, Title: "Track 1", Position: 2},
+		},
+	}
+
+	mockRepo.EXPECT().UpdateAlbumTracks(ctx, &repository.AlbumTracksUpdate{
+		AlbumID: 1,
+		Tracks: []*repository.TrackUpdate{
+			{ID: 2, Title: "Track 2", Position: 1},
+			{ID: 1, Title: "Track 1", Position: 2},
+		},
+	}).Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{2, 1}).Return(nil)
+
+	err := u.UpdateAlbumTracks(ctx, update)
+	assert.NoError(t, err)
+}
+
+func TestUpdateAlbumTracksInvalid(t *testing.T) {
+	tests := []struct {
+		name   string
+		tracks []*usecase.TrackUpdate
+	}{
+		{
+			name: "Duplicate position",
+			tracks: []*usecase.TrackUpdate{
+				{ID: 1, Title: "Track 1", Position: 1},
+				{ID: 2, Title: "Track 2", Position: 1},
+			},
+		},
+		{
+			name:   "Position out of range",
+			tracks: []*usecase.TrackUpdate{{ID: 1, Title: "Track 1", Position: 2}},
+		},
+		{
+			name:   "Empty title",
+			tracks: []*usecase.TrackUpdate{{ID: 1, Title: "  ", Position: 1}},
+		},
+		{
+			name:   "Too long title",
+			tracks: []*usecase.TrackUpdate{{ID: 1, Title: strings.Repeat("a", 101), Position: 1}},
+		},
+		{
+			name: "No tracks",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockRepo, mockS3Repo, ctx := setupTest(t)
+			u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+			err := u.UpdateAlbumTracks(ctx, &usecase.AlbumTracksUpdate{AlbumID: 1, Tracks: tt.tracks})
+			assert.Error(t, err)
+		})
+	}
+}
+
+// silentMp3 builds frames of 128 kbps 44.1 kHz MPEG-1 Layer III audio, 26 ms each
+func silentMp3(frames int) string {
+	frame := make([]byte, 417)
+	copy(frame, []byte{0xFF, 0xFB, 0x90, 0x64})
+	return strings.Repeat(string(frame), frames)
+}
+
+func TestEditTrack(t *testing.T) {
+	mockRepo, _, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, nil, mockIndex, nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().EditTrack(ctx, &repository.TrackEdit{ID: 7, AlbumID: 1, Title: "Intro", Position: 2}).Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{7}).Return(errors.New("index error"))
+
+	err := u.EditTrack(ctx, &usecase.TrackEdit{ID: 7, AlbumID: 1, Title: "  Intro ", Position: 2})
+	assert.NoError(t, err)
+}
+
+func TestEditTrackInvalid(t *testing.T) {
+	mockRepo, _, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, nil, nil, nil, config.FingerprintConfig{})
+
+	err := u.EditTrack(ctx, &usecase.TrackEdit{ID: 7, AlbumID: 1, Title: " "})
+	assert.ErrorIs(t, err, trackErrors.ErrTrackTitleInvalid)
+
+	err = u.EditTrack(ctx, &usecase.TrackEdit{ID: 7, AlbumID: 1, Title: "Intro", Position: -1})
+	assert.ErrorIs(t, err, trackErrors.ErrTrackPositionInvalid)
+}
+
+func TestParseLyricsPlain(t *testing.T) {
+	lines, times, err := parseLyrics("\r\n  First   line \r\nSecond line\r\n\r\n\r\nChorus\n\n")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"First line", "Second line", "", "Chorus"}, lines)
+	assert.Nil(t, times)
+}
+
+func TestParseLyricsLRC(t *testing.T) {
+	lrc := strings.Join([]string{
+		"[ar:Artist]",
+		"[ti:Song]",
+		"[offset:+500]",
+		"[00:12.50]First line",
+		"[00:17.2]<00:17.20>Second <00:18.00>line",
+		"[00:21.00]",
+		"[00:25.123][01:10.00]Chorus",
+		"a line without a time",
+	}, "\n")
+
+	lines, times, err := parseLyrics(lrc)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"First line", "Second line", "", "Chorus", "Chorus"}, lines)
+	assert.Equal(t, []int64{12000, 16700, 20500, 24623, 69500}, times)
+}
+
+func TestParseLyricsInvalid(t *testing.T) {
+	for name, text := range map[string]string{
+		"empty":        "",
+		"blank":        " \n\n ",
+		"no lrc text":  "[00:01.00]\n[00:02.00]",
+		"long line":    strings.Repeat("a", maxLyricsLineLength+1),
+		"too many":     strings.Repeat("line\n", maxLyricsLines+1),
+		"only id tags": "[ar:Artist]\n[ti:Song]",
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, _, err := parseLyrics(text)
+			assert.ErrorIs(t, err, trackErrors.ErrTrackLyricsInvalid)
+		})
+	}
+}
+
+func TestSaveTrackLyrics(t *testing.T) {
+	mockRepo, _, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, nil, mockIndex, nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().SaveTrackLyrics(ctx, &repository.TrackLyrics{
+		TrackID:   7,
+		AlbumID:   1,
+		Language:  "en",
+		Lines:     []string{"Hello", "World"},
+		LineTimes: []int64{1000, 2500},
+	}).Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{7}).Return(nil)
+
+	lyrics, err := u.SaveTrackLyrics(ctx, &usecase.TrackLyricsUpload{
+		TrackID:  7,
+		AlbumID:  1,
+		Language: " EN ",
+		Text:     "[00:01.00]Hello\n[00:02.50]World",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, &usecase.TrackLyrics{
+		Language: "en",
+		Synced:   true,
+		Lines: []*usecase.LyricsLine{
+			{Time: 1000, Text: "Hello"},
+			{Time: 2500, Text: "World"},
+		},
+	}, lyrics)
+}
+
+func TestSaveTrackLyricsInvalidLanguage(t *testing.T) {
+	mockRepo, _, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, nil, nil, nil, config.FingerprintConfig{})
+
+	_, err := u.SaveTrackLyrics(ctx, &usecase.TrackLyricsUpload{TrackID: 7, AlbumID: 1, Language: "english", Text: "Hello"})
+	assert.ErrorIs(t, err, trackErrors.ErrTrackLyricsLanguageInvalid)
+}
+
+func TestDeleteTrackLyrics(t *testing.T) {
+	mockRepo, _, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, nil, mockIndex, nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().DeleteTrackLyrics(ctx, &repository.TrackLyricsDelete{TrackID: 7, AlbumID: 1, Language: "de"}).Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{7}).Return(nil)
+
+	err := u.DeleteTrackLyrics(ctx, &usecase.TrackLyricsDelete{TrackID: 7, AlbumID: 1, Language: "DE"})
+	assert.NoError(t, err)
+}
+
+func TestGetTrackLyrics(t *testing.T) {
+	mockRepo, _, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, nil, nil, nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetTrackLyrics(ctx, int64(7)).Return([]*repository.TrackLyrics{
+		{TrackID: 7, Language: "en", Lines: []string{"Hello", "", "World"}},
+		{TrackID: 7, Language: "ru", Translation: true, Lines: []string{"Привет"}, LineTimes: []int64{1500}},
+	}, nil)
+
+	lyrics, err := u.GetTrackLyrics(ctx, 7)
+	require.NoError(t, err)
+	assert.Equal(t, []*usecase.TrackLyrics{
+		{Language: "en", Lines: []*usecase.LyricsLine{{Text: "Hello"}, {Text: ""}, {Text: "World"}}},
+		{Language: "ru", Translation: true, Synced: true, Lines: []*usecase.LyricsLine{{Time: 1500, Text: "Привет"}}},
+	}, lyrics)
+}
+
+func TestReplaceTrackAudio(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	mockS3Repo.EXPECT().OpenTrack(ctx, "uploads/new.mp3").Return(io.NopCloser(strings.NewReader(silentMp3(80))), nil)
+	mockRepo.EXPECT().ReplaceTrackFile(ctx, &repository.TrackAudioReplace{ID: 7, AlbumID: 1, FileKey: "uploads/new.mp3", SourceFormat: "mp3", Duration: 2}).Return(nil)
+
+	err := u.ReplaceTrackAudio(ctx, &usecase.TrackAudioReplace{ID: 7, AlbumID: 1, FileKey: "uploads/new.mp3"})
+	assert.NoError(t, err)
+}
+
+func TestReplaceTrackAudioNotMp3(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	mockS3Repo.EXPECT().OpenTrack(ctx, "uploads/cover.png").Return(io.NopCloser(strings.NewReader("\x89PNG not a song")), nil)
+
+	err := u.ReplaceTrackAudio(ctx, &usecase.TrackAudioReplace{ID: 7, AlbumID: 1, FileKey: "uploads/cover.png"})
+	assert.ErrorIs(t, err, trackErrors.ErrTrackAudioInvalid)
+}
+
+func TestUpdateAlbumTracksCover(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	mockS3Repo.EXPECT().CopyTrackAvatar(ctx, "album-1", "uploads/cover").Return("cover.jpg", "#a1b2c3", nil)
+	mockRepo.EXPECT().UpdateAlbumTracksThumbnail(ctx, int64(1), "cover.jpg", "#a1b2c3").Return(nil)
+
+	err := u.UpdateAlbumTracksCover(ctx, 1, "uploads/cover")
+	assert.NoError(t, err)
+}
+
+// wavFile builds the header of 16 bit 44.1 kHz stereo pcm audio with a list chunk before the samples
+func wavFile(seconds int) string {
+	var buf bytes.Buffer
+	dataSize := uint32(seconds * 176400)
+	buf.WriteString("RIFF")
+	binary.Write(&buf, binary.LittleEndian, uint32(36+10+8+dataSize))
+	buf.WriteString("WAVEfmt ")
+	binary.Write(&buf, binary.LittleEndian, uint32(16))
+	binary.Write(&buf, binary.LittleEndian, []uint16{1, 2})
+	binary.Write(&buf, binary.LittleEndian, []uint32{44100, 176400})
+	binary.Write(&buf, binary.LittleEndian, []uint16{4, 16})
+	buf.WriteString("LIST")
+	binary.Write(&buf, binary.LittleEndian, uint32(9))
+	buf.WriteString("INFOISFT\x00\x00")
+	buf.WriteString("data")
+	binary.Write(&buf, binary.LittleEndian, dataSize)
+	return buf.String()
+}
+
+// flacFile builds a STREAMINFO block of 16 bit stereo audio
+func flacFile(sampleRate uint64, samples uint64) string {
+	info := make([]byte, 34)
+	binary.BigEndian.PutUint64(info[10:18], sampleRate<<44|1<<41|15<<36|samples)
+	return "fLaC\x00\x00\x00\x22" + string(info)
+}
+
+func oggPage(granule uint64, body string) string {
+	header := make([]byte, 27)
+	copy(header, "OggS")
+	binary.LittleEndian.PutUint64(header[6:14], granule)
+	binary.LittleEndian.PutUint32(header[14:18], 1)
+	header[26] = 1
+	return string(header) + string([]byte{byte(len(body))}) + body
+}
+
+// opusFile builds the pages of an opus stream with 312 samples of pre-skip
+func opusFile(seconds uint64) string {
+	head := "OpusHead\x01\x02\x38\x01\x80\xbb\x00\x00\x00\x00\x00"
+	return oggPage(0, head) + oggPage(0, "OpusTags") + oggPage(^uint64(0), "audio") + oggPage(seconds*48000+312, "audio")
+}
+
+func TestDetectAudioFormat(t *testing.T) {
+	tests := []struct {
+		name     string
+		header   string
+		expected string
+	}{
+		{name: "id3 tagged mp3", header: "ID3\x04\x00\x00\x00\x00\x00\x00\x00\x00", expected: "mp3"},
+		{name: "bare mp3 frame", header: silentMp3(1)[:12], expected: "mp3"},
+		{name: "flac", header: flacFile(44100, 44100)[:12], expected: "flac"},
+		{name: "wav", header: wavFile(1)[:12], expected: "wav"},
+		{name: "ogg", header: opusFile(1)[:12], expected: "ogg"},
+		{name: "riff but not wave", header: "RIFF\x00\x00\x00\x00AVI ", expected: ""},
+		{name: "png", header: "\x89PNG\r\n\x1a\n\x00\x00\x00\x0d", expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, detectAudioFormat([]byte(tt.header)))
+		})
+	}
+}
+
+func TestGetAudioDuration(t *testing.T) {
+	tests := []struct {
+		name             string
+		file             string
+		expectedFormat   string
+		expectedDuration int64
+		expectErr        bool
+	}{
+		{name: "mp3", file: silentMp3(80), expectedFormat: "mp3", expectedDuration: 2},
+		{name: "flac", file: flacFile(96000, 96000*185), expectedFormat: "flac", expectedDuration: 185},
+		{name: "wav", file: wavFile(42), expectedFormat: "wav", expectedDuration: 42},
+		{name: "opus", file: opusFile(7), expectedFormat: "ogg", expectedDuration: 7},
+		{name: "flac of unknown length", file: flacFile(44100, 0), expectedFormat: "flac", expectErr: true},
+		{name: "vorbis without audio", file: oggPage(0, "\x01vorbis\x00\x00\x00\x00\x02\x44\xac\x00\x00"), expectedFormat: "ogg", expectErr: true},
+		{name: "not audio", file: "\x89PNG\r\n\x1a\n\x00\x00\x00\x0d", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, duration, err := getAudioDuration(bufio.NewReader(strings.NewReader(tt.file)))
+			assert.Equal(t, tt.expectedFormat, format)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedDuration, duration)
+		})
+	}
+}
+
+func TestAddTracksToAlbumFlac(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mockIndex, nil, config.FingerprintConfig{})
+
+	mockS3Repo.EXPECT().CopyTrackAvatar(ctx, "Master", "uploads/cover").Return("cover.jpg", "#a1b2c3", nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.flac").Return(io.NopCloser(strings.NewReader(flacFile(48000, 48000*200))), nil)
+	mockRepo.EXPECT().AddTracksToAlbum(ctx, []*repository.TrackWithFileKey{
+		{
+			Track: repository.Track{
+				Title:          "Master",
+				Thumbnail:      "cover.jpg",
+				ThumbnailColor: "#a1b2c3",
+				AlbumID:        3,
+				Duration:       200,
+			},
+			FileKey:      "upload-1.flac",
+			SourceFormat: "flac",
+		},
+	}).Return([]int64{7}, nil)
+	// the release still goes through when the staged cover can't be removed
+	mockS3Repo.EXPECT().DeleteEmbeddedCover(ctx, "uploads/upload-1-cover").Return(errors.New("s3 error"))
+	mockIndex.EXPECT().Refresh(ctx, []int64{7}).Return(nil)
+
+	trackIDs, err := u.AddTracksToAlbum(ctx, &usecase.TracksListWithAlbumID{
+		Tracks:   []*usecase.TrackLoad{{Title: "Master", FileKey: "upload-1.flac"}},
+		AlbumID:  3,
+		CoverKey: "uploads/cover",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, trackIDs)
+}
+
+func TestGetTrackByIDStreamsDefaultRendition(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetTrackByID(ctx, int64(1), int64(2)).Return(&repository.TrackWithFileKey{
+		Track:        repository.Track{ID: 1, Title: "Track 1"},
+		FileKey:      "master.flac",
+		LoudnessLUFS: -9,
+		ReplayGainDB: -9,
+	}, nil)
+	mockRepo.EXPECT().GetTrackRenditions(ctx, int64(1)).Return([]*repository.TrackRendition{
+		{Codec: "mp3", Bitrate: 128, FileKey: "renditions/master/mp3-128.mp3"},
+		{Codec: "mp3", Bitrate: 320, FileKey: "renditions/master/mp3-320.mp3"},
+		{Codec: "opus", Bitrate: 160, FileKey: "renditions/master/opus-160.ogg"},
+	}, nil)
+	mockS3Repo.EXPECT().GetPresignedURL(gomock.Any()).DoAndReturn(func(key string) (string, error) {
+		return "https://example.com/" + key, nil
+	}).Times(4)
+
+	track, err := u.GetTrackByID(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, "https://example.com/renditions/master/mp3-320.mp3", track.FileUrl)
+	require.Len(t, track.Renditions, 3)
+	assert.Equal(t, "https://example.com/renditions/master/opus-160.ogg", track.Renditions[2].FileUrl)
+	assert.Equal(t, -9.0, track.ReplayGainDB)
+}
+
+func TestRenditionKey(t *testing.T) {
+	profile := &repository.RenditionProfile{Codec: "opus", Bitrate: 160, Extension: "ogg"}
+	assert.Equal(t, "renditions/upload-1/opus-160.ogg", renditionKey("upload-1.flac", profile))
+	assert.Equal(t, "renditions/tracks/song/opus-160.ogg", renditionKey("/tracks/song.mp3", profile))
+}
+
+func TestSegmentKey(t *testing.T) {
+	profile := &repository.RenditionProfile{Codec: "aac", Bitrate: 128, Extension: "ts"}
+	assert.Equal(t, "hls/upload-1/aac-128/00012.ts", segmentKey("upload-1.flac", profile, 12))
+}
+
+func TestGetStreamManifest(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetStreamByID(ctx, int64(1)).Return(&repository.TrackStream{ID: 1, UserID: 2, TrackID: 7}, nil)
+	mockRepo.EXPECT().GetTrackSegments(ctx, int64(7)).Return([]*repository.TrackSegment{
+		{Codec: "aac", Bitrate: 64, Position: 0, Duration: 6, FileKey: "hls/upload/aac-64/00000.ts"},
+		{Codec: "aac", Bitrate: 64, Position: 1, Duration: 2.5, FileKey: "hls/upload/aac-64/00001.ts"},
+		{Codec: "aac", Bitrate: 128, Position: 0, Duration: 6, FileKey: "hls/upload/aac-128/00000.ts"},
+		{Codec: "aac", Bitrate: 128, Position: 1, Duration: 2.5, FileKey: "hls/upload/aac-128/00001.ts"},
+	}, nil)
+
+	variants, err := u.GetStreamManifest(ctx, 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []*usecase.StreamVariant{
+		{Codec: "aac", Bitrate: 64, Segments: []*usecase.StreamSegment{{Position: 0, Duration: 6}, {Position: 1, Duration: 2.5}}},
+		{Codec: "aac", Bitrate: 128, Segments: []*usecase.StreamSegment{{Position: 0, Duration: 6}, {Position: 1, Duration: 2.5}}},
+	}, variants)
+}
+
+func TestGetStreamManifestNotSegmented(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetStreamByID(ctx, int64(1)).Return(&repository.TrackStream{ID: 1, UserID: 2, TrackID: 7}, nil)
+	mockRepo.EXPECT().GetTrackSegments(ctx, int64(7)).Return([]*repository.TrackSegment{}, nil)
+
+	_, err := u.GetStreamManifest(ctx, 1, 2)
+	assert.Equal(t, trackErrors.ErrTrackSegmentsNotFound, err)
+}
+
+func TestGetStreamManifestPermissionDenied(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetStreamByID(ctx, int64(1)).Return(&repository.TrackStream{ID: 1, UserID: 3, TrackID: 7}, nil)
+
+	_, err := u.GetStreamManifest(ctx, 1, 2)
+	assert.Equal(t, trackErrors.ErrStreamPermissionDenied, err)
+}
+
+func TestGetStreamSegment(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetStreamByID(ctx, int64(1)).Return(&repository.TrackStream{ID: 1, UserID: 2, TrackID: 7}, nil)
+	mockRepo.EXPECT().GetTrackSegment(ctx, int64(7), int64(128), int64(3)).Return(&repository.TrackSegment{
+		Codec: "aac", Bitrate: 128, Position: 3, Duration: 6, FileKey: "hls/upload/aac-128/00003.ts",
+	}, nil)
+	mockRepo.EXPECT().RecordStreamSegment(ctx, &repository.StreamSegmentFetch{StreamID: 1, Position: 3, Duration: 6}).Return(nil)
+	mockS3Repo.EXPECT().GetPresignedURL("hls/upload/aac-128/00003.ts").Return("https://s3/hls/upload/aac-128/00003.ts", nil)
+
+	url, err := u.GetStreamSegment(ctx, &usecase.StreamSegmentRequest{StreamID: 1, UserID: 2, Bitrate: 128, Position: 3})
+	require.NoError(t, err)
+	assert.Equal(t, "https://s3/hls/upload/aac-128/00003.ts", url)
+}
+
+func TestGetStreamSegmentNotFound(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mock_searchIndex.NewMockIndex(gomock.NewController(t)), nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().GetStreamByID(ctx, int64(1)).Return(&repository.TrackStream{ID: 1, UserID: 2, TrackID: 7}, nil)
+	mockRepo.EXPECT().GetTrackSegment(ctx, int64(7), int64(128), int64(99)).Return(nil, trackErrors.ErrTrackSegmentNotFound)
+
+	_, err := u.GetStreamSegment(ctx, &usecase.StreamSegmentRequest{StreamID: 1, UserID: 2, Bitrate: 128, Position: 99})
+	assert.Equal(t, trackErrors.ErrTrackSegmentNotFound, err)
+}
+
+func setupTranscodeWorker(t *testing.T) (*TranscodeWorker, *mock_domain.MockRepository, *mock_domain.MockS3Repository, *mock_domain.MockTranscoder, context.Context) {
+	ctrl := gomock.NewController(t)
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	mockTranscoder := mock_domain.NewMockTranscoder(ctrl)
+	worker := NewTranscodeWorker(mockRepo, mockS3Repo, mockTranscoder, config.TranscodeConfig{
+		Interval:    time.Minute,
+		WorkDir:     t.TempDir(),
+		StaleAfter:  30 * time.Minute,
+		MaxAttempts: 3,
+	})
+	return worker, mockRepo, mockS3Repo, mockTranscoder, ctx
+}
+
+func TestTranscodeWorkerProcessNext(t *testing.T) {
+	worker, mockRepo, mockS3Repo, mockTranscoder, ctx := setupTranscodeWorker(t)
+
+	job := &repository.TranscodeJob{TrackID: 7, FileKey: "upload-1.flac", SourceFormat: "flac", Attempts: 1}
+	mockRepo.EXPECT().ClaimTranscodeJob(ctx, 30*time.Minute).Return(job, nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.flac").Return(io.NopCloser(strings.NewReader("master")), nil)
+	mockTranscoder.EXPECT().MeasureLoudness(ctx, gomock.Any()).DoAndReturn(func(_ context.Context, sourcePath string) (*repository.Loudness, error) {
+		source, err := os.ReadFile(sourcePath)
+		require.NoError(t, err)
+		assert.Equal(t, "master", string(source))
+		return &repository.Loudness{IntegratedLUFS: -10.5, TruePeakDB: -0.3}, nil
+	})
+	mockTranscoder.EXPECT().DecodePCM(ctx, gomock.Any(), int64(analysisSampleRate), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ int64, destinationPath string) error {
+			return os.WriteFile(destinationPath, []byte(pcmAudio([]float64{0.5, 0.5, -0.25, -0.25})), 0o600)
+		})
+	mockTranscoder.EXPECT().Transcode(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, profile *repository.RenditionProfile, destinationPath string) error {
+			return os.WriteFile(destinationPath, []byte(profile.Codec), 0o600)
+		}).Times(len(renditionProfiles))
+	mockS3Repo.EXPECT().UploadRendition(ctx, gomock.Any(), gomock.Any(), "audio/mpeg").Return(nil).Times(2)
+	mockS3Repo.EXPECT().UploadRendition(ctx, gomock.Any(), gomock.Any(), "audio/ogg").Return(nil)
+	mockTranscoder.EXPECT().Segment(ctx, gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, profile *repository.RenditionProfile, destinationDir string) ([]*repository.HLSSegment, error) {
+			segments := []*repository.HLSSegment{
+				{Position: 0, Duration: 6, Path: filepath.Join(destinationDir, "00000.ts")},
+				{Position: 1, Duration: 2.5, Path: filepath.Join(destinationDir, "00001.ts")},
+			}
+			for _, segment := range segments {
+				require.NoError(t, os.WriteFile(segment.Path, []byte(profile.Codec), 0o600))
+			}
+			return segments, nil
+		}).Times(len(hlsProfiles))
+	mockS3Repo.EXPECT().UploadRendition(ctx, gomock.Any(), gomock.Any(), "video/mp2t").Return(nil).Times(2 * len(hlsProfiles))
+	mockRepo.EXPECT().CompleteTranscodeJob(ctx, &repository.TranscodeResult{
+		TrackID: 7,
+		FileKey: "upload-1.flac",
+		Renditions: []*repository.TrackRendition{
+			{Codec: "mp3", Bitrate: 128, FileKey: "renditions/upload-1/mp3-128.mp3"},
+			{Codec: "mp3", Bitrate: 320, FileKey: "renditions/upload-1/mp3-320.mp3"},
+			{Codec: "opus", Bitrate: 160, FileKey: "renditions/upload-1/opus-160.ogg"},
+		},
+		Segments: []*repository.TrackSegment{
+			{Codec: "aac", Bitrate: 64, Position: 0, Duration: 6, FileKey: "hls/upload-1/aac-64/00000.ts"},
+			{Codec: "aac", Bitrate: 64, Position: 1, Duration: 2.5, FileKey: "hls/upload-1/aac-64/00001.ts"},
+			{Codec: "aac", Bitrate: 128, Position: 0, Duration: 6, FileKey: "hls/upload-1/aac-128/00000.ts"},
+			{Codec: "aac", Bitrate: 128, Position: 1, Duration: 2.5, FileKey: "hls/upload-1/aac-128/00001.ts"},
+			{Codec: "aac", Bitrate: 256, Position: 0, Duration: 6, FileKey: "hls/upload-1/aac-256/00000.ts"},
+			{Codec: "aac", Bitrate: 256, Position: 1, Duration: 2.5, FileKey: "hls/upload-1/aac-256/00001.ts"},
+		},
+		LoudnessLUFS: -10.5,
+		TruePeakDB:   -0.3,
+		ReplayGainDB: -7.5,
+		Analysis: &repository.TrackAnalysis{
+			Waveform: []float64{0.5, 0.5, 0.25, 0.25},
+		},
+	}).Return(nil)
+
+	processed, err := worker.processNext(ctx)
+	require.NoError(t, err)
+	assert.True(t, processed)
+}
+
+func TestTranscodeWorkerProcessNextFailure(t *testing.T) {
+	worker, mockRepo, mockS3Repo, mockTranscoder, ctx := setupTranscodeWorker(t)
+
+	job := &repository.TranscodeJob{TrackID: 7, FileKey: "upload-1.wav", SourceFormat: "wav", Attempts: 2}
+	mockRepo.EXPECT().ClaimTranscodeJob(ctx, 30*time.Minute).Return(job, nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.wav").Return(io.NopCloser(strings.NewReader("master")), nil)
+	mockTranscoder.EXPECT().MeasureLoudness(ctx, gomock.Any()).Return(&repository.Loudness{IntegratedLUFS: -14}, nil)
+	mockTranscoder.EXPECT().DecodePCM(ctx, gomock.Any(), int64(analysisSampleRate), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ int64, destinationPath string) error {
+			return os.WriteFile(destinationPath, []byte(pcmAudio([]float64{0.5})), 0o600)
+		})
+	mockTranscoder.EXPECT().Transcode(ctx, gomock.Any(), gomock.Any(), gomock.Any()).Return(errors.New("ffmpeg died"))
+	// the renditions are never saved half way
+	mockRepo.EXPECT().CompleteTranscodeJob(gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().FailTranscodeJob(ctx, job, int64(3)).Return(nil)
+
+	processed, err := worker.processNext(ctx)
+	require.NoError(t, err)
+	assert.True(t, processed)
+}
+
+func TestTranscodeWorkerDecodeFailure(t *testing.T) {
+	worker, mockRepo, mockS3Repo, mockTranscoder, ctx := setupTranscodeWorker(t)
+
+	job := &repository.TranscodeJob{TrackID: 7, FileKey: "upload-1.ogg", SourceFormat: "ogg", Attempts: 1}
+	mockRepo.EXPECT().ClaimTranscodeJob(ctx, 30*time.Minute).Return(job, nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.ogg").Return(io.NopCloser(strings.NewReader("master")), nil)
+	mockTranscoder.EXPECT().MeasureLoudness(ctx, gomock.Any()).Return(&repository.Loudness{IntegratedLUFS: -14}, nil)
+	// an empty decode leaves nothing to analyse
+	mockTranscoder.EXPECT().DecodePCM(ctx, gomock.Any(), int64(analysisSampleRate), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ string, _ int64, destinationPath string) error {
+			return os.WriteFile(destinationPath, nil, 0o600)
+		})
+	mockTranscoder.EXPECT().Transcode(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	mockRepo.EXPECT().FailTranscodeJob(ctx, job, int64(3)).Return(nil)
+
+	processed, err := worker.processNext(ctx)
+	require.NoError(t, err)
+	assert.True(t, processed)
+}
+
+func TestTranscodeWorkerEmptyQueue(t *testing.T) {
+	worker, mockRepo, _, _, ctx := setupTranscodeWorker(t)
+
+	mockRepo.EXPECT().ClaimTranscodeJob(ctx, 30*time.Minute).Return(nil, nil)
+
+	processed, err := worker.processNext(ctx)
+	require.NoError(t, err)
+	assert.False(t, processed)
+}
+
+func testFingerprint(seed int64, length int) []int32 {
+	random := rand.New(rand.NewSource(seed))
+	fingerprint := make([]int32, length)
+	for i := range fingerprint {
+		fingerprint[i] = int32(random.Uint32())
+	}
+	return fingerprint
+}
+
+// flipBits changes six of every 32 bits, which leaves the fingerprint 81.25% similar
+func flipBits(fingerprint []int32) []int32 {
+	flipped := make([]int32, len(fingerprint))
+	for i, value := range fingerprint {
+		flipped[i] = value ^ 0x3F
+	}
+	return flipped
+}
+
+func TestFingerprintSimilarity(t *testing.T) {
+	fingerprint := testFingerprint(1, 400)
+
+	assert.Equal(t, 1.0, fingerprintSimilarity(fingerprint, fingerprint))
+	assert.Equal(t, 1.0, fingerprintSimilarity(fingerprint, fingerprint[40:]), "leading silence is aligned away")
+	assert.Equal(t, 0.8125, fingerprintSimilarity(fingerprint, flipBits(fingerprint)))
+	assert.Less(t, fingerprintSimilarity(fingerprint, testFingerprint(2, 400)), 0.6)
+	assert.Zero(t, fingerprintSimilarity(fingerprint, nil))
+}
+
+func setupFingerprintTest(t *testing.T) (domain.Usecase, *mock_domain.MockRepository, *mock_domain.MockS3Repository, *mock_searchIndex.MockIndex, *mock_domain.MockFingerprinter, context.Context) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	mockIndex := mock_searchIndex.NewMockIndex(gomock.NewController(t))
+	mockFingerprinter := mock_domain.NewMockFingerprinter(gomock.NewController(t))
+	u := NewTrackUsecase(mockRepo, mockS3Repo, mockIndex, mockFingerprinter, config.FingerprintConfig{
+		WorkDir:          t.TempDir(),
+		MaxCandidates:    20,
+		FlagSimilarity:   0.75,
+		RejectSimilarity: 0.9,
+	})
+	return u, mockRepo, mockS3Repo, mockIndex, mockFingerprinter, ctx
+}
+
+func TestAddTracksToAlbumRejectsDuplicate(t *testing.T) {
+	u, mockRepo, mockS3Repo, _, mockFingerprinter, ctx := setupFingerprintTest(t)
+	fingerprint := testFingerprint(1, 400)
+
+	mockS3Repo.EXPECT().CopyTrackAvatar(ctx, "Track 1", "uploads/cover").Return("cover.jpg", "#a1b2c3", nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.mp3").Return(io.NopCloser(strings.NewReader(silentMp3(80))), nil)
+	mockFingerprinter.EXPECT().Fingerprint(ctx, gomock.Any()).Return(fingerprint, nil)
+	mockRepo.EXPECT().FindFingerprintCandidates(ctx, fingerprint, int64(0), int64(20)).Return([]*repository.FingerprintCandidate{
+		{TrackID: 5, Title: "Original", AlbumID: 2, AlbumTitle: "First album", LabelID: 1, Fingerprint: fingerprint[40:]},
+	}, nil)
+
+	trackIDs, err := u.AddTracksToAlbum(ctx, &usecase.TracksListWithAlbumID{
+		Tracks:   []*usecase.TrackLoad{{Title: "Track 1", FileKey: "upload-1.mp3"}},
+		AlbumID:  3,
+		CoverKey: "uploads/cover",
+	})
+	require.Error(t, err)
+	assert.Nil(t, trackIDs)
+	assert.Contains(t, err.Error(), `track 5 "Original" of album 2 "First album" by label 1 (100% similar)`)
+}
+
+func TestAddTracksToAlbumFlagsDuplicate(t *testing.T) {
+	u, mockRepo, mockS3Repo, mockIndex, mockFingerprinter, ctx := setupFingerprintTest(t)
+	fingerprint := testFingerprint(1, 400)
+
+	mockS3Repo.EXPECT().CopyTrackAvatar(ctx, "Track 1", "uploads/cover").Return("cover.jpg", "#a1b2c3", nil)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.mp3").Return(io.NopCloser(strings.NewReader(silentMp3(80))), nil)
+	mockFingerprinter.EXPECT().Fingerprint(ctx, gomock.Any()).Return(fingerprint, nil)
+	mockRepo.EXPECT().FindFingerprintCandidates(ctx, fingerprint, int64(0), int64(20)).Return([]*repository.FingerprintCandidate{
+		{TrackID: 5, Fingerprint: flipBits(fingerprint)},
+		{TrackID: 6, Fingerprint: testFingerprint(2, 400)},
+	}, nil)
+	mockRepo.EXPECT().AddTracksToAlbum(ctx, gomock.Any()).Return([]int64{7}, nil)
+	mockRepo.EXPECT().SaveTrackFingerprint(ctx, &repository.TrackFingerprint{
+		TrackID:     7,
+		Fingerprint: fingerprint,
+		Duplicates:  []*repository.DuplicateMatch{{TrackID: 5, Similarity: 0.8125}},
+	}).Return(nil)
+	mockS3Repo.EXPECT().DeleteEmbeddedCover(ctx, "uploads/upload-1-cover").Return(nil)
+	mockIndex.EXPECT().Refresh(ctx, []int64{7}).Return(nil)
+
+	trackIDs, err := u.AddTracksToAlbum(ctx, &usecase.TracksListWithAlbumID{
+		Tracks:   []*usecase.TrackLoad{{Title: "Track 1", FileKey: "upload-1.mp3"}},
+		AlbumID:  3,
+		CoverKey: "uploads/cover",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{7}, trackIDs)
+}
+
+func TestReplaceTrackAudioRejectsDuplicate(t *testing.T) {
+	u, mockRepo, mockS3Repo, _, mockFingerprinter, ctx := setupFingerprintTest(t)
+	fingerprint := testFingerprint(1, 400)
+
+	mockS3Repo.EXPECT().OpenTrack(ctx, "uploads/new.mp3").Return(io.NopCloser(strings.NewReader(silentMp3(80))), nil)
+	mockFingerprinter.EXPECT().Fingerprint(ctx, gomock.Any()).Return(fingerprint, nil)
+	mockRepo.EXPECT().FindFingerprintCandidates(ctx, fingerprint, int64(7), int64(20)).Return([]*repository.FingerprintCandidate{
+		{TrackID: 5, Title: "Original", AlbumID: 2, AlbumTitle: "First album", LabelID: 1, Fingerprint: fingerprint},
+	}, nil)
+
+	err := u.ReplaceTrackAudio(ctx, &usecase.TrackAudioReplace{ID: 7, AlbumID: 1, FileKey: "uploads/new.mp3"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "new audio of track 7 is the same recording as track 5")
+}
+
+func TestReplaceTrackAudioFingerprintFailure(t *testing.T) {
+	u, mockRepo, mockS3Repo, _, mockFingerprinter, ctx := setupFingerprintTest(t)
+
+	mockS3Repo.EXPECT().OpenTrack(ctx, "uploads/new.mp3").Return(io.NopCloser(strings.NewReader(silentMp3(80))), nil)
+	mockFingerprinter.EXPECT().Fingerprint(ctx, gomock.Any()).Return(nil, errors.New("fpcalc failed"))
+	mockRepo.EXPECT().ReplaceTrackFile(ctx, &repository.TrackAudioReplace{ID: 7, AlbumID: 1, FileKey: "uploads/new.mp3", SourceFormat: "mp3", Duration: 2}).Return(nil)
+
+	err := u.ReplaceTrackAudio(ctx, &usecase.TrackAudioReplace{ID: 7, AlbumID: 1, FileKey: "uploads/new.mp3"})
+	assert.NoError(t, err)
+}
+
+func TestGetPendingDuplicateFlags(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, nil, nil, config.FingerprintConfig{})
+	createdAt := time.Date(2025, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	mockRepo.EXPECT().GetPendingDuplicateFlags(ctx, &repository.TrackFilters{Pagination: &repository.Pagination{Limit: 10, Offset: 0}}).Return([]*repository.DuplicateFlag{{
+		ID:           1,
+		Track:        repository.DuplicateFlagTrack{ID: 7, Title: "Upload", AlbumID: 4, LabelID: 3},
+		MatchedTrack: repository.DuplicateFlagTrack{ID: 5, Title: "Original", AlbumID: 2, LabelID: 1},
+		Similarity:   0.8,
+		Status:       "pending",
+		CreatedAt:    createdAt,
+	}}, nil)
+
+	flags, err := u.GetPendingDuplicateFlags(ctx, &usecase.TrackFilters{Pagination: &usecase.Pagination{Limit: 10, Offset: 0}})
+	require.NoError(t, err)
+	assert.Equal(t, []*usecase.DuplicateFlag{{
+		ID:           1,
+		Track:        usecase.DuplicateFlagTrack{ID: 7, Title: "Upload", AlbumID: 4, LabelID: 3},
+		MatchedTrack: usecase.DuplicateFlagTrack{ID: 5, Title: "Original", AlbumID: 2, LabelID: 1},
+		Similarity:   0.8,
+		Status:       "pending",
+		CreatedAt:    createdAt,
+	}}, flags)
+}
+
+func TestResolveDuplicateFlag(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	mockRepo.EXPECT().ResolveDuplicateFlag(ctx, int64(1), "confirmed").Return(nil)
+
+	err := u.ResolveDuplicateFlag(ctx, 1, "confirmed")
+	assert.NoError(t, err)
+}
+
+func TestResolveDuplicateFlagInvalidStatus(t *testing.T) {
+	mockRepo, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(mockRepo, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	err := u.ResolveDuplicateFlag(ctx, 1, "pending")
+	assert.ErrorIs(t, err, trackErrors.ErrDuplicateFlagStatusInvalid)
+}
+
+// id3Frame builds an ID3v2.3 frame, the size is a plain big endian integer
+func id3Frame(id string, body string) string {
+	header := make([]byte, 10)
+	copy(header, id)
+	binary.BigEndian.PutUint32(header[4:8], uint32(len(body)))
+	return string(header) + body
+}
+
+// id3Tag wraps frames into a tag of the given major version
+func id3Tag(version byte, frames ...string) string {
+	body := strings.Join(frames, "")
+	size := len(body)
+	return "ID3" + string([]byte{version, 0, 0, byte(size >> 21 & 0x7F), byte(size >> 14 & 0x7F), byte(size >> 7 & 0x7F), byte(size & 0x7F)}) + body
+}
+
+func vorbisComments(comments ...string) string {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.LittleEndian, uint32(len("vendor")))
+	buf.WriteString("vendor")
+	binary.Write(&buf, binary.LittleEndian, uint32(len(comments)))
+	for _, comment := range comments {
+		binary.Write(&buf, binary.LittleEndian, uint32(len(comment)))
+		buf.WriteString(comment)
+	}
+	return buf.String()
+}
+
+func flacPicture(pictureType uint32, data string) string {
+	var buf bytes.Buffer
+	binary.Write(&buf, binary.BigEndian, pictureType)
+	binary.Write(&buf, binary.BigEndian, uint32(len("image/png")))
+	buf.WriteString("image/png")
+	binary.Write(&buf, binary.BigEndian, uint32(0))
+	buf.Write(make([]byte, 16))
+	binary.Write(&buf, binary.BigEndian, uint32(len(data)))
+	buf.WriteString(data)
+	return buf.String()
+}
+
+func flacBlock(blockType byte, last bool, body string) string {
+	if last {
+		blockType |= 0x80
+	}
+	size := len(body)
+	return string([]byte{blockType, byte(size >> 16), byte(size >> 8), byte(size)}) + body
+}
+
+// tinyPng is a valid one pixel image for the embedded cover checks
+func tinyPng(t *testing.T) string {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, image.NewGray(image.Rect(0, 0, 1, 1))))
+	return buf.String()
+}
+
+func TestReadTags(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		format   string
+		expected *trackTags
+		wantErr  bool
+	}{
+		{
+			name:   "id3v2.3",
+			format: "mp3",
+			file: id3Tag(3,
+				id3Frame("TIT2", "\x00Caf\xe9"),
+				id3Frame("TPE1", "\x01\xff\xfeB\x00j\x00\xf6\x00r\x00k\x00\x00\x00"),
+				id3Frame("TRCK", "\x003/12"),
+				id3Frame("TCON", "\x00(17)"),
+				id3Frame("TYER", "\x002021"),
+				id3Frame("TSRC", "\x00USRC17607839"),
+				id3Frame("APIC", "\x00image/png\x00\x04back\x00BACK"),
+				id3Frame("APIC", "\x00image/png\x00\x03\x00FRONT"),
+				id3Frame("APIC", "\x00image/png\x00\x05\x00LEAFLET"),
+			) + silentMp3(1),
+			expected: &trackTags{
+				title:       "Café",
+				trackNumber: 3,
+				artists:     []string{"Björk"},
+				genre:       "Rock",
+				year:        2021,
+				isrc:        "USRC17607839",
+				cover:       []byte("FRONT"),
+				coverType:   3,
+			},
+		},
+		{
+			name:   "id3v2.4 with several artists",
+			format: "mp3",
+			file: id3Tag(4,
+				id3Frame("TIT2", "\x03Intro"),
+				id3Frame("TPE1", "\x03First\x00Second"),
+				id3Frame("TDRC", "\x032019-04-09"),
+				id3Frame("TCON", "\x03Shoegaze"),
+			) + silentMp3(1),
+			expected: &trackTags{
+				title:   "Intro",
+				artists: []string{"First", "Second"},
+				genre:   "Shoegaze",
+				year:    2019,
+			},
+		},
+		{
+			name:   "broken frame keeps the ones before it",
+			format: "mp3",
+			file: id3Tag(3,
+				id3Frame("TIT2", "\x00Intro"),
+				id3Frame("TPE1", "\x00Artist")[:12],
+			) + silentMp3(1),
+			expected: &trackTags{title: "Intro"},
+			wantErr:  true,
+		},
+		{
+			name:     "id3 tag bigger than the limit",
+			format:   "mp3",
+			file:     "ID3\x03\x00\x00\x7f\x7f\x7f\x7f" + silentMp3(1),
+			expected: &trackTags{},
+			wantErr:  true,
+		},
+		{
+			name:   "flac",
+			format: "flac",
+			file: "fLaC" + flacBlock(0, false, flacFile(44100, 44100)[8:]) +
+				flacBlock(4, false, vorbisComments("TITLE=Outro", "ARTIST=First", "artist=Second", "TRACKNUMBER=12", "GENRE=Jazz", "DATE=2020", "ISRC=GBAYE0601498", "EMPTY=")) +
+				flacBlock(6, true, flacPicture(3, "FRONT")),
+			expected: &trackTags{
+				title:       "Outro",
+				trackNumber: 12,
+				artists:     []string{"First", "Second"},
+				genre:       "Jazz",
+				year:        2020,
+				isrc:        "GBAYE0601498",
+				cover:       []byte("FRONT"),
+				coverType:   3,
+			},
+		},
+		{
+			name:   "opus",
+			format: "ogg",
+			file: oggPage(0, "OpusHead\x01\x02\x38\x01\x80\xbb\x00\x00\x00\x00\x00") +
+				oggPage(0, "OpusTags"+vorbisComments("TITLE=Interlude", "METADATA_BLOCK_PICTURE="+base64.StdEncoding.EncodeToString([]byte(flacPicture(0, "ICON"))))),
+			expected: &trackTags{
+				title: "Interlude",
+				cover: []byte("ICON"),
+			},
+		},
+		{
+			name:     "wav has no tags",
+			format:   "wav",
+			file:     wavFile(1),
+			expected: &trackTags{},
+		},
+		{
+			name:    "unknown format",
+			format:  "",
+			file:    "\x89PNG\r\n\x1a\n\x00\x00\x00\x0d",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, tags, err := readTags(bufio.NewReader(strings.NewReader(tt.file)))
+			assert.Equal(t, tt.format, format)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			if tt.expected != nil {
+				assert.Equal(t, tt.expected, tags)
+			}
+		})
+	}
+}
+
+func TestParseID3Genre(t *testing.T) {
+	assert.Equal(t, "Rock", parseID3Genre("17"))
+	assert.Equal(t, "Rock", parseID3Genre("(17)"))
+	assert.Equal(t, "Darker Rock", parseID3Genre("(17)Darker Rock"))
+	assert.Equal(t, "", parseID3Genre("(200)"))
+	assert.Equal(t, "Synthwave", parseID3Genre("Synthwave"))
+}
+
+func TestReadTrackMetadata(t *testing.T) {
+	_, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(nil, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	cover := tinyPng(t)
+	file := id3Tag(3,
+		id3Frame("TIT2", "\x00Intro"),
+		id3Frame("TPE1", "\x00Artist"),
+		id3Frame("TRCK", "\x002"),
+		id3Frame("APIC", "\x00image/png\x00\x03\x00"+cover),
+	) + silentMp3(1)
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.mp3").Return(io.NopCloser(strings.NewReader(file)), nil)
+	mockS3Repo.EXPECT().UploadEmbeddedCover(ctx, "uploads/upload-1-cover", []byte(cover), "png").Return("https://images.s3.cloud.ru/uploads/upload-1-cover", nil)
+
+	metadata, err := u.ReadTrackMetadata(ctx, "upload-1.mp3")
+	assert.NoError(t, err)
+	assert.Equal(t, &usecase.TrackMetadata{
+		Format:      "mp3",
+		Title:       "Intro",
+		TrackNumber: 2,
+		Artists:     []string{"Artist"},
+		CoverKey:    "uploads/upload-1-cover",
+		CoverURL:    "https://images.s3.cloud.ru/uploads/upload-1-cover",
+	}, metadata)
+}
+
+func TestReadTrackMetadataSkipsUnknownCover(t *testing.T) {
+	_, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(nil, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	file := "fLaC" + flacBlock(0, false, flacFile(44100, 44100)[8:]) + flacBlock(6, true, flacPicture(3, "not an image"))
+	mockS3Repo.EXPECT().OpenTrack(ctx, "upload-1.flac").Return(io.NopCloser(strings.NewReader(file)), nil)
+
+	metadata, err := u.ReadTrackMetadata(ctx, "upload-1.flac")
+	assert.NoError(t, err)
+	assert.Equal(t, &usecase.TrackMetadata{Format: "flac"}, metadata)
+}
+
+func TestReadTrackMetadataNotAudio(t *testing.T) {
+	_, mockS3Repo, ctx := setupTest(t)
+	u := NewTrackUsecase(nil, mockS3Repo, nil, nil, config.FingerprintConfig{})
+
+	mockS3Repo.EXPECT().OpenTrack(ctx, "uploads/cover.png").Return(io.NopCloser(strings.NewReader("\x89PNG not a song")), nil)
+
+	_, err := u.ReadTrackMetadata(ctx, "uploads/cover.png")
+	assert.ErrorIs(t, err, trackErrors.ErrTrackAudioInvalid)
+}
+
+// pcmAudio encodes samples from -1 to 1 the way DecodePCM writes them
+func pcmAudio(samples []float64) string {
+	var buf bytes.Buffer
+	for _, sample := range samples {
+		binary.Write(&buf, binary.LittleEndian, int16(sample*32767))
+	}
+	return buf.String()
+}
+
+// clickTrack is a short burst of noise on every beat
+func clickTrack(bpm float64, seconds int) []float64 {
+	random := rand.New(rand.NewSource(1))
+	samples := make([]float64, seconds*analysisSampleRate)
+	beat := 60 / bpm * analysisSampleRate
+	for onset := 0.0; int(onset) < len(samples); onset += beat {
+		for i := int(onset); i < int(onset)+400 && i < len(samples); i++ {
+			samples[i] = 0.8 * (random.Float64()*2 - 1)
+		}
+	}
+	return samples
+}
+
+// chord sums sines of the given frequencies
+func chord(seconds int, frequencies ...float64) []float64 {
+	samples := make([]float64, seconds*analysisSampleRate)
+	for i := range samples {
+		for _, frequency := range frequencies {
+			samples[i] += 0.2 * math.Sin(2*math.Pi*frequency*float64(i)/analysisSampleRate)
+		}
+	}
+	return samples
+}
+
+func TestAnalyzeAudioTempo(t *testing.T) {
+	for _, bpm := range []float64{90, 120, 128, 174} {
+		samples := clickTrack(bpm, 30)
+		analysis, err := analyzeAudio(strings.NewReader(pcmAudio(samples)), int64(len(samples)))
+		require.NoError(t, err)
+		assert.InDelta(t, bpm, analysis.BPM, 1.5, "bpm %v", bpm)
+	}
+}
+
+func TestAnalyzeAudioKey(t *testing.T) {
+	tests := []struct {
+		name        string
+		frequencies []float64
+		expected    string
+	}{
+		{name: "c major", frequencies: []float64{261.63, 329.63, 392.00}, expected: "C major"},
+		{name: "a minor", frequencies: []float64{220.00, 261.63, 329.63}, expected: "A minor"},
+		{name: "f sharp minor", frequencies: []float64{185.00, 220.00, 277.18}, expected: "F# minor"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			samples := chord(5, tt.frequencies...)
+			analysis, err := analyzeAudio(strings.NewReader(pcmAudio(samples)), int64(len(samples)))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, analysis.Key)
+		})
+	}
+}
+
+func TestAnalyzeAudioWaveform(t *testing.T) {
+	samples := make([]float64, 4*waveformPeaks)
+	for i := range samples {
+		samples[i] = 0.5
+		if i >= len(samples)/2 {
+			samples[i] = -0.25
+		}
+	}
+
+	analysis, err := analyzeAudio(strings.NewReader(pcmAudio(samples)), int64(len(samples)))
+	require.NoError(t, err)
+	require.Len(t, analysis.Waveform, waveformPeaks)
+	assert.Equal(t, 0.5, analysis.Waveform[0])
+	assert.Equal(t, 0.5, analysis.Waveform[waveformPeaks/2-1])
+	assert.Equal(t, 0.25, analysis.Waveform[waveformPeaks/2])
+	assert.Equal(t, 0.25, analysis.Waveform[waveformPeaks-1])
+	// too short for a tempo and silent of pitch
+	assert.Zero(t, analysis.BPM)
+}
+
+func TestAnalyzeAudioSilence(t *testing.T) {
+	samples := make([]float64, 10*analysisSampleRate)
+
+	analysis, err := analyzeAudio(strings.NewReader(pcmAudio(samples)), int64(len(samples)))
+	require.NoError(t, err)
+	assert.Zero(t, analysis.BPM)
+	assert.Empty(t, analysis.Key)
+	assert.Len(t, analysis.Waveform, waveformPeaks)
+	assert.Zero(t, analysis.Waveform[0])
+}
+
+func TestAnalyzeAudioShortRead(t *testing.T) {
+	// fewer samples than the file size promised, the tail is left flat
+	analysis, err := analyzeAudio(strings.NewReader(pcmAudio([]float64{0.5, 0.5})), 4)
+	require.NoError(t, err)
+	assert.Equal(t, []float64{0.5, 0.5, 0, 0}, analysis.Waveform)
+
+	_, err = analyzeAudio(strings.NewReader(""), 0)
+	assert.Error(t, err)
+}
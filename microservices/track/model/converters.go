@@ -4,6 +4,7 @@ import (
 	trackProto "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/track"
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/track/model/usecase"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func PaginationFromUsecaseToRepository(pagination *usecaseModel.Pagination) *repoModel.Pagination {
@@ -21,19 +22,33 @@ func FiltersFromUsecaseToRepository(filters *usecaseModel.TrackFilters) *repoMod
 
 func TrackFromRepositoryToUsecase(track *repoModel.Track) *usecaseModel.Track {
 	return &usecaseModel.Track{
-		ID:         track.ID,
-		Title:      track.Title,
-		Thumbnail:  track.Thumbnail,
-		Duration:   track.Duration,
-		AlbumID:    track.AlbumID,
-		IsFavorite: track.IsFavorite,
+		ID:             track.ID,
+		Title:          track.Title,
+		Thumbnail:      track.Thumbnail,
+		ThumbnailColor: track.ThumbnailColor,
+		Duration:       track.Duration,
+		AlbumID:        track.AlbumID,
+		BPM:            track.BPM,
+		IsFavorite:     track.IsFavorite,
 	}
 }
 
-func TrackDetailedFromRepositoryToUsecase(track *repoModel.TrackWithFileKey, trackFileUrl string) *usecaseModel.TrackDetailed {
+func TrackDetailedFromRepositoryToUsecase(track *repoModel.TrackWithFileKey, trackFileUrl string, renditions []*usecaseModel.TrackRendition) *usecaseModel.TrackDetailed {
 	return &usecaseModel.TrackDetailed{
-		Track:   *TrackFromRepositoryToUsecase(&track.Track),
-		FileUrl: trackFileUrl,
+		Track:        *TrackFromRepositoryToUsecase(&track.Track),
+		FileUrl:      trackFileUrl,
+		Renditions:   renditions,
+		LoudnessLUFS: track.LoudnessLUFS,
+		ReplayGainDB: track.ReplayGainDB,
+		Key:          track.Key,
+		HasLyrics:    track.HasLyrics,
+	}
+}
+
+func TrackWaveformFromRepositoryToUsecase(waveform *repoModel.TrackWaveform) *usecaseModel.TrackWaveform {
+	return &usecaseModel.TrackWaveform{
+		Duration: waveform.Duration,
+		Peaks:    waveform.Peaks,
 	}
 }
 
@@ -61,19 +76,41 @@ func TrackStreamUpdateDataFromUsecaseToRepository(stream *usecaseModel.TrackStre
 
 func TrackFromUsecaseToProto(track *usecaseModel.Track) *trackProto.Track {
 	return &trackProto.Track{
-		Id:         track.ID,
-		Title:      track.Title,
-		Thumbnail:  track.Thumbnail,
-		Duration:   track.Duration,
-		AlbumId:    track.AlbumID,
-		IsFavorite: track.IsFavorite,
+		Id:             track.ID,
+		Title:          track.Title,
+		Thumbnail:      track.Thumbnail,
+		ThumbnailColor: track.ThumbnailColor,
+		Duration:       track.Duration,
+		AlbumId:        track.AlbumID,
+		Bpm:            track.BPM,
+		IsFavorite:     track.IsFavorite,
 	}
 }
 
 func TrackDetailedFromUsecaseToProto(track *usecaseModel.TrackDetailed) *trackProto.TrackDetailed {
+	renditions := make([]*trackProto.TrackRendition, len(track.Renditions))
+	for i, rendition := range track.Renditions {
+		renditions[i] = &trackProto.TrackRendition{
+			Codec:   rendition.Codec,
+			Bitrate: rendition.Bitrate,
+			FileUrl: rendition.FileUrl,
+		}
+	}
 	return &trackProto.TrackDetailed{
-		Track:   TrackFromUsecaseToProto(&track.Track),
-		FileUrl: track.FileUrl,
+		Track:        TrackFromUsecaseToProto(&track.Track),
+		FileUrl:      track.FileUrl,
+		Renditions:   renditions,
+		LoudnessLufs: track.LoudnessLUFS,
+		ReplayGainDb: track.ReplayGainDB,
+		Key:          track.Key,
+		HasLyrics:    track.HasLyrics,
+	}
+}
+
+func TrackWaveformFromUsecaseToProto(waveform *usecaseModel.TrackWaveform) *trackProto.TrackWaveform {
+	return &trackProto.TrackWaveform{
+		Duration: waveform.Duration,
+		Peaks:    waveform.Peaks,
 	}
 }
 
@@ -90,8 +127,8 @@ func TrackListFromUsecaseToProto(tracks []*usecaseModel.Track) *trackProto.Track
 func FiltersFromProtoToUsecase(filters *trackProto.Filters) *usecaseModel.TrackFilters {
 	return &usecaseModel.TrackFilters{
 		Pagination: &usecaseModel.Pagination{
-			Limit:  filters.Pagination.Limit,
-			Offset: filters.Pagination.Offset,
+			Limit:  filters.GetPagination().GetLimit(),
+			Offset: filters.GetPagination().GetOffset(),
 		},
 	}
 }
@@ -117,6 +154,36 @@ func TrackStreamUpdateDataFromProtoToUsecase(stream *trackProto.TrackStreamUpdat
 	}
 }
 
+func StreamManifestFromUsecaseToProto(variants []*usecaseModel.StreamVariant) *trackProto.StreamManifest {
+	protoVariants := make([]*trackProto.StreamVariant, len(variants))
+	for i, variant := range variants {
+		segments := make([]*trackProto.StreamSegment, len(variant.Segments))
+		for j, segment := range variant.Segments {
+			segments[j] = &trackProto.StreamSegment{
+				Position: segment.Position,
+				Duration: segment.Duration,
+			}
+		}
+		protoVariants[i] = &trackProto.StreamVariant{
+			Codec:    variant.Codec,
+			Bitrate:  variant.Bitrate,
+			Segments: segments,
+		}
+	}
+	return &trackProto.StreamManifest{
+		Variants: protoVariants,
+	}
+}
+
+func StreamSegmentRequestFromProtoToUsecase(request *trackProto.StreamSegmentRequest) *usecaseModel.StreamSegmentRequest {
+	return &usecaseModel.StreamSegmentRequest{
+		StreamID: request.StreamId.Id,
+		UserID:   request.UserId.Id,
+		Bitrate:  request.Bitrate,
+		Position: request.Position,
+	}
+}
+
 func TrackIDListFromProtoToUsecase(ids *trackProto.TrackIDList) ([]int64, int64) {
 	usecaseIDs := make([]int64, len(ids.Ids))
 	for i, id := range ids.Ids {
@@ -165,8 +232,8 @@ func TracksIDWithAlbumIDFromProtoToUsecase(tracksIDWithAlbumID []*trackProto.Tra
 	var tracks []*usecaseModel.TrackLoad
 	for _, track := range tracksIDWithAlbumID {
 		tracks = append(tracks, &usecaseModel.TrackLoad{
-			Title: track.Title,
-			File:  track.File,
+			Title:   track.Title,
+			FileKey: track.FileKey,
 		})
 	}
 	return tracks
@@ -175,7 +242,7 @@ func TracksIDWithAlbumIDFromProtoToUsecase(tracksIDWithAlbumID []*trackProto.Tra
 func TrackLoadFromUsecaseToRepository(track *usecaseModel.TrackLoad) *repoModel.TrackLoad {
 	return &repoModel.TrackLoad{
 		Title:    track.Title,
-		File:     track.File,
+		FileKey:  track.FileKey,
 		Position: track.Position,
 	}
 }
@@ -192,12 +259,13 @@ func TrackListFromUsecaseToRepository(tracks []*usecaseModel.Track) []*repoModel
 	repoTracks := make([]*repoModel.Track, len(tracks))
 	for i, track := range tracks {
 		repoTracks[i] = &repoModel.Track{
-			ID:         track.ID,
-			Title:      track.Title,
-			Thumbnail:  track.Thumbnail,
-			Duration:   track.Duration,
-			AlbumID:    track.AlbumID,
-			IsFavorite: track.IsFavorite,
+			ID:             track.ID,
+			Title:          track.Title,
+			Thumbnail:      track.Thumbnail,
+			ThumbnailColor: track.ThumbnailColor,
+			Duration:       track.Duration,
+			AlbumID:        track.AlbumID,
+			IsFavorite:     track.IsFavorite,
 		}
 	}
 	return repoTracks
@@ -206,9 +274,9 @@ func TrackListFromUsecaseToRepository(tracks []*usecaseModel.Track) []*repoModel
 func TracksListWithAlbumIDFromProtoToUsecase(tracksIDWithAlbumID *trackProto.TracksListWithAlbumID) *usecaseModel.TracksListWithAlbumID {
 	tracks := TracksIDWithAlbumIDFromProtoToUsecase(tracksIDWithAlbumID.Tracks)
 	return &usecaseModel.TracksListWithAlbumID{
-		Tracks:  tracks,
-		AlbumID: tracksIDWithAlbumID.AlbumId.Id,
-		Cover:   tracksIDWithAlbumID.Cover,
+		Tracks:   tracks,
+		AlbumID:  tracksIDWithAlbumID.AlbumId.Id,
+		CoverKey: tracksIDWithAlbumID.CoverKey,
 	}
 }
 
@@ -219,3 +287,197 @@ func TrackIdsListFromUsecaseToProto(trackIDs []int64) *trackProto.TrackIdsList {
 	}
 	return &trackProto.TrackIdsList{Ids: protoTrackIDs}
 }
+
+func AlbumTracksUpdateFromProtoToUsecase(update *trackProto.AlbumTracksUpdate) *usecaseModel.AlbumTracksUpdate {
+	tracks := make([]*usecaseModel.TrackUpdate, 0, len(update.Tracks))
+	for _, track := range update.Tracks {
+		tracks = append(tracks, &usecaseModel.TrackUpdate{
+			ID:       track.GetId().GetId(),
+			Title:    track.Title,
+			Position: track.Position,
+		})
+	}
+	return &usecaseModel.AlbumTracksUpdate{
+		AlbumID: update.GetAlbumId().GetId(),
+		Tracks:  tracks,
+	}
+}
+
+func AlbumTracksUpdateFromUsecaseToRepository(update *usecaseModel.AlbumTracksUpdate) *repoModel.AlbumTracksUpdate {
+	tracks := make([]*repoModel.TrackUpdate, 0, len(update.Tracks))
+	for _, track := range update.Tracks {
+		tracks = append(tracks, &repoModel.TrackUpdate{
+			ID:       track.ID,
+			Title:    track.Title,
+			Position: track.Position,
+		})
+	}
+	return &repoModel.AlbumTracksUpdate{
+		AlbumID: update.AlbumID,
+		Tracks:  tracks,
+	}
+}
+
+func TrackEditFromProtoToUsecase(edit *trackProto.TrackEdit) *usecaseModel.TrackEdit {
+	return &usecaseModel.TrackEdit{
+		ID:       edit.GetId().GetId(),
+		AlbumID:  edit.GetAlbumId().GetId(),
+		Title:    edit.Title,
+		Position: edit.Position,
+	}
+}
+
+func TrackEditFromUsecaseToRepository(edit *usecaseModel.TrackEdit) *repoModel.TrackEdit {
+	return &repoModel.TrackEdit{
+		ID:       edit.ID,
+		AlbumID:  edit.AlbumID,
+		Title:    edit.Title,
+		Position: edit.Position,
+	}
+}
+
+func TrackAudioReplaceFromProtoToUsecase(replace *trackProto.TrackAudioReplace) *usecaseModel.TrackAudioReplace {
+	return &usecaseModel.TrackAudioReplace{
+		ID:      replace.GetId().GetId(),
+		AlbumID: replace.GetAlbumId().GetId(),
+		FileKey: replace.FileKey,
+	}
+}
+
+func DuplicateFlagTrackFromRepositoryToUsecase(track repoModel.DuplicateFlagTrack) usecaseModel.DuplicateFlagTrack {
+	return usecaseModel.DuplicateFlagTrack{
+		ID:      track.ID,
+		Title:   track.Title,
+		AlbumID: track.AlbumID,
+		LabelID: track.LabelID,
+	}
+}
+
+func DuplicateFlagListFromRepositoryToUsecase(flags []*repoModel.DuplicateFlag) []*usecaseModel.DuplicateFlag {
+	usecaseFlags := make([]*usecaseModel.DuplicateFlag, 0, len(flags))
+	for _, flag := range flags {
+		usecaseFlags = append(usecaseFlags, &usecaseModel.DuplicateFlag{
+			ID:           flag.ID,
+			Track:        DuplicateFlagTrackFromRepositoryToUsecase(flag.Track),
+			MatchedTrack: DuplicateFlagTrackFromRepositoryToUsecase(flag.MatchedTrack),
+			Similarity:   flag.Similarity,
+			Status:       flag.Status,
+			CreatedAt:    flag.CreatedAt,
+		})
+	}
+	return usecaseFlags
+}
+
+func DuplicateFlagTrackFromUsecaseToProto(track usecaseModel.DuplicateFlagTrack) *trackProto.DuplicateFlagTrack {
+	return &trackProto.DuplicateFlagTrack{
+		Id:      &trackProto.TrackID{Id: track.ID},
+		Title:   track.Title,
+		AlbumId: &trackProto.AlbumID{Id: track.AlbumID},
+		LabelId: track.LabelID,
+	}
+}
+
+func DuplicateFlagListFromUsecaseToProto(flags []*usecaseModel.DuplicateFlag) *trackProto.DuplicateFlagList {
+	protoFlags := make([]*trackProto.DuplicateFlag, 0, len(flags))
+	for _, flag := range flags {
+		protoFlags = append(protoFlags, &trackProto.DuplicateFlag{
+			Id:           flag.ID,
+			Track:        DuplicateFlagTrackFromUsecaseToProto(flag.Track),
+			MatchedTrack: DuplicateFlagTrackFromUsecaseToProto(flag.MatchedTrack),
+			Similarity:   flag.Similarity,
+			Status:       flag.Status,
+			CreatedAt:    timestamppb.New(flag.CreatedAt),
+		})
+	}
+	return &trackProto.DuplicateFlagList{
+		Flags: protoFlags,
+	}
+}
+
+func TrackMetadataFromUsecaseToProto(metadata *usecaseModel.TrackMetadata) *trackProto.TrackMetadata {
+	return &trackProto.TrackMetadata{
+		Format:      metadata.Format,
+		Title:       metadata.Title,
+		TrackNumber: metadata.TrackNumber,
+		Artists:     metadata.Artists,
+		Genre:       metadata.Genre,
+		Year:        metadata.Year,
+		Isrc:        metadata.ISRC,
+		CoverKey:    metadata.CoverKey,
+		CoverUrl:    metadata.CoverURL,
+	}
+}
+
+func TrackLyricsUploadFromProtoToUsecase(upload *trackProto.TrackLyricsUpload) *usecaseModel.TrackLyricsUpload {
+	return &usecaseModel.TrackLyricsUpload{
+		TrackID:     upload.GetId().GetId(),
+		AlbumID:     upload.GetAlbumId().GetId(),
+		Language:    upload.Language,
+		Translation: upload.Translation,
+		Text:        upload.Text,
+	}
+}
+
+func TrackLyricsDeleteFromProtoToUsecase(request *trackProto.TrackLyricsDelete) *usecaseModel.TrackLyricsDelete {
+	return &usecaseModel.TrackLyricsDelete{
+		TrackID:  request.GetId().GetId(),
+		AlbumID:  request.GetAlbumId().GetId(),
+		Language: request.Language,
+	}
+}
+
+func TrackLyricsDeleteFromUsecaseToRepository(request *usecaseModel.TrackLyricsDelete) *repoModel.TrackLyricsDelete {
+	return &repoModel.TrackLyricsDelete{
+		TrackID:  request.TrackID,
+		AlbumID:  request.AlbumID,
+		Language: request.Language,
+	}
+}
+
+func TrackLyricsFromRepositoryToUsecase(lyrics *repoModel.TrackLyrics) *usecaseModel.TrackLyrics {
+	lines := make([]*usecaseModel.LyricsLine, len(lyrics.Lines))
+	for i, text := range lyrics.Lines {
+		lines[i] = &usecaseModel.LyricsLine{Text: text}
+		if lyrics.LineTimes != nil {
+			lines[i].Time = lyrics.LineTimes[i]
+		}
+	}
+	return &usecaseModel.TrackLyrics{
+		Language:    lyrics.Language,
+		Translation: lyrics.Translation,
+		Synced:      lyrics.LineTimes != nil,
+		Lines:       lines,
+	}
+}
+
+func TrackLyricsListFromRepositoryToUsecase(lyricsList []*repoModel.TrackLyrics) []*usecaseModel.TrackLyrics {
+	usecaseLyrics := make([]*usecaseModel.TrackLyrics, len(lyricsList))
+	for i, lyrics := range lyricsList {
+		usecaseLyrics[i] = TrackLyricsFromRepositoryToUsecase(lyrics)
+	}
+	return usecaseLyrics
+}
+
+func TrackLyricsFromUsecaseToProto(lyrics *usecaseModel.TrackLyrics) *trackProto.TrackLyrics {
+	lines := make([]*trackProto.LyricsLine, len(lyrics.Lines))
+	for i, line := range lyrics.Lines {
+		lines[i] = &trackProto.LyricsLine{
+			Time: line.Time,
+			Text: line.Text,
+		}
+	}
+	return &trackProto.TrackLyrics{
+		Language:    lyrics.Language,
+		Translation: lyrics.Translation,
+		Synced:      lyrics.Synced,
+		Lines:       lines,
+	}
+}
+
+func TrackLyricsListFromUsecaseToProto(lyricsList []*usecaseModel.TrackLyrics) *trackProto.TrackLyricsList {
+	protoLyrics := make([]*trackProto.TrackLyrics, len(lyricsList))
+	for i, lyrics := range lyricsList {
+		protoLyrics[i] = TrackLyricsFromUsecaseToProto(lyrics)
+	}
+	return &trackProto.TrackLyricsList{Lyrics: protoLyrics}
+}
@@ -43,6 +43,7 @@ func TestTrackFromRepositoryToUsecase(t *testing.T) {
 		Thumbnail:  "thumbnail.jpg",
 		Duration:   180,
 		AlbumID:    2,
+		BPM:        124.5,
 		IsFavorite: true,
 	}
 
@@ -53,6 +54,7 @@ func TestTrackFromRepositoryToUsecase(t *testing.T) {
 	assert.Equal(t, repoTrack.Thumbnail, result.Thumbnail)
 	assert.Equal(t, repoTrack.Duration, result.Duration)
 	assert.Equal(t, repoTrack.AlbumID, result.AlbumID)
+	assert.Equal(t, repoTrack.BPM, result.BPM)
 	assert.Equal(t, repoTrack.IsFavorite, result.IsFavorite)
 }
 
@@ -70,7 +72,7 @@ func TestTrackDetailedFromRepositoryToUsecase(t *testing.T) {
 	}
 	fileUrl := "https://example.com/tracks/1.mp3"
 
-	result := TrackDetailedFromRepositoryToUsecase(repoTrack, fileUrl)
+	result := TrackDetailedFromRepositoryToUsecase(repoTrack, fileUrl, nil)
 
 	assert.Equal(t, repoTrack.ID, result.Track.ID)
 	assert.Equal(t, repoTrack.Title, result.Track.Title)
@@ -146,6 +148,7 @@ func TestTrackFromUsecaseToProto(t *testing.T) {
 		Thumbnail:  "thumbnail.jpg",
 		Duration:   180,
 		AlbumID:    2,
+		BPM:        124.5,
 		IsFavorite: true,
 	}
 
@@ -156,6 +159,7 @@ func TestTrackFromUsecaseToProto(t *testing.T) {
 	assert.Equal(t, usecaseTrack.Thumbnail, result.Thumbnail)
 	assert.Equal(t, usecaseTrack.Duration, result.Duration)
 	assert.Equal(t, usecaseTrack.AlbumID, result.AlbumId)
+	assert.Equal(t, usecaseTrack.BPM, result.Bpm)
 	assert.Equal(t, usecaseTrack.IsFavorite, result.IsFavorite)
 }
 
@@ -170,6 +174,11 @@ func TestTrackDetailedFromUsecaseToProto(t *testing.T) {
 			IsFavorite: true,
 		},
 		FileUrl: "https://example.com/tracks/1.mp3",
+		Renditions: []*usecaseModel.TrackRendition{
+			{Codec: "opus", Bitrate: 160, FileUrl: "https://example.com/tracks/1/opus-160.ogg"},
+		},
+		LoudnessLUFS: -9.5,
+		ReplayGainDB: -8.5,
 	}
 
 	result := TrackDetailedFromUsecaseToProto(usecaseTrack)
@@ -181,6 +190,10 @@ func TestTrackDetailedFromUsecaseToProto(t *testing.T) {
 	assert.Equal(t, usecaseTrack.Track.AlbumID, result.Track.AlbumId)
 	assert.Equal(t, usecaseTrack.Track.IsFavorite, result.Track.IsFavorite)
 	assert.Equal(t, usecaseTrack.FileUrl, result.FileUrl)
+	assert.Len(t, result.Renditions, 1)
+	assert.Equal(t, "opus", result.Renditions[0].Codec)
+	assert.Equal(t, int64(160), result.Renditions[0].Bitrate)
+	assert.Equal(t, -8.5, result.ReplayGainDb)
 }
 
 func TestTrackListFromUsecaseToProto(t *testing.T) {
@@ -401,8 +414,8 @@ func TestFavoriteRequestFromUsecaseToRepository(t *testing.T) {
 
 func TestTracksIDWithAlbumIDFromProtoToUsecase(t *testing.T) {
 	protoTracks := []*trackProto.TrackLoad{
-		{Title: "Track 1", File: []byte("file1.mp3")},
-		{Title: "Track 2", File: []byte("file2.mp3")},
+		{Title: "Track 1", FileKey: "file1.mp3"},
+		{Title: "Track 2", FileKey: "file2.mp3"},
 	}
 
 	result := TracksIDWithAlbumIDFromProtoToUsecase(protoTracks)
@@ -410,21 +423,21 @@ func TestTracksIDWithAlbumIDFromProtoToUsecase(t *testing.T) {
 	assert.Len(t, result, len(protoTracks))
 	for i, track := range protoTracks {
 		assert.Equal(t, track.Title, result[i].Title)
-		assert.Equal(t, track.File, result[i].File)
+		assert.Equal(t, track.FileKey, result[i].FileKey)
 	}
 }
 
 func TestTrackLoadFromUsecaseToRepository(t *testing.T) {
 	usecaseTrack := &usecaseModel.TrackLoad{
 		Title:    "Test Track",
-		File:     []byte("file.mp3"),
+		FileKey:  "file.mp3",
 		Position: 1,
 	}
 
 	result := TrackLoadFromUsecaseToRepository(usecaseTrack)
 
 	assert.Equal(t, usecaseTrack.Title, result.Title)
-	assert.Equal(t, usecaseTrack.File, result.File)
+	assert.Equal(t, usecaseTrack.FileKey, result.FileKey)
 	assert.Equal(t, usecaseTrack.Position, result.Position)
 }
 
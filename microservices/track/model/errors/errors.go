@@ -55,9 +55,43 @@ func NewFailedToUploadAvatarError(format string, args ...interface{}) error {
 	}
 }
 
+func NewInvalidArgumentError(format string, args ...interface{}) error {
+	return &TrackError{
+		Code:    codes.InvalidArgument,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func NewAlreadyExistsError(format string, args ...interface{}) error {
+	return &TrackError{
+		Code:    codes.AlreadyExists,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+func NewFailedPreconditionError(format string, args ...interface{}) error {
+	return &TrackError{
+		Code:    codes.FailedPrecondition,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
 var (
 	ErrTrackNotFound                = NewNotFoundError("track not found")
 	ErrStreamPermissionDenied       = NewPermissionDeniedError("user does not have permission to update this stream")
 	ErrStreamNotFound               = NewNotFoundError("stream not found")
 	ErrFailedToUpdateStreamDuration = NewInternalError("failed to update stream duration")
+	ErrAlbumNotDraft                = NewFailedPreconditionError("album is not a draft")
+	ErrAlbumTracksInvalid           = NewInvalidArgumentError("every track of the album must be listed once with positions from 1")
+	ErrTrackTitleInvalid            = NewInvalidArgumentError("track title must be from 1 to 100 characters long")
+	ErrTrackPositionInvalid         = NewInvalidArgumentError("track position is out of range")
+	ErrTrackSegmentsNotFound        = NewNotFoundError("track is not segmented for hls yet")
+	ErrTrackWaveformNotFound        = NewNotFoundError("track is not analysed for a waveform yet")
+	ErrTrackSegmentNotFound         = NewNotFoundError("segment not found")
+	ErrDuplicateFlagNotFound        = NewNotFoundError("duplicate flag not found")
+	ErrDuplicateFlagStatusInvalid   = NewInvalidArgumentError("duplicate flag can only be confirmed or dismissed")
+	ErrTrackAudioInvalid            = NewInvalidArgumentError("uploaded file is not a supported audio file (mp3, flac, wav or ogg)")
+	ErrTrackLyricsInvalid           = NewInvalidArgumentError("lyrics must have from 1 to 1000 lines of up to 500 characters")
+	ErrTrackLyricsLanguageInvalid   = NewInvalidArgumentError("lyrics language must be a two or three letter ISO 639 code")
+	ErrTrackLyricsNotFound          = NewNotFoundError("lyrics not found")
 )
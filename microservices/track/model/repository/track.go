@@ -1,11 +1,17 @@
 package repository
 
+import "time"
+
 type Track struct {
-	ID         int64
-	Title      string
-	Thumbnail  string
-	Duration   int64
-	AlbumID    int64
+	ID        int64
+	Title     string
+	Thumbnail string
+	// ThumbnailColor is the dominant color of the thumbnail, empty for images stored before the variants
+	ThumbnailColor string
+	Duration       int64
+	AlbumID        int64
+	// BPM is 0 until the track is analyzed
+	BPM        float64
 	IsFavorite bool
 }
 
@@ -28,7 +34,12 @@ type TrackStream struct {
 
 type TrackWithFileKey struct {
 	Track
-	FileKey string
+	FileKey      string
+	SourceFormat string
+	LoudnessLUFS float64
+	ReplayGainDB float64
+	Key          string
+	HasLyrics    bool
 }
 
 type Pagination struct {
@@ -53,6 +64,164 @@ type FavoriteRequest struct {
 
 type TrackLoad struct {
 	Title    string
-	File     []byte
+	FileKey  string
+	Position int64
+}
+
+type TrackUpdate struct {
+	ID       int64
+	Title    string
+	Position int64
+}
+
+type AlbumTracksUpdate struct {
+	AlbumID int64
+	Tracks  []*TrackUpdate
+}
+
+// TrackEdit keeps the current position when Position is 0
+type TrackEdit struct {
+	ID       int64
+	AlbumID  int64
+	Title    string
+	Position int64
+}
+
+type TrackAudioReplace struct {
+	ID           int64
+	AlbumID      int64
+	FileKey      string
+	SourceFormat string
+	Duration     int64
+}
+
+type TrackRendition struct {
+	Codec   string
+	Bitrate int64
+	FileKey string
+}
+
+// TrackSegment is one HLS media segment of a variant of the track
+type TrackSegment struct {
+	Codec    string
+	Bitrate  int64
+	Position int64
+	Duration float64
+	FileKey  string
+}
+
+// HLSSegment is a segment written by the transcoder that is not uploaded yet
+type HLSSegment struct {
+	Position int64
+	Duration float64
+	Path     string
+}
+
+type StreamSegmentFetch struct {
+	StreamID int64
 	Position int64
+	Duration float64
+}
+
+// TranscodeJob is a track claimed by the transcoding worker
+type TranscodeJob struct {
+	TrackID      int64
+	FileKey      string
+	SourceFormat string
+	Attempts     int64
+}
+
+// TranscodeResult is only saved while the track still points to the transcoded file
+type TranscodeResult struct {
+	TrackID      int64
+	FileKey      string
+	Renditions   []*TrackRendition
+	Segments     []*TrackSegment
+	LoudnessLUFS float64
+	TruePeakDB   float64
+	ReplayGainDB float64
+	Analysis     *TrackAnalysis
+}
+
+type RenditionProfile struct {
+	Codec       string
+	Bitrate     int64
+	Format      string
+	Extension   string
+	ContentType string
+}
+
+// Loudness is measured as EBU R128 integrated loudness and true peak
+type Loudness struct {
+	IntegratedLUFS float64
+	TruePeakDB     float64
+}
+
+// TrackAnalysis is computed from the decoded audio, BPM is 0 and Key is empty
+// when the track has no steady beat or no tonal content
+type TrackAnalysis struct {
+	Waveform []float64
+	BPM      float64
+	Key      string
+}
+
+// TrackWaveform holds peak amplitudes from 0 to 1 spread evenly over the duration
+type TrackWaveform struct {
+	Duration int64
+	Peaks    []float64
+}
+
+// TrackLyrics of one language, LineTimes are milliseconds from the start of the track
+// and stay nil for plain lyrics
+type TrackLyrics struct {
+	TrackID     int64
+	AlbumID     int64
+	Language    string
+	Translation bool
+	Lines       []string
+	LineTimes   []int64
+}
+
+type TrackLyricsDelete struct {
+	TrackID  int64
+	AlbumID  int64
+	Language string
+}
+
+// TrackFingerprint is saved with the near duplicates found when the track was uploaded
+type TrackFingerprint struct {
+	TrackID     int64
+	Fingerprint []int32
+	Duplicates  []*DuplicateMatch
+}
+
+type DuplicateMatch struct {
+	TrackID    int64
+	Similarity float64
+}
+
+// FingerprintCandidate is a catalog track sharing sub-fingerprints with an upload
+type FingerprintCandidate struct {
+	TrackID     int64
+	Title       string
+	AlbumID     int64
+	AlbumTitle  string
+	LabelID     int64
+	Fingerprint []int32
+}
+
+type DuplicateFlagTrack struct {
+	ID      int64
+	Title   string
+	AlbumID int64
+	LabelID int64
+}
+
+type DuplicateFlag struct {
+	ID           int64
+	Track        DuplicateFlagTrack
+	MatchedTrack DuplicateFlagTrack
+	Similarity   float64
+	Status       string
+	CreatedAt    time.Time
 }
@@ -1,12 +1,16 @@
 package usecase
 
+import "time"
+
 type Track struct {
-	ID         int64
-	Title      string
-	Thumbnail  string
-	Duration   int64
-	AlbumID    int64
-	IsFavorite bool
+	ID             int64
+	Title          string
+	Thumbnail      string
+	ThumbnailColor string
+	Duration       int64
+	AlbumID        int64
+	BPM            float64
+	IsFavorite     bool
 }
 
 type TrackStreamCreateData struct {
@@ -28,9 +32,45 @@ type TrackStream struct {
 
 type TrackDetailed struct {
 	Track
+	FileUrl      string
+	Renditions   []*TrackRendition
+	LoudnessLUFS float64
+	ReplayGainDB float64
+	Key          string
+	HasLyrics    bool
+}
+
+// TrackWaveform holds the peaks of a track spread evenly over its duration
+type TrackWaveform struct {
+	Duration int64
+	Peaks    []float64
+}
+
+type TrackRendition struct {
+	Codec   string
+	Bitrate int64
 	FileUrl string
 }
 
+// StreamVariant is one bitrate of the HLS manifest of a stream
+type StreamVariant struct {
+	Codec    string
+	Bitrate  int64
+	Segments []*StreamSegment
+}
+
+type StreamSegment struct {
+	Position int64
+	Duration float64
+}
+
+type StreamSegmentRequest struct {
+	StreamID int64
+	UserID   int64
+	Bitrate  int64
+	Position int64
+}
+
 type Pagination struct {
 	Limit  int64
 	Offset int64
@@ -54,12 +94,102 @@ type FavoriteRequest struct {
 
 type TrackLoad struct {
 	Title    string
-	File     []byte
+	FileKey  string
 	Position int64
 }
 
 type TracksListWithAlbumID struct {
-	Tracks  []*TrackLoad
+	Tracks   []*TrackLoad
+	AlbumID  int64
+	CoverKey string
+}
+
+type TrackUpdate struct {
+	ID       int64
+	Title    string
+	Position int64
+}
+
+type AlbumTracksUpdate struct {
+	AlbumID int64
+	Tracks  []*TrackUpdate
+}
+
+type TrackEdit struct {
+	ID       int64
+	AlbumID  int64
+	Title    string
+	Position int64
+}
+
+type TrackAudioReplace struct {
+	ID      int64
+	AlbumID int64
+	FileKey string
+}
+
+const (
+	DuplicateFlagStatusPending   = "pending"
+	DuplicateFlagStatusConfirmed = "confirmed"
+	DuplicateFlagStatusDismissed = "dismissed"
+)
+
+type DuplicateFlagTrack struct {
+	ID      int64
+	Title   string
 	AlbumID int64
-	Cover   []byte
+	LabelID int64
+}
+
+type DuplicateFlag struct {
+	ID           int64
+	Track        DuplicateFlagTrack
+	MatchedTrack DuplicateFlagTrack
+	Similarity   float64
+	Status       string
+	CreatedAt    time.Time
+}
+
+// TrackMetadata is read from the tags embedded in an uploaded file, the cover
+// is staged in the image bucket so that a release can use it
+type TrackMetadata struct {
+	Format      string
+	Title       string
+	TrackNumber int64
+	Artists     []string
+	Genre       string
+	Year        int64
+	ISRC        string
+	CoverKey    string
+	CoverURL    string
+}
+
+// TrackLyrics of one language, Synced lyrics have the time of every line
+type TrackLyrics struct {
+	Language    string
+	Translation bool
+	Synced      bool
+	Lines       []*LyricsLine
+}
+
+// LyricsLine starts Time milliseconds into the track, an empty line of synced
+// lyrics is an instrumental break
+type LyricsLine struct {
+	Time int64
+	Text string
+}
+
+// TrackLyricsUpload holds lyrics as the label typed them, either plain text or LRC
+type TrackLyricsUpload struct {
+	TrackID     int64
+	AlbumID     int64
+	Language    string
+	Translation bool
+	Text        string
+}
+
+type TrackLyricsDelete struct {
+	TrackID  int64
+	AlbumID  int64
+	Language string
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/postgres"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/init/s3"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
+	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/imagePipeline"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/interceptors"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/metrics"
 	"github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/user/internal/delivery"
@@ -93,7 +94,7 @@ func main() {
 	}
 
 	userRepository := repository.NewUserPostgresRepository(postgresPool, metrics)
-	userS3Repository := repository.NewS3Repository(s3, cfg.S3.S3ImagesBucket, metrics)
+	userS3Repository := repository.NewS3Repository(s3, cfg.S3.S3ImagesBucket, imagePipeline.New(cfg.Images, metrics), metrics)
 	userUsecase := usecase.NewUserUsecase(userRepository, userS3Repository)
 	userService := delivery.NewUserService(userUsecase)
 	userProto.RegisterUserServiceServer(server, userService)
@@ -46,7 +46,7 @@ func (s *UserService) GetUserByID(ctx context.Context, req *userProto.UserID) (*
 }
 
 func (s *UserService) UploadAvatar(ctx context.Context, req *userProto.AvatarData) (*userProto.Nothing, error) {
-	err := s.userUsecase.UploadAvatar(ctx, req.AvatarPath, req.Id)
+	err := s.userUsecase.UploadAvatar(ctx, req.AvatarPath, req.AvatarColor, req.Id)
 	if err != nil {
 		return nil, err
 	}
@@ -109,12 +109,12 @@ func (s *UserService) GetUserAvatarURL(ctx context.Context, req *userProto.FileK
 	return model.AvatarUrlFromUsecaseToProto(avatarURL), nil
 }
 
-func (s *UserService) UploadUserAvatar(ctx context.Context, req *userProto.AvatarImage) (*userProto.FileKey, error) {
-	fileKey, err := s.userUsecase.UploadUserAvatar(ctx, req.Username, req.Image)
+func (s *UserService) UploadUserAvatar(ctx context.Context, req *userProto.AvatarImage) (*userProto.UploadedAvatar, error) {
+	fileKey, avatarColor, err := s.userUsecase.UploadUserAvatar(ctx, req.Username, req.Image)
 	if err != nil {
 		return nil, err
 	}
-	return model.FileKeyFromUsecaseToProto(fileKey), nil
+	return model.UploadedAvatarFromUsecaseToProto(fileKey, avatarColor), nil
 }
 
 func (s *UserService) GetLabelIDByUserID(ctx context.Context, req *userProto.UserID) (*userProto.LabelID, error) {
@@ -156,3 +156,43 @@ func (s *UserService) RemoveUsersFromLabel(ctx context.Context, req *userProto.R
 	}
 	return &userProto.Nothing{Dummy: true}, nil
 }
+
+func (s *UserService) FollowUser(ctx context.Context, req *userProto.FollowRequest) (*userProto.Nothing, error) {
+	err := s.userUsecase.FollowUser(ctx, req.FollowerId, req.FolloweeId)
+	if err != nil {
+		return nil, err
+	}
+	return &userProto.Nothing{Dummy: true}, nil
+}
+
+func (s *UserService) UnfollowUser(ctx context.Context, req *userProto.FollowRequest) (*userProto.Nothing, error) {
+	err := s.userUsecase.UnfollowUser(ctx, req.FollowerId, req.FolloweeId)
+	if err != nil {
+		return nil, err
+	}
+	return &userProto.Nothing{Dummy: true}, nil
+}
+
+func (s *UserService) GetFollowers(ctx context.Context, req *userProto.UserIDWithPagination) (*userProto.UsersToFront, error) {
+	users, err := s.userUsecase.GetFollowers(ctx, req.UserId, model.PaginationFromProtoToUsecase(req.Pagination))
+	if err != nil {
+		return nil, err
+	}
+	return model.UsersToFrontFromUsecaseToProto(users), nil
+}
+
+func (s *UserService) GetFollowing(ctx context.Context, req *userProto.UserIDWithPagination) (*userProto.UsersToFront, error) {
+	users, err := s.userUsecase.GetFollowing(ctx, req.UserId, model.PaginationFromProtoToUsecase(req.Pagination))
+	if err != nil {
+		return nil, err
+	}
+	return model.UsersToFrontFromUsecaseToProto(users), nil
+}
+
+func (s *UserService) GetActivityFeed(ctx context.Context, req *userProto.UserIDWithPagination) (*userProto.ActivityList, error) {
+	activities, err := s.userUsecase.GetActivityFeed(ctx, req.UserId, model.PaginationFromProtoToUsecase(req.Pagination))
+	if err != nil {
+		return nil, err
+	}
+	return model.ActivityListFromUsecaseToProto(activities), nil
+}
@@ -10,7 +10,7 @@ type Repository interface {
 	CreateUser(ctx context.Context, regData *repoModel.RegisterData) (*repoModel.User, error)
 	LoginUser(ctx context.Context, logData *repoModel.LoginData) (*repoModel.User, error)
 	GetUserByID(ctx context.Context, ID int64) (*repoModel.User, error)
-	UploadAvatar(ctx context.Context, avatarUrl string, ID int64) error
+	UploadAvatar(ctx context.Context, avatarUrl string, avatarColor string, ID int64) error
 	GetIDByUsername(ctx context.Context, username string) (int64, error)
 	DeleteUser(ctx context.Context, userRepo *repoModel.UserDelete) error
 	ChangeUserData(ctx context.Context, username string, changeData *repoModel.ChangeUserData) error
@@ -25,9 +25,14 @@ type Repository interface {
 	RemoveUsersFromLabel(ctx context.Context, labelID int64, usernames []string) error
 	UpdateLabel(ctx context.Context, newName string, labelID int64) error
 	GetLabelById(ctx context.Context, labelID int64) (string, error)
+	FollowUser(ctx context.Context, followerID int64, followeeID int64) error
+	UnfollowUser(ctx context.Context, followerID int64, followeeID int64) error
+	GetFollowers(ctx context.Context, userID int64, pagination *repoModel.Pagination) ([]*repoModel.User, error)
+	GetFollowing(ctx context.Context, userID int64, pagination *repoModel.Pagination) ([]*repoModel.User, error)
+	GetActivityFeed(ctx context.Context, userID int64, pagination *repoModel.Pagination) ([]*repoModel.Activity, error)
 }
 
 type S3Repository interface {
 	GetAvatarURL(ctx context.Context, fileKey string) (string, error)
-	UploadUserAvatar(ctx context.Context, fileKey string, file []byte) (string, error)
+	UploadUserAvatar(ctx context.Context, fileKey string, file []byte) (string, string, error)
 }
@@ -9,7 +9,7 @@ import (
 type Usecase interface {
 	CreateUser(ctx context.Context, registerData *usecaseModel.RegisterData) (*usecaseModel.UserFront, error)
 	LoginUser(ctx context.Context, loginData *usecaseModel.LoginData) (*usecaseModel.UserFront, error)
-	UploadAvatar(ctx context.Context, avatarUrl string, id int64) error
+	UploadAvatar(ctx context.Context, avatarUrl string, avatarColor string, id int64) error
 	DeleteUser(ctx context.Context, deleteData *usecaseModel.UserDelete) error
 	ChangeUserData(ctx context.Context, username string, changeData *usecaseModel.ChangeUserData) error
 	ChangeUserPrivacySettings(ctx context.Context, username string, privacySettings *usecaseModel.PrivacySettings) error
@@ -18,10 +18,15 @@ type Usecase interface {
 	GetIDByUsername(ctx context.Context, username string) (int64, error)
 	GetUserPrivacySettings(ctx context.Context, id int64) (*usecaseModel.PrivacySettings, error)
 	GetAvatarURL(ctx context.Context, fileKey string) (string, error)
-	UploadUserAvatar(ctx context.Context, username string, file []byte) (string, error)
+	UploadUserAvatar(ctx context.Context, username string, file []byte) (string, string, error)
 	GetLabelIDByUserID(ctx context.Context, userID int64) (int64, error)
 	UpdateUsersLabelID(ctx context.Context, labelID int64, usernames []string) error
 	CheckUsersByUsernames(ctx context.Context, usernames []string) error
 	GetUsersByLabelID(ctx context.Context, labelID int64) ([]string, error)
 	RemoveUsersFromLabel(ctx context.Context, labelID int64, usernames []string) error
+	FollowUser(ctx context.Context, followerID int64, followeeID int64) error
+	UnfollowUser(ctx context.Context, followerID int64, followeeID int64) error
+	GetFollowers(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.UserFront, error)
+	GetFollowing(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.UserFront, error)
+	GetActivityFeed(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.Activity, error)
 }
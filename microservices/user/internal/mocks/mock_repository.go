@@ -127,6 +127,65 @@ func (mr *MockRepositoryMockRecorder) DeleteUser(ctx, userRepo any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockRepository)(nil).DeleteUser), ctx, userRepo)
 }
 
+// FollowUser mocks base method.
+func (m *MockRepository) FollowUser(ctx context.Context, followerID, followeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowUser", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockRepositoryMockRecorder) FollowUser(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockRepository)(nil).FollowUser), ctx, followerID, followeeID)
+}
+
+// GetActivityFeed mocks base method.
+func (m *MockRepository) GetActivityFeed(ctx context.Context, userID int64, pagination *repository.Pagination) ([]*repository.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*repository.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed.
+func (mr *MockRepositoryMockRecorder) GetActivityFeed(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockRepository)(nil).GetActivityFeed), ctx, userID, pagination)
+}
+
+// GetFollowers mocks base method.
+func (m *MockRepository) GetFollowers(ctx context.Context, userID int64, pagination *repository.Pagination) ([]*repository.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*repository.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockRepositoryMockRecorder) GetFollowers(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockRepository)(nil).GetFollowers), ctx, userID, pagination)
+}
+
+// GetFollowing mocks base method.
+func (m *MockRepository) GetFollowing(ctx context.Context, userID int64, pagination *repository.Pagination) ([]*repository.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*repository.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockRepositoryMockRecorder) GetFollowing(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockRepository)(nil).GetFollowing), ctx, userID, pagination)
+}
+
 // GetFullUserData mocks base method.
 func (m *MockRepository) GetFullUserData(ctx context.Context, username string) (*repository.UserFullData, error) {
 	m.ctrl.T.Helper()
@@ -261,6 +320,20 @@ func (mr *MockRepositoryMockRecorder) RemoveUsersFromLabel(ctx, labelID, usernam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsersFromLabel", reflect.TypeOf((*MockRepository)(nil).RemoveUsersFromLabel), ctx, labelID, usernames)
 }
 
+// UnfollowUser mocks base method.
+func (m *MockRepository) UnfollowUser(ctx context.Context, followerID, followeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowUser", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockRepositoryMockRecorder) UnfollowUser(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockRepository)(nil).UnfollowUser), ctx, followerID, followeeID)
+}
+
 // UpdateLabel mocks base method.
 func (m *MockRepository) UpdateLabel(ctx context.Context, newName string, labelID int64) error {
 	m.ctrl.T.Helper()
@@ -290,17 +363,17 @@ func (mr *MockRepositoryMockRecorder) UpdateUsersLabel(ctx, labelID, usernames a
 }
 
 // UploadAvatar mocks base method.
-func (m *MockRepository) UploadAvatar(ctx context.Context, avatarUrl string, ID int64) error {
+func (m *MockRepository) UploadAvatar(ctx context.Context, avatarUrl, avatarColor string, ID int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadAvatar", ctx, avatarUrl, ID)
+	ret := m.ctrl.Call(m, "UploadAvatar", ctx, avatarUrl, avatarColor, ID)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UploadAvatar indicates an expected call of UploadAvatar.
-func (mr *MockRepositoryMockRecorder) UploadAvatar(ctx, avatarUrl, ID any) *gomock.Call {
+func (mr *MockRepositoryMockRecorder) UploadAvatar(ctx, avatarUrl, avatarColor, ID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockRepository)(nil).UploadAvatar), ctx, avatarUrl, ID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockRepository)(nil).UploadAvatar), ctx, avatarUrl, avatarColor, ID)
 }
 
 // MockS3Repository is a mock of S3Repository interface.
@@ -343,12 +416,13 @@ func (mr *MockS3RepositoryMockRecorder) GetAvatarURL(ctx, fileKey any) *gomock.C
 }
 
 // UploadUserAvatar mocks base method.
-func (m *MockS3Repository) UploadUserAvatar(ctx context.Context, fileKey string, file []byte) (string, error) {
+func (m *MockS3Repository) UploadUserAvatar(ctx context.Context, fileKey string, file []byte) (string, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadUserAvatar", ctx, fileKey, file)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UploadUserAvatar indicates an expected call of UploadUserAvatar.
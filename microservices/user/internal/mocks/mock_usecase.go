@@ -112,6 +112,35 @@ func (mr *MockUsecaseMockRecorder) DeleteUser(ctx, deleteData any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUsecase)(nil).DeleteUser), ctx, deleteData)
 }
 
+// FollowUser mocks base method.
+func (m *MockUsecase) FollowUser(ctx context.Context, followerID, followeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowUser", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockUsecaseMockRecorder) FollowUser(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockUsecase)(nil).FollowUser), ctx, followerID, followeeID)
+}
+
+// GetActivityFeed mocks base method.
+func (m *MockUsecase) GetActivityFeed(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.Activity, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*usecase.Activity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed.
+func (mr *MockUsecaseMockRecorder) GetActivityFeed(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockUsecase)(nil).GetActivityFeed), ctx, userID, pagination)
+}
+
 // GetAvatarURL mocks base method.
 func (m *MockUsecase) GetAvatarURL(ctx context.Context, fileKey string) (string, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +156,36 @@ func (mr *MockUsecaseMockRecorder) GetAvatarURL(ctx, fileKey any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvatarURL", reflect.TypeOf((*MockUsecase)(nil).GetAvatarURL), ctx, fileKey)
 }
 
+// GetFollowers mocks base method.
+func (m *MockUsecase) GetFollowers(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.UserFront, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*usecase.UserFront)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockUsecaseMockRecorder) GetFollowers(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockUsecase)(nil).GetFollowers), ctx, userID, pagination)
+}
+
+// GetFollowing mocks base method.
+func (m *MockUsecase) GetFollowing(ctx context.Context, userID int64, pagination *usecase.Pagination) ([]*usecase.UserFront, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", ctx, userID, pagination)
+	ret0, _ := ret[0].([]*usecase.UserFront)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockUsecaseMockRecorder) GetFollowing(ctx, userID, pagination any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockUsecase)(nil).GetFollowing), ctx, userID, pagination)
+}
+
 // GetFullUserData mocks base method.
 func (m *MockUsecase) GetFullUserData(ctx context.Context, username string) (*usecase.UserFullData, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +305,20 @@ func (mr *MockUsecaseMockRecorder) RemoveUsersFromLabel(ctx, labelID, usernames
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsersFromLabel", reflect.TypeOf((*MockUsecase)(nil).RemoveUsersFromLabel), ctx, labelID, usernames)
 }
 
+// UnfollowUser mocks base method.
+func (m *MockUsecase) UnfollowUser(ctx context.Context, followerID, followeeID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowUser", ctx, followerID, followeeID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockUsecaseMockRecorder) UnfollowUser(ctx, followerID, followeeID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockUsecase)(nil).UnfollowUser), ctx, followerID, followeeID)
+}
+
 // UpdateUsersLabelID mocks base method.
 func (m *MockUsecase) UpdateUsersLabelID(ctx context.Context, labelID int64, usernames []string) error {
 	m.ctrl.T.Helper()
@@ -261,26 +334,27 @@ func (mr *MockUsecaseMockRecorder) UpdateUsersLabelID(ctx, labelID, usernames an
 }
 
 // UploadAvatar mocks base method.
-func (m *MockUsecase) UploadAvatar(ctx context.Context, avatarUrl string, id int64) error {
+func (m *MockUsecase) UploadAvatar(ctx context.Context, avatarUrl, avatarColor string, id int64) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UploadAvatar", ctx, avatarUrl, id)
+	ret := m.ctrl.Call(m, "UploadAvatar", ctx, avatarUrl, avatarColor, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UploadAvatar indicates an expected call of UploadAvatar.
-func (mr *MockUsecaseMockRecorder) UploadAvatar(ctx, avatarUrl, id any) *gomock.Call {
+func (mr *MockUsecaseMockRecorder) UploadAvatar(ctx, avatarUrl, avatarColor, id any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockUsecase)(nil).UploadAvatar), ctx, avatarUrl, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAvatar", reflect.TypeOf((*MockUsecase)(nil).UploadAvatar), ctx, avatarUrl, avatarColor, id)
 }
 
 // UploadUserAvatar mocks base method.
-func (m *MockUsecase) UploadUserAvatar(ctx context.Context, username string, file []byte) (string, error) {
+func (m *MockUsecase) UploadUserAvatar(ctx context.Context, username string, file []byte) (string, string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadUserAvatar", ctx, username, file)
 	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
 }
 
 // UploadUserAvatar indicates an expected call of UploadUserAvatar.
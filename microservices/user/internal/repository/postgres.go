@@ -49,19 +49,19 @@ const (
             ) VALUES ($1, false, false, false, false, false, false)
     `
 	loginUserQuery = `
-			SELECT id, username, email, password_hash, thumbnail_url, label_id
+			SELECT id, username, email, password_hash, thumbnail_url, thumbnail_color, label_id
 			FROM "user"
 			WHERE username = $1 OR email = $2
 	`
 	getUserByIDQuery = `
-			SELECT id, username, email, thumbnail_url
+			SELECT id, username, email, thumbnail_url, thumbnail_color
 			FROM "user"
 			WHERE id = $1
 	`
 	uploadAvatarQuery = `
 			UPDATE "user"
-			SET thumbnail_url = $1
-			WHERE id = $2	
+			SET thumbnail_url = $1, thumbnail_color = $2
+			WHERE id = $3
 	`
 	getIdByUsernameQuery = `
 			SELECT id
@@ -169,6 +169,80 @@ const (
 			FROM "user"
 			WHERE username = ANY($1)
 	`
+	FollowUserQuery = `
+			INSERT INTO user_follow (follower_id, followee_id)
+			VALUES ($1, $2)
+			ON CONFLICT DO NOTHING
+	`
+	UnfollowUserQuery = `
+			DELETE FROM user_follow
+			WHERE follower_id = $1 AND followee_id = $2
+	`
+	GetFollowersQuery = `
+			SELECT u.id, u.username, u.thumbnail_url, u.thumbnail_color
+			FROM user_follow f
+			JOIN "user" u ON u.id = f.follower_id
+			WHERE f.followee_id = $1
+			ORDER BY f.created_at DESC, u.id DESC
+			LIMIT $2 OFFSET $3
+	`
+	GetFollowingQuery = `
+			SELECT u.id, u.username, u.thumbnail_url, u.thumbnail_color
+			FROM user_follow f
+			JOIN "user" u ON u.id = f.followee_id
+			WHERE f.follower_id = $1
+			ORDER BY f.created_at DESC, u.id DESC
+			LIMIT $2 OFFSET $3
+	`
+	// Every kind of activity is only visible when the author made it public in user_settings.
+	// Album likes have no flag of their own and follow the favorite tracks one.
+	// Only the latest stream of a user counts, and only while it is recent enough to be "now playing".
+	// Tracks and albums that are not published (anymore) are left out.
+	GetActivityFeedQuery = `
+			WITH followee AS (
+				SELECT s.*
+				FROM user_follow f
+				JOIN user_settings s ON s.user_id = f.followee_id
+				WHERE f.follower_id = $1
+			),
+			activity AS (
+				SELECT 'liked_track' AS type, ft.user_id, t.id AS entity_id, t.title, t.thumbnail_url, t.thumbnail_color, ft.created_at
+				FROM favorite_track ft
+				JOIN followee fe ON fe.user_id = ft.user_id AND fe.is_public_favorite_tracks
+				JOIN track t ON t.id = ft.track_id
+				JOIN album ta ON ta.id = t.album_id AND ta.status = 'published'
+				UNION ALL
+				SELECT 'liked_album', fa.user_id, a.id, a.title, a.thumbnail_url, a.thumbnail_color, fa.created_at
+				FROM favorite_album fa
+				JOIN followee fe ON fe.user_id = fa.user_id AND fe.is_public_favorite_tracks
+				JOIN album a ON a.id = fa.album_id AND a.status = 'published'
+				UNION ALL
+				SELECT 'liked_artist', far.user_id, ar.id, ar.title, ar.thumbnail_url, ar.thumbnail_color, far.created_at
+				FROM favorite_artist far
+				JOIN followee fe ON fe.user_id = far.user_id AND fe.is_public_favorite_artists
+				JOIN artist ar ON ar.id = far.artist_id
+				UNION ALL
+				SELECT 'created_playlist', p.user_id, p.id, p.title, p.thumbnail_url, p.thumbnail_color, p.created_at
+				FROM playlist p
+				JOIN followee fe ON fe.user_id = p.user_id AND fe.is_public_playlists
+				WHERE p.is_public
+				UNION ALL
+				SELECT * FROM (
+					SELECT DISTINCT ON (ts.user_id) 'listening', ts.user_id, t.id, t.title, t.thumbnail_url, t.thumbnail_color, ts.created_at
+					FROM track_stream ts
+					JOIN followee fe ON fe.user_id = ts.user_id AND fe.is_public_tracks_listened
+					JOIN track t ON t.id = ts.track_id
+					JOIN album ta ON ta.id = t.album_id AND ta.status = 'published'
+					WHERE ts.created_at > NOW() - INTERVAL '30 minutes'
+					ORDER BY ts.user_id, ts.created_at DESC
+				) latest_stream
+			)
+			SELECT a.type, u.id, u.username, u.thumbnail_url, u.thumbnail_color, a.entity_id, a.title, a.thumbnail_url, a.thumbnail_color, a.created_at
+			FROM activity a
+			JOIN "user" u ON u.id = a.user_id
+			ORDER BY a.created_at DESC, a.type, a.entity_id DESC
+			LIMIT $2 OFFSET $3
+	`
 )
 
 type userPostgresRepository struct {
@@ -382,7 +456,7 @@ func (r *userPostgresRepository) LoginUser(ctx context.Context, logData *repoMod
 	row := stmt.QueryRowContext(ctx, lowerUsername, logData.Email)
 	var userRepo repoModel.User
 	var labelID sql.NullInt64
-	err = row.Scan(&userRepo.ID, &userRepo.Username, &userRepo.Email, &storedHash, &userRepo.Thumbnail, &labelID)
+	err = row.Scan(&userRepo.ID, &userRepo.Username, &userRepo.Email, &storedHash, &userRepo.Thumbnail, &userRepo.ThumbnailColor, &labelID)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("LoginUser").Inc()
 		if errors.Is(err, sql.ErrNoRows) {
@@ -427,7 +501,7 @@ func (r *userPostgresRepository) GetUserByID(ctx context.Context, ID int64) (*re
 
 	row := stmt.QueryRowContext(ctx, ID)
 	var userRepo repoModel.User
-	err = row.Scan(&userRepo.ID, &userRepo.Username, &userRepo.Email, &userRepo.Thumbnail)
+	err = row.Scan(&userRepo.ID, &userRepo.Username, &userRepo.Email, &userRepo.Thumbnail, &userRepo.ThumbnailColor)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("GetUserByID").Inc()
 		if errors.Is(err, sql.ErrNoRows) {
@@ -442,7 +516,7 @@ func (r *userPostgresRepository) GetUserByID(ctx context.Context, ID int64) (*re
 	return &userRepo, nil
 }
 
-func (r *userPostgresRepository) UploadAvatar(ctx context.Context, avatarUrl string, ID int64) error {
+func (r *userPostgresRepository) UploadAvatar(ctx context.Context, avatarUrl string, avatarColor string, ID int64) error {
 	start := time.Now()
 	logger := loggerPkg.LoggerFromContext(ctx)
 	logger.Info("Loading avatar by ID", zap.Int64("ID", ID))
@@ -459,7 +533,7 @@ func (r *userPostgresRepository) UploadAvatar(ctx context.Context, avatarUrl str
 		}
 	}()
 
-	_, err = stmt.ExecContext(ctx, avatarUrl, ID)
+	_, err = stmt.ExecContext(ctx, avatarUrl, avatarColor, ID)
 	if err != nil {
 		r.metrics.DatabaseErrors.WithLabelValues("UploadAvatar").Inc()
 		logger.Error("failed to upload avatar", zap.Error(err))
@@ -872,10 +946,11 @@ func (r *userPostgresRepository) GetFullUserData(ctx context.Context, username s
 	duration := time.Since(start).Seconds()
 	r.metrics.DatabaseDuration.WithLabelValues("getFullUserData").Observe(duration)
 	return &repoModel.UserFullData{
-		Username:  user.Username,
-		Email:     user.Email,
-		Thumbnail: user.Thumbnail,
-		Privacy:   privacy,
+		Username:       user.Username,
+		Email:          user.Email,
+		Thumbnail:      user.Thumbnail,
+		ThumbnailColor: user.ThumbnailColor,
+		Privacy:        privacy,
 	}, nil
 }
 
@@ -1192,3 +1267,184 @@ func (r *userPostgresRepository) RemoveUsersFromLabel(ctx context.Context, label
 	r.metrics.DatabaseDuration.WithLabelValues("RemoveUsersFromLabel").Observe(duration)
 	return nil
 }
+
+func (r *userPostgresRepository) FollowUser(ctx context.Context, followerID int64, followeeID int64) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Following user", zap.Int64("followerID", followerID), zap.Int64("followeeID", followeeID))
+
+	stmt, err := r.db.PrepareContext(ctx, FollowUserQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("FollowUser").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("Error closing statement:", zap.Error(err))
+		}
+	}()
+
+	_, err = stmt.ExecContext(ctx, followerID, followeeID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("FollowUser").Inc()
+		logger.Error("failed to follow user", zap.Error(err))
+		return err
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("FollowUser").Observe(duration)
+	return nil
+}
+
+func (r *userPostgresRepository) UnfollowUser(ctx context.Context, followerID int64, followeeID int64) error {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Unfollowing user", zap.Int64("followerID", followerID), zap.Int64("followeeID", followeeID))
+
+	stmt, err := r.db.PrepareContext(ctx, UnfollowUserQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("UnfollowUser").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("Error closing statement:", zap.Error(err))
+		}
+	}()
+
+	_, err = stmt.ExecContext(ctx, followerID, followeeID)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("UnfollowUser").Inc()
+		logger.Error("failed to unfollow user", zap.Error(err))
+		return err
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("UnfollowUser").Observe(duration)
+	return nil
+}
+
+func (r *userPostgresRepository) GetFollowers(ctx context.Context, userID int64, pagination *repoModel.Pagination) ([]*repoModel.User, error) {
+	return r.getFollowUsers(ctx, "GetFollowers", GetFollowersQuery, userID, pagination)
+}
+
+func (r *userPostgresRepository) GetFollowing(ctx context.Context, userID int64, pagination *repoModel.Pagination) ([]*repoModel.User, error) {
+	return r.getFollowUsers(ctx, "GetFollowing", GetFollowingQuery, userID, pagination)
+}
+
+func (r *userPostgresRepository) getFollowUsers(ctx context.Context, method string, query string, userID int64, pagination *repoModel.Pagination) ([]*repoModel.User, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting follow list", zap.String("method", method), zap.Int64("userID", userID))
+
+	stmt, err := r.db.PrepareContext(ctx, query)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(method).Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("Error closing statement:", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, userID, pagination.Limit, pagination.Offset)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(method).Inc()
+		logger.Error("failed to get follow list", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("Error closing rows:", zap.Error(err))
+		}
+	}()
+
+	users := make([]*repoModel.User, 0)
+	for rows.Next() {
+		var user repoModel.User
+		err = rows.Scan(&user.ID, &user.Username, &user.Thumbnail, &user.ThumbnailColor)
+		if err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues(method).Inc()
+			logger.Error("failed to scan row", zap.Error(err))
+			return nil, err
+		}
+		users = append(users, &user)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues(method).Inc()
+		logger.Error("failed to get follow list", zap.Error(err))
+		return nil, err
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues(method).Observe(duration)
+	return users, nil
+}
+
+func (r *userPostgresRepository) GetActivityFeed(ctx context.Context, userID int64, pagination *repoModel.Pagination) ([]*repoModel.Activity, error) {
+	start := time.Now()
+	logger := loggerPkg.LoggerFromContext(ctx)
+	logger.Info("Getting activity feed", zap.Int64("userID", userID))
+
+	stmt, err := r.db.PrepareContext(ctx, GetActivityFeedQuery)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetActivityFeed").Inc()
+		logger.Error("failed to prepare statement", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := stmt.Close(); err != nil {
+			logger.Error("Error closing statement:", zap.Error(err))
+		}
+	}()
+
+	rows, err := stmt.QueryContext(ctx, userID, pagination.Limit, pagination.Offset)
+	if err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetActivityFeed").Inc()
+		logger.Error("failed to get activity feed", zap.Error(err))
+		return nil, err
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			logger.Error("Error closing rows:", zap.Error(err))
+		}
+	}()
+
+	activities := make([]*repoModel.Activity, 0)
+	for rows.Next() {
+		activity := repoModel.Activity{User: &repoModel.User{}}
+		err = rows.Scan(
+			&activity.Type,
+			&activity.User.ID,
+			&activity.User.Username,
+			&activity.User.Thumbnail,
+			&activity.User.ThumbnailColor,
+			&activity.EntityID,
+			&activity.EntityTitle,
+			&activity.EntityThumbnail,
+			&activity.EntityThumbnailColor,
+			&activity.CreatedAt,
+		)
+		if err != nil {
+			r.metrics.DatabaseErrors.WithLabelValues("GetActivityFeed").Inc()
+			logger.Error("failed to scan row", zap.Error(err))
+			return nil, err
+		}
+		activities = append(activities, &activity)
+	}
+
+	if err := rows.Err(); err != nil {
+		r.metrics.DatabaseErrors.WithLabelValues("GetActivityFeed").Inc()
+		logger.Error("failed to get activity feed", zap.Error(err))
+		return nil, err
+	}
+
+	duration := time.Since(start).Seconds()
+	r.metrics.DatabaseDuration.WithLabelValues("GetActivityFeed").Observe(duration)
+	return activities, nil
+}
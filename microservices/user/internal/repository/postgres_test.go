@@ -6,6 +6,7 @@ import (
 	"encoding/base64"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/DATA-DOG/go-sqlmock"
 	loggerPkg "github.com/go-park-mail-ru/2025_1_Return_Zero/internal/pkg/helpers/logger"
@@ -174,11 +175,11 @@ func TestLoginUser(t *testing.T) {
 	combined := append(salt, hash...)
 	passwordHash := base64.StdEncoding.EncodeToString(combined)
 
-	rows := sqlmock.NewRows([]string{"id", "username", "email", "password_hash", "thumbnail_url", "label_id"}).
-		AddRow(testUserID, testUsername, testEmail, passwordHash, testAvatarURL, nil)
+	rows := sqlmock.NewRows([]string{"id", "username", "email", "password_hash", "thumbnail_url", "thumbnail_color", "label_id"}).
+		AddRow(testUserID, testUsername, testEmail, passwordHash, testAvatarURL, "", nil)
 
 	lowerUsername := strings.ToLower(loginData.Username)
-	mock.ExpectPrepare("SELECT id, username, email, password_hash, thumbnail_url, label_id").
+	mock.ExpectPrepare("SELECT id, username, email, password_hash, thumbnail_url, thumbnail_color, label_id").
 		ExpectQuery().WithArgs(lowerUsername, loginData.Email).
 		WillReturnRows(rows)
 
@@ -204,7 +205,7 @@ func TestLoginUserFailure(t *testing.T) {
 		Password: testPassword,
 	}
 
-	mock.ExpectPrepare("SELECT id, username, email, password_hash, thumbnail_url, label_id").
+	mock.ExpectPrepare("SELECT id, username, email, password_hash, thumbnail_url, thumbnail_color, label_id").
 		ExpectQuery().WithArgs(loginData.Username, loginData.Email).
 		WillReturnError(sql.ErrNoRows)
 
@@ -223,10 +224,10 @@ func TestGetUserByID(t *testing.T) {
 
 	userID := testUserID
 
-	rows := sqlmock.NewRows([]string{"id", "username", "email", "thumbnail_url"}).
-		AddRow(userID, testUsername, testEmail, testAvatarURL)
+	rows := sqlmock.NewRows([]string{"id", "username", "email", "thumbnail_url", "thumbnail_color"}).
+		AddRow(userID, testUsername, testEmail, testAvatarURL, "")
 
-	mock.ExpectPrepare("SELECT id, username, email, thumbnail_url").
+	mock.ExpectPrepare("SELECT id, username, email, thumbnail_url, thumbnail_color").
 		ExpectQuery().WithArgs(userID).
 		WillReturnRows(rows)
 
@@ -248,7 +249,7 @@ func TestGetUserByIDFailure(t *testing.T) {
 
 	userID := int64(999)
 
-	mock.ExpectPrepare("SELECT id, username, email, thumbnail_url").
+	mock.ExpectPrepare("SELECT id, username, email, thumbnail_url, thumbnail_color").
 		ExpectQuery().WithArgs(userID).
 		WillReturnError(sql.ErrNoRows)
 
@@ -362,10 +363,10 @@ func TestUploadAvatar(t *testing.T) {
 	userID := testUserID
 
 	mock.ExpectPrepare("UPDATE").
-		ExpectExec().WithArgs(avatarURL, userID).
+		ExpectExec().WithArgs(avatarURL, "#a1b2c3", userID).
 		WillReturnResult(sqlmock.NewResult(0, 1))
 
-	err := repo.UploadAvatar(ctx, avatarURL, userID)
+	err := repo.UploadAvatar(ctx, avatarURL, "#a1b2c3", userID)
 
 	assert.NoError(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -381,10 +382,10 @@ func TestUploadAvatarFailure(t *testing.T) {
 	userID := int64(999)
 
 	mock.ExpectPrepare("UPDATE").
-		ExpectExec().WithArgs(avatarURL, userID).
+		ExpectExec().WithArgs(avatarURL, "#a1b2c3", userID).
 		WillReturnError(sql.ErrNoRows)
 
-	err := repo.UploadAvatar(ctx, avatarURL, userID)
+	err := repo.UploadAvatar(ctx, avatarURL, "#a1b2c3", userID)
 
 	assert.Error(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
@@ -484,9 +485,9 @@ func TestGetFullUserData(t *testing.T) {
 		ExpectQuery().WithArgs(userID).
 		WillReturnRows(privacyRows)
 
-	userRows := sqlmock.NewRows([]string{"id", "username", "email", "thumbnail_url"}).
-		AddRow(userID, testUsername, testEmail, testAvatarURL)
-	mock.ExpectPrepare("SELECT id, username, email, thumbnail_url").
+	userRows := sqlmock.NewRows([]string{"id", "username", "email", "thumbnail_url", "thumbnail_color"}).
+		AddRow(userID, testUsername, testEmail, testAvatarURL, "")
+	mock.ExpectPrepare("SELECT id, username, email, thumbnail_url, thumbnail_color").
 		ExpectQuery().WithArgs(userID).
 		WillReturnRows(userRows)
 
@@ -1270,4 +1271,175 @@ func TestRemoveFromLabelFailure(t *testing.T) {
 
 	assert.Error(t, err)
 	assert.NoError(t, mock.ExpectationsWereMet())
-}
\ No newline at end of file
+}
+
+func TestFollowUser(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("INSERT INTO user_follow").
+		ExpectExec().
+		WithArgs(testUserID, int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	err := repo.FollowUser(ctx, testUserID, 2)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestFollowUserFailure(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("INSERT INTO user_follow").
+		ExpectExec().
+		WithArgs(testUserID, int64(2)).
+		WillReturnError(sql.ErrConnDone)
+
+	err := repo.FollowUser(ctx, testUserID, 2)
+
+	assert.Error(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestUnfollowUser(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+
+	mock.ExpectPrepare("DELETE FROM user_follow").
+		ExpectExec().
+		WithArgs(testUserID, int64(2)).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err := repo.UnfollowUser(ctx, testUserID, 2)
+
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetFollowers(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+	pagination := &repoModel.Pagination{Offset: 0, Limit: 10}
+
+	rows := sqlmock.NewRows([]string{"id", "username", "thumbnail_url", "thumbnail_color"}).
+		AddRow(2, "follower1", "/avatar1.png", "").
+		AddRow(3, "follower2", "/avatar2.png", "")
+
+	mock.ExpectPrepare("SELECT u.id, u.username, u.thumbnail_url, u.thumbnail_color FROM user_follow f JOIN \"user\" u ON u.id = f.follower_id").
+		ExpectQuery().
+		WithArgs(testUserID, int64(10), int64(0)).
+		WillReturnRows(rows)
+
+	users, err := repo.GetFollowers(ctx, testUserID, pagination)
+
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, int64(2), users[0].ID)
+	assert.Equal(t, "follower1", users[0].Username)
+	assert.Equal(t, "/avatar1.png", users[0].Thumbnail)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetFollowing(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+	pagination := &repoModel.Pagination{Offset: 5, Limit: 10}
+
+	rows := sqlmock.NewRows([]string{"id", "username", "thumbnail_url", "thumbnail_color"}).
+		AddRow(4, "followee", "/avatar.png", "")
+
+	mock.ExpectPrepare("SELECT u.id, u.username, u.thumbnail_url, u.thumbnail_color FROM user_follow f JOIN \"user\" u ON u.id = f.followee_id").
+		ExpectQuery().
+		WithArgs(testUserID, int64(10), int64(5)).
+		WillReturnRows(rows)
+
+	users, err := repo.GetFollowing(ctx, testUserID, pagination)
+
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, "followee", users[0].Username)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetFollowingFailure(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+	pagination := &repoModel.Pagination{Offset: 0, Limit: 10}
+
+	mock.ExpectPrepare("SELECT u.id, u.username, u.thumbnail_url, u.thumbnail_color FROM user_follow f").
+		ExpectQuery().
+		WithArgs(testUserID, int64(10), int64(0)).
+		WillReturnError(sql.ErrConnDone)
+
+	users, err := repo.GetFollowing(ctx, testUserID, pagination)
+
+	assert.Error(t, err)
+	assert.Nil(t, users)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetActivityFeed(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+	pagination := &repoModel.Pagination{Offset: 0, Limit: 20}
+	now := time.Now()
+
+	rows := sqlmock.NewRows([]string{"type", "id", "username", "thumbnail_url", "thumbnail_color", "entity_id", "title", "thumbnail_url", "thumbnail_color", "created_at"}).
+		AddRow("listening", 2, "friend", "/avatar.png", "", 10, "Song", "track.png", "#a1b2c3", now).
+		AddRow("liked_album", 3, "other", "/other.png", "", 20, "Album", "album.png", "", now.Add(-time.Hour))
+
+	mock.ExpectPrepare("WITH followee AS").
+		ExpectQuery().
+		WithArgs(testUserID, int64(20), int64(0)).
+		WillReturnRows(rows)
+
+	activities, err := repo.GetActivityFeed(ctx, testUserID, pagination)
+
+	require.NoError(t, err)
+	require.Len(t, activities, 2)
+	assert.Equal(t, "listening", activities[0].Type)
+	assert.Equal(t, int64(2), activities[0].User.ID)
+	assert.Equal(t, "friend", activities[0].User.Username)
+	assert.Equal(t, int64(10), activities[0].EntityID)
+	assert.Equal(t, "Song", activities[0].EntityTitle)
+	assert.Equal(t, "track.png", activities[0].EntityThumbnail)
+	assert.Equal(t, "#a1b2c3", activities[0].EntityThumbnailColor)
+	assert.Equal(t, now, activities[0].CreatedAt)
+	assert.Equal(t, "liked_album", activities[1].Type)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestGetActivityFeedFailure(t *testing.T) {
+	db, mock, ctx := setupTest(t)
+	defer db.Close()
+
+	repo := NewUserPostgresRepository(db, metrics.NewMockMetrics())
+	pagination := &repoModel.Pagination{Offset: 0, Limit: 20}
+
+	mock.ExpectPrepare("WITH followee AS").
+		ExpectQuery().
+		WithArgs(testUserID, int64(20), int64(0)).
+		WillReturnError(sql.ErrConnDone)
+
+	activities, err := repo.GetActivityFeed(ctx, testUserID, pagination)
+
+	assert.Error(t, err)
+	assert.Nil(t, activities)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -5,6 +5,7 @@ import (
 
 	domain "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/user/internal/domain"
 	model "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/user/model"
+	userErrors "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/user/model/errors"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/user/model/usecase"
 )
 
@@ -38,8 +39,8 @@ func (u *userUsecase) LoginUser(ctx context.Context, loginData *usecaseModel.Log
 	return model.UserFromRepositoryToUsecase(userRepoData), nil
 }
 
-func (u *userUsecase) UploadAvatar(ctx context.Context, avatarUrl string, id int64) error {
-	return u.userRepo.UploadAvatar(ctx, avatarUrl, id)
+func (u *userUsecase) UploadAvatar(ctx context.Context, avatarUrl string, avatarColor string, id int64) error {
+	return u.userRepo.UploadAvatar(ctx, avatarUrl, avatarColor, id)
 }
 
 func (u *userUsecase) DeleteUser(ctx context.Context, deleteData *usecaseModel.UserDelete) error {
@@ -97,12 +98,12 @@ func (u *userUsecase) GetAvatarURL(ctx context.Context, fileKey string) (string,
 	return avatarURL, nil
 }
 
-func (u *userUsecase) UploadUserAvatar(ctx context.Context, username string, file []byte) (string, error) {
-	avatarURL, err := u.s3Repo.UploadUserAvatar(ctx, username, file)
+func (u *userUsecase) UploadUserAvatar(ctx context.Context, username string, file []byte) (string, string, error) {
+	avatarURL, avatarColor, err := u.s3Repo.UploadUserAvatar(ctx, username, file)
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
-	return avatarURL, nil
+	return avatarURL, avatarColor, nil
 }
 
 func (u *userUsecase) GetLabelIDByUserID(ctx context.Context, userID int64) (int64, error) {
@@ -144,3 +145,58 @@ func (u *userUsecase) RemoveUsersFromLabel(ctx context.Context, labelID int64, u
 	}
 	return nil
 }
+
+func (u *userUsecase) FollowUser(ctx context.Context, followerID int64, followeeID int64) error {
+	if followerID == followeeID {
+		return userErrors.ErrFollowYourself
+	}
+	return u.userRepo.FollowUser(ctx, followerID, followeeID)
+}
+
+func (u *userUsecase) UnfollowUser(ctx context.Context, followerID int64, followeeID int64) error {
+	return u.userRepo.UnfollowUser(ctx, followerID, followeeID)
+}
+
+func (u *userUsecase) GetFollowers(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.UserFront, error) {
+	users, err := u.userRepo.GetFollowers(ctx, userID, model.PaginationFromUsecaseToRepository(pagination))
+	if err != nil {
+		return nil, err
+	}
+	return u.withAvatarURLs(ctx, model.UsersToFrontFromRepositoryToUsecase(users))
+}
+
+func (u *userUsecase) GetFollowing(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.UserFront, error) {
+	users, err := u.userRepo.GetFollowing(ctx, userID, model.PaginationFromUsecaseToRepository(pagination))
+	if err != nil {
+		return nil, err
+	}
+	return u.withAvatarURLs(ctx, model.UsersToFrontFromRepositoryToUsecase(users))
+}
+
+func (u *userUsecase) GetActivityFeed(ctx context.Context, userID int64, pagination *usecaseModel.Pagination) ([]*usecaseModel.Activity, error) {
+	repoActivities, err := u.userRepo.GetActivityFeed(ctx, userID, model.PaginationFromUsecaseToRepository(pagination))
+	if err != nil {
+		return nil, err
+	}
+
+	activities := model.ActivityListFromRepositoryToUsecase(repoActivities)
+	for _, activity := range activities {
+		activity.User.Thumbnail, err = u.s3Repo.GetAvatarURL(ctx, activity.User.Thumbnail)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return activities, nil
+}
+
+// withAvatarURLs turns the stored avatar keys into links, the way GetUserAvatarURL does for a single user
+func (u *userUsecase) withAvatarURLs(ctx context.Context, users []*usecaseModel.UserFront) ([]*usecaseModel.UserFront, error) {
+	for _, user := range users {
+		avatarURL, err := u.s3Repo.GetAvatarURL(ctx, user.Thumbnail)
+		if err != nil {
+			return nil, err
+		}
+		user.Thumbnail = avatarURL
+	}
+	return users, nil
+}
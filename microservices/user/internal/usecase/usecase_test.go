@@ -301,9 +301,9 @@ func TestUploadAvatar(t *testing.T) {
 	avatarURL := mockAvatarURL
 	userID := int64(mockUserID)
 
-	mockRepo.EXPECT().UploadAvatar(ctx, avatarURL, userID).Return(nil)
+	mockRepo.EXPECT().UploadAvatar(ctx, avatarURL, "#a1b2c3", userID).Return(nil)
 
-	err := usecase.UploadAvatar(ctx, avatarURL, userID)
+	err := usecase.UploadAvatar(ctx, avatarURL, "#a1b2c3", userID)
 
 	require.NoError(t, err)
 }
@@ -350,11 +350,13 @@ func TestUploadUserAvatar(t *testing.T) {
 	fileKey := "test.jpg"
 	file := []byte("test file content")
 
-	mockS3Repo.EXPECT().UploadUserAvatar(ctx, fileKey, file).Return("test.jpg", nil)
+	mockS3Repo.EXPECT().UploadUserAvatar(ctx, fileKey, file).Return("test.jpg", "#a1b2c3", nil)
 
-	_, err := usecase.UploadUserAvatar(ctx, fileKey, file)
+	avatarKey, avatarColor, err := usecase.UploadUserAvatar(ctx, fileKey, file)
 
 	require.NoError(t, err)
+	assert.Equal(t, "test.jpg", avatarKey)
+	assert.Equal(t, "#a1b2c3", avatarColor)
 }
 
 func TestUploadUserAvatarError(t *testing.T) {
@@ -366,9 +368,9 @@ func TestUploadUserAvatarError(t *testing.T) {
 	fileKey := "test.jpg"
 	file := []byte("test file content")
 
-	mockS3Repo.EXPECT().UploadUserAvatar(ctx, fileKey, file).Return("", errors.New("upload failed"))
+	mockS3Repo.EXPECT().UploadUserAvatar(ctx, fileKey, file).Return("", "", errors.New("upload failed"))
 
-	_, err := usecase.UploadUserAvatar(ctx, fileKey, file)
+	_, _, err := usecase.UploadUserAvatar(ctx, fileKey, file)
 
 	require.Error(t, err)
 }
@@ -532,3 +534,105 @@ func TestRemoveUsersFromLabelError(t *testing.T) {
 
 	require.Error(t, err)
 }
+
+func TestFollowUser(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+
+	usecase := NewUserUsecase(mockRepo, mockS3Repo)
+
+	mockRepo.EXPECT().FollowUser(ctx, int64(mockUserID), int64(2)).Return(nil)
+
+	err := usecase.FollowUser(ctx, mockUserID, 2)
+
+	require.NoError(t, err)
+}
+
+func TestFollowUserYourself(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+
+	usecase := NewUserUsecase(mockRepo, mockS3Repo)
+
+	err := usecase.FollowUser(ctx, mockUserID, mockUserID)
+
+	require.Error(t, err)
+	assert.ErrorIs(t, err, userErrors.ErrFollowYourself)
+}
+
+func TestUnfollowUser(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+
+	usecase := NewUserUsecase(mockRepo, mockS3Repo)
+
+	mockRepo.EXPECT().UnfollowUser(ctx, int64(mockUserID), int64(2)).Return(nil)
+
+	err := usecase.UnfollowUser(ctx, mockUserID, 2)
+
+	require.NoError(t, err)
+}
+
+func TestGetFollowers(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+
+	usecase := NewUserUsecase(mockRepo, mockS3Repo)
+
+	repoUsers := []*repoModel.User{
+		{ID: 2, Username: mockExistingUsername, Thumbnail: mockAvatarURL},
+	}
+
+	mockRepo.EXPECT().GetFollowers(ctx, int64(mockUserID), &repoModel.Pagination{Offset: 0, Limit: 10}).Return(repoUsers, nil)
+	mockS3Repo.EXPECT().GetAvatarURL(ctx, mockAvatarURL).Return("https://example.com/"+mockAvatarURL, nil)
+
+	users, err := usecase.GetFollowers(ctx, mockUserID, &usecaseModel.Pagination{Offset: 0, Limit: 10})
+
+	require.NoError(t, err)
+	require.Len(t, users, 1)
+	assert.Equal(t, mockExistingUsername, users[0].Username)
+	assert.Equal(t, "https://example.com/"+mockAvatarURL, users[0].Thumbnail)
+}
+
+func TestGetFollowingError(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+
+	usecase := NewUserUsecase(mockRepo, mockS3Repo)
+
+	mockRepo.EXPECT().GetFollowing(ctx, int64(mockUserID), &repoModel.Pagination{Offset: 0, Limit: 10}).Return(nil, errors.New("some error"))
+
+	users, err := usecase.GetFollowing(ctx, mockUserID, &usecaseModel.Pagination{Offset: 0, Limit: 10})
+
+	require.Error(t, err)
+	assert.Nil(t, users)
+}
+
+func TestGetActivityFeed(t *testing.T) {
+	mockRepo, ctx := setupTest(t)
+	mockS3Repo := mock_domain.NewMockS3Repository(gomock.NewController(t))
+
+	usecase := NewUserUsecase(mockRepo, mockS3Repo)
+
+	repoActivities := []*repoModel.Activity{
+		{
+			Type:            "liked_album",
+			User:            &repoModel.User{ID: 2, Username: mockExistingUsername, Thumbnail: mockAvatarURL},
+			EntityID:        10,
+			EntityTitle:     "Album",
+			EntityThumbnail: "album.png",
+		},
+	}
+
+	mockRepo.EXPECT().GetActivityFeed(ctx, int64(mockUserID), &repoModel.Pagination{Offset: 0, Limit: 20}).Return(repoActivities, nil)
+	mockS3Repo.EXPECT().GetAvatarURL(ctx, mockAvatarURL).Return("https://example.com/"+mockAvatarURL, nil)
+
+	activities, err := usecase.GetActivityFeed(ctx, mockUserID, &usecaseModel.Pagination{Offset: 0, Limit: 20})
+
+	require.NoError(t, err)
+	require.Len(t, activities, 1)
+	assert.Equal(t, "liked_album", activities[0].Type)
+	assert.Equal(t, mockExistingUsername, activities[0].User.Username)
+	assert.Equal(t, "https://example.com/"+mockAvatarURL, activities[0].User.Thumbnail)
+	assert.Equal(t, "Album", activities[0].EntityTitle)
+}
@@ -4,6 +4,7 @@ import (
 	protoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/gen/user"
 	repoModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/user/model/repository"
 	usecaseModel "github.com/go-park-mail-ru/2025_1_Return_Zero/microservices/user/model/usecase"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func RegisterDataFromUsecaseToRepository(data *usecaseModel.RegisterData) *repoModel.RegisterData {
@@ -16,11 +17,12 @@ func RegisterDataFromUsecaseToRepository(data *usecaseModel.RegisterData) *repoM
 
 func UserFromRepositoryToUsecase(data *repoModel.User) *usecaseModel.UserFront {
 	return &usecaseModel.UserFront{
-		Username:  data.Username,
-		Email:     data.Email,
-		Thumbnail: data.Thumbnail,
-		Id:        data.ID,
-		LabelId:   data.LabelId,
+		Username:       data.Username,
+		Email:          data.Email,
+		Thumbnail:      data.Thumbnail,
+		ThumbnailColor: data.ThumbnailColor,
+		Id:             data.ID,
+		LabelId:        data.LabelId,
 	}
 }
 
@@ -74,10 +76,11 @@ func PrivacyFromRepositoryToUsecase(data *repoModel.PrivacySettings) *usecaseMod
 func UserFullDataFromRepositoryToUsecase(data *repoModel.UserFullData) *usecaseModel.UserFullData {
 	privacyUsecase := PrivacyFromRepositoryToUsecase(data.Privacy)
 	return &usecaseModel.UserFullData{
-		Username:  data.Username,
-		Thumbnail: data.Thumbnail,
-		Email:     data.Email,
-		Privacy:   privacyUsecase,
+		Username:       data.Username,
+		Thumbnail:      data.Thumbnail,
+		ThumbnailColor: data.ThumbnailColor,
+		Email:          data.Email,
+		Privacy:        privacyUsecase,
 	}
 }
 
@@ -91,11 +94,12 @@ func RegisterDataFromProtoToUsecase(data *protoModel.RegisterData) *usecaseModel
 
 func UserFrontFromUsecaseToProto(data *usecaseModel.UserFront) *protoModel.UserFront {
 	return &protoModel.UserFront{
-		Username: data.Username,
-		Email:    data.Email,
-		Avatar:   data.Thumbnail,
-		Id:       data.Id,
-		LabelId:  data.LabelId,
+		Username:    data.Username,
+		Email:       data.Email,
+		Avatar:      data.Thumbnail,
+		AvatarColor: data.ThumbnailColor,
+		Id:          data.Id,
+		LabelId:     data.LabelId,
 	}
 }
 
@@ -149,10 +153,11 @@ func PrivacySettingsFromUsecaseToProto(data *usecaseModel.PrivacySettings) *prot
 func UserFullDataFromUsecaseToProto(data *usecaseModel.UserFullData) *protoModel.UserFullData {
 	privacyProto := PrivacySettingsFromUsecaseToProto(data.Privacy)
 	return &protoModel.UserFullData{
-		Username: data.Username,
-		Avatar:   data.Thumbnail,
-		Email:    data.Email,
-		Privacy:  privacyProto,
+		Username:    data.Username,
+		Avatar:      data.Thumbnail,
+		AvatarColor: data.ThumbnailColor,
+		Email:       data.Email,
+		Privacy:     privacyProto,
 	}
 }
 
@@ -168,11 +173,11 @@ func AvatarUrlFromUsecaseToProto(url string) *protoModel.AvatarUrl {
 	}
 }
 
-func FileKeyFromUsecaseToProto(fileKey string) *protoModel.FileKey {
-	return &protoModel.FileKey{
-		FileKey: fileKey,
+func UploadedAvatarFromUsecaseToProto(fileKey string, avatarColor string) *protoModel.UploadedAvatar {
+	return &protoModel.UploadedAvatar{
+		FileKey:     fileKey,
+		AvatarColor: avatarColor,
 	}
-
 }
 
 func LabelIDFromUsecaseToProto(id int64) *protoModel.LabelID {
@@ -191,10 +196,11 @@ func LabelFromUsecaseToProto(label *usecaseModel.Label) *protoModel.Label {
 
 func UserToFrontFromRepositoryToUsecase(user *repoModel.User) *usecaseModel.UserFront {
 	return &usecaseModel.UserFront{
-		Username:  user.Username,
-		Email:     user.Email,
-		Thumbnail: user.Thumbnail,
-		Id:        user.ID,
+		Username:       user.Username,
+		Email:          user.Email,
+		Thumbnail:      user.Thumbnail,
+		ThumbnailColor: user.ThumbnailColor,
+		Id:             user.ID,
 	}
 }
 
@@ -208,9 +214,72 @@ func UsersToFrontFromRepositoryToUsecase(users []*repoModel.User) []*usecaseMode
 
 func UserToFrontFromUsecaseToProto(user *usecaseModel.UserFront) *protoModel.UserFront {
 	return &protoModel.UserFront{
-		Username: user.Username,
-		Email:    user.Email,
-		Avatar:   user.Thumbnail,
-		Id:       user.Id,
+		Username:    user.Username,
+		Email:       user.Email,
+		Avatar:      user.Thumbnail,
+		AvatarColor: user.ThumbnailColor,
+		Id:          user.Id,
+	}
+}
+
+func UsersToFrontFromUsecaseToProto(users []*usecaseModel.UserFront) *protoModel.UsersToFront {
+	protoUsers := make([]*protoModel.UserFront, 0, len(users))
+	for _, user := range users {
+		protoUsers = append(protoUsers, UserToFrontFromUsecaseToProto(user))
+	}
+	return &protoModel.UsersToFront{Users: protoUsers}
+}
+
+func PaginationFromProtoToUsecase(pagination *protoModel.Pagination) *usecaseModel.Pagination {
+	return &usecaseModel.Pagination{
+		Offset: pagination.GetOffset(),
+		Limit:  pagination.GetLimit(),
+	}
+}
+
+func PaginationFromUsecaseToRepository(pagination *usecaseModel.Pagination) *repoModel.Pagination {
+	return &repoModel.Pagination{
+		Offset: pagination.Offset,
+		Limit:  pagination.Limit,
+	}
+}
+
+func ActivityFromRepositoryToUsecase(activity *repoModel.Activity) *usecaseModel.Activity {
+	return &usecaseModel.Activity{
+		Type:                 activity.Type,
+		User:                 UserToFrontFromRepositoryToUsecase(activity.User),
+		EntityID:             activity.EntityID,
+		EntityTitle:          activity.EntityTitle,
+		EntityThumbnail:      activity.EntityThumbnail,
+		EntityThumbnailColor: activity.EntityThumbnailColor,
+		CreatedAt:            activity.CreatedAt,
+	}
+}
+
+func ActivityListFromRepositoryToUsecase(activities []*repoModel.Activity) []*usecaseModel.Activity {
+	usecaseActivities := make([]*usecaseModel.Activity, 0, len(activities))
+	for _, activity := range activities {
+		usecaseActivities = append(usecaseActivities, ActivityFromRepositoryToUsecase(activity))
+	}
+	return usecaseActivities
+}
+
+func ActivityFromUsecaseToProto(activity *usecaseModel.Activity) *protoModel.Activity {
+	return &protoModel.Activity{
+		Type:                 activity.Type,
+		User:                 UserToFrontFromUsecaseToProto(activity.User),
+		EntityId:             activity.EntityID,
+		EntityTitle:          activity.EntityTitle,
+		EntityThumbnail:      activity.EntityThumbnail,
+		EntityThumbnailColor: activity.EntityThumbnailColor,
+		CreatedAt:            timestamppb.New(activity.CreatedAt),
+	}
+}
+
+func ActivityListFromUsecaseToProto(activities []*usecaseModel.Activity) *protoModel.ActivityList {
+	protoActivities := make([]*protoModel.Activity, 0, len(activities))
+	for _, activity := range activities {
+		protoActivities = append(protoActivities, ActivityFromUsecaseToProto(activity))
 	}
+	return &protoModel.ActivityList{Activities: protoActivities}
 }
@@ -280,12 +280,13 @@ func TestAvatarUrlFromUsecaseToProto(t *testing.T) {
 	assert.Equal(t, protoData.Url, usecaseData)
 }
 
-func TestFileKeyFromUsecaseToProto(t *testing.T) {
+func TestUploadedAvatarFromUsecaseToProto(t *testing.T) {
 	usecaseData := "test.jpg"
 
-	protoData := FileKeyFromUsecaseToProto(usecaseData)
+	protoData := UploadedAvatarFromUsecaseToProto(usecaseData, "#a1b2c3")
 
 	assert.Equal(t, protoData.FileKey, usecaseData)
+	assert.Equal(t, protoData.AvatarColor, "#a1b2c3")
 }
 
 func TestLabelIDFromUsecaseToProto(t *testing.T) {
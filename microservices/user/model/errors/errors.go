@@ -83,6 +83,13 @@ func NewLabelExistError(format string, args ...interface{}) error {
 	}
 }
 
+func NewFollowYourselfError(format string, args ...interface{}) error {
+	return &UserError{
+		Code:    codes.InvalidArgument,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
 var (
 	ErrUserNotFound           = NewNotFoundError("user not found")
 	ErrUserExist              = NewUserExistError("user already exist")
@@ -93,4 +100,5 @@ var (
 	ErrUnsupportedImageFormat = NewUnsupportedImageFormatError("unsupported image format")
 	ErrFailedToUploadAvatar   = NewFailedToUploadAvatarError("failed to upload avatar")
 	ErrLabelExist             = NewLabelExistError("label already exist")
+	ErrFollowYourself         = NewFollowYourselfError("you can't follow yourself")
 )
@@ -1,5 +1,7 @@
 package repository
 
+import "time"
+
 type RegisterData struct {
 	Username string `sql:"username"`
 	Email    string `sql:"email"`
@@ -11,7 +13,10 @@ type User struct {
 	Username  string `sql:"username"`
 	Email     string `sql:"email"`
 	Thumbnail string `sql:"thumbnail"`
-	LabelId   int64  `sql:"is_label"`
+	// ThumbnailColor is the dominant color of the avatar, empty for the default avatar and
+	// for images stored before the variants
+	ThumbnailColor string `sql:"thumbnail_color"`
+	LabelId        int64  `sql:"is_label"`
 }
 
 type LoginData struct {
@@ -44,10 +49,11 @@ type PrivacySettings struct {
 }
 
 type UserFullData struct {
-	Username  string `sql:"username"`
-	Thumbnail string `sql:"thumbnail_url"`
-	Email     string `sql:"email"`
-	Privacy   *PrivacySettings
+	Username       string `sql:"username"`
+	Thumbnail      string `sql:"thumbnail_url"`
+	ThumbnailColor string `sql:"thumbnail_color"`
+	Email          string `sql:"email"`
+	Privacy        *PrivacySettings
 }
 
 type Label struct {
@@ -55,3 +61,18 @@ type Label struct {
 	Name    string  `sql:"name"`
 	Members []int64 `sql:"members"`
 }
+
+type Pagination struct {
+	Offset int64 `sql:"offset"`
+	Limit  int64 `sql:"limit"`
+}
+
+type Activity struct {
+	Type                 string    `sql:"type"`
+	User                 *User     `sql:"user"`
+	EntityID             int64     `sql:"entity_id"`
+	EntityTitle          string    `sql:"entity_title"`
+	EntityThumbnail      string    `sql:"entity_thumbnail"`
+	EntityThumbnailColor string    `sql:"entity_thumbnail_color"`
+	CreatedAt            time.Time `sql:"created_at"`
+}
@@ -1,5 +1,7 @@
 package usecase
 
+import "time"
+
 type RegisterData struct {
 	Username string
 	Email    string
@@ -7,11 +9,12 @@ type RegisterData struct {
 }
 
 type UserFront struct {
-	Username  string
-	Email     string
-	Thumbnail string
-	Id        int64
-	LabelId   int64
+	Username       string
+	Email          string
+	Thumbnail      string
+	ThumbnailColor string
+	Id             int64
+	LabelId        int64
 }
 
 type LoginData struct {
@@ -43,10 +46,11 @@ type PrivacySettings struct {
 }
 
 type UserFullData struct {
-	Username  string
-	Thumbnail string
-	Email     string
-	Privacy   *PrivacySettings
+	Username       string
+	Thumbnail      string
+	ThumbnailColor string
+	Email          string
+	Privacy        *PrivacySettings
 }
 
 type CreateLabelRequest struct {
@@ -58,3 +62,18 @@ type Label struct {
 	Name    string
 	Members []string
 }
+
+type Pagination struct {
+	Offset int64
+	Limit  int64
+}
+
+type Activity struct {
+	Type                 string
+	User                 *UserFront
+	EntityID             int64
+	EntityTitle          string
+	EntityThumbnail      string
+	EntityThumbnailColor string
+	CreatedAt            time.Time
+}
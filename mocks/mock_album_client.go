@@ -43,6 +43,26 @@ func (m *MockAlbumServiceClient) EXPECT() *MockAlbumServiceClientMockRecorder {
 	return m.recorder
 }
 
+// ChangeAlbumStatus mocks base method.
+func (m *MockAlbumServiceClient) ChangeAlbumStatus(ctx context.Context, in *album.ChangeAlbumStatusRequest, opts ...grpc.CallOption) (*album.Album, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ChangeAlbumStatus", varargs...)
+	ret0, _ := ret[0].(*album.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeAlbumStatus indicates an expected call of ChangeAlbumStatus.
+func (mr *MockAlbumServiceClientMockRecorder) ChangeAlbumStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAlbumStatus", reflect.TypeOf((*MockAlbumServiceClient)(nil).ChangeAlbumStatus), varargs...)
+}
+
 // CreateAlbum mocks base method.
 func (m *MockAlbumServiceClient) CreateAlbum(ctx context.Context, in *album.CreateAlbumRequest, opts ...grpc.CallOption) (*album.AlbumIDAndURL, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +123,26 @@ func (mr *MockAlbumServiceClientMockRecorder) DeleteAlbum(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockAlbumServiceClient)(nil).DeleteAlbum), varargs...)
 }
 
+// EditAlbum mocks base method.
+func (m *MockAlbumServiceClient) EditAlbum(ctx context.Context, in *album.EditAlbumRequest, opts ...grpc.CallOption) (*album.Album, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EditAlbum", varargs...)
+	ret0, _ := ret[0].(*album.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditAlbum indicates an expected call of EditAlbum.
+func (mr *MockAlbumServiceClientMockRecorder) EditAlbum(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAlbum", reflect.TypeOf((*MockAlbumServiceClient)(nil).EditAlbum), varargs...)
+}
+
 // GetAlbumByID mocks base method.
 func (m *MockAlbumServiceClient) GetAlbumByID(ctx context.Context, in *album.AlbumIDWithUserID, opts ...grpc.CallOption) (*album.Album, error) {
 	m.ctrl.T.Helper()
@@ -183,6 +223,26 @@ func (mr *MockAlbumServiceClientMockRecorder) GetAlbumsByIDs(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByIDs", reflect.TypeOf((*MockAlbumServiceClient)(nil).GetAlbumsByIDs), varargs...)
 }
 
+// GetAlbumsByStatus mocks base method.
+func (m *MockAlbumServiceClient) GetAlbumsByStatus(ctx context.Context, in *album.FiltersWithStatus, opts ...grpc.CallOption) (*album.AlbumList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAlbumsByStatus", varargs...)
+	ret0, _ := ret[0].(*album.AlbumList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumsByStatus indicates an expected call of GetAlbumsByStatus.
+func (mr *MockAlbumServiceClientMockRecorder) GetAlbumsByStatus(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByStatus", reflect.TypeOf((*MockAlbumServiceClient)(nil).GetAlbumsByStatus), varargs...)
+}
+
 // GetAlbumsLabelID mocks base method.
 func (m *MockAlbumServiceClient) GetAlbumsLabelID(ctx context.Context, in *album.FiltersWithLabelID, opts ...grpc.CallOption) (*album.AlbumList, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +303,26 @@ func (mr *MockAlbumServiceClientMockRecorder) GetFavoriteAlbums(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteAlbums", reflect.TypeOf((*MockAlbumServiceClient)(nil).GetFavoriteAlbums), varargs...)
 }
 
+// GetLabelAlbumByID mocks base method.
+func (m *MockAlbumServiceClient) GetLabelAlbumByID(ctx context.Context, in *album.LabelAlbumRequest, opts ...grpc.CallOption) (*album.Album, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLabelAlbumByID", varargs...)
+	ret0, _ := ret[0].(*album.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelAlbumByID indicates an expected call of GetLabelAlbumByID.
+func (mr *MockAlbumServiceClientMockRecorder) GetLabelAlbumByID(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelAlbumByID", reflect.TypeOf((*MockAlbumServiceClient)(nil).GetLabelAlbumByID), varargs...)
+}
+
 // LikeAlbum mocks base method.
 func (m *MockAlbumServiceClient) LikeAlbum(ctx context.Context, in *album.LikeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +343,26 @@ func (mr *MockAlbumServiceClientMockRecorder) LikeAlbum(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeAlbum", reflect.TypeOf((*MockAlbumServiceClient)(nil).LikeAlbum), varargs...)
 }
 
+// PublishScheduledAlbums mocks base method.
+func (m *MockAlbumServiceClient) PublishScheduledAlbums(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*album.AlbumIDList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PublishScheduledAlbums", varargs...)
+	ret0, _ := ret[0].(*album.AlbumIDList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledAlbums indicates an expected call of PublishScheduledAlbums.
+func (mr *MockAlbumServiceClientMockRecorder) PublishScheduledAlbums(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledAlbums", reflect.TypeOf((*MockAlbumServiceClient)(nil).PublishScheduledAlbums), varargs...)
+}
+
 // SearchAlbums mocks base method.
 func (m *MockAlbumServiceClient) SearchAlbums(ctx context.Context, in *album.Query, opts ...grpc.CallOption) (*album.AlbumList, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +407,21 @@ func (m *MockAlbumServiceServer) EXPECT() *MockAlbumServiceServerMockRecorder {
 	return m.recorder
 }
 
+// ChangeAlbumStatus mocks base method.
+func (m *MockAlbumServiceServer) ChangeAlbumStatus(arg0 context.Context, arg1 *album.ChangeAlbumStatusRequest) (*album.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeAlbumStatus", arg0, arg1)
+	ret0, _ := ret[0].(*album.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeAlbumStatus indicates an expected call of ChangeAlbumStatus.
+func (mr *MockAlbumServiceServerMockRecorder) ChangeAlbumStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeAlbumStatus", reflect.TypeOf((*MockAlbumServiceServer)(nil).ChangeAlbumStatus), arg0, arg1)
+}
+
 // CreateAlbum mocks base method.
 func (m *MockAlbumServiceServer) CreateAlbum(arg0 context.Context, arg1 *album.CreateAlbumRequest) (*album.AlbumIDAndURL, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +467,21 @@ func (mr *MockAlbumServiceServerMockRecorder) DeleteAlbum(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlbum", reflect.TypeOf((*MockAlbumServiceServer)(nil).DeleteAlbum), arg0, arg1)
 }
 
+// EditAlbum mocks base method.
+func (m *MockAlbumServiceServer) EditAlbum(arg0 context.Context, arg1 *album.EditAlbumRequest) (*album.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditAlbum", arg0, arg1)
+	ret0, _ := ret[0].(*album.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditAlbum indicates an expected call of EditAlbum.
+func (mr *MockAlbumServiceServerMockRecorder) EditAlbum(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditAlbum", reflect.TypeOf((*MockAlbumServiceServer)(nil).EditAlbum), arg0, arg1)
+}
+
 // GetAlbumByID mocks base method.
 func (m *MockAlbumServiceServer) GetAlbumByID(arg0 context.Context, arg1 *album.AlbumIDWithUserID) (*album.Album, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +542,21 @@ func (mr *MockAlbumServiceServerMockRecorder) GetAlbumsByIDs(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByIDs", reflect.TypeOf((*MockAlbumServiceServer)(nil).GetAlbumsByIDs), arg0, arg1)
 }
 
+// GetAlbumsByStatus mocks base method.
+func (m *MockAlbumServiceServer) GetAlbumsByStatus(arg0 context.Context, arg1 *album.FiltersWithStatus) (*album.AlbumList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlbumsByStatus", arg0, arg1)
+	ret0, _ := ret[0].(*album.AlbumList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlbumsByStatus indicates an expected call of GetAlbumsByStatus.
+func (mr *MockAlbumServiceServerMockRecorder) GetAlbumsByStatus(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlbumsByStatus", reflect.TypeOf((*MockAlbumServiceServer)(nil).GetAlbumsByStatus), arg0, arg1)
+}
+
 // GetAlbumsLabelID mocks base method.
 func (m *MockAlbumServiceServer) GetAlbumsLabelID(arg0 context.Context, arg1 *album.FiltersWithLabelID) (*album.AlbumList, error) {
 	m.ctrl.T.Helper()
@@ -457,6 +602,21 @@ func (mr *MockAlbumServiceServerMockRecorder) GetFavoriteAlbums(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteAlbums", reflect.TypeOf((*MockAlbumServiceServer)(nil).GetFavoriteAlbums), arg0, arg1)
 }
 
+// GetLabelAlbumByID mocks base method.
+func (m *MockAlbumServiceServer) GetLabelAlbumByID(arg0 context.Context, arg1 *album.LabelAlbumRequest) (*album.Album, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelAlbumByID", arg0, arg1)
+	ret0, _ := ret[0].(*album.Album)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelAlbumByID indicates an expected call of GetLabelAlbumByID.
+func (mr *MockAlbumServiceServerMockRecorder) GetLabelAlbumByID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelAlbumByID", reflect.TypeOf((*MockAlbumServiceServer)(nil).GetLabelAlbumByID), arg0, arg1)
+}
+
 // LikeAlbum mocks base method.
 func (m *MockAlbumServiceServer) LikeAlbum(arg0 context.Context, arg1 *album.LikeRequest) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -472,6 +632,21 @@ func (mr *MockAlbumServiceServerMockRecorder) LikeAlbum(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeAlbum", reflect.TypeOf((*MockAlbumServiceServer)(nil).LikeAlbum), arg0, arg1)
 }
 
+// PublishScheduledAlbums mocks base method.
+func (m *MockAlbumServiceServer) PublishScheduledAlbums(arg0 context.Context, arg1 *emptypb.Empty) (*album.AlbumIDList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishScheduledAlbums", arg0, arg1)
+	ret0, _ := ret[0].(*album.AlbumIDList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishScheduledAlbums indicates an expected call of PublishScheduledAlbums.
+func (mr *MockAlbumServiceServerMockRecorder) PublishScheduledAlbums(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishScheduledAlbums", reflect.TypeOf((*MockAlbumServiceServer)(nil).PublishScheduledAlbums), arg0, arg1)
+}
+
 // SearchAlbums mocks base method.
 func (m *MockAlbumServiceServer) SearchAlbums(arg0 context.Context, arg1 *album.Query) (*album.AlbumList, error) {
 	m.ctrl.T.Helper()
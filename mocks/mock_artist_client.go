@@ -423,6 +423,46 @@ func (mr *MockArtistServiceClientMockRecorder) SearchArtists(ctx, in any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockArtistServiceClient)(nil).SearchArtists), varargs...)
 }
 
+// SetArtistCredits mocks base method.
+func (m *MockArtistServiceClient) SetArtistCredits(ctx context.Context, in *artist.ArtistCredits, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetArtistCredits", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetArtistCredits indicates an expected call of SetArtistCredits.
+func (mr *MockArtistServiceClientMockRecorder) SetArtistCredits(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArtistCredits", reflect.TypeOf((*MockArtistServiceClient)(nil).SetArtistCredits), varargs...)
+}
+
+// SetTrackArtistRoles mocks base method.
+func (m *MockArtistServiceClient) SetTrackArtistRoles(ctx context.Context, in *artist.TrackArtistRoles, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetTrackArtistRoles", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTrackArtistRoles indicates an expected call of SetTrackArtistRoles.
+func (mr *MockArtistServiceClientMockRecorder) SetTrackArtistRoles(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackArtistRoles", reflect.TypeOf((*MockArtistServiceClient)(nil).SetTrackArtistRoles), varargs...)
+}
+
 // MockArtistServiceServer is a mock of ArtistServiceServer interface.
 type MockArtistServiceServer struct {
 	ctrl     *gomock.Controller
@@ -732,6 +772,36 @@ func (mr *MockArtistServiceServerMockRecorder) SearchArtists(arg0, arg1 any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchArtists", reflect.TypeOf((*MockArtistServiceServer)(nil).SearchArtists), arg0, arg1)
 }
 
+// SetArtistCredits mocks base method.
+func (m *MockArtistServiceServer) SetArtistCredits(arg0 context.Context, arg1 *artist.ArtistCredits) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetArtistCredits", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetArtistCredits indicates an expected call of SetArtistCredits.
+func (mr *MockArtistServiceServerMockRecorder) SetArtistCredits(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetArtistCredits", reflect.TypeOf((*MockArtistServiceServer)(nil).SetArtistCredits), arg0, arg1)
+}
+
+// SetTrackArtistRoles mocks base method.
+func (m *MockArtistServiceServer) SetTrackArtistRoles(arg0 context.Context, arg1 *artist.TrackArtistRoles) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTrackArtistRoles", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetTrackArtistRoles indicates an expected call of SetTrackArtistRoles.
+func (mr *MockArtistServiceServerMockRecorder) SetTrackArtistRoles(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrackArtistRoles", reflect.TypeOf((*MockArtistServiceServer)(nil).SetTrackArtistRoles), arg0, arg1)
+}
+
 // mustEmbedUnimplementedArtistServiceServer mocks base method.
 func (m *MockArtistServiceServer) mustEmbedUnimplementedArtistServiceServer() {
 	m.ctrl.T.Helper()
@@ -83,6 +83,26 @@ func (mr *MockTrackServiceClientMockRecorder) CreateStream(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockTrackServiceClient)(nil).CreateStream), varargs...)
 }
 
+// DeleteTrackLyrics mocks base method.
+func (m *MockTrackServiceClient) DeleteTrackLyrics(ctx context.Context, in *track.TrackLyricsDelete, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteTrackLyrics", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrackLyrics indicates an expected call of DeleteTrackLyrics.
+func (mr *MockTrackServiceClientMockRecorder) DeleteTrackLyrics(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackLyrics", reflect.TypeOf((*MockTrackServiceClient)(nil).DeleteTrackLyrics), varargs...)
+}
+
 // DeleteTracksByAlbumID mocks base method.
 func (m *MockTrackServiceClient) DeleteTracksByAlbumID(ctx context.Context, in *track.AlbumID, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +123,26 @@ func (mr *MockTrackServiceClientMockRecorder) DeleteTracksByAlbumID(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTracksByAlbumID", reflect.TypeOf((*MockTrackServiceClient)(nil).DeleteTracksByAlbumID), varargs...)
 }
 
+// EditTrack mocks base method.
+func (m *MockTrackServiceClient) EditTrack(ctx context.Context, in *track.TrackEdit, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "EditTrack", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditTrack indicates an expected call of EditTrack.
+func (mr *MockTrackServiceClientMockRecorder) EditTrack(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditTrack", reflect.TypeOf((*MockTrackServiceClient)(nil).EditTrack), varargs...)
+}
+
 // GetAlbumIDByTrackID mocks base method.
 func (m *MockTrackServiceClient) GetAlbumIDByTrackID(ctx context.Context, in *track.TrackID, opts ...grpc.CallOption) (*track.AlbumID, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +203,26 @@ func (mr *MockTrackServiceClientMockRecorder) GetFavoriteTracks(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockTrackServiceClient)(nil).GetFavoriteTracks), varargs...)
 }
 
+// GetLabelTracksByAlbumID mocks base method.
+func (m *MockTrackServiceClient) GetLabelTracksByAlbumID(ctx context.Context, in *track.AlbumID, opts ...grpc.CallOption) (*track.TrackList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLabelTracksByAlbumID", varargs...)
+	ret0, _ := ret[0].(*track.TrackList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelTracksByAlbumID indicates an expected call of GetLabelTracksByAlbumID.
+func (mr *MockTrackServiceClientMockRecorder) GetLabelTracksByAlbumID(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelTracksByAlbumID", reflect.TypeOf((*MockTrackServiceClient)(nil).GetLabelTracksByAlbumID), varargs...)
+}
+
 // GetLastListenedTracks mocks base method.
 func (m *MockTrackServiceClient) GetLastListenedTracks(ctx context.Context, in *track.UserIDWithFilters, opts ...grpc.CallOption) (*track.TrackList, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +343,66 @@ func (mr *MockTrackServiceClientMockRecorder) GetMostRecentTracks(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMostRecentTracks", reflect.TypeOf((*MockTrackServiceClient)(nil).GetMostRecentTracks), varargs...)
 }
 
+// GetPendingDuplicateFlags mocks base method.
+func (m *MockTrackServiceClient) GetPendingDuplicateFlags(ctx context.Context, in *track.Filters, opts ...grpc.CallOption) (*track.DuplicateFlagList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPendingDuplicateFlags", varargs...)
+	ret0, _ := ret[0].(*track.DuplicateFlagList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingDuplicateFlags indicates an expected call of GetPendingDuplicateFlags.
+func (mr *MockTrackServiceClientMockRecorder) GetPendingDuplicateFlags(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDuplicateFlags", reflect.TypeOf((*MockTrackServiceClient)(nil).GetPendingDuplicateFlags), varargs...)
+}
+
+// GetStreamManifest mocks base method.
+func (m *MockTrackServiceClient) GetStreamManifest(ctx context.Context, in *track.StreamIDWithUserID, opts ...grpc.CallOption) (*track.StreamManifest, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStreamManifest", varargs...)
+	ret0, _ := ret[0].(*track.StreamManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamManifest indicates an expected call of GetStreamManifest.
+func (mr *MockTrackServiceClientMockRecorder) GetStreamManifest(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamManifest", reflect.TypeOf((*MockTrackServiceClient)(nil).GetStreamManifest), varargs...)
+}
+
+// GetStreamSegment mocks base method.
+func (m *MockTrackServiceClient) GetStreamSegment(ctx context.Context, in *track.StreamSegmentRequest, opts ...grpc.CallOption) (*track.StreamSegmentURL, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStreamSegment", varargs...)
+	ret0, _ := ret[0].(*track.StreamSegmentURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamSegment indicates an expected call of GetStreamSegment.
+func (mr *MockTrackServiceClientMockRecorder) GetStreamSegment(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamSegment", reflect.TypeOf((*MockTrackServiceClient)(nil).GetStreamSegment), varargs...)
+}
+
 // GetTrackByID mocks base method.
 func (m *MockTrackServiceClient) GetTrackByID(ctx context.Context, in *track.TrackIDWithUserID, opts ...grpc.CallOption) (*track.TrackDetailed, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +423,46 @@ func (mr *MockTrackServiceClientMockRecorder) GetTrackByID(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackByID", reflect.TypeOf((*MockTrackServiceClient)(nil).GetTrackByID), varargs...)
 }
 
+// GetTrackLyrics mocks base method.
+func (m *MockTrackServiceClient) GetTrackLyrics(ctx context.Context, in *track.TrackID, opts ...grpc.CallOption) (*track.TrackLyricsList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrackLyrics", varargs...)
+	ret0, _ := ret[0].(*track.TrackLyricsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackLyrics indicates an expected call of GetTrackLyrics.
+func (mr *MockTrackServiceClientMockRecorder) GetTrackLyrics(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackLyrics", reflect.TypeOf((*MockTrackServiceClient)(nil).GetTrackLyrics), varargs...)
+}
+
+// GetTrackWaveform mocks base method.
+func (m *MockTrackServiceClient) GetTrackWaveform(ctx context.Context, in *track.TrackID, opts ...grpc.CallOption) (*track.TrackWaveform, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrackWaveform", varargs...)
+	ret0, _ := ret[0].(*track.TrackWaveform)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackWaveform indicates an expected call of GetTrackWaveform.
+func (mr *MockTrackServiceClientMockRecorder) GetTrackWaveform(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackWaveform", reflect.TypeOf((*MockTrackServiceClient)(nil).GetTrackWaveform), varargs...)
+}
+
 // GetTracksByAlbumID mocks base method.
 func (m *MockTrackServiceClient) GetTracksByAlbumID(ctx context.Context, in *track.AlbumIDWithUserID, opts ...grpc.CallOption) (*track.TrackList, error) {
 	m.ctrl.T.Helper()
@@ -403,6 +563,86 @@ func (mr *MockTrackServiceClientMockRecorder) LikeTrack(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeTrack", reflect.TypeOf((*MockTrackServiceClient)(nil).LikeTrack), varargs...)
 }
 
+// ReadTrackMetadata mocks base method.
+func (m *MockTrackServiceClient) ReadTrackMetadata(ctx context.Context, in *track.TrackFileKey, opts ...grpc.CallOption) (*track.TrackMetadata, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReadTrackMetadata", varargs...)
+	ret0, _ := ret[0].(*track.TrackMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTrackMetadata indicates an expected call of ReadTrackMetadata.
+func (mr *MockTrackServiceClientMockRecorder) ReadTrackMetadata(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTrackMetadata", reflect.TypeOf((*MockTrackServiceClient)(nil).ReadTrackMetadata), varargs...)
+}
+
+// ReplaceTrackAudio mocks base method.
+func (m *MockTrackServiceClient) ReplaceTrackAudio(ctx context.Context, in *track.TrackAudioReplace, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ReplaceTrackAudio", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceTrackAudio indicates an expected call of ReplaceTrackAudio.
+func (mr *MockTrackServiceClientMockRecorder) ReplaceTrackAudio(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTrackAudio", reflect.TypeOf((*MockTrackServiceClient)(nil).ReplaceTrackAudio), varargs...)
+}
+
+// ResolveDuplicateFlag mocks base method.
+func (m *MockTrackServiceClient) ResolveDuplicateFlag(ctx context.Context, in *track.DuplicateFlagResolution, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveDuplicateFlag", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDuplicateFlag indicates an expected call of ResolveDuplicateFlag.
+func (mr *MockTrackServiceClientMockRecorder) ResolveDuplicateFlag(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDuplicateFlag", reflect.TypeOf((*MockTrackServiceClient)(nil).ResolveDuplicateFlag), varargs...)
+}
+
+// SaveTrackLyrics mocks base method.
+func (m *MockTrackServiceClient) SaveTrackLyrics(ctx context.Context, in *track.TrackLyricsUpload, opts ...grpc.CallOption) (*track.TrackLyrics, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SaveTrackLyrics", varargs...)
+	ret0, _ := ret[0].(*track.TrackLyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTrackLyrics indicates an expected call of SaveTrackLyrics.
+func (mr *MockTrackServiceClientMockRecorder) SaveTrackLyrics(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrackLyrics", reflect.TypeOf((*MockTrackServiceClient)(nil).SaveTrackLyrics), varargs...)
+}
+
 // SearchTracks mocks base method.
 func (m *MockTrackServiceClient) SearchTracks(ctx context.Context, in *track.Query, opts ...grpc.CallOption) (*track.TrackList, error) {
 	m.ctrl.T.Helper()
@@ -423,6 +663,46 @@ func (mr *MockTrackServiceClientMockRecorder) SearchTracks(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockTrackServiceClient)(nil).SearchTracks), varargs...)
 }
 
+// UpdateAlbumTracks mocks base method.
+func (m *MockTrackServiceClient) UpdateAlbumTracks(ctx context.Context, in *track.AlbumTracksUpdate, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAlbumTracks", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlbumTracks indicates an expected call of UpdateAlbumTracks.
+func (mr *MockTrackServiceClientMockRecorder) UpdateAlbumTracks(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracks", reflect.TypeOf((*MockTrackServiceClient)(nil).UpdateAlbumTracks), varargs...)
+}
+
+// UpdateAlbumTracksCover mocks base method.
+func (m *MockTrackServiceClient) UpdateAlbumTracksCover(ctx context.Context, in *track.AlbumCover, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateAlbumTracksCover", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlbumTracksCover indicates an expected call of UpdateAlbumTracksCover.
+func (mr *MockTrackServiceClientMockRecorder) UpdateAlbumTracksCover(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracksCover", reflect.TypeOf((*MockTrackServiceClient)(nil).UpdateAlbumTracksCover), varargs...)
+}
+
 // UpdateStreamDuration mocks base method.
 func (m *MockTrackServiceClient) UpdateStreamDuration(ctx context.Context, in *track.TrackStreamUpdateData, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -497,6 +777,21 @@ func (mr *MockTrackServiceServerMockRecorder) CreateStream(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockTrackServiceServer)(nil).CreateStream), arg0, arg1)
 }
 
+// DeleteTrackLyrics mocks base method.
+func (m *MockTrackServiceServer) DeleteTrackLyrics(arg0 context.Context, arg1 *track.TrackLyricsDelete) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrackLyrics", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrackLyrics indicates an expected call of DeleteTrackLyrics.
+func (mr *MockTrackServiceServerMockRecorder) DeleteTrackLyrics(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrackLyrics", reflect.TypeOf((*MockTrackServiceServer)(nil).DeleteTrackLyrics), arg0, arg1)
+}
+
 // DeleteTracksByAlbumID mocks base method.
 func (m *MockTrackServiceServer) DeleteTracksByAlbumID(arg0 context.Context, arg1 *track.AlbumID) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
@@ -512,6 +807,21 @@ func (mr *MockTrackServiceServerMockRecorder) DeleteTracksByAlbumID(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTracksByAlbumID", reflect.TypeOf((*MockTrackServiceServer)(nil).DeleteTracksByAlbumID), arg0, arg1)
 }
 
+// EditTrack mocks base method.
+func (m *MockTrackServiceServer) EditTrack(arg0 context.Context, arg1 *track.TrackEdit) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditTrack", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EditTrack indicates an expected call of EditTrack.
+func (mr *MockTrackServiceServerMockRecorder) EditTrack(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditTrack", reflect.TypeOf((*MockTrackServiceServer)(nil).EditTrack), arg0, arg1)
+}
+
 // GetAlbumIDByTrackID mocks base method.
 func (m *MockTrackServiceServer) GetAlbumIDByTrackID(arg0 context.Context, arg1 *track.TrackID) (*track.AlbumID, error) {
 	m.ctrl.T.Helper()
@@ -557,6 +867,21 @@ func (mr *MockTrackServiceServerMockRecorder) GetFavoriteTracks(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFavoriteTracks", reflect.TypeOf((*MockTrackServiceServer)(nil).GetFavoriteTracks), arg0, arg1)
 }
 
+// GetLabelTracksByAlbumID mocks base method.
+func (m *MockTrackServiceServer) GetLabelTracksByAlbumID(arg0 context.Context, arg1 *track.AlbumID) (*track.TrackList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLabelTracksByAlbumID", arg0, arg1)
+	ret0, _ := ret[0].(*track.TrackList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLabelTracksByAlbumID indicates an expected call of GetLabelTracksByAlbumID.
+func (mr *MockTrackServiceServerMockRecorder) GetLabelTracksByAlbumID(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLabelTracksByAlbumID", reflect.TypeOf((*MockTrackServiceServer)(nil).GetLabelTracksByAlbumID), arg0, arg1)
+}
+
 // GetLastListenedTracks mocks base method.
 func (m *MockTrackServiceServer) GetLastListenedTracks(arg0 context.Context, arg1 *track.UserIDWithFilters) (*track.TrackList, error) {
 	m.ctrl.T.Helper()
@@ -647,6 +972,51 @@ func (mr *MockTrackServiceServerMockRecorder) GetMostRecentTracks(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMostRecentTracks", reflect.TypeOf((*MockTrackServiceServer)(nil).GetMostRecentTracks), arg0, arg1)
 }
 
+// GetPendingDuplicateFlags mocks base method.
+func (m *MockTrackServiceServer) GetPendingDuplicateFlags(arg0 context.Context, arg1 *track.Filters) (*track.DuplicateFlagList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPendingDuplicateFlags", arg0, arg1)
+	ret0, _ := ret[0].(*track.DuplicateFlagList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPendingDuplicateFlags indicates an expected call of GetPendingDuplicateFlags.
+func (mr *MockTrackServiceServerMockRecorder) GetPendingDuplicateFlags(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPendingDuplicateFlags", reflect.TypeOf((*MockTrackServiceServer)(nil).GetPendingDuplicateFlags), arg0, arg1)
+}
+
+// GetStreamManifest mocks base method.
+func (m *MockTrackServiceServer) GetStreamManifest(arg0 context.Context, arg1 *track.StreamIDWithUserID) (*track.StreamManifest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamManifest", arg0, arg1)
+	ret0, _ := ret[0].(*track.StreamManifest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamManifest indicates an expected call of GetStreamManifest.
+func (mr *MockTrackServiceServerMockRecorder) GetStreamManifest(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamManifest", reflect.TypeOf((*MockTrackServiceServer)(nil).GetStreamManifest), arg0, arg1)
+}
+
+// GetStreamSegment mocks base method.
+func (m *MockTrackServiceServer) GetStreamSegment(arg0 context.Context, arg1 *track.StreamSegmentRequest) (*track.StreamSegmentURL, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStreamSegment", arg0, arg1)
+	ret0, _ := ret[0].(*track.StreamSegmentURL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStreamSegment indicates an expected call of GetStreamSegment.
+func (mr *MockTrackServiceServerMockRecorder) GetStreamSegment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamSegment", reflect.TypeOf((*MockTrackServiceServer)(nil).GetStreamSegment), arg0, arg1)
+}
+
 // GetTrackByID mocks base method.
 func (m *MockTrackServiceServer) GetTrackByID(arg0 context.Context, arg1 *track.TrackIDWithUserID) (*track.TrackDetailed, error) {
 	m.ctrl.T.Helper()
@@ -662,6 +1032,36 @@ func (mr *MockTrackServiceServerMockRecorder) GetTrackByID(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackByID", reflect.TypeOf((*MockTrackServiceServer)(nil).GetTrackByID), arg0, arg1)
 }
 
+// GetTrackLyrics mocks base method.
+func (m *MockTrackServiceServer) GetTrackLyrics(arg0 context.Context, arg1 *track.TrackID) (*track.TrackLyricsList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackLyrics", arg0, arg1)
+	ret0, _ := ret[0].(*track.TrackLyricsList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackLyrics indicates an expected call of GetTrackLyrics.
+func (mr *MockTrackServiceServerMockRecorder) GetTrackLyrics(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackLyrics", reflect.TypeOf((*MockTrackServiceServer)(nil).GetTrackLyrics), arg0, arg1)
+}
+
+// GetTrackWaveform mocks base method.
+func (m *MockTrackServiceServer) GetTrackWaveform(arg0 context.Context, arg1 *track.TrackID) (*track.TrackWaveform, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTrackWaveform", arg0, arg1)
+	ret0, _ := ret[0].(*track.TrackWaveform)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTrackWaveform indicates an expected call of GetTrackWaveform.
+func (mr *MockTrackServiceServerMockRecorder) GetTrackWaveform(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrackWaveform", reflect.TypeOf((*MockTrackServiceServer)(nil).GetTrackWaveform), arg0, arg1)
+}
+
 // GetTracksByAlbumID mocks base method.
 func (m *MockTrackServiceServer) GetTracksByAlbumID(arg0 context.Context, arg1 *track.AlbumIDWithUserID) (*track.TrackList, error) {
 	m.ctrl.T.Helper()
@@ -737,6 +1137,66 @@ func (mr *MockTrackServiceServerMockRecorder) LikeTrack(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LikeTrack", reflect.TypeOf((*MockTrackServiceServer)(nil).LikeTrack), arg0, arg1)
 }
 
+// ReadTrackMetadata mocks base method.
+func (m *MockTrackServiceServer) ReadTrackMetadata(arg0 context.Context, arg1 *track.TrackFileKey) (*track.TrackMetadata, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTrackMetadata", arg0, arg1)
+	ret0, _ := ret[0].(*track.TrackMetadata)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTrackMetadata indicates an expected call of ReadTrackMetadata.
+func (mr *MockTrackServiceServerMockRecorder) ReadTrackMetadata(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTrackMetadata", reflect.TypeOf((*MockTrackServiceServer)(nil).ReadTrackMetadata), arg0, arg1)
+}
+
+// ReplaceTrackAudio mocks base method.
+func (m *MockTrackServiceServer) ReplaceTrackAudio(arg0 context.Context, arg1 *track.TrackAudioReplace) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceTrackAudio", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceTrackAudio indicates an expected call of ReplaceTrackAudio.
+func (mr *MockTrackServiceServerMockRecorder) ReplaceTrackAudio(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceTrackAudio", reflect.TypeOf((*MockTrackServiceServer)(nil).ReplaceTrackAudio), arg0, arg1)
+}
+
+// ResolveDuplicateFlag mocks base method.
+func (m *MockTrackServiceServer) ResolveDuplicateFlag(arg0 context.Context, arg1 *track.DuplicateFlagResolution) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDuplicateFlag", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDuplicateFlag indicates an expected call of ResolveDuplicateFlag.
+func (mr *MockTrackServiceServerMockRecorder) ResolveDuplicateFlag(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDuplicateFlag", reflect.TypeOf((*MockTrackServiceServer)(nil).ResolveDuplicateFlag), arg0, arg1)
+}
+
+// SaveTrackLyrics mocks base method.
+func (m *MockTrackServiceServer) SaveTrackLyrics(arg0 context.Context, arg1 *track.TrackLyricsUpload) (*track.TrackLyrics, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveTrackLyrics", arg0, arg1)
+	ret0, _ := ret[0].(*track.TrackLyrics)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SaveTrackLyrics indicates an expected call of SaveTrackLyrics.
+func (mr *MockTrackServiceServerMockRecorder) SaveTrackLyrics(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveTrackLyrics", reflect.TypeOf((*MockTrackServiceServer)(nil).SaveTrackLyrics), arg0, arg1)
+}
+
 // SearchTracks mocks base method.
 func (m *MockTrackServiceServer) SearchTracks(arg0 context.Context, arg1 *track.Query) (*track.TrackList, error) {
 	m.ctrl.T.Helper()
@@ -752,6 +1212,36 @@ func (mr *MockTrackServiceServerMockRecorder) SearchTracks(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchTracks", reflect.TypeOf((*MockTrackServiceServer)(nil).SearchTracks), arg0, arg1)
 }
 
+// UpdateAlbumTracks mocks base method.
+func (m *MockTrackServiceServer) UpdateAlbumTracks(arg0 context.Context, arg1 *track.AlbumTracksUpdate) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbumTracks", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlbumTracks indicates an expected call of UpdateAlbumTracks.
+func (mr *MockTrackServiceServerMockRecorder) UpdateAlbumTracks(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracks", reflect.TypeOf((*MockTrackServiceServer)(nil).UpdateAlbumTracks), arg0, arg1)
+}
+
+// UpdateAlbumTracksCover mocks base method.
+func (m *MockTrackServiceServer) UpdateAlbumTracksCover(arg0 context.Context, arg1 *track.AlbumCover) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAlbumTracksCover", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAlbumTracksCover indicates an expected call of UpdateAlbumTracksCover.
+func (mr *MockTrackServiceServerMockRecorder) UpdateAlbumTracksCover(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlbumTracksCover", reflect.TypeOf((*MockTrackServiceServer)(nil).UpdateAlbumTracksCover), arg0, arg1)
+}
+
 // UpdateStreamDuration mocks base method.
 func (m *MockTrackServiceServer) UpdateStreamDuration(arg0 context.Context, arg1 *track.TrackStreamUpdateData) (*emptypb.Empty, error) {
 	m.ctrl.T.Helper()
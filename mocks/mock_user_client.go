@@ -142,6 +142,86 @@ func (mr *MockUserServiceClientMockRecorder) DeleteUser(ctx, in any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserServiceClient)(nil).DeleteUser), varargs...)
 }
 
+// FollowUser mocks base method.
+func (m *MockUserServiceClient) FollowUser(ctx context.Context, in *user.FollowRequest, opts ...grpc.CallOption) (*user.Nothing, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FollowUser", varargs...)
+	ret0, _ := ret[0].(*user.Nothing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockUserServiceClientMockRecorder) FollowUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockUserServiceClient)(nil).FollowUser), varargs...)
+}
+
+// GetActivityFeed mocks base method.
+func (m *MockUserServiceClient) GetActivityFeed(ctx context.Context, in *user.UserIDWithPagination, opts ...grpc.CallOption) (*user.ActivityList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetActivityFeed", varargs...)
+	ret0, _ := ret[0].(*user.ActivityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed.
+func (mr *MockUserServiceClientMockRecorder) GetActivityFeed(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockUserServiceClient)(nil).GetActivityFeed), varargs...)
+}
+
+// GetFollowers mocks base method.
+func (m *MockUserServiceClient) GetFollowers(ctx context.Context, in *user.UserIDWithPagination, opts ...grpc.CallOption) (*user.UsersToFront, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFollowers", varargs...)
+	ret0, _ := ret[0].(*user.UsersToFront)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockUserServiceClientMockRecorder) GetFollowers(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockUserServiceClient)(nil).GetFollowers), varargs...)
+}
+
+// GetFollowing mocks base method.
+func (m *MockUserServiceClient) GetFollowing(ctx context.Context, in *user.UserIDWithPagination, opts ...grpc.CallOption) (*user.UsersToFront, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFollowing", varargs...)
+	ret0, _ := ret[0].(*user.UsersToFront)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockUserServiceClientMockRecorder) GetFollowing(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockUserServiceClient)(nil).GetFollowing), varargs...)
+}
+
 // GetIDByUsername mocks base method.
 func (m *MockUserServiceClient) GetIDByUsername(ctx context.Context, in *user.Username, opts ...grpc.CallOption) (*user.UserID, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +402,26 @@ func (mr *MockUserServiceClientMockRecorder) RemoveUsersFromLabel(ctx, in any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsersFromLabel", reflect.TypeOf((*MockUserServiceClient)(nil).RemoveUsersFromLabel), varargs...)
 }
 
+// UnfollowUser mocks base method.
+func (m *MockUserServiceClient) UnfollowUser(ctx context.Context, in *user.FollowRequest, opts ...grpc.CallOption) (*user.Nothing, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UnfollowUser", varargs...)
+	ret0, _ := ret[0].(*user.Nothing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockUserServiceClientMockRecorder) UnfollowUser(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockUserServiceClient)(nil).UnfollowUser), varargs...)
+}
+
 // UpdateUsersLabelID mocks base method.
 func (m *MockUserServiceClient) UpdateUsersLabelID(ctx context.Context, in *user.RequestUpdateUserLabelID, opts ...grpc.CallOption) (*user.Nothing, error) {
 	m.ctrl.T.Helper()
@@ -363,14 +463,14 @@ func (mr *MockUserServiceClientMockRecorder) UploadAvatar(ctx, in any, opts ...a
 }
 
 // UploadUserAvatar mocks base method.
-func (m *MockUserServiceClient) UploadUserAvatar(ctx context.Context, in *user.AvatarImage, opts ...grpc.CallOption) (*user.FileKey, error) {
+func (m *MockUserServiceClient) UploadUserAvatar(ctx context.Context, in *user.AvatarImage, opts ...grpc.CallOption) (*user.UploadedAvatar, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
 	ret := m.ctrl.Call(m, "UploadUserAvatar", varargs...)
-	ret0, _ := ret[0].(*user.FileKey)
+	ret0, _ := ret[0].(*user.UploadedAvatar)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -481,6 +581,66 @@ func (mr *MockUserServiceServerMockRecorder) DeleteUser(arg0, arg1 any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUser", reflect.TypeOf((*MockUserServiceServer)(nil).DeleteUser), arg0, arg1)
 }
 
+// FollowUser mocks base method.
+func (m *MockUserServiceServer) FollowUser(arg0 context.Context, arg1 *user.FollowRequest) (*user.Nothing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FollowUser", arg0, arg1)
+	ret0, _ := ret[0].(*user.Nothing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FollowUser indicates an expected call of FollowUser.
+func (mr *MockUserServiceServerMockRecorder) FollowUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FollowUser", reflect.TypeOf((*MockUserServiceServer)(nil).FollowUser), arg0, arg1)
+}
+
+// GetActivityFeed mocks base method.
+func (m *MockUserServiceServer) GetActivityFeed(arg0 context.Context, arg1 *user.UserIDWithPagination) (*user.ActivityList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActivityFeed", arg0, arg1)
+	ret0, _ := ret[0].(*user.ActivityList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActivityFeed indicates an expected call of GetActivityFeed.
+func (mr *MockUserServiceServerMockRecorder) GetActivityFeed(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActivityFeed", reflect.TypeOf((*MockUserServiceServer)(nil).GetActivityFeed), arg0, arg1)
+}
+
+// GetFollowers mocks base method.
+func (m *MockUserServiceServer) GetFollowers(arg0 context.Context, arg1 *user.UserIDWithPagination) (*user.UsersToFront, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowers", arg0, arg1)
+	ret0, _ := ret[0].(*user.UsersToFront)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowers indicates an expected call of GetFollowers.
+func (mr *MockUserServiceServerMockRecorder) GetFollowers(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowers", reflect.TypeOf((*MockUserServiceServer)(nil).GetFollowers), arg0, arg1)
+}
+
+// GetFollowing mocks base method.
+func (m *MockUserServiceServer) GetFollowing(arg0 context.Context, arg1 *user.UserIDWithPagination) (*user.UsersToFront, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFollowing", arg0, arg1)
+	ret0, _ := ret[0].(*user.UsersToFront)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFollowing indicates an expected call of GetFollowing.
+func (mr *MockUserServiceServerMockRecorder) GetFollowing(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFollowing", reflect.TypeOf((*MockUserServiceServer)(nil).GetFollowing), arg0, arg1)
+}
+
 // GetIDByUsername mocks base method.
 func (m *MockUserServiceServer) GetIDByUsername(arg0 context.Context, arg1 *user.Username) (*user.UserID, error) {
 	m.ctrl.T.Helper()
@@ -616,6 +776,21 @@ func (mr *MockUserServiceServerMockRecorder) RemoveUsersFromLabel(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveUsersFromLabel", reflect.TypeOf((*MockUserServiceServer)(nil).RemoveUsersFromLabel), arg0, arg1)
 }
 
+// UnfollowUser mocks base method.
+func (m *MockUserServiceServer) UnfollowUser(arg0 context.Context, arg1 *user.FollowRequest) (*user.Nothing, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnfollowUser", arg0, arg1)
+	ret0, _ := ret[0].(*user.Nothing)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnfollowUser indicates an expected call of UnfollowUser.
+func (mr *MockUserServiceServerMockRecorder) UnfollowUser(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnfollowUser", reflect.TypeOf((*MockUserServiceServer)(nil).UnfollowUser), arg0, arg1)
+}
+
 // UpdateUsersLabelID mocks base method.
 func (m *MockUserServiceServer) UpdateUsersLabelID(arg0 context.Context, arg1 *user.RequestUpdateUserLabelID) (*user.Nothing, error) {
 	m.ctrl.T.Helper()
@@ -647,10 +822,10 @@ func (mr *MockUserServiceServerMockRecorder) UploadAvatar(arg0, arg1 any) *gomoc
 }
 
 // UploadUserAvatar mocks base method.
-func (m *MockUserServiceServer) UploadUserAvatar(arg0 context.Context, arg1 *user.AvatarImage) (*user.FileKey, error) {
+func (m *MockUserServiceServer) UploadUserAvatar(arg0 context.Context, arg1 *user.AvatarImage) (*user.UploadedAvatar, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UploadUserAvatar", arg0, arg1)
-	ret0, _ := ret[0].(*user.FileKey)
+	ret0, _ := ret[0].(*user.UploadedAvatar)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }